@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
 	log "github.com/sirupsen/logrus"
@@ -24,8 +26,11 @@ import (
 	"github.com/argoproj/argo-cd/util/cache"
 	"github.com/argoproj/argo-cd/util/cli"
 	"github.com/argoproj/argo-cd/util/kube"
+	"github.com/argoproj/argo-cd/util/profile"
+	"github.com/argoproj/argo-cd/util/selfcheck"
 	"github.com/argoproj/argo-cd/util/settings"
 	"github.com/argoproj/argo-cd/util/stats"
+	"github.com/argoproj/argo-cd/util/tls"
 )
 
 const (
@@ -41,6 +46,7 @@ func newCommand() *cobra.Command {
 		appResyncPeriod          int64
 		repoServerAddress        string
 		repoServerTimeoutSeconds int
+		repoServerClientOpts     apiclient.ClientOptions
 		selfHealTimeoutSeconds   int
 		statusProcessors         int
 		operationProcessors      int
@@ -48,7 +54,13 @@ func newCommand() *cobra.Command {
 		glogLevel                int
 		metricsPort              int
 		kubectlParallelismLimit  int64
+		profileDir               string
+		leaderElect              bool
+		leaderElection           controller.LeaderElectionConfig
+		selfCheck                bool
+		selfCheckStrict          bool
 		cacheSrc                 func() (*cache.Cache, error)
+		internalCertsSrc         func() (*tls.InternalCerts, error)
 	)
 	var command = cobra.Command{
 		Use:   cliName,
@@ -69,7 +81,10 @@ func newCommand() *cobra.Command {
 			errors.CheckError(err)
 
 			resyncDuration := time.Duration(appResyncPeriod) * time.Second
-			repoClientset := apiclient.NewRepoServerClientset(repoServerAddress, repoServerTimeoutSeconds)
+			repoServerClientOpts.InternalCerts, err = internalCertsSrc()
+			errors.CheckError(err)
+			repoServerClientOpts.TimeoutSeconds = repoServerTimeoutSeconds
+			repoClientset := apiclient.NewRepoServerClientset(repoServerAddress, repoServerClientOpts)
 			ctx, cancel := context.WithCancel(context.Background())
 			defer cancel()
 
@@ -77,6 +92,14 @@ func newCommand() *cobra.Command {
 			errors.CheckError(err)
 
 			settingsMgr := settings.NewSettingsManager(ctx, kubeClient, namespace)
+			if selfCheck || selfCheckStrict {
+				report := selfcheck.Run(cliName, selfChecks(kubeClient, namespace, cache, settingsMgr))
+				report.Log()
+				if selfCheckStrict {
+					errors.CheckError(report.Err())
+				}
+			}
+
 			kubectl := kube.KubectlCmd{}
 			appController, err := controller.NewApplicationController(
 				namespace,
@@ -91,12 +114,31 @@ func newCommand() *cobra.Command {
 				metricsPort,
 				kubectlParallelismLimit)
 			errors.CheckError(err)
+			if repoServerClientOpts.InternalCerts != nil {
+				appController.UseInternalCerts(repoServerClientOpts.InternalCerts)
+			}
+			appController.RegisterProfiler(profile.NewProfiler(profileDir))
 
 			log.Infof("Application Controller (version: %s) starting (namespace: %s)", common.GetVersion(), namespace)
 			stats.RegisterStackDumper()
 			stats.StartStatsTicker(10 * time.Minute)
 			stats.RegisterHeapDumper("memprofile")
 
+			if leaderElect {
+				if leaderElection.Identity == "" {
+					leaderElection.Identity, err = os.Hostname()
+					errors.CheckError(err)
+				}
+				// cancel on termination, so that the lease is released and another replica takes over immediately
+				signals := make(chan os.Signal, 1)
+				signal.Notify(signals, syscall.SIGTERM, syscall.SIGINT)
+				go func() {
+					<-signals
+					cancel()
+				}()
+				return appController.RunWithLeaderElection(ctx, leaderElection, statusProcessors, operationProcessors)
+			}
+
 			go appController.Run(ctx, statusProcessors, operationProcessors)
 
 			// Wait forever
@@ -105,21 +147,51 @@ func newCommand() *cobra.Command {
 	}
 
 	clientConfig = cli.AddKubectlFlagsToCmd(&command)
-	command.Flags().Int64Var(&appResyncPeriod, "app-resync", defaultAppResyncPeriod, "Time period in seconds for application resync.")
+	command.Flags().Int64Var(&appResyncPeriod, "app-resync", defaultAppResyncPeriod, "Time period in seconds for application resync. Overridden by timeout.reconciliation setting of argocd-cm.")
 	command.Flags().StringVar(&repoServerAddress, "repo-server", common.DefaultRepoServerAddr, "Repo server address.")
 	command.Flags().IntVar(&repoServerTimeoutSeconds, "repo-server-timeout-seconds", 60, "Repo server RPC call timeout seconds.")
+	command.Flags().DurationVar(&repoServerClientOpts.KeepAliveTime, "repo-server-keepalive-time", 0, "Interval of keepalive pings of idle repo server connections, at least 10s. Disabled if zero.")
+	command.Flags().UintVar(&repoServerClientOpts.MaxRetries, "repo-server-max-retries", 3, "Number of retries of repo server RPC calls which failed because the repo server was unavailable.")
+	command.Flags().DurationVar(&repoServerClientOpts.RetryBackoff, "repo-server-retry-backoff", time.Second, "Wait before the first retry of a repo server RPC call, which doubles with every further retry.")
+	command.Flags().IntVar(&repoServerClientOpts.Connections, "repo-server-connections", 0, "Number of repo server connections which are shared by all reconciliations, so that they are spread across repo server replicas. A connection per reconciliation if zero.")
 	command.Flags().IntVar(&statusProcessors, "status-processors", 1, "Number of application status processors")
 	command.Flags().IntVar(&operationProcessors, "operation-processors", 1, "Number of application operation processors")
 	command.Flags().StringVar(&logLevel, "loglevel", "info", "Set the logging level. One of: debug|info|warn|error")
 	command.Flags().IntVar(&glogLevel, "gloglevel", 0, "Set the glog logging level")
 	command.Flags().IntVar(&metricsPort, "metrics-port", common.DefaultPortArgoCDMetrics, "Start metrics server on given port")
+	command.Flags().StringVar(&profileDir, "profile-dir", "", "Directory which profiles are dumped to on request, e.g. the mount path of a persistent volume")
 	command.Flags().IntVar(&selfHealTimeoutSeconds, "self-heal-timeout-seconds", 5, "Specifies timeout between application self heal attempts")
 	command.Flags().Int64Var(&kubectlParallelismLimit, "kubectl-parallelism-limit", 20, "Number of allowed concurrent kubectl fork/execs. Any value less the 1 means no limit.")
+	command.Flags().BoolVar(&leaderElect, "leader-elect", false, "Elect a leader among the controller replicas, so that several replicas can be run for fast failover")
+	command.Flags().StringVar(&leaderElection.Identity, "leader-elect-identity", "", "Identity of the replica in the leader election (default is the hostname)")
+	command.Flags().DurationVar(&leaderElection.LeaseDuration, "leader-elect-lease-duration", 15*time.Second, "Duration after which replicas which stand by take over the lease of a leader which stopped renewing it")
+	command.Flags().DurationVar(&leaderElection.RenewDeadline, "leader-elect-renew-deadline", 10*time.Second, "Duration the leader retries to renew its lease before it gives up the leadership")
+	command.Flags().DurationVar(&leaderElection.RetryPeriod, "leader-elect-retry-period", 2*time.Second, "Interval between attempts to acquire or renew the lease")
+	command.Flags().BoolVar(&selfCheck, "self-check", false, "Verify the required binaries, the permissions on the host cluster, the cache connectivity and the settings at startup, and log a report of the checks")
+	command.Flags().BoolVar(&selfCheckStrict, "self-check-strict", false, "Refuse to start if a fatal self-check fails. Implies --self-check.")
 
 	cacheSrc = cache.AddCacheFlagsToCmd(&command)
+	internalCertsSrc = tls.AddInternalTLSFlagsToCmd(&command)
 	return &command
 }
 
+// selfChecks returns the checks of the environment of the controller. The permissions across namespaces are not fatal,
+// since the controller may manage only the namespaces it is permitted to.
+func selfChecks(kubeClient kubernetes.Interface, namespace string, cache *cache.Cache, settingsMgr *settings.SettingsManager) []selfcheck.Check {
+	checks := []selfcheck.Check{
+		selfcheck.Binary("kubectl", true),
+		selfcheck.Cache(cache),
+		selfcheck.Settings(settingsMgr),
+	}
+	checks = append(checks, selfcheck.Permissions(kubeClient, namespace, "argoproj.io", "applications", []string{"get", "list", "watch", "update", "patch"}, true)...)
+	checks = append(checks, selfcheck.Permissions(kubeClient, namespace, "argoproj.io", "appprojects", []string{"get", "list", "watch"}, true)...)
+	checks = append(checks, selfcheck.Permissions(kubeClient, namespace, "", "secrets", []string{"get", "list", "watch"}, true)...)
+	checks = append(checks, selfcheck.Permissions(kubeClient, namespace, "", "configmaps", []string{"get", "list", "watch"}, true)...)
+	checks = append(checks, selfcheck.Permissions(kubeClient, namespace, "", "events", []string{"create"}, false)...)
+	checks = append(checks, selfcheck.Permissions(kubeClient, "", "*", "*", []string{"get", "list", "watch"}, false)...)
+	return checks
+}
+
 func main() {
 	if err := newCommand().Execute(); err != nil {
 		fmt.Println(err)
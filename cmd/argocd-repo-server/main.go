@@ -5,6 +5,8 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
 	log "github.com/sirupsen/logrus"
@@ -16,7 +18,9 @@ import (
 	"github.com/argoproj/argo-cd/reposerver/metrics"
 	"github.com/argoproj/argo-cd/util/cache"
 	"github.com/argoproj/argo-cd/util/cli"
+	"github.com/argoproj/argo-cd/util/profile"
 	"github.com/argoproj/argo-cd/util/repo/factory"
+	"github.com/argoproj/argo-cd/util/selfcheck"
 	"github.com/argoproj/argo-cd/util/stats"
 	"github.com/argoproj/argo-cd/util/tls"
 )
@@ -30,10 +34,19 @@ func newCommand() *cobra.Command {
 	var (
 		logLevel               string
 		parallelismLimit       int64
+		allowStaleManifests    bool
+		sparseCheckout         bool
+		revisionMessageLength  int
 		listenPort             int
 		metricsPort            int
+		profileDir             string
+		shutdownGracePeriod    time.Duration
+		lockTimeout            time.Duration
+		selfCheck              bool
+		selfCheckStrict        bool
 		cacheSrc               func() (*cache.Cache, error)
 		tlsConfigCustomizerSrc func() (tls.ConfigCustomizer, error)
+		internalCertsSrc       func() (*tls.InternalCerts, error)
 	)
 	var command = cobra.Command{
 		Use:   cliName,
@@ -43,12 +56,23 @@ func newCommand() *cobra.Command {
 
 			tlsConfigCustomizer, err := tlsConfigCustomizerSrc()
 			errors.CheckError(err)
+			internalCerts, err := internalCertsSrc()
+			errors.CheckError(err)
 
 			cache, err := cacheSrc()
 			errors.CheckError(err)
 
-			metricsServer := metrics.NewMetricsServer(factory.NewFactory())
-			server, err := reposerver.NewServer(metricsServer, cache, tlsConfigCustomizer, parallelismLimit)
+			if selfCheck || selfCheckStrict {
+				report := selfcheck.Run(cliName, selfChecks(cache))
+				report.Log()
+				if selfCheckStrict {
+					errors.CheckError(report.Err())
+				}
+			}
+
+			metricsServer := metrics.NewMetricsServer(factory.NewFactory(), cache)
+			profiler := profile.NewProfiler(profileDir)
+			server, err := reposerver.NewServer(metricsServer, cache, tlsConfigCustomizer, internalCerts, parallelismLimit, allowStaleManifests, sparseCheckout, revisionMessageLength, lockTimeout, profiler)
 			errors.CheckError(err)
 
 			grpc := server.CreateGRPC()
@@ -56,27 +80,62 @@ func newCommand() *cobra.Command {
 			errors.CheckError(err)
 
 			http.Handle("/metrics", metricsServer.GetHandler())
+			http.Handle(profile.PathPrefix, profiler)
 			go func() { errors.CheckError(http.ListenAndServe(fmt.Sprintf(":%d", metricsPort), nil)) }()
 
 			log.Infof("argocd-repo-server %s serving on %s", common.GetVersion(), listener.Addr())
 			stats.RegisterStackDumper()
 			stats.StartStatsTicker(10 * time.Minute)
 			stats.RegisterHeapDumper("memprofile")
+
+			shutdownDone := make(chan struct{})
+			go func() {
+				sigCh := make(chan os.Signal, 1)
+				signal.Notify(sigCh, syscall.SIGTERM, os.Interrupt)
+				sig := <-sigCh
+				log.Infof("Received %v, shutting down within %v", sig, shutdownGracePeriod)
+				server.Shutdown(grpc, shutdownGracePeriod)
+				close(shutdownDone)
+			}()
 			err = grpc.Serve(listener)
 			errors.CheckError(err)
+			<-shutdownDone
+			log.Info("Shutdown complete")
 			return nil
 		},
 	}
 
 	command.Flags().StringVar(&logLevel, "loglevel", "info", "Set the logging level. One of: debug|info|warn|error")
 	command.Flags().Int64Var(&parallelismLimit, "parallelismlimit", 0, "Limit on number of concurrent manifests generate requests. Any value less the 1 means no limit.")
+	command.Flags().BoolVar(&allowStaleManifests, "allow-stale-manifests", false, "Serve the last generated manifests of an application while its repository is unreachable, and refresh them in the background.")
+	command.Flags().BoolVar(&sparseCheckout, "sparse-checkout", false, "Check out only the source path and the manifest-generate-paths of an application when generating its manifests, e.g. for large monorepos.")
+	command.Flags().IntVar(&revisionMessageLength, "revision-message-length", common.DefaultRevisionMessageLength, "Length which the commit messages of revision metadata are truncated to. Any value less than 1 disables the truncation.")
 	command.Flags().IntVar(&listenPort, "port", common.DefaultPortRepoServer, "Listen on given port for incoming connections")
 	command.Flags().IntVar(&metricsPort, "metrics-port", common.DefaultPortRepoServerMetrics, "Start metrics server on given port")
+	command.Flags().DurationVar(&lockTimeout, "lock-timeout", 0, "Time to wait for the lock of a repository before failing the request, e.g. while a stuck manifest generation holds it. Waits indefinitely if zero.")
+	command.Flags().DurationVar(&shutdownGracePeriod, "shutdown-grace-period", 25*time.Second, "Time to wait for in-flight requests to finish on SIGTERM. Should be less than the terminationGracePeriodSeconds of the pod.")
+	command.Flags().StringVar(&profileDir, "profile-dir", "", "Directory which profiles are dumped to on request, e.g. the mount path of a persistent volume")
+	command.Flags().BoolVar(&selfCheck, "self-check", false, "Verify the required binaries and the cache connectivity at startup, and log a report of the checks")
+	command.Flags().BoolVar(&selfCheckStrict, "self-check-strict", false, "Refuse to start if a fatal self-check fails. Implies --self-check.")
 	tlsConfigCustomizerSrc = tls.AddTLSFlagsToCmd(&command)
+	internalCertsSrc = tls.AddInternalTLSFlagsToCmd(&command)
 	cacheSrc = cache.AddCacheFlagsToCmd(&command)
 	return &command
 }
 
+// selfChecks returns the checks of the environment of the repo server. The tools which only some applications use are
+// not fatal.
+func selfChecks(cache *cache.Cache) []selfcheck.Check {
+	return []selfcheck.Check{
+		selfcheck.Binary("git", true),
+		selfcheck.Binary("helm", true),
+		selfcheck.Binary("kustomize", true),
+		selfcheck.Binary("ks", false),
+		selfcheck.Binary("sops", false),
+		selfcheck.Cache(cache),
+	}
+}
+
 func main() {
 	if err := newCommand().Execute(); err != nil {
 		fmt.Println(err)
@@ -15,6 +15,7 @@ import (
 	"github.com/argoproj/argo-cd/server"
 	"github.com/argoproj/argo-cd/util/cache"
 	"github.com/argoproj/argo-cd/util/cli"
+	grpc_util "github.com/argoproj/argo-cd/util/grpc"
 	"github.com/argoproj/argo-cd/util/stats"
 	"github.com/argoproj/argo-cd/util/tls"
 )
@@ -25,16 +26,23 @@ func NewCommand() *cobra.Command {
 		insecure                 bool
 		listenPort               int
 		metricsPort              int
+		admissionPort            int
 		logLevel                 string
 		glogLevel                int
 		clientConfig             clientcmd.ClientConfig
 		repoServerTimeoutSeconds int
+		repoServerClientOpts     apiclient.ClientOptions
 		staticAssetsDir          string
 		baseHRef                 string
 		repoServerAddress        string
 		dexServerAddress         string
+		appControllerAddress     string
 		disableAuth              bool
+		hstsMaxAge               time.Duration
+		hstsIncludeSubdomains    bool
+		rateLimit                grpc_util.RateLimiterOptions
 		tlsConfigCustomizerSrc   func() (tls.ConfigCustomizer, error)
+		internalCertsSrc         func() (*tls.InternalCerts, error)
 		cacheSrc                 func() (*cache.Cache, error)
 	)
 	var command = &cobra.Command{
@@ -55,27 +63,36 @@ func NewCommand() *cobra.Command {
 
 			tlsConfigCustomizer, err := tlsConfigCustomizerSrc()
 			errors.CheckError(err)
+			repoServerClientOpts.InternalCerts, err = internalCertsSrc()
+			errors.CheckError(err)
 			cache, err := cacheSrc()
 			errors.CheckError(err)
 
 			kubeclientset := kubernetes.NewForConfigOrDie(config)
 			appclientset := appclientset.NewForConfigOrDie(config)
-			repoclientset := apiclient.NewRepoServerClientset(repoServerAddress, repoServerTimeoutSeconds)
+			repoServerClientOpts.TimeoutSeconds = repoServerTimeoutSeconds
+			repoclientset := apiclient.NewRepoServerClientset(repoServerAddress, repoServerClientOpts)
 
 			argoCDOpts := server.ArgoCDServerOpts{
-				Insecure:            insecure,
-				ListenPort:          listenPort,
-				MetricsPort:         metricsPort,
-				Namespace:           namespace,
-				StaticAssetsDir:     staticAssetsDir,
-				BaseHRef:            baseHRef,
-				KubeClientset:       kubeclientset,
-				AppClientset:        appclientset,
-				RepoClientset:       repoclientset,
-				DexServerAddr:       dexServerAddress,
-				DisableAuth:         disableAuth,
-				TLSConfigCustomizer: tlsConfigCustomizer,
-				Cache:               cache,
+				Insecure:              insecure,
+				ListenPort:            listenPort,
+				MetricsPort:           metricsPort,
+				AdmissionPort:         admissionPort,
+				Namespace:             namespace,
+				StaticAssetsDir:       staticAssetsDir,
+				BaseHRef:              baseHRef,
+				KubeClientset:         kubeclientset,
+				AppClientset:          appclientset,
+				RepoClientset:         repoclientset,
+				DexServerAddr:         dexServerAddress,
+				AppControllerAddr:     appControllerAddress,
+				DisableAuth:           disableAuth,
+				TLSConfigCustomizer:   tlsConfigCustomizer,
+				InternalCerts:         repoServerClientOpts.InternalCerts,
+				HSTSMaxAge:            hstsMaxAge,
+				HSTSIncludeSubdomains: hstsIncludeSubdomains,
+				RateLimit:             rateLimit,
+				Cache:                 cache,
 			}
 
 			stats.RegisterStackDumper()
@@ -100,12 +117,24 @@ func NewCommand() *cobra.Command {
 	command.Flags().IntVar(&glogLevel, "gloglevel", 0, "Set the glog logging level")
 	command.Flags().StringVar(&repoServerAddress, "repo-server", common.DefaultRepoServerAddr, "Repo server address")
 	command.Flags().StringVar(&dexServerAddress, "dex-server", common.DefaultDexServerAddr, "Dex server address")
+	command.Flags().StringVar(&appControllerAddress, "app-controller-server", common.DefaultAppControllerMetricsAddr, "Application controller metrics server address, which serves its profiles")
 	command.Flags().BoolVar(&disableAuth, "disable-auth", false, "Disable client authentication")
+	command.Flags().DurationVar(&hstsMaxAge, "hsts-max-age", 0, "Max age of the Strict-Transport-Security header of HTTPS responses, e.g. 8760h. The header is not sent if zero")
+	command.Flags().BoolVar(&hstsIncludeSubdomains, "hsts-include-subdomains", false, "Apply the Strict-Transport-Security header to the subdomains of the host as well")
+	command.Flags().Float64Var(&rateLimit.RequestsPerSecond, "rate-limit-rps", 0, "Sustained number of API requests per second of each user. Requests are not limited if zero")
+	command.Flags().IntVar(&rateLimit.Burst, "rate-limit-burst", 20, "Number of API requests each user may make at once, on top of the sustained rate")
+	command.Flags().IntVar(&rateLimit.MaxConcurrent, "max-concurrent-expensive-requests", 0, "Number of syncs, rollbacks and manifest generations each user may have in flight. Not limited if zero")
 	command.AddCommand(cli.NewVersionCmd(cliName))
 	command.Flags().IntVar(&listenPort, "port", common.DefaultPortAPIServer, "Listen on given port")
 	command.Flags().IntVar(&metricsPort, "metrics-port", common.DefaultPortArgoCDAPIServerMetrics, "Start metrics on given port")
+	command.Flags().IntVar(&admissionPort, "admission-port", 0, "Serve the validating admission webhook over TLS on given port, which should only be reachable by the Kubernetes API server. Disabled if zero")
 	command.Flags().IntVar(&repoServerTimeoutSeconds, "repo-server-timeout-seconds", 60, "Repo server RPC call timeout seconds.")
+	command.Flags().DurationVar(&repoServerClientOpts.KeepAliveTime, "repo-server-keepalive-time", 0, "Interval of keepalive pings of idle repo server connections, at least 10s. Disabled if zero.")
+	command.Flags().UintVar(&repoServerClientOpts.MaxRetries, "repo-server-max-retries", 3, "Number of retries of repo server RPC calls which failed because the repo server was unavailable.")
+	command.Flags().DurationVar(&repoServerClientOpts.RetryBackoff, "repo-server-retry-backoff", time.Second, "Wait before the first retry of a repo server RPC call, which doubles with every further retry.")
+	command.Flags().IntVar(&repoServerClientOpts.Connections, "repo-server-connections", 0, "Number of repo server connections which are shared by all requests, so that they are spread across repo server replicas. A connection per request if zero.")
 	tlsConfigCustomizerSrc = tls.AddTLSFlagsToCmd(command)
+	internalCertsSrc = tls.AddInternalTLSFlagsToCmd(command)
 	cacheSrc = cache.AddCacheFlagsToCmd(command)
 	return command
 }
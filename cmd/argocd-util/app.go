@@ -0,0 +1,148 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/argoproj/argo-cd/errors"
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	appclientset "github.com/argoproj/argo-cd/pkg/client/clientset/versioned"
+	appclient "github.com/argoproj/argo-cd/pkg/client/clientset/versioned/typed/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/cli"
+)
+
+// NewAppCommand returns a new instance of an `argocd-util app` command
+func NewAppCommand() *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "app",
+		Short: "Provides set of commands to repair applications directly in the cluster, bypassing the API server",
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+		},
+	}
+	command.AddCommand(NewRemoveFinalizersCommand())
+	command.AddCommand(NewTerminateOperationCommand())
+	return command
+}
+
+// NewRemoveFinalizersCommand returns a new instance of an `argocd-util app remove-finalizers` command
+func NewRemoveFinalizersCommand() *cobra.Command {
+	var (
+		clientConfig clientcmd.ClientConfig
+	)
+	var command = &cobra.Command{
+		Use:   "remove-finalizers APPNAME",
+		Short: "Removes the finalizers of an application whose deletion is stuck",
+		Long: "Removes the finalizers of an application whose deletion is stuck, e.g. because its destination cluster no " +
+			"longer exists. The resources of the application are not deleted.",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appIf := newApplicationClient(clientConfig)
+			finalizers, err := removeFinalizers(appIf, args[0])
+			errors.CheckError(err)
+			if len(finalizers) == 0 {
+				fmt.Printf("Application '%s' has no finalizers\n", args[0])
+				return
+			}
+			fmt.Printf("Removed finalizers %s of application '%s'\n", strings.Join(finalizers, ", "), args[0])
+		},
+	}
+	clientConfig = cli.AddKubectlFlagsToCmd(command)
+	return command
+}
+
+// NewTerminateOperationCommand returns a new instance of an `argocd-util app terminate-op` command
+func NewTerminateOperationCommand() *cobra.Command {
+	var (
+		clientConfig clientcmd.ClientConfig
+	)
+	var command = &cobra.Command{
+		Use:   "terminate-op APPNAME",
+		Short: "Marks the operation of an application as failed without waiting for the application controller",
+		Long: "Marks the operation of an application as failed without waiting for the application controller. Use it " +
+			"for operations which are orphaned, e.g. because the controller is unable to process them. Hooks which are " +
+			"already running are not stopped, so prefer 'argocd app terminate-op' while the controller is available.",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appIf := newApplicationClient(clientConfig)
+			err := terminateOperation(appIf, args[0])
+			errors.CheckError(err)
+			fmt.Printf("Terminated operation of application '%s'\n", args[0])
+		},
+	}
+	clientConfig = cli.AddKubectlFlagsToCmd(command)
+	return command
+}
+
+func newApplicationClient(clientConfig clientcmd.ClientConfig) appclient.ApplicationInterface {
+	config, err := clientConfig.ClientConfig()
+	errors.CheckError(err)
+	namespace, _, err := clientConfig.Namespace()
+	errors.CheckError(err)
+	return appclientset.NewForConfigOrDie(config).ArgoprojV1alpha1().Applications(namespace)
+}
+
+// removeFinalizers removes all finalizers of the application and returns the removed finalizers
+func removeFinalizers(appIf appclient.ApplicationInterface, appName string) ([]string, error) {
+	app, err := appIf.Get(appName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	finalizers := app.Finalizers
+	if len(finalizers) == 0 {
+		return nil, nil
+	}
+	app.Finalizers = nil
+	_, err = appIf.Update(app)
+	if err != nil {
+		return nil, err
+	}
+	return finalizers, nil
+}
+
+// terminateOperation removes the operation of the application and marks its state as failed
+func terminateOperation(appIf appclient.ApplicationInterface, appName string) error {
+	app, err := appIf.Get(appName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if app.Operation == nil && (app.Status.OperationState == nil || app.Status.OperationState.Phase.Completed()) {
+		return fmt.Errorf("application '%s' has no operation in progress", appName)
+	}
+	if state := app.Status.OperationState; state != nil && !state.Phase.Completed() {
+		now := metav1.Now()
+		state.Phase = v1alpha1.OperationFailed
+		state.Message = "Operation terminated by argocd-util"
+		state.FinishedAt = &now
+		// the status is a subresource, so it is patched separately. The resource version makes the patch fail with a
+		// conflict if the controller changed the application in the meantime.
+		patch, err := json.Marshal(map[string]interface{}{
+			"metadata": map[string]interface{}{"resourceVersion": app.ResourceVersion},
+			"status":   map[string]interface{}{"operationState": state},
+		})
+		if err != nil {
+			return err
+		}
+		app, err = appIf.Patch(appName, types.MergePatchType, patch, "status")
+		if err != nil {
+			return err
+		}
+	}
+	app.Operation = nil
+	// the update fails with a conflict if the controller changed the application in the meantime
+	_, err = appIf.Update(app)
+	return err
+}
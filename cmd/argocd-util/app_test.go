@@ -0,0 +1,54 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	appclientset "github.com/argoproj/argo-cd/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-cd/test"
+)
+
+func newTestApp() *v1alpha1.Application {
+	return &v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: testNamespace, Finalizers: []string{"resources-finalizer.argocd.argoproj.io"}},
+	}
+}
+
+func TestRemoveFinalizers(t *testing.T) {
+	appIf := appclientset.NewSimpleClientset(newTestApp()).ArgoprojV1alpha1().Applications(testNamespace)
+
+	finalizers, err := removeFinalizers(appIf, "guestbook")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"resources-finalizer.argocd.argoproj.io"}, finalizers)
+	app, err := appIf.Get("guestbook", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Empty(t, app.Finalizers)
+
+	finalizers, err = removeFinalizers(appIf, "guestbook")
+	assert.NoError(t, err)
+	assert.Empty(t, finalizers)
+}
+
+func TestTerminateOperation(t *testing.T) {
+	app := newTestApp()
+	app.Operation = &v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{Revision: "HEAD"}}
+	app.Status.OperationState = &v1alpha1.OperationState{Phase: v1alpha1.OperationRunning, Operation: *app.Operation}
+	// updates do not change the status of the application, like in a real cluster
+	appIf := test.NewFakeAppClientsetWithStatusSubresource(app).ArgoprojV1alpha1().Applications(testNamespace)
+
+	err := terminateOperation(appIf, "guestbook")
+	assert.NoError(t, err)
+	app, err = appIf.Get("guestbook", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Nil(t, app.Operation)
+	assert.Equal(t, v1alpha1.OperationFailed, app.Status.OperationState.Phase)
+	assert.Equal(t, "Operation terminated by argocd-util", app.Status.OperationState.Message)
+	assert.NotNil(t, app.Status.OperationState.FinishedAt)
+	assert.Equal(t, "HEAD", app.Status.OperationState.Operation.Sync.Revision)
+
+	err = terminateOperation(appIf, "guestbook")
+	assert.Error(t, err)
+}
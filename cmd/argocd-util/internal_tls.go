@@ -0,0 +1,138 @@
+package main
+
+import (
+	"crypto/tls"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	apiv1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/errors"
+	"github.com/argoproj/argo-cd/util/cli"
+	tlsutil "github.com/argoproj/argo-cd/util/tls"
+)
+
+// internalServiceNames are the services of the Argo CD components, which the internal certificate is issued for
+var internalServiceNames = []string{
+	"argocd-server",
+	"argocd-repo-server",
+	"argocd-application-controller",
+	"argocd-metrics",
+	"argocd-redis",
+	"argocd-redis-ha",
+	"argocd-redis-ha-haproxy",
+}
+
+// internalCertHosts returns the host names of the services of the components in the namespace
+func internalCertHosts(namespace string) []string {
+	hosts := []string{"localhost"}
+	for _, name := range internalServiceNames {
+		hosts = append(hosts,
+			name,
+			fmt.Sprintf("%s.%s", name, namespace),
+			fmt.Sprintf("%s.%s.svc", name, namespace),
+			fmt.Sprintf("%s.%s.svc.cluster.local", name, namespace))
+	}
+	return hosts
+}
+
+// NewGenInternalCertsCommand returns a new instance of an `argocd-util gen-internal-certs` command
+func NewGenInternalCertsCommand() *cobra.Command {
+	var (
+		clientConfig clientcmd.ClientConfig
+		validity     time.Duration
+		caValidity   time.Duration
+		renewCA      bool
+	)
+	var command = &cobra.Command{
+		Use:   "gen-internal-certs",
+		Short: "Issues the certificate which secures the connections between Argo CD components with mutual TLS, using a CA which is generated on first use",
+		Run: func(c *cobra.Command, args []string) {
+			conf, err := clientConfig.ClientConfig()
+			errors.CheckError(err)
+			namespace, _, err := clientConfig.Namespace()
+			errors.CheckError(err)
+			secrets := kubernetes.NewForConfigOrDie(conf).CoreV1().Secrets(namespace)
+
+			caSecret, err := secrets.Get(common.ArgoCDInternalCASecretName, metav1.GetOptions{})
+			if apierr.IsNotFound(err) {
+				caSecret = &apiv1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDInternalCASecretName},
+					Type:       apiv1.SecretTypeTLS,
+				}
+				renewCA = true
+			} else {
+				errors.CheckError(err)
+			}
+			var ca *tls.Certificate
+			if !renewCA {
+				caCert, err := tls.X509KeyPair(caSecret.Data[apiv1.TLSCertKey], caSecret.Data[apiv1.TLSPrivateKeyKey])
+				errors.CheckError(err)
+				ca = &caCert
+			} else {
+				ca, err = tlsutil.GenerateX509KeyPair(tlsutil.CertOptions{
+					Hosts:        []string{"argocd-internal-ca"},
+					Organization: "Argo CD",
+					IsCA:         true,
+					ValidFor:     caValidity,
+					// the usages of the CA restrict the usages of the certificates it issues
+					ClientAuth: true,
+				})
+				errors.CheckError(err)
+				certPEM, keyPEM := tlsutil.EncodeX509KeyPair(*ca)
+				caSecret.Data = map[string][]byte{apiv1.TLSCertKey: certPEM, apiv1.TLSPrivateKeyKey: keyPEM}
+				errors.CheckError(applySecret(secrets, caSecret))
+				log.Infof("Generated CA %s", common.ArgoCDInternalCASecretName)
+			}
+
+			cert, err := tlsutil.GenerateX509KeyPair(tlsutil.CertOptions{
+				Hosts:        internalCertHosts(namespace),
+				Organization: "Argo CD",
+				ValidFor:     validity,
+				Issuer:       ca,
+				ClientAuth:   true,
+			})
+			errors.CheckError(err)
+			certPEM, keyPEM := tlsutil.EncodeX509KeyPair(*cert)
+			caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.Certificate[0]})
+			errors.CheckError(applySecret(secrets, &apiv1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDInternalTLSSecretName},
+				Type:       apiv1.SecretTypeTLS,
+				Data: map[string][]byte{
+					apiv1.TLSCertKey:           certPEM,
+					apiv1.TLSPrivateKeyKey:     keyPEM,
+					tlsutil.InternalCAFileName: caPEM,
+				},
+			}))
+			log.Infof("Issued certificate %s, valid for %v", common.ArgoCDInternalTLSSecretName, validity)
+		},
+	}
+	clientConfig = cli.AddKubectlFlagsToCmd(command)
+	command.Flags().DurationVar(&validity, "validity", 90*24*time.Hour, "Validity of the issued certificate")
+	command.Flags().DurationVar(&caValidity, "ca-validity", 10*365*24*time.Hour, "Validity of the CA, if it is generated")
+	command.Flags().BoolVar(&renewCA, "renew-ca", false, "Generate a new CA, even if one exists")
+	return command
+}
+
+// applySecret creates the secret or replaces the data of the existing secret of the same name
+func applySecret(secrets corev1.SecretInterface, secret *apiv1.Secret) error {
+	existing, err := secrets.Get(secret.Name, metav1.GetOptions{})
+	if apierr.IsNotFound(err) {
+		_, err = secrets.Create(secret)
+		return err
+	} else if err != nil {
+		return err
+	}
+	existing.Data = secret.Data
+	_, err = secrets.Update(existing)
+	return err
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-cd/errors"
+	"github.com/argoproj/argo-cd/util/ksonnet"
+)
+
+// NewKsonnetCommand returns a new instance of an `argocd-util ksonnet` command
+func NewKsonnetCommand() *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "ksonnet",
+		Short: "Provides set of commands for migrating ksonnet applications",
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+		},
+	}
+	command.AddCommand(NewConvertKsonnetCommand())
+	return command
+}
+
+// NewConvertKsonnetCommand returns a new instance of an `argocd-util ksonnet convert` command
+func NewConvertKsonnetCommand() *cobra.Command {
+	var (
+		environment string
+		outDir      string
+		format      string
+	)
+	var command = &cobra.Command{
+		Use:   "convert APP_DIR",
+		Short: "Converts an environment of a ksonnet app into a kustomize or plain jsonnet directory",
+		Long: "Converts an environment of a ksonnet app into a directory which Argo CD deploys without ksonnet. " +
+			"The kustomize format contains the rendered manifests and a kustomization.yaml. The jsonnet format keeps the " +
+			"components as jsonnet with their evaluated parameters, and fails if the result differs from the ksonnet app.",
+		Example: `
+# Convert the prod environment into a kustomization
+argocd-util ksonnet convert ./guestbook --env prod --output ./guestbook-prod
+
+# Convert the prod environment into a jsonnet directory
+argocd-util ksonnet convert ./guestbook --env prod --output ./guestbook-prod --format jsonnet`,
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 || environment == "" || outDir == "" {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			err := ksonnet.Convert(args[0], environment, outDir, format)
+			errors.CheckError(err)
+			fmt.Printf("Converted environment '%s' of %s into %s\n", environment, args[0], outDir)
+		},
+	}
+	command.Flags().StringVar(&environment, "env", "", "Environment of the ksonnet app to convert")
+	command.Flags().StringVar(&outDir, "output", "", "Directory to write the converted app to; must not exist")
+	command.Flags().StringVar(&format, "format", ksonnet.FormatKustomize, fmt.Sprintf("Output format: %s|%s", ksonnet.FormatKustomize, ksonnet.FormatJsonnet))
+	return command
+}
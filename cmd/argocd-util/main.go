@@ -30,6 +30,7 @@ import (
 	"github.com/argoproj/argo-cd/util/cli"
 	"github.com/argoproj/argo-cd/util/db"
 	"github.com/argoproj/argo-cd/util/dex"
+	"github.com/argoproj/argo-cd/util/encryption"
 	"github.com/argoproj/argo-cd/util/kube"
 	"github.com/argoproj/argo-cd/util/settings"
 
@@ -73,6 +74,12 @@ func NewCommand() *cobra.Command {
 	command.AddCommand(NewImportCommand())
 	command.AddCommand(NewExportCommand())
 	command.AddCommand(NewClusterConfig())
+	command.AddCommand(NewEncryptCommand())
+	command.AddCommand(NewGenInternalCertsCommand())
+	command.AddCommand(NewSettingsCommand())
+	command.AddCommand(NewKsonnetCommand())
+	command.AddCommand(NewAppCommand())
+	command.AddCommand(NewProjectCommand())
 
 	command.Flags().StringVar(&logLevel, "loglevel", "info", "Set the logging level. One of: debug|info|warn|error")
 	return command
@@ -94,47 +101,41 @@ func NewRunDexCommand() *cobra.Command {
 			errors.CheckError(err)
 			kubeClientset := kubernetes.NewForConfigOrDie(config)
 			settingsMgr := settings.NewSettingsManager(context.Background(), kubeClientset, namespace)
-			prevSettings, err := settingsMgr.GetSettings()
+			argoSettings, err := settingsMgr.GetSettings()
 			errors.CheckError(err)
 			updateCh := make(chan *settings.ArgoCDSettings, 1)
 			settingsMgr.Subscribe(updateCh)
 
+			var cmd *exec.Cmd
+			dexCfgBytes, err := dex.GenerateDexConfigYAML(argoSettings)
+			if err != nil {
+				log.Errorf("invalid dex config, waiting for a valid config: %v", err)
+			} else {
+				cmd = startDex(dexCfgBytes)
+			}
+
+			// reload dex whenever the dex config changes. Invalid configs are ignored, so dex keeps running with
+			// the last valid config
 			for {
-				var cmd *exec.Cmd
-				dexCfgBytes, err := dex.GenerateDexConfigYAML(prevSettings)
-				errors.CheckError(err)
-				if len(dexCfgBytes) == 0 {
-					log.Infof("dex is not configured")
-				} else {
-					err = ioutil.WriteFile("/tmp/dex.yaml", dexCfgBytes, 0644)
-					errors.CheckError(err)
-					log.Info(string(dexCfgBytes))
-					cmd = exec.Command("dex", "serve", "/tmp/dex.yaml")
-					cmd.Stdout = os.Stdout
-					cmd.Stderr = os.Stderr
-					err = cmd.Start()
-					errors.CheckError(err)
+				newSettings := <-updateCh
+				newDexCfgBytes, err := dex.GenerateDexConfigYAML(newSettings)
+				if err != nil {
+					log.Errorf("invalid dex config, keeping previous config: %v", err)
+					continue
 				}
-
-				// loop until the dex config changes
-				for {
-					newSettings := <-updateCh
-					newDexCfgBytes, err := dex.GenerateDexConfigYAML(newSettings)
+				if string(newDexCfgBytes) == string(dexCfgBytes) {
+					log.Infof("dex config unmodified")
+					continue
+				}
+				log.Infof("dex config modified. reloading dex")
+				if cmd != nil && cmd.Process != nil {
+					err = cmd.Process.Signal(syscall.SIGTERM)
+					errors.CheckError(err)
+					_, err = cmd.Process.Wait()
 					errors.CheckError(err)
-					if string(newDexCfgBytes) != string(dexCfgBytes) {
-						prevSettings = newSettings
-						log.Infof("dex config modified. restarting dex")
-						if cmd != nil && cmd.Process != nil {
-							err = cmd.Process.Signal(syscall.SIGTERM)
-							errors.CheckError(err)
-							_, err = cmd.Process.Wait()
-							errors.CheckError(err)
-						}
-						break
-					} else {
-						log.Infof("dex config unmodified")
-					}
 				}
+				dexCfgBytes = newDexCfgBytes
+				cmd = startDex(dexCfgBytes)
 			}
 		},
 	}
@@ -143,6 +144,23 @@ func NewRunDexCommand() *cobra.Command {
 	return &command
 }
 
+// startDex writes the dex config and starts dex with it. Returns nil if dex is not configured.
+func startDex(dexCfgBytes []byte) *exec.Cmd {
+	if len(dexCfgBytes) == 0 {
+		log.Infof("dex is not configured")
+		return nil
+	}
+	err := ioutil.WriteFile("/tmp/dex.yaml", dexCfgBytes, 0644)
+	errors.CheckError(err)
+	log.Info(string(dexCfgBytes))
+	cmd := exec.Command("dex", "serve", "/tmp/dex.yaml")
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	err = cmd.Start()
+	errors.CheckError(err)
+	return cmd
+}
+
 func NewGenDexConfigCommand() *cobra.Command {
 	var (
 		clientConfig clientcmd.ClientConfig
@@ -289,15 +307,17 @@ func NewImportCommand() *cobra.Command {
 				}
 				if !exists {
 					if !dryRun {
-						_, err = dynClient.Create(obj, metav1.CreateOptions{})
+						res, err := dynClient.Create(obj, metav1.CreateOptions{})
 						errors.CheckError(err)
+						importStatus(dynClient, obj, res)
 					}
 					fmt.Printf("%s/%s %s created%s\n", gvk.Group, gvk.Kind, obj.GetName(), dryRunMsg)
 				} else {
 					if !dryRun {
 						obj.SetResourceVersion(resourceVersion)
-						_, err = dynClient.Update(obj, metav1.UpdateOptions{})
+						res, err := dynClient.Update(obj, metav1.UpdateOptions{})
 						errors.CheckError(err)
+						importStatus(dynClient, obj, res)
 					}
 					fmt.Printf("%s/%s %s replaced%s\n", gvk.Group, gvk.Kind, obj.GetName(), dryRunMsg)
 				}
@@ -457,6 +477,18 @@ func getReferencedSecrets(un unstructured.Unstructured) map[string]bool {
 
 // isArgoCDSecret returns whether or not the given secret is a part of Argo CD configuration
 // (e.g. argocd-secret, repo credentials, or cluster credentials)
+// importStatus restores the status of an imported application, e.g. its history, which is ignored on create and update
+// since it is only written through the status subresource
+func importStatus(dynClient dynamic.ResourceInterface, obj *unstructured.Unstructured, res *unstructured.Unstructured) {
+	status, ok := obj.Object["status"]
+	if !ok || obj.GetKind() != "Application" {
+		return
+	}
+	res.Object["status"] = status
+	_, err := dynClient.UpdateStatus(res, metav1.UpdateOptions{})
+	errors.CheckError(err)
+}
+
 func isArgoCDSecret(repoSecretRefs map[string]bool, un unstructured.Unstructured) bool {
 	secretName := un.GetName()
 	if secretName == common.ArgoCDSecretName {
@@ -535,6 +567,28 @@ func NewClusterConfig() *cobra.Command {
 	return command
 }
 
+// NewEncryptCommand returns a new instance of an `argocd-util encrypt` command
+func NewEncryptCommand() *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "encrypt VALUE",
+		Short: "Encrypts a value of argocd-secret with the key of the ARGOCD_ENCRYPTION_KEY environment variable",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			key := encryption.NewKeyFromEnv(encryption.PurposeSettings)
+			if key == nil {
+				log.Fatalf("%s is not set", encryption.EnvEncryptionKey)
+			}
+			value, err := key.EncryptString(args[0])
+			errors.CheckError(err)
+			fmt.Println(value)
+		},
+	}
+	return command
+}
+
 func main() {
 	if err := NewCommand().Execute(); err != nil {
 		fmt.Println(err)
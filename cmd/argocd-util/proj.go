@@ -0,0 +1,133 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/argoproj/argo-cd/errors"
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	appclientset "github.com/argoproj/argo-cd/pkg/client/clientset/versioned"
+	appclient "github.com/argoproj/argo-cd/pkg/client/clientset/versioned/typed/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/cli"
+)
+
+// projectPoliciesResult is the outcome of recomputing the policies of one project
+type projectPoliciesResult struct {
+	project string
+	updated bool
+	err     error
+}
+
+// NewProjectCommand returns a new instance of an `argocd-util proj` command
+func NewProjectCommand() *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "proj",
+		Short: "Provides set of commands to repair projects directly in the cluster, bypassing the API server",
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+		},
+	}
+	command.AddCommand(NewRecomputePoliciesCommand())
+	return command
+}
+
+// NewRecomputePoliciesCommand returns a new instance of an `argocd-util proj recompute-policies` command
+func NewRecomputePoliciesCommand() *cobra.Command {
+	var (
+		clientConfig clientcmd.ClientConfig
+		dryRun       bool
+	)
+	var command = &cobra.Command{
+		Use:   "recompute-policies [PROJECT...]",
+		Short: "Normalizes and validates the role policies of projects",
+		Long: "Normalizes and validates the role policies of the given projects, or of all projects if none are given, " +
+			"the same way the API server does when a project is updated. Use it to repair projects which were edited " +
+			"directly in the cluster.",
+		Example: `
+# Show which projects would be updated
+argocd-util proj recompute-policies --dry-run
+
+# Recompute the policies of the default project
+argocd-util proj recompute-policies default`,
+		Run: func(c *cobra.Command, args []string) {
+			config, err := clientConfig.ClientConfig()
+			errors.CheckError(err)
+			namespace, _, err := clientConfig.Namespace()
+			errors.CheckError(err)
+			projIf := appclientset.NewForConfigOrDie(config).ArgoprojV1alpha1().AppProjects(namespace)
+
+			results, err := recomputeProjectPolicies(projIf, args, dryRun)
+			errors.CheckError(err)
+			failed := false
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			_, _ = fmt.Fprintf(w, "PROJECT\tSTATUS\tMESSAGE\n")
+			for _, res := range results {
+				status, message := "Unchanged", ""
+				if res.err != nil {
+					failed = true
+					status, message = "Failed", res.err.Error()
+				} else if res.updated && dryRun {
+					status = "Changed (dry run)"
+				} else if res.updated {
+					status = "Updated"
+				}
+				_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", res.project, status, message)
+			}
+			_ = w.Flush()
+			if failed {
+				os.Exit(1)
+			}
+		},
+	}
+	clientConfig = cli.AddKubectlFlagsToCmd(command)
+	command.Flags().BoolVar(&dryRun, "dry-run", false, "Only show which projects would be updated")
+	return command
+}
+
+// recomputeProjectPolicies normalizes and validates the policies of the named projects, or all projects, and updates
+// the projects whose policies changed
+func recomputeProjectPolicies(projIf appclient.AppProjectInterface, names []string, dryRun bool) ([]projectPoliciesResult, error) {
+	var projects []v1alpha1.AppProject
+	if len(names) == 0 {
+		list, err := projIf.List(metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		projects = list.Items
+	} else {
+		for _, name := range names {
+			proj, err := projIf.Get(name, metav1.GetOptions{})
+			if err != nil {
+				return nil, err
+			}
+			projects = append(projects, *proj)
+		}
+	}
+	results := make([]projectPoliciesResult, len(projects))
+	for i := range projects {
+		proj := projects[i]
+		results[i].project = proj.Name
+		updated := proj.DeepCopy()
+		updated.NormalizePolicies()
+		if err := updated.ValidateProject(); err != nil {
+			results[i].err = err
+			continue
+		}
+		if reflect.DeepEqual(proj.Spec.Roles, updated.Spec.Roles) {
+			continue
+		}
+		results[i].updated = true
+		if !dryRun {
+			if _, err := projIf.Update(updated); err != nil {
+				results[i].err = err
+			}
+		}
+	}
+	return results, nil
+}
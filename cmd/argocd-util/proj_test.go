@@ -0,0 +1,52 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	appclientset "github.com/argoproj/argo-cd/pkg/client/clientset/versioned/fake"
+)
+
+func newTestProject(name string, policies ...string) *v1alpha1.AppProject {
+	return &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace},
+		Spec: v1alpha1.AppProjectSpec{
+			Roles: []v1alpha1.ProjectRole{{Name: "ci", Policies: policies}},
+		},
+	}
+}
+
+func TestRecomputeProjectPolicies(t *testing.T) {
+	projIf := appclientset.NewSimpleClientset(
+		newTestProject("normalized", "p, proj:normalized:ci, applications, sync, normalized/*, allow"),
+		newTestProject("unnormalized", "p,proj:unnormalized:ci,applications,sync,unnormalized/*,allow"),
+		newTestProject("invalid", "p, proj:other:ci, applications, sync, other/*, allow"),
+	).ArgoprojV1alpha1().AppProjects(testNamespace)
+
+	results, err := recomputeProjectPolicies(projIf, []string{"unnormalized"}, true)
+	assert.NoError(t, err)
+	assert.Equal(t, []projectPoliciesResult{{project: "unnormalized", updated: true}}, results)
+	proj, err := projIf.Get("unnormalized", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "p,proj:unnormalized:ci,applications,sync,unnormalized/*,allow", proj.Spec.Roles[0].Policies[0])
+
+	results, err = recomputeProjectPolicies(projIf, nil, false)
+	assert.NoError(t, err)
+	resultsByProject := make(map[string]projectPoliciesResult)
+	for _, res := range results {
+		resultsByProject[res.project] = res
+	}
+	assert.Len(t, resultsByProject, 3)
+	assert.Equal(t, projectPoliciesResult{project: "normalized"}, resultsByProject["normalized"])
+	assert.Equal(t, projectPoliciesResult{project: "unnormalized", updated: true}, resultsByProject["unnormalized"])
+	assert.Error(t, resultsByProject["invalid"].err)
+	proj, err = projIf.Get("unnormalized", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "p, proj:unnormalized:ci, applications, sync, unnormalized/*, allow", proj.Spec.Roles[0].Policies[0])
+
+	_, err = recomputeProjectPolicies(projIf, []string{"missing"}, false)
+	assert.Error(t, err)
+}
@@ -0,0 +1,415 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/ghodss/yaml"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	lua "github.com/yuin/gopher-lua"
+	apiv1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/errors"
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/argo"
+	"github.com/argoproj/argo-cd/util/cli"
+	"github.com/argoproj/argo-cd/util/dex"
+	"github.com/argoproj/argo-cd/util/groupsync"
+	"github.com/argoproj/argo-cd/util/rbac"
+	"github.com/argoproj/argo-cd/util/settings"
+)
+
+// settingsValidationResult is the outcome of validating one section of the settings
+type settingsValidationResult struct {
+	Section string   `json:"section"`
+	Errors  []string `json:"errors,omitempty"`
+}
+
+type settingsValidator struct {
+	section  string
+	validate func(mgr *settings.SettingsManager) []string
+}
+
+// NewSettingsCommand returns a new instance of an `argocd-util settings` command
+func NewSettingsCommand() *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "settings",
+		Short: "Provides set of commands for settings validation and troubleshooting",
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+		},
+	}
+	command.AddCommand(NewValidateSettingsCommand())
+	return command
+}
+
+// NewValidateSettingsCommand returns a new instance of an `argocd-util settings validate` command
+func NewValidateSettingsCommand() *cobra.Command {
+	var (
+		clientConfig        clientcmd.ClientConfig
+		argocdCMPath        string
+		argocdSecretPath    string
+		rbacCMPath          string
+		loadClusterSettings bool
+		output              string
+	)
+	var command = &cobra.Command{
+		Use:   "validate",
+		Short: "Validates settings of argocd-cm, argocd-rbac-cm and argocd-secret",
+		Long: "Validates settings of argocd-cm, argocd-rbac-cm and argocd-secret loaded from local files and/or the cluster. " +
+			"Secrets referenced by repositories are only verified if the settings are loaded from the cluster.",
+		Example: `
+# Validate the settings of the cluster
+argocd-util settings validate --load-cluster-settings
+
+# Validate a modified argocd-cm before applying it
+argocd-util settings validate --argocd-cm-path ./argocd-cm.yaml --load-cluster-settings`,
+		Run: func(c *cobra.Command, args []string) {
+			namespace := "argocd"
+			var liveClientset kubernetes.Interface
+			if loadClusterSettings {
+				config, err := clientConfig.ClientConfig()
+				errors.CheckError(err)
+				namespace, _, err = clientConfig.Namespace()
+				errors.CheckError(err)
+				liveClientset = kubernetes.NewForConfigOrDie(config)
+			}
+
+			argocdCM := &apiv1.ConfigMap{}
+			errors.CheckError(loadSettingsObject(liveClientset, namespace, common.ArgoCDConfigMapName, argocdCMPath, argocdCM))
+			rbacCM := &apiv1.ConfigMap{}
+			errors.CheckError(loadSettingsObject(liveClientset, namespace, common.ArgoCDRBACConfigMapName, rbacCMPath, rbacCM))
+			argocdSecret := &apiv1.Secret{}
+			errors.CheckError(loadSettingsObject(liveClientset, namespace, common.ArgoCDSecretName, argocdSecretPath, argocdSecret))
+			for k, v := range argocdSecret.StringData {
+				if argocdSecret.Data == nil {
+					argocdSecret.Data = make(map[string][]byte)
+				}
+				argocdSecret.Data[k] = []byte(v)
+			}
+
+			clientset := fake.NewSimpleClientset(argocdCM, rbacCM, argocdSecret)
+			mgr := settings.NewSettingsManager(context.Background(), clientset, namespace)
+			results := validateSettings(mgr, rbacCM, liveClientset, namespace)
+
+			failed := false
+			for _, res := range results {
+				failed = failed || len(res.Errors) > 0
+			}
+			switch output {
+			case "json":
+				data, err := json.MarshalIndent(results, "", "  ")
+				errors.CheckError(err)
+				fmt.Println(string(data))
+			case "":
+				w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+				_, _ = fmt.Fprintf(w, "SECTION\tSTATUS\tMESSAGE\n")
+				for _, res := range results {
+					if len(res.Errors) == 0 {
+						_, _ = fmt.Fprintf(w, "%s\t%s\t\n", res.Section, "Valid")
+						continue
+					}
+					for i, msg := range res.Errors {
+						section := ""
+						if i == 0 {
+							section = res.Section
+						}
+						_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", section, "Invalid", msg)
+					}
+				}
+				_ = w.Flush()
+			default:
+				log.Fatalf("Unknown output format: %s", output)
+			}
+			if failed {
+				os.Exit(1)
+			}
+		},
+	}
+	clientConfig = cli.AddKubectlFlagsToCmd(command)
+	command.Flags().StringVar(&argocdCMPath, "argocd-cm-path", "", "Path to a local argocd-cm.yaml file")
+	command.Flags().StringVar(&argocdSecretPath, "argocd-secret-path", "", "Path to a local argocd-secret.yaml file")
+	command.Flags().StringVar(&rbacCMPath, "argocd-rbac-cm-path", "", "Path to a local argocd-rbac-cm.yaml file")
+	command.Flags().BoolVar(&loadClusterSettings, "load-cluster-settings", false, "Load the settings which are not provided as local files from the cluster")
+	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: json")
+	return command
+}
+
+// loadSettingsObject loads the named settings object from the given file or, if no file is given, from the cluster
+func loadSettingsObject(clientset kubernetes.Interface, namespace string, name string, path string, obj metav1.Object) error {
+	var err error
+	switch {
+	case path != "":
+		var data []byte
+		data, err = ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if err = yaml.Unmarshal(data, obj); err != nil {
+			return fmt.Errorf("failed to parse %s: %v", path, err)
+		}
+	case clientset != nil:
+		switch o := obj.(type) {
+		case *apiv1.ConfigMap:
+			var cm *apiv1.ConfigMap
+			if cm, err = clientset.CoreV1().ConfigMaps(namespace).Get(name, metav1.GetOptions{}); err == nil {
+				cm.DeepCopyInto(o)
+			}
+		case *apiv1.Secret:
+			var secret *apiv1.Secret
+			if secret, err = clientset.CoreV1().Secrets(namespace).Get(name, metav1.GetOptions{}); err == nil {
+				secret.DeepCopyInto(o)
+			}
+		}
+		if err != nil && !apierr.IsNotFound(err) {
+			return err
+		}
+	}
+	obj.SetName(name)
+	obj.SetNamespace(namespace)
+	labels := obj.GetLabels()
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+	labels["app.kubernetes.io/part-of"] = "argocd"
+	obj.SetLabels(labels)
+	return nil
+}
+
+// validateSettings validates each section of the settings. Secrets referenced by repositories are looked up using
+// the given clientset, unless it is nil.
+func validateSettings(mgr *settings.SettingsManager, rbacCM *apiv1.ConfigMap, clientset kubernetes.Interface, namespace string) []settingsValidationResult {
+	validators := []settingsValidator{{
+		section: "General",
+		validate: func(mgr *settings.SettingsManager) []string {
+			var errs []string
+			if _, err := mgr.GetSettings(); err != nil {
+				errs = append(errs, err.Error())
+			}
+			if _, err := mgr.GetReconciliationTimeout(); err != nil {
+				errs = append(errs, err.Error())
+			}
+			if _, err := mgr.GetReconciliationJitter(); err != nil {
+				errs = append(errs, err.Error())
+			}
+			if _, err := mgr.GetResourcesFilter(); err != nil {
+				errs = append(errs, fmt.Sprintf("resource inclusions/exclusions: %v", err))
+			}
+			return errs
+		},
+	}, {
+		section:  "Resource Overrides",
+		validate: validateResourceOverrides,
+	}, {
+		section: "Repositories",
+		validate: func(mgr *settings.SettingsManager) []string {
+			return validateRepositories(mgr, clientset, namespace)
+		},
+	}, {
+		section: "Plugins",
+		validate: func(mgr *settings.SettingsManager) []string {
+			plugins, err := mgr.GetConfigManagementPlugins()
+			if err != nil {
+				return []string{err.Error()}
+			}
+			var errs []string
+			for _, plugin := range plugins {
+				if plugin.Name == "" {
+					errs = append(errs, "plugin name is missing")
+				}
+				if len(plugin.Generate.Command) == 0 {
+					errs = append(errs, fmt.Sprintf("plugin %s: generate command is missing", plugin.Name))
+				}
+			}
+			return errs
+		},
+	}, {
+		section: "Dex",
+		validate: func(mgr *settings.SettingsManager) []string {
+			argoSettings, err := mgr.GetSettings()
+			if err != nil || argoSettings.DexConfig == "" {
+				return nil
+			}
+			if _, err := dex.GenerateDexConfigYAML(argoSettings); err != nil {
+				return []string{err.Error()}
+			}
+			return nil
+		},
+	}, {
+		section: "OIDC",
+		validate: func(mgr *settings.SettingsManager) []string {
+			argoSettings, err := mgr.GetSettings()
+			if err != nil || argoSettings.OIDCConfigRAW == "" {
+				return nil
+			}
+			var oidcConfig settings.OIDCConfig
+			if err := yaml.Unmarshal([]byte(argoSettings.OIDCConfigRAW), &oidcConfig); err != nil {
+				return []string{err.Error()}
+			}
+			var errs []string
+			if oidcConfig.Issuer == "" {
+				errs = append(errs, "issuer is missing")
+			}
+			if oidcConfig.ClientID == "" {
+				errs = append(errs, "clientID is missing")
+			}
+			return errs
+		},
+	}, {
+		section: "RBAC",
+		validate: func(mgr *settings.SettingsManager) []string {
+			if err := rbac.ValidatePolicy(rbacCM.Data[rbac.ConfigMapPolicyCSVKey]); err != nil {
+				return []string{err.Error()}
+			}
+			return nil
+		},
+	}, {
+		section: "Secrets Backend",
+		validate: func(mgr *settings.SettingsManager) []string {
+			if _, err := mgr.GetSecretsBackendConfig(); err != nil {
+				return []string{err.Error()}
+			}
+			return nil
+		},
+	}, {
+		section: "Group Sync",
+		validate: func(mgr *settings.SettingsManager) []string {
+			config, err := mgr.GetGroupSyncConfig()
+			if err != nil {
+				return []string{err.Error()}
+			}
+			if config == nil {
+				return nil
+			}
+			var errs []string
+			if _, err := config.GetRefreshInterval(); err != nil {
+				errs = append(errs, err.Error())
+			}
+			if _, err := groupsync.NewProvider(config); err != nil {
+				errs = append(errs, err.Error())
+			}
+			return errs
+		},
+	}, {
+		section: "Session Signing Key",
+		validate: func(mgr *settings.SettingsManager) []string {
+			if _, err := mgr.GetSessionSigningKeyConfig(); err != nil {
+				return []string{err.Error()}
+			}
+			return nil
+		},
+	}}
+
+	results := make([]settingsValidationResult, len(validators))
+	for i, v := range validators {
+		results[i] = settingsValidationResult{Section: v.section, Errors: v.validate(mgr)}
+	}
+	return results
+}
+
+func validateResourceOverrides(mgr *settings.SettingsManager) []string {
+	overrides, err := mgr.GetResourceOverrides()
+	if err != nil {
+		return []string{err.Error()}
+	}
+	keys := make([]string, 0, len(overrides))
+	for key := range overrides {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var errs []string
+	for _, key := range keys {
+		override := overrides[key]
+		if override.HealthLua != "" {
+			if err := compileLua(override.HealthLua); err != nil {
+				errs = append(errs, fmt.Sprintf("%s: health.lua: %v", key, err))
+			}
+		}
+		if override.Actions != "" {
+			actions, err := override.GetActions()
+			if err != nil {
+				errs = append(errs, fmt.Sprintf("%s: actions: %v", key, err))
+			} else {
+				if err := compileLua(actions.ActionDiscoveryLua); err != nil {
+					errs = append(errs, fmt.Sprintf("%s: actions: discovery.lua: %v", key, err))
+				}
+				for _, action := range actions.Definitions {
+					if err := compileLua(action.ActionLua); err != nil {
+						errs = append(errs, fmt.Sprintf("%s: actions: %s: %v", key, action.Name, err))
+					}
+				}
+			}
+		}
+		if override.IgnoreDifferences != "" {
+			if !strings.Contains(key, "/") {
+				// the diff normalizer only applies overrides of resources with a group
+				continue
+			}
+			if _, err := argo.NewDiffNormalizer(nil, map[string]v1alpha1.ResourceOverride{key: override}); err != nil {
+				errs = append(errs, fmt.Sprintf("%s: ignoreDifferences: %v", key, err))
+			}
+		}
+	}
+	return errs
+}
+
+func compileLua(script string) error {
+	l := lua.NewState()
+	defer l.Close()
+	_, err := l.LoadString(script)
+	return err
+}
+
+func validateRepositories(mgr *settings.SettingsManager, clientset kubernetes.Interface, namespace string) []string {
+	var errs []string
+	repos, err := mgr.GetRepositories()
+	if err != nil {
+		errs = append(errs, fmt.Sprintf("repositories: %v", err))
+	}
+	repoCreds, err := mgr.GetRepositoryCredentials()
+	if err != nil {
+		errs = append(errs, fmt.Sprintf("repository.credentials: %v", err))
+	}
+	for _, repo := range append(repos, repoCreds...) {
+		if repo.URL == "" {
+			errs = append(errs, "repository URL is missing")
+			continue
+		}
+		if repo.Type != "" && repo.Type != "git" && repo.Type != "helm" {
+			errs = append(errs, fmt.Sprintf("%s: unknown repository type %s", repo.URL, repo.Type))
+		}
+		if repo.Type == "helm" && repo.Name == "" {
+			errs = append(errs, fmt.Sprintf("%s: name is required for helm repositories", repo.URL))
+		}
+		if clientset == nil {
+			continue
+		}
+		for _, selector := range []*apiv1.SecretKeySelector{repo.UsernameSecret, repo.PasswordSecret, repo.SSHPrivateKeySecret,
+			repo.TLSClientCertDataSecret, repo.TLSClientCertKeySecret, repo.TLSClientCASecret} {
+			if selector == nil {
+				continue
+			}
+			secret, err := clientset.CoreV1().Secrets(namespace).Get(selector.Name, metav1.GetOptions{})
+			if err != nil {
+				errs = append(errs, fmt.Sprintf("%s: %v", repo.URL, err))
+			} else if _, ok := secret.Data[selector.Key]; !ok {
+				errs = append(errs, fmt.Sprintf("%s: key %s is missing in secret %s", repo.URL, selector.Key, selector.Name))
+			}
+		}
+	}
+	return errs
+}
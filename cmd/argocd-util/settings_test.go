@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/util/settings"
+)
+
+const testNamespace = "default"
+
+func newSettingsObjects(cmData map[string]string, rbacData map[string]string) (*apiv1.ConfigMap, *apiv1.ConfigMap, *apiv1.Secret) {
+	labels := map[string]string{"app.kubernetes.io/part-of": "argocd"}
+	cm := &apiv1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDConfigMapName, Namespace: testNamespace, Labels: labels},
+		Data:       cmData,
+	}
+	rbacCM := &apiv1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDRBACConfigMapName, Namespace: testNamespace, Labels: labels},
+		Data:       rbacData,
+	}
+	secret := &apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDSecretName, Namespace: testNamespace, Labels: labels},
+		Data: map[string][]byte{
+			"admin.password":   []byte("$2a$10$wOIqxsrwN1iO3cJ2rzF0/OXKgTvr0Y/EFnJdAShAGbvWeZdtl5OyG"),
+			"server.secretkey": []byte("test"),
+		},
+	}
+	return cm, rbacCM, secret
+}
+
+func validate(cmData map[string]string, rbacData map[string]string) map[string][]string {
+	cm, rbacCM, secret := newSettingsObjects(cmData, rbacData)
+	mgr := settings.NewSettingsManager(context.Background(), fake.NewSimpleClientset(cm, rbacCM, secret), testNamespace)
+	res := make(map[string][]string)
+	for _, r := range validateSettings(mgr, rbacCM, nil, testNamespace) {
+		res[r.Section] = r.Errors
+	}
+	return res
+}
+
+func TestValidateSettings_Valid(t *testing.T) {
+	res := validate(map[string]string{
+		"resource.customizations": `
+apps/Deployment:
+  health.lua: |
+    return {status = "Healthy"}
+  ignoreDifferences: |
+    jsonPointers:
+    - /spec/replicas
+`,
+		"repositories": `
+- url: https://github.com/argoproj/argocd-example-apps
+`,
+	}, map[string]string{
+		"policy.csv": "p, role:org-admin, applications, *, */*, allow",
+	})
+	for section, errs := range res {
+		assert.Empty(t, errs, section)
+	}
+}
+
+func TestValidateSettings_Invalid(t *testing.T) {
+	res := validate(map[string]string{
+		"resource.customizations": `
+apps/Deployment:
+  health.lua: |
+    return {status =
+  actions: |
+    discovery.lua: |
+      return {}
+    definitions:
+    - name: restart
+      action.lua: |
+        end
+`,
+		"repositories": `
+- url: https://charts.example.com
+  type: helm
+`,
+		"configManagementPlugins": `
+- name: sops
+`,
+		"oidc.config": `
+name: Okta
+`,
+		"timeout.reconciliation": "soon",
+	}, map[string]string{
+		"policy.csv": "this, is, not, a, good, policy",
+	})
+	assert.Len(t, res["General"], 1)
+	if assert.Len(t, res["Resource Overrides"], 2) {
+		assert.Contains(t, res["Resource Overrides"][0], "apps/Deployment: health.lua:")
+		assert.Contains(t, res["Resource Overrides"][1], "apps/Deployment: actions: restart:")
+	}
+	assert.Equal(t, []string{"https://charts.example.com: name is required for helm repositories"}, res["Repositories"])
+	assert.Equal(t, []string{"plugin sops: generate command is missing"}, res["Plugins"])
+	assert.Equal(t, []string{"issuer is missing", "clientID is missing"}, res["OIDC"])
+	assert.Len(t, res["RBAC"], 1)
+}
@@ -7,6 +7,7 @@ import (
 	"os"
 	"strings"
 	"syscall"
+	"text/tabwriter"
 
 	"github.com/ghodss/yaml"
 	log "github.com/sirupsen/logrus"
@@ -33,6 +34,77 @@ func NewAccountCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	}
 	command.AddCommand(NewAccountUpdatePasswordCommand(clientOpts))
 	command.AddCommand(NewAccountGetUserInfoCommand(clientOpts))
+	command.AddCommand(NewAccountTokensCommand(clientOpts))
+	return command
+}
+
+// NewAccountTokensCommand returns a new instance of an `argocd account tokens` command
+func NewAccountTokensCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "tokens",
+		Short: "Manage the outstanding tokens of the account",
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+			os.Exit(1)
+		},
+	}
+	command.AddCommand(NewAccountTokensListCommand(clientOpts))
+	command.AddCommand(NewAccountTokensRevokeCommand(clientOpts))
+	return command
+}
+
+// NewAccountTokensListCommand returns a new instance of an `argocd account tokens list` command
+func NewAccountTokensListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "list",
+		Short: "List the outstanding tokens which Argo CD issued to the account",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 0 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, accountIf := argocdclient.NewClientOrDie(clientOpts).NewAccountClientOrDie()
+			defer util.Close(conn)
+
+			tokens, err := accountIf.ListTokens(context.Background(), &accountpkg.ListTokensRequest{})
+			errors.CheckError(err)
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintf(w, "ID\tISSUED-AT\tEXPIRES-AT\tCURRENT\n")
+			for _, token := range tokens.Items {
+				expiresAt := "<none>"
+				if token.ExpiresAt > 0 {
+					expiresAt = humanizeTimestamp(token.ExpiresAt)
+				}
+				current := ""
+				if token.Current {
+					current = "*"
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", token.Id, humanizeTimestamp(token.IssuedAt), expiresAt, current)
+			}
+			_ = w.Flush()
+		},
+	}
+	return command
+}
+
+// NewAccountTokensRevokeCommand returns a new instance of an `argocd account tokens revoke` command
+func NewAccountTokensRevokeCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "revoke ID",
+		Short: "Revoke an outstanding token of the account",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, accountIf := argocdclient.NewClientOrDie(clientOpts).NewAccountClientOrDie()
+			defer util.Close(conn)
+
+			_, err := accountIf.DeleteToken(context.Background(), &accountpkg.DeleteTokenRequest{Id: args[0]})
+			errors.CheckError(err)
+			fmt.Printf("Token '%s' revoked\n", args[0])
+		},
+	}
 	return command
 }
 
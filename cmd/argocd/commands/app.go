@@ -31,6 +31,7 @@ import (
 	argocdclient "github.com/argoproj/argo-cd/pkg/apiclient"
 	applicationpkg "github.com/argoproj/argo-cd/pkg/apiclient/application"
 	clusterpkg "github.com/argoproj/argo-cd/pkg/apiclient/cluster"
+	projectpkg "github.com/argoproj/argo-cd/pkg/apiclient/project"
 	settingspkg "github.com/argoproj/argo-cd/pkg/apiclient/settings"
 	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	repoapiclient "github.com/argoproj/argo-cd/reposerver/apiclient"
@@ -77,12 +78,18 @@ func NewApplicationCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comman
 	command.AddCommand(NewApplicationUnsetCommand(clientOpts))
 	command.AddCommand(NewApplicationSyncCommand(clientOpts))
 	command.AddCommand(NewApplicationHistoryCommand(clientOpts))
+	command.AddCommand(NewApplicationSyncReportCommand(clientOpts))
 	command.AddCommand(NewApplicationRollbackCommand(clientOpts))
+	command.AddCommand(NewApplicationUpdateImagesCommand(clientOpts))
 	command.AddCommand(NewApplicationListCommand(clientOpts))
 	command.AddCommand(NewApplicationDeleteCommand(clientOpts))
 	command.AddCommand(NewApplicationWaitCommand(clientOpts))
+	command.AddCommand(NewApplicationEventsCommand(clientOpts))
 	command.AddCommand(NewApplicationManifestsCommand(clientOpts))
+	command.AddCommand(NewApplicationPreviewCommand(clientOpts))
 	command.AddCommand(NewApplicationTerminateOpCommand(clientOpts))
+	command.AddCommand(NewApplicationSuspendCommand(clientOpts))
+	command.AddCommand(NewApplicationResumeCommand(clientOpts))
 	command.AddCommand(NewApplicationEditCommand(clientOpts))
 	command.AddCommand(NewApplicationPatchCommand(clientOpts))
 	command.AddCommand(NewApplicationPatchResourceCommand(clientOpts))
@@ -217,7 +224,7 @@ func NewApplicationGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
 				fmt.Println(string(jsonBytes))
 			case "":
 				aURL := appURL(acdClient, app.Name)
-				printAppSummaryTable(app, aURL)
+				printAppSummaryTable(app, aURL, getProject(acdClient, app.Spec.GetProject()))
 
 				if len(app.Status.Conditions) > 0 {
 					fmt.Println()
@@ -252,7 +259,9 @@ func NewApplicationGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
 	return command
 }
 
-func printAppSummaryTable(app *argoappv1.Application, appURL string) {
+// printAppSummaryTable prints the summary of the application. The sync policy falls back to the default sync policy of
+// the given project, if known.
+func printAppSummaryTable(app *argoappv1.Application, appURL string, proj *argoappv1.AppProject) {
 	fmt.Printf(printOpFmtStr, "Name:", app.Name)
 	fmt.Printf(printOpFmtStr, "Project:", app.Spec.GetProject())
 	fmt.Printf(printOpFmtStr, "Server:", app.Spec.Destination.Server)
@@ -262,16 +271,37 @@ func printAppSummaryTable(app *argoappv1.Application, appURL string) {
 	fmt.Printf(printOpFmtStr, "Target:", app.Spec.Source.TargetRevision)
 	fmt.Printf(printOpFmtStr, "Path:", app.Spec.Source.Path)
 	printAppSourceDetails(&app.Spec.Source)
-	var syncPolicy string
-	if app.Spec.SyncPolicy != nil && app.Spec.SyncPolicy.Automated != nil {
-		syncPolicy = "Automated"
-		if app.Spec.SyncPolicy.Automated.Prune {
-			syncPolicy += " (Prune)"
+	var syncPolicyStr string
+	syncPolicy := app.Spec.GetSyncPolicy(proj)
+	if syncPolicy != nil && syncPolicy.Automated != nil {
+		syncPolicyStr = "Automated"
+		if syncPolicy.Automated.Prune {
+			syncPolicyStr += " (Prune)"
 		}
 	} else {
-		syncPolicy = "<none>"
+		syncPolicyStr = "<none>"
+	}
+	fmt.Printf(printOpFmtStr, "Sync Policy:", syncPolicyStr)
+	if syncPolicy != nil && syncPolicy.Verify != nil {
+		verifyStr := fmt.Sprintf("Healthy within %v", syncPolicy.Verify.GetTimeout())
+		if syncPolicy.Verify.Rollback {
+			verifyStr += " (Rollback)"
+		}
+		fmt.Printf(printOpFmtStr, "Sync Verify:", verifyStr)
+	}
+	if app.Spec.Suspend != nil {
+		suspendStr := "Suspended"
+		if app.Spec.Suspend.Until != nil {
+			if !app.Spec.IsSuspended(time.Now()) {
+				suspendStr = "Expired"
+			}
+			suspendStr += fmt.Sprintf(" until %s", app.Spec.Suspend.Until.Format(time.RFC3339))
+		}
+		if app.Spec.Suspend.Reason != "" {
+			suspendStr += fmt.Sprintf(" (%s)", app.Spec.Suspend.Reason)
+		}
+		fmt.Printf(printOpFmtStr, "Suspend:", suspendStr)
 	}
-	fmt.Printf(printOpFmtStr, "Sync Policy:", syncPolicy)
 	syncStatusStr := string(app.Status.Sync.Status)
 	switch app.Status.Sync.Status {
 	case argoappv1.SyncStatusCodeSynced:
@@ -288,6 +318,13 @@ func printAppSummaryTable(app *argoappv1.Application, appURL string) {
 		healthStr = fmt.Sprintf("%s (%s)", app.Status.Health.Status, app.Status.Health.Message)
 	}
 	fmt.Printf(printOpFmtStr, "Health Status:", healthStr)
+	if verification := app.Status.Verification; verification != nil {
+		verificationStr := string(verification.Phase)
+		if verification.Message != "" {
+			verificationStr = fmt.Sprintf("%s (%s)", verification.Phase, verification.Message)
+		}
+		fmt.Printf(printOpFmtStr, "Verification:", verificationStr)
+	}
 }
 
 func printAppSourceDetails(appSrc *argoappv1.ApplicationSource) {
@@ -412,10 +449,16 @@ func setAppOptions(flags *pflag.FlagSet, app *argoappv1.Application, appOpts *ap
 			setHelmOpt(&app.Spec.Source, helmOpts{valueFiles: appOpts.valuesFiles})
 		case "release-name":
 			setHelmOpt(&app.Spec.Source, helmOpts{releaseName: appOpts.releaseName})
+		case "helm-include-crds":
+			setHelmOpt(&app.Spec.Source, helmOpts{includeCRDs: appOpts.helmIncludeCRDs})
+		case "values-outside-chart":
+			setHelmOpt(&app.Spec.Source, helmOpts{valueFilesOutsideChart: appOpts.valueFilesOutsideChart})
 		case "helm-set":
 			setHelmOpt(&app.Spec.Source, helmOpts{helmSets: appOpts.helmSets})
 		case "helm-set-string":
 			setHelmOpt(&app.Spec.Source, helmOpts{helmSetStrings: appOpts.helmSetStrings})
+		case "helm-set-file":
+			setHelmOpt(&app.Spec.Source, helmOpts{helmSetFiles: appOpts.helmSetFiles})
 		case "directory-recurse":
 			app.Spec.Source.Directory = &argoappv1.ApplicationSourceDirectory{Recurse: appOpts.directoryRecurse}
 		case "config-management-plugin":
@@ -434,6 +477,15 @@ func setAppOptions(flags *pflag.FlagSet, app *argoappv1.Application, appOpts *ap
 			setJsonnetOpt(&app.Spec.Source, appOpts.jsonnetTlaStr, false)
 		case "jsonnet-tla-code":
 			setJsonnetOpt(&app.Spec.Source, appOpts.jsonnetTlaCode, true)
+		case "write-back-branch":
+			setWriteBackOpt(app, func(w *argoappv1.ApplicationWriteBack) { w.Branch = appOpts.writeBackBranch })
+		case "write-back-path":
+			setWriteBackOpt(app, func(w *argoappv1.ApplicationWriteBack) { w.Path = appOpts.writeBackPath })
+		case "write-back-pull-request":
+			setWriteBackOpt(app, func(w *argoappv1.ApplicationWriteBack) { w.PullRequest = appOpts.writeBackPullRequest })
+		case "revision-history-limit":
+			limit := appOpts.revisionHistoryLimit
+			app.Spec.RevisionHistoryLimit = &limit
 		case "sync-policy":
 			switch appOpts.syncPolicy {
 			case "automated":
@@ -441,6 +493,8 @@ func setAppOptions(flags *pflag.FlagSet, app *argoappv1.Application, appOpts *ap
 					Automated: &argoappv1.SyncPolicyAutomated{},
 				}
 			case "none":
+				app.Spec.SyncPolicy = &argoappv1.SyncPolicy{}
+			case "inherit":
 				app.Spec.SyncPolicy = nil
 			default:
 				log.Fatalf("Invalid sync-policy: %s", appOpts.syncPolicy)
@@ -459,10 +513,34 @@ func setAppOptions(flags *pflag.FlagSet, app *argoappv1.Application, appOpts *ap
 		}
 		app.Spec.SyncPolicy.Automated.SelfHeal = appOpts.selfHeal
 	}
+	if flags.Changed("verify-timeout") || flags.Changed("verify-rollback") {
+		if app.Spec.SyncPolicy == nil {
+			log.Fatal("Cannot set --verify-timeout or --verify-rollback: application inherits the sync policy of its project, set --sync-policy first")
+		}
+		if app.Spec.SyncPolicy.Verify == nil {
+			app.Spec.SyncPolicy.Verify = &argoappv1.SyncPolicyVerify{}
+		}
+		if flags.Changed("verify-timeout") {
+			app.Spec.SyncPolicy.Verify.TimeoutSeconds = int64(appOpts.verifyTimeout.Seconds())
+		}
+		if flags.Changed("verify-rollback") {
+			app.Spec.SyncPolicy.Verify.Rollback = appOpts.verifyRollback
+		}
+		if flags.Changed("verify-timeout") && appOpts.verifyTimeout == 0 {
+			app.Spec.SyncPolicy.Verify = nil
+		}
+	}
 
 	return visited
 }
 
+func setWriteBackOpt(app *argoappv1.Application, set func(w *argoappv1.ApplicationWriteBack)) {
+	if app.Spec.WriteBack == nil {
+		app.Spec.WriteBack = &argoappv1.ApplicationWriteBack{}
+	}
+	set(app.Spec.WriteBack)
+}
+
 func setKsonnetOpt(src *argoappv1.ApplicationSource, env *string) {
 	if src.Ksonnet == nil {
 		src.Ksonnet = &argoappv1.ApplicationSourceKsonnet{}
@@ -499,10 +577,13 @@ func setKustomizeImages(src *argoappv1.ApplicationSource, images []string) {
 }
 
 type helmOpts struct {
-	valueFiles     []string
-	releaseName    string
-	helmSets       []string
-	helmSetStrings []string
+	valueFiles             []string
+	releaseName            string
+	helmSets               []string
+	helmSetStrings         []string
+	helmSetFiles           []string
+	includeCRDs            bool
+	valueFilesOutsideChart bool
 }
 
 func setHelmOpt(src *argoappv1.ApplicationSource, opts helmOpts) {
@@ -515,6 +596,12 @@ func setHelmOpt(src *argoappv1.ApplicationSource, opts helmOpts) {
 	if opts.releaseName != "" {
 		src.Helm.ReleaseName = opts.releaseName
 	}
+	if opts.includeCRDs {
+		src.Helm.IncludeCRDs = true
+	}
+	if opts.valueFilesOutsideChart {
+		src.Helm.AllowValueFilesOutsideChart = true
+	}
 	for _, text := range opts.helmSets {
 		p, err := argoappv1.NewHelmParameter(text, false)
 		if err != nil {
@@ -529,6 +616,13 @@ func setHelmOpt(src *argoappv1.ApplicationSource, opts helmOpts) {
 		}
 		src.Helm.AddParameter(*p)
 	}
+	for _, text := range opts.helmSetFiles {
+		p, err := argoappv1.NewHelmFileParameter(text)
+		if err != nil {
+			log.Fatal(err)
+		}
+		src.Helm.AddFileParameter(*p)
+	}
 	if src.Helm.IsZero() {
 		src.Helm = nil
 	}
@@ -579,16 +673,25 @@ type appOptions struct {
 	releaseName            string
 	helmSets               []string
 	helmSetStrings         []string
+	helmSetFiles           []string
+	helmIncludeCRDs        bool
+	valueFilesOutsideChart bool
 	project                string
 	syncPolicy             string
 	autoPrune              bool
 	selfHeal               bool
+	verifyTimeout          time.Duration
+	verifyRollback         bool
 	namePrefix             string
 	directoryRecurse       bool
 	configManagementPlugin string
 	jsonnetTlaStr          []string
 	jsonnetTlaCode         []string
 	kustomizeImages        []string
+	writeBackBranch        string
+	writeBackPath          string
+	writeBackPullRequest   bool
+	revisionHistoryLimit   int64
 }
 
 func addAppFlags(command *cobra.Command, opts *appOptions) {
@@ -600,19 +703,28 @@ func addAppFlags(command *cobra.Command, opts *appOptions) {
 	command.Flags().StringVar(&opts.destNamespace, "dest-namespace", "", "K8s target namespace (overrides the namespace specified in the ksonnet app.yaml)")
 	command.Flags().StringArrayVarP(&opts.parameters, "parameter", "p", []string{}, "set a parameter override (e.g. -p guestbook=image=example/guestbook:latest)")
 	command.Flags().StringArrayVar(&opts.valuesFiles, "values", []string{}, "Helm values file(s) to use")
+	command.Flags().BoolVar(&opts.valueFilesOutsideChart, "values-outside-chart", false, "Allow Helm values files outside of the chart directory, within the same repository")
 	command.Flags().StringVar(&opts.releaseName, "release-name", "", "Helm release-name")
 	command.Flags().StringArrayVar(&opts.helmSets, "helm-set", []string{}, "Helm set values on the command line (can specify multiple or separate values with commas: key1=val1,key2=val2)")
 	command.Flags().StringArrayVar(&opts.helmSetStrings, "helm-set-string", []string{}, "Helm set STRING values on the command line (can specify multiple or separate values with commas: key1=val1,key2=val2)")
+	command.Flags().StringArrayVar(&opts.helmSetFiles, "helm-set-file", []string{}, "Helm set values from respective files specified via the command line, relative to the application path (can be repeated to set several values: --helm-set-file key1=path1 --helm-set-file key2=path2)")
+	command.Flags().BoolVar(&opts.helmIncludeCRDs, "helm-include-crds", false, "Render the custom resource definitions in the crds/ directory of the Helm chart")
 	command.Flags().StringVar(&opts.project, "project", "", "Application project name")
-	command.Flags().StringVar(&opts.syncPolicy, "sync-policy", "", "Set the sync policy (one of: automated, none)")
+	command.Flags().StringVar(&opts.syncPolicy, "sync-policy", "", "Set the sync policy (one of: automated, none, inherit)")
 	command.Flags().BoolVar(&opts.autoPrune, "auto-prune", false, "Set automatic pruning when sync is automated")
 	command.Flags().BoolVar(&opts.selfHeal, "self-heal", false, "Set self healing when sync is automated")
+	command.Flags().DurationVar(&opts.verifyTimeout, "verify-timeout", 0, "Verify that the application becomes healthy within this duration after each sync (0 to stop verifying)")
+	command.Flags().BoolVar(&opts.verifyRollback, "verify-rollback", false, "Roll back to the previously deployed revision if the application does not become healthy after a sync")
 	command.Flags().StringVar(&opts.namePrefix, "nameprefix", "", "Kustomize nameprefix")
 	command.Flags().BoolVar(&opts.directoryRecurse, "directory-recurse", false, "Recurse directory")
 	command.Flags().StringVar(&opts.configManagementPlugin, "config-management-plugin", "", "Config management plugin name")
 	command.Flags().StringArrayVar(&opts.jsonnetTlaStr, "jsonnet-tla-str", []string{}, "Jsonnet top level string arguments")
 	command.Flags().StringArrayVar(&opts.jsonnetTlaCode, "jsonnet-tla-code", []string{}, "Jsonnet top level code arguments")
 	command.Flags().StringArrayVar(&opts.kustomizeImages, "kustomize-image", []string{}, "Kustomize images (e.g. --kustomize-image node:8.15.0 --kustomize-image mysql=mariadb,alpine@sha256:24a0c4b4a4c0eb97a1aabb8e29f18e917d05abfe1b7a7c07857230879ce7d3d)")
+	command.Flags().StringVar(&opts.writeBackBranch, "write-back-branch", "", "Commit parameter overrides to this branch of the repository instead of storing them in the application spec")
+	command.Flags().StringVar(&opts.writeBackPath, "write-back-path", "", "Commit parameter overrides to this file, relative to the application path (default \".argocd-source.yaml\")")
+	command.Flags().BoolVar(&opts.writeBackPullRequest, "write-back-pull-request", false, "Open a pull request with parameter overrides instead of committing them directly")
+	command.Flags().Int64Var(&opts.revisionHistoryLimit, "revision-history-limit", 0, "Number of entries kept in the application history (default from the application.revisionHistoryLimit setting)")
 }
 
 // NewApplicationUnsetCommand returns a new instance of an `argocd app unset` command
@@ -662,6 +774,14 @@ func NewApplicationUnsetCommand(clientOpts *argocdclient.ClientOptions) *cobra.C
 							break
 						}
 					}
+					fileParams := app.Spec.Source.Helm.FileParameters
+					for i, p := range fileParams {
+						if p.Name == paramStr {
+							app.Spec.Source.Helm.FileParameters = append(fileParams[0:i], fileParams[i+1:]...)
+							updated = true
+							break
+						}
+					}
 				}
 				specValueFiles := app.Spec.Source.Helm.ValueFiles
 				for _, valuesFile := range valuesFiles {
@@ -691,19 +811,6 @@ func NewApplicationUnsetCommand(clientOpts *argocdclient.ClientOptions) *cobra.C
 	return command
 }
 
-// targetObjects deserializes the list of target states into unstructured objects
-func targetObjects(resources []*argoappv1.ResourceDiff) ([]*unstructured.Unstructured, error) {
-	objs := make([]*unstructured.Unstructured, len(resources))
-	for i, resState := range resources {
-		obj, err := resState.TargetObject()
-		if err != nil {
-			return nil, err
-		}
-		objs[i] = obj
-	}
-	return objs, nil
-}
-
 // liveObjects deserializes the list of live states into unstructured objects
 func liveObjects(resources []*argoappv1.ResourceDiff) ([]*unstructured.Unstructured, error) {
 	objs := make([]*unstructured.Unstructured, len(resources))
@@ -717,19 +824,23 @@ func liveObjects(resources []*argoappv1.ResourceDiff) ([]*unstructured.Unstructu
 	return objs, nil
 }
 
-func getLocalObjects(app *argoappv1.Application, local, appLabelKey, kubeVersion string) []*unstructured.Unstructured {
-	manifestStrings := getLocalObjectsString(app, local, appLabelKey, kubeVersion, nil)
+func getLocalObjects(app *argoappv1.Application, local, appLabelKey, kubeVersion string) ([]*unstructured.Unstructured, error) {
+	manifestStrings, err := getLocalObjectsString(app, local, appLabelKey, kubeVersion, nil)
+	if err != nil {
+		return nil, err
+	}
 	objs := make([]*unstructured.Unstructured, len(manifestStrings))
 	for i := range manifestStrings {
 		obj := unstructured.Unstructured{}
-		err := json.Unmarshal([]byte(manifestStrings[i]), &obj)
-		errors.CheckError(err)
+		if err := json.Unmarshal([]byte(manifestStrings[i]), &obj); err != nil {
+			return nil, err
+		}
 		objs[i] = &obj
 	}
-	return objs
+	return objs, nil
 }
 
-func getLocalObjectsString(app *argoappv1.Application, local, appLabelKey, kubeVersion string, kustomizeOptions *argoappv1.KustomizeOptions) []string {
+func getLocalObjectsString(app *argoappv1.Application, local, appLabelKey, kubeVersion string, kustomizeOptions *argoappv1.KustomizeOptions) ([]string, error) {
 	res, err := repository.GenerateManifests(local, &repoapiclient.ManifestRequest{
 		ApplicationSource: &app.Spec.Source,
 		AppLabelKey:       appLabelKey,
@@ -737,10 +848,14 @@ func getLocalObjectsString(app *argoappv1.Application, local, appLabelKey, kubeV
 		Namespace:         app.Spec.Destination.Namespace,
 		KustomizeOptions:  kustomizeOptions,
 		KubeVersion:       kubeVersion,
+		// local manifests are decrypted using whichever SOPS keys are available to the user
+		DecryptionKeys:         []string{"*"},
+		ParameterOverridesFile: app.Spec.GetParameterOverridesFile(),
 	})
-	errors.CheckError(err)
-
-	return res.Manifests
+	if err != nil {
+		return nil, err
+	}
+	return res.Manifests, nil
 }
 
 type resourceInfoProvider struct {
@@ -773,56 +888,80 @@ func groupLocalObjs(localObs []*unstructured.Unstructured, liveObjs []*unstructu
 	return objByKey
 }
 
+// diffItem is a resource which is compared by `argocd app diff`
+type diffItem struct {
+	key    kube.ResourceKey
+	live   *unstructured.Unstructured
+	target *unstructured.Unstructured
+	// predicted is the live state with the diff computed by the controller applied
+	predicted *unstructured.Unstructured
+	// secretKeyChanges are the changed keys of a secret, whose data values are hidden
+	secretKeyChanges *argoappv1.SecretKeyChanges
+	// hideValues skips the diff of the resource, only its changed secret keys are shown
+	hideValues bool
+}
+
 // NewApplicationDiffCommand returns a new instance of an `argocd app diff` command
 func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
 		refresh     bool
 		hardRefresh bool
 		local       string
+		relistKinds []string
+		format      string
+		serverSide  bool
 	)
 	shortDesc := "Perform a diff against the target and live state."
 	var command = &cobra.Command{
 		Use:   "diff APPNAME",
 		Short: shortDesc,
-		Long:  shortDesc + "\nUses 'diff' to render the difference. KUBECTL_EXTERNAL_DIFF environment variable can be used to select your own diff tool.\nReturns the following exit codes: 2 on general errors, 1 when a diff is found, and 0 when no diff is found",
+		Long:  shortDesc + "\nUses 'diff' to render the difference, unless a format is given. KUBECTL_EXTERNAL_DIFF environment variable can be used to select your own diff tool.\nReturns the following exit codes: 2 on general errors, 1 when a diff is found, and 0 when no diff is found",
 		Run: func(c *cobra.Command, args []string) {
 			if len(args) != 1 {
 				c.HelpFunc()(c, args)
-				os.Exit(2)
+				os.Exit(errorCodeDiff)
+			}
+			if serverSide && local != "" {
+				checkDiffError(fmt.Errorf("--server-side cannot be used with --local"))
+			}
+			if format != "" && format != diff.DiffFormatUnified && format != diff.DiffFormatSideBySide {
+				checkDiffError(fmt.Errorf("unknown format '%s', must be one of: %s, %s", format, diff.DiffFormatUnified, diff.DiffFormatSideBySide))
 			}
 
-			clientset := argocdclient.NewClientOrDie(clientOpts)
-			conn, appIf := clientset.NewApplicationClientOrDie()
+			clientset, err := argocdclient.NewClient(clientOpts)
+			checkDiffError(err)
+			conn, appIf, err := clientset.NewApplicationClient()
+			checkDiffError(err)
 			defer util.Close(conn)
 			appName := args[0]
 			app, err := appIf.Get(context.Background(), &applicationpkg.ApplicationQuery{Name: &appName, Refresh: getRefreshType(refresh, hardRefresh)})
-			errors.CheckError(err)
-			resources, err := appIf.ManagedResources(context.Background(), &applicationpkg.ResourcesQuery{ApplicationName: &appName})
-			errors.CheckError(err)
+			checkDiffError(err)
+			resources, err := appIf.ManagedResources(context.Background(), &applicationpkg.ResourcesQuery{ApplicationName: &appName, RelistKinds: relistKinds})
+			checkDiffError(err)
 			liveObjs, err := liveObjects(resources.Items)
-			errors.CheckError(err)
-			items := make([]struct {
-				key    kube.ResourceKey
-				live   *unstructured.Unstructured
-				target *unstructured.Unstructured
-			}, 0)
+			checkDiffError(err)
+			items := make([]diffItem, 0)
 
-			conn, settingsIf := clientset.NewSettingsClientOrDie()
+			conn, settingsIf, err := clientset.NewSettingsClient()
+			checkDiffError(err)
 			defer util.Close(conn)
 			argoSettings, err := settingsIf.Get(context.Background(), &settingspkg.SettingsQuery{})
-			errors.CheckError(err)
+			checkDiffError(err)
 
 			if local != "" {
-				conn, clusterIf := clientset.NewClusterClientOrDie()
+				conn, clusterIf, err := clientset.NewClusterClient()
+				checkDiffError(err)
 				defer util.Close(conn)
 				cluster, err := clusterIf.Get(context.Background(), &clusterpkg.ClusterQuery{Server: app.Spec.Destination.Server})
-				errors.CheckError(err)
+				checkDiffError(err)
 				util.Close(conn)
-				localObjs := groupLocalObjs(getLocalObjects(app, local, argoSettings.AppLabelKey, cluster.ServerVersion), liveObjs, app.Spec.Destination.Namespace)
+				localObjects, err := getLocalObjects(app, local, argoSettings.AppLabelKey, cluster.ServerVersion)
+				checkDiffError(err)
+				localObjs := groupLocalObjs(localObjects, liveObjs, app.Spec.Destination.Namespace)
 				for _, res := range resources.Items {
 					var live = &unstructured.Unstructured{}
 					err := json.Unmarshal([]byte(res.LiveState), &live)
-					errors.CheckError(err)
+					checkDiffError(err)
 
 					var key kube.ResourceKey
 					if live != nil {
@@ -830,25 +969,30 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 					} else {
 						var target = &unstructured.Unstructured{}
 						err = json.Unmarshal([]byte(res.TargetState), &target)
-						errors.CheckError(err)
+						checkDiffError(err)
 						key = kube.GetResourceKey(target)
 					}
 					if key.Kind == kube.SecretKind && key.Group == "" {
-						// Don't bother comparing secrets, argo-cd doesn't have access to k8s secret data
+						// the data of live secrets is hidden, so only the added and removed keys can be compared
+						if local, ok := localObjs[key]; ok {
+							added, removed, _, err := diff.SecretKeyChanges(local, live, nil)
+							checkDiffError(err)
+							items = append(items, diffItem{
+								key:              key,
+								secretKeyChanges: &argoappv1.SecretKeyChanges{Added: added, Removed: removed},
+								hideValues:       true,
+							})
+						}
 						delete(localObjs, key)
 						continue
 					}
 					if local, ok := localObjs[key]; ok || live != nil {
 						if local != nil && !kube.IsCRD(local) {
 							err = kube.SetAppInstanceLabel(local, argoSettings.AppLabelKey, appName)
-							errors.CheckError(err)
+							checkDiffError(err)
 						}
 
-						items = append(items, struct {
-							key    kube.ResourceKey
-							live   *unstructured.Unstructured
-							target *unstructured.Unstructured
-						}{
+						items = append(items, diffItem{
 							live:   live,
 							target: local,
 							key:    key,
@@ -857,11 +1001,7 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 					}
 				}
 				for key, local := range localObjs {
-					items = append(items, struct {
-						key    kube.ResourceKey
-						live   *unstructured.Unstructured
-						target *unstructured.Unstructured
-					}{
+					items = append(items, diffItem{
 						live:   nil,
 						target: local,
 						key:    key,
@@ -872,54 +1012,75 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 					res := resources.Items[i]
 					var live = &unstructured.Unstructured{}
 					err := json.Unmarshal([]byte(res.LiveState), &live)
-					errors.CheckError(err)
+					checkDiffError(err)
 
 					var target = &unstructured.Unstructured{}
 					err = json.Unmarshal([]byte(res.TargetState), &target)
-					errors.CheckError(err)
+					checkDiffError(err)
 
-					items = append(items, struct {
-						key    kube.ResourceKey
-						live   *unstructured.Unstructured
-						target *unstructured.Unstructured
-					}{
-						live:   live,
-						target: target,
-						key:    kube.NewResourceKey(res.Group, res.Kind, res.Namespace, res.Name),
-					})
+					item := diffItem{
+						live:             live,
+						target:           target,
+						key:              kube.NewResourceKey(res.Group, res.Kind, res.Namespace, res.Name),
+						secretKeyChanges: res.SecretKeyChanges,
+					}
+					if serverSide && res.PredictedLiveState != "" {
+						item.predicted = &unstructured.Unstructured{}
+						err = json.Unmarshal([]byte(res.PredictedLiveState), item.predicted)
+						checkDiffError(err)
+					}
+					items = append(items, item)
 				}
 			}
 
 			foundDiffs := false
 			for _, item := range items {
+				if item.hideValues {
+					if !item.secretKeyChanges.IsEmpty() {
+						fmt.Printf("===== %s/%s %s/%s ======\n", item.key.Group, item.key.Kind, item.key.Namespace, item.key.Name)
+						fmt.Printf("Secret keys %s (values are not compared with local manifests)\n", item.secretKeyChanges.Summary())
+						foundDiffs = true
+					}
+					continue
+				}
 				if item.target != nil && hook.IsHook(item.target) || item.live != nil && hook.IsHook(item.live) {
 					continue
 				}
+				var live *unstructured.Unstructured
+				var target *unstructured.Unstructured
+				if serverSide {
+					// the controller only predicts the live state of resources which differ
+					if item.target != nil && item.live != nil && item.predicted == nil {
+						continue
+					}
+					live, target = item.live, item.target
+					if item.predicted != nil {
+						target = item.predicted
+					}
+					foundDiffs = true
+					printDiffItem(item, live, target, format)
+					continue
+				}
 				overrides := make(map[string]argoappv1.ResourceOverride)
 				for k := range argoSettings.ResourceOverrides {
 					val := argoSettings.ResourceOverrides[k]
 					overrides[k] = *val
 				}
 				normalizer, err := argo.NewDiffNormalizer(app.Spec.IgnoreDifferences, overrides)
-				errors.CheckError(err)
+				checkDiffError(err)
 				// Diff is already available in ResourceDiff Diff field but we have to recalculate diff again due to https://github.com/yudai/gojsondiff/issues/31
 				diffRes := diff.Diff(item.target, item.live, normalizer)
 				if diffRes.Modified || item.target == nil || item.live == nil {
-					fmt.Printf("===== %s/%s %s/%s ======\n", item.key.Group, item.key.Kind, item.key.Namespace, item.key.Name)
-					var live *unstructured.Unstructured
-					var target *unstructured.Unstructured
 					if item.target != nil && item.live != nil {
-						target = item.live
-						live = item.live.DeepCopy()
-						gojsondiff.New().ApplyPatch(live.Object, diffRes.Diff)
+						live = item.live
+						target = item.live.DeepCopy()
+						gojsondiff.New().ApplyPatch(target.Object, diffRes.Diff)
 					} else {
 						live = item.live
 						target = item.target
 					}
-
 					foundDiffs = true
-					err = diff.PrintDiff(item.key.Name, target, live)
-					errors.CheckError(err)
+					printDiffItem(item, live, target, format)
 				}
 			}
 			if foundDiffs {
@@ -931,9 +1092,36 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	command.Flags().BoolVar(&refresh, "refresh", false, "Refresh application data when retrieving")
 	command.Flags().BoolVar(&hardRefresh, "hard-refresh", false, "Refresh application data as well as target manifests cache")
 	command.Flags().StringVar(&local, "local", "", "Compare live app to a local ksonnet app")
+	command.Flags().StringArrayVar(&relistKinds, "relist-kind", []string{}, "List the live state of resources of the given kind (e.g. Deployment.apps) from the cluster instead of the cluster cache")
+	command.Flags().StringVar(&format, "format", "", fmt.Sprintf("Render the diff in the given format instead of using the diff tool, one of: %s, %s", diff.DiffFormatUnified, diff.DiffFormatSideBySide))
+	command.Flags().BoolVar(&serverSide, "server-side", false, "Show the diff normalized by the application controller, as shown in the UI, instead of computing it locally")
 	return command
 }
 
+// errorCodeDiff is the exit code of `argocd app diff` for errors without a more specific reason, since exit code 1
+// reports that a diff was found
+const errorCodeDiff = 2
+
+func checkDiffError(err error) {
+	errors.CheckErrorWithCode(err, errorCodeDiff)
+}
+
+// printDiffItem prints the diff of the live and target state of a resource, either with the diff tool or in the given format
+func printDiffItem(item diffItem, live, target *unstructured.Unstructured, format string) {
+	fmt.Printf("===== %s/%s %s/%s ======\n", item.key.Group, item.key.Kind, item.key.Namespace, item.key.Name)
+	if format == "" {
+		err := diff.PrintDiff(item.key.Name, live, target)
+		checkDiffError(err)
+	} else {
+		out, err := diff.FormatDiff(item.key.Name, live, target, format)
+		checkDiffError(err)
+		fmt.Print(out)
+	}
+	if !item.secretKeyChanges.IsEmpty() {
+		fmt.Printf("Secret keys %s\n", item.secretKeyChanges.Summary())
+	}
+}
+
 // NewApplicationDeleteCommand returns a new instance of an `argocd app delete` command
 func NewApplicationDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
@@ -972,8 +1160,8 @@ func printApplicationNames(apps []argoappv1.Application) {
 	}
 }
 
-// Print table of application data
-func printApplicationTable(apps []argoappv1.Application, output *string) {
+// Print table of application data. The sync policies fall back to the default sync policies of the given projects.
+func printApplicationTable(apps []argoappv1.Application, projects map[string]*argoappv1.AppProject, output *string) {
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 	var fmtStr string
 	headers := []interface{}{"NAME", "CLUSTER", "NAMESPACE", "PROJECT", "STATUS", "HEALTH", "SYNCPOLICY", "CONDITIONS"}
@@ -992,7 +1180,7 @@ func printApplicationTable(apps []argoappv1.Application, output *string) {
 			app.Spec.GetProject(),
 			app.Status.Sync.Status,
 			app.Status.Health.Status,
-			formatSyncPolicy(app),
+			formatSyncPolicy(app, projects[app.Spec.GetProject()]),
 			formatConditionsSummary(app),
 		}
 		if *output == "wide" {
@@ -1006,38 +1194,95 @@ func printApplicationTable(apps []argoappv1.Application, output *string) {
 // NewApplicationListCommand returns a new instance of an `argocd app list` command
 func NewApplicationListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		output string
+		output   string
+		projects []string
+		selector string
+		search   string
+		sortBy   string
 	)
 	var command = &cobra.Command{
 		Use:   "list",
 		Short: "List applications",
+		Example: `  # List the applications of a project, the least healthy first
+  argocd app list --project default --sort-by -health
+
+  # List the applications which name contains "guestbook" and which are labeled with team=frontend
+  argocd app list --search guestbook --selector team=frontend`,
 		Run: func(c *cobra.Command, args []string) {
-			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
+			acdClient := argocdclient.NewClientOrDie(clientOpts)
+			warnOnVersionSkew(acdClient)
+			conn, appIf := acdClient.NewApplicationClientOrDie()
 			defer util.Close(conn)
-			apps, err := appIf.List(context.Background(), &applicationpkg.ApplicationQuery{})
+			apps, err := appIf.List(context.Background(), &applicationpkg.ApplicationQuery{
+				Projects: projects,
+				Selector: selector,
+				Search:   search,
+				SortBy:   sortBy,
+			})
 			errors.CheckError(err)
 			if output == "name" {
 				printApplicationNames(apps.Items)
 			} else {
-				printApplicationTable(apps.Items, &output)
+				printApplicationTable(apps.Items, getProjects(acdClient), &output)
 			}
 		},
 	}
 	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: wide|name")
+	command.Flags().StringArrayVarP(&projects, "project", "p", []string{}, "List the applications of the projects")
+	command.Flags().StringVarP(&selector, "selector", "l", "", "List the applications matching the label selector")
+	command.Flags().StringVar(&search, "search", "", "List the applications which name contains the search string")
+	command.Flags().StringVar(&sortBy, "sort-by", "", "Sort the applications by name, sync, health or lastSync. Prefix with '-' to sort in descending order")
 	return command
 }
 
-func formatSyncPolicy(app argoappv1.Application) string {
-	if app.Spec.SyncPolicy == nil || app.Spec.SyncPolicy.Automated == nil {
+func formatSyncPolicy(app argoappv1.Application, proj *argoappv1.AppProject) string {
+	syncPolicy := app.Spec.GetSyncPolicy(proj)
+	if syncPolicy == nil || syncPolicy.Automated == nil {
 		return "<none>"
 	}
 	policy := "Auto"
-	if app.Spec.SyncPolicy.Automated.Prune {
+	if syncPolicy.Automated.Prune {
 		policy = policy + "-Prune"
 	}
 	return policy
 }
 
+// getProject returns the project of the given name, or nil if it cannot be read, in which case sync policies are
+// shown without the default sync policy of the project
+func getProject(acdClient argocdclient.Client, name string) *argoappv1.AppProject {
+	conn, projIf, err := acdClient.NewProjectClient()
+	if err != nil {
+		return nil
+	}
+	defer util.Close(conn)
+	proj, err := projIf.Get(context.Background(), &projectpkg.ProjectQuery{Name: name})
+	if err != nil {
+		log.Debugf("Failed to get project %s: %v", name, err)
+		return nil
+	}
+	return proj
+}
+
+// getProjects returns the projects by name, or nil if they cannot be listed, in which case sync policies are shown
+// without the default sync policies of the projects
+func getProjects(acdClient argocdclient.Client) map[string]*argoappv1.AppProject {
+	conn, projIf, err := acdClient.NewProjectClient()
+	if err != nil {
+		return nil
+	}
+	defer util.Close(conn)
+	list, err := projIf.List(context.Background(), &projectpkg.ProjectQuery{})
+	if err != nil {
+		log.Debugf("Failed to list projects: %v", err)
+		return nil
+	}
+	projects := make(map[string]*argoappv1.AppProject, len(list.Items))
+	for i := range list.Items {
+		projects[list.Items[i].Name] = &list.Items[i]
+	}
+	return projects
+}
+
 func formatConditionsSummary(app argoappv1.Application) string {
 	typeToCnt := make(map[string]int)
 	for i := range app.Status.Conditions {
@@ -1113,6 +1358,7 @@ func NewApplicationWaitCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 		watchOperations bool
 		timeout         uint
 		resources       []string
+		progress        progressOpts
 	)
 	var command = &cobra.Command{
 		Use:   "wait APPNAME",
@@ -1128,10 +1374,11 @@ func NewApplicationWaitCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 				watchOperations = true
 				watchSuspended = false
 			}
+			errors.CheckError(progress.validate())
 			selectedResources := parseSelectedResources(resources)
 			appName := args[0]
 			acdClient := argocdclient.NewClientOrDie(clientOpts)
-			_, err := waitOnApplicationStatus(acdClient, appName, timeout, watchSync, watchHealth, watchOperations, watchSuspended, selectedResources)
+			_, err := waitOnApplicationStatus(acdClient, appName, timeout, watchSync, watchHealth, watchOperations, watchSuspended, selectedResources, progress)
 			errors.CheckError(err)
 		},
 	}
@@ -1141,6 +1388,53 @@ func NewApplicationWaitCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	command.Flags().StringArrayVar(&resources, "resource", []string{}, fmt.Sprintf("Sync only specific resources as GROUP%sKIND%sNAME. Fields may be blank. This option may be specified repeatedly", resourceFieldDelimiter, resourceFieldDelimiter))
 	command.Flags().BoolVar(&watchOperations, "operation", false, "Wait for pending operations")
 	command.Flags().UintVar(&timeout, "timeout", defaultCheckTimeoutSeconds, "Time out after this many seconds")
+	addProgressFlags(command, &progress)
+	return command
+}
+
+// NewApplicationEventsCommand returns a new instance of an `argocd app events` command
+func NewApplicationEventsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		projects []string
+		types    []string
+	)
+	var command = &cobra.Command{
+		Use:   "events [APPNAME]",
+		Short: "Stream the sync, health and drift events of applications",
+		Example: `  # Stream the events of all applications
+  argocd app events
+
+  # Stream the failed syncs of the applications of a project
+  argocd app events --project my-project --type SyncFailed`,
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) > 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			query := applicationpkg.ApplicationEventStreamQuery{Projects: projects, Types: types}
+			if len(args) == 1 {
+				query.Name = args[0]
+			}
+			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
+			defer util.Close(conn)
+			stream, err := appIf.WatchEvents(context.Background(), &query)
+			errors.CheckError(err)
+			for {
+				event, err := stream.Recv()
+				if err == io.EOF {
+					return
+				}
+				errors.CheckError(err)
+				at := ""
+				if event.Time != nil {
+					at = event.Time.Format(time.RFC3339)
+				}
+				fmt.Printf("%s %-14s %s/%s %s\n", at, event.Type, event.Project, event.Application, event.Message)
+			}
+		},
+	}
+	command.Flags().StringArrayVarP(&projects, "project", "p", []string{}, "Stream only the events of the applications of the projects")
+	command.Flags().StringArrayVar(&types, "type", []string{}, "Stream only the events of the types: SyncStarted, SyncSucceeded, SyncFailed, HealthChanged or DriftDetected")
 	return command
 }
 
@@ -1155,16 +1449,19 @@ func printAppResources(w io.Writer, app *argoappv1.Application) {
 // NewApplicationSyncCommand returns a new instance of an `argocd app sync` command
 func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		revision  string
-		resources []string
-		labels    []string
-		prune     bool
-		dryRun    bool
-		timeout   uint
-		strategy  string
-		force     bool
-		async     bool
-		local     string
+		revision    string
+		resources   []string
+		labels      []string
+		prune       bool
+		dryRun      bool
+		timeout     uint
+		strategy    string
+		force       bool
+		async       bool
+		local       string
+		adopt       bool
+		pruneArgoCD bool
+		progress    progressOpts
 	)
 	var command = &cobra.Command{
 		Use:   "sync APPNAME",
@@ -1174,7 +1471,9 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 				c.HelpFunc()(c, args)
 				os.Exit(1)
 			}
+			errors.CheckError(progress.validate())
 			acdClient := argocdclient.NewClientOrDie(clientOpts)
+			warnOnVersionSkew(acdClient)
 			conn, appIf := acdClient.NewApplicationClientOrDie()
 			defer util.Close(conn)
 
@@ -1223,7 +1522,7 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 			if local != "" {
 				app, err := appIf.Get(context.Background(), &applicationpkg.ApplicationQuery{Name: &appName})
 				errors.CheckError(err)
-				if app.Spec.SyncPolicy != nil && app.Spec.SyncPolicy.Automated != nil {
+				if syncPolicy := app.Spec.GetSyncPolicy(getProject(acdClient, app.Spec.GetProject())); syncPolicy != nil && syncPolicy.Automated != nil {
 					log.Fatal("Cannot use local sync when Automatic Sync Policy is enabled")
 				}
 
@@ -1238,16 +1537,23 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 				cluster, err := clusterIf.Get(context.Background(), &clusterpkg.ClusterQuery{Server: app.Spec.Destination.Server})
 				errors.CheckError(err)
 				util.Close(conn)
-				localObjsStrings = getLocalObjectsString(app, local, cluster.ServerVersion, argoSettings.AppLabelKey, argoSettings.KustomizeOptions)
+				localObjsStrings, err = getLocalObjectsString(app, local, cluster.ServerVersion, argoSettings.AppLabelKey, argoSettings.KustomizeOptions)
+				errors.CheckError(err)
+			}
+
+			if prune {
+				warnOnArgoCDPrune(appIf, appName)
 			}
 
 			syncReq := applicationpkg.ApplicationSyncRequest{
-				Name:      &appName,
-				DryRun:    dryRun,
-				Revision:  revision,
-				Resources: selectedResources,
-				Prune:     prune,
-				Manifests: localObjsStrings,
+				Name:        &appName,
+				DryRun:      dryRun,
+				Revision:    revision,
+				Resources:   selectedResources,
+				Prune:       prune,
+				Manifests:   localObjsStrings,
+				Adopt:       adopt,
+				PruneArgoCD: pruneArgoCD,
 			}
 			switch strategy {
 			case "apply":
@@ -1264,7 +1570,7 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 			errors.CheckError(err)
 
 			if !async {
-				app, err := waitOnApplicationStatus(acdClient, appName, timeout, false, false, true, false, selectedResources)
+				app, err := waitOnApplicationStatus(acdClient, appName, timeout, false, false, true, false, selectedResources, progress)
 				errors.CheckError(err)
 
 				// Only get resources to be pruned if sync was application-wide
@@ -1283,7 +1589,7 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	}
 	command.Flags().BoolVar(&dryRun, "dry-run", false, "Preview apply without affecting cluster")
 	command.Flags().BoolVar(&prune, "prune", false, "Allow deleting unexpected resources")
-	command.Flags().StringVar(&revision, "revision", "", "Sync to a specific revision. Preserves parameter overrides")
+	command.Flags().StringVar(&revision, "revision", "", "Sync to a specific revision, e.g. a hotfix, without changing the target revision of the application. Preserves parameter overrides")
 	command.Flags().StringArrayVar(&resources, "resource", []string{}, fmt.Sprintf("Sync only specific resources as GROUP%sKIND%sNAME. Fields may be blank. This option may be specified repeatedly", resourceFieldDelimiter, resourceFieldDelimiter))
 	command.Flags().StringArrayVar(&labels, "label", []string{}, fmt.Sprintf("Sync only specific resources with a label. This option may be specified repeatedly."))
 	command.Flags().UintVar(&timeout, "timeout", defaultCheckTimeoutSeconds, "Time out after this many seconds")
@@ -1291,9 +1597,27 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	command.Flags().BoolVar(&force, "force", false, "Use a force apply")
 	command.Flags().BoolVar(&async, "async", false, "Do not wait for application to sync before continuing")
 	command.Flags().StringVar(&local, "local", "", "Path to a local directory. When this flag is present no git queries will be made")
+	command.Flags().BoolVar(&adopt, "adopt", false, "Take ownership of existing resources which are not managed by any application")
+	command.Flags().BoolVar(&pruneArgoCD, "prune-argocd", false, "Confirm that the sync may prune the argocd-server and argocd-application-controller deployments, e.g. of the application which manages Argo CD itself")
+	addProgressFlags(command, &progress)
 	return command
 }
 
+// warnOnArgoCDPrune warns if the components of Argo CD require pruning, e.g. because the application manages Argo CD
+// itself
+func warnOnArgoCDPrune(appIf applicationpkg.ApplicationServiceClient, appName string) {
+	app, err := appIf.Get(context.Background(), &applicationpkg.ApplicationQuery{Name: &appName})
+	if err != nil {
+		log.Debugf("Failed to get application '%s': %v", appName, err)
+		return
+	}
+	for _, condition := range app.Status.Conditions {
+		if condition.Type == argoappv1.ApplicationConditionArgoCDPruneWarning {
+			log.Warn(condition.Message)
+		}
+	}
+}
+
 // ResourceDiff tracks the state of a resource when waiting on an application status.
 type resourceState struct {
 	Group     string
@@ -1420,16 +1744,18 @@ func checkResourceStatus(watchSync bool, watchHealth bool, watchOperation bool,
 
 const waitFormatString = "%s\t%5s\t%10s\t%10s\t%20s\t%8s\t%7s\t%10s\t%s\n"
 
-func waitOnApplicationStatus(acdClient apiclient.Client, appName string, timeout uint, watchSync bool, watchHealth bool, watchOperation bool, watchSuspended bool, selectedResources []argoappv1.SyncOperationResource) (*argoappv1.Application, error) {
+func waitOnApplicationStatus(acdClient apiclient.Client, appName string, timeout uint, watchSync bool, watchHealth bool, watchOperation bool, watchSuspended bool, selectedResources []argoappv1.SyncOperationResource, progress progressOpts) (*argoappv1.Application, error) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
+	reporter := newProgressReporter(acdClient, progress, appName)
+
 	// refresh controls whether or not we refresh the app before printing the final status.
 	// We only want to do this when an operation is in progress, since operations are the only
 	// time when the sync status lags behind when an operation completes
 	refresh := false
 
-	printFinalStatus := func(app *argoappv1.Application) {
+	printFinalStatus := func(app *argoappv1.Application, waitErr error) {
 		var err error
 		if refresh {
 			conn, appClient := acdClient.NewApplicationClientOrDie()
@@ -1438,20 +1764,7 @@ func waitOnApplicationStatus(acdClient apiclient.Client, appName string, timeout
 			errors.CheckError(err)
 			_ = conn.Close()
 		}
-
-		fmt.Println()
-		printAppSummaryTable(app, appURL(acdClient, appName))
-		fmt.Println()
-		if watchOperation {
-			printOperationResult(app.Status.OperationState)
-		}
-
-		if len(app.Status.Resources) > 0 {
-			fmt.Println()
-			w := tabwriter.NewWriter(os.Stdout, 5, 0, 2, ' ', 0)
-			printAppResources(w, app)
-			_ = w.Flush()
-		}
+		reporter.done(app, watchOperation, waitErr)
 	}
 
 	if timeout != 0 {
@@ -1460,9 +1773,6 @@ func waitOnApplicationStatus(acdClient apiclient.Client, appName string, timeout
 		})
 	}
 
-	w := tabwriter.NewWriter(os.Stdout, 5, 0, 2, ' ', 0)
-	_, _ = fmt.Fprintf(w, waitFormatString, "TIMESTAMP", "GROUP", "KIND", "NAMESPACE", "NAME", "STATUS", "HEALTH", "HOOK", "MESSAGE")
-
 	prevStates := make(map[string]*resourceState)
 	appEventCh := acdClient.WatchApplicationWithRetry(ctx, appName)
 	conn, appClient := acdClient.NewApplicationClientOrDie()
@@ -1475,6 +1785,7 @@ func waitOnApplicationStatus(acdClient apiclient.Client, appName string, timeout
 		if app.Operation != nil {
 			refresh = true
 		}
+		reporter.operation(app)
 
 		var selectedResourcesAreReady bool
 
@@ -1494,18 +1805,20 @@ func waitOnApplicationStatus(acdClient apiclient.Client, appName string, timeout
 		}
 
 		if selectedResourcesAreReady {
-			printFinalStatus(app)
+			printFinalStatus(app, nil)
 			return app, nil
 		}
 
+		var changedStates []*resourceState
 		newStates := groupResourceStates(app, selectedResources)
 		for _, newState := range newStates {
 			var doPrint bool
 			stateKey := newState.Key()
 			if prevState, found := prevStates[stateKey]; found {
 				if watchHealth && prevState.Health != argoappv1.HealthStatusUnknown && prevState.Health != argoappv1.HealthStatusDegraded && newState.Health == argoappv1.HealthStatusDegraded {
-					printFinalStatus(app)
-					return nil, fmt.Errorf("Application '%s' health state has transitioned from %s to %s", appName, prevState.Health, newState.Health)
+					err := fmt.Errorf("Application '%s' health state has transitioned from %s to %s", appName, prevState.Health, newState.Health)
+					printFinalStatus(app, err)
+					return nil, err
 				}
 				doPrint = prevState.Merge(newState)
 			} else {
@@ -1513,13 +1826,14 @@ func waitOnApplicationStatus(acdClient apiclient.Client, appName string, timeout
 				doPrint = true
 			}
 			if doPrint {
-				fmt.Fprintf(w, waitFormatString, prevStates[stateKey].FormatItems()...)
+				changedStates = append(changedStates, prevStates[stateKey])
 			}
 		}
-		_ = w.Flush()
+		reporter.resources(changedStates)
 	}
-	printFinalStatus(app)
-	return nil, fmt.Errorf("Timed out (%ds) waiting for app %q match desired state", timeout, appName)
+	err = fmt.Errorf("Timed out (%ds) waiting for app %q match desired state", timeout, appName)
+	printFinalStatus(app, err)
+	return nil, err
 }
 
 // setParameterOverrides updates an existing or appends a new parameter override in the application
@@ -1602,25 +1916,51 @@ func printApplicationHistoryIds(revHistory []argoappv1.RevisionHistory) {
 // Print a history table for an application.
 func printApplicationHistoryTable(revHistory []argoappv1.RevisionHistory) {
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintf(w, "ID\tDATE\tREVISION\n")
+	fmt.Fprintf(w, "ID\tDATE\tREVISION\tINITIATED BY\n")
 	for _, depInfo := range revHistory {
 		rev := depInfo.Source.TargetRevision
 		if len(depInfo.Revision) >= 7 {
 			rev = fmt.Sprintf("%s (%s)", rev, depInfo.Revision[0:7])
 		}
-		fmt.Fprintf(w, "%d\t%s\t%s\n", depInfo.ID, depInfo.DeployedAt, rev)
+		if depInfo.Pinned {
+			rev = rev + " [pinned]"
+		}
+		initiatedBy := formatOperationInitiator(depInfo.InitiatedBy)
+		if rollback := depInfo.AutomatedRollback; rollback != nil && len(rollback.FromRevision) >= 7 {
+			initiatedBy = fmt.Sprintf("automated rollback from %s", rollback.FromRevision[0:7])
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", depInfo.ID, depInfo.DeployedAt, rev, initiatedBy)
 	}
 	_ = w.Flush()
 }
 
+// formatOperationInitiator returns a human readable description of who initiated an operation
+func formatOperationInitiator(initiator argoappv1.OperationInitiator) string {
+	switch {
+	case initiator.Automated:
+		return "automated sync policy"
+	case initiator.Username == "":
+		return "unknown"
+	case initiator.Source == "":
+		return initiator.Username
+	}
+	return fmt.Sprintf("%s (%s)", initiator.Username, initiator.Source)
+}
+
 // NewApplicationHistoryCommand returns a new instance of an `argocd app history` command
 func NewApplicationHistoryCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		output string
+		output  string
+		pending bool
 	)
 	var command = &cobra.Command{
 		Use:   "history APPNAME",
 		Short: "Show application deployment history",
+		Example: `  # Show the deployment history
+  argocd app history guestbook
+
+  # Show the commits which the next sync will deploy
+  argocd app history guestbook --pending`,
 		Run: func(c *cobra.Command, args []string) {
 			if len(args) != 1 {
 				c.HelpFunc()(c, args)
@@ -1629,6 +1969,12 @@ func NewApplicationHistoryCommand(clientOpts *argocdclient.ClientOptions) *cobra
 			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
 			defer util.Close(conn)
 			appName := args[0]
+			if pending {
+				changelog, err := appIf.RevisionChangelog(context.Background(), &applicationpkg.ApplicationRevisionChangelogQuery{Name: &appName})
+				errors.CheckError(err)
+				printRevisionChangelogTable(changelog)
+				return
+			}
 			app, err := appIf.Get(context.Background(), &applicationpkg.ApplicationQuery{Name: &appName})
 			errors.CheckError(err)
 			if output == "id" {
@@ -1639,6 +1985,91 @@ func NewApplicationHistoryCommand(clientOpts *argocdclient.ClientOptions) *cobra
 		},
 	}
 	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: wide|id")
+	command.Flags().BoolVar(&pending, "pending", false, "Show the commits between the deployed revision and the target revision instead of the history")
+	return command
+}
+
+// printRevisionChangelogTable prints the commits of a changelog, newest first
+func printRevisionChangelogTable(changelog *argoappv1.RevisionChangelog) {
+	fmt.Printf("Deployed revision: %s\n", changelog.Revision)
+	fmt.Printf("Target revision:   %s\n", changelog.TargetRevision)
+	if len(changelog.Commits) == 0 {
+		fmt.Println("No pending commits")
+		return
+	}
+	fmt.Println()
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "REVISION\tDATE\tAUTHOR\tMESSAGE\n")
+	for _, commit := range changelog.Commits {
+		rev := commit.Revision
+		if len(rev) >= 7 {
+			rev = rev[0:7]
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", rev, commit.Date, commit.Author, commit.Message)
+	}
+	_ = w.Flush()
+}
+
+// NewApplicationSyncReportCommand returns a new instance of an `argocd app sync-report` command
+func NewApplicationSyncReportCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		id     int64
+		output string
+	)
+	var command = &cobra.Command{
+		Use:   "sync-report APPNAME",
+		Short: "Print the report of a sync operation of an application",
+		Long:  "Print the report of a sync operation of an application. Prints the report of the last operation unless the ID, i.e. the start time of the operation in Unix seconds, is given.",
+		Example: `  # Archive the report of the last sync
+  argocd app sync-report guestbook > sync-report.json
+
+  # List the IDs of the available reports
+  argocd app sync-report guestbook -o id`,
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
+			defer util.Close(conn)
+			appName := args[0]
+			res, err := appIf.SyncReports(context.Background(), &applicationpkg.ApplicationSyncReportsQuery{Name: &appName})
+			errors.CheckError(err)
+			if output == "id" {
+				for _, report := range res.Items {
+					fmt.Println(report.ID)
+				}
+				return
+			}
+			var report *argoappv1.SyncReport
+			for _, r := range res.Items {
+				if id == 0 || r.ID == id {
+					report = r
+					break
+				}
+			}
+			if report == nil {
+				if id == 0 {
+					log.Fatalf("No sync reports found for application '%s'", appName)
+				}
+				log.Fatalf("Sync report %d not found for application '%s'", id, appName)
+			}
+			switch output {
+			case "yaml":
+				yamlBytes, err := yaml.Marshal(report)
+				errors.CheckError(err)
+				fmt.Print(string(yamlBytes))
+			case "json":
+				jsonBytes, err := json.MarshalIndent(report, "", "  ")
+				errors.CheckError(err)
+				fmt.Println(string(jsonBytes))
+			default:
+				log.Fatalf("Unknown output format: %s", output)
+			}
+		},
+	}
+	command.Flags().Int64Var(&id, "id", 0, "ID of the report. Defaults to the report of the last sync operation")
+	command.Flags().StringVarP(&output, "output", "o", "json", "Output format. One of: json|yaml|id")
 	return command
 }
 
@@ -1660,6 +2091,7 @@ func NewApplicationRollbackCommand(clientOpts *argocdclient.ClientOptions) *cobr
 			depID, err := strconv.Atoi(args[1])
 			errors.CheckError(err)
 			acdClient := argocdclient.NewClientOrDie(clientOpts)
+			warnOnVersionSkew(acdClient)
 			conn, appIf := acdClient.NewApplicationClientOrDie()
 			defer util.Close(conn)
 			ctx := context.Background()
@@ -1683,7 +2115,7 @@ func NewApplicationRollbackCommand(clientOpts *argocdclient.ClientOptions) *cobr
 			})
 			errors.CheckError(err)
 
-			_, err = waitOnApplicationStatus(acdClient, appName, timeout, false, false, true, false, nil)
+			_, err = waitOnApplicationStatus(acdClient, appName, timeout, false, false, true, false, nil, progressOpts{})
 			errors.CheckError(err)
 		},
 	}
@@ -1692,6 +2124,58 @@ func NewApplicationRollbackCommand(clientOpts *argocdclient.ClientOptions) *cobr
 	return command
 }
 
+// NewApplicationUpdateImagesCommand returns a new instance of an `argocd app update-images` command
+func NewApplicationUpdateImagesCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		helmSets        []string
+		kustomizeImages []string
+		writeBack       bool
+		dryRun          bool
+		timeout         uint
+	)
+	var command = &cobra.Command{
+		Use:   "update-images APPNAME",
+		Short: "Override the images deployed by an application and sync it",
+		Example: `  # Deploy a new image tag of a Helm application
+  argocd app update-images my-app --helm-set image.tag=v1.2.3
+
+  # Deploy a new Kustomize image and write it back to the application spec
+  argocd app update-images my-app --kustomize-image nginx:1.17 --write-back`,
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName := args[0]
+			req := applicationpkg.ApplicationUpdateImagesRequest{
+				Name:            &appName,
+				KustomizeImages: kustomizeImages,
+				WriteBack:       writeBack,
+				DryRun:          dryRun,
+			}
+			for _, text := range helmSets {
+				p, err := argoappv1.NewHelmParameter(text, false)
+				errors.CheckError(err)
+				req.HelmParameters = append(req.HelmParameters, *p)
+			}
+			acdClient := argocdclient.NewClientOrDie(clientOpts)
+			conn, appIf := acdClient.NewApplicationClientOrDie()
+			defer util.Close(conn)
+			_, err := appIf.UpdateImages(context.Background(), &req)
+			errors.CheckError(err)
+
+			_, err = waitOnApplicationStatus(acdClient, appName, timeout, false, false, true, false, nil, progressOpts{})
+			errors.CheckError(err)
+		},
+	}
+	command.Flags().StringArrayVar(&helmSets, "helm-set", []string{}, "Helm parameter to override (e.g. --helm-set image.tag=v1.2.3)")
+	command.Flags().StringArrayVar(&kustomizeImages, "kustomize-image", []string{}, "Kustomize image to override (e.g. --kustomize-image node:8.15.0)")
+	command.Flags().BoolVar(&writeBack, "write-back", false, "Write the overrides back to the application spec")
+	command.Flags().BoolVar(&dryRun, "dry-run", false, "Preview the sync without affecting the cluster")
+	command.Flags().UintVar(&timeout, "timeout", defaultCheckTimeoutSeconds, "Time out after this many seconds")
+	return command
+}
+
 const printOpFmtStr = "%-20s%s\n"
 const defaultCheckTimeoutSeconds = 0
 
@@ -1703,6 +2187,10 @@ func printOperationResult(opState *argoappv1.OperationState) {
 		fmt.Printf(printOpFmtStr, "Operation:", "Sync")
 		fmt.Printf(printOpFmtStr, "Sync Revision:", opState.SyncResult.Revision)
 	}
+	fmt.Printf(printOpFmtStr, "Initiated By:", formatOperationInitiator(opState.Operation.InitiatedBy))
+	if opState.Operation.Sync != nil && opState.Operation.Sync.DryRun {
+		fmt.Printf(printOpFmtStr, "Dry Run:", "true")
+	}
 	fmt.Printf(printOpFmtStr, "Phase:", opState.Phase)
 	fmt.Printf(printOpFmtStr, "Start:", opState.StartedAt)
 	fmt.Printf(printOpFmtStr, "Finished:", opState.FinishedAt)
@@ -1716,6 +2204,10 @@ func printOperationResult(opState *argoappv1.OperationState) {
 	if opState.Message != "" {
 		fmt.Printf(printOpFmtStr, "Message:", opState.Message)
 	}
+	if opState.SyncResult != nil && opState.SyncResult.Analysis != nil {
+		analysis := opState.SyncResult.Analysis
+		fmt.Printf(printOpFmtStr, "Analysis:", strings.TrimSuffix(fmt.Sprintf("%s %s", analysis.Phase, analysis.Message), " "))
+	}
 }
 
 // NewApplicationManifestsCommand returns a new instance of an `argocd app manifests` command
@@ -1736,43 +2228,26 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
 			defer util.Close(conn)
 			ctx := context.Background()
-			resources, err := appIf.ManagedResources(context.Background(), &applicationpkg.ResourcesQuery{ApplicationName: &appName})
-			errors.CheckError(err)
-
-			var unstructureds []*unstructured.Unstructured
-			switch source {
-			case "git":
-				if revision != "" {
-					q := applicationpkg.ApplicationManifestQuery{
-						Name:     &appName,
-						Revision: revision,
-					}
-					res, err := appIf.GetManifests(ctx, &q)
+			if source == "git" && revision != "" {
+				q := applicationpkg.ApplicationManifestQuery{
+					Name:     &appName,
+					Revision: revision,
+				}
+				res, err := appIf.GetManifests(ctx, &q)
+				errors.CheckError(err)
+				for _, mfst := range res.Manifests {
+					obj, err := argoappv1.UnmarshalToUnstructured(mfst)
 					errors.CheckError(err)
-					for _, mfst := range res.Manifests {
-						obj, err := argoappv1.UnmarshalToUnstructured(mfst)
-						errors.CheckError(err)
-						unstructureds = append(unstructureds, obj)
-					}
-				} else {
-					targetObjs, err := targetObjects(resources.Items)
+					fmt.Println("---")
+					yamlBytes, err := yaml.Marshal(obj)
 					errors.CheckError(err)
-					unstructureds = targetObjs
+					fmt.Printf("%s\n", yamlBytes)
 				}
-			case "live":
-				liveObjs, err := liveObjects(resources.Items)
-				errors.CheckError(err)
-				unstructureds = liveObjs
-			default:
-				log.Fatalf("Unknown source type '%s'", source)
-			}
-
-			for _, obj := range unstructureds {
-				fmt.Println("---")
-				yamlBytes, err := yaml.Marshal(obj)
-				errors.CheckError(err)
-				fmt.Printf("%s\n", yamlBytes)
+				return
 			}
+			res, err := appIf.ManagedManifests(ctx, &applicationpkg.ApplicationManagedManifestsQuery{Name: &appName, Source: source})
+			errors.CheckError(err)
+			fmt.Print(res.Manifests)
 		},
 	}
 	command.Flags().StringVar(&source, "source", "git", "Source of manifests. One of: live|git")
@@ -1780,7 +2255,44 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 	return command
 }
 
-// NewApplicationTerminateOpCommand returns a new instance of an `argocd app terminate-op` command
+// NewApplicationPreviewCommand returns a new instance of an `argocd app preview` command
+func NewApplicationPreviewCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		revision string
+	)
+	var command = &cobra.Command{
+		Use:   "preview APPNAME",
+		Short: "Preview the result of syncing an application using a server-side dry-run",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName := args[0]
+			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
+			defer util.Close(conn)
+			res, err := appIf.Preview(context.Background(), &applicationpkg.ApplicationPreviewRequest{Name: &appName, Revision: revision})
+			errors.CheckError(err)
+
+			failed := false
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintf(w, "GROUP\tKIND\tNAMESPACE\tNAME\tSTATUS\tMESSAGE\n")
+			for _, item := range res.Items {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", item.Group, item.Kind, item.Namespace, item.Name, item.Status, item.Message)
+				if item.Status != applicationpkg.PreviewStatusPassed {
+					failed = true
+				}
+			}
+			_ = w.Flush()
+			if failed {
+				os.Exit(1)
+			}
+		},
+	}
+	command.Flags().StringVar(&revision, "revision", "", "Preview manifests at a specific revision")
+	return command
+}
+
 func NewApplicationTerminateOpCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var command = &cobra.Command{
 		Use:   "terminate-op APPNAME",
@@ -1802,6 +2314,79 @@ func NewApplicationTerminateOpCommand(clientOpts *argocdclient.ClientOptions) *c
 	return command
 }
 
+// NewApplicationSuspendCommand returns a new instance of an `argocd app suspend` command
+func NewApplicationSuspendCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		duration time.Duration
+		reason   string
+	)
+	var command = &cobra.Command{
+		Use:   "suspend APPNAME",
+		Short: "Suspend the automated sync and self-heal of an application",
+		Example: `  # Suspend the automated sync until the application is resumed
+  argocd app suspend guestbook --reason "incident 42"
+
+  # Suspend the automated sync for two hours
+  argocd app suspend guestbook --duration 2h`,
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName := args[0]
+			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
+			defer util.Close(conn)
+			ctx := context.Background()
+			app, err := appIf.Get(ctx, &applicationpkg.ApplicationQuery{Name: &appName})
+			errors.CheckError(err)
+			app.Spec.Suspend = &argoappv1.ApplicationSuspend{Reason: reason}
+			if duration > 0 {
+				until := metav1.NewTime(time.Now().Add(duration))
+				app.Spec.Suspend.Until = &until
+			}
+			_, err = appIf.UpdateSpec(ctx, &applicationpkg.ApplicationUpdateSpecRequest{Name: &app.Name, Spec: app.Spec})
+			errors.CheckError(err)
+			if app.Spec.Suspend.Until != nil {
+				fmt.Printf("Application '%s' suspended until %s\n", appName, app.Spec.Suspend.Until.Format(time.RFC3339))
+			} else {
+				fmt.Printf("Application '%s' suspended\n", appName)
+			}
+		},
+	}
+	command.Flags().DurationVar(&duration, "duration", 0, "Resume the automated sync after the given duration (default: until resumed manually)")
+	command.Flags().StringVar(&reason, "reason", "", "Reason why the application is suspended")
+	return command
+}
+
+// NewApplicationResumeCommand returns a new instance of an `argocd app resume` command
+func NewApplicationResumeCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "resume APPNAME",
+		Short: "Resume the automated sync and self-heal of a suspended application",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName := args[0]
+			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
+			defer util.Close(conn)
+			ctx := context.Background()
+			app, err := appIf.Get(ctx, &applicationpkg.ApplicationQuery{Name: &appName})
+			errors.CheckError(err)
+			if app.Spec.Suspend == nil {
+				fmt.Printf("Application '%s' is not suspended\n", appName)
+				return
+			}
+			app.Spec.Suspend = nil
+			_, err = appIf.UpdateSpec(ctx, &applicationpkg.ApplicationUpdateSpecRequest{Name: &app.Name, Spec: app.Spec})
+			errors.CheckError(err)
+			fmt.Printf("Application '%s' resumed\n", appName)
+		},
+	}
+	return command
+}
+
 func NewApplicationEditCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var command = &cobra.Command{
 		Use:   "edit APPNAME",
@@ -0,0 +1,201 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	argocdclient "github.com/argoproj/argo-cd/pkg/apiclient"
+	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+const (
+	progressOutputTable = "table"
+	progressOutputJSON  = "json"
+)
+
+const (
+	progressEventOperationPhaseChanged = "OperationPhaseChanged"
+	progressEventResourceChanged       = "ResourceChanged"
+	progressEventCompleted             = "Completed"
+	progressEventFailed                = "Failed"
+)
+
+// progressOpts are the options of commands which wait for an application, e.g. `argocd app sync` and `argocd app wait`
+type progressOpts struct {
+	nonInteractive bool
+	output         string
+}
+
+func addProgressFlags(command *cobra.Command, opts *progressOpts) {
+	command.Flags().BoolVar(&opts.nonInteractive, "non-interactive", false, "Do not print the progress of the resources while waiting, only the final status")
+	command.Flags().StringVar(&opts.output, "progress", progressOutputTable, "Progress output format. One of: table|json. The json format prints one event per line, e.g. for parsing in CI")
+}
+
+// validate returns an error if the progress output format is unknown
+func (opts progressOpts) validate() error {
+	switch opts.output {
+	case "", progressOutputTable, progressOutputJSON:
+		return nil
+	}
+	return fmt.Errorf("Unknown progress output: '%s'", opts.output)
+}
+
+// progressReporter reports the progress of an application while waiting for it
+type progressReporter interface {
+	// operation reports the operation state of the application
+	operation(app *argoappv1.Application)
+	// resources reports the resources whose state changed
+	resources(states []*resourceState)
+	// done reports the final status of the application, and the error if the wait failed
+	done(app *argoappv1.Application, watchOperation bool, err error)
+}
+
+// newProgressReporter returns the progress reporter of the validated options, which writes to stdout
+func newProgressReporter(acdClient argocdclient.Client, opts progressOpts, appName string) progressReporter {
+	if opts.output == progressOutputJSON {
+		return newJSONProgressReporter(os.Stdout, appName)
+	}
+	r := newTableProgressReporter(os.Stdout, appURL(acdClient, appName), opts.nonInteractive)
+	r.getProject = func(name string) *argoappv1.AppProject {
+		return getProject(acdClient, name)
+	}
+	return r
+}
+
+// tableProgressReporter prints the changed resources as rows of a table, unless it is non-interactive, and the final
+// status as tables
+type tableProgressReporter struct {
+	w              *tabwriter.Writer
+	appURL         string
+	nonInteractive bool
+	// getProject returns the project of the application, whose default sync policy is shown if the application has
+	// none. May be nil.
+	getProject func(name string) *argoappv1.AppProject
+}
+
+func newTableProgressReporter(out io.Writer, appURL string, nonInteractive bool) *tableProgressReporter {
+	r := &tableProgressReporter{w: tabwriter.NewWriter(out, 5, 0, 2, ' ', 0), appURL: appURL, nonInteractive: nonInteractive}
+	if !nonInteractive {
+		_, _ = fmt.Fprintf(r.w, waitFormatString, "TIMESTAMP", "GROUP", "KIND", "NAMESPACE", "NAME", "STATUS", "HEALTH", "HOOK", "MESSAGE")
+	}
+	return r
+}
+
+func (r *tableProgressReporter) operation(app *argoappv1.Application) {
+}
+
+func (r *tableProgressReporter) resources(states []*resourceState) {
+	if r.nonInteractive {
+		return
+	}
+	for _, state := range states {
+		_, _ = fmt.Fprintf(r.w, waitFormatString, state.FormatItems()...)
+	}
+	_ = r.w.Flush()
+}
+
+func (r *tableProgressReporter) done(app *argoappv1.Application, watchOperation bool, err error) {
+	fmt.Println()
+	var proj *argoappv1.AppProject
+	if r.getProject != nil {
+		proj = r.getProject(app.Spec.GetProject())
+	}
+	printAppSummaryTable(app, r.appURL, proj)
+	fmt.Println()
+	if watchOperation {
+		printOperationResult(app.Status.OperationState)
+	}
+	if len(app.Status.Resources) > 0 {
+		fmt.Println()
+		w := tabwriter.NewWriter(os.Stdout, 5, 0, 2, ' ', 0)
+		printAppResources(w, app)
+		_ = w.Flush()
+	}
+}
+
+// progressEvent is a line of the json progress output
+type progressEvent struct {
+	Time         string `json:"time"`
+	Type         string `json:"type"`
+	Application  string `json:"application"`
+	Phase        string `json:"phase,omitempty"`
+	SyncStatus   string `json:"syncStatus,omitempty"`
+	HealthStatus string `json:"healthStatus,omitempty"`
+	Group        string `json:"group,omitempty"`
+	Kind         string `json:"kind,omitempty"`
+	Namespace    string `json:"namespace,omitempty"`
+	Name         string `json:"name,omitempty"`
+	Status       string `json:"status,omitempty"`
+	Health       string `json:"health,omitempty"`
+	Hook         string `json:"hook,omitempty"`
+	Message      string `json:"message,omitempty"`
+}
+
+// jsonProgressReporter prints the changes of the operation phase and of the resources, and the final status as line
+// delimited json events
+type jsonProgressReporter struct {
+	encoder *json.Encoder
+	appName string
+	phase   argoappv1.OperationPhase
+}
+
+func newJSONProgressReporter(out io.Writer, appName string) *jsonProgressReporter {
+	return &jsonProgressReporter{encoder: json.NewEncoder(out), appName: appName}
+}
+
+func (r *jsonProgressReporter) emit(event progressEvent) {
+	event.Time = time.Now().Format(time.RFC3339)
+	event.Application = r.appName
+	if err := r.encoder.Encode(event); err != nil {
+		log.Warnf("Failed to print progress event: %v", err)
+	}
+}
+
+func (r *jsonProgressReporter) operation(app *argoappv1.Application) {
+	opState := app.Status.OperationState
+	if opState == nil || opState.Phase == r.phase {
+		return
+	}
+	r.phase = opState.Phase
+	r.emit(progressEvent{Type: progressEventOperationPhaseChanged, Phase: string(opState.Phase), Message: opState.Message})
+}
+
+func (r *jsonProgressReporter) resources(states []*resourceState) {
+	for _, state := range states {
+		r.emit(progressEvent{
+			Type:      progressEventResourceChanged,
+			Group:     state.Group,
+			Kind:      state.Kind,
+			Namespace: state.Namespace,
+			Name:      state.Name,
+			Status:    state.Status,
+			Health:    state.Health,
+			Hook:      state.Hook,
+			Message:   state.Message,
+		})
+	}
+}
+
+func (r *jsonProgressReporter) done(app *argoappv1.Application, watchOperation bool, err error) {
+	event := progressEvent{
+		Type:         progressEventCompleted,
+		SyncStatus:   string(app.Status.Sync.Status),
+		HealthStatus: app.Status.Health.Status,
+	}
+	if opState := app.Status.OperationState; watchOperation && opState != nil {
+		event.Phase = string(opState.Phase)
+		event.Message = opState.Message
+	}
+	if err != nil {
+		event.Type = progressEventFailed
+		event.Message = err.Error()
+	}
+	r.emit(event)
+}
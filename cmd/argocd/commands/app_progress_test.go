@@ -0,0 +1,72 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+func TestProgressOpts_Validate(t *testing.T) {
+	assert.NoError(t, progressOpts{}.validate())
+	assert.NoError(t, progressOpts{output: progressOutputJSON}.validate())
+	assert.EqualError(t, progressOpts{output: "yaml"}.validate(), "Unknown progress output: 'yaml'")
+}
+
+func TestJSONProgressReporter(t *testing.T) {
+	out := &bytes.Buffer{}
+	reporter := newJSONProgressReporter(out, "guestbook")
+
+	app := &v1alpha1.Application{}
+	app.Status.OperationState = &v1alpha1.OperationState{Phase: v1alpha1.OperationRunning}
+	reporter.operation(app)
+	// the phase is reported once
+	reporter.operation(app)
+	reporter.resources([]*resourceState{{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook-ui", Status: "Synced", Health: "Progressing"}})
+	app.Status.OperationState = &v1alpha1.OperationState{Phase: v1alpha1.OperationSucceeded, Message: "successfully synced"}
+	app.Status.Sync.Status = v1alpha1.SyncStatusCodeSynced
+	app.Status.Health.Status = v1alpha1.HealthStatusHealthy
+	reporter.operation(app)
+	reporter.done(app, true, nil)
+	reporter.done(app, true, fmt.Errorf("timed out"))
+
+	var events []progressEvent
+	for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+		var event progressEvent
+		assert.NoError(t, json.Unmarshal([]byte(line), &event))
+		assert.Equal(t, "guestbook", event.Application)
+		assert.NotEmpty(t, event.Time)
+		events = append(events, event)
+	}
+	if assert.Len(t, events, 5) {
+		assert.Equal(t, progressEvent{Type: progressEventOperationPhaseChanged, Phase: "Running"}, withoutTime(events[0]))
+		assert.Equal(t, progressEvent{Type: progressEventResourceChanged, Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook-ui", Status: "Synced", Health: "Progressing"}, withoutTime(events[1]))
+		assert.Equal(t, progressEvent{Type: progressEventOperationPhaseChanged, Phase: "Succeeded", Message: "successfully synced"}, withoutTime(events[2]))
+		assert.Equal(t, progressEvent{Type: progressEventCompleted, Phase: "Succeeded", SyncStatus: "Synced", HealthStatus: "Healthy", Message: "successfully synced"}, withoutTime(events[3]))
+		assert.Equal(t, progressEvent{Type: progressEventFailed, Phase: "Succeeded", SyncStatus: "Synced", HealthStatus: "Healthy", Message: "timed out"}, withoutTime(events[4]))
+	}
+}
+
+func TestTableProgressReporter_NonInteractive(t *testing.T) {
+	out := &bytes.Buffer{}
+	reporter := newTableProgressReporter(out, "", true)
+	reporter.resources([]*resourceState{{Kind: "Service", Name: "guestbook-ui"}})
+	assert.Empty(t, out.String())
+
+	reporter = newTableProgressReporter(out, "", false)
+	reporter.resources([]*resourceState{{Kind: "Service", Name: "guestbook-ui"}})
+	assert.Contains(t, out.String(), "TIMESTAMP")
+	assert.Contains(t, out.String(), "guestbook-ui")
+}
+
+// withoutTime clears the fields of the event which are set by the reporter
+func withoutTime(event progressEvent) progressEvent {
+	event.Time = ""
+	event.Application = ""
+	return event
+}
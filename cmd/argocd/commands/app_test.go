@@ -42,14 +42,41 @@ func Test_setHelmOpt(t *testing.T) {
 		setHelmOpt(&src, helmOpts{releaseName: "foo"})
 		assert.Equal(t, "foo", src.Helm.ReleaseName)
 	})
+	t.Run("IncludeCRDs", func(t *testing.T) {
+		src := v1alpha1.ApplicationSource{}
+		setHelmOpt(&src, helmOpts{includeCRDs: true})
+		assert.True(t, src.Helm.IncludeCRDs)
+	})
 	t.Run("HelmSets", func(t *testing.T) {
 		src := v1alpha1.ApplicationSource{}
 		setHelmOpt(&src, helmOpts{helmSets: []string{"foo=bar"}})
 		assert.Equal(t, []v1alpha1.HelmParameter{{Name: "foo", Value: "bar"}}, src.Helm.Parameters)
 	})
+	t.Run("HelmSetFiles", func(t *testing.T) {
+		src := v1alpha1.ApplicationSource{}
+		setHelmOpt(&src, helmOpts{helmSetFiles: []string{"foo=bar.yaml"}})
+		assert.Equal(t, []v1alpha1.HelmFileParameter{{Name: "foo", Path: "bar.yaml"}}, src.Helm.FileParameters)
+	})
 	t.Run("HelmSetStrings", func(t *testing.T) {
 		src := v1alpha1.ApplicationSource{}
 		setHelmOpt(&src, helmOpts{helmSetStrings: []string{"foo=bar"}})
 		assert.Equal(t, []v1alpha1.HelmParameter{{Name: "foo", Value: "bar", ForceString: true}}, src.Helm.Parameters)
 	})
 }
+
+func TestFormatOperationInitiator(t *testing.T) {
+	assert.Equal(t, "automated sync policy", formatOperationInitiator(v1alpha1.OperationInitiator{Automated: true}))
+	assert.Equal(t, "admin (cli)", formatOperationInitiator(v1alpha1.OperationInitiator{Username: "admin", Source: v1alpha1.OperationSourceCLI}))
+	assert.Equal(t, "proj:default:ci", formatOperationInitiator(v1alpha1.OperationInitiator{Username: "proj:default:ci"}))
+	assert.Equal(t, "unknown", formatOperationInitiator(v1alpha1.OperationInitiator{}))
+}
+
+func TestFormatSyncPolicy(t *testing.T) {
+	proj := &v1alpha1.AppProject{Spec: v1alpha1.AppProjectSpec{SyncPolicy: &v1alpha1.SyncPolicy{Automated: &v1alpha1.SyncPolicyAutomated{Prune: true}}}}
+	app := v1alpha1.Application{}
+	assert.Equal(t, "<none>", formatSyncPolicy(app, nil))
+	// the default sync policy of the project applies to applications without sync policy
+	assert.Equal(t, "Auto-Prune", formatSyncPolicy(app, proj))
+	app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{}
+	assert.Equal(t, "<none>", formatSyncPolicy(app, proj))
+}
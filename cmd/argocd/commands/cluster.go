@@ -12,6 +12,7 @@ import (
 	"github.com/ghodss/yaml"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
@@ -52,10 +53,29 @@ func NewClusterAddCommand(clientOpts *argocdclient.ClientOptions, pathOpts *clie
 		awsRoleArn      string
 		awsClusterName  string
 		systemNamespace string
+		readOnly        bool
+		namespaces      []string
+		customRulesPath string
+		labels          []string
+		annotations     []string
 	)
 	var command = &cobra.Command{
 		Use:   "add",
 		Short: fmt.Sprintf("%s cluster add CONTEXT", cliName),
+		Long: "Adds a cluster from a kubeconfig context. Unless AWS IAM authentication is used, an argocd-manager service " +
+			"account is installed in the cluster with permissions to manage all resources, or the permissions of a preset.",
+		Example: `
+# Add a cluster which Argo CD only observes
+argocd cluster add my-context --read-only
+
+# Add a cluster to which Argo CD only deploys into the namespaces ns1 and ns2
+argocd cluster add my-context --namespaced ns1,ns2
+
+# Add a cluster with the permissions of a file of RBAC policy rules
+argocd cluster add my-context --custom-rules rules.yaml
+
+# Add a cluster labeled as a production cluster
+argocd cluster add my-context --label env=prod`,
 		Run: func(c *cobra.Command, args []string) {
 			var configAccess clientcmd.ConfigAccess = pathOpts
 			if len(args) == 0 {
@@ -79,6 +99,9 @@ func NewClusterAddCommand(clientOpts *argocdclient.ClientOptions, pathOpts *clie
 
 			managerBearerToken := ""
 			var awsAuthConf *argoappv1.AWSAuthConfig
+			if awsClusterName != "" && (readOnly || len(namespaces) > 0 || customRulesPath != "") {
+				log.Fatal("--read-only, --namespaced and --custom-rules cannot be used with --aws-cluster-name")
+			}
 			if awsClusterName != "" {
 				awsAuthConf = &argoappv1.AWSAuthConfig{
 					ClusterName: awsClusterName,
@@ -86,9 +109,15 @@ func NewClusterAddCommand(clientOpts *argocdclient.ClientOptions, pathOpts *clie
 				}
 			} else {
 				// Install RBAC resources for managing the cluster
+				rbacOpts := clusterauth.ClusterManagerRBACOptions{ReadOnly: readOnly, Namespaces: namespaces}
+				if customRulesPath != "" {
+					rbacOpts.Rules, err = readPolicyRules(customRulesPath)
+					errors.CheckError(err)
+				}
+				errors.CheckError(rbacOpts.Validate())
 				clientset, err := kubernetes.NewForConfig(conf)
 				errors.CheckError(err)
-				managerBearerToken, err = clusterauth.InstallClusterManagerRBAC(clientset, systemNamespace)
+				managerBearerToken, err = clusterauth.InstallClusterManagerRBAC(clientset, systemNamespace, rbacOpts)
 				errors.CheckError(err)
 			}
 			conn, clusterIf := argocdclient.NewClientOrDie(clientOpts).NewClusterClientOrDie()
@@ -97,6 +126,12 @@ func NewClusterAddCommand(clientOpts *argocdclient.ClientOptions, pathOpts *clie
 			if inCluster {
 				clst.Server = common.KubernetesInternalAPIServerAddr
 			}
+			// applications may only be deployed into the namespaces argocd-manager is permitted to change
+			clst.Namespaces = namespaces
+			clst.Labels, err = parseLabels(labels)
+			errors.CheckError(err)
+			clst.Annotations, err = parseLabels(annotations)
+			errors.CheckError(err)
 			clstCreateReq := clusterpkg.ClusterCreateRequest{
 				Cluster: clst,
 				Upsert:  upsert,
@@ -112,9 +147,30 @@ func NewClusterAddCommand(clientOpts *argocdclient.ClientOptions, pathOpts *clie
 	command.Flags().StringVar(&awsClusterName, "aws-cluster-name", "", "AWS Cluster name if set then aws-iam-authenticator will be used to access cluster")
 	command.Flags().StringVar(&awsRoleArn, "aws-role-arn", "", "Optional AWS role arn. If set then AWS IAM Authenticator assume a role to perform cluster operations instead of the default AWS credential provider chain.")
 	command.Flags().StringVar(&systemNamespace, "system-namespace", common.DefaultSystemNamespace, "Use different system namespace")
+	command.Flags().BoolVar(&readOnly, "read-only", false, "Only grant permissions to observe resources, so that the cluster can be monitored but not synced")
+	command.Flags().StringSliceVar(&namespaces, "namespaced", nil, "Only grant permissions to change resources in the given namespaces (e.g. ns1,ns2); resources of the whole cluster can still be observed")
+	command.Flags().StringVar(&customRulesPath, "custom-rules", "", "Path to a YAML file with a list of RBAC policy rules which replace the default permissions, in the given namespaces if --namespaced is set")
+	command.Flags().StringArrayVar(&labels, "label", nil, "Set a label of the cluster in the form key=value. This option may be specified repeatedly.")
+	command.Flags().StringArrayVar(&annotations, "annotation", nil, "Set an annotation of the cluster in the form key=value. This option may be specified repeatedly.")
 	return command
 }
 
+// readPolicyRules reads a list of RBAC policy rules from a YAML or JSON file
+func readPolicyRules(path string) ([]rbacv1.PolicyRule, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var rules []rbacv1.PolicyRule
+	if err := yaml.Unmarshal(data, &rules); err != nil {
+		return nil, fmt.Errorf("failed to parse policy rules in %s: %v", path, err)
+	}
+	if len(rules) == 0 {
+		return nil, fmt.Errorf("%s contains no policy rules", path)
+	}
+	return rules, nil
+}
+
 func printKubeContexts(ca clientcmd.ConfigAccess) {
 	config, err := ca.GetStartingConfig()
 	errors.CheckError(err)
@@ -249,7 +305,8 @@ func printClusterServers(clusters []argoappv1.Cluster) {
 // NewClusterListCommand returns a new instance of an `argocd cluster rm` command
 func NewClusterListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		output string
+		output   string
+		selector string
 	)
 	var command = &cobra.Command{
 		Use:   "list",
@@ -257,7 +314,7 @@ func NewClusterListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comman
 		Run: func(c *cobra.Command, args []string) {
 			conn, clusterIf := argocdclient.NewClientOrDie(clientOpts).NewClusterClientOrDie()
 			defer util.Close(conn)
-			clusters, err := clusterIf.List(context.Background(), &clusterpkg.ClusterQuery{})
+			clusters, err := clusterIf.List(context.Background(), &clusterpkg.ClusterQuery{Selector: selector})
 			errors.CheckError(err)
 			if output == "server" {
 				printClusterServers(clusters.Items)
@@ -267,6 +324,7 @@ func NewClusterListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comman
 		},
 	}
 	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: wide|server")
+	command.Flags().StringVarP(&selector, "selector", "l", "", "List the clusters matching the label selector")
 	return command
 }
 
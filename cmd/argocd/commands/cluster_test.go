@@ -1,8 +1,13 @@
 package commands
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
@@ -29,3 +34,25 @@ func Test_printClusterTable(t *testing.T) {
 		},
 	})
 }
+
+func Test_readPolicyRules(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rules")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	path := filepath.Join(dir, "rules.yaml")
+	err = ioutil.WriteFile(path, []byte(`
+- apiGroups: ["apps"]
+  resources: ["deployments"]
+  verbs: ["*"]
+`), 0644)
+	assert.NoError(t, err)
+	rules, err := readPolicyRules(path)
+	assert.NoError(t, err)
+	assert.Equal(t, []rbacv1.PolicyRule{{APIGroups: []string{"apps"}, Resources: []string{"deployments"}, Verbs: []string{"*"}}}, rules)
+
+	err = ioutil.WriteFile(path, []byte("[]"), 0644)
+	assert.NoError(t, err)
+	_, err = readPolicyRules(path)
+	assert.Error(t, err)
+}
@@ -0,0 +1,119 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-cd/errors"
+	argocdclient "github.com/argoproj/argo-cd/pkg/apiclient"
+	debugpkg "github.com/argoproj/argo-cd/pkg/apiclient/debug"
+	"github.com/argoproj/argo-cd/util"
+)
+
+// NewDebugCommand returns a new instance of an `argocd debug` command
+func NewDebugCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "debug",
+		Short: "Profile the application controller and repo server, and inspect their locks",
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+			os.Exit(1)
+		},
+	}
+	command.AddCommand(NewDebugProfilingCommand(clientOpts))
+	command.AddCommand(NewDebugProfileCommand(clientOpts))
+	command.AddCommand(NewDebugLocksCommand(clientOpts))
+	return command
+}
+
+// NewDebugProfilingCommand returns a new instance of an `argocd debug profiling` command
+func NewDebugProfilingCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var disable bool
+	var command = &cobra.Command{
+		Use:     "profiling COMPONENT",
+		Short:   "Enable or disable serving profiles on the metrics port of a component (application-controller or repo-server)",
+		Example: "  argocd debug profiling application-controller\n  argocd debug profiling repo-server --disable",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, debugIf := argocdclient.NewClientOrDie(clientOpts).NewDebugClientOrDie()
+			defer util.Close(conn)
+			res, err := debugIf.SetProfiling(context.Background(), &debugpkg.ProfilingRequest{Component: args[0], Enabled: !disable})
+			errors.CheckError(err)
+			fmt.Printf("Profiling of %s enabled: %v\n", args[0], res.Enabled)
+		},
+	}
+	command.Flags().BoolVar(&disable, "disable", false, "Disable profiling")
+	return command
+}
+
+// NewDebugProfileCommand returns a new instance of an `argocd debug profile` command
+func NewDebugProfileCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		outFile string
+		dump    bool
+	)
+	var command = &cobra.Command{
+		Use:   "profile COMPONENT PROFILE",
+		Short: "Retrieve a runtime profile (e.g. heap or goroutine) of a component (application-controller or repo-server)",
+		Example: "  argocd debug profile application-controller heap -o heap.pprof\n" +
+			"  argocd debug profile repo-server goroutine --dump",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 2 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			if !dump && outFile == "" {
+				log.Fatal("Either --out or --dump is required")
+			}
+			conn, debugIf := argocdclient.NewClientOrDie(clientOpts).NewDebugClientOrDie()
+			defer util.Close(conn)
+			res, err := debugIf.GetProfile(context.Background(), &debugpkg.ProfileRequest{Component: args[0], Name: args[1], Dump: dump})
+			errors.CheckError(err)
+			if dump {
+				fmt.Printf("Profile dumped to %s\n", res.Path)
+				return
+			}
+			errors.CheckError(ioutil.WriteFile(outFile, res.Data, 0644))
+			fmt.Printf("Profile written to %s\n", outFile)
+		},
+	}
+	command.Flags().StringVarP(&outFile, "out", "o", "", "File to write the profile to, which can be analyzed with `go tool pprof`")
+	command.Flags().BoolVar(&dump, "dump", false, "Dump the profile to the profile directory of the component instead of returning it")
+	return command
+}
+
+// NewDebugLocksCommand returns a new instance of an `argocd debug locks` command
+func NewDebugLocksCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var command = &cobra.Command{
+		Use:     "locks COMPONENT",
+		Short:   "List the held locks of a component (repo-server), e.g. to find a stuck manifest generation",
+		Example: "  argocd debug locks repo-server",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, debugIf := argocdclient.NewClientOrDie(clientOpts).NewDebugClientOrDie()
+			defer util.Close(conn)
+			res, err := debugIf.GetLocks(context.Background(), &debugpkg.LocksRequest{Component: args[0]})
+			errors.CheckError(err)
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			_, _ = fmt.Fprintf(w, "KEY\tOWNER\tHELD\tWAITING\n")
+			for _, lock := range res.Locks {
+				_, _ = fmt.Fprintf(w, "%s\t%s\t%v\t%d\n", lock.Key, lock.Owner, time.Duration(lock.HeldSeconds)*time.Second, lock.Waiting)
+			}
+			_ = w.Flush()
+		},
+	}
+	return command
+}
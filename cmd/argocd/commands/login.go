@@ -3,9 +3,11 @@ package commands
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/coreos/go-oidc"
@@ -103,6 +105,9 @@ func NewLoginCommand(globalClientOpts *argocdclient.ClientOptions) *cobra.Comman
 			errors.CheckError(err)
 
 			fmt.Printf("'%s' logged in successfully\n", userDisplayName(claims))
+			if acdSet, err := setIf.Get(context.Background(), &settingspkg.SettingsQuery{}); err == nil {
+				printAnnouncements(os.Stdout, acdSet)
+			}
 			// login successful. Persist the config
 			localCfg, err := localconfig.ReadLocalConfig(globalClientOpts.ConfigPath)
 			errors.CheckError(err)
@@ -142,6 +147,24 @@ func NewLoginCommand(globalClientOpts *argocdclient.ClientOptions) *cobra.Comman
 	return command
 }
 
+// printAnnouncements prints the announcements of the server and whether it is in maintenance mode
+func printAnnouncements(w io.Writer, set *settingspkg.Settings) {
+	if set.Maintenance != nil && set.Maintenance.Enabled {
+		_, _ = fmt.Fprintf(w, "MAINTENANCE: automated syncs are paused")
+		if set.Maintenance.Message != "" {
+			_, _ = fmt.Fprintf(w, ": %s", set.Maintenance.Message)
+		}
+		_, _ = fmt.Fprintln(w)
+	}
+	for _, announcement := range set.Announcements {
+		_, _ = fmt.Fprintf(w, "%s: %s", strings.ToUpper(announcement.Severity), announcement.Message)
+		if announcement.URL != "" {
+			_, _ = fmt.Fprintf(w, " (%s)", announcement.URL)
+		}
+		_, _ = fmt.Fprintln(w)
+	}
+}
+
 func userDisplayName(claims jwt.MapClaims) string {
 	if email, ok := claims["email"]; ok && email != nil {
 		return email.(string)
@@ -0,0 +1,25 @@
+package commands
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	settingspkg "github.com/argoproj/argo-cd/pkg/apiclient/settings"
+)
+
+func TestPrintAnnouncements(t *testing.T) {
+	var out bytes.Buffer
+	printAnnouncements(&out, &settingspkg.Settings{
+		Maintenance: &settingspkg.Maintenance{Enabled: true, Message: "upgrading Argo CD"},
+		Announcements: []*settingspkg.Announcement{
+			{Message: "Argo CD is upgraded on Friday", Severity: "warning", URL: "https://status.example.com"},
+			{Message: "Welcome", Severity: "info"},
+		},
+	})
+	assert.Equal(t, `MAINTENANCE: automated syncs are paused: upgrading Argo CD
+WARNING: Argo CD is upgraded on Friday (https://status.example.com)
+INFO: Welcome
+`, out.String())
+}
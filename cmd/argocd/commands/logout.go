@@ -1,7 +1,9 @@
 package commands
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"os"
 
 	log "github.com/sirupsen/logrus"
@@ -9,7 +11,10 @@ import (
 
 	"github.com/argoproj/argo-cd/errors"
 	argocdclient "github.com/argoproj/argo-cd/pkg/apiclient"
+	sessionpkg "github.com/argoproj/argo-cd/pkg/apiclient/session"
+	"github.com/argoproj/argo-cd/util"
 	"github.com/argoproj/argo-cd/util/localconfig"
+	"github.com/argoproj/argo-cd/util/session"
 )
 
 // NewLogoutCommand returns a new instance of `argocd logout` command
@@ -17,7 +22,7 @@ func NewLogoutCommand(globalClientOpts *argocdclient.ClientOptions) *cobra.Comma
 	var command = &cobra.Command{
 		Use:   "logout CONTEXT",
 		Short: "Log out from Argo CD",
-		Long:  "Log out from Argo CD",
+		Long:  "Log out from Argo CD. The token of the context is revoked by the server, if the server issued it, and deleted from the local config.",
 		Run: func(c *cobra.Command, args []string) {
 			if len(args) == 0 {
 				c.HelpFunc()(c, args)
@@ -31,6 +36,8 @@ func NewLogoutCommand(globalClientOpts *argocdclient.ClientOptions) *cobra.Comma
 				log.Fatalf("Nothing to logout from")
 			}
 
+			revokeToken(globalClientOpts, localCfg, context)
+
 			ok := localCfg.RemoveToken(context)
 			if !ok {
 				log.Fatalf("Context %s does not exist", context)
@@ -48,3 +55,34 @@ func NewLogoutCommand(globalClientOpts *argocdclient.ClientOptions) *cobra.Comma
 	}
 	return command
 }
+
+// revokeToken asks the server of the context to revoke its token. Failures are only logged, so that the local
+// credentials are deleted even if the server cannot be reached.
+func revokeToken(globalClientOpts *argocdclient.ClientOptions, localCfg *localconfig.LocalConfig, contextName string) {
+	user, err := localCfg.GetUser(contextName)
+	if err != nil || user.AuthToken == "" {
+		return
+	}
+	claims, err := user.Claims()
+	if err == nil && claims.Issuer != session.SessionManagerClaimsIssuer {
+		log.Warnf("The token of '%s' was issued by the SSO provider and cannot be revoked by Argo CD", contextName)
+		return
+	}
+	acdClient, err := argocdclient.NewClient(&argocdclient.ClientOptions{
+		ConfigPath: globalClientOpts.ConfigPath,
+		Context:    contextName,
+		UserAgent:  globalClientOpts.UserAgent,
+	})
+	if err == nil {
+		var conn io.Closer
+		var sessionIf sessionpkg.SessionServiceClient
+		conn, sessionIf, err = acdClient.NewSessionClient()
+		if err == nil {
+			defer util.Close(conn)
+			_, err = sessionIf.Delete(context.Background(), &sessionpkg.SessionDeleteRequest{})
+		}
+	}
+	if err != nil {
+		log.Warnf("Failed to revoke the token of '%s': %v", contextName, err)
+	}
+}
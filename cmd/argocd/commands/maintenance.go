@@ -0,0 +1,94 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-cd/errors"
+	argocdclient "github.com/argoproj/argo-cd/pkg/apiclient"
+	settingspkg "github.com/argoproj/argo-cd/pkg/apiclient/settings"
+	"github.com/argoproj/argo-cd/util"
+)
+
+// NewMaintenanceCommand returns a new instance of an `argocd maintenance` command
+func NewMaintenanceCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "maintenance",
+		Short: "Pause or resume the automated syncs of all applications",
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+			os.Exit(1)
+		},
+	}
+	command.AddCommand(NewMaintenanceEnableCommand(clientOpts))
+	command.AddCommand(NewMaintenanceDisableCommand(clientOpts))
+	command.AddCommand(NewMaintenanceGetCommand(clientOpts))
+	return command
+}
+
+// NewMaintenanceEnableCommand returns a new instance of an `argocd maintenance enable` command
+func NewMaintenanceEnableCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var message string
+	var command = &cobra.Command{
+		Use:     "enable",
+		Short:   "Enable the maintenance mode, which pauses the automated syncs of all applications",
+		Example: `  argocd maintenance enable --message "Upgrading Argo CD"`,
+		Run: func(c *cobra.Command, args []string) {
+			conn, settingsIf := argocdclient.NewClientOrDie(clientOpts).NewSettingsClientOrDie()
+			defer util.Close(conn)
+			_, err := settingsIf.SetMaintenance(context.Background(), &settingspkg.MaintenanceRequest{Enabled: true, Message: message})
+			errors.CheckError(err)
+			fmt.Println("Maintenance mode enabled")
+		},
+	}
+	command.Flags().StringVar(&message, "message", "", "Message shown to the users, e.g. the reason of the maintenance")
+	return command
+}
+
+// NewMaintenanceDisableCommand returns a new instance of an `argocd maintenance disable` command
+func NewMaintenanceDisableCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "disable",
+		Short: "Disable the maintenance mode, which resumes the automated syncs of all applications",
+		Run: func(c *cobra.Command, args []string) {
+			conn, settingsIf := argocdclient.NewClientOrDie(clientOpts).NewSettingsClientOrDie()
+			defer util.Close(conn)
+			_, err := settingsIf.SetMaintenance(context.Background(), &settingspkg.MaintenanceRequest{Enabled: false})
+			errors.CheckError(err)
+			fmt.Println("Maintenance mode disabled")
+		},
+	}
+	return command
+}
+
+// NewMaintenanceGetCommand returns a new instance of an `argocd maintenance get` command
+func NewMaintenanceGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "get",
+		Short: "Show whether the maintenance mode is enabled",
+		Run: func(c *cobra.Command, args []string) {
+			conn, settingsIf := argocdclient.NewClientOrDie(clientOpts).NewSettingsClientOrDie()
+			defer util.Close(conn)
+			set, err := settingsIf.Get(context.Background(), &settingspkg.SettingsQuery{})
+			errors.CheckError(err)
+			maintenance := set.Maintenance
+			if maintenance == nil || !maintenance.Enabled {
+				fmt.Println("Maintenance mode is disabled")
+				return
+			}
+			fmt.Print("Maintenance mode is enabled")
+			if maintenance.EnabledAt != nil {
+				fmt.Printf(" since %s", maintenance.EnabledAt.Format(time.RFC3339))
+			}
+			fmt.Println()
+			if maintenance.Message != "" {
+				fmt.Printf("Message: %s\n", maintenance.Message)
+			}
+		},
+	}
+	return command
+}
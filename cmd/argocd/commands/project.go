@@ -8,6 +8,7 @@ import (
 	"io"
 	"net/url"
 	"os"
+	"strconv"
 	"strings"
 	"text/tabwriter"
 	"time"
@@ -36,6 +37,9 @@ type projectOpts struct {
 	sources                  []string
 	orphanedResourcesEnabled bool
 	orphanedResourcesWarn    bool
+	failOnSharedResource     bool
+	maxResources             int64
+	maxManifestSize          int64
 }
 
 type policyOpts struct {
@@ -72,6 +76,8 @@ func NewProjectCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	}
 	command.AddCommand(NewProjectRoleCommand(clientOpts))
 	command.AddCommand(NewProjectCreateCommand(clientOpts))
+	command.AddCommand(NewProjectCreateFromTemplateCommand(clientOpts))
+	command.AddCommand(NewProjectListTemplatesCommand(clientOpts))
 	command.AddCommand(NewProjectGetCommand(clientOpts))
 	command.AddCommand(NewProjectDeleteCommand(clientOpts))
 	command.AddCommand(NewProjectListCommand(clientOpts))
@@ -81,6 +87,8 @@ func NewProjectCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command.AddCommand(NewProjectRemoveDestinationCommand(clientOpts))
 	command.AddCommand(NewProjectAddSourceCommand(clientOpts))
 	command.AddCommand(NewProjectRemoveSourceCommand(clientOpts))
+	command.AddCommand(NewProjectAddDecryptionKeyCommand(clientOpts))
+	command.AddCommand(NewProjectRemoveDecryptionKeyCommand(clientOpts))
 	command.AddCommand(NewProjectAllowClusterResourceCommand(clientOpts))
 	command.AddCommand(NewProjectDenyClusterResourceCommand(clientOpts))
 	command.AddCommand(NewProjectAllowNamespaceResourceCommand(clientOpts))
@@ -95,6 +103,16 @@ func addProjFlags(command *cobra.Command, opts *projectOpts) {
 	command.Flags().StringArrayVarP(&opts.sources, "src", "s", []string{}, "Permitted source repository URL")
 	command.Flags().BoolVar(&opts.orphanedResourcesEnabled, "orphaned-resources", false, "Enables orphaned resources monitoring")
 	command.Flags().BoolVar(&opts.orphanedResourcesWarn, "orphaned-resources-warn", false, "Specifies if applications should be a warning condition when orphaned resources detected")
+	command.Flags().BoolVar(&opts.failOnSharedResource, "fail-on-shared-resource", false, "Fail the sync of applications which would overwrite resources of other applications")
+	command.Flags().Int64Var(&opts.maxResources, "max-resources", 0, "Maximum number of resources which an application may render (0 for unlimited)")
+	command.Flags().Int64Var(&opts.maxManifestSize, "max-manifest-size", 0, "Maximum total size in bytes of the manifests which an application may render (0 for unlimited)")
+}
+
+func getManifestQuota(opts projectOpts) *v1alpha1.ManifestQuota {
+	if opts.maxResources <= 0 && opts.maxManifestSize <= 0 {
+		return nil
+	}
+	return &v1alpha1.ManifestQuota{MaxResources: opts.maxResources, MaxSize: opts.maxManifestSize}
 }
 
 func getOrphanedResourcesSettings(c *cobra.Command, opts projectOpts) *v1alpha1.OrphanedResourcesMonitorSettings {
@@ -161,10 +179,12 @@ func NewProjectCreateCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comm
 				proj = v1alpha1.AppProject{
 					ObjectMeta: v1.ObjectMeta{Name: projName},
 					Spec: v1alpha1.AppProjectSpec{
-						Description:       opts.description,
-						Destinations:      opts.GetDestinations(),
-						SourceRepos:       opts.sources,
-						OrphanedResources: getOrphanedResourcesSettings(c, opts),
+						Description:          opts.description,
+						Destinations:         opts.GetDestinations(),
+						SourceRepos:          opts.sources,
+						OrphanedResources:    getOrphanedResourcesSettings(c, opts),
+						FailOnSharedResource: opts.failOnSharedResource,
+						ManifestQuota:        getManifestQuota(opts),
 					},
 				}
 			}
@@ -184,6 +204,77 @@ func NewProjectCreateCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comm
 	return command
 }
 
+// NewProjectCreateFromTemplateCommand returns a new instance of an `argocd proj create-from-template` command
+func NewProjectCreateFromTemplateCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		template   string
+		parameters []string
+		upsert     bool
+	)
+	var command = &cobra.Command{
+		Use:   "create-from-template PROJECT",
+		Short: "Create a project from a project template",
+		Example: `  # Create the project 'payments' from the template 'team'
+  argocd proj create-from-template payments --template team -p team=billing`,
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 || template == "" {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			params := make(map[string]string)
+			for _, p := range parameters {
+				parts := strings.SplitN(p, "=", 2)
+				if len(parts) != 2 {
+					log.Fatalf("Expected parameter of the form: param=value. Received: %s", p)
+				}
+				params[parts[0]] = parts[1]
+			}
+			conn, projIf := argocdclient.NewClientOrDie(clientOpts).NewProjectClientOrDie()
+			defer util.Close(conn)
+			_, err := projIf.CreateFromTemplate(context.Background(), &projectpkg.ProjectCreateFromTemplateRequest{
+				Template:   template,
+				Name:       args[0],
+				Parameters: params,
+				Upsert:     upsert,
+			})
+			errors.CheckError(err)
+		},
+	}
+	command.Flags().StringVar(&template, "template", "", "Name of the project template")
+	command.Flags().StringArrayVarP(&parameters, "parameter", "p", []string{}, "Set a parameter of the template (e.g. -p team=billing)")
+	command.Flags().BoolVar(&upsert, "upsert", false, "Allows to override a project with the same name even if the rendered project spec is different from existing spec")
+	return command
+}
+
+// NewProjectListTemplatesCommand returns a new instance of an `argocd proj list-templates` command
+func NewProjectListTemplatesCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "list-templates",
+		Short: "List project templates",
+		Run: func(c *cobra.Command, args []string) {
+			conn, projIf := argocdclient.NewClientOrDie(clientOpts).NewProjectClientOrDie()
+			defer util.Close(conn)
+			templates, err := projIf.ListTemplates(context.Background(), &projectpkg.ProjectTemplatesQuery{})
+			errors.CheckError(err)
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintf(w, "NAME\tDESCRIPTION\tPARAMETERS\n")
+			for _, t := range templates.Items {
+				var params []string
+				for _, p := range t.Parameters {
+					if p.Required {
+						params = append(params, p.Name+" (required)")
+					} else {
+						params = append(params, p.Name)
+					}
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\n", t.Name, t.Description, strings.Join(params, ","))
+			}
+			_ = w.Flush()
+		},
+	}
+	return command
+}
+
 // NewProjectSetCommand returns a new instance of an `argocd proj set` command
 func NewProjectSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
@@ -216,6 +307,16 @@ func NewProjectSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 					proj.Spec.SourceRepos = opts.sources
 				case "orphaned-resources", "orphaned-resources-warn":
 					proj.Spec.OrphanedResources = getOrphanedResourcesSettings(c, opts)
+				case "fail-on-shared-resource":
+					proj.Spec.FailOnSharedResource = opts.failOnSharedResource
+				case "max-resources", "max-manifest-size":
+					if !c.Flag("max-resources").Changed && proj.Spec.ManifestQuota != nil {
+						opts.maxResources = proj.Spec.ManifestQuota.MaxResources
+					}
+					if !c.Flag("max-manifest-size").Changed && proj.Spec.ManifestQuota != nil {
+						opts.maxManifestSize = proj.Spec.ManifestQuota.MaxSize
+					}
+					proj.Spec.ManifestQuota = getManifestQuota(opts)
 				}
 			})
 			if visited == 0 {
@@ -475,6 +576,75 @@ func NewProjectRemoveSourceCommand(clientOpts *argocdclient.ClientOptions) *cobr
 	return command
 }
 
+// NewProjectAddDecryptionKeyCommand returns a new instance of an `argocd proj add-decryption-key` command
+func NewProjectAddDecryptionKeyCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "add-decryption-key PROJECT KEY",
+		Short: "Allow applications of the project to decrypt files with the SOPS key set of the repo server with the given name",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 2 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			projName := args[0]
+			key := args[1]
+			conn, projIf := argocdclient.NewClientOrDie(clientOpts).NewProjectClientOrDie()
+			defer util.Close(conn)
+
+			proj, err := projIf.Get(context.Background(), &projectpkg.ProjectQuery{Name: projName})
+			errors.CheckError(err)
+
+			for _, item := range proj.Spec.SourceDecryptionKeys {
+				if item == key {
+					fmt.Printf("Decryption key '%s' already allowed in project\n", key)
+					return
+				}
+			}
+			proj.Spec.SourceDecryptionKeys = append(proj.Spec.SourceDecryptionKeys, key)
+			_, err = projIf.Update(context.Background(), &projectpkg.ProjectUpdateRequest{Project: proj})
+			errors.CheckError(err)
+		},
+	}
+	return command
+}
+
+// NewProjectRemoveDecryptionKeyCommand returns a new instance of an `argocd proj remove-decryption-key` command
+func NewProjectRemoveDecryptionKeyCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "remove-decryption-key PROJECT KEY",
+		Short: "Remove project decryption key",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 2 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			projName := args[0]
+			key := args[1]
+			conn, projIf := argocdclient.NewClientOrDie(clientOpts).NewProjectClientOrDie()
+			defer util.Close(conn)
+
+			proj, err := projIf.Get(context.Background(), &projectpkg.ProjectQuery{Name: projName})
+			errors.CheckError(err)
+
+			index := -1
+			for i, item := range proj.Spec.SourceDecryptionKeys {
+				if item == key {
+					index = i
+					break
+				}
+			}
+			if index == -1 {
+				fmt.Printf("Decryption key '%s' does not exist in project\n", key)
+			} else {
+				proj.Spec.SourceDecryptionKeys = append(proj.Spec.SourceDecryptionKeys[:index], proj.Spec.SourceDecryptionKeys[index+1:]...)
+				_, err = projIf.Update(context.Background(), &projectpkg.ProjectUpdateRequest{Project: proj})
+				errors.CheckError(err)
+			}
+		},
+	}
+	return command
+}
+
 // NewProjectDeleteCommand returns a new instance of an `argocd proj delete` command
 func NewProjectDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var command = &cobra.Command{
@@ -544,6 +714,21 @@ func formatOrphanedResources(p *v1alpha1.AppProject) string {
 	return fmt.Sprintf("enabled (warn=%v)", p.Spec.OrphanedResources.IsWarn())
 }
 
+func formatManifestQuota(p *v1alpha1.AppProject) string {
+	quota := p.Spec.ManifestQuota
+	if quota == nil || quota.MaxResources <= 0 && quota.MaxSize <= 0 {
+		return "<none>"
+	}
+	var limits []string
+	if quota.MaxResources > 0 {
+		limits = append(limits, fmt.Sprintf("%d resources", quota.MaxResources))
+	}
+	if quota.MaxSize > 0 {
+		limits = append(limits, fmt.Sprintf("%d bytes", quota.MaxSize))
+	}
+	return strings.Join(limits, ", ")
+}
+
 func printProjectLine(w io.Writer, p *v1alpha1.AppProject) {
 	var destinations, sourceRepos, clusterWhitelist, namespaceBlacklist string
 	switch len(p.Spec.Destinations) {
@@ -638,6 +823,8 @@ func NewProjectGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 				fmt.Printf(printProjFmtStr, "", fmt.Sprintf("%s/%s", p.Spec.NamespaceResourceBlacklist[i].Group, p.Spec.NamespaceResourceBlacklist[i].Kind))
 			}
 			fmt.Printf(printProjFmtStr, "Orphaned Resources:", formatOrphanedResources(p))
+			fmt.Printf(printProjFmtStr, "Fail On Shared Resource:", strconv.FormatBool(p.Spec.FailOnSharedResource))
+			fmt.Printf(printProjFmtStr, "Manifest Quota:", formatManifestQuota(p))
 		},
 	}
 	return command
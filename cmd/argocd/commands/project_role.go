@@ -41,6 +41,8 @@ func NewProjectRoleCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comman
 	roleCommand.AddCommand(NewProjectRoleRemovePolicyCommand(clientOpts))
 	roleCommand.AddCommand(NewProjectRoleAddGroupCommand(clientOpts))
 	roleCommand.AddCommand(NewProjectRoleRemoveGroupCommand(clientOpts))
+	roleCommand.AddCommand(NewProjectRoleSetQuotaCommand(clientOpts))
+	roleCommand.AddCommand(NewProjectRoleTokenUsageCommand(clientOpts))
 	return roleCommand
 }
 
@@ -320,6 +322,9 @@ func NewProjectRoleGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
 			printRoleFmtStr := "%-15s%s\n"
 			fmt.Printf(printRoleFmtStr, "Role Name:", roleName)
 			fmt.Printf(printRoleFmtStr, "Description:", role.Description)
+			if role.Quota != nil {
+				fmt.Printf(printRoleFmtStr, "Quota:", fmt.Sprintf("%d requests, %d syncs per hour", role.Quota.RequestsPerHour, role.Quota.SyncsPerHour))
+			}
 			fmt.Printf("Policies:\n")
 			fmt.Printf("%s\n", proj.ProjectPoliciesString())
 			fmt.Printf("JWT Tokens:\n")
@@ -339,6 +344,86 @@ func NewProjectRoleGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
 	return command
 }
 
+// NewProjectRoleSetQuotaCommand returns a new instance of an `argocd proj role set-quota` command
+func NewProjectRoleSetQuotaCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		requestsPerHour int64
+		syncsPerHour    int64
+	)
+	var command = &cobra.Command{
+		Use:   "set-quota PROJECT ROLE-NAME",
+		Short: "Limit the API requests and syncs per hour of each token of a project role",
+		Example: `  # Allow each token of the role to make 1000 API requests and start 20 syncs per hour
+  argocd proj role set-quota myproject ci --requests-per-hour 1000 --syncs-per-hour 20
+
+  # Remove the quota of the role
+  argocd proj role set-quota myproject ci --requests-per-hour 0 --syncs-per-hour 0`,
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 2 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			projName := args[0]
+			roleName := args[1]
+			conn, projIf := argocdclient.NewClientOrDie(clientOpts).NewProjectClientOrDie()
+			defer util.Close(conn)
+
+			proj, err := projIf.Get(context.Background(), &projectpkg.ProjectQuery{Name: projName})
+			errors.CheckError(err)
+
+			_, roleIndex, err := proj.GetRoleByName(roleName)
+			errors.CheckError(err)
+
+			role := &proj.Spec.Roles[roleIndex]
+			if role.Quota == nil {
+				role.Quota = &v1alpha1.ProjectRoleQuota{}
+			}
+			if c.Flags().Changed("requests-per-hour") {
+				role.Quota.RequestsPerHour = requestsPerHour
+			}
+			if c.Flags().Changed("syncs-per-hour") {
+				role.Quota.SyncsPerHour = syncsPerHour
+			}
+			if role.Quota.RequestsPerHour == 0 && role.Quota.SyncsPerHour == 0 {
+				role.Quota = nil
+			}
+			_, err = projIf.Update(context.Background(), &projectpkg.ProjectUpdateRequest{Project: proj})
+			errors.CheckError(err)
+			fmt.Printf("Quota of role '%s' updated\n", roleName)
+		},
+	}
+	command.Flags().Int64Var(&requestsPerHour, "requests-per-hour", 0, "Number of API requests each token may make per hour (0 for no limit)")
+	command.Flags().Int64Var(&syncsPerHour, "syncs-per-hour", 0, "Number of syncs each token may start per hour (0 for no limit)")
+	return command
+}
+
+// NewProjectRoleTokenUsageCommand returns a new instance of an `argocd proj role token-usage` command
+func NewProjectRoleTokenUsageCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "token-usage PROJECT ROLE-NAME",
+		Short: "Print the API requests and syncs of the tokens of a project role since the API server started",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 2 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, projIf := argocdclient.NewClientOrDie(clientOpts).NewProjectClientOrDie()
+			defer util.Close(conn)
+
+			usage, err := projIf.GetTokenUsage(context.Background(), &projectpkg.ProjectTokenUsageQuery{Project: args[0], Role: args[1]})
+			errors.CheckError(err)
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintf(w, "ID\tREQUESTS\tSYNCS\tREJECTED\tQUOTA-REQUESTS\tQUOTA-SYNCS\tQUOTA-RESET-AT\tLAST-USED-AT\n")
+			for _, item := range usage.Items {
+				fmt.Fprintf(w, "%d\t%d\t%d\t%d\t%d\t%d\t%s\t%s\n", item.Iat, item.Requests, item.Syncs, item.Rejected, item.QuotaRequests, item.QuotaSyncs, humanizeTimestamp(item.QuotaResetAt), humanizeTimestamp(item.LastUsedAt))
+			}
+			_ = w.Flush()
+		},
+	}
+	return command
+}
+
 // NewProjectRoleAddGroupCommand returns a new instance of an `argocd proj role add-group` command
 func NewProjectRoleAddGroupCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var command = &cobra.Command{
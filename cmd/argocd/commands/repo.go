@@ -47,6 +47,7 @@ func NewRepoAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 		tlsClientCertPath              string
 		tlsClientCertKeyPath           string
 		enableLfs                      bool
+		createWebhook                  bool
 	)
 
 	// For better readability and easier formatting
@@ -57,6 +58,8 @@ Add a HTTPS repository using username/password and TLS client certificates:",
   $ argocd repo add https://git.example.com --username git --password secret --tls-client-cert-path ~/mycert.crt --tls-client-cert-key-path ~/mycert.key",
 Add a HTTPS repository using username/password without verifying the server's TLS certificate:",
   $ argocd repo add https://git.example.com --username git --password secret --insecure-skip-server-verification",
+Add a GitHub repository using an API token and create the push webhook of Argo CD in it:",
+  $ argocd repo add https://github.com/argoproj/argocd-example-apps --username git --password <token> --create-webhook",
 `
 
 	var command = &cobra.Command{
@@ -140,8 +143,9 @@ Add a HTTPS repository using username/password without verifying the server's TL
 			errors.CheckError(err)
 
 			repoCreateReq := repositorypkg.RepoCreateRequest{
-				Repo:   &repo,
-				Upsert: upsert,
+				Repo:          &repo,
+				Upsert:        upsert,
+				CreateWebhook: createWebhook,
 			}
 			createdRepo, err := repoIf.Create(context.Background(), &repoCreateReq)
 			errors.CheckError(err)
@@ -159,6 +163,7 @@ Add a HTTPS repository using username/password without verifying the server's TL
 	command.Flags().BoolVar(&insecureSkipServerVerification, "insecure-skip-server-verification", false, "disables server certificate and host key checks")
 	command.Flags().BoolVar(&enableLfs, "enable-lfs", false, "enable git-lfs (Large File Support) on this repository")
 	command.Flags().BoolVar(&upsert, "upsert", false, "Override an existing repository with the same name even if the spec differs")
+	command.Flags().BoolVar(&createWebhook, "create-webhook", false, "Create a push webhook in the GitHub or GitLab repository, using the password as API token. The webhook is deleted when the repository is removed")
 	return command
 }
 
@@ -3,8 +3,11 @@ package commands
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/Masterminds/semver"
 	"github.com/golang/protobuf/ptypes/empty"
+	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 
 	"github.com/argoproj/argo-cd/common"
@@ -56,11 +59,55 @@ func NewVersionCmd(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 				fmt.Printf("  Compiler: %s\n", serverVers.Compiler)
 				fmt.Printf("  Platform: %s\n", serverVers.Platform)
 				fmt.Printf("  Ksonnet Version: %s\n", serverVers.KsonnetVersion)
+				fmt.Printf("  Helm Version: %s\n", serverVers.HelmVersion)
+				fmt.Printf("  Kustomize Version: %s\n", serverVers.KustomizeVersion)
+				fmt.Printf("  Git Version: %s\n", serverVers.GitVersion)
+				fmt.Printf("  Features: %s\n", strings.Join(serverVers.Features, ","))
 			}
-
+			fmt.Printf("%s: %s\n", "argocd-application-controller", orUnknown(serverVers.ControllerVersion))
+			fmt.Printf("%s: %s\n", "argocd-repo-server", orUnknown(serverVers.RepoServerVersion))
 		},
 	}
 	versionCmd.Flags().BoolVar(&short, "short", false, "print just the version number")
 	versionCmd.Flags().BoolVar(&client, "client", false, "client version only (no server required)")
 	return &versionCmd
 }
+
+func orUnknown(version string) string {
+	if version == "" {
+		return "Unknown"
+	}
+	return version
+}
+
+// warnOnVersionSkew warns if the major or minor version of the API server differs from the version of the CLI,
+// because the semantics of the command may have changed between them
+func warnOnVersionSkew(acdClient argocdclient.Client) {
+	conn, versionIf := acdClient.NewVersionClientOrDie()
+	defer util.Close(conn)
+	serverVers, err := versionIf.Version(context.Background(), &empty.Empty{})
+	if err != nil {
+		log.Debugf("Failed to get the server version: %v", err)
+		return
+	}
+	if warning := versionSkewWarning(common.GetVersion().Version, serverVers.Version); warning != "" {
+		log.Warn(warning)
+	}
+}
+
+// versionSkewWarning returns a warning if the major or minor versions differ, or an empty string if they are equal or
+// cannot be parsed
+func versionSkewWarning(clientVersion string, serverVersion string) string {
+	clientVers, err := semver.NewVersion(clientVersion)
+	if err != nil {
+		return ""
+	}
+	serverVers, err := semver.NewVersion(serverVersion)
+	if err != nil {
+		return ""
+	}
+	if clientVers.Major() == serverVers.Major() && clientVers.Minor() == serverVers.Minor() {
+		return ""
+	}
+	return fmt.Sprintf("%s %s and argocd-server %s differ in version, the semantics of this command may differ between them", cliName, clientVersion, serverVersion)
+}
@@ -0,0 +1,14 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersionSkewWarning(t *testing.T) {
+	assert.Empty(t, versionSkewWarning("v1.2.0+abc", "v1.2.3+def"))
+	assert.Contains(t, versionSkewWarning("v1.2.0+abc", "v1.3.0+def"), "argocd-server v1.3.0+def")
+	assert.NotEmpty(t, versionSkewWarning("v2.2.0", "v1.2.0"))
+	assert.Empty(t, versionSkewWarning("v1.2.0", "unknown"))
+}
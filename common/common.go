@@ -8,6 +8,9 @@ const (
 	DefaultDexServerAddr = "http://argocd-dex-server:5556"
 	// DefaultRedisAddr is the default redis address
 	DefaultRedisAddr = "argocd-redis:6379"
+	// DefaultAppControllerMetricsAddr is the HTTP address of the metrics server of the application controller, which
+	// also serves its profiles
+	DefaultAppControllerMetricsAddr = "http://argocd-metrics:8082"
 )
 
 // Kubernetes ConfigMap and Secret resource names which hold Argo CD settings
@@ -19,6 +22,12 @@ const (
 	ArgoCDKnownHostsConfigMapName = "argocd-ssh-known-hosts-cm"
 	// Contains TLS certificate data for connecting repositories. Will get mounted as volume to pods
 	ArgoCDTLSCertsConfigMapName = "argocd-tls-certs-cm"
+	// Contains the externally issued certificate of the API server, e.g. by cert-manager. Takes precedence over the certificate of argocd-secret
+	ArgoCDServerTLSSecretName = "argocd-server-tls"
+	// Contains the CA which issues the certificates of the connections between Argo CD components
+	ArgoCDInternalCASecretName = "argocd-internal-ca"
+	// Contains the certificate of the connections between Argo CD components and its CA. Will get mounted as volume to pods
+	ArgoCDInternalTLSSecretName = "argocd-internal-tls"
 )
 
 // Default system namespace
@@ -45,6 +54,10 @@ const (
 	DefaultPathSSHConfig = "/app/config/ssh"
 	// Default name for the SSH known hosts file
 	DefaultSSHKnownHostsName = "ssh_known_hosts"
+	// The default path where the SOPS key sets of the repo server are located
+	DefaultPathSOPSKeys = "/app/config/sops"
+	// The default path of the token which authenticates the profiling requests of the API server to the repo server
+	DefaultPathRepoServerProfilerToken = "/app/config/profiler/token"
 )
 
 // Argo CD application related constants
@@ -61,10 +74,19 @@ const (
 	AuthCookieName = "argocd.token"
 	// RevisionHistoryLimit is the max number of successful sync to keep in history
 	RevisionHistoryLimit = 10
+	// DefaultRevisionMessageLength is the default length which the commit messages of revision metadata are truncated to
+	DefaultRevisionMessageLength = 64
 	// K8sClientConfigQPS controls the QPS to be used in K8s REST client configs
 	K8sClientConfigQPS = 25
 	// K8sClientConfigBurst controls the burst to be used in K8s REST client configs
 	K8sClientConfigBurst = 50
+	// ArgoCDGitUserName is the name of the committer of commits which Argo CD writes back to git
+	ArgoCDGitUserName = "Argo CD"
+	// ArgoCDGitUserEmail is the email of the committer of commits which Argo CD writes back to git
+	ArgoCDGitUserEmail = "argo-cd@argoproj.io"
+	// DefaultParameterOverridesFile is the default name of the file in the application path which holds the
+	// parameter overrides written back to git
+	DefaultParameterOverridesFile = ".argocd-source.yaml"
 )
 
 // Dex related constants
@@ -112,10 +134,26 @@ const (
 	// AnnotationKeyRefresh is the annotation key which indicates that app needs to be refreshed. Removed by application controller after app is refreshed.
 	// Might take values 'normal'/'hard'. Value 'hard' means manifest cache and target cluster state cache should be invalidated before refresh.
 	AnnotationKeyRefresh = "argocd.argoproj.io/refresh"
+	// AnnotationKeyReconciliationTimeout overrides the interval between periodic reconciliations of an application, e.g. '10m'
+	AnnotationKeyReconciliationTimeout = "argocd.argoproj.io/reconciliation-timeout"
+	// AnnotationKeyProgressingTimeout overrides the duration after which the resources of an application which stay
+	// progressing are reported degraded, e.g. '15m'
+	AnnotationKeyProgressingTimeout = "argocd.argoproj.io/progressing-timeout"
+	// AnnotationKeyManifestGeneratePaths is a semicolon-separated list of the paths, in addition to the source path, which
+	// the manifests of an application are generated from. Relative paths are relative to the source path and paths
+	// starting with '/' are relative to the root of the repository.
+	AnnotationKeyManifestGeneratePaths = "argocd.argoproj.io/manifest-generate-paths"
 	// AnnotationKeyManagedBy is annotation name which indicates that k8s resource is managed by an application.
 	AnnotationKeyManagedBy = "managed-by"
 	// AnnotationValueManagedByArgoCD is a 'managed-by' annotation value for resources managed by Argo CD
 	AnnotationValueManagedByArgoCD = "argocd.argoproj.io"
+	// AnnotationKeyExternalLink is the annotation key of a URL which is included in the external URLs of the application
+	AnnotationKeyExternalLink = "argocd.argoproj.io/external-link"
+	// AnnotationKeyProjectTemplate is the annotation key which records the template a project was created from
+	AnnotationKeyProjectTemplate = "argocd.argoproj.io/project-template"
+	// AnnotationKeyLinkPrefix is the prefix of annotation keys of links to external pages, such as dashboards or runbooks.
+	// The remainder of the key is the title of the link.
+	AnnotationKeyLinkPrefix = "link.argocd.argoproj.io/"
 	// ResourcesFinalizerName the finalizer value which we inject to finalize deletion of an application
 	ResourcesFinalizerName = "resources-finalizer.argocd.argoproj.io"
 )
@@ -133,8 +171,12 @@ const (
 	EnvVarSSHDataPath = "ARGOCD_SSH_DATA_PATH"
 	// Overrides the location where TLS certificate for repo access data is stored
 	EnvVarTLSDataPath = "ARGOCD_TLS_DATA_PATH"
+	// Overrides the location where the SOPS key sets of the repo server are stored
+	EnvVarSOPSKeysPath = "ARGOCD_SOPS_KEYS_PATH"
 	// Specifies number of git remote operations attempts count
 	EnvGitAttemptsCount = "ARGOCD_GIT_ATTEMPTS_COUNT"
+	// Specifies the maximum total size of the LFS objects of a revision, e.g. 500Mi
+	EnvGitLFSMaxSize = "ARGOCD_GIT_LFS_MAX_SIZE"
 )
 
 const (
@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"hash/fnv"
 	"math"
+	"net/http"
 	"reflect"
 	"runtime/debug"
 	"strings"
@@ -12,11 +14,13 @@ import (
 	"time"
 
 	log "github.com/sirupsen/logrus"
+	"github.com/yudai/gojsondiff"
 	"golang.org/x/sync/semaphore"
 	v1 "k8s.io/api/core/v1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -41,13 +45,18 @@ import (
 	"github.com/argoproj/argo-cd/util/db"
 	"github.com/argoproj/argo-cd/util/diff"
 	"github.com/argoproj/argo-cd/util/kube"
+	"github.com/argoproj/argo-cd/util/profile"
 	settings_util "github.com/argoproj/argo-cd/util/settings"
+	"github.com/argoproj/argo-cd/util/text"
+	tlsutil "github.com/argoproj/argo-cd/util/tls"
 )
 
 const (
 	updateOperationStateTimeout = 1 * time.Second
 	// orphanedIndex contains application which monitor orphaned resources by namespace
 	orphanedIndex = "orphaned"
+	// statusMessageLimit is the maximum length of the messages of operation results and conditions kept in the status
+	statusMessageLimit = 2048
 )
 
 type CompareWith int
@@ -73,7 +82,7 @@ type ApplicationController struct {
 	kubectl                   kube.Kubectl
 	applicationClientset      appclientset.Interface
 	auditLogger               *argo.AuditLogger
-	appRefreshQueue           workqueue.RateLimitingInterface
+	appRefreshQueue           *refreshQueue
 	appOperationQueue         workqueue.RateLimitingInterface
 	appInformer               cache.SharedIndexInformer
 	appLister                 applisters.ApplicationLister
@@ -118,7 +127,7 @@ func NewApplicationController(
 		kubectl:                   kubectl,
 		applicationClientset:      applicationClientset,
 		repoClientset:             repoClientset,
-		appRefreshQueue:           workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
+		appRefreshQueue:           newRefreshQueue(workqueue.DefaultControllerRateLimiter),
 		appOperationQueue:         workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
 		db:                        db,
 		statusRefreshTimeout:      appResyncPeriod,
@@ -143,7 +152,7 @@ func NewApplicationController(
 		return err
 	})
 	stateCache := statecache.NewLiveStateCache(db, appInformer, ctrl.settingsMgr, kubectl, ctrl.metricsServer, ctrl.handleObjectUpdated)
-	appStateManager := NewAppStateManager(db, applicationClientset, repoClientset, namespace, kubectl, ctrl.settingsMgr, stateCache, projInformer, ctrl.metricsServer)
+	appStateManager := NewAppStateManager(db, applicationClientset, repoClientset, namespace, kubectl, ctrl.settingsMgr, stateCache, projInformer, ctrl.metricsServer, ctrl.handleAnalysisWebhookCompleted)
 	ctrl.appInformer = appInformer
 	ctrl.appLister = appLister
 	ctrl.projInformer = projInformer
@@ -183,6 +192,20 @@ func (ctrl *ApplicationController) getAppProj(app *appv1.Application) (*appv1.Ap
 	return argo.GetAppProject(&app.Spec, applisters.NewAppProjectLister(ctrl.projInformer.GetIndexer()), ctrl.namespace)
 }
 
+// getAppProjOrNil returns the project of the application, or nil if it does not exist
+func (ctrl *ApplicationController) getAppProjOrNil(app *appv1.Application) *appv1.AppProject {
+	proj, err := ctrl.getAppProj(app)
+	if err != nil {
+		return nil
+	}
+	return proj
+}
+
+// handleAnalysisWebhookCompleted requeues the operation of the application whose analysis webhook responded
+func (ctrl *ApplicationController) handleAnalysisWebhookCompleted(appName string) {
+	ctrl.appOperationQueue.Add(ctrl.namespace + "/" + appName)
+}
+
 func (ctrl *ApplicationController) handleObjectUpdated(managedByApp map[string]bool, ref v1.ObjectReference) {
 	// if namespaced resource is not managed by any app it might be orphaned resource of some other apps
 	if len(managedByApp) == 0 && ref.Namespace != "" {
@@ -218,7 +241,7 @@ func (ctrl *ApplicationController) handleObjectUpdated(managedByApp map[string]b
 			}
 			ctrl.requestAppRefresh(appName, level)
 		}
-		ctrl.appRefreshQueue.Add(fmt.Sprintf("%s/%s", ctrl.namespace, appName))
+		ctrl.appRefreshQueue.AddRateLimited(fmt.Sprintf("%s/%s", ctrl.namespace, appName), refreshPriorityLow)
 	}
 }
 
@@ -345,7 +368,11 @@ func (ctrl *ApplicationController) managedResources(comparisonResult *comparison
 		live := res.Live
 		resDiff := res.Diff
 		if res.Kind == kube.SecretKind && res.Group == "" {
-			var err error
+			added, removed, modified, err := diff.SecretKeyChanges(res.Target, res.Live, comparisonResult.diffNormalizer)
+			if err != nil {
+				return nil, err
+			}
+			item.SecretKeyChanges = &appv1.SecretKeyChanges{Added: added, Removed: removed, Modified: modified}
 			target, live, err = diff.HideSecretData(res.Target, res.Live)
 			if err != nil {
 				return nil, err
@@ -377,6 +404,15 @@ func (ctrl *ApplicationController) managedResources(comparisonResult *comparison
 			return nil, err
 		}
 		item.Diff = jsonDiff
+		if resDiff.Modified && target != nil && live != nil {
+			predicted := live.DeepCopy()
+			gojsondiff.New().ApplyPatch(predicted.Object, resDiff.Diff)
+			data, err := json.Marshal(predicted)
+			if err != nil {
+				return nil, err
+			}
+			item.PredictedLiveState = string(data)
+		}
 
 		items[i] = &item
 	}
@@ -384,7 +420,46 @@ func (ctrl *ApplicationController) managedResources(comparisonResult *comparison
 }
 
 // Run starts the Application CRD controller.
+// RegisterProfiler serves the profiles of the controller on the metrics server. The profiler is controlled by the API
+// server, which authenticates using a token derived from the server secret. With internal certificates, the profiles
+// are only served to clients with a certificate of the internal CA.
+func (ctrl *ApplicationController) RegisterProfiler(profiler *profile.Profiler) {
+	var profiles, control http.Handler = profiler, profile.NewControlHandler(profiler, func() (string, error) {
+		argoSettings, err := ctrl.settingsMgr.GetSettings()
+		if err != nil {
+			return "", err
+		}
+		return argoSettings.ProfilerToken(), nil
+	})
+	if ctrl.metricsServer.TLSConfig != nil {
+		profiles, control = tlsutil.RequireClientCert(profiles), tlsutil.RequireClientCert(control)
+	}
+	ctrl.metricsServer.Handle(profile.PathPrefix, profiles)
+	ctrl.metricsServer.Handle(profile.ControlPathPrefix, control)
+}
+
+// UseInternalCerts serves the metrics port over TLS with the internal certificates. Metrics and health checks remain
+// available to clients without a certificate, such as Prometheus. Must be called before RegisterProfiler.
+func (ctrl *ApplicationController) UseInternalCerts(certs *tlsutil.InternalCerts) {
+	ctrl.metricsServer.TLSConfig = certs.OptionalClientCertServerConfig()
+}
+
+// serveMetrics serves the metrics port, over TLS if the controller uses internal certificates
+func (ctrl *ApplicationController) serveMetrics() error {
+	if ctrl.metricsServer.TLSConfig != nil {
+		return ctrl.metricsServer.ListenAndServeTLS("", "")
+	}
+	return ctrl.metricsServer.ListenAndServe()
+}
+
 func (ctrl *ApplicationController) Run(ctx context.Context, statusProcessors int, operationProcessors int) {
+	go func() { errors.CheckError(ctrl.serveMetrics()) }()
+	ctrl.runProcessors(ctx, statusProcessors, operationProcessors)
+}
+
+// runProcessors runs the informers, the cluster cache and the processors of the refresh and operation queues until
+// the context is cancelled
+func (ctrl *ApplicationController) runProcessors(ctx context.Context, statusProcessors int, operationProcessors int) {
 	defer runtime.HandleCrash()
 	defer ctrl.appRefreshQueue.ShutDown()
 
@@ -397,7 +472,13 @@ func (ctrl *ApplicationController) Run(ctx context.Context, statusProcessors int
 	}
 
 	go func() { errors.CheckError(ctrl.stateCache.Run(ctx)) }()
-	go func() { errors.CheckError(ctrl.metricsServer.ListenAndServe()) }()
+
+	// the API server reports the version of the controller, which it reads from the cache
+	go wait.Until(func() {
+		if err := ctrl.cache.SetControllerVersion(common.GetVersion().Version); err != nil {
+			log.Warnf("Failed to cache the controller version: %v", err)
+		}
+	}, 10*time.Minute, ctx.Done())
 
 	for i := 0; i < statusProcessors; i++ {
 		go wait.Until(func() {
@@ -476,8 +557,10 @@ func (ctrl *ApplicationController) processAppOperationQueueItem() (processNext b
 	return
 }
 
+// shouldBeDeleted returns whether the object is deleted along with the application. CRDs, and in particular the CRDs of
+// Argo CD whose deletion would take every application with them, are never deleted.
 func shouldBeDeleted(app *appv1.Application, obj *unstructured.Unstructured) bool {
-	return !kube.IsCRD(obj) && !isSelfReferencedApp(app, kube.GetObjectRef(obj))
+	return !kube.IsCRD(obj) && !argo.IsArgoCDCRD(obj) && !isSelfReferencedApp(app, kube.GetObjectRef(obj))
 }
 
 func (ctrl *ApplicationController) finalizeApplicationDeletion(app *appv1.Application) error {
@@ -538,6 +621,10 @@ func (ctrl *ApplicationController) finalizeApplicationDeletion(app *appv1.Applic
 	if err != nil {
 		return err
 	}
+	err = ctrl.cache.SetAppSyncReports(app.Name, nil)
+	if err != nil {
+		return err
+	}
 	app.SetCascadedDeletion(false)
 	var patch []byte
 	patch, _ = json.Marshal(map[string]interface{}{
@@ -574,7 +661,7 @@ func (ctrl *ApplicationController) setAppCondition(app *appv1.Application, condi
 		},
 	})
 	if err == nil {
-		_, err = ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Patch(app.Name, types.MergePatchType, patch)
+		_, err = ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Patch(app.Name, types.MergePatchType, patch, "status")
 	}
 	if err != nil {
 		log.Errorf("Unable to set application condition: %v", err)
@@ -651,6 +738,24 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 	}
 }
 
+// compactOperationState truncates the messages of an operation state, which can be as long as the output of kubectl
+// for large manifests, so that the application does not grow beyond the size limit of etcd
+func compactOperationState(state *appv1.OperationState) {
+	state.Message = text.Trunc(state.Message, statusMessageLimit)
+	if state.SyncResult != nil {
+		for _, res := range state.SyncResult.Resources {
+			res.Message = text.Trunc(res.Message, statusMessageLimit)
+		}
+	}
+}
+
+// compactConditions truncates the messages of application conditions
+func compactConditions(conditions []appv1.ApplicationCondition) {
+	for i := range conditions {
+		conditions[i].Message = text.Trunc(conditions[i].Message, statusMessageLimit)
+	}
+}
+
 func (ctrl *ApplicationController) setOperationState(app *appv1.Application, state *appv1.OperationState) {
 	util.RetryUntilSucceed(func() error {
 		if state.Phase == "" {
@@ -661,26 +766,27 @@ func (ctrl *ApplicationController) setOperationState(app *appv1.Application, sta
 			now := metav1.Now()
 			state.FinishedAt = &now
 		}
-		patch := map[string]interface{}{
-			"status": map[string]interface{}{
-				"operationState": state,
-			},
-		}
-		if state.Phase.Completed() {
-			// If operation is completed, clear the operation field to indicate no operation is
-			// in progress.
-			patch["operation"] = nil
-		}
-		if reflect.DeepEqual(app.Status.OperationState, state) {
+		compactOperationState(state)
+		// If operation is completed, the operation field is cleared to indicate no operation is in progress. The
+		// operation state is updated first, so that a completed operation is never started again.
+		clearOperation := state.Phase.Completed() && app.Operation != nil
+		if reflect.DeepEqual(app.Status.OperationState, state) && !clearOperation {
 			log.Infof("No operation updates necessary to '%s'. Skipping patch", app.Name)
 			return nil
 		}
-		patchJSON, err := json.Marshal(patch)
+		patchJSON, err := json.Marshal(map[string]interface{}{
+			"status": map[string]interface{}{
+				"operationState": state,
+			},
+		})
 		if err != nil {
 			return err
 		}
 		appClient := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(ctrl.namespace)
-		_, err = appClient.Patch(app.Name, types.MergePatchType, patchJSON)
+		_, err = appClient.Patch(app.Name, types.MergePatchType, patchJSON, "status")
+		if err == nil && clearOperation {
+			_, err = appClient.Patch(app.Name, types.MergePatchType, []byte(`{"operation":null}`))
+		}
 		if err != nil {
 			// Stop retrying updating deleted application
 			if apierr.IsNotFound(err) {
@@ -709,26 +815,33 @@ func (ctrl *ApplicationController) setOperationState(app *appv1.Application, sta
 			}
 			ctrl.auditLogger.LogAppEvent(app, eventInfo, strings.Join(messages, " "))
 			ctrl.metricsServer.IncSync(app, state)
+			if state.Operation.Sync != nil {
+				if err := ctrl.cache.AddAppSyncReport(app.Name, newSyncReport(app, state)); err != nil {
+					log.Warnf("Failed to save sync report of '%s': %v", app.Name, err)
+				}
+			}
 		}
 		return nil
 	}, "Update application operation state", context.Background(), updateOperationStateTimeout)
 }
 
 func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext bool) {
-	appKey, shutdown := ctrl.appRefreshQueue.Get()
+	appKey, priority, latency, shutdown := ctrl.appRefreshQueue.Get()
 	if shutdown {
 		processNext = false
 		return
 	}
 	processNext = true
+	ctrl.metricsServer.ObserveRefreshQueueLatency(priority.String(), latency)
 	defer func() {
 		if r := recover(); r != nil {
 			log.Errorf("Recovered from panic: %+v\n%s", r, debug.Stack())
 		}
+		ctrl.appRefreshQueue.Forget(appKey)
 		ctrl.appRefreshQueue.Done(appKey)
 	}()
 
-	obj, exists, err := ctrl.appInformer.GetIndexer().GetByKey(appKey.(string))
+	obj, exists, err := ctrl.appInformer.GetIndexer().GetByKey(appKey)
 	if err != nil {
 		log.Errorf("Failed to get application '%s' from informer index: %+v", appKey, err)
 		return
@@ -742,11 +855,19 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		log.Warnf("Key '%s' in index is not an application", appKey)
 		return
 	}
-	needRefresh, refreshType, comparisonLevel := ctrl.needRefreshAppStatus(origApp, ctrl.statusRefreshTimeout)
+	refreshTimeout := ctrl.getAppRefreshTimeout(origApp)
+	needRefresh, refreshType, comparisonLevel := ctrl.needRefreshAppStatus(origApp, refreshTimeout)
 
 	if !needRefresh {
+		if origApp.Status.ReconciledAt != nil {
+			// the informer resync might not happen before the reconciliation of the app expires, so it is scheduled explicitly
+			ctrl.appRefreshQueue.AddAfter(appKey, refreshPriorityLow, time.Until(origApp.Status.ReconciledAt.Add(refreshTimeout)))
+		}
 		return
 	}
+	if _, requested := origApp.IsRefreshRequested(); requested {
+		ctrl.metricsServer.IncRefresh(origApp, refreshType)
+	}
 
 	startTime := time.Now()
 	defer func() {
@@ -765,7 +886,9 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 			if tree, err := ctrl.getResourceTree(app, managedResources); err != nil {
 				app.Status.Conditions = []appv1.ApplicationCondition{{Type: appv1.ApplicationConditionComparisonError, Message: err.Error()}}
 			} else {
+				renderedSources := app.Status.Summary.RenderedSources
 				app.Status.Summary = tree.GetSummary()
+				app.Status.Summary.RenderedSources = renderedSources
 				if err = ctrl.cache.SetAppResourcesTree(app.Name, tree); err != nil {
 					logCtx.Errorf("Failed to cache resources tree: %v", err)
 					return
@@ -801,15 +924,26 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 	ctrl.normalizeApplication(origApp, app)
 
 	app.Status.Conditions = append(app.Status.Conditions, compareResult.conditions...)
+	app.Status.Conditions = append(app.Status.Conditions, ctrl.getResourceConflictConditions(app, compareResult.resources)...)
 
 	tree, err := ctrl.setAppManagedResources(app, compareResult)
 	if err != nil {
 		logCtx.Errorf("Failed to cache app resources: %v", err)
 	} else {
+		renderedSources := app.Status.Summary.RenderedSources
 		app.Status.Summary = tree.GetSummary()
+		// the rendered sources are only known if the manifests were generated by the repo server
+		if compareResult.appSourceType != "" {
+			renderedSources = compareResult.renderedSources
+		}
+		app.Status.Summary.RenderedSources = renderedSources
 	}
 
-	syncErrCond := ctrl.autoSync(app, compareResult.syncStatus, compareResult.resources)
+	var syncErrCond *appv1.ApplicationCondition
+	// an automated rollback is an operation of its own, so no automated sync is attempted at the same time
+	if rolledBack := ctrl.verifySync(app, compareResult.healthStatus); !rolledBack {
+		syncErrCond = ctrl.autoSync(app, compareResult.syncStatus, compareResult.resources)
+	}
 	if syncErrCond != nil {
 		app.Status.SetConditions([]appv1.ApplicationCondition{*syncErrCond}, map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionSyncError: true})
 	} else {
@@ -826,6 +960,75 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 	return
 }
 
+// getResourceConflictConditions returns a warning for each resource of the application which is also claimed by
+// another application, unless the comparison has already reported it
+func (ctrl *ApplicationController) getResourceConflictConditions(app *appv1.Application, resources []appv1.ResourceStatus) []appv1.ApplicationCondition {
+	apps, err := ctrl.appLister.Applications(ctrl.namespace).List(labels.Everything())
+	if err != nil {
+		log.Warnf("Failed to list applications to detect resource conflicts: %v", err)
+		return nil
+	}
+	current := app.DeepCopy()
+	current.Status.Resources = resources
+	claimants := []*appv1.Application{current}
+	for i := range apps {
+		if apps[i].Name != app.Name {
+			claimants = append(claimants, apps[i])
+		}
+	}
+	conditions := make([]appv1.ApplicationCondition, 0)
+	for _, conflict := range argo.GetResourceConflicts(claimants) {
+		otherApp, ok := conflict.GetConflictingApplication(app.Name)
+		if !ok {
+			continue
+		}
+		condition := appv1.ApplicationCondition{
+			Type:    appv1.ApplicationConditionSharedResourceWarning,
+			Message: fmt.Sprintf("%s/%s is part of a different application: %s", conflict.Kind, conflict.Name, otherApp),
+		}
+		reported := false
+		for _, existing := range app.Status.Conditions {
+			if existing == condition {
+				reported = true
+				break
+			}
+		}
+		if !reported {
+			conditions = append(conditions, condition)
+		}
+	}
+	return conditions
+}
+
+// getAppRefreshTimeout returns the interval between periodic reconciliations of the application. The interval is taken
+// from the application annotation, the argocd-cm ConfigMap or the --app-resync flag, in this order, and is extended by a
+// jitter which is derived from the application name, so that applications created at the same time are spread out.
+func (ctrl *ApplicationController) getAppRefreshTimeout(app *appv1.Application) time.Duration {
+	logCtx := log.WithFields(log.Fields{"application": app.Name})
+	timeout := ctrl.statusRefreshTimeout
+	if configured, err := ctrl.settingsMgr.GetReconciliationTimeout(); err != nil {
+		logCtx.Warnf("Failed to get reconciliation timeout: %v", err)
+	} else if configured > 0 {
+		timeout = configured
+	}
+	if value, ok := app.Annotations[common.AnnotationKeyReconciliationTimeout]; ok {
+		if override, err := time.ParseDuration(value); err != nil || override <= 0 {
+			logCtx.Warnf("Ignoring invalid %s annotation '%s'", common.AnnotationKeyReconciliationTimeout, value)
+		} else {
+			timeout = override
+		}
+	}
+	jitter, err := ctrl.settingsMgr.GetReconciliationJitter()
+	if err != nil {
+		logCtx.Warnf("Failed to get reconciliation jitter: %v", err)
+	} else if jitter > 0 {
+		hash := fnv.New32a()
+		_, _ = hash.Write([]byte(app.Namespace + "/" + app.Name))
+		timeout += time.Duration(float64(jitter) * float64(hash.Sum32()) / math.MaxUint32)
+	}
+	return timeout
+}
+
 // needRefreshAppStatus answers if application status needs to be refreshed.
 // Returns true if application never been compared, has changed or comparison result has expired.
 // Additionally returns whether full refresh was requested or not.
@@ -884,13 +1087,26 @@ func (ctrl *ApplicationController) refreshAppConditions(app *appv1.Application)
 			errorConditions = append(errorConditions, specConditions...)
 		}
 	}
+	apps, err := ctrl.appLister.Applications(ctrl.namespace).List(labels.Everything())
+	if err != nil {
+		errorConditions = append(errorConditions, appv1.ApplicationCondition{
+			Type:    appv1.ApplicationConditionUnknownError,
+			Message: err.Error(),
+		})
+	} else {
+		errorConditions = append(errorConditions, argo.ValidateHelmReleaseName(app, apps)...)
+	}
 	app.Status.SetConditions(errorConditions, map[appv1.ApplicationConditionType]bool{
-		appv1.ApplicationConditionInvalidSpecError:        true,
-		appv1.ApplicationConditionUnknownError:            true,
-		appv1.ApplicationConditionComparisonError:         true,
-		appv1.ApplicationConditionSharedResourceWarning:   true,
-		appv1.ApplicationConditionRepeatedResourceWarning: true,
-		appv1.ApplicationConditionExcludedResourceWarning: true,
+		appv1.ApplicationConditionInvalidSpecError:            true,
+		appv1.ApplicationConditionUnknownError:                true,
+		appv1.ApplicationConditionComparisonError:             true,
+		appv1.ApplicationConditionSharedResourceWarning:       true,
+		appv1.ApplicationConditionRepeatedResourceWarning:     true,
+		appv1.ApplicationConditionExcludedResourceWarning:     true,
+		appv1.ApplicationConditionArgoCDPruneWarning:          true,
+		appv1.ApplicationConditionIncompleteComparisonWarning: true,
+		appv1.ApplicationConditionManifestQuotaError:          true,
+		appv1.ApplicationConditionStaleManifestsWarning:       true,
 	})
 	return len(errorConditions) > 0
 }
@@ -924,41 +1140,72 @@ func (ctrl *ApplicationController) persistAppStatus(orig *appv1.Application, new
 		message := fmt.Sprintf("Updated health status: %s -> %s", orig.Status.Health.Status, newStatus.Health.Status)
 		ctrl.auditLogger.LogAppEvent(orig, argo.EventInfo{Reason: argo.EventReasonResourceUpdated, Type: v1.EventTypeNormal}, message)
 	}
-	var newAnnotations map[string]string
-	if orig.GetAnnotations() != nil {
-		newAnnotations = make(map[string]string)
-		for k, v := range orig.GetAnnotations() {
-			newAnnotations[k] = v
-		}
-		delete(newAnnotations, common.AnnotationKeyRefresh)
-	}
-	patch, modified, err := diff.CreateTwoWayMergePatch(
-		&appv1.Application{ObjectMeta: metav1.ObjectMeta{Annotations: orig.GetAnnotations()}, Status: orig.Status},
-		&appv1.Application{ObjectMeta: metav1.ObjectMeta{Annotations: newAnnotations}, Status: *newStatus}, appv1.Application{})
+	compactConditions(newStatus.Conditions)
+	appClient := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(orig.Namespace)
+	// the status is patched through the status subresource, so that the patch does not conflict with changes of the
+	// spec, and only the changed fields are sent
+	patch, modified, err := diff.CreateTwoWayMergePatch(&appv1.Application{Status: orig.Status}, &appv1.Application{Status: *newStatus}, appv1.Application{})
 	if err != nil {
 		logCtx.Errorf("Error constructing app status patch: %v", err)
 		return
 	}
-	if !modified {
+	if modified {
+		logCtx.Debugf("patch: %s", string(patch))
+		_, err = appClient.Patch(orig.Name, types.MergePatchType, patch, "status")
+		if err != nil {
+			logCtx.Warnf("Error updating application: %v", err)
+			return
+		}
+		logCtx.Infof("Update successful")
+	} else {
 		logCtx.Infof("No status changes. Skipping patch")
-		return
 	}
-	logCtx.Debugf("patch: %s", string(patch))
-	appClient := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(orig.Namespace)
-	_, err = appClient.Patch(orig.Name, types.MergePatchType, patch)
-	if err != nil {
-		logCtx.Warnf("Error updating application: %v", err)
-	} else {
-		logCtx.Infof("Update successful")
+	if _, ok := orig.GetAnnotations()[common.AnnotationKeyRefresh]; ok {
+		patch, err := json.Marshal(map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"annotations": map[string]interface{}{
+					common.AnnotationKeyRefresh: nil,
+				},
+			},
+		})
+		if err == nil {
+			_, err = appClient.Patch(orig.Name, types.MergePatchType, patch)
+		}
+		if err != nil {
+			logCtx.Warnf("Error removing refresh annotation: %v", err)
+		}
 	}
 }
 
 // autoSync will initiate a sync operation for an application configured with automated sync
 func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *appv1.SyncStatus, resources []appv1.ResourceStatus) *appv1.ApplicationCondition {
-	if app.Spec.SyncPolicy == nil || app.Spec.SyncPolicy.Automated == nil {
+	logCtx := log.WithFields(log.Fields{"application": app.Name})
+	proj, err := ctrl.getAppProj(app)
+	if err != nil {
+		logCtx.Warnf("Failed to load application project, using the application sync policy only: %v", err)
+	}
+	syncPolicy := app.Spec.GetSyncPolicy(proj)
+	if syncPolicy == nil || syncPolicy.Automated == nil {
+		return nil
+	}
+	if now := time.Now(); app.Spec.IsSuspended(now) {
+		logCtx.Infof("Skipping auto-sync: application is suspended")
+		// resume the automated sync as soon as the suspension expires
+		if app.Spec.Suspend.Until != nil {
+			if key, err := cache.MetaNamespaceKeyFunc(app); err == nil {
+				ctrl.requestAppRefresh(app.Name, CompareWithRecent)
+				ctrl.appRefreshQueue.AddAfter(key, refreshPriorityLow, app.Spec.Suspend.Until.Sub(now))
+			}
+		}
+		return nil
+	}
+	// operations which are in progress are finished, only new automated syncs are paused
+	if maintenance, err := ctrl.settingsMgr.GetMaintenanceMode(); err != nil {
+		logCtx.Warnf("Failed to load maintenance mode: %v", err)
+	} else if maintenance.Enabled {
+		logCtx.Infof("Skipping auto-sync: Argo CD is in maintenance mode")
 		return nil
 	}
-	logCtx := log.WithFields(log.Fields{"application": app.Name})
 	if app.Operation != nil {
 		logCtx.Infof("Skipping auto-sync: another operation is in progress")
 		return nil
@@ -975,13 +1222,20 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 	}
 
 	desiredCommitSHA := syncStatus.Revision
+	if rollback := lastAutomatedRollback(app); rollback != nil && rollback.FromRevision == desiredCommitSHA {
+		// syncing the revision again would only fail the verification again and roll back in a loop
+		logCtx.Warnf("Skipping auto-sync: %s was rolled back", desiredCommitSHA)
+		message := fmt.Sprintf("Skipping sync to %s, which was rolled back: %s", desiredCommitSHA, rollback.Reason)
+		return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionSyncError, Message: message}
+	}
 	alreadyAttempted, attemptPhase := alreadyAttemptedSync(app, desiredCommitSHA)
-	selfHeal := app.Spec.SyncPolicy.Automated.SelfHeal
+	selfHeal := syncPolicy.Automated.SelfHeal
 	op := appv1.Operation{
 		Sync: &appv1.SyncOperation{
 			Revision: desiredCommitSHA,
-			Prune:    app.Spec.SyncPolicy.Automated.Prune,
+			Prune:    syncPolicy.Automated.Prune,
 		},
+		InitiatedBy: appv1.OperationInitiator{Automated: true},
 	}
 	// It is possible for manifests to remain OutOfSync even after a sync/kubectl apply (e.g.
 	// auto-sync with pruning disabled). We need to ensure that we do not keep Syncing an
@@ -1010,7 +1264,7 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 			logCtx.Infof("Skipping auto-sync: already attempted sync to %s with timeout %v (retrying in %v)", desiredCommitSHA, ctrl.selfHealTimeout, retryAfter)
 			if key, err := cache.MetaNamespaceKeyFunc(app); err == nil {
 				ctrl.requestAppRefresh(app.Name, CompareWithLatest)
-				ctrl.appRefreshQueue.AddAfter(key, retryAfter)
+				ctrl.appRefreshQueue.AddAfter(key, refreshPriorityLow, retryAfter)
 			} else {
 				logCtx.Warnf("Fails to requeue application: %v", err)
 			}
@@ -1020,7 +1274,7 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 	}
 
 	appIf := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace)
-	_, err := argo.SetAppOperation(appIf, app.Name, &op)
+	_, err = argo.SetAppOperation(appIf, app.Name, &op)
 	if err != nil {
 		logCtx.Errorf("Failed to initiate auto-sync to %s: %v", desiredCommitSHA, err)
 		return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionSyncError, Message: err.Error()}
@@ -1031,6 +1285,132 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 	return nil
 }
 
+// lastAutomatedRollback returns the automated rollback which deployed the current revision of the application, if any
+func lastAutomatedRollback(app *appv1.Application) *appv1.AutomatedRollback {
+	if len(app.Status.History) == 0 {
+		return nil
+	}
+	return app.Status.History[len(app.Status.History)-1].AutomatedRollback
+}
+
+// verifySync verifies that the application becomes healthy after its last sync, within the timeout of its verify
+// policy. If it does not and the policy says so, the application is rolled back to the previously deployed revision.
+// Returns true if a rollback was initiated.
+func (ctrl *ApplicationController) verifySync(app *appv1.Application, health *appv1.HealthStatus) bool {
+	logCtx := log.WithFields(log.Fields{"application": app.Name})
+	proj, err := ctrl.getAppProj(app)
+	if err != nil {
+		logCtx.Warnf("Failed to load application project, using the application sync policy only: %v", err)
+	}
+	syncPolicy := app.Spec.GetSyncPolicy(proj)
+	if syncPolicy == nil || syncPolicy.Verify == nil || app.Operation != nil {
+		return false
+	}
+	// only syncs which deploy the whole application are verified, and rollbacks are never rolled back themselves.
+	// Syncs which failed because their post-sync analysis failed are verified too, so that they can be rolled back.
+	opState := app.Status.OperationState
+	if opState == nil || opState.FinishedAt == nil || opState.SyncResult == nil {
+		return false
+	}
+	analysis := opState.SyncResult.Analysis
+	analysisFailed := opState.Phase == appv1.OperationFailed && analysis != nil && !analysis.Phase.Successful()
+	if !opState.Phase.Successful() && !analysisFailed {
+		return false
+	}
+	if syncOp := opState.Operation.Sync; syncOp == nil || syncOp.DryRun || len(syncOp.Resources) > 0 || syncOp.AutomatedRollback != nil {
+		return false
+	}
+
+	now := time.Now()
+	verification := app.Status.Verification
+	if verification == nil || !verification.SyncedAt.Equal(opState.FinishedAt) {
+		deadline := opState.FinishedAt.Add(syncPolicy.Verify.GetTimeout())
+		if now.After(deadline) {
+			// the sync finished before the verify policy was configured
+			return false
+		}
+		verification = &appv1.SyncVerification{
+			Phase:    appv1.SyncVerificationRunning,
+			Revision: opState.SyncResult.Revision,
+			SyncedAt: *opState.FinishedAt,
+			Deadline: metav1.NewTime(deadline),
+		}
+		app.Status.Verification = verification
+	}
+	if verification.Phase != appv1.SyncVerificationRunning {
+		return false
+	}
+	if analysisFailed {
+		verification.Phase = appv1.SyncVerificationFailed
+		verification.Message = fmt.Sprintf("Post-sync analysis of the sync to %s failed: %s", verification.Revision, analysis.Message)
+		return ctrl.rollbackVerifiedSync(app, syncPolicy.Verify, verification, app.Status.History)
+	}
+	if health.Status == appv1.HealthStatusHealthy {
+		verification.Phase = appv1.SyncVerificationSucceeded
+		verification.Message = fmt.Sprintf("Application became healthy after the sync to %s", verification.Revision)
+		ctrl.auditLogger.LogAppEvent(app, argo.EventInfo{Reason: argo.EventReasonResourceUpdated, Type: v1.EventTypeNormal}, verification.Message)
+		return false
+	}
+	if now.Before(verification.Deadline.Time) {
+		// the application is refreshed at the deadline even if none of its resources change
+		if key, err := cache.MetaNamespaceKeyFunc(app); err == nil {
+			ctrl.requestAppRefresh(app.Name, CompareWithRecent)
+			ctrl.appRefreshQueue.AddAfter(key, refreshPriorityHigh, verification.Deadline.Sub(now))
+		}
+		return false
+	}
+
+	verification.Phase = appv1.SyncVerificationFailed
+	verification.Message = fmt.Sprintf("Application did not become healthy within %v after the sync to %s: health status is %s", syncPolicy.Verify.GetTimeout(), verification.Revision, health.Status)
+	if health.Message != "" {
+		verification.Message = fmt.Sprintf("%s (%s)", verification.Message, health.Message)
+	}
+	// the sync is the last entry of the history, so the entry before it is rolled back to
+	var history []appv1.RevisionHistory
+	if len(app.Status.History) > 0 {
+		history = app.Status.History[:len(app.Status.History)-1]
+	}
+	return ctrl.rollbackVerifiedSync(app, syncPolicy.Verify, verification, history)
+}
+
+// rollbackVerifiedSync rolls the application back to the last entry of the given history after the verification of a
+// sync failed, if the verify policy asks for it. It returns true if the rollback was initiated.
+func (ctrl *ApplicationController) rollbackVerifiedSync(app *appv1.Application, verify *appv1.SyncPolicyVerify, verification *appv1.SyncVerification, history []appv1.RevisionHistory) bool {
+	logCtx := log.WithFields(log.Fields{"application": app.Name})
+	ctrl.auditLogger.LogAppEvent(app, argo.EventInfo{Reason: argo.EventReasonResourceUpdated, Type: v1.EventTypeWarning}, verification.Message)
+	if !verify.Rollback {
+		return false
+	}
+	if len(history) == 0 {
+		verification.Message = fmt.Sprintf("%s. There is no previous revision to roll back to", verification.Message)
+		return false
+	}
+	previous := history[len(history)-1]
+	source := previous.Source
+	op := appv1.Operation{
+		Sync: &appv1.SyncOperation{
+			Revision: previous.Revision,
+			Source:   &source,
+			AutomatedRollback: &appv1.AutomatedRollback{
+				FromRevision: verification.Revision,
+				Reason:       verification.Message,
+			},
+		},
+		InitiatedBy: appv1.OperationInitiator{Automated: true},
+	}
+	appIf := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace)
+	if _, err := argo.SetAppOperation(appIf, app.Name, &op); err != nil {
+		logCtx.Errorf("Failed to initiate automated rollback to %s: %v", previous.Revision, err)
+		verification.Message = fmt.Sprintf("%s. Failed to roll back: %v", verification.Message, err)
+		return false
+	}
+	verification.RolledBack = true
+	message := fmt.Sprintf("Initiated automated rollback to '%s' (history ID %d)", previous.Revision, previous.ID)
+	ctrl.auditLogger.LogAppEvent(app, argo.EventInfo{Reason: argo.EventReasonOperationStarted, Type: v1.EventTypeWarning}, message)
+	logCtx.Info(message)
+	return true
+}
+
 // alreadyAttemptedSync returns whether or not the most recent sync was performed against the
 // commitSHA and with the same app source config which are currently set in the app
 func alreadyAttemptedSync(app *appv1.Application, commitSHA string) (bool, appv1.OperationPhase) {
@@ -1063,6 +1443,15 @@ func (ctrl *ApplicationController) shouldSelfHeal(app *appv1.Application) (bool,
 	return retryAfter <= 0, retryAfter
 }
 
+// getRefreshPriority returns the tier of the refresh queue for an application. Applications with a pending operation or
+// a refresh requested by a user or a webhook take precedence over periodic refreshes.
+func getRefreshPriority(app *appv1.Application) refreshPriority {
+	if _, ok := app.IsRefreshRequested(); ok || app.Operation != nil {
+		return refreshPriorityHigh
+	}
+	return refreshPriorityLow
+}
+
 func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.SharedIndexInformer, applisters.ApplicationLister, error) {
 	appInformerFactory := appinformers.NewFilteredSharedInformerFactory(
 		ctrl.applicationClientset,
@@ -1077,7 +1466,7 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 			AddFunc: func(obj interface{}) {
 				key, err := cache.MetaNamespaceKeyFunc(obj)
 				if err == nil {
-					ctrl.appRefreshQueue.Add(key)
+					ctrl.appRefreshQueue.AddWithPriority(key, refreshPriorityHigh)
 					ctrl.appOperationQueue.Add(key)
 				}
 			},
@@ -1088,13 +1477,16 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 				}
 				oldApp, oldOK := old.(*appv1.Application)
 				newApp, newOK := new.(*appv1.Application)
+				priority := refreshPriorityLow
 				if oldOK && newOK {
-					if toggledAutomatedSync(oldApp, newApp) {
+					if toggledAutomatedSync(oldApp, newApp, ctrl.getAppProjOrNil(oldApp), ctrl.getAppProjOrNil(newApp)) {
 						log.WithField("application", newApp.Name).Info("Enabled automated sync")
 						ctrl.requestAppRefresh(newApp.Name, CompareWithLatest)
 					}
+					priority = getRefreshPriority(newApp)
 				}
-				ctrl.appRefreshQueue.Add(key)
+				// bursts of updates, e.g. by webhooks or informer resyncs, are throttled
+				ctrl.appRefreshQueue.AddRateLimited(key, priority)
 				ctrl.appOperationQueue.Add(key)
 			},
 			DeleteFunc: func(obj interface{}) {
@@ -1131,14 +1523,16 @@ func isOperationInProgress(app *appv1.Application) bool {
 	return app.Status.OperationState != nil && !app.Status.OperationState.Phase.Completed()
 }
 
-// toggledAutomatedSync tests if an app went from auto-sync disabled to enabled.
-// if it was toggled to be enabled, the informer handler will force a refresh
-func toggledAutomatedSync(old *appv1.Application, new *appv1.Application) bool {
-	if new.Spec.SyncPolicy == nil || new.Spec.SyncPolicy.Automated == nil {
+// toggledAutomatedSync tests if an app went from auto-sync disabled to enabled, taking the default sync policies of
+// the projects of the app into account. if it was toggled to be enabled, the informer handler will force a refresh
+func toggledAutomatedSync(old *appv1.Application, new *appv1.Application, oldProj *appv1.AppProject, newProj *appv1.AppProject) bool {
+	newPolicy := new.Spec.GetSyncPolicy(newProj)
+	if newPolicy == nil || newPolicy.Automated == nil {
 		return false
 	}
 	// auto-sync is enabled. check if it was previously disabled
-	if old.Spec.SyncPolicy == nil || old.Spec.SyncPolicy.Automated == nil {
+	oldPolicy := old.Spec.GetSyncPolicy(oldProj)
+	if oldPolicy == nil || oldPolicy.Automated == nil {
 		return true
 	}
 	// nothing changed
@@ -2,12 +2,19 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"strings"
 	"testing"
 	"time"
 
+	jsonpatch "github.com/evanphx/json-patch"
 	"github.com/ghodss/yaml"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
 	corev1 "k8s.io/api/core/v1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -27,6 +34,8 @@ import (
 	mockreposerver "github.com/argoproj/argo-cd/reposerver/mocks"
 	"github.com/argoproj/argo-cd/test"
 	utilcache "github.com/argoproj/argo-cd/util/cache"
+	"github.com/argoproj/argo-cd/util/diff"
+	grpc_util "github.com/argoproj/argo-cd/util/grpc"
 	"github.com/argoproj/argo-cd/util/kube"
 	"github.com/argoproj/argo-cd/util/kube/kubetest"
 	"github.com/argoproj/argo-cd/util/settings"
@@ -40,9 +49,11 @@ type namespacedResource struct {
 type fakeData struct {
 	apps                []runtime.Object
 	manifestResponse    *apiclient.ManifestResponse
+	manifestErr         error
 	managedLiveObjs     map[kube.ResourceKey]*unstructured.Unstructured
 	namespacedResources map[kube.ResourceKey]namespacedResource
 	configMapData       map[string]string
+	skippedKinds        []schema.GroupKind
 }
 
 func newFakeController(data *fakeData) *ApplicationController {
@@ -54,7 +65,7 @@ func newFakeController(data *fakeData) *ApplicationController {
 
 	// Mock out call to GenerateManifest
 	mockRepoClient := mockrepoclient.RepoServerServiceClient{}
-	mockRepoClient.On("GenerateManifest", mock.Anything, mock.Anything).Return(data.manifestResponse, nil)
+	mockRepoClient.On("GenerateManifest", mock.Anything, mock.Anything).Return(data.manifestResponse, data.manifestErr)
 	mockRepoClientset := mockreposerver.Clientset{}
 	mockRepoClientset.On("NewRepoServerClient").Return(&fakeCloser{}, &mockRepoClient, nil)
 
@@ -106,6 +117,7 @@ func newFakeController(data *fakeData) *ApplicationController {
 	ctrl.stateCache = &mockStateCache
 	mockStateCache.On("IsNamespaced", mock.Anything, mock.Anything).Return(true, nil)
 	mockStateCache.On("GetManagedLiveObjs", mock.Anything, mock.Anything).Return(data.managedLiveObjs, nil)
+	mockStateCache.On("GetSkippedKinds", mock.Anything).Return(data.skippedKinds, nil)
 	response := make(map[kube.ResourceKey]argoappv1.ResourceNode)
 	for k, v := range data.namespacedResources {
 		response[k] = v.ResourceNode
@@ -209,6 +221,216 @@ func TestAutoSync(t *testing.T) {
 	assert.NotNil(t, app.Operation)
 	assert.NotNil(t, app.Operation.Sync)
 	assert.False(t, app.Operation.Sync.Prune)
+	assert.Equal(t, argoappv1.OperationInitiator{Automated: true}, app.Operation.InitiatedBy)
+}
+
+func TestAutoSyncSuspended(t *testing.T) {
+	syncStatus := argoappv1.SyncStatus{
+		Status:   argoappv1.SyncStatusCodeOutOfSync,
+		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+	}
+
+	t.Run("Suspended", func(t *testing.T) {
+		app := newFakeApp()
+		until := metav1.NewTime(time.Now().Add(time.Hour))
+		app.Spec.Suspend = &argoappv1.ApplicationSuspend{Until: &until}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		cond := ctrl.autoSync(app, &syncStatus, []argoappv1.ResourceStatus{})
+		assert.Nil(t, cond)
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get("my-app", metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Nil(t, app.Operation)
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		app := newFakeApp()
+		until := metav1.NewTime(time.Now().Add(-time.Hour))
+		app.Spec.Suspend = &argoappv1.ApplicationSuspend{Until: &until}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		cond := ctrl.autoSync(app, &syncStatus, []argoappv1.ResourceStatus{})
+		assert.Nil(t, cond)
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get("my-app", metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.NotNil(t, app.Operation)
+	})
+}
+
+func TestAutoSyncMaintenanceMode(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}, configMapData: map[string]string{
+		"maintenance": "enabled: true\nmessage: upgrading Argo CD",
+	}})
+	syncStatus := argoappv1.SyncStatus{
+		Status:   argoappv1.SyncStatusCodeOutOfSync,
+		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+	}
+	cond := ctrl.autoSync(app, &syncStatus, []argoappv1.ResourceStatus{})
+	assert.Nil(t, cond)
+	app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get("my-app", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Nil(t, app.Operation)
+}
+
+func TestAutoSyncProjectDefault(t *testing.T) {
+	syncStatus := argoappv1.SyncStatus{
+		Status:   argoappv1.SyncStatusCodeOutOfSync,
+		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+	}
+	proj := defaultProj.DeepCopy()
+	proj.Spec.SyncPolicy = &argoappv1.SyncPolicy{Automated: &argoappv1.SyncPolicyAutomated{Prune: true}}
+
+	t.Run("Inherited", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.SyncPolicy = nil
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, proj}})
+		cond := ctrl.autoSync(app, &syncStatus, []argoappv1.ResourceStatus{})
+		assert.Nil(t, cond)
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get("my-app", metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.NotNil(t, app.Operation)
+		assert.True(t, app.Operation.Sync.Prune)
+	})
+
+	t.Run("OptOut", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.SyncPolicy = &argoappv1.SyncPolicy{}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, proj}})
+		cond := ctrl.autoSync(app, &syncStatus, []argoappv1.ResourceStatus{})
+		assert.Nil(t, cond)
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get("my-app", metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Nil(t, app.Operation)
+	})
+}
+
+// newVerifiedApp returns an application which was just synced from the revision aaa to bbb and verifies its health
+func newVerifiedApp(syncedAt time.Time) *argoappv1.Application {
+	app := newFakeApp()
+	app.Spec.SyncPolicy = &argoappv1.SyncPolicy{Verify: &argoappv1.SyncPolicyVerify{TimeoutSeconds: 60, Rollback: true}}
+	app.Status.History = []argoappv1.RevisionHistory{
+		{ID: 1, Revision: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", Source: app.Spec.Source},
+		{ID: 2, Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb", Source: app.Spec.Source},
+	}
+	finishedAt := metav1.NewTime(syncedAt)
+	app.Status.OperationState = &argoappv1.OperationState{
+		Operation:  argoappv1.Operation{Sync: &argoappv1.SyncOperation{}},
+		Phase:      argoappv1.OperationSucceeded,
+		FinishedAt: &finishedAt,
+		SyncResult: &argoappv1.SyncOperationResult{Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"},
+	}
+	return app
+}
+
+func TestVerifySync(t *testing.T) {
+	t.Run("Healthy", func(t *testing.T) {
+		app := newVerifiedApp(time.Now())
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		assert.False(t, ctrl.verifySync(app, &argoappv1.HealthStatus{Status: argoappv1.HealthStatusHealthy}))
+		assert.Equal(t, argoappv1.SyncVerificationSucceeded, app.Status.Verification.Phase)
+		assert.Equal(t, "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb", app.Status.Verification.Revision)
+	})
+
+	t.Run("Waiting", func(t *testing.T) {
+		app := newVerifiedApp(time.Now())
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		assert.False(t, ctrl.verifySync(app, &argoappv1.HealthStatus{Status: argoappv1.HealthStatusProgressing}))
+		assert.Equal(t, argoappv1.SyncVerificationRunning, app.Status.Verification.Phase)
+	})
+
+	t.Run("RolledBack", func(t *testing.T) {
+		app := newVerifiedApp(time.Now().Add(-30 * time.Second))
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		assert.False(t, ctrl.verifySync(app, &argoappv1.HealthStatus{Status: argoappv1.HealthStatusProgressing}))
+
+		// the deadline passes while the application is still unhealthy
+		app.Status.Verification.Deadline = metav1.NewTime(time.Now().Add(-time.Second))
+		assert.True(t, ctrl.verifySync(app, &argoappv1.HealthStatus{Status: argoappv1.HealthStatusDegraded, Message: "crash loop"}))
+		assert.Equal(t, argoappv1.SyncVerificationFailed, app.Status.Verification.Phase)
+		assert.True(t, app.Status.Verification.RolledBack)
+		assert.Contains(t, app.Status.Verification.Message, "health status is Degraded (crash loop)")
+
+		updated, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get("my-app", metav1.GetOptions{})
+		assert.NoError(t, err)
+		if assert.NotNil(t, updated.Operation) {
+			assert.Equal(t, "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", updated.Operation.Sync.Revision)
+			assert.Equal(t, &argoappv1.AutomatedRollback{
+				FromRevision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+				Reason:       app.Status.Verification.Message,
+			}, updated.Operation.Sync.AutomatedRollback)
+		}
+	})
+
+	t.Run("NoRollback", func(t *testing.T) {
+		app := newVerifiedApp(time.Now().Add(-30 * time.Second))
+		app.Spec.SyncPolicy.Verify.Rollback = false
+		app.Status.Verification = &argoappv1.SyncVerification{
+			Phase:    argoappv1.SyncVerificationRunning,
+			SyncedAt: *app.Status.OperationState.FinishedAt,
+			Deadline: metav1.NewTime(time.Now().Add(-time.Second)),
+		}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		assert.False(t, ctrl.verifySync(app, &argoappv1.HealthStatus{Status: argoappv1.HealthStatusDegraded}))
+		assert.Equal(t, argoappv1.SyncVerificationFailed, app.Status.Verification.Phase)
+		assert.False(t, app.Status.Verification.RolledBack)
+	})
+
+	t.Run("AnalysisFailed", func(t *testing.T) {
+		app := newVerifiedApp(time.Now())
+		// failed syncs are not recorded in the history, so the last entry is rolled back to
+		app.Status.History = app.Status.History[:1]
+		app.Status.OperationState.Phase = argoappv1.OperationFailed
+		app.Status.OperationState.SyncResult.Analysis = &argoappv1.SyncAnalysisResult{Phase: argoappv1.OperationFailed, Message: "smoke test failed"}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		assert.True(t, ctrl.verifySync(app, &argoappv1.HealthStatus{Status: argoappv1.HealthStatusHealthy}))
+		assert.Equal(t, argoappv1.SyncVerificationFailed, app.Status.Verification.Phase)
+		assert.Contains(t, app.Status.Verification.Message, "analysis of the sync to bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb failed: smoke test failed")
+
+		updated, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get("my-app", metav1.GetOptions{})
+		assert.NoError(t, err)
+		if assert.NotNil(t, updated.Operation) {
+			assert.Equal(t, "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", updated.Operation.Sync.Revision)
+		}
+	})
+
+	t.Run("SyncFailed", func(t *testing.T) {
+		app := newVerifiedApp(time.Now())
+		app.Status.OperationState.Phase = argoappv1.OperationFailed
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		assert.False(t, ctrl.verifySync(app, &argoappv1.HealthStatus{Status: argoappv1.HealthStatusDegraded}))
+		assert.Nil(t, app.Status.Verification)
+	})
+
+	t.Run("SyncedBeforePolicy", func(t *testing.T) {
+		app := newVerifiedApp(time.Now().Add(-time.Hour))
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		assert.False(t, ctrl.verifySync(app, &argoappv1.HealthStatus{Status: argoappv1.HealthStatusDegraded}))
+		assert.Nil(t, app.Status.Verification)
+	})
+}
+
+func TestAutoSyncRolledBackRevision(t *testing.T) {
+	app := newFakeApp()
+	app.Status.History = []argoappv1.RevisionHistory{{
+		ID:                3,
+		Revision:          "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+		AutomatedRollback: &argoappv1.AutomatedRollback{FromRevision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb", Reason: "unhealthy"},
+	}}
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+	syncStatus := argoappv1.SyncStatus{
+		Status:   argoappv1.SyncStatusCodeOutOfSync,
+		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+	}
+	cond := ctrl.autoSync(app, &syncStatus, []argoappv1.ResourceStatus{})
+	if assert.NotNil(t, cond) {
+		assert.Contains(t, cond.Message, "rolled back: unhealthy")
+	}
+	updated, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get("my-app", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Nil(t, updated.Operation)
+
+	// a new revision is synced again
+	syncStatus.Revision = "cccccccccccccccccccccccccccccccccccccccc"
+	assert.Nil(t, ctrl.autoSync(app, &syncStatus, []argoappv1.ResourceStatus{}))
 }
 
 func TestSkipAutoSync(t *testing.T) {
@@ -382,6 +604,59 @@ func TestAutoSyncParameterOverrides(t *testing.T) {
 }
 
 // TestFinalizeAppDeletion verifies application deletion
+func TestManagedResourcesHideSecretData(t *testing.T) {
+	secret := func(data map[string]interface{}) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Secret",
+			"metadata":   map[string]interface{}{"name": "my-secret", "namespace": test.FakeDestNamespace},
+			"data":       data,
+		}}
+	}
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{newFakeApp()}})
+	items, err := ctrl.managedResources(&comparisonResult{managedResources: []managedResource{{
+		Kind:   kube.SecretKind,
+		Name:   "my-secret",
+		Target: secret(map[string]interface{}{"password": "c2VjcmV0LTI=", "username": "YWRtaW4="}),
+		Live:   secret(map[string]interface{}{"password": "c2VjcmV0LTE=", "token": "dG9rZW4="}),
+	}}})
+	assert.NoError(t, err)
+	if assert.Len(t, items, 1) {
+		assert.Equal(t, &argoappv1.SecretKeyChanges{Added: []string{"username"}, Removed: []string{"token"}, Modified: []string{"password"}}, items[0].SecretKeyChanges)
+		assert.NotEmpty(t, items[0].PredictedLiveState)
+		for _, state := range []string{items[0].TargetState, items[0].LiveState, items[0].Diff, items[0].PredictedLiveState} {
+			assert.NotContains(t, state, "c2VjcmV0")
+		}
+	}
+}
+
+func TestManagedResourcesPredictedLiveState(t *testing.T) {
+	configMap := func(data map[string]interface{}) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata":   map[string]interface{}{"name": "my-config", "namespace": test.FakeDestNamespace},
+			"data":       data,
+		}}
+	}
+	target := configMap(map[string]interface{}{"key": "value-2"})
+	live := configMap(map[string]interface{}{"key": "value-1"})
+	live.SetUID("my-uid")
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{newFakeApp()}})
+	items, err := ctrl.managedResources(&comparisonResult{managedResources: []managedResource{
+		{Kind: "ConfigMap", Name: "my-config", Target: target, Live: live, Diff: *diff.Diff(target, live, nil)},
+		{Kind: "ConfigMap", Name: "my-config", Target: live, Live: live, Diff: *diff.Diff(live, live, nil)},
+	}})
+	assert.NoError(t, err)
+	if assert.Len(t, items, 2) {
+		predicted, err := argoappv1.UnmarshalToUnstructured(items[0].PredictedLiveState)
+		assert.NoError(t, err)
+		assert.Equal(t, "value-2", predicted.Object["data"].(map[string]interface{})["key"])
+		assert.Equal(t, "my-uid", string(predicted.GetUID()))
+		assert.Empty(t, items[1].PredictedLiveState)
+	}
+}
+
 func TestFinalizeAppDeletion(t *testing.T) {
 	app := newFakeApp()
 	app.Spec.Destination.Namespace = test.FakeArgoCDNamespace
@@ -406,6 +681,35 @@ func TestFinalizeAppDeletion(t *testing.T) {
 	assert.True(t, patched)
 }
 
+// TestFinalizeAppDeletionKeepsArgoCDCRDs verifies the CRDs of Argo CD are not deleted with the application managing them
+func TestFinalizeAppDeletionKeepsArgoCDCRDs(t *testing.T) {
+	app := newFakeApp()
+	crd := &unstructured.Unstructured{}
+	crd.SetAPIVersion("apiextensions.k8s.io/v1beta1")
+	crd.SetKind(kube.CustomResourceDefinitionKind)
+	crd.SetName("applications.argoproj.io")
+	assert.False(t, shouldBeDeleted(app, crd))
+
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}, managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{
+		kube.GetResourceKey(crd): crd,
+	}})
+	patched := false
+	fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+	defaultReactor := fakeAppCs.ReactionChain[0]
+	fakeAppCs.ReactionChain = nil
+	fakeAppCs.AddReactor("get", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		return defaultReactor.React(action)
+	})
+	fakeAppCs.AddReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		patched = true
+		return true, nil, nil
+	})
+	// the CRD is not waited for, so the finalizer is removed right away
+	err := ctrl.finalizeApplicationDeletion(app)
+	assert.NoError(t, err)
+	assert.True(t, patched)
+}
+
 // TestNormalizeApplication verifies we normalize an application during reconciliation
 func TestNormalizeApplication(t *testing.T) {
 	defaultProj := argoappv1.AppProject{
@@ -524,6 +828,33 @@ func TestHandleOrphanedResourceUpdated(t *testing.T) {
 	assert.Equal(t, ComparisonWithNothing, level)
 }
 
+func TestGetResourceConflictConditions(t *testing.T) {
+	svc := argoappv1.ResourceStatus{Kind: kube.ServiceKind, Namespace: test.FakeArgoCDNamespace, Name: "guestbook"}
+
+	app1 := newFakeApp()
+	app1.Name = "app1"
+
+	app2 := newFakeApp()
+	app2.Name = "app2"
+	app2.Status.Resources = []argoappv1.ResourceStatus{svc}
+
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app1, app2}})
+
+	conditions := ctrl.getResourceConflictConditions(app1, nil)
+	assert.Empty(t, conditions)
+
+	conditions = ctrl.getResourceConflictConditions(app1, []argoappv1.ResourceStatus{svc})
+	assert.Equal(t, []argoappv1.ApplicationCondition{{
+		Type:    argoappv1.ApplicationConditionSharedResourceWarning,
+		Message: "Service/guestbook is part of a different application: app2",
+	}}, conditions)
+
+	// conflicts which were already reported by the comparison are not duplicated
+	app1.Status.Conditions = conditions
+	conditions = ctrl.getResourceConflictConditions(app1, []argoappv1.ResourceStatus{svc})
+	assert.Empty(t, conditions)
+}
+
 func TestSetOperationStateOnDeletedApp(t *testing.T) {
 	ctrl := newFakeController(&fakeData{apps: []runtime.Object{}})
 	fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
@@ -582,3 +913,182 @@ func TestNeedRefreshAppStatus(t *testing.T) {
 	assert.Equal(t, CompareWithLatest, compareWith)
 
 }
+
+func TestGetAppRefreshTimeout(t *testing.T) {
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{}})
+	app := newFakeApp()
+
+	// defaults to the --app-resync flag
+	assert.Equal(t, time.Minute, ctrl.getAppRefreshTimeout(app))
+
+	// the annotation takes precedence over the flag
+	app.Annotations = map[string]string{common.AnnotationKeyReconciliationTimeout: "10m"}
+	assert.Equal(t, 10*time.Minute, ctrl.getAppRefreshTimeout(app))
+
+	// invalid annotations are ignored
+	app.Annotations[common.AnnotationKeyReconciliationTimeout] = "ten minutes"
+	assert.Equal(t, time.Minute, ctrl.getAppRefreshTimeout(app))
+
+	ctrl = newFakeController(&fakeData{apps: []runtime.Object{}, configMapData: map[string]string{
+		"timeout.reconciliation":        "5m",
+		"timeout.reconciliation.jitter": "1m",
+	}})
+	app = newFakeApp()
+	timeout := ctrl.getAppRefreshTimeout(app)
+	assert.True(t, timeout >= 5*time.Minute && timeout <= 6*time.Minute)
+	// the jitter is stable for each app
+	assert.Equal(t, timeout, ctrl.getAppRefreshTimeout(app))
+
+	otherApp := newFakeApp()
+	otherApp.Name = "other-app"
+	assert.NotEqual(t, timeout, ctrl.getAppRefreshTimeout(otherApp))
+
+	// jitters of minutes are spread across the whole jitter
+	ctrl = newFakeController(&fakeData{apps: []runtime.Object{}, configMapData: map[string]string{
+		"timeout.reconciliation":        "5m",
+		"timeout.reconciliation.jitter": "30m",
+	}})
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(app.Namespace + "/" + app.Name))
+	expected := 5*time.Minute + time.Duration(float64(30*time.Minute)*float64(hash.Sum32())/math.MaxUint32)
+	assert.Equal(t, expected, ctrl.getAppRefreshTimeout(app))
+	assert.True(t, expected > 5*time.Minute && expected <= 35*time.Minute)
+}
+
+func TestToggledAutomatedSync(t *testing.T) {
+	automated := &argoappv1.SyncPolicy{Automated: &argoappv1.SyncPolicyAutomated{}}
+	old := newFakeApp()
+	old.Spec.SyncPolicy = nil
+	new := newFakeApp()
+	new.Spec.SyncPolicy = automated
+	assert.True(t, toggledAutomatedSync(old, new, nil, nil))
+	assert.False(t, toggledAutomatedSync(new, new, nil, nil))
+
+	// the default sync policy of the project applies to applications without sync policy
+	proj := &argoappv1.AppProject{Spec: argoappv1.AppProjectSpec{SyncPolicy: automated}}
+	assert.False(t, toggledAutomatedSync(old, new, proj, proj))
+	assert.True(t, toggledAutomatedSync(old, old, nil, proj))
+	optedOut := newFakeApp()
+	optedOut.Spec.SyncPolicy = &argoappv1.SyncPolicy{}
+	assert.True(t, toggledAutomatedSync(optedOut, old, proj, proj))
+}
+
+func TestPersistRevisionHistoryLimit(t *testing.T) {
+	app := newFakeApp()
+	app.Status.History = []argoappv1.RevisionHistory{{ID: 0}, {ID: 1}, {ID: 2}}
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}, configMapData: map[string]string{"application.revisionHistoryLimit": "3"}})
+	manager := ctrl.appStateManager.(*appStateManager)
+
+	err := manager.persistRevisionHistory(app, "abc", app.Spec.Source, argoappv1.OperationInitiator{}, nil, nil, false)
+	assert.NoError(t, err)
+	updated, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(app.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1, 2, 3}, historyIDs(updated.Status.History))
+
+	limit := int64(1)
+	updated.Spec.RevisionHistoryLimit = &limit
+	err = manager.persistRevisionHistory(updated, "def", app.Spec.Source, argoappv1.OperationInitiator{}, nil, nil, false)
+	assert.NoError(t, err)
+	updated, err = ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(app.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{4}, historyIDs(updated.Status.History))
+}
+
+func historyIDs(history []argoappv1.RevisionHistory) []int64 {
+	var ids []int64
+	for _, h := range history {
+		ids = append(ids, h.ID)
+	}
+	return ids
+}
+
+func TestCompactOperationState(t *testing.T) {
+	state := &argoappv1.OperationState{
+		Message:    strings.Repeat("x", statusMessageLimit+1),
+		SyncResult: &argoappv1.SyncOperationResult{Resources: argoappv1.ResourceResults{{Message: strings.Repeat("y", statusMessageLimit*2)}, {Message: "ok"}}},
+	}
+	compactOperationState(state)
+	assert.Len(t, state.Message, statusMessageLimit)
+	assert.Len(t, state.SyncResult.Resources[0].Message, statusMessageLimit)
+	assert.Equal(t, "ok", state.SyncResult.Resources[1].Message)
+}
+
+func TestPersistAppStatus_StatusSubresource(t *testing.T) {
+	app := newFakeApp()
+	app.Annotations = map[string]string{common.AnnotationKeyRefresh: string(argoappv1.RefreshTypeNormal)}
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+	fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+	patches := map[string]string{}
+	fakeAppCs.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		patches[action.GetSubresource()] = string(action.(kubetesting.PatchAction).GetPatch())
+		return true, nil, nil
+	})
+	newStatus := app.Status.DeepCopy()
+	newStatus.Sync.Status = argoappv1.SyncStatusCodeOutOfSync
+
+	ctrl.persistAppStatus(app, newStatus)
+	assert.Len(t, patches, 2)
+	assert.Contains(t, patches["status"], `"status":{"sync":{"status":"OutOfSync"}}`)
+	assert.NotContains(t, patches["status"], "annotations")
+	assert.Contains(t, patches[""], common.AnnotationKeyRefresh)
+	assert.NotContains(t, patches[""], "status")
+}
+
+func conditionsOfType(app *argoappv1.Application, conditionType argoappv1.ApplicationConditionType) []argoappv1.ApplicationCondition {
+	var conditions []argoappv1.ApplicationCondition
+	for _, condition := range app.Status.Conditions {
+		if condition.Type == conditionType {
+			conditions = append(conditions, condition)
+		}
+	}
+	return conditions
+}
+
+func TestRefreshAppConditions_ManifestQuota(t *testing.T) {
+	app := newFakeApp()
+	app.Annotations = map[string]string{common.AnnotationKeyRefresh: string(argoappv1.RefreshTypeNormal)}
+	app.Status.Conditions = []argoappv1.ApplicationCondition{{Type: argoappv1.ApplicationConditionManifestQuotaError, Message: "previous refresh"}}
+	// refresh returns the application with the status patch of the refresh applied
+	refresh := func(data *fakeData) *argoappv1.Application {
+		data.apps = []runtime.Object{app, &defaultProj}
+		data.managedLiveObjs = make(map[kube.ResourceKey]*unstructured.Unstructured)
+		ctrl := newFakeController(data)
+		refreshed := app.DeepCopy()
+		ctrl.applicationClientset.(*appclientset.Clientset).PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+			if action.GetSubresource() == "status" {
+				orig, err := json.Marshal(app)
+				assert.NoError(t, err)
+				patched, err := jsonpatch.MergePatch(orig, action.(kubetesting.PatchAction).GetPatch())
+				assert.NoError(t, err)
+				refreshed = &argoappv1.Application{}
+				assert.NoError(t, json.Unmarshal(patched, refreshed))
+			}
+			return true, nil, nil
+		})
+		key, _ := cache.MetaNamespaceKeyFunc(app)
+		ctrl.appRefreshQueue.Add(key)
+		ctrl.processAppRefreshQueueItem()
+		return refreshed
+	}
+
+	// the condition of the previous refresh is replaced
+	refreshed := refresh(&fakeData{
+		manifestErr: grpc_util.WrapError(fmt.Errorf("manifests exceed the quota of the project: 3 resources rendered, at most 2 allowed"),
+			codes.ResourceExhausted, grpc_util.ErrorReasonManifestQuotaExceeded),
+	})
+	conditions := conditionsOfType(refreshed, argoappv1.ApplicationConditionManifestQuotaError)
+	if assert.Len(t, conditions, 1) {
+		assert.Contains(t, conditions[0].Message, "3 resources rendered, at most 2 allowed")
+	}
+
+	// and cleared once the manifests are within the quota
+	app = refreshed
+	app.Annotations = map[string]string{common.AnnotationKeyRefresh: string(argoappv1.RefreshTypeNormal)}
+	refreshed = refresh(&fakeData{manifestResponse: &apiclient.ManifestResponse{
+		Manifests: []string{},
+		Namespace: test.FakeDestNamespace,
+		Server:    test.FakeClusterURL,
+		Revision:  "abc123",
+	}})
+	assert.Empty(t, conditionsOfType(refreshed, argoappv1.ApplicationConditionManifestQuotaError))
+}
@@ -5,6 +5,7 @@ import (
 	"reflect"
 	"sync"
 
+	jsonpatch "github.com/evanphx/json-patch"
 	log "github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -24,6 +25,9 @@ type cacheSettings struct {
 	ResourceOverrides   map[string]appv1.ResourceOverride
 	AppInstanceLabelKey string
 	ResourcesFilter     *settings.ResourcesFilter
+	ClusterCache        settings.ClusterCacheSettings
+	// ignoredUpdateFieldsPatches remove the fields which are ignored in resource updates, nil if no updates are ignored
+	ignoredUpdateFieldsPatches []jsonpatch.Patch
 }
 
 type LiveStateCache interface {
@@ -32,6 +36,9 @@ type LiveStateCache interface {
 	IterateHierarchy(server string, key kube.ResourceKey, action func(child appv1.ResourceNode, appName string)) error
 	// Returns state of live nodes which correspond for target nodes of specified application.
 	GetManagedLiveObjs(a *appv1.Application, targetObjs []*unstructured.Unstructured) (map[kube.ResourceKey]*unstructured.Unstructured, error)
+	// Returns the kinds which are not cached because they have more objects than allowed. Resources of these kinds are
+	// only loaded if they are defined by the application, so that extra resources are not detected.
+	GetSkippedKinds(server string) ([]schema.GroupKind, error)
 	// Returns all top level resources (resources without owner references) of a specified namespace
 	GetNamespaceTopLevelResources(server string, namespace string) (map[kube.ResourceKey]appv1.ResourceNode, error)
 	// Starts watching resources of each controlled cluster.
@@ -61,7 +68,7 @@ func NewLiveStateCache(
 	metricsServer *metrics.MetricsServer,
 	onObjectUpdated ObjectUpdatedHandler) LiveStateCache {
 
-	return &liveStateCache{
+	c := &liveStateCache{
 		appInformer:       appInformer,
 		db:                db,
 		clusters:          make(map[string]*clusterInfo),
@@ -72,6 +79,8 @@ func NewLiveStateCache(
 		metricsServer:     metricsServer,
 		cacheSettingsLock: &sync.Mutex{},
 	}
+	metricsServer.RegisterClusterCache(c.getClusterCacheInfo)
+	return c
 }
 
 type liveStateCache struct {
@@ -100,7 +109,28 @@ func (c *liveStateCache) loadCacheSettings() (*cacheSettings, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &cacheSettings{AppInstanceLabelKey: appInstanceLabelKey, ResourceOverrides: resourceOverrides, ResourcesFilter: resourcesFilter}, nil
+	clusterCacheSettings, err := c.settingsMgr.GetClusterCacheSettings()
+	if err != nil {
+		return nil, err
+	}
+	ignoreUpdatesSettings, err := c.settingsMgr.GetIgnoreResourceUpdatesSettings()
+	if err != nil {
+		return nil, err
+	}
+	var ignoredUpdateFieldsPatches []jsonpatch.Patch
+	if ignoreUpdatesSettings.Enabled {
+		ignoredUpdateFieldsPatches, err = newIgnoredFieldsPatches(ignoreUpdatesSettings.JSONPointers)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &cacheSettings{
+		AppInstanceLabelKey:        appInstanceLabelKey,
+		ResourceOverrides:          resourceOverrides,
+		ResourcesFilter:            resourcesFilter,
+		ClusterCache:               clusterCacheSettings,
+		ignoredUpdateFieldsPatches: ignoredUpdateFieldsPatches,
+	}, nil
 }
 
 func (c *liveStateCache) getCluster(server string) (*clusterInfo, error) {
@@ -117,6 +147,8 @@ func (c *liveStateCache) getCluster(server string) (*clusterInfo, error) {
 			lock:             &sync.Mutex{},
 			nodes:            make(map[kube.ResourceKey]*node),
 			nsIndex:          make(map[string]map[kube.ResourceKey]*node),
+			kindCounts:       make(map[schema.GroupKind]int),
+			skippedKinds:     make(map[schema.GroupKind]bool),
 			onObjectUpdated:  c.onObjectUpdated,
 			kubectl:          c.kubectl,
 			cluster:          cluster,
@@ -163,6 +195,14 @@ func (c *liveStateCache) IsNamespaced(server string, gk schema.GroupKind) (bool,
 	return clusterInfo.isNamespaced(gk), nil
 }
 
+func (c *liveStateCache) GetSkippedKinds(server string) ([]schema.GroupKind, error) {
+	clusterInfo, err := c.getSyncedCluster(server)
+	if err != nil {
+		return nil, err
+	}
+	return clusterInfo.getSkippedKinds(), nil
+}
+
 func (c *liveStateCache) IterateHierarchy(server string, key kube.ResourceKey, action func(child appv1.ResourceNode, appName string)) error {
 	clusterInfo, err := c.getSyncedCluster(server)
 	if err != nil {
@@ -188,6 +228,22 @@ func (c *liveStateCache) GetManagedLiveObjs(a *appv1.Application, targetObjs []*
 	return clusterInfo.getManagedLiveObjs(a, targetObjs, c.metricsServer)
 }
 
+// getClusterCacheInfo returns the number of cached objects of each kind of each cluster
+func (c *liveStateCache) getClusterCacheInfo() []metrics.ClusterCacheInfo {
+	c.lock.Lock()
+	clusters := make([]*clusterInfo, 0, len(c.clusters))
+	for _, cluster := range c.clusters {
+		clusters = append(clusters, cluster)
+	}
+	c.lock.Unlock()
+
+	info := make([]metrics.ClusterCacheInfo, 0)
+	for _, cluster := range clusters {
+		info = append(info, cluster.getCacheInfo()...)
+	}
+	return info
+}
+
 func isClusterHasApps(apps []interface{}, cluster *appv1.Cluster) bool {
 	for _, obj := range apps {
 		if app, ok := obj.(*appv1.Application); ok && app.Spec.Destination.Server == cluster.Server {
@@ -14,6 +14,7 @@ import (
 	"github.com/argoproj/argo-cd/controller/metrics"
 
 	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -24,6 +25,7 @@ import (
 	"github.com/argoproj/argo-cd/util"
 	"github.com/argoproj/argo-cd/util/health"
 	"github.com/argoproj/argo-cd/util/kube"
+	"github.com/argoproj/argo-cd/util/settings"
 )
 
 const (
@@ -47,6 +49,10 @@ type clusterInfo struct {
 	lock    *sync.Mutex
 	nodes   map[kube.ResourceKey]*node
 	nsIndex map[string]map[kube.ResourceKey]*node
+	// kindCounts is the number of cached objects of each kind
+	kindCounts map[schema.GroupKind]int
+	// skippedKinds are the kinds which are not cached because they have more objects than allowed
+	skippedKinds map[schema.GroupKind]bool
 
 	onObjectUpdated  ObjectUpdatedHandler
 	kubectl          kube.Kubectl
@@ -141,11 +147,38 @@ func (c *clusterInfo) createObjInfo(un *unstructured.Unstructured, appInstanceLa
 		nodeInfo.resource = un
 	}
 	nodeInfo.health, _ = health.GetResourceHealth(un, c.cacheSettingsSrc().ResourceOverrides)
+	if patches := c.cacheSettingsSrc().ignoredUpdateFieldsPatches; patches != nil {
+		if manifestHash, err := getManifestHash(un, patches); err == nil {
+			nodeInfo.manifestHash = manifestHash
+		} else {
+			c.log.Warnf("Failed to compute hash of %s: %v", nodeInfo.resourceKey(), err)
+		}
+	}
+	if nodeInfo.resource != nil {
+		stripCachedObject(un, c.cacheSettingsSrc().ClusterCache)
+	}
 	return nodeInfo
 }
 
+// stripCachedObject removes the fields which are configured to be dropped from the objects which are kept in the cache
+func stripCachedObject(un *unstructured.Unstructured, cacheSettings settings.ClusterCacheSettings) {
+	if cacheSettings.StripManagedFields {
+		unstructured.RemoveNestedField(un.Object, "metadata", "managedFields")
+	}
+	if cacheSettings.StripLastAppliedConfig {
+		annotations := un.GetAnnotations()
+		if _, ok := annotations[corev1.LastAppliedConfigAnnotation]; ok {
+			delete(annotations, corev1.LastAppliedConfigAnnotation)
+			un.SetAnnotations(annotations)
+		}
+	}
+}
+
 func (c *clusterInfo) setNode(n *node) {
 	key := n.resourceKey()
+	if _, ok := c.nodes[key]; !ok {
+		c.kindCounts[key.GroupKind()]++
+	}
 	c.nodes[key] = n
 	ns, ok := c.nsIndex[key.Namespace]
 	if !ok {
@@ -156,6 +189,13 @@ func (c *clusterInfo) setNode(n *node) {
 }
 
 func (c *clusterInfo) removeNode(key kube.ResourceKey) {
+	if _, ok := c.nodes[key]; ok {
+		gk := key.GroupKind()
+		c.kindCounts[gk]--
+		if c.kindCounts[gk] <= 0 {
+			delete(c.kindCounts, gk)
+		}
+	}
 	delete(c.nodes, key)
 	if ns, ok := c.nsIndex[key.Namespace]; ok {
 		delete(ns, key)
@@ -188,14 +228,112 @@ func (c *clusterInfo) synced() bool {
 func (c *clusterInfo) stopWatching(gk schema.GroupKind) {
 	c.syncLock.Lock()
 	defer c.syncLock.Unlock()
+	c.stopWatchingUnsafe(gk)
+}
+
+// stopWatchingUnsafe stops the watch of the given group/kind and removes its objects from the cache. Caller must hold syncLock.
+func (c *clusterInfo) stopWatchingUnsafe(gk schema.GroupKind) {
 	if info, ok := c.apisMeta[gk]; ok {
 		info.watchCancel()
-		delete(c.apisMeta, gk)
 		c.replaceResourceCache(gk, "", []unstructured.Unstructured{})
+		delete(c.apisMeta, gk)
 		log.Warnf("Stop watching %s not found on %s.", gk, c.cluster.Server)
 	}
 }
 
+// exceedsObjectLimit returns true if the given number of objects of a kind is more than the cache is allowed to hold
+func (c *clusterInfo) exceedsObjectLimit(count int) bool {
+	limit := c.cacheSettingsSrc().ClusterCache.MaxObjectsPerKind
+	return limit > 0 && count > limit
+}
+
+// kindObjectCount returns the number of cached objects of the given kind
+func (c *clusterInfo) kindObjectCount(gk schema.GroupKind) int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.kindCounts[gk]
+}
+
+// skipKindUnsafe stops watching the given group/kind because it has more objects than allowed, and removes its objects
+// from the cache. The objects are loaded from the cluster when needed instead. Caller must hold syncLock.
+func (c *clusterInfo) skipKindUnsafe(gk schema.GroupKind, info *apiMeta, count int) {
+	if c.apisMeta[gk] != info {
+		return
+	}
+	info.watchCancel()
+	c.replaceResourceCache(gk, "", []unstructured.Unstructured{})
+	c.lock.Lock()
+	c.skippedKinds[gk] = true
+	c.lock.Unlock()
+	c.log.Warnf("Stop caching %s: %d objects exceed the limit of %d objects per kind", gk, count, c.cacheSettingsSrc().ClusterCache.MaxObjectsPerKind)
+}
+
+// isSkipped returns true if the given group/kind is not cached because it has more objects than allowed. Caller must hold lock.
+func (c *clusterInfo) isSkipped(gk schema.GroupKind) bool {
+	return c.skippedKinds[gk]
+}
+
+// getSkippedKinds returns the kinds which are not cached because they have more objects than allowed
+func (c *clusterInfo) getSkippedKinds() []schema.GroupKind {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	kinds := make([]schema.GroupKind, 0, len(c.skippedKinds))
+	for gk := range c.skippedKinds {
+		kinds = append(kinds, gk)
+	}
+	sort.Slice(kinds, func(i, j int) bool {
+		return kinds[i].String() < kinds[j].String()
+	})
+	return kinds
+}
+
+// getCacheInfo returns the number of cached objects of each kind
+func (c *clusterInfo) getCacheInfo() []metrics.ClusterCacheInfo {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	info := make([]metrics.ClusterCacheInfo, 0, len(c.kindCounts)+len(c.skippedKinds))
+	for gk, count := range c.kindCounts {
+		info = append(info, metrics.ClusterCacheInfo{Server: c.cluster.Server, Group: gk.Group, Kind: gk.Kind, Objects: count})
+	}
+	for gk := range c.skippedKinds {
+		info = append(info, metrics.ClusterCacheInfo{Server: c.cluster.Server, Group: gk.Group, Kind: gk.Kind, LimitExceeded: true})
+	}
+	return info
+}
+
+// crdGroupKind returns the group/kind of the custom resources defined by the given CRD
+func crdGroupKind(crd *unstructured.Unstructured) (schema.GroupKind, bool) {
+	group, groupOk, groupErr := unstructured.NestedString(crd.Object, "spec", "group")
+	kind, kindOk, kindErr := unstructured.NestedString(crd.Object, "spec", "names", "kind")
+	if !groupOk || groupErr != nil || !kindOk || kindErr != nil {
+		return schema.GroupKind{}, false
+	}
+	return schema.GroupKind{Group: group, Kind: kind}, true
+}
+
+// processCRDEvent refreshes the watch of the group/kind defined by the given CRD, so that custom resources of newly installed
+// CRDs become available without invalidating the whole cluster cache. Watches of other group/kinds are not affected.
+func (c *clusterInfo) processCRDEvent(event watch.EventType, crd *unstructured.Unstructured) error {
+	gk, ok := crdGroupKind(crd)
+	if !ok {
+		return nil
+	}
+	if event == watch.Deleted {
+		c.stopWatching(gk)
+		return nil
+	}
+	return runSynced(c.syncLock, func() error {
+		if info, ok := c.apisMeta[gk]; ok {
+			// resources are cached using the scope reported by the discovery API, so re-list them if the CRD scope has changed
+			if scope, ok, err := unstructured.NestedString(crd.Object, "spec", "scope"); ok && err == nil && (scope == "Namespaced") != info.namespaced {
+				c.log.Infof("Scope of %s has changed to %s, restarting watch", gk, scope)
+				c.stopWatchingUnsafe(gk)
+			}
+		}
+		return c.startMissingWatches()
+	})
+}
+
 // startMissingWatches lists supported cluster resources and start watching for changes unless watch is already running
 func (c *clusterInfo) startMissingWatches() error {
 
@@ -230,18 +368,24 @@ func (c *clusterInfo) watchEvents(ctx context.Context, api kube.APIResourceInfo,
 			}
 		}()
 
+		skipped := false
 		err = runSynced(c.syncLock, func() error {
 			if info.resourceVersion == "" {
 				list, err := api.Interface.List(metav1.ListOptions{})
 				if err != nil {
 					return err
 				}
+				if c.exceedsObjectLimit(len(list.Items)) {
+					c.skipKindUnsafe(api.GroupKind, info, len(list.Items))
+					skipped = true
+					return nil
+				}
 				c.replaceResourceCache(api.GroupKind, list.GetResourceVersion(), list.Items)
 			}
 			return nil
 		})
 
-		if err != nil {
+		if err != nil || skipped {
 			return err
 		}
 
@@ -272,21 +416,15 @@ func (c *clusterInfo) watchEvents(ctx context.Context, api kube.APIResourceInfo,
 					obj := event.Object.(*unstructured.Unstructured)
 					info.resourceVersion = obj.GetResourceVersion()
 					c.processEvent(event.Type, obj)
+					if count := c.kindObjectCount(api.GroupKind); event.Type == watch.Added && c.exceedsObjectLimit(count) {
+						_ = runSynced(c.syncLock, func() error {
+							c.skipKindUnsafe(api.GroupKind, info, count)
+							return nil
+						})
+						return nil
+					}
 					if kube.IsCRD(obj) {
-						if event.Type == watch.Deleted {
-							group, groupOk, groupErr := unstructured.NestedString(obj.Object, "spec", "group")
-							kind, kindOk, kindErr := unstructured.NestedString(obj.Object, "spec", "names", "kind")
-
-							if groupOk && groupErr == nil && kindOk && kindErr == nil {
-								gk := schema.GroupKind{Group: group, Kind: kind}
-								c.stopWatching(gk)
-							}
-						} else {
-							err = runSynced(c.syncLock, func() error {
-								return c.startMissingWatches()
-							})
-
-						}
+						err = c.processCRDEvent(event.Type, obj)
 					}
 					if err != nil {
 						log.Warnf("Failed to start missing watch: %v", err)
@@ -308,7 +446,11 @@ func (c *clusterInfo) sync() (err error) {
 		c.apisMeta[i].watchCancel()
 	}
 	c.apisMeta = make(map[schema.GroupKind]*apiMeta)
+	c.lock.Lock()
 	c.nodes = make(map[kube.ResourceKey]*node)
+	c.kindCounts = make(map[schema.GroupKind]int)
+	c.skippedKinds = make(map[schema.GroupKind]bool)
+	c.lock.Unlock()
 
 	apis, err := c.kubectl.GetAPIResources(c.cluster.RESTConfig(), c.cacheSettingsSrc().ResourcesFilter)
 	if err != nil {
@@ -322,6 +464,15 @@ func (c *clusterInfo) sync() (err error) {
 			return err
 		}
 
+		// kinds with more objects than allowed are not cached, the watch skips them as well
+		if c.exceedsObjectLimit(len(list.Items)) {
+			lock.Lock()
+			c.skippedKinds[api.GroupKind] = true
+			lock.Unlock()
+			c.log.Warnf("Not caching %s: %d objects exceed the limit of %d objects per kind", api.GroupKind, len(list.Items), c.cacheSettingsSrc().ClusterCache.MaxObjectsPerKind)
+			return nil
+		}
+
 		lock.Lock()
 		for i := range list.Items {
 			c.setNode(c.createObjInfo(&list.Items[i], c.cacheSettingsSrc().AppInstanceLabelKey))
@@ -442,7 +593,7 @@ func (c *clusterInfo) getManagedLiveObjs(a *appv1.Application, targetObjs []*uns
 						return err
 					}
 				}
-			} else if _, watched := c.apisMeta[key.GroupKind()]; !watched {
+			} else if _, watched := c.apisMeta[key.GroupKind()]; !watched || c.isSkipped(key.GroupKind()) {
 				var err error
 				managedObj, err = c.kubectl.GetResource(config, targetObj.GroupVersionKind(), targetObj.GetName(), targetObj.GetNamespace())
 				if err != nil {
@@ -503,6 +654,9 @@ func (c *clusterInfo) onNodeUpdated(exists bool, existingNode *node, un *unstruc
 	}
 	newObj := c.createObjInfo(un, c.cacheSettingsSrc().AppInstanceLabelKey)
 	c.setNode(newObj)
+	if exists && existingNode.isUpdateIgnored(newObj) {
+		return
+	}
 	nodes = append(nodes, newObj)
 	toNotify := make(map[string]bool)
 	for i := range nodes {
@@ -19,10 +19,12 @@ import (
 	"k8s.io/client-go/dynamic/fake"
 
 	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/controller/metrics"
 	"github.com/argoproj/argo-cd/errors"
 	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/util/kube"
 	"github.com/argoproj/argo-cd/util/kube/kubetest"
+	"github.com/argoproj/argo-cd/util/settings"
 )
 
 func strToUnstructured(jsonStr string) *unstructured.Unstructured {
@@ -158,6 +160,8 @@ func newClusterExt(kubectl kube.Kubectl) *clusterInfo {
 		onObjectUpdated: func(managedByApp map[string]bool, reference corev1.ObjectReference) {},
 		kubectl:         kubectl,
 		nsIndex:         make(map[string]map[kube.ResourceKey]*node),
+		kindCounts:      make(map[schema.GroupKind]int),
+		skippedKinds:    make(map[schema.GroupKind]bool),
 		cluster:         &appv1.Cluster{},
 		syncTime:        nil,
 		syncLock:        &sync.Mutex{},
@@ -486,3 +490,98 @@ func TestGetDuplicatedChildren(t *testing.T) {
 		assert.Equal(t, testRS.GetName(), children[0].Name)
 	}
 }
+
+func TestProcessCRDEvent(t *testing.T) {
+	cluster := newCluster(testPod)
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	podCRD := strToUnstructured(`
+  apiVersion: apiextensions.k8s.io/v1beta1
+  kind: CustomResourceDefinition
+  metadata:
+    name: pods
+  spec:
+    group: ""
+    scope: Namespaced
+    names:
+      kind: Pod`)
+	podGroupKind := testPod.GroupVersionKind().GroupKind()
+
+	err = cluster.processCRDEvent(watch.Deleted, podCRD)
+	assert.Nil(t, err)
+	_, ok := cluster.apisMeta[podGroupKind]
+	assert.False(t, ok)
+	_, ok = cluster.nodes[kube.GetResourceKey(testPod)]
+	assert.False(t, ok)
+
+	err = cluster.processCRDEvent(watch.Added, podCRD)
+	assert.Nil(t, err)
+	_, ok = cluster.apisMeta[podGroupKind]
+	assert.True(t, ok)
+}
+
+func TestMaxObjectsPerKind(t *testing.T) {
+	otherPod := testPod.DeepCopy()
+	otherPod.SetName(testPod.GetName() + "-other")
+
+	cluster := newCluster(testPod, otherPod, testDeploy)
+	cluster.cacheSettingsSrc = func() *cacheSettings {
+		return &cacheSettings{AppInstanceLabelKey: common.LabelKeyAppInstance, ClusterCache: settings.ClusterCacheSettings{MaxObjectsPerKind: 1}}
+	}
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	_, ok := cluster.nodes[kube.GetResourceKey(testPod)]
+	assert.False(t, ok)
+	_, ok = cluster.nodes[kube.GetResourceKey(testDeploy)]
+	assert.True(t, ok)
+	assert.Contains(t, cluster.getCacheInfo(), metrics.ClusterCacheInfo{Group: "apps", Kind: "Deployment", Objects: 1})
+	assert.Contains(t, cluster.getCacheInfo(), metrics.ClusterCacheInfo{Kind: "Pod", LimitExceeded: true})
+	assert.Equal(t, []schema.GroupKind{{Kind: "Pod"}}, cluster.getSkippedKinds())
+}
+
+func TestStripCachedObject(t *testing.T) {
+	deploy := testDeploy.DeepCopy()
+	deploy.SetAnnotations(map[string]string{corev1.LastAppliedConfigAnnotation: "{}", "foo": "bar"})
+	err := unstructured.SetNestedSlice(deploy.Object, []interface{}{map[string]interface{}{"manager": "kubectl"}}, "metadata", "managedFields")
+	assert.NoError(t, err)
+
+	cluster := newCluster()
+	cluster.cacheSettingsSrc = func() *cacheSettings {
+		return &cacheSettings{AppInstanceLabelKey: common.LabelKeyAppInstance, ClusterCache: settings.ClusterCacheSettings{StripManagedFields: true, StripLastAppliedConfig: true}}
+	}
+	node := cluster.createObjInfo(deploy, common.LabelKeyAppInstance)
+
+	_, ok, _ := unstructured.NestedSlice(node.resource.Object, "metadata", "managedFields")
+	assert.False(t, ok)
+	assert.Equal(t, map[string]string{"foo": "bar"}, node.resource.GetAnnotations())
+}
+
+func TestIgnoreResourceUpdates(t *testing.T) {
+	patches, err := newIgnoredFieldsPatches([]string{"/metadata/annotations/prometheus.io~1scrape"})
+	assert.NoError(t, err)
+	updatesReceived := 0
+	deploy := testDeploy.DeepCopy()
+	deploy.SetAnnotations(map[string]string{"prometheus.io/scrape": "false"})
+	cluster := newCluster()
+	cluster.cacheSettingsSrc = func() *cacheSettings {
+		return &cacheSettings{AppInstanceLabelKey: common.LabelKeyAppInstance, ignoredUpdateFieldsPatches: patches}
+	}
+	cluster.onObjectUpdated = func(managedByApp map[string]bool, _ corev1.ObjectReference) {
+		updatesReceived++
+	}
+	cluster.processEvent(watch.Added, deploy)
+	assert.Equal(t, 1, updatesReceived)
+
+	ignored := deploy.DeepCopy()
+	ignored.SetResourceVersion("124")
+	ignored.SetAnnotations(map[string]string{"prometheus.io/scrape": "true"})
+	cluster.processEvent(watch.Modified, ignored)
+	assert.Equal(t, 1, updatesReceived)
+
+	updated := ignored.DeepCopy()
+	updated.SetAnnotations(map[string]string{"prometheus.io/scrape": "true", "foo": "bar"})
+	cluster.processEvent(watch.Modified, updated)
+	assert.Equal(t, 2, updatesReceived)
+}
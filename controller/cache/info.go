@@ -2,12 +2,16 @@ package cache
 
 import (
 	"fmt"
+	"net/url"
+	"sort"
+	"strings"
 
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	k8snode "k8s.io/kubernetes/pkg/util/node"
 
+	"github.com/argoproj/argo-cd/common"
 	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/util"
 	"github.com/argoproj/argo-cd/util/kube"
@@ -26,18 +30,64 @@ func populateNodeInfo(un *unstructured.Unstructured, node *node) {
 		switch gvk.Kind {
 		case kube.PodKind:
 			populatePodInfo(un, node)
-			return
 		case kube.ServiceKind:
 			populateServiceInfo(un, node)
-			return
 		}
 	case "extensions":
 		switch gvk.Kind {
 		case kube.IngressKind:
 			populateIngressInfo(un, node)
-			return
 		}
 	}
+	populateLinkInfo(un, node)
+}
+
+// externalLinkTitle is the title of links which are also included in the external URLs of the application
+const externalLinkTitle = "external-link"
+
+// populateLinkInfo collects the links declared by the link annotations of any resource. Only http and https links are
+// accepted, since links are rendered as clickable by the UI.
+func populateLinkInfo(un *unstructured.Unstructured, node *node) {
+	annotations := un.GetAnnotations()
+	keys := make([]string, 0)
+	for key := range annotations {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	node.links = nil
+	for _, key := range keys {
+		var title string
+		switch {
+		case key == common.AnnotationKeyExternalLink:
+			title = externalLinkTitle
+		case strings.HasPrefix(key, common.AnnotationKeyLinkPrefix):
+			title = strings.TrimPrefix(key, common.AnnotationKeyLinkPrefix)
+		default:
+			continue
+		}
+		link, err := url.Parse(annotations[key])
+		if title == "" || err != nil || (link.Scheme != "http" && link.Scheme != "https") {
+			continue
+		}
+		node.links = append(node.links, v1alpha1.ResourceLink{Title: title, URL: link.String()})
+		if title == externalLinkTitle {
+			if node.networkingInfo == nil {
+				node.networkingInfo = &v1alpha1.ResourceNetworkingInfo{}
+			}
+			node.networkingInfo.ExternalURLs = append(node.networkingInfo.ExternalURLs, link.String())
+		}
+	}
+}
+
+func getExternalURL(host string, port string) string {
+	switch port {
+	case "80", "http":
+		return fmt.Sprintf("http://%s", host)
+	case "443", "https":
+		return fmt.Sprintf("https://%s", host)
+	default:
+		return fmt.Sprintf("http://%s:%s", host, port)
+	}
 }
 
 func getIngress(un *unstructured.Unstructured) []v1.LoadBalancerIngress {
@@ -64,7 +114,18 @@ func populateServiceInfo(un *unstructured.Unstructured, node *node) {
 	if serviceType, ok, err := unstructured.NestedString(un.Object, "spec", "type"); ok && err == nil && serviceType == string(v1.ServiceTypeLoadBalancer) {
 		ingress = getIngress(un)
 	}
-	node.networkingInfo = &v1alpha1.ResourceNetworkingInfo{TargetLabels: targetLabels, Ingress: ingress}
+	var urls []string
+	if ports, ok, err := unstructured.NestedSlice(un.Object, "spec", "ports"); ok && err == nil {
+		for i := range ingress {
+			host := util.FirstNonEmpty(ingress[i].Hostname, ingress[i].IP)
+			for j := range ports {
+				if port, ok := ports[j].(map[string]interface{}); ok && port["port"] != nil {
+					urls = append(urls, getExternalURL(host, fmt.Sprintf("%v", port["port"])))
+				}
+			}
+		}
+	}
+	node.networkingInfo = &v1alpha1.ResourceNetworkingInfo{TargetLabels: targetLabels, Ingress: ingress, ExternalURLs: urls}
 }
 
 func populateIngressInfo(un *unstructured.Unstructured, node *node) {
@@ -126,15 +187,7 @@ func populateIngressInfo(un *unstructured.Unstructured, node *node) {
 						stringPort = fmt.Sprintf("%v", port)
 					}
 
-					var externalURL string
-					switch stringPort {
-					case "80", "http":
-						externalURL = fmt.Sprintf("http://%s", host)
-					case "443", "https":
-						externalURL = fmt.Sprintf("https://%s", host)
-					default:
-						externalURL = fmt.Sprintf("http://%s:%s", host, stringPort)
-					}
+					externalURL := getExternalURL(fmt.Sprintf("%s", host), stringPort)
 
 					subPath := ""
 					if nestedPath, ok, err := unstructured.NestedString(path, "path"); ok && err == nil {
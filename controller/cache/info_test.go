@@ -48,6 +48,52 @@ func TestGetServiceInfo(t *testing.T) {
 	}, node.networkingInfo)
 }
 
+func TestGetLoadBalancerServiceInfo(t *testing.T) {
+	svc := strToUnstructured(`
+  apiVersion: v1
+  kind: Service
+  metadata:
+    name: helm-guestbook
+    namespace: default
+  spec:
+    ports:
+    - port: 443
+    - port: 8080
+    selector:
+      app: guestbook
+    type: LoadBalancer
+  status:
+    loadBalancer:
+      ingress:
+      - ip: 107.178.210.11`)
+
+	node := &node{}
+	populateNodeInfo(svc, node)
+	assert.Equal(t, []string{"https://107.178.210.11", "http://107.178.210.11:8080"}, node.networkingInfo.ExternalURLs)
+}
+
+func TestGetLinkInfo(t *testing.T) {
+	deploy := strToUnstructured(`
+  apiVersion: apps/v1
+  kind: Deployment
+  metadata:
+    name: helm-guestbook
+    namespace: default
+    annotations:
+      argocd.argoproj.io/external-link: https://guestbook.example.com
+      link.argocd.argoproj.io/logs: https://logs.example.com/?q=guestbook
+      link.argocd.argoproj.io/runbook: javascript:alert(1)`)
+
+	node := &node{}
+	populateNodeInfo(deploy, node)
+	assert.Equal(t, []v1alpha1.ResourceLink{
+		{Title: "external-link", URL: "https://guestbook.example.com"},
+		{Title: "logs", URL: "https://logs.example.com/?q=guestbook"},
+	}, node.links)
+	assert.Equal(t, &v1alpha1.ResourceNetworkingInfo{ExternalURLs: []string{"https://guestbook.example.com"}}, node.networkingInfo)
+	assert.Equal(t, node.links, node.asResourceNode().Links)
+}
+
 func TestGetIngressInfo(t *testing.T) {
 	node := &node{}
 	populateNodeInfo(testIngress, node)
@@ -72,6 +72,29 @@ func (_m *LiveStateCache) Invalidate() {
 	_m.Called()
 }
 
+// GetSkippedKinds provides a mock function with given fields: server
+func (_m *LiveStateCache) GetSkippedKinds(server string) ([]schema.GroupKind, error) {
+	ret := _m.Called(server)
+
+	var r0 []schema.GroupKind
+	if rf, ok := ret.Get(0).(func(string) []schema.GroupKind); ok {
+		r0 = rf(server)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]schema.GroupKind)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(server)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // IsNamespaced provides a mock function with given fields: server, gk
 func (_m *LiveStateCache) IsNamespaced(server string, gk schema.GroupKind) (bool, error) {
 	ret := _m.Called(server, gk)
@@ -1,6 +1,12 @@
 package cache
 
 import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	jsonpatch "github.com/evanphx/json-patch"
 	log "github.com/sirupsen/logrus"
 
 	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
@@ -24,6 +30,53 @@ type node struct {
 	networkingInfo *appv1.ResourceNetworkingInfo
 	images         []string
 	health         *appv1.HealthStatus
+	links          []appv1.ResourceLink
+	// manifestHash is the hash of the manifest without the fields which are ignored in resource updates, available only
+	// if resource updates are ignored
+	manifestHash string
+}
+
+var (
+	// defaultIgnoredUpdateFields are the fields which are ignored in resource updates if ignoring updates is enabled
+	defaultIgnoredUpdateFields = []string{"/status", "/metadata/resourceVersion", "/metadata/generation", "/metadata/managedFields"}
+)
+
+// newIgnoredFieldsPatches returns the patches which remove the given fields, in addition to the default ignored fields,
+// from manifests
+func newIgnoredFieldsPatches(jsonPointers []string) ([]jsonpatch.Patch, error) {
+	patches := make([]jsonpatch.Patch, 0)
+	for _, path := range append(defaultIgnoredUpdateFields, jsonPointers...) {
+		patchData, err := json.Marshal([]map[string]string{{"op": "remove", "path": path}})
+		if err != nil {
+			return nil, err
+		}
+		patch, err := jsonpatch.DecodePatch(patchData)
+		if err != nil {
+			return nil, err
+		}
+		patches = append(patches, patch)
+	}
+	return patches, nil
+}
+
+// getManifestHash returns the hash of the manifest without the fields which are removed by the given patches
+func getManifestHash(un *unstructured.Unstructured, patches []jsonpatch.Patch) (string, error) {
+	data, err := json.Marshal(un)
+	if err != nil {
+		return "", err
+	}
+	for _, patch := range patches {
+		// the patch fails if the ignored field is not set
+		if patched, err := patch.Apply(data); err == nil {
+			data = patched
+		}
+	}
+	return fmt.Sprintf("%x", sha256.Sum256(data)), nil
+}
+
+// isUpdateIgnored returns true if the given update of the node only changes ignored fields, and does not change its health
+func (n *node) isUpdateIgnored(updated *node) bool {
+	return n.manifestHash != "" && n.manifestHash == updated.manifestHash && reflect.DeepEqual(n.health, updated.health)
 }
 
 func (n *node) isRootAppNode() bool {
@@ -123,6 +176,7 @@ func (n *node) asResourceNode() appv1.ResourceNode {
 		ResourceVersion: n.resourceVersion,
 		NetworkingInfo:  n.networkingInfo,
 		Images:          n.images,
+		Links:           n.links,
 		Health:          n.health,
 	}
 }
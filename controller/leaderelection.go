@@ -0,0 +1,84 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+
+	"github.com/argoproj/argo-cd/errors"
+)
+
+const (
+	// leaderElectionLockName is the name of the lease which is held by the leader of the application controller replicas
+	leaderElectionLockName = "argocd-application-controller"
+)
+
+// LeaderElectionConfig configures the election of the leader among the replicas of the application controller
+type LeaderElectionConfig struct {
+	// Identity is the unique identity of the replica, e.g. the name of its pod
+	Identity string
+	// LeaseDuration is how long replicas which stand by wait before they take over the lease of a leader which stopped
+	// renewing it
+	LeaseDuration time.Duration
+	// RenewDeadline is how long the leader retries to renew its lease before it gives up the leadership
+	RenewDeadline time.Duration
+	// RetryPeriod is the interval between attempts to acquire or renew the lease
+	RetryPeriod time.Duration
+}
+
+// RunWithLeaderElection runs the controller once this replica is elected as the leader, so that several replicas can
+// be run for fast failover. The replicas which stand by only serve metrics. The lease is released when the context is
+// cancelled, e.g. on shutdown, so that another replica takes over without waiting for the lease to expire. The process
+// exits if the leadership is lost otherwise, since the controller cannot be restarted.
+func (ctrl *ApplicationController) RunWithLeaderElection(ctx context.Context, config LeaderElectionConfig, statusProcessors int, operationProcessors int) error {
+	lock, err := resourcelock.New(
+		resourcelock.LeasesResourceLock,
+		ctrl.namespace,
+		leaderElectionLockName,
+		ctrl.kubeClientset.CoreV1(),
+		ctrl.kubeClientset.CoordinationV1(),
+		resourcelock.ResourceLockConfig{Identity: config.Identity},
+	)
+	if err != nil {
+		return err
+	}
+	elector, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock:            lock,
+		Name:            leaderElectionLockName,
+		LeaseDuration:   config.LeaseDuration,
+		RenewDeadline:   config.RenewDeadline,
+		RetryPeriod:     config.RetryPeriod,
+		ReleaseOnCancel: true,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(ctx context.Context) {
+				log.Infof("Started leading as '%s'", config.Identity)
+				ctrl.metricsServer.SetLeader(true)
+				ctrl.runProcessors(ctx, statusProcessors, operationProcessors)
+			},
+			OnStoppedLeading: func() {
+				ctrl.metricsServer.SetLeader(false)
+				select {
+				case <-ctx.Done():
+					log.Infof("Released leadership of '%s'", config.Identity)
+				default:
+					log.Fatalf("Lost leadership of '%s'", config.Identity)
+				}
+			},
+			OnNewLeader: func(identity string) {
+				if identity != config.Identity {
+					log.Infof("Standing by, current leader is '%s'", identity)
+				}
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	ctrl.metricsServer.SetLeader(false)
+	go func() { errors.CheckError(ctrl.serveMetrics()) }()
+	elector.Run(ctx)
+	return nil
+}
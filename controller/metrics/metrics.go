@@ -8,21 +8,28 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 
 	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	applister "github.com/argoproj/argo-cd/pkg/client/listers/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/argo"
 	"github.com/argoproj/argo-cd/util/git"
 	"github.com/argoproj/argo-cd/util/healthz"
 )
 
 type MetricsServer struct {
 	*http.Server
+	mux                     *http.ServeMux
+	registry                *prometheus.Registry
 	syncCounter             *prometheus.CounterVec
 	k8sRequestCounter       *prometheus.CounterVec
 	kubectlExecCounter      *prometheus.CounterVec
 	kubectlExecPendingGauge *prometheus.GaugeVec
 	reconcileHistogram      *prometheus.HistogramVec
+	refreshQueueHistogram   *prometheus.HistogramVec
+	refreshCounter          *prometheus.CounterVec
+	leaderGauge             prometheus.Gauge
 }
 
 const (
@@ -59,8 +66,50 @@ var (
 		append(descAppDefaultLabels, "health_status"),
 		nil,
 	)
+	descAppResourceConflicts = prometheus.NewDesc(
+		"argocd_app_resource_conflicts",
+		"Number of resources the application shares with other applications.",
+		descAppDefaultLabels,
+		nil,
+	)
+	descAppSinceLastSuccessfulSync = prometheus.NewDesc(
+		"argocd_app_seconds_since_last_successful_sync",
+		"Seconds since the application was last synced successfully.",
+		append(descAppDefaultLabels, "dest_server"),
+		nil,
+	)
+	descAppOutOfSyncDuration = prometheus.NewDesc(
+		"argocd_app_out_of_sync_seconds",
+		"Seconds since the application drifted from its target state, zero if it is not out of sync.",
+		append(descAppDefaultLabels, "dest_server"),
+		nil,
+	)
+
+	descClusterCacheObjects = prometheus.NewDesc(
+		"argocd_cluster_cache_objects",
+		"Number of objects of a kind in the cache of a cluster.",
+		[]string{"server", "group", "kind"},
+		nil,
+	)
+	descClusterCacheLimitExceeded = prometheus.NewDesc(
+		"argocd_cluster_cache_limit_exceeded",
+		"Kinds which are not cached because they have more objects than allowed.",
+		[]string{"server", "group", "kind"},
+		nil,
+	)
 )
 
+// ClusterCacheInfo holds the number of objects of a kind in the cache of a cluster
+type ClusterCacheInfo struct {
+	Server string
+	Group  string
+	Kind   string
+	// Objects is the number of cached objects
+	Objects int
+	// LimitExceeded is true if the objects of the kind are not cached because there are more of them than allowed
+	LimitExceeded bool
+}
+
 // NewMetricsServer returns a new prometheus server which collects application metrics
 func NewMetricsServer(addr string, appLister applister.ApplicationLister, healthCheck func() error) *MetricsServer {
 	mux := http.NewServeMux()
@@ -109,19 +158,59 @@ func NewMetricsServer(addr string, appLister applister.ApplicationLister, health
 
 	appRegistry.MustRegister(reconcileHistogram)
 
+	refreshQueueHistogram := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "argocd_app_refresh_queue_latency",
+			Help:    "Time applications wait in the refresh queue before they are reconciled.",
+			Buckets: []float64{0.1, .5, 1, 5, 10, 30, 60, 300},
+		},
+		[]string{"priority"},
+	)
+	appRegistry.MustRegister(refreshQueueHistogram)
+
+	refreshCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_app_refresh_total",
+			Help: "Number of requested application refreshes, by refresh type.",
+		},
+		append(descAppDefaultLabels, "type"),
+	)
+	appRegistry.MustRegister(refreshCounter)
+
+	leaderGauge := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "argocd_app_controller_leader",
+		Help: "Whether the application controller replica is the elected leader (1) or stands by (0).",
+	})
+	appRegistry.MustRegister(leaderGauge)
+
 	return &MetricsServer{
 		Server: &http.Server{
 			Addr:    addr,
 			Handler: mux,
 		},
+		mux:                     mux,
+		registry:                appRegistry,
 		syncCounter:             syncCounter,
 		k8sRequestCounter:       k8sRequestCounter,
 		reconcileHistogram:      reconcileHistogram,
+		refreshQueueHistogram:   refreshQueueHistogram,
 		kubectlExecCounter:      kubectlExecCounter,
+		refreshCounter:          refreshCounter,
 		kubectlExecPendingGauge: kubectlExecPendingGauge,
+		leaderGauge:             leaderGauge,
 	}
 }
 
+// Handle registers an additional handler on the metrics server, such as the profiler
+func (m *MetricsServer) Handle(pattern string, handler http.Handler) {
+	m.mux.Handle(pattern, handler)
+}
+
+// RegisterClusterCache registers a collector of the size of the caches of the managed clusters
+func (m *MetricsServer) RegisterClusterCache(source func() []ClusterCacheInfo) {
+	m.registry.MustRegister(&clusterCacheCollector{source: source})
+}
+
 // IncSync increments the sync counter for an application
 func (m *MetricsServer) IncSync(app *argoappv1.Application, state *argoappv1.OperationState) {
 	if !state.Phase.Completed() {
@@ -140,6 +229,25 @@ func (m *MetricsServer) IncReconcile(app *argoappv1.Application, duration time.D
 	m.reconcileHistogram.WithLabelValues(app.Namespace, app.Name, app.Spec.GetProject()).Observe(duration.Seconds())
 }
 
+// IncRefresh increments the counter of requested refreshes of the given type for an application
+func (m *MetricsServer) IncRefresh(app *argoappv1.Application, refreshType argoappv1.RefreshType) {
+	m.refreshCounter.WithLabelValues(app.Namespace, app.Name, app.Spec.GetProject(), string(refreshType)).Inc()
+}
+
+// ObserveRefreshQueueLatency records how long an application waited in the given tier of the refresh queue
+func (m *MetricsServer) ObserveRefreshQueueLatency(priority string, latency time.Duration) {
+	m.refreshQueueHistogram.WithLabelValues(priority).Observe(latency.Seconds())
+}
+
+// SetLeader records whether the replica is the elected leader of the application controller replicas
+func (m *MetricsServer) SetLeader(leader bool) {
+	if leader {
+		m.leaderGauge.Set(1)
+	} else {
+		m.leaderGauge.Set(0)
+	}
+}
+
 func (m *MetricsServer) IncKubectlExec(command string) {
 	m.kubectlExecCounter.WithLabelValues(command).Inc()
 }
@@ -176,6 +284,9 @@ func (c *appCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- descAppCreated
 	ch <- descAppSyncStatusCode
 	ch <- descAppHealthStatus
+	ch <- descAppResourceConflicts
+	ch <- descAppSinceLastSuccessfulSync
+	ch <- descAppOutOfSyncDuration
 }
 
 // Collect implements the prometheus.Collector interface
@@ -185,8 +296,35 @@ func (c *appCollector) Collect(ch chan<- prometheus.Metric) {
 		log.Warnf("Failed to collect applications: %v", err)
 		return
 	}
+	conflicts := make(map[string]int)
+	for _, conflict := range argo.GetResourceConflicts(apps) {
+		conflicts[conflict.Application]++
+		conflicts[conflict.ConflictingApplication]++
+	}
+	now := time.Now()
 	for _, app := range apps {
-		collectApps(ch, app)
+		collectApps(ch, app, conflicts[app.Name], now)
+	}
+}
+
+type clusterCacheCollector struct {
+	source func() []ClusterCacheInfo
+}
+
+// Describe implements the prometheus.Collector interface
+func (c *clusterCacheCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- descClusterCacheObjects
+	ch <- descClusterCacheLimitExceeded
+}
+
+// Collect implements the prometheus.Collector interface
+func (c *clusterCacheCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, info := range c.source() {
+		if info.LimitExceeded {
+			ch <- prometheus.MustNewConstMetric(descClusterCacheLimitExceeded, prometheus.GaugeValue, 1, info.Server, info.Group, info.Kind)
+		} else {
+			ch <- prometheus.MustNewConstMetric(descClusterCacheObjects, prometheus.GaugeValue, float64(info.Objects), info.Server, info.Group, info.Kind)
+		}
 	}
 }
 
@@ -197,7 +335,36 @@ func boolFloat64(b bool) float64 {
 	return 0
 }
 
-func collectApps(ch chan<- prometheus.Metric, app *argoappv1.Application) {
+// lastSuccessfulSync returns the time at which the application was last synced successfully, nil if it never was
+func lastSuccessfulSync(app *argoappv1.Application) *metav1.Time {
+	var last *metav1.Time
+	if len(app.Status.History) > 0 {
+		last = &app.Status.History[len(app.Status.History)-1].DeployedAt
+	}
+	if state := app.Status.OperationState; state != nil && state.Phase == argoappv1.OperationSucceeded && state.FinishedAt != nil &&
+		state.Operation.Sync != nil && !state.Operation.Sync.DryRun && (last == nil || last.Before(state.FinishedAt)) {
+		last = state.FinishedAt
+	}
+	return last
+}
+
+// outOfSyncSince returns the time at which the first resource of an out of sync application drifted, nil if the
+// application is not out of sync
+func outOfSyncSince(app *argoappv1.Application) *metav1.Time {
+	if app.Status.Sync.Status != argoappv1.SyncStatusCodeOutOfSync {
+		return nil
+	}
+	var since *metav1.Time
+	for i := range app.Status.Resources {
+		res := &app.Status.Resources[i]
+		if res.Status == argoappv1.SyncStatusCodeOutOfSync && res.OutOfSyncSince != nil && (since == nil || res.OutOfSyncSince.Before(since)) {
+			since = res.OutOfSyncSince
+		}
+	}
+	return since
+}
+
+func collectApps(ch chan<- prometheus.Metric, app *argoappv1.Application, conflicts int, now time.Time) {
 	addConstMetric := func(desc *prometheus.Desc, t prometheus.ValueType, v float64, lv ...string) {
 		project := app.Spec.GetProject()
 		lv = append([]string{app.Namespace, app.Name, project}, lv...)
@@ -223,4 +390,15 @@ func collectApps(ch chan<- prometheus.Metric, app *argoappv1.Application) {
 	addGauge(descAppHealthStatus, boolFloat64(healthStatus == argoappv1.HealthStatusHealthy), argoappv1.HealthStatusHealthy)
 	addGauge(descAppHealthStatus, boolFloat64(healthStatus == argoappv1.HealthStatusDegraded), argoappv1.HealthStatusDegraded)
 	addGauge(descAppHealthStatus, boolFloat64(healthStatus == argoappv1.HealthStatusMissing), argoappv1.HealthStatusMissing)
+
+	addGauge(descAppResourceConflicts, float64(conflicts))
+
+	if last := lastSuccessfulSync(app); last != nil {
+		addGauge(descAppSinceLastSuccessfulSync, now.Sub(last.Time).Seconds(), app.Spec.Destination.Server)
+	}
+	var outOfSyncDuration time.Duration
+	if since := outOfSyncSince(app); since != nil {
+		outOfSyncDuration = now.Sub(since.Time)
+	}
+	addGauge(descAppOutOfSyncDuration, outOfSyncDuration.Seconds(), app.Spec.Destination.Server)
 }
@@ -10,6 +10,8 @@ import (
 	"time"
 
 	"github.com/ghodss/yaml"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -56,6 +58,12 @@ argocd_app_health_status{health_status="Unknown",name="my-app",namespace="argocd
 # HELP argocd_app_info Information about application.
 # TYPE argocd_app_info gauge
 argocd_app_info{dest_namespace="dummy-namespace",dest_server="https://localhost:6443",name="my-app",namespace="argocd",project="important-project",repo="https://github.com/argoproj/argocd-example-apps"} 1
+# HELP argocd_app_out_of_sync_seconds Seconds since the application drifted from its target state, zero if it is not out of sync.
+# TYPE argocd_app_out_of_sync_seconds gauge
+argocd_app_out_of_sync_seconds{dest_server="https://localhost:6443",name="my-app",namespace="argocd",project="important-project"} 0
+# HELP argocd_app_resource_conflicts Number of resources the application shares with other applications.
+# TYPE argocd_app_resource_conflicts gauge
+argocd_app_resource_conflicts{name="my-app",namespace="argocd",project="important-project"} 0
 # HELP argocd_app_sync_status The application current sync status.
 # TYPE argocd_app_sync_status gauge
 argocd_app_sync_status{name="my-app",namespace="argocd",project="important-project",sync_status="OutOfSync"} 0
@@ -97,6 +105,12 @@ argocd_app_health_status{health_status="Unknown",name="my-app",namespace="argocd
 # HELP argocd_app_info Information about application.
 # TYPE argocd_app_info gauge
 argocd_app_info{dest_namespace="dummy-namespace",dest_server="https://localhost:6443",name="my-app",namespace="argocd",project="default",repo="https://github.com/argoproj/argocd-example-apps"} 1
+# HELP argocd_app_out_of_sync_seconds Seconds since the application drifted from its target state, zero if it is not out of sync.
+# TYPE argocd_app_out_of_sync_seconds gauge
+argocd_app_out_of_sync_seconds{dest_server="https://localhost:6443",name="my-app",namespace="argocd",project="default"} 0
+# HELP argocd_app_resource_conflicts Number of resources the application shares with other applications.
+# TYPE argocd_app_resource_conflicts gauge
+argocd_app_resource_conflicts{name="my-app",namespace="argocd",project="default"} 0
 # HELP argocd_app_sync_status The application current sync status.
 # TYPE argocd_app_sync_status gauge
 argocd_app_sync_status{name="my-app",namespace="argocd",project="default",sync_status="OutOfSync"} 0
@@ -170,6 +184,48 @@ func TestMetrics(t *testing.T) {
 	}
 }
 
+const fakeConflictingApp = `
+apiVersion: argoproj.io/v1alpha1
+kind: Application
+metadata:
+  name: my-other-app
+  namespace: argocd
+spec:
+  destination:
+    namespace: dummy-namespace
+    server: https://localhost:6443
+  project: important-project
+  source:
+    path: some/other/path
+    repoURL: https://github.com/argoproj/argocd-example-apps.git
+status:
+  resources:
+  - kind: Service
+    namespace: dummy-namespace
+    name: guestbook-ui
+`
+
+const resourceConflictsMetrics = `argocd_app_resource_conflicts{name="my-app",namespace="argocd",project="important-project"} 1
+argocd_app_resource_conflicts{name="my-other-app",namespace="argocd",project="important-project"} 1
+`
+
+func TestMetricsResourceConflicts(t *testing.T) {
+	app := newFakeApp(fakeApp)
+	app.Status.Resources = []argoappv1.ResourceStatus{{Kind: "Service", Namespace: "dummy-namespace", Name: "guestbook-ui"}}
+	appYaml, err := yaml.Marshal(app)
+	assert.NoError(t, err)
+
+	cancel, appLister := newFakeLister(string(appYaml), fakeConflictingApp)
+	defer cancel()
+	metricsServ := NewMetricsServer("localhost:8082", appLister, noOpHealthCheck)
+	req, err := http.NewRequest("GET", "/metrics", nil)
+	assert.NoError(t, err)
+	rr := httptest.NewRecorder()
+	metricsServ.Handler.ServeHTTP(rr, req)
+	assert.Equal(t, rr.Code, http.StatusOK)
+	assertMetricsPrinted(t, resourceConflictsMetrics, rr.Body.String())
+}
+
 const appSyncTotal = `# HELP argocd_app_sync_total Number of application syncs.
 # TYPE argocd_app_sync_total counter
 argocd_app_sync_total{name="my-app",namespace="argocd",phase="Error",project="important-project"} 1
@@ -177,6 +233,51 @@ argocd_app_sync_total{name="my-app",namespace="argocd",phase="Failed",project="i
 argocd_app_sync_total{name="my-app",namespace="argocd",phase="Succeeded",project="important-project"} 2
 `
 
+func TestMetricsSyncDurations(t *testing.T) {
+	now := time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) *metav1.Time {
+		t := metav1.NewTime(now.Add(-d))
+		return &t
+	}
+	collect := func(app *argoappv1.Application) map[*prometheus.Desc]float64 {
+		ch := make(chan prometheus.Metric, 100)
+		collectApps(ch, app, 0, now)
+		close(ch)
+		values := make(map[*prometheus.Desc]float64)
+		for metric := range ch {
+			var m dto.Metric
+			assert.NoError(t, metric.Write(&m))
+			values[metric.Desc()] = m.GetGauge().GetValue()
+		}
+		return values
+	}
+
+	app := newFakeApp(fakeApp)
+	values := collect(app)
+	assert.NotContains(t, values, descAppSinceLastSuccessfulSync)
+	assert.Equal(t, float64(0), values[descAppOutOfSyncDuration])
+
+	app.Status.History = []argoappv1.RevisionHistory{{DeployedAt: *at(2 * time.Hour)}, {DeployedAt: *at(time.Hour)}}
+	app.Status.Sync.Status = argoappv1.SyncStatusCodeOutOfSync
+	app.Status.Resources = []argoappv1.ResourceStatus{
+		{Kind: "Service", Name: "synced", Status: argoappv1.SyncStatusCodeSynced},
+		{Kind: "Service", Name: "drifted", Status: argoappv1.SyncStatusCodeOutOfSync, OutOfSyncSince: at(10 * time.Minute)},
+		{Kind: "Service", Name: "drifted-earlier", Status: argoappv1.SyncStatusCodeOutOfSync, OutOfSyncSince: at(30 * time.Minute)},
+	}
+	values = collect(app)
+	assert.Equal(t, float64(3600), values[descAppSinceLastSuccessfulSync])
+	assert.Equal(t, float64(1800), values[descAppOutOfSyncDuration])
+
+	// a successful sync which did not deploy a new revision counts as well
+	app.Status.OperationState = &argoappv1.OperationState{
+		Operation:  argoappv1.Operation{Sync: &argoappv1.SyncOperation{}},
+		Phase:      argoappv1.OperationSucceeded,
+		FinishedAt: at(time.Minute),
+	}
+	values = collect(app)
+	assert.Equal(t, float64(60), values[descAppSinceLastSuccessfulSync])
+}
+
 func TestMetricsSyncCounter(t *testing.T) {
 	cancel, appLister := newFakeLister()
 	defer cancel()
@@ -206,6 +307,30 @@ func assertMetricsPrinted(t *testing.T, expectedLines, body string) {
 	}
 }
 
+const appRefreshMetrics = `argocd_app_refresh_total{name="my-app",namespace="argocd",project="important-project",type="hard"} 1
+argocd_app_refresh_total{name="my-app",namespace="argocd",project="important-project",type="normal"} 2
+`
+
+func TestMetricsRefreshCounter(t *testing.T) {
+	cancel, appLister := newFakeLister()
+	defer cancel()
+	metricsServ := NewMetricsServer("localhost:8082", appLister, noOpHealthCheck)
+
+	fakeApp := newFakeApp(fakeApp)
+	metricsServ.IncRefresh(fakeApp, argoappv1.RefreshTypeNormal)
+	metricsServ.IncRefresh(fakeApp, argoappv1.RefreshTypeNormal)
+	metricsServ.IncRefresh(fakeApp, argoappv1.RefreshTypeHard)
+
+	req, err := http.NewRequest("GET", "/metrics", nil)
+	assert.NoError(t, err)
+	rr := httptest.NewRecorder()
+	metricsServ.Handler.ServeHTTP(rr, req)
+	assert.Equal(t, rr.Code, http.StatusOK)
+	body := rr.Body.String()
+	log.Println(body)
+	assertMetricsPrinted(t, appRefreshMetrics, body)
+}
+
 const appReconcileMetrics = `argocd_app_reconcile_bucket{name="my-app",namespace="argocd",project="important-project",le="0.25"} 0
 argocd_app_reconcile_bucket{name="my-app",namespace="argocd",project="important-project",le="0.5"} 0
 argocd_app_reconcile_bucket{name="my-app",namespace="argocd",project="important-project",le="1"} 0
@@ -235,3 +360,46 @@ func TestReconcileMetrics(t *testing.T) {
 	log.Println(body)
 	assertMetricsPrinted(t, appReconcileMetrics, body)
 }
+
+func TestMetricsLeader(t *testing.T) {
+	cancel, appLister := newFakeLister()
+	defer cancel()
+	metricsServ := NewMetricsServer("localhost:8082", appLister, noOpHealthCheck)
+
+	for _, leader := range []bool{true, false} {
+		metricsServ.SetLeader(leader)
+		req, err := http.NewRequest("GET", "/metrics", nil)
+		assert.NoError(t, err)
+		rr := httptest.NewRecorder()
+		metricsServ.Handler.ServeHTTP(rr, req)
+		assert.Equal(t, rr.Code, http.StatusOK)
+		if leader {
+			assertMetricsPrinted(t, "argocd_app_controller_leader 1\n", rr.Body.String())
+		} else {
+			assertMetricsPrinted(t, "argocd_app_controller_leader 0\n", rr.Body.String())
+		}
+	}
+}
+
+func TestMetricsClusterCache(t *testing.T) {
+	cancel, appLister := newFakeLister()
+	defer cancel()
+	metricsServ := NewMetricsServer("localhost:8082", appLister, noOpHealthCheck)
+	metricsServ.RegisterClusterCache(func() []ClusterCacheInfo {
+		return []ClusterCacheInfo{
+			{Server: "https://localhost:6443", Group: "apps", Kind: "Deployment", Objects: 3},
+			{Server: "https://localhost:6443", Kind: "Event", LimitExceeded: true},
+		}
+	})
+
+	req, err := http.NewRequest("GET", "/metrics", nil)
+	assert.NoError(t, err)
+	rr := httptest.NewRecorder()
+	metricsServ.Handler.ServeHTTP(rr, req)
+	assert.Equal(t, rr.Code, http.StatusOK)
+	body := rr.Body.String()
+	assertMetricsPrinted(t, `
+argocd_cluster_cache_limit_exceeded{group="",kind="Event",server="https://localhost:6443"} 1
+argocd_cluster_cache_objects{group="apps",kind="Deployment",server="https://localhost:6443"} 3
+`, body)
+}
@@ -0,0 +1,180 @@
+package controller
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/client-go/util/workqueue"
+)
+
+// refreshPriority is the tier of the refresh queue an application is waiting in
+type refreshPriority int
+
+const (
+	// refreshPriorityLow is used for periodic refreshes and changes of live resources
+	refreshPriorityLow refreshPriority = iota
+	// refreshPriorityHigh is used for applications with a pending operation or a requested refresh
+	refreshPriorityHigh
+)
+
+func (p refreshPriority) String() string {
+	if p == refreshPriorityHigh {
+		return "high"
+	}
+	return "low"
+}
+
+type queuedKey struct {
+	priority refreshPriority
+	addedAt  time.Time
+}
+
+// refreshQueue is a work queue of application keys with two tiers. Keys waiting in the high tier are always handed
+// out before keys of the low tier, so that user triggered refreshes do not wait behind thousands of periodic ones.
+// Like a workqueue, a key is queued at most once, and is never processed by two workers at the same time.
+type refreshQueue struct {
+	cond *sync.Cond
+	// tiers are the FIFOs of each priority. They might contain stale entries of keys which were promoted to the high tier.
+	tiers      [2][]string
+	queued     map[string]queuedKey
+	dirty      map[string]queuedKey
+	processing map[string]bool
+	// scheduled holds the time keys added by AddAfter are going to be queued
+	scheduled map[string]time.Time
+	// rateLimiters throttle the keys added by AddRateLimited to each tier. Each tier has its own limiter, so that a
+	// burst of low priority refreshes does not delay refreshes of the high tier.
+	rateLimiters [2]workqueue.RateLimiter
+	shuttingDown bool
+}
+
+func newRefreshQueue(newRateLimiter func() workqueue.RateLimiter) *refreshQueue {
+	return &refreshQueue{
+		cond:         sync.NewCond(&sync.Mutex{}),
+		queued:       make(map[string]queuedKey),
+		dirty:        make(map[string]queuedKey),
+		processing:   make(map[string]bool),
+		scheduled:    make(map[string]time.Time),
+		rateLimiters: [2]workqueue.RateLimiter{newRateLimiter(), newRateLimiter()},
+	}
+}
+
+// Add queues the key in the low tier
+func (q *refreshQueue) Add(key string) {
+	q.AddWithPriority(key, refreshPriorityLow)
+}
+
+// AddWithPriority queues the key in the given tier. A key which is already waiting is promoted to the high tier, but
+// never demoted.
+func (q *refreshQueue) AddWithPriority(key string, priority refreshPriority) {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	if q.shuttingDown {
+		return
+	}
+	item := queuedKey{priority: priority, addedAt: time.Now()}
+	if q.processing[key] {
+		// the key is queued again once it is done
+		if dirty, ok := q.dirty[key]; !ok || dirty.priority < priority {
+			q.dirty[key] = item
+		}
+		return
+	}
+	q.enqueue(key, item)
+}
+
+// AddAfter queues the key in the given tier once the duration has passed. Nothing is scheduled if the key is already
+// scheduled to be queued earlier.
+func (q *refreshQueue) AddAfter(key string, priority refreshPriority, duration time.Duration) {
+	if duration <= 0 {
+		q.AddWithPriority(key, priority)
+		return
+	}
+	at := time.Now().Add(duration)
+	q.cond.L.Lock()
+	if scheduled, ok := q.scheduled[key]; ok && !scheduled.After(at) {
+		q.cond.L.Unlock()
+		return
+	}
+	q.scheduled[key] = at
+	q.cond.L.Unlock()
+	time.AfterFunc(duration, func() {
+		q.cond.L.Lock()
+		if q.scheduled[key].Equal(at) {
+			delete(q.scheduled, key)
+		}
+		q.cond.L.Unlock()
+		q.AddWithPriority(key, priority)
+	})
+}
+
+// AddRateLimited queues the key in the given tier once the rate limiter of the tier allows it
+func (q *refreshQueue) AddRateLimited(key string, priority refreshPriority) {
+	q.AddAfter(key, priority, q.rateLimiters[priority].When(key))
+}
+
+// Forget resets the rate limiting of the key, once it was processed
+func (q *refreshQueue) Forget(key string) {
+	for _, rateLimiter := range q.rateLimiters {
+		rateLimiter.Forget(key)
+	}
+}
+
+func (q *refreshQueue) enqueue(key string, item queuedKey) {
+	if existing, ok := q.queued[key]; ok && existing.priority >= item.priority {
+		return
+	}
+	q.queued[key] = item
+	q.tiers[item.priority] = append(q.tiers[item.priority], key)
+	q.cond.Signal()
+}
+
+// Get blocks until a key is available and returns it, along with the tier it was taken from and how long it waited
+func (q *refreshQueue) Get() (key string, priority refreshPriority, latency time.Duration, shutdown bool) {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	for {
+		for tier := refreshPriorityHigh; tier >= refreshPriorityLow; tier-- {
+			for len(q.tiers[tier]) > 0 {
+				next := q.tiers[tier][0]
+				q.tiers[tier] = q.tiers[tier][1:]
+				item, ok := q.queued[next]
+				if !ok || item.priority != tier {
+					continue
+				}
+				delete(q.queued, next)
+				q.processing[next] = true
+				return next, tier, time.Since(item.addedAt), false
+			}
+		}
+		if q.shuttingDown {
+			return "", refreshPriorityLow, 0, true
+		}
+		q.cond.Wait()
+	}
+}
+
+// Done marks the key as processed, and queues it again if it was added in the meantime
+func (q *refreshQueue) Done(key string) {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	delete(q.processing, key)
+	if item, ok := q.dirty[key]; ok {
+		delete(q.dirty, key)
+		q.enqueue(key, item)
+	}
+}
+
+// Len returns the number of keys waiting in the queue
+func (q *refreshQueue) Len() int {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	return len(q.queued)
+}
+
+// ShutDown makes workers waiting for keys return once the queue is drained, and ignores keys added afterwards
+func (q *refreshQueue) ShutDown() {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	q.shuttingDown = true
+	q.cond.Broadcast()
+}
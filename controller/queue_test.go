@@ -0,0 +1,131 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/util/workqueue"
+
+	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+func getKey(t *testing.T, q *refreshQueue) (string, refreshPriority) {
+	key, priority, _, shutdown := q.Get()
+	assert.False(t, shutdown)
+	return key, priority
+}
+
+func TestRefreshQueue_Priority(t *testing.T) {
+	q := newRefreshQueue(workqueue.DefaultControllerRateLimiter)
+	q.Add("argocd/periodic-1")
+	q.Add("argocd/periodic-2")
+	q.AddWithPriority("argocd/synced", refreshPriorityHigh)
+	// promoted to the high tier
+	q.AddWithPriority("argocd/periodic-2", refreshPriorityHigh)
+	// never demoted
+	q.Add("argocd/synced")
+	assert.Equal(t, 3, q.Len())
+
+	key, priority := getKey(t, q)
+	assert.Equal(t, "argocd/synced", key)
+	assert.Equal(t, refreshPriorityHigh, priority)
+	q.Done(key)
+
+	key, priority = getKey(t, q)
+	assert.Equal(t, "argocd/periodic-2", key)
+	assert.Equal(t, refreshPriorityHigh, priority)
+	q.Done(key)
+
+	key, priority = getKey(t, q)
+	assert.Equal(t, "argocd/periodic-1", key)
+	assert.Equal(t, refreshPriorityLow, priority)
+	q.Done(key)
+
+	assert.Equal(t, 0, q.Len())
+}
+
+func TestRefreshQueue_Processing(t *testing.T) {
+	q := newRefreshQueue(workqueue.DefaultControllerRateLimiter)
+	q.Add("argocd/app")
+	key, _ := getKey(t, q)
+
+	// keys added while being processed are queued again once done, in the highest requested tier
+	q.Add(key)
+	q.AddWithPriority(key, refreshPriorityHigh)
+	assert.Equal(t, 0, q.Len())
+	q.Done(key)
+	assert.Equal(t, 1, q.Len())
+
+	key, priority := getKey(t, q)
+	assert.Equal(t, "argocd/app", key)
+	assert.Equal(t, refreshPriorityHigh, priority)
+	q.Done(key)
+	assert.Equal(t, 0, q.Len())
+}
+
+func TestRefreshQueue_ShutDown(t *testing.T) {
+	q := newRefreshQueue(workqueue.DefaultControllerRateLimiter)
+	done := make(chan bool)
+	go func() {
+		_, _, _, shutdown := q.Get()
+		done <- shutdown
+	}()
+	q.ShutDown()
+	select {
+	case shutdown := <-done:
+		assert.True(t, shutdown)
+	case <-time.After(time.Second):
+		t.Fatal("Get did not return after shut down")
+	}
+	q.Add("argocd/app")
+	assert.Equal(t, 0, q.Len())
+}
+
+func TestGetRefreshPriority(t *testing.T) {
+	app := newFakeApp()
+	assert.Equal(t, refreshPriorityLow, getRefreshPriority(app))
+
+	app.Annotations = map[string]string{"argocd.argoproj.io/refresh": "normal"}
+	assert.Equal(t, refreshPriorityHigh, getRefreshPriority(app))
+
+	app = newFakeApp()
+	app.Operation = &argoappv1.Operation{Sync: &argoappv1.SyncOperation{}}
+	assert.Equal(t, refreshPriorityHigh, getRefreshPriority(app))
+}
+
+func TestRefreshQueue_AddAfter(t *testing.T) {
+	q := newRefreshQueue(workqueue.DefaultControllerRateLimiter)
+	q.AddAfter("argocd/app", refreshPriorityLow, time.Hour)
+	q.AddAfter("argocd/app", refreshPriorityLow, 2*time.Hour)
+	assert.Len(t, q.scheduled, 1)
+	at := q.scheduled["argocd/app"]
+
+	// earlier additions are scheduled again
+	q.AddAfter("argocd/app", refreshPriorityLow, 10*time.Millisecond)
+	q.cond.L.Lock()
+	assert.True(t, q.scheduled["argocd/app"].Before(at))
+	q.cond.L.Unlock()
+
+	key, priority := getKey(t, q)
+	assert.Equal(t, "argocd/app", key)
+	assert.Equal(t, refreshPriorityLow, priority)
+	q.Done(key)
+	assert.Empty(t, q.scheduled)
+}
+
+func TestRefreshQueue_AddRateLimited(t *testing.T) {
+	q := newRefreshQueue(func() workqueue.RateLimiter {
+		return workqueue.NewItemExponentialFailureRateLimiter(time.Hour, time.Hour)
+	})
+	q.AddRateLimited("argocd/app", refreshPriorityLow)
+	assert.Equal(t, 0, q.Len())
+	assert.Len(t, q.scheduled, 1)
+
+	// the tiers are throttled separately
+	assert.Equal(t, 0, q.rateLimiters[refreshPriorityHigh].NumRequeues("argocd/app"))
+	assert.Equal(t, 1, q.rateLimiters[refreshPriorityLow].NumRequeues("argocd/app"))
+
+	q.Forget("argocd/app")
+	assert.Equal(t, 0, q.rateLimiters[refreshPriorityLow].NumRequeues("argocd/app"))
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	log "github.com/sirupsen/logrus"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -19,11 +21,14 @@ import (
 	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/pkg/client/clientset/versioned"
+	listersv1alpha1 "github.com/argoproj/argo-cd/pkg/client/listers/application/v1alpha1"
 	"github.com/argoproj/argo-cd/reposerver/apiclient"
 	"github.com/argoproj/argo-cd/util"
+	apppath "github.com/argoproj/argo-cd/util/app/path"
 	"github.com/argoproj/argo-cd/util/argo"
 	"github.com/argoproj/argo-cd/util/db"
 	"github.com/argoproj/argo-cd/util/diff"
+	grpc_util "github.com/argoproj/argo-cd/util/grpc"
 	"github.com/argoproj/argo-cd/util/health"
 	hookutil "github.com/argoproj/argo-cd/util/hook"
 	kubeutil "github.com/argoproj/argo-cd/util/kube"
@@ -72,6 +77,10 @@ type comparisonResult struct {
 	hooks            []*unstructured.Unstructured
 	diffNormalizer   diff.Normalizer
 	appSourceType    v1alpha1.ApplicationSourceType
+	// renderedSources are the Helm charts and remote kustomize bases which the target state was rendered from
+	renderedSources []v1alpha1.RenderedSource
+	// staleManifests is true if target state was loaded from the manifests cache because the repository was unreachable
+	staleManifests bool
 }
 
 // appStateManager allows to compare applications to git
@@ -85,6 +94,8 @@ type appStateManager struct {
 	repoClientset  apiclient.Clientset
 	liveStateCache statecache.LiveStateCache
 	namespace      string
+	// analysisWebhooks calls the analysis webhooks of sync operations in the background
+	analysisWebhooks *analysisWebhookRunner
 }
 
 func (m *appStateManager) getRepoObjs(app *v1alpha1.Application, source v1alpha1.ApplicationSource, appLabelKey, revision string, noCache bool) ([]*unstructured.Unstructured, []*unstructured.Unstructured, *apiclient.ManifestResponse, error) {
@@ -116,6 +127,21 @@ func (m *appStateManager) getRepoObjs(app *v1alpha1.Application, source v1alpha1
 		tools[i] = &plugins[i]
 	}
 
+	// a missing project is reported by the application validation; nothing can be decrypted, and no credentials of
+	// dependency repositories are used, without it
+	var decryptionKeys []string
+	var manifestQuota *appv1.ManifestQuota
+	proj, err := argo.GetAppProject(&app.Spec, listersv1alpha1.NewAppProjectLister(m.projInformer.GetIndexer()), m.namespace)
+	if err == nil {
+		decryptionKeys = proj.Spec.SourceDecryptionKeys
+		manifestQuota = proj.Spec.ManifestQuota
+		repos = proj.ScopeRepositoryCredentials(repos)
+	} else if apierr.IsNotFound(err) {
+		repos = appv1.AppProject{}.ScopeRepositoryCredentials(repos)
+	} else {
+		return nil, nil, nil, err
+	}
+
 	buildOptions, err := m.settingsMgr.GetKustomizeBuildOptions()
 	if err != nil {
 		return nil, nil, nil, err
@@ -128,6 +154,15 @@ func (m *appStateManager) getRepoObjs(app *v1alpha1.Application, source v1alpha1
 	if err != nil {
 		return nil, nil, nil, err
 	}
+	apiVersions, err := m.kubectl.GetAPIVersions(cluster.RESTConfig())
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	// the manifests of the last compared revision are reused if none of the paths of the application were changed since
+	var manifestGeneratePaths []string
+	if source.Path == app.Spec.Source.Path {
+		manifestGeneratePaths = apppath.ManifestGeneratePaths(app)
+	}
 	manifestInfo, err := repoClient.GenerateManifest(context.Background(), &apiclient.ManifestRequest{
 		Repo:              repo,
 		Repos:             repos,
@@ -141,19 +176,27 @@ func (m *appStateManager) getRepoObjs(app *v1alpha1.Application, source v1alpha1
 		KustomizeOptions: &appv1.KustomizeOptions{
 			BuildOptions: buildOptions,
 		},
-		KubeVersion: cluster.ServerVersion,
+		KubeVersion:            cluster.ServerVersion,
+		ApiVersions:            apiVersions,
+		DecryptionKeys:         decryptionKeys,
+		ParameterOverridesFile: app.Spec.GetParameterOverridesFile(),
+		ManifestGeneratePaths:  manifestGeneratePaths,
+		PreviousRevision:       app.Status.Sync.Revision,
+		ManifestQuota:          manifestQuota,
 	})
 	if err != nil {
 		return nil, nil, nil, err
 	}
-	targetObjs, hooks, err := unmarshalManifests(manifestInfo.Manifests)
+	targetObjs, hooks, err := unmarshalManifests(manifestInfo.Manifests, app.Spec.ResourceHooks)
 	if err != nil {
 		return nil, nil, nil, err
 	}
 	return targetObjs, hooks, manifestInfo, nil
 }
 
-func unmarshalManifests(manifests []string) ([]*unstructured.Unstructured, []*unstructured.Unstructured, error) {
+// unmarshalManifests unmarshals the given manifests and separates the hooks, including the resources which are hooks
+// according to the resource hooks of the application spec, from the other resources
+func unmarshalManifests(manifests []string, resourceHooks []v1alpha1.ResourceHook) ([]*unstructured.Unstructured, []*unstructured.Unstructured, error) {
 	targetObjs := make([]*unstructured.Unstructured, 0)
 	hooks := make([]*unstructured.Unstructured, 0)
 	for _, manifest := range manifests {
@@ -164,6 +207,7 @@ func unmarshalManifests(manifests []string) ([]*unstructured.Unstructured, []*un
 		if ignore.Ignore(obj) {
 			continue
 		}
+		hookutil.ApplyResourceHooks(obj, resourceHooks)
 		if hookutil.IsHook(obj) {
 			hooks = append(hooks, obj)
 		} else {
@@ -265,6 +309,21 @@ func (m *appStateManager) getComparisonSettings(app *appv1.Application) (string,
 // CompareAppState compares application git state to the live app state, using the specified
 // revision and supplied source. If revision or overrides are empty, then compares against
 // revision and overrides in the app spec.
+// getProgressingTimeout returns the progressing timeout of the application annotation, or zero if there is none, in
+// which case the timeouts of the resource kinds apply
+func getProgressingTimeout(app *v1alpha1.Application) time.Duration {
+	value, ok := app.Annotations[common.AnnotationKeyProgressingTimeout]
+	if !ok {
+		return 0
+	}
+	timeout, err := time.ParseDuration(value)
+	if err != nil || timeout <= 0 {
+		log.WithField("application", app.Name).Warnf("Ignoring invalid %s annotation '%s'", common.AnnotationKeyProgressingTimeout, value)
+		return 0
+	}
+	return timeout
+}
+
 func (m *appStateManager) CompareAppState(app *v1alpha1.Application, revision string, source v1alpha1.ApplicationSource, noCache bool, localManifests []string) *comparisonResult {
 	reconciledAt := metav1.Now()
 	appLabelKey, resourceOverrides, diffNormalizer, err := m.getComparisonSettings(app)
@@ -296,11 +355,20 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, revision st
 		targetObjs, hooks, manifestInfo, err = m.getRepoObjs(app, source, appLabelKey, revision, noCache)
 		if err != nil {
 			targetObjs = make([]*unstructured.Unstructured, 0)
-			conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: err.Error()})
+			conditionType := v1alpha1.ApplicationConditionComparisonError
+			if grpc_util.GetErrorReason(err) == grpc_util.ErrorReasonManifestQuotaExceeded {
+				conditionType = v1alpha1.ApplicationConditionManifestQuotaError
+			}
+			conditions = append(conditions, v1alpha1.ApplicationCondition{Type: conditionType, Message: err.Error()})
 			failedToLoadObjs = true
+		} else if manifestInfo.Stale {
+			conditions = append(conditions, v1alpha1.ApplicationCondition{
+				Type:    v1alpha1.ApplicationConditionStaleManifestsWarning,
+				Message: fmt.Sprintf("Repository is unreachable. Compared to the last manifests generated for revision %s", manifestInfo.Revision),
+			})
 		}
 	} else {
-		targetObjs, hooks, err = unmarshalManifests(localManifests)
+		targetObjs, hooks, err = unmarshalManifests(localManifests, app.Spec.ResourceHooks)
 		if err != nil {
 			targetObjs = make([]*unstructured.Unstructured, 0)
 			conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: err.Error()})
@@ -341,6 +409,17 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, revision st
 		failedToLoadObjs = true
 	}
 	logCtx.Debugf("Retrieved lived manifests")
+	// extra resources of kinds which are not cached are neither detected nor pruned
+	if skippedKinds, err := m.liveStateCache.GetSkippedKinds(app.Spec.Destination.Server); err == nil && len(skippedKinds) > 0 {
+		kinds := make([]string, len(skippedKinds))
+		for i, gk := range skippedKinds {
+			kinds[i] = gk.String()
+		}
+		conditions = append(conditions, v1alpha1.ApplicationCondition{
+			Type:    v1alpha1.ApplicationConditionIncompleteComparisonWarning,
+			Message: fmt.Sprintf("Resources of %s are not cached since they exceed the limit of objects per kind: resources of these kinds which are not defined by the application are not detected", strings.Join(kinds, ", ")),
+		})
+	}
 	for _, liveObj := range liveObjByKey {
 		if liveObj != nil {
 			appInstanceName := kubeutil.GetAppInstanceLabel(liveObj, appLabelKey)
@@ -385,7 +464,27 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, revision st
 		conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: err.Error()})
 	}
 
+	// resources which stay out of sync or progressing keep the time at which they were first detected to be so. A sync
+	// starts a new rollout, so resources which were progressing before the last sync started get the full timeout again.
+	var syncStartedAt *metav1.Time
+	if app.Status.OperationState != nil {
+		syncStartedAt = &app.Status.OperationState.StartedAt
+	}
+	outOfSyncSince := make(map[kubeutil.ResourceKey]*metav1.Time)
+	progressingSince := make(map[kubeutil.ResourceKey]*metav1.Time)
+	for _, res := range app.Status.Resources {
+		key := kubeutil.NewResourceKey(res.Group, res.Kind, res.Namespace, res.Name)
+		if res.Status == v1alpha1.SyncStatusCodeOutOfSync && res.OutOfSyncSince != nil {
+			outOfSyncSince[key] = res.OutOfSyncSince
+		}
+		if res.ProgressingSince != nil && (syncStartedAt == nil || !res.ProgressingSince.Before(syncStartedAt)) {
+			progressingSince[key] = res.ProgressingSince
+		}
+	}
+	now := metav1.Now()
+
 	syncCode := v1alpha1.SyncStatusCodeSynced
+	var argoCDPrunes []string
 	managedResources := make([]managedResource, len(targetObjs))
 	resourceSummaries := make([]v1alpha1.ResourceStatus, len(targetObjs))
 	for i, targetObj := range targetObjs {
@@ -408,6 +507,10 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, revision st
 			Hook:            hookutil.IsHook(obj),
 			RequiresPruning: targetObj == nil && liveObj != nil,
 		}
+		resState.ProgressingSince = progressingSince[kubeutil.NewResourceKey(resState.Group, resState.Kind, resState.Namespace, resState.Name)]
+		if resState.RequiresPruning && !resState.Hook && argo.IsArgoCDComponent(liveObj) {
+			argoCDPrunes = append(argoCDPrunes, fmt.Sprintf("%s/%s", resState.Kind, resState.Name))
+		}
 
 		diffResult := diffResults.Diffs[i]
 		if resState.Hook || ignore.Ignore(obj) {
@@ -418,6 +521,10 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, revision st
 			// * target resource not defined and live resource is extra
 			// * target resource present but live resource is missing
 			resState.Status = v1alpha1.SyncStatusCodeOutOfSync
+			resState.OutOfSyncSince = outOfSyncSince[kubeutil.NewResourceKey(resState.Group, resState.Kind, resState.Namespace, resState.Name)]
+			if resState.OutOfSyncSince == nil {
+				resState.OutOfSyncSince = &now
+			}
 			// we ignore the status if the obj needs pruning AND we have the annotation
 			needsPruning := targetObj == nil && liveObj != nil
 			if !(needsPruning && resource.HasAnnotationOption(obj, common.AnnotationCompareOptions, "IgnoreExtraneous")) {
@@ -443,6 +550,13 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, revision st
 	if failedToLoadObjs {
 		syncCode = v1alpha1.SyncStatusCodeUnknown
 	}
+	// pruning the components of Argo CD, e.g. by the application which manages Argo CD itself, might break Argo CD
+	if len(argoCDPrunes) > 0 {
+		conditions = append(conditions, v1alpha1.ApplicationCondition{
+			Type:    v1alpha1.ApplicationConditionArgoCDPruneWarning,
+			Message: fmt.Sprintf("Pruning would delete components of Argo CD: %s", strings.Join(argoCDPrunes, ", ")),
+		})
+	}
 	syncStatus := v1alpha1.SyncStatus{
 		ComparedTo: appv1.ComparedTo{
 			Source:      source,
@@ -454,7 +568,7 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, revision st
 		syncStatus.Revision = manifestInfo.Revision
 	}
 
-	healthStatus, err := health.SetApplicationHealth(resourceSummaries, GetLiveObjs(managedResources), resourceOverrides, func(obj *unstructured.Unstructured) bool {
+	healthStatus, err := health.SetApplicationHealth(resourceSummaries, GetLiveObjs(managedResources), resourceOverrides, getProgressingTimeout(app), func(obj *unstructured.Unstructured) bool {
 		return !isSelfReferencedApp(app, kubeutil.GetObjectRef(obj))
 	})
 
@@ -474,24 +588,39 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, revision st
 	}
 	if manifestInfo != nil {
 		compRes.appSourceType = v1alpha1.ApplicationSourceType(manifestInfo.SourceType)
+		compRes.renderedSources = manifestInfo.RenderedSources
+		compRes.staleManifests = manifestInfo.Stale
 	}
 	return &compRes
 }
 
-func (m *appStateManager) persistRevisionHistory(app *v1alpha1.Application, revision string, source v1alpha1.ApplicationSource) error {
+func (m *appStateManager) persistRevisionHistory(app *v1alpha1.Application, revision string, source v1alpha1.ApplicationSource, initiatedBy v1alpha1.OperationInitiator, imageUpdate *v1alpha1.ImageUpdate, automatedRollback *v1alpha1.AutomatedRollback, pinned bool) error {
+	defaultLimit, err := m.settingsMgr.GetRevisionHistoryLimit()
+	if err != nil {
+		return err
+	}
 	var nextID int64
 	if len(app.Status.History) > 0 {
 		nextID = app.Status.History[len(app.Status.History)-1].ID + 1
 	}
 	history := append(app.Status.History, v1alpha1.RevisionHistory{
-		Revision:   revision,
-		DeployedAt: metav1.NewTime(time.Now().UTC()),
-		ID:         nextID,
-		Source:     source,
+		Revision:          revision,
+		DeployedAt:        metav1.NewTime(time.Now().UTC()),
+		ID:                nextID,
+		Source:            source,
+		InitiatedBy:       initiatedBy,
+		ImageUpdate:       imageUpdate,
+		AutomatedRollback: automatedRollback,
+		Pinned:            pinned,
 	})
 
-	if len(history) > common.RevisionHistoryLimit {
-		history = history[1 : common.RevisionHistoryLimit+1]
+	// the last entry is always kept since it holds the deployed revision and the next ID is derived from it
+	limit := app.Spec.GetRevisionHistoryLimit(defaultLimit)
+	if limit < 1 {
+		limit = 1
+	}
+	if len(history) > limit {
+		history = history[len(history)-limit:]
 	}
 
 	patch, err := json.Marshal(map[string]map[string][]v1alpha1.RevisionHistory{
@@ -502,7 +631,7 @@ func (m *appStateManager) persistRevisionHistory(app *v1alpha1.Application, revi
 	if err != nil {
 		return err
 	}
-	_, err = m.appclientset.ArgoprojV1alpha1().Applications(m.namespace).Patch(app.Name, types.MergePatchType, patch)
+	_, err = m.appclientset.ArgoprojV1alpha1().Applications(m.namespace).Patch(app.Name, types.MergePatchType, patch, "status")
 	return err
 }
 
@@ -517,16 +646,18 @@ func NewAppStateManager(
 	liveStateCache statecache.LiveStateCache,
 	projInformer cache.SharedIndexInformer,
 	metricsServer *metrics.MetricsServer,
+	onAnalysisWebhookCompleted func(appName string),
 ) AppStateManager {
 	return &appStateManager{
-		liveStateCache: liveStateCache,
-		db:             db,
-		appclientset:   appclientset,
-		kubectl:        kubectl,
-		repoClientset:  repoClientset,
-		namespace:      namespace,
-		settingsMgr:    settingsMgr,
-		projInformer:   projInformer,
-		metricsServer:  metricsServer,
+		liveStateCache:   liveStateCache,
+		db:               db,
+		appclientset:     appclientset,
+		kubectl:          kubectl,
+		repoClientset:    repoClientset,
+		namespace:        namespace,
+		settingsMgr:      settingsMgr,
+		projInformer:     projInformer,
+		metricsServer:    metricsServer,
+		analysisWebhooks: newAnalysisWebhookRunner(onAnalysisWebhookCompleted),
 	}
 }
@@ -2,18 +2,23 @@ package controller
 
 import (
 	"encoding/json"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
 	v1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/argoproj/argo-cd/common"
 	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/reposerver/apiclient"
 	"github.com/argoproj/argo-cd/test"
+	grpc_util "github.com/argoproj/argo-cd/util/grpc"
 	"github.com/argoproj/argo-cd/util/kube"
 )
 
@@ -39,6 +44,65 @@ func TestCompareAppStateEmpty(t *testing.T) {
 	assert.Len(t, compRes.conditions, 0)
 }
 
+// TestCompareAppStateRenderedSources tests that the charts which the manifests were rendered from are recorded
+func TestCompareAppStateRenderedSources(t *testing.T) {
+	app := newFakeApp()
+	sources := []argoappv1.RenderedSource{{Type: argoappv1.ApplicationSourceTypeHelm, Name: "redis", Version: "3.6.5", AppVersion: "4.0.10"}}
+	data := fakeData{
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests:       []string{},
+			Namespace:       test.FakeDestNamespace,
+			Server:          test.FakeClusterURL,
+			Revision:        "abc123",
+			SourceType:      string(argoappv1.ApplicationSourceTypeHelm),
+			RenderedSources: sources,
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(&data)
+	compRes := ctrl.appStateManager.CompareAppState(app, "", app.Spec.Source, false, nil)
+	assert.Equal(t, argoappv1.ApplicationSourceTypeHelm, compRes.appSourceType)
+	assert.Equal(t, sources, compRes.renderedSources)
+}
+
+// TestCompareAppStateStaleManifests tests comparison with manifests which were served stale by the repo server
+func TestCompareAppStateStaleManifests(t *testing.T) {
+	app := newFakeApp()
+	data := fakeData{
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+			Stale:     true,
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(&data)
+	compRes := ctrl.appStateManager.CompareAppState(app, "", app.Spec.Source, false, nil)
+	assert.True(t, compRes.staleManifests)
+	assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
+	assert.Len(t, compRes.conditions, 1)
+	assert.Equal(t, argoappv1.ApplicationConditionStaleManifestsWarning, compRes.conditions[0].Type)
+	assert.False(t, compRes.conditions[0].IsError())
+}
+
+// TestCompareAppStateManifestQuotaExceeded tests that manifests exceeding the quota of the project fail the comparison
+func TestCompareAppStateManifestQuotaExceeded(t *testing.T) {
+	app := newFakeApp()
+	data := fakeData{
+		manifestErr: grpc_util.WrapError(fmt.Errorf("manifests exceed the quota of the project: 3 resources rendered, at most 2 allowed"),
+			codes.ResourceExhausted, grpc_util.ErrorReasonManifestQuotaExceeded),
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(&data)
+	compRes := ctrl.appStateManager.CompareAppState(app, "", app.Spec.Source, false, nil)
+	assert.Equal(t, argoappv1.SyncStatusCodeUnknown, compRes.syncStatus.Status)
+	assert.Len(t, compRes.conditions, 1)
+	assert.Equal(t, argoappv1.ApplicationConditionManifestQuotaError, compRes.conditions[0].Type)
+	assert.Contains(t, compRes.conditions[0].Message, "3 resources rendered, at most 2 allowed")
+}
+
 // TestCompareAppStateMissing tests when there is a manifest defined in the repo which doesn't exist in live
 func TestCompareAppStateMissing(t *testing.T) {
 	app := newFakeApp()
@@ -88,6 +152,91 @@ func TestCompareAppStateExtra(t *testing.T) {
 	assert.Equal(t, 0, len(compRes.conditions))
 }
 
+// TestCompareAppStateSkippedKinds tests that the comparison is marked as incomplete if kinds are not cached
+func TestCompareAppStateSkippedKinds(t *testing.T) {
+	app := newFakeApp()
+	data := fakeData{
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+		skippedKinds:    []schema.GroupKind{{Group: "apps", Kind: "ReplicaSet"}, {Kind: "Secret"}},
+	}
+	ctrl := newFakeController(&data)
+	compRes := ctrl.appStateManager.CompareAppState(app, "", app.Spec.Source, false, nil)
+	assert.Len(t, compRes.conditions, 1)
+	assert.Equal(t, argoappv1.ApplicationConditionIncompleteComparisonWarning, compRes.conditions[0].Type)
+	assert.Contains(t, compRes.conditions[0].Message, "ReplicaSet.apps, Secret")
+}
+
+// TestCompareAppStateOutOfSyncSince tests that the time at which a resource drifted is kept while it stays out of sync
+func TestCompareAppStateOutOfSyncSince(t *testing.T) {
+	pod := test.NewPod()
+	pod.SetNamespace(test.FakeDestNamespace)
+	app := newFakeApp()
+	key := kube.ResourceKey{Group: "", Kind: "Pod", Namespace: test.FakeDestNamespace, Name: pod.GetName()}
+	data := fakeData{
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{
+			key: pod,
+		},
+	}
+	ctrl := newFakeController(&data)
+
+	compRes := ctrl.appStateManager.CompareAppState(app, "", app.Spec.Source, false, nil)
+	assert.Len(t, compRes.resources, 1)
+	assert.NotNil(t, compRes.resources[0].OutOfSyncSince)
+
+	since := metav1.NewTime(time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC))
+	app.Status.Resources = []argoappv1.ResourceStatus{{
+		Kind: "Pod", Namespace: test.FakeDestNamespace, Name: pod.GetName(), Status: argoappv1.SyncStatusCodeOutOfSync, OutOfSyncSince: &since,
+	}}
+	compRes = ctrl.appStateManager.CompareAppState(app, "", app.Spec.Source, false, nil)
+	assert.Equal(t, &since, compRes.resources[0].OutOfSyncSince)
+}
+
+// TestCompareAppStateProgressingSince tests that the time since which a resource is progressing is reset by a sync
+func TestCompareAppStateProgressingSince(t *testing.T) {
+	pod := test.NewPod()
+	pod.SetNamespace(test.FakeDestNamespace)
+	assert.NoError(t, unstructured.SetNestedField(pod.Object, "Pending", "status", "phase"))
+	app := newFakeApp()
+	key := kube.ResourceKey{Group: "", Kind: "Pod", Namespace: test.FakeDestNamespace, Name: pod.GetName()}
+	data := fakeData{
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{
+			key: pod,
+		},
+	}
+	ctrl := newFakeController(&data)
+
+	since := metav1.NewTime(time.Now().Add(-time.Hour))
+	app.Status.Resources = []argoappv1.ResourceStatus{{
+		Kind: "Pod", Namespace: test.FakeDestNamespace, Name: pod.GetName(), ProgressingSince: &since,
+	}}
+	app.Status.OperationState = &argoappv1.OperationState{StartedAt: metav1.NewTime(since.Add(-time.Minute))}
+	compRes := ctrl.appStateManager.CompareAppState(app, "", app.Spec.Source, false, nil)
+	assert.Len(t, compRes.resources, 1)
+	assert.Equal(t, &since, compRes.resources[0].ProgressingSince)
+
+	app.Status.OperationState.StartedAt = metav1.NewTime(since.Add(time.Minute))
+	compRes = ctrl.appStateManager.CompareAppState(app, "", app.Spec.Source, false, nil)
+	assert.True(t, compRes.resources[0].ProgressingSince.After(app.Status.OperationState.StartedAt.Time))
+}
+
 // TestCompareAppStateHook checks that hooks are detected during manifest generation, and not
 // considered as part of resources when assessing Synced status
 func TestCompareAppStateHook(t *testing.T) {
@@ -115,6 +264,30 @@ func TestCompareAppStateHook(t *testing.T) {
 	assert.Equal(t, 0, len(compRes.conditions))
 }
 
+// checks that resources which are hooks according to the application spec are treated like annotated hooks
+func TestCompareAppStateResourceHook(t *testing.T) {
+	pod := test.NewPod()
+	podBytes, _ := json.Marshal(pod)
+	app := newFakeApp()
+	app.Spec.ResourceHooks = []argoappv1.ResourceHook{{Kind: "Pod", Name: pod.GetName(), Hook: []argoappv1.HookType{argoappv1.HookTypePreSync}}}
+	data := fakeData{
+		apps: []runtime.Object{app},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{string(podBytes)},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(&data)
+	compRes := ctrl.appStateManager.CompareAppState(app, "", app.Spec.Source, false, nil)
+	assert.NotNil(t, compRes)
+	assert.Equal(t, 0, len(compRes.managedResources))
+	assert.Equal(t, 1, len(compRes.hooks))
+	assert.Equal(t, "PreSync", compRes.hooks[0].GetAnnotations()[common.AnnotationKeyHook])
+}
+
 // checks that ignore resources are detected, but excluded from status
 func TestCompareAppStateCompareOptionIgnoreExtraneous(t *testing.T) {
 	pod := test.NewPod()
@@ -3,6 +3,7 @@ package controller
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"reflect"
 	"sort"
 	"strings"
@@ -42,6 +43,7 @@ var syncIdPrefix uint64 = 0
 type syncContext struct {
 	resourceOverrides   map[string]v1alpha1.ResourceOverride
 	appName             string
+	appLabelKey         string
 	proj                *v1alpha1.AppProject
 	compareResult       *comparisonResult
 	config              *rest.Config
@@ -55,6 +57,10 @@ type syncContext struct {
 	syncRes             *v1alpha1.SyncOperationResult
 	syncResources       []v1alpha1.SyncOperationResource
 	opState             *v1alpha1.OperationState
+	analysis            *v1alpha1.SyncAnalysis
+	analysisWebhookURLs []*url.URL
+	analysisWebhooks    *analysisWebhookRunner
+	kindOrder           map[string]int
 	log                 *log.Entry
 	// lock to protect concurrent updates of the result list
 	lock sync.Mutex
@@ -81,6 +87,11 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 	if syncOp.Source == nil {
 		// normal sync case (where source is taken from app.spec.source)
 		source = app.Spec.Source
+		if syncOp.Pinned {
+			// pinned sync case (where the source targets the explicitly requested revision), so that the revision
+			// is recorded in the history and a rollback to the history entry deploys it again
+			source.TargetRevision = syncOp.Revision
+		}
 	} else {
 		// rollback case
 		source = *state.Operation.Sync.Source
@@ -119,6 +130,12 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 		state.Message = argo.FormatAppConditions(errConditions)
 		return
 	}
+	if compareResult.staleManifests {
+		// the cached manifests might be outdated, so they are never applied
+		state.Phase = v1alpha1.OperationError
+		state.Message = "Unable to sync while the repository is unreachable"
+		return
+	}
 
 	// We now have a concrete commit SHA. Save this in the sync result revision so that we remember
 	// what we should be syncing to when resuming operations.
@@ -166,11 +183,39 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 		return
 	}
 
+	appLabelKey, err := m.settingsMgr.GetAppInstanceLabelKey()
+	if err != nil {
+		state.Phase = v1alpha1.OperationError
+		state.Message = fmt.Sprintf("Failed to load application instance label key: %v", err)
+		return
+	}
+
+	kindOrderSettings, err := m.settingsMgr.GetKindOrder()
+	if err != nil {
+		state.Phase = v1alpha1.OperationError
+		state.Message = fmt.Sprintf("Failed to load kind order: %v", err)
+		return
+	}
+	kindOrder, err := getKindOrder(kindOrderSettings)
+	if err != nil {
+		state.Phase = v1alpha1.OperationError
+		state.Message = fmt.Sprintf("Invalid kind order: %v", err)
+		return
+	}
+
+	analysisWebhookURLs, err := m.settingsMgr.GetAnalysisWebhookURLs()
+	if err != nil {
+		state.Phase = v1alpha1.OperationError
+		state.Message = fmt.Sprintf("Failed to load analysis webhook URLs: %v", err)
+		return
+	}
+
 	atomic.AddUint64(&syncIdPrefix, 1)
 	syncId := fmt.Sprintf("%05d-%s", syncIdPrefix, rand.RandString(5))
 	syncCtx := syncContext{
 		resourceOverrides:   resourceOverrides,
 		appName:             app.Name,
+		appLabelKey:         appLabelKey,
 		proj:                proj,
 		compareResult:       compareResult,
 		config:              restConfig,
@@ -184,6 +229,10 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 		syncRes:             syncRes,
 		syncResources:       syncResources,
 		opState:             state,
+		analysis:            app.Spec.Analysis,
+		analysisWebhookURLs: analysisWebhookURLs,
+		analysisWebhooks:    m.analysisWebhooks,
+		kindOrder:           kindOrder,
 		log:                 log.WithFields(log.Fields{"application": app.Name, "syncId": syncId}),
 	}
 
@@ -198,7 +247,7 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 	syncCtx.log.WithField("duration", time.Since(start)).Info("sync/terminate complete")
 
 	if !syncOp.DryRun && !syncCtx.isSelectiveSync() && syncCtx.opState.Phase.Successful() {
-		err := m.persistRevisionHistory(app, compareResult.syncStatus.Revision, source)
+		err := m.persistRevisionHistory(app, compareResult.syncStatus.Revision, source, state.Operation.InitiatedBy, syncOp.ImageUpdate, syncOp.AutomatedRollback, syncOp.Pinned)
 		if err != nil {
 			syncCtx.setOperationPhase(v1alpha1.OperationError, fmt.Sprintf("failed to record sync to history: %v", err))
 		}
@@ -206,6 +255,42 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 }
 
 // sync has performs the actual apply or hook based sync
+// newSyncReport returns the report of a completed sync operation
+func newSyncReport(app *v1alpha1.Application, state *v1alpha1.OperationState) *v1alpha1.SyncReport {
+	report := &v1alpha1.SyncReport{
+		ID:          state.StartedAt.Unix(),
+		Phase:       state.Phase,
+		Message:     state.Message,
+		DryRun:      state.Operation.Sync.DryRun,
+		InitiatedBy: state.Operation.InitiatedBy,
+		StartedAt:   state.StartedAt,
+	}
+	if state.FinishedAt != nil {
+		report.FinishedAt = *state.FinishedAt
+		report.DurationSeconds = int64(state.FinishedAt.Sub(state.StartedAt.Time).Seconds())
+	}
+	if state.SyncResult != nil {
+		report.Revision = state.SyncResult.Revision
+		report.Source = state.SyncResult.Source
+		for _, res := range state.SyncResult.Resources {
+			switch {
+			case res.HookType != "":
+				report.Hooks = append(report.Hooks, res)
+			case res.Status == v1alpha1.ResultCodePruned || res.Status == v1alpha1.ResultCodePruneSkipped:
+				report.Pruned = append(report.Pruned, res)
+			default:
+				report.Applied = append(report.Applied, res)
+			}
+		}
+	}
+	for _, condition := range app.Status.Conditions {
+		if strings.HasSuffix(condition.Type, "Warning") {
+			report.Warnings = append(report.Warnings, fmt.Sprintf("%s: %s", condition.Type, condition.Message))
+		}
+	}
+	return report
+}
+
 func (sc *syncContext) sync() {
 	sc.log.WithFields(log.Fields{"isSelectiveSync": sc.isSelectiveSync(), "skipHooks": sc.skipHooks(), "started": sc.started()}).Info("syncing")
 	tasks, ok := sc.getSyncTasks()
@@ -239,6 +324,9 @@ func (sc *syncContext) sync() {
 			// update the hook's result
 			operationState, message := getOperationPhase(task.liveObj)
 			sc.setResourceResult(task, "", operationState, message)
+			if task.analysis && task.completed() {
+				sc.syncRes.Analysis = &v1alpha1.SyncAnalysisResult{Phase: operationState, Message: message}
+			}
 
 			// maybe delete the hook
 			if task.needsDeleting() {
@@ -270,7 +358,8 @@ func (sc *syncContext) sync() {
 	// if (a) we are multi-step and we have any running tasks,
 	// or (b) there are any running hooks,
 	// then wait...
-	multiStep := tasks.multiStep()
+	// The analysis webhook is only called once all the resources are healthy, so it makes the sync multi-step too.
+	multiStep := tasks.multiStep() || sc.analysisWebhook() != nil
 	if tasks.Any(func(t *syncTask) bool { return (multiStep || t.isHook()) && t.running() }) {
 		sc.setOperationPhase(v1alpha1.OperationRunning, "one or more tasks are running")
 		return
@@ -281,7 +370,11 @@ func (sc *syncContext) sync() {
 
 	// if there are any completed but unsuccessful tasks, sync is a failure.
 	if tasks.Any(func(t *syncTask) bool { return t.completed() && !t.successful() }) {
-		sc.setOperationFailed(syncFailTasks, "one or more synchronization tasks completed unsuccessfully")
+		if analysis := sc.syncRes.Analysis; analysis != nil && !analysis.Phase.Successful() {
+			sc.setOperationFailed(syncFailTasks, analysisFailedMessage(analysis))
+		} else {
+			sc.setOperationFailed(syncFailTasks, "one or more synchronization tasks completed unsuccessfully")
+		}
 		return
 	}
 
@@ -292,7 +385,7 @@ func (sc *syncContext) sync() {
 	// If no sync tasks were generated (e.g., in case all application manifests have been removed),
 	// the sync operation is successful.
 	if len(tasks) == 0 {
-		sc.setOperationPhase(v1alpha1.OperationSucceeded, "successfully synced (no more tasks)")
+		sc.setOperationSucceeded(syncFailTasks, "successfully synced (no more tasks)")
 		return
 	}
 
@@ -303,7 +396,7 @@ func (sc *syncContext) sync() {
 	// if it is the last phase/wave and the only remaining tasks are non-hooks, the we are successful
 	// EVEN if those objects subsequently degraded
 	// This handles the common case where neither hooks or waves are used and a sync equates to simply an (asynchronous) kubectl apply of manifests, which succeeds immediately.
-	complete := !tasks.Any(func(t *syncTask) bool { return t.phase != phase || wave != t.wave() || t.isHook() })
+	complete := sc.analysisWebhook() == nil && !tasks.Any(func(t *syncTask) bool { return t.phase != phase || wave != t.wave() || t.isHook() })
 
 	sc.log.WithFields(log.Fields{"phase": phase, "wave": wave, "tasks": tasks, "syncFailTasks": syncFailTasks}).Debug("filtering tasks in correct phase and wave")
 	tasks = tasks.Filter(func(t *syncTask) bool { return t.phase == phase && t.wave() == wave })
@@ -411,7 +504,15 @@ func (sc *syncContext) getSyncTasks() (_ syncTasks, successful bool) {
 
 	hookTasks := syncTasks{}
 	if !sc.skipHooks() {
-		for _, obj := range sc.compareResult.hooks {
+		hooks := sc.compareResult.hooks
+		analysisJob, err := sc.analysisJob(hooks)
+		if err != nil {
+			sc.log.Errorf("Failed to create analysis job: %v", err)
+			successful = false
+		} else if analysisJob != nil {
+			hooks = append(append([]*unstructured.Unstructured{}, hooks...), analysisJob)
+		}
+		for _, obj := range hooks {
 			for _, phase := range syncPhases(obj) {
 				// Hook resources names are deterministic, whether they are defined by the user (metadata.name),
 				// or formulated at the time of the operation (metadata.generateName). If user specifies
@@ -423,7 +524,7 @@ func (sc *syncContext) getSyncTasks() (_ syncTasks, successful bool) {
 					targetObj.SetName(fmt.Sprintf("%s%s", generateName, postfix))
 				}
 
-				hookTasks = append(hookTasks, &syncTask{phase: phase, targetObj: targetObj})
+				hookTasks = append(hookTasks, &syncTask{phase: phase, targetObj: targetObj, analysis: obj == analysisJob})
 			}
 		}
 	}
@@ -467,14 +568,55 @@ func (sc *syncContext) getSyncTasks() (_ syncTasks, successful bool) {
 		}
 	}
 
+	// resources which exist but are not tracked by any application are only taken over when
+	// adoption was explicitly requested, either for the whole operation or by the resource itself
+	for _, task := range tasks {
+		if task.isHook() || task.targetObj == nil || task.liveObj == nil || kube.GetAppInstanceLabel(task.liveObj, sc.appLabelKey) != "" {
+			continue
+		}
+		if sc.syncOp.Adopt || resource.HasAnnotationOption(task.targetObj, common.AnnotationSyncOptions, "Adopt=true") {
+			task.adopt = true
+		} else {
+			sc.setResourceResult(task, v1alpha1.ResultCodeSyncFailed, "", "resource already exists and is not managed by any application (use the adopt option to take ownership)")
+			successful = false
+		}
+	}
+
+	// pruning the deployments without which Argo CD cannot redeploy itself must be confirmed explicitly
+	if sc.syncOp.Prune && !sc.syncOp.PruneArgoCD {
+		for _, task := range tasks {
+			if task.isHook() || !task.isPrune() || task.liveObj == nil || !argo.IsCriticalArgoCDComponent(task.liveObj) {
+				continue
+			}
+			if !resource.HasAnnotationOption(task.liveObj, common.AnnotationSyncOptions, "Prune=false") {
+				sc.setResourceResult(task, v1alpha1.ResultCodeSyncFailed, "", "pruning a deployment of Argo CD itself requires the prune-argocd option")
+				successful = false
+			}
+		}
+	}
+
+	// resources which are tracked by another application are only overwritten if the project does not forbid it
+	if sc.proj.Spec.FailOnSharedResource {
+		for _, task := range tasks {
+			if task.targetObj == nil || task.liveObj == nil {
+				continue
+			}
+			if appName := kube.GetAppInstanceLabel(task.liveObj, sc.appLabelKey); appName != "" && appName != sc.appName {
+				sc.setResourceResult(task, v1alpha1.ResultCodeSyncFailed, "", fmt.Sprintf("%s/%s is part of a different application: %s", task.kind(), task.name(), appName))
+				successful = false
+			}
+		}
+	}
+
 	// check permissions
 	for _, task := range tasks {
 		serverRes, err := kube.ServerResourceForGroupVersionKind(sc.disco, task.groupVersionKind())
 		if err != nil {
 			// Special case for custom resources: if CRD is not yet known by the K8s API server,
 			// skip verification during `kubectl apply --dry-run` since we expect the CRD
-			// to be created during app synchronization.
-			if apierr.IsNotFound(err) && sc.hasCRDOfGroupKind(task.group(), task.kind()) {
+			// to be created during app synchronization, either by the app itself or, if the resource
+			// opts in, e.g. by an operator which is deployed in an earlier wave.
+			if apierr.IsNotFound(err) && (sc.hasCRDOfGroupKind(task.group(), task.kind()) || task.skipDryRunOnMissingResource()) {
 				sc.log.WithFields(log.Fields{"task": task}).Debug("skip dry-run for custom resource")
 				task.skipDryRun = true
 			} else {
@@ -493,7 +635,11 @@ func (sc *syncContext) getSyncTasks() (_ syncTasks, successful bool) {
 		}
 	}
 
-	sort.Sort(tasks)
+	if sc.kindOrder != nil {
+		sort.Sort(orderedSyncTasks{syncTasks: tasks, kindOrder: sc.kindOrder})
+	} else {
+		sort.Sort(tasks)
+	}
 
 	return tasks, successful
 }
@@ -546,7 +692,11 @@ func (sc *syncContext) ensureCRDReady(name string) {
 // applyObject performs a `kubectl apply` of a single resource
 func (sc *syncContext) applyObject(targetObj *unstructured.Unstructured, dryRun bool, force bool) (v1alpha1.ResultCode, string) {
 	validate := !resource.HasAnnotationOption(targetObj, common.AnnotationSyncOptions, "Validate=false")
-	message, err := sc.kubectl.ApplyResource(sc.config, targetObj, targetObj.GetNamespace(), dryRun, force, validate)
+	dryRunStrategy := kube.DryRunNone
+	if dryRun {
+		dryRunStrategy = kube.DryRunClient
+	}
+	message, err := sc.kubectl.ApplyResource(sc.config, targetObj, targetObj.GetNamespace(), dryRunStrategy, force, validate)
 	if err != nil {
 		return v1alpha1.ResultCodeSyncFailed, err.Error()
 	}
@@ -562,6 +712,8 @@ func (sc *syncContext) pruneObject(liveObj *unstructured.Unstructured, prune, dr
 		return v1alpha1.ResultCodePruneSkipped, "ignored (requires pruning)"
 	} else if resource.HasAnnotationOption(liveObj, common.AnnotationSyncOptions, "Prune=false") {
 		return v1alpha1.ResultCodePruneSkipped, "ignored (no prune)"
+	} else if argo.IsArgoCDCRD(liveObj) {
+		return v1alpha1.ResultCodePruneSkipped, "ignored (the CRDs of Argo CD are never pruned)"
 	} else {
 		if dryRun {
 			return v1alpha1.ResultCodePruned, "pruned (dry run)"
@@ -739,6 +891,8 @@ func (sc *syncContext) runTasks(tasks syncTasks, dryRun bool) runState {
 					result, message := sc.applyObject(t.targetObj, dryRun, sc.syncOp.SyncStrategy.Force())
 					if result == v1alpha1.ResultCodeSyncFailed {
 						runState = failed
+					} else if t.adopt {
+						message = fmt.Sprintf("%s (adopted)", message)
 					}
 					if !dryRun || result == v1alpha1.ResultCodeSyncFailed {
 						sc.setResourceResult(t, result, operationPhases[result], message)
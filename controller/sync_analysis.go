@@ -0,0 +1,254 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/kube"
+	"github.com/argoproj/argo-cd/util/resource/syncwaves"
+)
+
+const (
+	// maxAnalysisAppNameLength keeps the names of analysis jobs, including the generated postfix, below the 63
+	// characters which are allowed in the job-name label of their pods
+	maxAnalysisAppNameLength = 26
+)
+
+// analysisRequest is the body of the requests sent to analysis webhooks
+type analysisRequest struct {
+	Application string                   `json:"application"`
+	Project     string                   `json:"project"`
+	Server      string                   `json:"server"`
+	Namespace   string                   `json:"namespace"`
+	Revision    string                   `json:"revision"`
+	StartedAt   metav1.Time              `json:"startedAt"`
+	Resources   v1alpha1.ResourceResults `json:"resources,omitempty"`
+}
+
+// analysisWebhook returns the analysis webhook of the application, or nil if the operation is not analysed by one.
+// Like hooks, the analysis only runs for syncs of the whole application which are not dry runs.
+func (sc *syncContext) analysisWebhook() *v1alpha1.SyncAnalysisWebhook {
+	if sc.analysis == nil || sc.skipHooks() || sc.syncOp.DryRun {
+		return nil
+	}
+	return sc.analysis.Webhook
+}
+
+// analysisJob returns the analysis job of the application as a PostSync hook which runs after all the other PostSync
+// hooks, or nil if the application does not define one
+func (sc *syncContext) analysisJob(hooks []*unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	if sc.analysis == nil || sc.analysis.Job == nil {
+		return nil, nil
+	}
+	wave := 0
+	for _, obj := range hooks {
+		for _, phase := range syncPhases(obj) {
+			if phase == v1alpha1.SyncPhasePostSync && syncwaves.Wave(obj) >= wave {
+				wave = syncwaves.Wave(obj) + 1
+			}
+		}
+	}
+
+	spec := sc.analysis.Job
+	env := []v1.EnvVar{
+		{Name: "ARGOCD_APP_NAME", Value: sc.appName},
+		{Name: "ARGOCD_APP_NAMESPACE", Value: sc.namespace},
+		{Name: "ARGOCD_APP_REVISION", Value: sc.syncRes.Revision},
+	}
+	for _, entry := range spec.Env {
+		env = append(env, v1.EnvVar{Name: entry.Name, Value: entry.Value})
+	}
+	backoffLimit := int32(spec.BackoffLimit)
+	job := batchv1.Job{
+		TypeMeta: metav1.TypeMeta{APIVersion: "batch/v1", Kind: kube.JobKind},
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: fmt.Sprintf("%s-analysis-", truncate(sc.appName, maxAnalysisAppNameLength)),
+			Annotations: map[string]string{
+				common.AnnotationKeyHook:  string(v1alpha1.HookTypePostSync),
+				common.AnnotationSyncWave: fmt.Sprintf("%d", wave),
+			},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: &backoffLimit,
+			Template: v1.PodTemplateSpec{
+				Spec: v1.PodSpec{
+					RestartPolicy:      v1.RestartPolicyNever,
+					ServiceAccountName: spec.ServiceAccountName,
+					Containers: []v1.Container{{
+						Name:    "analysis",
+						Image:   spec.Image,
+						Command: spec.Command,
+						Args:    spec.Args,
+						Env:     env,
+					}},
+				},
+			},
+		},
+	}
+	if spec.TimeoutSeconds > 0 {
+		job.Spec.ActiveDeadlineSeconds = &spec.TimeoutSeconds
+	}
+	data, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&job)
+	if err != nil {
+		return nil, err
+	}
+	obj := &unstructured.Unstructured{Object: data}
+	if err := kube.SetAppInstanceLabel(obj, sc.appLabelKey, sc.appName); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// analysisWebhookRunner calls analysis webhooks in the background, so that slow webhooks do not block the operation
+// workers, and notifies the controller once a webhook responded
+type analysisWebhookRunner struct {
+	lock sync.Mutex
+	// results are the results of the webhook calls by key, nil while the call is running
+	results     map[string]*v1alpha1.SyncAnalysisResult
+	onCompleted func(appName string)
+}
+
+func newAnalysisWebhookRunner(onCompleted func(appName string)) *analysisWebhookRunner {
+	return &analysisWebhookRunner{results: make(map[string]*v1alpha1.SyncAnalysisResult), onCompleted: onCompleted}
+}
+
+// result returns the result of the webhook call with the given key, or nil until the call completed. The call is
+// started unless it is running already.
+func (r *analysisWebhookRunner) result(appName string, key string, call func() (v1alpha1.OperationPhase, string)) *v1alpha1.SyncAnalysisResult {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if result, ok := r.results[key]; ok {
+		if result != nil {
+			delete(r.results, key)
+		}
+		return result
+	}
+	r.results[key] = nil
+	go func() {
+		phase, message := call()
+		r.lock.Lock()
+		r.results[key] = &v1alpha1.SyncAnalysisResult{Phase: phase, Message: message}
+		r.lock.Unlock()
+		if r.onCompleted != nil {
+			r.onCompleted(appName)
+		}
+	}()
+	return nil
+}
+
+// analysisWebhookAllowed returns whether the URL has the scheme and host of one of the allowed URLs and is below its
+// path
+func analysisWebhookAllowed(webhookURL string, allowed []*url.URL) bool {
+	u, err := url.Parse(webhookURL)
+	if err != nil || u.Opaque != "" || u.User != nil {
+		return false
+	}
+	for _, segment := range strings.Split(u.Path, "/") {
+		if segment == ".." {
+			return false
+		}
+	}
+	for _, allowedURL := range allowed {
+		if u.Scheme != allowedURL.Scheme || !strings.EqualFold(u.Host, allowedURL.Host) {
+			continue
+		}
+		prefix := strings.TrimSuffix(allowedURL.Path, "/")
+		if u.Path == prefix || strings.HasPrefix(u.Path, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// runAnalysisWebhook sends the details of the operation to the analysis webhook and returns whether it approved it
+func runAnalysisWebhook(webhook *v1alpha1.SyncAnalysisWebhook, body []byte) (v1alpha1.OperationPhase, string) {
+	client := &http.Client{
+		Timeout: webhook.GetTimeout(),
+		// redirects could lead to URLs which are not allowed
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	resp, err := client.Post(webhook.URL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return v1alpha1.OperationFailed, fmt.Sprintf("analysis webhook failed: %v", err)
+	}
+	_ = resp.Body.Close()
+	// the response body is not recorded, since it is shown to every user who can see the application
+	message := fmt.Sprintf("analysis webhook responded with %s", resp.Status)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return v1alpha1.OperationFailed, message
+	}
+	return v1alpha1.OperationSucceeded, message
+}
+
+// setOperationSucceeded marks the operation as successful unless the analysis webhook of the application rejects it
+func (sc *syncContext) setOperationSucceeded(syncFailTasks syncTasks, message string) {
+	webhook := sc.analysisWebhook()
+	if webhook == nil {
+		sc.setOperationPhase(v1alpha1.OperationSucceeded, message)
+		return
+	}
+	// the webhook is only called once; if it failed, the SyncFail hooks might still be running
+	if analysis := sc.syncRes.Analysis; analysis == nil || analysis.Phase.Successful() {
+		body, err := json.Marshal(analysisRequest{
+			Application: sc.appName,
+			Project:     sc.proj.Name,
+			Server:      sc.server,
+			Namespace:   sc.namespace,
+			Revision:    sc.syncRes.Revision,
+			StartedAt:   sc.opState.StartedAt,
+			Resources:   sc.syncRes.Resources,
+		})
+		if err != nil {
+			sc.syncRes.Analysis = &v1alpha1.SyncAnalysisResult{Phase: v1alpha1.OperationError, Message: err.Error()}
+		} else if !analysisWebhookAllowed(webhook.URL, sc.analysisWebhookURLs) {
+			sc.syncRes.Analysis = &v1alpha1.SyncAnalysisResult{
+				Phase:   v1alpha1.OperationError,
+				Message: "analysis webhook URL is not allowed by analysis.webhookURLs in argocd-cm",
+			}
+		} else {
+			key := fmt.Sprintf("%s/%d", sc.appName, sc.opState.StartedAt.Unix())
+			logCtx := sc.log
+			webhook := webhook.DeepCopy()
+			result := sc.analysisWebhooks.result(sc.appName, key, func() (v1alpha1.OperationPhase, string) {
+				logCtx.WithField("url", webhook.URL).Info("running analysis webhook")
+				return runAnalysisWebhook(webhook, body)
+			})
+			if result == nil {
+				sc.setOperationPhase(v1alpha1.OperationRunning, "waiting for the analysis webhook")
+				return
+			}
+			sc.syncRes.Analysis = result
+		}
+	}
+	if !sc.syncRes.Analysis.Phase.Successful() {
+		sc.setOperationFailed(syncFailTasks, analysisFailedMessage(sc.syncRes.Analysis))
+		return
+	}
+	sc.setOperationPhase(v1alpha1.OperationSucceeded, fmt.Sprintf("%s, analysis succeeded", message))
+}
+
+func analysisFailedMessage(analysis *v1alpha1.SyncAnalysisResult) string {
+	return fmt.Sprintf("post-sync analysis failed: %s", analysis.Message)
+}
+
+func truncate(s string, length int) string {
+	if len(s) > length {
+		return s[:length]
+	}
+	return s
+}
@@ -6,8 +6,10 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
+	"github.com/argoproj/argo-cd/common"
 	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/util/hook"
+	"github.com/argoproj/argo-cd/util/resource"
 	"github.com/argoproj/argo-cd/util/resource/syncwaves"
 )
 
@@ -19,6 +21,8 @@ type syncTask struct {
 	liveObj        *unstructured.Unstructured
 	targetObj      *unstructured.Unstructured
 	skipDryRun     bool
+	adopt          bool
+	analysis       bool
 	syncStatus     v1alpha1.ResultCode
 	operationState v1alpha1.OperationPhase
 	message        string
@@ -55,6 +59,11 @@ func (t *syncTask) wave() int {
 	return syncwaves.Wave(t.obj())
 }
 
+// skipDryRunOnMissingResource returns true if the resource opted in to skip the dry-run if its kind is not known yet
+func (t *syncTask) skipDryRunOnMissingResource() bool {
+	return t.targetObj != nil && resource.HasAnnotationOption(t.targetObj, common.AnnotationSyncOptions, "SkipDryRunOnMissingResource=true")
+}
+
 func (t *syncTask) isHook() bool {
 	return hook.IsHook(t.obj())
 }
@@ -1,9 +1,11 @@
 package controller
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/settings"
 )
 
 // kindOrder represents the correct order of Kubernetes resources within a manifest
@@ -16,42 +18,80 @@ var syncPhaseOrder = map[v1alpha1.SyncPhase]int{
 
 // kindOrder represents the correct order of Kubernetes resources within a manifest
 // https://github.com/helm/helm/blob/master/pkg/tiller/kind_sorter.go
-var kindOrder = map[string]int{}
-
-func init() {
-	kinds := []string{
-		"Namespace",
-		"ResourceQuota",
-		"LimitRange",
-		"PodSecurityPolicy",
-		"PodDisruptionBudget",
-		"Secret",
-		"ConfigMap",
-		"StorageClass",
-		"PersistentVolume",
-		"PersistentVolumeClaim",
-		"ServiceAccount",
-		"CustomResourceDefinition",
-		"ClusterRole",
-		"ClusterRoleBinding",
-		"Role",
-		"RoleBinding",
-		"Service",
-		"DaemonSet",
-		"Pod",
-		"ReplicationController",
-		"ReplicaSet",
-		"Deployment",
-		"StatefulSet",
-		"Job",
-		"CronJob",
-		"Ingress",
-		"APIService",
-	}
+var kindOrder = newKindOrder(kinds)
+
+// kinds are the built-in kinds in the order in which they are applied
+var kinds = []string{
+	"Namespace",
+	"ResourceQuota",
+	"LimitRange",
+	"PodSecurityPolicy",
+	"PodDisruptionBudget",
+	"Secret",
+	"ConfigMap",
+	"StorageClass",
+	"PersistentVolume",
+	"PersistentVolumeClaim",
+	"ServiceAccount",
+	"CustomResourceDefinition",
+	"ClusterRole",
+	"ClusterRoleBinding",
+	"Role",
+	"RoleBinding",
+	"Service",
+	"DaemonSet",
+	"Pod",
+	"ReplicationController",
+	"ReplicaSet",
+	"Deployment",
+	"StatefulSet",
+	"Job",
+	"CronJob",
+	"Ingress",
+	"APIService",
+}
+
+func newKindOrder(kinds []string) map[string]int {
+	order := make(map[string]int, len(kinds))
 	for i, kind := range kinds {
 		// make sure none of the above entries are zero, we need that for custom resources
-		kindOrder[kind] = i - len(kinds)
+		order[kind] = i - len(kinds)
 	}
+	return order
+}
+
+// getKindOrder returns the built-in kind order with the kinds of the settings inserted into it. Built-in kinds can be
+// moved too.
+func getKindOrder(entries []settings.KindOrder) (map[string]int, error) {
+	if len(entries) == 0 {
+		return kindOrder, nil
+	}
+	order := append([]string{}, kinds...)
+	indexOf := func(kind string) int {
+		for i := range order {
+			if order[i] == kind {
+				return i
+			}
+		}
+		return -1
+	}
+	for _, entry := range entries {
+		if i := indexOf(entry.Kind); i >= 0 {
+			order = append(order[:i], order[i+1:]...)
+		}
+		i := len(order)
+		if entry.Before != "" || entry.After != "" {
+			i = indexOf(entry.Before + entry.After)
+			if i < 0 {
+				return nil, fmt.Errorf("cannot order kind %s relative to kind %s which is not ordered", entry.Kind, entry.Before+entry.After)
+			}
+			if entry.After != "" {
+				i++
+			}
+		}
+		order = append(order[:i], append([]string{entry.Kind}, order[i:]...)...)
+	}
+	return newKindOrder(order), nil
 }
 
 type syncTasks []*syncTask
@@ -70,6 +110,10 @@ func (s syncTasks) Swap(i, j int) {
 // 3. kind
 // 4. name
 func (s syncTasks) Less(i, j int) bool {
+	return s.less(i, j, kindOrder)
+}
+
+func (s syncTasks) less(i, j int, kindOrder map[string]int) bool {
 
 	tA := s[i]
 	tB := s[j]
@@ -97,6 +141,16 @@ func (s syncTasks) Less(i, j int) bool {
 	return a.GetName() < b.GetName()
 }
 
+// orderedSyncTasks sorts sync tasks using a kind order other than the built-in one
+type orderedSyncTasks struct {
+	syncTasks
+	kindOrder map[string]int
+}
+
+func (s orderedSyncTasks) Less(i, j int) bool {
+	return s.syncTasks.less(i, j, s.kindOrder)
+}
+
 func (s syncTasks) Filter(predicate func(task *syncTask) bool) (tasks syncTasks) {
 	for _, task := range s {
 		if predicate(task) {
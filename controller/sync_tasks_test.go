@@ -11,6 +11,7 @@ import (
 	"github.com/argoproj/argo-cd/common"
 	. "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	. "github.com/argoproj/argo-cd/test"
+	"github.com/argoproj/argo-cd/util/settings"
 )
 
 func Test_syncTasks_kindOrder(t *testing.T) {
@@ -19,6 +20,47 @@ func Test_syncTasks_kindOrder(t *testing.T) {
 	assert.Equal(t, 0, kindOrder["MyCRD"])
 }
 
+func Test_getKindOrder(t *testing.T) {
+	order, err := getKindOrder(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, kindOrder, order)
+
+	order, err = getKindOrder([]settings.KindOrder{
+		{Kind: "Issuer", Before: "Secret"},
+		{Kind: "Certificate", After: "Issuer"},
+		{Kind: "Prometheus"},
+		{Kind: "Namespace", After: "LimitRange"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, -30, order["ResourceQuota"])
+	assert.Equal(t, -29, order["LimitRange"])
+	assert.Equal(t, -28, order["Namespace"])
+	assert.Equal(t, -25, order["Issuer"])
+	assert.Equal(t, -24, order["Certificate"])
+	assert.Equal(t, -23, order["Secret"])
+	assert.Equal(t, -2, order["APIService"])
+	assert.Equal(t, -1, order["Prometheus"])
+	assert.Equal(t, 0, order["MyCRD"])
+	// the built-in order is unchanged
+	assert.Equal(t, -27, kindOrder["Namespace"])
+
+	_, err = getKindOrder([]settings.KindOrder{{Kind: "Issuer", Before: "Certificate"}})
+	assert.EqualError(t, err, "cannot order kind Issuer relative to kind Certificate which is not ordered")
+}
+
+func TestSortSyncTask_KindOrder(t *testing.T) {
+	order, err := getKindOrder([]settings.KindOrder{{Kind: "Issuer", Before: "Service"}})
+	assert.NoError(t, err)
+	issuer := &unstructured.Unstructured{Object: map[string]interface{}{"kind": "Issuer", "metadata": map[string]interface{}{"name": "b"}}}
+	tasks := syncTasks{{phase: SyncPhaseSync, targetObj: NewService()}, {phase: SyncPhaseSync, targetObj: issuer}}
+
+	sort.Sort(tasks)
+	assert.Equal(t, "Service", tasks[0].kind())
+
+	sort.Sort(orderedSyncTasks{syncTasks: tasks, kindOrder: order})
+	assert.Equal(t, "Issuer", tasks[0].kind())
+}
+
 func TestSortSyncTask(t *testing.T) {
 	sort.Sort(unsortedTasks)
 	assert.Equal(t, sortedTasks, unsortedTasks)
@@ -1,9 +1,14 @@
 package controller
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"reflect"
 	"testing"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
@@ -43,9 +48,11 @@ func newTestSyncCtx(resources ...*v1.APIResourceList) *syncContext {
 			},
 		})
 	sc := syncContext{
-		config:    &rest.Config{},
-		namespace: test.FakeArgoCDNamespace,
-		server:    test.FakeClusterURL,
+		config:      &rest.Config{},
+		appName:     "fake-app",
+		appLabelKey: common.LabelKeyAppInstance,
+		namespace:   test.FakeArgoCDNamespace,
+		server:      test.FakeClusterURL,
 		syncRes: &v1alpha1.SyncOperationResult{
 			Revision: "FooBarBaz",
 		},
@@ -69,9 +76,10 @@ func newTestSyncCtx(resources ...*v1.APIResourceList) *syncContext {
 				},
 			},
 		},
-		opState: &v1alpha1.OperationState{},
-		disco:   fakeDisco,
-		log:     log.WithFields(log.Fields{"application": "fake-app"}),
+		opState:          &v1alpha1.OperationState{},
+		disco:            fakeDisco,
+		log:              log.WithFields(log.Fields{"application": "fake-app"}),
+		analysisWebhooks: newAnalysisWebhookRunner(nil),
 	}
 	sc.kubectl = &kubetest.MockKubectlCmd{}
 	return &sc
@@ -338,6 +346,52 @@ func TestDontPrunePruneFalse(t *testing.T) {
 	assert.Equal(t, v1alpha1.OperationSucceeded, syncCtx.opState.Phase)
 }
 
+func TestPruneArgoCD(t *testing.T) {
+	crds := &v1.APIResourceList{
+		GroupVersion: "apiextensions.k8s.io/v1beta1",
+		APIResources: []v1.APIResource{{Kind: "CustomResourceDefinition", Group: "apiextensions.k8s.io", Version: "v1beta1"}},
+	}
+	server := test.NewDeployment()
+	server.SetName("argocd-server")
+	server.SetNamespace(test.FakeArgoCDNamespace)
+	crd := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apiextensions.k8s.io/v1beta1",
+		"kind":       "CustomResourceDefinition",
+		"metadata":   map[string]interface{}{"name": "applications.argoproj.io"},
+	}}
+
+	// pruning argocd-server requires a confirmation
+	syncCtx := newTestSyncCtx(crds)
+	syncCtx.syncOp.Prune = true
+	syncCtx.compareResult = &comparisonResult{managedResources: []managedResource{{Live: server}}}
+	syncCtx.sync()
+	assert.Equal(t, v1alpha1.OperationFailed, syncCtx.opState.Phase)
+	if assert.Len(t, syncCtx.syncRes.Resources, 1) {
+		assert.Contains(t, syncCtx.syncRes.Resources[0].Message, "prune-argocd")
+	}
+
+	syncCtx = newTestSyncCtx(crds)
+	syncCtx.syncOp.Prune = true
+	syncCtx.syncOp.PruneArgoCD = true
+	syncCtx.compareResult = &comparisonResult{managedResources: []managedResource{{Live: server}}}
+	syncCtx.sync()
+	assert.Equal(t, v1alpha1.OperationSucceeded, syncCtx.opState.Phase)
+	if assert.Len(t, syncCtx.syncRes.Resources, 1) {
+		assert.Equal(t, v1alpha1.ResultCodePruned, syncCtx.syncRes.Resources[0].Status)
+	}
+
+	// the CRDs of Argo CD are never pruned
+	syncCtx = newTestSyncCtx(crds)
+	syncCtx.syncOp.Prune = true
+	syncCtx.syncOp.PruneArgoCD = true
+	syncCtx.compareResult = &comparisonResult{managedResources: []managedResource{{Live: crd}}}
+	syncCtx.sync()
+	if assert.Len(t, syncCtx.syncRes.Resources, 1) {
+		assert.Equal(t, v1alpha1.ResultCodePruneSkipped, syncCtx.syncRes.Resources[0].Status)
+		assert.Equal(t, "ignored (the CRDs of Argo CD are never pruned)", syncCtx.syncRes.Resources[0].Message)
+	}
+}
+
 // make sure Validate=false means we don't validate
 func TestSyncOptionValidate(t *testing.T) {
 	tests := []struct {
@@ -354,6 +408,7 @@ func TestSyncOptionValidate(t *testing.T) {
 			syncCtx := newTestSyncCtx()
 			pod := test.NewPod()
 			pod.SetAnnotations(map[string]string{common.AnnotationSyncOptions: tt.annotationVal})
+			pod.SetLabels(map[string]string{common.LabelKeyAppInstance: "fake-app"})
 			pod.SetNamespace(test.FakeArgoCDNamespace)
 			syncCtx.compareResult = &comparisonResult{managedResources: []managedResource{{Target: pod, Live: pod}}}
 
@@ -365,6 +420,104 @@ func TestSyncOptionValidate(t *testing.T) {
 	}
 }
 
+func TestSyncOptionSkipDryRunOnMissingResource(t *testing.T) {
+	tests := []struct {
+		name          string
+		annotationVal string
+		want          bool
+	}{
+		{"Empty", "", false},
+		{"True", "SkipDryRunOnMissingResource=true", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			syncCtx := newTestSyncCtx(&v1.APIResourceList{
+				GroupVersion: "example.com/v1",
+				APIResources: []v1.APIResource{{Kind: "Bar", Group: "example.com", Version: "v1", Namespaced: true}},
+			})
+			cr := &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "example.com/v1",
+				"kind":       "Foo",
+				"metadata":   map[string]interface{}{"name": "my-foo", "namespace": test.FakeArgoCDNamespace},
+			}}
+			cr.SetAnnotations(map[string]string{common.AnnotationSyncOptions: tt.annotationVal})
+			syncCtx.compareResult = &comparisonResult{managedResources: []managedResource{{Target: cr}}}
+
+			tasks, successful := syncCtx.getSyncTasks()
+
+			assert.Equal(t, tt.want, successful)
+			assert.Len(t, tasks, 1)
+			assert.Equal(t, tt.want, tasks[0].skipDryRun)
+		})
+	}
+}
+
+func TestSyncAdopt(t *testing.T) {
+	tests := []struct {
+		name          string
+		adopt         bool
+		annotationVal string
+		want          v1alpha1.OperationPhase
+	}{
+		{"NotRequested", false, "", v1alpha1.OperationFailed},
+		{"Operation", true, "", v1alpha1.OperationSucceeded},
+		{"Annotation", false, "Adopt=true", v1alpha1.OperationSucceeded},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			syncCtx := newTestSyncCtx()
+			syncCtx.syncOp.Adopt = tt.adopt
+			live := test.NewPod()
+			live.SetNamespace(test.FakeArgoCDNamespace)
+			target := live.DeepCopy()
+			target.SetAnnotations(map[string]string{common.AnnotationSyncOptions: tt.annotationVal})
+			syncCtx.compareResult = &comparisonResult{managedResources: []managedResource{{Target: target, Live: live}}}
+
+			syncCtx.sync()
+
+			assert.Equal(t, tt.want, syncCtx.opState.Phase)
+			assert.Len(t, syncCtx.syncRes.Resources, 1)
+			if tt.want == v1alpha1.OperationSucceeded {
+				assert.Contains(t, syncCtx.syncRes.Resources[0].Message, "adopted")
+			} else {
+				assert.Contains(t, syncCtx.syncRes.Resources[0].Message, "not managed by any application")
+			}
+		})
+	}
+}
+
+func TestSyncFailOnSharedResource(t *testing.T) {
+	tests := []struct {
+		name                 string
+		failOnSharedResource bool
+		appName              string
+		want                 v1alpha1.OperationPhase
+	}{
+		{"Disabled", false, "other-app", v1alpha1.OperationSucceeded},
+		{"SameApp", true, "fake-app", v1alpha1.OperationSucceeded},
+		{"OtherApp", true, "other-app", v1alpha1.OperationFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			syncCtx := newTestSyncCtx()
+			syncCtx.proj.Spec.FailOnSharedResource = tt.failOnSharedResource
+			live := test.NewPod()
+			live.SetNamespace(test.FakeArgoCDNamespace)
+			live.SetLabels(map[string]string{common.LabelKeyAppInstance: tt.appName})
+			syncCtx.compareResult = &comparisonResult{managedResources: []managedResource{{Target: live.DeepCopy(), Live: live}}}
+
+			syncCtx.sync()
+
+			assert.Equal(t, tt.want, syncCtx.opState.Phase)
+			assert.Len(t, syncCtx.syncRes.Resources, 1)
+			if tt.want == v1alpha1.OperationFailed {
+				assert.Equal(t, v1alpha1.ResultCodeSyncFailed, syncCtx.syncRes.Resources[0].Status)
+				assert.Equal(t, "Pod/my-pod is part of a different application: other-app", syncCtx.syncRes.Resources[0].Message)
+			}
+		})
+	}
+}
+
 func TestSelectiveSyncOnly(t *testing.T) {
 	syncCtx := newTestSyncCtx()
 	pod1 := test.NewPod()
@@ -530,6 +683,45 @@ func TestPersistRevisionHistoryRollback(t *testing.T) {
 	assert.Equal(t, "abc123", updatedApp.Status.History[0].Revision)
 }
 
+func TestPersistRevisionHistoryPinned(t *testing.T) {
+	app := newFakeApp()
+	app.Status.OperationState = nil
+	app.Status.History = nil
+	defaultProject := &v1alpha1.AppProject{
+		ObjectMeta: v1.ObjectMeta{
+			Namespace: test.FakeArgoCDNamespace,
+			Name:      "default",
+		},
+	}
+	data := fakeData{
+		apps: []runtime.Object{app, defaultProject},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(&data)
+
+	// Sync to an explicitly requested revision
+	opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{
+		Sync: &v1alpha1.SyncOperation{Revision: "abc123", Pinned: true},
+	}}
+	ctrl.appStateManager.SyncAppState(app, opState)
+	assert.Equal(t, "abc123", opState.SyncResult.Source.TargetRevision)
+
+	updatedApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Get(app.Name, v1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(updatedApp.Status.History))
+	assert.True(t, updatedApp.Status.History[0].Pinned)
+	assert.Equal(t, "abc123", updatedApp.Status.History[0].Revision)
+	assert.Equal(t, "abc123", updatedApp.Status.History[0].Source.TargetRevision)
+	// the spec is left unchanged
+	assert.Equal(t, app.Spec.Source.TargetRevision, updatedApp.Spec.Source.TargetRevision)
+}
+
 func TestSyncFailureHookWithSuccessfulSync(t *testing.T) {
 	syncCtx := newTestSyncCtx()
 	syncCtx.syncOp.SyncStrategy.Apply = nil
@@ -688,3 +880,170 @@ func Test_syncContext_liveObj(t *testing.T) {
 		})
 	}
 }
+
+func TestNewSyncReport(t *testing.T) {
+	app := newFakeApp()
+	app.Status.Conditions = []ApplicationCondition{
+		{Type: ApplicationConditionSharedResourceWarning, Message: "shared"},
+		{Type: ApplicationConditionComparisonError, Message: "error"},
+	}
+	startedAt := metav1.Unix(1000, 0)
+	finishedAt := metav1.Unix(1042, 0)
+	state := &OperationState{
+		Operation:  Operation{Sync: &SyncOperation{}, InitiatedBy: OperationInitiator{Username: "admin"}},
+		Phase:      OperationSucceeded,
+		StartedAt:  startedAt,
+		FinishedAt: &finishedAt,
+		SyncResult: &SyncOperationResult{Revision: "abc", Resources: ResourceResults{
+			{Kind: "Pod", Name: "my-pod", Status: ResultCodeSynced},
+			{Kind: "Pod", Name: "my-hook", HookType: HookTypePreSync, HookPhase: OperationSucceeded},
+			{Kind: "Service", Name: "my-svc", Status: ResultCodePruned},
+		}},
+	}
+
+	report := newSyncReport(app, state)
+	assert.Equal(t, int64(1000), report.ID)
+	assert.Equal(t, int64(42), report.DurationSeconds)
+	assert.Equal(t, "abc", report.Revision)
+	assert.Equal(t, "admin", report.InitiatedBy.Username)
+	assert.Equal(t, "my-pod", report.Applied[0].Name)
+	assert.Equal(t, "my-hook", report.Hooks[0].Name)
+	assert.Equal(t, "my-svc", report.Pruned[0].Name)
+	assert.Equal(t, []string{"SharedResourceWarning: shared"}, report.Warnings)
+}
+
+func TestSyncAnalysisJob(t *testing.T) {
+	syncCtx := newTestSyncCtx(&v1.APIResourceList{
+		GroupVersion: "batch/v1",
+		APIResources: []v1.APIResource{{Kind: "Job", Group: "batch", Version: "v1", Namespaced: true}},
+	})
+	syncCtx.syncOp.SyncStrategy.Apply = nil
+	syncCtx.analysis = &SyncAnalysis{Job: &SyncAnalysisJob{Image: "smoke-test:latest", Args: []string{"--quick"}}}
+	postSyncHook := test.Annotate(test.NewHook(HookTypePostSync), common.AnnotationSyncWave, "2")
+	syncCtx.compareResult = &comparisonResult{
+		managedResources: []managedResource{{Target: test.NewPod()}},
+		hooks:            []*unstructured.Unstructured{postSyncHook},
+	}
+
+	tasks, successful := syncCtx.getSyncTasks()
+
+	assert.True(t, successful)
+	assert.Len(t, tasks, 3)
+	job := tasks[2]
+	assert.True(t, job.analysis)
+	assert.Equal(t, SyncPhasePostSync, job.phase)
+	assert.Equal(t, 3, job.wave())
+	assert.Equal(t, kube.JobKind, job.kind())
+	assert.Contains(t, job.name(), "fake-app-analysis-foobarb-postsync-")
+	assert.Equal(t, test.FakeArgoCDNamespace, job.namespace())
+	assert.Equal(t, "fake-app", kube.GetAppInstanceLabel(job.targetObj, common.LabelKeyAppInstance))
+	containers, _, _ := unstructured.NestedSlice(job.targetObj.Object, "spec", "template", "spec", "containers")
+	if assert.Len(t, containers, 1) {
+		container := containers[0].(map[string]interface{})
+		assert.Equal(t, "smoke-test:latest", container["image"])
+		assert.Contains(t, container["env"], map[string]interface{}{"name": "ARGOCD_APP_REVISION", "value": "FooBarBaz"})
+	}
+
+	// a failed analysis job fails the operation
+	syncCtx.setResourceResult(job, ResultCodeSynced, OperationFailed, "job failed")
+	syncCtx.syncRes.Analysis = &SyncAnalysisResult{Phase: OperationFailed, Message: "job failed"}
+	syncCtx.sync()
+	assert.Equal(t, OperationFailed, syncCtx.opState.Phase)
+	assert.Equal(t, "post-sync analysis failed: job failed", syncCtx.opState.Message)
+}
+
+// setOperationSucceededAfterAnalysis calls setOperationSucceeded again once the analysis webhook responded
+func setOperationSucceededAfterAnalysis(t *testing.T, syncCtx *syncContext) {
+	completed := make(chan string, 1)
+	syncCtx.analysisWebhooks.onCompleted = func(appName string) {
+		completed <- appName
+	}
+	syncCtx.setOperationSucceeded(nil, "successfully synced (no more tasks)")
+	if syncCtx.opState.Phase != OperationRunning {
+		return
+	}
+	assert.Equal(t, "waiting for the analysis webhook", syncCtx.opState.Message)
+	select {
+	case appName := <-completed:
+		assert.Equal(t, syncCtx.appName, appName)
+	case <-time.After(10 * time.Second):
+		t.Fatal("analysis webhook did not complete")
+	}
+	syncCtx.setOperationSucceeded(nil, "successfully synced (no more tasks)")
+}
+
+func TestSyncAnalysisWebhook(t *testing.T) {
+	var request analysisRequest
+	status := http.StatusInternalServerError
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte("error rate too high\n"))
+	}))
+	defer server.Close()
+	serverURL, err := url.Parse(server.URL)
+	assert.NoError(t, err)
+
+	syncCtx := newTestSyncCtx()
+	syncCtx.syncOp.SyncStrategy.Apply = nil
+	syncCtx.analysis = &SyncAnalysis{Webhook: &SyncAnalysisWebhook{URL: server.URL + "/analysis"}}
+
+	// webhooks are only called if their URL is allowed
+	setOperationSucceededAfterAnalysis(t, syncCtx)
+	assert.Equal(t, OperationFailed, syncCtx.opState.Phase)
+	assert.Equal(t, "post-sync analysis failed: analysis webhook URL is not allowed by analysis.webhookURLs in argocd-cm", syncCtx.opState.Message)
+
+	syncCtx.syncRes.Analysis = nil
+	syncCtx.analysisWebhookURLs = []*url.URL{serverURL}
+	setOperationSucceededAfterAnalysis(t, syncCtx)
+	assert.Equal(t, OperationFailed, syncCtx.opState.Phase)
+	assert.Equal(t, "post-sync analysis failed: analysis webhook responded with 500 Internal Server Error", syncCtx.opState.Message)
+	assert.Equal(t, analysisRequest{Application: "fake-app", Project: "test", Server: test.FakeClusterURL, Namespace: test.FakeArgoCDNamespace, Revision: "FooBarBaz"}, request)
+
+	// the webhook is not called again while the SyncFail hooks run
+	status = http.StatusOK
+	syncCtx.setOperationSucceeded(nil, "successfully synced (no more tasks)")
+	assert.Equal(t, OperationFailed, syncCtx.opState.Phase)
+
+	syncCtx.syncRes.Analysis = nil
+	syncCtx.opState.StartedAt = metav1.NewTime(time.Now().Add(time.Hour))
+	setOperationSucceededAfterAnalysis(t, syncCtx)
+	assert.Equal(t, OperationSucceeded, syncCtx.opState.Phase)
+	assert.Equal(t, &SyncAnalysisResult{Phase: OperationSucceeded, Message: "analysis webhook responded with 200 OK"}, syncCtx.syncRes.Analysis)
+
+	// the webhook is not called by syncs which skip hooks
+	syncCtx.syncRes.Analysis = nil
+	syncCtx.syncOp.SyncStrategy.Apply = &SyncStrategyApply{}
+	syncCtx.setOperationSucceeded(nil, "successfully synced (no more tasks)")
+	assert.Nil(t, syncCtx.syncRes.Analysis)
+}
+
+func TestAnalysisWebhookAllowed(t *testing.T) {
+	allowed := []*url.URL{{Scheme: "https", Host: "analysis.example.com", Path: "/argocd/"}}
+	assert.True(t, analysisWebhookAllowed("https://analysis.example.com/argocd", allowed))
+	assert.True(t, analysisWebhookAllowed("https://ANALYSIS.example.com/argocd/canary?app=guestbook", allowed))
+	assert.False(t, analysisWebhookAllowed("http://analysis.example.com/argocd", allowed))
+	assert.False(t, analysisWebhookAllowed("https://analysis.example.com:8443/argocd", allowed))
+	assert.False(t, analysisWebhookAllowed("https://analysis.example.com/argocd-admin", allowed))
+	assert.False(t, analysisWebhookAllowed("https://analysis.example.com/argocd/../admin", allowed))
+	assert.False(t, analysisWebhookAllowed("https://user@analysis.example.com/argocd", allowed))
+	assert.False(t, analysisWebhookAllowed("http://169.254.169.254/latest/meta-data", allowed))
+	assert.False(t, analysisWebhookAllowed("https://analysis.example.com/argocd", nil))
+}
+
+func TestSyncAnalysisWebhookDoesNotFollowRedirects(t *testing.T) {
+	redirected := false
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		redirected = true
+	}))
+	defer target.Close()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, target.URL, http.StatusTemporaryRedirect)
+	}))
+	defer server.Close()
+
+	phase, message := runAnalysisWebhook(&SyncAnalysisWebhook{URL: server.URL}, []byte("{}"))
+	assert.Equal(t, OperationFailed, phase)
+	assert.Equal(t, "analysis webhook responded with 307 Temporary Redirect", message)
+	assert.False(t, redirected)
+}
@@ -1,12 +1,56 @@
 package errors
 
 import (
+	"os"
+
 	log "github.com/sirupsen/logrus"
+
+	grpc_util "github.com/argoproj/argo-cd/util/grpc"
+)
+
+const (
+	// ErrorCodeGeneric is the exit code for errors without a more specific reason
+	ErrorCodeGeneric = 1
+	// ErrorCodeAuthFailed is the exit code for errors caused by missing or invalid credentials
+	ErrorCodeAuthFailed = 20
+	// ErrorCodeRepositoryUnreachable is the exit code for errors caused by a repository which could not be fetched
+	ErrorCodeRepositoryUnreachable = 21
+	// ErrorCodeRenderFailed is the exit code for errors caused by manifests which could not be generated
+	ErrorCodeRenderFailed = 22
+	// ErrorCodeProjectPermissionDenied is the exit code for errors caused by an application violating its project rules
+	ErrorCodeProjectPermissionDenied = 23
+	// ErrorCodeTimeout is the exit code for errors caused by a request exceeding its deadline
+	ErrorCodeTimeout = 24
 )
 
-// CheckError is a convenience function to exit if an error is non-nil and exit if it was
+var reasonExitCodes = map[grpc_util.ErrorReason]int{
+	grpc_util.ErrorReasonAuthFailed:              ErrorCodeAuthFailed,
+	grpc_util.ErrorReasonRepositoryUnreachable:   ErrorCodeRepositoryUnreachable,
+	grpc_util.ErrorReasonRenderFailed:            ErrorCodeRenderFailed,
+	grpc_util.ErrorReasonProjectPermissionDenied: ErrorCodeProjectPermissionDenied,
+	grpc_util.ErrorReasonTimeout:                 ErrorCodeTimeout,
+}
+
+// CheckError is a convenience function to exit if an error is non-nil and exit if it was.
+// Errors carrying a reason exit with the code of that reason, so that scripts are able to branch on it.
 func CheckError(err error) {
+	CheckErrorWithCode(err, ErrorCodeGeneric)
+}
+
+// CheckErrorWithCode exits if an error is non-nil, like CheckError, but exits with the given code if the error has no
+// reason. Commands which use exit code 1 to report a result, such as a diff being found, pass a different code so that
+// errors can be told apart.
+func CheckErrorWithCode(err error, code int) {
 	if err != nil {
+		reason := grpc_util.GetErrorReason(err)
+		if reasonCode, ok := reasonExitCodes[reason]; ok {
+			log.WithField("reason", reason).Error(err)
+			os.Exit(reasonCode)
+		}
+		if code != ErrorCodeGeneric {
+			log.Error(err)
+			os.Exit(code)
+		}
 		log.Fatal(err)
 	}
 }
@@ -17,6 +17,7 @@ import (
 
 	"github.com/coreos/go-oidc"
 	"github.com/dgrijalva/jwt-go"
+	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/oauth2"
 	"google.golang.org/grpc"
@@ -29,6 +30,7 @@ import (
 	applicationpkg "github.com/argoproj/argo-cd/pkg/apiclient/application"
 	certificatepkg "github.com/argoproj/argo-cd/pkg/apiclient/certificate"
 	clusterpkg "github.com/argoproj/argo-cd/pkg/apiclient/cluster"
+	debugpkg "github.com/argoproj/argo-cd/pkg/apiclient/debug"
 	projectpkg "github.com/argoproj/argo-cd/pkg/apiclient/project"
 	repositorypkg "github.com/argoproj/argo-cd/pkg/apiclient/repository"
 	sessionpkg "github.com/argoproj/argo-cd/pkg/apiclient/session"
@@ -51,6 +53,8 @@ const (
 	EnvArgoCDAuthToken = "ARGOCD_AUTH_TOKEN"
 	// MaxGRPCMessageSize contains max grpc message size
 	MaxGRPCMessageSize = 100 * 1024 * 1024
+	// defaultRetryBackoff is the wait between retries if the client options do not set one
+	defaultRetryBackoff = 1 * time.Second
 )
 
 // Client defines an interface for interaction with an Argo CD server.
@@ -76,7 +80,10 @@ type Client interface {
 	NewProjectClientOrDie() (io.Closer, projectpkg.ProjectServiceClient)
 	NewAccountClient() (io.Closer, accountpkg.AccountServiceClient, error)
 	NewAccountClientOrDie() (io.Closer, accountpkg.AccountServiceClient)
+	NewDebugClient() (io.Closer, debugpkg.DebugServiceClient, error)
+	NewDebugClientOrDie() (io.Closer, debugpkg.DebugServiceClient)
 	WatchApplicationWithRetry(ctx context.Context, appName string) chan *argoappv1.ApplicationWatchEvent
+	WatchApplicationsWithRetry(ctx context.Context, query *applicationpkg.ApplicationQuery) chan *argoappv1.ApplicationWatchEvent
 }
 
 // ClientOptions hold address, security, and other settings for the API client.
@@ -90,6 +97,11 @@ type ClientOptions struct {
 	Context    string
 	UserAgent  string
 	GRPCWeb    bool
+	// MaxRetries is the number of times a call which failed because the server was unavailable is retried. Calls are
+	// not retried if zero.
+	MaxRetries uint
+	// RetryBackoff is the wait between retries of calls and watches, one second if zero
+	RetryBackoff time.Duration
 }
 
 type client struct {
@@ -101,6 +113,8 @@ type client struct {
 	RefreshToken string
 	UserAgent    string
 	GRPCWeb      bool
+	MaxRetries   uint
+	RetryBackoff time.Duration
 
 	proxyMutex      *sync.Mutex
 	proxyListener   net.Listener
@@ -183,6 +197,11 @@ func NewClient(opts *ClientOptions) (Client, error) {
 	if opts.GRPCWeb {
 		c.GRPCWeb = true
 	}
+	c.MaxRetries = opts.MaxRetries
+	c.RetryBackoff = opts.RetryBackoff
+	if c.RetryBackoff <= 0 {
+		c.RetryBackoff = defaultRetryBackoff
+	}
 	if localCfg != nil {
 		err = c.refreshAuthToken(localCfg, ctxName, opts.ConfigPath)
 		if err != nil {
@@ -348,12 +367,32 @@ func (c jwtCredentials) RequireTransportSecurity() bool {
 	return false
 }
 
-func (c jwtCredentials) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+func (c jwtCredentials) GetRequestMetadata(ctx context.Context, _ ...string) (map[string]string, error) {
+	token := c.Token
+	if ctxToken, ok := authTokenFromContext(ctx); ok {
+		token = ctxToken
+	}
 	return map[string]string{
-		MetaDataTokenKey: c.Token,
+		MetaDataTokenKey: token,
 	}, nil
 }
 
+type authTokenKey struct{}
+
+// ContextWithAuthToken returns a context whose calls authenticate with the given token instead of the token of the
+// client, e.g. so that a service shares one client between the users it acts on behalf of
+func ContextWithAuthToken(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, authTokenKey{}, token)
+}
+
+func authTokenFromContext(ctx context.Context) (string, bool) {
+	if ctx == nil {
+		return "", false
+	}
+	token, ok := ctx.Value(authTokenKey{}).(string)
+	return token, ok
+}
+
 func (c *client) newConn() (*grpc.ClientConn, io.Closer, error) {
 	closers := make([]io.Closer, 0)
 	serverAddr := c.ServerAddr
@@ -386,6 +425,14 @@ func (c *client) newConn() (*grpc.ClientConn, io.Closer, error) {
 	if c.UserAgent != "" {
 		dialOpts = append(dialOpts, grpc.WithUserAgent(c.UserAgent))
 	}
+	if c.MaxRetries > 0 {
+		// only unary calls are retried, watches are retried by the watch helpers
+		dialOpts = append(dialOpts, grpc.WithUnaryInterceptor(grpc_retry.UnaryClientInterceptor(
+			grpc_retry.WithMax(c.MaxRetries),
+			grpc_retry.WithBackoff(grpc_retry.BackoffLinearWithJitter(c.RetryBackoff, 0.1)),
+			grpc_retry.WithCodes(codes.Unavailable),
+		)))
+	}
 	conn, e := grpc_util.BlockingDial(context.Background(), network, serverAddr, creds, dialOpts...)
 	closers = append(closers, conn)
 	return conn, util.NewCloser(func() error {
@@ -417,10 +464,12 @@ func (c *client) tlsConfig() (*tls.Config, error) {
 
 func (c *client) ClientOptions() ClientOptions {
 	return ClientOptions{
-		ServerAddr: c.ServerAddr,
-		PlainText:  c.PlainText,
-		Insecure:   c.Insecure,
-		AuthToken:  c.AuthToken,
+		ServerAddr:   c.ServerAddr,
+		PlainText:    c.PlainText,
+		Insecure:     c.Insecure,
+		AuthToken:    c.AuthToken,
+		MaxRetries:   c.MaxRetries,
+		RetryBackoff: c.RetryBackoff,
 	}
 }
 
@@ -577,9 +626,33 @@ func (c *client) NewAccountClientOrDie() (io.Closer, accountpkg.AccountServiceCl
 	return conn, usrIf
 }
 
+func (c *client) NewDebugClient() (io.Closer, debugpkg.DebugServiceClient, error) {
+	conn, closer, err := c.newConn()
+	if err != nil {
+		return nil, nil, err
+	}
+	debugIf := debugpkg.NewDebugServiceClient(conn)
+	return closer, debugIf, nil
+}
+
+func (c *client) NewDebugClientOrDie() (io.Closer, debugpkg.DebugServiceClient) {
+	conn, debugIf, err := c.NewDebugClient()
+	if err != nil {
+		log.Fatalf("Failed to establish connection to %s: %v", c.ServerAddr, err)
+	}
+	return conn, debugIf
+}
+
 // WatchApplicationWithRetry returns a channel of watch events for an application, retrying the
 // watch upon errors. Closes the returned channel when the context is cancelled.
 func (c *client) WatchApplicationWithRetry(ctx context.Context, appName string) chan *argoappv1.ApplicationWatchEvent {
+	return c.WatchApplicationsWithRetry(ctx, &applicationpkg.ApplicationQuery{Name: &appName})
+}
+
+// WatchApplicationsWithRetry returns a channel of watch events for the applications matching the query, retrying the
+// watch upon errors. Every (re)started watch first sends the current state of the applications as Modified events.
+// Closes the returned channel when the context is cancelled.
+func (c *client) WatchApplicationsWithRetry(ctx context.Context, query *applicationpkg.ApplicationQuery) chan *argoappv1.ApplicationWatchEvent {
 	appEventsCh := make(chan *argoappv1.ApplicationWatchEvent)
 	cancelled := false
 	go func() {
@@ -588,7 +661,7 @@ func (c *client) WatchApplicationWithRetry(ctx context.Context, appName string)
 			conn, appIf, err := c.NewApplicationClient()
 			if err == nil {
 				var wc applicationpkg.ApplicationService_WatchClient
-				wc, err = appIf.Watch(ctx, &applicationpkg.ApplicationQuery{Name: &appName})
+				wc, err = appIf.Watch(ctx, query)
 				if err == nil {
 					for {
 						var appEvent *v1alpha1.ApplicationWatchEvent
@@ -596,7 +669,14 @@ func (c *client) WatchApplicationWithRetry(ctx context.Context, appName string)
 						if err != nil {
 							break
 						}
-						appEventsCh <- appEvent
+						select {
+						case appEventsCh <- appEvent:
+						case <-ctx.Done():
+							err = ctx.Err()
+						}
+						if err != nil {
+							break
+						}
 					}
 				}
 			}
@@ -607,7 +687,11 @@ func (c *client) WatchApplicationWithRetry(ctx context.Context, appName string)
 					if err != io.EOF {
 						log.Warnf("watch err: %v", err)
 					}
-					time.Sleep(1 * time.Second)
+					select {
+					case <-time.After(c.RetryBackoff):
+					case <-ctx.Done():
+						cancelled = true
+					}
 				}
 			}
 			if conn != nil {
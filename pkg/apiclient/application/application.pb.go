@@ -50,10 +50,18 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
 // ApplicationQuery is a query for application resources
 type ApplicationQuery struct {
-	Name                 *string  `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
-	Refresh              *string  `protobuf:"bytes,2,opt,name=refresh" json:"refresh,omitempty"`
-	Projects             []string `protobuf:"bytes,3,rep,name=project" json:"project,omitempty"`
-	ResourceVersion      string   `protobuf:"bytes,4,opt,name=resourceVersion" json:"resourceVersion"`
+	Name *string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	// forces application reconciliation if set to 'normal' or 'hard'. A normal refresh compares the application with
+	// the latest revision using cached manifests, a hard refresh also invalidates the manifest cache.
+	Refresh         *string  `protobuf:"bytes,2,opt,name=refresh" json:"refresh,omitempty"`
+	Projects        []string `protobuf:"bytes,3,rep,name=project" json:"project,omitempty"`
+	ResourceVersion string   `protobuf:"bytes,4,opt,name=resourceVersion" json:"resourceVersion"`
+	// lists only the applications which name contains the search string (case insensitive)
+	Search string `protobuf:"bytes,5,opt,name=search" json:"search"`
+	// lists only the applications matching the label selector
+	Selector string `protobuf:"bytes,6,opt,name=selector" json:"selector"`
+	// sorts the applications by name (default), sync, health or lastSync. Prefix with '-' to sort in descending order.
+	SortBy               string   `protobuf:"bytes,7,opt,name=sortBy" json:"sortBy"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -63,7 +71,7 @@ func (m *ApplicationQuery) Reset()         { *m = ApplicationQuery{} }
 func (m *ApplicationQuery) String() string { return proto.CompactTextString(m) }
 func (*ApplicationQuery) ProtoMessage()    {}
 func (*ApplicationQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_25eb5dc9e619c6e4, []int{0}
+	return fileDescriptor_application_55137f215f842c93, []int{0}
 }
 func (m *ApplicationQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -120,6 +128,27 @@ func (m *ApplicationQuery) GetResourceVersion() string {
 	return ""
 }
 
+func (m *ApplicationQuery) GetSearch() string {
+	if m != nil {
+		return m.Search
+	}
+	return ""
+}
+
+func (m *ApplicationQuery) GetSelector() string {
+	if m != nil {
+		return m.Selector
+	}
+	return ""
+}
+
+func (m *ApplicationQuery) GetSortBy() string {
+	if m != nil {
+		return m.SortBy
+	}
+	return ""
+}
+
 type RevisionMetadataQuery struct {
 	// the application's name
 	Name *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
@@ -134,7 +163,7 @@ func (m *RevisionMetadataQuery) Reset()         { *m = RevisionMetadataQuery{} }
 func (m *RevisionMetadataQuery) String() string { return proto.CompactTextString(m) }
 func (*RevisionMetadataQuery) ProtoMessage()    {}
 func (*RevisionMetadataQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_25eb5dc9e619c6e4, []int{1}
+	return fileDescriptor_application_55137f215f842c93, []int{1}
 }
 func (m *RevisionMetadataQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -177,6 +206,64 @@ func (m *RevisionMetadataQuery) GetRevision() string {
 	return ""
 }
 
+// ApplicationRevisionChangelogQuery is a query for the commits between the deployed and the target revision of an application
+type ApplicationRevisionChangelogQuery struct {
+	// the application's name
+	Name *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	// the revision which would be deployed, defaults to the target revision of the application
+	TargetRevision       string   `protobuf:"bytes,2,opt,name=targetRevision" json:"targetRevision"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationRevisionChangelogQuery) Reset()         { *m = ApplicationRevisionChangelogQuery{} }
+func (m *ApplicationRevisionChangelogQuery) String() string { return proto.CompactTextString(m) }
+func (*ApplicationRevisionChangelogQuery) ProtoMessage()    {}
+func (*ApplicationRevisionChangelogQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_55137f215f842c93, []int{2}
+}
+func (m *ApplicationRevisionChangelogQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationRevisionChangelogQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationRevisionChangelogQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ApplicationRevisionChangelogQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationRevisionChangelogQuery.Merge(dst, src)
+}
+func (m *ApplicationRevisionChangelogQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationRevisionChangelogQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationRevisionChangelogQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationRevisionChangelogQuery proto.InternalMessageInfo
+
+func (m *ApplicationRevisionChangelogQuery) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *ApplicationRevisionChangelogQuery) GetTargetRevision() string {
+	if m != nil {
+		return m.TargetRevision
+	}
+	return ""
+}
+
 // ApplicationEventsQuery is a query for application resource events
 type ApplicationResourceEventsQuery struct {
 	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
@@ -192,7 +279,7 @@ func (m *ApplicationResourceEventsQuery) Reset()         { *m = ApplicationResou
 func (m *ApplicationResourceEventsQuery) String() string { return proto.CompactTextString(m) }
 func (*ApplicationResourceEventsQuery) ProtoMessage()    {}
 func (*ApplicationResourceEventsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_25eb5dc9e619c6e4, []int{2}
+	return fileDescriptor_application_55137f215f842c93, []int{3}
 }
 func (m *ApplicationResourceEventsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -249,27 +336,31 @@ func (m *ApplicationResourceEventsQuery) GetResourceUID() string {
 	return ""
 }
 
-// ManifestQuery is a query for manifest resources
-type ApplicationManifestQuery struct {
-	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
-	Revision             string   `protobuf:"bytes,2,opt,name=revision" json:"revision"`
+// ApplicationEventStreamQuery is a query for the stream of significant events of applications
+type ApplicationEventStreamQuery struct {
+	// streams only the events of the application with the name
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name"`
+	// streams only the events of the applications of the projects
+	Projects []string `protobuf:"bytes,2,rep,name=project" json:"project,omitempty"`
+	// streams only the events of the types: SyncStarted, SyncSucceeded, SyncFailed, HealthChanged or DriftDetected
+	Types                []string `protobuf:"bytes,3,rep,name=type" json:"type,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ApplicationManifestQuery) Reset()         { *m = ApplicationManifestQuery{} }
-func (m *ApplicationManifestQuery) String() string { return proto.CompactTextString(m) }
-func (*ApplicationManifestQuery) ProtoMessage()    {}
-func (*ApplicationManifestQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_25eb5dc9e619c6e4, []int{3}
+func (m *ApplicationEventStreamQuery) Reset()         { *m = ApplicationEventStreamQuery{} }
+func (m *ApplicationEventStreamQuery) String() string { return proto.CompactTextString(m) }
+func (*ApplicationEventStreamQuery) ProtoMessage()    {}
+func (*ApplicationEventStreamQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_55137f215f842c93, []int{4}
 }
-func (m *ApplicationManifestQuery) XXX_Unmarshal(b []byte) error {
+func (m *ApplicationEventStreamQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ApplicationManifestQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ApplicationEventStreamQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ApplicationManifestQuery.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ApplicationEventStreamQuery.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -279,50 +370,66 @@ func (m *ApplicationManifestQuery) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (dst *ApplicationManifestQuery) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ApplicationManifestQuery.Merge(dst, src)
+func (dst *ApplicationEventStreamQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationEventStreamQuery.Merge(dst, src)
 }
-func (m *ApplicationManifestQuery) XXX_Size() int {
+func (m *ApplicationEventStreamQuery) XXX_Size() int {
 	return m.Size()
 }
-func (m *ApplicationManifestQuery) XXX_DiscardUnknown() {
-	xxx_messageInfo_ApplicationManifestQuery.DiscardUnknown(m)
+func (m *ApplicationEventStreamQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationEventStreamQuery.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ApplicationManifestQuery proto.InternalMessageInfo
+var xxx_messageInfo_ApplicationEventStreamQuery proto.InternalMessageInfo
 
-func (m *ApplicationManifestQuery) GetName() string {
-	if m != nil && m.Name != nil {
-		return *m.Name
+func (m *ApplicationEventStreamQuery) GetName() string {
+	if m != nil {
+		return m.Name
 	}
 	return ""
 }
 
-func (m *ApplicationManifestQuery) GetRevision() string {
+func (m *ApplicationEventStreamQuery) GetProjects() []string {
 	if m != nil {
-		return m.Revision
+		return m.Projects
 	}
-	return ""
+	return nil
 }
 
-type ApplicationResponse struct {
+func (m *ApplicationEventStreamQuery) GetTypes() []string {
+	if m != nil {
+		return m.Types
+	}
+	return nil
+}
+
+// ApplicationEvent is a significant change of the state of an application, observed while the controller reconciles it
+type ApplicationEvent struct {
+	// the type of the event: SyncStarted, SyncSucceeded, SyncFailed, HealthChanged or DriftDetected
+	Type        string `protobuf:"bytes,1,req,name=type" json:"type"`
+	Application string `protobuf:"bytes,2,req,name=application" json:"application"`
+	Project     string `protobuf:"bytes,3,req,name=project" json:"project"`
+	Message     string `protobuf:"bytes,4,opt,name=message" json:"message"`
+	// the revision which is synced or which the application drifted from
+	Revision             string   `protobuf:"bytes,5,opt,name=revision" json:"revision"`
+	Time                 *v1.Time `protobuf:"bytes,6,opt,name=time" json:"time,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ApplicationResponse) Reset()         { *m = ApplicationResponse{} }
-func (m *ApplicationResponse) String() string { return proto.CompactTextString(m) }
-func (*ApplicationResponse) ProtoMessage()    {}
-func (*ApplicationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_25eb5dc9e619c6e4, []int{4}
+func (m *ApplicationEvent) Reset()         { *m = ApplicationEvent{} }
+func (m *ApplicationEvent) String() string { return proto.CompactTextString(m) }
+func (*ApplicationEvent) ProtoMessage()    {}
+func (*ApplicationEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_55137f215f842c93, []int{5}
 }
-func (m *ApplicationResponse) XXX_Unmarshal(b []byte) error {
+func (m *ApplicationEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ApplicationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ApplicationEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ApplicationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ApplicationEvent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -332,92 +439,81 @@ func (m *ApplicationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (dst *ApplicationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ApplicationResponse.Merge(dst, src)
+func (dst *ApplicationEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationEvent.Merge(dst, src)
 }
-func (m *ApplicationResponse) XXX_Size() int {
+func (m *ApplicationEvent) XXX_Size() int {
 	return m.Size()
 }
-func (m *ApplicationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ApplicationResponse.DiscardUnknown(m)
+func (m *ApplicationEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationEvent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ApplicationResponse proto.InternalMessageInfo
+var xxx_messageInfo_ApplicationEvent proto.InternalMessageInfo
 
-type ApplicationCreateRequest struct {
-	Application          v1alpha1.Application `protobuf:"bytes,1,req,name=application" json:"application"`
-	Upsert               *bool                `protobuf:"varint,2,opt,name=upsert" json:"upsert,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+func (m *ApplicationEvent) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
 }
 
-func (m *ApplicationCreateRequest) Reset()         { *m = ApplicationCreateRequest{} }
-func (m *ApplicationCreateRequest) String() string { return proto.CompactTextString(m) }
-func (*ApplicationCreateRequest) ProtoMessage()    {}
-func (*ApplicationCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_25eb5dc9e619c6e4, []int{5}
-}
-func (m *ApplicationCreateRequest) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *ApplicationCreateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_ApplicationCreateRequest.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *ApplicationEvent) GetApplication() string {
+	if m != nil {
+		return m.Application
 	}
+	return ""
 }
-func (dst *ApplicationCreateRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ApplicationCreateRequest.Merge(dst, src)
-}
-func (m *ApplicationCreateRequest) XXX_Size() int {
-	return m.Size()
-}
-func (m *ApplicationCreateRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ApplicationCreateRequest.DiscardUnknown(m)
+
+func (m *ApplicationEvent) GetProject() string {
+	if m != nil {
+		return m.Project
+	}
+	return ""
 }
 
-var xxx_messageInfo_ApplicationCreateRequest proto.InternalMessageInfo
+func (m *ApplicationEvent) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
 
-func (m *ApplicationCreateRequest) GetApplication() v1alpha1.Application {
+func (m *ApplicationEvent) GetRevision() string {
 	if m != nil {
-		return m.Application
+		return m.Revision
 	}
-	return v1alpha1.Application{}
+	return ""
 }
 
-func (m *ApplicationCreateRequest) GetUpsert() bool {
-	if m != nil && m.Upsert != nil {
-		return *m.Upsert
+func (m *ApplicationEvent) GetTime() *v1.Time {
+	if m != nil {
+		return m.Time
 	}
-	return false
+	return nil
 }
 
-type ApplicationUpdateRequest struct {
-	Application          *v1alpha1.Application `protobuf:"bytes,1,req,name=application" json:"application,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+// ManifestQuery is a query for manifest resources
+type ApplicationManifestQuery struct {
+	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Revision             string   `protobuf:"bytes,2,opt,name=revision" json:"revision"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ApplicationUpdateRequest) Reset()         { *m = ApplicationUpdateRequest{} }
-func (m *ApplicationUpdateRequest) String() string { return proto.CompactTextString(m) }
-func (*ApplicationUpdateRequest) ProtoMessage()    {}
-func (*ApplicationUpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_25eb5dc9e619c6e4, []int{6}
+func (m *ApplicationManifestQuery) Reset()         { *m = ApplicationManifestQuery{} }
+func (m *ApplicationManifestQuery) String() string { return proto.CompactTextString(m) }
+func (*ApplicationManifestQuery) ProtoMessage()    {}
+func (*ApplicationManifestQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_55137f215f842c93, []int{6}
 }
-func (m *ApplicationUpdateRequest) XXX_Unmarshal(b []byte) error {
+func (m *ApplicationManifestQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ApplicationUpdateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ApplicationManifestQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ApplicationUpdateRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ApplicationManifestQuery.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -427,45 +523,55 @@ func (m *ApplicationUpdateRequest) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (dst *ApplicationUpdateRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ApplicationUpdateRequest.Merge(dst, src)
+func (dst *ApplicationManifestQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationManifestQuery.Merge(dst, src)
 }
-func (m *ApplicationUpdateRequest) XXX_Size() int {
+func (m *ApplicationManifestQuery) XXX_Size() int {
 	return m.Size()
 }
-func (m *ApplicationUpdateRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ApplicationUpdateRequest.DiscardUnknown(m)
+func (m *ApplicationManifestQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationManifestQuery.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ApplicationUpdateRequest proto.InternalMessageInfo
+var xxx_messageInfo_ApplicationManifestQuery proto.InternalMessageInfo
 
-func (m *ApplicationUpdateRequest) GetApplication() *v1alpha1.Application {
+func (m *ApplicationManifestQuery) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *ApplicationManifestQuery) GetRevision() string {
 	if m != nil {
-		return m.Application
+		return m.Revision
 	}
-	return nil
+	return ""
 }
 
-type ApplicationDeleteRequest struct {
-	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
-	Cascade              *bool    `protobuf:"varint,2,opt,name=cascade" json:"cascade,omitempty"`
+// ApplicationManagedManifestsQuery is a query for the manifests of the resources managed by an application
+type ApplicationManagedManifestsQuery struct {
+	Name *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	// Source of the manifests: "git" for the target manifests rendered in the last comparison, "live" for the live
+	// manifests without fields set by the cluster. Defaults to "git".
+	Source               string   `protobuf:"bytes,2,opt,name=source" json:"source"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ApplicationDeleteRequest) Reset()         { *m = ApplicationDeleteRequest{} }
-func (m *ApplicationDeleteRequest) String() string { return proto.CompactTextString(m) }
-func (*ApplicationDeleteRequest) ProtoMessage()    {}
-func (*ApplicationDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_25eb5dc9e619c6e4, []int{7}
+func (m *ApplicationManagedManifestsQuery) Reset()         { *m = ApplicationManagedManifestsQuery{} }
+func (m *ApplicationManagedManifestsQuery) String() string { return proto.CompactTextString(m) }
+func (*ApplicationManagedManifestsQuery) ProtoMessage()    {}
+func (*ApplicationManagedManifestsQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_55137f215f842c93, []int{7}
 }
-func (m *ApplicationDeleteRequest) XXX_Unmarshal(b []byte) error {
+func (m *ApplicationManagedManifestsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ApplicationDeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ApplicationManagedManifestsQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ApplicationDeleteRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ApplicationManagedManifestsQuery.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -475,58 +581,53 @@ func (m *ApplicationDeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (dst *ApplicationDeleteRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ApplicationDeleteRequest.Merge(dst, src)
+func (dst *ApplicationManagedManifestsQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationManagedManifestsQuery.Merge(dst, src)
 }
-func (m *ApplicationDeleteRequest) XXX_Size() int {
+func (m *ApplicationManagedManifestsQuery) XXX_Size() int {
 	return m.Size()
 }
-func (m *ApplicationDeleteRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ApplicationDeleteRequest.DiscardUnknown(m)
+func (m *ApplicationManagedManifestsQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationManagedManifestsQuery.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ApplicationDeleteRequest proto.InternalMessageInfo
+var xxx_messageInfo_ApplicationManagedManifestsQuery proto.InternalMessageInfo
 
-func (m *ApplicationDeleteRequest) GetName() string {
+func (m *ApplicationManagedManifestsQuery) GetName() string {
 	if m != nil && m.Name != nil {
 		return *m.Name
 	}
 	return ""
 }
 
-func (m *ApplicationDeleteRequest) GetCascade() bool {
-	if m != nil && m.Cascade != nil {
-		return *m.Cascade
+func (m *ApplicationManagedManifestsQuery) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
-	return false
+	return ""
 }
 
-// ApplicationSyncRequest is a request to apply the config state to live state
-type ApplicationSyncRequest struct {
-	Name                 *string                          `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
-	Revision             string                           `protobuf:"bytes,2,opt,name=revision" json:"revision"`
-	DryRun               bool                             `protobuf:"varint,3,opt,name=dryRun" json:"dryRun"`
-	Prune                bool                             `protobuf:"varint,4,opt,name=prune" json:"prune"`
-	Strategy             *v1alpha1.SyncStrategy           `protobuf:"bytes,5,opt,name=strategy" json:"strategy,omitempty"`
-	Resources            []v1alpha1.SyncOperationResource `protobuf:"bytes,7,rep,name=resources" json:"resources"`
-	Manifests            []string                         `protobuf:"bytes,8,rep,name=manifests" json:"manifests,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
-	XXX_unrecognized     []byte                           `json:"-"`
-	XXX_sizecache        int32                            `json:"-"`
+// ApplicationManagedManifestsResponse holds the manifests of the resources managed by an application
+type ApplicationManagedManifestsResponse struct {
+	// Manifests is a YAML stream of the manifests
+	Manifests            string   `protobuf:"bytes,1,opt,name=manifests" json:"manifests"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ApplicationSyncRequest) Reset()         { *m = ApplicationSyncRequest{} }
-func (m *ApplicationSyncRequest) String() string { return proto.CompactTextString(m) }
-func (*ApplicationSyncRequest) ProtoMessage()    {}
-func (*ApplicationSyncRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_25eb5dc9e619c6e4, []int{8}
+func (m *ApplicationManagedManifestsResponse) Reset()         { *m = ApplicationManagedManifestsResponse{} }
+func (m *ApplicationManagedManifestsResponse) String() string { return proto.CompactTextString(m) }
+func (*ApplicationManagedManifestsResponse) ProtoMessage()    {}
+func (*ApplicationManagedManifestsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_55137f215f842c93, []int{8}
 }
-func (m *ApplicationSyncRequest) XXX_Unmarshal(b []byte) error {
+func (m *ApplicationManagedManifestsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ApplicationSyncRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ApplicationManagedManifestsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ApplicationSyncRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ApplicationManagedManifestsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -536,88 +637,46 @@ func (m *ApplicationSyncRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (dst *ApplicationSyncRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ApplicationSyncRequest.Merge(dst, src)
+func (dst *ApplicationManagedManifestsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationManagedManifestsResponse.Merge(dst, src)
 }
-func (m *ApplicationSyncRequest) XXX_Size() int {
+func (m *ApplicationManagedManifestsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ApplicationSyncRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ApplicationSyncRequest.DiscardUnknown(m)
+func (m *ApplicationManagedManifestsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationManagedManifestsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ApplicationSyncRequest proto.InternalMessageInfo
+var xxx_messageInfo_ApplicationManagedManifestsResponse proto.InternalMessageInfo
 
-func (m *ApplicationSyncRequest) GetName() string {
-	if m != nil && m.Name != nil {
-		return *m.Name
+func (m *ApplicationManagedManifestsResponse) GetManifests() string {
+	if m != nil {
+		return m.Manifests
 	}
 	return ""
 }
 
-func (m *ApplicationSyncRequest) GetRevision() string {
-	if m != nil {
-		return m.Revision
-	}
-	return ""
-}
-
-func (m *ApplicationSyncRequest) GetDryRun() bool {
-	if m != nil {
-		return m.DryRun
-	}
-	return false
-}
-
-func (m *ApplicationSyncRequest) GetPrune() bool {
-	if m != nil {
-		return m.Prune
-	}
-	return false
-}
-
-func (m *ApplicationSyncRequest) GetStrategy() *v1alpha1.SyncStrategy {
-	if m != nil {
-		return m.Strategy
-	}
-	return nil
-}
-
-func (m *ApplicationSyncRequest) GetResources() []v1alpha1.SyncOperationResource {
-	if m != nil {
-		return m.Resources
-	}
-	return nil
-}
-
-func (m *ApplicationSyncRequest) GetManifests() []string {
-	if m != nil {
-		return m.Manifests
-	}
-	return nil
-}
-
-// ApplicationUpdateSpecRequest is a request to update application spec
-type ApplicationUpdateSpecRequest struct {
-	Name                 *string                  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
-	Spec                 v1alpha1.ApplicationSpec `protobuf:"bytes,2,req,name=spec" json:"spec"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+// ApplicationPreviewRequest is a request to preview the result of syncing an application
+type ApplicationPreviewRequest struct {
+	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Revision             string   `protobuf:"bytes,2,opt,name=revision" json:"revision"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ApplicationUpdateSpecRequest) Reset()         { *m = ApplicationUpdateSpecRequest{} }
-func (m *ApplicationUpdateSpecRequest) String() string { return proto.CompactTextString(m) }
-func (*ApplicationUpdateSpecRequest) ProtoMessage()    {}
-func (*ApplicationUpdateSpecRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_25eb5dc9e619c6e4, []int{9}
+func (m *ApplicationPreviewRequest) Reset()         { *m = ApplicationPreviewRequest{} }
+func (m *ApplicationPreviewRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplicationPreviewRequest) ProtoMessage()    {}
+func (*ApplicationPreviewRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_55137f215f842c93, []int{9}
 }
-func (m *ApplicationUpdateSpecRequest) XXX_Unmarshal(b []byte) error {
+func (m *ApplicationPreviewRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ApplicationUpdateSpecRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ApplicationPreviewRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ApplicationUpdateSpecRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ApplicationPreviewRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -627,54 +686,58 @@ func (m *ApplicationUpdateSpecRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (dst *ApplicationUpdateSpecRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ApplicationUpdateSpecRequest.Merge(dst, src)
+func (dst *ApplicationPreviewRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationPreviewRequest.Merge(dst, src)
 }
-func (m *ApplicationUpdateSpecRequest) XXX_Size() int {
+func (m *ApplicationPreviewRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ApplicationUpdateSpecRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ApplicationUpdateSpecRequest.DiscardUnknown(m)
+func (m *ApplicationPreviewRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationPreviewRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ApplicationUpdateSpecRequest proto.InternalMessageInfo
+var xxx_messageInfo_ApplicationPreviewRequest proto.InternalMessageInfo
 
-func (m *ApplicationUpdateSpecRequest) GetName() string {
+func (m *ApplicationPreviewRequest) GetName() string {
 	if m != nil && m.Name != nil {
 		return *m.Name
 	}
 	return ""
 }
 
-func (m *ApplicationUpdateSpecRequest) GetSpec() v1alpha1.ApplicationSpec {
+func (m *ApplicationPreviewRequest) GetRevision() string {
 	if m != nil {
-		return m.Spec
+		return m.Revision
 	}
-	return v1alpha1.ApplicationSpec{}
+	return ""
 }
 
-// ApplicationPatchRequest is a request to patch an application
-type ApplicationPatchRequest struct {
-	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
-	Patch                string   `protobuf:"bytes,2,req,name=patch" json:"patch"`
-	PatchType            string   `protobuf:"bytes,3,req,name=patchType" json:"patchType"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// ResourcePreviewResult is the result of the server-side dry-run of a single resource
+type ResourcePreviewResult struct {
+	Group     string `protobuf:"bytes,1,req,name=group" json:"group"`
+	Kind      string `protobuf:"bytes,2,req,name=kind" json:"kind"`
+	Namespace string `protobuf:"bytes,3,req,name=namespace" json:"namespace"`
+	Name      string `protobuf:"bytes,4,req,name=name" json:"name"`
+	// Status is either Passed or Failed
+	Status               PreviewStatus `protobuf:"bytes,5,req,name=status,casttype=PreviewStatus" json:"status"`
+	Message              string        `protobuf:"bytes,6,opt,name=message" json:"message"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *ApplicationPatchRequest) Reset()         { *m = ApplicationPatchRequest{} }
-func (m *ApplicationPatchRequest) String() string { return proto.CompactTextString(m) }
-func (*ApplicationPatchRequest) ProtoMessage()    {}
-func (*ApplicationPatchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_25eb5dc9e619c6e4, []int{10}
+func (m *ResourcePreviewResult) Reset()         { *m = ResourcePreviewResult{} }
+func (m *ResourcePreviewResult) String() string { return proto.CompactTextString(m) }
+func (*ResourcePreviewResult) ProtoMessage()    {}
+func (*ResourcePreviewResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_55137f215f842c93, []int{10}
 }
-func (m *ApplicationPatchRequest) XXX_Unmarshal(b []byte) error {
+func (m *ResourcePreviewResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ApplicationPatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ResourcePreviewResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ApplicationPatchRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ResourcePreviewResult.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -684,61 +747,80 @@ func (m *ApplicationPatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (dst *ApplicationPatchRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ApplicationPatchRequest.Merge(dst, src)
+func (dst *ResourcePreviewResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourcePreviewResult.Merge(dst, src)
 }
-func (m *ApplicationPatchRequest) XXX_Size() int {
+func (m *ResourcePreviewResult) XXX_Size() int {
 	return m.Size()
 }
-func (m *ApplicationPatchRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ApplicationPatchRequest.DiscardUnknown(m)
+func (m *ResourcePreviewResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourcePreviewResult.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ApplicationPatchRequest proto.InternalMessageInfo
+var xxx_messageInfo_ResourcePreviewResult proto.InternalMessageInfo
 
-func (m *ApplicationPatchRequest) GetName() string {
-	if m != nil && m.Name != nil {
-		return *m.Name
+func (m *ResourcePreviewResult) GetGroup() string {
+	if m != nil {
+		return m.Group
 	}
 	return ""
 }
 
-func (m *ApplicationPatchRequest) GetPatch() string {
+func (m *ResourcePreviewResult) GetKind() string {
 	if m != nil {
-		return m.Patch
+		return m.Kind
 	}
 	return ""
 }
 
-func (m *ApplicationPatchRequest) GetPatchType() string {
+func (m *ResourcePreviewResult) GetNamespace() string {
 	if m != nil {
-		return m.PatchType
+		return m.Namespace
 	}
 	return ""
 }
 
-type ApplicationRollbackRequest struct {
-	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
-	ID                   int64    `protobuf:"varint,2,req,name=id" json:"id"`
-	DryRun               bool     `protobuf:"varint,3,opt,name=dryRun" json:"dryRun"`
-	Prune                bool     `protobuf:"varint,4,opt,name=prune" json:"prune"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ResourcePreviewResult) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *ApplicationRollbackRequest) Reset()         { *m = ApplicationRollbackRequest{} }
-func (m *ApplicationRollbackRequest) String() string { return proto.CompactTextString(m) }
-func (*ApplicationRollbackRequest) ProtoMessage()    {}
-func (*ApplicationRollbackRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_25eb5dc9e619c6e4, []int{11}
+func (m *ResourcePreviewResult) GetStatus() PreviewStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ""
 }
-func (m *ApplicationRollbackRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *ResourcePreviewResult) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+// ApplicationPreviewResponse holds the admission results of all target resources of an application
+type ApplicationPreviewResponse struct {
+	Items                []ResourcePreviewResult `protobuf:"bytes,1,rep,name=items" json:"items"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *ApplicationPreviewResponse) Reset()         { *m = ApplicationPreviewResponse{} }
+func (m *ApplicationPreviewResponse) String() string { return proto.CompactTextString(m) }
+func (*ApplicationPreviewResponse) ProtoMessage()    {}
+func (*ApplicationPreviewResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_55137f215f842c93, []int{11}
+}
+func (m *ApplicationPreviewResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ApplicationRollbackRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ApplicationPreviewResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ApplicationRollbackRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ApplicationPreviewResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -748,70 +830,100 @@ func (m *ApplicationRollbackRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (dst *ApplicationRollbackRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ApplicationRollbackRequest.Merge(dst, src)
+func (dst *ApplicationPreviewResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationPreviewResponse.Merge(dst, src)
 }
-func (m *ApplicationRollbackRequest) XXX_Size() int {
+func (m *ApplicationPreviewResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ApplicationRollbackRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ApplicationRollbackRequest.DiscardUnknown(m)
+func (m *ApplicationPreviewResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationPreviewResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ApplicationRollbackRequest proto.InternalMessageInfo
+var xxx_messageInfo_ApplicationPreviewResponse proto.InternalMessageInfo
 
-func (m *ApplicationRollbackRequest) GetName() string {
-	if m != nil && m.Name != nil {
-		return *m.Name
+func (m *ApplicationPreviewResponse) GetItems() []ResourcePreviewResult {
+	if m != nil {
+		return m.Items
 	}
-	return ""
+	return nil
 }
 
-func (m *ApplicationRollbackRequest) GetID() int64 {
-	if m != nil {
-		return m.ID
-	}
-	return 0
+// ResourceConflictsQuery is a query for resources which are managed by more than one application
+type ResourceConflictsQuery struct {
+	// Name restricts the result to conflicts involving the named application
+	Name                 string   `protobuf:"bytes,1,opt,name=name" json:"name"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ApplicationRollbackRequest) GetDryRun() bool {
-	if m != nil {
-		return m.DryRun
+func (m *ResourceConflictsQuery) Reset()         { *m = ResourceConflictsQuery{} }
+func (m *ResourceConflictsQuery) String() string { return proto.CompactTextString(m) }
+func (*ResourceConflictsQuery) ProtoMessage()    {}
+func (*ResourceConflictsQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_55137f215f842c93, []int{12}
+}
+func (m *ResourceConflictsQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceConflictsQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResourceConflictsQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return false
+}
+func (dst *ResourceConflictsQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceConflictsQuery.Merge(dst, src)
+}
+func (m *ResourceConflictsQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceConflictsQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceConflictsQuery.DiscardUnknown(m)
 }
 
-func (m *ApplicationRollbackRequest) GetPrune() bool {
+var xxx_messageInfo_ResourceConflictsQuery proto.InternalMessageInfo
+
+func (m *ResourceConflictsQuery) GetName() string {
 	if m != nil {
-		return m.Prune
+		return m.Name
 	}
-	return false
+	return ""
 }
 
-type ApplicationResourceRequest struct {
-	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
-	Namespace            string   `protobuf:"bytes,2,req,name=namespace" json:"namespace"`
-	ResourceName         string   `protobuf:"bytes,3,req,name=resourceName" json:"resourceName"`
-	Version              string   `protobuf:"bytes,4,req,name=version" json:"version"`
-	Group                string   `protobuf:"bytes,5,req,name=group" json:"group"`
-	Kind                 string   `protobuf:"bytes,6,req,name=kind" json:"kind"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// ResourceConflict is a live resource which is claimed by two applications
+type ResourceConflict struct {
+	Server                 string   `protobuf:"bytes,1,req,name=server" json:"server"`
+	Group                  string   `protobuf:"bytes,2,req,name=group" json:"group"`
+	Kind                   string   `protobuf:"bytes,3,req,name=kind" json:"kind"`
+	Namespace              string   `protobuf:"bytes,4,req,name=namespace" json:"namespace"`
+	Name                   string   `protobuf:"bytes,5,req,name=name" json:"name"`
+	Application            string   `protobuf:"bytes,6,req,name=application" json:"application"`
+	ConflictingApplication string   `protobuf:"bytes,7,req,name=conflictingApplication" json:"conflictingApplication"`
+	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
+	XXX_unrecognized       []byte   `json:"-"`
+	XXX_sizecache          int32    `json:"-"`
 }
 
-func (m *ApplicationResourceRequest) Reset()         { *m = ApplicationResourceRequest{} }
-func (m *ApplicationResourceRequest) String() string { return proto.CompactTextString(m) }
-func (*ApplicationResourceRequest) ProtoMessage()    {}
-func (*ApplicationResourceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_25eb5dc9e619c6e4, []int{12}
+func (m *ResourceConflict) Reset()         { *m = ResourceConflict{} }
+func (m *ResourceConflict) String() string { return proto.CompactTextString(m) }
+func (*ResourceConflict) ProtoMessage()    {}
+func (*ResourceConflict) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_55137f215f842c93, []int{13}
 }
-func (m *ApplicationResourceRequest) XXX_Unmarshal(b []byte) error {
+func (m *ResourceConflict) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ApplicationResourceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ResourceConflict) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ApplicationResourceRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ResourceConflict.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -821,86 +933,87 @@ func (m *ApplicationResourceRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (dst *ApplicationResourceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ApplicationResourceRequest.Merge(dst, src)
+func (dst *ResourceConflict) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceConflict.Merge(dst, src)
 }
-func (m *ApplicationResourceRequest) XXX_Size() int {
+func (m *ResourceConflict) XXX_Size() int {
 	return m.Size()
 }
-func (m *ApplicationResourceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ApplicationResourceRequest.DiscardUnknown(m)
+func (m *ResourceConflict) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceConflict.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ApplicationResourceRequest proto.InternalMessageInfo
+var xxx_messageInfo_ResourceConflict proto.InternalMessageInfo
 
-func (m *ApplicationResourceRequest) GetName() string {
-	if m != nil && m.Name != nil {
-		return *m.Name
+func (m *ResourceConflict) GetServer() string {
+	if m != nil {
+		return m.Server
 	}
 	return ""
 }
 
-func (m *ApplicationResourceRequest) GetNamespace() string {
+func (m *ResourceConflict) GetGroup() string {
 	if m != nil {
-		return m.Namespace
+		return m.Group
 	}
 	return ""
 }
 
-func (m *ApplicationResourceRequest) GetResourceName() string {
+func (m *ResourceConflict) GetKind() string {
 	if m != nil {
-		return m.ResourceName
+		return m.Kind
 	}
 	return ""
 }
 
-func (m *ApplicationResourceRequest) GetVersion() string {
+func (m *ResourceConflict) GetNamespace() string {
 	if m != nil {
-		return m.Version
+		return m.Namespace
 	}
 	return ""
 }
 
-func (m *ApplicationResourceRequest) GetGroup() string {
+func (m *ResourceConflict) GetName() string {
 	if m != nil {
-		return m.Group
+		return m.Name
 	}
 	return ""
 }
 
-func (m *ApplicationResourceRequest) GetKind() string {
+func (m *ResourceConflict) GetApplication() string {
 	if m != nil {
-		return m.Kind
+		return m.Application
 	}
 	return ""
 }
 
-type ApplicationResourcePatchRequest struct {
-	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
-	Namespace            string   `protobuf:"bytes,2,req,name=namespace" json:"namespace"`
-	ResourceName         string   `protobuf:"bytes,3,req,name=resourceName" json:"resourceName"`
-	Version              string   `protobuf:"bytes,4,req,name=version" json:"version"`
-	Group                string   `protobuf:"bytes,5,req,name=group" json:"group"`
-	Kind                 string   `protobuf:"bytes,6,req,name=kind" json:"kind"`
-	Patch                string   `protobuf:"bytes,7,req,name=patch" json:"patch"`
-	PatchType            string   `protobuf:"bytes,8,req,name=patchType" json:"patchType"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ResourceConflict) GetConflictingApplication() string {
+	if m != nil {
+		return m.ConflictingApplication
+	}
+	return ""
 }
 
-func (m *ApplicationResourcePatchRequest) Reset()         { *m = ApplicationResourcePatchRequest{} }
-func (m *ApplicationResourcePatchRequest) String() string { return proto.CompactTextString(m) }
-func (*ApplicationResourcePatchRequest) ProtoMessage()    {}
-func (*ApplicationResourcePatchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_25eb5dc9e619c6e4, []int{13}
+// ResourceConflictList holds the pairs of applications which manage the same resources
+type ResourceConflictList struct {
+	Items                []ResourceConflict `protobuf:"bytes,1,rep,name=items" json:"items"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
-func (m *ApplicationResourcePatchRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *ResourceConflictList) Reset()         { *m = ResourceConflictList{} }
+func (m *ResourceConflictList) String() string { return proto.CompactTextString(m) }
+func (*ResourceConflictList) ProtoMessage()    {}
+func (*ResourceConflictList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_55137f215f842c93, []int{14}
+}
+func (m *ResourceConflictList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ApplicationResourcePatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ResourceConflictList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ApplicationResourcePatchRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ResourceConflictList.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -910,99 +1023,133 @@ func (m *ApplicationResourcePatchRequest) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (dst *ApplicationResourcePatchRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ApplicationResourcePatchRequest.Merge(dst, src)
+func (dst *ResourceConflictList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceConflictList.Merge(dst, src)
 }
-func (m *ApplicationResourcePatchRequest) XXX_Size() int {
+func (m *ResourceConflictList) XXX_Size() int {
 	return m.Size()
 }
-func (m *ApplicationResourcePatchRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ApplicationResourcePatchRequest.DiscardUnknown(m)
+func (m *ResourceConflictList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceConflictList.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ApplicationResourcePatchRequest proto.InternalMessageInfo
+var xxx_messageInfo_ResourceConflictList proto.InternalMessageInfo
 
-func (m *ApplicationResourcePatchRequest) GetName() string {
-	if m != nil && m.Name != nil {
-		return *m.Name
+func (m *ResourceConflictList) GetItems() []ResourceConflict {
+	if m != nil {
+		return m.Items
 	}
-	return ""
+	return nil
 }
 
-func (m *ApplicationResourcePatchRequest) GetNamespace() string {
-	if m != nil {
-		return m.Namespace
-	}
-	return ""
+// ApplicationDriftReportQuery is a query for the resources of the applications of a project which drifted from their target state
+type ApplicationDriftReportQuery struct {
+	Project string `protobuf:"bytes,1,req,name=project" json:"project"`
+	// since restricts the report to resources which were first detected to be out of sync at or after the given time,
+	// in RFC3339 format
+	Since string `protobuf:"bytes,2,opt,name=since" json:"since"`
+	// limit is the maximum number of resources in a page of the report, all resources are returned if not set
+	Limit int64 `protobuf:"varint,3,opt,name=limit" json:"limit"`
+	// continue is the token returned with the previous page of the report
+	Continue             string   `protobuf:"bytes,4,opt,name=continue" json:"continue"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ApplicationResourcePatchRequest) GetResourceName() string {
-	if m != nil {
-		return m.ResourceName
-	}
-	return ""
+func (m *ApplicationDriftReportQuery) Reset()         { *m = ApplicationDriftReportQuery{} }
+func (m *ApplicationDriftReportQuery) String() string { return proto.CompactTextString(m) }
+func (*ApplicationDriftReportQuery) ProtoMessage()    {}
+func (*ApplicationDriftReportQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_55137f215f842c93, []int{15}
 }
-
-func (m *ApplicationResourcePatchRequest) GetVersion() string {
-	if m != nil {
-		return m.Version
+func (m *ApplicationDriftReportQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationDriftReportQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationDriftReportQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (dst *ApplicationDriftReportQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationDriftReportQuery.Merge(dst, src)
+}
+func (m *ApplicationDriftReportQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationDriftReportQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationDriftReportQuery.DiscardUnknown(m)
 }
 
-func (m *ApplicationResourcePatchRequest) GetGroup() string {
+var xxx_messageInfo_ApplicationDriftReportQuery proto.InternalMessageInfo
+
+func (m *ApplicationDriftReportQuery) GetProject() string {
 	if m != nil {
-		return m.Group
+		return m.Project
 	}
 	return ""
 }
 
-func (m *ApplicationResourcePatchRequest) GetKind() string {
+func (m *ApplicationDriftReportQuery) GetSince() string {
 	if m != nil {
-		return m.Kind
+		return m.Since
 	}
 	return ""
 }
 
-func (m *ApplicationResourcePatchRequest) GetPatch() string {
+func (m *ApplicationDriftReportQuery) GetLimit() int64 {
 	if m != nil {
-		return m.Patch
+		return m.Limit
 	}
-	return ""
+	return 0
 }
 
-func (m *ApplicationResourcePatchRequest) GetPatchType() string {
+func (m *ApplicationDriftReportQuery) GetContinue() string {
 	if m != nil {
-		return m.PatchType
+		return m.Continue
 	}
 	return ""
 }
 
-type ApplicationResourceDeleteRequest struct {
-	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
-	Namespace            string   `protobuf:"bytes,2,req,name=namespace" json:"namespace"`
-	ResourceName         string   `protobuf:"bytes,3,req,name=resourceName" json:"resourceName"`
-	Version              string   `protobuf:"bytes,4,req,name=version" json:"version"`
-	Group                string   `protobuf:"bytes,5,req,name=group" json:"group"`
-	Kind                 string   `protobuf:"bytes,6,req,name=kind" json:"kind"`
-	Force                *bool    `protobuf:"varint,7,opt,name=force" json:"force,omitempty"`
+// DriftedResource is a resource whose live state differs from its target state
+type DriftedResource struct {
+	Application string `protobuf:"bytes,1,req,name=application" json:"application"`
+	Group       string `protobuf:"bytes,2,req,name=group" json:"group"`
+	Kind        string `protobuf:"bytes,3,req,name=kind" json:"kind"`
+	Namespace   string `protobuf:"bytes,4,req,name=namespace" json:"namespace"`
+	Name        string `protobuf:"bytes,5,req,name=name" json:"name"`
+	// fields are the JSON pointers of the fields which differ between the live and the target state
+	Fields []string `protobuf:"bytes,6,rep,name=fields" json:"fields,omitempty"`
+	// missing is true if the resource does not exist in the cluster
+	Missing bool `protobuf:"varint,7,opt,name=missing" json:"missing"`
+	// requiresPruning is true if the resource exists in the cluster but not in the target state
+	RequiresPruning bool `protobuf:"varint,8,opt,name=requiresPruning" json:"requiresPruning"`
+	// detectedAt is the time at which the resource was first detected to be out of sync
+	DetectedAt           *v1.Time `protobuf:"bytes,9,opt,name=detectedAt" json:"detectedAt,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ApplicationResourceDeleteRequest) Reset()         { *m = ApplicationResourceDeleteRequest{} }
-func (m *ApplicationResourceDeleteRequest) String() string { return proto.CompactTextString(m) }
-func (*ApplicationResourceDeleteRequest) ProtoMessage()    {}
-func (*ApplicationResourceDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_25eb5dc9e619c6e4, []int{14}
+func (m *DriftedResource) Reset()         { *m = DriftedResource{} }
+func (m *DriftedResource) String() string { return proto.CompactTextString(m) }
+func (*DriftedResource) ProtoMessage()    {}
+func (*DriftedResource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_55137f215f842c93, []int{16}
 }
-func (m *ApplicationResourceDeleteRequest) XXX_Unmarshal(b []byte) error {
+func (m *DriftedResource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ApplicationResourceDeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DriftedResource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ApplicationResourceDeleteRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DriftedResource.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1012,92 +1159,103 @@ func (m *ApplicationResourceDeleteRequest) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (dst *ApplicationResourceDeleteRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ApplicationResourceDeleteRequest.Merge(dst, src)
+func (dst *DriftedResource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DriftedResource.Merge(dst, src)
 }
-func (m *ApplicationResourceDeleteRequest) XXX_Size() int {
+func (m *DriftedResource) XXX_Size() int {
 	return m.Size()
 }
-func (m *ApplicationResourceDeleteRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ApplicationResourceDeleteRequest.DiscardUnknown(m)
+func (m *DriftedResource) XXX_DiscardUnknown() {
+	xxx_messageInfo_DriftedResource.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ApplicationResourceDeleteRequest proto.InternalMessageInfo
+var xxx_messageInfo_DriftedResource proto.InternalMessageInfo
 
-func (m *ApplicationResourceDeleteRequest) GetName() string {
-	if m != nil && m.Name != nil {
-		return *m.Name
+func (m *DriftedResource) GetApplication() string {
+	if m != nil {
+		return m.Application
 	}
 	return ""
 }
 
-func (m *ApplicationResourceDeleteRequest) GetNamespace() string {
+func (m *DriftedResource) GetGroup() string {
 	if m != nil {
-		return m.Namespace
+		return m.Group
 	}
 	return ""
 }
 
-func (m *ApplicationResourceDeleteRequest) GetResourceName() string {
+func (m *DriftedResource) GetKind() string {
 	if m != nil {
-		return m.ResourceName
+		return m.Kind
 	}
 	return ""
 }
 
-func (m *ApplicationResourceDeleteRequest) GetVersion() string {
+func (m *DriftedResource) GetNamespace() string {
 	if m != nil {
-		return m.Version
+		return m.Namespace
 	}
 	return ""
 }
 
-func (m *ApplicationResourceDeleteRequest) GetGroup() string {
+func (m *DriftedResource) GetName() string {
 	if m != nil {
-		return m.Group
+		return m.Name
 	}
 	return ""
 }
 
-func (m *ApplicationResourceDeleteRequest) GetKind() string {
+func (m *DriftedResource) GetFields() []string {
 	if m != nil {
-		return m.Kind
+		return m.Fields
 	}
-	return ""
+	return nil
 }
 
-func (m *ApplicationResourceDeleteRequest) GetForce() bool {
-	if m != nil && m.Force != nil {
-		return *m.Force
+func (m *DriftedResource) GetMissing() bool {
+	if m != nil {
+		return m.Missing
 	}
 	return false
 }
 
-type ResourceActionRunRequest struct {
-	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
-	Namespace            string   `protobuf:"bytes,2,req,name=namespace" json:"namespace"`
-	ResourceName         string   `protobuf:"bytes,3,req,name=resourceName" json:"resourceName"`
-	Version              string   `protobuf:"bytes,4,req,name=version" json:"version"`
-	Group                string   `protobuf:"bytes,5,req,name=group" json:"group"`
-	Kind                 string   `protobuf:"bytes,6,req,name=kind" json:"kind"`
-	Action               string   `protobuf:"bytes,7,req,name=action" json:"action"`
+func (m *DriftedResource) GetRequiresPruning() bool {
+	if m != nil {
+		return m.RequiresPruning
+	}
+	return false
+}
+
+func (m *DriftedResource) GetDetectedAt() *v1.Time {
+	if m != nil {
+		return m.DetectedAt
+	}
+	return nil
+}
+
+// ApplicationDriftReport holds a page of the resources which drifted from their target state
+type ApplicationDriftReport struct {
+	Items []DriftedResource `protobuf:"bytes,1,rep,name=items" json:"items"`
+	// continue is the token to request the next page of the report, empty on the last page
+	Continue             string   `protobuf:"bytes,2,opt,name=continue" json:"continue"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ResourceActionRunRequest) Reset()         { *m = ResourceActionRunRequest{} }
-func (m *ResourceActionRunRequest) String() string { return proto.CompactTextString(m) }
-func (*ResourceActionRunRequest) ProtoMessage()    {}
-func (*ResourceActionRunRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_25eb5dc9e619c6e4, []int{15}
+func (m *ApplicationDriftReport) Reset()         { *m = ApplicationDriftReport{} }
+func (m *ApplicationDriftReport) String() string { return proto.CompactTextString(m) }
+func (*ApplicationDriftReport) ProtoMessage()    {}
+func (*ApplicationDriftReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_55137f215f842c93, []int{17}
 }
-func (m *ResourceActionRunRequest) XXX_Unmarshal(b []byte) error {
+func (m *ApplicationDriftReport) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ResourceActionRunRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ApplicationDriftReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ResourceActionRunRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ApplicationDriftReport.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1107,86 +1265,56 @@ func (m *ResourceActionRunRequest) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (dst *ResourceActionRunRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ResourceActionRunRequest.Merge(dst, src)
+func (dst *ApplicationDriftReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationDriftReport.Merge(dst, src)
 }
-func (m *ResourceActionRunRequest) XXX_Size() int {
+func (m *ApplicationDriftReport) XXX_Size() int {
 	return m.Size()
 }
-func (m *ResourceActionRunRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ResourceActionRunRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_ResourceActionRunRequest proto.InternalMessageInfo
-
-func (m *ResourceActionRunRequest) GetName() string {
-	if m != nil && m.Name != nil {
-		return *m.Name
-	}
-	return ""
-}
-
-func (m *ResourceActionRunRequest) GetNamespace() string {
-	if m != nil {
-		return m.Namespace
-	}
-	return ""
-}
-
-func (m *ResourceActionRunRequest) GetResourceName() string {
-	if m != nil {
-		return m.ResourceName
-	}
-	return ""
-}
-
-func (m *ResourceActionRunRequest) GetVersion() string {
-	if m != nil {
-		return m.Version
-	}
-	return ""
+func (m *ApplicationDriftReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationDriftReport.DiscardUnknown(m)
 }
 
-func (m *ResourceActionRunRequest) GetGroup() string {
-	if m != nil {
-		return m.Group
-	}
-	return ""
-}
+var xxx_messageInfo_ApplicationDriftReport proto.InternalMessageInfo
 
-func (m *ResourceActionRunRequest) GetKind() string {
+func (m *ApplicationDriftReport) GetItems() []DriftedResource {
 	if m != nil {
-		return m.Kind
+		return m.Items
 	}
-	return ""
+	return nil
 }
 
-func (m *ResourceActionRunRequest) GetAction() string {
+func (m *ApplicationDriftReport) GetContinue() string {
 	if m != nil {
-		return m.Action
+		return m.Continue
 	}
 	return ""
 }
 
-type ResourceActionsListResponse struct {
-	Actions              []v1alpha1.ResourceAction `protobuf:"bytes,1,rep,name=actions" json:"actions"`
-	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
-	XXX_unrecognized     []byte                    `json:"-"`
-	XXX_sizecache        int32                     `json:"-"`
+// ApplicationStatusMatrixQuery selects the applications which are aggregated into a status matrix
+type ApplicationStatusMatrixQuery struct {
+	// label selector of the applications, e.g. 'team=payments', all applications are included if empty
+	Selector string `protobuf:"bytes,1,opt,name=selector" json:"selector"`
+	// groupBy is the key of the label whose value is the row of an application, e.g. 'app.kubernetes.io/name'.
+	// Applications without the label, or all applications if not set, are rows of their own.
+	GroupBy              string   `protobuf:"bytes,2,opt,name=groupBy" json:"groupBy"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ResourceActionsListResponse) Reset()         { *m = ResourceActionsListResponse{} }
-func (m *ResourceActionsListResponse) String() string { return proto.CompactTextString(m) }
-func (*ResourceActionsListResponse) ProtoMessage()    {}
-func (*ResourceActionsListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_25eb5dc9e619c6e4, []int{16}
+func (m *ApplicationStatusMatrixQuery) Reset()         { *m = ApplicationStatusMatrixQuery{} }
+func (m *ApplicationStatusMatrixQuery) String() string { return proto.CompactTextString(m) }
+func (*ApplicationStatusMatrixQuery) ProtoMessage()    {}
+func (*ApplicationStatusMatrixQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_55137f215f842c93, []int{18}
 }
-func (m *ResourceActionsListResponse) XXX_Unmarshal(b []byte) error {
+func (m *ApplicationStatusMatrixQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ResourceActionsListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ApplicationStatusMatrixQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ResourceActionsListResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ApplicationStatusMatrixQuery.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1196,44 +1324,53 @@ func (m *ResourceActionsListResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (dst *ResourceActionsListResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ResourceActionsListResponse.Merge(dst, src)
+func (dst *ApplicationStatusMatrixQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationStatusMatrixQuery.Merge(dst, src)
 }
-func (m *ResourceActionsListResponse) XXX_Size() int {
+func (m *ApplicationStatusMatrixQuery) XXX_Size() int {
 	return m.Size()
 }
-func (m *ResourceActionsListResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ResourceActionsListResponse.DiscardUnknown(m)
+func (m *ApplicationStatusMatrixQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationStatusMatrixQuery.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ResourceActionsListResponse proto.InternalMessageInfo
+var xxx_messageInfo_ApplicationStatusMatrixQuery proto.InternalMessageInfo
 
-func (m *ResourceActionsListResponse) GetActions() []v1alpha1.ResourceAction {
+func (m *ApplicationStatusMatrixQuery) GetSelector() string {
 	if m != nil {
-		return m.Actions
+		return m.Selector
 	}
-	return nil
+	return ""
 }
 
-type ApplicationResourceResponse struct {
-	Manifest             string   `protobuf:"bytes,1,req,name=manifest" json:"manifest"`
+func (m *ApplicationStatusMatrixQuery) GetGroupBy() string {
+	if m != nil {
+		return m.GroupBy
+	}
+	return ""
+}
+
+// ApplicationStatusMatrixCluster is a column of a status matrix
+type ApplicationStatusMatrixCluster struct {
+	Server               string   `protobuf:"bytes,1,req,name=server" json:"server"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name" json:"name"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ApplicationResourceResponse) Reset()         { *m = ApplicationResourceResponse{} }
-func (m *ApplicationResourceResponse) String() string { return proto.CompactTextString(m) }
-func (*ApplicationResourceResponse) ProtoMessage()    {}
-func (*ApplicationResourceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_25eb5dc9e619c6e4, []int{17}
+func (m *ApplicationStatusMatrixCluster) Reset()         { *m = ApplicationStatusMatrixCluster{} }
+func (m *ApplicationStatusMatrixCluster) String() string { return proto.CompactTextString(m) }
+func (*ApplicationStatusMatrixCluster) ProtoMessage()    {}
+func (*ApplicationStatusMatrixCluster) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_55137f215f842c93, []int{19}
 }
-func (m *ApplicationResourceResponse) XXX_Unmarshal(b []byte) error {
+func (m *ApplicationStatusMatrixCluster) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ApplicationResourceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ApplicationStatusMatrixCluster) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ApplicationResourceResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ApplicationStatusMatrixCluster.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1243,51 +1380,58 @@ func (m *ApplicationResourceResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (dst *ApplicationResourceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ApplicationResourceResponse.Merge(dst, src)
+func (dst *ApplicationStatusMatrixCluster) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationStatusMatrixCluster.Merge(dst, src)
 }
-func (m *ApplicationResourceResponse) XXX_Size() int {
+func (m *ApplicationStatusMatrixCluster) XXX_Size() int {
 	return m.Size()
 }
-func (m *ApplicationResourceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ApplicationResourceResponse.DiscardUnknown(m)
+func (m *ApplicationStatusMatrixCluster) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationStatusMatrixCluster.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ApplicationResourceResponse proto.InternalMessageInfo
+var xxx_messageInfo_ApplicationStatusMatrixCluster proto.InternalMessageInfo
 
-func (m *ApplicationResourceResponse) GetManifest() string {
+func (m *ApplicationStatusMatrixCluster) GetServer() string {
 	if m != nil {
-		return m.Manifest
+		return m.Server
 	}
 	return ""
 }
 
-type ApplicationPodLogsQuery struct {
-	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
-	Namespace            string   `protobuf:"bytes,2,req,name=namespace" json:"namespace"`
-	PodName              *string  `protobuf:"bytes,3,req,name=podName" json:"podName,omitempty"`
-	Container            string   `protobuf:"bytes,4,req,name=container" json:"container"`
-	SinceSeconds         int64    `protobuf:"varint,5,req,name=sinceSeconds" json:"sinceSeconds"`
-	SinceTime            *v1.Time `protobuf:"bytes,6,opt,name=sinceTime" json:"sinceTime,omitempty"`
-	TailLines            int64    `protobuf:"varint,7,req,name=tailLines" json:"tailLines"`
-	Follow               bool     `protobuf:"varint,8,req,name=follow" json:"follow"`
+func (m *ApplicationStatusMatrixCluster) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// ApplicationStatusMatrixCell holds the status of an application deployed to the cluster of a column
+type ApplicationStatusMatrixCell struct {
+	// cluster is the index of the column of the cluster
+	Cluster              int32    `protobuf:"varint,1,req,name=cluster" json:"cluster"`
+	Application          string   `protobuf:"bytes,2,req,name=application" json:"application"`
+	Namespace            string   `protobuf:"bytes,3,opt,name=namespace" json:"namespace"`
+	SyncStatus           string   `protobuf:"bytes,4,opt,name=syncStatus" json:"syncStatus"`
+	HealthStatus         string   `protobuf:"bytes,5,opt,name=healthStatus" json:"healthStatus"`
+	Revision             string   `protobuf:"bytes,6,opt,name=revision" json:"revision"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ApplicationPodLogsQuery) Reset()         { *m = ApplicationPodLogsQuery{} }
-func (m *ApplicationPodLogsQuery) String() string { return proto.CompactTextString(m) }
-func (*ApplicationPodLogsQuery) ProtoMessage()    {}
-func (*ApplicationPodLogsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_25eb5dc9e619c6e4, []int{18}
+func (m *ApplicationStatusMatrixCell) Reset()         { *m = ApplicationStatusMatrixCell{} }
+func (m *ApplicationStatusMatrixCell) String() string { return proto.CompactTextString(m) }
+func (*ApplicationStatusMatrixCell) ProtoMessage()    {}
+func (*ApplicationStatusMatrixCell) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_55137f215f842c93, []int{20}
 }
-func (m *ApplicationPodLogsQuery) XXX_Unmarshal(b []byte) error {
+func (m *ApplicationStatusMatrixCell) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ApplicationPodLogsQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ApplicationStatusMatrixCell) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ApplicationPodLogsQuery.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ApplicationStatusMatrixCell.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1297,94 +1441,137 @@ func (m *ApplicationPodLogsQuery) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (dst *ApplicationPodLogsQuery) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ApplicationPodLogsQuery.Merge(dst, src)
+func (dst *ApplicationStatusMatrixCell) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationStatusMatrixCell.Merge(dst, src)
 }
-func (m *ApplicationPodLogsQuery) XXX_Size() int {
+func (m *ApplicationStatusMatrixCell) XXX_Size() int {
 	return m.Size()
 }
-func (m *ApplicationPodLogsQuery) XXX_DiscardUnknown() {
-	xxx_messageInfo_ApplicationPodLogsQuery.DiscardUnknown(m)
+func (m *ApplicationStatusMatrixCell) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationStatusMatrixCell.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ApplicationPodLogsQuery proto.InternalMessageInfo
+var xxx_messageInfo_ApplicationStatusMatrixCell proto.InternalMessageInfo
 
-func (m *ApplicationPodLogsQuery) GetName() string {
-	if m != nil && m.Name != nil {
-		return *m.Name
+func (m *ApplicationStatusMatrixCell) GetCluster() int32 {
+	if m != nil {
+		return m.Cluster
+	}
+	return 0
+}
+
+func (m *ApplicationStatusMatrixCell) GetApplication() string {
+	if m != nil {
+		return m.Application
 	}
 	return ""
 }
 
-func (m *ApplicationPodLogsQuery) GetNamespace() string {
+func (m *ApplicationStatusMatrixCell) GetNamespace() string {
 	if m != nil {
 		return m.Namespace
 	}
 	return ""
 }
 
-func (m *ApplicationPodLogsQuery) GetPodName() string {
-	if m != nil && m.PodName != nil {
-		return *m.PodName
+func (m *ApplicationStatusMatrixCell) GetSyncStatus() string {
+	if m != nil {
+		return m.SyncStatus
 	}
 	return ""
 }
 
-func (m *ApplicationPodLogsQuery) GetContainer() string {
+func (m *ApplicationStatusMatrixCell) GetHealthStatus() string {
 	if m != nil {
-		return m.Container
+		return m.HealthStatus
 	}
 	return ""
 }
 
-func (m *ApplicationPodLogsQuery) GetSinceSeconds() int64 {
+func (m *ApplicationStatusMatrixCell) GetRevision() string {
 	if m != nil {
-		return m.SinceSeconds
+		return m.Revision
 	}
-	return 0
+	return ""
 }
 
-func (m *ApplicationPodLogsQuery) GetSinceTime() *v1.Time {
-	if m != nil {
-		return m.SinceTime
+// ApplicationStatusMatrixRow holds the statuses of the applications of a group
+type ApplicationStatusMatrixRow struct {
+	Name                 string                        `protobuf:"bytes,1,req,name=name" json:"name"`
+	Cells                []ApplicationStatusMatrixCell `protobuf:"bytes,2,rep,name=cells" json:"cells"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *ApplicationStatusMatrixRow) Reset()         { *m = ApplicationStatusMatrixRow{} }
+func (m *ApplicationStatusMatrixRow) String() string { return proto.CompactTextString(m) }
+func (*ApplicationStatusMatrixRow) ProtoMessage()    {}
+func (*ApplicationStatusMatrixRow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_55137f215f842c93, []int{21}
+}
+func (m *ApplicationStatusMatrixRow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationStatusMatrixRow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationStatusMatrixRow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (dst *ApplicationStatusMatrixRow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationStatusMatrixRow.Merge(dst, src)
+}
+func (m *ApplicationStatusMatrixRow) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationStatusMatrixRow) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationStatusMatrixRow.DiscardUnknown(m)
 }
 
-func (m *ApplicationPodLogsQuery) GetTailLines() int64 {
+var xxx_messageInfo_ApplicationStatusMatrixRow proto.InternalMessageInfo
+
+func (m *ApplicationStatusMatrixRow) GetName() string {
 	if m != nil {
-		return m.TailLines
+		return m.Name
 	}
-	return 0
+	return ""
 }
 
-func (m *ApplicationPodLogsQuery) GetFollow() bool {
+func (m *ApplicationStatusMatrixRow) GetCells() []ApplicationStatusMatrixCell {
 	if m != nil {
-		return m.Follow
+		return m.Cells
 	}
-	return false
+	return nil
 }
 
-type LogEntry struct {
-	Content              string   `protobuf:"bytes,1,req,name=content" json:"content"`
-	TimeStamp            v1.Time  `protobuf:"bytes,2,req,name=timeStamp" json:"timeStamp"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// ApplicationStatusMatrix holds the sync and health statuses and the revisions of applications by group and cluster
+type ApplicationStatusMatrix struct {
+	Clusters             []ApplicationStatusMatrixCluster `protobuf:"bytes,1,rep,name=clusters" json:"clusters"`
+	Rows                 []ApplicationStatusMatrixRow     `protobuf:"bytes,2,rep,name=rows" json:"rows"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_unrecognized     []byte                           `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
 }
 
-func (m *LogEntry) Reset()         { *m = LogEntry{} }
-func (m *LogEntry) String() string { return proto.CompactTextString(m) }
-func (*LogEntry) ProtoMessage()    {}
-func (*LogEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_25eb5dc9e619c6e4, []int{19}
+func (m *ApplicationStatusMatrix) Reset()         { *m = ApplicationStatusMatrix{} }
+func (m *ApplicationStatusMatrix) String() string { return proto.CompactTextString(m) }
+func (*ApplicationStatusMatrix) ProtoMessage()    {}
+func (*ApplicationStatusMatrix) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_55137f215f842c93, []int{22}
 }
-func (m *LogEntry) XXX_Unmarshal(b []byte) error {
+func (m *ApplicationStatusMatrix) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *LogEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ApplicationStatusMatrix) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_LogEntry.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ApplicationStatusMatrix.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1394,51 +1581,58 @@ func (m *LogEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (dst *LogEntry) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LogEntry.Merge(dst, src)
+func (dst *ApplicationStatusMatrix) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationStatusMatrix.Merge(dst, src)
 }
-func (m *LogEntry) XXX_Size() int {
+func (m *ApplicationStatusMatrix) XXX_Size() int {
 	return m.Size()
 }
-func (m *LogEntry) XXX_DiscardUnknown() {
-	xxx_messageInfo_LogEntry.DiscardUnknown(m)
+func (m *ApplicationStatusMatrix) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationStatusMatrix.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LogEntry proto.InternalMessageInfo
+var xxx_messageInfo_ApplicationStatusMatrix proto.InternalMessageInfo
 
-func (m *LogEntry) GetContent() string {
+func (m *ApplicationStatusMatrix) GetClusters() []ApplicationStatusMatrixCluster {
 	if m != nil {
-		return m.Content
+		return m.Clusters
 	}
-	return ""
+	return nil
 }
 
-func (m *LogEntry) GetTimeStamp() v1.Time {
+func (m *ApplicationStatusMatrix) GetRows() []ApplicationStatusMatrixRow {
 	if m != nil {
-		return m.TimeStamp
+		return m.Rows
 	}
-	return v1.Time{}
+	return nil
 }
 
-type OperationTerminateRequest struct {
-	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+// ApplicationBulkRequest selects the applications of a bulk operation, either by name or by label selector
+type ApplicationBulkRequest struct {
+	Names []string `protobuf:"bytes,1,rep,name=names" json:"names,omitempty"`
+	// label selector of the applications, e.g. 'team=payments', used if no names are given
+	Selector string `protobuf:"bytes,2,opt,name=selector" json:"selector"`
+	// type of the refresh of bulk refreshes, 'normal' or 'hard'
+	Refresh string `protobuf:"bytes,3,opt,name=refresh" json:"refresh"`
+	// whether bulk deletes delete the resources of the applications as well
+	Cascade              *bool    `protobuf:"varint,4,opt,name=cascade" json:"cascade,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *OperationTerminateRequest) Reset()         { *m = OperationTerminateRequest{} }
-func (m *OperationTerminateRequest) String() string { return proto.CompactTextString(m) }
-func (*OperationTerminateRequest) ProtoMessage()    {}
-func (*OperationTerminateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_25eb5dc9e619c6e4, []int{20}
+func (m *ApplicationBulkRequest) Reset()         { *m = ApplicationBulkRequest{} }
+func (m *ApplicationBulkRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplicationBulkRequest) ProtoMessage()    {}
+func (*ApplicationBulkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_55137f215f842c93, []int{23}
 }
-func (m *OperationTerminateRequest) XXX_Unmarshal(b []byte) error {
+func (m *ApplicationBulkRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *OperationTerminateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ApplicationBulkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_OperationTerminateRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ApplicationBulkRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1448,43 +1642,71 @@ func (m *OperationTerminateRequest) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (dst *OperationTerminateRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OperationTerminateRequest.Merge(dst, src)
+func (dst *ApplicationBulkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationBulkRequest.Merge(dst, src)
 }
-func (m *OperationTerminateRequest) XXX_Size() int {
+func (m *ApplicationBulkRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *OperationTerminateRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_OperationTerminateRequest.DiscardUnknown(m)
+func (m *ApplicationBulkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationBulkRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OperationTerminateRequest proto.InternalMessageInfo
+var xxx_messageInfo_ApplicationBulkRequest proto.InternalMessageInfo
 
-func (m *OperationTerminateRequest) GetName() string {
-	if m != nil && m.Name != nil {
-		return *m.Name
+func (m *ApplicationBulkRequest) GetNames() []string {
+	if m != nil {
+		return m.Names
+	}
+	return nil
+}
+
+func (m *ApplicationBulkRequest) GetSelector() string {
+	if m != nil {
+		return m.Selector
 	}
 	return ""
 }
 
-type OperationTerminateResponse struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ApplicationBulkRequest) GetRefresh() string {
+	if m != nil {
+		return m.Refresh
+	}
+	return ""
 }
 
-func (m *OperationTerminateResponse) Reset()         { *m = OperationTerminateResponse{} }
-func (m *OperationTerminateResponse) String() string { return proto.CompactTextString(m) }
-func (*OperationTerminateResponse) ProtoMessage()    {}
-func (*OperationTerminateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_25eb5dc9e619c6e4, []int{21}
+func (m *ApplicationBulkRequest) GetCascade() bool {
+	if m != nil && m.Cascade != nil {
+		return *m.Cascade
+	}
+	return false
 }
-func (m *OperationTerminateResponse) XXX_Unmarshal(b []byte) error {
+
+// ApplicationBulkSyncRequest is a request to sync the applications of a list or selector
+type ApplicationBulkSyncRequest struct {
+	Names []string `protobuf:"bytes,1,rep,name=names" json:"names,omitempty"`
+	// label selector of the applications, e.g. 'team=payments', used if no names are given
+	Selector             string                 `protobuf:"bytes,2,opt,name=selector" json:"selector"`
+	DryRun               bool                   `protobuf:"varint,3,opt,name=dryRun" json:"dryRun"`
+	Prune                bool                   `protobuf:"varint,4,opt,name=prune" json:"prune"`
+	Strategy             *v1alpha1.SyncStrategy `protobuf:"bytes,5,opt,name=strategy" json:"strategy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *ApplicationBulkSyncRequest) Reset()         { *m = ApplicationBulkSyncRequest{} }
+func (m *ApplicationBulkSyncRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplicationBulkSyncRequest) ProtoMessage()    {}
+func (*ApplicationBulkSyncRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_55137f215f842c93, []int{24}
+}
+func (m *ApplicationBulkSyncRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *OperationTerminateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ApplicationBulkSyncRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_OperationTerminateResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ApplicationBulkSyncRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1494,37 +1716,75 @@ func (m *OperationTerminateResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (dst *OperationTerminateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OperationTerminateResponse.Merge(dst, src)
+func (dst *ApplicationBulkSyncRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationBulkSyncRequest.Merge(dst, src)
 }
-func (m *OperationTerminateResponse) XXX_Size() int {
+func (m *ApplicationBulkSyncRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *OperationTerminateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_OperationTerminateResponse.DiscardUnknown(m)
+func (m *ApplicationBulkSyncRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationBulkSyncRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OperationTerminateResponse proto.InternalMessageInfo
+var xxx_messageInfo_ApplicationBulkSyncRequest proto.InternalMessageInfo
 
-type ResourcesQuery struct {
-	ApplicationName      *string  `protobuf:"bytes,1,req,name=applicationName" json:"applicationName,omitempty"`
+func (m *ApplicationBulkSyncRequest) GetNames() []string {
+	if m != nil {
+		return m.Names
+	}
+	return nil
+}
+
+func (m *ApplicationBulkSyncRequest) GetSelector() string {
+	if m != nil {
+		return m.Selector
+	}
+	return ""
+}
+
+func (m *ApplicationBulkSyncRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *ApplicationBulkSyncRequest) GetPrune() bool {
+	if m != nil {
+		return m.Prune
+	}
+	return false
+}
+
+func (m *ApplicationBulkSyncRequest) GetStrategy() *v1alpha1.SyncStrategy {
+	if m != nil {
+		return m.Strategy
+	}
+	return nil
+}
+
+// ApplicationBulkResult is the outcome of a bulk operation for a single application
+type ApplicationBulkResult struct {
+	Name *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	// error of the operation, empty if it succeeded
+	Error                string   `protobuf:"bytes,2,opt,name=error" json:"error"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ResourcesQuery) Reset()         { *m = ResourcesQuery{} }
-func (m *ResourcesQuery) String() string { return proto.CompactTextString(m) }
-func (*ResourcesQuery) ProtoMessage()    {}
-func (*ResourcesQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_25eb5dc9e619c6e4, []int{22}
+func (m *ApplicationBulkResult) Reset()         { *m = ApplicationBulkResult{} }
+func (m *ApplicationBulkResult) String() string { return proto.CompactTextString(m) }
+func (*ApplicationBulkResult) ProtoMessage()    {}
+func (*ApplicationBulkResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_55137f215f842c93, []int{25}
 }
-func (m *ResourcesQuery) XXX_Unmarshal(b []byte) error {
+func (m *ApplicationBulkResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ResourcesQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ApplicationBulkResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ResourcesQuery.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ApplicationBulkResult.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1534,44 +1794,51 @@ func (m *ResourcesQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (dst *ResourcesQuery) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ResourcesQuery.Merge(dst, src)
+func (dst *ApplicationBulkResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationBulkResult.Merge(dst, src)
 }
-func (m *ResourcesQuery) XXX_Size() int {
+func (m *ApplicationBulkResult) XXX_Size() int {
 	return m.Size()
 }
-func (m *ResourcesQuery) XXX_DiscardUnknown() {
-	xxx_messageInfo_ResourcesQuery.DiscardUnknown(m)
+func (m *ApplicationBulkResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationBulkResult.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ResourcesQuery proto.InternalMessageInfo
+var xxx_messageInfo_ApplicationBulkResult proto.InternalMessageInfo
 
-func (m *ResourcesQuery) GetApplicationName() string {
-	if m != nil && m.ApplicationName != nil {
-		return *m.ApplicationName
+func (m *ApplicationBulkResult) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
 	}
 	return ""
 }
 
-type ManagedResourcesResponse struct {
-	Items                []*v1alpha1.ResourceDiff `protobuf:"bytes,1,rep,name=items" json:"items,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+func (m *ApplicationBulkResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
 }
 
-func (m *ManagedResourcesResponse) Reset()         { *m = ManagedResourcesResponse{} }
-func (m *ManagedResourcesResponse) String() string { return proto.CompactTextString(m) }
-func (*ManagedResourcesResponse) ProtoMessage()    {}
-func (*ManagedResourcesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_25eb5dc9e619c6e4, []int{23}
+type ApplicationBulkResponse struct {
+	Results              []ApplicationBulkResult `protobuf:"bytes,1,rep,name=results" json:"results"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
-func (m *ManagedResourcesResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *ApplicationBulkResponse) Reset()         { *m = ApplicationBulkResponse{} }
+func (m *ApplicationBulkResponse) String() string { return proto.CompactTextString(m) }
+func (*ApplicationBulkResponse) ProtoMessage()    {}
+func (*ApplicationBulkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_55137f215f842c93, []int{26}
+}
+func (m *ApplicationBulkResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ManagedResourcesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ApplicationBulkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ManagedResourcesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ApplicationBulkResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1581,2351 +1848,10888 @@ func (m *ManagedResourcesResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (dst *ManagedResourcesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ManagedResourcesResponse.Merge(dst, src)
+func (dst *ApplicationBulkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationBulkResponse.Merge(dst, src)
 }
-func (m *ManagedResourcesResponse) XXX_Size() int {
+func (m *ApplicationBulkResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ManagedResourcesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ManagedResourcesResponse.DiscardUnknown(m)
+func (m *ApplicationBulkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationBulkResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ManagedResourcesResponse proto.InternalMessageInfo
+var xxx_messageInfo_ApplicationBulkResponse proto.InternalMessageInfo
 
-func (m *ManagedResourcesResponse) GetItems() []*v1alpha1.ResourceDiff {
+func (m *ApplicationBulkResponse) GetResults() []ApplicationBulkResult {
 	if m != nil {
-		return m.Items
+		return m.Results
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*ApplicationQuery)(nil), "application.ApplicationQuery")
-	proto.RegisterType((*RevisionMetadataQuery)(nil), "application.RevisionMetadataQuery")
-	proto.RegisterType((*ApplicationResourceEventsQuery)(nil), "application.ApplicationResourceEventsQuery")
-	proto.RegisterType((*ApplicationManifestQuery)(nil), "application.ApplicationManifestQuery")
-	proto.RegisterType((*ApplicationResponse)(nil), "application.ApplicationResponse")
-	proto.RegisterType((*ApplicationCreateRequest)(nil), "application.ApplicationCreateRequest")
-	proto.RegisterType((*ApplicationUpdateRequest)(nil), "application.ApplicationUpdateRequest")
-	proto.RegisterType((*ApplicationDeleteRequest)(nil), "application.ApplicationDeleteRequest")
-	proto.RegisterType((*ApplicationSyncRequest)(nil), "application.ApplicationSyncRequest")
-	proto.RegisterType((*ApplicationUpdateSpecRequest)(nil), "application.ApplicationUpdateSpecRequest")
-	proto.RegisterType((*ApplicationPatchRequest)(nil), "application.ApplicationPatchRequest")
-	proto.RegisterType((*ApplicationRollbackRequest)(nil), "application.ApplicationRollbackRequest")
-	proto.RegisterType((*ApplicationResourceRequest)(nil), "application.ApplicationResourceRequest")
-	proto.RegisterType((*ApplicationResourcePatchRequest)(nil), "application.ApplicationResourcePatchRequest")
-	proto.RegisterType((*ApplicationResourceDeleteRequest)(nil), "application.ApplicationResourceDeleteRequest")
-	proto.RegisterType((*ResourceActionRunRequest)(nil), "application.ResourceActionRunRequest")
-	proto.RegisterType((*ResourceActionsListResponse)(nil), "application.ResourceActionsListResponse")
-	proto.RegisterType((*ApplicationResourceResponse)(nil), "application.ApplicationResourceResponse")
-	proto.RegisterType((*ApplicationPodLogsQuery)(nil), "application.ApplicationPodLogsQuery")
-	proto.RegisterType((*LogEntry)(nil), "application.LogEntry")
-	proto.RegisterType((*OperationTerminateRequest)(nil), "application.OperationTerminateRequest")
-	proto.RegisterType((*OperationTerminateResponse)(nil), "application.OperationTerminateResponse")
-	proto.RegisterType((*ResourcesQuery)(nil), "application.ResourcesQuery")
-	proto.RegisterType((*ManagedResourcesResponse)(nil), "application.ManagedResourcesResponse")
+type ApplicationResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *ApplicationResponse) Reset()         { *m = ApplicationResponse{} }
+func (m *ApplicationResponse) String() string { return proto.CompactTextString(m) }
+func (*ApplicationResponse) ProtoMessage()    {}
+func (*ApplicationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_55137f215f842c93, []int{27}
+}
+func (m *ApplicationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ApplicationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationResponse.Merge(dst, src)
+}
+func (m *ApplicationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationResponse.DiscardUnknown(m)
+}
 
-// Client API for ApplicationService service
+var xxx_messageInfo_ApplicationResponse proto.InternalMessageInfo
 
-type ApplicationServiceClient interface {
-	// List returns list of applications
-	List(ctx context.Context, in *ApplicationQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationList, error)
-	// ListResourceEvents returns a list of event resources
-	ListResourceEvents(ctx context.Context, in *ApplicationResourceEventsQuery, opts ...grpc.CallOption) (*v11.EventList, error)
-	// Watch returns stream of application change events.
-	Watch(ctx context.Context, in *ApplicationQuery, opts ...grpc.CallOption) (ApplicationService_WatchClient, error)
-	// Create creates an application
-	Create(ctx context.Context, in *ApplicationCreateRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error)
-	// Get returns an application by name
-	Get(ctx context.Context, in *ApplicationQuery, opts ...grpc.CallOption) (*v1alpha1.Application, error)
-	// Get the meta-data (author, date, tags, message) for a specific revision of the application
-	RevisionMetadata(ctx context.Context, in *RevisionMetadataQuery, opts ...grpc.CallOption) (*v1alpha1.RevisionMetadata, error)
-	// GetManifests returns application manifests
-	GetManifests(ctx context.Context, in *ApplicationManifestQuery, opts ...grpc.CallOption) (*apiclient.ManifestResponse, error)
-	// Update updates an application
-	Update(ctx context.Context, in *ApplicationUpdateRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error)
-	// UpdateSpec updates an application spec
-	UpdateSpec(ctx context.Context, in *ApplicationUpdateSpecRequest, opts ...grpc.CallOption) (*v1alpha1.ApplicationSpec, error)
-	// Patch patch an application
-	Patch(ctx context.Context, in *ApplicationPatchRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error)
-	// Delete deletes an application
-	Delete(ctx context.Context, in *ApplicationDeleteRequest, opts ...grpc.CallOption) (*ApplicationResponse, error)
-	// Sync syncs an application to its target state
-	Sync(ctx context.Context, in *ApplicationSyncRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error)
-	ManagedResources(ctx context.Context, in *ResourcesQuery, opts ...grpc.CallOption) (*ManagedResourcesResponse, error)
-	ResourceTree(ctx context.Context, in *ResourcesQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationTree, error)
-	// Rollback syncs an application to its target state
-	Rollback(ctx context.Context, in *ApplicationRollbackRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error)
-	// TerminateOperation terminates the currently running operation
-	TerminateOperation(ctx context.Context, in *OperationTerminateRequest, opts ...grpc.CallOption) (*OperationTerminateResponse, error)
-	// GetResource returns single application resource
-	GetResource(ctx context.Context, in *ApplicationResourceRequest, opts ...grpc.CallOption) (*ApplicationResourceResponse, error)
-	// PatchResource patch single application resource
-	PatchResource(ctx context.Context, in *ApplicationResourcePatchRequest, opts ...grpc.CallOption) (*ApplicationResourceResponse, error)
-	ListResourceActions(ctx context.Context, in *ApplicationResourceRequest, opts ...grpc.CallOption) (*ResourceActionsListResponse, error)
-	RunResourceAction(ctx context.Context, in *ResourceActionRunRequest, opts ...grpc.CallOption) (*ApplicationResponse, error)
-	// DeleteResource deletes a single application resource
-	DeleteResource(ctx context.Context, in *ApplicationResourceDeleteRequest, opts ...grpc.CallOption) (*ApplicationResponse, error)
-	// PodLogs returns stream of log entries for the specified pod. Pod
-	PodLogs(ctx context.Context, in *ApplicationPodLogsQuery, opts ...grpc.CallOption) (ApplicationService_PodLogsClient, error)
+type ApplicationCreateRequest struct {
+	Application          v1alpha1.Application `protobuf:"bytes,1,req,name=application" json:"application"`
+	Upsert               *bool                `protobuf:"varint,2,opt,name=upsert" json:"upsert,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-type applicationServiceClient struct {
-	cc *grpc.ClientConn
+func (m *ApplicationCreateRequest) Reset()         { *m = ApplicationCreateRequest{} }
+func (m *ApplicationCreateRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplicationCreateRequest) ProtoMessage()    {}
+func (*ApplicationCreateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_55137f215f842c93, []int{28}
 }
-
-func NewApplicationServiceClient(cc *grpc.ClientConn) ApplicationServiceClient {
-	return &applicationServiceClient{cc}
+func (m *ApplicationCreateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *applicationServiceClient) List(ctx context.Context, in *ApplicationQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationList, error) {
-	out := new(v1alpha1.ApplicationList)
-	err := c.cc.Invoke(ctx, "/application.ApplicationService/List", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ApplicationCreateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationCreateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *applicationServiceClient) ListResourceEvents(ctx context.Context, in *ApplicationResourceEventsQuery, opts ...grpc.CallOption) (*v11.EventList, error) {
-	out := new(v11.EventList)
-	err := c.cc.Invoke(ctx, "/application.ApplicationService/ListResourceEvents", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (dst *ApplicationCreateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationCreateRequest.Merge(dst, src)
 }
-
-func (c *applicationServiceClient) Watch(ctx context.Context, in *ApplicationQuery, opts ...grpc.CallOption) (ApplicationService_WatchClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ApplicationService_serviceDesc.Streams[0], "/application.ApplicationService/Watch", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &applicationServiceWatchClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
+func (m *ApplicationCreateRequest) XXX_Size() int {
+	return m.Size()
 }
-
-type ApplicationService_WatchClient interface {
-	Recv() (*v1alpha1.ApplicationWatchEvent, error)
-	grpc.ClientStream
+func (m *ApplicationCreateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationCreateRequest.DiscardUnknown(m)
 }
 
-type applicationServiceWatchClient struct {
-	grpc.ClientStream
-}
+var xxx_messageInfo_ApplicationCreateRequest proto.InternalMessageInfo
 
-func (x *applicationServiceWatchClient) Recv() (*v1alpha1.ApplicationWatchEvent, error) {
-	m := new(v1alpha1.ApplicationWatchEvent)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *ApplicationCreateRequest) GetApplication() v1alpha1.Application {
+	if m != nil {
+		return m.Application
 	}
-	return m, nil
+	return v1alpha1.Application{}
 }
 
-func (c *applicationServiceClient) Create(ctx context.Context, in *ApplicationCreateRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error) {
-	out := new(v1alpha1.Application)
-	err := c.cc.Invoke(ctx, "/application.ApplicationService/Create", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ApplicationCreateRequest) GetUpsert() bool {
+	if m != nil && m.Upsert != nil {
+		return *m.Upsert
 	}
-	return out, nil
+	return false
 }
 
-func (c *applicationServiceClient) Get(ctx context.Context, in *ApplicationQuery, opts ...grpc.CallOption) (*v1alpha1.Application, error) {
-	out := new(v1alpha1.Application)
-	err := c.cc.Invoke(ctx, "/application.ApplicationService/Get", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type ApplicationUpdateRequest struct {
+	Application          *v1alpha1.Application `protobuf:"bytes,1,req,name=application" json:"application,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (c *applicationServiceClient) RevisionMetadata(ctx context.Context, in *RevisionMetadataQuery, opts ...grpc.CallOption) (*v1alpha1.RevisionMetadata, error) {
-	out := new(v1alpha1.RevisionMetadata)
-	err := c.cc.Invoke(ctx, "/application.ApplicationService/RevisionMetadata", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ApplicationUpdateRequest) Reset()         { *m = ApplicationUpdateRequest{} }
+func (m *ApplicationUpdateRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplicationUpdateRequest) ProtoMessage()    {}
+func (*ApplicationUpdateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_55137f215f842c93, []int{29}
+}
+func (m *ApplicationUpdateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationUpdateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationUpdateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (dst *ApplicationUpdateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationUpdateRequest.Merge(dst, src)
+}
+func (m *ApplicationUpdateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationUpdateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationUpdateRequest.DiscardUnknown(m)
 }
 
-func (c *applicationServiceClient) GetManifests(ctx context.Context, in *ApplicationManifestQuery, opts ...grpc.CallOption) (*apiclient.ManifestResponse, error) {
-	out := new(apiclient.ManifestResponse)
-	err := c.cc.Invoke(ctx, "/application.ApplicationService/GetManifests", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ApplicationUpdateRequest proto.InternalMessageInfo
+
+func (m *ApplicationUpdateRequest) GetApplication() *v1alpha1.Application {
+	if m != nil {
+		return m.Application
 	}
-	return out, nil
+	return nil
 }
 
-func (c *applicationServiceClient) Update(ctx context.Context, in *ApplicationUpdateRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error) {
-	out := new(v1alpha1.Application)
-	err := c.cc.Invoke(ctx, "/application.ApplicationService/Update", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type ApplicationDeleteRequest struct {
+	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Cascade              *bool    `protobuf:"varint,2,opt,name=cascade" json:"cascade,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *applicationServiceClient) UpdateSpec(ctx context.Context, in *ApplicationUpdateSpecRequest, opts ...grpc.CallOption) (*v1alpha1.ApplicationSpec, error) {
-	out := new(v1alpha1.ApplicationSpec)
-	err := c.cc.Invoke(ctx, "/application.ApplicationService/UpdateSpec", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ApplicationDeleteRequest) Reset()         { *m = ApplicationDeleteRequest{} }
+func (m *ApplicationDeleteRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplicationDeleteRequest) ProtoMessage()    {}
+func (*ApplicationDeleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_55137f215f842c93, []int{30}
+}
+func (m *ApplicationDeleteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationDeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationDeleteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (dst *ApplicationDeleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationDeleteRequest.Merge(dst, src)
+}
+func (m *ApplicationDeleteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationDeleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationDeleteRequest.DiscardUnknown(m)
 }
 
-func (c *applicationServiceClient) Patch(ctx context.Context, in *ApplicationPatchRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error) {
-	out := new(v1alpha1.Application)
-	err := c.cc.Invoke(ctx, "/application.ApplicationService/Patch", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ApplicationDeleteRequest proto.InternalMessageInfo
+
+func (m *ApplicationDeleteRequest) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
 	}
-	return out, nil
+	return ""
 }
 
-func (c *applicationServiceClient) Delete(ctx context.Context, in *ApplicationDeleteRequest, opts ...grpc.CallOption) (*ApplicationResponse, error) {
-	out := new(ApplicationResponse)
-	err := c.cc.Invoke(ctx, "/application.ApplicationService/Delete", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ApplicationDeleteRequest) GetCascade() bool {
+	if m != nil && m.Cascade != nil {
+		return *m.Cascade
 	}
-	return out, nil
+	return false
 }
 
-func (c *applicationServiceClient) Sync(ctx context.Context, in *ApplicationSyncRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error) {
-	out := new(v1alpha1.Application)
-	err := c.cc.Invoke(ctx, "/application.ApplicationService/Sync", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// ApplicationSyncRequest is a request to apply the config state to live state
+type ApplicationSyncRequest struct {
+	Name      *string                          `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Revision  string                           `protobuf:"bytes,2,opt,name=revision" json:"revision"`
+	DryRun    bool                             `protobuf:"varint,3,opt,name=dryRun" json:"dryRun"`
+	Prune     bool                             `protobuf:"varint,4,opt,name=prune" json:"prune"`
+	Strategy  *v1alpha1.SyncStrategy           `protobuf:"bytes,5,opt,name=strategy" json:"strategy,omitempty"`
+	Resources []v1alpha1.SyncOperationResource `protobuf:"bytes,7,rep,name=resources" json:"resources"`
+	Manifests []string                         `protobuf:"bytes,8,rep,name=manifests" json:"manifests,omitempty"`
+	Adopt     bool                             `protobuf:"varint,9,opt,name=adopt" json:"adopt"`
+	// confirms that the sync may prune the argocd-server and argocd-application-controller deployments
+	PruneArgoCD          bool     `protobuf:"varint,10,opt,name=pruneArgoCD" json:"pruneArgoCD"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *applicationServiceClient) ManagedResources(ctx context.Context, in *ResourcesQuery, opts ...grpc.CallOption) (*ManagedResourcesResponse, error) {
-	out := new(ManagedResourcesResponse)
-	err := c.cc.Invoke(ctx, "/application.ApplicationService/ManagedResources", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ApplicationSyncRequest) Reset()         { *m = ApplicationSyncRequest{} }
+func (m *ApplicationSyncRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplicationSyncRequest) ProtoMessage()    {}
+func (*ApplicationSyncRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_55137f215f842c93, []int{31}
+}
+func (m *ApplicationSyncRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSyncRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationSyncRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (dst *ApplicationSyncRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSyncRequest.Merge(dst, src)
+}
+func (m *ApplicationSyncRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSyncRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSyncRequest.DiscardUnknown(m)
 }
 
-func (c *applicationServiceClient) ResourceTree(ctx context.Context, in *ResourcesQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationTree, error) {
-	out := new(v1alpha1.ApplicationTree)
-	err := c.cc.Invoke(ctx, "/application.ApplicationService/ResourceTree", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ApplicationSyncRequest proto.InternalMessageInfo
+
+func (m *ApplicationSyncRequest) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
 	}
-	return out, nil
+	return ""
 }
 
-func (c *applicationServiceClient) Rollback(ctx context.Context, in *ApplicationRollbackRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error) {
-	out := new(v1alpha1.Application)
-	err := c.cc.Invoke(ctx, "/application.ApplicationService/Rollback", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ApplicationSyncRequest) GetRevision() string {
+	if m != nil {
+		return m.Revision
 	}
-	return out, nil
+	return ""
 }
 
-func (c *applicationServiceClient) TerminateOperation(ctx context.Context, in *OperationTerminateRequest, opts ...grpc.CallOption) (*OperationTerminateResponse, error) {
-	out := new(OperationTerminateResponse)
-	err := c.cc.Invoke(ctx, "/application.ApplicationService/TerminateOperation", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ApplicationSyncRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
 	}
-	return out, nil
+	return false
 }
 
-func (c *applicationServiceClient) GetResource(ctx context.Context, in *ApplicationResourceRequest, opts ...grpc.CallOption) (*ApplicationResourceResponse, error) {
-	out := new(ApplicationResourceResponse)
-	err := c.cc.Invoke(ctx, "/application.ApplicationService/GetResource", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ApplicationSyncRequest) GetPrune() bool {
+	if m != nil {
+		return m.Prune
 	}
-	return out, nil
+	return false
 }
 
-func (c *applicationServiceClient) PatchResource(ctx context.Context, in *ApplicationResourcePatchRequest, opts ...grpc.CallOption) (*ApplicationResourceResponse, error) {
-	out := new(ApplicationResourceResponse)
-	err := c.cc.Invoke(ctx, "/application.ApplicationService/PatchResource", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ApplicationSyncRequest) GetStrategy() *v1alpha1.SyncStrategy {
+	if m != nil {
+		return m.Strategy
 	}
-	return out, nil
+	return nil
 }
 
-func (c *applicationServiceClient) ListResourceActions(ctx context.Context, in *ApplicationResourceRequest, opts ...grpc.CallOption) (*ResourceActionsListResponse, error) {
-	out := new(ResourceActionsListResponse)
-	err := c.cc.Invoke(ctx, "/application.ApplicationService/ListResourceActions", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ApplicationSyncRequest) GetResources() []v1alpha1.SyncOperationResource {
+	if m != nil {
+		return m.Resources
 	}
-	return out, nil
+	return nil
 }
 
-func (c *applicationServiceClient) RunResourceAction(ctx context.Context, in *ResourceActionRunRequest, opts ...grpc.CallOption) (*ApplicationResponse, error) {
-	out := new(ApplicationResponse)
-	err := c.cc.Invoke(ctx, "/application.ApplicationService/RunResourceAction", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ApplicationSyncRequest) GetManifests() []string {
+	if m != nil {
+		return m.Manifests
 	}
-	return out, nil
+	return nil
 }
 
-func (c *applicationServiceClient) DeleteResource(ctx context.Context, in *ApplicationResourceDeleteRequest, opts ...grpc.CallOption) (*ApplicationResponse, error) {
-	out := new(ApplicationResponse)
-	err := c.cc.Invoke(ctx, "/application.ApplicationService/DeleteResource", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ApplicationSyncRequest) GetAdopt() bool {
+	if m != nil {
+		return m.Adopt
 	}
-	return out, nil
+	return false
 }
 
-func (c *applicationServiceClient) PodLogs(ctx context.Context, in *ApplicationPodLogsQuery, opts ...grpc.CallOption) (ApplicationService_PodLogsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ApplicationService_serviceDesc.Streams[1], "/application.ApplicationService/PodLogs", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &applicationServicePodLogsClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *ApplicationSyncRequest) GetPruneArgoCD() bool {
+	if m != nil {
+		return m.PruneArgoCD
 	}
-	return x, nil
+	return false
 }
 
-type ApplicationService_PodLogsClient interface {
-	Recv() (*LogEntry, error)
-	grpc.ClientStream
+// ApplicationUpdateSpecRequest is a request to update application spec
+type ApplicationUpdateSpecRequest struct {
+	Name                 *string                  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Spec                 v1alpha1.ApplicationSpec `protobuf:"bytes,2,req,name=spec" json:"spec"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
-type applicationServicePodLogsClient struct {
-	grpc.ClientStream
+func (m *ApplicationUpdateSpecRequest) Reset()         { *m = ApplicationUpdateSpecRequest{} }
+func (m *ApplicationUpdateSpecRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplicationUpdateSpecRequest) ProtoMessage()    {}
+func (*ApplicationUpdateSpecRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_55137f215f842c93, []int{32}
 }
-
-func (x *applicationServicePodLogsClient) Recv() (*LogEntry, error) {
-	m := new(LogEntry)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *ApplicationUpdateSpecRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationUpdateSpecRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationUpdateSpecRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return m, nil
 }
-
-// Server API for ApplicationService service
-
-type ApplicationServiceServer interface {
-	// List returns list of applications
-	List(context.Context, *ApplicationQuery) (*v1alpha1.ApplicationList, error)
-	// ListResourceEvents returns a list of event resources
-	ListResourceEvents(context.Context, *ApplicationResourceEventsQuery) (*v11.EventList, error)
-	// Watch returns stream of application change events.
-	Watch(*ApplicationQuery, ApplicationService_WatchServer) error
-	// Create creates an application
-	Create(context.Context, *ApplicationCreateRequest) (*v1alpha1.Application, error)
-	// Get returns an application by name
-	Get(context.Context, *ApplicationQuery) (*v1alpha1.Application, error)
-	// Get the meta-data (author, date, tags, message) for a specific revision of the application
-	RevisionMetadata(context.Context, *RevisionMetadataQuery) (*v1alpha1.RevisionMetadata, error)
-	// GetManifests returns application manifests
-	GetManifests(context.Context, *ApplicationManifestQuery) (*apiclient.ManifestResponse, error)
-	// Update updates an application
-	Update(context.Context, *ApplicationUpdateRequest) (*v1alpha1.Application, error)
-	// UpdateSpec updates an application spec
-	UpdateSpec(context.Context, *ApplicationUpdateSpecRequest) (*v1alpha1.ApplicationSpec, error)
-	// Patch patch an application
-	Patch(context.Context, *ApplicationPatchRequest) (*v1alpha1.Application, error)
-	// Delete deletes an application
-	Delete(context.Context, *ApplicationDeleteRequest) (*ApplicationResponse, error)
-	// Sync syncs an application to its target state
-	Sync(context.Context, *ApplicationSyncRequest) (*v1alpha1.Application, error)
-	ManagedResources(context.Context, *ResourcesQuery) (*ManagedResourcesResponse, error)
-	ResourceTree(context.Context, *ResourcesQuery) (*v1alpha1.ApplicationTree, error)
-	// Rollback syncs an application to its target state
-	Rollback(context.Context, *ApplicationRollbackRequest) (*v1alpha1.Application, error)
-	// TerminateOperation terminates the currently running operation
-	TerminateOperation(context.Context, *OperationTerminateRequest) (*OperationTerminateResponse, error)
-	// GetResource returns single application resource
-	GetResource(context.Context, *ApplicationResourceRequest) (*ApplicationResourceResponse, error)
-	// PatchResource patch single application resource
-	PatchResource(context.Context, *ApplicationResourcePatchRequest) (*ApplicationResourceResponse, error)
-	ListResourceActions(context.Context, *ApplicationResourceRequest) (*ResourceActionsListResponse, error)
-	RunResourceAction(context.Context, *ResourceActionRunRequest) (*ApplicationResponse, error)
-	// DeleteResource deletes a single application resource
-	DeleteResource(context.Context, *ApplicationResourceDeleteRequest) (*ApplicationResponse, error)
-	// PodLogs returns stream of log entries for the specified pod. Pod
-	PodLogs(*ApplicationPodLogsQuery, ApplicationService_PodLogsServer) error
+func (dst *ApplicationUpdateSpecRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationUpdateSpecRequest.Merge(dst, src)
 }
-
-func RegisterApplicationServiceServer(s *grpc.Server, srv ApplicationServiceServer) {
-	s.RegisterService(&_ApplicationService_serviceDesc, srv)
+func (m *ApplicationUpdateSpecRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationUpdateSpecRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationUpdateSpecRequest.DiscardUnknown(m)
 }
 
-func _ApplicationService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplicationQuery)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ApplicationServiceServer).List(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/application.ApplicationService/List",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).List(ctx, req.(*ApplicationQuery))
+var xxx_messageInfo_ApplicationUpdateSpecRequest proto.InternalMessageInfo
+
+func (m *ApplicationUpdateSpecRequest) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _ApplicationService_ListResourceEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplicationResourceEventsQuery)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ApplicationServiceServer).ListResourceEvents(ctx, in)
+func (m *ApplicationUpdateSpecRequest) GetSpec() v1alpha1.ApplicationSpec {
+	if m != nil {
+		return m.Spec
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/application.ApplicationService/ListResourceEvents",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).ListResourceEvents(ctx, req.(*ApplicationResourceEventsQuery))
-	}
-	return interceptor(ctx, in, info, handler)
+	return v1alpha1.ApplicationSpec{}
 }
 
-func _ApplicationService_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ApplicationQuery)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(ApplicationServiceServer).Watch(m, &applicationServiceWatchServer{stream})
+// ApplicationPatchRequest is a request to patch an application
+// ApplicationValidateSpecRequest is a request to check an application spec against the rules of its project
+type ApplicationValidateSpecRequest struct {
+	Name                 *string                  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Spec                 v1alpha1.ApplicationSpec `protobuf:"bytes,2,req,name=spec" json:"spec"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
-type ApplicationService_WatchServer interface {
-	Send(*v1alpha1.ApplicationWatchEvent) error
-	grpc.ServerStream
+func (m *ApplicationValidateSpecRequest) Reset()         { *m = ApplicationValidateSpecRequest{} }
+func (m *ApplicationValidateSpecRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplicationValidateSpecRequest) ProtoMessage()    {}
+func (*ApplicationValidateSpecRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_55137f215f842c93, []int{33}
 }
-
-type applicationServiceWatchServer struct {
-	grpc.ServerStream
+func (m *ApplicationValidateSpecRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (x *applicationServiceWatchServer) Send(m *v1alpha1.ApplicationWatchEvent) error {
-	return x.ServerStream.SendMsg(m)
+func (m *ApplicationValidateSpecRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationValidateSpecRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ApplicationValidateSpecRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationValidateSpecRequest.Merge(dst, src)
+}
+func (m *ApplicationValidateSpecRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationValidateSpecRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationValidateSpecRequest.DiscardUnknown(m)
 }
 
-func _ApplicationService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplicationCreateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ApplicationServiceServer).Create(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/application.ApplicationService/Create",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).Create(ctx, req.(*ApplicationCreateRequest))
+var xxx_messageInfo_ApplicationValidateSpecRequest proto.InternalMessageInfo
+
+func (m *ApplicationValidateSpecRequest) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _ApplicationService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplicationQuery)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ApplicationServiceServer).Get(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/application.ApplicationService/Get",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).Get(ctx, req.(*ApplicationQuery))
+func (m *ApplicationValidateSpecRequest) GetSpec() v1alpha1.ApplicationSpec {
+	if m != nil {
+		return m.Spec
 	}
-	return interceptor(ctx, in, info, handler)
+	return v1alpha1.ApplicationSpec{}
 }
 
-func _ApplicationService_RevisionMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RevisionMetadataQuery)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ApplicationServiceServer).RevisionMetadata(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/application.ApplicationService/RevisionMetadata",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).RevisionMetadata(ctx, req.(*RevisionMetadataQuery))
-	}
-	return interceptor(ctx, in, info, handler)
+// ProjectRuleViolation explains which rule rejects an application spec
+type ProjectRuleViolation struct {
+	Rule                 string   `protobuf:"bytes,1,req,name=rule" json:"rule"`
+	Message              string   `protobuf:"bytes,2,req,name=message" json:"message"`
+	Allowed              []string `protobuf:"bytes,3,rep,name=allowed" json:"allowed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _ApplicationService_GetManifests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplicationManifestQuery)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ApplicationServiceServer).GetManifests(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/application.ApplicationService/GetManifests",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).GetManifests(ctx, req.(*ApplicationManifestQuery))
+func (m *ProjectRuleViolation) Reset()         { *m = ProjectRuleViolation{} }
+func (m *ProjectRuleViolation) String() string { return proto.CompactTextString(m) }
+func (*ProjectRuleViolation) ProtoMessage()    {}
+func (*ProjectRuleViolation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_55137f215f842c93, []int{34}
+}
+func (m *ProjectRuleViolation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectRuleViolation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProjectRuleViolation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (dst *ProjectRuleViolation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectRuleViolation.Merge(dst, src)
+}
+func (m *ProjectRuleViolation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectRuleViolation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectRuleViolation.DiscardUnknown(m)
 }
 
-func _ApplicationService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplicationUpdateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ApplicationServiceServer).Update(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/application.ApplicationService/Update",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).Update(ctx, req.(*ApplicationUpdateRequest))
+var xxx_messageInfo_ProjectRuleViolation proto.InternalMessageInfo
+
+func (m *ProjectRuleViolation) GetRule() string {
+	if m != nil {
+		return m.Rule
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _ApplicationService_UpdateSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplicationUpdateSpecRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ApplicationServiceServer).UpdateSpec(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/application.ApplicationService/UpdateSpec",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).UpdateSpec(ctx, req.(*ApplicationUpdateSpecRequest))
+func (m *ProjectRuleViolation) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _ApplicationService_Patch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplicationPatchRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ApplicationServiceServer).Patch(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/application.ApplicationService/Patch",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).Patch(ctx, req.(*ApplicationPatchRequest))
+func (m *ProjectRuleViolation) GetAllowed() []string {
+	if m != nil {
+		return m.Allowed
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _ApplicationService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplicationDeleteRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ApplicationServiceServer).Delete(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/application.ApplicationService/Delete",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).Delete(ctx, req.(*ApplicationDeleteRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// ApplicationValidateSpecResponse lists the rules an application spec violates, and is empty if the spec is valid
+type ApplicationValidateSpecResponse struct {
+	Violations           []ProjectRuleViolation `protobuf:"bytes,1,rep,name=violations" json:"violations"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
-func _ApplicationService_Sync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplicationSyncRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ApplicationServiceServer).Sync(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/application.ApplicationService/Sync",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).Sync(ctx, req.(*ApplicationSyncRequest))
+func (m *ApplicationValidateSpecResponse) Reset()         { *m = ApplicationValidateSpecResponse{} }
+func (m *ApplicationValidateSpecResponse) String() string { return proto.CompactTextString(m) }
+func (*ApplicationValidateSpecResponse) ProtoMessage()    {}
+func (*ApplicationValidateSpecResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_55137f215f842c93, []int{35}
+}
+func (m *ApplicationValidateSpecResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationValidateSpecResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationValidateSpecResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (dst *ApplicationValidateSpecResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationValidateSpecResponse.Merge(dst, src)
+}
+func (m *ApplicationValidateSpecResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationValidateSpecResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationValidateSpecResponse.DiscardUnknown(m)
 }
 
-func _ApplicationService_ManagedResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ResourcesQuery)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ApplicationServiceServer).ManagedResources(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/application.ApplicationService/ManagedResources",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).ManagedResources(ctx, req.(*ResourcesQuery))
+var xxx_messageInfo_ApplicationValidateSpecResponse proto.InternalMessageInfo
+
+func (m *ApplicationValidateSpecResponse) GetViolations() []ProjectRuleViolation {
+	if m != nil {
+		return m.Violations
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _ApplicationService_ResourceTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ResourcesQuery)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ApplicationServiceServer).ResourceTree(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/application.ApplicationService/ResourceTree",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).ResourceTree(ctx, req.(*ResourcesQuery))
-	}
-	return interceptor(ctx, in, info, handler)
+type ApplicationPatchRequest struct {
+	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Patch                string   `protobuf:"bytes,2,req,name=patch" json:"patch"`
+	PatchType            string   `protobuf:"bytes,3,req,name=patchType" json:"patchType"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _ApplicationService_Rollback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplicationRollbackRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ApplicationServiceServer).Rollback(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/application.ApplicationService/Rollback",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).Rollback(ctx, req.(*ApplicationRollbackRequest))
+func (m *ApplicationPatchRequest) Reset()         { *m = ApplicationPatchRequest{} }
+func (m *ApplicationPatchRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplicationPatchRequest) ProtoMessage()    {}
+func (*ApplicationPatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_55137f215f842c93, []int{36}
+}
+func (m *ApplicationPatchRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationPatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationPatchRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (dst *ApplicationPatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationPatchRequest.Merge(dst, src)
+}
+func (m *ApplicationPatchRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationPatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationPatchRequest.DiscardUnknown(m)
 }
 
-func _ApplicationService_TerminateOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OperationTerminateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ApplicationServiceServer).TerminateOperation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/application.ApplicationService/TerminateOperation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).TerminateOperation(ctx, req.(*OperationTerminateRequest))
+var xxx_messageInfo_ApplicationPatchRequest proto.InternalMessageInfo
+
+func (m *ApplicationPatchRequest) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _ApplicationService_GetResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplicationResourceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ApplicationServiceServer).GetResource(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/application.ApplicationService/GetResource",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).GetResource(ctx, req.(*ApplicationResourceRequest))
+func (m *ApplicationPatchRequest) GetPatch() string {
+	if m != nil {
+		return m.Patch
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _ApplicationService_PatchResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplicationResourcePatchRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ApplicationServiceServer).PatchResource(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/application.ApplicationService/PatchResource",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).PatchResource(ctx, req.(*ApplicationResourcePatchRequest))
+func (m *ApplicationPatchRequest) GetPatchType() string {
+	if m != nil {
+		return m.PatchType
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _ApplicationService_ListResourceActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplicationResourceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ApplicationServiceServer).ListResourceActions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/application.ApplicationService/ListResourceActions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).ListResourceActions(ctx, req.(*ApplicationResourceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type ApplicationRollbackRequest struct {
+	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	ID                   int64    `protobuf:"varint,2,req,name=id" json:"id"`
+	DryRun               bool     `protobuf:"varint,3,opt,name=dryRun" json:"dryRun"`
+	Prune                bool     `protobuf:"varint,4,opt,name=prune" json:"prune"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _ApplicationService_RunResourceAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ResourceActionRunRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ApplicationServiceServer).RunResourceAction(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/application.ApplicationService/RunResourceAction",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).RunResourceAction(ctx, req.(*ResourceActionRunRequest))
+func (m *ApplicationRollbackRequest) Reset()         { *m = ApplicationRollbackRequest{} }
+func (m *ApplicationRollbackRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplicationRollbackRequest) ProtoMessage()    {}
+func (*ApplicationRollbackRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_55137f215f842c93, []int{37}
+}
+func (m *ApplicationRollbackRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationRollbackRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationRollbackRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (dst *ApplicationRollbackRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationRollbackRequest.Merge(dst, src)
+}
+func (m *ApplicationRollbackRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationRollbackRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationRollbackRequest.DiscardUnknown(m)
 }
 
-func _ApplicationService_DeleteResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplicationResourceDeleteRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ApplicationServiceServer).DeleteResource(ctx, in)
+var xxx_messageInfo_ApplicationRollbackRequest proto.InternalMessageInfo
+
+func (m *ApplicationRollbackRequest) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/application.ApplicationService/DeleteResource",
+	return ""
+}
+
+func (m *ApplicationRollbackRequest) GetID() int64 {
+	if m != nil {
+		return m.ID
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).DeleteResource(ctx, req.(*ApplicationResourceDeleteRequest))
+	return 0
+}
+
+func (m *ApplicationRollbackRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _ApplicationService_PodLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ApplicationPodLogsQuery)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (m *ApplicationRollbackRequest) GetPrune() bool {
+	if m != nil {
+		return m.Prune
 	}
-	return srv.(ApplicationServiceServer).PodLogs(m, &applicationServicePodLogsServer{stream})
+	return false
 }
 
-type ApplicationService_PodLogsServer interface {
-	Send(*LogEntry) error
-	grpc.ServerStream
+// ApplicationUpdateImagesRequest is a request to override the images deployed by an application
+type ApplicationUpdateImagesRequest struct {
+	Name            *string                  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	HelmParameters  []v1alpha1.HelmParameter `protobuf:"bytes,2,rep,name=helmParameters" json:"helmParameters"`
+	KustomizeImages []string                 `protobuf:"bytes,3,rep,name=kustomizeImages" json:"kustomizeImages,omitempty"`
+	// writeBack persists the overrides to the application spec instead of only deploying them
+	WriteBack            bool     `protobuf:"varint,4,opt,name=writeBack" json:"writeBack"`
+	DryRun               bool     `protobuf:"varint,5,opt,name=dryRun" json:"dryRun"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type applicationServicePodLogsServer struct {
-	grpc.ServerStream
+func (m *ApplicationUpdateImagesRequest) Reset()         { *m = ApplicationUpdateImagesRequest{} }
+func (m *ApplicationUpdateImagesRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplicationUpdateImagesRequest) ProtoMessage()    {}
+func (*ApplicationUpdateImagesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_55137f215f842c93, []int{38}
+}
+func (m *ApplicationUpdateImagesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationUpdateImagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationUpdateImagesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ApplicationUpdateImagesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationUpdateImagesRequest.Merge(dst, src)
+}
+func (m *ApplicationUpdateImagesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationUpdateImagesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationUpdateImagesRequest.DiscardUnknown(m)
 }
 
-func (x *applicationServicePodLogsServer) Send(m *LogEntry) error {
-	return x.ServerStream.SendMsg(m)
+var xxx_messageInfo_ApplicationUpdateImagesRequest proto.InternalMessageInfo
+
+func (m *ApplicationUpdateImagesRequest) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
 }
 
-var _ApplicationService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "application.ApplicationService",
-	HandlerType: (*ApplicationServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "List",
-			Handler:    _ApplicationService_List_Handler,
-		},
-		{
-			MethodName: "ListResourceEvents",
-			Handler:    _ApplicationService_ListResourceEvents_Handler,
-		},
-		{
-			MethodName: "Create",
-			Handler:    _ApplicationService_Create_Handler,
-		},
-		{
-			MethodName: "Get",
-			Handler:    _ApplicationService_Get_Handler,
-		},
-		{
-			MethodName: "RevisionMetadata",
-			Handler:    _ApplicationService_RevisionMetadata_Handler,
-		},
-		{
-			MethodName: "GetManifests",
-			Handler:    _ApplicationService_GetManifests_Handler,
-		},
-		{
-			MethodName: "Update",
-			Handler:    _ApplicationService_Update_Handler,
-		},
-		{
-			MethodName: "UpdateSpec",
-			Handler:    _ApplicationService_UpdateSpec_Handler,
-		},
-		{
-			MethodName: "Patch",
-			Handler:    _ApplicationService_Patch_Handler,
-		},
-		{
-			MethodName: "Delete",
-			Handler:    _ApplicationService_Delete_Handler,
-		},
-		{
-			MethodName: "Sync",
-			Handler:    _ApplicationService_Sync_Handler,
-		},
-		{
-			MethodName: "ManagedResources",
-			Handler:    _ApplicationService_ManagedResources_Handler,
-		},
-		{
-			MethodName: "ResourceTree",
-			Handler:    _ApplicationService_ResourceTree_Handler,
-		},
-		{
-			MethodName: "Rollback",
-			Handler:    _ApplicationService_Rollback_Handler,
-		},
-		{
-			MethodName: "TerminateOperation",
-			Handler:    _ApplicationService_TerminateOperation_Handler,
-		},
-		{
-			MethodName: "GetResource",
-			Handler:    _ApplicationService_GetResource_Handler,
-		},
-		{
-			MethodName: "PatchResource",
-			Handler:    _ApplicationService_PatchResource_Handler,
-		},
-		{
-			MethodName: "ListResourceActions",
-			Handler:    _ApplicationService_ListResourceActions_Handler,
-		},
-		{
-			MethodName: "RunResourceAction",
-			Handler:    _ApplicationService_RunResourceAction_Handler,
-		},
-		{
-			MethodName: "DeleteResource",
-			Handler:    _ApplicationService_DeleteResource_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "Watch",
-			Handler:       _ApplicationService_Watch_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "PodLogs",
-			Handler:       _ApplicationService_PodLogs_Handler,
-			ServerStreams: true,
-		},
-	},
-	Metadata: "server/application/application.proto",
+func (m *ApplicationUpdateImagesRequest) GetHelmParameters() []v1alpha1.HelmParameter {
+	if m != nil {
+		return m.HelmParameters
+	}
+	return nil
 }
 
-func (m *ApplicationQuery) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ApplicationUpdateImagesRequest) GetKustomizeImages() []string {
+	if m != nil {
+		return m.KustomizeImages
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ApplicationQuery) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Name != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
-		i += copy(dAtA[i:], *m.Name)
+func (m *ApplicationUpdateImagesRequest) GetWriteBack() bool {
+	if m != nil {
+		return m.WriteBack
 	}
-	if m.Refresh != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Refresh)))
-		i += copy(dAtA[i:], *m.Refresh)
+	return false
+}
+
+func (m *ApplicationUpdateImagesRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
 	}
-	if len(m.Projects) > 0 {
-		for _, s := range m.Projects {
-			dAtA[i] = 0x1a
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
+	return false
+}
+
+type ApplicationResourceRequest struct {
+	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Namespace            string   `protobuf:"bytes,2,req,name=namespace" json:"namespace"`
+	ResourceName         string   `protobuf:"bytes,3,req,name=resourceName" json:"resourceName"`
+	Version              string   `protobuf:"bytes,4,req,name=version" json:"version"`
+	Group                string   `protobuf:"bytes,5,req,name=group" json:"group"`
+	Kind                 string   `protobuf:"bytes,6,req,name=kind" json:"kind"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationResourceRequest) Reset()         { *m = ApplicationResourceRequest{} }
+func (m *ApplicationResourceRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplicationResourceRequest) ProtoMessage()    {}
+func (*ApplicationResourceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_55137f215f842c93, []int{39}
+}
+func (m *ApplicationResourceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationResourceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationResourceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	dAtA[i] = 0x22
-	i++
-	i = encodeVarintApplication(dAtA, i, uint64(len(m.ResourceVersion)))
-	i += copy(dAtA[i:], m.ResourceVersion)
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+}
+func (dst *ApplicationResourceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationResourceRequest.Merge(dst, src)
+}
+func (m *ApplicationResourceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationResourceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationResourceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationResourceRequest proto.InternalMessageInfo
+
+func (m *ApplicationResourceRequest) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
 	}
-	return i, nil
+	return ""
 }
 
-func (m *RevisionMetadataQuery) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ApplicationResourceRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *RevisionMetadataQuery) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Name == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
-	} else {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
-		i += copy(dAtA[i:], *m.Name)
+func (m *ApplicationResourceRequest) GetResourceName() string {
+	if m != nil {
+		return m.ResourceName
 	}
-	if m.Revision == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("revision")
-	} else {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Revision)))
-		i += copy(dAtA[i:], *m.Revision)
+	return ""
+}
+
+func (m *ApplicationResourceRequest) GetVersion() string {
+	if m != nil {
+		return m.Version
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return ""
+}
+
+func (m *ApplicationResourceRequest) GetGroup() string {
+	if m != nil {
+		return m.Group
 	}
-	return i, nil
+	return ""
 }
 
-func (m *ApplicationResourceEventsQuery) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ApplicationResourceRequest) GetKind() string {
+	if m != nil {
+		return m.Kind
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ApplicationResourceEventsQuery) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Name == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+type ApplicationResourcePatchRequest struct {
+	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Namespace            string   `protobuf:"bytes,2,req,name=namespace" json:"namespace"`
+	ResourceName         string   `protobuf:"bytes,3,req,name=resourceName" json:"resourceName"`
+	Version              string   `protobuf:"bytes,4,req,name=version" json:"version"`
+	Group                string   `protobuf:"bytes,5,req,name=group" json:"group"`
+	Kind                 string   `protobuf:"bytes,6,req,name=kind" json:"kind"`
+	Patch                string   `protobuf:"bytes,7,req,name=patch" json:"patch"`
+	PatchType            string   `protobuf:"bytes,8,req,name=patchType" json:"patchType"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationResourcePatchRequest) Reset()         { *m = ApplicationResourcePatchRequest{} }
+func (m *ApplicationResourcePatchRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplicationResourcePatchRequest) ProtoMessage()    {}
+func (*ApplicationResourcePatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_55137f215f842c93, []int{40}
+}
+func (m *ApplicationResourcePatchRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationResourcePatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationResourcePatchRequest.Marshal(b, m, deterministic)
 	} else {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
-		i += copy(dAtA[i:], *m.Name)
-	}
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintApplication(dAtA, i, uint64(len(m.ResourceNamespace)))
-	i += copy(dAtA[i:], m.ResourceNamespace)
-	dAtA[i] = 0x1a
-	i++
-	i = encodeVarintApplication(dAtA, i, uint64(len(m.ResourceName)))
-	i += copy(dAtA[i:], m.ResourceName)
-	dAtA[i] = 0x22
-	i++
-	i = encodeVarintApplication(dAtA, i, uint64(len(m.ResourceUID)))
-	i += copy(dAtA[i:], m.ResourceUID)
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (dst *ApplicationResourcePatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationResourcePatchRequest.Merge(dst, src)
+}
+func (m *ApplicationResourcePatchRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationResourcePatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationResourcePatchRequest.DiscardUnknown(m)
 }
 
-func (m *ApplicationManifestQuery) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ApplicationResourcePatchRequest proto.InternalMessageInfo
+
+func (m *ApplicationResourcePatchRequest) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ApplicationManifestQuery) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Name == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
-	} else {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
-		i += copy(dAtA[i:], *m.Name)
-	}
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintApplication(dAtA, i, uint64(len(m.Revision)))
-	i += copy(dAtA[i:], m.Revision)
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *ApplicationResourcePatchRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	return i, nil
+	return ""
 }
 
-func (m *ApplicationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ApplicationResourcePatchRequest) GetResourceName() string {
+	if m != nil {
+		return m.ResourceName
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ApplicationResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *ApplicationResourcePatchRequest) GetVersion() string {
+	if m != nil {
+		return m.Version
 	}
-	return i, nil
+	return ""
 }
 
-func (m *ApplicationCreateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ApplicationResourcePatchRequest) GetGroup() string {
+	if m != nil {
+		return m.Group
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ApplicationCreateRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintApplication(dAtA, i, uint64(m.Application.Size()))
-	n1, err := m.Application.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n1
-	if m.Upsert != nil {
-		dAtA[i] = 0x10
-		i++
-		if *m.Upsert {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+func (m *ApplicationResourcePatchRequest) GetKind() string {
+	if m != nil {
+		return m.Kind
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return ""
+}
+
+func (m *ApplicationResourcePatchRequest) GetPatch() string {
+	if m != nil {
+		return m.Patch
 	}
-	return i, nil
+	return ""
 }
 
-func (m *ApplicationUpdateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ApplicationResourcePatchRequest) GetPatchType() string {
+	if m != nil {
+		return m.PatchType
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ApplicationUpdateRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Application == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("application")
+type ApplicationResourceDeleteRequest struct {
+	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Namespace            string   `protobuf:"bytes,2,req,name=namespace" json:"namespace"`
+	ResourceName         string   `protobuf:"bytes,3,req,name=resourceName" json:"resourceName"`
+	Version              string   `protobuf:"bytes,4,req,name=version" json:"version"`
+	Group                string   `protobuf:"bytes,5,req,name=group" json:"group"`
+	Kind                 string   `protobuf:"bytes,6,req,name=kind" json:"kind"`
+	Force                *bool    `protobuf:"varint,7,opt,name=force" json:"force,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationResourceDeleteRequest) Reset()         { *m = ApplicationResourceDeleteRequest{} }
+func (m *ApplicationResourceDeleteRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplicationResourceDeleteRequest) ProtoMessage()    {}
+func (*ApplicationResourceDeleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_55137f215f842c93, []int{41}
+}
+func (m *ApplicationResourceDeleteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationResourceDeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationResourceDeleteRequest.Marshal(b, m, deterministic)
 	} else {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(m.Application.Size()))
-		n2, err := m.Application.MarshalTo(dAtA[i:])
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n2
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+}
+func (dst *ApplicationResourceDeleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationResourceDeleteRequest.Merge(dst, src)
+}
+func (m *ApplicationResourceDeleteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationResourceDeleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationResourceDeleteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationResourceDeleteRequest proto.InternalMessageInfo
+
+func (m *ApplicationResourceDeleteRequest) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
 	}
-	return i, nil
+	return ""
 }
 
-func (m *ApplicationDeleteRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ApplicationResourceDeleteRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ApplicationDeleteRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Name == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
-	} else {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
-		i += copy(dAtA[i:], *m.Name)
-	}
-	if m.Cascade != nil {
-		dAtA[i] = 0x10
-		i++
-		if *m.Cascade {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+func (m *ApplicationResourceDeleteRequest) GetResourceName() string {
+	if m != nil {
+		return m.ResourceName
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return ""
+}
+
+func (m *ApplicationResourceDeleteRequest) GetVersion() string {
+	if m != nil {
+		return m.Version
 	}
-	return i, nil
+	return ""
 }
 
-func (m *ApplicationSyncRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ApplicationResourceDeleteRequest) GetGroup() string {
+	if m != nil {
+		return m.Group
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ApplicationSyncRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Name == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
-	} else {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
-		i += copy(dAtA[i:], *m.Name)
+func (m *ApplicationResourceDeleteRequest) GetKind() string {
+	if m != nil {
+		return m.Kind
 	}
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintApplication(dAtA, i, uint64(len(m.Revision)))
-	i += copy(dAtA[i:], m.Revision)
-	dAtA[i] = 0x18
-	i++
-	if m.DryRun {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
+	return ""
+}
+
+func (m *ApplicationResourceDeleteRequest) GetForce() bool {
+	if m != nil && m.Force != nil {
+		return *m.Force
 	}
-	i++
-	dAtA[i] = 0x20
-	i++
-	if m.Prune {
-		dAtA[i] = 1
+	return false
+}
+
+type ResourceActionRunRequest struct {
+	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Namespace            string   `protobuf:"bytes,2,req,name=namespace" json:"namespace"`
+	ResourceName         string   `protobuf:"bytes,3,req,name=resourceName" json:"resourceName"`
+	Version              string   `protobuf:"bytes,4,req,name=version" json:"version"`
+	Group                string   `protobuf:"bytes,5,req,name=group" json:"group"`
+	Kind                 string   `protobuf:"bytes,6,req,name=kind" json:"kind"`
+	Action               string   `protobuf:"bytes,7,req,name=action" json:"action"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResourceActionRunRequest) Reset()         { *m = ResourceActionRunRequest{} }
+func (m *ResourceActionRunRequest) String() string { return proto.CompactTextString(m) }
+func (*ResourceActionRunRequest) ProtoMessage()    {}
+func (*ResourceActionRunRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_55137f215f842c93, []int{42}
+}
+func (m *ResourceActionRunRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceActionRunRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResourceActionRunRequest.Marshal(b, m, deterministic)
 	} else {
-		dAtA[i] = 0
-	}
-	i++
-	if m.Strategy != nil {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(m.Strategy.Size()))
-		n3, err := m.Strategy.MarshalTo(dAtA[i:])
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
-		}
-		i += n3
-	}
-	if len(m.Resources) > 0 {
-		for _, msg := range m.Resources {
-			dAtA[i] = 0x3a
-			i++
-			i = encodeVarintApplication(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if len(m.Manifests) > 0 {
-		for _, s := range m.Manifests {
-			dAtA[i] = 0x42
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+}
+func (dst *ResourceActionRunRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceActionRunRequest.Merge(dst, src)
+}
+func (m *ResourceActionRunRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceActionRunRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceActionRunRequest.DiscardUnknown(m)
 }
 
-func (m *ApplicationUpdateSpecRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ResourceActionRunRequest proto.InternalMessageInfo
+
+func (m *ResourceActionRunRequest) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ApplicationUpdateSpecRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Name == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
-	} else {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
-		i += copy(dAtA[i:], *m.Name)
-	}
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintApplication(dAtA, i, uint64(m.Spec.Size()))
-	n4, err := m.Spec.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n4
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *ResourceActionRunRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	return i, nil
+	return ""
 }
 
-func (m *ApplicationPatchRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ResourceActionRunRequest) GetResourceName() string {
+	if m != nil {
+		return m.ResourceName
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ApplicationPatchRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Name == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
-	} else {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
-		i += copy(dAtA[i:], *m.Name)
+func (m *ResourceActionRunRequest) GetVersion() string {
+	if m != nil {
+		return m.Version
 	}
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintApplication(dAtA, i, uint64(len(m.Patch)))
-	i += copy(dAtA[i:], m.Patch)
-	dAtA[i] = 0x1a
-	i++
-	i = encodeVarintApplication(dAtA, i, uint64(len(m.PatchType)))
-	i += copy(dAtA[i:], m.PatchType)
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return ""
+}
+
+func (m *ResourceActionRunRequest) GetGroup() string {
+	if m != nil {
+		return m.Group
 	}
-	return i, nil
+	return ""
 }
 
-func (m *ApplicationRollbackRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ResourceActionRunRequest) GetKind() string {
+	if m != nil {
+		return m.Kind
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ApplicationRollbackRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Name == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
-	} else {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
-		i += copy(dAtA[i:], *m.Name)
-	}
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintApplication(dAtA, i, uint64(m.ID))
-	dAtA[i] = 0x18
-	i++
-	if m.DryRun {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
-	}
-	i++
-	dAtA[i] = 0x20
-	i++
-	if m.Prune {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
-	}
-	i++
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *ResourceActionRunRequest) GetAction() string {
+	if m != nil {
+		return m.Action
 	}
-	return i, nil
+	return ""
 }
 
-func (m *ApplicationResourceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type ResourceActionsListResponse struct {
+	Actions              []v1alpha1.ResourceAction `protobuf:"bytes,1,rep,name=actions" json:"actions"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
 
-func (m *ApplicationResourceRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Name == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+func (m *ResourceActionsListResponse) Reset()         { *m = ResourceActionsListResponse{} }
+func (m *ResourceActionsListResponse) String() string { return proto.CompactTextString(m) }
+func (*ResourceActionsListResponse) ProtoMessage()    {}
+func (*ResourceActionsListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_55137f215f842c93, []int{43}
+}
+func (m *ResourceActionsListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceActionsListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResourceActionsListResponse.Marshal(b, m, deterministic)
 	} else {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
-		i += copy(dAtA[i:], *m.Name)
-	}
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintApplication(dAtA, i, uint64(len(m.Namespace)))
-	i += copy(dAtA[i:], m.Namespace)
-	dAtA[i] = 0x1a
-	i++
-	i = encodeVarintApplication(dAtA, i, uint64(len(m.ResourceName)))
-	i += copy(dAtA[i:], m.ResourceName)
-	dAtA[i] = 0x22
-	i++
-	i = encodeVarintApplication(dAtA, i, uint64(len(m.Version)))
-	i += copy(dAtA[i:], m.Version)
-	dAtA[i] = 0x2a
-	i++
-	i = encodeVarintApplication(dAtA, i, uint64(len(m.Group)))
-	i += copy(dAtA[i:], m.Group)
-	dAtA[i] = 0x32
-	i++
-	i = encodeVarintApplication(dAtA, i, uint64(len(m.Kind)))
-	i += copy(dAtA[i:], m.Kind)
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (dst *ResourceActionsListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceActionsListResponse.Merge(dst, src)
+}
+func (m *ResourceActionsListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceActionsListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceActionsListResponse.DiscardUnknown(m)
 }
 
-func (m *ApplicationResourcePatchRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ResourceActionsListResponse proto.InternalMessageInfo
+
+func (m *ResourceActionsListResponse) GetActions() []v1alpha1.ResourceAction {
+	if m != nil {
+		return m.Actions
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ApplicationResourcePatchRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Name == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+type ApplicationResourceResponse struct {
+	Manifest             string   `protobuf:"bytes,1,req,name=manifest" json:"manifest"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationResourceResponse) Reset()         { *m = ApplicationResourceResponse{} }
+func (m *ApplicationResourceResponse) String() string { return proto.CompactTextString(m) }
+func (*ApplicationResourceResponse) ProtoMessage()    {}
+func (*ApplicationResourceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_55137f215f842c93, []int{44}
+}
+func (m *ApplicationResourceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationResourceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationResourceResponse.Marshal(b, m, deterministic)
 	} else {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
-		i += copy(dAtA[i:], *m.Name)
-	}
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintApplication(dAtA, i, uint64(len(m.Namespace)))
-	i += copy(dAtA[i:], m.Namespace)
-	dAtA[i] = 0x1a
-	i++
-	i = encodeVarintApplication(dAtA, i, uint64(len(m.ResourceName)))
-	i += copy(dAtA[i:], m.ResourceName)
-	dAtA[i] = 0x22
-	i++
-	i = encodeVarintApplication(dAtA, i, uint64(len(m.Version)))
-	i += copy(dAtA[i:], m.Version)
-	dAtA[i] = 0x2a
-	i++
-	i = encodeVarintApplication(dAtA, i, uint64(len(m.Group)))
-	i += copy(dAtA[i:], m.Group)
-	dAtA[i] = 0x32
-	i++
-	i = encodeVarintApplication(dAtA, i, uint64(len(m.Kind)))
-	i += copy(dAtA[i:], m.Kind)
-	dAtA[i] = 0x3a
-	i++
-	i = encodeVarintApplication(dAtA, i, uint64(len(m.Patch)))
-	i += copy(dAtA[i:], m.Patch)
-	dAtA[i] = 0x42
-	i++
-	i = encodeVarintApplication(dAtA, i, uint64(len(m.PatchType)))
-	i += copy(dAtA[i:], m.PatchType)
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (dst *ApplicationResourceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationResourceResponse.Merge(dst, src)
+}
+func (m *ApplicationResourceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationResourceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationResourceResponse.DiscardUnknown(m)
 }
 
-func (m *ApplicationResourceDeleteRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ApplicationResourceResponse proto.InternalMessageInfo
+
+func (m *ApplicationResourceResponse) GetManifest() string {
+	if m != nil {
+		return m.Manifest
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ApplicationResourceDeleteRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Name == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+type ApplicationPodLogsQuery struct {
+	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Namespace            string   `protobuf:"bytes,2,req,name=namespace" json:"namespace"`
+	PodName              *string  `protobuf:"bytes,3,req,name=podName" json:"podName,omitempty"`
+	Container            string   `protobuf:"bytes,4,req,name=container" json:"container"`
+	SinceSeconds         int64    `protobuf:"varint,5,req,name=sinceSeconds" json:"sinceSeconds"`
+	SinceTime            *v1.Time `protobuf:"bytes,6,opt,name=sinceTime" json:"sinceTime,omitempty"`
+	TailLines            int64    `protobuf:"varint,7,req,name=tailLines" json:"tailLines"`
+	Follow               bool     `protobuf:"varint,8,req,name=follow" json:"follow"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationPodLogsQuery) Reset()         { *m = ApplicationPodLogsQuery{} }
+func (m *ApplicationPodLogsQuery) String() string { return proto.CompactTextString(m) }
+func (*ApplicationPodLogsQuery) ProtoMessage()    {}
+func (*ApplicationPodLogsQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_55137f215f842c93, []int{45}
+}
+func (m *ApplicationPodLogsQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationPodLogsQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationPodLogsQuery.Marshal(b, m, deterministic)
 	} else {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
-		i += copy(dAtA[i:], *m.Name)
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintApplication(dAtA, i, uint64(len(m.Namespace)))
-	i += copy(dAtA[i:], m.Namespace)
-	dAtA[i] = 0x1a
-	i++
-	i = encodeVarintApplication(dAtA, i, uint64(len(m.ResourceName)))
-	i += copy(dAtA[i:], m.ResourceName)
-	dAtA[i] = 0x22
-	i++
-	i = encodeVarintApplication(dAtA, i, uint64(len(m.Version)))
-	i += copy(dAtA[i:], m.Version)
-	dAtA[i] = 0x2a
-	i++
-	i = encodeVarintApplication(dAtA, i, uint64(len(m.Group)))
-	i += copy(dAtA[i:], m.Group)
-	dAtA[i] = 0x32
-	i++
-	i = encodeVarintApplication(dAtA, i, uint64(len(m.Kind)))
-	i += copy(dAtA[i:], m.Kind)
-	if m.Force != nil {
-		dAtA[i] = 0x38
-		i++
-		if *m.Force {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+}
+func (dst *ApplicationPodLogsQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationPodLogsQuery.Merge(dst, src)
+}
+func (m *ApplicationPodLogsQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationPodLogsQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationPodLogsQuery.DiscardUnknown(m)
 }
 
-func (m *ResourceActionRunRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ApplicationPodLogsQuery proto.InternalMessageInfo
+
+func (m *ApplicationPodLogsQuery) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ResourceActionRunRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Name == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
-	} else {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
-		i += copy(dAtA[i:], *m.Name)
-	}
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintApplication(dAtA, i, uint64(len(m.Namespace)))
-	i += copy(dAtA[i:], m.Namespace)
-	dAtA[i] = 0x1a
-	i++
-	i = encodeVarintApplication(dAtA, i, uint64(len(m.ResourceName)))
-	i += copy(dAtA[i:], m.ResourceName)
-	dAtA[i] = 0x22
-	i++
-	i = encodeVarintApplication(dAtA, i, uint64(len(m.Version)))
-	i += copy(dAtA[i:], m.Version)
-	dAtA[i] = 0x2a
-	i++
-	i = encodeVarintApplication(dAtA, i, uint64(len(m.Group)))
-	i += copy(dAtA[i:], m.Group)
-	dAtA[i] = 0x32
-	i++
-	i = encodeVarintApplication(dAtA, i, uint64(len(m.Kind)))
-	i += copy(dAtA[i:], m.Kind)
-	dAtA[i] = 0x3a
-	i++
-	i = encodeVarintApplication(dAtA, i, uint64(len(m.Action)))
-	i += copy(dAtA[i:], m.Action)
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *ApplicationPodLogsQuery) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	return i, nil
+	return ""
 }
 
-func (m *ResourceActionsListResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ApplicationPodLogsQuery) GetPodName() string {
+	if m != nil && m.PodName != nil {
+		return *m.PodName
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ResourceActionsListResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Actions) > 0 {
-		for _, msg := range m.Actions {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintApplication(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *ApplicationPodLogsQuery) GetContainer() string {
+	if m != nil {
+		return m.Container
 	}
-	return i, nil
+	return ""
 }
 
-func (m *ApplicationResourceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ApplicationPodLogsQuery) GetSinceSeconds() int64 {
+	if m != nil {
+		return m.SinceSeconds
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ApplicationResourceResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintApplication(dAtA, i, uint64(len(m.Manifest)))
-	i += copy(dAtA[i:], m.Manifest)
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *ApplicationPodLogsQuery) GetSinceTime() *v1.Time {
+	if m != nil {
+		return m.SinceTime
 	}
-	return i, nil
+	return nil
 }
 
-func (m *ApplicationPodLogsQuery) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ApplicationPodLogsQuery) GetTailLines() int64 {
+	if m != nil {
+		return m.TailLines
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ApplicationPodLogsQuery) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Name == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
-	} else {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
-		i += copy(dAtA[i:], *m.Name)
+func (m *ApplicationPodLogsQuery) GetFollow() bool {
+	if m != nil {
+		return m.Follow
 	}
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintApplication(dAtA, i, uint64(len(m.Namespace)))
-	i += copy(dAtA[i:], m.Namespace)
-	if m.PodName == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("podName")
+	return false
+}
+
+type LogEntry struct {
+	Content              string   `protobuf:"bytes,1,req,name=content" json:"content"`
+	TimeStamp            v1.Time  `protobuf:"bytes,2,req,name=timeStamp" json:"timeStamp"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LogEntry) Reset()         { *m = LogEntry{} }
+func (m *LogEntry) String() string { return proto.CompactTextString(m) }
+func (*LogEntry) ProtoMessage()    {}
+func (*LogEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_55137f215f842c93, []int{46}
+}
+func (m *LogEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LogEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LogEntry.Marshal(b, m, deterministic)
 	} else {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.PodName)))
-		i += copy(dAtA[i:], *m.PodName)
-	}
-	dAtA[i] = 0x22
-	i++
-	i = encodeVarintApplication(dAtA, i, uint64(len(m.Container)))
-	i += copy(dAtA[i:], m.Container)
-	dAtA[i] = 0x28
-	i++
-	i = encodeVarintApplication(dAtA, i, uint64(m.SinceSeconds))
-	if m.SinceTime != nil {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(m.SinceTime.Size()))
-		n5, err := m.SinceTime.MarshalTo(dAtA[i:])
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n5
-	}
-	dAtA[i] = 0x38
-	i++
-	i = encodeVarintApplication(dAtA, i, uint64(m.TailLines))
-	dAtA[i] = 0x40
-	i++
-	if m.Follow {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
-	}
-	i++
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+		return b[:n], nil
 	}
-	return i, nil
 }
-
-func (m *LogEntry) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (dst *LogEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogEntry.Merge(dst, src)
+}
+func (m *LogEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *LogEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogEntry.DiscardUnknown(m)
 }
 
-func (m *LogEntry) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintApplication(dAtA, i, uint64(len(m.Content)))
-	i += copy(dAtA[i:], m.Content)
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintApplication(dAtA, i, uint64(m.TimeStamp.Size()))
-	n6, err := m.TimeStamp.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n6
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+var xxx_messageInfo_LogEntry proto.InternalMessageInfo
+
+func (m *LogEntry) GetContent() string {
+	if m != nil {
+		return m.Content
 	}
-	return i, nil
+	return ""
 }
 
-func (m *OperationTerminateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *LogEntry) GetTimeStamp() v1.Time {
+	if m != nil {
+		return m.TimeStamp
 	}
-	return dAtA[:n], nil
+	return v1.Time{}
 }
 
-func (m *OperationTerminateRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Name == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+type OperationTerminateRequest struct {
+	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OperationTerminateRequest) Reset()         { *m = OperationTerminateRequest{} }
+func (m *OperationTerminateRequest) String() string { return proto.CompactTextString(m) }
+func (*OperationTerminateRequest) ProtoMessage()    {}
+func (*OperationTerminateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_55137f215f842c93, []int{47}
+}
+func (m *OperationTerminateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperationTerminateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperationTerminateRequest.Marshal(b, m, deterministic)
 	} else {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
-		i += copy(dAtA[i:], *m.Name)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (dst *OperationTerminateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperationTerminateRequest.Merge(dst, src)
+}
+func (m *OperationTerminateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperationTerminateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperationTerminateRequest.DiscardUnknown(m)
 }
 
-func (m *OperationTerminateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_OperationTerminateRequest proto.InternalMessageInfo
+
+func (m *OperationTerminateRequest) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *OperationTerminateResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+type OperationTerminateResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ResourcesQuery) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *OperationTerminateResponse) Reset()         { *m = OperationTerminateResponse{} }
+func (m *OperationTerminateResponse) String() string { return proto.CompactTextString(m) }
+func (*OperationTerminateResponse) ProtoMessage()    {}
+func (*OperationTerminateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_55137f215f842c93, []int{48}
+}
+func (m *OperationTerminateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperationTerminateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperationTerminateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (dst *OperationTerminateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperationTerminateResponse.Merge(dst, src)
+}
+func (m *OperationTerminateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperationTerminateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperationTerminateResponse.DiscardUnknown(m)
 }
 
-func (m *ResourcesQuery) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.ApplicationName == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("applicationName")
+var xxx_messageInfo_OperationTerminateResponse proto.InternalMessageInfo
+
+type ResourcesQuery struct {
+	ApplicationName *string `protobuf:"bytes,1,req,name=applicationName" json:"applicationName,omitempty"`
+	// kinds, in the form 'Kind' or 'Kind.group' (e.g. 'Deployment.apps'), whose live state is listed from the destination
+	// cluster rather than taken from the cluster cache of the application controller
+	RelistKinds []string `protobuf:"bytes,2,rep,name=relistKinds" json:"relistKinds,omitempty"`
+	// kinds, in the form 'Kind' or 'Kind.group', of the nodes which are returned by the resource tree, all kinds if empty
+	Kinds []string `protobuf:"bytes,3,rep,name=kinds" json:"kinds,omitempty"`
+	// health statuses, e.g. 'Degraded', of the nodes which are returned by the resource tree, all nodes if empty
+	HealthStatuses []string `protobuf:"bytes,4,rep,name=healthStatuses" json:"healthStatuses,omitempty"`
+	// namespaces of the nodes which are returned by the resource tree, all namespaces if empty
+	Namespaces []string `protobuf:"bytes,5,rep,name=namespaces" json:"namespaces,omitempty"`
+	// orphaned returns only the orphaned nodes of the resource tree if true, or only the nodes of the application if
+	// false. Both are returned if it is not set.
+	Orphaned             *bool    `protobuf:"varint,6,opt,name=orphaned" json:"orphaned,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResourcesQuery) Reset()         { *m = ResourcesQuery{} }
+func (m *ResourcesQuery) String() string { return proto.CompactTextString(m) }
+func (*ResourcesQuery) ProtoMessage()    {}
+func (*ResourcesQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_55137f215f842c93, []int{49}
+}
+func (m *ResourcesQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourcesQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResourcesQuery.Marshal(b, m, deterministic)
 	} else {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.ApplicationName)))
-		i += copy(dAtA[i:], *m.ApplicationName)
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+}
+func (dst *ResourcesQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourcesQuery.Merge(dst, src)
+}
+func (m *ResourcesQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourcesQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourcesQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourcesQuery proto.InternalMessageInfo
+
+func (m *ResourcesQuery) GetApplicationName() string {
+	if m != nil && m.ApplicationName != nil {
+		return *m.ApplicationName
 	}
-	return i, nil
+	return ""
 }
 
-func (m *ManagedResourcesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ResourcesQuery) GetRelistKinds() []string {
+	if m != nil {
+		return m.RelistKinds
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ManagedResourcesResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Items) > 0 {
-		for _, msg := range m.Items {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintApplication(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+func (m *ResourcesQuery) GetKinds() []string {
+	if m != nil {
+		return m.Kinds
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return nil
+}
+
+func (m *ResourcesQuery) GetHealthStatuses() []string {
+	if m != nil {
+		return m.HealthStatuses
 	}
-	return i, nil
+	return nil
 }
 
-func encodeVarintApplication(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ResourcesQuery) GetNamespaces() []string {
+	if m != nil {
+		return m.Namespaces
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return nil
 }
-func (m *ApplicationQuery) Size() (n int) {
-	var l int
-	_ = l
-	if m.Name != nil {
-		l = len(*m.Name)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.Refresh != nil {
-		l = len(*m.Refresh)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if len(m.Projects) > 0 {
-		for _, s := range m.Projects {
-			l = len(s)
-			n += 1 + l + sovApplication(uint64(l))
-		}
-	}
-	l = len(m.ResourceVersion)
-	n += 1 + l + sovApplication(uint64(l))
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+
+func (m *ResourcesQuery) GetOrphaned() bool {
+	if m != nil && m.Orphaned != nil {
+		return *m.Orphaned
 	}
-	return n
+	return false
 }
 
-func (m *RevisionMetadataQuery) Size() (n int) {
-	var l int
-	_ = l
-	if m.Name != nil {
-		l = len(*m.Name)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.Revision != nil {
-		l = len(*m.Revision)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+type ManagedResourcesResponse struct {
+	Items                []*v1alpha1.ResourceDiff `protobuf:"bytes,1,rep,name=items" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
-func (m *ApplicationResourceEventsQuery) Size() (n int) {
-	var l int
-	_ = l
-	if m.Name != nil {
-		l = len(*m.Name)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	l = len(m.ResourceNamespace)
-	n += 1 + l + sovApplication(uint64(l))
-	l = len(m.ResourceName)
-	n += 1 + l + sovApplication(uint64(l))
-	l = len(m.ResourceUID)
-	n += 1 + l + sovApplication(uint64(l))
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *ManagedResourcesResponse) Reset()         { *m = ManagedResourcesResponse{} }
+func (m *ManagedResourcesResponse) String() string { return proto.CompactTextString(m) }
+func (*ManagedResourcesResponse) ProtoMessage()    {}
+func (*ManagedResourcesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_55137f215f842c93, []int{50}
+}
+func (m *ManagedResourcesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ManagedResourcesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ManagedResourcesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (dst *ManagedResourcesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManagedResourcesResponse.Merge(dst, src)
+}
+func (m *ManagedResourcesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ManagedResourcesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManagedResourcesResponse.DiscardUnknown(m)
 }
 
-func (m *ApplicationManifestQuery) Size() (n int) {
-	var l int
-	_ = l
-	if m.Name != nil {
-		l = len(*m.Name)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	l = len(m.Revision)
-	n += 1 + l + sovApplication(uint64(l))
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+var xxx_messageInfo_ManagedResourcesResponse proto.InternalMessageInfo
+
+func (m *ManagedResourcesResponse) GetItems() []*v1alpha1.ResourceDiff {
+	if m != nil {
+		return m.Items
 	}
-	return n
+	return nil
 }
 
-func (m *ApplicationResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+// ApplicationSyncReportsQuery is a query for the reports of the last sync operations of an application
+type ApplicationSyncReportsQuery struct {
+	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ApplicationCreateRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = m.Application.Size()
-	n += 1 + l + sovApplication(uint64(l))
-	if m.Upsert != nil {
-		n += 2
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *ApplicationSyncReportsQuery) Reset()         { *m = ApplicationSyncReportsQuery{} }
+func (m *ApplicationSyncReportsQuery) String() string { return proto.CompactTextString(m) }
+func (*ApplicationSyncReportsQuery) ProtoMessage()    {}
+func (*ApplicationSyncReportsQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_55137f215f842c93, []int{51}
+}
+func (m *ApplicationSyncReportsQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSyncReportsQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationSyncReportsQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (dst *ApplicationSyncReportsQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSyncReportsQuery.Merge(dst, src)
+}
+func (m *ApplicationSyncReportsQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSyncReportsQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSyncReportsQuery.DiscardUnknown(m)
 }
 
-func (m *ApplicationUpdateRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Application != nil {
-		l = m.Application.Size()
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+var xxx_messageInfo_ApplicationSyncReportsQuery proto.InternalMessageInfo
+
+func (m *ApplicationSyncReportsQuery) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
 	}
-	return n
+	return ""
 }
 
-func (m *ApplicationDeleteRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Name != nil {
-		l = len(*m.Name)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.Cascade != nil {
-		n += 2
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+// ApplicationSyncReportsResponse holds the reports of the last sync operations of an application, newest first
+type ApplicationSyncReportsResponse struct {
+	Items                []*v1alpha1.SyncReport `protobuf:"bytes,1,rep,name=items" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
-func (m *ApplicationSyncRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Name != nil {
-		l = len(*m.Name)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	l = len(m.Revision)
-	n += 1 + l + sovApplication(uint64(l))
-	n += 2
-	n += 2
-	if m.Strategy != nil {
-		l = m.Strategy.Size()
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if len(m.Resources) > 0 {
-		for _, e := range m.Resources {
-			l = e.Size()
-			n += 1 + l + sovApplication(uint64(l))
-		}
-	}
-	if len(m.Manifests) > 0 {
-		for _, s := range m.Manifests {
-			l = len(s)
-			n += 1 + l + sovApplication(uint64(l))
+func (m *ApplicationSyncReportsResponse) Reset()         { *m = ApplicationSyncReportsResponse{} }
+func (m *ApplicationSyncReportsResponse) String() string { return proto.CompactTextString(m) }
+func (*ApplicationSyncReportsResponse) ProtoMessage()    {}
+func (*ApplicationSyncReportsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_55137f215f842c93, []int{52}
+}
+func (m *ApplicationSyncReportsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSyncReportsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationSyncReportsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
 }
-
-func (m *ApplicationUpdateSpecRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Name != nil {
-		l = len(*m.Name)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	l = m.Spec.Size()
-	n += 1 + l + sovApplication(uint64(l))
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (dst *ApplicationSyncReportsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSyncReportsResponse.Merge(dst, src)
+}
+func (m *ApplicationSyncReportsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSyncReportsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSyncReportsResponse.DiscardUnknown(m)
 }
 
-func (m *ApplicationPatchRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Name != nil {
-		l = len(*m.Name)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	l = len(m.Patch)
-	n += 1 + l + sovApplication(uint64(l))
-	l = len(m.PatchType)
-	n += 1 + l + sovApplication(uint64(l))
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+var xxx_messageInfo_ApplicationSyncReportsResponse proto.InternalMessageInfo
+
+func (m *ApplicationSyncReportsResponse) GetItems() []*v1alpha1.SyncReport {
+	if m != nil {
+		return m.Items
 	}
-	return n
+	return nil
 }
 
-func (m *ApplicationRollbackRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Name != nil {
-		l = len(*m.Name)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	n += 1 + sovApplication(uint64(m.ID))
-	n += 2
-	n += 2
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func init() {
+	proto.RegisterType((*ApplicationQuery)(nil), "application.ApplicationQuery")
+	proto.RegisterType((*RevisionMetadataQuery)(nil), "application.RevisionMetadataQuery")
+	proto.RegisterType((*ApplicationRevisionChangelogQuery)(nil), "application.ApplicationRevisionChangelogQuery")
+	proto.RegisterType((*ApplicationResourceEventsQuery)(nil), "application.ApplicationResourceEventsQuery")
+	proto.RegisterType((*ApplicationEventStreamQuery)(nil), "application.ApplicationEventStreamQuery")
+	proto.RegisterType((*ApplicationEvent)(nil), "application.ApplicationEvent")
+	proto.RegisterType((*ApplicationManifestQuery)(nil), "application.ApplicationManifestQuery")
+	proto.RegisterType((*ApplicationManagedManifestsQuery)(nil), "application.ApplicationManagedManifestsQuery")
+	proto.RegisterType((*ApplicationManagedManifestsResponse)(nil), "application.ApplicationManagedManifestsResponse")
+	proto.RegisterType((*ApplicationPreviewRequest)(nil), "application.ApplicationPreviewRequest")
+	proto.RegisterType((*ResourcePreviewResult)(nil), "application.ResourcePreviewResult")
+	proto.RegisterType((*ApplicationPreviewResponse)(nil), "application.ApplicationPreviewResponse")
+	proto.RegisterType((*ResourceConflictsQuery)(nil), "application.ResourceConflictsQuery")
+	proto.RegisterType((*ResourceConflict)(nil), "application.ResourceConflict")
+	proto.RegisterType((*ResourceConflictList)(nil), "application.ResourceConflictList")
+	proto.RegisterType((*ApplicationDriftReportQuery)(nil), "application.ApplicationDriftReportQuery")
+	proto.RegisterType((*DriftedResource)(nil), "application.DriftedResource")
+	proto.RegisterType((*ApplicationDriftReport)(nil), "application.ApplicationDriftReport")
+	proto.RegisterType((*ApplicationStatusMatrixQuery)(nil), "application.ApplicationStatusMatrixQuery")
+	proto.RegisterType((*ApplicationStatusMatrixCluster)(nil), "application.ApplicationStatusMatrixCluster")
+	proto.RegisterType((*ApplicationStatusMatrixCell)(nil), "application.ApplicationStatusMatrixCell")
+	proto.RegisterType((*ApplicationStatusMatrixRow)(nil), "application.ApplicationStatusMatrixRow")
+	proto.RegisterType((*ApplicationStatusMatrix)(nil), "application.ApplicationStatusMatrix")
+	proto.RegisterType((*ApplicationBulkRequest)(nil), "application.ApplicationBulkRequest")
+	proto.RegisterType((*ApplicationBulkSyncRequest)(nil), "application.ApplicationBulkSyncRequest")
+	proto.RegisterType((*ApplicationBulkResult)(nil), "application.ApplicationBulkResult")
+	proto.RegisterType((*ApplicationBulkResponse)(nil), "application.ApplicationBulkResponse")
+	proto.RegisterType((*ApplicationResponse)(nil), "application.ApplicationResponse")
+	proto.RegisterType((*ApplicationCreateRequest)(nil), "application.ApplicationCreateRequest")
+	proto.RegisterType((*ApplicationUpdateRequest)(nil), "application.ApplicationUpdateRequest")
+	proto.RegisterType((*ApplicationDeleteRequest)(nil), "application.ApplicationDeleteRequest")
+	proto.RegisterType((*ApplicationSyncRequest)(nil), "application.ApplicationSyncRequest")
+	proto.RegisterType((*ApplicationUpdateSpecRequest)(nil), "application.ApplicationUpdateSpecRequest")
+	proto.RegisterType((*ApplicationValidateSpecRequest)(nil), "application.ApplicationValidateSpecRequest")
+	proto.RegisterType((*ProjectRuleViolation)(nil), "application.ProjectRuleViolation")
+	proto.RegisterType((*ApplicationValidateSpecResponse)(nil), "application.ApplicationValidateSpecResponse")
+	proto.RegisterType((*ApplicationPatchRequest)(nil), "application.ApplicationPatchRequest")
+	proto.RegisterType((*ApplicationRollbackRequest)(nil), "application.ApplicationRollbackRequest")
+	proto.RegisterType((*ApplicationUpdateImagesRequest)(nil), "application.ApplicationUpdateImagesRequest")
+	proto.RegisterType((*ApplicationResourceRequest)(nil), "application.ApplicationResourceRequest")
+	proto.RegisterType((*ApplicationResourcePatchRequest)(nil), "application.ApplicationResourcePatchRequest")
+	proto.RegisterType((*ApplicationResourceDeleteRequest)(nil), "application.ApplicationResourceDeleteRequest")
+	proto.RegisterType((*ResourceActionRunRequest)(nil), "application.ResourceActionRunRequest")
+	proto.RegisterType((*ResourceActionsListResponse)(nil), "application.ResourceActionsListResponse")
+	proto.RegisterType((*ApplicationResourceResponse)(nil), "application.ApplicationResourceResponse")
+	proto.RegisterType((*ApplicationPodLogsQuery)(nil), "application.ApplicationPodLogsQuery")
+	proto.RegisterType((*LogEntry)(nil), "application.LogEntry")
+	proto.RegisterType((*OperationTerminateRequest)(nil), "application.OperationTerminateRequest")
+	proto.RegisterType((*OperationTerminateResponse)(nil), "application.OperationTerminateResponse")
+	proto.RegisterType((*ResourcesQuery)(nil), "application.ResourcesQuery")
+	proto.RegisterType((*ManagedResourcesResponse)(nil), "application.ManagedResourcesResponse")
+	proto.RegisterType((*ApplicationSyncReportsQuery)(nil), "application.ApplicationSyncReportsQuery")
+	proto.RegisterType((*ApplicationSyncReportsResponse)(nil), "application.ApplicationSyncReportsResponse")
 }
 
-func (m *ApplicationResourceRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Name != nil {
-		l = len(*m.Name)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	l = len(m.Namespace)
-	n += 1 + l + sovApplication(uint64(l))
-	l = len(m.ResourceName)
-	n += 1 + l + sovApplication(uint64(l))
-	l = len(m.Version)
-	n += 1 + l + sovApplication(uint64(l))
-	l = len(m.Group)
-	n += 1 + l + sovApplication(uint64(l))
-	l = len(m.Kind)
-	n += 1 + l + sovApplication(uint64(l))
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for ApplicationService service
+
+type ApplicationServiceClient interface {
+	// List returns list of applications
+	List(ctx context.Context, in *ApplicationQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationList, error)
+	// ListResourceEvents returns a list of event resources
+	ListResourceEvents(ctx context.Context, in *ApplicationResourceEventsQuery, opts ...grpc.CallOption) (*v11.EventList, error)
+	// ListResourceConflicts returns the pairs of applications which manage the same resources
+	ListResourceConflicts(ctx context.Context, in *ResourceConflictsQuery, opts ...grpc.CallOption) (*ResourceConflictList, error)
+	// DriftReport returns the resources of the applications of a project which drifted from their target state
+	DriftReport(ctx context.Context, in *ApplicationDriftReportQuery, opts ...grpc.CallOption) (*ApplicationDriftReport, error)
+	// StatusMatrix aggregates the statuses of the selected applications by group and destination cluster
+	StatusMatrix(ctx context.Context, in *ApplicationStatusMatrixQuery, opts ...grpc.CallOption) (*ApplicationStatusMatrix, error)
+	// Watch returns stream of application change events.
+	Watch(ctx context.Context, in *ApplicationQuery, opts ...grpc.CallOption) (ApplicationService_WatchClient, error)
+	// WatchEvents returns a stream of the significant events of applications: syncs started and completed, health
+	// transitions and drift from the synced state
+	WatchEvents(ctx context.Context, in *ApplicationEventStreamQuery, opts ...grpc.CallOption) (ApplicationService_WatchEventsClient, error)
+	// Create creates an application
+	Create(ctx context.Context, in *ApplicationCreateRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error)
+	// Get returns an application by name
+	Get(ctx context.Context, in *ApplicationQuery, opts ...grpc.CallOption) (*v1alpha1.Application, error)
+	// Get the meta-data (author, date, tags, message) for a specific revision of the application
+	RevisionMetadata(ctx context.Context, in *RevisionMetadataQuery, opts ...grpc.CallOption) (*v1alpha1.RevisionMetadata, error)
+	// Get the untruncated meta-data and the changed files for a specific revision of the application
+	RevisionDetails(ctx context.Context, in *RevisionMetadataQuery, opts ...grpc.CallOption) (*v1alpha1.RevisionMetadata, error)
+	// RevisionChangelog returns the commits (author, date, message) between the deployed revision and the target revision of an application
+	RevisionChangelog(ctx context.Context, in *ApplicationRevisionChangelogQuery, opts ...grpc.CallOption) (*v1alpha1.RevisionChangelog, error)
+	// ManagedManifests returns the target or live manifests of the resources managed by an application as a YAML stream
+	ManagedManifests(ctx context.Context, in *ApplicationManagedManifestsQuery, opts ...grpc.CallOption) (*ApplicationManagedManifestsResponse, error)
+	// GetManifests returns application manifests
+	GetManifests(ctx context.Context, in *ApplicationManifestQuery, opts ...grpc.CallOption) (*apiclient.ManifestResponse, error)
+	// Preview performs a server-side dry-run apply of the application target manifests and returns per-resource admission results
+	Preview(ctx context.Context, in *ApplicationPreviewRequest, opts ...grpc.CallOption) (*ApplicationPreviewResponse, error)
+	// Update updates an application
+	Update(ctx context.Context, in *ApplicationUpdateRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error)
+	// UpdateSpec updates an application spec
+	UpdateSpec(ctx context.Context, in *ApplicationUpdateSpecRequest, opts ...grpc.CallOption) (*v1alpha1.ApplicationSpec, error)
+	// ValidateSpec checks an application spec against the rules of its project without changing the application
+	ValidateSpec(ctx context.Context, in *ApplicationValidateSpecRequest, opts ...grpc.CallOption) (*ApplicationValidateSpecResponse, error)
+	// Patch patch an application
+	Patch(ctx context.Context, in *ApplicationPatchRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error)
+	// Delete deletes an application
+	Delete(ctx context.Context, in *ApplicationDeleteRequest, opts ...grpc.CallOption) (*ApplicationResponse, error)
+	// Sync syncs an application to its target state
+	Sync(ctx context.Context, in *ApplicationSyncRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error)
+	ManagedResources(ctx context.Context, in *ResourcesQuery, opts ...grpc.CallOption) (*ManagedResourcesResponse, error)
+	// SyncReports returns the reports of the last sync operations of an application
+	SyncReports(ctx context.Context, in *ApplicationSyncReportsQuery, opts ...grpc.CallOption) (*ApplicationSyncReportsResponse, error)
+	ResourceTree(ctx context.Context, in *ResourcesQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationTree, error)
+	// Rollback syncs an application to its target state
+	Rollback(ctx context.Context, in *ApplicationRollbackRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error)
+	// UpdateImages overrides the Helm parameters or Kustomize images of an application and syncs it
+	UpdateImages(ctx context.Context, in *ApplicationUpdateImagesRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error)
+	// TerminateOperation terminates the currently running operation
+	TerminateOperation(ctx context.Context, in *OperationTerminateRequest, opts ...grpc.CallOption) (*OperationTerminateResponse, error)
+	// BulkSync syncs the applications of a list or selector
+	BulkSync(ctx context.Context, in *ApplicationBulkSyncRequest, opts ...grpc.CallOption) (*ApplicationBulkResponse, error)
+	// BulkRefresh requests the refresh of the applications of a list or selector, without waiting for it
+	BulkRefresh(ctx context.Context, in *ApplicationBulkRequest, opts ...grpc.CallOption) (*ApplicationBulkResponse, error)
+	// BulkTerminateOperation terminates the running operations of the applications of a list or selector
+	BulkTerminateOperation(ctx context.Context, in *ApplicationBulkRequest, opts ...grpc.CallOption) (*ApplicationBulkResponse, error)
+	// BulkDelete deletes the applications of a list or selector
+	BulkDelete(ctx context.Context, in *ApplicationBulkRequest, opts ...grpc.CallOption) (*ApplicationBulkResponse, error)
+	// GetResource returns single application resource
+	GetResource(ctx context.Context, in *ApplicationResourceRequest, opts ...grpc.CallOption) (*ApplicationResourceResponse, error)
+	// PatchResource patch single application resource
+	PatchResource(ctx context.Context, in *ApplicationResourcePatchRequest, opts ...grpc.CallOption) (*ApplicationResourceResponse, error)
+	ListResourceActions(ctx context.Context, in *ApplicationResourceRequest, opts ...grpc.CallOption) (*ResourceActionsListResponse, error)
+	RunResourceAction(ctx context.Context, in *ResourceActionRunRequest, opts ...grpc.CallOption) (*ApplicationResponse, error)
+	// DeleteResource deletes a single application resource
+	DeleteResource(ctx context.Context, in *ApplicationResourceDeleteRequest, opts ...grpc.CallOption) (*ApplicationResponse, error)
+	// PodLogs returns stream of log entries for the specified pod. Pod
+	PodLogs(ctx context.Context, in *ApplicationPodLogsQuery, opts ...grpc.CallOption) (ApplicationService_PodLogsClient, error)
 }
 
-func (m *ApplicationResourcePatchRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Name != nil {
-		l = len(*m.Name)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	l = len(m.Namespace)
-	n += 1 + l + sovApplication(uint64(l))
-	l = len(m.ResourceName)
-	n += 1 + l + sovApplication(uint64(l))
-	l = len(m.Version)
-	n += 1 + l + sovApplication(uint64(l))
-	l = len(m.Group)
-	n += 1 + l + sovApplication(uint64(l))
-	l = len(m.Kind)
-	n += 1 + l + sovApplication(uint64(l))
-	l = len(m.Patch)
-	n += 1 + l + sovApplication(uint64(l))
-	l = len(m.PatchType)
-	n += 1 + l + sovApplication(uint64(l))
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+type applicationServiceClient struct {
+	cc *grpc.ClientConn
 }
 
-func (m *ApplicationResourceDeleteRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Name != nil {
-		l = len(*m.Name)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	l = len(m.Namespace)
-	n += 1 + l + sovApplication(uint64(l))
-	l = len(m.ResourceName)
-	n += 1 + l + sovApplication(uint64(l))
-	l = len(m.Version)
-	n += 1 + l + sovApplication(uint64(l))
-	l = len(m.Group)
-	n += 1 + l + sovApplication(uint64(l))
-	l = len(m.Kind)
-	n += 1 + l + sovApplication(uint64(l))
-	if m.Force != nil {
-		n += 2
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func NewApplicationServiceClient(cc *grpc.ClientConn) ApplicationServiceClient {
+	return &applicationServiceClient{cc}
 }
 
-func (m *ResourceActionRunRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Name != nil {
-		l = len(*m.Name)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	l = len(m.Namespace)
-	n += 1 + l + sovApplication(uint64(l))
-	l = len(m.ResourceName)
-	n += 1 + l + sovApplication(uint64(l))
-	l = len(m.Version)
-	n += 1 + l + sovApplication(uint64(l))
-	l = len(m.Group)
-	n += 1 + l + sovApplication(uint64(l))
-	l = len(m.Kind)
-	n += 1 + l + sovApplication(uint64(l))
-	l = len(m.Action)
-	n += 1 + l + sovApplication(uint64(l))
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (c *applicationServiceClient) List(ctx context.Context, in *ApplicationQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationList, error) {
+	out := new(v1alpha1.ApplicationList)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *ResourceActionsListResponse) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.Actions) > 0 {
-		for _, e := range m.Actions {
-			l = e.Size()
-			n += 1 + l + sovApplication(uint64(l))
-		}
+func (c *applicationServiceClient) ListResourceEvents(ctx context.Context, in *ApplicationResourceEventsQuery, opts ...grpc.CallOption) (*v11.EventList, error) {
+	out := new(v11.EventList)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/ListResourceEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return out, nil
+}
+
+func (c *applicationServiceClient) ListResourceConflicts(ctx context.Context, in *ResourceConflictsQuery, opts ...grpc.CallOption) (*ResourceConflictList, error) {
+	out := new(ResourceConflictList)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/ListResourceConflicts", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *ApplicationResourceResponse) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Manifest)
-	n += 1 + l + sovApplication(uint64(l))
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (c *applicationServiceClient) DriftReport(ctx context.Context, in *ApplicationDriftReportQuery, opts ...grpc.CallOption) (*ApplicationDriftReport, error) {
+	out := new(ApplicationDriftReport)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/DriftReport", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *ApplicationPodLogsQuery) Size() (n int) {
-	var l int
-	_ = l
-	if m.Name != nil {
-		l = len(*m.Name)
-		n += 1 + l + sovApplication(uint64(l))
+func (c *applicationServiceClient) StatusMatrix(ctx context.Context, in *ApplicationStatusMatrixQuery, opts ...grpc.CallOption) (*ApplicationStatusMatrix, error) {
+	out := new(ApplicationStatusMatrix)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/StatusMatrix", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Namespace)
-	n += 1 + l + sovApplication(uint64(l))
-	if m.PodName != nil {
-		l = len(*m.PodName)
-		n += 1 + l + sovApplication(uint64(l))
+	return out, nil
+}
+
+func (c *applicationServiceClient) Watch(ctx context.Context, in *ApplicationQuery, opts ...grpc.CallOption) (ApplicationService_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ApplicationService_serviceDesc.Streams[0], "/application.ApplicationService/Watch", opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Container)
-	n += 1 + l + sovApplication(uint64(l))
-	n += 1 + sovApplication(uint64(m.SinceSeconds))
-	if m.SinceTime != nil {
-		l = m.SinceTime.Size()
-		n += 1 + l + sovApplication(uint64(l))
+	x := &applicationServiceWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	n += 1 + sovApplication(uint64(m.TailLines))
-	n += 2
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return n
+	return x, nil
 }
 
-func (m *LogEntry) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Content)
-	n += 1 + l + sovApplication(uint64(l))
-	l = m.TimeStamp.Size()
-	n += 1 + l + sovApplication(uint64(l))
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+type ApplicationService_WatchClient interface {
+	Recv() (*v1alpha1.ApplicationWatchEvent, error)
+	grpc.ClientStream
+}
+
+type applicationServiceWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *applicationServiceWatchClient) Recv() (*v1alpha1.ApplicationWatchEvent, error) {
+	m := new(v1alpha1.ApplicationWatchEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return n
+	return m, nil
 }
 
-func (m *OperationTerminateRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Name != nil {
-		l = len(*m.Name)
-		n += 1 + l + sovApplication(uint64(l))
+func (c *applicationServiceClient) WatchEvents(ctx context.Context, in *ApplicationEventStreamQuery, opts ...grpc.CallOption) (ApplicationService_WatchEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ApplicationService_serviceDesc.Streams[1], "/application.ApplicationService/WatchEvents", opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	x := &applicationServiceWatchEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	return n
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (m *OperationTerminateResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+type ApplicationService_WatchEventsClient interface {
+	Recv() (*ApplicationEvent, error)
+	grpc.ClientStream
+}
+
+type applicationServiceWatchEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *applicationServiceWatchEventsClient) Recv() (*ApplicationEvent, error) {
+	m := new(ApplicationEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return n
+	return m, nil
 }
 
-func (m *ResourcesQuery) Size() (n int) {
-	var l int
-	_ = l
-	if m.ApplicationName != nil {
-		l = len(*m.ApplicationName)
-		n += 1 + l + sovApplication(uint64(l))
+func (c *applicationServiceClient) Create(ctx context.Context, in *ApplicationCreateRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error) {
+	out := new(v1alpha1.Application)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return out, nil
+}
+
+func (c *applicationServiceClient) Get(ctx context.Context, in *ApplicationQuery, opts ...grpc.CallOption) (*v1alpha1.Application, error) {
+	out := new(v1alpha1.Application)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *ManagedResourcesResponse) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovApplication(uint64(l))
+func (c *applicationServiceClient) RevisionMetadata(ctx context.Context, in *RevisionMetadataQuery, opts ...grpc.CallOption) (*v1alpha1.RevisionMetadata, error) {
+	out := new(v1alpha1.RevisionMetadata)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/RevisionMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) RevisionDetails(ctx context.Context, in *RevisionMetadataQuery, opts ...grpc.CallOption) (*v1alpha1.RevisionMetadata, error) {
+	out := new(v1alpha1.RevisionMetadata)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/RevisionDetails", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) RevisionChangelog(ctx context.Context, in *ApplicationRevisionChangelogQuery, opts ...grpc.CallOption) (*v1alpha1.RevisionChangelog, error) {
+	out := new(v1alpha1.RevisionChangelog)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/RevisionChangelog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) ManagedManifests(ctx context.Context, in *ApplicationManagedManifestsQuery, opts ...grpc.CallOption) (*ApplicationManagedManifestsResponse, error) {
+	out := new(ApplicationManagedManifestsResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/ManagedManifests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) GetManifests(ctx context.Context, in *ApplicationManifestQuery, opts ...grpc.CallOption) (*apiclient.ManifestResponse, error) {
+	out := new(apiclient.ManifestResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/GetManifests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) Preview(ctx context.Context, in *ApplicationPreviewRequest, opts ...grpc.CallOption) (*ApplicationPreviewResponse, error) {
+	out := new(ApplicationPreviewResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/Preview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) Update(ctx context.Context, in *ApplicationUpdateRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error) {
+	out := new(v1alpha1.Application)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) UpdateSpec(ctx context.Context, in *ApplicationUpdateSpecRequest, opts ...grpc.CallOption) (*v1alpha1.ApplicationSpec, error) {
+	out := new(v1alpha1.ApplicationSpec)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/UpdateSpec", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) ValidateSpec(ctx context.Context, in *ApplicationValidateSpecRequest, opts ...grpc.CallOption) (*ApplicationValidateSpecResponse, error) {
+	out := new(ApplicationValidateSpecResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/ValidateSpec", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) Patch(ctx context.Context, in *ApplicationPatchRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error) {
+	out := new(v1alpha1.Application)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/Patch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) Delete(ctx context.Context, in *ApplicationDeleteRequest, opts ...grpc.CallOption) (*ApplicationResponse, error) {
+	out := new(ApplicationResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) Sync(ctx context.Context, in *ApplicationSyncRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error) {
+	out := new(v1alpha1.Application)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/Sync", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) ManagedResources(ctx context.Context, in *ResourcesQuery, opts ...grpc.CallOption) (*ManagedResourcesResponse, error) {
+	out := new(ManagedResourcesResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/ManagedResources", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) SyncReports(ctx context.Context, in *ApplicationSyncReportsQuery, opts ...grpc.CallOption) (*ApplicationSyncReportsResponse, error) {
+	out := new(ApplicationSyncReportsResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/SyncReports", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) ResourceTree(ctx context.Context, in *ResourcesQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationTree, error) {
+	out := new(v1alpha1.ApplicationTree)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/ResourceTree", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) Rollback(ctx context.Context, in *ApplicationRollbackRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error) {
+	out := new(v1alpha1.Application)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/Rollback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) UpdateImages(ctx context.Context, in *ApplicationUpdateImagesRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error) {
+	out := new(v1alpha1.Application)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/UpdateImages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) TerminateOperation(ctx context.Context, in *OperationTerminateRequest, opts ...grpc.CallOption) (*OperationTerminateResponse, error) {
+	out := new(OperationTerminateResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/TerminateOperation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) BulkSync(ctx context.Context, in *ApplicationBulkSyncRequest, opts ...grpc.CallOption) (*ApplicationBulkResponse, error) {
+	out := new(ApplicationBulkResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/BulkSync", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) BulkRefresh(ctx context.Context, in *ApplicationBulkRequest, opts ...grpc.CallOption) (*ApplicationBulkResponse, error) {
+	out := new(ApplicationBulkResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/BulkRefresh", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) BulkTerminateOperation(ctx context.Context, in *ApplicationBulkRequest, opts ...grpc.CallOption) (*ApplicationBulkResponse, error) {
+	out := new(ApplicationBulkResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/BulkTerminateOperation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) BulkDelete(ctx context.Context, in *ApplicationBulkRequest, opts ...grpc.CallOption) (*ApplicationBulkResponse, error) {
+	out := new(ApplicationBulkResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/BulkDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) GetResource(ctx context.Context, in *ApplicationResourceRequest, opts ...grpc.CallOption) (*ApplicationResourceResponse, error) {
+	out := new(ApplicationResourceResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/GetResource", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) PatchResource(ctx context.Context, in *ApplicationResourcePatchRequest, opts ...grpc.CallOption) (*ApplicationResourceResponse, error) {
+	out := new(ApplicationResourceResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/PatchResource", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) ListResourceActions(ctx context.Context, in *ApplicationResourceRequest, opts ...grpc.CallOption) (*ResourceActionsListResponse, error) {
+	out := new(ResourceActionsListResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/ListResourceActions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) RunResourceAction(ctx context.Context, in *ResourceActionRunRequest, opts ...grpc.CallOption) (*ApplicationResponse, error) {
+	out := new(ApplicationResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/RunResourceAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) DeleteResource(ctx context.Context, in *ApplicationResourceDeleteRequest, opts ...grpc.CallOption) (*ApplicationResponse, error) {
+	out := new(ApplicationResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/DeleteResource", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) PodLogs(ctx context.Context, in *ApplicationPodLogsQuery, opts ...grpc.CallOption) (ApplicationService_PodLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ApplicationService_serviceDesc.Streams[2], "/application.ApplicationService/PodLogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &applicationServicePodLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ApplicationService_PodLogsClient interface {
+	Recv() (*LogEntry, error)
+	grpc.ClientStream
+}
+
+type applicationServicePodLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *applicationServicePodLogsClient) Recv() (*LogEntry, error) {
+	m := new(LogEntry)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Server API for ApplicationService service
+
+type ApplicationServiceServer interface {
+	// List returns list of applications
+	List(context.Context, *ApplicationQuery) (*v1alpha1.ApplicationList, error)
+	// ListResourceEvents returns a list of event resources
+	ListResourceEvents(context.Context, *ApplicationResourceEventsQuery) (*v11.EventList, error)
+	// ListResourceConflicts returns the pairs of applications which manage the same resources
+	ListResourceConflicts(context.Context, *ResourceConflictsQuery) (*ResourceConflictList, error)
+	// DriftReport returns the resources of the applications of a project which drifted from their target state
+	DriftReport(context.Context, *ApplicationDriftReportQuery) (*ApplicationDriftReport, error)
+	// StatusMatrix aggregates the statuses of the selected applications by group and destination cluster
+	StatusMatrix(context.Context, *ApplicationStatusMatrixQuery) (*ApplicationStatusMatrix, error)
+	// Watch returns stream of application change events.
+	Watch(*ApplicationQuery, ApplicationService_WatchServer) error
+	// WatchEvents returns a stream of the significant events of applications: syncs started and completed, health
+	// transitions and drift from the synced state
+	WatchEvents(*ApplicationEventStreamQuery, ApplicationService_WatchEventsServer) error
+	// Create creates an application
+	Create(context.Context, *ApplicationCreateRequest) (*v1alpha1.Application, error)
+	// Get returns an application by name
+	Get(context.Context, *ApplicationQuery) (*v1alpha1.Application, error)
+	// Get the meta-data (author, date, tags, message) for a specific revision of the application
+	RevisionMetadata(context.Context, *RevisionMetadataQuery) (*v1alpha1.RevisionMetadata, error)
+	// Get the untruncated meta-data and the changed files for a specific revision of the application
+	RevisionDetails(context.Context, *RevisionMetadataQuery) (*v1alpha1.RevisionMetadata, error)
+	// RevisionChangelog returns the commits (author, date, message) between the deployed revision and the target revision of an application
+	RevisionChangelog(context.Context, *ApplicationRevisionChangelogQuery) (*v1alpha1.RevisionChangelog, error)
+	// ManagedManifests returns the target or live manifests of the resources managed by an application as a YAML stream
+	ManagedManifests(context.Context, *ApplicationManagedManifestsQuery) (*ApplicationManagedManifestsResponse, error)
+	// GetManifests returns application manifests
+	GetManifests(context.Context, *ApplicationManifestQuery) (*apiclient.ManifestResponse, error)
+	// Preview performs a server-side dry-run apply of the application target manifests and returns per-resource admission results
+	Preview(context.Context, *ApplicationPreviewRequest) (*ApplicationPreviewResponse, error)
+	// Update updates an application
+	Update(context.Context, *ApplicationUpdateRequest) (*v1alpha1.Application, error)
+	// UpdateSpec updates an application spec
+	UpdateSpec(context.Context, *ApplicationUpdateSpecRequest) (*v1alpha1.ApplicationSpec, error)
+	// ValidateSpec checks an application spec against the rules of its project without changing the application
+	ValidateSpec(context.Context, *ApplicationValidateSpecRequest) (*ApplicationValidateSpecResponse, error)
+	// Patch patch an application
+	Patch(context.Context, *ApplicationPatchRequest) (*v1alpha1.Application, error)
+	// Delete deletes an application
+	Delete(context.Context, *ApplicationDeleteRequest) (*ApplicationResponse, error)
+	// Sync syncs an application to its target state
+	Sync(context.Context, *ApplicationSyncRequest) (*v1alpha1.Application, error)
+	ManagedResources(context.Context, *ResourcesQuery) (*ManagedResourcesResponse, error)
+	// SyncReports returns the reports of the last sync operations of an application
+	SyncReports(context.Context, *ApplicationSyncReportsQuery) (*ApplicationSyncReportsResponse, error)
+	ResourceTree(context.Context, *ResourcesQuery) (*v1alpha1.ApplicationTree, error)
+	// Rollback syncs an application to its target state
+	Rollback(context.Context, *ApplicationRollbackRequest) (*v1alpha1.Application, error)
+	// UpdateImages overrides the Helm parameters or Kustomize images of an application and syncs it
+	UpdateImages(context.Context, *ApplicationUpdateImagesRequest) (*v1alpha1.Application, error)
+	// TerminateOperation terminates the currently running operation
+	TerminateOperation(context.Context, *OperationTerminateRequest) (*OperationTerminateResponse, error)
+	// BulkSync syncs the applications of a list or selector
+	BulkSync(context.Context, *ApplicationBulkSyncRequest) (*ApplicationBulkResponse, error)
+	// BulkRefresh requests the refresh of the applications of a list or selector, without waiting for it
+	BulkRefresh(context.Context, *ApplicationBulkRequest) (*ApplicationBulkResponse, error)
+	// BulkTerminateOperation terminates the running operations of the applications of a list or selector
+	BulkTerminateOperation(context.Context, *ApplicationBulkRequest) (*ApplicationBulkResponse, error)
+	// BulkDelete deletes the applications of a list or selector
+	BulkDelete(context.Context, *ApplicationBulkRequest) (*ApplicationBulkResponse, error)
+	// GetResource returns single application resource
+	GetResource(context.Context, *ApplicationResourceRequest) (*ApplicationResourceResponse, error)
+	// PatchResource patch single application resource
+	PatchResource(context.Context, *ApplicationResourcePatchRequest) (*ApplicationResourceResponse, error)
+	ListResourceActions(context.Context, *ApplicationResourceRequest) (*ResourceActionsListResponse, error)
+	RunResourceAction(context.Context, *ResourceActionRunRequest) (*ApplicationResponse, error)
+	// DeleteResource deletes a single application resource
+	DeleteResource(context.Context, *ApplicationResourceDeleteRequest) (*ApplicationResponse, error)
+	// PodLogs returns stream of log entries for the specified pod. Pod
+	PodLogs(*ApplicationPodLogsQuery, ApplicationService_PodLogsServer) error
+}
+
+func RegisterApplicationServiceServer(s *grpc.Server, srv ApplicationServiceServer) {
+	s.RegisterService(&_ApplicationService_serviceDesc, srv)
+}
+
+func _ApplicationService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).List(ctx, req.(*ApplicationQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_ListResourceEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationResourceEventsQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).ListResourceEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/ListResourceEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).ListResourceEvents(ctx, req.(*ApplicationResourceEventsQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_ListResourceConflicts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResourceConflictsQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).ListResourceConflicts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/ListResourceConflicts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).ListResourceConflicts(ctx, req.(*ResourceConflictsQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_DriftReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationDriftReportQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).DriftReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/DriftReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).DriftReport(ctx, req.(*ApplicationDriftReportQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_StatusMatrix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationStatusMatrixQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).StatusMatrix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/StatusMatrix",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).StatusMatrix(ctx, req.(*ApplicationStatusMatrixQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ApplicationQuery)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApplicationServiceServer).Watch(m, &applicationServiceWatchServer{stream})
+}
+
+type ApplicationService_WatchServer interface {
+	Send(*v1alpha1.ApplicationWatchEvent) error
+	grpc.ServerStream
+}
+
+type applicationServiceWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *applicationServiceWatchServer) Send(m *v1alpha1.ApplicationWatchEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ApplicationService_WatchEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ApplicationEventStreamQuery)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApplicationServiceServer).WatchEvents(m, &applicationServiceWatchEventsServer{stream})
+}
+
+type ApplicationService_WatchEventsServer interface {
+	Send(*ApplicationEvent) error
+	grpc.ServerStream
+}
+
+type applicationServiceWatchEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *applicationServiceWatchEventsServer) Send(m *ApplicationEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ApplicationService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).Create(ctx, req.(*ApplicationCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).Get(ctx, req.(*ApplicationQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_RevisionMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevisionMetadataQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).RevisionMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/RevisionMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).RevisionMetadata(ctx, req.(*RevisionMetadataQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_RevisionDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevisionMetadataQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).RevisionDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/RevisionDetails",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).RevisionDetails(ctx, req.(*RevisionMetadataQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_RevisionChangelog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationRevisionChangelogQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).RevisionChangelog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/RevisionChangelog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).RevisionChangelog(ctx, req.(*ApplicationRevisionChangelogQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_ManagedManifests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationManagedManifestsQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).ManagedManifests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/ManagedManifests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).ManagedManifests(ctx, req.(*ApplicationManagedManifestsQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_GetManifests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationManifestQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).GetManifests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/GetManifests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).GetManifests(ctx, req.(*ApplicationManifestQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_Preview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationPreviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).Preview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/Preview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).Preview(ctx, req.(*ApplicationPreviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).Update(ctx, req.(*ApplicationUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_UpdateSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationUpdateSpecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).UpdateSpec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/UpdateSpec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).UpdateSpec(ctx, req.(*ApplicationUpdateSpecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_ValidateSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationValidateSpecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).ValidateSpec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/ValidateSpec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).ValidateSpec(ctx, req.(*ApplicationValidateSpecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_Patch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationPatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).Patch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/Patch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).Patch(ctx, req.(*ApplicationPatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).Delete(ctx, req.(*ApplicationDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_Sync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationSyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).Sync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/Sync",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).Sync(ctx, req.(*ApplicationSyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_ManagedResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResourcesQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).ManagedResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/ManagedResources",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).ManagedResources(ctx, req.(*ResourcesQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_SyncReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationSyncReportsQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).SyncReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/SyncReports",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).SyncReports(ctx, req.(*ApplicationSyncReportsQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_ResourceTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResourcesQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).ResourceTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/ResourceTree",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).ResourceTree(ctx, req.(*ResourcesQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_Rollback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationRollbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).Rollback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/Rollback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).Rollback(ctx, req.(*ApplicationRollbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_UpdateImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationUpdateImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).UpdateImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/UpdateImages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).UpdateImages(ctx, req.(*ApplicationUpdateImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_TerminateOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperationTerminateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).TerminateOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/TerminateOperation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).TerminateOperation(ctx, req.(*OperationTerminateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_BulkSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationBulkSyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).BulkSync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/BulkSync",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).BulkSync(ctx, req.(*ApplicationBulkSyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_BulkRefresh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationBulkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).BulkRefresh(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/BulkRefresh",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).BulkRefresh(ctx, req.(*ApplicationBulkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_BulkTerminateOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationBulkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).BulkTerminateOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/BulkTerminateOperation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).BulkTerminateOperation(ctx, req.(*ApplicationBulkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_BulkDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationBulkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).BulkDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/BulkDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).BulkDelete(ctx, req.(*ApplicationBulkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_GetResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).GetResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/GetResource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).GetResource(ctx, req.(*ApplicationResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_PatchResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationResourcePatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).PatchResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/PatchResource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).PatchResource(ctx, req.(*ApplicationResourcePatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_ListResourceActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).ListResourceActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/ListResourceActions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).ListResourceActions(ctx, req.(*ApplicationResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_RunResourceAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResourceActionRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).RunResourceAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/RunResourceAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).RunResourceAction(ctx, req.(*ResourceActionRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_DeleteResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationResourceDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).DeleteResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/DeleteResource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).DeleteResource(ctx, req.(*ApplicationResourceDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_PodLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ApplicationPodLogsQuery)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApplicationServiceServer).PodLogs(m, &applicationServicePodLogsServer{stream})
+}
+
+type ApplicationService_PodLogsServer interface {
+	Send(*LogEntry) error
+	grpc.ServerStream
+}
+
+type applicationServicePodLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *applicationServicePodLogsServer) Send(m *LogEntry) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _ApplicationService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "application.ApplicationService",
+	HandlerType: (*ApplicationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _ApplicationService_List_Handler,
+		},
+		{
+			MethodName: "ListResourceEvents",
+			Handler:    _ApplicationService_ListResourceEvents_Handler,
+		},
+		{
+			MethodName: "ListResourceConflicts",
+			Handler:    _ApplicationService_ListResourceConflicts_Handler,
+		},
+		{
+			MethodName: "DriftReport",
+			Handler:    _ApplicationService_DriftReport_Handler,
+		},
+		{
+			MethodName: "StatusMatrix",
+			Handler:    _ApplicationService_StatusMatrix_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _ApplicationService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _ApplicationService_Get_Handler,
+		},
+		{
+			MethodName: "RevisionMetadata",
+			Handler:    _ApplicationService_RevisionMetadata_Handler,
+		},
+		{
+			MethodName: "RevisionDetails",
+			Handler:    _ApplicationService_RevisionDetails_Handler,
+		},
+		{
+			MethodName: "RevisionChangelog",
+			Handler:    _ApplicationService_RevisionChangelog_Handler,
+		},
+		{
+			MethodName: "ManagedManifests",
+			Handler:    _ApplicationService_ManagedManifests_Handler,
+		},
+		{
+			MethodName: "GetManifests",
+			Handler:    _ApplicationService_GetManifests_Handler,
+		},
+		{
+			MethodName: "Preview",
+			Handler:    _ApplicationService_Preview_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _ApplicationService_Update_Handler,
+		},
+		{
+			MethodName: "UpdateSpec",
+			Handler:    _ApplicationService_UpdateSpec_Handler,
+		},
+		{
+			MethodName: "ValidateSpec",
+			Handler:    _ApplicationService_ValidateSpec_Handler,
+		},
+		{
+			MethodName: "Patch",
+			Handler:    _ApplicationService_Patch_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _ApplicationService_Delete_Handler,
+		},
+		{
+			MethodName: "Sync",
+			Handler:    _ApplicationService_Sync_Handler,
+		},
+		{
+			MethodName: "ManagedResources",
+			Handler:    _ApplicationService_ManagedResources_Handler,
+		},
+		{
+			MethodName: "SyncReports",
+			Handler:    _ApplicationService_SyncReports_Handler,
+		},
+		{
+			MethodName: "ResourceTree",
+			Handler:    _ApplicationService_ResourceTree_Handler,
+		},
+		{
+			MethodName: "Rollback",
+			Handler:    _ApplicationService_Rollback_Handler,
+		},
+		{
+			MethodName: "UpdateImages",
+			Handler:    _ApplicationService_UpdateImages_Handler,
+		},
+		{
+			MethodName: "TerminateOperation",
+			Handler:    _ApplicationService_TerminateOperation_Handler,
+		},
+		{
+			MethodName: "BulkSync",
+			Handler:    _ApplicationService_BulkSync_Handler,
+		},
+		{
+			MethodName: "BulkRefresh",
+			Handler:    _ApplicationService_BulkRefresh_Handler,
+		},
+		{
+			MethodName: "BulkTerminateOperation",
+			Handler:    _ApplicationService_BulkTerminateOperation_Handler,
+		},
+		{
+			MethodName: "BulkDelete",
+			Handler:    _ApplicationService_BulkDelete_Handler,
+		},
+		{
+			MethodName: "GetResource",
+			Handler:    _ApplicationService_GetResource_Handler,
+		},
+		{
+			MethodName: "PatchResource",
+			Handler:    _ApplicationService_PatchResource_Handler,
+		},
+		{
+			MethodName: "ListResourceActions",
+			Handler:    _ApplicationService_ListResourceActions_Handler,
+		},
+		{
+			MethodName: "RunResourceAction",
+			Handler:    _ApplicationService_RunResourceAction_Handler,
+		},
+		{
+			MethodName: "DeleteResource",
+			Handler:    _ApplicationService_DeleteResource_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _ApplicationService_Watch_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchEvents",
+			Handler:       _ApplicationService_WatchEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "PodLogs",
+			Handler:       _ApplicationService_PodLogs_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "server/application/application.proto",
+}
+
+func (m *ApplicationQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationQuery) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Name != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i += copy(dAtA[i:], *m.Name)
+	}
+	if m.Refresh != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Refresh)))
+		i += copy(dAtA[i:], *m.Refresh)
+	}
+	if len(m.Projects) > 0 {
+		for _, s := range m.Projects {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.ResourceVersion)))
+	i += copy(dAtA[i:], m.ResourceVersion)
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Search)))
+	i += copy(dAtA[i:], m.Search)
+	dAtA[i] = 0x32
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Selector)))
+	i += copy(dAtA[i:], m.Selector)
+	dAtA[i] = 0x3a
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.SortBy)))
+	i += copy(dAtA[i:], m.SortBy)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RevisionMetadataQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevisionMetadataQuery) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i += copy(dAtA[i:], *m.Name)
+	}
+	if m.Revision == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("revision")
+	} else {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Revision)))
+		i += copy(dAtA[i:], *m.Revision)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ApplicationRevisionChangelogQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationRevisionChangelogQuery) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i += copy(dAtA[i:], *m.Name)
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.TargetRevision)))
+	i += copy(dAtA[i:], m.TargetRevision)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ApplicationResourceEventsQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationResourceEventsQuery) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i += copy(dAtA[i:], *m.Name)
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.ResourceNamespace)))
+	i += copy(dAtA[i:], m.ResourceNamespace)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.ResourceName)))
+	i += copy(dAtA[i:], m.ResourceName)
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.ResourceUID)))
+	i += copy(dAtA[i:], m.ResourceUID)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ApplicationEventStreamQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationEventStreamQuery) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	if len(m.Projects) > 0 {
+		for _, s := range m.Projects {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Types) > 0 {
+		for _, s := range m.Types {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ApplicationEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationEvent) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Type)))
+	i += copy(dAtA[i:], m.Type)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Application)))
+	i += copy(dAtA[i:], m.Application)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Project)))
+	i += copy(dAtA[i:], m.Project)
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Message)))
+	i += copy(dAtA[i:], m.Message)
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Revision)))
+	i += copy(dAtA[i:], m.Revision)
+	if m.Time != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.Time.Size()))
+		n1, err := m.Time.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ApplicationManifestQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationManifestQuery) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i += copy(dAtA[i:], *m.Name)
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Revision)))
+	i += copy(dAtA[i:], m.Revision)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ApplicationManagedManifestsQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationManagedManifestsQuery) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i += copy(dAtA[i:], *m.Name)
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Source)))
+	i += copy(dAtA[i:], m.Source)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ApplicationManagedManifestsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationManagedManifestsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Manifests)))
+	i += copy(dAtA[i:], m.Manifests)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ApplicationPreviewRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationPreviewRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i += copy(dAtA[i:], *m.Name)
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Revision)))
+	i += copy(dAtA[i:], m.Revision)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ResourcePreviewResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourcePreviewResult) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Group)))
+	i += copy(dAtA[i:], m.Group)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Kind)))
+	i += copy(dAtA[i:], m.Kind)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Namespace)))
+	i += copy(dAtA[i:], m.Namespace)
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Status)))
+	i += copy(dAtA[i:], m.Status)
+	dAtA[i] = 0x32
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Message)))
+	i += copy(dAtA[i:], m.Message)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ApplicationPreviewResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationPreviewResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, msg := range m.Items {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintApplication(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ResourceConflictsQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceConflictsQuery) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ResourceConflict) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceConflict) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Server)))
+	i += copy(dAtA[i:], m.Server)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Group)))
+	i += copy(dAtA[i:], m.Group)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Kind)))
+	i += copy(dAtA[i:], m.Kind)
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Namespace)))
+	i += copy(dAtA[i:], m.Namespace)
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	dAtA[i] = 0x32
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Application)))
+	i += copy(dAtA[i:], m.Application)
+	dAtA[i] = 0x3a
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.ConflictingApplication)))
+	i += copy(dAtA[i:], m.ConflictingApplication)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ResourceConflictList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceConflictList) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, msg := range m.Items {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintApplication(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ApplicationDriftReportQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationDriftReportQuery) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Project)))
+	i += copy(dAtA[i:], m.Project)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Since)))
+	i += copy(dAtA[i:], m.Since)
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(m.Limit))
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Continue)))
+	i += copy(dAtA[i:], m.Continue)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *DriftedResource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DriftedResource) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Application)))
+	i += copy(dAtA[i:], m.Application)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Group)))
+	i += copy(dAtA[i:], m.Group)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Kind)))
+	i += copy(dAtA[i:], m.Kind)
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Namespace)))
+	i += copy(dAtA[i:], m.Namespace)
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	if len(m.Fields) > 0 {
+		for _, s := range m.Fields {
+			dAtA[i] = 0x32
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	dAtA[i] = 0x38
+	i++
+	if m.Missing {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	dAtA[i] = 0x40
+	i++
+	if m.RequiresPruning {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	if m.DetectedAt != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.DetectedAt.Size()))
+		n2, err := m.DetectedAt.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ApplicationDriftReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationDriftReport) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, msg := range m.Items {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintApplication(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Continue)))
+	i += copy(dAtA[i:], m.Continue)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ApplicationStatusMatrixQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationStatusMatrixQuery) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Selector)))
+	i += copy(dAtA[i:], m.Selector)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.GroupBy)))
+	i += copy(dAtA[i:], m.GroupBy)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ApplicationStatusMatrixCluster) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationStatusMatrixCluster) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Server)))
+	i += copy(dAtA[i:], m.Server)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ApplicationStatusMatrixCell) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationStatusMatrixCell) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(m.Cluster))
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Application)))
+	i += copy(dAtA[i:], m.Application)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Namespace)))
+	i += copy(dAtA[i:], m.Namespace)
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.SyncStatus)))
+	i += copy(dAtA[i:], m.SyncStatus)
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.HealthStatus)))
+	i += copy(dAtA[i:], m.HealthStatus)
+	dAtA[i] = 0x32
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Revision)))
+	i += copy(dAtA[i:], m.Revision)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ApplicationStatusMatrixRow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationStatusMatrixRow) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	if len(m.Cells) > 0 {
+		for _, msg := range m.Cells {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintApplication(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ApplicationStatusMatrix) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationStatusMatrix) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Clusters) > 0 {
+		for _, msg := range m.Clusters {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintApplication(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Rows) > 0 {
+		for _, msg := range m.Rows {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintApplication(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ApplicationBulkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationBulkRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Names) > 0 {
+		for _, s := range m.Names {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Selector)))
+	i += copy(dAtA[i:], m.Selector)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Refresh)))
+	i += copy(dAtA[i:], m.Refresh)
+	if m.Cascade != nil {
+		dAtA[i] = 0x20
+		i++
+		if *m.Cascade {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ApplicationBulkSyncRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationBulkSyncRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Names) > 0 {
+		for _, s := range m.Names {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Selector)))
+	i += copy(dAtA[i:], m.Selector)
+	dAtA[i] = 0x18
+	i++
+	if m.DryRun {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	dAtA[i] = 0x20
+	i++
+	if m.Prune {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	if m.Strategy != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.Strategy.Size()))
+		n3, err := m.Strategy.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ApplicationBulkResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationBulkResult) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i += copy(dAtA[i:], *m.Name)
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Error)))
+	i += copy(dAtA[i:], m.Error)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ApplicationBulkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationBulkResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, msg := range m.Results {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintApplication(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ApplicationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ApplicationCreateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationCreateRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(m.Application.Size()))
+	n4, err := m.Application.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n4
+	if m.Upsert != nil {
+		dAtA[i] = 0x10
+		i++
+		if *m.Upsert {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ApplicationUpdateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationUpdateRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Application == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("application")
+	} else {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.Application.Size()))
+		n5, err := m.Application.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ApplicationDeleteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationDeleteRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i += copy(dAtA[i:], *m.Name)
+	}
+	if m.Cascade != nil {
+		dAtA[i] = 0x10
+		i++
+		if *m.Cascade {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ApplicationSyncRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSyncRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i += copy(dAtA[i:], *m.Name)
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Revision)))
+	i += copy(dAtA[i:], m.Revision)
+	dAtA[i] = 0x18
+	i++
+	if m.DryRun {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	dAtA[i] = 0x20
+	i++
+	if m.Prune {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	if m.Strategy != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.Strategy.Size()))
+		n6, err := m.Strategy.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if len(m.Resources) > 0 {
+		for _, msg := range m.Resources {
+			dAtA[i] = 0x3a
+			i++
+			i = encodeVarintApplication(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Manifests) > 0 {
+		for _, s := range m.Manifests {
+			dAtA[i] = 0x42
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	dAtA[i] = 0x48
+	i++
+	if m.Adopt {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	dAtA[i] = 0x50
+	i++
+	if m.PruneArgoCD {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ApplicationUpdateSpecRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationUpdateSpecRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i += copy(dAtA[i:], *m.Name)
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(m.Spec.Size()))
+	n7, err := m.Spec.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n7
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ApplicationValidateSpecRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationValidateSpecRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i += copy(dAtA[i:], *m.Name)
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(m.Spec.Size()))
+	n8, err := m.Spec.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n8
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ProjectRuleViolation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectRuleViolation) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Rule)))
+	i += copy(dAtA[i:], m.Rule)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Message)))
+	i += copy(dAtA[i:], m.Message)
+	if len(m.Allowed) > 0 {
+		for _, s := range m.Allowed {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ApplicationValidateSpecResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationValidateSpecResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Violations) > 0 {
+		for _, msg := range m.Violations {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintApplication(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ApplicationPatchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationPatchRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i += copy(dAtA[i:], *m.Name)
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Patch)))
+	i += copy(dAtA[i:], m.Patch)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.PatchType)))
+	i += copy(dAtA[i:], m.PatchType)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ApplicationRollbackRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationRollbackRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i += copy(dAtA[i:], *m.Name)
+	}
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(m.ID))
+	dAtA[i] = 0x18
+	i++
+	if m.DryRun {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	dAtA[i] = 0x20
+	i++
+	if m.Prune {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ApplicationUpdateImagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationUpdateImagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i += copy(dAtA[i:], *m.Name)
+	}
+	if len(m.HelmParameters) > 0 {
+		for _, msg := range m.HelmParameters {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintApplication(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.KustomizeImages) > 0 {
+		for _, s := range m.KustomizeImages {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	dAtA[i] = 0x20
+	i++
+	if m.WriteBack {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	dAtA[i] = 0x28
+	i++
+	if m.DryRun {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ApplicationResourceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationResourceRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i += copy(dAtA[i:], *m.Name)
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Namespace)))
+	i += copy(dAtA[i:], m.Namespace)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.ResourceName)))
+	i += copy(dAtA[i:], m.ResourceName)
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Version)))
+	i += copy(dAtA[i:], m.Version)
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Group)))
+	i += copy(dAtA[i:], m.Group)
+	dAtA[i] = 0x32
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Kind)))
+	i += copy(dAtA[i:], m.Kind)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ApplicationResourcePatchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationResourcePatchRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i += copy(dAtA[i:], *m.Name)
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Namespace)))
+	i += copy(dAtA[i:], m.Namespace)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.ResourceName)))
+	i += copy(dAtA[i:], m.ResourceName)
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Version)))
+	i += copy(dAtA[i:], m.Version)
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Group)))
+	i += copy(dAtA[i:], m.Group)
+	dAtA[i] = 0x32
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Kind)))
+	i += copy(dAtA[i:], m.Kind)
+	dAtA[i] = 0x3a
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Patch)))
+	i += copy(dAtA[i:], m.Patch)
+	dAtA[i] = 0x42
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.PatchType)))
+	i += copy(dAtA[i:], m.PatchType)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ApplicationResourceDeleteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationResourceDeleteRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i += copy(dAtA[i:], *m.Name)
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Namespace)))
+	i += copy(dAtA[i:], m.Namespace)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.ResourceName)))
+	i += copy(dAtA[i:], m.ResourceName)
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Version)))
+	i += copy(dAtA[i:], m.Version)
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Group)))
+	i += copy(dAtA[i:], m.Group)
+	dAtA[i] = 0x32
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Kind)))
+	i += copy(dAtA[i:], m.Kind)
+	if m.Force != nil {
+		dAtA[i] = 0x38
+		i++
+		if *m.Force {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ResourceActionRunRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceActionRunRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i += copy(dAtA[i:], *m.Name)
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Namespace)))
+	i += copy(dAtA[i:], m.Namespace)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.ResourceName)))
+	i += copy(dAtA[i:], m.ResourceName)
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Version)))
+	i += copy(dAtA[i:], m.Version)
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Group)))
+	i += copy(dAtA[i:], m.Group)
+	dAtA[i] = 0x32
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Kind)))
+	i += copy(dAtA[i:], m.Kind)
+	dAtA[i] = 0x3a
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Action)))
+	i += copy(dAtA[i:], m.Action)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ResourceActionsListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceActionsListResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Actions) > 0 {
+		for _, msg := range m.Actions {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintApplication(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ApplicationResourceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationResourceResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Manifest)))
+	i += copy(dAtA[i:], m.Manifest)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ApplicationPodLogsQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationPodLogsQuery) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i += copy(dAtA[i:], *m.Name)
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Namespace)))
+	i += copy(dAtA[i:], m.Namespace)
+	if m.PodName == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("podName")
+	} else {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.PodName)))
+		i += copy(dAtA[i:], *m.PodName)
+	}
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Container)))
+	i += copy(dAtA[i:], m.Container)
+	dAtA[i] = 0x28
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(m.SinceSeconds))
+	if m.SinceTime != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.SinceTime.Size()))
+		n9, err := m.SinceTime.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	dAtA[i] = 0x38
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(m.TailLines))
+	dAtA[i] = 0x40
+	i++
+	if m.Follow {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *LogEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LogEntry) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Content)))
+	i += copy(dAtA[i:], m.Content)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(m.TimeStamp.Size()))
+	n10, err := m.TimeStamp.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n10
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *OperationTerminateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperationTerminateRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i += copy(dAtA[i:], *m.Name)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *OperationTerminateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperationTerminateResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ResourcesQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourcesQuery) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ApplicationName == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("applicationName")
+	} else {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.ApplicationName)))
+		i += copy(dAtA[i:], *m.ApplicationName)
+	}
+	if len(m.RelistKinds) > 0 {
+		for _, s := range m.RelistKinds {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Kinds) > 0 {
+		for _, s := range m.Kinds {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.HealthStatuses) > 0 {
+		for _, s := range m.HealthStatuses {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Namespaces) > 0 {
+		for _, s := range m.Namespaces {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.Orphaned != nil {
+		dAtA[i] = 0x30
+		i++
+		if *m.Orphaned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ManagedResourcesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ManagedResourcesResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, msg := range m.Items {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintApplication(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ApplicationSyncReportsQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSyncReportsQuery) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i += copy(dAtA[i:], *m.Name)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ApplicationSyncReportsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSyncReportsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, msg := range m.Items {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintApplication(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func encodeVarintApplication(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *ApplicationQuery) Size() (n int) {
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Refresh != nil {
+		l = len(*m.Refresh)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if len(m.Projects) > 0 {
+		for _, s := range m.Projects {
+			l = len(s)
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	l = len(m.ResourceVersion)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Search)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Selector)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.SortBy)
+	n += 1 + l + sovApplication(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RevisionMetadataQuery) Size() (n int) {
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Revision != nil {
+		l = len(*m.Revision)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationRevisionChangelogQuery) Size() (n int) {
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.TargetRevision)
+	n += 1 + l + sovApplication(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationResourceEventsQuery) Size() (n int) {
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.ResourceNamespace)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.ResourceName)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.ResourceUID)
+	n += 1 + l + sovApplication(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationEventStreamQuery) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovApplication(uint64(l))
+	if len(m.Projects) > 0 {
+		for _, s := range m.Projects {
+			l = len(s)
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if len(m.Types) > 0 {
+		for _, s := range m.Types {
+			l = len(s)
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationEvent) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Type)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Application)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Project)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Revision)
+	n += 1 + l + sovApplication(uint64(l))
+	if m.Time != nil {
+		l = m.Time.Size()
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationManifestQuery) Size() (n int) {
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.Revision)
+	n += 1 + l + sovApplication(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationManagedManifestsQuery) Size() (n int) {
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.Source)
+	n += 1 + l + sovApplication(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationManagedManifestsResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Manifests)
+	n += 1 + l + sovApplication(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationPreviewRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.Revision)
+	n += 1 + l + sovApplication(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResourcePreviewResult) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Group)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Status)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovApplication(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationPreviewResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResourceConflictsQuery) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovApplication(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResourceConflict) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Server)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Group)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Application)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.ConflictingApplication)
+	n += 1 + l + sovApplication(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResourceConflictList) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationDriftReportQuery) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Project)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Since)
+	n += 1 + l + sovApplication(uint64(l))
+	n += 1 + sovApplication(uint64(m.Limit))
+	l = len(m.Continue)
+	n += 1 + l + sovApplication(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DriftedResource) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Application)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Group)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovApplication(uint64(l))
+	if len(m.Fields) > 0 {
+		for _, s := range m.Fields {
+			l = len(s)
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	n += 2
+	n += 2
+	if m.DetectedAt != nil {
+		l = m.DetectedAt.Size()
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationDriftReport) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	l = len(m.Continue)
+	n += 1 + l + sovApplication(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationStatusMatrixQuery) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Selector)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.GroupBy)
+	n += 1 + l + sovApplication(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationStatusMatrixCluster) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Server)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovApplication(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationStatusMatrixCell) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovApplication(uint64(m.Cluster))
+	l = len(m.Application)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.SyncStatus)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.HealthStatus)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Revision)
+	n += 1 + l + sovApplication(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationStatusMatrixRow) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovApplication(uint64(l))
+	if len(m.Cells) > 0 {
+		for _, e := range m.Cells {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationStatusMatrix) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Clusters) > 0 {
+		for _, e := range m.Clusters {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if len(m.Rows) > 0 {
+		for _, e := range m.Rows {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationBulkRequest) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Names) > 0 {
+		for _, s := range m.Names {
+			l = len(s)
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	l = len(m.Selector)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Refresh)
+	n += 1 + l + sovApplication(uint64(l))
+	if m.Cascade != nil {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationBulkSyncRequest) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Names) > 0 {
+		for _, s := range m.Names {
+			l = len(s)
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	l = len(m.Selector)
+	n += 1 + l + sovApplication(uint64(l))
+	n += 2
+	n += 2
+	if m.Strategy != nil {
+		l = m.Strategy.Size()
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationBulkResult) Size() (n int) {
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.Error)
+	n += 1 + l + sovApplication(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationBulkResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationCreateRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Application.Size()
+	n += 1 + l + sovApplication(uint64(l))
+	if m.Upsert != nil {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationUpdateRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Application != nil {
+		l = m.Application.Size()
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationDeleteRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Cascade != nil {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationSyncRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.Revision)
+	n += 1 + l + sovApplication(uint64(l))
+	n += 2
+	n += 2
+	if m.Strategy != nil {
+		l = m.Strategy.Size()
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if len(m.Resources) > 0 {
+		for _, e := range m.Resources {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if len(m.Manifests) > 0 {
+		for _, s := range m.Manifests {
+			l = len(s)
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	n += 2
+	n += 2
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationUpdateSpecRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = m.Spec.Size()
+	n += 1 + l + sovApplication(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationValidateSpecRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = m.Spec.Size()
+	n += 1 + l + sovApplication(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProjectRuleViolation) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Rule)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovApplication(uint64(l))
+	if len(m.Allowed) > 0 {
+		for _, s := range m.Allowed {
+			l = len(s)
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationValidateSpecResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Violations) > 0 {
+		for _, e := range m.Violations {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationPatchRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.Patch)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.PatchType)
+	n += 1 + l + sovApplication(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationRollbackRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	n += 1 + sovApplication(uint64(m.ID))
+	n += 2
+	n += 2
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationUpdateImagesRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if len(m.HelmParameters) > 0 {
+		for _, e := range m.HelmParameters {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if len(m.KustomizeImages) > 0 {
+		for _, s := range m.KustomizeImages {
+			l = len(s)
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	n += 2
+	n += 2
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationResourceRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.Namespace)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.ResourceName)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Version)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Group)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovApplication(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationResourcePatchRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.Namespace)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.ResourceName)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Version)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Group)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Patch)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.PatchType)
+	n += 1 + l + sovApplication(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationResourceDeleteRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.Namespace)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.ResourceName)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Version)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Group)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovApplication(uint64(l))
+	if m.Force != nil {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResourceActionRunRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.Namespace)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.ResourceName)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Version)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Group)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Action)
+	n += 1 + l + sovApplication(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResourceActionsListResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Actions) > 0 {
+		for _, e := range m.Actions {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationResourceResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Manifest)
+	n += 1 + l + sovApplication(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationPodLogsQuery) Size() (n int) {
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.Namespace)
+	n += 1 + l + sovApplication(uint64(l))
+	if m.PodName != nil {
+		l = len(*m.PodName)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.Container)
+	n += 1 + l + sovApplication(uint64(l))
+	n += 1 + sovApplication(uint64(m.SinceSeconds))
+	if m.SinceTime != nil {
+		l = m.SinceTime.Size()
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	n += 1 + sovApplication(uint64(m.TailLines))
+	n += 2
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LogEntry) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Content)
+	n += 1 + l + sovApplication(uint64(l))
+	l = m.TimeStamp.Size()
+	n += 1 + l + sovApplication(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *OperationTerminateRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *OperationTerminateResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResourcesQuery) Size() (n int) {
+	var l int
+	_ = l
+	if m.ApplicationName != nil {
+		l = len(*m.ApplicationName)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if len(m.RelistKinds) > 0 {
+		for _, s := range m.RelistKinds {
+			l = len(s)
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if len(m.Kinds) > 0 {
+		for _, s := range m.Kinds {
+			l = len(s)
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if len(m.HealthStatuses) > 0 {
+		for _, s := range m.HealthStatuses {
+			l = len(s)
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if len(m.Namespaces) > 0 {
+		for _, s := range m.Namespaces {
+			l = len(s)
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if m.Orphaned != nil {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ManagedResourcesResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationSyncReportsQuery) Size() (n int) {
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationSyncReportsResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovApplication(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozApplication(x uint64) (n int) {
+	return sovApplication(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ApplicationQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refresh", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Refresh = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Projects", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Projects = append(m.Projects, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Search", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Search = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Selector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SortBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SortBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RevisionMetadataQuery) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevisionMetadataQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevisionMetadataQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Revision = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("revision")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationRevisionChangelogQuery) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationRevisionChangelogQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationRevisionChangelogQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetRevision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetRevision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationResourceEventsQuery) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationResourceEventsQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationResourceEventsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceNamespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000004)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceUID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceUID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000008)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("resourceNamespace")
+	}
+	if hasFields[0]&uint64(0x00000004) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("resourceName")
+	}
+	if hasFields[0]&uint64(0x00000008) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("resourceUID")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationEventStreamQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationEventStreamQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationEventStreamQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Projects", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Projects = append(m.Projects, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Types", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Types = append(m.Types, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationEvent) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Application = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Project = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000004)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Time == nil {
+				m.Time = &v1.Time{}
+			}
+			if err := m.Time.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("type")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("application")
+	}
+	if hasFields[0]&uint64(0x00000004) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("project")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationManifestQuery) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationManifestQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationManifestQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationManagedManifestsQuery) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationManagedManifestsQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationManagedManifestsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationManagedManifestsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationManagedManifestsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationManagedManifestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manifests", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Manifests = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationPreviewRequest) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationPreviewRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationPreviewRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourcePreviewResult) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourcePreviewResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourcePreviewResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000004)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000008)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = PreviewStatus(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000010)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("group")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("kind")
+	}
+	if hasFields[0]&uint64(0x00000004) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("namespace")
+	}
+	if hasFields[0]&uint64(0x00000008) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+	if hasFields[0]&uint64(0x00000010) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("status")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationPreviewResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationPreviewResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationPreviewResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, ResourcePreviewResult{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceConflictsQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceConflictsQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceConflictsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceConflict) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceConflict: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceConflict: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Server = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000004)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000008)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000010)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Application = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000020)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictingApplication", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConflictingApplication = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000040)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("server")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("group")
+	}
+	if hasFields[0]&uint64(0x00000004) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("kind")
+	}
+	if hasFields[0]&uint64(0x00000008) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("namespace")
+	}
+	if hasFields[0]&uint64(0x00000010) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+	if hasFields[0]&uint64(0x00000020) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("application")
+	}
+	if hasFields[0]&uint64(0x00000040) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("conflictingApplication")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceConflictList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceConflictList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceConflictList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, ResourceConflict{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationDriftReportQuery) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationDriftReportQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationDriftReportQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Project = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Since = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Continue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Continue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("project")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DriftedResource) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DriftedResource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DriftedResource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Application = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000004)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000008)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000010)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = append(m.Fields, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Missing", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Missing = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiresPruning", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequiresPruning = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DetectedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DetectedAt == nil {
+				m.DetectedAt = &v1.Time{}
+			}
+			if err := m.DetectedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("application")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("group")
+	}
+	if hasFields[0]&uint64(0x00000004) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("kind")
+	}
+	if hasFields[0]&uint64(0x00000008) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("namespace")
+	}
+	if hasFields[0]&uint64(0x00000010) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationDriftReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationDriftReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationDriftReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, DriftedResource{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Continue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Continue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationStatusMatrixQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationStatusMatrixQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationStatusMatrixQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Selector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationStatusMatrixCluster) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationStatusMatrixCluster: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationStatusMatrixCluster: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Server = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("server")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationStatusMatrixCell) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationStatusMatrixCell: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationStatusMatrixCell: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
+			}
+			m.Cluster = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cluster |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Application = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SyncStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HealthStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("cluster")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("application")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationStatusMatrixRow) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationStatusMatrixRow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationStatusMatrixRow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cells", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cells = append(m.Cells, ApplicationStatusMatrixCell{})
+			if err := m.Cells[len(m.Cells)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationStatusMatrix) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationStatusMatrix: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationStatusMatrix: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Clusters = append(m.Clusters, ApplicationStatusMatrixCluster{})
+			if err := m.Clusters[len(m.Clusters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rows = append(m.Rows, ApplicationStatusMatrixRow{})
+			if err := m.Rows[len(m.Rows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationBulkRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationBulkRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationBulkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Names = append(m.Names, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Selector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refresh", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Refresh = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cascade", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Cascade = &b
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationBulkSyncRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationBulkSyncRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationBulkSyncRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Names = append(m.Names, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Selector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prune", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Prune = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Strategy == nil {
+				m.Strategy = &v1alpha1.SyncStrategy{}
+			}
+			if err := m.Strategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationBulkResult) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationBulkResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationBulkResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationBulkResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationBulkResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationBulkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, ApplicationBulkResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationCreateRequest) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationCreateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Application.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upsert", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Upsert = &b
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("application")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationUpdateRequest) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationUpdateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationUpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Application == nil {
+				m.Application = &v1alpha1.Application{}
+			}
+			if err := m.Application.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("application")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationDeleteRequest) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationDeleteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationDeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cascade", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Cascade = &b
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
 	}
-	return n
-}
 
-func sovApplication(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-func sozApplication(x uint64) (n int) {
-	return sovApplication(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *ApplicationQuery) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSyncRequest) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3948,10 +12752,10 @@ func (m *ApplicationQuery) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationQuery: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSyncRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSyncRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3984,9 +12788,10 @@ func (m *ApplicationQuery) Unmarshal(dAtA []byte) error {
 			s := string(dAtA[iNdEx:postIndex])
 			m.Name = &s
 			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Refresh", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4011,14 +12816,53 @@ func (m *ApplicationQuery) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Refresh = &s
+			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prune", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Prune = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Projects", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -4028,24 +12872,59 @@ func (m *ApplicationQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Projects = append(m.Projects, string(dAtA[iNdEx:postIndex]))
+			if m.Strategy == nil {
+				m.Strategy = &v1alpha1.SyncStrategy{}
+			}
+			if err := m.Strategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resources = append(m.Resources, v1alpha1.SyncOperationResource{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manifests", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4070,8 +12949,48 @@ func (m *ApplicationQuery) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResourceVersion = string(dAtA[iNdEx:postIndex])
+			m.Manifests = append(m.Manifests, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Adopt", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Adopt = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruneArgoCD", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PruneArgoCD = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -4088,13 +13007,16 @@ func (m *ApplicationQuery) Unmarshal(dAtA []byte) error {
 			iNdEx += skippy
 		}
 	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *RevisionMetadataQuery) Unmarshal(dAtA []byte) error {
+func (m *ApplicationUpdateSpecRequest) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -4118,10 +13040,10 @@ func (m *RevisionMetadataQuery) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RevisionMetadataQuery: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationUpdateSpecRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RevisionMetadataQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationUpdateSpecRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4157,9 +13079,9 @@ func (m *RevisionMetadataQuery) Unmarshal(dAtA []byte) error {
 			hasFields[0] |= uint64(0x00000001)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -4169,21 +13091,21 @@ func (m *RevisionMetadataQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Revision = &s
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000002)
 		default:
@@ -4206,7 +13128,7 @@ func (m *RevisionMetadataQuery) Unmarshal(dAtA []byte) error {
 		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
 	}
 	if hasFields[0]&uint64(0x00000002) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("revision")
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("spec")
 	}
 
 	if iNdEx > l {
@@ -4214,7 +13136,7 @@ func (m *RevisionMetadataQuery) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationResourceEventsQuery) Unmarshal(dAtA []byte) error {
+func (m *ApplicationValidateSpecRequest) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -4238,10 +13160,10 @@ func (m *ApplicationResourceEventsQuery) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationResourceEventsQuery: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationValidateSpecRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationResourceEventsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationValidateSpecRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4277,69 +13199,9 @@ func (m *ApplicationResourceEventsQuery) Unmarshal(dAtA []byte) error {
 			hasFields[0] |= uint64(0x00000001)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceNamespace", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApplication
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ResourceNamespace = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000002)
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApplication
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ResourceName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000004)
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceUID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -4349,22 +13211,23 @@ func (m *ApplicationResourceEventsQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResourceUID = string(dAtA[iNdEx:postIndex])
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000008)
+			hasFields[0] |= uint64(0x00000002)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -4385,13 +13248,7 @@ func (m *ApplicationResourceEventsQuery) Unmarshal(dAtA []byte) error {
 		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
 	}
 	if hasFields[0]&uint64(0x00000002) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("resourceNamespace")
-	}
-	if hasFields[0]&uint64(0x00000004) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("resourceName")
-	}
-	if hasFields[0]&uint64(0x00000008) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("resourceUID")
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("spec")
 	}
 
 	if iNdEx > l {
@@ -4399,7 +13256,7 @@ func (m *ApplicationResourceEventsQuery) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationManifestQuery) Unmarshal(dAtA []byte) error {
+func (m *ProjectRuleViolation) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -4423,15 +13280,15 @@ func (m *ApplicationManifestQuery) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationManifestQuery: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectRuleViolation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationManifestQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectRuleViolation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rule", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4456,13 +13313,12 @@ func (m *ApplicationManifestQuery) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Name = &s
+			m.Rule = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000001)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4487,7 +13343,37 @@ func (m *ApplicationManifestQuery) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowed = append(m.Allowed, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4506,7 +13392,10 @@ func (m *ApplicationManifestQuery) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("rule")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("message")
 	}
 
 	if iNdEx > l {
@@ -4514,7 +13403,7 @@ func (m *ApplicationManifestQuery) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationResponse) Unmarshal(dAtA []byte) error {
+func (m *ApplicationValidateSpecResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4537,12 +13426,43 @@ func (m *ApplicationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationValidateSpecResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationValidateSpecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Violations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Violations = append(m.Violations, ProjectRuleViolation{})
+			if err := m.Violations[len(m.Violations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -4565,7 +13485,7 @@ func (m *ApplicationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationCreateRequest) Unmarshal(dAtA []byte) error {
+func (m *ApplicationPatchRequest) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -4589,17 +13509,17 @@ func (m *ApplicationCreateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationCreateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationPatchRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationPatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -4609,28 +13529,28 @@ func (m *ApplicationCreateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Application.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000001)
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Upsert", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -4640,13 +13560,52 @@ func (m *ApplicationCreateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.Upsert = &b
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Patch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PatchType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PatchType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000004)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -4664,7 +13623,13 @@ func (m *ApplicationCreateRequest) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("application")
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("patch")
+	}
+	if hasFields[0]&uint64(0x00000004) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("patchType")
 	}
 
 	if iNdEx > l {
@@ -4672,7 +13637,7 @@ func (m *ApplicationCreateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationUpdateRequest) Unmarshal(dAtA []byte) error {
+func (m *ApplicationRollbackRequest) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -4696,17 +13661,17 @@ func (m *ApplicationUpdateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationUpdateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationRollbackRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationUpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationRollbackRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -4716,26 +13681,83 @@ func (m *ApplicationUpdateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Application == nil {
-				m.Application = &v1alpha1.Application{}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			if err := m.Application.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prune", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000001)
+			m.Prune = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -4753,7 +13775,10 @@ func (m *ApplicationUpdateRequest) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("application")
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("id")
 	}
 
 	if iNdEx > l {
@@ -4761,7 +13786,7 @@ func (m *ApplicationUpdateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationDeleteRequest) Unmarshal(dAtA []byte) error {
+func (m *ApplicationUpdateImagesRequest) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -4785,10 +13810,10 @@ func (m *ApplicationDeleteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationDeleteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationUpdateImagesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationDeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationUpdateImagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4823,8 +13848,68 @@ func (m *ApplicationDeleteRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000001)
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HelmParameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HelmParameters = append(m.HelmParameters, v1alpha1.HelmParameter{})
+			if err := m.HelmParameters[len(m.HelmParameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KustomizeImages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KustomizeImages = append(m.KustomizeImages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cascade", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteBack", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -4841,8 +13926,27 @@ func (m *ApplicationDeleteRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.Cascade = &b
+			m.WriteBack = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -4868,7 +13972,7 @@ func (m *ApplicationDeleteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSyncRequest) Unmarshal(dAtA []byte) error {
+func (m *ApplicationResourceRequest) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -4892,10 +13996,10 @@ func (m *ApplicationSyncRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSyncRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationResourceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSyncRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationResourceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4931,7 +14035,7 @@ func (m *ApplicationSyncRequest) Unmarshal(dAtA []byte) error {
 			hasFields[0] |= uint64(0x00000001)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4956,86 +14060,14 @@ func (m *ApplicationSyncRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.DryRun = bool(v != 0)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prune", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Prune = bool(v != 0)
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthApplication
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Strategy == nil {
-				m.Strategy = &v1alpha1.SyncStrategy{}
-			}
-			if err := m.Strategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -5045,26 +14077,25 @@ func (m *ApplicationSyncRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resources = append(m.Resources, v1alpha1.SyncOperationResource{})
-			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ResourceName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+			hasFields[0] |= uint64(0x00000004)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Manifests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5089,66 +14120,12 @@ func (m *ApplicationSyncRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Manifests = append(m.Manifests, string(dAtA[iNdEx:postIndex]))
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplication(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthApplication
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationUpdateSpecRequest) Unmarshal(dAtA []byte) error {
-	var hasFields [1]uint64
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplication
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationUpdateSpecRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationUpdateSpecRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			hasFields[0] |= uint64(0x00000008)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5173,15 +14150,14 @@ func (m *ApplicationUpdateSpecRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Name = &s
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000001)
-		case 2:
+			hasFields[0] |= uint64(0x00000010)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -5191,23 +14167,22 @@ func (m *ApplicationUpdateSpecRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000002)
+			hasFields[0] |= uint64(0x00000020)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -5228,7 +14203,19 @@ func (m *ApplicationUpdateSpecRequest) Unmarshal(dAtA []byte) error {
 		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
 	}
 	if hasFields[0]&uint64(0x00000002) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("spec")
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("namespace")
+	}
+	if hasFields[0]&uint64(0x00000004) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("resourceName")
+	}
+	if hasFields[0]&uint64(0x00000008) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("version")
+	}
+	if hasFields[0]&uint64(0x00000010) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("group")
+	}
+	if hasFields[0]&uint64(0x00000020) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("kind")
 	}
 
 	if iNdEx > l {
@@ -5236,7 +14223,7 @@ func (m *ApplicationUpdateSpecRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationPatchRequest) Unmarshal(dAtA []byte) error {
+func (m *ApplicationResourcePatchRequest) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -5260,10 +14247,10 @@ func (m *ApplicationPatchRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationPatchRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationResourcePatchRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationPatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationResourcePatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5299,7 +14286,7 @@ func (m *ApplicationPatchRequest) Unmarshal(dAtA []byte) error {
 			hasFields[0] |= uint64(0x00000001)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5324,12 +14311,12 @@ func (m *ApplicationPatchRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Patch = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000002)
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PatchType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5354,73 +14341,42 @@ func (m *ApplicationPatchRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PatchType = string(dAtA[iNdEx:postIndex])
+			m.ResourceName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000004)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplication(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthApplication
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
-	}
-	if hasFields[0]&uint64(0x00000002) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("patch")
-	}
-	if hasFields[0]&uint64(0x00000004) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("patchType")
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationRollbackRequest) Unmarshal(dAtA []byte) error {
-	var hasFields [1]uint64
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplication
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationRollbackRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationRollbackRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000008)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5445,15 +14401,14 @@ func (m *ApplicationRollbackRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Name = &s
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000001)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			hasFields[0] |= uint64(0x00000010)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			m.ID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -5463,17 +14418,27 @@ func (m *ApplicationRollbackRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			hasFields[0] |= uint64(0x00000002)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
 			}
-			var v int
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000020)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -5483,17 +14448,27 @@ func (m *ApplicationRollbackRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DryRun = bool(v != 0)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prune", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
 			}
-			var v int
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Patch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000040)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PatchType", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -5503,12 +14478,22 @@ func (m *ApplicationRollbackRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Prune = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PatchType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000080)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -5529,7 +14514,25 @@ func (m *ApplicationRollbackRequest) Unmarshal(dAtA []byte) error {
 		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
 	}
 	if hasFields[0]&uint64(0x00000002) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("id")
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("namespace")
+	}
+	if hasFields[0]&uint64(0x00000004) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("resourceName")
+	}
+	if hasFields[0]&uint64(0x00000008) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("version")
+	}
+	if hasFields[0]&uint64(0x00000010) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("group")
+	}
+	if hasFields[0]&uint64(0x00000020) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("kind")
+	}
+	if hasFields[0]&uint64(0x00000040) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("patch")
+	}
+	if hasFields[0]&uint64(0x00000080) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("patchType")
 	}
 
 	if iNdEx > l {
@@ -5537,7 +14540,7 @@ func (m *ApplicationRollbackRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationResourceRequest) Unmarshal(dAtA []byte) error {
+func (m *ApplicationResourceDeleteRequest) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -5561,10 +14564,10 @@ func (m *ApplicationResourceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationResourceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationResourceDeleteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationResourceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationResourceDeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5745,9 +14748,30 @@ func (m *ApplicationResourceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000020)
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000020)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Force = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -5788,7 +14812,7 @@ func (m *ApplicationResourceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationResourcePatchRequest) Unmarshal(dAtA []byte) error {
+func (m *ResourceActionRunRequest) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -5812,10 +14836,10 @@ func (m *ApplicationResourcePatchRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationResourcePatchRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceActionRunRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationResourcePatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceActionRunRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6001,7 +15025,7 @@ func (m *ApplicationResourcePatchRequest) Unmarshal(dAtA []byte) error {
 			hasFields[0] |= uint64(0x00000020)
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6026,39 +15050,9 @@ func (m *ApplicationResourcePatchRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Patch = string(dAtA[iNdEx:postIndex])
+			m.Action = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000040)
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PatchType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApplication
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PatchType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000080)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -6094,10 +15088,7 @@ func (m *ApplicationResourcePatchRequest) Unmarshal(dAtA []byte) error {
 		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("kind")
 	}
 	if hasFields[0]&uint64(0x00000040) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("patch")
-	}
-	if hasFields[0]&uint64(0x00000080) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("patchType")
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("action")
 	}
 
 	if iNdEx > l {
@@ -6105,8 +15096,7 @@ func (m *ApplicationResourcePatchRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationResourceDeleteRequest) Unmarshal(dAtA []byte) error {
-	var hasFields [1]uint64
+func (m *ResourceActionsListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6129,17 +15119,17 @@ func (m *ApplicationResourceDeleteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationResourceDeleteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceActionsListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationResourceDeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceActionsListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -6149,146 +15139,78 @@ func (m *ApplicationResourceDeleteRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Name = &s
-			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000001)
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApplication
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Actions = append(m.Actions, v1alpha1.ResourceAction{})
+			if err := m.Actions[len(m.Actions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000002)
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResourceName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000004)
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApplication
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationResourceResponse) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000008)
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApplication
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000010)
-		case 6:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationResourceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationResourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Manifest", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6309,34 +15231,13 @@ func (m *ApplicationResourceDeleteRequest) Unmarshal(dAtA []byte) error {
 			if intStringLen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000020)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			b := bool(v != 0)
-			m.Force = &b
+			m.Manifest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -6354,22 +15255,7 @@ func (m *ApplicationResourceDeleteRequest) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
-	}
-	if hasFields[0]&uint64(0x00000002) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("namespace")
-	}
-	if hasFields[0]&uint64(0x00000004) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("resourceName")
-	}
-	if hasFields[0]&uint64(0x00000008) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("version")
-	}
-	if hasFields[0]&uint64(0x00000010) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("group")
-	}
-	if hasFields[0]&uint64(0x00000020) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("kind")
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("manifest")
 	}
 
 	if iNdEx > l {
@@ -6377,7 +15263,7 @@ func (m *ApplicationResourceDeleteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceActionRunRequest) Unmarshal(dAtA []byte) error {
+func (m *ApplicationPodLogsQuery) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -6401,10 +15287,10 @@ func (m *ResourceActionRunRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceActionRunRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationPodLogsQuery: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceActionRunRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationPodLogsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6470,7 +15356,7 @@ func (m *ResourceActionRunRequest) Unmarshal(dAtA []byte) error {
 			hasFields[0] |= uint64(0x00000002)
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6495,12 +15381,13 @@ func (m *ResourceActionRunRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResourceName = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.PodName = &s
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000004)
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6525,14 +15412,14 @@ func (m *ResourceActionRunRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.Container = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000008)
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SinceSeconds", wireType)
 			}
-			var stringLen uint64
+			m.SinceSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -6542,27 +15429,17 @@ func (m *ResourceActionRunRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.SinceSeconds |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApplication
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Group = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000010)
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SinceTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -6572,129 +15449,30 @@ func (m *ResourceActionRunRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000020)
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApplication
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.SinceTime == nil {
+				m.SinceTime = &v1.Time{}
 			}
-			m.Action = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000040)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplication(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.SinceTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthApplication
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
-	}
-	if hasFields[0]&uint64(0x00000002) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("namespace")
-	}
-	if hasFields[0]&uint64(0x00000004) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("resourceName")
-	}
-	if hasFields[0]&uint64(0x00000008) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("version")
-	}
-	if hasFields[0]&uint64(0x00000010) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("group")
-	}
-	if hasFields[0]&uint64(0x00000020) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("kind")
-	}
-	if hasFields[0]&uint64(0x00000040) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("action")
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResourceActionsListResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplication
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceActionsListResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceActionsListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TailLines", wireType)
 			}
-			var msglen int
+			m.TailLines = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -6703,81 +15481,18 @@ func (m *ResourceActionsListResponse) Unmarshal(dAtA []byte) error {
 					return io.ErrUnexpectedEOF
 				}
 				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthApplication
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Actions = append(m.Actions, v1alpha1.ResourceAction{})
-			if err := m.Actions[len(m.Actions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplication(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthApplication
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationResourceResponse) Unmarshal(dAtA []byte) error {
-	var hasFields [1]uint64
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplication
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationResourceResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationResourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Manifest", wireType)
+				iNdEx++
+				m.TailLines |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var stringLen uint64
+			hasFields[0] |= uint64(0x00000020)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Follow", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -6787,22 +15502,13 @@ func (m *ApplicationResourceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApplication
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Manifest = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000001)
+			m.Follow = bool(v != 0)
+			hasFields[0] |= uint64(0x00000040)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -6820,7 +15526,25 @@ func (m *ApplicationResourceResponse) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("manifest")
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("namespace")
+	}
+	if hasFields[0]&uint64(0x00000004) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("podName")
+	}
+	if hasFields[0]&uint64(0x00000008) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("container")
+	}
+	if hasFields[0]&uint64(0x00000010) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("sinceSeconds")
+	}
+	if hasFields[0]&uint64(0x00000020) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("tailLines")
+	}
+	if hasFields[0]&uint64(0x00000040) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("follow")
 	}
 
 	if iNdEx > l {
@@ -6828,7 +15552,7 @@ func (m *ApplicationResourceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationPodLogsQuery) Unmarshal(dAtA []byte) error {
+func (m *LogEntry) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -6852,15 +15576,15 @@ func (m *ApplicationPodLogsQuery) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationPodLogsQuery: wiretype end group for non-group")
+			return fmt.Errorf("proto: LogEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationPodLogsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LogEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6885,15 +15609,14 @@ func (m *ApplicationPodLogsQuery) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Name = &s
+			m.Content = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000001)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeStamp", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -6903,56 +15626,84 @@ func (m *ApplicationPodLogsQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			if err := m.TimeStamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000002)
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.PodName = &s
-			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000004)
-		case 4:
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("content")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("timeStamp")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperationTerminateRequest) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperationTerminateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperationTerminateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6977,103 +15728,64 @@ func (m *ApplicationPodLogsQuery) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Container = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000008)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SinceSeconds", wireType)
-			}
-			m.SinceSeconds = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SinceSeconds |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			hasFields[0] |= uint64(0x00000010)
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SinceTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			hasFields[0] |= uint64(0x00000001)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SinceTime == nil {
-				m.SinceTime = &v1.Time{}
-			}
-			if err := m.SinceTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TailLines", wireType)
-			}
-			m.TailLines = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TailLines |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperationTerminateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
 			}
-			hasFields[0] |= uint64(0x00000020)
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Follow", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.Follow = bool(v != 0)
-			hasFields[0] |= uint64(0x00000040)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperationTerminateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperationTerminateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -7090,34 +15802,13 @@ func (m *ApplicationPodLogsQuery) Unmarshal(dAtA []byte) error {
 			iNdEx += skippy
 		}
 	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
-	}
-	if hasFields[0]&uint64(0x00000002) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("namespace")
-	}
-	if hasFields[0]&uint64(0x00000004) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("podName")
-	}
-	if hasFields[0]&uint64(0x00000008) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("container")
-	}
-	if hasFields[0]&uint64(0x00000010) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("sinceSeconds")
-	}
-	if hasFields[0]&uint64(0x00000020) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("tailLines")
-	}
-	if hasFields[0]&uint64(0x00000040) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("follow")
-	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *LogEntry) Unmarshal(dAtA []byte) error {
+func (m *ResourcesQuery) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -7141,15 +15832,15 @@ func (m *LogEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LogEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourcesQuery: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LogEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourcesQuery: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7174,14 +15865,15 @@ func (m *LogEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Content = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.ApplicationName = &s
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000001)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeStamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelistKinds", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -7191,84 +15883,82 @@ func (m *LogEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TimeStamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RelistKinds = append(m.RelistKinds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000002)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplication(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kinds", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("content")
-	}
-	if hasFields[0]&uint64(0x00000002) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("timeStamp")
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *OperationTerminateRequest) Unmarshal(dAtA []byte) error {
-	var hasFields [1]uint64
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplication
+			m.Kinds = append(m.Kinds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthStatuses", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OperationTerminateRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperationTerminateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HealthStatuses = append(m.HealthStatuses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7293,10 +15983,29 @@ func (m *OperationTerminateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Name = &s
+			m.Namespaces = append(m.Namespaces, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000001)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orphaned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Orphaned = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -7314,7 +16023,7 @@ func (m *OperationTerminateRequest) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("applicationName")
 	}
 
 	if iNdEx > l {
@@ -7322,7 +16031,7 @@ func (m *OperationTerminateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OperationTerminateResponse) Unmarshal(dAtA []byte) error {
+func (m *ManagedResourcesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7345,12 +16054,43 @@ func (m *OperationTerminateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OperationTerminateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ManagedResourcesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperationTerminateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ManagedResourcesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &v1alpha1.ResourceDiff{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -7373,7 +16113,7 @@ func (m *OperationTerminateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourcesQuery) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSyncReportsQuery) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -7397,15 +16137,15 @@ func (m *ResourcesQuery) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourcesQuery: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSyncReportsQuery: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourcesQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSyncReportsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7431,7 +16171,7 @@ func (m *ResourcesQuery) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.ApplicationName = &s
+			m.Name = &s
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000001)
 		default:
@@ -7451,7 +16191,7 @@ func (m *ResourcesQuery) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("applicationName")
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
 	}
 
 	if iNdEx > l {
@@ -7459,7 +16199,7 @@ func (m *ResourcesQuery) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ManagedResourcesResponse) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSyncReportsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7482,10 +16222,10 @@ func (m *ManagedResourcesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ManagedResourcesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSyncReportsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ManagedResourcesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSyncReportsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7514,7 +16254,7 @@ func (m *ManagedResourcesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, &v1alpha1.ResourceDiff{})
+			m.Items = append(m.Items, &v1alpha1.SyncReport{})
 			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -7647,127 +16387,220 @@ var (
 )
 
 func init() {
-	proto.RegisterFile("server/application/application.proto", fileDescriptor_application_25eb5dc9e619c6e4)
-}
-
-var fileDescriptor_application_25eb5dc9e619c6e4 = []byte{
-	// 1873 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xcf, 0x6f, 0x1c, 0x49,
-	0x15, 0xa6, 0x66, 0x6c, 0xcf, 0xcc, 0x73, 0xd8, 0xcd, 0xd6, 0x6e, 0x42, 0xd3, 0x9e, 0x38, 0xa3,
-	0x4a, 0xe2, 0x38, 0xde, 0xb8, 0x3b, 0x36, 0x01, 0x16, 0x83, 0xb4, 0x1b, 0x6f, 0x82, 0x37, 0x90,
-	0x04, 0x33, 0xce, 0x82, 0x84, 0x84, 0x50, 0x6f, 0x77, 0x79, 0xdc, 0x78, 0xa6, 0xbb, 0xe9, 0xee,
-	0x19, 0x34, 0x44, 0x39, 0xec, 0x0a, 0x21, 0x0e, 0x08, 0x84, 0xe0, 0xb0, 0x20, 0x7e, 0x69, 0xcf,
-	0x70, 0x42, 0x5c, 0x38, 0x70, 0x03, 0xed, 0x11, 0x09, 0xce, 0x11, 0xb2, 0xf8, 0x03, 0x38, 0x71,
-	0x46, 0x55, 0x5d, 0xd5, 0x5d, 0x35, 0x99, 0xe9, 0x99, 0xac, 0x87, 0x43, 0x6e, 0x35, 0xaf, 0xaa,
-	0xdf, 0xfb, 0xde, 0x7b, 0x5f, 0xbd, 0xaa, 0x57, 0x03, 0x97, 0x13, 0x1a, 0x0f, 0x68, 0x6c, 0x3b,
-	0x51, 0xd4, 0xf5, 0x5d, 0x27, 0xf5, 0xc3, 0x40, 0x1d, 0x5b, 0x51, 0x1c, 0xa6, 0x21, 0x5e, 0x56,
-	0x44, 0xe6, 0x2b, 0x9d, 0xb0, 0x13, 0x72, 0xb9, 0xcd, 0x46, 0xd9, 0x12, 0xb3, 0xd9, 0x09, 0xc3,
-	0x4e, 0x97, 0xda, 0x4e, 0xe4, 0xdb, 0x4e, 0x10, 0x84, 0x29, 0x5f, 0x9c, 0x88, 0x59, 0x72, 0xfc,
-	0x5a, 0x62, 0xf9, 0x21, 0x9f, 0x75, 0xc3, 0x98, 0xda, 0x83, 0x2d, 0xbb, 0x43, 0x03, 0x1a, 0x3b,
-	0x29, 0xf5, 0xc4, 0x9a, 0x9b, 0xc5, 0x9a, 0x9e, 0xe3, 0x1e, 0xf9, 0x01, 0x8d, 0x87, 0x76, 0x74,
-	0xdc, 0x61, 0x82, 0xc4, 0xee, 0xd1, 0xd4, 0x19, 0xf7, 0xd5, 0xdd, 0x8e, 0x9f, 0x1e, 0xf5, 0xdf,
-	0xb1, 0xdc, 0xb0, 0x67, 0x3b, 0x31, 0x07, 0xf6, 0x6d, 0x3e, 0xd8, 0x74, 0xbd, 0xe2, 0x6b, 0xd5,
-	0xbd, 0xc1, 0x96, 0xd3, 0x8d, 0x8e, 0x9c, 0xa7, 0x55, 0xed, 0x96, 0xa9, 0x8a, 0x69, 0x14, 0x8a,
-	0x58, 0xf1, 0xa1, 0x9f, 0x86, 0xf1, 0x50, 0x19, 0x66, 0x3a, 0xc8, 0xfb, 0x08, 0xce, 0xde, 0x2a,
-	0x8c, 0x7d, 0xb5, 0x4f, 0xe3, 0x21, 0xc6, 0xb0, 0x10, 0x38, 0x3d, 0x6a, 0xa0, 0x16, 0x5a, 0x6f,
-	0xb4, 0xf9, 0x18, 0x1b, 0x50, 0x8b, 0xe9, 0x61, 0x4c, 0x93, 0x23, 0xa3, 0xc2, 0xc5, 0xf2, 0x27,
-	0x5e, 0x83, 0x1a, 0xb3, 0x4c, 0xdd, 0xd4, 0xa8, 0xb6, 0xaa, 0xeb, 0x8d, 0xdd, 0x33, 0x27, 0x4f,
-	0x2e, 0xd6, 0xf7, 0x33, 0x51, 0xd2, 0x96, 0x93, 0xd8, 0x82, 0x17, 0x63, 0x9a, 0x84, 0xfd, 0xd8,
-	0xa5, 0x5f, 0xa3, 0x71, 0xe2, 0x87, 0x81, 0xb1, 0xc0, 0x34, 0xed, 0x2e, 0x7c, 0xf8, 0xe4, 0xe2,
-	0xc7, 0xda, 0xa3, 0x93, 0x64, 0x0f, 0xce, 0xb5, 0xe9, 0xc0, 0x67, 0xe3, 0xfb, 0x34, 0x75, 0x3c,
-	0x27, 0x75, 0x46, 0xe1, 0x55, 0x72, 0x78, 0x26, 0xd4, 0x63, 0xb1, 0xd8, 0xa8, 0x70, 0x79, 0xfe,
-	0x9b, 0xfc, 0x19, 0xc1, 0xaa, 0xe2, 0x63, 0x5b, 0xd8, 0xb9, 0x33, 0xa0, 0x41, 0x9a, 0x4c, 0x56,
-	0xb9, 0x0d, 0x2f, 0x49, 0x48, 0x0f, 0x9c, 0x1e, 0x4d, 0x22, 0xc7, 0xa5, 0x99, 0x6e, 0x81, 0xf8,
-	0xe9, 0x69, 0xbc, 0x0e, 0x67, 0x54, 0xa1, 0x51, 0x55, 0x96, 0x6b, 0x33, 0x78, 0x0d, 0x96, 0xe5,
-	0xef, 0xb7, 0xef, 0xde, 0x36, 0x16, 0x94, 0x85, 0xea, 0x04, 0xd9, 0x07, 0x43, 0xc1, 0x7e, 0xdf,
-	0x09, 0xfc, 0x43, 0x9a, 0xa4, 0x93, 0x51, 0xb7, 0xb4, 0x40, 0x14, 0xe1, 0x2d, 0xc2, 0x71, 0x0e,
-	0x5e, 0xd6, 0xa3, 0x11, 0x85, 0x41, 0x42, 0xc9, 0x07, 0x48, 0xb3, 0xf4, 0x66, 0x4c, 0x9d, 0x94,
-	0xb6, 0xe9, 0x77, 0xfa, 0x34, 0x49, 0x71, 0x00, 0xea, 0x96, 0xe2, 0x06, 0x97, 0xb7, 0xbf, 0x68,
-	0x15, 0x04, 0xb4, 0x24, 0x01, 0xf9, 0xe0, 0x5b, 0xae, 0x67, 0x45, 0xc7, 0x1d, 0x8b, 0x71, 0xd9,
-	0x52, 0xb7, 0xa7, 0xe4, 0xb2, 0xa5, 0x58, 0x92, 0x5e, 0x2b, 0xeb, 0xf0, 0x79, 0x58, 0xea, 0x47,
-	0x09, 0x8d, 0x53, 0xee, 0x43, 0xbd, 0x2d, 0x7e, 0x91, 0xef, 0xeb, 0x20, 0xdf, 0x8e, 0x3c, 0x05,
-	0xe4, 0xd1, 0xff, 0x11, 0xa4, 0x06, 0x8f, 0xbc, 0xa5, 0xa1, 0xb8, 0x4d, 0xbb, 0xb4, 0x40, 0x31,
-	0x2e, 0x29, 0x06, 0xd4, 0x5c, 0x27, 0x71, 0x1d, 0x8f, 0x0a, 0x7f, 0xe4, 0x4f, 0xf2, 0x6e, 0x15,
-	0xce, 0x2b, 0xaa, 0x0e, 0x86, 0x81, 0x5b, 0xa6, 0x68, 0x6a, 0x76, 0x71, 0x13, 0x96, 0xbc, 0x78,
-	0xd8, 0xee, 0x07, 0x46, 0x95, 0x59, 0x12, 0xf3, 0x42, 0x86, 0x4d, 0x58, 0x8c, 0xe2, 0x7e, 0x40,
-	0xf9, 0xce, 0x93, 0x93, 0x99, 0x08, 0xbb, 0x50, 0x4f, 0x52, 0x56, 0x5f, 0x3a, 0x43, 0x63, 0xb1,
-	0x85, 0xd6, 0x97, 0xb7, 0xf7, 0x4e, 0x11, 0x3b, 0xe6, 0xc9, 0x81, 0x50, 0xd7, 0xce, 0x15, 0xe3,
-	0x14, 0x1a, 0x92, 0xdd, 0x89, 0x51, 0x6b, 0x55, 0xd7, 0x97, 0xb7, 0xf7, 0x4f, 0x69, 0xe5, 0x2b,
-	0x11, 0xab, 0x8a, 0xca, 0xc6, 0x16, 0x6e, 0x15, 0x86, 0x70, 0x13, 0x1a, 0x3d, 0xb1, 0x73, 0x12,
-	0xa3, 0xce, 0x8a, 0x54, 0xbb, 0x10, 0xb0, 0x1a, 0xd8, 0x7c, 0x8a, 0x54, 0x07, 0x11, 0x2d, 0xcd,
-	0x84, 0x07, 0x0b, 0x49, 0x44, 0x5d, 0x5e, 0x10, 0x96, 0xb7, 0xbf, 0x34, 0x1f, 0x96, 0x31, 0xa3,
-	0x02, 0x3d, 0xd7, 0x4e, 0x7a, 0xf0, 0x09, 0x65, 0x7a, 0xdf, 0x49, 0xdd, 0xa3, 0x32, 0x50, 0x2c,
-	0xbd, 0x6c, 0x8d, 0x56, 0xa6, 0x32, 0x11, 0x26, 0xd0, 0xe0, 0x83, 0x87, 0xc3, 0x48, 0xaf, 0x4b,
-	0x85, 0x98, 0xfc, 0x00, 0x81, 0xa9, 0x92, 0x3e, 0xec, 0x76, 0xdf, 0x71, 0xdc, 0xe3, 0x72, 0x93,
-	0x15, 0xdf, 0xe3, 0xf6, 0xaa, 0xbb, 0xc0, 0xf4, 0x9d, 0x3c, 0xb9, 0x58, 0xb9, 0x7b, 0xbb, 0x5d,
-	0xf1, 0xbd, 0x8f, 0xce, 0x45, 0xf2, 0xcf, 0x11, 0x20, 0x22, 0x93, 0x65, 0x40, 0x08, 0x34, 0x82,
-	0xb1, 0x65, 0xba, 0x10, 0x3f, 0x43, 0x79, 0x5e, 0x85, 0xda, 0x20, 0x3f, 0xa4, 0x8a, 0x45, 0x52,
-	0xc8, 0xc0, 0x77, 0xe2, 0xb0, 0x1f, 0x19, 0x8b, 0x6a, 0xa4, 0xb9, 0x08, 0x1b, 0xb0, 0x70, 0xec,
-	0x07, 0x9e, 0xb1, 0xa4, 0x4c, 0x71, 0x09, 0xf9, 0x45, 0x05, 0x2e, 0x8e, 0x71, 0x6b, 0x6a, 0x5e,
-	0x9f, 0x03, 0xdf, 0x0a, 0xee, 0xd5, 0xa6, 0x70, 0xaf, 0x3e, 0x9e, 0x7b, 0xff, 0x45, 0xd0, 0x1a,
-	0x13, 0x9b, 0xe9, 0xc5, 0xf5, 0x39, 0x09, 0xce, 0x61, 0x18, 0xbb, 0xd4, 0xa8, 0xe5, 0x5c, 0x47,
-	0xed, 0x4c, 0x44, 0xfe, 0x83, 0xc0, 0x90, 0xde, 0xde, 0x72, 0xb9, 0xef, 0xfd, 0xe0, 0x79, 0x77,
-	0xb8, 0x09, 0x4b, 0x0e, 0xf7, 0x45, 0xa3, 0x83, 0x90, 0x91, 0x1f, 0x22, 0x58, 0xd1, 0x5d, 0x4e,
-	0xee, 0xf9, 0x49, 0x2a, 0xef, 0x22, 0xd8, 0x87, 0x5a, 0xb6, 0x32, 0x31, 0x10, 0x3f, 0x23, 0xee,
-	0x9e, 0xa2, 0xbe, 0xea, 0x86, 0xa4, 0x7b, 0x42, 0x3f, 0x79, 0x1d, 0x56, 0xc6, 0x16, 0x1a, 0x81,
-	0xa4, 0x05, 0x75, 0x79, 0x50, 0x64, 0x39, 0x90, 0x07, 0xae, 0x94, 0x92, 0xbf, 0x56, 0xf4, 0x1a,
-	0x1d, 0x7a, 0xf7, 0xc2, 0x4e, 0xc9, 0xb5, 0x72, 0x96, 0xec, 0x19, 0x50, 0x8b, 0x42, 0xaf, 0x48,
-	0x5c, 0x5b, 0xfe, 0x64, 0x5f, 0xbb, 0x61, 0x90, 0x3a, 0xac, 0xdb, 0xd0, 0xf2, 0x55, 0x88, 0x59,
-	0xee, 0x13, 0x3f, 0x70, 0xe9, 0x01, 0x75, 0xc3, 0xc0, 0x4b, 0x78, 0xe2, 0xaa, 0x32, 0xf7, 0xea,
-	0x0c, 0x7e, 0x0b, 0x1a, 0xfc, 0xf7, 0x43, 0xbf, 0x47, 0x8d, 0x25, 0x7e, 0xe6, 0x6f, 0x58, 0x59,
-	0x5b, 0x63, 0xa9, 0x6d, 0x4d, 0x11, 0x61, 0xd6, 0xd6, 0x58, 0x83, 0x2d, 0x8b, 0x7d, 0xd1, 0x2e,
-	0x3e, 0x66, 0xb8, 0x52, 0xc7, 0xef, 0xde, 0xf3, 0x03, 0x7e, 0xae, 0x17, 0x06, 0x0b, 0x31, 0xe3,
-	0xc4, 0x61, 0xd8, 0xed, 0x86, 0xdf, 0xe5, 0x25, 0x20, 0x3f, 0x0e, 0x32, 0x19, 0xf9, 0x1e, 0xd4,
-	0xef, 0x85, 0x9d, 0x3b, 0x41, 0x1a, 0x0f, 0x19, 0x27, 0x99, 0x3b, 0x34, 0xd0, 0x83, 0x2e, 0x85,
-	0xf8, 0x01, 0x34, 0x52, 0xbf, 0x47, 0x0f, 0x52, 0xa7, 0x17, 0x89, 0x13, 0xf8, 0x19, 0x70, 0xe7,
-	0xc8, 0xa4, 0x0a, 0x62, 0xc3, 0x27, 0xf3, 0x5b, 0xc4, 0x43, 0x1a, 0xf7, 0xfc, 0xc0, 0x29, 0xad,
-	0x39, 0xa4, 0x09, 0xe6, 0xb8, 0x0f, 0xc4, 0x55, 0xfa, 0x0d, 0x78, 0x41, 0x12, 0x49, 0x10, 0xc1,
-	0x82, 0x17, 0x15, 0x6e, 0x3e, 0xc8, 0xd5, 0x89, 0x4a, 0x30, 0x3a, 0x49, 0x86, 0x60, 0xdc, 0x77,
-	0x02, 0xa7, 0x43, 0xbd, 0x5c, 0x51, 0x4e, 0xc9, 0x6f, 0xc2, 0xa2, 0x9f, 0xd2, 0x9e, 0xdc, 0x1a,
-	0x7b, 0x73, 0xd8, 0x1a, 0xb7, 0xfd, 0xc3, 0xc3, 0x76, 0xa6, 0x75, 0xfb, 0x0f, 0x2b, 0x80, 0xd5,
-	0x2b, 0x09, 0x8d, 0x07, 0xbe, 0x4b, 0xf1, 0x4f, 0x10, 0x2c, 0xb0, 0x3d, 0x8a, 0x2f, 0x68, 0xaa,
-	0x46, 0x7b, 0x47, 0x73, 0x4e, 0x37, 0x21, 0x66, 0x8a, 0x34, 0xdf, 0xfb, 0xc7, 0xbf, 0x7f, 0x56,
-	0x39, 0x8f, 0x5f, 0xe1, 0x7d, 0xf8, 0x60, 0x4b, 0x6d, 0x8b, 0x13, 0xfc, 0x23, 0x04, 0x58, 0x54,
-	0x0d, 0xa5, 0x9f, 0xc3, 0xaf, 0x4e, 0xc2, 0x37, 0xa6, 0xef, 0x33, 0x2f, 0x28, 0xac, 0xb1, 0x58,
-	0xa3, 0xcf, 0x38, 0xc2, 0x17, 0x70, 0x00, 0x1b, 0x1c, 0xc0, 0x65, 0x4c, 0xc6, 0x01, 0xb0, 0x1f,
-	0x31, 0x2a, 0x3c, 0xb6, 0x69, 0x66, 0xf7, 0xb7, 0x08, 0x16, 0xbf, 0xce, 0x4f, 0xbb, 0x29, 0x11,
-	0xda, 0x9f, 0x4f, 0x84, 0xb8, 0x2d, 0x0e, 0x95, 0x5c, 0xe2, 0x30, 0x2f, 0xe0, 0x15, 0x09, 0x33,
-	0x49, 0x63, 0xea, 0xf4, 0x34, 0xb4, 0x37, 0x10, 0xfe, 0x00, 0xc1, 0x52, 0xd6, 0xd6, 0xe1, 0x2b,
-	0x93, 0x20, 0x6a, 0x6d, 0x9f, 0x39, 0xa7, 0xe6, 0x89, 0x5c, 0xe3, 0x00, 0x2f, 0x91, 0xb1, 0x89,
-	0xdc, 0xd1, 0x3a, 0xbf, 0x9f, 0x22, 0xa8, 0xee, 0xd1, 0xa9, 0x34, 0x9b, 0x17, 0xb2, 0xa7, 0x42,
-	0x37, 0x26, 0xc3, 0xf8, 0x6f, 0x08, 0xce, 0x8e, 0x3e, 0x45, 0x60, 0xa2, 0x29, 0x1f, 0xfb, 0x52,
-	0x61, 0x7e, 0xf9, 0x54, 0x7b, 0x53, 0xd7, 0x48, 0x6e, 0x71, 0xa8, 0x9f, 0xc7, 0x9f, 0x2b, 0x23,
-	0xa3, 0xec, 0x03, 0x13, 0xfb, 0x91, 0x1c, 0x3e, 0xe6, 0x6f, 0x51, 0x1c, 0xf3, 0x7b, 0x08, 0xce,
-	0xec, 0xd1, 0x54, 0xbe, 0x22, 0x24, 0x93, 0x79, 0xa0, 0x3d, 0x34, 0x98, 0x4d, 0x4b, 0x79, 0x38,
-	0x92, 0x53, 0x79, 0xb9, 0xdb, 0xe4, 0xc0, 0xae, 0xe2, 0x2b, 0x65, 0xc0, 0xf2, 0x76, 0x0b, 0xff,
-	0x05, 0xc1, 0x52, 0xd6, 0x63, 0x4d, 0x36, 0xaf, 0x35, 0xf6, 0x73, 0x4b, 0xf6, 0x1d, 0x0e, 0xf4,
-	0x75, 0xf3, 0xc6, 0x78, 0xa0, 0xea, 0xf7, 0x32, 0x64, 0x16, 0x47, 0xaf, 0x53, 0xf4, 0x8f, 0x08,
-	0xa0, 0x68, 0x12, 0xf1, 0xb5, 0x72, 0x27, 0x94, 0x46, 0xd2, 0x9c, 0x63, 0x9b, 0x48, 0x2c, 0xee,
-	0xcc, 0xba, 0xd9, 0x2a, 0x8b, 0x3a, 0x6b, 0x22, 0x77, 0x78, 0x2b, 0x89, 0x7f, 0x8d, 0x60, 0x91,
-	0x37, 0x1a, 0xf8, 0xf2, 0x24, 0xc0, 0x6a, 0x1f, 0x32, 0xb7, 0xa0, 0xaf, 0x71, 0x9c, 0xad, 0xed,
-	0xb2, 0x1d, 0xb6, 0x83, 0x36, 0xf0, 0x00, 0x96, 0xb2, 0xbb, 0xfe, 0x64, 0x56, 0x68, 0xbd, 0x80,
-	0xd9, 0x2a, 0x29, 0xf4, 0x19, 0x31, 0xc5, 0xe6, 0xde, 0x28, 0xdd, 0xdc, 0xbf, 0x43, 0xb0, 0x70,
-	0x30, 0x0c, 0x5c, 0x7c, 0x69, 0x92, 0x3e, 0xe5, 0x51, 0x66, 0x6e, 0x51, 0x79, 0x95, 0x43, 0xbb,
-	0x42, 0xca, 0xb3, 0x37, 0x0c, 0x5c, 0x16, 0x9a, 0xf7, 0x11, 0x9c, 0x1d, 0xbd, 0x0e, 0xe0, 0x95,
-	0x91, 0xfa, 0xa3, 0xde, 0x37, 0x4c, 0x3d, 0x84, 0x93, 0xae, 0x12, 0xe4, 0x0d, 0x8e, 0x62, 0x07,
-	0xbf, 0x36, 0x75, 0x43, 0x3c, 0x90, 0x9b, 0x98, 0x29, 0xda, 0x2c, 0x5e, 0x56, 0xfe, 0x84, 0xe0,
-	0x8c, 0xd4, 0xfb, 0x30, 0xa6, 0xb4, 0x1c, 0xd6, 0x9c, 0xf8, 0xcf, 0x0c, 0x91, 0x2f, 0x70, 0xec,
-	0x9f, 0xc1, 0x37, 0x67, 0xc4, 0x2e, 0x31, 0x6f, 0xa6, 0x0c, 0xe6, 0xef, 0x11, 0xd4, 0xe5, 0xf3,
-	0x06, 0xbe, 0x3a, 0x91, 0x49, 0xfa, 0x03, 0xc8, 0xdc, 0xb2, 0x6f, 0x73, 0xec, 0xd7, 0xc8, 0xe5,
-	0xd2, 0x52, 0x2e, 0x8c, 0x33, 0x06, 0xfc, 0x1c, 0x01, 0xce, 0xef, 0x99, 0xf9, 0xcd, 0x13, 0xaf,
-	0x69, 0xa6, 0x26, 0x5e, 0x61, 0xcd, 0xab, 0x53, 0xd7, 0xe9, 0xa5, 0x7c, 0xa3, 0xb4, 0x94, 0x87,
-	0xb9, 0xfd, 0x1f, 0x23, 0x58, 0xde, 0xa3, 0xf9, 0x0d, 0xac, 0x24, 0x90, 0xfa, 0x03, 0x8e, 0xb9,
-	0x3e, 0x7d, 0xa1, 0x40, 0x74, 0x9d, 0x23, 0x5a, 0xc3, 0xe5, 0xa1, 0x92, 0x00, 0x7e, 0x85, 0xe0,
-	0xe3, 0xa2, 0x8a, 0x09, 0xc9, 0xf5, 0x69, 0x96, 0xb4, 0xa2, 0x37, 0x3b, 0xae, 0x4f, 0x71, 0x5c,
-	0x9b, 0x64, 0x26, 0x5c, 0x3b, 0xe2, 0x1d, 0xe4, 0x37, 0x08, 0x5e, 0x56, 0xaf, 0xac, 0xa2, 0xf7,
-	0xfd, 0xa8, 0x71, 0x2b, 0x69, 0xa1, 0xc9, 0x4d, 0x8e, 0xcf, 0xc2, 0xd7, 0x67, 0xc1, 0x67, 0x8b,
-	0x6e, 0x18, 0xff, 0x12, 0xc1, 0x4b, 0xfc, 0xf5, 0x41, 0x55, 0x3c, 0x52, 0x90, 0x27, 0xbd, 0x55,
-	0xcc, 0x50, 0x90, 0xc5, 0x9e, 0x25, 0xcf, 0x04, 0x6a, 0x47, 0xbc, 0x1a, 0xb0, 0x16, 0xe4, 0x05,
-	0x79, 0x04, 0x88, 0xec, 0x6e, 0x4e, 0x0b, 0xdc, 0xb3, 0x1e, 0x19, 0x82, 0x6e, 0x1b, 0xb3, 0xd1,
-	0xed, 0x5d, 0x04, 0x35, 0xd1, 0xf0, 0x97, 0x9c, 0xaa, 0xca, 0x8b, 0x80, 0x79, 0x4e, 0x5b, 0x25,
-	0x1b, 0x5e, 0xf2, 0x59, 0x6e, 0x76, 0x0b, 0xdb, 0x65, 0x66, 0xa3, 0xd0, 0x4b, 0xec, 0x47, 0xe2,
-	0x25, 0xe0, 0xb1, 0xdd, 0x0d, 0x3b, 0xc9, 0x0d, 0xb4, 0xfb, 0xe6, 0x87, 0x27, 0xab, 0xe8, 0xef,
-	0x27, 0xab, 0xe8, 0x5f, 0x27, 0xab, 0xe8, 0x1b, 0x9f, 0x9e, 0xe1, 0xef, 0x45, 0xb7, 0xeb, 0xd3,
-	0x20, 0x55, 0x4d, 0xfc, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xfb, 0x14, 0xec, 0xfd, 0x57, 0x1d, 0x00,
-	0x00,
+	proto.RegisterFile("server/application/application.proto", fileDescriptor_application_55137f215f842c93)
+}
+
+var fileDescriptor_application_55137f215f842c93 = []byte{
+	// 3371 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5b, 0xcd, 0x8f, 0x1c, 0x47,
+	0x15, 0xa7, 0x66, 0x77, 0x66, 0x67, 0xdf, 0x3a, 0x76, 0x52, 0xb1, 0x9d, 0x49, 0x7b, 0xbd, 0x5e,
+	0xd7, 0xfa, 0x63, 0xed, 0x78, 0x67, 0xec, 0x25, 0x81, 0xc4, 0x44, 0x09, 0x5e, 0xaf, 0xb1, 0x9d,
+	0xd8, 0x61, 0x33, 0xeb, 0x04, 0x04, 0x44, 0xd0, 0xe9, 0xa9, 0x9d, 0xed, 0x6c, 0x4f, 0x77, 0xa7,
+	0xbb, 0x67, 0xcd, 0x26, 0x18, 0x41, 0x64, 0x25, 0x28, 0x42, 0x20, 0x14, 0x04, 0x21, 0x7c, 0x2a,
+	0x47, 0xe0, 0x04, 0xe2, 0xc2, 0x01, 0x71, 0x20, 0x28, 0x47, 0x24, 0x38, 0x21, 0x64, 0x81, 0xc5,
+	0x1f, 0x80, 0x84, 0x94, 0x03, 0x5c, 0x50, 0x55, 0x57, 0x75, 0x57, 0xf5, 0x74, 0xf7, 0x8e, 0xed,
+	0x01, 0x92, 0xdb, 0xf4, 0xab, 0x8f, 0xf7, 0xab, 0xf7, 0x5e, 0xbd, 0xf7, 0xea, 0x55, 0x0d, 0x1c,
+	0x0a, 0x69, 0xb0, 0x49, 0x83, 0x96, 0xe9, 0xfb, 0x8e, 0x6d, 0x99, 0x91, 0xed, 0xb9, 0xea, 0xef,
+	0xa6, 0x1f, 0x78, 0x91, 0x87, 0xa7, 0x14, 0x92, 0xb1, 0xbb, 0xeb, 0x75, 0x3d, 0x4e, 0x6f, 0xb1,
+	0x5f, 0x71, 0x17, 0x63, 0xba, 0xeb, 0x79, 0x5d, 0x87, 0xb6, 0x4c, 0xdf, 0x6e, 0x99, 0xae, 0xeb,
+	0x45, 0xbc, 0x73, 0x28, 0x5a, 0xc9, 0xc6, 0xc3, 0x61, 0xd3, 0xf6, 0x78, 0xab, 0xe5, 0x05, 0xb4,
+	0xb5, 0x79, 0xaa, 0xd5, 0xa5, 0x2e, 0x0d, 0xcc, 0x88, 0x76, 0x44, 0x9f, 0x07, 0xd3, 0x3e, 0x3d,
+	0xd3, 0x5a, 0xb7, 0x5d, 0x1a, 0x6c, 0xb5, 0xfc, 0x8d, 0x2e, 0x23, 0x84, 0xad, 0x1e, 0x8d, 0xcc,
+	0xbc, 0x51, 0x17, 0xbb, 0x76, 0xb4, 0xde, 0x7f, 0xbe, 0x69, 0x79, 0xbd, 0x96, 0x19, 0x70, 0x60,
+	0x2f, 0xf0, 0x1f, 0x0b, 0x56, 0x27, 0x1d, 0xad, 0x2e, 0x6f, 0xf3, 0x94, 0xe9, 0xf8, 0xeb, 0xe6,
+	0xe0, 0x54, 0x4b, 0x65, 0x53, 0x05, 0xd4, 0xf7, 0x84, 0xac, 0xf8, 0x4f, 0x3b, 0xf2, 0x82, 0x2d,
+	0xe5, 0x67, 0x3c, 0x07, 0x79, 0x0f, 0xc1, 0xdd, 0x67, 0x52, 0x66, 0x4f, 0xf7, 0x69, 0xb0, 0x85,
+	0x31, 0x8c, 0xbb, 0x66, 0x8f, 0x36, 0xd0, 0x2c, 0x9a, 0x9f, 0x6c, 0xf3, 0xdf, 0xb8, 0x01, 0x13,
+	0x01, 0x5d, 0x0b, 0x68, 0xb8, 0xde, 0xa8, 0x70, 0xb2, 0xfc, 0xc4, 0x47, 0x60, 0x82, 0x71, 0xa6,
+	0x56, 0xd4, 0x18, 0x9b, 0x1d, 0x9b, 0x9f, 0x5c, 0xda, 0x71, 0xf3, 0xc6, 0x81, 0xfa, 0x4a, 0x4c,
+	0x0a, 0xdb, 0xb2, 0x11, 0x37, 0x61, 0x57, 0x40, 0x43, 0xaf, 0x1f, 0x58, 0xf4, 0x59, 0x1a, 0x84,
+	0xb6, 0xe7, 0x36, 0xc6, 0xd9, 0x4c, 0x4b, 0xe3, 0xef, 0xde, 0x38, 0xf0, 0xa1, 0x76, 0xb6, 0x11,
+	0x4f, 0x43, 0x2d, 0xa4, 0x66, 0x60, 0xad, 0x37, 0xaa, 0x4a, 0x37, 0x41, 0xc3, 0xb3, 0x50, 0x0f,
+	0xa9, 0x43, 0xad, 0xc8, 0x0b, 0x1a, 0x35, 0xa5, 0x3d, 0xa1, 0xf2, 0xf1, 0x5e, 0x10, 0x2d, 0x6d,
+	0x35, 0x26, 0xb4, 0xf1, 0x9c, 0x46, 0xce, 0xc3, 0x9e, 0x36, 0xdd, 0xb4, 0x19, 0xa7, 0xcb, 0x34,
+	0x32, 0x3b, 0x66, 0x64, 0x66, 0x17, 0x5f, 0x49, 0x16, 0x6f, 0x40, 0x3d, 0x10, 0x9d, 0x1b, 0x15,
+	0x4e, 0x4f, 0xbe, 0x09, 0x85, 0x83, 0x8a, 0x00, 0xe5, 0x9c, 0x67, 0xd7, 0x4d, 0xb7, 0x4b, 0x1d,
+	0xaf, 0x5b, 0x3c, 0xe9, 0x09, 0xd8, 0x19, 0x99, 0x41, 0x97, 0x46, 0xed, 0x74, 0xea, 0x14, 0x67,
+	0xa6, 0x8d, 0xfc, 0x1a, 0xc1, 0x8c, 0xc6, 0x27, 0x16, 0xd6, 0xb9, 0x4d, 0xea, 0x46, 0x61, 0x31,
+	0x93, 0x45, 0xb8, 0x47, 0xca, 0xf5, 0x29, 0xb3, 0x47, 0x43, 0xdf, 0xb4, 0x68, 0xbc, 0x04, 0xc1,
+	0x67, 0xb0, 0x19, 0xcf, 0xc3, 0x0e, 0x95, 0xd8, 0x18, 0x53, 0xba, 0x6b, 0x2d, 0xf8, 0x08, 0x4c,
+	0xc9, 0xef, 0x67, 0x2e, 0x2e, 0x37, 0xc6, 0x95, 0x8e, 0x6a, 0x03, 0xf9, 0x32, 0xec, 0x53, 0xb0,
+	0x73, 0xcc, 0xab, 0x51, 0x40, 0xcd, 0x5e, 0x0c, 0xbc, 0xa1, 0xda, 0x9b, 0x18, 0x1f, 0xc3, 0x57,
+	0x6c, 0xab, 0x52, 0x66, 0x5b, 0xfb, 0x61, 0x3c, 0xda, 0xf2, 0xa9, 0x30, 0xc0, 0xc9, 0x9b, 0x37,
+	0x0e, 0x54, 0xaf, 0x6c, 0xf9, 0x34, 0x6c, 0x73, 0x72, 0xd6, 0xca, 0x39, 0x00, 0xc6, 0x95, 0x8f,
+	0x41, 0x0a, 0x6a, 0x4e, 0x61, 0xcb, 0x52, 0x36, 0xa0, 0x26, 0x2e, 0xb5, 0x01, 0xcf, 0xa8, 0x96,
+	0x9f, 0xf6, 0x49, 0x50, 0xcd, 0xc0, 0x44, 0x8f, 0x86, 0xa1, 0xd9, 0xa5, 0x9a, 0xa5, 0x4b, 0x22,
+	0xb3, 0xe1, 0xc4, 0xac, 0x54, 0x1b, 0x4f, 0xa8, 0xf8, 0x31, 0x18, 0x8f, 0xec, 0x1e, 0xe5, 0x16,
+	0x3e, 0xb5, 0x78, 0xbc, 0x19, 0xbb, 0x9c, 0xa6, 0xea, 0x72, 0x9a, 0xfe, 0x46, 0x97, 0x11, 0xc2,
+	0x26, 0x73, 0x39, 0xcd, 0xcd, 0x53, 0xcd, 0x2b, 0x76, 0x8f, 0xb6, 0xf9, 0x38, 0xb2, 0x02, 0x0d,
+	0x65, 0xdd, 0x97, 0x4d, 0xd7, 0x5e, 0xa3, 0x61, 0x54, 0x6c, 0x2e, 0xb3, 0x9a, 0xa1, 0xe7, 0x20,
+	0x22, 0x57, 0x60, 0x56, 0x9f, 0xd1, 0xec, 0xd2, 0x8e, 0x9c, 0xb8, 0xc4, 0x10, 0xf9, 0x6e, 0x64,
+	0xf6, 0xa0, 0xcd, 0x2b, 0x68, 0xe4, 0x22, 0xcc, 0x95, 0xcc, 0xda, 0xa6, 0xa1, 0xef, 0xb9, 0x21,
+	0xc5, 0x04, 0x26, 0x7b, 0x92, 0xa8, 0x59, 0x4b, 0x4a, 0x26, 0x4f, 0xc3, 0xfd, 0xca, 0x54, 0x2b,
+	0x0c, 0x38, 0xbd, 0xda, 0xa6, 0x2f, 0xf6, 0x69, 0x18, 0xdd, 0xe6, 0x9a, 0xff, 0x8c, 0x98, 0xb3,
+	0x88, 0xa1, 0x26, 0x13, 0x86, 0x7d, 0x27, 0xc2, 0x06, 0x54, 0xbb, 0x81, 0xd7, 0xf7, 0x35, 0x23,
+	0x8a, 0x49, 0xcc, 0xbe, 0x36, 0x6c, 0xb7, 0xa3, 0x99, 0x0f, 0xa7, 0xb0, 0x65, 0xb8, 0xc9, 0x66,
+	0x54, 0x2d, 0x27, 0x25, 0x27, 0x7b, 0x42, 0xdd, 0x53, 0x31, 0xde, 0x05, 0xa8, 0x85, 0x91, 0x19,
+	0xf5, 0xc3, 0x46, 0x95, 0xb7, 0xed, 0x61, 0x6d, 0xff, 0xba, 0x71, 0xe0, 0x2e, 0x01, 0x6d, 0x95,
+	0x37, 0xb6, 0x45, 0x27, 0xd5, 0x08, 0x6b, 0x39, 0x46, 0x48, 0x3e, 0x07, 0x46, 0x9e, 0xbc, 0x84,
+	0xc4, 0x1f, 0x83, 0xaa, 0x1d, 0xd1, 0x1e, 0x93, 0xf6, 0xd8, 0xfc, 0xd4, 0x22, 0x69, 0xaa, 0xc1,
+	0x36, 0x57, 0x26, 0x52, 0x08, 0x7c, 0x18, 0x59, 0x84, 0xbd, 0xb2, 0xd7, 0x59, 0xcf, 0x5d, 0x73,
+	0x6c, 0x4b, 0x1a, 0x49, 0xe1, 0xa6, 0x27, 0xaf, 0x57, 0xe0, 0xee, 0xec, 0xa0, 0x38, 0x1a, 0xb0,
+	0x70, 0xa6, 0x89, 0x5a, 0xd0, 0x52, 0x3d, 0x54, 0x8a, 0xf5, 0x30, 0x56, 0xae, 0x87, 0xf1, 0x72,
+	0x3d, 0x54, 0x07, 0xf4, 0x90, 0xf1, 0x12, 0xb5, 0x22, 0x2f, 0xf1, 0x28, 0xec, 0xb5, 0xc4, 0x2a,
+	0x6c, 0xb7, 0xab, 0xc8, 0xba, 0x31, 0xa1, 0x0c, 0x29, 0xe8, 0x43, 0x9e, 0x86, 0xdd, 0x59, 0x59,
+	0x5c, 0xb2, 0xc3, 0x08, 0x3f, 0xa2, 0x2b, 0x66, 0x7f, 0xae, 0x62, 0xe4, 0x08, 0x5d, 0x27, 0xdf,
+	0x45, 0x9a, 0x3b, 0x5e, 0x0e, 0xec, 0xb5, 0xa8, 0x4d, 0x7d, 0x2f, 0x10, 0x8e, 0x41, 0x71, 0x6b,
+	0x28, 0xcf, 0xad, 0x19, 0x50, 0x0d, 0x6d, 0x37, 0xb3, 0x93, 0x63, 0x12, 0x6b, 0x73, 0xec, 0x9e,
+	0xcd, 0x1c, 0x22, 0x9a, 0x1f, 0x93, 0x6d, 0x9c, 0xc4, 0x36, 0x9a, 0xe5, 0xb9, 0x91, 0xed, 0xf6,
+	0x75, 0x7f, 0x98, 0x50, 0xc9, 0xdf, 0x2a, 0xb0, 0x8b, 0xc3, 0xa1, 0x1d, 0xb9, 0x84, 0xac, 0x98,
+	0x51, 0x91, 0x98, 0xff, 0x1f, 0x26, 0xb0, 0x17, 0x6a, 0x6b, 0x36, 0x75, 0x3a, 0x61, 0xa3, 0xc6,
+	0x02, 0x4f, 0x5b, 0x7c, 0xf1, 0x3d, 0x67, 0x87, 0xa1, 0xed, 0x76, 0x79, 0xee, 0x51, 0x4f, 0xf6,
+	0x5c, 0x4c, 0x8c, 0x53, 0xa1, 0x17, 0xfb, 0x76, 0x40, 0xc3, 0x95, 0xa0, 0xef, 0xb2, 0x7e, 0x75,
+	0xa5, 0x5f, 0xb6, 0x11, 0x3f, 0x01, 0xd0, 0xa1, 0x11, 0xb5, 0x22, 0xda, 0x39, 0x13, 0x35, 0x26,
+	0x6f, 0x39, 0x18, 0x28, 0xa3, 0x49, 0x04, 0x7b, 0xf3, 0x95, 0x8f, 0x1f, 0xd6, 0x4d, 0x6a, 0x5a,
+	0x33, 0xa9, 0x8c, 0x5a, 0x34, 0x8b, 0xd2, 0x34, 0x5b, 0xc9, 0xd5, 0xec, 0x17, 0x60, 0x5a, 0xe1,
+	0x1a, 0xbb, 0xa8, 0xcb, 0x66, 0x14, 0xd8, 0x5f, 0x8c, 0x6d, 0x4e, 0x4d, 0xe7, 0x50, 0x6e, 0x3a,
+	0x37, 0x03, 0x13, 0x5c, 0x99, 0x4b, 0x5b, 0x1a, 0x0b, 0x49, 0x24, 0x9f, 0x86, 0x99, 0x02, 0x0e,
+	0x67, 0x9d, 0x7e, 0x18, 0xd1, 0x60, 0x1b, 0x17, 0x22, 0xb5, 0x5c, 0x19, 0xf0, 0x47, 0xff, 0xd6,
+	0xf7, 0x8b, 0x36, 0x35, 0x75, 0x1c, 0x86, 0xcc, 0x8a, 0x59, 0xf0, 0x89, 0xab, 0x12, 0x99, 0x20,
+	0x0e, 0x9d, 0x4e, 0x64, 0xc2, 0x02, 0xca, 0xb3, 0xc5, 0x43, 0x00, 0xe1, 0x96, 0x6b, 0xc5, 0x18,
+	0xb4, 0x5d, 0xa4, 0xd0, 0x59, 0x06, 0xb7, 0x4e, 0x4d, 0x27, 0x5a, 0x5f, 0x95, 0x81, 0x22, 0xed,
+	0xa7, 0xb5, 0x68, 0xc1, 0xaf, 0x96, 0x1b, 0xfc, 0xbe, 0xa4, 0xc5, 0x07, 0x75, 0xf1, 0x6d, 0xef,
+	0xaa, 0xe2, 0xc5, 0xb3, 0x7b, 0x63, 0x19, 0xaa, 0x16, 0x75, 0x9c, 0x90, 0x27, 0x6e, 0x53, 0x8b,
+	0xf3, 0x9a, 0x35, 0x95, 0x88, 0x53, 0x5a, 0x16, 0x1f, 0x4c, 0x7e, 0x86, 0xe0, 0xbe, 0x82, 0xce,
+	0xf8, 0x32, 0xd4, 0x85, 0x88, 0xa5, 0xc9, 0x3e, 0x30, 0x14, 0x93, 0x78, 0x4c, 0x62, 0xa2, 0x62,
+	0x0a, 0x7c, 0x06, 0xc6, 0x03, 0xef, 0xaa, 0xc4, 0x7b, 0x74, 0x98, 0xa9, 0xda, 0xde, 0x55, 0xb9,
+	0x66, 0x36, 0x94, 0xbc, 0x8e, 0xb4, 0xcd, 0xb5, 0xd4, 0x77, 0x36, 0x64, 0xe6, 0xb1, 0x1b, 0xaa,
+	0x5c, 0x8b, 0x1c, 0xe9, 0x64, 0x3b, 0xfe, 0xd0, 0xcc, 0xbe, 0x52, 0x64, 0xf6, 0xf2, 0xdc, 0xa5,
+	0x9a, 0x84, 0x24, 0xb2, 0x73, 0x99, 0x65, 0x86, 0x96, 0xd9, 0x89, 0x7d, 0x6a, 0xbd, 0x2d, 0x3f,
+	0xc9, 0x3f, 0x11, 0x18, 0x19, 0x30, 0xab, 0x5b, 0xae, 0x75, 0xa7, 0x80, 0xa6, 0xa1, 0xd6, 0x09,
+	0xb6, 0xda, 0x7d, 0xb7, 0x31, 0xa6, 0xb8, 0x2c, 0x41, 0x63, 0x5e, 0xd8, 0x0f, 0xfa, 0xae, 0x00,
+	0x23, 0x75, 0xc9, 0x49, 0xd8, 0x82, 0x7a, 0x18, 0x05, 0x66, 0x44, 0xbb, 0x5b, 0xdc, 0x22, 0xa7,
+	0x16, 0xcf, 0x37, 0xd3, 0x23, 0x6c, 0x53, 0x1e, 0x61, 0xf9, 0x8f, 0xcf, 0x5b, 0x9d, 0xd4, 0x97,
+	0xa9, 0x9a, 0x90, 0xa7, 0xe1, 0xe6, 0x2a, 0x37, 0xf7, 0x78, 0xba, 0x76, 0x32, 0x31, 0x3b, 0xd7,
+	0x0d, 0x68, 0x80, 0xa7, 0x6a, 0xf9, 0xe7, 0xba, 0x2a, 0x0d, 0x82, 0xcc, 0x52, 0x63, 0x12, 0x79,
+	0x0e, 0xee, 0x1b, 0x9c, 0x28, 0x4e, 0x8a, 0x96, 0x98, 0x4e, 0xd8, 0xa4, 0xf9, 0x69, 0x51, 0x2e,
+	0xff, 0x54, 0x6f, 0x7c, 0x20, 0xd9, 0x03, 0xf7, 0xea, 0xc7, 0x39, 0x3e, 0x35, 0x79, 0x1b, 0x69,
+	0x19, 0xfb, 0xd9, 0x80, 0x9a, 0x11, 0x95, 0x2a, 0x73, 0x07, 0x43, 0xe1, 0xd4, 0xe2, 0x27, 0xee,
+	0x40, 0x86, 0x2a, 0xd2, 0x1c, 0x87, 0xb4, 0x17, 0x6a, 0x7d, 0x3f, 0xa4, 0x41, 0xc4, 0xe5, 0x53,
+	0x6f, 0x8b, 0x2f, 0x72, 0x5d, 0x07, 0xf9, 0x8c, 0xdf, 0x51, 0x40, 0xae, 0xff, 0x17, 0x41, 0x6a,
+	0xf0, 0xc8, 0x05, 0x0d, 0xc5, 0x32, 0x75, 0x68, 0x8a, 0x22, 0x4f, 0xdb, 0xca, 0x56, 0xa9, 0xe8,
+	0x5b, 0xe5, 0x9d, 0x31, 0x6d, 0xdf, 0xaa, 0xdb, 0xe4, 0xb6, 0x4e, 0x0c, 0xef, 0xf3, 0x4d, 0x82,
+	0x23, 0x98, 0x94, 0xc7, 0xf3, 0xb0, 0x31, 0xc1, 0x4d, 0x78, 0xe5, 0x0e, 0xb9, 0x7c, 0xd2, 0xa7,
+	0x81, 0x56, 0x99, 0x90, 0xb1, 0x2b, 0x61, 0x84, 0xa7, 0xd5, 0xd3, 0x5b, 0x9d, 0x7b, 0x9d, 0x94,
+	0xc0, 0x84, 0x62, 0x76, 0x3c, 0x3f, 0x4e, 0x6f, 0x12, 0xa1, 0x70, 0x12, 0x8b, 0xa0, 0x5c, 0x3a,
+	0x67, 0x82, 0xae, 0x77, 0x76, 0xb9, 0x01, 0x4a, 0x0f, 0xb5, 0x81, 0xbc, 0x89, 0x60, 0x7a, 0xc0,
+	0x30, 0x57, 0x7d, 0x5a, 0xaa, 0xcd, 0x0e, 0x8c, 0x87, 0x3e, 0xb5, 0x78, 0x5c, 0x9e, 0x5a, 0x7c,
+	0x62, 0x34, 0x96, 0xca, 0x98, 0xca, 0xd0, 0xc0, 0x66, 0x27, 0x6f, 0xe9, 0xf5, 0x9b, 0x67, 0x4d,
+	0xc7, 0x7e, 0xff, 0x80, 0x7b, 0x01, 0x76, 0x8b, 0x9a, 0x4a, 0xbb, 0xef, 0xd0, 0x67, 0x6d, 0xcf,
+	0x89, 0x1d, 0x40, 0x03, 0xc6, 0x83, 0xbe, 0x93, 0x89, 0xee, 0x8c, 0xa2, 0x9e, 0x2a, 0xd5, 0x7c,
+	0x46, 0x12, 0xd9, 0x5e, 0x33, 0x1d, 0xc7, 0xbb, 0x4a, 0x3b, 0x71, 0x4d, 0xa6, 0x2d, 0x3f, 0xc9,
+	0x0b, 0x70, 0xa0, 0x50, 0x0e, 0xc2, 0xbf, 0x9e, 0x07, 0xd8, 0x94, 0x18, 0xa4, 0x8b, 0x3d, 0xa8,
+	0xad, 0x2a, 0x0f, 0xad, 0xcc, 0x83, 0xd2, 0xa1, 0xa4, 0xa7, 0xf9, 0xf0, 0x15, 0x33, 0xb2, 0xd6,
+	0xcb, 0x84, 0xcd, 0xf6, 0x25, 0xeb, 0xa3, 0x1f, 0x21, 0x38, 0x89, 0x25, 0x67, 0xfc, 0xc7, 0x95,
+	0xb8, 0xcc, 0x94, 0xb6, 0xa7, 0x64, 0xf2, 0xaa, 0x1e, 0x71, 0xdb, 0x9e, 0xe3, 0x3c, 0x6f, 0x5a,
+	0x1b, 0xe5, 0x2c, 0x2b, 0x76, 0x5c, 0x22, 0x18, 0x5b, 0x02, 0x36, 0xdf, 0xcd, 0x1b, 0x07, 0x2a,
+	0x17, 0x97, 0xdb, 0x15, 0xbb, 0x73, 0xfb, 0x4e, 0x84, 0xbc, 0x59, 0x81, 0x99, 0x81, 0x7d, 0x70,
+	0xb1, 0x67, 0x76, 0x69, 0x58, 0x06, 0x66, 0x13, 0x76, 0xae, 0x53, 0xa7, 0xb7, 0x62, 0x06, 0x66,
+	0x8f, 0xf2, 0xb4, 0x2a, 0xce, 0x85, 0x2e, 0xdc, 0x81, 0xd9, 0x5d, 0x50, 0x27, 0x94, 0xb5, 0x4d,
+	0x9d, 0x0b, 0x9e, 0x87, 0x5d, 0x1b, 0xfd, 0x30, 0xf2, 0x7a, 0xf6, 0x4b, 0x02, 0xa5, 0x30, 0x9a,
+	0x2c, 0x99, 0x69, 0xe1, 0x6a, 0x60, 0x47, 0x74, 0xc9, 0xb4, 0x36, 0xb4, 0x85, 0xa7, 0x64, 0x45,
+	0x6c, 0xd5, 0x41, 0xb1, 0x91, 0x3f, 0x65, 0x74, 0x24, 0xbc, 0x53, 0x99, 0x58, 0xb4, 0xbc, 0xbc,
+	0x92, 0x7f, 0x46, 0x1c, 0xbe, 0x66, 0x3a, 0x03, 0x13, 0x9b, 0x49, 0xf9, 0x5b, 0xd9, 0x39, 0x82,
+	0x98, 0x9e, 0x63, 0xab, 0xc5, 0xe7, 0xd8, 0x5a, 0xf6, 0x1c, 0x4b, 0xbe, 0x57, 0x81, 0x03, 0x39,
+	0xcb, 0xda, 0xd6, 0xe4, 0x3f, 0x00, 0x6b, 0x4b, 0xb7, 0xe5, 0xc4, 0x36, 0xdb, 0xb2, 0x9e, 0xbf,
+	0x2d, 0xdf, 0x43, 0x30, 0x9b, 0x23, 0x9b, 0xed, 0x13, 0x86, 0x0f, 0x88, 0x70, 0xd6, 0x3c, 0x56,
+	0x56, 0x4d, 0x0b, 0x0d, 0xa8, 0x1d, 0x93, 0xc8, 0x3f, 0x10, 0x34, 0xe4, 0x6a, 0xcf, 0x58, 0x7c,
+	0xed, 0x7d, 0xf7, 0x83, 0xbe, 0xe0, 0x69, 0xa8, 0x99, 0xd6, 0x40, 0xf9, 0x4c, 0xd0, 0xc8, 0xd7,
+	0x10, 0xec, 0xd3, 0x97, 0x1c, 0xb2, 0x72, 0x59, 0x12, 0x5a, 0x6c, 0x98, 0x30, 0x2d, 0x35, 0xae,
+	0x5c, 0xbc, 0x03, 0xdf, 0xa6, 0x33, 0x92, 0xcb, 0x13, 0xf3, 0x93, 0xc7, 0xb5, 0xaa, 0x41, 0xea,
+	0x68, 0x04, 0x92, 0x59, 0xa8, 0xcb, 0xe4, 0x47, 0x8b, 0xaf, 0x09, 0x95, 0xbc, 0x53, 0xd1, 0xc3,
+	0x97, 0xd7, 0xb9, 0xe4, 0x75, 0x4b, 0x4a, 0xec, 0xc3, 0x68, 0xaf, 0x01, 0x13, 0xbe, 0xd7, 0x49,
+	0x15, 0xd7, 0x96, 0x9f, 0x6c, 0xb4, 0xe5, 0xb9, 0x91, 0x69, 0xbb, 0x34, 0xd0, 0x2b, 0x61, 0x09,
+	0x99, 0xe9, 0x9e, 0x97, 0xf9, 0x56, 0xa9, 0xe5, 0xb9, 0x9d, 0xb8, 0x00, 0x2d, 0x8b, 0x7c, 0x5a,
+	0x0b, 0xbe, 0x00, 0x93, 0xfc, 0xfb, 0xca, 0xed, 0xdd, 0x5e, 0xa4, 0x83, 0x19, 0xae, 0xc8, 0xb4,
+	0x9d, 0x4b, 0xb6, 0xcb, 0x73, 0xd5, 0x94, 0x61, 0x4a, 0x66, 0x36, 0xb1, 0xe6, 0xb1, 0xfc, 0x82,
+	0xbb, 0x80, 0xc4, 0xe5, 0xc7, 0x34, 0xf2, 0x12, 0xd4, 0x2f, 0x79, 0xdd, 0x73, 0x6e, 0x14, 0xd7,
+	0x36, 0xd9, 0x72, 0xa8, 0x9b, 0xa9, 0x6d, 0x0a, 0x22, 0x7e, 0x0a, 0x26, 0x23, 0xbb, 0x47, 0x57,
+	0x23, 0xb3, 0xe7, 0x8b, 0xa4, 0xeb, 0x16, 0x70, 0x27, 0xc8, 0xe4, 0x14, 0xa4, 0x05, 0xf7, 0x27,
+	0x99, 0xf1, 0x15, 0x1a, 0xf4, 0x6c, 0xd7, 0x2c, 0xf5, 0x39, 0x64, 0x1a, 0x8c, 0xbc, 0x01, 0xe2,
+	0x78, 0xf8, 0x17, 0x04, 0x3b, 0xa5, 0x25, 0x09, 0x4b, 0x68, 0xc2, 0x2e, 0xc5, 0x38, 0x9f, 0xd2,
+	0x8b, 0x31, 0xa8, 0x9d, 0x6d, 0xc4, 0xb3, 0xec, 0xce, 0xce, 0xb1, 0xc3, 0xe8, 0x49, 0xdb, 0xed,
+	0xc4, 0x11, 0x7e, 0xb2, 0xad, 0x92, 0x58, 0x65, 0x60, 0x83, 0xb7, 0xc5, 0x41, 0x38, 0xfe, 0xc0,
+	0x47, 0x60, 0xa7, 0x5a, 0x39, 0xa2, 0xac, 0xfa, 0xc4, 0x9a, 0x33, 0x54, 0x3c, 0x03, 0x90, 0x98,
+	0x1b, 0xb3, 0x10, 0xd6, 0x47, 0xa1, 0xb0, 0xbb, 0x54, 0x2f, 0xf0, 0xd7, 0x4d, 0x97, 0x76, 0xb8,
+	0x61, 0xd4, 0xdb, 0xc9, 0x37, 0xd9, 0x82, 0x86, 0xb8, 0xfb, 0x49, 0x16, 0x99, 0xec, 0x97, 0xe7,
+	0xf4, 0xea, 0xe4, 0xf9, 0x11, 0xec, 0xdb, 0x65, 0x7b, 0x6d, 0x4d, 0x16, 0xc5, 0x4f, 0xc1, 0xbe,
+	0x81, 0x13, 0xa0, 0xef, 0x05, 0x25, 0x57, 0x5a, 0xe4, 0x1a, 0xcc, 0xe4, 0x0f, 0x49, 0x30, 0x7f,
+	0x56, 0xc7, 0x7c, 0xee, 0x0e, 0xcf, 0x58, 0xf1, 0xf4, 0x02, 0xf1, 0xe2, 0xf7, 0x17, 0x00, 0xab,
+	0xfc, 0x69, 0xb0, 0x69, 0x5b, 0x14, 0x7f, 0x13, 0xc1, 0x38, 0xbf, 0x21, 0xd8, 0x5f, 0x54, 0x94,
+	0xe0, 0x2b, 0x32, 0x46, 0x74, 0x96, 0x60, 0xac, 0xc8, 0xf4, 0x2b, 0x7f, 0xfc, 0xfb, 0x1b, 0x95,
+	0xbd, 0x78, 0x37, 0x7f, 0x30, 0xb1, 0x79, 0x4a, 0x7d, 0xbf, 0x10, 0xe2, 0xaf, 0x23, 0xc0, 0xc2,
+	0x09, 0x2b, 0x77, 0xd6, 0xb8, 0xb0, 0x58, 0x97, 0x73, 0xb7, 0x6d, 0xec, 0x57, 0x36, 0x61, 0xd3,
+	0xf2, 0x02, 0xca, 0xb6, 0x1c, 0xef, 0xc0, 0x01, 0x1c, 0xe7, 0x00, 0x0e, 0x61, 0x92, 0x07, 0xa0,
+	0xf5, 0x32, 0x53, 0xd7, 0xb5, 0x16, 0x8d, 0xf9, 0xbe, 0x86, 0x60, 0x8f, 0x0a, 0x27, 0xb9, 0x97,
+	0xc2, 0x73, 0xa5, 0x97, 0x28, 0x02, 0xc9, 0xc1, 0xd2, 0x4e, 0x1c, 0xcd, 0x11, 0x8e, 0x66, 0x16,
+	0xcf, 0x48, 0x34, 0xf2, 0x6e, 0x27, 0xd4, 0x05, 0xf3, 0x15, 0x04, 0x53, 0x6a, 0x01, 0xbe, 0xb0,
+	0x46, 0x9a, 0xbd, 0xa2, 0x31, 0xe6, 0x86, 0xe8, 0x49, 0x08, 0x87, 0x31, 0x8d, 0x0d, 0x09, 0xa3,
+	0xc3, 0x1a, 0x75, 0x08, 0xd7, 0x11, 0xec, 0xd0, 0x8a, 0xaa, 0xc7, 0x86, 0xa9, 0x7b, 0xc6, 0x20,
+	0x0e, 0x0d, 0xd3, 0x95, 0xcc, 0x71, 0x14, 0xfb, 0xf1, 0x3e, 0x89, 0xa2, 0xc7, 0xe9, 0x3a, 0x8c,
+	0x1f, 0x23, 0xa8, 0x7e, 0x8a, 0x27, 0x74, 0xdb, 0x58, 0xed, 0xca, 0x68, 0xac, 0x96, 0xf3, 0xe2,
+	0xe6, 0x33, 0x88, 0x2f, 0xe4, 0x2f, 0x13, 0x34, 0x7c, 0x27, 0x11, 0xbe, 0x0a, 0x53, 0xe9, 0xa0,
+	0xb0, 0x58, 0x55, 0xd9, 0xc7, 0x0d, 0xc6, 0xfe, 0xd2, 0x9e, 0x64, 0x3f, 0x67, 0x7f, 0x1f, 0xde,
+	0x93, 0x61, 0x1f, 0x1b, 0xeb, 0x49, 0x84, 0xdf, 0x46, 0x50, 0x8b, 0xcb, 0x80, 0xf8, 0x70, 0xd1,
+	0x54, 0x5a, 0x99, 0xd0, 0x18, 0x51, 0xb1, 0x8d, 0x1c, 0xe3, 0xd0, 0xe6, 0x48, 0xee, 0xae, 0x3e,
+	0xad, 0x55, 0x0a, 0xbf, 0x85, 0x60, 0xec, 0x3c, 0xdd, 0xd6, 0xe7, 0x8c, 0x0a, 0xd9, 0x80, 0xce,
+	0x72, 0xb6, 0x3b, 0xfe, 0x3d, 0x62, 0xd7, 0xc8, 0xfa, 0x13, 0x1f, 0x9c, 0xbd, 0xc0, 0xce, 0x79,
+	0x01, 0x64, 0x3c, 0x79, 0x47, 0xa1, 0x45, 0x9f, 0x91, 0x9c, 0xe1, 0x50, 0x3f, 0x86, 0x1f, 0x29,
+	0xf3, 0x4c, 0xb2, 0x6e, 0x18, 0xb6, 0x5e, 0x96, 0x3f, 0xaf, 0xb5, 0x7a, 0x62, 0x0a, 0xfc, 0x3b,
+	0x04, 0xbb, 0xe4, 0xbc, 0xcb, 0x34, 0x32, 0x6d, 0x27, 0xfc, 0xdf, 0xaf, 0xe3, 0xe3, 0x7c, 0x1d,
+	0xa7, 0xf1, 0xc3, 0xb7, 0xbc, 0x8e, 0x8e, 0x80, 0xfc, 0x5b, 0x04, 0xf7, 0x0c, 0x3c, 0x8f, 0xc2,
+	0xcd, 0xe2, 0x28, 0x90, 0xf7, 0x92, 0xca, 0xb8, 0x34, 0x82, 0x45, 0x25, 0x53, 0x92, 0x05, 0xbe,
+	0xaa, 0xa3, 0xf8, 0x70, 0xd9, 0xaa, 0xac, 0x04, 0xec, 0x4f, 0x11, 0xdc, 0x9d, 0x7d, 0x9c, 0x82,
+	0x17, 0x8a, 0x56, 0x90, 0xfb, 0x38, 0xc6, 0x38, 0x39, 0x6c, 0xf7, 0x24, 0xe9, 0x7b, 0x88, 0x83,
+	0x6c, 0xe1, 0x85, 0x32, 0x90, 0xbd, 0x78, 0xf4, 0x42, 0x5a, 0x50, 0x7d, 0x05, 0xc1, 0x8e, 0xf3,
+	0x34, 0x4a, 0x81, 0x1e, 0x2e, 0xe1, 0x9c, 0xbe, 0x0b, 0x32, 0xa6, 0x9b, 0xca, 0x2b, 0x41, 0xd9,
+	0x94, 0x80, 0x19, 0x4a, 0x62, 0x29, 0x88, 0xd7, 0x10, 0x4c, 0x88, 0xe7, 0x21, 0xf8, 0x48, 0x11,
+	0x7f, 0xfd, 0x91, 0x8e, 0x71, 0x74, 0xdb, 0x7e, 0x02, 0xcb, 0x03, 0x1c, 0xcb, 0x61, 0x3c, 0x57,
+	0x86, 0xc5, 0x17, 0xdc, 0x7f, 0x83, 0xa0, 0x16, 0x17, 0xc2, 0x8a, 0x05, 0xa1, 0xdd, 0x64, 0x8c,
+	0xcc, 0x5b, 0x9d, 0xe3, 0x30, 0x1f, 0x37, 0x4e, 0xe6, 0xc3, 0x54, 0xc7, 0xcb, 0x3d, 0xdf, 0xe4,
+	0xd8, 0x75, 0x1f, 0xfb, 0x4b, 0x04, 0x90, 0x56, 0xb4, 0x8b, 0x03, 0xf5, 0x40, 0xd5, 0xdb, 0x18,
+	0x61, 0xd9, 0x98, 0x34, 0xf9, 0x62, 0xe6, 0x8d, 0xd9, 0x32, 0x99, 0x87, 0x3e, 0xb5, 0x4e, 0xf3,
+	0xd2, 0x32, 0x0b, 0x5f, 0x3b, 0xd4, 0x22, 0x6f, 0x71, 0xda, 0x97, 0x53, 0x12, 0x37, 0x4e, 0x0c,
+	0xd7, 0x59, 0xd8, 0xc3, 0x47, 0x39, 0xb6, 0x53, 0xe4, 0xd8, 0x76, 0xd8, 0x5a, 0x9b, 0x62, 0xb8,
+	0x00, 0xf9, 0x43, 0x04, 0x55, 0x5e, 0x2a, 0xc3, 0x85, 0x39, 0x8d, 0x5a, 0x49, 0x1b, 0x99, 0x65,
+	0x88, 0x44, 0x71, 0xb1, 0x2c, 0x8e, 0x9d, 0x46, 0xc7, 0xf1, 0x26, 0xd4, 0xe2, 0x6a, 0x55, 0xb1,
+	0xe9, 0x6a, 0xd5, 0x2c, 0x63, 0xb6, 0x24, 0xb7, 0x8e, 0x65, 0x25, 0x42, 0xe8, 0xf1, 0xd2, 0x10,
+	0xfa, 0x13, 0x04, 0xe3, 0xec, 0xe0, 0x81, 0x0b, 0xf3, 0x4d, 0xe5, 0xaa, 0x6c, 0x64, 0x52, 0x11,
+	0xdb, 0x9a, 0x94, 0x9b, 0xd8, 0x96, 0x6b, 0x31, 0xd1, 0xbc, 0x99, 0xba, 0xe4, 0xe4, 0xcc, 0x88,
+	0xf7, 0xe5, 0xe6, 0xe8, 0xc2, 0x01, 0xeb, 0x22, 0x2c, 0x3a, 0x6f, 0x6e, 0x17, 0xf0, 0x32, 0xc7,
+	0xea, 0xd4, 0x01, 0xa7, 0xf7, 0x5d, 0xdf, 0x41, 0x30, 0xa5, 0x9c, 0x0a, 0x8b, 0x73, 0xc6, 0xec,
+	0x69, 0xd3, 0x78, 0x60, 0x88, 0x9e, 0x09, 0xd0, 0x93, 0x1c, 0xe8, 0x71, 0x3c, 0xbf, 0x9d, 0xb8,
+	0x16, 0x02, 0x01, 0xe4, 0x57, 0x08, 0x76, 0xc8, 0x05, 0x5f, 0x09, 0x28, 0x2d, 0x97, 0xd7, 0x88,
+	0xbc, 0x07, 0x63, 0x44, 0x1e, 0xe5, 0x58, 0x3f, 0x82, 0x1f, 0x1c, 0x52, 0xa8, 0x52, 0x98, 0x0b,
+	0x11, 0x83, 0xf9, 0x73, 0x04, 0x75, 0x79, 0xa9, 0x82, 0x0b, 0xa3, 0x44, 0xe6, 0xda, 0x65, 0x64,
+	0x66, 0xd9, 0xe2, 0xd8, 0x8f, 0x91, 0x43, 0xa5, 0x19, 0x90, 0x60, 0xce, 0x4c, 0xf3, 0x17, 0x08,
+	0x76, 0xa8, 0x57, 0x2f, 0xc5, 0xae, 0x2f, 0xe7, 0x82, 0x66, 0x64, 0xb0, 0x45, 0xc0, 0x26, 0xa5,
+	0x47, 0x63, 0x9b, 0xb3, 0x66, 0xa0, 0xbf, 0x8d, 0x00, 0x27, 0x75, 0xa7, 0xa4, 0x12, 0x95, 0x89,
+	0xdd, 0x85, 0x25, 0x2d, 0xe3, 0xe8, 0xb6, 0xfd, 0xf4, 0x3c, 0xe2, 0x78, 0x69, 0x1e, 0xe1, 0x25,
+	0xfc, 0xaf, 0x23, 0xa8, 0xcb, 0x17, 0x2c, 0xc5, 0xaa, 0xcf, 0xbc, 0x71, 0x31, 0x0e, 0x95, 0x75,
+	0x4c, 0xa0, 0xc8, 0x73, 0x4e, 0x72, 0x5c, 0x7f, 0xbe, 0xef, 0x6c, 0xe8, 0x78, 0xa4, 0xb7, 0x79,
+	0x15, 0xc1, 0x54, 0x3c, 0x36, 0x7e, 0x7d, 0x33, 0x57, 0xce, 0xe0, 0x56, 0x50, 0x9c, 0xe0, 0x28,
+	0x8e, 0x90, 0x83, 0xc5, 0x28, 0xc4, 0x9b, 0x1f, 0x06, 0xe4, 0x0d, 0x04, 0x7b, 0xd9, 0xf0, 0x1c,
+	0x55, 0x8d, 0x10, 0x93, 0x08, 0xf6, 0x64, 0xae, 0x18, 0x53, 0x24, 0x01, 0x30, 0x54, 0xd7, 0x11,
+	0x00, 0x9b, 0x40, 0x04, 0xab, 0x11, 0x22, 0x19, 0x88, 0x09, 0x83, 0x48, 0x3a, 0x9c, 0x29, 0x83,
+	0xf1, 0x0d, 0x04, 0x53, 0xe7, 0x69, 0x52, 0xe0, 0x29, 0x71, 0x15, 0xfa, 0xed, 0x9f, 0x31, 0xbf,
+	0x7d, 0x47, 0x5d, 0x5b, 0xb8, 0xdc, 0x19, 0x48, 0x00, 0x3f, 0x40, 0x70, 0x97, 0x48, 0x20, 0x04,
+	0xe5, 0xc4, 0x76, 0x9c, 0xb4, 0x7c, 0x63, 0x78, 0x5c, 0x1f, 0xe6, 0xb8, 0x16, 0xc8, 0x50, 0xb8,
+	0x4e, 0x8b, 0x4b, 0xb4, 0x1f, 0x21, 0xb8, 0x57, 0xad, 0x88, 0x89, 0x8b, 0x93, 0xdb, 0x95, 0x5b,
+	0xc9, 0xfd, 0x0b, 0x79, 0x90, 0xe3, 0x6b, 0xe2, 0x13, 0xc3, 0xe0, 0x6b, 0x89, 0xab, 0x14, 0xfc,
+	0x16, 0x3b, 0x3a, 0xf6, 0x5d, 0x7d, 0xe2, 0x4c, 0x2e, 0x54, 0x74, 0xd1, 0x35, 0x44, 0x2e, 0x24,
+	0xa2, 0x12, 0xb9, 0x25, 0x50, 0xa7, 0xc5, 0x95, 0x13, 0x2b, 0xb8, 0xee, 0x94, 0xd9, 0x97, 0xd0,
+	0xee, 0xc2, 0x76, 0x82, 0xbb, 0xd5, 0x6c, 0x4d, 0x98, 0xdb, 0xf1, 0xe1, 0xcc, 0xed, 0xab, 0xec,
+	0xd0, 0x15, 0xdf, 0x16, 0x95, 0x24, 0xb4, 0xca, 0x75, 0x92, 0xb1, 0x47, 0xeb, 0x25, 0x6f, 0x4b,
+	0x64, 0x42, 0x8d, 0x5b, 0x65, 0x6c, 0x7d, 0xaf, 0x13, 0xb6, 0x5e, 0x16, 0xd7, 0x48, 0xd7, 0x5a,
+	0x8e, 0xd7, 0x0d, 0x4f, 0xa2, 0xa5, 0xb3, 0xef, 0xde, 0x9c, 0x41, 0x7f, 0xb8, 0x39, 0x83, 0xfe,
+	0x7a, 0x73, 0x06, 0x7d, 0xe6, 0xa1, 0x21, 0xfe, 0xf5, 0x66, 0x39, 0x36, 0x75, 0xb5, 0xf2, 0xe4,
+	0x7f, 0x06, 0x00, 0x66, 0x02, 0x98, 0xdb, 0xee, 0x37, 0x00, 0x00,
 }
@@ -0,0 +1,11 @@
+package application
+
+// PreviewStatus is the result of the server-side dry-run of a resource
+type PreviewStatus string
+
+const (
+	// PreviewStatusPassed means that the resource was admitted by the destination cluster
+	PreviewStatusPassed PreviewStatus = "Passed"
+	// PreviewStatusFailed means that the resource was rejected by the destination cluster
+	PreviewStatusFailed PreviewStatus = "Failed"
+)
@@ -44,7 +44,9 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
 // ClusterQuery is a query for cluster resources
 type ClusterQuery struct {
-	Server               string   `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
+	Server string `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
+	// lists only the clusters matching the label selector, e.g. 'env=prod'
+	Selector             string   `protobuf:"bytes,2,opt,name=selector,proto3" json:"selector,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -54,7 +56,7 @@ func (m *ClusterQuery) Reset()         { *m = ClusterQuery{} }
 func (m *ClusterQuery) String() string { return proto.CompactTextString(m) }
 func (*ClusterQuery) ProtoMessage()    {}
 func (*ClusterQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_66eaae81439ad97b, []int{0}
+	return fileDescriptor_cluster_77a83e7ac5d8ce07, []int{0}
 }
 func (m *ClusterQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -90,6 +92,13 @@ func (m *ClusterQuery) GetServer() string {
 	return ""
 }
 
+func (m *ClusterQuery) GetSelector() string {
+	if m != nil {
+		return m.Selector
+	}
+	return ""
+}
+
 type ClusterResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -100,7 +109,7 @@ func (m *ClusterResponse) Reset()         { *m = ClusterResponse{} }
 func (m *ClusterResponse) String() string { return proto.CompactTextString(m) }
 func (*ClusterResponse) ProtoMessage()    {}
 func (*ClusterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_66eaae81439ad97b, []int{1}
+	return fileDescriptor_cluster_77a83e7ac5d8ce07, []int{1}
 }
 func (m *ClusterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -141,7 +150,7 @@ func (m *ClusterCreateRequest) Reset()         { *m = ClusterCreateRequest{} }
 func (m *ClusterCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*ClusterCreateRequest) ProtoMessage()    {}
 func (*ClusterCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_66eaae81439ad97b, []int{2}
+	return fileDescriptor_cluster_77a83e7ac5d8ce07, []int{2}
 }
 func (m *ClusterCreateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -195,7 +204,7 @@ func (m *ClusterUpdateRequest) Reset()         { *m = ClusterUpdateRequest{} }
 func (m *ClusterUpdateRequest) String() string { return proto.CompactTextString(m) }
 func (*ClusterUpdateRequest) ProtoMessage()    {}
 func (*ClusterUpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_66eaae81439ad97b, []int{3}
+	return fileDescriptor_cluster_77a83e7ac5d8ce07, []int{3}
 }
 func (m *ClusterUpdateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -508,6 +517,12 @@ func (m *ClusterQuery) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintCluster(dAtA, i, uint64(len(m.Server)))
 		i += copy(dAtA[i:], m.Server)
 	}
+	if len(m.Selector) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCluster(dAtA, i, uint64(len(m.Selector)))
+		i += copy(dAtA[i:], m.Selector)
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -623,6 +638,10 @@ func (m *ClusterQuery) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCluster(uint64(l))
 	}
+	l = len(m.Selector)
+	if l > 0 {
+		n += 1 + l + sovCluster(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -738,6 +757,35 @@ func (m *ClusterQuery) Unmarshal(dAtA []byte) error {
 			}
 			m.Server = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Selector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCluster(dAtA[iNdEx:])
@@ -1105,41 +1153,41 @@ var (
 )
 
 func init() {
-	proto.RegisterFile("server/cluster/cluster.proto", fileDescriptor_cluster_66eaae81439ad97b)
-}
-
-var fileDescriptor_cluster_66eaae81439ad97b = []byte{
-	// 502 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0x4f, 0x8b, 0x13, 0x3f,
-	0x18, 0xc7, 0xc9, 0xfe, 0x7e, 0x8c, 0x1a, 0xc5, 0x3f, 0x61, 0x95, 0x3a, 0xae, 0x65, 0x37, 0xa0,
-	0x2e, 0x62, 0x13, 0x5a, 0x2f, 0xe2, 0x45, 0xdc, 0x15, 0x45, 0xf0, 0xe2, 0x88, 0x17, 0x59, 0x90,
-	0xec, 0xf4, 0x61, 0x3a, 0x76, 0x9c, 0xc4, 0x24, 0x33, 0x20, 0x22, 0x82, 0x5e, 0xc5, 0x8b, 0xe0,
-	0xd5, 0xb7, 0xe3, 0x51, 0xf0, 0x0d, 0x48, 0xf1, 0x85, 0xc8, 0x64, 0x92, 0x76, 0xb7, 0xa5, 0xa2,
-	0x58, 0x3c, 0x35, 0x79, 0x92, 0x7e, 0xbf, 0x9f, 0x7c, 0xf3, 0x4c, 0xf0, 0x86, 0x01, 0x5d, 0x83,
-	0xe6, 0x69, 0x51, 0x19, 0x3b, 0xfb, 0x65, 0x4a, 0x4b, 0x2b, 0xc9, 0x11, 0x3f, 0x8d, 0xd7, 0x33,
-	0x99, 0x49, 0x57, 0xe3, 0xcd, 0xa8, 0x5d, 0x8e, 0x37, 0x32, 0x29, 0xb3, 0x02, 0xb8, 0x50, 0x39,
-	0x17, 0x65, 0x29, 0xad, 0xb0, 0xb9, 0x2c, 0x8d, 0x5f, 0xa5, 0xe3, 0x1b, 0x86, 0xe5, 0xd2, 0xad,
-	0xa6, 0x52, 0x03, 0xaf, 0xfb, 0x3c, 0x83, 0x12, 0xb4, 0xb0, 0x30, 0xf4, 0x7b, 0xee, 0x67, 0xb9,
-	0x1d, 0x55, 0xfb, 0x2c, 0x95, 0xcf, 0xb9, 0xd0, 0xce, 0xe2, 0x99, 0x1b, 0xf4, 0xd2, 0x21, 0x57,
-	0xe3, 0xac, 0xf9, 0xb3, 0xe1, 0x42, 0xa9, 0x22, 0x4f, 0x9d, 0x38, 0xaf, 0xfb, 0xa2, 0x50, 0x23,
-	0xb1, 0x20, 0x45, 0x2f, 0xe3, 0x13, 0xbb, 0x2d, 0xed, 0xc3, 0x0a, 0xf4, 0x4b, 0x72, 0x0e, 0x47,
-	0xed, 0xd9, 0x3a, 0x68, 0x13, 0x6d, 0x1f, 0x4b, 0xfc, 0x8c, 0x9e, 0xc1, 0xa7, 0xfc, 0xbe, 0x04,
-	0x8c, 0x92, 0xa5, 0x01, 0xfa, 0x1e, 0xe1, 0x75, 0x5f, 0xdb, 0xd5, 0x20, 0x2c, 0x24, 0xf0, 0xa2,
-	0x02, 0x63, 0xc9, 0x1e, 0x0e, 0x09, 0x38, 0x91, 0xe3, 0x83, 0x1d, 0x36, 0x03, 0x66, 0x01, 0xd8,
-	0x0d, 0x9e, 0xa6, 0x43, 0xa6, 0xc6, 0x19, 0x6b, 0x80, 0xd9, 0x01, 0x60, 0x16, 0x80, 0x59, 0x70,
-	0x0d, 0x92, 0x0d, 0x61, 0xa5, 0x0c, 0x68, 0xdb, 0x59, 0xdb, 0x44, 0xdb, 0x47, 0x13, 0x3f, 0xa3,
-	0x76, 0x4a, 0xf3, 0x58, 0x0d, 0xff, 0x15, 0xcd, 0xe0, 0x53, 0x84, 0x4f, 0xfa, 0xe2, 0x23, 0xd0,
-	0x75, 0x9e, 0x02, 0x79, 0x83, 0xff, 0x7f, 0x90, 0x1b, 0x4b, 0xce, 0xb2, 0xd0, 0x16, 0x07, 0x13,
-	0x8e, 0xef, 0xfe, 0xbd, 0x7d, 0x23, 0x4f, 0x3b, 0x6f, 0xbf, 0xfd, 0xf8, 0xb8, 0x46, 0xc8, 0x69,
-	0xd7, 0x2a, 0x75, 0x3f, 0x34, 0xa1, 0x21, 0x1f, 0x10, 0x8e, 0xda, 0x1b, 0x21, 0x17, 0xe7, 0x19,
-	0x0e, 0xdd, 0x54, 0xbc, 0x82, 0x28, 0xe8, 0x96, 0xe3, 0xb8, 0x40, 0x17, 0x38, 0x6e, 0x4e, 0xaf,
-	0xec, 0x1d, 0xc2, 0xff, 0xdd, 0x83, 0xa5, 0x89, 0xac, 0x90, 0x82, 0x9c, 0x9f, 0xa7, 0xe0, 0xaf,
-	0xda, 0x0e, 0x7e, 0x4d, 0x3e, 0x23, 0x1c, 0xb5, 0xad, 0xb1, 0x18, 0xcb, 0xa1, 0x96, 0x59, 0x09,
-	0xd0, 0xc0, 0x01, 0x5d, 0x8b, 0xb7, 0x16, 0x81, 0x82, 0xb7, 0x07, 0x9b, 0xe5, 0xb4, 0x87, 0xa3,
-	0x3b, 0x50, 0x80, 0x85, 0x65, 0x49, 0x75, 0xe6, 0xcb, 0xd3, 0x8f, 0xd1, 0x9f, 0xff, 0xea, 0x2f,
-	0xce, 0x5f, 0x60, 0x9c, 0x34, 0x8f, 0x0d, 0xdc, 0xae, 0xec, 0xe8, 0xcf, 0x1d, 0x7a, 0xce, 0xe1,
-	0x0a, 0xbd, 0xb4, 0xd4, 0x81, 0x6b, 0x27, 0xdf, 0x13, 0x95, 0x1d, 0xed, 0xdc, 0xfa, 0x32, 0xe9,
-	0xa2, 0xaf, 0x93, 0x2e, 0xfa, 0x3e, 0xe9, 0xa2, 0x27, 0xfd, 0xdf, 0x78, 0xb1, 0xd2, 0x22, 0x87,
-	0xd2, 0x06, 0xd9, 0xfd, 0xc8, 0x3d, 0x50, 0xd7, 0x7f, 0x06, 0x00, 0x00, 0xff, 0xff, 0x4e, 0xde,
-	0x52, 0x19, 0x6c, 0x05, 0x00, 0x00,
+	proto.RegisterFile("server/cluster/cluster.proto", fileDescriptor_cluster_77a83e7ac5d8ce07)
+}
+
+var fileDescriptor_cluster_77a83e7ac5d8ce07 = []byte{
+	// 511 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0x5f, 0x8b, 0xd3, 0x4c,
+	0x14, 0xc6, 0xc9, 0xbe, 0x2f, 0x71, 0x1d, 0xc5, 0x3f, 0xc3, 0x2a, 0x35, 0xae, 0xc5, 0x0d, 0x88,
+	0x22, 0x76, 0x86, 0xd6, 0x1b, 0xf1, 0x46, 0xec, 0x8a, 0x22, 0x78, 0x63, 0xc4, 0x1b, 0x59, 0x90,
+	0xd9, 0xe9, 0x21, 0x8d, 0x8d, 0x99, 0x71, 0xe6, 0x24, 0x20, 0x22, 0x82, 0xde, 0x8a, 0x37, 0x82,
+	0xb7, 0x7e, 0x1d, 0x2f, 0x05, 0xbf, 0x80, 0x14, 0x3f, 0x88, 0x64, 0x32, 0x69, 0x77, 0x5b, 0x2a,
+	0x8a, 0xc5, 0xab, 0xce, 0x39, 0x27, 0x3d, 0xcf, 0xef, 0x39, 0x73, 0x12, 0xb2, 0x6d, 0xc1, 0x54,
+	0x60, 0xb8, 0xcc, 0x4b, 0x8b, 0xf3, 0x5f, 0xa6, 0x8d, 0x42, 0x45, 0x8f, 0xf8, 0x30, 0xda, 0x4a,
+	0x55, 0xaa, 0x5c, 0x8e, 0xd7, 0xa7, 0xa6, 0x1c, 0x6d, 0xa7, 0x4a, 0xa5, 0x39, 0x70, 0xa1, 0x33,
+	0x2e, 0x8a, 0x42, 0xa1, 0xc0, 0x4c, 0x15, 0xd6, 0x57, 0xe3, 0xc9, 0x0d, 0xcb, 0x32, 0xe5, 0xaa,
+	0x52, 0x19, 0xe0, 0x55, 0x9f, 0xa7, 0x50, 0x80, 0x11, 0x08, 0x23, 0xff, 0xcc, 0xfd, 0x34, 0xc3,
+	0x71, 0xb9, 0xcf, 0xa4, 0x7a, 0xce, 0x85, 0x71, 0x12, 0xcf, 0xdc, 0xa1, 0x27, 0x47, 0x5c, 0x4f,
+	0xd2, 0xfa, 0xcf, 0x96, 0x0b, 0xad, 0xf3, 0x4c, 0xba, 0xe6, 0xbc, 0xea, 0x8b, 0x5c, 0x8f, 0xc5,
+	0x52, 0xab, 0x78, 0x48, 0x8e, 0xef, 0x36, 0xb4, 0x0f, 0x4b, 0x30, 0x2f, 0xe9, 0x59, 0x12, 0x36,
+	0xde, 0x3a, 0xc1, 0xc5, 0xe0, 0xca, 0xd1, 0xc4, 0x47, 0x34, 0x22, 0x9b, 0x16, 0x72, 0x90, 0xa8,
+	0x4c, 0x67, 0xc3, 0x55, 0x66, 0x71, 0x7c, 0x9a, 0x9c, 0xf4, 0x3d, 0x12, 0xb0, 0x5a, 0x15, 0x16,
+	0xe2, 0xf7, 0x01, 0xd9, 0xf2, 0xb9, 0x5d, 0x03, 0x02, 0x21, 0x81, 0x17, 0x25, 0x58, 0xa4, 0x7b,
+	0xa4, 0x9d, 0x8e, 0x13, 0x38, 0x36, 0x18, 0xb2, 0xb9, 0x19, 0xd6, 0x9a, 0x71, 0x87, 0xa7, 0x72,
+	0xc4, 0xf4, 0x24, 0x65, 0xb5, 0x19, 0x76, 0xc0, 0x0c, 0x6b, 0xcd, 0xb0, 0x56, 0xb5, 0x6d, 0x59,
+	0xd3, 0x97, 0xda, 0x82, 0x41, 0xc7, 0xb8, 0x99, 0xf8, 0x28, 0xc6, 0x19, 0xcd, 0x63, 0x3d, 0xfa,
+	0x57, 0x34, 0x83, 0x4f, 0x21, 0x39, 0xe1, 0x93, 0x8f, 0xc0, 0x54, 0x99, 0x04, 0xfa, 0x86, 0xfc,
+	0xff, 0x20, 0xb3, 0x48, 0xcf, 0x30, 0xff, 0x10, 0x3b, 0x38, 0xfd, 0xe8, 0xee, 0xdf, 0xcb, 0xd7,
+	0xed, 0xe3, 0xce, 0xdb, 0x6f, 0x3f, 0x3e, 0x6e, 0x50, 0x7a, 0xca, 0xad, 0x51, 0xd5, 0x6f, 0x17,
+	0xd4, 0xd2, 0x0f, 0x01, 0x09, 0x9b, 0x1b, 0xa1, 0x17, 0x16, 0x19, 0x0e, 0xdd, 0x54, 0xb4, 0x86,
+	0x51, 0xc4, 0x3b, 0x8e, 0xe3, 0x7c, 0xbc, 0xc4, 0x71, 0x73, 0x76, 0x65, 0xef, 0x02, 0xf2, 0xdf,
+	0x3d, 0x58, 0x39, 0x91, 0x35, 0x52, 0xd0, 0x73, 0x8b, 0x14, 0xfc, 0x55, 0xb3, 0xdd, 0xaf, 0xe9,
+	0xe7, 0x80, 0x84, 0xcd, 0x6a, 0x2c, 0x8f, 0xe5, 0xd0, 0xca, 0xac, 0x05, 0x68, 0xe0, 0x80, 0xae,
+	0x45, 0x3b, 0xcb, 0x40, 0xad, 0xb6, 0x07, 0x9b, 0xcf, 0x69, 0x8f, 0x84, 0x77, 0x20, 0x07, 0x84,
+	0x55, 0x93, 0xea, 0x2c, 0xa6, 0x67, 0x2f, 0xa3, 0xf7, 0x7f, 0xf5, 0x17, 0xfe, 0x73, 0x42, 0x12,
+	0x85, 0x02, 0xe1, 0x76, 0x89, 0xe3, 0x3f, 0x57, 0xe8, 0x39, 0x85, 0xcb, 0xf1, 0xa5, 0x95, 0x0a,
+	0xdc, 0xb8, 0xf6, 0x3d, 0x51, 0xe2, 0x78, 0x78, 0xeb, 0xcb, 0xb4, 0x1b, 0x7c, 0x9d, 0x76, 0x83,
+	0xef, 0xd3, 0x6e, 0xf0, 0xa4, 0xff, 0x1b, 0x5f, 0x33, 0x99, 0x67, 0x50, 0x60, 0xdb, 0x76, 0x3f,
+	0x74, 0x1f, 0xaf, 0xeb, 0x3f, 0x07, 0x00, 0x2c, 0x91, 0x76, 0x72, 0x88, 0x05, 0x00, 0x00,
 }
@@ -0,0 +1,89 @@
+package apiclient_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/argoproj/argo-cd/pkg/apiclient"
+	applicationpkg "github.com/argoproj/argo-cd/pkg/apiclient/application"
+	sessionpkg "github.com/argoproj/argo-cd/pkg/apiclient/session"
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+func ExampleNewClient() {
+	client, err := apiclient.NewClient(&apiclient.ClientOptions{
+		ServerAddr: "argocd.example.com:443",
+		AuthToken:  "<token>",
+		MaxRetries: 3,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	closer, appIf, err := client.NewApplicationClient()
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer func() { _ = closer.Close() }()
+
+	apps, err := appIf.List(context.Background(), &applicationpkg.ApplicationQuery{Projects: []string{"default"}})
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, app := range apps.Items {
+		fmt.Println(app.Name, app.Status.Sync.Status, app.Status.Health.Status)
+	}
+}
+
+func ExampleContextWithAuthToken() {
+	client, err := apiclient.NewClient(&apiclient.ClientOptions{ServerAddr: "argocd.example.com:443"})
+	if err != nil {
+		log.Fatal(err)
+	}
+	closer, sessionIf, err := client.NewSessionClient()
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer func() { _ = closer.Close() }()
+
+	session, err := sessionIf.Create(context.Background(), &sessionpkg.SessionCreateRequest{Username: "admin", Password: "<password>"})
+	if err != nil {
+		log.Fatal(err)
+	}
+	// calls with this context are made on behalf of the user of the session
+	ctx := apiclient.ContextWithAuthToken(context.Background(), session.Token)
+	info, err := sessionIf.GetUserInfo(ctx, &sessionpkg.GetUserInfoRequest{})
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(info.Username)
+}
+
+func ExampleWaitForApplication() {
+	client, err := apiclient.NewClient(&apiclient.ClientOptions{ServerAddr: "argocd.example.com:443", AuthToken: "<token>"})
+	if err != nil {
+		log.Fatal(err)
+	}
+	closer, appIf, err := client.NewApplicationClient()
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer func() { _ = closer.Close() }()
+
+	name := "guestbook"
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+	events := client.WatchApplicationWithRetry(ctx, name)
+	_, err = appIf.Sync(ctx, &applicationpkg.ApplicationSyncRequest{Name: &name})
+	if err != nil {
+		log.Fatal(err)
+	}
+	app, err := apiclient.WaitForApplication(ctx, events, func(app *v1alpha1.Application) bool {
+		return apiclient.ApplicationOperationCompleted(app) && apiclient.ApplicationSyncedAndHealthy(app)
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(app.Status.OperationState.Phase)
+}
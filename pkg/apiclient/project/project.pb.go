@@ -57,7 +57,7 @@ func (m *ProjectCreateRequest) Reset()         { *m = ProjectCreateRequest{} }
 func (m *ProjectCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*ProjectCreateRequest) ProtoMessage()    {}
 func (*ProjectCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_project_50eae08168c57247, []int{0}
+	return fileDescriptor_project_2eb5934a24c418a8, []int{0}
 }
 func (m *ProjectCreateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -114,7 +114,7 @@ func (m *ProjectTokenDeleteRequest) Reset()         { *m = ProjectTokenDeleteReq
 func (m *ProjectTokenDeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*ProjectTokenDeleteRequest) ProtoMessage()    {}
 func (*ProjectTokenDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_project_50eae08168c57247, []int{1}
+	return fileDescriptor_project_2eb5934a24c418a8, []int{1}
 }
 func (m *ProjectTokenDeleteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -180,7 +180,7 @@ func (m *ProjectTokenCreateRequest) Reset()         { *m = ProjectTokenCreateReq
 func (m *ProjectTokenCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*ProjectTokenCreateRequest) ProtoMessage()    {}
 func (*ProjectTokenCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_project_50eae08168c57247, []int{2}
+	return fileDescriptor_project_2eb5934a24c418a8, []int{2}
 }
 func (m *ProjectTokenCreateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -249,7 +249,7 @@ func (m *ProjectTokenResponse) Reset()         { *m = ProjectTokenResponse{} }
 func (m *ProjectTokenResponse) String() string { return proto.CompactTextString(m) }
 func (*ProjectTokenResponse) ProtoMessage()    {}
 func (*ProjectTokenResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_project_50eae08168c57247, []int{3}
+	return fileDescriptor_project_2eb5934a24c418a8, []int{3}
 }
 func (m *ProjectTokenResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -285,6 +285,216 @@ func (m *ProjectTokenResponse) GetToken() string {
 	return ""
 }
 
+// ProjectTokenUsageQuery is a query for the usage of the tokens of a project role
+type ProjectTokenUsageQuery struct {
+	Project              string   `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	Role                 string   `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProjectTokenUsageQuery) Reset()         { *m = ProjectTokenUsageQuery{} }
+func (m *ProjectTokenUsageQuery) String() string { return proto.CompactTextString(m) }
+func (*ProjectTokenUsageQuery) ProtoMessage()    {}
+func (*ProjectTokenUsageQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_2eb5934a24c418a8, []int{4}
+}
+func (m *ProjectTokenUsageQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectTokenUsageQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProjectTokenUsageQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ProjectTokenUsageQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectTokenUsageQuery.Merge(dst, src)
+}
+func (m *ProjectTokenUsageQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectTokenUsageQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectTokenUsageQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectTokenUsageQuery proto.InternalMessageInfo
+
+func (m *ProjectTokenUsageQuery) GetProject() string {
+	if m != nil {
+		return m.Project
+	}
+	return ""
+}
+
+func (m *ProjectTokenUsageQuery) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+// ProjectTokenUsage is the usage of a project token since the API server started
+type ProjectTokenUsage struct {
+	Iat      int64 `protobuf:"varint,1,opt,name=iat,proto3" json:"iat,omitempty"`
+	Requests int64 `protobuf:"varint,2,opt,name=requests,proto3" json:"requests,omitempty"`
+	Syncs    int64 `protobuf:"varint,3,opt,name=syncs,proto3" json:"syncs,omitempty"`
+	// rejected is the number of requests and syncs which exceeded the quota of the role
+	Rejected int64 `protobuf:"varint,4,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	// quotaRequests and quotaSyncs are the requests and syncs which count towards the quota until quotaResetAt
+	QuotaRequests        int64    `protobuf:"varint,5,opt,name=quotaRequests,proto3" json:"quotaRequests,omitempty"`
+	QuotaSyncs           int64    `protobuf:"varint,6,opt,name=quotaSyncs,proto3" json:"quotaSyncs,omitempty"`
+	QuotaResetAt         int64    `protobuf:"varint,7,opt,name=quotaResetAt,proto3" json:"quotaResetAt,omitempty"`
+	LastUsedAt           int64    `protobuf:"varint,8,opt,name=lastUsedAt,proto3" json:"lastUsedAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProjectTokenUsage) Reset()         { *m = ProjectTokenUsage{} }
+func (m *ProjectTokenUsage) String() string { return proto.CompactTextString(m) }
+func (*ProjectTokenUsage) ProtoMessage()    {}
+func (*ProjectTokenUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_2eb5934a24c418a8, []int{5}
+}
+func (m *ProjectTokenUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectTokenUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProjectTokenUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ProjectTokenUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectTokenUsage.Merge(dst, src)
+}
+func (m *ProjectTokenUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectTokenUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectTokenUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectTokenUsage proto.InternalMessageInfo
+
+func (m *ProjectTokenUsage) GetIat() int64 {
+	if m != nil {
+		return m.Iat
+	}
+	return 0
+}
+
+func (m *ProjectTokenUsage) GetRequests() int64 {
+	if m != nil {
+		return m.Requests
+	}
+	return 0
+}
+
+func (m *ProjectTokenUsage) GetSyncs() int64 {
+	if m != nil {
+		return m.Syncs
+	}
+	return 0
+}
+
+func (m *ProjectTokenUsage) GetRejected() int64 {
+	if m != nil {
+		return m.Rejected
+	}
+	return 0
+}
+
+func (m *ProjectTokenUsage) GetQuotaRequests() int64 {
+	if m != nil {
+		return m.QuotaRequests
+	}
+	return 0
+}
+
+func (m *ProjectTokenUsage) GetQuotaSyncs() int64 {
+	if m != nil {
+		return m.QuotaSyncs
+	}
+	return 0
+}
+
+func (m *ProjectTokenUsage) GetQuotaResetAt() int64 {
+	if m != nil {
+		return m.QuotaResetAt
+	}
+	return 0
+}
+
+func (m *ProjectTokenUsage) GetLastUsedAt() int64 {
+	if m != nil {
+		return m.LastUsedAt
+	}
+	return 0
+}
+
+// ProjectTokenUsageList is the usage of the tokens of a project role
+type ProjectTokenUsageList struct {
+	Items                []*ProjectTokenUsage `protobuf:"bytes,1,rep,name=items" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ProjectTokenUsageList) Reset()         { *m = ProjectTokenUsageList{} }
+func (m *ProjectTokenUsageList) String() string { return proto.CompactTextString(m) }
+func (*ProjectTokenUsageList) ProtoMessage()    {}
+func (*ProjectTokenUsageList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_2eb5934a24c418a8, []int{6}
+}
+func (m *ProjectTokenUsageList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectTokenUsageList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProjectTokenUsageList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ProjectTokenUsageList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectTokenUsageList.Merge(dst, src)
+}
+func (m *ProjectTokenUsageList) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectTokenUsageList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectTokenUsageList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectTokenUsageList proto.InternalMessageInfo
+
+func (m *ProjectTokenUsageList) GetItems() []*ProjectTokenUsage {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
 // ProjectQuery is a query for Project resources
 type ProjectQuery struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -297,7 +507,7 @@ func (m *ProjectQuery) Reset()         { *m = ProjectQuery{} }
 func (m *ProjectQuery) String() string { return proto.CompactTextString(m) }
 func (*ProjectQuery) ProtoMessage()    {}
 func (*ProjectQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_project_50eae08168c57247, []int{4}
+	return fileDescriptor_project_2eb5934a24c418a8, []int{7}
 }
 func (m *ProjectQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -344,7 +554,7 @@ func (m *ProjectUpdateRequest) Reset()         { *m = ProjectUpdateRequest{} }
 func (m *ProjectUpdateRequest) String() string { return proto.CompactTextString(m) }
 func (*ProjectUpdateRequest) ProtoMessage()    {}
 func (*ProjectUpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_project_50eae08168c57247, []int{5}
+	return fileDescriptor_project_2eb5934a24c418a8, []int{8}
 }
 func (m *ProjectUpdateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -390,7 +600,7 @@ func (m *EmptyResponse) Reset()         { *m = EmptyResponse{} }
 func (m *EmptyResponse) String() string { return proto.CompactTextString(m) }
 func (*EmptyResponse) ProtoMessage()    {}
 func (*EmptyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_project_50eae08168c57247, []int{6}
+	return fileDescriptor_project_2eb5934a24c418a8, []int{9}
 }
 func (m *EmptyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -419,14 +629,181 @@ func (m *EmptyResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EmptyResponse proto.InternalMessageInfo
 
+// ProjectTemplatesQuery is a query for project templates
+type ProjectTemplatesQuery struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProjectTemplatesQuery) Reset()         { *m = ProjectTemplatesQuery{} }
+func (m *ProjectTemplatesQuery) String() string { return proto.CompactTextString(m) }
+func (*ProjectTemplatesQuery) ProtoMessage()    {}
+func (*ProjectTemplatesQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_2eb5934a24c418a8, []int{10}
+}
+func (m *ProjectTemplatesQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectTemplatesQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProjectTemplatesQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ProjectTemplatesQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectTemplatesQuery.Merge(dst, src)
+}
+func (m *ProjectTemplatesQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectTemplatesQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectTemplatesQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectTemplatesQuery proto.InternalMessageInfo
+
+// ProjectTemplateList is a list of project templates
+type ProjectTemplateList struct {
+	Items                []*v1alpha1.ProjectTemplate `protobuf:"bytes,1,rep,name=items" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *ProjectTemplateList) Reset()         { *m = ProjectTemplateList{} }
+func (m *ProjectTemplateList) String() string { return proto.CompactTextString(m) }
+func (*ProjectTemplateList) ProtoMessage()    {}
+func (*ProjectTemplateList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_2eb5934a24c418a8, []int{11}
+}
+func (m *ProjectTemplateList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectTemplateList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProjectTemplateList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ProjectTemplateList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectTemplateList.Merge(dst, src)
+}
+func (m *ProjectTemplateList) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectTemplateList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectTemplateList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectTemplateList proto.InternalMessageInfo
+
+func (m *ProjectTemplateList) GetItems() []*v1alpha1.ProjectTemplate {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+// ProjectCreateFromTemplateRequest defines the parameters of a project created from a template
+type ProjectCreateFromTemplateRequest struct {
+	Template             string            `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	Name                 string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Parameters           map[string]string `protobuf:"bytes,3,rep,name=parameters" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Upsert               bool              `protobuf:"varint,4,opt,name=upsert,proto3" json:"upsert,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ProjectCreateFromTemplateRequest) Reset()         { *m = ProjectCreateFromTemplateRequest{} }
+func (m *ProjectCreateFromTemplateRequest) String() string { return proto.CompactTextString(m) }
+func (*ProjectCreateFromTemplateRequest) ProtoMessage()    {}
+func (*ProjectCreateFromTemplateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_2eb5934a24c418a8, []int{12}
+}
+func (m *ProjectCreateFromTemplateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectCreateFromTemplateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProjectCreateFromTemplateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ProjectCreateFromTemplateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectCreateFromTemplateRequest.Merge(dst, src)
+}
+func (m *ProjectCreateFromTemplateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectCreateFromTemplateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectCreateFromTemplateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectCreateFromTemplateRequest proto.InternalMessageInfo
+
+func (m *ProjectCreateFromTemplateRequest) GetTemplate() string {
+	if m != nil {
+		return m.Template
+	}
+	return ""
+}
+
+func (m *ProjectCreateFromTemplateRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ProjectCreateFromTemplateRequest) GetParameters() map[string]string {
+	if m != nil {
+		return m.Parameters
+	}
+	return nil
+}
+
+func (m *ProjectCreateFromTemplateRequest) GetUpsert() bool {
+	if m != nil {
+		return m.Upsert
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*ProjectCreateRequest)(nil), "project.ProjectCreateRequest")
 	proto.RegisterType((*ProjectTokenDeleteRequest)(nil), "project.ProjectTokenDeleteRequest")
 	proto.RegisterType((*ProjectTokenCreateRequest)(nil), "project.ProjectTokenCreateRequest")
 	proto.RegisterType((*ProjectTokenResponse)(nil), "project.ProjectTokenResponse")
+	proto.RegisterType((*ProjectTokenUsageQuery)(nil), "project.ProjectTokenUsageQuery")
+	proto.RegisterType((*ProjectTokenUsage)(nil), "project.ProjectTokenUsage")
+	proto.RegisterType((*ProjectTokenUsageList)(nil), "project.ProjectTokenUsageList")
 	proto.RegisterType((*ProjectQuery)(nil), "project.ProjectQuery")
 	proto.RegisterType((*ProjectUpdateRequest)(nil), "project.ProjectUpdateRequest")
 	proto.RegisterType((*EmptyResponse)(nil), "project.EmptyResponse")
+	proto.RegisterType((*ProjectTemplatesQuery)(nil), "project.ProjectTemplatesQuery")
+	proto.RegisterType((*ProjectTemplateList)(nil), "project.ProjectTemplateList")
+	proto.RegisterType((*ProjectCreateFromTemplateRequest)(nil), "project.ProjectCreateFromTemplateRequest")
+	proto.RegisterMapType((map[string]string)(nil), "project.ProjectCreateFromTemplateRequest.ParametersEntry")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -444,6 +821,8 @@ type ProjectServiceClient interface {
 	CreateToken(ctx context.Context, in *ProjectTokenCreateRequest, opts ...grpc.CallOption) (*ProjectTokenResponse, error)
 	// Delete a new project token.
 	DeleteToken(ctx context.Context, in *ProjectTokenDeleteRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// GetTokenUsage returns the usage of the tokens of a project role
+	GetTokenUsage(ctx context.Context, in *ProjectTokenUsageQuery, opts ...grpc.CallOption) (*ProjectTokenUsageList, error)
 	// Create a new project.
 	Create(ctx context.Context, in *ProjectCreateRequest, opts ...grpc.CallOption) (*v1alpha1.AppProject, error)
 	// List returns list of projects
@@ -454,6 +833,10 @@ type ProjectServiceClient interface {
 	Update(ctx context.Context, in *ProjectUpdateRequest, opts ...grpc.CallOption) (*v1alpha1.AppProject, error)
 	// Delete deletes a project
 	Delete(ctx context.Context, in *ProjectQuery, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// ListTemplates returns the list of project templates
+	ListTemplates(ctx context.Context, in *ProjectTemplatesQuery, opts ...grpc.CallOption) (*ProjectTemplateList, error)
+	// CreateFromTemplate creates a new project from a template
+	CreateFromTemplate(ctx context.Context, in *ProjectCreateFromTemplateRequest, opts ...grpc.CallOption) (*v1alpha1.AppProject, error)
 	// ListEvents returns a list of project events
 	ListEvents(ctx context.Context, in *ProjectQuery, opts ...grpc.CallOption) (*v1.EventList, error)
 }
@@ -484,6 +867,15 @@ func (c *projectServiceClient) DeleteToken(ctx context.Context, in *ProjectToken
 	return out, nil
 }
 
+func (c *projectServiceClient) GetTokenUsage(ctx context.Context, in *ProjectTokenUsageQuery, opts ...grpc.CallOption) (*ProjectTokenUsageList, error) {
+	out := new(ProjectTokenUsageList)
+	err := c.cc.Invoke(ctx, "/project.ProjectService/GetTokenUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *projectServiceClient) Create(ctx context.Context, in *ProjectCreateRequest, opts ...grpc.CallOption) (*v1alpha1.AppProject, error) {
 	out := new(v1alpha1.AppProject)
 	err := c.cc.Invoke(ctx, "/project.ProjectService/Create", in, out, opts...)
@@ -529,6 +921,24 @@ func (c *projectServiceClient) Delete(ctx context.Context, in *ProjectQuery, opt
 	return out, nil
 }
 
+func (c *projectServiceClient) ListTemplates(ctx context.Context, in *ProjectTemplatesQuery, opts ...grpc.CallOption) (*ProjectTemplateList, error) {
+	out := new(ProjectTemplateList)
+	err := c.cc.Invoke(ctx, "/project.ProjectService/ListTemplates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) CreateFromTemplate(ctx context.Context, in *ProjectCreateFromTemplateRequest, opts ...grpc.CallOption) (*v1alpha1.AppProject, error) {
+	out := new(v1alpha1.AppProject)
+	err := c.cc.Invoke(ctx, "/project.ProjectService/CreateFromTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *projectServiceClient) ListEvents(ctx context.Context, in *ProjectQuery, opts ...grpc.CallOption) (*v1.EventList, error) {
 	out := new(v1.EventList)
 	err := c.cc.Invoke(ctx, "/project.ProjectService/ListEvents", in, out, opts...)
@@ -545,6 +955,8 @@ type ProjectServiceServer interface {
 	CreateToken(context.Context, *ProjectTokenCreateRequest) (*ProjectTokenResponse, error)
 	// Delete a new project token.
 	DeleteToken(context.Context, *ProjectTokenDeleteRequest) (*EmptyResponse, error)
+	// GetTokenUsage returns the usage of the tokens of a project role
+	GetTokenUsage(context.Context, *ProjectTokenUsageQuery) (*ProjectTokenUsageList, error)
 	// Create a new project.
 	Create(context.Context, *ProjectCreateRequest) (*v1alpha1.AppProject, error)
 	// List returns list of projects
@@ -555,6 +967,10 @@ type ProjectServiceServer interface {
 	Update(context.Context, *ProjectUpdateRequest) (*v1alpha1.AppProject, error)
 	// Delete deletes a project
 	Delete(context.Context, *ProjectQuery) (*EmptyResponse, error)
+	// ListTemplates returns the list of project templates
+	ListTemplates(context.Context, *ProjectTemplatesQuery) (*ProjectTemplateList, error)
+	// CreateFromTemplate creates a new project from a template
+	CreateFromTemplate(context.Context, *ProjectCreateFromTemplateRequest) (*v1alpha1.AppProject, error)
 	// ListEvents returns a list of project events
 	ListEvents(context.Context, *ProjectQuery) (*v1.EventList, error)
 }
@@ -599,35 +1015,53 @@ func _ProjectService_DeleteToken_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProjectService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ProjectCreateRequest)
+func _ProjectService_GetTokenUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectTokenUsageQuery)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProjectServiceServer).Create(ctx, in)
+		return srv.(ProjectServiceServer).GetTokenUsage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/project.ProjectService/Create",
+		FullMethod: "/project.ProjectService/GetTokenUsage",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProjectServiceServer).Create(ctx, req.(*ProjectCreateRequest))
+		return srv.(ProjectServiceServer).GetTokenUsage(ctx, req.(*ProjectTokenUsageQuery))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProjectService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ProjectQuery)
+func _ProjectService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectCreateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProjectServiceServer).List(ctx, in)
+		return srv.(ProjectServiceServer).Create(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/project.ProjectService/List",
+		FullMethod: "/project.ProjectService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).Create(ctx, req.(*ProjectCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/project.ProjectService/List",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(ProjectServiceServer).List(ctx, req.(*ProjectQuery))
@@ -689,6 +1123,42 @@ func _ProjectService_Delete_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProjectService_ListTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectTemplatesQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).ListTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/project.ProjectService/ListTemplates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).ListTemplates(ctx, req.(*ProjectTemplatesQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_CreateFromTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectCreateFromTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).CreateFromTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/project.ProjectService/CreateFromTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).CreateFromTemplate(ctx, req.(*ProjectCreateFromTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProjectService_ListEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ProjectQuery)
 	if err := dec(in); err != nil {
@@ -719,6 +1189,10 @@ var _ProjectService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteToken",
 			Handler:    _ProjectService_DeleteToken_Handler,
 		},
+		{
+			MethodName: "GetTokenUsage",
+			Handler:    _ProjectService_GetTokenUsage_Handler,
+		},
 		{
 			MethodName: "Create",
 			Handler:    _ProjectService_Create_Handler,
@@ -739,6 +1213,14 @@ var _ProjectService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _ProjectService_Delete_Handler,
 		},
+		{
+			MethodName: "ListTemplates",
+			Handler:    _ProjectService_ListTemplates_Handler,
+		},
+		{
+			MethodName: "CreateFromTemplate",
+			Handler:    _ProjectService_CreateFromTemplate_Handler,
+		},
 		{
 			MethodName: "ListEvents",
 			Handler:    _ProjectService_ListEvents_Handler,
@@ -898,6 +1380,133 @@ func (m *ProjectTokenResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *ProjectTokenUsageQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectTokenUsageQuery) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Project) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Project)))
+		i += copy(dAtA[i:], m.Project)
+	}
+	if len(m.Role) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Role)))
+		i += copy(dAtA[i:], m.Role)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ProjectTokenUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectTokenUsage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Iat != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintProject(dAtA, i, uint64(m.Iat))
+	}
+	if m.Requests != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintProject(dAtA, i, uint64(m.Requests))
+	}
+	if m.Syncs != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintProject(dAtA, i, uint64(m.Syncs))
+	}
+	if m.Rejected != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintProject(dAtA, i, uint64(m.Rejected))
+	}
+	if m.QuotaRequests != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintProject(dAtA, i, uint64(m.QuotaRequests))
+	}
+	if m.QuotaSyncs != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintProject(dAtA, i, uint64(m.QuotaSyncs))
+	}
+	if m.QuotaResetAt != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintProject(dAtA, i, uint64(m.QuotaResetAt))
+	}
+	if m.LastUsedAt != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintProject(dAtA, i, uint64(m.LastUsedAt))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ProjectTokenUsageList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectTokenUsageList) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, msg := range m.Items {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintProject(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func (m *ProjectQuery) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -977,6 +1586,120 @@ func (m *EmptyResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *ProjectTemplatesQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectTemplatesQuery) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ProjectTemplateList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectTemplateList) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, msg := range m.Items {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintProject(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ProjectCreateFromTemplateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectCreateFromTemplateRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Template) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Template)))
+		i += copy(dAtA[i:], m.Template)
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Parameters) > 0 {
+		for k, _ := range m.Parameters {
+			dAtA[i] = 0x1a
+			i++
+			v := m.Parameters[k]
+			mapSize := 1 + len(k) + sovProject(uint64(len(k))) + 1 + len(v) + sovProject(uint64(len(v)))
+			i = encodeVarintProject(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintProject(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintProject(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if m.Upsert {
+		dAtA[i] = 0x20
+		i++
+		if m.Upsert {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func encodeVarintProject(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -1059,10 +1782,14 @@ func (m *ProjectTokenResponse) Size() (n int) {
 	return n
 }
 
-func (m *ProjectQuery) Size() (n int) {
+func (m *ProjectTokenUsageQuery) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Name)
+	l = len(m.Project)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	l = len(m.Role)
 	if l > 0 {
 		n += 1 + l + sovProject(uint64(l))
 	}
@@ -1072,12 +1799,32 @@ func (m *ProjectQuery) Size() (n int) {
 	return n
 }
 
-func (m *ProjectUpdateRequest) Size() (n int) {
+func (m *ProjectTokenUsage) Size() (n int) {
 	var l int
 	_ = l
-	if m.Project != nil {
-		l = m.Project.Size()
-		n += 1 + l + sovProject(uint64(l))
+	if m.Iat != 0 {
+		n += 1 + sovProject(uint64(m.Iat))
+	}
+	if m.Requests != 0 {
+		n += 1 + sovProject(uint64(m.Requests))
+	}
+	if m.Syncs != 0 {
+		n += 1 + sovProject(uint64(m.Syncs))
+	}
+	if m.Rejected != 0 {
+		n += 1 + sovProject(uint64(m.Rejected))
+	}
+	if m.QuotaRequests != 0 {
+		n += 1 + sovProject(uint64(m.QuotaRequests))
+	}
+	if m.QuotaSyncs != 0 {
+		n += 1 + sovProject(uint64(m.QuotaSyncs))
+	}
+	if m.QuotaResetAt != 0 {
+		n += 1 + sovProject(uint64(m.QuotaResetAt))
+	}
+	if m.LastUsedAt != 0 {
+		n += 1 + sovProject(uint64(m.LastUsedAt))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -1085,21 +1832,114 @@ func (m *ProjectUpdateRequest) Size() (n int) {
 	return n
 }
 
-func (m *EmptyResponse) Size() (n int) {
+func (m *ProjectTokenUsageList) Size() (n int) {
 	var l int
 	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovProject(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func sovProject(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
+func (m *ProjectQuery) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProjectUpdateRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Project != nil {
+		l = m.Project.Size()
+		n += 1 + l + sovProject(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EmptyResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProjectTemplatesQuery) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProjectTemplateList) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovProject(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProjectCreateFromTemplateRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Template)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	if len(m.Parameters) > 0 {
+		for k, v := range m.Parameters {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovProject(uint64(len(k))) + 1 + len(v) + sovProject(uint64(len(v)))
+			n += mapEntrySize + 1 + sovProject(uint64(mapEntrySize))
+		}
+	}
+	if m.Upsert {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovProject(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
 		}
 	}
 	return n
@@ -1576,7 +2416,7 @@ func (m *ProjectTokenResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProjectQuery) Unmarshal(dAtA []byte) error {
+func (m *ProjectTokenUsageQuery) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1599,15 +2439,15 @@ func (m *ProjectQuery) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProjectQuery: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectTokenUsageQuery: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProjectQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectTokenUsageQuery: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1632,7 +2472,36 @@ func (m *ProjectQuery) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Project = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1656,7 +2525,7 @@ func (m *ProjectQuery) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProjectUpdateRequest) Unmarshal(dAtA []byte) error {
+func (m *ProjectTokenUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1679,17 +2548,17 @@ func (m *ProjectUpdateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProjectUpdateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectTokenUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProjectUpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectTokenUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Iat", wireType)
 			}
-			var msglen int
+			m.Iat = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProject
@@ -1699,25 +2568,144 @@ func (m *ProjectUpdateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Iat |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthProject
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Requests = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Requests |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if m.Project == nil {
-				m.Project = &v1alpha1.AppProject{}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Syncs", wireType)
 			}
-			if err := m.Project.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Syncs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Syncs |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rejected", wireType)
+			}
+			m.Rejected = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rejected |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuotaRequests", wireType)
+			}
+			m.QuotaRequests = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuotaRequests |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuotaSyncs", wireType)
+			}
+			m.QuotaSyncs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuotaSyncs |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuotaResetAt", wireType)
+			}
+			m.QuotaResetAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuotaResetAt |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUsedAt", wireType)
+			}
+			m.LastUsedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUsedAt |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProject(dAtA[iNdEx:])
@@ -1740,7 +2728,7 @@ func (m *ProjectUpdateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EmptyResponse) Unmarshal(dAtA []byte) error {
+func (m *ProjectTokenUsageList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1763,12 +2751,638 @@ func (m *EmptyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EmptyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectTokenUsageList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EmptyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectTokenUsageList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &ProjectTokenUsage{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProject(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProject
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProject
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProject(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProject
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectUpdateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProject
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectUpdateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectUpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Project == nil {
+				m.Project = &v1alpha1.AppProject{}
+			}
+			if err := m.Project.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProject(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProject
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EmptyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProject
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmptyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmptyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProject(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProject
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectTemplatesQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProject
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectTemplatesQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectTemplatesQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProject(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProject
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectTemplateList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProject
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectTemplateList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectTemplateList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &v1alpha1.ProjectTemplate{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProject(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProject
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectCreateFromTemplateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProject
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectCreateFromTemplateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectCreateFromTemplateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Template = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Parameters == nil {
+				m.Parameters = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProject
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProject
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthProject
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProject
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthProject
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipProject(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthProject
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Parameters[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upsert", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Upsert = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProject(dAtA[iNdEx:])
@@ -1897,54 +3511,77 @@ var (
 )
 
 func init() {
-	proto.RegisterFile("server/project/project.proto", fileDescriptor_project_50eae08168c57247)
-}
-
-var fileDescriptor_project_50eae08168c57247 = []byte{
-	// 720 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x5d, 0x6b, 0x13, 0x4d,
-	0x14, 0x66, 0x9a, 0xbe, 0x79, 0xdb, 0xc9, 0xfb, 0x6a, 0x19, 0xda, 0x9a, 0xc6, 0x36, 0x86, 0xb9,
-	0x90, 0x50, 0xec, 0x0c, 0x69, 0x15, 0x8a, 0x5e, 0x88, 0x1f, 0x45, 0x0a, 0x5e, 0x68, 0x54, 0x10,
-	0xbd, 0x28, 0xd3, 0xcd, 0x61, 0xbb, 0x4d, 0xb2, 0x33, 0xce, 0x4e, 0xa2, 0xa5, 0xe4, 0xa6, 0x88,
-	0xa0, 0x5e, 0x78, 0xe1, 0x4f, 0xf0, 0xd6, 0x1f, 0xe2, 0xa5, 0xe0, 0x1f, 0x90, 0xe2, 0x0f, 0x91,
-	0x99, 0xdd, 0x4d, 0xba, 0x4d, 0x57, 0x14, 0x82, 0x57, 0x7b, 0x76, 0xe6, 0xcc, 0x79, 0x9e, 0xe7,
-	0x7c, 0x70, 0xf0, 0x72, 0x04, 0xba, 0x0f, 0x9a, 0x2b, 0x2d, 0xf7, 0xc1, 0x33, 0xe9, 0x97, 0x29,
-	0x2d, 0x8d, 0x24, 0xff, 0x26, 0xbf, 0x95, 0x79, 0x5f, 0xfa, 0xd2, 0x9d, 0x71, 0x6b, 0xc5, 0xd7,
-	0x95, 0x65, 0x5f, 0x4a, 0xbf, 0x03, 0x5c, 0xa8, 0x80, 0x8b, 0x30, 0x94, 0x46, 0x98, 0x40, 0x86,
-	0x51, 0x72, 0x4b, 0xdb, 0x9b, 0x11, 0x0b, 0xa4, 0xbb, 0xf5, 0xa4, 0x06, 0xde, 0x6f, 0x70, 0x1f,
-	0x42, 0xd0, 0xc2, 0x40, 0x2b, 0xf1, 0xb9, 0x3a, 0xf2, 0xe9, 0x0a, 0x6f, 0x2f, 0x08, 0x41, 0x1f,
-	0x70, 0xd5, 0xf6, 0xed, 0x41, 0xc4, 0xbb, 0x60, 0xc4, 0x59, 0xaf, 0xb6, 0xfd, 0xc0, 0xec, 0xf5,
-	0x76, 0x99, 0x27, 0xbb, 0x5c, 0x68, 0x47, 0x6c, 0xdf, 0x19, 0x6b, 0x5e, 0x6b, 0xf4, 0x5a, 0x28,
-	0xd5, 0x09, 0x3c, 0x47, 0x89, 0xf7, 0x1b, 0xa2, 0xa3, 0xf6, 0xc4, 0x58, 0x28, 0xfa, 0x01, 0xe1,
-	0xf9, 0x07, 0xb1, 0xc8, 0x3b, 0x1a, 0x84, 0x81, 0x26, 0xbc, 0xe8, 0x41, 0x64, 0xc8, 0x0e, 0x4e,
-	0xc5, 0x97, 0x51, 0x0d, 0xd5, 0x4b, 0xeb, 0x5b, 0x6c, 0x84, 0xca, 0x52, 0x54, 0x67, 0xec, 0x78,
-	0x2d, 0xa6, 0xda, 0x3e, 0xb3, 0xa8, 0xec, 0x04, 0x2a, 0x4b, 0x51, 0xd9, 0x2d, 0xa5, 0x12, 0x90,
-	0x66, 0x1a, 0x95, 0x2c, 0xe2, 0x62, 0x4f, 0x45, 0xa0, 0x4d, 0x79, 0xaa, 0x86, 0xea, 0x33, 0xcd,
-	0xe4, 0x8f, 0x3e, 0xc7, 0x4b, 0x89, 0xef, 0x63, 0xd9, 0x86, 0xf0, 0x2e, 0x74, 0x60, 0xc4, 0xaa,
-	0x9c, 0x65, 0x35, 0x3b, 0x0a, 0x47, 0xf0, 0xb4, 0x96, 0x1d, 0x70, 0xc1, 0x66, 0x9b, 0xce, 0x26,
-	0x73, 0xb8, 0x10, 0x08, 0x53, 0x2e, 0xd4, 0x50, 0xbd, 0xd0, 0xb4, 0x26, 0x7d, 0x8b, 0xb2, 0xd1,
-	0xb3, 0x9a, 0xf3, 0xa3, 0xd7, 0x70, 0xa9, 0x05, 0x91, 0xa7, 0x03, 0x65, 0x85, 0x25, 0x20, 0x27,
-	0x8f, 0x86, 0xf8, 0x85, 0x13, 0xf8, 0xcb, 0x78, 0x16, 0x5e, 0xa9, 0x40, 0x43, 0xb4, 0x1d, 0x96,
-	0xa7, 0x1d, 0x8b, 0xd1, 0x01, 0xbd, 0x32, 0xcc, 0xbc, 0xa3, 0xd2, 0x84, 0x48, 0xc9, 0x30, 0x02,
-	0x32, 0x8f, 0xff, 0x31, 0xf6, 0x20, 0xe1, 0x10, 0xff, 0x50, 0x8a, 0xff, 0x4b, 0xbc, 0x1f, 0xf6,
-	0x40, 0x1f, 0x58, 0xbc, 0x50, 0x74, 0x21, 0x71, 0x72, 0x36, 0x7d, 0x39, 0x8c, 0xf8, 0x44, 0xb5,
-	0xfe, 0x62, 0x2d, 0xe9, 0x79, 0xfc, 0xff, 0x56, 0x57, 0x99, 0x83, 0x54, 0xc3, 0xfa, 0xe7, 0x19,
-	0x7c, 0x2e, 0xf1, 0x7a, 0x04, 0xba, 0x1f, 0x78, 0x40, 0xde, 0x21, 0x5c, 0x8a, 0xd3, 0xed, 0xe4,
-	0x12, 0xca, 0xd2, 0x59, 0xcb, 0x2d, 0x48, 0x65, 0xe5, 0x4c, 0x9f, 0x14, 0x85, 0x6e, 0x1e, 0x7d,
-	0xfb, 0xf1, 0x71, 0x6a, 0x9d, 0xae, 0xb9, 0x19, 0xeb, 0x37, 0xd2, 0xe9, 0x8d, 0xf8, 0x61, 0x62,
-	0x0d, 0xb8, 0x2d, 0x44, 0xc4, 0x0f, 0xed, 0x67, 0xc0, 0x5d, 0x2a, 0xaf, 0xa3, 0x55, 0xf2, 0x06,
-	0xe1, 0x52, 0xdc, 0x59, 0xbf, 0x22, 0x93, 0xe9, 0xbd, 0xca, 0xe2, 0xd0, 0x27, 0xa3, 0x95, 0xde,
-	0x70, 0x2c, 0xae, 0xad, 0x6e, 0xfc, 0x11, 0x0b, 0x7e, 0x18, 0x08, 0x33, 0x20, 0xef, 0x11, 0x2e,
-	0xc6, 0x9a, 0xc9, 0x98, 0xd8, 0x6c, 0x2e, 0x26, 0x53, 0x33, 0x7a, 0xd1, 0xb1, 0x5d, 0xa0, 0x73,
-	0xa7, 0xd9, 0xda, 0xb4, 0x1c, 0x21, 0x3c, 0x7d, 0x3f, 0x88, 0x0c, 0x59, 0x38, 0xcd, 0xc5, 0x35,
-	0x5d, 0x65, 0x7b, 0x22, 0x1c, 0x2c, 0x02, 0x2d, 0x3b, 0x1e, 0x84, 0x8c, 0xf1, 0x20, 0xaf, 0x11,
-	0x2e, 0xdc, 0x83, 0x5c, 0x0e, 0x13, 0xca, 0xc3, 0x25, 0x87, 0xbf, 0x44, 0x2e, 0x8c, 0x57, 0xcd,
-	0xce, 0xd2, 0x80, 0x7c, 0x42, 0xb8, 0x18, 0x8f, 0xd1, 0x78, 0x65, 0x32, 0xe3, 0x35, 0x29, 0x46,
-	0x1b, 0x8e, 0xd1, 0x5a, 0xa5, 0x9e, 0xdb, 0x47, 0xcc, 0x2e, 0x84, 0x96, 0x30, 0x82, 0x39, 0x8a,
-	0xb6, 0x62, 0x4f, 0x71, 0x31, 0xee, 0xd2, 0xbc, 0x74, 0xe5, 0x75, 0x6d, 0xa2, 0x7f, 0x35, 0x57,
-	0xff, 0x3e, 0xc6, 0xb6, 0x50, 0x5b, 0x7d, 0x08, 0x4d, 0x94, 0x17, 0x7d, 0x85, 0xc5, 0x0b, 0xcc,
-	0x2a, 0x64, 0x76, 0xc9, 0xb1, 0x7e, 0x83, 0xb9, 0x27, 0xae, 0xc8, 0x97, 0x1d, 0x48, 0x8d, 0x54,
-	0x73, 0x40, 0x38, 0xb8, 0xe8, 0xb7, 0x6f, 0x7e, 0x39, 0xae, 0xa2, 0xaf, 0xc7, 0x55, 0xf4, 0xfd,
-	0xb8, 0x8a, 0x9e, 0x35, 0x7e, 0x63, 0xbd, 0x79, 0x9d, 0x00, 0xc2, 0xe1, 0xba, 0xde, 0x2d, 0xba,
-	0x6d, 0xb6, 0xf1, 0x33, 0x00, 0x00, 0xff, 0xff, 0x08, 0xdd, 0xd6, 0xe7, 0xcf, 0x07, 0x00, 0x00,
+	proto.RegisterFile("server/project/project.proto", fileDescriptor_project_2eb5934a24c418a8)
+}
+
+var fileDescriptor_project_2eb5934a24c418a8 = []byte{
+	// 1073 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x5f, 0x6f, 0x1b, 0x45,
+	0x10, 0xd7, 0xc6, 0x89, 0x9b, 0x8c, 0x1b, 0x5a, 0x96, 0x24, 0x75, 0x8f, 0xc4, 0xb5, 0x56, 0x08,
+	0x85, 0xa8, 0xb9, 0xc3, 0x09, 0x88, 0x50, 0x84, 0x50, 0x80, 0xb4, 0x0a, 0xe2, 0xa1, 0xb8, 0x44,
+	0xe2, 0xcf, 0x43, 0xd9, 0x9e, 0x47, 0xce, 0x25, 0xf6, 0xdd, 0x75, 0x77, 0xed, 0x62, 0x45, 0x79,
+	0xa9, 0x2a, 0x24, 0x40, 0x88, 0x07, 0x3e, 0x02, 0x1f, 0x84, 0x57, 0x1e, 0x91, 0xf8, 0x02, 0x28,
+	0xe2, 0x9d, 0x4f, 0x80, 0x84, 0x76, 0x6f, 0xef, 0x7c, 0x67, 0xe7, 0x50, 0x23, 0x22, 0x9e, 0x32,
+	0x3b, 0x3b, 0x3b, 0xf3, 0x9b, 0xdf, 0xfc, 0xf1, 0x05, 0x56, 0x25, 0x8a, 0x21, 0x0a, 0x2f, 0x16,
+	0xd1, 0x11, 0xfa, 0x2a, 0xfd, 0xeb, 0xc6, 0x22, 0x52, 0x11, 0xbd, 0x62, 0x8f, 0xce, 0x52, 0x37,
+	0xea, 0x46, 0x46, 0xe7, 0x69, 0x29, 0xb9, 0x76, 0x56, 0xbb, 0x51, 0xd4, 0xed, 0xa1, 0xc7, 0xe3,
+	0xc0, 0xe3, 0x61, 0x18, 0x29, 0xae, 0x82, 0x28, 0x94, 0xf6, 0x96, 0x1d, 0xef, 0x48, 0x37, 0x88,
+	0xcc, 0xad, 0x1f, 0x09, 0xf4, 0x86, 0x2d, 0xaf, 0x8b, 0x21, 0x0a, 0xae, 0xb0, 0x63, 0x6d, 0xde,
+	0x18, 0xdb, 0xf4, 0xb9, 0x7f, 0x18, 0x84, 0x28, 0x46, 0x5e, 0x7c, 0xdc, 0xd5, 0x0a, 0xe9, 0xf5,
+	0x51, 0xf1, 0xf3, 0x5e, 0xed, 0x77, 0x03, 0x75, 0x38, 0x78, 0xe4, 0xfa, 0x51, 0xdf, 0xe3, 0xc2,
+	0x00, 0x3b, 0x32, 0xc2, 0xa6, 0xdf, 0x19, 0xbf, 0xe6, 0x71, 0xdc, 0x0b, 0x7c, 0x03, 0xc9, 0x1b,
+	0xb6, 0x78, 0x2f, 0x3e, 0xe4, 0x53, 0xae, 0xd8, 0x8f, 0x04, 0x96, 0xee, 0x27, 0x49, 0x7e, 0x20,
+	0x90, 0x2b, 0x6c, 0xe3, 0xe3, 0x01, 0x4a, 0x45, 0x1f, 0x42, 0x9a, 0x7c, 0x9d, 0x34, 0xc9, 0x7a,
+	0x6d, 0x6b, 0xcf, 0x1d, 0x47, 0x75, 0xd3, 0xa8, 0x46, 0x78, 0xe8, 0x77, 0xdc, 0xf8, 0xb8, 0xeb,
+	0xea, 0xa8, 0x6e, 0x2e, 0xaa, 0x9b, 0x46, 0x75, 0x77, 0xe3, 0xd8, 0x06, 0x69, 0xa7, 0x5e, 0xe9,
+	0x0a, 0x54, 0x07, 0xb1, 0x44, 0xa1, 0xea, 0x33, 0x4d, 0xb2, 0x3e, 0xdf, 0xb6, 0x27, 0xf6, 0x25,
+	0xdc, 0xb4, 0xb6, 0x9f, 0x46, 0xc7, 0x18, 0x7e, 0x88, 0x3d, 0x1c, 0xa3, 0xaa, 0x17, 0x51, 0x2d,
+	0x8c, 0xdd, 0x51, 0x98, 0x15, 0x51, 0x0f, 0x8d, 0xb3, 0x85, 0xb6, 0x91, 0xe9, 0x75, 0xa8, 0x04,
+	0x5c, 0xd5, 0x2b, 0x4d, 0xb2, 0x5e, 0x69, 0x6b, 0x91, 0x7d, 0x4b, 0x8a, 0xde, 0x8b, 0x39, 0x97,
+	0x7b, 0x6f, 0x42, 0xad, 0x83, 0xd2, 0x17, 0x41, 0xac, 0x13, 0xb3, 0x41, 0xf2, 0xaa, 0x2c, 0x7e,
+	0x25, 0x17, 0x7f, 0x15, 0x16, 0xf0, 0xeb, 0x38, 0x10, 0x28, 0xf7, 0xc3, 0xfa, 0xac, 0x41, 0x31,
+	0x56, 0xb0, 0xdb, 0xb0, 0x94, 0x87, 0xd2, 0x46, 0x19, 0x47, 0xa1, 0x44, 0xba, 0x04, 0x73, 0x4a,
+	0x2b, 0x2c, 0x86, 0xe4, 0xc0, 0xee, 0xc2, 0x4a, 0xde, 0xfa, 0x40, 0xf2, 0x2e, 0x7e, 0x32, 0x40,
+	0x31, 0xba, 0x18, 0x27, 0xec, 0x6f, 0x02, 0x2f, 0x4e, 0x39, 0x4a, 0x99, 0x22, 0x19, 0x53, 0xd4,
+	0x81, 0x79, 0x91, 0xd0, 0x22, 0xcd, 0xfb, 0x4a, 0x3b, 0x3b, 0x6b, 0x84, 0x72, 0x14, 0xfa, 0xd2,
+	0x32, 0x9b, 0x1c, 0x92, 0x17, 0xda, 0x2f, 0x76, 0x6c, 0xb2, 0xd9, 0x99, 0xbe, 0x02, 0x8b, 0x8f,
+	0x07, 0x91, 0xe2, 0xed, 0xd4, 0xe5, 0x9c, 0x31, 0x28, 0x2a, 0x69, 0x03, 0xc0, 0x28, 0x1e, 0x18,
+	0xe7, 0x55, 0x63, 0x92, 0xd3, 0x50, 0x06, 0x57, 0xed, 0x03, 0x89, 0x6a, 0x57, 0xd5, 0xaf, 0x18,
+	0x8b, 0x82, 0x4e, 0xfb, 0xe8, 0x71, 0xa9, 0x0e, 0x24, 0x76, 0x76, 0x55, 0x7d, 0x3e, 0xf1, 0x31,
+	0xd6, 0xb0, 0x7d, 0x58, 0x9e, 0x4a, 0xff, 0xe3, 0x40, 0x2a, 0xfa, 0x3a, 0xcc, 0x05, 0x0a, 0xfb,
+	0xb2, 0x4e, 0x9a, 0x95, 0xf5, 0xda, 0x96, 0xe3, 0xa6, 0xab, 0x60, 0xca, 0xbc, 0x9d, 0x18, 0x32,
+	0x06, 0x57, 0xed, 0x5d, 0x52, 0x08, 0x0a, 0xb3, 0x21, 0xef, 0xa3, 0xad, 0x82, 0x91, 0xd9, 0x93,
+	0xac, 0xc8, 0x07, 0x71, 0xe7, 0x7f, 0x1c, 0x2f, 0x76, 0x0d, 0x16, 0xf7, 0xfa, 0xb1, 0x1a, 0xa5,
+	0x6d, 0xc5, 0x6e, 0x8c, 0x13, 0xc7, 0x7e, 0xdc, 0xe3, 0x0a, 0xa5, 0x81, 0xcd, 0x9e, 0xc0, 0x4b,
+	0x13, 0x17, 0x86, 0x8f, 0xaf, 0x8a, 0x7c, 0x7c, 0xf4, 0x1f, 0xf0, 0x4d, 0xb8, 0x4f, 0xf9, 0x7b,
+	0x36, 0x03, 0xcd, 0xc2, 0xee, 0xb9, 0x2b, 0xa2, 0x7e, 0x66, 0x64, 0x89, 0x72, 0x60, 0x5e, 0x59,
+	0x95, 0x25, 0x36, 0x3b, 0x67, 0x84, 0xcf, 0x8c, 0x09, 0xa7, 0x9f, 0x03, 0xc4, 0x5c, 0xf0, 0x3e,
+	0x2a, 0x14, 0xba, 0x41, 0x35, 0xf6, 0xb7, 0x27, 0x6b, 0x59, 0x1a, 0xce, 0xbd, 0x9f, 0xbd, 0xdd,
+	0x0b, 0x95, 0x18, 0xb5, 0x73, 0xce, 0x72, 0x1b, 0x6b, 0x36, 0xbf, 0xb1, 0x9c, 0x77, 0xe1, 0xda,
+	0xc4, 0x33, 0x3d, 0x4f, 0xc7, 0x38, 0xb2, 0x80, 0xb5, 0xa8, 0x67, 0x66, 0xc8, 0x7b, 0x83, 0x14,
+	0x6c, 0x72, 0xb8, 0x33, 0xb3, 0x43, 0xb6, 0xfe, 0xaa, 0xc1, 0x0b, 0x16, 0xd7, 0x03, 0x14, 0xc3,
+	0xc0, 0x47, 0xfa, 0x1d, 0x81, 0x5a, 0x82, 0xd1, 0x74, 0x1d, 0x65, 0xe7, 0x36, 0x63, 0x61, 0x79,
+	0x39, 0x6b, 0xe7, 0xda, 0x64, 0xe5, 0xdf, 0x79, 0xfa, 0xfb, 0x9f, 0x3f, 0xcd, 0x6c, 0xb1, 0x4d,
+	0xf3, 0x7b, 0x34, 0x6c, 0xa5, 0xbf, 0x74, 0xd2, 0x3b, 0xb1, 0xd2, 0xa9, 0xa7, 0x17, 0x84, 0xf4,
+	0x4e, 0xf4, 0x9f, 0x53, 0xcf, 0xac, 0x9d, 0x3b, 0x64, 0x83, 0x7e, 0x43, 0xa0, 0x96, 0x6c, 0xe1,
+	0x7f, 0x03, 0x53, 0xd8, 0xd3, 0xce, 0x4a, 0x66, 0x53, 0x6c, 0xc2, 0x77, 0x0c, 0x8a, 0x37, 0x37,
+	0xb6, 0x2f, 0x84, 0xc2, 0x3b, 0x09, 0xb8, 0x3a, 0xa5, 0x3f, 0x10, 0x58, 0xbc, 0x87, 0xf9, 0xb5,
+	0x75, 0xab, 0x7c, 0x48, 0x4d, 0x6f, 0x3b, 0x8d, 0x72, 0x03, 0xdd, 0xe4, 0x29, 0x1e, 0x7a, 0x31,
+	0x3c, 0x9b, 0x03, 0x13, 0xfd, 0x7b, 0x02, 0xd5, 0xa4, 0x06, 0x74, 0xed, 0xfc, 0x0e, 0x4b, 0xe9,
+	0xb8, 0x9c, 0xe1, 0x66, 0x2f, 0x1b, 0xb4, 0xcb, 0xec, 0xfa, 0x24, 0x5a, 0x5d, 0xa6, 0xa7, 0x04,
+	0x66, 0xcd, 0xe0, 0x2e, 0x4f, 0x62, 0x49, 0xa8, 0xd8, 0xbf, 0x14, 0x0c, 0x86, 0xb5, 0xba, 0xc1,
+	0x41, 0xe9, 0x14, 0x0e, 0xfa, 0x8c, 0x40, 0xe5, 0x1e, 0x96, 0x62, 0xb8, 0x24, 0x1e, 0x6e, 0x99,
+	0xf8, 0x37, 0xe9, 0x8d, 0xe9, 0xaa, 0xe9, 0x1d, 0x70, 0x4a, 0x7f, 0x26, 0x50, 0x4d, 0xf6, 0xed,
+	0x74, 0x65, 0x0a, 0x7b, 0xf8, 0xb2, 0x10, 0x6d, 0x1b, 0x44, 0x9b, 0xce, 0x7a, 0x69, 0x1f, 0xb9,
+	0x7d, 0x54, 0xbc, 0xc3, 0x15, 0x77, 0x0d, 0x44, 0x5d, 0xb1, 0xcf, 0xa0, 0x9a, 0x4c, 0x4d, 0x19,
+	0x5d, 0x65, 0x53, 0x64, 0xf3, 0xdf, 0x28, 0xcd, 0x3f, 0x82, 0x45, 0x5d, 0xa8, 0x6c, 0xd1, 0xd3,
+	0xe9, 0x39, 0x28, 0xfc, 0x06, 0x38, 0xab, 0x65, 0xf7, 0xa6, 0xde, 0x4d, 0x13, 0xcf, 0xa1, 0xf5,
+	0x89, 0x78, 0x2a, 0xf3, 0xff, 0x0b, 0x01, 0x3a, 0xbd, 0x54, 0xe9, 0x6b, 0xcf, 0xbd, 0x78, 0x2f,
+	0xab, 0x10, 0x6f, 0x19, 0xa8, 0x2d, 0x76, 0xbb, 0x0c, 0xaa, 0x77, 0x92, 0x8a, 0xa7, 0x85, 0xf1,
+	0x39, 0x02, 0xd0, 0xb9, 0xee, 0x0d, 0x31, 0x54, 0xb2, 0xac, 0x20, 0x6b, 0x6e, 0xf2, 0xbd, 0xae,
+	0xb1, 0xb8, 0x7e, 0x24, 0xd0, 0x1d, 0xb6, 0x5c, 0xf3, 0xc4, 0xf0, 0xf4, 0xaa, 0x09, 0xde, 0xa4,
+	0x8d, 0x92, 0xba, 0x78, 0x68, 0xbc, 0xbf, 0xff, 0xde, 0xaf, 0x67, 0x0d, 0xf2, 0xdb, 0x59, 0x83,
+	0xfc, 0x71, 0xd6, 0x20, 0x5f, 0xb4, 0x9e, 0xe3, 0x6b, 0xde, 0xef, 0x05, 0x18, 0x66, 0xff, 0x9d,
+	0x3c, 0xaa, 0x9a, 0x8f, 0xf7, 0xed, 0x7f, 0x06, 0x00, 0x12, 0x24, 0x7c, 0x3d, 0xbe, 0x0c, 0x00,
+	0x00,
 }
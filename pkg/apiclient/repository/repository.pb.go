@@ -57,7 +57,7 @@ func (m *RepoAppsQuery) Reset()         { *m = RepoAppsQuery{} }
 func (m *RepoAppsQuery) String() string { return proto.CompactTextString(m) }
 func (*RepoAppsQuery) ProtoMessage()    {}
 func (*RepoAppsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_f708b278e3c45c90, []int{0}
+	return fileDescriptor_repository_ff0637cdb50fe17f, []int{0}
 }
 func (m *RepoAppsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -113,7 +113,7 @@ func (m *AppInfo) Reset()         { *m = AppInfo{} }
 func (m *AppInfo) String() string { return proto.CompactTextString(m) }
 func (*AppInfo) ProtoMessage()    {}
 func (*AppInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_f708b278e3c45c90, []int{1}
+	return fileDescriptor_repository_ff0637cdb50fe17f, []int{1}
 }
 func (m *AppInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -172,7 +172,7 @@ func (m *RepoAppDetailsQuery) Reset()         { *m = RepoAppDetailsQuery{} }
 func (m *RepoAppDetailsQuery) String() string { return proto.CompactTextString(m) }
 func (*RepoAppDetailsQuery) ProtoMessage()    {}
 func (*RepoAppDetailsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_f708b278e3c45c90, []int{2}
+	return fileDescriptor_repository_ff0637cdb50fe17f, []int{2}
 }
 func (m *RepoAppDetailsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -248,7 +248,7 @@ func (m *RepoAppsResponse) Reset()         { *m = RepoAppsResponse{} }
 func (m *RepoAppsResponse) String() string { return proto.CompactTextString(m) }
 func (*RepoAppsResponse) ProtoMessage()    {}
 func (*RepoAppsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_f708b278e3c45c90, []int{3}
+	return fileDescriptor_repository_ff0637cdb50fe17f, []int{3}
 }
 func (m *RepoAppsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -296,7 +296,7 @@ func (m *RepoQuery) Reset()         { *m = RepoQuery{} }
 func (m *RepoQuery) String() string { return proto.CompactTextString(m) }
 func (*RepoQuery) ProtoMessage()    {}
 func (*RepoQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_f708b278e3c45c90, []int{4}
+	return fileDescriptor_repository_ff0637cdb50fe17f, []int{4}
 }
 func (m *RepoQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -362,7 +362,7 @@ func (m *RepoAccessQuery) Reset()         { *m = RepoAccessQuery{} }
 func (m *RepoAccessQuery) String() string { return proto.CompactTextString(m) }
 func (*RepoAccessQuery) ProtoMessage()    {}
 func (*RepoAccessQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_f708b278e3c45c90, []int{5}
+	return fileDescriptor_repository_ff0637cdb50fe17f, []int{5}
 }
 func (m *RepoAccessQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -471,7 +471,7 @@ func (m *RepoResponse) Reset()         { *m = RepoResponse{} }
 func (m *RepoResponse) String() string { return proto.CompactTextString(m) }
 func (*RepoResponse) ProtoMessage()    {}
 func (*RepoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_f708b278e3c45c90, []int{6}
+	return fileDescriptor_repository_ff0637cdb50fe17f, []int{6}
 }
 func (m *RepoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -501,18 +501,20 @@ func (m *RepoResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_RepoResponse proto.InternalMessageInfo
 
 type RepoCreateRequest struct {
-	Repo                 *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo" json:"repo,omitempty"`
-	Upsert               bool                 `protobuf:"varint,2,opt,name=upsert,proto3" json:"upsert,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	Repo   *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo" json:"repo,omitempty"`
+	Upsert bool                 `protobuf:"varint,2,opt,name=upsert,proto3" json:"upsert,omitempty"`
+	// Whether to create a push webhook in the GitHub or GitLab repository, using the password as API token
+	CreateWebhook        bool     `protobuf:"varint,3,opt,name=createWebhook,proto3" json:"createWebhook,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *RepoCreateRequest) Reset()         { *m = RepoCreateRequest{} }
 func (m *RepoCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*RepoCreateRequest) ProtoMessage()    {}
 func (*RepoCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_f708b278e3c45c90, []int{7}
+	return fileDescriptor_repository_ff0637cdb50fe17f, []int{7}
 }
 func (m *RepoCreateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -555,6 +557,13 @@ func (m *RepoCreateRequest) GetUpsert() bool {
 	return false
 }
 
+func (m *RepoCreateRequest) GetCreateWebhook() bool {
+	if m != nil {
+		return m.CreateWebhook
+	}
+	return false
+}
+
 type RepoUpdateRequest struct {
 	Repo                 *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo" json:"repo,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
@@ -566,7 +575,7 @@ func (m *RepoUpdateRequest) Reset()         { *m = RepoUpdateRequest{} }
 func (m *RepoUpdateRequest) String() string { return proto.CompactTextString(m) }
 func (*RepoUpdateRequest) ProtoMessage()    {}
 func (*RepoUpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_f708b278e3c45c90, []int{8}
+	return fileDescriptor_repository_ff0637cdb50fe17f, []int{8}
 }
 func (m *RepoUpdateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -627,6 +636,8 @@ const _ = grpc.SupportPackageIsVersion4
 type RepositoryServiceClient interface {
 	// List returns list of repos
 	List(ctx context.Context, in *RepoQuery, opts ...grpc.CallOption) (*v1alpha1.RepositoryList, error)
+	// ListFailures returns the most recent failed requests to repos, optionally of a single repo
+	ListFailures(ctx context.Context, in *RepoQuery, opts ...grpc.CallOption) (*v1alpha1.RepositoryFailureList, error)
 	// ListApps returns list of apps in the repo
 	ListApps(ctx context.Context, in *RepoAppsQuery, opts ...grpc.CallOption) (*RepoAppsResponse, error)
 	// GetAppDetails returns application details by given path
@@ -658,6 +669,15 @@ func (c *repositoryServiceClient) List(ctx context.Context, in *RepoQuery, opts
 	return out, nil
 }
 
+func (c *repositoryServiceClient) ListFailures(ctx context.Context, in *RepoQuery, opts ...grpc.CallOption) (*v1alpha1.RepositoryFailureList, error) {
+	out := new(v1alpha1.RepositoryFailureList)
+	err := c.cc.Invoke(ctx, "/repository.RepositoryService/ListFailures", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *repositoryServiceClient) ListApps(ctx context.Context, in *RepoAppsQuery, opts ...grpc.CallOption) (*RepoAppsResponse, error) {
 	out := new(RepoAppsResponse)
 	err := c.cc.Invoke(ctx, "/repository.RepositoryService/ListApps", in, out, opts...)
@@ -717,6 +737,8 @@ func (c *repositoryServiceClient) ValidateAccess(ctx context.Context, in *RepoAc
 type RepositoryServiceServer interface {
 	// List returns list of repos
 	List(context.Context, *RepoQuery) (*v1alpha1.RepositoryList, error)
+	// ListFailures returns the most recent failed requests to repos, optionally of a single repo
+	ListFailures(context.Context, *RepoQuery) (*v1alpha1.RepositoryFailureList, error)
 	// ListApps returns list of apps in the repo
 	ListApps(context.Context, *RepoAppsQuery) (*RepoAppsResponse, error)
 	// GetAppDetails returns application details by given path
@@ -753,6 +775,24 @@ func _RepositoryService_List_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RepositoryService_ListFailures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepoQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).ListFailures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/repository.RepositoryService/ListFailures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).ListFailures(ctx, req.(*RepoQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RepositoryService_ListApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RepoAppsQuery)
 	if err := dec(in); err != nil {
@@ -869,6 +909,10 @@ var _RepositoryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "List",
 			Handler:    _RepositoryService_List_Handler,
 		},
+		{
+			MethodName: "ListFailures",
+			Handler:    _RepositoryService_ListFailures_Handler,
+		},
 		{
 			MethodName: "ListApps",
 			Handler:    _RepositoryService_ListApps_Handler,
@@ -1224,6 +1268,16 @@ func (m *RepoCreateRequest) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if m.CreateWebhook {
+		dAtA[i] = 0x18
+		i++
+		if m.CreateWebhook {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -1428,6 +1482,9 @@ func (m *RepoCreateRequest) Size() (n int) {
 	if m.Upsert {
 		n += 2
 	}
+	if m.CreateWebhook {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2509,6 +2566,26 @@ func (m *RepoCreateRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Upsert = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateWebhook", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CreateWebhook = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -2721,62 +2798,65 @@ var (
 )
 
 func init() {
-	proto.RegisterFile("server/repository/repository.proto", fileDescriptor_repository_f708b278e3c45c90)
-}
-
-var fileDescriptor_repository_f708b278e3c45c90 = []byte{
-	// 838 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x5b, 0x8b, 0x23, 0x45,
-	0x14, 0xa6, 0x67, 0xb3, 0xb9, 0xd4, 0x38, 0x7b, 0xa9, 0x5d, 0x97, 0xd8, 0x9b, 0x9d, 0x09, 0xe5,
-	0x82, 0xd9, 0x65, 0xed, 0x26, 0x59, 0x1f, 0x44, 0x14, 0x99, 0x8b, 0xe8, 0xb0, 0x3e, 0x68, 0x8b,
-	0x82, 0x3e, 0x28, 0x35, 0x9d, 0x63, 0xa7, 0x4c, 0xa7, 0xab, 0xac, 0xaa, 0xb4, 0x84, 0x61, 0x5e,
-	0x04, 0xe7, 0x07, 0xe8, 0xbb, 0x8f, 0xfe, 0x16, 0x41, 0x1f, 0x04, 0xff, 0x80, 0x0c, 0xfe, 0x10,
-	0xa9, 0xea, 0x4b, 0x3a, 0x97, 0xc9, 0x88, 0x0c, 0xbe, 0x9d, 0x3a, 0x75, 0xce, 0xf9, 0xbe, 0x3a,
-	0xb7, 0x6e, 0x44, 0x14, 0xc8, 0x14, 0xa4, 0x2f, 0x41, 0x70, 0xc5, 0x34, 0x97, 0xb3, 0x8a, 0xe8,
-	0x09, 0xc9, 0x35, 0xc7, 0x68, 0xae, 0x71, 0xef, 0x47, 0x3c, 0xe2, 0x56, 0xed, 0x1b, 0x29, 0xb3,
-	0x70, 0x3b, 0x11, 0xe7, 0x51, 0x0c, 0x3e, 0x15, 0xcc, 0xa7, 0x49, 0xc2, 0x35, 0xd5, 0x8c, 0x27,
-	0x2a, 0xbf, 0x25, 0xe3, 0x37, 0x95, 0xc7, 0xb8, 0xbd, 0x0d, 0xb9, 0x04, 0x3f, 0xed, 0xfb, 0x11,
-	0x24, 0x20, 0xa9, 0x86, 0x61, 0x6e, 0x73, 0x1c, 0x31, 0x3d, 0x9a, 0x9e, 0x78, 0x21, 0x9f, 0xf8,
-	0x54, 0x5a, 0x88, 0x6f, 0xac, 0xf0, 0x7a, 0x38, 0xf4, 0xc5, 0x38, 0x32, 0xce, 0xca, 0xa7, 0x42,
-	0xc4, 0x2c, 0xb4, 0xc1, 0xfd, 0xb4, 0x4f, 0x63, 0x31, 0xa2, 0xab, 0xa1, 0x0e, 0x36, 0x85, 0xb2,
-	0x4f, 0xb9, 0xf2, 0xc9, 0xe4, 0x5d, 0xb4, 0x13, 0x80, 0xe0, 0xfb, 0x42, 0xa8, 0x8f, 0xa7, 0x20,
-	0x67, 0x18, 0xa3, 0x9a, 0x31, 0x6a, 0x3b, 0x5d, 0xa7, 0xd7, 0x0a, 0xac, 0x8c, 0x5d, 0xd4, 0x94,
-	0x90, 0x32, 0xc5, 0x78, 0xd2, 0xde, 0xb2, 0xfa, 0xf2, 0x4c, 0xfa, 0xa8, 0xb1, 0x2f, 0xc4, 0x71,
-	0xf2, 0x35, 0x37, 0xae, 0x7a, 0x26, 0xa0, 0x70, 0x35, 0xb2, 0xd1, 0x09, 0xaa, 0x47, 0xb9, 0x9b,
-	0x95, 0xc9, 0xef, 0x0e, 0xba, 0x97, 0x83, 0x1e, 0x81, 0xa6, 0x2c, 0xfe, 0x6f, 0xd0, 0x65, 0xec,
-	0x1b, 0xf3, 0xd8, 0xf8, 0x39, 0xaa, 0x8d, 0x20, 0x9e, 0xb4, 0x6b, 0x5d, 0xa7, 0xb7, 0x3d, 0xd8,
-	0xf3, 0x2a, 0x0f, 0xfe, 0x00, 0xe2, 0xc9, 0x12, 0x64, 0x60, 0x8d, 0xf1, 0xdb, 0xa8, 0x31, 0x56,
-	0x3c, 0x49, 0x40, 0xb7, 0x6f, 0x5a, 0x3f, 0x52, 0xf5, 0x7b, 0x91, 0x5d, 0x2d, 0xbb, 0x16, 0x2e,
-	0xe4, 0x1d, 0x74, 0xa7, 0x48, 0x61, 0x00, 0x4a, 0xf0, 0x44, 0x01, 0x7e, 0x82, 0x6e, 0x32, 0x0d,
-	0x13, 0xd5, 0x76, 0xba, 0x37, 0x7a, 0xdb, 0x83, 0x7b, 0xd5, 0x78, 0x79, 0xba, 0x82, 0xcc, 0x82,
-	0xec, 0xa1, 0x96, 0x71, 0xbf, 0x34, 0x05, 0xe4, 0xb7, 0x2d, 0x74, 0xdb, 0x02, 0x84, 0x21, 0xa8,
-	0xcd, 0xa9, 0x9a, 0x2a, 0x90, 0x09, 0x9d, 0x40, 0x91, 0xaa, 0xe2, 0x6c, 0xee, 0x04, 0x55, 0xea,
-	0x3b, 0x2e, 0x87, 0x79, 0xba, 0xca, 0x33, 0x7e, 0x8c, 0x76, 0x94, 0x1a, 0x7d, 0x24, 0x59, 0x4a,
-	0x35, 0xbc, 0x80, 0x99, 0xcd, 0x5d, 0x2b, 0x58, 0x54, 0x9a, 0x08, 0x2c, 0x51, 0x10, 0x4e, 0x25,
-	0xd8, 0x24, 0x35, 0x83, 0xf2, 0x8c, 0x9f, 0xa1, 0xbb, 0x3a, 0x56, 0x87, 0x31, 0x83, 0x44, 0x1f,
-	0x82, 0xd4, 0x47, 0x54, 0xd3, 0x76, 0xdd, 0x46, 0x59, 0xbd, 0xc0, 0x4f, 0xd1, 0x9d, 0x05, 0xa5,
-	0x81, 0x6c, 0x58, 0xe3, 0x15, 0x3d, 0xee, 0xa1, 0xdb, 0x73, 0xdd, 0xbe, 0x8d, 0xdb, 0xb4, 0xa6,
-	0xcb, 0xea, 0xb2, 0xf9, 0x5a, 0x8b, 0xcd, 0x67, 0xb3, 0x81, 0x32, 0x9d, 0x91, 0xc9, 0x2d, 0xf4,
-	0x92, 0x49, 0x66, 0x51, 0x29, 0x72, 0xee, 0xa0, 0xbb, 0x46, 0x71, 0x28, 0x81, 0x6a, 0x08, 0xe0,
-	0xdb, 0x29, 0x28, 0x8d, 0x3f, 0xaf, 0xe4, 0x77, 0x7b, 0xf0, 0x9e, 0x37, 0x9f, 0x34, 0xaf, 0x98,
-	0x34, 0x2b, 0x7c, 0x15, 0x0e, 0x3d, 0x31, 0x8e, 0x3c, 0x33, 0xb4, 0x5e, 0x65, 0x68, 0xbd, 0x62,
-	0x68, 0xbd, 0xa0, 0x2c, 0x7c, 0x5e, 0xa6, 0x07, 0xa8, 0x3e, 0x15, 0x0a, 0xa4, 0xb6, 0x45, 0x6a,
-	0x06, 0xf9, 0x89, 0x24, 0x19, 0x8f, 0x4f, 0xc5, 0xf0, 0x7f, 0xe1, 0x31, 0xf8, 0xa5, 0x91, 0x01,
-	0x66, 0xca, 0x4f, 0x40, 0xa6, 0x2c, 0x04, 0x7c, 0xee, 0xa0, 0xda, 0x87, 0x4c, 0x69, 0xfc, 0x72,
-	0xb5, 0x65, 0xcb, 0x06, 0x75, 0x8f, 0xaf, 0x85, 0x82, 0x41, 0x20, 0x9d, 0xef, 0xff, 0xfc, 0xfb,
-	0xa7, 0xad, 0x07, 0xf8, 0xbe, 0xdd, 0x97, 0x69, 0x7f, 0xbe, 0x9c, 0x18, 0x28, 0x3c, 0x41, 0x4d,
-	0x63, 0x65, 0xa6, 0x0a, 0xbf, 0xb2, 0xcc, 0xa5, 0x5c, 0x57, 0x6e, 0x67, 0xdd, 0x55, 0x59, 0xdc,
-	0x9e, 0x85, 0x20, 0xb8, 0xbb, 0x0e, 0xc2, 0x3f, 0x35, 0xa7, 0x33, 0xb3, 0x6b, 0x15, 0xfe, 0xc1,
-	0x41, 0x3b, 0xef, 0x57, 0x87, 0x1c, 0xef, 0xad, 0x89, 0x5c, 0x5d, 0x00, 0x2e, 0xb9, 0xdc, 0xa0,
-	0x24, 0xe0, 0x5b, 0x02, 0x4f, 0xf0, 0x6b, 0x57, 0x11, 0xf0, 0x4f, 0xcd, 0xfa, 0x3a, 0xc3, 0x3f,
-	0x3a, 0xa8, 0x9e, 0xb5, 0x22, 0x7e, 0xb4, 0x1c, 0x7f, 0xa1, 0x45, 0xdd, 0xeb, 0x69, 0x06, 0x42,
-	0x2c, 0xc3, 0x0e, 0x59, 0x5b, 0x85, 0xb7, 0xb2, 0x96, 0xfd, 0xd9, 0x41, 0xf5, 0xac, 0x2f, 0x57,
-	0x49, 0x2d, 0xf4, 0xeb, 0x75, 0x91, 0xf2, 0x2c, 0xa9, 0x9e, 0xbb, 0xa1, 0x6e, 0x96, 0xc7, 0x59,
-	0x4e, 0xf0, 0x4b, 0x54, 0x3f, 0x82, 0x18, 0x34, 0x5c, 0xd6, 0xb6, 0xed, 0x65, 0x75, 0x59, 0xa1,
-	0x57, 0x2d, 0xd4, 0xa3, 0xa7, 0x0f, 0x37, 0x54, 0x08, 0x9f, 0xa2, 0x5b, 0x9f, 0xd1, 0x98, 0x99,
-	0x97, 0x66, 0x5b, 0x18, 0x3f, 0x5c, 0x29, 0xfe, 0x7c, 0x3b, 0x6f, 0x40, 0x1b, 0x58, 0xb4, 0x67,
-	0xe4, 0xf1, 0xa6, 0x7e, 0x48, 0x73, 0xa8, 0xec, 0x71, 0x07, 0x07, 0xbf, 0x5e, 0xec, 0x3a, 0x7f,
-	0x5c, 0xec, 0x3a, 0x7f, 0x5d, 0xec, 0x3a, 0x5f, 0xbc, 0xf1, 0x2f, 0xfe, 0x1f, 0x42, 0xbb, 0x18,
-	0x2b, 0x1f, 0xfb, 0x93, 0xba, 0xfd, 0xda, 0x3f, 0xff, 0x27, 0x00, 0x00, 0xff, 0xff, 0xf7, 0x29,
-	0xcd, 0xc6, 0x06, 0x09, 0x00, 0x00,
+	proto.RegisterFile("server/repository/repository.proto", fileDescriptor_repository_ff0637cdb50fe17f)
+}
+
+var fileDescriptor_repository_ff0637cdb50fe17f = []byte{
+	// 885 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x5b, 0x6f, 0x1b, 0x45,
+	0x14, 0xd6, 0xa6, 0xa9, 0xe3, 0x4c, 0x9a, 0xb6, 0x99, 0x96, 0xca, 0xb8, 0xb9, 0x69, 0x28, 0xc2,
+	0xad, 0xca, 0xae, 0xe2, 0xf2, 0x80, 0x10, 0x08, 0xe5, 0xc2, 0x25, 0x2a, 0x0f, 0x65, 0x11, 0x20,
+	0x78, 0x00, 0x4d, 0xd6, 0xa7, 0xf6, 0xe0, 0xf5, 0xce, 0x30, 0x33, 0x36, 0xb2, 0xa2, 0xbc, 0x20,
+	0xc1, 0x0f, 0x80, 0xf7, 0xfe, 0x02, 0xfe, 0x07, 0x48, 0xf0, 0x80, 0xc4, 0x1f, 0x40, 0x11, 0x3f,
+	0x04, 0xcd, 0xd9, 0xab, 0x2f, 0x71, 0x11, 0x8a, 0xfa, 0x76, 0xe6, 0xcc, 0x39, 0xe7, 0xfb, 0xe6,
+	0xdc, 0x76, 0x09, 0x33, 0xa0, 0x47, 0xa0, 0x03, 0x0d, 0x4a, 0x1a, 0x61, 0xa5, 0x1e, 0x57, 0x44,
+	0x5f, 0x69, 0x69, 0x25, 0x25, 0xa5, 0xa6, 0x79, 0xbb, 0x2b, 0xbb, 0x12, 0xd5, 0x81, 0x93, 0x52,
+	0x8b, 0xe6, 0x66, 0x57, 0xca, 0x6e, 0x0c, 0x01, 0x57, 0x22, 0xe0, 0x49, 0x22, 0x2d, 0xb7, 0x42,
+	0x26, 0x26, 0xbb, 0x65, 0xfd, 0x37, 0x8d, 0x2f, 0x24, 0xde, 0x46, 0x52, 0x43, 0x30, 0xda, 0x0b,
+	0xba, 0x90, 0x80, 0xe6, 0x16, 0x3a, 0x99, 0xcd, 0x71, 0x57, 0xd8, 0xde, 0xf0, 0xc4, 0x8f, 0xe4,
+	0x20, 0xe0, 0x1a, 0x21, 0xbe, 0x41, 0xe1, 0xf5, 0xa8, 0x13, 0xa8, 0x7e, 0xd7, 0x39, 0x9b, 0x80,
+	0x2b, 0x15, 0x8b, 0x08, 0x83, 0x07, 0xa3, 0x3d, 0x1e, 0xab, 0x1e, 0x9f, 0x0d, 0x75, 0xb0, 0x28,
+	0x14, 0x3e, 0xe5, 0xb9, 0x4f, 0x66, 0xef, 0x92, 0xf5, 0x10, 0x94, 0xdc, 0x57, 0xca, 0x7c, 0x3c,
+	0x04, 0x3d, 0xa6, 0x94, 0x2c, 0x3b, 0xa3, 0x86, 0xb7, 0xeb, 0xb5, 0x56, 0x43, 0x94, 0x69, 0x93,
+	0xd4, 0x35, 0x8c, 0x84, 0x11, 0x32, 0x69, 0x2c, 0xa1, 0xbe, 0x38, 0xb3, 0x3d, 0xb2, 0xb2, 0xaf,
+	0xd4, 0x71, 0xf2, 0x54, 0x3a, 0x57, 0x3b, 0x56, 0x90, 0xbb, 0x3a, 0xd9, 0xe9, 0x14, 0xb7, 0xbd,
+	0xcc, 0x0d, 0x65, 0xf6, 0x87, 0x47, 0x6e, 0x65, 0xa0, 0x47, 0x60, 0xb9, 0x88, 0xff, 0x1f, 0x74,
+	0x11, 0xfb, 0x4a, 0x19, 0x9b, 0x3e, 0x22, 0xcb, 0x3d, 0x88, 0x07, 0x8d, 0xe5, 0x5d, 0xaf, 0xb5,
+	0xd6, 0xde, 0xf1, 0x2b, 0x0f, 0xfe, 0x10, 0xe2, 0xc1, 0x14, 0x64, 0x88, 0xc6, 0xf4, 0x6d, 0xb2,
+	0xd2, 0x37, 0x32, 0x49, 0xc0, 0x36, 0xae, 0xa2, 0x1f, 0xab, 0xfa, 0x3d, 0x4e, 0xaf, 0xa6, 0x5d,
+	0x73, 0x17, 0xf6, 0x0e, 0xb9, 0x99, 0xa7, 0x30, 0x04, 0xa3, 0x64, 0x62, 0x80, 0xde, 0x27, 0x57,
+	0x85, 0x85, 0x81, 0x69, 0x78, 0xbb, 0x57, 0x5a, 0x6b, 0xed, 0x5b, 0xd5, 0x78, 0x59, 0xba, 0xc2,
+	0xd4, 0x82, 0xed, 0x90, 0x55, 0xe7, 0x7e, 0x61, 0x0a, 0xd8, 0xef, 0x4b, 0xe4, 0x06, 0x02, 0x44,
+	0x11, 0x98, 0xc5, 0xa9, 0x1a, 0x1a, 0xd0, 0x09, 0x1f, 0x40, 0x9e, 0xaa, 0xfc, 0xec, 0xee, 0x14,
+	0x37, 0xe6, 0x3b, 0xa9, 0x3b, 0x59, 0xba, 0x8a, 0x33, 0xbd, 0x47, 0xd6, 0x8d, 0xe9, 0x3d, 0xd1,
+	0x62, 0xc4, 0x2d, 0x3c, 0x86, 0x31, 0xe6, 0x6e, 0x35, 0x9c, 0x54, 0xba, 0x08, 0x22, 0x31, 0x10,
+	0x0d, 0x35, 0x60, 0x92, 0xea, 0x61, 0x71, 0xa6, 0x0f, 0xc9, 0x86, 0x8d, 0xcd, 0x61, 0x2c, 0x20,
+	0xb1, 0x87, 0xa0, 0xed, 0x11, 0xb7, 0xbc, 0x51, 0xc3, 0x28, 0xb3, 0x17, 0xf4, 0x01, 0xb9, 0x39,
+	0xa1, 0x74, 0x90, 0x2b, 0x68, 0x3c, 0xa3, 0xa7, 0x2d, 0x72, 0xa3, 0xd4, 0xed, 0x63, 0xdc, 0x3a,
+	0x9a, 0x4e, 0xab, 0x8b, 0xe6, 0x5b, 0x9d, 0x6c, 0x3e, 0xcc, 0x06, 0x49, 0x75, 0x4e, 0x66, 0xd7,
+	0xc9, 0x35, 0x97, 0xcc, 0xbc, 0x52, 0xec, 0x17, 0x8f, 0x6c, 0x38, 0xc5, 0xa1, 0x06, 0x6e, 0x21,
+	0x84, 0x6f, 0x87, 0x60, 0x2c, 0xfd, 0xa2, 0x92, 0xdf, 0xb5, 0xf6, 0x7b, 0x7e, 0x39, 0x69, 0x7e,
+	0x3e, 0x69, 0x28, 0x7c, 0x1d, 0x75, 0x7c, 0xd5, 0xef, 0xfa, 0x6e, 0x68, 0xfd, 0xca, 0xd0, 0xfa,
+	0xf9, 0xd0, 0xfa, 0x61, 0x51, 0xf8, 0xac, 0x4c, 0x77, 0x48, 0x6d, 0xa8, 0x0c, 0x68, 0x8b, 0x45,
+	0xaa, 0x87, 0xd9, 0xc9, 0x95, 0x21, 0x42, 0x0e, 0x9f, 0xc3, 0x49, 0x4f, 0xca, 0x3e, 0xd6, 0xa9,
+	0x1e, 0x4e, 0x2a, 0x59, 0x92, 0xb2, 0xfd, 0x54, 0x75, 0x5e, 0x08, 0xdb, 0xf6, 0xaf, 0x75, 0xb2,
+	0x51, 0x2a, 0x3f, 0x01, 0x3d, 0x12, 0x11, 0xd0, 0x1f, 0x3d, 0xb2, 0xfc, 0x91, 0x30, 0x96, 0xbe,
+	0x54, 0x6d, 0xec, 0xa2, 0x8d, 0x9b, 0xc7, 0x97, 0x42, 0xc1, 0x21, 0xb0, 0xcd, 0xef, 0xff, 0xfa,
+	0xe7, 0xe7, 0xa5, 0x3b, 0xf4, 0x36, 0x6e, 0xd5, 0xd1, 0x5e, 0xb9, 0xc2, 0x04, 0x18, 0xfa, 0xcc,
+	0x23, 0xd7, 0x9c, 0xd9, 0xfb, 0x5c, 0xc4, 0x43, 0x0d, 0xe6, 0x22, 0x42, 0x4f, 0x2e, 0x85, 0x50,
+	0x86, 0x82, 0xbc, 0x5e, 0x45, 0x5e, 0x3b, 0x74, 0x6b, 0x1e, 0xaf, 0xe0, 0x69, 0xce, 0x67, 0x40,
+	0xea, 0xce, 0xdc, 0x2d, 0x07, 0xfa, 0xf2, 0x34, 0xb7, 0x62, 0xeb, 0x36, 0x37, 0xe7, 0x5d, 0x15,
+	0x3d, 0xda, 0x42, 0x2c, 0x46, 0x77, 0xe7, 0x62, 0x9d, 0xba, 0xd3, 0x99, 0xfb, 0x64, 0x18, 0xfa,
+	0x83, 0x47, 0xd6, 0x3f, 0xa8, 0xee, 0x2a, 0xba, 0x33, 0x27, 0x72, 0x75, 0x8f, 0x35, 0xd9, 0xc5,
+	0x06, 0x05, 0x81, 0x00, 0x09, 0xdc, 0xa7, 0xaf, 0x3d, 0x8f, 0x40, 0x70, 0xea, 0xb6, 0xf0, 0x19,
+	0xfd, 0xc9, 0x23, 0xb5, 0x74, 0xa2, 0xe8, 0xd6, 0x74, 0xfc, 0x89, 0x49, 0x6b, 0x5e, 0x4e, 0xb7,
+	0x32, 0x86, 0x0c, 0x37, 0xd9, 0xdc, 0x36, 0x79, 0x2b, 0x9d, 0xbc, 0x67, 0x1e, 0xa9, 0xa5, 0x83,
+	0x33, 0x4b, 0x6a, 0x62, 0xa0, 0x2e, 0x8b, 0x94, 0x8f, 0xa4, 0x5a, 0xcd, 0x05, 0x75, 0x43, 0x1e,
+	0x67, 0x19, 0xc1, 0xaf, 0x48, 0xed, 0x08, 0x62, 0xb0, 0x70, 0x51, 0x1b, 0x37, 0xa6, 0xd5, 0x45,
+	0x85, 0x5e, 0x41, 0xa8, 0xad, 0x07, 0x77, 0x17, 0x54, 0x88, 0x9e, 0x92, 0xeb, 0x9f, 0xf1, 0x58,
+	0xb8, 0x97, 0xa6, 0x1f, 0x13, 0x7a, 0x77, 0xa6, 0xf8, 0xe5, 0x47, 0x66, 0x01, 0x5a, 0x1b, 0xd1,
+	0x1e, 0xb2, 0x7b, 0x8b, 0xfa, 0x61, 0x94, 0x41, 0xa5, 0x8f, 0x3b, 0x38, 0xf8, 0xed, 0x7c, 0xdb,
+	0xfb, 0xf3, 0x7c, 0xdb, 0xfb, 0xfb, 0x7c, 0xdb, 0xfb, 0xf2, 0x8d, 0xff, 0xf0, 0x1b, 0x14, 0xe1,
+	0x7e, 0x2f, 0x63, 0x8f, 0x4f, 0x6a, 0xf8, 0xd3, 0xf2, 0xe8, 0xdf, 0x01, 0x00, 0x9b, 0x07, 0x73,
+	0xf0, 0xcd, 0x09, 0x00, 0x00,
 }
@@ -24,6 +24,8 @@ import (
 
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	context "golang.org/x/net/context"
 
 	grpc "google.golang.org/grpc"
@@ -53,7 +55,7 @@ func (m *SettingsQuery) Reset()         { *m = SettingsQuery{} }
 func (m *SettingsQuery) String() string { return proto.CompactTextString(m) }
 func (*SettingsQuery) ProtoMessage()    {}
 func (*SettingsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_settings_b6f0d72a41cb0abb, []int{0}
+	return fileDescriptor_settings_14d3ac587a90c5d1, []int{0}
 }
 func (m *SettingsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -92,17 +94,21 @@ type Settings struct {
 	GoogleAnalytics    *GoogleAnalyticsConfig                `protobuf:"bytes,7,opt,name=googleAnalytics" json:"googleAnalytics,omitempty"`
 	KustomizeOptions   *v1alpha1.KustomizeOptions            `protobuf:"bytes,8,opt,name=kustomizeOptions" json:"kustomizeOptions,omitempty"`
 	// Help settings
-	Help                 *Help    `protobuf:"bytes,9,opt,name=help" json:"help,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Help *Help `protobuf:"bytes,9,opt,name=help" json:"help,omitempty"`
+	// Maintenance is the maintenance mode, which the UI shows as a banner while it is enabled
+	Maintenance *Maintenance `protobuf:"bytes,10,opt,name=maintenance" json:"maintenance,omitempty"`
+	// Announcements are the messages to all users which did not expire yet
+	Announcements        []*Announcement `protobuf:"bytes,11,rep,name=announcements" json:"announcements,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *Settings) Reset()         { *m = Settings{} }
 func (m *Settings) String() string { return proto.CompactTextString(m) }
 func (*Settings) ProtoMessage()    {}
 func (*Settings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_settings_b6f0d72a41cb0abb, []int{1}
+	return fileDescriptor_settings_14d3ac587a90c5d1, []int{1}
 }
 func (m *Settings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -194,6 +200,224 @@ func (m *Settings) GetHelp() *Help {
 	return nil
 }
 
+func (m *Settings) GetMaintenance() *Maintenance {
+	if m != nil {
+		return m.Maintenance
+	}
+	return nil
+}
+
+func (m *Settings) GetAnnouncements() []*Announcement {
+	if m != nil {
+		return m.Announcements
+	}
+	return nil
+}
+
+// Announcement is a message to all users of Argo CD, e.g. about planned maintenance
+type Announcement struct {
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// Severity is one of info, warning or critical
+	Severity string `protobuf:"bytes,2,opt,name=severity,proto3" json:"severity,omitempty"`
+	// URL links to the details of the announcement
+	URL                  string   `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	ExpiresAt            *v1.Time `protobuf:"bytes,4,opt,name=expiresAt" json:"expiresAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Announcement) Reset()         { *m = Announcement{} }
+func (m *Announcement) String() string { return proto.CompactTextString(m) }
+func (*Announcement) ProtoMessage()    {}
+func (*Announcement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_settings_14d3ac587a90c5d1, []int{2}
+}
+func (m *Announcement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Announcement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Announcement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *Announcement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Announcement.Merge(dst, src)
+}
+func (m *Announcement) XXX_Size() int {
+	return m.Size()
+}
+func (m *Announcement) XXX_DiscardUnknown() {
+	xxx_messageInfo_Announcement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Announcement proto.InternalMessageInfo
+
+func (m *Announcement) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *Announcement) GetSeverity() string {
+	if m != nil {
+		return m.Severity
+	}
+	return ""
+}
+
+func (m *Announcement) GetURL() string {
+	if m != nil {
+		return m.URL
+	}
+	return ""
+}
+
+func (m *Announcement) GetExpiresAt() *v1.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+// Maintenance is the maintenance mode of Argo CD, in which the automated syncs of all applications are paused
+type Maintenance struct {
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Message is shown to the users, e.g. the reason of the maintenance
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// EnabledBy is the user who enabled the maintenance mode
+	EnabledBy            string   `protobuf:"bytes,3,opt,name=enabledBy,proto3" json:"enabledBy,omitempty"`
+	EnabledAt            *v1.Time `protobuf:"bytes,4,opt,name=enabledAt" json:"enabledAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Maintenance) Reset()         { *m = Maintenance{} }
+func (m *Maintenance) String() string { return proto.CompactTextString(m) }
+func (*Maintenance) ProtoMessage()    {}
+func (*Maintenance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_settings_14d3ac587a90c5d1, []int{3}
+}
+func (m *Maintenance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Maintenance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Maintenance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *Maintenance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Maintenance.Merge(dst, src)
+}
+func (m *Maintenance) XXX_Size() int {
+	return m.Size()
+}
+func (m *Maintenance) XXX_DiscardUnknown() {
+	xxx_messageInfo_Maintenance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Maintenance proto.InternalMessageInfo
+
+func (m *Maintenance) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *Maintenance) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *Maintenance) GetEnabledBy() string {
+	if m != nil {
+		return m.EnabledBy
+	}
+	return ""
+}
+
+func (m *Maintenance) GetEnabledAt() *v1.Time {
+	if m != nil {
+		return m.EnabledAt
+	}
+	return nil
+}
+
+// MaintenanceRequest enables or disables the maintenance mode
+type MaintenanceRequest struct {
+	Enabled              bool     `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Message              string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MaintenanceRequest) Reset()         { *m = MaintenanceRequest{} }
+func (m *MaintenanceRequest) String() string { return proto.CompactTextString(m) }
+func (*MaintenanceRequest) ProtoMessage()    {}
+func (*MaintenanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_settings_14d3ac587a90c5d1, []int{4}
+}
+func (m *MaintenanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaintenanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MaintenanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *MaintenanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaintenanceRequest.Merge(dst, src)
+}
+func (m *MaintenanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaintenanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaintenanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaintenanceRequest proto.InternalMessageInfo
+
+func (m *MaintenanceRequest) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *MaintenanceRequest) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
 type GoogleAnalyticsConfig struct {
 	TrackingID           string   `protobuf:"bytes,1,opt,name=trackingID,proto3" json:"trackingID,omitempty"`
 	AnonymizeUsers       bool     `protobuf:"varint,2,opt,name=anonymizeUsers,proto3" json:"anonymizeUsers,omitempty"`
@@ -206,7 +430,7 @@ func (m *GoogleAnalyticsConfig) Reset()         { *m = GoogleAnalyticsConfig{} }
 func (m *GoogleAnalyticsConfig) String() string { return proto.CompactTextString(m) }
 func (*GoogleAnalyticsConfig) ProtoMessage()    {}
 func (*GoogleAnalyticsConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_settings_b6f0d72a41cb0abb, []int{2}
+	return fileDescriptor_settings_14d3ac587a90c5d1, []int{5}
 }
 func (m *GoogleAnalyticsConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -264,7 +488,7 @@ func (m *Help) Reset()         { *m = Help{} }
 func (m *Help) String() string { return proto.CompactTextString(m) }
 func (*Help) ProtoMessage()    {}
 func (*Help) Descriptor() ([]byte, []int) {
-	return fileDescriptor_settings_b6f0d72a41cb0abb, []int{3}
+	return fileDescriptor_settings_14d3ac587a90c5d1, []int{6}
 }
 func (m *Help) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -318,7 +542,7 @@ func (m *DexConfig) Reset()         { *m = DexConfig{} }
 func (m *DexConfig) String() string { return proto.CompactTextString(m) }
 func (*DexConfig) ProtoMessage()    {}
 func (*DexConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_settings_b6f0d72a41cb0abb, []int{4}
+	return fileDescriptor_settings_14d3ac587a90c5d1, []int{7}
 }
 func (m *DexConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -366,7 +590,7 @@ func (m *Connector) Reset()         { *m = Connector{} }
 func (m *Connector) String() string { return proto.CompactTextString(m) }
 func (*Connector) ProtoMessage()    {}
 func (*Connector) Descriptor() ([]byte, []int) {
-	return fileDescriptor_settings_b6f0d72a41cb0abb, []int{5}
+	return fileDescriptor_settings_14d3ac587a90c5d1, []int{8}
 }
 func (m *Connector) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -409,30 +633,25 @@ func (m *Connector) GetType() string {
 	return ""
 }
 
-type OIDCConfig struct {
-	Name                 string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Issuer               string                 `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
-	ClientID             string                 `protobuf:"bytes,3,opt,name=clientID,proto3" json:"clientID,omitempty"`
-	CLIClientID          string                 `protobuf:"bytes,4,opt,name=cliClientID,proto3" json:"cliClientID,omitempty"`
-	Scopes               []string               `protobuf:"bytes,5,rep,name=scopes" json:"scopes,omitempty"`
-	IDTokenClaims        map[string]*oidc.Claim `protobuf:"bytes,6,rep,name=idTokenClaims" json:"idTokenClaims,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
-	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
-	XXX_unrecognized     []byte                 `json:"-"`
-	XXX_sizecache        int32                  `json:"-"`
+// DexConnectorsHealthQuery is a query for the health of the dex connectors
+type DexConnectorsHealthQuery struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *OIDCConfig) Reset()         { *m = OIDCConfig{} }
-func (m *OIDCConfig) String() string { return proto.CompactTextString(m) }
-func (*OIDCConfig) ProtoMessage()    {}
-func (*OIDCConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_settings_b6f0d72a41cb0abb, []int{6}
+func (m *DexConnectorsHealthQuery) Reset()         { *m = DexConnectorsHealthQuery{} }
+func (m *DexConnectorsHealthQuery) String() string { return proto.CompactTextString(m) }
+func (*DexConnectorsHealthQuery) ProtoMessage()    {}
+func (*DexConnectorsHealthQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_settings_14d3ac587a90c5d1, []int{9}
 }
-func (m *OIDCConfig) XXX_Unmarshal(b []byte) error {
+func (m *DexConnectorsHealthQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *OIDCConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DexConnectorsHealthQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_OIDCConfig.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DexConnectorsHealthQuery.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -442,97 +661,401 @@ func (m *OIDCConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (dst *OIDCConfig) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OIDCConfig.Merge(dst, src)
+func (dst *DexConnectorsHealthQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DexConnectorsHealthQuery.Merge(dst, src)
 }
-func (m *OIDCConfig) XXX_Size() int {
+func (m *DexConnectorsHealthQuery) XXX_Size() int {
 	return m.Size()
 }
-func (m *OIDCConfig) XXX_DiscardUnknown() {
-	xxx_messageInfo_OIDCConfig.DiscardUnknown(m)
+func (m *DexConnectorsHealthQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_DexConnectorsHealthQuery.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OIDCConfig proto.InternalMessageInfo
+var xxx_messageInfo_DexConnectorsHealthQuery proto.InternalMessageInfo
 
-func (m *OIDCConfig) GetName() string {
-	if m != nil {
-		return m.Name
+// ConnectorHealth is the health of a dex connector
+type ConnectorHealth struct {
+	ID      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type    string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Healthy bool   `protobuf:"varint,4,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	// Message is the last error reported for the connector
+	Message              string   `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConnectorHealth) Reset()         { *m = ConnectorHealth{} }
+func (m *ConnectorHealth) String() string { return proto.CompactTextString(m) }
+func (*ConnectorHealth) ProtoMessage()    {}
+func (*ConnectorHealth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_settings_14d3ac587a90c5d1, []int{10}
+}
+func (m *ConnectorHealth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConnectorHealth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConnectorHealth.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (dst *ConnectorHealth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConnectorHealth.Merge(dst, src)
+}
+func (m *ConnectorHealth) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConnectorHealth) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConnectorHealth.DiscardUnknown(m)
 }
 
-func (m *OIDCConfig) GetIssuer() string {
+var xxx_messageInfo_ConnectorHealth proto.InternalMessageInfo
+
+func (m *ConnectorHealth) GetID() string {
 	if m != nil {
-		return m.Issuer
+		return m.ID
 	}
 	return ""
 }
 
-func (m *OIDCConfig) GetClientID() string {
+func (m *ConnectorHealth) GetName() string {
 	if m != nil {
-		return m.ClientID
+		return m.Name
 	}
 	return ""
 }
 
-func (m *OIDCConfig) GetCLIClientID() string {
+func (m *ConnectorHealth) GetType() string {
 	if m != nil {
-		return m.CLIClientID
+		return m.Type
 	}
 	return ""
 }
 
-func (m *OIDCConfig) GetScopes() []string {
+func (m *ConnectorHealth) GetHealthy() bool {
 	if m != nil {
-		return m.Scopes
+		return m.Healthy
 	}
-	return nil
+	return false
 }
 
-func (m *OIDCConfig) GetIDTokenClaims() map[string]*oidc.Claim {
+func (m *ConnectorHealth) GetMessage() string {
 	if m != nil {
-		return m.IDTokenClaims
+		return m.Message
 	}
-	return nil
+	return ""
 }
 
-func init() {
-	proto.RegisterType((*SettingsQuery)(nil), "cluster.SettingsQuery")
-	proto.RegisterType((*Settings)(nil), "cluster.Settings")
-	proto.RegisterMapType((map[string]*v1alpha1.ResourceOverride)(nil), "cluster.Settings.ResourceOverridesEntry")
-	proto.RegisterType((*GoogleAnalyticsConfig)(nil), "cluster.GoogleAnalyticsConfig")
-	proto.RegisterType((*Help)(nil), "cluster.Help")
-	proto.RegisterType((*DexConfig)(nil), "cluster.DexConfig")
-	proto.RegisterType((*Connector)(nil), "cluster.Connector")
-	proto.RegisterType((*OIDCConfig)(nil), "cluster.OIDCConfig")
-	proto.RegisterMapType((map[string]*oidc.Claim)(nil), "cluster.OIDCConfig.IdTokenClaimsEntry")
+type DexConnectorsHealth struct {
+	Connectors           []*ConnectorHealth `protobuf:"bytes,1,rep,name=connectors" json:"connectors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *DexConnectorsHealth) Reset()         { *m = DexConnectorsHealth{} }
+func (m *DexConnectorsHealth) String() string { return proto.CompactTextString(m) }
+func (*DexConnectorsHealth) ProtoMessage()    {}
+func (*DexConnectorsHealth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_settings_14d3ac587a90c5d1, []int{11}
+}
+func (m *DexConnectorsHealth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DexConnectorsHealth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DexConnectorsHealth.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *DexConnectorsHealth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DexConnectorsHealth.Merge(dst, src)
+}
+func (m *DexConnectorsHealth) XXX_Size() int {
+	return m.Size()
+}
+func (m *DexConnectorsHealth) XXX_DiscardUnknown() {
+	xxx_messageInfo_DexConnectorsHealth.DiscardUnknown(m)
+}
 
-// Client API for SettingsService service
+var xxx_messageInfo_DexConnectorsHealth proto.InternalMessageInfo
 
-type SettingsServiceClient interface {
-	// Get returns Argo CD settings
-	Get(ctx context.Context, in *SettingsQuery, opts ...grpc.CallOption) (*Settings, error)
+func (m *DexConnectorsHealth) GetConnectors() []*ConnectorHealth {
+	if m != nil {
+		return m.Connectors
+	}
+	return nil
 }
 
-type settingsServiceClient struct {
-	cc *grpc.ClientConn
+// GroupSyncQuery is a query for the sync of group memberships from the identity provider
+type GroupSyncQuery struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func NewSettingsServiceClient(cc *grpc.ClientConn) SettingsServiceClient {
-	return &settingsServiceClient{cc}
+func (m *GroupSyncQuery) Reset()         { *m = GroupSyncQuery{} }
+func (m *GroupSyncQuery) String() string { return proto.CompactTextString(m) }
+func (*GroupSyncQuery) ProtoMessage()    {}
+func (*GroupSyncQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_settings_14d3ac587a90c5d1, []int{12}
 }
-
-func (c *settingsServiceClient) Get(ctx context.Context, in *SettingsQuery, opts ...grpc.CallOption) (*Settings, error) {
-	out := new(Settings)
+func (m *GroupSyncQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GroupSyncQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GroupSyncQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *GroupSyncQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupSyncQuery.Merge(dst, src)
+}
+func (m *GroupSyncQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *GroupSyncQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupSyncQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GroupSyncQuery proto.InternalMessageInfo
+
+// GroupSyncStatus is the status of the sync of group memberships from the identity provider
+type GroupSyncStatus struct {
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// LastSyncTime is the time of the last successful sync
+	LastSyncTime *v1.Time `protobuf:"bytes,2,opt,name=lastSyncTime" json:"lastSyncTime,omitempty"`
+	// Users is the number of user identifiers which have at least one group
+	Users int64 `protobuf:"varint,3,opt,name=users,proto3" json:"users,omitempty"`
+	// Error is the error of the last sync, if it failed
+	Error                string   `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GroupSyncStatus) Reset()         { *m = GroupSyncStatus{} }
+func (m *GroupSyncStatus) String() string { return proto.CompactTextString(m) }
+func (*GroupSyncStatus) ProtoMessage()    {}
+func (*GroupSyncStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_settings_14d3ac587a90c5d1, []int{13}
+}
+func (m *GroupSyncStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GroupSyncStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GroupSyncStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *GroupSyncStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupSyncStatus.Merge(dst, src)
+}
+func (m *GroupSyncStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *GroupSyncStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupSyncStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GroupSyncStatus proto.InternalMessageInfo
+
+func (m *GroupSyncStatus) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *GroupSyncStatus) GetLastSyncTime() *v1.Time {
+	if m != nil {
+		return m.LastSyncTime
+	}
+	return nil
+}
+
+func (m *GroupSyncStatus) GetUsers() int64 {
+	if m != nil {
+		return m.Users
+	}
+	return 0
+}
+
+func (m *GroupSyncStatus) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type OIDCConfig struct {
+	Name                 string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Issuer               string                 `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	ClientID             string                 `protobuf:"bytes,3,opt,name=clientID,proto3" json:"clientID,omitempty"`
+	CLIClientID          string                 `protobuf:"bytes,4,opt,name=cliClientID,proto3" json:"cliClientID,omitempty"`
+	Scopes               []string               `protobuf:"bytes,5,rep,name=scopes" json:"scopes,omitempty"`
+	IDTokenClaims        map[string]*oidc.Claim `protobuf:"bytes,6,rep,name=idTokenClaims" json:"idTokenClaims,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *OIDCConfig) Reset()         { *m = OIDCConfig{} }
+func (m *OIDCConfig) String() string { return proto.CompactTextString(m) }
+func (*OIDCConfig) ProtoMessage()    {}
+func (*OIDCConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_settings_14d3ac587a90c5d1, []int{14}
+}
+func (m *OIDCConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OIDCConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OIDCConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *OIDCConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OIDCConfig.Merge(dst, src)
+}
+func (m *OIDCConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *OIDCConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_OIDCConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OIDCConfig proto.InternalMessageInfo
+
+func (m *OIDCConfig) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *OIDCConfig) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *OIDCConfig) GetClientID() string {
+	if m != nil {
+		return m.ClientID
+	}
+	return ""
+}
+
+func (m *OIDCConfig) GetCLIClientID() string {
+	if m != nil {
+		return m.CLIClientID
+	}
+	return ""
+}
+
+func (m *OIDCConfig) GetScopes() []string {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
+}
+
+func (m *OIDCConfig) GetIDTokenClaims() map[string]*oidc.Claim {
+	if m != nil {
+		return m.IDTokenClaims
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SettingsQuery)(nil), "cluster.SettingsQuery")
+	proto.RegisterType((*Settings)(nil), "cluster.Settings")
+	proto.RegisterMapType((map[string]*v1alpha1.ResourceOverride)(nil), "cluster.Settings.ResourceOverridesEntry")
+	proto.RegisterType((*Announcement)(nil), "cluster.Announcement")
+	proto.RegisterType((*Maintenance)(nil), "cluster.Maintenance")
+	proto.RegisterType((*MaintenanceRequest)(nil), "cluster.MaintenanceRequest")
+	proto.RegisterType((*GoogleAnalyticsConfig)(nil), "cluster.GoogleAnalyticsConfig")
+	proto.RegisterType((*Help)(nil), "cluster.Help")
+	proto.RegisterType((*DexConfig)(nil), "cluster.DexConfig")
+	proto.RegisterType((*Connector)(nil), "cluster.Connector")
+	proto.RegisterType((*DexConnectorsHealthQuery)(nil), "cluster.DexConnectorsHealthQuery")
+	proto.RegisterType((*ConnectorHealth)(nil), "cluster.ConnectorHealth")
+	proto.RegisterType((*DexConnectorsHealth)(nil), "cluster.DexConnectorsHealth")
+	proto.RegisterType((*GroupSyncQuery)(nil), "cluster.GroupSyncQuery")
+	proto.RegisterType((*GroupSyncStatus)(nil), "cluster.GroupSyncStatus")
+	proto.RegisterType((*OIDCConfig)(nil), "cluster.OIDCConfig")
+	proto.RegisterMapType((map[string]*oidc.Claim)(nil), "cluster.OIDCConfig.IdTokenClaimsEntry")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for SettingsService service
+
+type SettingsServiceClient interface {
+	// Get returns Argo CD settings
+	Get(ctx context.Context, in *SettingsQuery, opts ...grpc.CallOption) (*Settings, error)
+	// GetDexConnectorsHealth returns the health of each configured dex connector
+	GetDexConnectorsHealth(ctx context.Context, in *DexConnectorsHealthQuery, opts ...grpc.CallOption) (*DexConnectorsHealth, error)
+	// GetGroupSyncStatus returns the status of the sync of group memberships from the identity provider
+	GetGroupSyncStatus(ctx context.Context, in *GroupSyncQuery, opts ...grpc.CallOption) (*GroupSyncStatus, error)
+	// SyncGroups immediately syncs the group memberships from the identity provider
+	SyncGroups(ctx context.Context, in *GroupSyncQuery, opts ...grpc.CallOption) (*GroupSyncStatus, error)
+	// SetMaintenance enables or disables the maintenance mode
+	SetMaintenance(ctx context.Context, in *MaintenanceRequest, opts ...grpc.CallOption) (*Maintenance, error)
+}
+
+type settingsServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewSettingsServiceClient(cc *grpc.ClientConn) SettingsServiceClient {
+	return &settingsServiceClient{cc}
+}
+
+func (c *settingsServiceClient) Get(ctx context.Context, in *SettingsQuery, opts ...grpc.CallOption) (*Settings, error) {
+	out := new(Settings)
 	err := c.cc.Invoke(ctx, "/cluster.SettingsService/Get", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -540,11 +1063,55 @@ func (c *settingsServiceClient) Get(ctx context.Context, in *SettingsQuery, opts
 	return out, nil
 }
 
+func (c *settingsServiceClient) GetDexConnectorsHealth(ctx context.Context, in *DexConnectorsHealthQuery, opts ...grpc.CallOption) (*DexConnectorsHealth, error) {
+	out := new(DexConnectorsHealth)
+	err := c.cc.Invoke(ctx, "/cluster.SettingsService/GetDexConnectorsHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *settingsServiceClient) GetGroupSyncStatus(ctx context.Context, in *GroupSyncQuery, opts ...grpc.CallOption) (*GroupSyncStatus, error) {
+	out := new(GroupSyncStatus)
+	err := c.cc.Invoke(ctx, "/cluster.SettingsService/GetGroupSyncStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *settingsServiceClient) SyncGroups(ctx context.Context, in *GroupSyncQuery, opts ...grpc.CallOption) (*GroupSyncStatus, error) {
+	out := new(GroupSyncStatus)
+	err := c.cc.Invoke(ctx, "/cluster.SettingsService/SyncGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *settingsServiceClient) SetMaintenance(ctx context.Context, in *MaintenanceRequest, opts ...grpc.CallOption) (*Maintenance, error) {
+	out := new(Maintenance)
+	err := c.cc.Invoke(ctx, "/cluster.SettingsService/SetMaintenance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for SettingsService service
 
 type SettingsServiceServer interface {
 	// Get returns Argo CD settings
 	Get(context.Context, *SettingsQuery) (*Settings, error)
+	// GetDexConnectorsHealth returns the health of each configured dex connector
+	GetDexConnectorsHealth(context.Context, *DexConnectorsHealthQuery) (*DexConnectorsHealth, error)
+	// GetGroupSyncStatus returns the status of the sync of group memberships from the identity provider
+	GetGroupSyncStatus(context.Context, *GroupSyncQuery) (*GroupSyncStatus, error)
+	// SyncGroups immediately syncs the group memberships from the identity provider
+	SyncGroups(context.Context, *GroupSyncQuery) (*GroupSyncStatus, error)
+	// SetMaintenance enables or disables the maintenance mode
+	SetMaintenance(context.Context, *MaintenanceRequest) (*Maintenance, error)
 }
 
 func RegisterSettingsServiceServer(s *grpc.Server, srv SettingsServiceServer) {
@@ -569,52 +1136,140 @@ func _SettingsService_Get_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
-var _SettingsService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cluster.SettingsService",
-	HandlerType: (*SettingsServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Get",
-			Handler:    _SettingsService_Get_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "server/settings/settings.proto",
-}
-
-func (m *SettingsQuery) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _SettingsService_GetDexConnectorsHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DexConnectorsHealthQuery)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SettingsQuery) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(SettingsServiceServer).GetDexConnectorsHealth(ctx, in)
 	}
-	return i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cluster.SettingsService/GetDexConnectorsHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettingsServiceServer).GetDexConnectorsHealth(ctx, req.(*DexConnectorsHealthQuery))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Settings) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _SettingsService_GetGroupSyncStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupSyncQuery)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Settings) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	if interceptor == nil {
+		return srv.(SettingsServiceServer).GetGroupSyncStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cluster.SettingsService/GetGroupSyncStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettingsServiceServer).GetGroupSyncStatus(ctx, req.(*GroupSyncQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SettingsService_SyncGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupSyncQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettingsServiceServer).SyncGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cluster.SettingsService/SyncGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettingsServiceServer).SyncGroups(ctx, req.(*GroupSyncQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SettingsService_SetMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MaintenanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettingsServiceServer).SetMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cluster.SettingsService/SetMaintenance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettingsServiceServer).SetMaintenance(ctx, req.(*MaintenanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _SettingsService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cluster.SettingsService",
+	HandlerType: (*SettingsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _SettingsService_Get_Handler,
+		},
+		{
+			MethodName: "GetDexConnectorsHealth",
+			Handler:    _SettingsService_GetDexConnectorsHealth_Handler,
+		},
+		{
+			MethodName: "GetGroupSyncStatus",
+			Handler:    _SettingsService_GetGroupSyncStatus_Handler,
+		},
+		{
+			MethodName: "SyncGroups",
+			Handler:    _SettingsService_SyncGroups_Handler,
+		},
+		{
+			MethodName: "SetMaintenance",
+			Handler:    _SettingsService_SetMaintenance_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "server/settings/settings.proto",
+}
+
+func (m *SettingsQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SettingsQuery) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Settings) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Settings) MarshalTo(dAtA []byte) (int, error) {
+	var i int
 	_ = i
 	var l int
 	_ = l
@@ -718,6 +1373,167 @@ func (m *Settings) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n6
 	}
+	if m.Maintenance != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintSettings(dAtA, i, uint64(m.Maintenance.Size()))
+		n7, err := m.Maintenance.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	if len(m.Announcements) > 0 {
+		for _, msg := range m.Announcements {
+			dAtA[i] = 0x5a
+			i++
+			i = encodeVarintSettings(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Announcement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Announcement) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if len(m.Severity) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.Severity)))
+		i += copy(dAtA[i:], m.Severity)
+	}
+	if len(m.URL) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.URL)))
+		i += copy(dAtA[i:], m.URL)
+	}
+	if m.ExpiresAt != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintSettings(dAtA, i, uint64(m.ExpiresAt.Size()))
+		n8, err := m.ExpiresAt.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Maintenance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Maintenance) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		dAtA[i] = 0x8
+		i++
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if len(m.EnabledBy) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.EnabledBy)))
+		i += copy(dAtA[i:], m.EnabledBy)
+	}
+	if m.EnabledAt != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintSettings(dAtA, i, uint64(m.EnabledAt.Size()))
+		n9, err := m.EnabledAt.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *MaintenanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaintenanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		dAtA[i] = 0x8
+		i++
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -860,7 +1676,7 @@ func (m *Connector) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *OIDCConfig) Marshal() (dAtA []byte, err error) {
+func (m *DexConnectorsHealthQuery) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -870,38 +1686,220 @@ func (m *OIDCConfig) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OIDCConfig) MarshalTo(dAtA []byte) (int, error) {
+func (m *DexConnectorsHealthQuery) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Name) > 0 {
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ConnectorHealth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConnectorHealth) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintSettings(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
 	}
-	if len(m.Issuer) > 0 {
+	if len(m.Name) > 0 {
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintSettings(dAtA, i, uint64(len(m.Issuer)))
-		i += copy(dAtA[i:], m.Issuer)
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
-	if len(m.ClientID) > 0 {
+	if len(m.Type) > 0 {
 		dAtA[i] = 0x1a
 		i++
-		i = encodeVarintSettings(dAtA, i, uint64(len(m.ClientID)))
-		i += copy(dAtA[i:], m.ClientID)
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.Type)))
+		i += copy(dAtA[i:], m.Type)
 	}
-	if len(m.CLIClientID) > 0 {
-		dAtA[i] = 0x22
+	if m.Healthy {
+		dAtA[i] = 0x20
+		i++
+		if m.Healthy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i++
-		i = encodeVarintSettings(dAtA, i, uint64(len(m.CLIClientID)))
-		i += copy(dAtA[i:], m.CLIClientID)
 	}
-	if len(m.Scopes) > 0 {
-		for _, s := range m.Scopes {
-			dAtA[i] = 0x2a
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *DexConnectorsHealth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DexConnectorsHealth) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Connectors) > 0 {
+		for _, msg := range m.Connectors {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintSettings(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *GroupSyncQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupSyncQuery) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *GroupSyncStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupSyncStatus) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		dAtA[i] = 0x8
+		i++
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.LastSyncTime != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintSettings(dAtA, i, uint64(m.LastSyncTime.Size()))
+		n10, err := m.LastSyncTime.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	if m.Users != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintSettings(dAtA, i, uint64(m.Users))
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *OIDCConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OIDCConfig) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Issuer) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.Issuer)))
+		i += copy(dAtA[i:], m.Issuer)
+	}
+	if len(m.ClientID) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.ClientID)))
+		i += copy(dAtA[i:], m.ClientID)
+	}
+	if len(m.CLIClientID) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.CLIClientID)))
+		i += copy(dAtA[i:], m.CLIClientID)
+	}
+	if len(m.Scopes) > 0 {
+		for _, s := range m.Scopes {
+			dAtA[i] = 0x2a
 			i++
 			l = len(s)
 			for l >= 1<<7 {
@@ -934,11 +1932,11 @@ func (m *OIDCConfig) MarshalTo(dAtA []byte) (int, error) {
 				dAtA[i] = 0x12
 				i++
 				i = encodeVarintSettings(dAtA, i, uint64(v.Size()))
-				n7, err := v.MarshalTo(dAtA[i:])
+				n11, err := v.MarshalTo(dAtA[i:])
 				if err != nil {
 					return 0, err
 				}
-				i += n7
+				i += n11
 			}
 		}
 	}
@@ -1013,6 +2011,81 @@ func (m *Settings) Size() (n int) {
 		l = m.Help.Size()
 		n += 1 + l + sovSettings(uint64(l))
 	}
+	if m.Maintenance != nil {
+		l = m.Maintenance.Size()
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	if len(m.Announcements) > 0 {
+		for _, e := range m.Announcements {
+			l = e.Size()
+			n += 1 + l + sovSettings(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Announcement) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	l = len(m.Severity)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	l = len(m.URL)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	if m.ExpiresAt != nil {
+		l = m.ExpiresAt.Size()
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Maintenance) Size() (n int) {
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	l = len(m.EnabledBy)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	if m.EnabledAt != nil {
+		l = m.EnabledAt.Size()
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MaintenanceRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1084,42 +2157,50 @@ func (m *Connector) Size() (n int) {
 	return n
 }
 
-func (m *OIDCConfig) Size() (n int) {
+func (m *DexConnectorsHealthQuery) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Name)
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ConnectorHealth) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
 	if l > 0 {
 		n += 1 + l + sovSettings(uint64(l))
 	}
-	l = len(m.Issuer)
+	l = len(m.Name)
 	if l > 0 {
 		n += 1 + l + sovSettings(uint64(l))
 	}
-	l = len(m.ClientID)
+	l = len(m.Type)
 	if l > 0 {
 		n += 1 + l + sovSettings(uint64(l))
 	}
-	l = len(m.CLIClientID)
+	if m.Healthy {
+		n += 2
+	}
+	l = len(m.Message)
 	if l > 0 {
 		n += 1 + l + sovSettings(uint64(l))
 	}
-	if len(m.Scopes) > 0 {
-		for _, s := range m.Scopes {
-			l = len(s)
-			n += 1 + l + sovSettings(uint64(l))
-		}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
-	if len(m.IDTokenClaims) > 0 {
-		for k, v := range m.IDTokenClaims {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovSettings(uint64(l))
-			}
-			mapEntrySize := 1 + len(k) + sovSettings(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovSettings(uint64(mapEntrySize))
+	return n
+}
+
+func (m *DexConnectorsHealth) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Connectors) > 0 {
+		for _, e := range m.Connectors {
+			l = e.Size()
+			n += 1 + l + sovSettings(uint64(l))
 		}
 	}
 	if m.XXX_unrecognized != nil {
@@ -1128,23 +2209,802 @@ func (m *OIDCConfig) Size() (n int) {
 	return n
 }
 
-func sovSettings(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+func (m *GroupSyncQuery) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
-func sozSettings(x uint64) (n int) {
-	return sovSettings(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *SettingsQuery) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
+
+func (m *GroupSyncStatus) Size() (n int) {
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if m.LastSyncTime != nil {
+		l = m.LastSyncTime.Size()
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	if m.Users != 0 {
+		n += 1 + sovSettings(uint64(m.Users))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *OIDCConfig) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	l = len(m.ClientID)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	l = len(m.CLIClientID)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	if len(m.Scopes) > 0 {
+		for _, s := range m.Scopes {
+			l = len(s)
+			n += 1 + l + sovSettings(uint64(l))
+		}
+	}
+	if len(m.IDTokenClaims) > 0 {
+		for k, v := range m.IDTokenClaims {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovSettings(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovSettings(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovSettings(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovSettings(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozSettings(x uint64) (n int) {
+	return sovSettings(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SettingsQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettings
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SettingsQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SettingsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettings(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Settings) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettings
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Settings: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Settings: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DexConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DexConfig == nil {
+				m.DexConfig = &DexConfig{}
+			}
+			if err := m.DexConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OIDCConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OIDCConfig == nil {
+				m.OIDCConfig = &OIDCConfig{}
+			}
+			if err := m.OIDCConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppLabelKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppLabelKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResourceOverrides == nil {
+				m.ResourceOverrides = make(map[string]*v1alpha1.ResourceOverride)
+			}
+			var mapkey string
+			var mapvalue *v1alpha1.ResourceOverride
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSettings
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSettings
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSettings
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSettings
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= (int(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthSettings
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if mapmsglen < 0 {
+						return ErrInvalidLengthSettings
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &v1alpha1.ResourceOverride{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSettings(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthSettings
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ResourceOverrides[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusBadgeEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StatusBadgeEnabled = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoogleAnalytics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GoogleAnalytics == nil {
+				m.GoogleAnalytics = &GoogleAnalyticsConfig{}
+			}
+			if err := m.GoogleAnalytics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KustomizeOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KustomizeOptions == nil {
+				m.KustomizeOptions = &v1alpha1.KustomizeOptions{}
+			}
+			if err := m.KustomizeOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Help", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Help == nil {
+				m.Help = &Help{}
+			}
+			if err := m.Help.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Maintenance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Maintenance == nil {
+				m.Maintenance = &Maintenance{}
+			}
+			if err := m.Maintenance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Announcements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Announcements = append(m.Announcements, &Announcement{})
+			if err := m.Announcements[len(m.Announcements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettings(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Announcement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettings
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Announcement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Announcement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Severity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Severity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = &v1.Time{}
+			}
+			if err := m.ExpiresAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettings(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Maintenance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -1154,22 +3014,133 @@ func (m *SettingsQuery) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Maintenance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Maintenance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnabledBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnabledBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnabledAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EnabledAt == nil {
+				m.EnabledAt = &v1.Time{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SettingsQuery: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SettingsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if err := m.EnabledAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSettings(dAtA[iNdEx:])
@@ -1192,7 +3163,7 @@ func (m *SettingsQuery) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Settings) Unmarshal(dAtA []byte) error {
+func (m *MaintenanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1215,17 +3186,17 @@ func (m *Settings) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Settings: wiretype end group for non-group")
+			return fmt.Errorf("proto: MaintenanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Settings: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MaintenanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettings
@@ -1235,26 +3206,17 @@ func (m *Settings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSettings
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.URL = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Enabled = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DexConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettings
@@ -1264,61 +3226,75 @@ func (m *Settings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSettings
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DexConfig == nil {
-				m.DexConfig = &DexConfig{}
-			}
-			if err := m.DexConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OIDCConfig", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSettings
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettings(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthSettings
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OIDCConfig == nil {
-				m.OIDCConfig = &OIDCConfig{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GoogleAnalyticsConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettings
 			}
-			if err := m.OIDCConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GoogleAnalyticsConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GoogleAnalyticsConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppLabelKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TrackingID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1337,140 +3313,17 @@ func (m *Settings) Unmarshal(dAtA []byte) error {
 			}
 			intStringLen := int(stringLen)
 			if intStringLen < 0 {
-				return ErrInvalidLengthSettings
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AppLabelKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceOverrides", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSettings
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthSettings
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ResourceOverrides == nil {
-				m.ResourceOverrides = make(map[string]*v1alpha1.ResourceOverride)
-			}
-			var mapkey string
-			var mapvalue *v1alpha1.ResourceOverride
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowSettings
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSettings
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthSettings
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSettings
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= (int(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthSettings
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if mapmsglen < 0 {
-						return ErrInvalidLengthSettings
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &v1alpha1.ResourceOverride{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipSettings(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthSettings
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+				return ErrInvalidLengthSettings
 			}
-			m.ResourceOverrides[mapkey] = mapvalue
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrackingID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StatusBadgeEnabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AnonymizeUsers", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -1487,12 +3340,63 @@ func (m *Settings) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.StatusBadgeEnabled = bool(v != 0)
-		case 7:
+			m.AnonymizeUsers = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettings(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Help) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettings
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Help: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Help: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoogleAnalytics", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChatUrl", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettings
@@ -1502,28 +3406,104 @@ func (m *Settings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSettings
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GoogleAnalytics == nil {
-				m.GoogleAnalytics = &GoogleAnalyticsConfig{}
+			m.ChatUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChatText", wireType)
 			}
-			if err := m.GoogleAnalytics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettings
 			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChatText = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettings(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DexConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettings
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DexConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DexConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KustomizeOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Connectors", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1547,18 +3527,96 @@ func (m *Settings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KustomizeOptions == nil {
-				m.KustomizeOptions = &v1alpha1.KustomizeOptions{}
+			m.Connectors = append(m.Connectors, &Connector{})
+			if err := m.Connectors[len(m.Connectors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.KustomizeOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettings(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if skippy < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Connector) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettings
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Connector: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Connector: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Help", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettings
@@ -1568,24 +3626,20 @@ func (m *Settings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSettings
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Help == nil {
-				m.Help = &Help{}
-			}
-			if err := m.Help.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1609,7 +3663,7 @@ func (m *Settings) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GoogleAnalyticsConfig) Unmarshal(dAtA []byte) error {
+func (m *DexConnectorsHealthQuery) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1632,61 +3686,12 @@ func (m *GoogleAnalyticsConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GoogleAnalyticsConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: DexConnectorsHealthQuery: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GoogleAnalyticsConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DexConnectorsHealthQuery: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrackingID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSettings
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSettings
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TrackingID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnonymizeUsers", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSettings
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.AnonymizeUsers = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSettings(dAtA[iNdEx:])
@@ -1709,7 +3714,7 @@ func (m *GoogleAnalyticsConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Help) Unmarshal(dAtA []byte) error {
+func (m *ConnectorHealth) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1732,15 +3737,15 @@ func (m *Help) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Help: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConnectorHealth: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Help: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConnectorHealth: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChatUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1765,11 +3770,11 @@ func (m *Help) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChatUrl = string(dAtA[iNdEx:postIndex])
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChatText", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1794,7 +3799,85 @@ func (m *Help) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChatText = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Healthy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Healthy = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1818,7 +3901,7 @@ func (m *Help) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DexConfig) Unmarshal(dAtA []byte) error {
+func (m *DexConnectorsHealth) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1841,10 +3924,10 @@ func (m *DexConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DexConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: DexConnectorsHealth: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DexConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DexConnectorsHealth: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1873,7 +3956,7 @@ func (m *DexConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Connectors = append(m.Connectors, &Connector{})
+			m.Connectors = append(m.Connectors, &ConnectorHealth{})
 			if err := m.Connectors[len(m.Connectors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -1900,7 +3983,7 @@ func (m *DexConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Connector) Unmarshal(dAtA []byte) error {
+func (m *GroupSyncQuery) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1923,17 +4006,88 @@ func (m *Connector) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Connector: wiretype end group for non-group")
+			return fmt.Errorf("proto: GroupSyncQuery: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Connector: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GroupSyncQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettings(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GroupSyncStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettings
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GroupSyncStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GroupSyncStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSyncTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettings
@@ -1943,24 +4097,47 @@ func (m *Connector) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSettings
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.LastSyncTime == nil {
+				m.LastSyncTime = &v1.Time{}
+			}
+			if err := m.LastSyncTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Users", wireType)
+			}
+			m.Users = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Users |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1985,7 +4162,7 @@ func (m *Connector) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2434,61 +4611,89 @@ var (
 )
 
 func init() {
-	proto.RegisterFile("server/settings/settings.proto", fileDescriptor_settings_b6f0d72a41cb0abb)
-}
-
-var fileDescriptor_settings_b6f0d72a41cb0abb = []byte{
-	// 832 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4f, 0x6f, 0xeb, 0x44,
-	0x10, 0x97, 0x9b, 0xfe, 0x49, 0x26, 0xf4, 0xb5, 0x5d, 0xa0, 0x32, 0x11, 0x4a, 0x42, 0x0e, 0x4f,
-	0xb9, 0x60, 0xd3, 0xbc, 0x03, 0x08, 0x81, 0x80, 0x24, 0x4f, 0xef, 0x85, 0x16, 0x55, 0xec, 0x7b,
-	0xe5, 0x80, 0x84, 0xaa, 0xad, 0x3d, 0xb8, 0x4b, 0xdc, 0x5d, 0x6b, 0x77, 0x1d, 0x5e, 0x38, 0x72,
-	0xe3, 0x88, 0xf8, 0x42, 0x1c, 0x39, 0x22, 0x71, 0x8f, 0x50, 0xc4, 0x07, 0x41, 0xde, 0xd8, 0xae,
-	0x9b, 0x44, 0x4f, 0x48, 0xdc, 0x66, 0x67, 0xe6, 0x37, 0x33, 0x3b, 0xfb, 0x9b, 0x59, 0x68, 0x6b,
-	0x54, 0x33, 0x54, 0xbe, 0x46, 0x63, 0xb8, 0x88, 0x74, 0x29, 0x78, 0x89, 0x92, 0x46, 0x92, 0x83,
-	0x20, 0x4e, 0xb5, 0x41, 0xd5, 0x7a, 0x2b, 0x92, 0x91, 0xb4, 0x3a, 0x3f, 0x93, 0x56, 0xe6, 0xd6,
-	0xbb, 0x91, 0x94, 0x51, 0x8c, 0x3e, 0x4b, 0xb8, 0xcf, 0x84, 0x90, 0x86, 0x19, 0x2e, 0x45, 0x0e,
-	0x6e, 0x4d, 0x22, 0x6e, 0x6e, 0xd3, 0x1b, 0x2f, 0x90, 0x77, 0x3e, 0x53, 0x16, 0xfe, 0x83, 0x15,
-	0xde, 0x0f, 0x42, 0x3f, 0x99, 0x46, 0x19, 0x4c, 0xfb, 0x2c, 0x49, 0x62, 0x1e, 0x58, 0xa0, 0x3f,
-	0x3b, 0x63, 0x71, 0x72, 0xcb, 0xce, 0xfc, 0x08, 0x05, 0x2a, 0x66, 0x30, 0xcc, 0x43, 0x7d, 0xfa,
-	0xba, 0x50, 0xeb, 0x77, 0x90, 0x3c, 0x0c, 0xfc, 0x20, 0x66, 0xfc, 0x2e, 0xaf, 0xa4, 0x77, 0x04,
-	0x87, 0x2f, 0x72, 0xeb, 0xd7, 0x29, 0xaa, 0x79, 0xef, 0xf7, 0x3d, 0xa8, 0x17, 0x1a, 0xf2, 0x0e,
-	0xd4, 0x52, 0x15, 0xbb, 0x4e, 0xd7, 0xe9, 0x37, 0x86, 0x07, 0xcb, 0x45, 0xa7, 0x76, 0x45, 0x2f,
-	0x68, 0xa6, 0x23, 0x1f, 0x40, 0x23, 0xc4, 0x57, 0x23, 0x29, 0xbe, 0xe7, 0x91, 0xbb, 0xd3, 0x75,
-	0xfa, 0xcd, 0x01, 0xf1, 0xf2, 0x9e, 0x78, 0xe3, 0xc2, 0x42, 0xef, 0x9d, 0xc8, 0x08, 0x20, 0xcb,
-	0x9f, 0x43, 0x6a, 0x16, 0xf2, 0x66, 0x09, 0xb9, 0x9c, 0x8c, 0x47, 0x2b, 0xd3, 0xf0, 0xd1, 0x72,
-	0xd1, 0x81, 0xfb, 0x33, 0xad, 0xc0, 0x48, 0x17, 0x9a, 0x2c, 0x49, 0x2e, 0xd8, 0x0d, 0xc6, 0xe7,
-	0x38, 0x77, 0x77, 0xb3, 0xca, 0x68, 0x55, 0x45, 0xbe, 0x81, 0x13, 0x85, 0x5a, 0xa6, 0x2a, 0xc0,
-	0xcb, 0x19, 0x2a, 0xc5, 0x43, 0xd4, 0xee, 0x5e, 0xb7, 0xd6, 0x6f, 0x0e, 0xfa, 0x65, 0xb6, 0xe2,
-	0x86, 0x1e, 0x5d, 0x77, 0x7d, 0x2a, 0x8c, 0x9a, 0xd3, 0xcd, 0x10, 0xc4, 0x03, 0xa2, 0x0d, 0x33,
-	0xa9, 0x1e, 0xb2, 0x30, 0xc2, 0xa7, 0x82, 0xdd, 0xc4, 0x18, 0xba, 0xfb, 0x5d, 0xa7, 0x5f, 0xa7,
-	0x5b, 0x2c, 0xe4, 0x39, 0x1c, 0xad, 0x38, 0xf0, 0x85, 0x60, 0xf1, 0xdc, 0xf0, 0x40, 0xbb, 0x07,
-	0xf6, 0xce, 0xed, 0xb2, 0x8a, 0x67, 0x0f, 0xed, 0xf9, 0x75, 0xd7, 0x61, 0xe4, 0x47, 0x38, 0x9e,
-	0xa6, 0xda, 0xc8, 0x3b, 0xfe, 0x13, 0x5e, 0x26, 0x96, 0x47, 0x6e, 0xdd, 0x86, 0x3a, 0xf7, 0xee,
-	0x5f, 0xdf, 0x2b, 0x5e, 0xdf, 0x0a, 0xd7, 0x41, 0xe8, 0x25, 0xd3, 0xc8, 0xcb, 0x88, 0xe4, 0x55,
-	0x88, 0xe4, 0x15, 0x44, 0xf2, 0xce, 0xd7, 0x42, 0xd2, 0x8d, 0x24, 0xe4, 0x3d, 0xd8, 0xbd, 0xc5,
-	0x38, 0x71, 0x1b, 0x36, 0xd9, 0x61, 0x59, 0xf7, 0x73, 0x8c, 0x13, 0x6a, 0x4d, 0xad, 0x5f, 0x1d,
-	0x38, 0xdd, 0xde, 0x43, 0x72, 0x0c, 0xb5, 0x29, 0xce, 0x57, 0xe4, 0xa1, 0x99, 0x48, 0x18, 0xec,
-	0xcd, 0x58, 0x9c, 0x62, 0xce, 0x97, 0xff, 0x53, 0xfd, 0x7a, 0x4e, 0xba, 0x8a, 0xfc, 0xf1, 0xce,
-	0x47, 0x4e, 0xef, 0x1a, 0xde, 0xde, 0xda, 0x59, 0xd2, 0x06, 0x30, 0x8a, 0x05, 0x53, 0x2e, 0xa2,
-	0xc9, 0x38, 0x2f, 0xac, 0xa2, 0x21, 0x8f, 0xe1, 0x11, 0x13, 0x52, 0xcc, 0xb3, 0x1e, 0x5c, 0x69,
-	0x54, 0xda, 0x16, 0x5a, 0xa7, 0x6b, 0xda, 0xde, 0x27, 0xb0, 0x9b, 0xb5, 0x80, 0xb8, 0x70, 0x10,
-	0xdc, 0x32, 0x73, 0x55, 0x8c, 0x08, 0x2d, 0x8e, 0xa4, 0x05, 0xf5, 0x4c, 0x7c, 0x89, 0xaf, 0x8c,
-	0x8d, 0xd1, 0xa0, 0xe5, 0xb9, 0xf7, 0x19, 0x34, 0xca, 0xf9, 0x20, 0x03, 0x80, 0x40, 0x0a, 0x81,
-	0x81, 0x91, 0x4a, 0xbb, 0x8e, 0xa5, 0xe9, 0xfd, 0x1c, 0x8d, 0x0a, 0x13, 0xad, 0x78, 0xf5, 0x9e,
-	0x40, 0xa3, 0x34, 0x10, 0x02, 0xbb, 0x82, 0xdd, 0x61, 0x5e, 0x80, 0x95, 0x33, 0x9d, 0x99, 0x27,
-	0x98, 0x67, 0xb6, 0x72, 0xef, 0x97, 0x1a, 0x54, 0x66, 0x6a, 0x2b, 0xec, 0x14, 0xf6, 0xb9, 0xd6,
-	0x29, 0xaa, 0x1c, 0x98, 0x9f, 0x48, 0x1f, 0xea, 0x41, 0xcc, 0x51, 0x98, 0xc9, 0xd8, 0x8e, 0x6d,
-	0x63, 0xf8, 0xc6, 0x72, 0xd1, 0xa9, 0x8f, 0x72, 0x1d, 0x2d, 0xad, 0xe4, 0x0c, 0x9a, 0x41, 0xcc,
-	0x0b, 0xc3, 0x6a, 0x3a, 0x87, 0x47, 0xcb, 0x45, 0xa7, 0x39, 0xba, 0x98, 0x94, 0xfe, 0x55, 0x9f,
-	0x2c, 0xa9, 0x0e, 0x64, 0x92, 0xcf, 0x68, 0x83, 0xe6, 0x27, 0x72, 0x0d, 0x87, 0x3c, 0x7c, 0x29,
-	0xa7, 0x28, 0x46, 0x76, 0x5f, 0xb9, 0xfb, 0xb6, 0x37, 0x8f, 0xb7, 0x2c, 0x0c, 0x6f, 0x52, 0x75,
-	0xb4, 0xe4, 0x1b, 0x9e, 0x2c, 0x17, 0x9d, 0xc3, 0xc9, 0xb8, 0xa2, 0xa7, 0x0f, 0xe3, 0xb5, 0xe6,
-	0x40, 0x36, 0x71, 0x5b, 0x48, 0xfb, 0xd5, 0x43, 0xd2, 0x7e, 0xf8, 0x5a, 0xd2, 0xae, 0x16, 0xae,
-	0x57, 0xfe, 0x15, 0xd9, 0xe6, 0xf2, 0x6c, 0xfc, 0x0a, 0x41, 0x07, 0xdf, 0xc1, 0x51, 0xb1, 0x80,
-	0x5e, 0xa0, 0x9a, 0xf1, 0x00, 0xc9, 0x97, 0x50, 0x7b, 0x86, 0x86, 0x9c, 0x6e, 0x6c, 0x28, 0xbb,
-	0x95, 0x5b, 0x27, 0x1b, 0xfa, 0x9e, 0xfb, 0xf3, 0x5f, 0xff, 0xfc, 0xb6, 0x43, 0xc8, 0xb1, 0xfd,
-	0x63, 0x66, 0x67, 0xe5, 0x96, 0x1f, 0x7e, 0xfe, 0xc7, 0xb2, 0xed, 0xfc, 0xb9, 0x6c, 0x3b, 0x7f,
-	0x2f, 0xdb, 0xce, 0xb7, 0x83, 0xff, 0xf0, 0xd7, 0xac, 0x1e, 0xb0, 0x8c, 0x70, 0xb3, 0x6f, 0x3f,
-	0x87, 0x27, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x46, 0x00, 0xa9, 0x98, 0x05, 0x07, 0x00, 0x00,
+	proto.RegisterFile("server/settings/settings.proto", fileDescriptor_settings_14d3ac587a90c5d1)
+}
+
+var fileDescriptor_settings_14d3ac587a90c5d1 = []byte{
+	// 1273 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x17, 0x5b, 0x6f, 0x1b, 0x45,
+	0x57, 0x6b, 0xe7, 0x62, 0x1f, 0x37, 0x97, 0x4e, 0xdb, 0x7c, 0xfb, 0xf9, 0x8b, 0xec, 0x74, 0xf5,
+	0xa9, 0x44, 0x95, 0xd8, 0x25, 0x29, 0x82, 0xaa, 0x80, 0xa0, 0x76, 0xaa, 0xc4, 0xb4, 0x25, 0x62,
+	0x92, 0xf2, 0xc0, 0x4b, 0x35, 0x59, 0x1f, 0xd6, 0x83, 0xd7, 0xbb, 0xcb, 0xce, 0xd8, 0xc4, 0x3c,
+	0x56, 0xe2, 0x81, 0x47, 0xc4, 0x8f, 0x40, 0xfd, 0x01, 0xfc, 0x07, 0x1e, 0x91, 0x78, 0x8f, 0x90,
+	0xc5, 0x0f, 0x41, 0x33, 0x7b, 0xf1, 0xfa, 0xa2, 0x08, 0xca, 0xdb, 0x9e, 0xfb, 0x39, 0x73, 0xae,
+	0x0b, 0x0d, 0x81, 0xf1, 0x08, 0x63, 0x47, 0xa0, 0x94, 0x3c, 0xf0, 0x44, 0xfe, 0x61, 0x47, 0x71,
+	0x28, 0x43, 0xb2, 0xee, 0xfa, 0x43, 0x21, 0x31, 0xae, 0xdf, 0xf6, 0x42, 0x2f, 0xd4, 0x38, 0x47,
+	0x7d, 0x25, 0xe4, 0xfa, 0xae, 0x17, 0x86, 0x9e, 0x8f, 0x0e, 0x8b, 0xb8, 0xc3, 0x82, 0x20, 0x94,
+	0x4c, 0xf2, 0x30, 0x48, 0x85, 0xeb, 0xef, 0xf6, 0x1f, 0x0a, 0x9b, 0x87, 0x8a, 0x3a, 0x60, 0x6e,
+	0x8f, 0x07, 0x18, 0x8f, 0x9d, 0xa8, 0xef, 0x29, 0x84, 0x70, 0x06, 0x28, 0x99, 0x33, 0x3a, 0x70,
+	0x3c, 0x0c, 0x30, 0x66, 0x12, 0xbb, 0xa9, 0x54, 0xc7, 0xe3, 0xb2, 0x37, 0xbc, 0xb0, 0xdd, 0x70,
+	0xe0, 0xb0, 0x58, 0x1b, 0xfd, 0x5a, 0x7f, 0xbc, 0xed, 0x76, 0xa7, 0xd2, 0x2c, 0x8a, 0x7c, 0xee,
+	0x6a, 0x73, 0xce, 0xe8, 0x80, 0xf9, 0x51, 0x8f, 0x2d, 0xaa, 0xfa, 0xe8, 0x3a, 0x55, 0xf3, 0x91,
+	0x87, 0xbc, 0xeb, 0x3a, 0xae, 0xcf, 0xf8, 0x20, 0xf5, 0xdf, 0xda, 0x82, 0x8d, 0xb3, 0x94, 0xfa,
+	0xf9, 0x10, 0xe3, 0xb1, 0xf5, 0xcb, 0x1a, 0x54, 0x32, 0x0c, 0xf9, 0x2f, 0x94, 0x87, 0xb1, 0x6f,
+	0x1a, 0x7b, 0xc6, 0x7e, 0xb5, 0xb5, 0x3e, 0xb9, 0x6a, 0x96, 0x5f, 0xd0, 0x67, 0x54, 0xe1, 0xc8,
+	0x3b, 0x50, 0xed, 0xe2, 0x65, 0x3b, 0x0c, 0xbe, 0xe2, 0x9e, 0x59, 0xda, 0x33, 0xf6, 0x6b, 0x87,
+	0xc4, 0x4e, 0x5f, 0xd2, 0x3e, 0xca, 0x28, 0x74, 0xca, 0x44, 0xda, 0x00, 0xca, 0x7e, 0x2a, 0x52,
+	0xd6, 0x22, 0xb7, 0x72, 0x91, 0xd3, 0xce, 0x51, 0x3b, 0x21, 0xb5, 0x36, 0x27, 0x57, 0x4d, 0x98,
+	0xc2, 0xb4, 0x20, 0x46, 0xf6, 0xa0, 0xc6, 0xa2, 0xe8, 0x19, 0xbb, 0x40, 0xff, 0x29, 0x8e, 0xcd,
+	0x15, 0xe5, 0x19, 0x2d, 0xa2, 0xc8, 0x17, 0x70, 0x33, 0x46, 0x11, 0x0e, 0x63, 0x17, 0x4f, 0x47,
+	0x18, 0xc7, 0xbc, 0x8b, 0xc2, 0x5c, 0xdd, 0x2b, 0xef, 0xd7, 0x0e, 0xf7, 0x73, 0x6b, 0x59, 0x84,
+	0x36, 0x9d, 0x67, 0x7d, 0x12, 0xc8, 0x78, 0x4c, 0x17, 0x55, 0x10, 0x1b, 0x88, 0x90, 0x4c, 0x0e,
+	0x45, 0x8b, 0x75, 0x3d, 0x7c, 0x12, 0xb0, 0x0b, 0x1f, 0xbb, 0xe6, 0xda, 0x9e, 0xb1, 0x5f, 0xa1,
+	0x4b, 0x28, 0xe4, 0x04, 0xb6, 0x92, 0xca, 0x79, 0x1c, 0x30, 0x7f, 0x2c, 0xb9, 0x2b, 0xcc, 0x75,
+	0x1d, 0x73, 0x23, 0xf7, 0xe2, 0x78, 0x96, 0x9e, 0x86, 0x3b, 0x2f, 0x46, 0xbe, 0x85, 0xed, 0xfe,
+	0x50, 0xc8, 0x70, 0xc0, 0xbf, 0xc3, 0xd3, 0x48, 0x57, 0x9f, 0x59, 0xd1, 0xaa, 0x9e, 0xda, 0xd3,
+	0xec, 0xdb, 0x59, 0xf6, 0xf5, 0xc7, 0x4b, 0xb7, 0x6b, 0x47, 0x7d, 0xcf, 0x56, 0x85, 0x64, 0x17,
+	0x0a, 0xc9, 0xce, 0x0a, 0xc9, 0x7e, 0x3a, 0xa7, 0x92, 0x2e, 0x18, 0x21, 0x77, 0x61, 0xa5, 0x87,
+	0x7e, 0x64, 0x56, 0xb5, 0xb1, 0x8d, 0xdc, 0xef, 0x13, 0xf4, 0x23, 0xaa, 0x49, 0xe4, 0x3d, 0xa8,
+	0x0d, 0x18, 0x0f, 0x24, 0x06, 0x2c, 0x70, 0xd1, 0x04, 0xcd, 0x79, 0x3b, 0xe7, 0x7c, 0x3e, 0xa5,
+	0xd1, 0x22, 0x23, 0xf9, 0x00, 0x36, 0x54, 0x33, 0x0d, 0x03, 0x17, 0x07, 0x18, 0x48, 0x61, 0xd6,
+	0x74, 0x86, 0xee, 0xe4, 0x92, 0x8f, 0x0b, 0x54, 0x3a, 0xcb, 0x5b, 0xff, 0xd1, 0x80, 0x9d, 0xe5,
+	0x89, 0x23, 0xdb, 0x50, 0xee, 0xe3, 0x38, 0xa9, 0x58, 0xaa, 0x3e, 0x09, 0x83, 0xd5, 0x11, 0xf3,
+	0x87, 0x68, 0x96, 0xfe, 0xf5, 0x93, 0xcd, 0xdb, 0xa4, 0x89, 0xe6, 0x47, 0xa5, 0x87, 0x86, 0xf5,
+	0xda, 0x80, 0x1b, 0x45, 0x9f, 0x89, 0x09, 0xeb, 0x03, 0x14, 0x82, 0x79, 0x98, 0x7a, 0x93, 0x81,
+	0xa4, 0x0e, 0x15, 0x81, 0x23, 0x8c, 0xb9, 0x1c, 0x6b, 0xa7, 0xaa, 0x34, 0x87, 0xb3, 0x8e, 0x2b,
+	0x2f, 0xe9, 0xb8, 0x13, 0xa8, 0xe2, 0x65, 0xc4, 0x63, 0x14, 0x8f, 0xa5, 0x2e, 0xfc, 0xda, 0xe1,
+	0x7d, 0x3b, 0x19, 0x3f, 0x76, 0x71, 0xfc, 0x4c, 0x83, 0x50, 0xe3, 0xc7, 0x1e, 0x1d, 0xd8, 0xe7,
+	0x7c, 0x80, 0x74, 0x2a, 0xac, 0x7c, 0xad, 0x15, 0x32, 0xa3, 0x5c, 0xc5, 0xb4, 0x9e, 0x0d, 0x5d,
+	0xcf, 0x19, 0x58, 0x0c, 0xa2, 0x34, 0x1b, 0xc4, 0x2e, 0x54, 0x53, 0xa6, 0xd6, 0x38, 0x71, 0x97,
+	0x4e, 0x11, 0xda, 0xd7, 0x04, 0x78, 0x43, 0x5f, 0x33, 0x61, 0xeb, 0x04, 0x48, 0xb1, 0x88, 0xf0,
+	0x9b, 0x21, 0x0a, 0xf9, 0x26, 0x1e, 0x5b, 0x2f, 0xe1, 0xce, 0xd2, 0x86, 0x23, 0x0d, 0x00, 0x19,
+	0x33, 0xb7, 0xcf, 0x03, 0xaf, 0x73, 0x94, 0x26, 0xab, 0x80, 0x21, 0xf7, 0x60, 0x93, 0x05, 0x61,
+	0x30, 0x56, 0xad, 0xf1, 0x42, 0x60, 0x2c, 0xb4, 0xe6, 0x0a, 0x9d, 0xc3, 0x5a, 0x1f, 0xc2, 0x8a,
+	0xea, 0x0c, 0xe5, 0x82, 0xdb, 0x63, 0xf2, 0x45, 0x36, 0x39, 0x69, 0x06, 0xaa, 0xcc, 0xab, 0xcf,
+	0x73, 0xbc, 0x94, 0x59, 0xe6, 0x33, 0xd8, 0xfa, 0x18, 0xaa, 0xf9, 0xd8, 0x24, 0x87, 0x00, 0x6e,
+	0x18, 0x04, 0xe8, 0xca, 0x30, 0x16, 0xa6, 0xb1, 0x57, 0x9e, 0x19, 0xaf, 0xed, 0x8c, 0x44, 0x0b,
+	0x5c, 0xd6, 0x03, 0xa8, 0xe6, 0x04, 0x42, 0x60, 0x25, 0x60, 0x83, 0xac, 0xf4, 0xf4, 0xb7, 0xc2,
+	0xc9, 0x71, 0x94, 0xbd, 0x8b, 0xfe, 0xb6, 0xea, 0x60, 0x26, 0x56, 0x53, 0x2d, 0x27, 0xc8, 0x7c,
+	0xd9, 0x4b, 0x56, 0xc1, 0xf7, 0x06, 0x6c, 0xe5, 0x94, 0x84, 0x40, 0x76, 0xa0, 0xc4, 0xbb, 0xe9,
+	0x42, 0x58, 0x9b, 0x5c, 0x35, 0x4b, 0x9d, 0x23, 0x5a, 0xe2, 0xdd, 0xdc, 0x5e, 0x69, 0x89, 0xbd,
+	0xf2, 0xd4, 0x9e, 0x7a, 0x9b, 0x9e, 0xd6, 0x94, 0xcc, 0xee, 0x0a, 0xcd, 0xc0, 0x62, 0xe2, 0x56,
+	0x67, 0x13, 0x77, 0x0a, 0xb7, 0x96, 0xf8, 0x48, 0x1e, 0x2e, 0x79, 0x23, 0x73, 0xf1, 0x8d, 0x12,
+	0xee, 0x99, 0x97, 0xda, 0x86, 0xcd, 0xe3, 0x38, 0x1c, 0x46, 0x67, 0xe3, 0xc0, 0x4d, 0x42, 0xfd,
+	0xd9, 0x80, 0xad, 0x1c, 0x75, 0xa6, 0x87, 0xf9, 0x35, 0x35, 0xf6, 0x19, 0xdc, 0xf0, 0x99, 0x90,
+	0x8a, 0xf7, 0x9c, 0xa7, 0x41, 0xff, 0xb3, 0x02, 0x9f, 0x91, 0x27, 0xb7, 0x61, 0x75, 0xa8, 0xeb,
+	0x4a, 0xbd, 0x54, 0x99, 0x26, 0x80, 0xc2, 0x62, 0x1c, 0x87, 0x71, 0xba, 0xe4, 0x12, 0xc0, 0xfa,
+	0xa1, 0x0c, 0x85, 0xdd, 0xb8, 0x34, 0xcf, 0x3b, 0xb0, 0xc6, 0x85, 0x18, 0x62, 0x9c, 0x66, 0x23,
+	0x85, 0xc8, 0x3e, 0x54, 0x5c, 0x9f, 0x63, 0x20, 0x3b, 0x47, 0xe9, 0x80, 0xb9, 0x31, 0xb9, 0x6a,
+	0x56, 0xda, 0x29, 0x8e, 0xe6, 0x54, 0x72, 0x00, 0x35, 0xd7, 0xe7, 0x19, 0x21, 0x71, 0xa0, 0xb5,
+	0x35, 0xb9, 0x6a, 0xd6, 0xda, 0xcf, 0x3a, 0x39, 0x7f, 0x91, 0x47, 0x19, 0x15, 0x6e, 0x18, 0xa5,
+	0xbb, 0xb6, 0x4a, 0x53, 0x88, 0xbc, 0x84, 0x0d, 0xde, 0x3d, 0x0f, 0xfb, 0x18, 0xb4, 0xf5, 0xdd,
+	0x61, 0xae, 0xe9, 0x44, 0xdd, 0x5b, 0xb2, 0xf8, 0xed, 0x4e, 0x91, 0x51, 0xcf, 0xf3, 0xd6, 0xcd,
+	0xc9, 0x55, 0x73, 0xa3, 0x73, 0x54, 0xc0, 0xd3, 0x59, 0x7d, 0xf5, 0x31, 0x90, 0x45, 0xb9, 0x25,
+	0x7b, 0xe0, 0xf9, 0xec, 0x1e, 0x78, 0xff, 0xda, 0x3d, 0x90, 0x1c, 0x4e, 0x76, 0x7e, 0x29, 0xaa,
+	0x0b, 0xc4, 0xd6, 0xfa, 0x0b, 0x33, 0xff, 0xf0, 0xf5, 0x0a, 0x6c, 0x65, 0x97, 0xc4, 0x19, 0xc6,
+	0x23, 0xee, 0x22, 0xf9, 0x14, 0xca, 0xc7, 0x28, 0xc9, 0xce, 0xc2, 0xa9, 0xa1, 0x0b, 0xad, 0x7e,
+	0x73, 0x01, 0x6f, 0x99, 0xaf, 0x7e, 0xff, 0xf3, 0xa7, 0x12, 0x21, 0xdb, 0xfa, 0xc4, 0x1c, 0x1d,
+	0xe4, 0xe7, 0x1a, 0x79, 0x65, 0xc0, 0xce, 0x31, 0xca, 0x65, 0xc5, 0x7f, 0x77, 0xee, 0xd6, 0x5a,
+	0x6c, 0xdf, 0xfa, 0xee, 0x75, 0x2c, 0xd6, 0x5b, 0xda, 0xea, 0x5d, 0xd2, 0x9c, 0xb7, 0xea, 0x74,
+	0xf1, 0xd2, 0x99, 0x36, 0x0b, 0x19, 0x00, 0x39, 0x46, 0x39, 0xdf, 0x1c, 0xff, 0x99, 0x1e, 0x31,
+	0x33, 0x9d, 0x54, 0x37, 0x17, 0x09, 0x89, 0x88, 0xf5, 0x7f, 0x6d, 0xb1, 0x41, 0x76, 0x17, 0x2c,
+	0x7a, 0x8a, 0x53, 0x38, 0x62, 0x1c, 0xb8, 0xa4, 0x07, 0xa0, 0x64, 0xb4, 0xf0, 0x1b, 0x99, 0x49,
+	0x03, 0xb3, 0xae, 0x35, 0xf3, 0xc8, 0xb8, 0x4f, 0x7c, 0xd8, 0x3c, 0x43, 0x59, 0xdc, 0x83, 0xff,
+	0x5b, 0x7a, 0xb7, 0x24, 0x2b, 0xa7, 0xbe, 0xf4, 0xa8, 0xb9, 0xc6, 0x5a, 0xe1, 0xda, 0x79, 0x64,
+	0xdc, 0x6f, 0x7d, 0xf2, 0xeb, 0xa4, 0x61, 0xfc, 0x36, 0x69, 0x18, 0x7f, 0x4c, 0x1a, 0xc6, 0x97,
+	0x87, 0x7f, 0xe3, 0x07, 0x20, 0xe9, 0xc6, 0x5c, 0xe1, 0xc5, 0x9a, 0xbe, 0xd8, 0x1f, 0xfc, 0x35,
+	0x00, 0x6a, 0xb8, 0xff, 0xf0, 0xd0, 0x0c, 0x00, 0x00,
 }
@@ -40,15 +40,24 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
 // VersionMessage represents version of the Argo CD API server
 type VersionMessage struct {
-	Version              string   `protobuf:"bytes,1,opt,name=Version,proto3" json:"Version,omitempty"`
-	BuildDate            string   `protobuf:"bytes,2,opt,name=BuildDate,proto3" json:"BuildDate,omitempty"`
-	GitCommit            string   `protobuf:"bytes,3,opt,name=GitCommit,proto3" json:"GitCommit,omitempty"`
-	GitTag               string   `protobuf:"bytes,4,opt,name=GitTag,proto3" json:"GitTag,omitempty"`
-	GitTreeState         string   `protobuf:"bytes,5,opt,name=GitTreeState,proto3" json:"GitTreeState,omitempty"`
-	GoVersion            string   `protobuf:"bytes,6,opt,name=GoVersion,proto3" json:"GoVersion,omitempty"`
-	Compiler             string   `protobuf:"bytes,7,opt,name=Compiler,proto3" json:"Compiler,omitempty"`
-	Platform             string   `protobuf:"bytes,8,opt,name=Platform,proto3" json:"Platform,omitempty"`
-	KsonnetVersion       string   `protobuf:"bytes,9,opt,name=KsonnetVersion,proto3" json:"KsonnetVersion,omitempty"`
+	Version        string `protobuf:"bytes,1,opt,name=Version,proto3" json:"Version,omitempty"`
+	BuildDate      string `protobuf:"bytes,2,opt,name=BuildDate,proto3" json:"BuildDate,omitempty"`
+	GitCommit      string `protobuf:"bytes,3,opt,name=GitCommit,proto3" json:"GitCommit,omitempty"`
+	GitTag         string `protobuf:"bytes,4,opt,name=GitTag,proto3" json:"GitTag,omitempty"`
+	GitTreeState   string `protobuf:"bytes,5,opt,name=GitTreeState,proto3" json:"GitTreeState,omitempty"`
+	GoVersion      string `protobuf:"bytes,6,opt,name=GoVersion,proto3" json:"GoVersion,omitempty"`
+	Compiler       string `protobuf:"bytes,7,opt,name=Compiler,proto3" json:"Compiler,omitempty"`
+	Platform       string `protobuf:"bytes,8,opt,name=Platform,proto3" json:"Platform,omitempty"`
+	KsonnetVersion string `protobuf:"bytes,9,opt,name=KsonnetVersion,proto3" json:"KsonnetVersion,omitempty"`
+	// ControllerVersion is the version of the application controller, or empty if it is not running
+	ControllerVersion string `protobuf:"bytes,10,opt,name=ControllerVersion,proto3" json:"ControllerVersion,omitempty"`
+	// RepoServerVersion is the version of the repo server, or empty if it is not available
+	RepoServerVersion string `protobuf:"bytes,11,opt,name=RepoServerVersion,proto3" json:"RepoServerVersion,omitempty"`
+	HelmVersion       string `protobuf:"bytes,12,opt,name=HelmVersion,proto3" json:"HelmVersion,omitempty"`
+	KustomizeVersion  string `protobuf:"bytes,13,opt,name=KustomizeVersion,proto3" json:"KustomizeVersion,omitempty"`
+	GitVersion        string `protobuf:"bytes,14,opt,name=GitVersion,proto3" json:"GitVersion,omitempty"`
+	// Features are the enabled optional features of the API server and the repo server
+	Features             []string `protobuf:"bytes,15,rep,name=Features" json:"Features,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -58,7 +67,7 @@ func (m *VersionMessage) Reset()         { *m = VersionMessage{} }
 func (m *VersionMessage) String() string { return proto.CompactTextString(m) }
 func (*VersionMessage) ProtoMessage()    {}
 func (*VersionMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_version_1975a99ee4067d4c, []int{0}
+	return fileDescriptor_version_cdc5e3de220fdff2, []int{0}
 }
 func (m *VersionMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -150,6 +159,48 @@ func (m *VersionMessage) GetKsonnetVersion() string {
 	return ""
 }
 
+func (m *VersionMessage) GetControllerVersion() string {
+	if m != nil {
+		return m.ControllerVersion
+	}
+	return ""
+}
+
+func (m *VersionMessage) GetRepoServerVersion() string {
+	if m != nil {
+		return m.RepoServerVersion
+	}
+	return ""
+}
+
+func (m *VersionMessage) GetHelmVersion() string {
+	if m != nil {
+		return m.HelmVersion
+	}
+	return ""
+}
+
+func (m *VersionMessage) GetKustomizeVersion() string {
+	if m != nil {
+		return m.KustomizeVersion
+	}
+	return ""
+}
+
+func (m *VersionMessage) GetGitVersion() string {
+	if m != nil {
+		return m.GitVersion
+	}
+	return ""
+}
+
+func (m *VersionMessage) GetFeatures() []string {
+	if m != nil {
+		return m.Features
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*VersionMessage)(nil), "version.VersionMessage")
 }
@@ -297,6 +348,51 @@ func (m *VersionMessage) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintVersion(dAtA, i, uint64(len(m.KsonnetVersion)))
 		i += copy(dAtA[i:], m.KsonnetVersion)
 	}
+	if len(m.ControllerVersion) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintVersion(dAtA, i, uint64(len(m.ControllerVersion)))
+		i += copy(dAtA[i:], m.ControllerVersion)
+	}
+	if len(m.RepoServerVersion) > 0 {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintVersion(dAtA, i, uint64(len(m.RepoServerVersion)))
+		i += copy(dAtA[i:], m.RepoServerVersion)
+	}
+	if len(m.HelmVersion) > 0 {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintVersion(dAtA, i, uint64(len(m.HelmVersion)))
+		i += copy(dAtA[i:], m.HelmVersion)
+	}
+	if len(m.KustomizeVersion) > 0 {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintVersion(dAtA, i, uint64(len(m.KustomizeVersion)))
+		i += copy(dAtA[i:], m.KustomizeVersion)
+	}
+	if len(m.GitVersion) > 0 {
+		dAtA[i] = 0x72
+		i++
+		i = encodeVarintVersion(dAtA, i, uint64(len(m.GitVersion)))
+		i += copy(dAtA[i:], m.GitVersion)
+	}
+	if len(m.Features) > 0 {
+		for _, s := range m.Features {
+			dAtA[i] = 0x7a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -351,6 +447,32 @@ func (m *VersionMessage) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovVersion(uint64(l))
 	}
+	l = len(m.ControllerVersion)
+	if l > 0 {
+		n += 1 + l + sovVersion(uint64(l))
+	}
+	l = len(m.RepoServerVersion)
+	if l > 0 {
+		n += 1 + l + sovVersion(uint64(l))
+	}
+	l = len(m.HelmVersion)
+	if l > 0 {
+		n += 1 + l + sovVersion(uint64(l))
+	}
+	l = len(m.KustomizeVersion)
+	if l > 0 {
+		n += 1 + l + sovVersion(uint64(l))
+	}
+	l = len(m.GitVersion)
+	if l > 0 {
+		n += 1 + l + sovVersion(uint64(l))
+	}
+	if len(m.Features) > 0 {
+		for _, s := range m.Features {
+			l = len(s)
+			n += 1 + l + sovVersion(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -660,6 +782,180 @@ func (m *VersionMessage) Unmarshal(dAtA []byte) error {
 			}
 			m.KsonnetVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ControllerVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVersion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVersion
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ControllerVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoServerVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVersion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVersion
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepoServerVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HelmVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVersion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVersion
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HelmVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KustomizeVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVersion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVersion
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KustomizeVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GitVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVersion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVersion
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GitVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVersion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVersion
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Features = append(m.Features, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVersion(dAtA[iNdEx:])
@@ -788,31 +1084,37 @@ var (
 )
 
 func init() {
-	proto.RegisterFile("server/version/version.proto", fileDescriptor_version_1975a99ee4067d4c)
-}
-
-var fileDescriptor_version_1975a99ee4067d4c = []byte{
-	// 351 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0xcf, 0x4a, 0xf3, 0x40,
-	0x14, 0xc5, 0x49, 0xbf, 0xcf, 0xfe, 0x19, 0x4a, 0x17, 0x83, 0xd4, 0x21, 0x96, 0x22, 0x5d, 0x88,
-	0x1b, 0x13, 0xd4, 0x07, 0x10, 0x5a, 0xa5, 0x0b, 0x11, 0x8a, 0x15, 0x17, 0xee, 0x26, 0xe9, 0xed,
-	0x38, 0x9a, 0xcc, 0x0d, 0x93, 0x69, 0xc1, 0xad, 0xaf, 0xe0, 0x0b, 0xf8, 0x38, 0x2e, 0x05, 0x5f,
-	0x40, 0x8a, 0x0f, 0x22, 0x99, 0x64, 0x22, 0x75, 0x95, 0x7b, 0xce, 0xef, 0xe6, 0x24, 0x9c, 0x4b,
-	0x06, 0x39, 0xe8, 0x35, 0xe8, 0x70, 0x0d, 0x3a, 0x97, 0xa8, 0xdc, 0x33, 0xc8, 0x34, 0x1a, 0xa4,
-	0xad, 0x4a, 0xfa, 0x03, 0x81, 0x28, 0x12, 0x08, 0x79, 0x26, 0x43, 0xae, 0x14, 0x1a, 0x6e, 0x24,
-	0xaa, 0xbc, 0x5c, 0xf3, 0xf7, 0x2b, 0x6a, 0x55, 0xb4, 0x5a, 0x86, 0x90, 0x66, 0xe6, 0xb9, 0x84,
-	0xa3, 0xb7, 0x06, 0xe9, 0xdd, 0x95, 0x31, 0xd7, 0x90, 0xe7, 0x5c, 0x00, 0x65, 0xa4, 0x55, 0x39,
-	0xcc, 0x3b, 0xf0, 0x8e, 0x3a, 0x37, 0x4e, 0xd2, 0x01, 0xe9, 0x8c, 0x57, 0x32, 0x59, 0x5c, 0x70,
-	0x03, 0xac, 0x61, 0xd9, 0xaf, 0x51, 0xd0, 0xa9, 0x34, 0x13, 0x4c, 0x53, 0x69, 0xd8, 0xbf, 0x92,
-	0xd6, 0x06, 0xed, 0x93, 0xe6, 0x54, 0x9a, 0x5b, 0x2e, 0xd8, 0x7f, 0x8b, 0x2a, 0x45, 0x47, 0xa4,
-	0x5b, 0x4c, 0x1a, 0x60, 0x6e, 0x8a, 0xd8, 0x1d, 0x4b, 0xb7, 0x3c, 0x9b, 0x8c, 0xee, 0x9f, 0x9a,
-	0x55, 0xb2, 0x33, 0xa8, 0x4f, 0xda, 0x13, 0x4c, 0x33, 0x99, 0x80, 0x66, 0x2d, 0x0b, 0x6b, 0x5d,
-	0xb0, 0x59, 0xc2, 0xcd, 0x12, 0x75, 0xca, 0xda, 0x25, 0x73, 0x9a, 0x1e, 0x92, 0xde, 0x55, 0x8e,
-	0x4a, 0x81, 0x71, 0xd1, 0x1d, 0xbb, 0xf1, 0xc7, 0x3d, 0x8d, 0xea, 0x86, 0xe6, 0xa0, 0xd7, 0x32,
-	0x06, 0x3a, 0xab, 0x1b, 0xa2, 0xfd, 0xa0, 0x6c, 0x37, 0x70, 0xed, 0x06, 0x97, 0x45, 0xbb, 0xfe,
-	0x5e, 0xe0, 0x6e, 0xb5, 0xdd, 0xee, 0x68, 0xf7, 0xe5, 0xf3, 0xfb, 0xb5, 0xd1, 0xa3, 0x5d, 0x7b,
-	0xad, 0x6a, 0x69, 0x7c, 0xfe, 0xbe, 0x19, 0x7a, 0x1f, 0x9b, 0xa1, 0xf7, 0xb5, 0x19, 0x7a, 0xf7,
-	0x27, 0x42, 0x9a, 0x87, 0x55, 0x14, 0xc4, 0x98, 0x86, 0x5c, 0x0b, 0xcc, 0x34, 0x3e, 0xda, 0xe1,
-	0x38, 0x5e, 0x84, 0xd9, 0x93, 0x28, 0x5e, 0x8d, 0x13, 0x09, 0xca, 0xb8, 0x80, 0xa8, 0x69, 0xbf,
-	0x7f, 0xf6, 0x13, 0x00, 0x00, 0xff, 0xff, 0x9e, 0x2e, 0x73, 0x5d, 0x32, 0x02, 0x00, 0x00,
+	proto.RegisterFile("server/version/version.proto", fileDescriptor_version_cdc5e3de220fdff2)
+}
+
+var fileDescriptor_version_cdc5e3de220fdff2 = []byte{
+	// 436 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0xd1, 0x6e, 0xd3, 0x30,
+	0x14, 0x86, 0x95, 0x6d, 0xb4, 0xab, 0x57, 0x0a, 0x58, 0x68, 0x44, 0xa5, 0xaa, 0xaa, 0x5e, 0x20,
+	0x84, 0x20, 0x11, 0xf0, 0x00, 0x48, 0x2b, 0x50, 0xa4, 0x09, 0x69, 0xda, 0x10, 0x17, 0xdc, 0xb9,
+	0xd9, 0x59, 0x30, 0xd8, 0x3e, 0x91, 0x7d, 0x32, 0x09, 0x2e, 0xb9, 0xe0, 0x05, 0x78, 0x29, 0x2e,
+	0x91, 0x78, 0x01, 0x54, 0xf1, 0x20, 0xc8, 0x4e, 0x1c, 0xd6, 0xf5, 0x2a, 0xfe, 0xff, 0xff, 0xcb,
+	0x89, 0x63, 0xff, 0x6c, 0xe2, 0xc0, 0x5e, 0x82, 0xcd, 0x2f, 0xc1, 0x3a, 0x89, 0x26, 0x3e, 0xb3,
+	0xca, 0x22, 0x21, 0xef, 0xb7, 0x72, 0x3c, 0x29, 0x11, 0x4b, 0x05, 0xb9, 0xa8, 0x64, 0x2e, 0x8c,
+	0x41, 0x12, 0x24, 0xd1, 0xb8, 0x06, 0x1b, 0xdf, 0x6f, 0xd3, 0xa0, 0x56, 0xf5, 0x45, 0x0e, 0xba,
+	0xa2, 0x2f, 0x4d, 0x38, 0xff, 0xbe, 0xc7, 0x46, 0xef, 0x9b, 0x31, 0x6f, 0xc1, 0x39, 0x51, 0x02,
+	0x4f, 0x59, 0xbf, 0x75, 0xd2, 0x64, 0x96, 0x3c, 0x1c, 0x9c, 0x46, 0xc9, 0x27, 0x6c, 0x70, 0x54,
+	0x4b, 0x75, 0xfe, 0x52, 0x10, 0xa4, 0x3b, 0x21, 0xfb, 0x6f, 0xf8, 0x74, 0x29, 0x69, 0x81, 0x5a,
+	0x4b, 0x4a, 0x77, 0x9b, 0xb4, 0x33, 0xf8, 0x21, 0xeb, 0x2d, 0x25, 0xbd, 0x13, 0x65, 0xba, 0x17,
+	0xa2, 0x56, 0xf1, 0x39, 0x1b, 0xfa, 0x95, 0x05, 0x38, 0x23, 0x3f, 0xf6, 0x46, 0x48, 0x37, 0xbc,
+	0x30, 0x19, 0xe3, 0x9e, 0x7a, 0xed, 0xe4, 0x68, 0xf0, 0x31, 0xdb, 0x5f, 0xa0, 0xae, 0xa4, 0x02,
+	0x9b, 0xf6, 0x43, 0xd8, 0x69, 0x9f, 0x9d, 0x28, 0x41, 0x17, 0x68, 0x75, 0xba, 0xdf, 0x64, 0x51,
+	0xf3, 0x07, 0x6c, 0x74, 0xec, 0xd0, 0x18, 0xa0, 0x38, 0x7a, 0x10, 0x88, 0x6b, 0x2e, 0x7f, 0xcc,
+	0xee, 0x2c, 0xd0, 0x90, 0x45, 0xa5, 0xc0, 0x46, 0x94, 0x05, 0x74, 0x3b, 0xf0, 0xf4, 0x29, 0x54,
+	0x78, 0x16, 0x2e, 0x2e, 0xd2, 0x07, 0x0d, 0xbd, 0x15, 0xf0, 0x19, 0x3b, 0x78, 0x03, 0x4a, 0x47,
+	0x6e, 0x18, 0xb8, 0xab, 0x16, 0x7f, 0xc4, 0x6e, 0x1f, 0xd7, 0x8e, 0x50, 0xcb, 0xaf, 0x10, 0xb1,
+	0x9b, 0x01, 0xdb, 0xf2, 0xf9, 0x94, 0xb1, 0xa5, 0xec, 0xfe, 0x66, 0x14, 0xa8, 0x2b, 0x8e, 0x3f,
+	0x8d, 0xd7, 0x20, 0xa8, 0xb6, 0xe0, 0xd2, 0x5b, 0xb3, 0x5d, 0x7f, 0x1a, 0x51, 0x3f, 0x5b, 0x75,
+	0x3d, 0xf0, 0x3b, 0x94, 0x05, 0xf0, 0x93, 0xae, 0x07, 0xfc, 0x30, 0x6b, 0x3a, 0x94, 0xc5, 0x0e,
+	0x65, 0xaf, 0x7c, 0x87, 0xc6, 0xf7, 0xb2, 0xd8, 0xc8, 0xcd, 0x0e, 0xcd, 0xef, 0x7e, 0xfb, 0xfd,
+	0xf7, 0xc7, 0xce, 0x88, 0x0f, 0x43, 0x27, 0x5b, 0xe8, 0xe8, 0xc5, 0xcf, 0xf5, 0x34, 0xf9, 0xb5,
+	0x9e, 0x26, 0x7f, 0xd6, 0xd3, 0xe4, 0xc3, 0xd3, 0x52, 0xd2, 0xc7, 0x7a, 0x95, 0x15, 0xa8, 0x73,
+	0x61, 0x4b, 0xac, 0x2c, 0x7e, 0x0a, 0x8b, 0x27, 0xc5, 0x79, 0x5e, 0x7d, 0x2e, 0xfd, 0xab, 0x85,
+	0x92, 0x60, 0x28, 0x0e, 0x58, 0xf5, 0xc2, 0xf7, 0x9f, 0xff, 0x1b, 0x00, 0xa2, 0xb1, 0x9b, 0xa0,
+	0x18, 0x03, 0x00, 0x00,
 }
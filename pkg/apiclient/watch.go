@@ -0,0 +1,46 @@
+package apiclient
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/watch"
+
+	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+// ApplicationCondition is a condition on the state of an application which WaitForApplication waits for
+type ApplicationCondition func(app *argoappv1.Application) bool
+
+// ApplicationSyncedAndHealthy is satisfied by applications which are synced with their target revision and healthy
+func ApplicationSyncedAndHealthy(app *argoappv1.Application) bool {
+	return app.Status.Sync.Status == argoappv1.SyncStatusCodeSynced && app.Status.Health.Status == argoappv1.HealthStatusHealthy
+}
+
+// ApplicationOperationCompleted is satisfied by applications without operation in progress
+func ApplicationOperationCompleted(app *argoappv1.Application) bool {
+	return app.Operation == nil && (app.Status.OperationState == nil || app.Status.OperationState.Phase.Completed())
+}
+
+// WaitForApplication reads the watch events of an application, e.g. from WatchApplicationWithRetry, until the
+// application satisfies the condition and returns it. Fails if the application is deleted, the channel is closed or
+// the context is done.
+func WaitForApplication(ctx context.Context, events <-chan *argoappv1.ApplicationWatchEvent, condition ApplicationCondition) (*argoappv1.Application, error) {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case event, ok := <-events:
+			if !ok {
+				return nil, fmt.Errorf("watch of application closed")
+			}
+			app := event.Application
+			if event.Type == watch.Deleted {
+				return nil, fmt.Errorf("application '%s' was deleted", app.Name)
+			}
+			if condition(&app) {
+				return &app, nil
+			}
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package apiclient
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+
+	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+func newWatchEvent(eventType watch.EventType, syncStatus argoappv1.SyncStatusCode) *argoappv1.ApplicationWatchEvent {
+	return &argoappv1.ApplicationWatchEvent{
+		Type: eventType,
+		Application: argoappv1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: "guestbook"},
+			Status: argoappv1.ApplicationStatus{
+				Sync:   argoappv1.SyncStatus{Status: syncStatus},
+				Health: argoappv1.HealthStatus{Status: argoappv1.HealthStatusHealthy},
+			},
+		},
+	}
+}
+
+func TestWaitForApplication(t *testing.T) {
+	t.Run("Satisfied", func(t *testing.T) {
+		events := make(chan *argoappv1.ApplicationWatchEvent, 2)
+		events <- newWatchEvent(watch.Modified, argoappv1.SyncStatusCodeOutOfSync)
+		events <- newWatchEvent(watch.Modified, argoappv1.SyncStatusCodeSynced)
+		app, err := WaitForApplication(context.Background(), events, ApplicationSyncedAndHealthy)
+		assert.NoError(t, err)
+		assert.Equal(t, argoappv1.SyncStatusCodeSynced, app.Status.Sync.Status)
+	})
+	t.Run("Deleted", func(t *testing.T) {
+		events := make(chan *argoappv1.ApplicationWatchEvent, 1)
+		events <- newWatchEvent(watch.Deleted, argoappv1.SyncStatusCodeSynced)
+		_, err := WaitForApplication(context.Background(), events, ApplicationSyncedAndHealthy)
+		assert.EqualError(t, err, "application 'guestbook' was deleted")
+	})
+	t.Run("Closed", func(t *testing.T) {
+		events := make(chan *argoappv1.ApplicationWatchEvent)
+		close(events)
+		_, err := WaitForApplication(context.Background(), events, ApplicationSyncedAndHealthy)
+		assert.Error(t, err)
+	})
+	t.Run("Cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := WaitForApplication(ctx, make(chan *argoappv1.ApplicationWatchEvent), ApplicationSyncedAndHealthy)
+		assert.Equal(t, context.Canceled, err)
+	})
+}
+
+func TestJwtCredentials_ContextToken(t *testing.T) {
+	creds := jwtCredentials{Token: "client-token"}
+
+	md, err := creds.GetRequestMetadata(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "client-token", md[MetaDataTokenKey])
+
+	md, err = creds.GetRequestMetadata(ContextWithAuthToken(context.Background(), "user-token"))
+	assert.NoError(t, err)
+	assert.Equal(t, "user-token", md[MetaDataTokenKey])
+}
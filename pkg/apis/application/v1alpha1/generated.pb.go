@@ -39,7 +39,7 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 func (m *AWSAuthConfig) Reset()      { *m = AWSAuthConfig{} }
 func (*AWSAuthConfig) ProtoMessage() {}
 func (*AWSAuthConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{0}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{0}
 }
 func (m *AWSAuthConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -67,7 +67,7 @@ var xxx_messageInfo_AWSAuthConfig proto.InternalMessageInfo
 func (m *AppProject) Reset()      { *m = AppProject{} }
 func (*AppProject) ProtoMessage() {}
 func (*AppProject) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{1}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{1}
 }
 func (m *AppProject) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -95,7 +95,7 @@ var xxx_messageInfo_AppProject proto.InternalMessageInfo
 func (m *AppProjectList) Reset()      { *m = AppProjectList{} }
 func (*AppProjectList) ProtoMessage() {}
 func (*AppProjectList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{2}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{2}
 }
 func (m *AppProjectList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -123,7 +123,7 @@ var xxx_messageInfo_AppProjectList proto.InternalMessageInfo
 func (m *AppProjectSpec) Reset()      { *m = AppProjectSpec{} }
 func (*AppProjectSpec) ProtoMessage() {}
 func (*AppProjectSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{3}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{3}
 }
 func (m *AppProjectSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -151,7 +151,7 @@ var xxx_messageInfo_AppProjectSpec proto.InternalMessageInfo
 func (m *Application) Reset()      { *m = Application{} }
 func (*Application) ProtoMessage() {}
 func (*Application) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{4}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{4}
 }
 func (m *Application) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -179,7 +179,7 @@ var xxx_messageInfo_Application proto.InternalMessageInfo
 func (m *ApplicationCondition) Reset()      { *m = ApplicationCondition{} }
 func (*ApplicationCondition) ProtoMessage() {}
 func (*ApplicationCondition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{5}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{5}
 }
 func (m *ApplicationCondition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -207,7 +207,7 @@ var xxx_messageInfo_ApplicationCondition proto.InternalMessageInfo
 func (m *ApplicationDestination) Reset()      { *m = ApplicationDestination{} }
 func (*ApplicationDestination) ProtoMessage() {}
 func (*ApplicationDestination) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{6}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{6}
 }
 func (m *ApplicationDestination) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -235,7 +235,7 @@ var xxx_messageInfo_ApplicationDestination proto.InternalMessageInfo
 func (m *ApplicationList) Reset()      { *m = ApplicationList{} }
 func (*ApplicationList) ProtoMessage() {}
 func (*ApplicationList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{7}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{7}
 }
 func (m *ApplicationList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -263,7 +263,7 @@ var xxx_messageInfo_ApplicationList proto.InternalMessageInfo
 func (m *ApplicationSource) Reset()      { *m = ApplicationSource{} }
 func (*ApplicationSource) ProtoMessage() {}
 func (*ApplicationSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{8}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{8}
 }
 func (m *ApplicationSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -291,7 +291,7 @@ var xxx_messageInfo_ApplicationSource proto.InternalMessageInfo
 func (m *ApplicationSourceDirectory) Reset()      { *m = ApplicationSourceDirectory{} }
 func (*ApplicationSourceDirectory) ProtoMessage() {}
 func (*ApplicationSourceDirectory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{9}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{9}
 }
 func (m *ApplicationSourceDirectory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -319,7 +319,7 @@ var xxx_messageInfo_ApplicationSourceDirectory proto.InternalMessageInfo
 func (m *ApplicationSourceHelm) Reset()      { *m = ApplicationSourceHelm{} }
 func (*ApplicationSourceHelm) ProtoMessage() {}
 func (*ApplicationSourceHelm) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{10}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{10}
 }
 func (m *ApplicationSourceHelm) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -347,7 +347,7 @@ var xxx_messageInfo_ApplicationSourceHelm proto.InternalMessageInfo
 func (m *ApplicationSourceJsonnet) Reset()      { *m = ApplicationSourceJsonnet{} }
 func (*ApplicationSourceJsonnet) ProtoMessage() {}
 func (*ApplicationSourceJsonnet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{11}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{11}
 }
 func (m *ApplicationSourceJsonnet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -375,7 +375,7 @@ var xxx_messageInfo_ApplicationSourceJsonnet proto.InternalMessageInfo
 func (m *ApplicationSourceKsonnet) Reset()      { *m = ApplicationSourceKsonnet{} }
 func (*ApplicationSourceKsonnet) ProtoMessage() {}
 func (*ApplicationSourceKsonnet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{12}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{12}
 }
 func (m *ApplicationSourceKsonnet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -403,7 +403,7 @@ var xxx_messageInfo_ApplicationSourceKsonnet proto.InternalMessageInfo
 func (m *ApplicationSourceKustomize) Reset()      { *m = ApplicationSourceKustomize{} }
 func (*ApplicationSourceKustomize) ProtoMessage() {}
 func (*ApplicationSourceKustomize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{13}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{13}
 }
 func (m *ApplicationSourceKustomize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -428,10 +428,66 @@ func (m *ApplicationSourceKustomize) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApplicationSourceKustomize proto.InternalMessageInfo
 
+func (m *ApplicationSourcePatch) Reset()      { *m = ApplicationSourcePatch{} }
+func (*ApplicationSourcePatch) ProtoMessage() {}
+func (*ApplicationSourcePatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_947ac14459ab55d5, []int{14}
+}
+func (m *ApplicationSourcePatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSourcePatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *ApplicationSourcePatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSourcePatch.Merge(dst, src)
+}
+func (m *ApplicationSourcePatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSourcePatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSourcePatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSourcePatch proto.InternalMessageInfo
+
+func (m *ApplicationSourcePatchTarget) Reset()      { *m = ApplicationSourcePatchTarget{} }
+func (*ApplicationSourcePatchTarget) ProtoMessage() {}
+func (*ApplicationSourcePatchTarget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_947ac14459ab55d5, []int{15}
+}
+func (m *ApplicationSourcePatchTarget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSourcePatchTarget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *ApplicationSourcePatchTarget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSourcePatchTarget.Merge(dst, src)
+}
+func (m *ApplicationSourcePatchTarget) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSourcePatchTarget) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSourcePatchTarget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSourcePatchTarget proto.InternalMessageInfo
+
 func (m *ApplicationSourcePlugin) Reset()      { *m = ApplicationSourcePlugin{} }
 func (*ApplicationSourcePlugin) ProtoMessage() {}
 func (*ApplicationSourcePlugin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{14}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{16}
 }
 func (m *ApplicationSourcePlugin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -459,7 +515,7 @@ var xxx_messageInfo_ApplicationSourcePlugin proto.InternalMessageInfo
 func (m *ApplicationSpec) Reset()      { *m = ApplicationSpec{} }
 func (*ApplicationSpec) ProtoMessage() {}
 func (*ApplicationSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{15}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{17}
 }
 func (m *ApplicationSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -487,7 +543,7 @@ var xxx_messageInfo_ApplicationSpec proto.InternalMessageInfo
 func (m *ApplicationStatus) Reset()      { *m = ApplicationStatus{} }
 func (*ApplicationStatus) ProtoMessage() {}
 func (*ApplicationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{16}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{18}
 }
 func (m *ApplicationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -515,7 +571,7 @@ var xxx_messageInfo_ApplicationStatus proto.InternalMessageInfo
 func (m *ApplicationSummary) Reset()      { *m = ApplicationSummary{} }
 func (*ApplicationSummary) ProtoMessage() {}
 func (*ApplicationSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{17}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{19}
 }
 func (m *ApplicationSummary) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -540,10 +596,38 @@ func (m *ApplicationSummary) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApplicationSummary proto.InternalMessageInfo
 
+func (m *ApplicationSuspend) Reset()      { *m = ApplicationSuspend{} }
+func (*ApplicationSuspend) ProtoMessage() {}
+func (*ApplicationSuspend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_947ac14459ab55d5, []int{20}
+}
+func (m *ApplicationSuspend) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSuspend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *ApplicationSuspend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSuspend.Merge(dst, src)
+}
+func (m *ApplicationSuspend) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSuspend) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSuspend.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSuspend proto.InternalMessageInfo
+
 func (m *ApplicationTree) Reset()      { *m = ApplicationTree{} }
 func (*ApplicationTree) ProtoMessage() {}
 func (*ApplicationTree) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{18}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{21}
 }
 func (m *ApplicationTree) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -571,7 +655,7 @@ var xxx_messageInfo_ApplicationTree proto.InternalMessageInfo
 func (m *ApplicationWatchEvent) Reset()      { *m = ApplicationWatchEvent{} }
 func (*ApplicationWatchEvent) ProtoMessage() {}
 func (*ApplicationWatchEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{19}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{22}
 }
 func (m *ApplicationWatchEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -596,10 +680,66 @@ func (m *ApplicationWatchEvent) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApplicationWatchEvent proto.InternalMessageInfo
 
+func (m *ApplicationWriteBack) Reset()      { *m = ApplicationWriteBack{} }
+func (*ApplicationWriteBack) ProtoMessage() {}
+func (*ApplicationWriteBack) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_947ac14459ab55d5, []int{23}
+}
+func (m *ApplicationWriteBack) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationWriteBack) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *ApplicationWriteBack) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationWriteBack.Merge(dst, src)
+}
+func (m *ApplicationWriteBack) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationWriteBack) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationWriteBack.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationWriteBack proto.InternalMessageInfo
+
+func (m *AutomatedRollback) Reset()      { *m = AutomatedRollback{} }
+func (*AutomatedRollback) ProtoMessage() {}
+func (*AutomatedRollback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_947ac14459ab55d5, []int{24}
+}
+func (m *AutomatedRollback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutomatedRollback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *AutomatedRollback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutomatedRollback.Merge(dst, src)
+}
+func (m *AutomatedRollback) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutomatedRollback) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutomatedRollback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutomatedRollback proto.InternalMessageInfo
+
 func (m *Cluster) Reset()      { *m = Cluster{} }
 func (*Cluster) ProtoMessage() {}
 func (*Cluster) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{20}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{25}
 }
 func (m *Cluster) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -627,7 +767,7 @@ var xxx_messageInfo_Cluster proto.InternalMessageInfo
 func (m *ClusterConfig) Reset()      { *m = ClusterConfig{} }
 func (*ClusterConfig) ProtoMessage() {}
 func (*ClusterConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{21}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{26}
 }
 func (m *ClusterConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -655,7 +795,7 @@ var xxx_messageInfo_ClusterConfig proto.InternalMessageInfo
 func (m *ClusterList) Reset()      { *m = ClusterList{} }
 func (*ClusterList) ProtoMessage() {}
 func (*ClusterList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{22}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{27}
 }
 func (m *ClusterList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -683,7 +823,7 @@ var xxx_messageInfo_ClusterList proto.InternalMessageInfo
 func (m *Command) Reset()      { *m = Command{} }
 func (*Command) ProtoMessage() {}
 func (*Command) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{23}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{28}
 }
 func (m *Command) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -708,10 +848,38 @@ func (m *Command) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Command proto.InternalMessageInfo
 
+func (m *CommitMetadata) Reset()      { *m = CommitMetadata{} }
+func (*CommitMetadata) ProtoMessage() {}
+func (*CommitMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_947ac14459ab55d5, []int{29}
+}
+func (m *CommitMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *CommitMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitMetadata.Merge(dst, src)
+}
+func (m *CommitMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommitMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitMetadata proto.InternalMessageInfo
+
 func (m *ComparedTo) Reset()      { *m = ComparedTo{} }
 func (*ComparedTo) ProtoMessage() {}
 func (*ComparedTo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{24}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{30}
 }
 func (m *ComparedTo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -739,7 +907,7 @@ var xxx_messageInfo_ComparedTo proto.InternalMessageInfo
 func (m *ComponentParameter) Reset()      { *m = ComponentParameter{} }
 func (*ComponentParameter) ProtoMessage() {}
 func (*ComponentParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{25}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{31}
 }
 func (m *ComponentParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -767,7 +935,7 @@ var xxx_messageInfo_ComponentParameter proto.InternalMessageInfo
 func (m *ConfigManagementPlugin) Reset()      { *m = ConfigManagementPlugin{} }
 func (*ConfigManagementPlugin) ProtoMessage() {}
 func (*ConfigManagementPlugin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{26}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{32}
 }
 func (m *ConfigManagementPlugin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -795,7 +963,7 @@ var xxx_messageInfo_ConfigManagementPlugin proto.InternalMessageInfo
 func (m *ConnectionState) Reset()      { *m = ConnectionState{} }
 func (*ConnectionState) ProtoMessage() {}
 func (*ConnectionState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{27}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{33}
 }
 func (m *ConnectionState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -823,7 +991,7 @@ var xxx_messageInfo_ConnectionState proto.InternalMessageInfo
 func (m *EnvEntry) Reset()      { *m = EnvEntry{} }
 func (*EnvEntry) ProtoMessage() {}
 func (*EnvEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{28}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{34}
 }
 func (m *EnvEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -851,7 +1019,7 @@ var xxx_messageInfo_EnvEntry proto.InternalMessageInfo
 func (m *HealthStatus) Reset()      { *m = HealthStatus{} }
 func (*HealthStatus) ProtoMessage() {}
 func (*HealthStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{29}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{35}
 }
 func (m *HealthStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -876,10 +1044,38 @@ func (m *HealthStatus) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HealthStatus proto.InternalMessageInfo
 
+func (m *HelmFileParameter) Reset()      { *m = HelmFileParameter{} }
+func (*HelmFileParameter) ProtoMessage() {}
+func (*HelmFileParameter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_947ac14459ab55d5, []int{36}
+}
+func (m *HelmFileParameter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HelmFileParameter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *HelmFileParameter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HelmFileParameter.Merge(dst, src)
+}
+func (m *HelmFileParameter) XXX_Size() int {
+	return m.Size()
+}
+func (m *HelmFileParameter) XXX_DiscardUnknown() {
+	xxx_messageInfo_HelmFileParameter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HelmFileParameter proto.InternalMessageInfo
+
 func (m *HelmParameter) Reset()      { *m = HelmParameter{} }
 func (*HelmParameter) ProtoMessage() {}
 func (*HelmParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{30}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{37}
 }
 func (m *HelmParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -904,10 +1100,38 @@ func (m *HelmParameter) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HelmParameter proto.InternalMessageInfo
 
+func (m *ImageUpdate) Reset()      { *m = ImageUpdate{} }
+func (*ImageUpdate) ProtoMessage() {}
+func (*ImageUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_947ac14459ab55d5, []int{38}
+}
+func (m *ImageUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImageUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *ImageUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImageUpdate.Merge(dst, src)
+}
+func (m *ImageUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImageUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImageUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImageUpdate proto.InternalMessageInfo
+
 func (m *Info) Reset()      { *m = Info{} }
 func (*Info) ProtoMessage() {}
 func (*Info) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{31}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{39}
 }
 func (m *Info) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -935,7 +1159,7 @@ var xxx_messageInfo_Info proto.InternalMessageInfo
 func (m *InfoItem) Reset()      { *m = InfoItem{} }
 func (*InfoItem) ProtoMessage() {}
 func (*InfoItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{32}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{40}
 }
 func (m *InfoItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -963,7 +1187,7 @@ var xxx_messageInfo_InfoItem proto.InternalMessageInfo
 func (m *JWTToken) Reset()      { *m = JWTToken{} }
 func (*JWTToken) ProtoMessage() {}
 func (*JWTToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{33}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{41}
 }
 func (m *JWTToken) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -991,7 +1215,7 @@ var xxx_messageInfo_JWTToken proto.InternalMessageInfo
 func (m *JsonnetVar) Reset()      { *m = JsonnetVar{} }
 func (*JsonnetVar) ProtoMessage() {}
 func (*JsonnetVar) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{34}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{42}
 }
 func (m *JsonnetVar) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1019,7 +1243,7 @@ var xxx_messageInfo_JsonnetVar proto.InternalMessageInfo
 func (m *KsonnetParameter) Reset()      { *m = KsonnetParameter{} }
 func (*KsonnetParameter) ProtoMessage() {}
 func (*KsonnetParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{35}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{43}
 }
 func (m *KsonnetParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1047,7 +1271,7 @@ var xxx_messageInfo_KsonnetParameter proto.InternalMessageInfo
 func (m *KustomizeOptions) Reset()      { *m = KustomizeOptions{} }
 func (*KustomizeOptions) ProtoMessage() {}
 func (*KustomizeOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{36}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{44}
 }
 func (m *KustomizeOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1072,10 +1296,38 @@ func (m *KustomizeOptions) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_KustomizeOptions proto.InternalMessageInfo
 
+func (m *ManifestQuota) Reset()      { *m = ManifestQuota{} }
+func (*ManifestQuota) ProtoMessage() {}
+func (*ManifestQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_947ac14459ab55d5, []int{45}
+}
+func (m *ManifestQuota) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ManifestQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *ManifestQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManifestQuota.Merge(dst, src)
+}
+func (m *ManifestQuota) XXX_Size() int {
+	return m.Size()
+}
+func (m *ManifestQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManifestQuota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ManifestQuota proto.InternalMessageInfo
+
 func (m *Operation) Reset()      { *m = Operation{} }
 func (*Operation) ProtoMessage() {}
 func (*Operation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{37}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{46}
 }
 func (m *Operation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1100,10 +1352,38 @@ func (m *Operation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Operation proto.InternalMessageInfo
 
+func (m *OperationInitiator) Reset()      { *m = OperationInitiator{} }
+func (*OperationInitiator) ProtoMessage() {}
+func (*OperationInitiator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_947ac14459ab55d5, []int{47}
+}
+func (m *OperationInitiator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperationInitiator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *OperationInitiator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperationInitiator.Merge(dst, src)
+}
+func (m *OperationInitiator) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperationInitiator) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperationInitiator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperationInitiator proto.InternalMessageInfo
+
 func (m *OperationState) Reset()      { *m = OperationState{} }
 func (*OperationState) ProtoMessage() {}
 func (*OperationState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{38}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{48}
 }
 func (m *OperationState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1131,7 +1411,7 @@ var xxx_messageInfo_OperationState proto.InternalMessageInfo
 func (m *OrphanedResourcesMonitorSettings) Reset()      { *m = OrphanedResourcesMonitorSettings{} }
 func (*OrphanedResourcesMonitorSettings) ProtoMessage() {}
 func (*OrphanedResourcesMonitorSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{39}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{49}
 }
 func (m *OrphanedResourcesMonitorSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1159,7 +1439,7 @@ var xxx_messageInfo_OrphanedResourcesMonitorSettings proto.InternalMessageInfo
 func (m *ProjectRole) Reset()      { *m = ProjectRole{} }
 func (*ProjectRole) ProtoMessage() {}
 func (*ProjectRole) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{40}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{50}
 }
 func (m *ProjectRole) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1184,10 +1464,122 @@ func (m *ProjectRole) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ProjectRole proto.InternalMessageInfo
 
+func (m *ProjectRoleQuota) Reset()      { *m = ProjectRoleQuota{} }
+func (*ProjectRoleQuota) ProtoMessage() {}
+func (*ProjectRoleQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_947ac14459ab55d5, []int{51}
+}
+func (m *ProjectRoleQuota) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectRoleQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *ProjectRoleQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectRoleQuota.Merge(dst, src)
+}
+func (m *ProjectRoleQuota) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectRoleQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectRoleQuota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectRoleQuota proto.InternalMessageInfo
+
+func (m *ProjectTemplate) Reset()      { *m = ProjectTemplate{} }
+func (*ProjectTemplate) ProtoMessage() {}
+func (*ProjectTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_947ac14459ab55d5, []int{52}
+}
+func (m *ProjectTemplate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *ProjectTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectTemplate.Merge(dst, src)
+}
+func (m *ProjectTemplate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectTemplate proto.InternalMessageInfo
+
+func (m *ProjectTemplateParameter) Reset()      { *m = ProjectTemplateParameter{} }
+func (*ProjectTemplateParameter) ProtoMessage() {}
+func (*ProjectTemplateParameter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_947ac14459ab55d5, []int{53}
+}
+func (m *ProjectTemplateParameter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectTemplateParameter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *ProjectTemplateParameter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectTemplateParameter.Merge(dst, src)
+}
+func (m *ProjectTemplateParameter) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectTemplateParameter) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectTemplateParameter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectTemplateParameter proto.InternalMessageInfo
+
+func (m *RenderedSource) Reset()      { *m = RenderedSource{} }
+func (*RenderedSource) ProtoMessage() {}
+func (*RenderedSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_947ac14459ab55d5, []int{54}
+}
+func (m *RenderedSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RenderedSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *RenderedSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenderedSource.Merge(dst, src)
+}
+func (m *RenderedSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *RenderedSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenderedSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RenderedSource proto.InternalMessageInfo
+
 func (m *Repository) Reset()      { *m = Repository{} }
 func (*Repository) ProtoMessage() {}
 func (*Repository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{41}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{55}
 }
 func (m *Repository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1215,7 +1607,7 @@ var xxx_messageInfo_Repository proto.InternalMessageInfo
 func (m *RepositoryCertificate) Reset()      { *m = RepositoryCertificate{} }
 func (*RepositoryCertificate) ProtoMessage() {}
 func (*RepositoryCertificate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{42}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{56}
 }
 func (m *RepositoryCertificate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1243,7 +1635,7 @@ var xxx_messageInfo_RepositoryCertificate proto.InternalMessageInfo
 func (m *RepositoryCertificateList) Reset()      { *m = RepositoryCertificateList{} }
 func (*RepositoryCertificateList) ProtoMessage() {}
 func (*RepositoryCertificateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{43}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{57}
 }
 func (m *RepositoryCertificateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1268,10 +1660,66 @@ func (m *RepositoryCertificateList) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RepositoryCertificateList proto.InternalMessageInfo
 
+func (m *RepositoryFailure) Reset()      { *m = RepositoryFailure{} }
+func (*RepositoryFailure) ProtoMessage() {}
+func (*RepositoryFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_947ac14459ab55d5, []int{58}
+}
+func (m *RepositoryFailure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RepositoryFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *RepositoryFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepositoryFailure.Merge(dst, src)
+}
+func (m *RepositoryFailure) XXX_Size() int {
+	return m.Size()
+}
+func (m *RepositoryFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepositoryFailure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepositoryFailure proto.InternalMessageInfo
+
+func (m *RepositoryFailureList) Reset()      { *m = RepositoryFailureList{} }
+func (*RepositoryFailureList) ProtoMessage() {}
+func (*RepositoryFailureList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_947ac14459ab55d5, []int{59}
+}
+func (m *RepositoryFailureList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RepositoryFailureList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *RepositoryFailureList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepositoryFailureList.Merge(dst, src)
+}
+func (m *RepositoryFailureList) XXX_Size() int {
+	return m.Size()
+}
+func (m *RepositoryFailureList) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepositoryFailureList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepositoryFailureList proto.InternalMessageInfo
+
 func (m *RepositoryList) Reset()      { *m = RepositoryList{} }
 func (*RepositoryList) ProtoMessage() {}
 func (*RepositoryList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{44}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{60}
 }
 func (m *RepositoryList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1299,7 +1747,7 @@ var xxx_messageInfo_RepositoryList proto.InternalMessageInfo
 func (m *ResourceAction) Reset()      { *m = ResourceAction{} }
 func (*ResourceAction) ProtoMessage() {}
 func (*ResourceAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{45}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{61}
 }
 func (m *ResourceAction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1327,7 +1775,7 @@ var xxx_messageInfo_ResourceAction proto.InternalMessageInfo
 func (m *ResourceActionDefinition) Reset()      { *m = ResourceActionDefinition{} }
 func (*ResourceActionDefinition) ProtoMessage() {}
 func (*ResourceActionDefinition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{46}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{62}
 }
 func (m *ResourceActionDefinition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1355,7 +1803,7 @@ var xxx_messageInfo_ResourceActionDefinition proto.InternalMessageInfo
 func (m *ResourceActionParam) Reset()      { *m = ResourceActionParam{} }
 func (*ResourceActionParam) ProtoMessage() {}
 func (*ResourceActionParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{47}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{63}
 }
 func (m *ResourceActionParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1383,7 +1831,7 @@ var xxx_messageInfo_ResourceActionParam proto.InternalMessageInfo
 func (m *ResourceActions) Reset()      { *m = ResourceActions{} }
 func (*ResourceActions) ProtoMessage() {}
 func (*ResourceActions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{48}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{64}
 }
 func (m *ResourceActions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1411,7 +1859,7 @@ var xxx_messageInfo_ResourceActions proto.InternalMessageInfo
 func (m *ResourceDiff) Reset()      { *m = ResourceDiff{} }
 func (*ResourceDiff) ProtoMessage() {}
 func (*ResourceDiff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{49}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{65}
 }
 func (m *ResourceDiff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1436,10 +1884,38 @@ func (m *ResourceDiff) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ResourceDiff proto.InternalMessageInfo
 
+func (m *ResourceHook) Reset()      { *m = ResourceHook{} }
+func (*ResourceHook) ProtoMessage() {}
+func (*ResourceHook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_947ac14459ab55d5, []int{66}
+}
+func (m *ResourceHook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceHook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *ResourceHook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceHook.Merge(dst, src)
+}
+func (m *ResourceHook) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceHook) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceHook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceHook proto.InternalMessageInfo
+
 func (m *ResourceIgnoreDifferences) Reset()      { *m = ResourceIgnoreDifferences{} }
 func (*ResourceIgnoreDifferences) ProtoMessage() {}
 func (*ResourceIgnoreDifferences) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{50}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{67}
 }
 func (m *ResourceIgnoreDifferences) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1464,10 +1940,38 @@ func (m *ResourceIgnoreDifferences) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ResourceIgnoreDifferences proto.InternalMessageInfo
 
+func (m *ResourceLink) Reset()      { *m = ResourceLink{} }
+func (*ResourceLink) ProtoMessage() {}
+func (*ResourceLink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_947ac14459ab55d5, []int{68}
+}
+func (m *ResourceLink) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceLink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *ResourceLink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceLink.Merge(dst, src)
+}
+func (m *ResourceLink) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceLink) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceLink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceLink proto.InternalMessageInfo
+
 func (m *ResourceNetworkingInfo) Reset()      { *m = ResourceNetworkingInfo{} }
 func (*ResourceNetworkingInfo) ProtoMessage() {}
 func (*ResourceNetworkingInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{51}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{69}
 }
 func (m *ResourceNetworkingInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1495,7 +1999,7 @@ var xxx_messageInfo_ResourceNetworkingInfo proto.InternalMessageInfo
 func (m *ResourceNode) Reset()      { *m = ResourceNode{} }
 func (*ResourceNode) ProtoMessage() {}
 func (*ResourceNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{52}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{70}
 }
 func (m *ResourceNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1523,7 +2027,7 @@ var xxx_messageInfo_ResourceNode proto.InternalMessageInfo
 func (m *ResourceOverride) Reset()      { *m = ResourceOverride{} }
 func (*ResourceOverride) ProtoMessage() {}
 func (*ResourceOverride) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{53}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{71}
 }
 func (m *ResourceOverride) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1551,7 +2055,7 @@ var xxx_messageInfo_ResourceOverride proto.InternalMessageInfo
 func (m *ResourceRef) Reset()      { *m = ResourceRef{} }
 func (*ResourceRef) ProtoMessage() {}
 func (*ResourceRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{54}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{72}
 }
 func (m *ResourceRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1579,7 +2083,7 @@ var xxx_messageInfo_ResourceRef proto.InternalMessageInfo
 func (m *ResourceResult) Reset()      { *m = ResourceResult{} }
 func (*ResourceResult) ProtoMessage() {}
 func (*ResourceResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{55}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{73}
 }
 func (m *ResourceResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1607,7 +2111,7 @@ var xxx_messageInfo_ResourceResult proto.InternalMessageInfo
 func (m *ResourceStatus) Reset()      { *m = ResourceStatus{} }
 func (*ResourceStatus) ProtoMessage() {}
 func (*ResourceStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{56}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{74}
 }
 func (m *ResourceStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1632,10 +2136,38 @@ func (m *ResourceStatus) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ResourceStatus proto.InternalMessageInfo
 
+func (m *RevisionChangelog) Reset()      { *m = RevisionChangelog{} }
+func (*RevisionChangelog) ProtoMessage() {}
+func (*RevisionChangelog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_947ac14459ab55d5, []int{75}
+}
+func (m *RevisionChangelog) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RevisionChangelog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *RevisionChangelog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevisionChangelog.Merge(dst, src)
+}
+func (m *RevisionChangelog) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevisionChangelog) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevisionChangelog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevisionChangelog proto.InternalMessageInfo
+
 func (m *RevisionHistory) Reset()      { *m = RevisionHistory{} }
 func (*RevisionHistory) ProtoMessage() {}
 func (*RevisionHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{57}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{76}
 }
 func (m *RevisionHistory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1663,7 +2195,7 @@ var xxx_messageInfo_RevisionHistory proto.InternalMessageInfo
 func (m *RevisionMetadata) Reset()      { *m = RevisionMetadata{} }
 func (*RevisionMetadata) ProtoMessage() {}
 func (*RevisionMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{58}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{77}
 }
 func (m *RevisionMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1688,10 +2220,150 @@ func (m *RevisionMetadata) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RevisionMetadata proto.InternalMessageInfo
 
+func (m *SecretKeyChanges) Reset()      { *m = SecretKeyChanges{} }
+func (*SecretKeyChanges) ProtoMessage() {}
+func (*SecretKeyChanges) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_947ac14459ab55d5, []int{78}
+}
+func (m *SecretKeyChanges) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SecretKeyChanges) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *SecretKeyChanges) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SecretKeyChanges.Merge(dst, src)
+}
+func (m *SecretKeyChanges) XXX_Size() int {
+	return m.Size()
+}
+func (m *SecretKeyChanges) XXX_DiscardUnknown() {
+	xxx_messageInfo_SecretKeyChanges.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SecretKeyChanges proto.InternalMessageInfo
+
+func (m *SyncAnalysis) Reset()      { *m = SyncAnalysis{} }
+func (*SyncAnalysis) ProtoMessage() {}
+func (*SyncAnalysis) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_947ac14459ab55d5, []int{79}
+}
+func (m *SyncAnalysis) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncAnalysis) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *SyncAnalysis) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncAnalysis.Merge(dst, src)
+}
+func (m *SyncAnalysis) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncAnalysis) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncAnalysis.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncAnalysis proto.InternalMessageInfo
+
+func (m *SyncAnalysisJob) Reset()      { *m = SyncAnalysisJob{} }
+func (*SyncAnalysisJob) ProtoMessage() {}
+func (*SyncAnalysisJob) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_947ac14459ab55d5, []int{80}
+}
+func (m *SyncAnalysisJob) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncAnalysisJob) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *SyncAnalysisJob) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncAnalysisJob.Merge(dst, src)
+}
+func (m *SyncAnalysisJob) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncAnalysisJob) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncAnalysisJob.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncAnalysisJob proto.InternalMessageInfo
+
+func (m *SyncAnalysisResult) Reset()      { *m = SyncAnalysisResult{} }
+func (*SyncAnalysisResult) ProtoMessage() {}
+func (*SyncAnalysisResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_947ac14459ab55d5, []int{81}
+}
+func (m *SyncAnalysisResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncAnalysisResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *SyncAnalysisResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncAnalysisResult.Merge(dst, src)
+}
+func (m *SyncAnalysisResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncAnalysisResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncAnalysisResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncAnalysisResult proto.InternalMessageInfo
+
+func (m *SyncAnalysisWebhook) Reset()      { *m = SyncAnalysisWebhook{} }
+func (*SyncAnalysisWebhook) ProtoMessage() {}
+func (*SyncAnalysisWebhook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_947ac14459ab55d5, []int{82}
+}
+func (m *SyncAnalysisWebhook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncAnalysisWebhook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *SyncAnalysisWebhook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncAnalysisWebhook.Merge(dst, src)
+}
+func (m *SyncAnalysisWebhook) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncAnalysisWebhook) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncAnalysisWebhook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncAnalysisWebhook proto.InternalMessageInfo
+
 func (m *SyncOperation) Reset()      { *m = SyncOperation{} }
 func (*SyncOperation) ProtoMessage() {}
 func (*SyncOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{59}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{83}
 }
 func (m *SyncOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1719,7 +2391,7 @@ var xxx_messageInfo_SyncOperation proto.InternalMessageInfo
 func (m *SyncOperationResource) Reset()      { *m = SyncOperationResource{} }
 func (*SyncOperationResource) ProtoMessage() {}
 func (*SyncOperationResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{60}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{84}
 }
 func (m *SyncOperationResource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1747,7 +2419,7 @@ var xxx_messageInfo_SyncOperationResource proto.InternalMessageInfo
 func (m *SyncOperationResult) Reset()      { *m = SyncOperationResult{} }
 func (*SyncOperationResult) ProtoMessage() {}
 func (*SyncOperationResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{61}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{85}
 }
 func (m *SyncOperationResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1775,7 +2447,7 @@ var xxx_messageInfo_SyncOperationResult proto.InternalMessageInfo
 func (m *SyncPolicy) Reset()      { *m = SyncPolicy{} }
 func (*SyncPolicy) ProtoMessage() {}
 func (*SyncPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{62}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{86}
 }
 func (m *SyncPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1803,7 +2475,7 @@ var xxx_messageInfo_SyncPolicy proto.InternalMessageInfo
 func (m *SyncPolicyAutomated) Reset()      { *m = SyncPolicyAutomated{} }
 func (*SyncPolicyAutomated) ProtoMessage() {}
 func (*SyncPolicyAutomated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{63}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{87}
 }
 func (m *SyncPolicyAutomated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1828,10 +2500,66 @@ func (m *SyncPolicyAutomated) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SyncPolicyAutomated proto.InternalMessageInfo
 
+func (m *SyncPolicyVerify) Reset()      { *m = SyncPolicyVerify{} }
+func (*SyncPolicyVerify) ProtoMessage() {}
+func (*SyncPolicyVerify) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_947ac14459ab55d5, []int{88}
+}
+func (m *SyncPolicyVerify) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncPolicyVerify) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *SyncPolicyVerify) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncPolicyVerify.Merge(dst, src)
+}
+func (m *SyncPolicyVerify) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncPolicyVerify) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncPolicyVerify.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncPolicyVerify proto.InternalMessageInfo
+
+func (m *SyncReport) Reset()      { *m = SyncReport{} }
+func (*SyncReport) ProtoMessage() {}
+func (*SyncReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_947ac14459ab55d5, []int{89}
+}
+func (m *SyncReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *SyncReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncReport.Merge(dst, src)
+}
+func (m *SyncReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncReport proto.InternalMessageInfo
+
 func (m *SyncStatus) Reset()      { *m = SyncStatus{} }
 func (*SyncStatus) ProtoMessage() {}
 func (*SyncStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{64}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{90}
 }
 func (m *SyncStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1859,7 +2587,7 @@ var xxx_messageInfo_SyncStatus proto.InternalMessageInfo
 func (m *SyncStrategy) Reset()      { *m = SyncStrategy{} }
 func (*SyncStrategy) ProtoMessage() {}
 func (*SyncStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{65}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{91}
 }
 func (m *SyncStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1887,7 +2615,7 @@ var xxx_messageInfo_SyncStrategy proto.InternalMessageInfo
 func (m *SyncStrategyApply) Reset()      { *m = SyncStrategyApply{} }
 func (*SyncStrategyApply) ProtoMessage() {}
 func (*SyncStrategyApply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{66}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{92}
 }
 func (m *SyncStrategyApply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1915,7 +2643,7 @@ var xxx_messageInfo_SyncStrategyApply proto.InternalMessageInfo
 func (m *SyncStrategyHook) Reset()      { *m = SyncStrategyHook{} }
 func (*SyncStrategyHook) ProtoMessage() {}
 func (*SyncStrategyHook) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{67}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{93}
 }
 func (m *SyncStrategyHook) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1940,10 +2668,38 @@ func (m *SyncStrategyHook) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SyncStrategyHook proto.InternalMessageInfo
 
+func (m *SyncVerification) Reset()      { *m = SyncVerification{} }
+func (*SyncVerification) ProtoMessage() {}
+func (*SyncVerification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_947ac14459ab55d5, []int{94}
+}
+func (m *SyncVerification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncVerification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *SyncVerification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncVerification.Merge(dst, src)
+}
+func (m *SyncVerification) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncVerification) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncVerification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncVerification proto.InternalMessageInfo
+
 func (m *TLSClientConfig) Reset()      { *m = TLSClientConfig{} }
 func (*TLSClientConfig) ProtoMessage() {}
 func (*TLSClientConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_a98acdddc8282f86, []int{68}
+	return fileDescriptor_generated_947ac14459ab55d5, []int{95}
 }
 func (m *TLSClientConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1984,43 +2740,63 @@ func init() {
 	proto.RegisterType((*ApplicationSourceKsonnet)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ApplicationSourceKsonnet")
 	proto.RegisterType((*ApplicationSourceKustomize)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ApplicationSourceKustomize")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ApplicationSourceKustomize.CommonLabelsEntry")
+	proto.RegisterType((*ApplicationSourcePatch)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ApplicationSourcePatch")
+	proto.RegisterType((*ApplicationSourcePatchTarget)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ApplicationSourcePatchTarget")
 	proto.RegisterType((*ApplicationSourcePlugin)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ApplicationSourcePlugin")
 	proto.RegisterType((*ApplicationSpec)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ApplicationSpec")
 	proto.RegisterType((*ApplicationStatus)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ApplicationStatus")
 	proto.RegisterType((*ApplicationSummary)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ApplicationSummary")
+	proto.RegisterType((*ApplicationSuspend)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ApplicationSuspend")
 	proto.RegisterType((*ApplicationTree)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ApplicationTree")
 	proto.RegisterType((*ApplicationWatchEvent)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ApplicationWatchEvent")
+	proto.RegisterType((*ApplicationWriteBack)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ApplicationWriteBack")
+	proto.RegisterType((*AutomatedRollback)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.AutomatedRollback")
 	proto.RegisterType((*Cluster)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.Cluster")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.Cluster.AnnotationsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.Cluster.LabelsEntry")
 	proto.RegisterType((*ClusterConfig)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ClusterConfig")
 	proto.RegisterType((*ClusterList)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ClusterList")
 	proto.RegisterType((*Command)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.Command")
+	proto.RegisterType((*CommitMetadata)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.CommitMetadata")
 	proto.RegisterType((*ComparedTo)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ComparedTo")
 	proto.RegisterType((*ComponentParameter)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ComponentParameter")
 	proto.RegisterType((*ConfigManagementPlugin)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ConfigManagementPlugin")
 	proto.RegisterType((*ConnectionState)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ConnectionState")
 	proto.RegisterType((*EnvEntry)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.EnvEntry")
 	proto.RegisterType((*HealthStatus)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.HealthStatus")
+	proto.RegisterType((*HelmFileParameter)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.HelmFileParameter")
 	proto.RegisterType((*HelmParameter)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.HelmParameter")
+	proto.RegisterType((*ImageUpdate)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ImageUpdate")
 	proto.RegisterType((*Info)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.Info")
 	proto.RegisterType((*InfoItem)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.InfoItem")
 	proto.RegisterType((*JWTToken)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.JWTToken")
 	proto.RegisterType((*JsonnetVar)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.JsonnetVar")
 	proto.RegisterType((*KsonnetParameter)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.KsonnetParameter")
 	proto.RegisterType((*KustomizeOptions)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.KustomizeOptions")
+	proto.RegisterType((*ManifestQuota)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ManifestQuota")
 	proto.RegisterType((*Operation)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.Operation")
+	proto.RegisterType((*OperationInitiator)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.OperationInitiator")
 	proto.RegisterType((*OperationState)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.OperationState")
 	proto.RegisterType((*OrphanedResourcesMonitorSettings)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.OrphanedResourcesMonitorSettings")
 	proto.RegisterType((*ProjectRole)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ProjectRole")
+	proto.RegisterType((*ProjectRoleQuota)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ProjectRoleQuota")
+	proto.RegisterType((*ProjectTemplate)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ProjectTemplate")
+	proto.RegisterType((*ProjectTemplateParameter)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ProjectTemplateParameter")
+	proto.RegisterType((*RenderedSource)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.RenderedSource")
 	proto.RegisterType((*Repository)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.Repository")
 	proto.RegisterType((*RepositoryCertificate)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.RepositoryCertificate")
 	proto.RegisterType((*RepositoryCertificateList)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.RepositoryCertificateList")
+	proto.RegisterType((*RepositoryFailure)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.RepositoryFailure")
+	proto.RegisterType((*RepositoryFailureList)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.RepositoryFailureList")
 	proto.RegisterType((*RepositoryList)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.RepositoryList")
 	proto.RegisterType((*ResourceAction)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ResourceAction")
 	proto.RegisterType((*ResourceActionDefinition)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ResourceActionDefinition")
 	proto.RegisterType((*ResourceActionParam)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ResourceActionParam")
 	proto.RegisterType((*ResourceActions)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ResourceActions")
 	proto.RegisterType((*ResourceDiff)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ResourceDiff")
+	proto.RegisterType((*ResourceHook)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ResourceHook")
 	proto.RegisterType((*ResourceIgnoreDifferences)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ResourceIgnoreDifferences")
+	proto.RegisterType((*ResourceLink)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ResourceLink")
 	proto.RegisterType((*ResourceNetworkingInfo)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ResourceNetworkingInfo")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ResourceNetworkingInfo.LabelsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ResourceNetworkingInfo.TargetLabelsEntry")
@@ -2029,17 +2805,26 @@ func init() {
 	proto.RegisterType((*ResourceRef)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ResourceRef")
 	proto.RegisterType((*ResourceResult)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ResourceResult")
 	proto.RegisterType((*ResourceStatus)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ResourceStatus")
+	proto.RegisterType((*RevisionChangelog)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.RevisionChangelog")
 	proto.RegisterType((*RevisionHistory)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.RevisionHistory")
 	proto.RegisterType((*RevisionMetadata)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.RevisionMetadata")
+	proto.RegisterType((*SecretKeyChanges)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SecretKeyChanges")
+	proto.RegisterType((*SyncAnalysis)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SyncAnalysis")
+	proto.RegisterType((*SyncAnalysisJob)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SyncAnalysisJob")
+	proto.RegisterType((*SyncAnalysisResult)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SyncAnalysisResult")
+	proto.RegisterType((*SyncAnalysisWebhook)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SyncAnalysisWebhook")
 	proto.RegisterType((*SyncOperation)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SyncOperation")
 	proto.RegisterType((*SyncOperationResource)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SyncOperationResource")
 	proto.RegisterType((*SyncOperationResult)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SyncOperationResult")
 	proto.RegisterType((*SyncPolicy)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SyncPolicy")
 	proto.RegisterType((*SyncPolicyAutomated)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SyncPolicyAutomated")
+	proto.RegisterType((*SyncPolicyVerify)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SyncPolicyVerify")
+	proto.RegisterType((*SyncReport)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SyncReport")
 	proto.RegisterType((*SyncStatus)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SyncStatus")
 	proto.RegisterType((*SyncStrategy)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SyncStrategy")
 	proto.RegisterType((*SyncStrategyApply)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SyncStrategyApply")
 	proto.RegisterType((*SyncStrategyHook)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SyncStrategyHook")
+	proto.RegisterType((*SyncVerification)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SyncVerification")
 	proto.RegisterType((*TLSClientConfig)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.TLSClientConfig")
 }
 func (m *AWSAuthConfig) Marshal() (dAtA []byte, err error) {
@@ -2232,6 +3017,49 @@ func (m *AppProjectSpec) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n4
 	}
+	if m.SyncPolicy != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.SyncPolicy.Size()))
+		n5, err := m.SyncPolicy.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if len(m.SourceDecryptionKeys) > 0 {
+		for _, s := range m.SourceDecryptionKeys {
+			dAtA[i] = 0x4a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	dAtA[i] = 0x50
+	i++
+	if m.FailOnSharedResource {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	if m.ManifestQuota != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.ManifestQuota.Size()))
+		n6, err := m.ManifestQuota.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
 	return i, nil
 }
 
@@ -2253,36 +3081,36 @@ func (m *Application) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.ObjectMeta.Size()))
-	n5, err := m.ObjectMeta.MarshalTo(dAtA[i:])
+	n7, err := m.ObjectMeta.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n5
+	i += n7
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Spec.Size()))
-	n6, err := m.Spec.MarshalTo(dAtA[i:])
+	n8, err := m.Spec.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n6
+	i += n8
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Status.Size()))
-	n7, err := m.Status.MarshalTo(dAtA[i:])
+	n9, err := m.Status.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n7
+	i += n9
 	if m.Operation != nil {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.Operation.Size()))
-		n8, err := m.Operation.MarshalTo(dAtA[i:])
+		n10, err := m.Operation.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n8
+		i += n10
 	}
 	return i, nil
 }
@@ -2357,11 +3185,11 @@ func (m *ApplicationList) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.ListMeta.Size()))
-	n9, err := m.ListMeta.MarshalTo(dAtA[i:])
+	n11, err := m.ListMeta.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n9
+	i += n11
 	if len(m.Items) > 0 {
 		for _, msg := range m.Items {
 			dAtA[i] = 0x12
@@ -2408,51 +3236,63 @@ func (m *ApplicationSource) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3a
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.Helm.Size()))
-		n10, err := m.Helm.MarshalTo(dAtA[i:])
+		n12, err := m.Helm.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n10
+		i += n12
 	}
 	if m.Kustomize != nil {
 		dAtA[i] = 0x42
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.Kustomize.Size()))
-		n11, err := m.Kustomize.MarshalTo(dAtA[i:])
+		n13, err := m.Kustomize.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n11
+		i += n13
 	}
 	if m.Ksonnet != nil {
 		dAtA[i] = 0x4a
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.Ksonnet.Size()))
-		n12, err := m.Ksonnet.MarshalTo(dAtA[i:])
+		n14, err := m.Ksonnet.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n12
+		i += n14
 	}
 	if m.Directory != nil {
 		dAtA[i] = 0x52
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.Directory.Size()))
-		n13, err := m.Directory.MarshalTo(dAtA[i:])
+		n15, err := m.Directory.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n13
+		i += n15
 	}
 	if m.Plugin != nil {
 		dAtA[i] = 0x5a
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.Plugin.Size()))
-		n14, err := m.Plugin.MarshalTo(dAtA[i:])
+		n16, err := m.Plugin.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n14
+		i += n16
+	}
+	if len(m.Patches) > 0 {
+		for _, msg := range m.Patches {
+			dAtA[i] = 0x62
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	return i, nil
 }
@@ -2483,11 +3323,11 @@ func (m *ApplicationSourceDirectory) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Jsonnet.Size()))
-	n15, err := m.Jsonnet.MarshalTo(dAtA[i:])
+	n17, err := m.Jsonnet.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n15
+	i += n17
 	return i, nil
 }
 
@@ -2541,6 +3381,34 @@ func (m *ApplicationSourceHelm) MarshalTo(dAtA []byte) (int, error) {
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Values)))
 	i += copy(dAtA[i:], m.Values)
+	dAtA[i] = 0x28
+	i++
+	if m.IncludeCRDs {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	if len(m.FileParameters) > 0 {
+		for _, msg := range m.FileParameters {
+			dAtA[i] = 0x32
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	dAtA[i] = 0x38
+	i++
+	if m.AllowValueFilesOutsideChart {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
 	return i, nil
 }
 
@@ -2679,7 +3547,7 @@ func (m *ApplicationSourceKustomize) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ApplicationSourcePlugin) Marshal() (dAtA []byte, err error) {
+func (m *ApplicationSourcePatch) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2689,7 +3557,71 @@ func (m *ApplicationSourcePlugin) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ApplicationSourcePlugin) MarshalTo(dAtA []byte) (int, error) {
+func (m *ApplicationSourcePatch) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Target.Size()))
+	n18, err := m.Target.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n18
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Patch)))
+	i += copy(dAtA[i:], m.Patch)
+	return i, nil
+}
+
+func (m *ApplicationSourcePatchTarget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSourcePatchTarget) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Group)))
+	i += copy(dAtA[i:], m.Group)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i += copy(dAtA[i:], m.Kind)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i += copy(dAtA[i:], m.Namespace)
+	return i, nil
+}
+
+func (m *ApplicationSourcePlugin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSourcePlugin) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -2731,19 +3663,19 @@ func (m *ApplicationSpec) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Source.Size()))
-	n16, err := m.Source.MarshalTo(dAtA[i:])
+	n19, err := m.Source.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n16
+	i += n19
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Destination.Size()))
-	n17, err := m.Destination.MarshalTo(dAtA[i:])
+	n20, err := m.Destination.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n17
+	i += n20
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Project)))
@@ -2752,11 +3684,11 @@ func (m *ApplicationSpec) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.SyncPolicy.Size()))
-		n18, err := m.SyncPolicy.MarshalTo(dAtA[i:])
+		n21, err := m.SyncPolicy.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n18
+		i += n21
 	}
 	if len(m.IgnoreDifferences) > 0 {
 		for _, msg := range m.IgnoreDifferences {
@@ -2782,6 +3714,53 @@ func (m *ApplicationSpec) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.WriteBack != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.WriteBack.Size()))
+		n22, err := m.WriteBack.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n22
+	}
+	if m.RevisionHistoryLimit != nil {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.RevisionHistoryLimit))
+	}
+	if m.Suspend != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Suspend.Size()))
+		n23, err := m.Suspend.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n23
+	}
+	if len(m.ResourceHooks) > 0 {
+		for _, msg := range m.ResourceHooks {
+			dAtA[i] = 0x52
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Analysis != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Analysis.Size()))
+		n24, err := m.Analysis.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n24
+	}
 	return i, nil
 }
 
@@ -2815,19 +3794,19 @@ func (m *ApplicationStatus) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Sync.Size()))
-	n19, err := m.Sync.MarshalTo(dAtA[i:])
+	n25, err := m.Sync.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n19
+	i += n25
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Health.Size()))
-	n20, err := m.Health.MarshalTo(dAtA[i:])
+	n26, err := m.Health.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n20
+	i += n26
 	if len(m.History) > 0 {
 		for _, msg := range m.History {
 			dAtA[i] = 0x22
@@ -2856,31 +3835,31 @@ func (m *ApplicationStatus) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x32
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.ReconciledAt.Size()))
-		n21, err := m.ReconciledAt.MarshalTo(dAtA[i:])
+		n27, err := m.ReconciledAt.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n21
+		i += n27
 	}
 	if m.OperationState != nil {
 		dAtA[i] = 0x3a
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.OperationState.Size()))
-		n22, err := m.OperationState.MarshalTo(dAtA[i:])
+		n28, err := m.OperationState.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n22
+		i += n28
 	}
 	if m.ObservedAt != nil {
 		dAtA[i] = 0x42
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.ObservedAt.Size()))
-		n23, err := m.ObservedAt.MarshalTo(dAtA[i:])
+		n29, err := m.ObservedAt.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n23
+		i += n29
 	}
 	dAtA[i] = 0x4a
 	i++
@@ -2889,11 +3868,21 @@ func (m *ApplicationStatus) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x52
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Summary.Size()))
-	n24, err := m.Summary.MarshalTo(dAtA[i:])
+	n30, err := m.Summary.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n24
+	i += n30
+	if m.Verification != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Verification.Size()))
+		n31, err := m.Verification.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n31
+	}
 	return i, nil
 }
 
@@ -2942,6 +3931,50 @@ func (m *ApplicationSummary) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
+	if len(m.RenderedSources) > 0 {
+		for _, msg := range m.RenderedSources {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ApplicationSuspend) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSuspend) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Until != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Until.Size()))
+		n32, err := m.Until.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n32
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Reason)))
+	i += copy(dAtA[i:], m.Reason)
 	return i, nil
 }
 
@@ -3009,11 +4042,71 @@ func (m *ApplicationWatchEvent) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Application.Size()))
-	n25, err := m.Application.MarshalTo(dAtA[i:])
+	n33, err := m.Application.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n25
+	i += n33
+	return i, nil
+}
+
+func (m *ApplicationWriteBack) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationWriteBack) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Branch)))
+	i += copy(dAtA[i:], m.Branch)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
+	i += copy(dAtA[i:], m.Path)
+	dAtA[i] = 0x18
+	i++
+	if m.PullRequest {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	return i, nil
+}
+
+func (m *AutomatedRollback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutomatedRollback) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FromRevision)))
+	i += copy(dAtA[i:], m.FromRevision)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Reason)))
+	i += copy(dAtA[i:], m.Reason)
 	return i, nil
 }
 
@@ -3043,23 +4136,82 @@ func (m *Cluster) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Config.Size()))
-	n26, err := m.Config.MarshalTo(dAtA[i:])
+	n34, err := m.Config.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n26
+	i += n34
 	dAtA[i] = 0x22
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.ConnectionState.Size()))
-	n27, err := m.ConnectionState.MarshalTo(dAtA[i:])
+	n35, err := m.ConnectionState.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n27
+	i += n35
 	dAtA[i] = 0x2a
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ServerVersion)))
 	i += copy(dAtA[i:], m.ServerVersion)
+	if len(m.Namespaces) > 0 {
+		for _, s := range m.Namespaces {
+			dAtA[i] = 0x32
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Labels) > 0 {
+		keysForLabels := make([]string, 0, len(m.Labels))
+		for k := range m.Labels {
+			keysForLabels = append(keysForLabels, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+		for _, k := range keysForLabels {
+			dAtA[i] = 0x3a
+			i++
+			v := m.Labels[string(k)]
+			mapSize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			i = encodeVarintGenerated(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if len(m.Annotations) > 0 {
+		keysForAnnotations := make([]string, 0, len(m.Annotations))
+		for k := range m.Annotations {
+			keysForAnnotations = append(keysForAnnotations, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
+		for _, k := range keysForAnnotations {
+			dAtA[i] = 0x42
+			i++
+			v := m.Annotations[string(k)]
+			mapSize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			i = encodeVarintGenerated(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
 	return i, nil
 }
 
@@ -3093,20 +4245,20 @@ func (m *ClusterConfig) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x22
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.TLSClientConfig.Size()))
-	n28, err := m.TLSClientConfig.MarshalTo(dAtA[i:])
+	n36, err := m.TLSClientConfig.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n28
+	i += n36
 	if m.AWSAuthConfig != nil {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.AWSAuthConfig.Size()))
-		n29, err := m.AWSAuthConfig.MarshalTo(dAtA[i:])
+		n37, err := m.AWSAuthConfig.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n29
+		i += n37
 	}
 	return i, nil
 }
@@ -3129,11 +4281,11 @@ func (m *ClusterList) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.ListMeta.Size()))
-	n30, err := m.ListMeta.MarshalTo(dAtA[i:])
+	n38, err := m.ListMeta.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n30
+	i += n38
 	if len(m.Items) > 0 {
 		for _, msg := range m.Items {
 			dAtA[i] = 0x12
@@ -3197,7 +4349,7 @@ func (m *Command) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ComparedTo) Marshal() (dAtA []byte, err error) {
+func (m *CommitMetadata) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -3207,27 +4359,65 @@ func (m *ComparedTo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ComparedTo) MarshalTo(dAtA []byte) (int, error) {
+func (m *CommitMetadata) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
 	dAtA[i] = 0xa
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(m.Source.Size()))
-	n31, err := m.Source.MarshalTo(dAtA[i:])
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Revision)))
+	i += copy(dAtA[i:], m.Revision)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Author)))
+	i += copy(dAtA[i:], m.Author)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Date.Size()))
+	n39, err := m.Date.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n31
+	i += n39
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i += copy(dAtA[i:], m.Message)
+	return i, nil
+}
+
+func (m *ComparedTo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ComparedTo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Source.Size()))
+	n40, err := m.Source.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n40
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Destination.Size()))
-	n32, err := m.Destination.MarshalTo(dAtA[i:])
+	n41, err := m.Destination.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n32
+	i += n41
 	return i, nil
 }
 
@@ -3284,20 +4474,20 @@ func (m *ConfigManagementPlugin) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.Init.Size()))
-		n33, err := m.Init.MarshalTo(dAtA[i:])
+		n42, err := m.Init.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n33
+		i += n42
 	}
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Generate.Size()))
-	n34, err := m.Generate.MarshalTo(dAtA[i:])
+	n43, err := m.Generate.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n34
+	i += n43
 	return i, nil
 }
 
@@ -3328,11 +4518,11 @@ func (m *ConnectionState) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.ModifiedAt.Size()))
-		n35, err := m.ModifiedAt.MarshalTo(dAtA[i:])
+		n44, err := m.ModifiedAt.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n35
+		i += n44
 	}
 	return i, nil
 }
@@ -3389,6 +4579,32 @@ func (m *HealthStatus) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *HelmFileParameter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HelmFileParameter) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
+	i += copy(dAtA[i:], m.Path)
+	return i, nil
+}
+
 func (m *HelmParameter) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3423,6 +4639,59 @@ func (m *HelmParameter) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *ImageUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImageUpdate) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.HelmParameters) > 0 {
+		for _, msg := range m.HelmParameters {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.KustomizeImages) > 0 {
+		for _, s := range m.KustomizeImages {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	dAtA[i] = 0x18
+	i++
+	if m.WriteBack {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	return i, nil
+}
+
 func (m *Info) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3585,6 +4854,30 @@ func (m *KustomizeOptions) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *ManifestQuota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ManifestQuota) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxResources))
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxSize))
+	return i, nil
+}
+
 func (m *Operation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3604,12 +4897,54 @@ func (m *Operation) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.Sync.Size()))
-		n36, err := m.Sync.MarshalTo(dAtA[i:])
+		n45, err := m.Sync.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n36
+		i += n45
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.InitiatedBy.Size()))
+	n46, err := m.InitiatedBy.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
+	i += n46
+	return i, nil
+}
+
+func (m *OperationInitiator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperationInitiator) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Username)))
+	i += copy(dAtA[i:], m.Username)
+	dAtA[i] = 0x10
+	i++
+	if m.Automated {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Source)))
+	i += copy(dAtA[i:], m.Source)
 	return i, nil
 }
 
@@ -3631,11 +4966,11 @@ func (m *OperationState) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Operation.Size()))
-	n37, err := m.Operation.MarshalTo(dAtA[i:])
+	n47, err := m.Operation.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n37
+	i += n47
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
@@ -3648,29 +4983,29 @@ func (m *OperationState) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.SyncResult.Size()))
-		n38, err := m.SyncResult.MarshalTo(dAtA[i:])
+		n48, err := m.SyncResult.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n38
+		i += n48
 	}
 	dAtA[i] = 0x32
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.StartedAt.Size()))
-	n39, err := m.StartedAt.MarshalTo(dAtA[i:])
+	n49, err := m.StartedAt.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n39
+	i += n49
 	if m.FinishedAt != nil {
 		dAtA[i] = 0x3a
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.FinishedAt.Size()))
-		n40, err := m.FinishedAt.MarshalTo(dAtA[i:])
+		n50, err := m.FinishedAt.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n40
+		i += n50
 	}
 	return i, nil
 }
@@ -3768,10 +5103,20 @@ func (m *ProjectRole) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
+	if m.Quota != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Quota.Size()))
+		n51, err := m.Quota.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n51
+	}
 	return i, nil
 }
 
-func (m *Repository) Marshal() (dAtA []byte, err error) {
+func (m *ProjectRoleQuota) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -3781,83 +5126,21 @@ func (m *Repository) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Repository) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProjectRoleQuota) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Repo)))
-	i += copy(dAtA[i:], m.Repo)
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Username)))
-	i += copy(dAtA[i:], m.Username)
-	dAtA[i] = 0x1a
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Password)))
-	i += copy(dAtA[i:], m.Password)
-	dAtA[i] = 0x22
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SSHPrivateKey)))
-	i += copy(dAtA[i:], m.SSHPrivateKey)
-	dAtA[i] = 0x2a
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(m.ConnectionState.Size()))
-	n41, err := m.ConnectionState.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n41
-	dAtA[i] = 0x30
-	i++
-	if m.InsecureIgnoreHostKey {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
-	}
-	i++
-	dAtA[i] = 0x38
-	i++
-	if m.Insecure {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
-	}
-	i++
-	dAtA[i] = 0x40
-	i++
-	if m.EnableLFS {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
-	}
-	i++
-	dAtA[i] = 0x4a
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TLSClientCertData)))
-	i += copy(dAtA[i:], m.TLSClientCertData)
-	dAtA[i] = 0x52
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TLSClientCertKey)))
-	i += copy(dAtA[i:], m.TLSClientCertKey)
-	dAtA[i] = 0x5a
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TLSClientCAData)))
-	i += copy(dAtA[i:], m.TLSClientCAData)
-	dAtA[i] = 0x62
+	dAtA[i] = 0x8
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
-	i += copy(dAtA[i:], m.Type)
-	dAtA[i] = 0x6a
+	i = encodeVarintGenerated(dAtA, i, uint64(m.RequestsPerHour))
+	dAtA[i] = 0x10
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
-	i += copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(m.SyncsPerHour))
 	return i, nil
 }
 
-func (m *RepositoryCertificate) Marshal() (dAtA []byte, err error) {
+func (m *ProjectTemplate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -3867,62 +5150,22 @@ func (m *RepositoryCertificate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RepositoryCertificate) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProjectTemplate) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
 	dAtA[i] = 0xa
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ServerName)))
-	i += copy(dAtA[i:], m.ServerName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
 	dAtA[i] = 0x12
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CertType)))
-	i += copy(dAtA[i:], m.CertType)
-	dAtA[i] = 0x1a
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CertSubType)))
-	i += copy(dAtA[i:], m.CertSubType)
-	if m.CertData != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintGenerated(dAtA, i, uint64(len(m.CertData)))
-		i += copy(dAtA[i:], m.CertData)
-	}
-	dAtA[i] = 0x2a
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CertInfo)))
-	i += copy(dAtA[i:], m.CertInfo)
-	return i, nil
-}
-
-func (m *RepositoryCertificateList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *RepositoryCertificateList) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(m.ListMeta.Size()))
-	n42, err := m.ListMeta.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n42
-	if len(m.Items) > 0 {
-		for _, msg := range m.Items {
-			dAtA[i] = 0x12
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Description)))
+	i += copy(dAtA[i:], m.Description)
+	if len(m.Parameters) > 0 {
+		for _, msg := range m.Parameters {
+			dAtA[i] = 0x1a
 			i++
 			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
 			n, err := msg.MarshalTo(dAtA[i:])
@@ -3932,10 +5175,18 @@ func (m *RepositoryCertificateList) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Spec.Size()))
+	n52, err := m.Spec.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n52
 	return i, nil
 }
 
-func (m *RepositoryList) Marshal() (dAtA []byte, err error) {
+func (m *ProjectTemplateParameter) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -3945,35 +5196,35 @@ func (m *RepositoryList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RepositoryList) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProjectTemplateParameter) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
 	dAtA[i] = 0xa
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(m.ListMeta.Size()))
-	n43, err := m.ListMeta.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n43
-	if len(m.Items) > 0 {
-		for _, msg := range m.Items {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Description)))
+	i += copy(dAtA[i:], m.Description)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Default)))
+	i += copy(dAtA[i:], m.Default)
+	dAtA[i] = 0x20
+	i++
+	if m.Required {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
+	i++
 	return i, nil
 }
 
-func (m *ResourceAction) Marshal() (dAtA []byte, err error) {
+func (m *RenderedSource) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -3983,31 +5234,39 @@ func (m *ResourceAction) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResourceAction) MarshalTo(dAtA []byte) (int, error) {
+func (m *RenderedSource) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
 	dAtA[i] = 0xa
 	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i += copy(dAtA[i:], m.Type)
+	dAtA[i] = 0x12
+	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
 	i += copy(dAtA[i:], m.Name)
-	if len(m.Params) > 0 {
-		for _, msg := range m.Params {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Version)))
+	i += copy(dAtA[i:], m.Version)
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.AppVersion)))
+	i += copy(dAtA[i:], m.AppVersion)
+	dAtA[i] = 0x28
+	i++
+	if m.Dependency {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
+	i++
 	return i, nil
 }
 
-func (m *ResourceActionDefinition) Marshal() (dAtA []byte, err error) {
+func (m *Repository) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4017,23 +5276,87 @@ func (m *ResourceActionDefinition) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResourceActionDefinition) MarshalTo(dAtA []byte) (int, error) {
+func (m *Repository) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
 	dAtA[i] = 0xa
 	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Repo)))
+	i += copy(dAtA[i:], m.Repo)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Username)))
+	i += copy(dAtA[i:], m.Username)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Password)))
+	i += copy(dAtA[i:], m.Password)
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SSHPrivateKey)))
+	i += copy(dAtA[i:], m.SSHPrivateKey)
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ConnectionState.Size()))
+	n53, err := m.ConnectionState.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n53
+	dAtA[i] = 0x30
+	i++
+	if m.InsecureIgnoreHostKey {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	dAtA[i] = 0x38
+	i++
+	if m.Insecure {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	dAtA[i] = 0x40
+	i++
+	if m.EnableLFS {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	dAtA[i] = 0x4a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TLSClientCertData)))
+	i += copy(dAtA[i:], m.TLSClientCertData)
+	dAtA[i] = 0x52
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TLSClientCertKey)))
+	i += copy(dAtA[i:], m.TLSClientCertKey)
+	dAtA[i] = 0x5a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TLSClientCAData)))
+	i += copy(dAtA[i:], m.TLSClientCAData)
+	dAtA[i] = 0x62
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i += copy(dAtA[i:], m.Type)
+	dAtA[i] = 0x6a
+	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
 	i += copy(dAtA[i:], m.Name)
-	dAtA[i] = 0x12
+	dAtA[i] = 0x72
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ActionLua)))
-	i += copy(dAtA[i:], m.ActionLua)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.WebhookID)))
+	i += copy(dAtA[i:], m.WebhookID)
 	return i, nil
 }
 
-func (m *ResourceActionParam) Marshal() (dAtA []byte, err error) {
+func (m *RepositoryCertificate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4043,31 +5366,37 @@ func (m *ResourceActionParam) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResourceActionParam) MarshalTo(dAtA []byte) (int, error) {
+func (m *RepositoryCertificate) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
 	dAtA[i] = 0xa
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
-	i += copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ServerName)))
+	i += copy(dAtA[i:], m.ServerName)
 	dAtA[i] = 0x12
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
-	i += copy(dAtA[i:], m.Value)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CertType)))
+	i += copy(dAtA[i:], m.CertType)
 	dAtA[i] = 0x1a
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
-	i += copy(dAtA[i:], m.Type)
-	dAtA[i] = 0x22
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CertSubType)))
+	i += copy(dAtA[i:], m.CertSubType)
+	if m.CertData != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(len(m.CertData)))
+		i += copy(dAtA[i:], m.CertData)
+	}
+	dAtA[i] = 0x2a
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Default)))
-	i += copy(dAtA[i:], m.Default)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CertInfo)))
+	i += copy(dAtA[i:], m.CertInfo)
 	return i, nil
 }
 
-func (m *ResourceActions) Marshal() (dAtA []byte, err error) {
+func (m *RepositoryCertificateList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4077,17 +5406,21 @@ func (m *ResourceActions) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResourceActions) MarshalTo(dAtA []byte) (int, error) {
+func (m *RepositoryCertificateList) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
 	dAtA[i] = 0xa
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ActionDiscoveryLua)))
-	i += copy(dAtA[i:], m.ActionDiscoveryLua)
-	if len(m.Definitions) > 0 {
-		for _, msg := range m.Definitions {
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ListMeta.Size()))
+	n54, err := m.ListMeta.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n54
+	if len(m.Items) > 0 {
+		for _, msg := range m.Items {
 			dAtA[i] = 0x12
 			i++
 			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
@@ -4101,7 +5434,7 @@ func (m *ResourceActions) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ResourceDiff) Marshal() (dAtA []byte, err error) {
+func (m *RepositoryFailure) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4111,100 +5444,56 @@ func (m *ResourceDiff) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResourceDiff) MarshalTo(dAtA []byte) (int, error) {
+func (m *RepositoryFailure) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
 	dAtA[i] = 0xa
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Group)))
-	i += copy(dAtA[i:], m.Group)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Repo)))
+	i += copy(dAtA[i:], m.Repo)
 	dAtA[i] = 0x12
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
-	i += copy(dAtA[i:], m.Kind)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RequestType)))
+	i += copy(dAtA[i:], m.RequestType)
 	dAtA[i] = 0x1a
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
-	i += copy(dAtA[i:], m.Namespace)
-	dAtA[i] = 0x22
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
-	i += copy(dAtA[i:], m.Name)
-	dAtA[i] = 0x2a
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TargetState)))
-	i += copy(dAtA[i:], m.TargetState)
-	dAtA[i] = 0x32
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LiveState)))
-	i += copy(dAtA[i:], m.LiveState)
-	dAtA[i] = 0x3a
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Diff)))
-	i += copy(dAtA[i:], m.Diff)
-	dAtA[i] = 0x40
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i += copy(dAtA[i:], m.Message)
+	dAtA[i] = 0x20
 	i++
-	if m.Hook {
+	if m.AuthFailure {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
 	i++
-	return i, nil
-}
-
-func (m *ResourceIgnoreDifferences) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	dAtA[i] = 0x28
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Failures))
+	dAtA[i] = 0x32
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.FailedAt.Size()))
+	n55, err := m.FailedAt.MarshalTo(dAtA[i:])
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ResourceIgnoreDifferences) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Group)))
-	i += copy(dAtA[i:], m.Group)
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
-	i += copy(dAtA[i:], m.Kind)
-	dAtA[i] = 0x1a
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
-	i += copy(dAtA[i:], m.Name)
-	dAtA[i] = 0x22
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
-	i += copy(dAtA[i:], m.Namespace)
-	if len(m.JSONPointers) > 0 {
-		for _, s := range m.JSONPointers {
-			dAtA[i] = 0x2a
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
+	i += n55
+	if m.LastSucceededAt != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.LastSucceededAt.Size()))
+		n56, err := m.LastSucceededAt.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n56
 	}
 	return i, nil
 }
 
-func (m *ResourceNetworkingInfo) Marshal() (dAtA []byte, err error) {
+func (m *RepositoryFailureList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4214,71 +5503,15 @@ func (m *ResourceNetworkingInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResourceNetworkingInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *RepositoryFailureList) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.TargetLabels) > 0 {
-		keysForTargetLabels := make([]string, 0, len(m.TargetLabels))
-		for k := range m.TargetLabels {
-			keysForTargetLabels = append(keysForTargetLabels, string(k))
-		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForTargetLabels)
-		for _, k := range keysForTargetLabels {
-			dAtA[i] = 0xa
-			i++
-			v := m.TargetLabels[string(k)]
-			mapSize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			i = encodeVarintGenerated(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintGenerated(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
-			i += copy(dAtA[i:], v)
-		}
-	}
-	if len(m.TargetRefs) > 0 {
-		for _, msg := range m.TargetRefs {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if len(m.Labels) > 0 {
-		keysForLabels := make([]string, 0, len(m.Labels))
-		for k := range m.Labels {
-			keysForLabels = append(keysForLabels, string(k))
-		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
-		for _, k := range keysForLabels {
-			dAtA[i] = 0x1a
-			i++
-			v := m.Labels[string(k)]
-			mapSize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			i = encodeVarintGenerated(dAtA, i, uint64(mapSize))
+	if len(m.Items) > 0 {
+		for _, msg := range m.Items {
 			dAtA[i] = 0xa
 			i++
-			i = encodeVarintGenerated(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
-			i += copy(dAtA[i:], v)
-		}
-	}
-	if len(m.Ingress) > 0 {
-		for _, msg := range m.Ingress {
-			dAtA[i] = 0x22
-			i++
 			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
 			n, err := msg.MarshalTo(dAtA[i:])
 			if err != nil {
@@ -4287,25 +5520,10 @@ func (m *ResourceNetworkingInfo) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
-	if len(m.ExternalURLs) > 0 {
-		for _, s := range m.ExternalURLs {
-			dAtA[i] = 0x2a
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
-	}
 	return i, nil
 }
 
-func (m *ResourceNode) Marshal() (dAtA []byte, err error) {
+func (m *RepositoryList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4315,21 +5533,21 @@ func (m *ResourceNode) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResourceNode) MarshalTo(dAtA []byte) (int, error) {
+func (m *RepositoryList) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
 	dAtA[i] = 0xa
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(m.ResourceRef.Size()))
-	n44, err := m.ResourceRef.MarshalTo(dAtA[i:])
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ListMeta.Size()))
+	n57, err := m.ListMeta.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n44
-	if len(m.ParentRefs) > 0 {
-		for _, msg := range m.ParentRefs {
+	i += n57
+	if len(m.Items) > 0 {
+		for _, msg := range m.Items {
 			dAtA[i] = 0x12
 			i++
 			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
@@ -4340,9 +5558,31 @@ func (m *ResourceNode) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
-	if len(m.Info) > 0 {
-		for _, msg := range m.Info {
-			dAtA[i] = 0x1a
+	return i, nil
+}
+
+func (m *ResourceAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceAction) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	if len(m.Params) > 0 {
+		for _, msg := range m.Params {
+			dAtA[i] = 0x12
 			i++
 			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
 			n, err := msg.MarshalTo(dAtA[i:])
@@ -4352,49 +5592,10 @@ func (m *ResourceNode) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
-	if m.NetworkingInfo != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintGenerated(dAtA, i, uint64(m.NetworkingInfo.Size()))
-		n45, err := m.NetworkingInfo.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n45
-	}
-	dAtA[i] = 0x2a
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ResourceVersion)))
-	i += copy(dAtA[i:], m.ResourceVersion)
-	if len(m.Images) > 0 {
-		for _, s := range m.Images {
-			dAtA[i] = 0x32
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
-	}
-	if m.Health != nil {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintGenerated(dAtA, i, uint64(m.Health.Size()))
-		n46, err := m.Health.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n46
-	}
 	return i, nil
 }
 
-func (m *ResourceOverride) Marshal() (dAtA []byte, err error) {
+func (m *ResourceActionDefinition) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4404,27 +5605,23 @@ func (m *ResourceOverride) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResourceOverride) MarshalTo(dAtA []byte) (int, error) {
+func (m *ResourceActionDefinition) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
 	dAtA[i] = 0xa
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.HealthLua)))
-	i += copy(dAtA[i:], m.HealthLua)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
 	dAtA[i] = 0x12
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.IgnoreDifferences)))
-	i += copy(dAtA[i:], m.IgnoreDifferences)
-	dAtA[i] = 0x1a
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Actions)))
-	i += copy(dAtA[i:], m.Actions)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ActionLua)))
+	i += copy(dAtA[i:], m.ActionLua)
 	return i, nil
 }
 
-func (m *ResourceRef) Marshal() (dAtA []byte, err error) {
+func (m *ResourceActionParam) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4434,39 +5631,31 @@ func (m *ResourceRef) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResourceRef) MarshalTo(dAtA []byte) (int, error) {
+func (m *ResourceActionParam) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
 	dAtA[i] = 0xa
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Group)))
-	i += copy(dAtA[i:], m.Group)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
 	dAtA[i] = 0x12
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Version)))
-	i += copy(dAtA[i:], m.Version)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
+	i += copy(dAtA[i:], m.Value)
 	dAtA[i] = 0x1a
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
-	i += copy(dAtA[i:], m.Kind)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i += copy(dAtA[i:], m.Type)
 	dAtA[i] = 0x22
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
-	i += copy(dAtA[i:], m.Namespace)
-	dAtA[i] = 0x2a
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
-	i += copy(dAtA[i:], m.Name)
-	dAtA[i] = 0x32
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.UID)))
-	i += copy(dAtA[i:], m.UID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Default)))
+	i += copy(dAtA[i:], m.Default)
 	return i, nil
 }
 
-func (m *ResourceResult) Marshal() (dAtA []byte, err error) {
+func (m *ResourceActions) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4476,55 +5665,31 @@ func (m *ResourceResult) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResourceResult) MarshalTo(dAtA []byte) (int, error) {
+func (m *ResourceActions) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
 	dAtA[i] = 0xa
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Group)))
-	i += copy(dAtA[i:], m.Group)
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Version)))
-	i += copy(dAtA[i:], m.Version)
-	dAtA[i] = 0x1a
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
-	i += copy(dAtA[i:], m.Kind)
-	dAtA[i] = 0x22
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
-	i += copy(dAtA[i:], m.Namespace)
-	dAtA[i] = 0x2a
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
-	i += copy(dAtA[i:], m.Name)
-	dAtA[i] = 0x32
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Status)))
-	i += copy(dAtA[i:], m.Status)
-	dAtA[i] = 0x3a
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
-	i += copy(dAtA[i:], m.Message)
-	dAtA[i] = 0x42
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.HookType)))
-	i += copy(dAtA[i:], m.HookType)
-	dAtA[i] = 0x4a
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.HookPhase)))
-	i += copy(dAtA[i:], m.HookPhase)
-	dAtA[i] = 0x52
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SyncPhase)))
-	i += copy(dAtA[i:], m.SyncPhase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ActionDiscoveryLua)))
+	i += copy(dAtA[i:], m.ActionDiscoveryLua)
+	if len(m.Definitions) > 0 {
+		for _, msg := range m.Definitions {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
-func (m *ResourceStatus) Marshal() (dAtA []byte, err error) {
+func (m *ResourceDiff) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4534,7 +5699,7 @@ func (m *ResourceStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResourceStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *ResourceDiff) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -4545,34 +5710,28 @@ func (m *ResourceStatus) MarshalTo(dAtA []byte) (int, error) {
 	i += copy(dAtA[i:], m.Group)
 	dAtA[i] = 0x12
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Version)))
-	i += copy(dAtA[i:], m.Version)
-	dAtA[i] = 0x1a
-	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
 	i += copy(dAtA[i:], m.Kind)
-	dAtA[i] = 0x22
+	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
 	i += copy(dAtA[i:], m.Namespace)
-	dAtA[i] = 0x2a
+	dAtA[i] = 0x22
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
 	i += copy(dAtA[i:], m.Name)
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TargetState)))
+	i += copy(dAtA[i:], m.TargetState)
 	dAtA[i] = 0x32
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Status)))
-	i += copy(dAtA[i:], m.Status)
-	if m.Health != nil {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintGenerated(dAtA, i, uint64(m.Health.Size()))
-		n47, err := m.Health.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n47
-	}
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LiveState)))
+	i += copy(dAtA[i:], m.LiveState)
+	dAtA[i] = 0x3a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Diff)))
+	i += copy(dAtA[i:], m.Diff)
 	dAtA[i] = 0x40
 	i++
 	if m.Hook {
@@ -4581,18 +5740,24 @@ func (m *ResourceStatus) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0
 	}
 	i++
-	dAtA[i] = 0x48
-	i++
-	if m.RequiresPruning {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
+	if m.SecretKeyChanges != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.SecretKeyChanges.Size()))
+		n58, err := m.SecretKeyChanges.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n58
 	}
+	dAtA[i] = 0x52
 	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PredictedLiveState)))
+	i += copy(dAtA[i:], m.PredictedLiveState)
 	return i, nil
 }
 
-func (m *RevisionHistory) Marshal() (dAtA []byte, err error) {
+func (m *ResourceHook) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4602,67 +5767,50 @@ func (m *RevisionHistory) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RevisionHistory) MarshalTo(dAtA []byte) (int, error) {
+func (m *ResourceHook) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x12
+	dAtA[i] = 0xa
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Revision)))
-	i += copy(dAtA[i:], m.Revision)
-	dAtA[i] = 0x22
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Group)))
+	i += copy(dAtA[i:], m.Group)
+	dAtA[i] = 0x12
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(m.DeployedAt.Size()))
-	n48, err := m.DeployedAt.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n48
-	dAtA[i] = 0x28
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i += copy(dAtA[i:], m.Kind)
+	dAtA[i] = 0x1a
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(m.ID))
-	dAtA[i] = 0x32
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	dAtA[i] = 0x22
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(m.Source.Size()))
-	n49, err := m.Source.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n49
-	return i, nil
-}
-
-func (m *RevisionMetadata) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i += copy(dAtA[i:], m.Namespace)
+	if len(m.Hook) > 0 {
+		for _, s := range m.Hook {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RevisionMetadata) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Author)))
-	i += copy(dAtA[i:], m.Author)
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(m.Date.Size()))
-	n50, err := m.Date.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	if m.SyncWave != nil {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.SyncWave))
 	}
-	i += n50
-	if len(m.Tags) > 0 {
-		for _, s := range m.Tags {
-			dAtA[i] = 0x1a
+	if len(m.DeletePolicy) > 0 {
+		for _, s := range m.DeletePolicy {
+			dAtA[i] = 0x3a
 			i++
 			l = len(s)
 			for l >= 1<<7 {
@@ -4675,14 +5823,10 @@ func (m *RevisionMetadata) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
-	dAtA[i] = 0x22
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
-	i += copy(dAtA[i:], m.Message)
 	return i, nil
 }
 
-func (m *SyncOperation) Marshal() (dAtA []byte, err error) {
+func (m *ResourceIgnoreDifferences) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4692,66 +5836,30 @@ func (m *SyncOperation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SyncOperation) MarshalTo(dAtA []byte) (int, error) {
+func (m *ResourceIgnoreDifferences) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
 	dAtA[i] = 0xa
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Revision)))
-	i += copy(dAtA[i:], m.Revision)
-	dAtA[i] = 0x10
-	i++
-	if m.Prune {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
-	}
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Group)))
+	i += copy(dAtA[i:], m.Group)
+	dAtA[i] = 0x12
 	i++
-	dAtA[i] = 0x18
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i += copy(dAtA[i:], m.Kind)
+	dAtA[i] = 0x1a
 	i++
-	if m.DryRun {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
-	}
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	dAtA[i] = 0x22
 	i++
-	if m.SyncStrategy != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintGenerated(dAtA, i, uint64(m.SyncStrategy.Size()))
-		n51, err := m.SyncStrategy.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n51
-	}
-	if len(m.Resources) > 0 {
-		for _, msg := range m.Resources {
-			dAtA[i] = 0x32
-			i++
-			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if m.Source != nil {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintGenerated(dAtA, i, uint64(m.Source.Size()))
-		n52, err := m.Source.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n52
-	}
-	if len(m.Manifests) > 0 {
-		for _, s := range m.Manifests {
-			dAtA[i] = 0x42
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i += copy(dAtA[i:], m.Namespace)
+	if len(m.JSONPointers) > 0 {
+		for _, s := range m.JSONPointers {
+			dAtA[i] = 0x2a
 			i++
 			l = len(s)
 			for l >= 1<<7 {
@@ -4767,7 +5875,7 @@ func (m *SyncOperation) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *SyncOperationResource) Marshal() (dAtA []byte, err error) {
+func (m *ResourceLink) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4777,27 +5885,23 @@ func (m *SyncOperationResource) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SyncOperationResource) MarshalTo(dAtA []byte) (int, error) {
+func (m *ResourceLink) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
 	dAtA[i] = 0xa
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Group)))
-	i += copy(dAtA[i:], m.Group)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Title)))
+	i += copy(dAtA[i:], m.Title)
 	dAtA[i] = 0x12
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
-	i += copy(dAtA[i:], m.Kind)
-	dAtA[i] = 0x1a
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
-	i += copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i += copy(dAtA[i:], m.URL)
 	return i, nil
 }
 
-func (m *SyncOperationResult) Marshal() (dAtA []byte, err error) {
+func (m *ResourceNetworkingInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4807,39 +5911,98 @@ func (m *SyncOperationResult) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SyncOperationResult) MarshalTo(dAtA []byte) (int, error) {
+func (m *ResourceNetworkingInfo) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Resources) > 0 {
-		for _, msg := range m.Resources {
+	if len(m.TargetLabels) > 0 {
+		keysForTargetLabels := make([]string, 0, len(m.TargetLabels))
+		for k := range m.TargetLabels {
+			keysForTargetLabels = append(keysForTargetLabels, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForTargetLabels)
+		for _, k := range keysForTargetLabels {
 			dAtA[i] = 0xa
 			i++
-			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Revision)))
-	i += copy(dAtA[i:], m.Revision)
-	dAtA[i] = 0x1a
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(m.Source.Size()))
-	n53, err := m.Source.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+			v := m.TargetLabels[string(k)]
+			mapSize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			i = encodeVarintGenerated(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if len(m.TargetRefs) > 0 {
+		for _, msg := range m.TargetRefs {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Labels) > 0 {
+		keysForLabels := make([]string, 0, len(m.Labels))
+		for k := range m.Labels {
+			keysForLabels = append(keysForLabels, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+		for _, k := range keysForLabels {
+			dAtA[i] = 0x1a
+			i++
+			v := m.Labels[string(k)]
+			mapSize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			i = encodeVarintGenerated(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if len(m.Ingress) > 0 {
+		for _, msg := range m.Ingress {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.ExternalURLs) > 0 {
+		for _, s := range m.ExternalURLs {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
 	}
-	i += n53
 	return i, nil
 }
 
-func (m *SyncPolicy) Marshal() (dAtA []byte, err error) {
+func (m *ResourceNode) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4849,25 +6012,98 @@ func (m *SyncPolicy) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SyncPolicy) MarshalTo(dAtA []byte) (int, error) {
+func (m *ResourceNode) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Automated != nil {
-		dAtA[i] = 0xa
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ResourceRef.Size()))
+	n59, err := m.ResourceRef.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n59
+	if len(m.ParentRefs) > 0 {
+		for _, msg := range m.ParentRefs {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Info) > 0 {
+		for _, msg := range m.Info {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.NetworkingInfo != nil {
+		dAtA[i] = 0x22
 		i++
-		i = encodeVarintGenerated(dAtA, i, uint64(m.Automated.Size()))
-		n54, err := m.Automated.MarshalTo(dAtA[i:])
+		i = encodeVarintGenerated(dAtA, i, uint64(m.NetworkingInfo.Size()))
+		n60, err := m.NetworkingInfo.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n60
+	}
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ResourceVersion)))
+	i += copy(dAtA[i:], m.ResourceVersion)
+	if len(m.Images) > 0 {
+		for _, s := range m.Images {
+			dAtA[i] = 0x32
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.Health != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Health.Size()))
+		n61, err := m.Health.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n54
+		i += n61
+	}
+	if len(m.Links) > 0 {
+		for _, msg := range m.Links {
+			dAtA[i] = 0x42
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	return i, nil
 }
 
-func (m *SyncPolicyAutomated) Marshal() (dAtA []byte, err error) {
+func (m *ResourceOverride) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4877,22 +6113,34 @@ func (m *SyncPolicyAutomated) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SyncPolicyAutomated) MarshalTo(dAtA []byte) (int, error) {
+func (m *ResourceOverride) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
+	dAtA[i] = 0xa
 	i++
-	if m.Prune {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
-	}
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.HealthLua)))
+	i += copy(dAtA[i:], m.HealthLua)
+	dAtA[i] = 0x12
 	i++
-	dAtA[i] = 0x10
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.IgnoreDifferences)))
+	i += copy(dAtA[i:], m.IgnoreDifferences)
+	dAtA[i] = 0x1a
 	i++
-	if m.SelfHeal {
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Actions)))
+	i += copy(dAtA[i:], m.Actions)
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.HealthProgressingTimeout)))
+	i += copy(dAtA[i:], m.HealthProgressingTimeout)
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.HealthCompleted)))
+	i += copy(dAtA[i:], m.HealthCompleted)
+	dAtA[i] = 0x30
+	i++
+	if m.HealthIncludeHooks {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
@@ -4901,7 +6149,7 @@ func (m *SyncPolicyAutomated) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *SyncStatus) Marshal() (dAtA []byte, err error) {
+func (m *ResourceRef) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4911,69 +6159,39 @@ func (m *SyncStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SyncStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *ResourceRef) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
 	dAtA[i] = 0xa
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Status)))
-	i += copy(dAtA[i:], m.Status)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Group)))
+	i += copy(dAtA[i:], m.Group)
 	dAtA[i] = 0x12
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(m.ComparedTo.Size()))
-	n55, err := m.ComparedTo.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n55
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Version)))
+	i += copy(dAtA[i:], m.Version)
 	dAtA[i] = 0x1a
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Revision)))
-	i += copy(dAtA[i:], m.Revision)
-	return i, nil
-}
-
-func (m *SyncStrategy) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *SyncStrategy) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Apply != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintGenerated(dAtA, i, uint64(m.Apply.Size()))
-		n56, err := m.Apply.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n56
-	}
-	if m.Hook != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintGenerated(dAtA, i, uint64(m.Hook.Size()))
-		n57, err := m.Hook.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n57
-	}
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i += copy(dAtA[i:], m.Kind)
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i += copy(dAtA[i:], m.Namespace)
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	dAtA[i] = 0x32
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.UID)))
+	i += copy(dAtA[i:], m.UID)
 	return i, nil
 }
 
-func (m *SyncStrategyApply) Marshal() (dAtA []byte, err error) {
+func (m *ResourceResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4983,23 +6201,143 @@ func (m *SyncStrategyApply) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SyncStrategyApply) MarshalTo(dAtA []byte) (int, error) {
+func (m *ResourceResult) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
+	dAtA[i] = 0xa
 	i++
-	if m.Force {
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Group)))
+	i += copy(dAtA[i:], m.Group)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Version)))
+	i += copy(dAtA[i:], m.Version)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i += copy(dAtA[i:], m.Kind)
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i += copy(dAtA[i:], m.Namespace)
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	dAtA[i] = 0x32
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Status)))
+	i += copy(dAtA[i:], m.Status)
+	dAtA[i] = 0x3a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i += copy(dAtA[i:], m.Message)
+	dAtA[i] = 0x42
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.HookType)))
+	i += copy(dAtA[i:], m.HookType)
+	dAtA[i] = 0x4a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.HookPhase)))
+	i += copy(dAtA[i:], m.HookPhase)
+	dAtA[i] = 0x52
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SyncPhase)))
+	i += copy(dAtA[i:], m.SyncPhase)
+	return i, nil
+}
+
+func (m *ResourceStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceStatus) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Group)))
+	i += copy(dAtA[i:], m.Group)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Version)))
+	i += copy(dAtA[i:], m.Version)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i += copy(dAtA[i:], m.Kind)
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i += copy(dAtA[i:], m.Namespace)
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	dAtA[i] = 0x32
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Status)))
+	i += copy(dAtA[i:], m.Status)
+	if m.Health != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Health.Size()))
+		n62, err := m.Health.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n62
+	}
+	dAtA[i] = 0x40
+	i++
+	if m.Hook {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	dAtA[i] = 0x48
+	i++
+	if m.RequiresPruning {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
 	i++
+	if m.OutOfSyncSince != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.OutOfSyncSince.Size()))
+		n63, err := m.OutOfSyncSince.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n63
+	}
+	if m.ProgressingSince != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.ProgressingSince.Size()))
+		n64, err := m.ProgressingSince.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n64
+	}
 	return i, nil
 }
 
-func (m *SyncStrategyHook) Marshal() (dAtA []byte, err error) {
+func (m *RevisionChangelog) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5009,23 +6347,35 @@ func (m *SyncStrategyHook) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SyncStrategyHook) MarshalTo(dAtA []byte) (int, error) {
+func (m *RevisionChangelog) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
 	dAtA[i] = 0xa
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(m.SyncStrategyApply.Size()))
-	n58, err := m.SyncStrategyApply.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Revision)))
+	i += copy(dAtA[i:], m.Revision)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TargetRevision)))
+	i += copy(dAtA[i:], m.TargetRevision)
+	if len(m.Commits) > 0 {
+		for _, msg := range m.Commits {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	i += n58
 	return i, nil
 }
 
-func (m *TLSClientConfig) Marshal() (dAtA []byte, err error) {
+func (m *RevisionHistory) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5035,751 +6385,1058 @@ func (m *TLSClientConfig) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TLSClientConfig) MarshalTo(dAtA []byte) (int, error) {
+func (m *RevisionHistory) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
+	dAtA[i] = 0x12
 	i++
-	if m.Insecure {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Revision)))
+	i += copy(dAtA[i:], m.Revision)
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.DeployedAt.Size()))
+	n65, err := m.DeployedAt.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
+	i += n65
+	dAtA[i] = 0x28
 	i++
-	dAtA[i] = 0x12
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ID))
+	dAtA[i] = 0x32
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ServerName)))
-	i += copy(dAtA[i:], m.ServerName)
-	if m.CertData != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintGenerated(dAtA, i, uint64(len(m.CertData)))
-		i += copy(dAtA[i:], m.CertData)
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Source.Size()))
+	n66, err := m.Source.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	if m.KeyData != nil {
-		dAtA[i] = 0x22
+	i += n66
+	dAtA[i] = 0x3a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.InitiatedBy.Size()))
+	n67, err := m.InitiatedBy.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n67
+	if m.ImageUpdate != nil {
+		dAtA[i] = 0x42
 		i++
-		i = encodeVarintGenerated(dAtA, i, uint64(len(m.KeyData)))
-		i += copy(dAtA[i:], m.KeyData)
+		i = encodeVarintGenerated(dAtA, i, uint64(m.ImageUpdate.Size()))
+		n68, err := m.ImageUpdate.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n68
 	}
-	if m.CAData != nil {
-		dAtA[i] = 0x2a
+	if m.AutomatedRollback != nil {
+		dAtA[i] = 0x4a
 		i++
-		i = encodeVarintGenerated(dAtA, i, uint64(len(m.CAData)))
-		i += copy(dAtA[i:], m.CAData)
+		i = encodeVarintGenerated(dAtA, i, uint64(m.AutomatedRollback.Size()))
+		n69, err := m.AutomatedRollback.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n69
+	}
+	dAtA[i] = 0x50
+	i++
+	if m.Pinned {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
+	i++
 	return i, nil
 }
 
-func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *RevisionMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
-}
-func (m *AWSAuthConfig) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.ClusterName)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.RoleARN)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
-}
-
-func (m *AppProject) Size() (n int) {
-	var l int
-	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AppProjectList) Size() (n int) {
+func (m *RevisionMetadata) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Author)))
+	i += copy(dAtA[i:], m.Author)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Date.Size()))
+	n70, err := m.Date.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	return n
-}
-
-func (m *AppProjectSpec) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.SourceRepos) > 0 {
-		for _, s := range m.SourceRepos {
+	i += n70
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			dAtA[i] = 0x1a
+			i++
 			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	if len(m.Destinations) > 0 {
-		for _, e := range m.Destinations {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	l = len(m.Description)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Roles) > 0 {
-		for _, e := range m.Roles {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	if len(m.ClusterResourceWhitelist) > 0 {
-		for _, e := range m.ClusterResourceWhitelist {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
 	}
-	if len(m.NamespaceResourceBlacklist) > 0 {
-		for _, e := range m.NamespaceResourceBlacklist {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i += copy(dAtA[i:], m.Message)
+	if len(m.ChangedFiles) > 0 {
+		for _, s := range m.ChangedFiles {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
 	}
-	if m.OrphanedResources != nil {
-		l = m.OrphanedResources.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	return n
+	return i, nil
 }
 
-func (m *Application) Size() (n int) {
-	var l int
-	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Status.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.Operation != nil {
-		l = m.Operation.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+func (m *SecretKeyChanges) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
-}
-
-func (m *ApplicationCondition) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Type)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Message)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
-}
-
-func (m *ApplicationDestination) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Server)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Namespace)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ApplicationList) Size() (n int) {
+func (m *SecretKeyChanges) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Added) > 0 {
+		for _, s := range m.Added {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
 	}
-	return n
-}
-
-func (m *ApplicationSource) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.RepoURL)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Path)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.TargetRevision)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.Helm != nil {
-		l = m.Helm.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Kustomize != nil {
-		l = m.Kustomize.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Ksonnet != nil {
-		l = m.Ksonnet.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Directory != nil {
-		l = m.Directory.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Plugin != nil {
-		l = m.Plugin.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	return n
-}
-
-func (m *ApplicationSourceDirectory) Size() (n int) {
-	var l int
-	_ = l
-	n += 2
-	l = m.Jsonnet.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
-}
-
-func (m *ApplicationSourceHelm) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.ValueFiles) > 0 {
-		for _, s := range m.ValueFiles {
+	if len(m.Removed) > 0 {
+		for _, s := range m.Removed {
+			dAtA[i] = 0x12
+			i++
 			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
 	}
-	if len(m.Parameters) > 0 {
-		for _, e := range m.Parameters {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Modified) > 0 {
+		for _, s := range m.Modified {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
 	}
-	l = len(m.ReleaseName)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Values)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return i, nil
 }
 
-func (m *ApplicationSourceJsonnet) Size() (n int) {
+func (m *SyncAnalysis) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncAnalysis) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.ExtVars) > 0 {
-		for _, e := range m.ExtVars {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	if m.Webhook != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Webhook.Size()))
+		n71, err := m.Webhook.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n71
 	}
-	if len(m.TLAs) > 0 {
-		for _, e := range m.TLAs {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	if m.Job != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Job.Size()))
+		n72, err := m.Job.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n72
 	}
-	return n
+	return i, nil
 }
 
-func (m *ApplicationSourceKsonnet) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Environment)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Parameters) > 0 {
-		for _, e := range m.Parameters {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+func (m *SyncAnalysisJob) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ApplicationSourceKustomize) Size() (n int) {
+func (m *SyncAnalysisJob) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NamePrefix)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Images) > 0 {
-		for _, s := range m.Images {
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Image)))
+	i += copy(dAtA[i:], m.Image)
+	if len(m.Command) > 0 {
+		for _, s := range m.Command {
+			dAtA[i] = 0x12
+			i++
 			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
 	}
-	if len(m.CommonLabels) > 0 {
-		for k, v := range m.CommonLabels {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+	if len(m.Args) > 0 {
+		for _, s := range m.Args {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
 	}
-	return n
+	if len(m.Env) > 0 {
+		for _, msg := range m.Env {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ServiceAccountName)))
+	i += copy(dAtA[i:], m.ServiceAccountName)
+	dAtA[i] = 0x30
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.BackoffLimit))
+	dAtA[i] = 0x38
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.TimeoutSeconds))
+	return i, nil
 }
 
-func (m *ApplicationSourcePlugin) Size() (n int) {
+func (m *SyncAnalysisResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncAnalysisResult) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Env) > 0 {
-		for _, e := range m.Env {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i += copy(dAtA[i:], m.Phase)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i += copy(dAtA[i:], m.Message)
+	return i, nil
+}
+
+func (m *SyncAnalysisWebhook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ApplicationSpec) Size() (n int) {
+func (m *SyncAnalysisWebhook) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = m.Source.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Destination.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Project)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.SyncPolicy != nil {
-		l = m.SyncPolicy.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if len(m.IgnoreDifferences) > 0 {
-		for _, e := range m.IgnoreDifferences {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	if len(m.Info) > 0 {
-		for _, e := range m.Info {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i += copy(dAtA[i:], m.URL)
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.TimeoutSeconds))
+	return i, nil
+}
+
+func (m *SyncOperation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ApplicationStatus) Size() (n int) {
+func (m *SyncOperation) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Resources) > 0 {
-		for _, e := range m.Resources {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Revision)))
+	i += copy(dAtA[i:], m.Revision)
+	dAtA[i] = 0x10
+	i++
+	if m.Prune {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
-	l = m.Sync.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Health.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.History) > 0 {
-		for _, e := range m.History {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	i++
+	dAtA[i] = 0x18
+	i++
+	if m.DryRun {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
-	if len(m.Conditions) > 0 {
-		for _, e := range m.Conditions {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	i++
+	if m.SyncStrategy != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.SyncStrategy.Size()))
+		n73, err := m.SyncStrategy.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n73
 	}
-	if m.ReconciledAt != nil {
-		l = m.ReconciledAt.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.OperationState != nil {
-		l = m.OperationState.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.ObservedAt != nil {
-		l = m.ObservedAt.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Resources) > 0 {
+		for _, msg := range m.Resources {
+			dAtA[i] = 0x32
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	l = len(m.SourceType)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Summary.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
-}
-
-func (m *ApplicationSummary) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.ExternalURLs) > 0 {
-		for _, s := range m.ExternalURLs {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
+	if m.Source != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Source.Size()))
+		n74, err := m.Source.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n74
 	}
-	if len(m.Images) > 0 {
-		for _, s := range m.Images {
+	if len(m.Manifests) > 0 {
+		for _, s := range m.Manifests {
+			dAtA[i] = 0x42
+			i++
 			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
 	}
-	return n
-}
-
-func (m *ApplicationTree) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.Nodes) > 0 {
-		for _, e := range m.Nodes {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	dAtA[i] = 0x48
+	i++
+	if m.Adopt {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	if m.ImageUpdate != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.ImageUpdate.Size()))
+		n75, err := m.ImageUpdate.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n75
 	}
-	if len(m.OrphanedNodes) > 0 {
-		for _, e := range m.OrphanedNodes {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	if m.AutomatedRollback != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.AutomatedRollback.Size()))
+		n76, err := m.AutomatedRollback.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n76
 	}
-	return n
+	dAtA[i] = 0x60
+	i++
+	if m.Pinned {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	dAtA[i] = 0x68
+	i++
+	if m.PruneArgoCD {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	return i, nil
 }
 
-func (m *ApplicationWatchEvent) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Type)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Application.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+func (m *SyncOperationResource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
-func (m *Cluster) Size() (n int) {
+func (m *SyncOperationResource) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Server)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Config.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.ConnectionState.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.ServerVersion)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Group)))
+	i += copy(dAtA[i:], m.Group)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i += copy(dAtA[i:], m.Kind)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	return i, nil
 }
 
-func (m *ClusterConfig) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Username)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Password)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.BearerToken)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.TLSClientConfig.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.AWSAuthConfig != nil {
-		l = m.AWSAuthConfig.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+func (m *SyncOperationResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ClusterList) Size() (n int) {
+func (m *SyncOperationResult) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Resources) > 0 {
+		for _, msg := range m.Resources {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	return n
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Revision)))
+	i += copy(dAtA[i:], m.Revision)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Source.Size()))
+	n77, err := m.Source.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n77
+	if m.Analysis != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Analysis.Size()))
+		n78, err := m.Analysis.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n78
+	}
+	return i, nil
 }
 
-func (m *Command) Size() (n int) {
+func (m *SyncPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncPolicy) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Command) > 0 {
-		for _, s := range m.Command {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
+	if m.Automated != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Automated.Size()))
+		n79, err := m.Automated.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n79
 	}
-	if len(m.Args) > 0 {
-		for _, s := range m.Args {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
+	if m.Verify != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Verify.Size()))
+		n80, err := m.Verify.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n80
 	}
-	return n
+	return i, nil
 }
 
-func (m *ComparedTo) Size() (n int) {
-	var l int
-	_ = l
-	l = m.Source.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Destination.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+func (m *SyncPolicyAutomated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
-func (m *ComponentParameter) Size() (n int) {
+func (m *SyncPolicyAutomated) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Component)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Value)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	dAtA[i] = 0x8
+	i++
+	if m.Prune {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	dAtA[i] = 0x10
+	i++
+	if m.SelfHeal {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	return i, nil
 }
 
-func (m *ConfigManagementPlugin) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.Init != nil {
-		l = m.Init.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+func (m *SyncPolicyVerify) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	l = m.Generate.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ConnectionState) Size() (n int) {
+func (m *SyncPolicyVerify) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Status)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Message)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.ModifiedAt != nil {
-		l = m.ModifiedAt.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.TimeoutSeconds))
+	dAtA[i] = 0x10
+	i++
+	if m.Rollback {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
-	return n
+	i++
+	return i, nil
 }
 
-func (m *EnvEntry) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Value)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+func (m *SyncReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
-func (m *HealthStatus) Size() (n int) {
+func (m *SyncReport) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Status)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Message)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ID))
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Revision)))
+	i += copy(dAtA[i:], m.Revision)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Source.Size()))
+	n81, err := m.Source.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n81
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i += copy(dAtA[i:], m.Phase)
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i += copy(dAtA[i:], m.Message)
+	dAtA[i] = 0x30
+	i++
+	if m.DryRun {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	dAtA[i] = 0x3a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.InitiatedBy.Size()))
+	n82, err := m.InitiatedBy.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n82
+	dAtA[i] = 0x42
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.StartedAt.Size()))
+	n83, err := m.StartedAt.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n83
+	dAtA[i] = 0x4a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.FinishedAt.Size()))
+	n84, err := m.FinishedAt.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n84
+	dAtA[i] = 0x50
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.DurationSeconds))
+	if len(m.Applied) > 0 {
+		for _, msg := range m.Applied {
+			dAtA[i] = 0x5a
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Hooks) > 0 {
+		for _, msg := range m.Hooks {
+			dAtA[i] = 0x62
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Pruned) > 0 {
+		for _, msg := range m.Pruned {
+			dAtA[i] = 0x6a
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Warnings) > 0 {
+		for _, s := range m.Warnings {
+			dAtA[i] = 0x72
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
 }
 
-func (m *HelmParameter) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Value)
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 2
-	return n
+func (m *SyncStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
-func (m *Info) Size() (n int) {
+func (m *SyncStatus) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Value)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Status)))
+	i += copy(dAtA[i:], m.Status)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ComparedTo.Size()))
+	n85, err := m.ComparedTo.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n85
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Revision)))
+	i += copy(dAtA[i:], m.Revision)
+	return i, nil
 }
 
-func (m *InfoItem) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Value)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+func (m *SyncStrategy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
-func (m *JWTToken) Size() (n int) {
+func (m *SyncStrategy) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	n += 1 + sovGenerated(uint64(m.IssuedAt))
-	n += 1 + sovGenerated(uint64(m.ExpiresAt))
-	return n
+	if m.Apply != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Apply.Size()))
+		n86, err := m.Apply.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n86
+	}
+	if m.Hook != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Hook.Size()))
+		n87, err := m.Hook.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n87
+	}
+	return i, nil
 }
 
-func (m *JsonnetVar) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Value)
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 2
-	return n
+func (m *SyncStrategyApply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
-func (m *KsonnetParameter) Size() (n int) {
+func (m *SyncStrategyApply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Component)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Value)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	dAtA[i] = 0x8
+	i++
+	if m.Force {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	return i, nil
 }
 
-func (m *KustomizeOptions) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.BuildOptions)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+func (m *SyncStrategyHook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
-func (m *Operation) Size() (n int) {
+func (m *SyncStrategyHook) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Sync != nil {
-		l = m.Sync.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.SyncStrategyApply.Size()))
+	n88, err := m.SyncStrategyApply.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	return n
+	i += n88
+	return i, nil
 }
 
-func (m *OperationState) Size() (n int) {
+func (m *SyncVerification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncVerification) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = m.Operation.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Phase)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Message)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.SyncResult != nil {
-		l = m.SyncResult.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	l = m.StartedAt.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.FinishedAt != nil {
-		l = m.FinishedAt.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i += copy(dAtA[i:], m.Phase)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Revision)))
+	i += copy(dAtA[i:], m.Revision)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.SyncedAt.Size()))
+	n89, err := m.SyncedAt.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	return n
+	i += n89
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Deadline.Size()))
+	n90, err := m.Deadline.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n90
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i += copy(dAtA[i:], m.Message)
+	dAtA[i] = 0x30
+	i++
+	if m.RolledBack {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	return i, nil
 }
 
-func (m *OrphanedResourcesMonitorSettings) Size() (n int) {
-	var l int
-	_ = l
-	if m.Warn != nil {
-		n += 2
+func (m *TLSClientConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ProjectRole) Size() (n int) {
+func (m *TLSClientConfig) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Description)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Policies) > 0 {
-		for _, s := range m.Policies {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	dAtA[i] = 0x8
+	i++
+	if m.Insecure {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
-	if len(m.JWTTokens) > 0 {
-		for _, e := range m.JWTTokens {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	i++
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ServerName)))
+	i += copy(dAtA[i:], m.ServerName)
+	if m.CertData != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(len(m.CertData)))
+		i += copy(dAtA[i:], m.CertData)
 	}
-	if len(m.Groups) > 0 {
-		for _, s := range m.Groups {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	if m.KeyData != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(len(m.KeyData)))
+		i += copy(dAtA[i:], m.KeyData)
 	}
-	return n
+	if m.CAData != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(len(m.CAData)))
+		i += copy(dAtA[i:], m.CAData)
+	}
+	return i, nil
 }
 
-func (m *Repository) Size() (n int) {
+func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *AWSAuthConfig) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Repo)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Username)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Password)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.SSHPrivateKey)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.ConnectionState.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 2
-	n += 2
-	n += 2
-	l = len(m.TLSClientCertData)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.TLSClientCertKey)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.TLSClientCAData)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Type)
+	l = len(m.ClusterName)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Name)
+	l = len(m.RoleARN)
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *RepositoryCertificate) Size() (n int) {
+func (m *AppProject) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.ServerName)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.CertType)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.CertSubType)
+	l = m.ObjectMeta.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.CertData != nil {
-		l = len(m.CertData)
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	l = len(m.CertInfo)
+	l = m.Spec.Size()
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *RepositoryCertificateList) Size() (n int) {
+func (m *AppProjectList) Size() (n int) {
 	var l int
 	_ = l
 	l = m.ListMeta.Size()
@@ -5793,65 +7450,106 @@ func (m *RepositoryCertificateList) Size() (n int) {
 	return n
 }
 
-func (m *RepositoryList) Size() (n int) {
+func (m *AppProjectSpec) Size() (n int) {
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
+	if len(m.SourceRepos) > 0 {
+		for _, s := range m.SourceRepos {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Destinations) > 0 {
+		for _, e := range m.Destinations {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.Description)
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
+	if len(m.Roles) > 0 {
+		for _, e := range m.Roles {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.ClusterResourceWhitelist) > 0 {
+		for _, e := range m.ClusterResourceWhitelist {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.NamespaceResourceBlacklist) > 0 {
+		for _, e := range m.NamespaceResourceBlacklist {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.OrphanedResources != nil {
+		l = m.OrphanedResources.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.SyncPolicy != nil {
+		l = m.SyncPolicy.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.SourceDecryptionKeys) > 0 {
+		for _, s := range m.SourceDecryptionKeys {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 2
+	if m.ManifestQuota != nil {
+		l = m.ManifestQuota.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
-func (m *ResourceAction) Size() (n int) {
+func (m *Application) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Name)
+	l = m.ObjectMeta.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Params) > 0 {
-		for _, e := range m.Params {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Operation != nil {
+		l = m.Operation.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
 }
 
-func (m *ResourceActionDefinition) Size() (n int) {
+func (m *ApplicationCondition) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Name)
+	l = len(m.Type)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.ActionLua)
+	l = len(m.Message)
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *ResourceActionParam) Size() (n int) {
+func (m *ApplicationDestination) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Value)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Type)
+	l = len(m.Server)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Default)
+	l = len(m.Namespace)
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *ResourceActions) Size() (n int) {
+func (m *ApplicationList) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.ActionDiscoveryLua)
+	l = m.ListMeta.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Definitions) > 0 {
-		for _, e := range m.Definitions {
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
@@ -5859,109 +7557,119 @@ func (m *ResourceActions) Size() (n int) {
 	return n
 }
 
-func (m *ResourceDiff) Size() (n int) {
+func (m *ApplicationSource) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Group)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Kind)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Namespace)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.TargetState)
+	l = len(m.RepoURL)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.LiveState)
+	l = len(m.Path)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Diff)
+	l = len(m.TargetRevision)
 	n += 1 + l + sovGenerated(uint64(l))
-	n += 2
-	return n
-}
-
-func (m *ResourceIgnoreDifferences) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Group)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Kind)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Namespace)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.JSONPointers) > 0 {
-		for _, s := range m.JSONPointers {
-			l = len(s)
+	if m.Helm != nil {
+		l = m.Helm.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Kustomize != nil {
+		l = m.Kustomize.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Ksonnet != nil {
+		l = m.Ksonnet.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Directory != nil {
+		l = m.Directory.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Plugin != nil {
+		l = m.Plugin.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Patches) > 0 {
+		for _, e := range m.Patches {
+			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
 	return n
 }
 
-func (m *ResourceNetworkingInfo) Size() (n int) {
+func (m *ApplicationSourceDirectory) Size() (n int) {
 	var l int
 	_ = l
-	if len(m.TargetLabels) > 0 {
-		for k, v := range m.TargetLabels {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+	n += 2
+	l = m.Jsonnet.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ApplicationSourceHelm) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.ValueFiles) > 0 {
+		for _, s := range m.ValueFiles {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	if len(m.TargetRefs) > 0 {
-		for _, e := range m.TargetRefs {
+	if len(m.Parameters) > 0 {
+		for _, e := range m.Parameters {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	if len(m.Labels) > 0 {
-		for k, v := range m.Labels {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+	l = len(m.ReleaseName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Values)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	if len(m.FileParameters) > 0 {
+		for _, e := range m.FileParameters {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	if len(m.Ingress) > 0 {
-		for _, e := range m.Ingress {
+	n += 2
+	return n
+}
+
+func (m *ApplicationSourceJsonnet) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.ExtVars) > 0 {
+		for _, e := range m.ExtVars {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	if len(m.ExternalURLs) > 0 {
-		for _, s := range m.ExternalURLs {
-			l = len(s)
+	if len(m.TLAs) > 0 {
+		for _, e := range m.TLAs {
+			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
 	return n
 }
 
-func (m *ResourceNode) Size() (n int) {
+func (m *ApplicationSourceKsonnet) Size() (n int) {
 	var l int
 	_ = l
-	l = m.ResourceRef.Size()
+	l = len(m.Environment)
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.ParentRefs) > 0 {
-		for _, e := range m.ParentRefs {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	if len(m.Info) > 0 {
-		for _, e := range m.Info {
+	if len(m.Parameters) > 0 {
+		for _, e := range m.Parameters {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	if m.NetworkingInfo != nil {
-		l = m.NetworkingInfo.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	l = len(m.ResourceVersion)
+	return n
+}
+
+func (m *ApplicationSourceKustomize) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.NamePrefix)
 	n += 1 + l + sovGenerated(uint64(l))
 	if len(m.Images) > 0 {
 		for _, s := range m.Images {
@@ -5969,167 +7677,105 @@ func (m *ResourceNode) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	if m.Health != nil {
-		l = m.Health.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if len(m.CommonLabels) > 0 {
+		for k, v := range m.CommonLabels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
 	}
 	return n
 }
 
-func (m *ResourceOverride) Size() (n int) {
+func (m *ApplicationSourcePatch) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.HealthLua)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.IgnoreDifferences)
+	l = m.Target.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Actions)
+	l = len(m.Patch)
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *ResourceRef) Size() (n int) {
+func (m *ApplicationSourcePatchTarget) Size() (n int) {
 	var l int
 	_ = l
 	l = len(m.Group)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Version)
-	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Kind)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Namespace)
-	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.UID)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
-}
-
-func (m *ResourceResult) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Group)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Version)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Kind)
-	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Namespace)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Status)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Message)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.HookType)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.HookPhase)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.SyncPhase)
-	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *ResourceStatus) Size() (n int) {
+func (m *ApplicationSourcePlugin) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Group)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Version)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Kind)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Namespace)
-	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Status)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.Health != nil {
-		l = m.Health.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Env) > 0 {
+		for _, e := range m.Env {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	n += 2
-	n += 2
 	return n
 }
 
-func (m *RevisionHistory) Size() (n int) {
+func (m *ApplicationSpec) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Revision)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.DeployedAt.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 1 + sovGenerated(uint64(m.ID))
 	l = m.Source.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	return n
-}
-
-func (m *RevisionMetadata) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Author)
+	l = m.Destination.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Date.Size()
+	l = len(m.Project)
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Tags) > 0 {
-		for _, s := range m.Tags {
-			l = len(s)
+	if m.SyncPolicy != nil {
+		l = m.SyncPolicy.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.IgnoreDifferences) > 0 {
+		for _, e := range m.IgnoreDifferences {
+			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	l = len(m.Message)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
-}
-
-func (m *SyncOperation) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Revision)
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 2
-	n += 2
-	if m.SyncStrategy != nil {
-		l = m.SyncStrategy.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if len(m.Resources) > 0 {
-		for _, e := range m.Resources {
+	if len(m.Info) > 0 {
+		for _, e := range m.Info {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	if m.Source != nil {
-		l = m.Source.Size()
+	if m.WriteBack != nil {
+		l = m.WriteBack.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if len(m.Manifests) > 0 {
-		for _, s := range m.Manifests {
-			l = len(s)
+	if m.RevisionHistoryLimit != nil {
+		n += 1 + sovGenerated(uint64(*m.RevisionHistoryLimit))
+	}
+	if m.Suspend != nil {
+		l = m.Suspend.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.ResourceHooks) > 0 {
+		for _, e := range m.ResourceHooks {
+			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.Analysis != nil {
+		l = m.Analysis.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
-func (m *SyncOperationResource) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Group)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Kind)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
-}
-
-func (m *SyncOperationResult) Size() (n int) {
+func (m *ApplicationStatus) Size() (n int) {
 	var l int
 	_ = l
 	if len(m.Resources) > 0 {
@@ -6138,1013 +7784,7648 @@ func (m *SyncOperationResult) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	l = len(m.Revision)
+	l = m.Sync.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Source.Size()
+	l = m.Health.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Conditions) > 0 {
+		for _, e := range m.Conditions {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.ReconciledAt != nil {
+		l = m.ReconciledAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.OperationState != nil {
+		l = m.OperationState.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.ObservedAt != nil {
+		l = m.ObservedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.SourceType)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Summary.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.Verification != nil {
+		l = m.Verification.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
-func (m *SyncPolicy) Size() (n int) {
+func (m *ApplicationSummary) Size() (n int) {
 	var l int
 	_ = l
-	if m.Automated != nil {
-		l = m.Automated.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if len(m.ExternalURLs) > 0 {
+		for _, s := range m.ExternalURLs {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Images) > 0 {
+		for _, s := range m.Images {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.RenderedSources) > 0 {
+		for _, e := range m.RenderedSources {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *SyncPolicyAutomated) Size() (n int) {
+func (m *ApplicationSuspend) Size() (n int) {
 	var l int
 	_ = l
-	n += 2
-	n += 2
+	if m.Until != nil {
+		l = m.Until.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Reason)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *SyncStatus) Size() (n int) {
+func (m *ApplicationTree) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Status)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.ComparedTo.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Revision)
-	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.OrphanedNodes) > 0 {
+		for _, e := range m.OrphanedNodes {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *SyncStrategy) Size() (n int) {
+func (m *ApplicationWatchEvent) Size() (n int) {
 	var l int
 	_ = l
-	if m.Apply != nil {
-		l = m.Apply.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Hook != nil {
-		l = m.Hook.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Application.Size()
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *SyncStrategyApply) Size() (n int) {
+func (m *ApplicationWriteBack) Size() (n int) {
 	var l int
 	_ = l
+	l = len(m.Branch)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Path)
+	n += 1 + l + sovGenerated(uint64(l))
 	n += 2
 	return n
 }
 
-func (m *SyncStrategyHook) Size() (n int) {
+func (m *AutomatedRollback) Size() (n int) {
 	var l int
 	_ = l
-	l = m.SyncStrategyApply.Size()
+	l = len(m.FromRevision)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Reason)
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *TLSClientConfig) Size() (n int) {
+func (m *Cluster) Size() (n int) {
 	var l int
 	_ = l
-	n += 2
-	l = len(m.ServerName)
+	l = len(m.Server)
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.CertData != nil {
-		l = len(m.CertData)
-		n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Config.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.ConnectionState.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ServerVersion)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Namespaces) > 0 {
+		for _, s := range m.Namespaces {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	if m.KeyData != nil {
-		l = len(m.KeyData)
-		n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
 	}
-	if m.CAData != nil {
-		l = len(m.CAData)
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *ClusterConfig) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Username)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Password)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.BearerToken)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.TLSClientConfig.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.AWSAuthConfig != nil {
+		l = m.AWSAuthConfig.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
 }
 
-func sovGenerated(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
+func (m *ClusterList) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
 	return n
 }
-func sozGenerated(x uint64) (n int) {
-	return sovGenerated(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *AWSAuthConfig) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Command) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Command) > 0 {
+		for _, s := range m.Command {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&AWSAuthConfig{`,
-		`ClusterName:` + fmt.Sprintf("%v", this.ClusterName) + `,`,
-		`RoleARN:` + fmt.Sprintf("%v", this.RoleARN) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *AppProject) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Args) > 0 {
+		for _, s := range m.Args {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&AppProject{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(this.ObjectMeta.String(), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "AppProjectSpec", "AppProjectSpec", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *AppProjectList) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&AppProjectList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(this.ListMeta.String(), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Items), "AppProject", "AppProject", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *CommitMetadata) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Revision)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Author)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Date.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *AppProjectSpec) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&AppProjectSpec{`,
-		`SourceRepos:` + fmt.Sprintf("%v", this.SourceRepos) + `,`,
-		`Destinations:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Destinations), "ApplicationDestination", "ApplicationDestination", 1), `&`, ``, 1) + `,`,
-		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
-		`Roles:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Roles), "ProjectRole", "ProjectRole", 1), `&`, ``, 1) + `,`,
-		`ClusterResourceWhitelist:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ClusterResourceWhitelist), "GroupKind", "v1.GroupKind", 1), `&`, ``, 1) + `,`,
-		`NamespaceResourceBlacklist:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.NamespaceResourceBlacklist), "GroupKind", "v1.GroupKind", 1), `&`, ``, 1) + `,`,
-		`OrphanedResources:` + strings.Replace(fmt.Sprintf("%v", this.OrphanedResources), "OrphanedResourcesMonitorSettings", "OrphanedResourcesMonitorSettings", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *ComparedTo) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Source.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Destination.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *Application) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&Application{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(this.ObjectMeta.String(), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ApplicationSpec", "ApplicationSpec", 1), `&`, ``, 1) + `,`,
-		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "ApplicationStatus", "ApplicationStatus", 1), `&`, ``, 1) + `,`,
-		`Operation:` + strings.Replace(fmt.Sprintf("%v", this.Operation), "Operation", "Operation", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *ComponentParameter) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Component)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Value)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *ApplicationCondition) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ConfigManagementPlugin) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Init != nil {
+		l = m.Init.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&ApplicationCondition{`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`}`,
-	}, "")
-	return s
+	l = m.Generate.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *ApplicationDestination) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ConnectionState) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Status)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.ModifiedAt != nil {
+		l = m.ModifiedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&ApplicationDestination{`,
-		`Server:` + fmt.Sprintf("%v", this.Server) + `,`,
-		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *ApplicationList) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ApplicationList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(this.ListMeta.String(), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Items), "Application", "Application", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *EnvEntry) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Value)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *ApplicationSource) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ApplicationSource{`,
-		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
-		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
-		`TargetRevision:` + fmt.Sprintf("%v", this.TargetRevision) + `,`,
-		`Helm:` + strings.Replace(fmt.Sprintf("%v", this.Helm), "ApplicationSourceHelm", "ApplicationSourceHelm", 1) + `,`,
-		`Kustomize:` + strings.Replace(fmt.Sprintf("%v", this.Kustomize), "ApplicationSourceKustomize", "ApplicationSourceKustomize", 1) + `,`,
-		`Ksonnet:` + strings.Replace(fmt.Sprintf("%v", this.Ksonnet), "ApplicationSourceKsonnet", "ApplicationSourceKsonnet", 1) + `,`,
-		`Directory:` + strings.Replace(fmt.Sprintf("%v", this.Directory), "ApplicationSourceDirectory", "ApplicationSourceDirectory", 1) + `,`,
-		`Plugin:` + strings.Replace(fmt.Sprintf("%v", this.Plugin), "ApplicationSourcePlugin", "ApplicationSourcePlugin", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *HealthStatus) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Status)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *ApplicationSourceDirectory) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ApplicationSourceDirectory{`,
-		`Recurse:` + fmt.Sprintf("%v", this.Recurse) + `,`,
-		`Jsonnet:` + strings.Replace(strings.Replace(this.Jsonnet.String(), "ApplicationSourceJsonnet", "ApplicationSourceJsonnet", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *HelmFileParameter) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Path)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *ApplicationSourceHelm) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ApplicationSourceHelm{`,
-		`ValueFiles:` + fmt.Sprintf("%v", this.ValueFiles) + `,`,
-		`Parameters:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Parameters), "HelmParameter", "HelmParameter", 1), `&`, ``, 1) + `,`,
-		`ReleaseName:` + fmt.Sprintf("%v", this.ReleaseName) + `,`,
-		`Values:` + fmt.Sprintf("%v", this.Values) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *HelmParameter) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Value)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	return n
 }
-func (this *ApplicationSourceJsonnet) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ImageUpdate) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.HelmParameters) > 0 {
+		for _, e := range m.HelmParameters {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&ApplicationSourceJsonnet{`,
-		`ExtVars:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ExtVars), "JsonnetVar", "JsonnetVar", 1), `&`, ``, 1) + `,`,
-		`TLAs:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.TLAs), "JsonnetVar", "JsonnetVar", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ApplicationSourceKsonnet) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.KustomizeImages) > 0 {
+		for _, s := range m.KustomizeImages {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&ApplicationSourceKsonnet{`,
-		`Environment:` + fmt.Sprintf("%v", this.Environment) + `,`,
-		`Parameters:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Parameters), "KsonnetParameter", "KsonnetParameter", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	n += 2
+	return n
 }
-func (this *ApplicationSourceKustomize) String() string {
-	if this == nil {
-		return "nil"
-	}
-	keysForCommonLabels := make([]string, 0, len(this.CommonLabels))
-	for k := range this.CommonLabels {
-		keysForCommonLabels = append(keysForCommonLabels, k)
-	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForCommonLabels)
-	mapStringForCommonLabels := "map[string]string{"
-	for _, k := range keysForCommonLabels {
-		mapStringForCommonLabels += fmt.Sprintf("%v: %v,", k, this.CommonLabels[k])
-	}
-	mapStringForCommonLabels += "}"
-	s := strings.Join([]string{`&ApplicationSourceKustomize{`,
-		`NamePrefix:` + fmt.Sprintf("%v", this.NamePrefix) + `,`,
-		`Images:` + fmt.Sprintf("%v", this.Images) + `,`,
-		`CommonLabels:` + mapStringForCommonLabels + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *Info) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Value)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *ApplicationSourcePlugin) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ApplicationSourcePlugin{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Env:` + strings.Replace(fmt.Sprintf("%v", this.Env), "EnvEntry", "EnvEntry", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *InfoItem) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Value)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *ApplicationSpec) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ApplicationSpec{`,
-		`Source:` + strings.Replace(strings.Replace(this.Source.String(), "ApplicationSource", "ApplicationSource", 1), `&`, ``, 1) + `,`,
-		`Destination:` + strings.Replace(strings.Replace(this.Destination.String(), "ApplicationDestination", "ApplicationDestination", 1), `&`, ``, 1) + `,`,
-		`Project:` + fmt.Sprintf("%v", this.Project) + `,`,
-		`SyncPolicy:` + strings.Replace(fmt.Sprintf("%v", this.SyncPolicy), "SyncPolicy", "SyncPolicy", 1) + `,`,
-		`IgnoreDifferences:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.IgnoreDifferences), "ResourceIgnoreDifferences", "ResourceIgnoreDifferences", 1), `&`, ``, 1) + `,`,
-		`Info:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Info), "Info", "Info", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *JWTToken) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.IssuedAt))
+	n += 1 + sovGenerated(uint64(m.ExpiresAt))
+	return n
 }
-func (this *ApplicationStatus) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ApplicationStatus{`,
-		`Resources:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Resources), "ResourceStatus", "ResourceStatus", 1), `&`, ``, 1) + `,`,
-		`Sync:` + strings.Replace(strings.Replace(this.Sync.String(), "SyncStatus", "SyncStatus", 1), `&`, ``, 1) + `,`,
-		`Health:` + strings.Replace(strings.Replace(this.Health.String(), "HealthStatus", "HealthStatus", 1), `&`, ``, 1) + `,`,
-		`History:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.History), "RevisionHistory", "RevisionHistory", 1), `&`, ``, 1) + `,`,
-		`Conditions:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Conditions), "ApplicationCondition", "ApplicationCondition", 1), `&`, ``, 1) + `,`,
-		`ReconciledAt:` + strings.Replace(fmt.Sprintf("%v", this.ReconciledAt), "Time", "v1.Time", 1) + `,`,
-		`OperationState:` + strings.Replace(fmt.Sprintf("%v", this.OperationState), "OperationState", "OperationState", 1) + `,`,
-		`ObservedAt:` + strings.Replace(fmt.Sprintf("%v", this.ObservedAt), "Time", "v1.Time", 1) + `,`,
-		`SourceType:` + fmt.Sprintf("%v", this.SourceType) + `,`,
-		`Summary:` + strings.Replace(strings.Replace(this.Summary.String(), "ApplicationSummary", "ApplicationSummary", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *JsonnetVar) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Value)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	return n
 }
-func (this *ApplicationSummary) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ApplicationSummary{`,
-		`ExternalURLs:` + fmt.Sprintf("%v", this.ExternalURLs) + `,`,
-		`Images:` + fmt.Sprintf("%v", this.Images) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *KsonnetParameter) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Component)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Value)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *ApplicationTree) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ApplicationTree{`,
-		`Nodes:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Nodes), "ResourceNode", "ResourceNode", 1), `&`, ``, 1) + `,`,
-		`OrphanedNodes:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.OrphanedNodes), "ResourceNode", "ResourceNode", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *KustomizeOptions) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.BuildOptions)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *ApplicationWatchEvent) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ApplicationWatchEvent{`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`Application:` + strings.Replace(strings.Replace(this.Application.String(), "Application", "Application", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *ManifestQuota) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.MaxResources))
+	n += 1 + sovGenerated(uint64(m.MaxSize))
+	return n
 }
-func (this *Cluster) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Operation) Size() (n int) {
+	var l int
+	_ = l
+	if m.Sync != nil {
+		l = m.Sync.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&Cluster{`,
-		`Server:` + fmt.Sprintf("%v", this.Server) + `,`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Config:` + strings.Replace(strings.Replace(this.Config.String(), "ClusterConfig", "ClusterConfig", 1), `&`, ``, 1) + `,`,
-		`ConnectionState:` + strings.Replace(strings.Replace(this.ConnectionState.String(), "ConnectionState", "ConnectionState", 1), `&`, ``, 1) + `,`,
-		`ServerVersion:` + fmt.Sprintf("%v", this.ServerVersion) + `,`,
-		`}`,
-	}, "")
-	return s
+	l = m.InitiatedBy.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *ClusterConfig) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ClusterConfig{`,
-		`Username:` + fmt.Sprintf("%v", this.Username) + `,`,
-		`Password:` + fmt.Sprintf("%v", this.Password) + `,`,
-		`BearerToken:` + fmt.Sprintf("%v", this.BearerToken) + `,`,
-		`TLSClientConfig:` + strings.Replace(strings.Replace(this.TLSClientConfig.String(), "TLSClientConfig", "TLSClientConfig", 1), `&`, ``, 1) + `,`,
-		`AWSAuthConfig:` + strings.Replace(fmt.Sprintf("%v", this.AWSAuthConfig), "AWSAuthConfig", "AWSAuthConfig", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *OperationInitiator) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Username)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	l = len(m.Source)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *ClusterList) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *OperationState) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Operation.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.SyncResult != nil {
+		l = m.SyncResult.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&ClusterList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(this.ListMeta.String(), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Items), "Cluster", "Cluster", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Command) String() string {
-	if this == nil {
-		return "nil"
+	l = m.StartedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.FinishedAt != nil {
+		l = m.FinishedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&Command{`,
-		`Command:` + fmt.Sprintf("%v", this.Command) + `,`,
-		`Args:` + fmt.Sprintf("%v", this.Args) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *ComparedTo) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *OrphanedResourcesMonitorSettings) Size() (n int) {
+	var l int
+	_ = l
+	if m.Warn != nil {
+		n += 2
 	}
-	s := strings.Join([]string{`&ComparedTo{`,
-		`Source:` + strings.Replace(strings.Replace(this.Source.String(), "ApplicationSource", "ApplicationSource", 1), `&`, ``, 1) + `,`,
-		`Destination:` + strings.Replace(strings.Replace(this.Destination.String(), "ApplicationDestination", "ApplicationDestination", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *ComponentParameter) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ProjectRole) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Description)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Policies) > 0 {
+		for _, s := range m.Policies {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&ComponentParameter{`,
-		`Component:` + fmt.Sprintf("%v", this.Component) + `,`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ConfigManagementPlugin) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.JWTTokens) > 0 {
+		for _, e := range m.JWTTokens {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&ConfigManagementPlugin{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Init:` + strings.Replace(fmt.Sprintf("%v", this.Init), "Command", "Command", 1) + `,`,
-		`Generate:` + strings.Replace(strings.Replace(this.Generate.String(), "Command", "Command", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ConnectionState) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Groups) > 0 {
+		for _, s := range m.Groups {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&ConnectionState{`,
-		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`ModifiedAt:` + strings.Replace(fmt.Sprintf("%v", this.ModifiedAt), "Time", "v1.Time", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *EnvEntry) String() string {
-	if this == nil {
-		return "nil"
+	if m.Quota != nil {
+		l = m.Quota.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&EnvEntry{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *HealthStatus) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&HealthStatus{`,
-		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *ProjectRoleQuota) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.RequestsPerHour))
+	n += 1 + sovGenerated(uint64(m.SyncsPerHour))
+	return n
 }
-func (this *HelmParameter) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&HelmParameter{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`ForceString:` + fmt.Sprintf("%v", this.ForceString) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Info) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ProjectTemplate) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Description)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Parameters) > 0 {
+		for _, e := range m.Parameters {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&Info{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`}`,
-	}, "")
-	return s
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *InfoItem) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&InfoItem{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *ProjectTemplateParameter) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Description)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Default)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	return n
 }
-func (this *JWTToken) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&JWTToken{`,
-		`IssuedAt:` + fmt.Sprintf("%v", this.IssuedAt) + `,`,
-		`ExpiresAt:` + fmt.Sprintf("%v", this.ExpiresAt) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *RenderedSource) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Version)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.AppVersion)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	return n
 }
-func (this *JsonnetVar) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&JsonnetVar{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`Code:` + fmt.Sprintf("%v", this.Code) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *Repository) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Repo)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Username)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Password)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.SSHPrivateKey)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.ConnectionState.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	n += 2
+	n += 2
+	l = len(m.TLSClientCertData)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.TLSClientCertKey)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.TLSClientCAData)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.WebhookID)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *KsonnetParameter) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RepositoryCertificate) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ServerName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.CertType)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.CertSubType)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.CertData != nil {
+		l = len(m.CertData)
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&KsonnetParameter{`,
-		`Component:` + fmt.Sprintf("%v", this.Component) + `,`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`}`,
-	}, "")
-	return s
+	l = len(m.CertInfo)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *KustomizeOptions) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RepositoryCertificateList) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&KustomizeOptions{`,
-		`BuildOptions:` + fmt.Sprintf("%v", this.BuildOptions) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *Operation) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RepositoryFailure) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Repo)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.RequestType)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	n += 1 + sovGenerated(uint64(m.Failures))
+	l = m.FailedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.LastSucceededAt != nil {
+		l = m.LastSucceededAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&Operation{`,
-		`Sync:` + strings.Replace(fmt.Sprintf("%v", this.Sync), "SyncOperation", "SyncOperation", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *OperationState) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RepositoryFailureList) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&OperationState{`,
-		`Operation:` + strings.Replace(strings.Replace(this.Operation.String(), "Operation", "Operation", 1), `&`, ``, 1) + `,`,
-		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`SyncResult:` + strings.Replace(fmt.Sprintf("%v", this.SyncResult), "SyncOperationResult", "SyncOperationResult", 1) + `,`,
-		`StartedAt:` + strings.Replace(strings.Replace(this.StartedAt.String(), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
-		`FinishedAt:` + strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v1.Time", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *OrphanedResourcesMonitorSettings) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RepositoryList) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&OrphanedResourcesMonitorSettings{`,
-		`Warn:` + valueToStringGenerated(this.Warn) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *ProjectRole) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ResourceAction) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Params) > 0 {
+		for _, e := range m.Params {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&ProjectRole{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
-		`Policies:` + fmt.Sprintf("%v", this.Policies) + `,`,
-		`JWTTokens:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.JWTTokens), "JWTToken", "JWTToken", 1), `&`, ``, 1) + `,`,
-		`Groups:` + fmt.Sprintf("%v", this.Groups) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *Repository) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&Repository{`,
-		`Repo:` + fmt.Sprintf("%v", this.Repo) + `,`,
-		`Username:` + fmt.Sprintf("%v", this.Username) + `,`,
-		`Password:` + fmt.Sprintf("%v", this.Password) + `,`,
-		`SSHPrivateKey:` + fmt.Sprintf("%v", this.SSHPrivateKey) + `,`,
-		`ConnectionState:` + strings.Replace(strings.Replace(this.ConnectionState.String(), "ConnectionState", "ConnectionState", 1), `&`, ``, 1) + `,`,
-		`InsecureIgnoreHostKey:` + fmt.Sprintf("%v", this.InsecureIgnoreHostKey) + `,`,
-		`Insecure:` + fmt.Sprintf("%v", this.Insecure) + `,`,
-		`EnableLFS:` + fmt.Sprintf("%v", this.EnableLFS) + `,`,
-		`TLSClientCertData:` + fmt.Sprintf("%v", this.TLSClientCertData) + `,`,
-		`TLSClientCertKey:` + fmt.Sprintf("%v", this.TLSClientCertKey) + `,`,
-		`TLSClientCAData:` + fmt.Sprintf("%v", this.TLSClientCAData) + `,`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RepositoryCertificate) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&RepositoryCertificate{`,
-		`ServerName:` + fmt.Sprintf("%v", this.ServerName) + `,`,
-		`CertType:` + fmt.Sprintf("%v", this.CertType) + `,`,
-		`CertSubType:` + fmt.Sprintf("%v", this.CertSubType) + `,`,
-		`CertData:` + valueToStringGenerated(this.CertData) + `,`,
-		`CertInfo:` + fmt.Sprintf("%v", this.CertInfo) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *ResourceActionDefinition) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ActionLua)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *RepositoryCertificateList) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&RepositoryCertificateList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(this.ListMeta.String(), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Items), "RepositoryCertificate", "RepositoryCertificate", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *ResourceActionParam) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Value)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Default)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *RepositoryList) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ResourceActions) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ActionDiscoveryLua)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Definitions) > 0 {
+		for _, e := range m.Definitions {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&RepositoryList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(this.ListMeta.String(), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + strings.Replace(fmt.Sprintf("%v", this.Items), "Repository", "Repository", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *ResourceAction) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ResourceDiff) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Group)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.TargetState)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.LiveState)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Diff)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	if m.SecretKeyChanges != nil {
+		l = m.SecretKeyChanges.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&ResourceAction{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Params:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Params), "ResourceActionParam", "ResourceActionParam", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	l = len(m.PredictedLiveState)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *ResourceActionDefinition) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ResourceHook) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Group)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Hook) > 0 {
+		for _, s := range m.Hook {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&ResourceActionDefinition{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`ActionLua:` + fmt.Sprintf("%v", this.ActionLua) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ResourceActionParam) String() string {
-	if this == nil {
-		return "nil"
+	if m.SyncWave != nil {
+		n += 1 + sovGenerated(uint64(*m.SyncWave))
 	}
-	s := strings.Join([]string{`&ResourceActionParam{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`Default:` + fmt.Sprintf("%v", this.Default) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ResourceActions) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.DeletePolicy) > 0 {
+		for _, s := range m.DeletePolicy {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&ResourceActions{`,
-		`ActionDiscoveryLua:` + fmt.Sprintf("%v", this.ActionDiscoveryLua) + `,`,
-		`Definitions:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Definitions), "ResourceActionDefinition", "ResourceActionDefinition", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *ResourceDiff) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ResourceIgnoreDifferences) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Group)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.JSONPointers) > 0 {
+		for _, s := range m.JSONPointers {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&ResourceDiff{`,
-		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
-		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
-		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`TargetState:` + fmt.Sprintf("%v", this.TargetState) + `,`,
-		`LiveState:` + fmt.Sprintf("%v", this.LiveState) + `,`,
-		`Diff:` + fmt.Sprintf("%v", this.Diff) + `,`,
-		`Hook:` + fmt.Sprintf("%v", this.Hook) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *ResourceIgnoreDifferences) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ResourceIgnoreDifferences{`,
-		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
-		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
-		`JSONPointers:` + fmt.Sprintf("%v", this.JSONPointers) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *ResourceLink) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Title)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *ResourceNetworkingInfo) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ResourceNetworkingInfo) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.TargetLabels) > 0 {
+		for k, v := range m.TargetLabels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
 	}
-	keysForTargetLabels := make([]string, 0, len(this.TargetLabels))
-	for k := range this.TargetLabels {
-		keysForTargetLabels = append(keysForTargetLabels, k)
+	if len(m.TargetRefs) > 0 {
+		for _, e := range m.TargetRefs {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForTargetLabels)
-	mapStringForTargetLabels := "map[string]string{"
-	for _, k := range keysForTargetLabels {
-		mapStringForTargetLabels += fmt.Sprintf("%v: %v,", k, this.TargetLabels[k])
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
 	}
-	mapStringForTargetLabels += "}"
-	keysForLabels := make([]string, 0, len(this.Labels))
-	for k := range this.Labels {
-		keysForLabels = append(keysForLabels, k)
+	if len(m.Ingress) > 0 {
+		for _, e := range m.Ingress {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
-	mapStringForLabels := "map[string]string{"
-	for _, k := range keysForLabels {
-		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	if len(m.ExternalURLs) > 0 {
+		for _, s := range m.ExternalURLs {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	mapStringForLabels += "}"
-	s := strings.Join([]string{`&ResourceNetworkingInfo{`,
-		`TargetLabels:` + mapStringForTargetLabels + `,`,
-		`TargetRefs:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.TargetRefs), "ResourceRef", "ResourceRef", 1), `&`, ``, 1) + `,`,
-		`Labels:` + mapStringForLabels + `,`,
-		`Ingress:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Ingress), "LoadBalancerIngress", "v11.LoadBalancerIngress", 1), `&`, ``, 1) + `,`,
-		`ExternalURLs:` + fmt.Sprintf("%v", this.ExternalURLs) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *ResourceNode) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ResourceNode) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ResourceRef.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.ParentRefs) > 0 {
+		for _, e := range m.ParentRefs {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&ResourceNode{`,
-		`ResourceRef:` + strings.Replace(strings.Replace(this.ResourceRef.String(), "ResourceRef", "ResourceRef", 1), `&`, ``, 1) + `,`,
-		`ParentRefs:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ParentRefs), "ResourceRef", "ResourceRef", 1), `&`, ``, 1) + `,`,
-		`Info:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Info), "InfoItem", "InfoItem", 1), `&`, ``, 1) + `,`,
-		`NetworkingInfo:` + strings.Replace(fmt.Sprintf("%v", this.NetworkingInfo), "ResourceNetworkingInfo", "ResourceNetworkingInfo", 1) + `,`,
-		`ResourceVersion:` + fmt.Sprintf("%v", this.ResourceVersion) + `,`,
-		`Images:` + fmt.Sprintf("%v", this.Images) + `,`,
-		`Health:` + strings.Replace(fmt.Sprintf("%v", this.Health), "HealthStatus", "HealthStatus", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ResourceOverride) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Info) > 0 {
+		for _, e := range m.Info {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&ResourceOverride{`,
-		`HealthLua:` + fmt.Sprintf("%v", this.HealthLua) + `,`,
-		`IgnoreDifferences:` + fmt.Sprintf("%v", this.IgnoreDifferences) + `,`,
-		`Actions:` + fmt.Sprintf("%v", this.Actions) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ResourceRef) String() string {
-	if this == nil {
-		return "nil"
+	if m.NetworkingInfo != nil {
+		l = m.NetworkingInfo.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&ResourceRef{`,
-		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
-		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
-		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
-		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`UID:` + fmt.Sprintf("%v", this.UID) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ResourceResult) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.ResourceVersion)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Images) > 0 {
+		for _, s := range m.Images {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&ResourceResult{`,
-		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
-		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
-		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
-		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`HookType:` + fmt.Sprintf("%v", this.HookType) + `,`,
-		`HookPhase:` + fmt.Sprintf("%v", this.HookPhase) + `,`,
-		`SyncPhase:` + fmt.Sprintf("%v", this.SyncPhase) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ResourceStatus) String() string {
-	if this == nil {
-		return "nil"
+	if m.Health != nil {
+		l = m.Health.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&ResourceStatus{`,
-		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
-		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
-		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
-		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
-		`Health:` + strings.Replace(fmt.Sprintf("%v", this.Health), "HealthStatus", "HealthStatus", 1) + `,`,
-		`Hook:` + fmt.Sprintf("%v", this.Hook) + `,`,
-		`RequiresPruning:` + fmt.Sprintf("%v", this.RequiresPruning) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RevisionHistory) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Links) > 0 {
+		for _, e := range m.Links {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&RevisionHistory{`,
-		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
-		`DeployedAt:` + strings.Replace(strings.Replace(this.DeployedAt.String(), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
-		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
-		`Source:` + strings.Replace(strings.Replace(this.Source.String(), "ApplicationSource", "ApplicationSource", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *RevisionMetadata) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&RevisionMetadata{`,
-		`Author:` + fmt.Sprintf("%v", this.Author) + `,`,
-		`Date:` + strings.Replace(strings.Replace(this.Date.String(), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
-		`Tags:` + fmt.Sprintf("%v", this.Tags) + `,`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *ResourceOverride) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.HealthLua)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.IgnoreDifferences)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Actions)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.HealthProgressingTimeout)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.HealthCompleted)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	return n
 }
-func (this *SyncOperation) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&SyncOperation{`,
-		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
-		`Prune:` + fmt.Sprintf("%v", this.Prune) + `,`,
-		`DryRun:` + fmt.Sprintf("%v", this.DryRun) + `,`,
-		`SyncStrategy:` + strings.Replace(fmt.Sprintf("%v", this.SyncStrategy), "SyncStrategy", "SyncStrategy", 1) + `,`,
-		`Resources:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Resources), "SyncOperationResource", "SyncOperationResource", 1), `&`, ``, 1) + `,`,
-		`Source:` + strings.Replace(fmt.Sprintf("%v", this.Source), "ApplicationSource", "ApplicationSource", 1) + `,`,
-		`Manifests:` + fmt.Sprintf("%v", this.Manifests) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *ResourceRef) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Group)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Version)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.UID)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *SyncOperationResource) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&SyncOperationResource{`,
-		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
-		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *ResourceResult) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Group)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Version)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Status)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.HookType)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.HookPhase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.SyncPhase)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *SyncOperationResult) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ResourceStatus) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Group)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Version)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Status)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Health != nil {
+		l = m.Health.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&SyncOperationResult{`,
-		`Resources:` + strings.Replace(fmt.Sprintf("%v", this.Resources), "ResourceResult", "ResourceResult", 1) + `,`,
-		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
-		`Source:` + strings.Replace(strings.Replace(this.Source.String(), "ApplicationSource", "ApplicationSource", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *SyncPolicy) String() string {
-	if this == nil {
-		return "nil"
+	n += 2
+	n += 2
+	if m.OutOfSyncSince != nil {
+		l = m.OutOfSyncSince.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&SyncPolicy{`,
-		`Automated:` + strings.Replace(fmt.Sprintf("%v", this.Automated), "SyncPolicyAutomated", "SyncPolicyAutomated", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	if m.ProgressingSince != nil {
+		l = m.ProgressingSince.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
 }
-func (this *SyncPolicyAutomated) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RevisionChangelog) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Revision)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.TargetRevision)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Commits) > 0 {
+		for _, e := range m.Commits {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&SyncPolicyAutomated{`,
-		`Prune:` + fmt.Sprintf("%v", this.Prune) + `,`,
-		`SelfHeal:` + fmt.Sprintf("%v", this.SelfHeal) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *SyncStatus) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RevisionHistory) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Revision)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.DeployedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.ID))
+	l = m.Source.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.InitiatedBy.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.ImageUpdate != nil {
+		l = m.ImageUpdate.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&SyncStatus{`,
-		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
-		`ComparedTo:` + strings.Replace(strings.Replace(this.ComparedTo.String(), "ComparedTo", "ComparedTo", 1), `&`, ``, 1) + `,`,
-		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
-		`}`,
-	}, "")
-	return s
+	if m.AutomatedRollback != nil {
+		l = m.AutomatedRollback.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 2
+	return n
 }
-func (this *SyncStrategy) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RevisionMetadata) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Author)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Date.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&SyncStrategy{`,
-		`Apply:` + strings.Replace(fmt.Sprintf("%v", this.Apply), "SyncStrategyApply", "SyncStrategyApply", 1) + `,`,
-		`Hook:` + strings.Replace(fmt.Sprintf("%v", this.Hook), "SyncStrategyHook", "SyncStrategyHook", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.ChangedFiles) > 0 {
+		for _, s := range m.ChangedFiles {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
 }
-func (this *SyncStrategyApply) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SecretKeyChanges) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Added) > 0 {
+		for _, s := range m.Added {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Removed) > 0 {
+		for _, s := range m.Removed {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Modified) > 0 {
+		for _, s := range m.Modified {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SyncAnalysis) Size() (n int) {
+	var l int
+	_ = l
+	if m.Webhook != nil {
+		l = m.Webhook.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *SyncAnalysisJob) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Image)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Command) > 0 {
+		for _, s := range m.Command {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Args) > 0 {
+		for _, s := range m.Args {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Env) > 0 {
+		for _, e := range m.Env {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.ServiceAccountName)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.BackoffLimit))
+	n += 1 + sovGenerated(uint64(m.TimeoutSeconds))
+	return n
+}
+
+func (m *SyncAnalysisResult) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *SyncAnalysisWebhook) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.TimeoutSeconds))
+	return n
+}
+
+func (m *SyncOperation) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Revision)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	n += 2
+	if m.SyncStrategy != nil {
+		l = m.SyncStrategy.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Resources) > 0 {
+		for _, e := range m.Resources {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.Source != nil {
+		l = m.Source.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Manifests) > 0 {
+		for _, s := range m.Manifests {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 2
+	if m.ImageUpdate != nil {
+		l = m.ImageUpdate.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.AutomatedRollback != nil {
+		l = m.AutomatedRollback.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 2
+	n += 2
+	return n
+}
+
+func (m *SyncOperationResource) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Group)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *SyncOperationResult) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Resources) > 0 {
+		for _, e := range m.Resources {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.Revision)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Source.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Analysis != nil {
+		l = m.Analysis.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *SyncPolicy) Size() (n int) {
+	var l int
+	_ = l
+	if m.Automated != nil {
+		l = m.Automated.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Verify != nil {
+		l = m.Verify.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *SyncPolicyAutomated) Size() (n int) {
+	var l int
+	_ = l
+	n += 2
+	n += 2
+	return n
+}
+
+func (m *SyncPolicyVerify) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.TimeoutSeconds))
+	n += 2
+	return n
+}
+
+func (m *SyncReport) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.ID))
+	l = len(m.Revision)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Source.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	l = m.InitiatedBy.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.StartedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.FinishedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.DurationSeconds))
+	if len(m.Applied) > 0 {
+		for _, e := range m.Applied {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Hooks) > 0 {
+		for _, e := range m.Hooks {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Pruned) > 0 {
+		for _, e := range m.Pruned {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Warnings) > 0 {
+		for _, s := range m.Warnings {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SyncStatus) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Status)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.ComparedTo.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Revision)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *SyncStrategy) Size() (n int) {
+	var l int
+	_ = l
+	if m.Apply != nil {
+		l = m.Apply.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Hook != nil {
+		l = m.Hook.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *SyncStrategyApply) Size() (n int) {
+	var l int
+	_ = l
+	n += 2
+	return n
+}
+
+func (m *SyncStrategyHook) Size() (n int) {
+	var l int
+	_ = l
+	l = m.SyncStrategyApply.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *SyncVerification) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Revision)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.SyncedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Deadline.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	return n
+}
+
+func (m *TLSClientConfig) Size() (n int) {
+	var l int
+	_ = l
+	n += 2
+	l = len(m.ServerName)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.CertData != nil {
+		l = len(m.CertData)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.KeyData != nil {
+		l = len(m.KeyData)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.CAData != nil {
+		l = len(m.CAData)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func sovGenerated(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozGenerated(x uint64) (n int) {
+	return sovGenerated(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *AWSAuthConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AWSAuthConfig{`,
+		`ClusterName:` + fmt.Sprintf("%v", this.ClusterName) + `,`,
+		`RoleARN:` + fmt.Sprintf("%v", this.RoleARN) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AppProject) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AppProject{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(this.ObjectMeta.String(), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "AppProjectSpec", "AppProjectSpec", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AppProjectList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AppProjectList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(this.ListMeta.String(), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Items), "AppProject", "AppProject", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AppProjectSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AppProjectSpec{`,
+		`SourceRepos:` + fmt.Sprintf("%v", this.SourceRepos) + `,`,
+		`Destinations:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Destinations), "ApplicationDestination", "ApplicationDestination", 1), `&`, ``, 1) + `,`,
+		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
+		`Roles:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Roles), "ProjectRole", "ProjectRole", 1), `&`, ``, 1) + `,`,
+		`ClusterResourceWhitelist:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ClusterResourceWhitelist), "GroupKind", "v1.GroupKind", 1), `&`, ``, 1) + `,`,
+		`NamespaceResourceBlacklist:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.NamespaceResourceBlacklist), "GroupKind", "v1.GroupKind", 1), `&`, ``, 1) + `,`,
+		`OrphanedResources:` + strings.Replace(fmt.Sprintf("%v", this.OrphanedResources), "OrphanedResourcesMonitorSettings", "OrphanedResourcesMonitorSettings", 1) + `,`,
+		`SyncPolicy:` + strings.Replace(fmt.Sprintf("%v", this.SyncPolicy), "SyncPolicy", "SyncPolicy", 1) + `,`,
+		`SourceDecryptionKeys:` + fmt.Sprintf("%v", this.SourceDecryptionKeys) + `,`,
+		`FailOnSharedResource:` + fmt.Sprintf("%v", this.FailOnSharedResource) + `,`,
+		`ManifestQuota:` + strings.Replace(fmt.Sprintf("%v", this.ManifestQuota), "ManifestQuota", "ManifestQuota", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Application) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Application{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(this.ObjectMeta.String(), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ApplicationSpec", "ApplicationSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "ApplicationStatus", "ApplicationStatus", 1), `&`, ``, 1) + `,`,
+		`Operation:` + strings.Replace(fmt.Sprintf("%v", this.Operation), "Operation", "Operation", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationCondition) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationCondition{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationDestination) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationDestination{`,
+		`Server:` + fmt.Sprintf("%v", this.Server) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(this.ListMeta.String(), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Items), "Application", "Application", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationSource) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationSource{`,
+		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`TargetRevision:` + fmt.Sprintf("%v", this.TargetRevision) + `,`,
+		`Helm:` + strings.Replace(fmt.Sprintf("%v", this.Helm), "ApplicationSourceHelm", "ApplicationSourceHelm", 1) + `,`,
+		`Kustomize:` + strings.Replace(fmt.Sprintf("%v", this.Kustomize), "ApplicationSourceKustomize", "ApplicationSourceKustomize", 1) + `,`,
+		`Ksonnet:` + strings.Replace(fmt.Sprintf("%v", this.Ksonnet), "ApplicationSourceKsonnet", "ApplicationSourceKsonnet", 1) + `,`,
+		`Directory:` + strings.Replace(fmt.Sprintf("%v", this.Directory), "ApplicationSourceDirectory", "ApplicationSourceDirectory", 1) + `,`,
+		`Plugin:` + strings.Replace(fmt.Sprintf("%v", this.Plugin), "ApplicationSourcePlugin", "ApplicationSourcePlugin", 1) + `,`,
+		`Patches:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Patches), "ApplicationSourcePatch", "ApplicationSourcePatch", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationSourceDirectory) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationSourceDirectory{`,
+		`Recurse:` + fmt.Sprintf("%v", this.Recurse) + `,`,
+		`Jsonnet:` + strings.Replace(strings.Replace(this.Jsonnet.String(), "ApplicationSourceJsonnet", "ApplicationSourceJsonnet", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationSourceHelm) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationSourceHelm{`,
+		`ValueFiles:` + fmt.Sprintf("%v", this.ValueFiles) + `,`,
+		`Parameters:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Parameters), "HelmParameter", "HelmParameter", 1), `&`, ``, 1) + `,`,
+		`ReleaseName:` + fmt.Sprintf("%v", this.ReleaseName) + `,`,
+		`Values:` + fmt.Sprintf("%v", this.Values) + `,`,
+		`IncludeCRDs:` + fmt.Sprintf("%v", this.IncludeCRDs) + `,`,
+		`FileParameters:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FileParameters), "HelmFileParameter", "HelmFileParameter", 1), `&`, ``, 1) + `,`,
+		`AllowValueFilesOutsideChart:` + fmt.Sprintf("%v", this.AllowValueFilesOutsideChart) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationSourceJsonnet) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationSourceJsonnet{`,
+		`ExtVars:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ExtVars), "JsonnetVar", "JsonnetVar", 1), `&`, ``, 1) + `,`,
+		`TLAs:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.TLAs), "JsonnetVar", "JsonnetVar", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationSourceKsonnet) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationSourceKsonnet{`,
+		`Environment:` + fmt.Sprintf("%v", this.Environment) + `,`,
+		`Parameters:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Parameters), "KsonnetParameter", "KsonnetParameter", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationSourceKustomize) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForCommonLabels := make([]string, 0, len(this.CommonLabels))
+	for k := range this.CommonLabels {
+		keysForCommonLabels = append(keysForCommonLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForCommonLabels)
+	mapStringForCommonLabels := "map[string]string{"
+	for _, k := range keysForCommonLabels {
+		mapStringForCommonLabels += fmt.Sprintf("%v: %v,", k, this.CommonLabels[k])
+	}
+	mapStringForCommonLabels += "}"
+	s := strings.Join([]string{`&ApplicationSourceKustomize{`,
+		`NamePrefix:` + fmt.Sprintf("%v", this.NamePrefix) + `,`,
+		`Images:` + fmt.Sprintf("%v", this.Images) + `,`,
+		`CommonLabels:` + mapStringForCommonLabels + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationSourcePatch) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationSourcePatch{`,
+		`Target:` + strings.Replace(strings.Replace(this.Target.String(), "ApplicationSourcePatchTarget", "ApplicationSourcePatchTarget", 1), `&`, ``, 1) + `,`,
+		`Patch:` + fmt.Sprintf("%v", this.Patch) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationSourcePatchTarget) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationSourcePatchTarget{`,
+		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationSourcePlugin) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationSourcePlugin{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Env:` + strings.Replace(fmt.Sprintf("%v", this.Env), "EnvEntry", "EnvEntry", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationSpec{`,
+		`Source:` + strings.Replace(strings.Replace(this.Source.String(), "ApplicationSource", "ApplicationSource", 1), `&`, ``, 1) + `,`,
+		`Destination:` + strings.Replace(strings.Replace(this.Destination.String(), "ApplicationDestination", "ApplicationDestination", 1), `&`, ``, 1) + `,`,
+		`Project:` + fmt.Sprintf("%v", this.Project) + `,`,
+		`SyncPolicy:` + strings.Replace(fmt.Sprintf("%v", this.SyncPolicy), "SyncPolicy", "SyncPolicy", 1) + `,`,
+		`IgnoreDifferences:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.IgnoreDifferences), "ResourceIgnoreDifferences", "ResourceIgnoreDifferences", 1), `&`, ``, 1) + `,`,
+		`Info:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Info), "Info", "Info", 1), `&`, ``, 1) + `,`,
+		`WriteBack:` + strings.Replace(fmt.Sprintf("%v", this.WriteBack), "ApplicationWriteBack", "ApplicationWriteBack", 1) + `,`,
+		`RevisionHistoryLimit:` + valueToStringGenerated(this.RevisionHistoryLimit) + `,`,
+		`Suspend:` + strings.Replace(fmt.Sprintf("%v", this.Suspend), "ApplicationSuspend", "ApplicationSuspend", 1) + `,`,
+		`ResourceHooks:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ResourceHooks), "ResourceHook", "ResourceHook", 1), `&`, ``, 1) + `,`,
+		`Analysis:` + strings.Replace(fmt.Sprintf("%v", this.Analysis), "SyncAnalysis", "SyncAnalysis", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationStatus{`,
+		`Resources:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Resources), "ResourceStatus", "ResourceStatus", 1), `&`, ``, 1) + `,`,
+		`Sync:` + strings.Replace(strings.Replace(this.Sync.String(), "SyncStatus", "SyncStatus", 1), `&`, ``, 1) + `,`,
+		`Health:` + strings.Replace(strings.Replace(this.Health.String(), "HealthStatus", "HealthStatus", 1), `&`, ``, 1) + `,`,
+		`History:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.History), "RevisionHistory", "RevisionHistory", 1), `&`, ``, 1) + `,`,
+		`Conditions:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Conditions), "ApplicationCondition", "ApplicationCondition", 1), `&`, ``, 1) + `,`,
+		`ReconciledAt:` + strings.Replace(fmt.Sprintf("%v", this.ReconciledAt), "Time", "v1.Time", 1) + `,`,
+		`OperationState:` + strings.Replace(fmt.Sprintf("%v", this.OperationState), "OperationState", "OperationState", 1) + `,`,
+		`ObservedAt:` + strings.Replace(fmt.Sprintf("%v", this.ObservedAt), "Time", "v1.Time", 1) + `,`,
+		`SourceType:` + fmt.Sprintf("%v", this.SourceType) + `,`,
+		`Summary:` + strings.Replace(strings.Replace(this.Summary.String(), "ApplicationSummary", "ApplicationSummary", 1), `&`, ``, 1) + `,`,
+		`Verification:` + strings.Replace(fmt.Sprintf("%v", this.Verification), "SyncVerification", "SyncVerification", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationSummary) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationSummary{`,
+		`ExternalURLs:` + fmt.Sprintf("%v", this.ExternalURLs) + `,`,
+		`Images:` + fmt.Sprintf("%v", this.Images) + `,`,
+		`RenderedSources:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.RenderedSources), "RenderedSource", "RenderedSource", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationSuspend) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationSuspend{`,
+		`Until:` + strings.Replace(fmt.Sprintf("%v", this.Until), "Time", "v1.Time", 1) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationTree) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationTree{`,
+		`Nodes:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Nodes), "ResourceNode", "ResourceNode", 1), `&`, ``, 1) + `,`,
+		`OrphanedNodes:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.OrphanedNodes), "ResourceNode", "ResourceNode", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationWatchEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationWatchEvent{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Application:` + strings.Replace(strings.Replace(this.Application.String(), "Application", "Application", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationWriteBack) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationWriteBack{`,
+		`Branch:` + fmt.Sprintf("%v", this.Branch) + `,`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`PullRequest:` + fmt.Sprintf("%v", this.PullRequest) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AutomatedRollback) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AutomatedRollback{`,
+		`FromRevision:` + fmt.Sprintf("%v", this.FromRevision) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Cluster) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
+	keysForAnnotations := make([]string, 0, len(this.Annotations))
+	for k := range this.Annotations {
+		keysForAnnotations = append(keysForAnnotations, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
+	mapStringForAnnotations := "map[string]string{"
+	for _, k := range keysForAnnotations {
+		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	}
+	mapStringForAnnotations += "}"
+	s := strings.Join([]string{`&Cluster{`,
+		`Server:` + fmt.Sprintf("%v", this.Server) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Config:` + strings.Replace(strings.Replace(this.Config.String(), "ClusterConfig", "ClusterConfig", 1), `&`, ``, 1) + `,`,
+		`ConnectionState:` + strings.Replace(strings.Replace(this.ConnectionState.String(), "ConnectionState", "ConnectionState", 1), `&`, ``, 1) + `,`,
+		`ServerVersion:` + fmt.Sprintf("%v", this.ServerVersion) + `,`,
+		`Namespaces:` + fmt.Sprintf("%v", this.Namespaces) + `,`,
+		`Labels:` + mapStringForLabels + `,`,
+		`Annotations:` + mapStringForAnnotations + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClusterConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClusterConfig{`,
+		`Username:` + fmt.Sprintf("%v", this.Username) + `,`,
+		`Password:` + fmt.Sprintf("%v", this.Password) + `,`,
+		`BearerToken:` + fmt.Sprintf("%v", this.BearerToken) + `,`,
+		`TLSClientConfig:` + strings.Replace(strings.Replace(this.TLSClientConfig.String(), "TLSClientConfig", "TLSClientConfig", 1), `&`, ``, 1) + `,`,
+		`AWSAuthConfig:` + strings.Replace(fmt.Sprintf("%v", this.AWSAuthConfig), "AWSAuthConfig", "AWSAuthConfig", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClusterList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClusterList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(this.ListMeta.String(), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Items), "Cluster", "Cluster", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Command) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Command{`,
+		`Command:` + fmt.Sprintf("%v", this.Command) + `,`,
+		`Args:` + fmt.Sprintf("%v", this.Args) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CommitMetadata) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CommitMetadata{`,
+		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
+		`Author:` + fmt.Sprintf("%v", this.Author) + `,`,
+		`Date:` + strings.Replace(strings.Replace(this.Date.String(), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ComparedTo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ComparedTo{`,
+		`Source:` + strings.Replace(strings.Replace(this.Source.String(), "ApplicationSource", "ApplicationSource", 1), `&`, ``, 1) + `,`,
+		`Destination:` + strings.Replace(strings.Replace(this.Destination.String(), "ApplicationDestination", "ApplicationDestination", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ComponentParameter) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ComponentParameter{`,
+		`Component:` + fmt.Sprintf("%v", this.Component) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ConfigManagementPlugin) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ConfigManagementPlugin{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Init:` + strings.Replace(fmt.Sprintf("%v", this.Init), "Command", "Command", 1) + `,`,
+		`Generate:` + strings.Replace(strings.Replace(this.Generate.String(), "Command", "Command", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ConnectionState) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ConnectionState{`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`ModifiedAt:` + strings.Replace(fmt.Sprintf("%v", this.ModifiedAt), "Time", "v1.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EnvEntry) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EnvEntry{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HealthStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HealthStatus{`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HelmFileParameter) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HelmFileParameter{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HelmParameter) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HelmParameter{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`ForceString:` + fmt.Sprintf("%v", this.ForceString) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImageUpdate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImageUpdate{`,
+		`HelmParameters:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.HelmParameters), "HelmParameter", "HelmParameter", 1), `&`, ``, 1) + `,`,
+		`KustomizeImages:` + fmt.Sprintf("%v", this.KustomizeImages) + `,`,
+		`WriteBack:` + fmt.Sprintf("%v", this.WriteBack) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Info) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Info{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *InfoItem) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&InfoItem{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JWTToken) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JWTToken{`,
+		`IssuedAt:` + fmt.Sprintf("%v", this.IssuedAt) + `,`,
+		`ExpiresAt:` + fmt.Sprintf("%v", this.ExpiresAt) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JsonnetVar) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JsonnetVar{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`Code:` + fmt.Sprintf("%v", this.Code) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KsonnetParameter) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KsonnetParameter{`,
+		`Component:` + fmt.Sprintf("%v", this.Component) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KustomizeOptions) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KustomizeOptions{`,
+		`BuildOptions:` + fmt.Sprintf("%v", this.BuildOptions) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ManifestQuota) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ManifestQuota{`,
+		`MaxResources:` + fmt.Sprintf("%v", this.MaxResources) + `,`,
+		`MaxSize:` + fmt.Sprintf("%v", this.MaxSize) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Operation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Operation{`,
+		`Sync:` + strings.Replace(fmt.Sprintf("%v", this.Sync), "SyncOperation", "SyncOperation", 1) + `,`,
+		`InitiatedBy:` + strings.Replace(strings.Replace(this.InitiatedBy.String(), "OperationInitiator", "OperationInitiator", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OperationInitiator) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OperationInitiator{`,
+		`Username:` + fmt.Sprintf("%v", this.Username) + `,`,
+		`Automated:` + fmt.Sprintf("%v", this.Automated) + `,`,
+		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OperationState) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OperationState{`,
+		`Operation:` + strings.Replace(strings.Replace(this.Operation.String(), "Operation", "Operation", 1), `&`, ``, 1) + `,`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`SyncResult:` + strings.Replace(fmt.Sprintf("%v", this.SyncResult), "SyncOperationResult", "SyncOperationResult", 1) + `,`,
+		`StartedAt:` + strings.Replace(strings.Replace(this.StartedAt.String(), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`FinishedAt:` + strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v1.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OrphanedResourcesMonitorSettings) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OrphanedResourcesMonitorSettings{`,
+		`Warn:` + valueToStringGenerated(this.Warn) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProjectRole) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProjectRole{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
+		`Policies:` + fmt.Sprintf("%v", this.Policies) + `,`,
+		`JWTTokens:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.JWTTokens), "JWTToken", "JWTToken", 1), `&`, ``, 1) + `,`,
+		`Groups:` + fmt.Sprintf("%v", this.Groups) + `,`,
+		`Quota:` + strings.Replace(fmt.Sprintf("%v", this.Quota), "ProjectRoleQuota", "ProjectRoleQuota", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProjectRoleQuota) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProjectRoleQuota{`,
+		`RequestsPerHour:` + fmt.Sprintf("%v", this.RequestsPerHour) + `,`,
+		`SyncsPerHour:` + fmt.Sprintf("%v", this.SyncsPerHour) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProjectTemplate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProjectTemplate{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
+		`Parameters:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Parameters), "ProjectTemplateParameter", "ProjectTemplateParameter", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "AppProjectSpec", "AppProjectSpec", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProjectTemplateParameter) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProjectTemplateParameter{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
+		`Default:` + fmt.Sprintf("%v", this.Default) + `,`,
+		`Required:` + fmt.Sprintf("%v", this.Required) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RenderedSource) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RenderedSource{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`AppVersion:` + fmt.Sprintf("%v", this.AppVersion) + `,`,
+		`Dependency:` + fmt.Sprintf("%v", this.Dependency) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Repository) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Repository{`,
+		`Repo:` + fmt.Sprintf("%v", this.Repo) + `,`,
+		`Username:` + fmt.Sprintf("%v", this.Username) + `,`,
+		`Password:` + fmt.Sprintf("%v", this.Password) + `,`,
+		`SSHPrivateKey:` + fmt.Sprintf("%v", this.SSHPrivateKey) + `,`,
+		`ConnectionState:` + strings.Replace(strings.Replace(this.ConnectionState.String(), "ConnectionState", "ConnectionState", 1), `&`, ``, 1) + `,`,
+		`InsecureIgnoreHostKey:` + fmt.Sprintf("%v", this.InsecureIgnoreHostKey) + `,`,
+		`Insecure:` + fmt.Sprintf("%v", this.Insecure) + `,`,
+		`EnableLFS:` + fmt.Sprintf("%v", this.EnableLFS) + `,`,
+		`TLSClientCertData:` + fmt.Sprintf("%v", this.TLSClientCertData) + `,`,
+		`TLSClientCertKey:` + fmt.Sprintf("%v", this.TLSClientCertKey) + `,`,
+		`TLSClientCAData:` + fmt.Sprintf("%v", this.TLSClientCAData) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`WebhookID:` + fmt.Sprintf("%v", this.WebhookID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RepositoryCertificate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RepositoryCertificate{`,
+		`ServerName:` + fmt.Sprintf("%v", this.ServerName) + `,`,
+		`CertType:` + fmt.Sprintf("%v", this.CertType) + `,`,
+		`CertSubType:` + fmt.Sprintf("%v", this.CertSubType) + `,`,
+		`CertData:` + valueToStringGenerated(this.CertData) + `,`,
+		`CertInfo:` + fmt.Sprintf("%v", this.CertInfo) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RepositoryCertificateList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RepositoryCertificateList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(this.ListMeta.String(), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Items), "RepositoryCertificate", "RepositoryCertificate", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RepositoryFailure) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RepositoryFailure{`,
+		`Repo:` + fmt.Sprintf("%v", this.Repo) + `,`,
+		`RequestType:` + fmt.Sprintf("%v", this.RequestType) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`AuthFailure:` + fmt.Sprintf("%v", this.AuthFailure) + `,`,
+		`Failures:` + fmt.Sprintf("%v", this.Failures) + `,`,
+		`FailedAt:` + strings.Replace(strings.Replace(this.FailedAt.String(), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`LastSucceededAt:` + strings.Replace(fmt.Sprintf("%v", this.LastSucceededAt), "Time", "v1.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RepositoryFailureList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RepositoryFailureList{`,
+		`Items:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Items), "RepositoryFailure", "RepositoryFailure", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RepositoryList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RepositoryList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(this.ListMeta.String(), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + strings.Replace(fmt.Sprintf("%v", this.Items), "Repository", "Repository", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResourceAction) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResourceAction{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Params:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Params), "ResourceActionParam", "ResourceActionParam", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResourceActionDefinition) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResourceActionDefinition{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`ActionLua:` + fmt.Sprintf("%v", this.ActionLua) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResourceActionParam) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResourceActionParam{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Default:` + fmt.Sprintf("%v", this.Default) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResourceActions) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResourceActions{`,
+		`ActionDiscoveryLua:` + fmt.Sprintf("%v", this.ActionDiscoveryLua) + `,`,
+		`Definitions:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Definitions), "ResourceActionDefinition", "ResourceActionDefinition", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResourceDiff) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResourceDiff{`,
+		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`TargetState:` + fmt.Sprintf("%v", this.TargetState) + `,`,
+		`LiveState:` + fmt.Sprintf("%v", this.LiveState) + `,`,
+		`Diff:` + fmt.Sprintf("%v", this.Diff) + `,`,
+		`Hook:` + fmt.Sprintf("%v", this.Hook) + `,`,
+		`SecretKeyChanges:` + strings.Replace(fmt.Sprintf("%v", this.SecretKeyChanges), "SecretKeyChanges", "SecretKeyChanges", 1) + `,`,
+		`PredictedLiveState:` + fmt.Sprintf("%v", this.PredictedLiveState) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResourceHook) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResourceHook{`,
+		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Hook:` + fmt.Sprintf("%v", this.Hook) + `,`,
+		`SyncWave:` + valueToStringGenerated(this.SyncWave) + `,`,
+		`DeletePolicy:` + fmt.Sprintf("%v", this.DeletePolicy) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResourceIgnoreDifferences) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResourceIgnoreDifferences{`,
+		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`JSONPointers:` + fmt.Sprintf("%v", this.JSONPointers) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResourceLink) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResourceLink{`,
+		`Title:` + fmt.Sprintf("%v", this.Title) + `,`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResourceNetworkingInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForTargetLabels := make([]string, 0, len(this.TargetLabels))
+	for k := range this.TargetLabels {
+		keysForTargetLabels = append(keysForTargetLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForTargetLabels)
+	mapStringForTargetLabels := "map[string]string{"
+	for _, k := range keysForTargetLabels {
+		mapStringForTargetLabels += fmt.Sprintf("%v: %v,", k, this.TargetLabels[k])
+	}
+	mapStringForTargetLabels += "}"
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
+	s := strings.Join([]string{`&ResourceNetworkingInfo{`,
+		`TargetLabels:` + mapStringForTargetLabels + `,`,
+		`TargetRefs:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.TargetRefs), "ResourceRef", "ResourceRef", 1), `&`, ``, 1) + `,`,
+		`Labels:` + mapStringForLabels + `,`,
+		`Ingress:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Ingress), "LoadBalancerIngress", "v11.LoadBalancerIngress", 1), `&`, ``, 1) + `,`,
+		`ExternalURLs:` + fmt.Sprintf("%v", this.ExternalURLs) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResourceNode) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResourceNode{`,
+		`ResourceRef:` + strings.Replace(strings.Replace(this.ResourceRef.String(), "ResourceRef", "ResourceRef", 1), `&`, ``, 1) + `,`,
+		`ParentRefs:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ParentRefs), "ResourceRef", "ResourceRef", 1), `&`, ``, 1) + `,`,
+		`Info:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Info), "InfoItem", "InfoItem", 1), `&`, ``, 1) + `,`,
+		`NetworkingInfo:` + strings.Replace(fmt.Sprintf("%v", this.NetworkingInfo), "ResourceNetworkingInfo", "ResourceNetworkingInfo", 1) + `,`,
+		`ResourceVersion:` + fmt.Sprintf("%v", this.ResourceVersion) + `,`,
+		`Images:` + fmt.Sprintf("%v", this.Images) + `,`,
+		`Health:` + strings.Replace(fmt.Sprintf("%v", this.Health), "HealthStatus", "HealthStatus", 1) + `,`,
+		`Links:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Links), "ResourceLink", "ResourceLink", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResourceOverride) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResourceOverride{`,
+		`HealthLua:` + fmt.Sprintf("%v", this.HealthLua) + `,`,
+		`IgnoreDifferences:` + fmt.Sprintf("%v", this.IgnoreDifferences) + `,`,
+		`Actions:` + fmt.Sprintf("%v", this.Actions) + `,`,
+		`HealthProgressingTimeout:` + fmt.Sprintf("%v", this.HealthProgressingTimeout) + `,`,
+		`HealthCompleted:` + fmt.Sprintf("%v", this.HealthCompleted) + `,`,
+		`HealthIncludeHooks:` + fmt.Sprintf("%v", this.HealthIncludeHooks) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResourceRef) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResourceRef{`,
+		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`UID:` + fmt.Sprintf("%v", this.UID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResourceResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResourceResult{`,
+		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`HookType:` + fmt.Sprintf("%v", this.HookType) + `,`,
+		`HookPhase:` + fmt.Sprintf("%v", this.HookPhase) + `,`,
+		`SyncPhase:` + fmt.Sprintf("%v", this.SyncPhase) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResourceStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResourceStatus{`,
+		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`Health:` + strings.Replace(fmt.Sprintf("%v", this.Health), "HealthStatus", "HealthStatus", 1) + `,`,
+		`Hook:` + fmt.Sprintf("%v", this.Hook) + `,`,
+		`RequiresPruning:` + fmt.Sprintf("%v", this.RequiresPruning) + `,`,
+		`OutOfSyncSince:` + strings.Replace(fmt.Sprintf("%v", this.OutOfSyncSince), "Time", "v1.Time", 1) + `,`,
+		`ProgressingSince:` + strings.Replace(fmt.Sprintf("%v", this.ProgressingSince), "Time", "v1.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RevisionChangelog) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RevisionChangelog{`,
+		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
+		`TargetRevision:` + fmt.Sprintf("%v", this.TargetRevision) + `,`,
+		`Commits:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Commits), "CommitMetadata", "CommitMetadata", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RevisionHistory) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RevisionHistory{`,
+		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
+		`DeployedAt:` + strings.Replace(strings.Replace(this.DeployedAt.String(), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Source:` + strings.Replace(strings.Replace(this.Source.String(), "ApplicationSource", "ApplicationSource", 1), `&`, ``, 1) + `,`,
+		`InitiatedBy:` + strings.Replace(strings.Replace(this.InitiatedBy.String(), "OperationInitiator", "OperationInitiator", 1), `&`, ``, 1) + `,`,
+		`ImageUpdate:` + strings.Replace(fmt.Sprintf("%v", this.ImageUpdate), "ImageUpdate", "ImageUpdate", 1) + `,`,
+		`AutomatedRollback:` + strings.Replace(fmt.Sprintf("%v", this.AutomatedRollback), "AutomatedRollback", "AutomatedRollback", 1) + `,`,
+		`Pinned:` + fmt.Sprintf("%v", this.Pinned) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RevisionMetadata) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RevisionMetadata{`,
+		`Author:` + fmt.Sprintf("%v", this.Author) + `,`,
+		`Date:` + strings.Replace(strings.Replace(this.Date.String(), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`Tags:` + fmt.Sprintf("%v", this.Tags) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`ChangedFiles:` + fmt.Sprintf("%v", this.ChangedFiles) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SecretKeyChanges) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SecretKeyChanges{`,
+		`Added:` + fmt.Sprintf("%v", this.Added) + `,`,
+		`Removed:` + fmt.Sprintf("%v", this.Removed) + `,`,
+		`Modified:` + fmt.Sprintf("%v", this.Modified) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncAnalysis) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncAnalysis{`,
+		`Webhook:` + strings.Replace(fmt.Sprintf("%v", this.Webhook), "SyncAnalysisWebhook", "SyncAnalysisWebhook", 1) + `,`,
+		`Job:` + strings.Replace(fmt.Sprintf("%v", this.Job), "SyncAnalysisJob", "SyncAnalysisJob", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncAnalysisJob) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncAnalysisJob{`,
+		`Image:` + fmt.Sprintf("%v", this.Image) + `,`,
+		`Command:` + fmt.Sprintf("%v", this.Command) + `,`,
+		`Args:` + fmt.Sprintf("%v", this.Args) + `,`,
+		`Env:` + strings.Replace(fmt.Sprintf("%v", this.Env), "EnvEntry", "EnvEntry", 1) + `,`,
+		`ServiceAccountName:` + fmt.Sprintf("%v", this.ServiceAccountName) + `,`,
+		`BackoffLimit:` + fmt.Sprintf("%v", this.BackoffLimit) + `,`,
+		`TimeoutSeconds:` + fmt.Sprintf("%v", this.TimeoutSeconds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncAnalysisResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncAnalysisResult{`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncAnalysisWebhook) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncAnalysisWebhook{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`TimeoutSeconds:` + fmt.Sprintf("%v", this.TimeoutSeconds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncOperation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncOperation{`,
+		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
+		`Prune:` + fmt.Sprintf("%v", this.Prune) + `,`,
+		`DryRun:` + fmt.Sprintf("%v", this.DryRun) + `,`,
+		`SyncStrategy:` + strings.Replace(fmt.Sprintf("%v", this.SyncStrategy), "SyncStrategy", "SyncStrategy", 1) + `,`,
+		`Resources:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Resources), "SyncOperationResource", "SyncOperationResource", 1), `&`, ``, 1) + `,`,
+		`Source:` + strings.Replace(fmt.Sprintf("%v", this.Source), "ApplicationSource", "ApplicationSource", 1) + `,`,
+		`Manifests:` + fmt.Sprintf("%v", this.Manifests) + `,`,
+		`Adopt:` + fmt.Sprintf("%v", this.Adopt) + `,`,
+		`ImageUpdate:` + strings.Replace(fmt.Sprintf("%v", this.ImageUpdate), "ImageUpdate", "ImageUpdate", 1) + `,`,
+		`AutomatedRollback:` + strings.Replace(fmt.Sprintf("%v", this.AutomatedRollback), "AutomatedRollback", "AutomatedRollback", 1) + `,`,
+		`Pinned:` + fmt.Sprintf("%v", this.Pinned) + `,`,
+		`PruneArgoCD:` + fmt.Sprintf("%v", this.PruneArgoCD) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncOperationResource) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncOperationResource{`,
+		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncOperationResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncOperationResult{`,
+		`Resources:` + strings.Replace(fmt.Sprintf("%v", this.Resources), "ResourceResult", "ResourceResult", 1) + `,`,
+		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
+		`Source:` + strings.Replace(strings.Replace(this.Source.String(), "ApplicationSource", "ApplicationSource", 1), `&`, ``, 1) + `,`,
+		`Analysis:` + strings.Replace(fmt.Sprintf("%v", this.Analysis), "SyncAnalysisResult", "SyncAnalysisResult", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncPolicy{`,
+		`Automated:` + strings.Replace(fmt.Sprintf("%v", this.Automated), "SyncPolicyAutomated", "SyncPolicyAutomated", 1) + `,`,
+		`Verify:` + strings.Replace(fmt.Sprintf("%v", this.Verify), "SyncPolicyVerify", "SyncPolicyVerify", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncPolicyAutomated) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncPolicyAutomated{`,
+		`Prune:` + fmt.Sprintf("%v", this.Prune) + `,`,
+		`SelfHeal:` + fmt.Sprintf("%v", this.SelfHeal) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncPolicyVerify) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncPolicyVerify{`,
+		`TimeoutSeconds:` + fmt.Sprintf("%v", this.TimeoutSeconds) + `,`,
+		`Rollback:` + fmt.Sprintf("%v", this.Rollback) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncReport) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncReport{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
+		`Source:` + strings.Replace(strings.Replace(this.Source.String(), "ApplicationSource", "ApplicationSource", 1), `&`, ``, 1) + `,`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`DryRun:` + fmt.Sprintf("%v", this.DryRun) + `,`,
+		`InitiatedBy:` + strings.Replace(strings.Replace(this.InitiatedBy.String(), "OperationInitiator", "OperationInitiator", 1), `&`, ``, 1) + `,`,
+		`StartedAt:` + strings.Replace(strings.Replace(this.StartedAt.String(), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`FinishedAt:` + strings.Replace(strings.Replace(this.FinishedAt.String(), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`DurationSeconds:` + fmt.Sprintf("%v", this.DurationSeconds) + `,`,
+		`Applied:` + strings.Replace(fmt.Sprintf("%v", this.Applied), "ResourceResult", "ResourceResult", 1) + `,`,
+		`Hooks:` + strings.Replace(fmt.Sprintf("%v", this.Hooks), "ResourceResult", "ResourceResult", 1) + `,`,
+		`Pruned:` + strings.Replace(fmt.Sprintf("%v", this.Pruned), "ResourceResult", "ResourceResult", 1) + `,`,
+		`Warnings:` + fmt.Sprintf("%v", this.Warnings) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncStatus{`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`ComparedTo:` + strings.Replace(strings.Replace(this.ComparedTo.String(), "ComparedTo", "ComparedTo", 1), `&`, ``, 1) + `,`,
+		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncStrategy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncStrategy{`,
+		`Apply:` + strings.Replace(fmt.Sprintf("%v", this.Apply), "SyncStrategyApply", "SyncStrategyApply", 1) + `,`,
+		`Hook:` + strings.Replace(fmt.Sprintf("%v", this.Hook), "SyncStrategyHook", "SyncStrategyHook", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncStrategyApply) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncStrategyApply{`,
+		`Force:` + fmt.Sprintf("%v", this.Force) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncStrategyHook) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncStrategyHook{`,
+		`SyncStrategyApply:` + strings.Replace(strings.Replace(this.SyncStrategyApply.String(), "SyncStrategyApply", "SyncStrategyApply", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncVerification) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncVerification{`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
+		`SyncedAt:` + strings.Replace(strings.Replace(this.SyncedAt.String(), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`Deadline:` + strings.Replace(strings.Replace(this.Deadline.String(), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`RolledBack:` + fmt.Sprintf("%v", this.RolledBack) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TLSClientConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TLSClientConfig{`,
+		`Insecure:` + fmt.Sprintf("%v", this.Insecure) + `,`,
+		`ServerName:` + fmt.Sprintf("%v", this.ServerName) + `,`,
+		`CertData:` + valueToStringGenerated(this.CertData) + `,`,
+		`KeyData:` + valueToStringGenerated(this.KeyData) + `,`,
+		`CAData:` + valueToStringGenerated(this.CAData) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringGenerated(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *AWSAuthConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AWSAuthConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AWSAuthConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleARN", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoleARN = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AppProject) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppProject: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppProject: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AppProjectList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppProjectList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppProjectList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, AppProject{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AppProjectSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppProjectSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppProjectSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceRepos", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceRepos = append(m.SourceRepos, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destinations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destinations = append(m.Destinations, ApplicationDestination{})
+			if err := m.Destinations[len(m.Destinations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, ProjectRole{})
+			if err := m.Roles[len(m.Roles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterResourceWhitelist", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterResourceWhitelist = append(m.ClusterResourceWhitelist, v1.GroupKind{})
+			if err := m.ClusterResourceWhitelist[len(m.ClusterResourceWhitelist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceResourceBlacklist", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceResourceBlacklist = append(m.NamespaceResourceBlacklist, v1.GroupKind{})
+			if err := m.NamespaceResourceBlacklist[len(m.NamespaceResourceBlacklist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrphanedResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OrphanedResources == nil {
+				m.OrphanedResources = &OrphanedResourcesMonitorSettings{}
+			}
+			if err := m.OrphanedResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SyncPolicy == nil {
+				m.SyncPolicy = &SyncPolicy{}
+			}
+			if err := m.SyncPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceDecryptionKeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceDecryptionKeys = append(m.SourceDecryptionKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailOnSharedResource", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FailOnSharedResource = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManifestQuota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ManifestQuota == nil {
+				m.ManifestQuota = &ManifestQuota{}
+			}
+			if err := m.ManifestQuota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Application) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Application: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Application: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Operation == nil {
+				m.Operation = &Operation{}
+			}
+			if err := m.Operation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationCondition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationCondition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationCondition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationDestination) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationDestination: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationDestination: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Server = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, Application{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetRevision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetRevision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Helm", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Helm == nil {
+				m.Helm = &ApplicationSourceHelm{}
+			}
+			if err := m.Helm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kustomize", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Kustomize == nil {
+				m.Kustomize = &ApplicationSourceKustomize{}
+			}
+			if err := m.Kustomize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ksonnet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Ksonnet == nil {
+				m.Ksonnet = &ApplicationSourceKsonnet{}
+			}
+			if err := m.Ksonnet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Directory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Directory == nil {
+				m.Directory = &ApplicationSourceDirectory{}
+			}
+			if err := m.Directory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Plugin == nil {
+				m.Plugin = &ApplicationSourcePlugin{}
+			}
+			if err := m.Plugin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Patches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Patches = append(m.Patches, ApplicationSourcePatch{})
+			if err := m.Patches[len(m.Patches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSourceDirectory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSourceDirectory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSourceDirectory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recurse", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Recurse = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jsonnet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Jsonnet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSourceHelm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSourceHelm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueFiles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValueFiles = append(m.ValueFiles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, HelmParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReleaseName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeCRDs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeCRDs = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileParameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FileParameters = append(m.FileParameters, HelmFileParameter{})
+			if err := m.FileParameters[len(m.FileParameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowValueFilesOutsideChart", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowValueFilesOutsideChart = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSourceJsonnet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSourceJsonnet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSourceJsonnet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtVars", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtVars = append(m.ExtVars, JsonnetVar{})
+			if err := m.ExtVars[len(m.ExtVars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLAs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TLAs = append(m.TLAs, JsonnetVar{})
+			if err := m.TLAs[len(m.TLAs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSourceKsonnet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSourceKsonnet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSourceKsonnet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Environment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Environment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, KsonnetParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSourceKustomize: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSourceKustomize: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamePrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamePrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Images = append(m.Images, KustomizeImage(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommonLabels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CommonLabels == nil {
+				m.CommonLabels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.CommonLabels[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSourcePatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSourcePatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSourcePatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Patch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSourcePatchTarget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSourcePatchTarget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSourcePatchTarget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSourcePlugin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSourcePlugin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSourcePlugin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Env = append(m.Env, &EnvEntry{})
+			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Project = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SyncPolicy == nil {
+				m.SyncPolicy = &SyncPolicy{}
+			}
+			if err := m.SyncPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreDifferences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IgnoreDifferences = append(m.IgnoreDifferences, ResourceIgnoreDifferences{})
+			if err := m.IgnoreDifferences[len(m.IgnoreDifferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Info = append(m.Info, Info{})
+			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteBack", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WriteBack == nil {
+				m.WriteBack = &ApplicationWriteBack{}
+			}
+			if err := m.WriteBack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevisionHistoryLimit", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RevisionHistoryLimit = &v
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suspend", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Suspend == nil {
+				m.Suspend = &ApplicationSuspend{}
+			}
+			if err := m.Suspend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceHooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceHooks = append(m.ResourceHooks, ResourceHook{})
+			if err := m.ResourceHooks[len(m.ResourceHooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Analysis", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Analysis == nil {
+				m.Analysis = &SyncAnalysis{}
+			}
+			if err := m.Analysis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resources = append(m.Resources, ResourceStatus{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sync", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Sync.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, RevisionHistory{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conditions = append(m.Conditions, ApplicationCondition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReconciledAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReconciledAt == nil {
+				m.ReconciledAt = &v1.Time{}
+			}
+			if err := m.ReconciledAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OperationState == nil {
+				m.OperationState = &OperationState{}
+			}
+			if err := m.OperationState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ObservedAt == nil {
+				m.ObservedAt = &v1.Time{}
+			}
+			if err := m.ObservedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceType = ApplicationSourceType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Summary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verification", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Verification == nil {
+				m.Verification = &SyncVerification{}
+			}
+			if err := m.Verification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSummary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSummary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalURLs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalURLs = append(m.ExternalURLs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Images = append(m.Images, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenderedSources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RenderedSources = append(m.RenderedSources, RenderedSource{})
+			if err := m.RenderedSources[len(m.RenderedSources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSuspend) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSuspend: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSuspend: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Until", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Until == nil {
+				m.Until = &v1.Time{}
+			}
+			if err := m.Until.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationTree) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationTree: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationTree: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nodes = append(m.Nodes, ResourceNode{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrphanedNodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrphanedNodes = append(m.OrphanedNodes, ResourceNode{})
+			if err := m.OrphanedNodes[len(m.OrphanedNodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationWatchEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationWatchEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationWatchEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = k8s_io_apimachinery_pkg_watch.EventType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Application.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationWriteBack) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationWriteBack: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationWriteBack: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullRequest", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PullRequest = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutomatedRollback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutomatedRollback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutomatedRollback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromRevision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromRevision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Cluster) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Cluster: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Cluster: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Server = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConnectionState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServerVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespaces = append(m.Namespaces, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BearerToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BearerToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TLSClientConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AWSAuthConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AWSAuthConfig == nil {
+				m.AWSAuthConfig = &AWSAuthConfig{}
+			}
+			if err := m.AWSAuthConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	s := strings.Join([]string{`&SyncStrategyApply{`,
-		`Force:` + fmt.Sprintf("%v", this.Force) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *SyncStrategyHook) String() string {
-	if this == nil {
-		return "nil"
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	s := strings.Join([]string{`&SyncStrategyHook{`,
-		`SyncStrategyApply:` + strings.Replace(strings.Replace(this.SyncStrategyApply.String(), "SyncStrategyApply", "SyncStrategyApply", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return nil
 }
-func (this *TLSClientConfig) String() string {
-	if this == nil {
-		return "nil"
+func (m *ClusterList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, Cluster{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	s := strings.Join([]string{`&TLSClientConfig{`,
-		`Insecure:` + fmt.Sprintf("%v", this.Insecure) + `,`,
-		`ServerName:` + fmt.Sprintf("%v", this.ServerName) + `,`,
-		`CertData:` + valueToStringGenerated(this.CertData) + `,`,
-		`KeyData:` + valueToStringGenerated(this.KeyData) + `,`,
-		`CAData:` + valueToStringGenerated(this.CAData) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringGenerated(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *AWSAuthConfig) Unmarshal(dAtA []byte) error {
+func (m *Command) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7167,15 +15448,15 @@ func (m *AWSAuthConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AWSAuthConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: Command: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AWSAuthConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Command: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7200,11 +15481,11 @@ func (m *AWSAuthConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			m.Command = append(m.Command, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoleARN", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7229,7 +15510,7 @@ func (m *AWSAuthConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RoleARN = string(dAtA[iNdEx:postIndex])
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7252,7 +15533,7 @@ func (m *AWSAuthConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AppProject) Unmarshal(dAtA []byte) error {
+func (m *CommitMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7262,28 +15543,86 @@ func (m *AppProject) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowGenerated
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AppProject: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AppProject: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Author = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7307,15 +15646,15 @@ func (m *AppProject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Date.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7325,21 +15664,20 @@ func (m *AppProject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7362,7 +15700,7 @@ func (m *AppProject) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AppProjectList) Unmarshal(dAtA []byte) error {
+func (m *ComparedTo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7385,15 +15723,15 @@ func (m *AppProjectList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AppProjectList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ComparedTo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AppProjectList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ComparedTo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7417,13 +15755,13 @@ func (m *AppProjectList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7447,8 +15785,7 @@ func (m *AppProjectList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, AppProject{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7473,7 +15810,7 @@ func (m *AppProjectList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AppProjectSpec) Unmarshal(dAtA []byte) error {
+func (m *ComponentParameter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7496,15 +15833,15 @@ func (m *AppProjectSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AppProjectSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: ComponentParameter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AppProjectSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ComponentParameter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceRepos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Component", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7529,13 +15866,13 @@ func (m *AppProjectSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceRepos = append(m.SourceRepos, string(dAtA[iNdEx:postIndex]))
+			m.Component = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Destinations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7545,26 +15882,24 @@ func (m *AppProjectSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Destinations = append(m.Destinations, ApplicationDestination{})
-			if err := m.Destinations[len(m.Destinations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7589,44 +15924,63 @@ func (m *AppProjectSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Roles = append(m.Roles, ProjectRole{})
-			if err := m.Roles[len(m.Roles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigManagementPlugin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			iNdEx = postIndex
-		case 5:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigManagementPlugin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigManagementPlugin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterResourceWhitelist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7636,26 +15990,24 @@ func (m *AppProjectSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterResourceWhitelist = append(m.ClusterResourceWhitelist, v1.GroupKind{})
-			if err := m.ClusterResourceWhitelist[len(m.ClusterResourceWhitelist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceResourceBlacklist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Init", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7679,14 +16031,16 @@ func (m *AppProjectSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceResourceBlacklist = append(m.NamespaceResourceBlacklist, v1.GroupKind{})
-			if err := m.NamespaceResourceBlacklist[len(m.NamespaceResourceBlacklist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Init == nil {
+				m.Init = &Command{}
+			}
+			if err := m.Init.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrphanedResources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Generate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7710,10 +16064,7 @@ func (m *AppProjectSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OrphanedResources == nil {
-				m.OrphanedResources = &OrphanedResourcesMonitorSettings{}
-			}
-			if err := m.OrphanedResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Generate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7738,7 +16089,7 @@ func (m *AppProjectSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Application) Unmarshal(dAtA []byte) error {
+func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7757,51 +16108,21 @@ func (m *Application) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Application: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Application: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConnectionState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConnectionState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7811,27 +16132,26 @@ func (m *Application) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7841,25 +16161,24 @@ func (m *Application) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModifiedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7883,10 +16202,10 @@ func (m *Application) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Operation == nil {
-				m.Operation = &Operation{}
+			if m.ModifiedAt == nil {
+				m.ModifiedAt = &v1.Time{}
 			}
-			if err := m.Operation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ModifiedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7911,7 +16230,7 @@ func (m *Application) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationCondition) Unmarshal(dAtA []byte) error {
+func (m *EnvEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7934,15 +16253,15 @@ func (m *ApplicationCondition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationCondition: wiretype end group for non-group")
+			return fmt.Errorf("proto: EnvEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationCondition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EnvEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7967,11 +16286,11 @@ func (m *ApplicationCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7996,7 +16315,7 @@ func (m *ApplicationCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8019,7 +16338,7 @@ func (m *ApplicationCondition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationDestination) Unmarshal(dAtA []byte) error {
+func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8042,15 +16361,15 @@ func (m *ApplicationDestination) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationDestination: wiretype end group for non-group")
+			return fmt.Errorf("proto: HealthStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationDestination: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HealthStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8075,11 +16394,11 @@ func (m *ApplicationDestination) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Server = string(dAtA[iNdEx:postIndex])
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8104,7 +16423,7 @@ func (m *ApplicationDestination) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8127,7 +16446,7 @@ func (m *ApplicationDestination) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationList) Unmarshal(dAtA []byte) error {
+func (m *HelmFileParameter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8150,17 +16469,17 @@ func (m *ApplicationList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationList: wiretype end group for non-group")
+			return fmt.Errorf("proto: HelmFileParameter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HelmFileParameter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8170,27 +16489,26 @@ func (m *ApplicationList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8200,22 +16518,20 @@ func (m *ApplicationList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Application{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8238,7 +16554,7 @@ func (m *ApplicationList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
+func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8261,15 +16577,15 @@ func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: HelmParameter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HelmParameter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8294,11 +16610,11 @@ func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8323,13 +16639,13 @@ func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetRevision", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceString", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8339,24 +16655,65 @@ func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			m.ForceString = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetRevision = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImageUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImageUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImageUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Helm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HelmParameters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8380,18 +16737,16 @@ func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Helm == nil {
-				m.Helm = &ApplicationSourceHelm{}
-			}
-			if err := m.Helm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.HelmParameters = append(m.HelmParameters, HelmParameter{})
+			if err := m.HelmParameters[len(m.HelmParameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kustomize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KustomizeImages", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8401,30 +16756,26 @@ func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Kustomize == nil {
-				m.Kustomize = &ApplicationSourceKustomize{}
-			}
-			if err := m.Kustomize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.KustomizeImages = append(m.KustomizeImages, KustomizeImage(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ksonnet", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteBack", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8434,30 +16785,67 @@ func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			m.WriteBack = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Info) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Ksonnet == nil {
-				m.Ksonnet = &ApplicationSourceKsonnet{}
-			}
-			if err := m.Ksonnet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 10:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Info: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Info: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Directory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8467,30 +16855,26 @@ func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Directory == nil {
-				m.Directory = &ApplicationSourceDirectory{}
-			}
-			if err := m.Directory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8500,24 +16884,20 @@ func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Plugin == nil {
-				m.Plugin = &ApplicationSourcePlugin{}
-			}
-			if err := m.Plugin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8540,7 +16920,7 @@ func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSourceDirectory) Unmarshal(dAtA []byte) error {
+func (m *InfoItem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8563,17 +16943,17 @@ func (m *ApplicationSourceDirectory) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSourceDirectory: wiretype end group for non-group")
+			return fmt.Errorf("proto: InfoItem: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSourceDirectory: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InfoItem: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recurse", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8583,17 +16963,26 @@ func (m *ApplicationSourceDirectory) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Recurse = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Jsonnet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8603,21 +16992,20 @@ func (m *ApplicationSourceDirectory) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Jsonnet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8640,7 +17028,7 @@ func (m *ApplicationSourceDirectory) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
+func (m *JWTToken) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8663,17 +17051,17 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSourceHelm: wiretype end group for non-group")
+			return fmt.Errorf("proto: JWTToken: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSourceHelm: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JWTToken: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueFiles", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
 			}
-			var stringLen uint64
+			m.IssuedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8683,26 +17071,16 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.IssuedAt |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValueFiles = append(m.ValueFiles, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
-			var msglen int
+			m.ExpiresAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8712,26 +17090,64 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.ExpiresAt |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameters = append(m.Parameters, HelmParameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			iNdEx = postIndex
-		case 3:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JsonnetVar: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JsonnetVar: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8756,11 +17172,11 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReleaseName = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8785,8 +17201,28 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Values = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Code = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -8808,7 +17244,7 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSourceJsonnet) Unmarshal(dAtA []byte) error {
+func (m *KsonnetParameter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8831,17 +17267,46 @@ func (m *ApplicationSourceJsonnet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSourceJsonnet: wiretype end group for non-group")
+			return fmt.Errorf("proto: KsonnetParameter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSourceJsonnet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KsonnetParameter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtVars", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Component", wireType)
 			}
-			var msglen int
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Component = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8851,28 +17316,26 @@ func (m *ApplicationSourceJsonnet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExtVars = append(m.ExtVars, JsonnetVar{})
-			if err := m.ExtVars[len(m.ExtVars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLAs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8882,22 +17345,20 @@ func (m *ApplicationSourceJsonnet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TLAs = append(m.TLAs, JsonnetVar{})
-			if err := m.TLAs[len(m.TLAs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8920,7 +17381,7 @@ func (m *ApplicationSourceJsonnet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSourceKsonnet) Unmarshal(dAtA []byte) error {
+func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8943,15 +17404,15 @@ func (m *ApplicationSourceKsonnet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSourceKsonnet: wiretype end group for non-group")
+			return fmt.Errorf("proto: KustomizeOptions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSourceKsonnet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KustomizeOptions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Environment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildOptions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8976,38 +17437,7 @@ func (m *ApplicationSourceKsonnet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Environment = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Parameters = append(m.Parameters, KsonnetParameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BuildOptions = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9030,7 +17460,7 @@ func (m *ApplicationSourceKsonnet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
+func (m *ManifestQuota) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9053,17 +17483,17 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSourceKustomize: wiretype end group for non-group")
+			return fmt.Errorf("proto: ManifestQuota: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSourceKustomize: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ManifestQuota: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamePrefix", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxResources", wireType)
 			}
-			var stringLen uint64
+			m.MaxResources = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9073,26 +17503,16 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.MaxResources |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NamePrefix = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSize", wireType)
 			}
-			var stringLen uint64
+			m.MaxSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9102,138 +17522,123 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.MaxSize |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Images = append(m.Images, KustomizeImage(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommonLabels", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Operation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CommonLabels == nil {
-				m.CommonLabels = make(map[string]string)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Operation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Operation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sync", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.CommonLabels[mapkey] = mapvalue
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Sync == nil {
+				m.Sync = &SyncOperation{}
+			}
+			if err := m.Sync.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedBy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitiatedBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9256,7 +17661,7 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSourcePlugin) Unmarshal(dAtA []byte) error {
+func (m *OperationInitiator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9279,15 +17684,15 @@ func (m *ApplicationSourcePlugin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSourcePlugin: wiretype end group for non-group")
+			return fmt.Errorf("proto: OperationInitiator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSourcePlugin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OperationInitiator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9312,13 +17717,33 @@ func (m *ApplicationSourcePlugin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Username = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Automated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Automated = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9328,22 +17753,20 @@ func (m *ApplicationSourcePlugin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Env = append(m.Env, &EnvEntry{})
-			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9366,7 +17789,7 @@ func (m *ApplicationSourcePlugin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
+func (m *OperationState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9389,15 +17812,15 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: OperationState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OperationState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9421,15 +17844,15 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Operation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9439,25 +17862,24 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Phase = OperationPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9482,11 +17904,11 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Project = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncResult", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9510,16 +17932,16 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SyncPolicy == nil {
-				m.SyncPolicy = &SyncPolicy{}
+			if m.SyncResult == nil {
+				m.SyncResult = &SyncOperationResult{}
 			}
-			if err := m.SyncPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SyncResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreDifferences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9543,14 +17965,13 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IgnoreDifferences = append(m.IgnoreDifferences, ResourceIgnoreDifferences{})
-			if err := m.IgnoreDifferences[len(m.IgnoreDifferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9574,8 +17995,10 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Info = append(m.Info, Info{})
-			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.FinishedAt == nil {
+				m.FinishedAt = &v1.Time{}
+			}
+			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9600,7 +18023,7 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
+func (m *OrphanedResourcesMonitorSettings) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9610,121 +18033,30 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowGenerated
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Resources = append(m.Resources, ResourceStatus{})
-			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sync", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Sync.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrphanedResourcesMonitorSettings: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrphanedResourcesMonitorSettings: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Warn", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9734,28 +18066,68 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			b := bool(v != 0)
+			m.Warn = &b
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.History = append(m.History, RevisionHistory{})
-			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectRole) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			iNdEx = postIndex
-		case 5:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectRole: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectRole: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9765,28 +18137,26 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Conditions = append(m.Conditions, ApplicationCondition{})
-			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReconciledAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9796,30 +18166,26 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ReconciledAt == nil {
-				m.ReconciledAt = &v1.Time{}
-			}
-			if err := m.ReconciledAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperationState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9829,28 +18195,24 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OperationState == nil {
-				m.OperationState = &OperationState{}
-			}
-			if err := m.OperationState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Policies = append(m.Policies, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JWTTokens", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9874,16 +18236,14 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ObservedAt == nil {
-				m.ObservedAt = &v1.Time{}
-			}
-			if err := m.ObservedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.JWTTokens = append(m.JWTTokens, JWTToken{})
+			if err := m.JWTTokens[len(m.JWTTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9908,11 +18268,11 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceType = ApplicationSourceType(dAtA[iNdEx:postIndex])
+			m.Groups = append(m.Groups, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 10:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9936,7 +18296,10 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Summary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Quota == nil {
+				m.Quota = &ProjectRoleQuota{}
+			}
+			if err := m.Quota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9961,7 +18324,7 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSummary) Unmarshal(dAtA []byte) error {
+func (m *ProjectRoleQuota) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9984,17 +18347,17 @@ func (m *ApplicationSummary) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSummary: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectRoleQuota: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectRoleQuota: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalURLs", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestsPerHour", wireType)
 			}
-			var stringLen uint64
+			m.RequestsPerHour = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10004,26 +18367,16 @@ func (m *ApplicationSummary) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.RequestsPerHour |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ExternalURLs = append(m.ExternalURLs, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncsPerHour", wireType)
 			}
-			var stringLen uint64
+			m.SyncsPerHour = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10033,21 +18386,11 @@ func (m *ApplicationSummary) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.SyncsPerHour |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Images = append(m.Images, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -10069,7 +18412,7 @@ func (m *ApplicationSummary) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationTree) Unmarshal(dAtA []byte) error {
+func (m *ProjectTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10079,28 +18422,86 @@ func (m *ApplicationTree) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowGenerated
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationTree: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationTree: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10124,14 +18525,14 @@ func (m *ApplicationTree) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nodes = append(m.Nodes, ResourceNode{})
-			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Parameters = append(m.Parameters, ProjectTemplateParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrphanedNodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10155,8 +18556,7 @@ func (m *ApplicationTree) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrphanedNodes = append(m.OrphanedNodes, ResourceNode{})
-			if err := m.OrphanedNodes[len(m.OrphanedNodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10181,7 +18581,7 @@ func (m *ApplicationTree) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationWatchEvent) Unmarshal(dAtA []byte) error {
+func (m *ProjectTemplateParameter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10204,15 +18604,15 @@ func (m *ApplicationWatchEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationWatchEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectTemplateParameter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationWatchEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectTemplateParameter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10237,13 +18637,13 @@ func (m *ApplicationWatchEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = k8s_io_apimachinery_pkg_watch.EventType(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10253,22 +18653,70 @@ func (m *ApplicationWatchEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Application.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Default = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Required", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Required = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -10290,7 +18738,7 @@ func (m *ApplicationWatchEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Cluster) Unmarshal(dAtA []byte) error {
+func (m *RenderedSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10313,15 +18761,15 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Cluster: wiretype end group for non-group")
+			return fmt.Errorf("proto: RenderedSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Cluster: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RenderedSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10346,7 +18794,7 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Server = string(dAtA[iNdEx:postIndex])
+			m.Type = ApplicationSourceType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -10379,9 +18827,9 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10391,27 +18839,26 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppVersion", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10421,27 +18868,26 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ConnectionState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AppVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerVersion", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dependency", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10451,21 +18897,12 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ServerVersion = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Dependency = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -10487,7 +18924,7 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
+func (m *Repository) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10510,13 +18947,42 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: Repository: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Repository: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
 			}
@@ -10545,7 +19011,7 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 			}
 			m.Username = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
 			}
@@ -10574,9 +19040,9 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 			}
 			m.Password = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BearerToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SSHPrivateKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10601,13 +19067,63 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BearerToken = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientConfig", wireType)
+			m.SSHPrivateKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConnectionState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsecureIgnoreHostKey", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InsecureIgnoreHostKey = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10617,27 +19133,17 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TLSClientConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AWSAuthConfig", wireType)
+			m.Insecure = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableLFS", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10647,80 +19153,17 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.AWSAuthConfig == nil {
-				m.AWSAuthConfig = &AWSAuthConfig{}
-			}
-			if err := m.AWSAuthConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ClusterList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.EnableLFS = bool(v != 0)
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCertData", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10730,27 +19173,26 @@ func (m *ClusterList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TLSClientCertData = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCertKey", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10760,76 +19202,24 @@ func (m *ClusterList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Cluster{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TLSClientCertKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Command) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Command: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Command: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCAData", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10854,11 +19244,11 @@ func (m *Command) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Command = append(m.Command, string(dAtA[iNdEx:postIndex]))
+			m.TLSClientCAData = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10883,63 +19273,13 @@ func (m *Command) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ComparedTo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ComparedTo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ComparedTo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10949,27 +19289,26 @@ func (m *ComparedTo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WebhookID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10979,21 +19318,20 @@ func (m *ComparedTo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.WebhookID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11016,7 +19354,7 @@ func (m *ComparedTo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ComponentParameter) Unmarshal(dAtA []byte) error {
+func (m *RepositoryCertificate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11039,15 +19377,15 @@ func (m *ComponentParameter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ComponentParameter: wiretype end group for non-group")
+			return fmt.Errorf("proto: RepositoryCertificate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ComponentParameter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RepositoryCertificate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Component", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11072,11 +19410,11 @@ func (m *ComponentParameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Component = string(dAtA[iNdEx:postIndex])
+			m.ServerName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CertType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11101,11 +19439,11 @@ func (m *ComponentParameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.CertType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CertSubType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11130,7 +19468,67 @@ func (m *ComponentParameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.CertSubType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CertData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CertData = append(m.CertData[:0], dAtA[iNdEx:postIndex]...)
+			if m.CertData == nil {
+				m.CertData = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CertInfo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CertInfo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11153,7 +19551,7 @@ func (m *ComponentParameter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConfigManagementPlugin) Unmarshal(dAtA []byte) error {
+func (m *RepositoryCertificateList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11176,44 +19574,15 @@ func (m *ConfigManagementPlugin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConfigManagementPlugin: wiretype end group for non-group")
+			return fmt.Errorf("proto: RepositoryCertificateList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConfigManagementPlugin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RepositoryCertificateList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Init", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11237,16 +19606,13 @@ func (m *ConfigManagementPlugin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Init == nil {
-				m.Init = &Command{}
-			}
-			if err := m.Init.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Generate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11270,7 +19636,8 @@ func (m *ConfigManagementPlugin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Generate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, RepositoryCertificate{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11295,7 +19662,7 @@ func (m *ConfigManagementPlugin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConnectionState) Unmarshal(dAtA []byte) error {
+func (m *RepositoryFailure) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11318,17 +19685,124 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConnectionState: wiretype end group for non-group")
+			return fmt.Errorf("proto: RepositoryFailure: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConnectionState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RepositoryFailure: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthFailure", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AuthFailure = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failures", wireType)
 			}
-			var stringLen uint64
+			m.Failures = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11338,26 +19812,16 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Failures |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Status = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11367,24 +19831,25 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			if err := m.FailedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModifiedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSucceededAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11408,10 +19873,10 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ModifiedAt == nil {
-				m.ModifiedAt = &v1.Time{}
+			if m.LastSucceededAt == nil {
+				m.LastSucceededAt = &v1.Time{}
 			}
-			if err := m.ModifiedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastSucceededAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11436,7 +19901,7 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EnvEntry) Unmarshal(dAtA []byte) error {
+func (m *RepositoryFailureList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11459,17 +19924,17 @@ func (m *EnvEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EnvEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: RepositoryFailureList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EnvEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RepositoryFailureList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11479,49 +19944,22 @@ func (m *EnvEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Items = append(m.Items, RepositoryFailure{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11544,7 +19982,7 @@ func (m *EnvEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HealthStatus) Unmarshal(dAtA []byte) error {
+func (m *RepositoryList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11567,17 +20005,17 @@ func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HealthStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: RepositoryList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HealthStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RepositoryList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11587,26 +20025,27 @@ func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11616,20 +20055,22 @@ func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Items = append(m.Items, &Repository{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11652,7 +20093,7 @@ func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HelmParameter) Unmarshal(dAtA []byte) error {
+func (m *ResourceAction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11675,10 +20116,10 @@ func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HelmParameter: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceAction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HelmParameter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceAction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11712,9 +20153,9 @@ func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11724,41 +20165,23 @@ func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForceString", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Params = append(m.Params, ResourceActionParam{})
+			if err := m.Params[len(m.Params)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.ForceString = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -11780,7 +20203,7 @@ func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Info) Unmarshal(dAtA []byte) error {
+func (m *ResourceActionDefinition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11803,10 +20226,10 @@ func (m *Info) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Info: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceActionDefinition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Info: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceActionDefinition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11840,7 +20263,7 @@ func (m *Info) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionLua", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11865,7 +20288,7 @@ func (m *Info) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.ActionLua = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11888,7 +20311,7 @@ func (m *Info) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InfoItem) Unmarshal(dAtA []byte) error {
+func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11911,10 +20334,10 @@ func (m *InfoItem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InfoItem: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceActionParam: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InfoItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceActionParam: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11975,61 +20398,11 @@ func (m *InfoItem) Unmarshal(dAtA []byte) error {
 			}
 			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JWTToken) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JWTToken: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JWTToken: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.IssuedAt = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12039,16 +20412,26 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.IssuedAt |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.ExpiresAt = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12058,11 +20441,21 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExpiresAt |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Default = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -12084,7 +20477,7 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
+func (m *ResourceActions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12107,15 +20500,15 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JsonnetVar: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceActions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JsonnetVar: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceActions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionDiscoveryLua", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12140,13 +20533,13 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ActionDiscoveryLua = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Definitions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12156,41 +20549,23 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Definitions = append(m.Definitions, ResourceActionDefinition{})
+			if err := m.Definitions[len(m.Definitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Code = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -12212,7 +20587,7 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KsonnetParameter) Unmarshal(dAtA []byte) error {
+func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12235,15 +20610,15 @@ func (m *KsonnetParameter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KsonnetParameter: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceDiff: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KsonnetParameter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceDiff: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Component", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12268,11 +20643,11 @@ func (m *KsonnetParameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Component = string(dAtA[iNdEx:postIndex])
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12297,11 +20672,11 @@ func (m *KsonnetParameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12326,61 +20701,69 @@ func (m *KsonnetParameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetState", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: KustomizeOptions: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KustomizeOptions: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BuildOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiveState", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12405,61 +20788,60 @@ func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BuildOptions = string(dAtA[iNdEx:postIndex])
+			m.LiveState = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Diff", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Operation) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.Diff = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Operation: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Operation: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Hook = bool(v != 0)
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sync", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKeyChanges", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12483,13 +20865,42 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Sync == nil {
-				m.Sync = &SyncOperation{}
+			if m.SecretKeyChanges == nil {
+				m.SecretKeyChanges = &SecretKeyChanges{}
 			}
-			if err := m.Sync.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SecretKeyChanges.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PredictedLiveState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PredictedLiveState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -12511,7 +20922,7 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OperationState) Unmarshal(dAtA []byte) error {
+func (m *ResourceHook) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12534,17 +20945,17 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OperationState: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceHook: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperationState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceHook: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12554,25 +20965,24 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Operation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12597,11 +21007,11 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = OperationPhase(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12626,13 +21036,13 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncResult", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12642,30 +21052,26 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SyncResult == nil {
-				m.SyncResult = &SyncOperationResult{}
-			}
-			if err := m.SyncResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12675,27 +21081,26 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Hook = append(m.Hook, HookType(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncWave", wireType)
 			}
-			var msglen int
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12705,80 +21110,17 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.FinishedAt == nil {
-				m.FinishedAt = &v1.Time{}
-			}
-			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *OrphanedResourcesMonitorSettings) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OrphanedResourcesMonitorSettings: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OrphanedResourcesMonitorSettings: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Warn", wireType)
+			m.SyncWave = &v
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletePolicy", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12788,13 +21130,21 @@ func (m *OrphanedResourcesMonitorSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.Warn = &b
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeletePolicy = append(m.DeletePolicy, HookDeletePolicy(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -12816,7 +21166,7 @@ func (m *OrphanedResourcesMonitorSettings) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProjectRole) Unmarshal(dAtA []byte) error {
+func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12839,15 +21189,15 @@ func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProjectRole: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceIgnoreDifferences: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProjectRole: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceIgnoreDifferences: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12872,11 +21222,11 @@ func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12901,11 +21251,11 @@ func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12930,13 +21280,13 @@ func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Policies = append(m.Policies, string(dAtA[iNdEx:postIndex]))
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JWTTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12946,26 +21296,24 @@ func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JWTTokens = append(m.JWTTokens, JWTToken{})
-			if err := m.JWTTokens[len(m.JWTTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONPointers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12990,7 +21338,7 @@ func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Groups = append(m.Groups, string(dAtA[iNdEx:postIndex]))
+			m.JSONPointers = append(m.JSONPointers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13013,7 +21361,7 @@ func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Repository) Unmarshal(dAtA []byte) error {
+func (m *ResourceLink) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13036,44 +21384,15 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Repository: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Repository: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceLink: wiretype end group for non-group")
 		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Repo = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceLink: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13098,11 +21417,11 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Username = string(dAtA[iNdEx:postIndex])
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13127,40 +21446,61 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Password = string(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SSHPrivateKey", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SSHPrivateKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceNetworkingInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceNetworkingInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetLabels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13184,104 +21524,103 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ConnectionState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InsecureIgnoreHostKey", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.InsecureIgnoreHostKey = bool(v != 0)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Insecure = bool(v != 0)
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableLFS", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.EnableLFS = bool(v != 0)
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCertData", wireType)
+			if m.TargetLabels == nil {
+				m.TargetLabels = make(map[string]string)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TLSClientCertData = string(dAtA[iNdEx:postIndex])
+			m.TargetLabels[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCertKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetRefs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13291,26 +21630,28 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TLSClientCertKey = string(dAtA[iNdEx:postIndex])
+			m.TargetRefs = append(m.TargetRefs, ResourceRef{})
+			if err := m.TargetRefs[len(m.TargetRefs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 11:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCAData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13320,26 +21661,115 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TLSClientCAData = string(dAtA[iNdEx:postIndex])
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 12:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ingress", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13349,24 +21779,26 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Ingress = append(m.Ingress, v11.LoadBalancerIngress{})
+			if err := m.Ingress[len(m.Ingress)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 13:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalURLs", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13391,7 +21823,7 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ExternalURLs = append(m.ExternalURLs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13414,7 +21846,7 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RepositoryCertificate) Unmarshal(dAtA []byte) error {
+func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13437,17 +21869,17 @@ func (m *RepositoryCertificate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RepositoryCertificate: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceNode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepositoryCertificate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceNode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRef", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13457,26 +21889,27 @@ func (m *RepositoryCertificate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServerName = string(dAtA[iNdEx:postIndex])
+			if err := m.ResourceRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CertType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentRefs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13486,26 +21919,28 @@ func (m *RepositoryCertificate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CertType = string(dAtA[iNdEx:postIndex])
+			m.ParentRefs = append(m.ParentRefs, ResourceRef{})
+			if err := m.ParentRefs[len(m.ParentRefs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CertSubType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13515,26 +21950,28 @@ func (m *RepositoryCertificate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CertSubType = string(dAtA[iNdEx:postIndex])
+			m.Info = append(m.Info, InfoItem{})
+			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CertData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkingInfo", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13544,26 +21981,28 @@ func (m *RepositoryCertificate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CertData = append(m.CertData[:0], dAtA[iNdEx:postIndex]...)
-			if m.CertData == nil {
-				m.CertData = []byte{}
+			if m.NetworkingInfo == nil {
+				m.NetworkingInfo = &ResourceNetworkingInfo{}
+			}
+			if err := m.NetworkingInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CertInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13588,61 +22027,40 @@ func (m *RepositoryCertificate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CertInfo = string(dAtA[iNdEx:postIndex])
+			m.ResourceVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RepositoryCertificateList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RepositoryCertificateList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepositoryCertificateList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Images = append(m.Images, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13666,13 +22084,16 @@ func (m *RepositoryCertificateList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Health == nil {
+				m.Health = &HealthStatus{}
+			}
+			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Links", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13696,8 +22117,8 @@ func (m *RepositoryCertificateList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, RepositoryCertificate{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Links = append(m.Links, ResourceLink{})
+			if err := m.Links[len(m.Links)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13722,7 +22143,7 @@ func (m *RepositoryCertificateList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RepositoryList) Unmarshal(dAtA []byte) error {
+func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13745,17 +22166,17 @@ func (m *RepositoryList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RepositoryList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceOverride: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepositoryList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceOverride: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthLua", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13765,27 +22186,26 @@ func (m *RepositoryList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.HealthLua = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreDifferences", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13795,76 +22215,53 @@ func (m *RepositoryList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, &Repository{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.IgnoreDifferences = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResourceAction) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceAction: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceAction: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Actions = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthProgressingTimeout", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13889,13 +22286,13 @@ func (m *ResourceAction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.HealthProgressingTimeout = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthCompleted", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13905,23 +22302,41 @@ func (m *ResourceAction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Params = append(m.Params, ResourceActionParam{})
-			if err := m.Params[len(m.Params)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.HealthCompleted = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthIncludeHooks", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HealthIncludeHooks = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -13943,7 +22358,7 @@ func (m *ResourceAction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceActionDefinition) Unmarshal(dAtA []byte) error {
+func (m *ResourceRef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13966,15 +22381,15 @@ func (m *ResourceActionDefinition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceActionDefinition: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceRef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceActionDefinition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceRef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13999,11 +22414,11 @@ func (m *ResourceActionDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActionLua", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14015,74 +22430,24 @@ func (m *ResourceActionDefinition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ActionLua = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceActionParam: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceActionParam: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14107,11 +22472,11 @@ func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14136,11 +22501,11 @@ func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14165,11 +22530,11 @@ func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14194,7 +22559,7 @@ func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Default = string(dAtA[iNdEx:postIndex])
+			m.UID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14217,7 +22582,7 @@ func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceActions) Unmarshal(dAtA []byte) error {
+func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14240,15 +22605,15 @@ func (m *ResourceActions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceActions: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceActions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActionDiscoveryLua", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14273,13 +22638,13 @@ func (m *ResourceActions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActionDiscoveryLua = string(dAtA[iNdEx:postIndex])
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Definitions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14289,76 +22654,24 @@ func (m *ResourceActions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Definitions = append(m.Definitions, ResourceActionDefinition{})
-			if err := m.Definitions[len(m.Definitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceDiff: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceDiff: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14383,11 +22696,11 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14412,11 +22725,11 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14441,11 +22754,11 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14470,11 +22783,11 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Status = ResultCode(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14499,11 +22812,11 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetState = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiveState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HookType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14528,11 +22841,11 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LiveState = string(dAtA[iNdEx:postIndex])
+			m.HookType = HookType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Diff", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HookPhase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14557,13 +22870,13 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Diff = string(dAtA[iNdEx:postIndex])
+			m.HookPhase = OperationPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncPhase", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14573,12 +22886,21 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Hook = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SyncPhase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -14600,7 +22922,7 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
+func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14623,15 +22945,73 @@ func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceIgnoreDifferences: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceIgnoreDifferences: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14656,11 +23036,11 @@ func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14685,9 +23065,9 @@ func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
@@ -14716,9 +23096,9 @@ func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14743,13 +23123,13 @@ func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Status = SyncStatusCode(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JSONPointers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14759,74 +23139,68 @@ func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JSONPointers = append(m.JSONPointers, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if m.Health == nil {
+				m.Health = &HealthStatus{}
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
+			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.Hook = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiresPruning", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceNetworkingInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceNetworkingInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.RequiresPruning = bool(v != 0)
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetLabels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutOfSyncSince", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14850,103 +23224,101 @@ func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TargetLabels == nil {
-				m.TargetLabels = make(map[string]string)
+			if m.OutOfSyncSince == nil {
+				m.OutOfSyncSince = &v1.Time{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if err := m.OutOfSyncSince.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgressingSince", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.TargetLabels[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProgressingSince == nil {
+				m.ProgressingSince = &v1.Time{}
+			}
+			if err := m.ProgressingSince.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RevisionChangelog) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevisionChangelog: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevisionChangelog: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetRefs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14956,28 +23328,26 @@ func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetRefs = append(m.TargetRefs, ResourceRef{})
-			if err := m.TargetRefs[len(m.TargetRefs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetRevision", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14987,113 +23357,24 @@ func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Labels[mapkey] = mapvalue
+			m.TargetRevision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ingress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15117,40 +23398,11 @@ func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ingress = append(m.Ingress, v11.LoadBalancerIngress{})
-			if err := m.Ingress[len(m.Ingress)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Commits = append(m.Commits, CommitMetadata{})
+			if err := m.Commits[len(m.Commits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalURLs", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ExternalURLs = append(m.ExternalURLs, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -15172,7 +23424,7 @@ func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceNode) Unmarshal(dAtA []byte) error {
+func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15195,17 +23447,17 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceNode: wiretype end group for non-group")
+			return fmt.Errorf("proto: RevisionHistory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceNode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RevisionHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15215,25 +23467,24 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ResourceRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentRefs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeployedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15257,16 +23508,15 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ParentRefs = append(m.ParentRefs, ResourceRef{})
-			if err := m.ParentRefs[len(m.ParentRefs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DeployedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var msglen int
+			m.ID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15276,26 +23526,14 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.ID |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Info = append(m.Info, InfoItem{})
-			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetworkingInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15319,18 +23557,15 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NetworkingInfo == nil {
-				m.NetworkingInfo = &ResourceNetworkingInfo{}
-			}
-			if err := m.NetworkingInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedBy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15340,26 +23575,27 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResourceVersion = string(dAtA[iNdEx:postIndex])
+			if err := m.InitiatedBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageUpdate", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15369,24 +23605,28 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Images = append(m.Images, string(dAtA[iNdEx:postIndex]))
+			if m.ImageUpdate == nil {
+				m.ImageUpdate = &ImageUpdate{}
+			}
+			if err := m.ImageUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AutomatedRollback", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15410,13 +23650,33 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Health == nil {
-				m.Health = &HealthStatus{}
+			if m.AutomatedRollback == nil {
+				m.AutomatedRollback = &AutomatedRollback{}
 			}
-			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AutomatedRollback.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pinned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Pinned = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -15438,7 +23698,7 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
+func (m *RevisionMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15461,15 +23721,15 @@ func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceOverride: wiretype end group for non-group")
+			return fmt.Errorf("proto: RevisionMetadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RevisionMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HealthLua", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15494,11 +23754,41 @@ func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HealthLua = string(dAtA[iNdEx:postIndex])
+			m.Author = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreDifferences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Date.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15523,11 +23813,11 @@ func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IgnoreDifferences = string(dAtA[iNdEx:postIndex])
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15552,7 +23842,36 @@ func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Actions = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangedFiles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChangedFiles = append(m.ChangedFiles, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15575,7 +23894,7 @@ func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceRef) Unmarshal(dAtA []byte) error {
+func (m *SecretKeyChanges) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15598,15 +23917,15 @@ func (m *ResourceRef) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceRef: wiretype end group for non-group")
+			return fmt.Errorf("proto: SecretKeyChanges: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceRef: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SecretKeyChanges: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Added", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15631,11 +23950,11 @@ func (m *ResourceRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			m.Added = append(m.Added, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15660,11 +23979,11 @@ func (m *ResourceRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.Removed = append(m.Removed, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Modified", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15689,42 +24008,63 @@ func (m *ResourceRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.Modified = append(m.Modified, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncAnalysis) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncAnalysis: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncAnalysis: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15734,26 +24074,30 @@ func (m *ResourceRef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Webhook == nil {
+				m.Webhook = &SyncAnalysisWebhook{}
+			}
+			if err := m.Webhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15763,20 +24107,24 @@ func (m *ResourceRef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UID = string(dAtA[iNdEx:postIndex])
+			if m.Job == nil {
+				m.Job = &SyncAnalysisJob{}
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15799,7 +24147,7 @@ func (m *ResourceRef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceResult) Unmarshal(dAtA []byte) error {
+func (m *SyncAnalysisJob) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15822,15 +24170,15 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncAnalysisJob: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncAnalysisJob: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15855,11 +24203,11 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			m.Image = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15884,11 +24232,11 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.Command = append(m.Command, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15913,13 +24261,13 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15929,24 +24277,26 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Env = append(m.Env, &EnvEntry{})
+			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15971,13 +24321,13 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackoffLimit", wireType)
 			}
-			var stringLen uint64
+			m.BackoffLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15987,26 +24337,16 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.BackoffLimit |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Status = ResultCode(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutSeconds", wireType)
 			}
-			var stringLen uint64
+			m.TimeoutSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16016,24 +24356,64 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.TimeoutSeconds |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncAnalysisResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncAnalysisResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncAnalysisResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HookType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16058,11 +24438,11 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HookType = HookType(dAtA[iNdEx:postIndex])
+			m.Phase = OperationPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HookPhase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16087,11 +24467,61 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HookPhase = OperationPhase(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncAnalysisWebhook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncAnalysisWebhook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncAnalysisWebhook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncPhase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16116,8 +24546,27 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SyncPhase = string(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutSeconds", wireType)
+			}
+			m.TimeoutSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutSeconds |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -16139,7 +24588,7 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
+func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16162,15 +24611,15 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncOperation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncOperation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16195,13 +24644,53 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prune", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Prune = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncStrategy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16211,26 +24700,61 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			if m.SyncStrategy == nil {
+				m.SyncStrategy = &SyncStrategy{}
+			}
+			if err := m.SyncStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resources = append(m.Resources, SyncOperationResource{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16240,24 +24764,28 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			if m.Source == nil {
+				m.Source = &ApplicationSource{}
+			}
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Manifests", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16282,13 +24810,13 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Manifests = append(m.Manifests, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Adopt", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16298,26 +24826,17 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			m.Adopt = bool(v != 0)
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageUpdate", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16327,24 +24846,28 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = SyncStatusCode(dAtA[iNdEx:postIndex])
+			if m.ImageUpdate == nil {
+				m.ImageUpdate = &ImageUpdate{}
+			}
+			if err := m.ImageUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AutomatedRollback", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16368,16 +24891,16 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Health == nil {
-				m.Health = &HealthStatus{}
+			if m.AutomatedRollback == nil {
+				m.AutomatedRollback = &AutomatedRollback{}
 			}
-			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AutomatedRollback.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pinned", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -16394,10 +24917,10 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Hook = bool(v != 0)
-		case 9:
+			m.Pinned = bool(v != 0)
+		case 13:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequiresPruning", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PruneArgoCD", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -16414,7 +24937,7 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.RequiresPruning = bool(v != 0)
+			m.PruneArgoCD = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -16436,7 +24959,7 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
+func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16459,15 +24982,15 @@ func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RevisionHistory: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncOperationResource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RevisionHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncOperationResource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16492,13 +25015,13 @@ func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeployedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16508,46 +25031,26 @@ func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DeployedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
-			}
-			m.ID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ID |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16557,21 +25060,20 @@ func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16594,7 +25096,7 @@ func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RevisionMetadata) Unmarshal(dAtA []byte) error {
+func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16617,15 +25119,46 @@ func (m *RevisionMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RevisionMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncOperationResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RevisionMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncOperationResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resources = append(m.Resources, &ResourceResult{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16650,11 +25183,11 @@ func (m *RevisionMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Author = string(dAtA[iNdEx:postIndex])
+			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16678,15 +25211,98 @@ func (m *RevisionMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Date.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Analysis", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Analysis == nil {
+				m.Analysis = &SyncAnalysisResult{}
+			}
+			if err := m.Analysis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Automated", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16696,26 +25312,30 @@ func (m *RevisionMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			if m.Automated == nil {
+				m.Automated = &SyncPolicyAutomated{}
+			}
+			if err := m.Automated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Verify", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16725,20 +25345,24 @@ func (m *RevisionMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			if m.Verify == nil {
+				m.Verify = &SyncPolicyVerify{}
+			}
+			if err := m.Verify.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16761,7 +25385,7 @@ func (m *RevisionMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncOperation) Unmarshal(dAtA []byte) error {
+func (m *SyncPolicyAutomated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16784,42 +25408,13 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncOperation: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncPolicyAutomated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncOperation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncPolicyAutomated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Prune", wireType)
 			}
@@ -16839,9 +25434,9 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Prune = bool(v != 0)
-		case 3:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfHeal", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -16858,76 +25453,62 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.DryRun = bool(v != 0)
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncStrategy", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.SelfHeal = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SyncStrategy == nil {
-				m.SyncStrategy = &SyncStrategy{}
-			}
-			if err := m.SyncStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncPolicyVerify) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resources = append(m.Resources, SyncOperationResource{})
-			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncPolicyVerify: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncPolicyVerify: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutSeconds", wireType)
 			}
-			var msglen int
+			m.TimeoutSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16937,30 +25518,16 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.TimeoutSeconds |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Source == nil {
-				m.Source = &ApplicationSource{}
-			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Manifests", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rollback", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16970,21 +25537,12 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Manifests = append(m.Manifests, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
+			m.Rollback = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -17006,7 +25564,7 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
+func (m *SyncReport) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17029,17 +25587,65 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncOperationResource: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncReport: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncOperationResource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncReport: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17049,24 +25655,25 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17091,11 +25698,11 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.Phase = OperationPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17120,61 +25727,31 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SyncOperationResult: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncOperationResult: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.DryRun = bool(v != 0)
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedBy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17198,16 +25775,15 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resources = append(m.Resources, &ResourceResult{})
-			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InitiatedBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17217,24 +25793,25 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17258,63 +25835,32 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationSeconds", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.DurationSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DurationSeconds |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SyncPolicy: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Automated", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Applied", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17338,68 +25884,47 @@ func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Automated == nil {
-				m.Automated = &SyncPolicyAutomated{}
-			}
-			if err := m.Automated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Applied = append(m.Applied, &ResourceResult{})
+			if err := m.Applied[len(m.Applied)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SyncPolicyAutomated) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Hooks = append(m.Hooks, &ResourceResult{})
+			if err := m.Hooks[len(m.Hooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SyncPolicyAutomated: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncPolicyAutomated: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prune", wireType)
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pruned", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17409,17 +25934,28 @@ func (m *SyncPolicyAutomated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Prune = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SelfHeal", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pruned = append(m.Pruned, &ResourceResult{})
+			if err := m.Pruned[len(m.Pruned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Warnings", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17429,12 +25965,21 @@ func (m *SyncPolicyAutomated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SelfHeal = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Warnings = append(m.Warnings, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -17860,6 +26405,223 @@ func (m *SyncStrategyHook) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SyncVerification) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncVerification: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncVerification: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = SyncVerificationPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SyncedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deadline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RolledBack", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RolledBack = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *TLSClientConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -18158,292 +26920,428 @@ var (
 )
 
 func init() {
-	proto.RegisterFile("github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1/generated.proto", fileDescriptor_generated_a98acdddc8282f86)
-}
-
-var fileDescriptor_generated_a98acdddc8282f86 = []byte{
-	// 4528 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x3c, 0x4d, 0x8c, 0x1c, 0xd9,
-	0x59, 0xae, 0xee, 0x9e, 0xee, 0x9e, 0x6f, 0x7e, 0xec, 0x79, 0x59, 0x6f, 0x3a, 0xa3, 0x8d, 0xc7,
-	0x2a, 0x2b, 0xc9, 0x2e, 0xd9, 0xf4, 0xb0, 0x96, 0x03, 0x0e, 0x48, 0x2c, 0xd3, 0x33, 0xfe, 0x19,
-	0x7b, 0x3c, 0x9e, 0x7d, 0x3d, 0x5e, 0x4b, 0x9b, 0x10, 0xb6, 0x5c, 0xfd, 0xba, 0xbb, 0x3c, 0xdd,
-	0x55, 0xb5, 0x55, 0xd5, 0x6d, 0xf7, 0x42, 0x42, 0x02, 0x04, 0x85, 0xc0, 0x46, 0x08, 0xc4, 0x09,
-	0x45, 0x22, 0x88, 0x0b, 0x2b, 0x2e, 0x5c, 0xc8, 0x8d, 0x43, 0x0e, 0xb0, 0xc7, 0x80, 0x56, 0x28,
-	0x02, 0x64, 0xb1, 0x0e, 0x07, 0x44, 0x0e, 0x80, 0x10, 0x17, 0x9f, 0xd0, 0xfb, 0x7f, 0x55, 0xdd,
-	0xed, 0x69, 0xbb, 0xcb, 0x8e, 0x14, 0x6e, 0x53, 0xef, 0xfb, 0xde, 0xf7, 0x7d, 0xef, 0x7b, 0x3f,
-	0xdf, 0x6f, 0x0f, 0xec, 0x76, 0xbc, 0xa4, 0x3b, 0xb8, 0x53, 0x77, 0x83, 0xfe, 0xa6, 0x13, 0x75,
-	0x82, 0x30, 0x0a, 0xee, 0xb2, 0x3f, 0x3e, 0xe7, 0xb6, 0x36, 0xc3, 0xa3, 0xce, 0xa6, 0x13, 0x7a,
-	0xf1, 0xa6, 0x13, 0x86, 0x3d, 0xcf, 0x75, 0x12, 0x2f, 0xf0, 0x37, 0x87, 0xaf, 0x39, 0xbd, 0xb0,
-	0xeb, 0xbc, 0xb6, 0xd9, 0x21, 0x3e, 0x89, 0x9c, 0x84, 0xb4, 0xea, 0x61, 0x14, 0x24, 0x01, 0xfa,
-	0x82, 0x26, 0x55, 0x97, 0xa4, 0xd8, 0x1f, 0xbf, 0xea, 0xb6, 0xea, 0xe1, 0x51, 0xa7, 0x4e, 0x49,
-	0xd5, 0x0d, 0x52, 0x75, 0x49, 0x6a, 0xfd, 0x73, 0x86, 0x14, 0x9d, 0xa0, 0x13, 0x6c, 0x32, 0x8a,
-	0x77, 0x06, 0x6d, 0xf6, 0xc5, 0x3e, 0xd8, 0x5f, 0x9c, 0xd3, 0xba, 0x7d, 0x74, 0x31, 0xae, 0x7b,
-	0x01, 0x95, 0x6d, 0xd3, 0x0d, 0x22, 0xb2, 0x39, 0x1c, 0x93, 0x66, 0xfd, 0x82, 0xc6, 0xe9, 0x3b,
-	0x6e, 0xd7, 0xf3, 0x49, 0x34, 0xd2, 0x0b, 0xea, 0x93, 0xc4, 0x99, 0x34, 0x6b, 0x73, 0xda, 0xac,
-	0x68, 0xe0, 0x27, 0x5e, 0x9f, 0x8c, 0x4d, 0xf8, 0xb9, 0xe3, 0x26, 0xc4, 0x6e, 0x97, 0xf4, 0x9d,
-	0xec, 0x3c, 0xfb, 0x1d, 0x58, 0xd9, 0xba, 0xdd, 0xdc, 0x1a, 0x24, 0xdd, 0xed, 0xc0, 0x6f, 0x7b,
-	0x1d, 0xf4, 0x79, 0x58, 0x72, 0x7b, 0x83, 0x38, 0x21, 0xd1, 0xbe, 0xd3, 0x27, 0x35, 0xeb, 0xac,
-	0xf5, 0xf2, 0x62, 0xe3, 0x63, 0x1f, 0x3c, 0xd8, 0x38, 0xf1, 0xf0, 0xc1, 0xc6, 0xd2, 0xb6, 0x06,
-	0x61, 0x13, 0x0f, 0xbd, 0x02, 0x95, 0x28, 0xe8, 0x91, 0x2d, 0xbc, 0x5f, 0x2b, 0xb0, 0x29, 0x27,
-	0xc5, 0x94, 0x0a, 0xe6, 0xc3, 0x58, 0xc2, 0xed, 0x7f, 0xb6, 0x00, 0xb6, 0xc2, 0xf0, 0x20, 0x0a,
-	0xee, 0x12, 0x37, 0x41, 0x6f, 0x43, 0x95, 0x6a, 0xa1, 0xe5, 0x24, 0x0e, 0xe3, 0xb6, 0x74, 0xfe,
-	0x67, 0xeb, 0x7c, 0x31, 0x75, 0x73, 0x31, 0x7a, 0xe7, 0x28, 0x76, 0x7d, 0xf8, 0x5a, 0xfd, 0xe6,
-	0x1d, 0x3a, 0xff, 0x06, 0x49, 0x9c, 0x06, 0x12, 0xcc, 0x40, 0x8f, 0x61, 0x45, 0x15, 0x1d, 0x41,
-	0x29, 0x0e, 0x89, 0xcb, 0x04, 0x5b, 0x3a, 0xbf, 0x5b, 0x7f, 0xea, 0xf3, 0x51, 0xd7, 0x62, 0x37,
-	0x43, 0xe2, 0x36, 0x96, 0x05, 0xdb, 0x12, 0xfd, 0xc2, 0x8c, 0x89, 0xfd, 0x4f, 0x16, 0xac, 0x6a,
-	0xb4, 0x3d, 0x2f, 0x4e, 0xd0, 0x97, 0xc6, 0x56, 0x58, 0x9f, 0x6d, 0x85, 0x74, 0x36, 0x5b, 0xdf,
-	0x29, 0xc1, 0xa8, 0x2a, 0x47, 0x8c, 0xd5, 0xdd, 0x85, 0x05, 0x2f, 0x21, 0xfd, 0xb8, 0x56, 0x38,
-	0x5b, 0x7c, 0x79, 0xe9, 0xfc, 0xa5, 0x5c, 0x96, 0xd7, 0x58, 0x11, 0x1c, 0x17, 0x76, 0x29, 0x6d,
-	0xcc, 0x59, 0xd8, 0x7f, 0x53, 0x36, 0x17, 0x47, 0x57, 0x8d, 0x5e, 0x83, 0xa5, 0x38, 0x18, 0x44,
-	0x2e, 0xc1, 0x24, 0x0c, 0xe2, 0x9a, 0x75, 0xb6, 0x48, 0x37, 0x9f, 0x9e, 0x95, 0xa6, 0x1e, 0xc6,
-	0x26, 0x0e, 0xfa, 0x3d, 0x0b, 0x96, 0x5b, 0x24, 0x4e, 0x3c, 0x9f, 0xf1, 0x97, 0x92, 0xbf, 0x31,
-	0x9f, 0xe4, 0x72, 0x70, 0x47, 0x53, 0x6e, 0xbc, 0x20, 0x56, 0xb1, 0x6c, 0x0c, 0xc6, 0x38, 0xc5,
-	0x9c, 0x1e, 0xf8, 0x16, 0x89, 0xdd, 0xc8, 0x0b, 0xe9, 0x77, 0xad, 0x98, 0x3e, 0xf0, 0x3b, 0x1a,
-	0x84, 0x4d, 0x3c, 0x74, 0x04, 0x0b, 0xf4, 0x40, 0xc7, 0xb5, 0x12, 0x13, 0xfe, 0xf2, 0x1c, 0xc2,
-	0x0b, 0x75, 0xd2, 0x8b, 0xa2, 0xf5, 0x4e, 0xbf, 0x62, 0xcc, 0x79, 0xa0, 0xf7, 0x2c, 0xa8, 0x89,
-	0xdb, 0x86, 0x09, 0x57, 0xe5, 0xed, 0xae, 0x97, 0x90, 0x9e, 0x17, 0x27, 0xb5, 0x05, 0x26, 0xc0,
-	0xe6, 0x6c, 0x47, 0xea, 0x4a, 0x14, 0x0c, 0xc2, 0xeb, 0x9e, 0xdf, 0x6a, 0x9c, 0x15, 0x9c, 0x6a,
-	0xdb, 0x53, 0x08, 0xe3, 0xa9, 0x2c, 0xd1, 0x1f, 0x59, 0xb0, 0xee, 0x3b, 0x7d, 0x12, 0x87, 0x0e,
-	0xdd, 0x54, 0x0e, 0x6e, 0xf4, 0x1c, 0xf7, 0x88, 0x49, 0x54, 0x7e, 0x3a, 0x89, 0x6c, 0x21, 0xd1,
-	0xfa, 0xfe, 0x54, 0xd2, 0xf8, 0x31, 0x6c, 0xd1, 0x9f, 0x5a, 0xb0, 0x16, 0x44, 0x61, 0xd7, 0xf1,
-	0x49, 0x4b, 0x42, 0xe3, 0x5a, 0x85, 0xdd, 0xb8, 0x2f, 0xce, 0xb1, 0x3f, 0x37, 0xb3, 0x34, 0x6f,
-	0x04, 0xbe, 0x97, 0x04, 0x51, 0x93, 0x24, 0x89, 0xe7, 0x77, 0xe2, 0xc6, 0xe9, 0x87, 0x0f, 0x36,
-	0xd6, 0xc6, 0xb0, 0xf0, 0xb8, 0x30, 0xf6, 0xdf, 0x16, 0x61, 0xc9, 0x38, 0xab, 0xcf, 0xe1, 0xf1,
-	0xeb, 0xa5, 0x1e, 0xbf, 0x6b, 0xf9, 0xdc, 0xb1, 0x69, 0xaf, 0x1f, 0x4a, 0xa0, 0x1c, 0x27, 0x4e,
-	0x32, 0x88, 0xd9, 0x3d, 0x5a, 0x3a, 0xbf, 0x97, 0x13, 0x3f, 0x46, 0xb3, 0xb1, 0x2a, 0x38, 0x96,
-	0xf9, 0x37, 0x16, 0xbc, 0xd0, 0x3b, 0xb0, 0x18, 0x84, 0xd4, 0xac, 0xd1, 0x0b, 0x5c, 0x62, 0x8c,
-	0x77, 0xe6, 0xd9, 0x6f, 0x49, 0xab, 0xb1, 0xf2, 0xf0, 0xc1, 0xc6, 0xa2, 0xfa, 0xc4, 0x9a, 0x8b,
-	0xed, 0xc2, 0x0b, 0x86, 0x7c, 0xdb, 0x81, 0xdf, 0xf2, 0xd8, 0x86, 0x9e, 0x85, 0x52, 0x32, 0x0a,
-	0xa5, 0xdd, 0x54, 0x2a, 0x3a, 0x1c, 0x85, 0x04, 0x33, 0x08, 0xb5, 0x94, 0x7d, 0x12, 0xc7, 0x4e,
-	0x87, 0x64, 0x2d, 0xe5, 0x0d, 0x3e, 0x8c, 0x25, 0xdc, 0x7e, 0x07, 0x5e, 0x9c, 0xfc, 0xb0, 0xa1,
-	0x4f, 0x43, 0x39, 0x26, 0xd1, 0x90, 0x44, 0x82, 0x91, 0xd6, 0x0c, 0x1b, 0xc5, 0x02, 0x8a, 0x36,
-	0x61, 0x51, 0x5d, 0x18, 0xc1, 0x6e, 0x4d, 0xa0, 0x2e, 0xea, 0x5b, 0xa6, 0x71, 0xec, 0x7f, 0xb1,
-	0xe0, 0xa4, 0xc1, 0xf3, 0x39, 0xd8, 0xaf, 0xa3, 0xb4, 0xfd, 0xba, 0x9c, 0xcf, 0x89, 0x99, 0x62,
-	0xc0, 0xbe, 0x5d, 0x86, 0x35, 0xf3, 0x5c, 0xb1, 0x6b, 0xc9, 0x9c, 0x17, 0x12, 0x06, 0xb7, 0xf0,
-	0x9e, 0x50, 0xa7, 0x76, 0x5e, 0xf8, 0x30, 0x96, 0x70, 0xba, 0xbf, 0xa1, 0x93, 0x74, 0x85, 0x2e,
-	0xd5, 0xfe, 0x1e, 0x38, 0x49, 0x17, 0x33, 0x08, 0xfa, 0x25, 0x58, 0x4d, 0x9c, 0xa8, 0x43, 0x12,
-	0x4c, 0x86, 0x5e, 0x2c, 0x4f, 0xe4, 0x62, 0xe3, 0x45, 0x81, 0xbb, 0x7a, 0x98, 0x82, 0xe2, 0x0c,
-	0x36, 0xf2, 0xa1, 0xd4, 0x25, 0xbd, 0xbe, 0x78, 0xb7, 0x0e, 0x72, 0xba, 0x40, 0x6c, 0xa1, 0x57,
-	0x49, 0xaf, 0xdf, 0xa8, 0x52, 0x79, 0xe9, 0x5f, 0x98, 0xf1, 0x41, 0xbf, 0x69, 0xc1, 0xe2, 0xd1,
-	0x20, 0x4e, 0x82, 0xbe, 0xf7, 0x2e, 0xa9, 0x55, 0x19, 0xd7, 0x5b, 0x79, 0x72, 0xbd, 0x2e, 0x89,
-	0xf3, 0xeb, 0xa4, 0x3e, 0xb1, 0x66, 0x8b, 0xde, 0x85, 0xca, 0x51, 0x1c, 0xf8, 0x3e, 0x49, 0x6a,
-	0x8b, 0x4c, 0x82, 0x66, 0xae, 0x12, 0x70, 0xd2, 0x8d, 0x25, 0xba, 0xa5, 0xe2, 0x03, 0x4b, 0x86,
-	0x4c, 0x01, 0x2d, 0x2f, 0x22, 0x6e, 0x12, 0x44, 0xa3, 0x1a, 0xe4, 0xaf, 0x80, 0x1d, 0x49, 0x9c,
-	0x2b, 0x40, 0x7d, 0x62, 0xcd, 0x16, 0x0d, 0xa1, 0x1c, 0xf6, 0x06, 0x1d, 0xcf, 0xaf, 0x2d, 0x31,
-	0x01, 0x70, 0x9e, 0x02, 0x1c, 0x30, 0xca, 0x0d, 0xa0, 0x0f, 0x04, 0xff, 0x1b, 0x0b, 0x6e, 0xf6,
-	0xdf, 0x59, 0xb0, 0x3e, 0x5d, 0x60, 0x7e, 0x33, 0xdc, 0x41, 0x14, 0xf3, 0x17, 0xad, 0x6a, 0xde,
-	0x0c, 0x36, 0x8c, 0x25, 0x1c, 0x7d, 0x15, 0x2a, 0x77, 0xc5, 0x16, 0x16, 0xf2, 0xdf, 0xc2, 0x6b,
-	0x62, 0x0b, 0x15, 0xff, 0x6b, 0x72, 0x1b, 0x05, 0x53, 0xfb, 0xcf, 0x0b, 0x70, 0x7a, 0xe2, 0x89,
-	0x47, 0x75, 0x80, 0xa1, 0xd3, 0x1b, 0x90, 0xcb, 0x1e, 0xf5, 0xd7, 0xb8, 0x87, 0xba, 0x4a, 0x0d,
-	0xe6, 0x9b, 0x6a, 0x14, 0x1b, 0x18, 0xe8, 0xd7, 0x01, 0x42, 0x27, 0x72, 0xfa, 0x24, 0x21, 0x91,
-	0x7c, 0x96, 0xae, 0xce, 0xb1, 0x18, 0x2a, 0xc4, 0x81, 0x24, 0xa8, 0xcd, 0xb5, 0x1a, 0x8a, 0xb1,
-	0xc1, 0x8f, 0xfa, 0xa3, 0x11, 0xe9, 0x11, 0x27, 0x26, 0x2c, 0x00, 0xcb, 0xf8, 0xa3, 0x58, 0x83,
-	0xb0, 0x89, 0x47, 0x2d, 0x02, 0x5b, 0x42, 0x2c, 0x9e, 0x1b, 0x65, 0x11, 0xd8, 0x22, 0x63, 0x2c,
-	0xa0, 0xf6, 0xff, 0x5a, 0x50, 0x9b, 0xa6, 0x5d, 0x14, 0x42, 0x85, 0xdc, 0x4f, 0xde, 0x74, 0x22,
-	0xae, 0xa6, 0xf9, 0xa2, 0x09, 0x41, 0xf4, 0x4d, 0x27, 0xd2, 0xbb, 0x76, 0x89, 0x53, 0xc7, 0x92,
-	0x0d, 0xea, 0x40, 0x29, 0xe9, 0x39, 0x79, 0x04, 0x2f, 0x06, 0x3b, 0x6d, 0x76, 0xf7, 0xb6, 0x62,
-	0xcc, 0x18, 0xd8, 0xff, 0x30, 0x69, 0xdd, 0xe2, 0x2d, 0xa0, 0x3a, 0x27, 0xfe, 0xd0, 0x8b, 0x02,
-	0xbf, 0x4f, 0xfc, 0x24, 0x1b, 0xf4, 0x5e, 0xd2, 0x20, 0x6c, 0xe2, 0xa1, 0xdf, 0x98, 0x70, 0x50,
-	0xae, 0xcf, 0xb1, 0x04, 0x21, 0xce, 0xcc, 0x67, 0xc5, 0xfe, 0x71, 0x61, 0xc2, 0xed, 0x55, 0x0f,
-	0x2c, 0x3a, 0x0f, 0x40, 0x2d, 0xfb, 0x41, 0x44, 0xda, 0xde, 0x7d, 0xb1, 0x2a, 0x45, 0x72, 0x5f,
-	0x41, 0xb0, 0x81, 0x85, 0x2e, 0x40, 0xd9, 0xeb, 0x3b, 0x1d, 0x42, 0x3d, 0x38, 0x7a, 0x51, 0x5e,
-	0xa2, 0x67, 0x68, 0x97, 0x8d, 0x3c, 0x7a, 0xb0, 0xb1, 0xaa, 0x88, 0xb3, 0x21, 0x2c, 0x70, 0xd1,
-	0x77, 0x2d, 0x58, 0x76, 0x83, 0x7e, 0x3f, 0xf0, 0xf7, 0x9c, 0x3b, 0xa4, 0x27, 0xa3, 0xa2, 0xce,
-	0x33, 0xb1, 0x23, 0xf5, 0x6d, 0x83, 0xd3, 0x25, 0x3f, 0x89, 0x46, 0x3a, 0xd0, 0x33, 0x41, 0x38,
-	0x25, 0xd2, 0xfa, 0xeb, 0xb0, 0x36, 0x36, 0x11, 0x9d, 0x82, 0xe2, 0x11, 0x19, 0x71, 0xdd, 0x60,
-	0xfa, 0x27, 0x7a, 0x01, 0x16, 0xd8, 0x55, 0xe1, 0x26, 0x1e, 0xf3, 0x8f, 0x5f, 0x28, 0x5c, 0xb4,
-	0xec, 0x3f, 0xb1, 0xe0, 0xe3, 0x53, 0xde, 0x56, 0xea, 0x17, 0xf8, 0x3a, 0x5f, 0xa2, 0x0e, 0x20,
-	0xbb, 0xa7, 0x0c, 0x82, 0xbe, 0x0c, 0x45, 0xe2, 0x0f, 0xc5, 0x29, 0xd9, 0x9e, 0x43, 0x31, 0x97,
-	0xfc, 0x21, 0x5f, 0x74, 0xe5, 0xe1, 0x83, 0x8d, 0xe2, 0x25, 0x7f, 0x88, 0x29, 0x61, 0xfb, 0x7b,
-	0x0b, 0x29, 0xcf, 0xad, 0x29, 0xdd, 0x71, 0x26, 0xa5, 0xf0, 0xdb, 0xf6, 0xf2, 0xdc, 0x0f, 0xc3,
-	0xe9, 0xe4, 0xc1, 0xbd, 0xe0, 0x85, 0xbe, 0x69, 0xb1, 0x90, 0x5a, 0x3a, 0xab, 0xc2, 0x1c, 0x3c,
-	0x83, 0xf0, 0xde, 0x8c, 0xd2, 0xe5, 0x20, 0x36, 0x59, 0x53, 0xfb, 0x15, 0xf2, 0xe8, 0x5a, 0x3c,
-	0xa4, 0xea, 0x25, 0x92, 0x41, 0xb7, 0x84, 0xa3, 0x01, 0x40, 0x3c, 0xf2, 0xdd, 0x83, 0xa0, 0xe7,
-	0xb9, 0x23, 0x11, 0x45, 0xcc, 0xf3, 0x1e, 0x35, 0x15, 0x31, 0x6e, 0x6c, 0xf4, 0x37, 0x36, 0x18,
-	0xa1, 0xef, 0x58, 0xb0, 0xe6, 0x75, 0xfc, 0x20, 0x22, 0x3b, 0x5e, 0xbb, 0x4d, 0x22, 0xe2, 0xd3,
-	0xa0, 0x95, 0xc7, 0xf4, 0x87, 0x73, 0xb0, 0x97, 0x31, 0xe7, 0x6e, 0x96, 0x76, 0xe3, 0x13, 0x42,
-	0x05, 0x6b, 0x63, 0x20, 0x3c, 0x2e, 0x09, 0x72, 0xa0, 0xe4, 0xf9, 0xed, 0x40, 0xc4, 0xf4, 0xaf,
-	0xcf, 0x21, 0xd1, 0xae, 0xdf, 0x0e, 0xf4, 0xcd, 0xa0, 0x5f, 0x98, 0x91, 0xb6, 0xff, 0xa7, 0x9a,
-	0x76, 0xca, 0x79, 0x50, 0xf7, 0x2e, 0x2c, 0x46, 0x2a, 0x88, 0xe7, 0xd6, 0x68, 0x37, 0x07, 0x7d,
-	0x88, 0x50, 0x52, 0x45, 0x41, 0x3a, 0x5c, 0xd7, 0xec, 0xa8, 0x55, 0xa2, 0x5b, 0x24, 0x4e, 0xee,
-	0xbc, 0xa7, 0x40, 0xb0, 0xd4, 0xf1, 0xf2, 0xc8, 0xa7, 0xf1, 0xf2, 0xc8, 0x77, 0x51, 0x00, 0xe5,
-	0x2e, 0x71, 0x7a, 0x49, 0x57, 0xc4, 0xcb, 0x57, 0xe6, 0x72, 0x33, 0x28, 0xa1, 0x6c, 0xa8, 0xcc,
-	0x47, 0xb1, 0x60, 0x83, 0x06, 0x50, 0xe9, 0x7a, 0x31, 0xf3, 0x74, 0xf9, 0x13, 0x7d, 0x6d, 0x2e,
-	0x9d, 0xf2, 0x98, 0xe5, 0x2a, 0xa7, 0xa8, 0x2f, 0x97, 0x18, 0xc0, 0x92, 0x17, 0xfa, 0x2d, 0x0b,
-	0xc0, 0x95, 0x41, 0xb2, 0x3c, 0xde, 0x37, 0xf3, 0x79, 0x11, 0x54, 0xf0, 0xad, 0x6d, 0x9b, 0x1a,
-	0x8a, 0xb1, 0xc1, 0x16, 0xbd, 0x0d, 0xcb, 0x11, 0x71, 0x03, 0xdf, 0xf5, 0x7a, 0xa4, 0xb5, 0x95,
-	0xd4, 0xca, 0x4c, 0xe7, 0x3f, 0x33, 0x5b, 0x30, 0x7b, 0xe8, 0xf5, 0x49, 0xe3, 0x14, 0xb5, 0x31,
-	0xd8, 0xa0, 0x81, 0x53, 0x14, 0xd1, 0x37, 0x2c, 0x58, 0x55, 0x49, 0x02, 0xba, 0x15, 0x44, 0xc4,
-	0x71, 0xbb, 0x79, 0xe4, 0x23, 0x18, 0xc1, 0x06, 0xa2, 0x41, 0x64, 0x7a, 0x0c, 0x67, 0x98, 0xa2,
-	0xb7, 0x00, 0x82, 0x3b, 0x2c, 0x07, 0x40, 0xd7, 0x59, 0x7d, 0xe2, 0x75, 0xae, 0xf2, 0x7c, 0x92,
-	0xa4, 0x80, 0x0d, 0x6a, 0xe8, 0x3a, 0x00, 0xbf, 0x27, 0x87, 0xa3, 0x90, 0xb0, 0x70, 0x6d, 0xb1,
-	0xf1, 0x59, 0xa9, 0xf9, 0xa6, 0x82, 0x3c, 0x7a, 0xb0, 0x31, 0xee, 0x8f, 0xb3, 0x3c, 0x88, 0x31,
-	0x1d, 0xdd, 0x87, 0x4a, 0x3c, 0xe8, 0xf7, 0x1d, 0x15, 0x79, 0xdd, 0xc8, 0xc9, 0x44, 0x71, 0xa2,
-	0xfa, 0x48, 0x8a, 0x01, 0x2c, 0xd9, 0xd9, 0x3e, 0xa0, 0x71, 0x7c, 0x74, 0x01, 0x96, 0xc9, 0xfd,
-	0x84, 0x44, 0xbe, 0xd3, 0xbb, 0x85, 0xf7, 0x64, 0xb4, 0xc0, 0xb6, 0xfd, 0x92, 0x31, 0x8e, 0x53,
-	0x58, 0xc8, 0x56, 0x4e, 0x53, 0x81, 0xe1, 0x83, 0x76, 0x9a, 0xa4, 0x8b, 0x64, 0xff, 0x4e, 0x21,
-	0x65, 0x9f, 0x0f, 0x23, 0x42, 0x50, 0x0f, 0x16, 0xfc, 0xa0, 0xa5, 0xde, 0xb7, 0x2b, 0x39, 0xbc,
-	0x6f, 0xfb, 0x41, 0xcb, 0xc8, 0x22, 0xd3, 0xaf, 0x18, 0x73, 0x26, 0xe8, 0xb7, 0x2d, 0x58, 0x91,
-	0x29, 0x49, 0x06, 0x10, 0xce, 0x48, 0x6e, 0x6c, 0x4f, 0x0b, 0xb6, 0x2b, 0x37, 0x4d, 0x2e, 0x38,
-	0xcd, 0xd4, 0xfe, 0x91, 0x95, 0x0a, 0xd4, 0x6e, 0x3b, 0x89, 0xdb, 0xbd, 0x34, 0xa4, 0xfe, 0xf4,
-	0xf5, 0x54, 0xf2, 0xec, 0xe7, 0xcd, 0xe4, 0xd9, 0xa3, 0x07, 0x1b, 0x9f, 0x99, 0x56, 0xe2, 0xba,
-	0x47, 0x29, 0xd4, 0x19, 0x09, 0x23, 0xcf, 0xf6, 0x15, 0x58, 0x32, 0x24, 0x16, 0x4f, 0x79, 0x5e,
-	0xd9, 0x25, 0xe5, 0x79, 0x18, 0x83, 0xd8, 0xe4, 0x67, 0xff, 0x61, 0x11, 0x2a, 0x22, 0xb3, 0x3e,
-	0x73, 0xb6, 0x4e, 0x3a, 0x91, 0x85, 0xa9, 0x4e, 0x64, 0x08, 0x65, 0x97, 0xd5, 0xe9, 0x84, 0xbd,
-	0x98, 0x27, 0x2c, 0x15, 0xd2, 0xf1, 0xba, 0x9f, 0x96, 0x89, 0x7f, 0x63, 0xc1, 0x07, 0xbd, 0x67,
-	0xc1, 0x49, 0x97, 0x86, 0x25, 0xae, 0x7e, 0xd2, 0x4a, 0x73, 0xe7, 0x92, 0xb7, 0xd3, 0x14, 0x1b,
-	0x1f, 0x17, 0xdc, 0x4f, 0x66, 0x00, 0x38, 0xcb, 0x1b, 0xfd, 0x22, 0xac, 0x70, 0x6d, 0xbd, 0x49,
-	0x22, 0x96, 0x5d, 0x5b, 0x60, 0xca, 0x52, 0x47, 0xaf, 0x69, 0x02, 0x71, 0x1a, 0xd7, 0xfe, 0xeb,
-	0x22, 0xac, 0xa4, 0x96, 0x8d, 0x5e, 0x85, 0xea, 0x20, 0xa6, 0x17, 0x59, 0xf9, 0xee, 0x2a, 0x57,
-	0x79, 0x4b, 0x8c, 0x63, 0x85, 0x41, 0xb1, 0x43, 0x27, 0x8e, 0xef, 0x05, 0x51, 0x4b, 0x6c, 0x92,
-	0xc2, 0x3e, 0x10, 0xe3, 0x58, 0x61, 0xd0, 0xa8, 0xf2, 0x0e, 0x71, 0x22, 0x12, 0x1d, 0x06, 0x47,
-	0x64, 0xac, 0xb2, 0xd4, 0xd0, 0x20, 0x6c, 0xe2, 0x31, 0x8d, 0x27, 0xbd, 0x78, 0xbb, 0xe7, 0x11,
-	0x3f, 0xe1, 0x62, 0xe6, 0xa0, 0xf1, 0xc3, 0xbd, 0xa6, 0x49, 0x51, 0x6b, 0x3c, 0x03, 0xc0, 0x59,
-	0xde, 0xe8, 0xeb, 0x16, 0xac, 0x38, 0xf7, 0x62, 0x5d, 0x23, 0x66, 0x2a, 0x9f, 0xef, 0xec, 0xa5,
-	0x6a, 0xce, 0x8d, 0x35, 0xba, 0x71, 0xa9, 0x21, 0x9c, 0xe6, 0x68, 0x7f, 0x68, 0x81, 0xac, 0x3d,
-	0x3f, 0x87, 0x94, 0x74, 0x27, 0x9d, 0x92, 0x6e, 0xcc, 0x7f, 0xc9, 0xa6, 0xa4, 0xa3, 0xf7, 0xa1,
-	0x42, 0x43, 0x52, 0xc7, 0x6f, 0xa1, 0x4f, 0x41, 0xc5, 0xe5, 0x7f, 0x0a, 0x9b, 0xc3, 0x92, 0x95,
-	0x02, 0x8a, 0x25, 0x0c, 0xbd, 0x04, 0x25, 0x27, 0xea, 0x48, 0x3b, 0xc3, 0x72, 0xb9, 0x5b, 0x51,
-	0x27, 0xc6, 0x6c, 0xd4, 0x7e, 0xaf, 0x00, 0xb0, 0x1d, 0xf4, 0x43, 0x27, 0x22, 0xad, 0xc3, 0xe0,
-	0xff, 0x7d, 0xf8, 0x67, 0xff, 0xbe, 0x05, 0x88, 0xea, 0x23, 0xf0, 0x89, 0xaf, 0xd3, 0x2a, 0x68,
-	0x13, 0x16, 0x5d, 0x39, 0x2a, 0x6e, 0xbd, 0x8a, 0x07, 0x14, 0x3a, 0xd6, 0x38, 0x33, 0x3c, 0xcc,
-	0xe7, 0x64, 0xd6, 0x80, 0xdf, 0x72, 0xb5, 0xdd, 0x2c, 0xfb, 0x26, 0x92, 0x08, 0xf6, 0xb7, 0x0b,
-	0xf0, 0x22, 0x3f, 0xd0, 0x37, 0x1c, 0xdf, 0xe9, 0x90, 0x3e, 0x95, 0x6a, 0xd6, 0xfc, 0xc1, 0xdb,
-	0x34, 0x10, 0xf3, 0x64, 0x72, 0x75, 0xae, 0x33, 0xc9, 0xcf, 0x12, 0x3f, 0x3d, 0xbb, 0xbe, 0x97,
-	0x60, 0x46, 0x19, 0x85, 0x50, 0x95, 0xed, 0x21, 0xc2, 0xbc, 0xe4, 0xc1, 0x45, 0x5d, 0xb4, 0x2b,
-	0x82, 0x36, 0x56, 0x5c, 0xec, 0xef, 0x5b, 0x90, 0x7d, 0xf1, 0x99, 0xb1, 0xe4, 0x25, 0xc4, 0xac,
-	0xb1, 0x4c, 0x17, 0xfd, 0x66, 0xaf, 0xa3, 0xa1, 0x2f, 0xc1, 0x92, 0x93, 0x24, 0xa4, 0x1f, 0x26,
-	0xcc, 0x1d, 0x2e, 0x3e, 0x9d, 0x3b, 0x7c, 0x23, 0x68, 0x79, 0x6d, 0x8f, 0xb9, 0xc3, 0x26, 0x39,
-	0xfb, 0x0d, 0xa8, 0xca, 0x94, 0xcc, 0x0c, 0xdb, 0x78, 0x2e, 0x95, 0x5e, 0x9a, 0x72, 0x50, 0x1c,
-	0x58, 0x36, 0xa3, 0xb9, 0x67, 0xa0, 0x13, 0xfb, 0x3d, 0x0b, 0x56, 0x52, 0x89, 0xe9, 0x9c, 0x64,
-	0xa7, 0x56, 0xaf, 0x1d, 0xb0, 0x40, 0x3b, 0xf2, 0x7c, 0xee, 0xa7, 0x54, 0xf5, 0x55, 0xbd, 0xac,
-	0x41, 0xd8, 0xc4, 0xb3, 0x6f, 0x00, 0x4b, 0x09, 0xe4, 0xa5, 0xc1, 0x37, 0xa0, 0x4a, 0xc9, 0xd1,
-	0xd7, 0x36, 0x2f, 0x92, 0x4d, 0xa8, 0x5e, 0xbb, 0x7d, 0xc8, 0x6d, 0xb4, 0x0d, 0x45, 0xcf, 0xe1,
-	0x6f, 0x47, 0x51, 0x9f, 0xf0, 0xdd, 0x38, 0x1e, 0xb0, 0xf3, 0x41, 0x81, 0xe8, 0x1c, 0x14, 0xc9,
-	0xfd, 0x90, 0x91, 0x2c, 0xea, 0xf7, 0xe5, 0xd2, 0xfd, 0xd0, 0x8b, 0x48, 0x4c, 0x91, 0xc8, 0xfd,
-	0xd0, 0x1e, 0x00, 0xe8, 0xc4, 0x75, 0x5e, 0x5b, 0x70, 0x16, 0x4a, 0x6e, 0xd0, 0x22, 0x42, 0xf7,
-	0x8a, 0xcc, 0x76, 0xd0, 0x22, 0x98, 0x41, 0xec, 0x6f, 0x59, 0x70, 0x2a, 0x9b, 0x6d, 0xfe, 0x89,
-	0x3d, 0x8b, 0x7b, 0x70, 0x4a, 0xe5, 0x76, 0x6f, 0x86, 0x3c, 0x54, 0xbf, 0x08, 0xcb, 0x77, 0x06,
-	0x5e, 0xaf, 0x25, 0xbe, 0x85, 0x38, 0x2a, 0xcd, 0xdb, 0x30, 0x60, 0x38, 0x85, 0x69, 0xc7, 0xa0,
-	0x2b, 0xf6, 0xa8, 0x2d, 0x12, 0x39, 0xd6, 0xdc, 0x1e, 0x4b, 0x73, 0xe4, 0xbb, 0xba, 0x31, 0xa0,
-	0x9a, 0xce, 0xe3, 0xd8, 0x7f, 0x56, 0x82, 0x4c, 0x48, 0x8e, 0x06, 0x66, 0x53, 0x82, 0x95, 0x63,
-	0x53, 0x82, 0xda, 0x93, 0x49, 0x8d, 0x09, 0xe8, 0xf3, 0xb0, 0x10, 0x76, 0x9d, 0x58, 0x6e, 0xca,
-	0x86, 0xd4, 0xf8, 0x01, 0x1d, 0x7c, 0x64, 0x66, 0x0e, 0xd8, 0x08, 0xe6, 0xd8, 0xe6, 0xcb, 0x51,
-	0x3c, 0xe6, 0x35, 0xfd, 0x2a, 0x4f, 0x94, 0x62, 0x12, 0x0f, 0x7a, 0x89, 0xf0, 0x4c, 0xf7, 0xf3,
-	0xd2, 0x2c, 0xa7, 0xaa, 0x33, 0xa6, 0xfc, 0x1b, 0x1b, 0x1c, 0xd1, 0x17, 0x61, 0x31, 0x4e, 0x9c,
-	0x28, 0x79, 0xca, 0x14, 0x8e, 0x52, 0x5f, 0x53, 0x12, 0xc1, 0x9a, 0x1e, 0x7a, 0x0b, 0xa0, 0xed,
-	0xf9, 0x5e, 0xdc, 0x65, 0xd4, 0x2b, 0x4f, 0x67, 0x29, 0x2e, 0x2b, 0x0a, 0xd8, 0xa0, 0x66, 0xff,
-	0x32, 0x9c, 0x3d, 0xae, 0x95, 0x88, 0xfa, 0x77, 0xf7, 0x9c, 0xc8, 0x17, 0xd5, 0x56, 0x76, 0xcc,
-	0x6e, 0x3b, 0x91, 0x8f, 0xd9, 0xa8, 0xfd, 0x7e, 0x01, 0x96, 0x8c, 0x6e, 0xb1, 0x19, 0xde, 0x8b,
-	0x4c, 0x77, 0x5b, 0x61, 0xc6, 0xee, 0xb6, 0x97, 0xa1, 0x1a, 0x06, 0x3d, 0xcf, 0xf5, 0x54, 0x1d,
-	0x68, 0x99, 0x05, 0x39, 0x62, 0x0c, 0x2b, 0x28, 0x4a, 0x60, 0xf1, 0xee, 0xbd, 0x84, 0xbd, 0x8a,
-	0xb2, 0xea, 0x33, 0x4f, 0x71, 0x43, 0xbe, 0xb0, 0x7a, 0x9b, 0xe4, 0x48, 0x8c, 0x35, 0x23, 0x64,
-	0x43, 0xb9, 0x13, 0x05, 0x83, 0x90, 0xa7, 0x12, 0x45, 0xc2, 0x85, 0x75, 0x92, 0xc5, 0x58, 0x40,
-	0xec, 0xef, 0x96, 0x01, 0x58, 0xc3, 0xa1, 0xc7, 0x52, 0x90, 0x67, 0xa1, 0x14, 0x91, 0x30, 0xc8,
-	0xea, 0x8a, 0x62, 0x60, 0x06, 0x49, 0xc5, 0x82, 0x85, 0x27, 0x8a, 0x05, 0x8b, 0xc7, 0xc6, 0x82,
-	0x34, 0x6c, 0x8d, 0xbb, 0x07, 0x91, 0x37, 0x74, 0x12, 0x72, 0x9d, 0x8c, 0x44, 0x95, 0x56, 0x87,
-	0xad, 0xcd, 0xab, 0x1a, 0x88, 0xd3, 0xb8, 0x13, 0x63, 0xf0, 0x85, 0x9f, 0x60, 0x0c, 0xde, 0x84,
-	0xd3, 0x9e, 0x1f, 0x13, 0x77, 0x10, 0x89, 0xf2, 0xc2, 0xd5, 0x20, 0x4e, 0xe8, 0xa2, 0xca, 0xec,
-	0xd4, 0x7e, 0x52, 0x10, 0x3a, 0xbd, 0x3b, 0x09, 0x09, 0x4f, 0x9e, 0x4b, 0xf5, 0x29, 0x01, 0xec,
-	0xde, 0x55, 0x0d, 0xbb, 0x2a, 0xc6, 0xb1, 0xc2, 0xa0, 0xb6, 0x8a, 0xf8, 0xce, 0x9d, 0x1e, 0xd9,
-	0x6b, 0xc7, 0x2c, 0xbf, 0x59, 0x35, 0x4c, 0x2c, 0x07, 0x5c, 0x6e, 0x62, 0x8d, 0x83, 0xae, 0xc0,
-	0x9a, 0x0e, 0x6c, 0x49, 0x94, 0xec, 0xd0, 0xd0, 0x91, 0x27, 0x2f, 0x55, 0x41, 0x44, 0x87, 0xc2,
-	0x02, 0x01, 0x8f, 0xcf, 0x41, 0x3b, 0x70, 0x2a, 0x35, 0x48, 0xd7, 0x0d, 0x8c, 0x4e, 0x4d, 0xd0,
-	0x39, 0x95, 0xa2, 0x43, 0x97, 0x3c, 0x36, 0x03, 0x6d, 0x99, 0x31, 0xbe, 0xc3, 0x84, 0x59, 0x62,
-	0x44, 0x26, 0xc4, 0xe5, 0x5b, 0x4c, 0x94, 0x2c, 0xbe, 0x6a, 0x35, 0x5b, 0x9e, 0xda, 0x6a, 0x26,
-	0x9f, 0x87, 0x95, 0x69, 0xcf, 0x83, 0xfd, 0xcd, 0x02, 0x9c, 0xd6, 0x77, 0x84, 0x0a, 0xe7, 0xb5,
-	0xe9, 0x41, 0x61, 0xb5, 0x63, 0x9e, 0x3b, 0x31, 0xda, 0xc0, 0x55, 0x7e, 0xbd, 0xa9, 0x20, 0xd8,
-	0xc0, 0xa2, 0x5b, 0xe8, 0x92, 0x88, 0x25, 0xe1, 0xb2, 0x17, 0x68, 0x5b, 0x8c, 0x63, 0x85, 0xc1,
-	0x3a, 0xcd, 0x49, 0x94, 0x34, 0x07, 0x77, 0xd8, 0x84, 0x4c, 0x7a, 0x64, 0x5b, 0x83, 0xb0, 0x89,
-	0x47, 0x9f, 0x26, 0x57, 0xee, 0x1f, 0xbd, 0x44, 0xcb, 0xfc, 0x69, 0x52, 0x5b, 0xa6, 0xa0, 0x52,
-	0x1c, 0xea, 0x07, 0x8a, 0x2c, 0x51, 0x4a, 0x1c, 0x56, 0x81, 0x52, 0x18, 0xf6, 0x7f, 0x59, 0xf0,
-	0x89, 0x89, 0xaa, 0x78, 0x0e, 0x09, 0x87, 0x41, 0x3a, 0xe1, 0x70, 0x30, 0x57, 0x42, 0x76, 0xc2,
-	0x12, 0xa6, 0xa4, 0x1f, 0xfe, 0xd1, 0x82, 0x55, 0x8d, 0xff, 0x1c, 0xd6, 0xd9, 0xce, 0xaf, 0x57,
-	0x5d, 0xcb, 0xdd, 0x58, 0x1c, 0x5b, 0xd8, 0xfb, 0x6c, 0x61, 0xdc, 0xc4, 0x6e, 0xb9, 0xb2, 0x31,
-	0xf3, 0x18, 0x53, 0x39, 0x84, 0x32, 0x6b, 0xad, 0x90, 0xd2, 0xed, 0xe7, 0x90, 0x16, 0xe7, 0xcc,
-	0x99, 0x8b, 0xad, 0x83, 0x36, 0xf6, 0x19, 0x63, 0xc1, 0xcd, 0xee, 0x43, 0x2d, 0x8d, 0xbe, 0x43,
-	0xa8, 0xd3, 0x30, 0xa3, 0xd4, 0x9b, 0xb0, 0xe8, 0xb0, 0x59, 0x7b, 0x03, 0x27, 0xdb, 0xe1, 0xb9,
-	0x25, 0x01, 0x58, 0xe3, 0xd8, 0x7f, 0x61, 0xc1, 0xc7, 0x26, 0x88, 0x97, 0x63, 0xec, 0x91, 0xe8,
-	0xeb, 0x3c, 0xa5, 0x01, 0xb6, 0x45, 0xda, 0x8e, 0x74, 0x1e, 0x0d, 0x57, 0x73, 0x87, 0x0f, 0x63,
-	0x09, 0xb7, 0xff, 0xc3, 0x82, 0x93, 0x69, 0x59, 0x63, 0x74, 0x0d, 0x10, 0x5f, 0xcc, 0x8e, 0x17,
-	0xbb, 0xc1, 0x90, 0x44, 0x23, 0xba, 0x72, 0x2e, 0xf5, 0xba, 0xa0, 0x84, 0xb6, 0xc6, 0x30, 0xf0,
-	0x84, 0x59, 0xe8, 0x5b, 0x2c, 0x55, 0x25, 0xb5, 0x2d, 0x37, 0xbe, 0x99, 0xdb, 0xc6, 0xeb, 0x9d,
-	0x34, 0x7d, 0x2e, 0xc5, 0x0f, 0x9b, 0xcc, 0xed, 0x0f, 0x0b, 0xb0, 0x2c, 0xa7, 0xef, 0x78, 0xed,
-	0x36, 0xd5, 0x37, 0x73, 0x65, 0xc4, 0xe2, 0x94, 0xbe, 0x99, 0x9f, 0x83, 0x39, 0x8c, 0xea, 0xfb,
-	0xc8, 0xf3, 0x5b, 0xd9, 0x18, 0xec, 0xba, 0xe7, 0xb7, 0x30, 0x83, 0xa4, 0x7b, 0x80, 0x8b, 0xc7,
-	0xf7, 0x00, 0xab, 0x93, 0x50, 0x7a, 0x9c, 0x57, 0xc9, 0xbb, 0x56, 0xb5, 0x2f, 0x62, 0x3c, 0xdd,
-	0x87, 0x1a, 0x84, 0x4d, 0x3c, 0x2a, 0x49, 0xcf, 0x1b, 0x12, 0x3e, 0xa9, 0x9c, 0x96, 0x64, 0x4f,
-	0x02, 0xb0, 0xc6, 0xa1, 0x92, 0xb4, 0xbc, 0x76, 0x9b, 0xf9, 0x03, 0x86, 0x24, 0x54, 0x3b, 0x98,
-	0x41, 0x28, 0x46, 0x37, 0x08, 0x8e, 0x84, 0x0b, 0xa0, 0x30, 0xae, 0x06, 0xc1, 0x11, 0x66, 0x10,
-	0xfb, 0xc7, 0xec, 0x5d, 0x9f, 0xd2, 0x0c, 0x91, 0x97, 0x8e, 0xa5, 0xca, 0x8a, 0x8f, 0xbb, 0xa7,
-	0x7a, 0x17, 0x4a, 0x33, 0xec, 0xc2, 0x05, 0x58, 0xbe, 0x1b, 0x07, 0xfe, 0x41, 0xe0, 0xf9, 0xac,
-	0xbd, 0x6c, 0x41, 0x57, 0x22, 0xaf, 0x35, 0x6f, 0xee, 0xcb, 0x71, 0x9c, 0xc2, 0xb2, 0xbf, 0xbf,
-	0x00, 0x2f, 0xaa, 0x9a, 0x1c, 0x49, 0xee, 0x05, 0xd1, 0x91, 0xe7, 0x77, 0x58, 0x66, 0xe5, 0x3b,
-	0x16, 0x2c, 0xf3, 0xdd, 0x10, 0x3d, 0x5a, 0xbc, 0xe8, 0xe8, 0xe6, 0x51, 0xfd, 0x4b, 0x71, 0xaa,
-	0x1f, 0x1a, 0x5c, 0x32, 0xfd, 0x59, 0x26, 0x08, 0xa7, 0xc4, 0x41, 0xef, 0x02, 0xc8, 0x56, 0xe8,
-	0x76, 0x1e, 0xdd, 0xe0, 0x52, 0x38, 0x4c, 0xda, 0xda, 0x73, 0x39, 0x54, 0x1c, 0xb0, 0xc1, 0x0d,
-	0x7d, 0xc3, 0x82, 0x72, 0x8f, 0x6b, 0xa5, 0xc8, 0x18, 0xff, 0x4a, 0xfe, 0x5a, 0x31, 0xf5, 0xa1,
-	0x6c, 0x81, 0xd0, 0x84, 0x60, 0x8e, 0x30, 0x54, 0x3c, 0xbf, 0x13, 0x91, 0x58, 0xc6, 0x52, 0x9f,
-	0x31, 0xac, 0x6f, 0xdd, 0x0d, 0x22, 0xc2, 0x6c, 0x6d, 0xe0, 0xb4, 0x1a, 0x4e, 0xcf, 0xf1, 0x5d,
-	0x12, 0xed, 0x72, 0x74, 0xfd, 0x88, 0x8a, 0x01, 0x2c, 0x09, 0x8d, 0x95, 0xb4, 0x17, 0x66, 0x29,
-	0x69, 0xaf, 0xbf, 0x0e, 0x6b, 0x63, 0xdb, 0xf8, 0x24, 0xdd, 0x72, 0xeb, 0x5f, 0x80, 0xa5, 0xa7,
-	0x6d, 0xb4, 0xfb, 0x70, 0x41, 0xbf, 0x84, 0xfb, 0x41, 0x8b, 0xd5, 0x72, 0x23, 0xbd, 0x9b, 0xc2,
-	0x31, 0xc9, 0xeb, 0x6c, 0x18, 0xbd, 0xb5, 0x6a, 0x10, 0x9b, 0xfc, 0xe8, 0xc9, 0x0c, 0x9d, 0x88,
-	0xf8, 0xcf, 0xf4, 0x64, 0x1e, 0x28, 0x0e, 0xd8, 0xe0, 0x86, 0x88, 0xe8, 0xbf, 0x2a, 0xce, 0x1d,
-	0x5a, 0xcb, 0x7c, 0xe8, 0xa4, 0x1e, 0x2c, 0x1a, 0x62, 0xae, 0xfa, 0xa9, 0xf3, 0x2a, 0x32, 0x3b,
-	0x6f, 0xe4, 0x7e, 0x11, 0x78, 0x03, 0x4b, 0x7a, 0x0c, 0x67, 0x98, 0xd3, 0xf8, 0x48, 0xee, 0x40,
-	0xba, 0xd0, 0xab, 0xe2, 0x23, 0x9c, 0x06, 0xe3, 0x2c, 0xbe, 0xd1, 0x94, 0x51, 0x9e, 0xd6, 0x94,
-	0x81, 0x8e, 0x54, 0xff, 0x55, 0x25, 0xdf, 0xfe, 0x2b, 0x18, 0xef, 0xbd, 0xb2, 0xbf, 0x67, 0xc1,
-	0x29, 0x29, 0xf5, 0xcd, 0x21, 0x89, 0x22, 0xaf, 0xc5, 0xec, 0x02, 0x07, 0x6b, 0x2f, 0x46, 0xd9,
-	0x85, 0xab, 0x12, 0x80, 0x35, 0x0e, 0x0d, 0x64, 0xc7, 0xfb, 0x05, 0x0b, 0xe9, 0x40, 0x76, 0xa6,
-	0xce, 0xbe, 0x57, 0xa0, 0xc2, 0x5d, 0xa2, 0x38, 0x9b, 0xf2, 0x13, 0xae, 0x16, 0x96, 0x70, 0xfb,
-	0xbf, 0x2d, 0x30, 0x6f, 0xc7, 0x6c, 0x56, 0xf3, 0x15, 0xa8, 0x0c, 0xc5, 0xd6, 0x65, 0x8a, 0x11,
-	0x72, 0xcb, 0x24, 0x5c, 0x19, 0xd8, 0xe2, 0x6c, 0x4e, 0x4c, 0xe9, 0x09, 0x9c, 0x98, 0x85, 0xa9,
-	0x16, 0xf9, 0x93, 0x50, 0x1c, 0x78, 0x2d, 0xe1, 0x87, 0x2c, 0x09, 0x84, 0xe2, 0xad, 0xdd, 0x1d,
-	0x4c, 0xc7, 0xed, 0x7f, 0x2b, 0xea, 0x18, 0x42, 0x64, 0x1e, 0x7f, 0x2a, 0x96, 0x7d, 0x41, 0xd5,
-	0x92, 0xf8, 0xca, 0x5f, 0x4a, 0xd7, 0x92, 0x1e, 0x3d, 0xd8, 0x00, 0xbe, 0x5c, 0x56, 0x2e, 0x98,
-	0x50, 0x59, 0xaa, 0x1c, 0x93, 0x1f, 0xbe, 0x08, 0x55, 0xea, 0x78, 0xb1, 0xa0, 0xbe, 0x9a, 0x62,
-	0x51, 0xbd, 0x2a, 0xc6, 0x1f, 0x19, 0x7f, 0x63, 0x85, 0x8d, 0xb6, 0x60, 0x91, 0xfe, 0xcd, 0x12,
-	0xd3, 0x22, 0x37, 0x73, 0x4e, 0xdd, 0x05, 0x09, 0x98, 0x90, 0xc3, 0xd6, 0xb3, 0xa8, 0xc2, 0x58,
-	0x73, 0x2d, 0x23, 0x01, 0x69, 0x85, 0x35, 0x25, 0x00, 0x6b, 0x1c, 0xfb, 0x23, 0x63, 0x9b, 0x45,
-	0xb5, 0xed, 0xa7, 0x62, 0x9b, 0x2f, 0x66, 0xb6, 0xf9, 0xec, 0xd8, 0x36, 0xaf, 0xea, 0xde, 0xd4,
-	0xd4, 0x56, 0x3f, 0xcf, 0x37, 0xf1, 0x78, 0xff, 0x9d, 0x5b, 0x82, 0x77, 0x06, 0x5e, 0x44, 0xe2,
-	0x83, 0x68, 0xe0, 0x7b, 0x7e, 0x87, 0x1d, 0x8d, 0xaa, 0x69, 0x09, 0x52, 0x60, 0x9c, 0xc5, 0xb7,
-	0xff, 0xaa, 0x40, 0xc3, 0xc8, 0x54, 0xaf, 0x2a, 0x7a, 0x15, 0xaa, 0x91, 0xfc, 0x81, 0x5e, 0x26,
-	0x57, 0xa5, 0x7e, 0x9a, 0xa7, 0x30, 0xd0, 0x97, 0x01, 0x5a, 0x24, 0xec, 0x05, 0x23, 0x56, 0x16,
-	0x28, 0x3d, 0x71, 0x59, 0x40, 0x59, 0xf9, 0x1d, 0x45, 0x05, 0x1b, 0x14, 0xd1, 0x3a, 0x14, 0xbc,
-	0x16, 0xdb, 0xcd, 0x62, 0x03, 0x04, 0x6e, 0x61, 0x77, 0x07, 0x17, 0xbc, 0x96, 0xd1, 0xc5, 0x51,
-	0x7e, 0x7e, 0x5d, 0x1c, 0xf6, 0xdf, 0x33, 0x63, 0xc5, 0x97, 0x7f, 0x43, 0xe6, 0x6f, 0x3e, 0x0d,
-	0x65, 0x67, 0x90, 0x74, 0x83, 0xb1, 0x46, 0xb6, 0x2d, 0x36, 0x8a, 0x05, 0x14, 0xed, 0x41, 0xa9,
-	0x45, 0x63, 0xbc, 0xc2, 0x13, 0x2b, 0x4a, 0xc7, 0x78, 0x34, 0x14, 0x64, 0x54, 0xd0, 0x4b, 0x50,
-	0x4a, 0x9c, 0x8e, 0x2c, 0x44, 0xb0, 0x9a, 0xc8, 0xa1, 0xd3, 0x89, 0x31, 0x1b, 0x35, 0x5f, 0xa6,
-	0xd2, 0x31, 0x35, 0xef, 0xbf, 0x2c, 0xc1, 0x4a, 0xaa, 0xda, 0x94, 0x3a, 0x05, 0xd6, 0xb1, 0xa7,
-	0xe0, 0x1c, 0x2c, 0x84, 0xd1, 0xc0, 0xe7, 0xeb, 0xaa, 0xea, 0x87, 0x81, 0x9e, 0x33, 0x82, 0x39,
-	0x8c, 0xea, 0xa8, 0x15, 0x8d, 0xf0, 0xc0, 0x17, 0xe5, 0x57, 0xa5, 0xa3, 0x1d, 0x36, 0x8a, 0x05,
-	0x14, 0x7d, 0x05, 0x96, 0x63, 0x76, 0x01, 0x23, 0x27, 0x21, 0x1d, 0xf9, 0x8b, 0x83, 0x2b, 0x73,
-	0xf7, 0x9a, 0x73, 0x72, 0xdc, 0xbf, 0x37, 0x47, 0x70, 0x8a, 0x1d, 0xfa, 0xba, 0x65, 0xf6, 0xd7,
-	0x97, 0xe7, 0xce, 0x3b, 0x66, 0xab, 0x78, 0xfc, 0x74, 0x3d, 0xbe, 0xcd, 0x3e, 0x54, 0x27, 0xbb,
-	0xf2, 0x0c, 0x4e, 0x36, 0x4c, 0xe8, 0x4d, 0xfa, 0x2c, 0x2c, 0xf6, 0x1d, 0xdf, 0x6b, 0x93, 0x38,
-	0x89, 0x6b, 0x55, 0x76, 0x9e, 0xd8, 0x6f, 0x32, 0x6f, 0xc8, 0x41, 0xac, 0xe1, 0xf6, 0xd7, 0x2c,
-	0x38, 0x3d, 0x71, 0x59, 0xcf, 0x2d, 0x6b, 0x40, 0x5f, 0xae, 0x8f, 0x4d, 0xa8, 0x8f, 0xa2, 0xe1,
-	0xb3, 0xf9, 0x71, 0x84, 0xa8, 0xbe, 0xae, 0x4c, 0xdd, 0xb1, 0x27, 0x7b, 0x35, 0xf5, 0xcb, 0x55,
-	0x7c, 0x8e, 0x2f, 0xd7, 0xef, 0x5a, 0x60, 0xfc, 0xd8, 0x06, 0xfd, 0x1a, 0x2c, 0x3a, 0x83, 0x24,
-	0xe8, 0x3b, 0x09, 0x69, 0x89, 0xc8, 0x71, 0x3f, 0x97, 0x9f, 0xf5, 0x6c, 0x49, 0xaa, 0x5c, 0x5f,
-	0xea, 0x13, 0x6b, 0x7e, 0x76, 0x97, 0x6f, 0x5f, 0x66, 0x82, 0x7e, 0x48, 0xac, 0xc7, 0x3c, 0x24,
-	0xaf, 0x42, 0x35, 0x26, 0xbd, 0x36, 0x35, 0x98, 0xe2, 0xc1, 0x51, 0xba, 0x6e, 0x8a, 0x71, 0xac,
-	0x30, 0xec, 0xff, 0x14, 0xab, 0x16, 0x3e, 0xcc, 0xc5, 0x4c, 0xc7, 0xd0, 0xec, 0xe6, 0x7f, 0x04,
-	0xe0, 0xaa, 0x16, 0xc2, 0x1c, 0x7e, 0x01, 0xa3, 0xfb, 0x11, 0xcd, 0xdf, 0x67, 0xc8, 0x31, 0x6c,
-	0x30, 0x4b, 0x9d, 0xae, 0xe2, 0x71, 0xa7, 0xcb, 0xfe, 0x77, 0x0b, 0x52, 0x0f, 0x1c, 0xea, 0xc3,
-	0x02, 0x95, 0x60, 0x94, 0x43, 0xb7, 0xa3, 0x49, 0x97, 0x9e, 0x3c, 0x51, 0x64, 0x60, 0x7f, 0x62,
-	0xce, 0x05, 0x79, 0xc2, 0x75, 0xe1, 0x2a, 0xba, 0x9e, 0x13, 0x37, 0xea, 0xf9, 0x88, 0xdf, 0xe8,
-	0xeb, 0x1c, 0xe6, 0x45, 0x58, 0x1b, 0x93, 0x88, 0x1e, 0x22, 0xd6, 0x40, 0x95, 0x3d, 0x44, 0xac,
-	0xc5, 0x0a, 0x73, 0x98, 0xfd, 0xbe, 0x05, 0xa7, 0xb2, 0xe4, 0xd1, 0x1f, 0x5b, 0xb0, 0x16, 0x67,
-	0xe9, 0x3d, 0x13, 0xad, 0xa9, 0x88, 0x74, 0x0c, 0x84, 0xc7, 0x25, 0xa0, 0x3b, 0x9a, 0x6d, 0x47,
-	0x4e, 0x95, 0x85, 0xad, 0x63, 0xcb, 0xc2, 0xe9, 0xaa, 0x65, 0x61, 0xa6, 0xaa, 0xa5, 0x59, 0x50,
-	0x2c, 0x3e, 0xb6, 0xa0, 0xf8, 0x29, 0xa8, 0x1c, 0x91, 0x91, 0x51, 0x79, 0xe4, 0xff, 0x50, 0x80,
-	0x0f, 0x61, 0x09, 0x43, 0x36, 0x94, 0x5d, 0x5e, 0xd2, 0x5d, 0x60, 0x58, 0xcc, 0x10, 0x89, 0x2a,
-	0xae, 0x80, 0x34, 0xea, 0x1f, 0x7c, 0x74, 0xe6, 0xc4, 0x0f, 0x3e, 0x3a, 0x73, 0xe2, 0x87, 0x1f,
-	0x9d, 0x39, 0xf1, 0xb5, 0x87, 0x67, 0xac, 0x0f, 0x1e, 0x9e, 0xb1, 0x7e, 0xf0, 0xf0, 0x8c, 0xf5,
-	0xc3, 0x87, 0x67, 0xac, 0x7f, 0x7d, 0x78, 0xc6, 0xfa, 0x83, 0x1f, 0x9d, 0x39, 0xf1, 0x56, 0x55,
-	0xaa, 0xf6, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x86, 0x4a, 0xa0, 0xa4, 0x20, 0x4d, 0x00, 0x00,
+	proto.RegisterFile("github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1/generated.proto", fileDescriptor_generated_947ac14459ab55d5)
+}
+
+var fileDescriptor_generated_947ac14459ab55d5 = []byte{
+	// 6692 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7d, 0x5d, 0x8c, 0x1c, 0xd9,
+	0x55, 0xf0, 0x56, 0x77, 0xcf, 0xf4, 0xf4, 0x99, 0x1f, 0xcf, 0xd4, 0xda, 0x9b, 0x8e, 0xb3, 0xf1,
+	0x58, 0xb5, 0xdf, 0x26, 0xbb, 0x5f, 0x92, 0x31, 0xbb, 0xda, 0x05, 0x07, 0x50, 0xc2, 0xf4, 0x8c,
+	0xbd, 0x1e, 0x7b, 0x6c, 0xcf, 0x9e, 0x9e, 0x5d, 0x47, 0x49, 0x08, 0xa9, 0xe9, 0xba, 0xdd, 0x53,
+	0x3b, 0xdd, 0x55, 0xed, 0xaa, 0xea, 0xb1, 0x67, 0xc9, 0x1f, 0x10, 0x48, 0x08, 0xbb, 0x40, 0x84,
+	0x10, 0x08, 0x14, 0x89, 0x20, 0x5e, 0xc8, 0x13, 0x4f, 0xc0, 0x13, 0x88, 0x3c, 0x84, 0x7d, 0xe0,
+	0x21, 0x44, 0x11, 0x0a, 0x3f, 0x32, 0xac, 0xc3, 0x03, 0x22, 0x48, 0x01, 0x21, 0x14, 0xc9, 0x12,
+	0x12, 0xba, 0xff, 0xf7, 0x56, 0xf7, 0x78, 0x7e, 0xba, 0xec, 0x5d, 0xc2, 0xd3, 0x74, 0x9d, 0x73,
+	0xee, 0x39, 0xf7, 0xff, 0x9e, 0x7b, 0x7e, 0xee, 0xc0, 0x5a, 0x27, 0xcc, 0xb6, 0x07, 0x5b, 0x4b,
+	0xad, 0xb8, 0x77, 0xce, 0x4f, 0x3a, 0x71, 0x3f, 0x89, 0x5f, 0x61, 0x3f, 0x3e, 0xd0, 0x0a, 0xce,
+	0xf5, 0x77, 0x3a, 0xe7, 0xfc, 0x7e, 0x98, 0x9e, 0xf3, 0xfb, 0xfd, 0x6e, 0xd8, 0xf2, 0xb3, 0x30,
+	0x8e, 0xce, 0xed, 0x3e, 0xe3, 0x77, 0xfb, 0xdb, 0xfe, 0x33, 0xe7, 0x3a, 0x24, 0x22, 0x89, 0x9f,
+	0x91, 0x60, 0xa9, 0x9f, 0xc4, 0x59, 0xec, 0x7e, 0x50, 0xb3, 0x5a, 0x92, 0xac, 0xd8, 0x8f, 0x9f,
+	0x69, 0x05, 0x4b, 0xfd, 0x9d, 0xce, 0x12, 0x65, 0xb5, 0x64, 0xb0, 0x5a, 0x92, 0xac, 0x4e, 0x7f,
+	0xc0, 0xa8, 0x45, 0x27, 0xee, 0xc4, 0xe7, 0x18, 0xc7, 0xad, 0x41, 0x9b, 0x7d, 0xb1, 0x0f, 0xf6,
+	0x8b, 0x4b, 0x3a, 0xed, 0xed, 0x9c, 0x4f, 0x97, 0xc2, 0x98, 0xd6, 0xed, 0x5c, 0x2b, 0x4e, 0xc8,
+	0xb9, 0xdd, 0xa1, 0xda, 0x9c, 0x7e, 0x4e, 0xd3, 0xf4, 0xfc, 0xd6, 0x76, 0x18, 0x91, 0x64, 0x4f,
+	0x37, 0xa8, 0x47, 0x32, 0x7f, 0x54, 0xa9, 0x73, 0xfb, 0x95, 0x4a, 0x06, 0x51, 0x16, 0xf6, 0xc8,
+	0x50, 0x81, 0x1f, 0x3d, 0xa8, 0x40, 0xda, 0xda, 0x26, 0x3d, 0x3f, 0x5f, 0xce, 0xbb, 0x09, 0xb3,
+	0xcb, 0x37, 0x9a, 0xcb, 0x83, 0x6c, 0x7b, 0x25, 0x8e, 0xda, 0x61, 0xc7, 0x7d, 0x1e, 0xa6, 0x5b,
+	0xdd, 0x41, 0x9a, 0x91, 0xe4, 0x9a, 0xdf, 0x23, 0x75, 0xe7, 0xac, 0xf3, 0x54, 0xad, 0xf1, 0xe8,
+	0x1b, 0x77, 0x16, 0x1f, 0xb9, 0x7b, 0x67, 0x71, 0x7a, 0x45, 0xa3, 0xd0, 0xa4, 0x73, 0x9f, 0x86,
+	0x6a, 0x12, 0x77, 0xc9, 0x32, 0x5e, 0xab, 0x97, 0x58, 0x91, 0x13, 0xa2, 0x48, 0x15, 0x39, 0x18,
+	0x25, 0xde, 0xfb, 0x7b, 0x07, 0x60, 0xb9, 0xdf, 0xdf, 0x48, 0xe2, 0x57, 0x48, 0x2b, 0x73, 0x3f,
+	0x09, 0x53, 0xb4, 0x17, 0x02, 0x3f, 0xf3, 0x99, 0xb4, 0xe9, 0x67, 0x7f, 0x64, 0x89, 0x37, 0x66,
+	0xc9, 0x6c, 0x8c, 0x1e, 0x39, 0x4a, 0xbd, 0xb4, 0xfb, 0xcc, 0xd2, 0xf5, 0x2d, 0x5a, 0xfe, 0x2a,
+	0xc9, 0xfc, 0x86, 0x2b, 0x84, 0x81, 0x86, 0xa1, 0xe2, 0xea, 0xee, 0x40, 0x25, 0xed, 0x93, 0x16,
+	0xab, 0xd8, 0xf4, 0xb3, 0x6b, 0x4b, 0xc7, 0x9e, 0x1f, 0x4b, 0xba, 0xda, 0xcd, 0x3e, 0x69, 0x35,
+	0x66, 0x84, 0xd8, 0x0a, 0xfd, 0x42, 0x26, 0xc4, 0xfb, 0x3b, 0x07, 0xe6, 0x34, 0xd9, 0x7a, 0x98,
+	0x66, 0xee, 0xc7, 0x87, 0x5a, 0xb8, 0x74, 0xb8, 0x16, 0xd2, 0xd2, 0xac, 0x7d, 0xf3, 0x42, 0xd0,
+	0x94, 0x84, 0x18, 0xad, 0x7b, 0x05, 0x26, 0xc2, 0x8c, 0xf4, 0xd2, 0x7a, 0xe9, 0x6c, 0xf9, 0xa9,
+	0xe9, 0x67, 0x2f, 0x14, 0xd2, 0xbc, 0xc6, 0xac, 0x90, 0x38, 0xb1, 0x46, 0x79, 0x23, 0x17, 0xe1,
+	0xfd, 0x6d, 0xcd, 0x6c, 0x1c, 0x6d, 0xb5, 0xfb, 0x0c, 0x4c, 0xa7, 0xf1, 0x20, 0x69, 0x11, 0x24,
+	0xfd, 0x38, 0xad, 0x3b, 0x67, 0xcb, 0x74, 0xf0, 0xe9, 0x5c, 0x69, 0x6a, 0x30, 0x9a, 0x34, 0xee,
+	0xaf, 0x38, 0x30, 0x13, 0x90, 0x34, 0x0b, 0x23, 0x26, 0x5f, 0xd6, 0xfc, 0xc5, 0xf1, 0x6a, 0x2e,
+	0x81, 0xab, 0x9a, 0x73, 0xe3, 0xa4, 0x68, 0xc5, 0x8c, 0x01, 0x4c, 0xd1, 0x12, 0x4e, 0x27, 0x7c,
+	0x40, 0xd2, 0x56, 0x12, 0xf6, 0xe9, 0x77, 0xbd, 0x6c, 0x4f, 0xf8, 0x55, 0x8d, 0x42, 0x93, 0xce,
+	0xdd, 0x81, 0x09, 0x3a, 0xa1, 0xd3, 0x7a, 0x85, 0x55, 0xfe, 0xe2, 0x18, 0x95, 0x17, 0xdd, 0x49,
+	0x17, 0x8a, 0xee, 0x77, 0xfa, 0x95, 0x22, 0x97, 0xe1, 0xbe, 0xee, 0x40, 0x5d, 0xac, 0x36, 0x24,
+	0xbc, 0x2b, 0x6f, 0x6c, 0x87, 0x19, 0xe9, 0x86, 0x69, 0x56, 0x9f, 0x60, 0x15, 0x38, 0x77, 0xb8,
+	0x29, 0xf5, 0x42, 0x12, 0x0f, 0xfa, 0x57, 0xc2, 0x28, 0x68, 0x9c, 0x15, 0x92, 0xea, 0x2b, 0xfb,
+	0x30, 0xc6, 0x7d, 0x45, 0xba, 0xbf, 0xe1, 0xc0, 0xe9, 0xc8, 0xef, 0x91, 0xb4, 0xef, 0xb7, 0x88,
+	0x44, 0x37, 0xba, 0x7e, 0x6b, 0x87, 0xd5, 0x68, 0xf2, 0x78, 0x35, 0xf2, 0x44, 0x8d, 0x4e, 0x5f,
+	0xdb, 0x97, 0x35, 0xde, 0x47, 0xac, 0xfb, 0x7b, 0x0e, 0x2c, 0xc4, 0x49, 0x7f, 0xdb, 0x8f, 0x48,
+	0x20, 0xb1, 0x69, 0xbd, 0xca, 0x56, 0xdc, 0xc7, 0xc6, 0x18, 0x9f, 0xeb, 0x79, 0x9e, 0x57, 0xe3,
+	0x28, 0xcc, 0xe2, 0xa4, 0x49, 0xb2, 0x2c, 0x8c, 0x3a, 0x69, 0xe3, 0xd4, 0xdd, 0x3b, 0x8b, 0x0b,
+	0x43, 0x54, 0x38, 0x5c, 0x19, 0x77, 0x00, 0x90, 0xee, 0x45, 0xad, 0x8d, 0xb8, 0x1b, 0xb6, 0xf6,
+	0xea, 0x53, 0x67, 0x9d, 0x31, 0x57, 0x6c, 0x53, 0x31, 0x6b, 0xcc, 0xd1, 0xfd, 0x4f, 0x7f, 0xa3,
+	0x21, 0xc8, 0x5d, 0x87, 0x93, 0xbc, 0x06, 0xab, 0xa4, 0x95, 0xec, 0xb1, 0x09, 0x7c, 0x85, 0xec,
+	0xa5, 0xf5, 0x1a, 0x5b, 0xad, 0xf5, 0xbb, 0x77, 0x16, 0x4f, 0x36, 0x47, 0xe0, 0x71, 0x64, 0x29,
+	0x77, 0x03, 0x4e, 0xb6, 0xfd, 0xb0, 0x7b, 0x3d, 0x6a, 0x6e, 0xfb, 0x89, 0x6e, 0x5d, 0x1d, 0xce,
+	0x3a, 0x4f, 0x4d, 0x35, 0x1e, 0x17, 0xa3, 0x78, 0xf2, 0xe2, 0x08, 0x1a, 0x1c, 0x59, 0xd2, 0xfd,
+	0x39, 0x07, 0x66, 0x7b, 0x7e, 0x14, 0xb6, 0x49, 0x9a, 0xbd, 0x38, 0x88, 0x33, 0xbf, 0x3e, 0xcd,
+	0xba, 0xe6, 0xd2, 0x18, 0x5d, 0x73, 0xd5, 0xe4, 0xd7, 0x58, 0xb8, 0x7b, 0x67, 0x71, 0xd6, 0x02,
+	0xa1, 0x2d, 0xd1, 0xfb, 0x46, 0x19, 0xa6, 0x8d, 0x6d, 0xe4, 0x21, 0x9c, 0x4b, 0x5d, 0xeb, 0x5c,
+	0xba, 0x5c, 0xcc, 0xf6, 0xb7, 0xdf, 0xc1, 0xe4, 0x66, 0x30, 0x99, 0x66, 0x7e, 0x36, 0x48, 0xd9,
+	0x16, 0x37, 0xfd, 0xec, 0x7a, 0x41, 0xf2, 0x18, 0xcf, 0xc6, 0x9c, 0x90, 0x38, 0xc9, 0xbf, 0x51,
+	0xc8, 0x72, 0x6f, 0x42, 0x2d, 0xee, 0x53, 0x8d, 0x83, 0xee, 0xad, 0x15, 0x26, 0x78, 0x75, 0x9c,
+	0xa5, 0x28, 0x79, 0x35, 0x66, 0xef, 0xde, 0x59, 0xac, 0xa9, 0x4f, 0xd4, 0x52, 0xbc, 0x16, 0x9c,
+	0x34, 0xea, 0xb7, 0x12, 0x47, 0x41, 0xc8, 0x06, 0xf4, 0x2c, 0x54, 0xb2, 0xbd, 0xbe, 0x54, 0x69,
+	0x54, 0x17, 0x6d, 0xee, 0xf5, 0x09, 0x32, 0x0c, 0x55, 0x62, 0x7a, 0x24, 0x4d, 0xfd, 0x0e, 0xc9,
+	0x2b, 0x31, 0x57, 0x39, 0x18, 0x25, 0xde, 0xbb, 0x09, 0x8f, 0x8d, 0x3e, 0x73, 0xdc, 0xf7, 0xc0,
+	0x64, 0x4a, 0x92, 0x5d, 0x92, 0x08, 0x41, 0xba, 0x67, 0x18, 0x14, 0x05, 0xd6, 0x3d, 0x07, 0x35,
+	0xb5, 0x97, 0x09, 0x71, 0x0b, 0x82, 0xb4, 0xa6, 0x37, 0x40, 0x4d, 0xe3, 0xfd, 0x83, 0x03, 0x27,
+	0x0c, 0x99, 0x0f, 0x41, 0xb5, 0xd8, 0xb1, 0x55, 0x8b, 0x8b, 0xc5, 0xcc, 0x98, 0x7d, 0x74, 0x8b,
+	0xd7, 0xaa, 0xb0, 0x60, 0xce, 0x2b, 0xbe, 0x33, 0x50, 0xbd, 0x92, 0xf4, 0xe3, 0x97, 0x70, 0xbd,
+	0xee, 0xd8, 0x43, 0x82, 0x1c, 0x8c, 0x12, 0x4f, 0xc7, 0xb7, 0xef, 0x67, 0xdb, 0xf5, 0x92, 0x3d,
+	0xbe, 0x1b, 0x7e, 0xb6, 0x8d, 0x0c, 0xe3, 0x7e, 0x08, 0xe6, 0x32, 0x3f, 0xe9, 0x90, 0x0c, 0xc9,
+	0x6e, 0x98, 0xca, 0x19, 0x59, 0x6b, 0x3c, 0x26, 0x68, 0xe7, 0x36, 0x2d, 0x2c, 0xe6, 0xa8, 0xdd,
+	0x08, 0x2a, 0xdb, 0xa4, 0xdb, 0x13, 0x47, 0xca, 0x46, 0x41, 0x0b, 0x88, 0x35, 0xf4, 0x12, 0xe9,
+	0xf6, 0x1a, 0x53, 0xb4, 0xbe, 0xf4, 0x17, 0x32, 0x39, 0xee, 0xcf, 0x3b, 0x50, 0xdb, 0x19, 0xa4,
+	0x59, 0xdc, 0x0b, 0x5f, 0x25, 0xe2, 0xb4, 0x78, 0xa9, 0x48, 0xa9, 0x57, 0x24, 0x73, 0xbe, 0x9c,
+	0xd4, 0x27, 0x6a, 0xb1, 0xee, 0xab, 0x50, 0xdd, 0x49, 0xe3, 0x28, 0x22, 0x59, 0xbd, 0xc6, 0x6a,
+	0xd0, 0x2c, 0xb4, 0x06, 0x9c, 0x75, 0x63, 0x9a, 0x0e, 0xa9, 0xf8, 0x40, 0x29, 0x90, 0x75, 0x40,
+	0x10, 0x26, 0xa4, 0x95, 0xc5, 0xc9, 0x5e, 0x1d, 0x8a, 0xef, 0x80, 0x55, 0xc9, 0x9c, 0x77, 0x80,
+	0xfa, 0x44, 0x2d, 0xd6, 0xdd, 0x85, 0xc9, 0x7e, 0x77, 0xd0, 0x09, 0x23, 0x71, 0x28, 0x61, 0x91,
+	0x15, 0xd8, 0x60, 0x9c, 0x1b, 0x40, 0x37, 0x08, 0xfe, 0x1b, 0x85, 0x34, 0xf7, 0x53, 0x50, 0xed,
+	0xfb, 0x59, 0x6b, 0x9b, 0xa4, 0xf5, 0x99, 0x22, 0x15, 0x64, 0x21, 0x98, 0xb2, 0xd6, 0xab, 0x69,
+	0x83, 0x4b, 0x42, 0x29, 0xd2, 0xfb, 0x4b, 0x07, 0x4e, 0xef, 0xdf, 0x5d, 0x7c, 0x5d, 0xb6, 0x06,
+	0x49, 0xca, 0xf7, 0xd3, 0x29, 0x73, 0x5d, 0x32, 0x30, 0x4a, 0xbc, 0xfb, 0x19, 0xa8, 0xbe, 0x22,
+	0x26, 0x50, 0xa9, 0xf8, 0x09, 0x74, 0x59, 0x4c, 0x20, 0x25, 0xff, 0xb2, 0x9c, 0x44, 0x42, 0xa8,
+	0xf7, 0xd7, 0x15, 0x38, 0x35, 0x72, 0xbd, 0xb9, 0x4b, 0x00, 0xbb, 0x7e, 0x77, 0x40, 0x2e, 0x86,
+	0x5d, 0x22, 0xaf, 0x2e, 0x4c, 0x8d, 0x7a, 0x59, 0x41, 0xd1, 0xa0, 0x70, 0x3f, 0x05, 0xd0, 0xf7,
+	0x13, 0xbf, 0x47, 0x32, 0x92, 0xc8, 0x4d, 0x71, 0x1c, 0x15, 0x85, 0x56, 0x62, 0x43, 0x32, 0xd4,
+	0xca, 0x82, 0x02, 0xa5, 0x68, 0xc8, 0xa3, 0x17, 0x95, 0x84, 0x74, 0x89, 0x9f, 0x12, 0x76, 0x33,
+	0xcf, 0x5d, 0x54, 0x50, 0xa3, 0xd0, 0xa4, 0xa3, 0xe7, 0x11, 0x6b, 0x42, 0x5a, 0xaf, 0xd8, 0xe7,
+	0x11, 0x6b, 0x64, 0x8a, 0x02, 0x4b, 0xd9, 0x87, 0x51, 0xab, 0x3b, 0x08, 0xc8, 0x0a, 0xae, 0xa6,
+	0xf5, 0x09, 0x36, 0xaa, 0x8a, 0xfd, 0x9a, 0x46, 0xa1, 0x49, 0xe7, 0xbe, 0xe6, 0xc0, 0x5c, 0x3b,
+	0xec, 0x12, 0x5d, 0x69, 0xa1, 0xfe, 0xaf, 0x8f, 0xd9, 0x31, 0x17, 0x4d, 0xa6, 0x7a, 0x8b, 0xb6,
+	0xc0, 0x29, 0xe6, 0x64, 0xbb, 0x04, 0xde, 0xe5, 0x77, 0xbb, 0xf1, 0x2d, 0x3d, 0x82, 0xd7, 0x07,
+	0x59, 0x1a, 0x06, 0x64, 0x65, 0xdb, 0x4f, 0x32, 0xb6, 0x73, 0x4f, 0x35, 0x9e, 0x10, 0xcc, 0xde,
+	0xb5, 0xbc, 0x3f, 0x29, 0xde, 0x8f, 0x8f, 0xf7, 0x5f, 0x0e, 0xd4, 0xf7, 0x9b, 0x8a, 0x6e, 0x1f,
+	0xaa, 0xe4, 0x76, 0xf6, 0xb2, 0x9f, 0xf0, 0x39, 0x35, 0x9e, 0x86, 0x2f, 0x98, 0xbe, 0xec, 0x27,
+	0x7a, 0x8a, 0x5f, 0xe0, 0xdc, 0x51, 0x8a, 0x71, 0x3b, 0x50, 0xc9, 0xba, 0x7e, 0x11, 0x26, 0x00,
+	0x43, 0x9c, 0xd6, 0x90, 0xd6, 0x97, 0x53, 0x64, 0x02, 0xbc, 0x6f, 0x8d, 0x6a, 0xb7, 0xd8, 0xb6,
+	0xe9, 0x0c, 0x22, 0xd1, 0x6e, 0x98, 0xc4, 0x51, 0x8f, 0x44, 0x59, 0xde, 0x74, 0x74, 0x41, 0xa3,
+	0xd0, 0xa4, 0x73, 0x3f, 0x3b, 0x62, 0x55, 0x5d, 0x19, 0xa3, 0x09, 0xa2, 0x3a, 0x87, 0x5e, 0x58,
+	0xde, 0xf7, 0x4a, 0x23, 0xb6, 0x3a, 0x75, 0x16, 0xba, 0xcf, 0x02, 0x50, 0x25, 0x6c, 0x23, 0x21,
+	0xed, 0xf0, 0xb6, 0x68, 0x95, 0x62, 0x79, 0x4d, 0x61, 0xd0, 0xa0, 0x72, 0x9f, 0x83, 0xc9, 0xb0,
+	0xe7, 0x77, 0x08, 0x55, 0xb6, 0xe9, 0xae, 0xf2, 0x38, 0x5d, 0x70, 0x6b, 0x0c, 0x72, 0xef, 0xce,
+	0xe2, 0x9c, 0x62, 0xce, 0x40, 0x28, 0x68, 0xdd, 0xaf, 0x3a, 0x30, 0xd3, 0x8a, 0x7b, 0xbd, 0x38,
+	0x5a, 0xf7, 0xb7, 0x48, 0x57, 0xda, 0x16, 0x3a, 0x0f, 0xe4, 0xc8, 0x5f, 0x5a, 0x31, 0x24, 0x5d,
+	0x88, 0xb2, 0x64, 0x4f, 0x9b, 0x4b, 0x4c, 0x14, 0x5a, 0x55, 0x3a, 0xfd, 0x61, 0x58, 0x18, 0x2a,
+	0xe8, 0xce, 0x43, 0x79, 0x87, 0xec, 0xf1, 0xbe, 0x41, 0xfa, 0xd3, 0x3d, 0x09, 0x13, 0x6c, 0x5f,
+	0xe1, 0xda, 0x18, 0xf2, 0x8f, 0x1f, 0x2f, 0x9d, 0x77, 0xbc, 0x3f, 0x73, 0xe0, 0xb1, 0xa1, 0x5a,
+	0xb1, 0xe3, 0xc7, 0xfd, 0x2c, 0x4c, 0x72, 0x8d, 0x4b, 0xe8, 0xb2, 0x37, 0x0a, 0x3f, 0xf0, 0xb8,
+	0x82, 0xa7, 0xf7, 0x40, 0xfe, 0x8d, 0x42, 0xac, 0xfb, 0x04, 0x4c, 0xb0, 0xf3, 0x4f, 0xe8, 0x90,
+	0x4a, 0x51, 0x65, 0x65, 0x91, 0xe3, 0xbc, 0x3f, 0x75, 0xe0, 0xf1, 0xfb, 0x71, 0xa7, 0x5c, 0x3a,
+	0xd4, 0xa8, 0x51, 0x77, 0x6c, 0x2e, 0xcc, 0xd2, 0x81, 0x1c, 0x47, 0xb5, 0xd5, 0x9d, 0x30, 0x0a,
+	0xf2, 0xda, 0x2a, 0x35, 0x84, 0x20, 0xc3, 0x50, 0x8a, 0x48, 0x6f, 0xf4, 0x8a, 0x82, 0xed, 0xf0,
+	0x0c, 0x63, 0x5f, 0x21, 0x2a, 0x87, 0xb8, 0x42, 0xfc, 0xae, 0x03, 0xef, 0xd8, 0x47, 0x05, 0x51,
+	0xe2, 0x9c, 0x7d, 0xc5, 0x7d, 0x02, 0xca, 0x24, 0xda, 0x15, 0x2b, 0x74, 0x65, 0x8c, 0xb1, 0xb9,
+	0x10, 0xed, 0xf2, 0x09, 0x57, 0xbd, 0x7b, 0x67, 0xb1, 0x7c, 0x21, 0xda, 0x45, 0xca, 0xd8, 0xfb,
+	0xcf, 0x9a, 0x75, 0xc1, 0x69, 0xca, 0x5b, 0x2b, 0xb7, 0x2e, 0x38, 0x85, 0xde, 0x5a, 0x19, 0x4f,
+	0xe3, 0x6e, 0xc6, 0xbe, 0x51, 0xc8, 0x72, 0xbf, 0xe8, 0x30, 0xa3, 0xa0, 0xbc, 0xd3, 0x09, 0xbd,
+	0xe5, 0x01, 0x18, 0x28, 0x4d, 0x3b, 0xa3, 0x04, 0xa2, 0x29, 0x9a, 0x2a, 0x5a, 0x7d, 0x6e, 0x1f,
+	0x14, 0x13, 0x41, 0xab, 0x6c, 0x1c, 0x8c, 0x12, 0x9f, 0x33, 0x2e, 0x55, 0x1e, 0x96, 0x71, 0xe9,
+	0x2b, 0x0e, 0x2c, 0x84, 0x9d, 0x28, 0x4e, 0xc8, 0x6a, 0xd8, 0x6e, 0x93, 0x84, 0x44, 0xd4, 0xec,
+	0xc6, 0xad, 0x92, 0x9b, 0x63, 0x88, 0x97, 0xd6, 0xa1, 0xb5, 0x3c, 0xef, 0xc6, 0x3b, 0x45, 0x17,
+	0x2c, 0x0c, 0xa1, 0x70, 0xb8, 0x26, 0xae, 0x0f, 0x95, 0x30, 0x6a, 0xc7, 0x42, 0x2d, 0xf9, 0xf0,
+	0x18, 0x35, 0x5a, 0x8b, 0xda, 0xb1, 0x5e, 0x19, 0xf4, 0x0b, 0x19, 0x6b, 0xf7, 0x53, 0x50, 0xbb,
+	0x95, 0x84, 0x19, 0x69, 0xf8, 0xad, 0x1d, 0x71, 0x3b, 0xbc, 0x5e, 0xcc, 0x64, 0xb9, 0x21, 0xd9,
+	0xf2, 0x0b, 0x8a, 0xfa, 0x44, 0x2d, 0x90, 0x5a, 0xf7, 0x12, 0x71, 0x45, 0xbd, 0x14, 0xa6, 0x54,
+	0x3d, 0x5f, 0x0f, 0x7b, 0x61, 0xc6, 0x2e, 0x8c, 0x65, 0x6e, 0xdd, 0xc3, 0x11, 0x78, 0x1c, 0x59,
+	0xca, 0xcd, 0xa0, 0x9a, 0x0e, 0xd2, 0x3e, 0x89, 0x02, 0x71, 0xdf, 0xbb, 0x5a, 0xd0, 0x92, 0xe3,
+	0x4c, 0xf9, 0x4d, 0x4f, 0x7c, 0xa0, 0x14, 0xe5, 0x7e, 0xde, 0x81, 0xd9, 0x44, 0x0c, 0xf8, 0xa5,
+	0x38, 0xde, 0x49, 0xeb, 0xc0, 0x86, 0xeb, 0x85, 0x02, 0x26, 0x10, 0xe5, 0xd7, 0x38, 0x25, 0x86,
+	0x6d, 0xd6, 0x84, 0xa6, 0x68, 0x0b, 0x75, 0x6f, 0xc2, 0x94, 0x1f, 0xf9, 0xdd, 0xbd, 0x34, 0x4c,
+	0xc5, 0x6d, 0xef, 0x85, 0x31, 0x17, 0xd0, 0xb2, 0x60, 0xd7, 0x98, 0xa1, 0x46, 0x16, 0xf9, 0x85,
+	0x4a, 0x8c, 0xf7, 0x83, 0x9a, 0x6d, 0xf7, 0xe0, 0x76, 0xb3, 0x57, 0xa1, 0x96, 0x28, 0x13, 0x36,
+	0xd7, 0x22, 0xd7, 0x0a, 0xe8, 0x0a, 0xce, 0x5d, 0x9f, 0x12, 0xda, 0x58, 0xad, 0xc5, 0x51, 0x6d,
+	0x92, 0x2e, 0x6f, 0xb1, 0xeb, 0x8d, 0xbb, 0x83, 0x08, 0x91, 0xda, 0x24, 0xb9, 0x17, 0x51, 0x93,
+	0xe4, 0x5e, 0xd4, 0x72, 0x63, 0x98, 0xdc, 0x26, 0x7e, 0x37, 0xdb, 0xae, 0x97, 0xc7, 0xee, 0xeb,
+	0x4b, 0x8c, 0x51, 0xde, 0x1a, 0xc9, 0xa1, 0x28, 0xc4, 0xb8, 0x03, 0xa8, 0x6e, 0xf3, 0xb9, 0x2e,
+	0x54, 0xab, 0xcb, 0x63, 0xf5, 0xa9, 0xb5, 0x7a, 0xf4, 0xc6, 0x2c, 0x00, 0x28, 0x65, 0xb9, 0xbf,
+	0xe0, 0x00, 0xb4, 0xa4, 0x1d, 0x52, 0x6e, 0x8d, 0x05, 0x6d, 0x10, 0xca, 0xbe, 0xa9, 0x75, 0x52,
+	0x05, 0x4a, 0xd1, 0x10, 0xeb, 0x7e, 0x12, 0x66, 0x12, 0xd2, 0x8a, 0xa3, 0x56, 0xd8, 0x25, 0xc1,
+	0x32, 0xf5, 0xd2, 0xd0, 0x3e, 0xff, 0xff, 0x87, 0xb3, 0x17, 0x6e, 0x86, 0x3d, 0xd2, 0x98, 0xa7,
+	0xba, 0x21, 0x1a, 0x3c, 0xd0, 0xe2, 0xe8, 0xfe, 0xa2, 0x03, 0x73, 0xca, 0x0e, 0x4b, 0x87, 0x82,
+	0x88, 0xcd, 0x70, 0xad, 0x08, 0x93, 0x2f, 0x63, 0xd8, 0x70, 0xe9, 0x25, 0xd0, 0x86, 0x61, 0x4e,
+	0xa8, 0xfb, 0x51, 0x80, 0x78, 0x8b, 0x99, 0x59, 0x83, 0x65, 0xbe, 0x0d, 0x1e, 0xad, 0x9d, 0x73,
+	0xdc, 0x64, 0x2f, 0x39, 0xa0, 0xc1, 0xcd, 0xbd, 0x02, 0xc0, 0xd7, 0x09, 0xb5, 0x1b, 0xb3, 0x1d,
+	0xb2, 0xd6, 0x78, 0x9f, 0xec, 0xf9, 0xa6, 0xc2, 0xdc, 0xbb, 0xb3, 0x38, 0x6c, 0x74, 0xa0, 0x08,
+	0x34, 0x8a, 0xbb, 0xb7, 0xe9, 0x5e, 0xdb, 0xeb, 0xf9, 0xca, 0xb8, 0x55, 0xd8, 0x5e, 0xcb, 0x98,
+	0xea, 0x29, 0x29, 0x00, 0x28, 0xc5, 0x51, 0x8f, 0xcb, 0xcc, 0x2e, 0x49, 0xc2, 0xb6, 0x28, 0x21,
+	0x76, 0xbb, 0x2b, 0x63, 0x2e, 0xf6, 0x97, 0x0d, 0x96, 0x7c, 0xba, 0x98, 0x10, 0xb4, 0x44, 0x7a,
+	0xff, 0xed, 0x80, 0x3b, 0x5c, 0x69, 0xf7, 0x39, 0x98, 0x21, 0xb7, 0x33, 0x92, 0x44, 0x7e, 0xf7,
+	0x25, 0x5c, 0x97, 0x76, 0x19, 0xc6, 0xec, 0x82, 0x01, 0x47, 0x8b, 0xca, 0xf5, 0xd4, 0x8d, 0xab,
+	0xc4, 0xe8, 0x41, 0xdf, 0xb8, 0xd4, 0xfd, 0xea, 0x35, 0x07, 0x4e, 0x24, 0x24, 0x0a, 0x48, 0x42,
+	0x82, 0xa6, 0xd8, 0x5b, 0xcb, 0x05, 0xec, 0xad, 0x26, 0xc7, 0xc6, 0x3b, 0x44, 0x9f, 0x9f, 0xb0,
+	0xe1, 0x29, 0xe6, 0x45, 0x7b, 0xbf, 0x9c, 0x6f, 0x3f, 0x3f, 0x0a, 0xaf, 0xc0, 0x04, 0x8d, 0xd9,
+	0xe8, 0xd6, 0x9d, 0x23, 0x4f, 0xdc, 0x1a, 0xbd, 0x66, 0xbc, 0x44, 0x0b, 0x23, 0xe7, 0x41, 0xad,
+	0x3f, 0x09, 0xf1, 0x53, 0xa1, 0xc3, 0x1a, 0xd6, 0x1f, 0x64, 0x50, 0x14, 0x58, 0xef, 0x97, 0x4a,
+	0x96, 0xee, 0xbd, 0x99, 0x10, 0xe2, 0x76, 0x61, 0x22, 0x8a, 0x03, 0x75, 0xfe, 0x14, 0x71, 0x14,
+	0x5f, 0x8b, 0x03, 0xc3, 0xc7, 0x4d, 0xbf, 0x52, 0xe4, 0x42, 0x98, 0x06, 0x20, 0x1d, 0xa6, 0x0c,
+	0x51, 0x2f, 0x15, 0x2b, 0x56, 0x69, 0x00, 0xd7, 0x4d, 0x29, 0x68, 0x0b, 0xf5, 0xbe, 0xeb, 0x58,
+	0xd6, 0xc2, 0x1b, 0xf4, 0x5e, 0x77, 0x61, 0x97, 0xda, 0x29, 0xae, 0x58, 0xfe, 0xa3, 0x1f, 0x33,
+	0xfd, 0x47, 0xf7, 0xee, 0x2c, 0xbe, 0x77, 0xbf, 0x00, 0x9c, 0x5b, 0x94, 0xc3, 0x12, 0x63, 0x61,
+	0xb8, 0x9a, 0x3e, 0x0d, 0xd3, 0x46, 0x8d, 0xc5, 0x51, 0x5b, 0x94, 0x83, 0x45, 0xdd, 0x2a, 0x0c,
+	0x20, 0x9a, 0xf2, 0xbc, 0xdf, 0x72, 0x2c, 0x27, 0x99, 0x52, 0x2b, 0xe9, 0x7c, 0xd9, 0x4a, 0xfc,
+	0xa8, 0xb5, 0x9d, 0xf7, 0x5e, 0x35, 0x18, 0x14, 0x05, 0xf6, 0x10, 0xce, 0x96, 0xe7, 0x61, 0xba,
+	0x3f, 0xe8, 0x76, 0x91, 0xdc, 0x1c, 0x90, 0x94, 0x5f, 0x5e, 0x0c, 0x7b, 0xe2, 0x86, 0x46, 0xa1,
+	0x49, 0xe7, 0x0d, 0x60, 0x61, 0x79, 0x90, 0xc5, 0x3d, 0x3f, 0x23, 0x01, 0xc6, 0xdd, 0xee, 0x16,
+	0xad, 0xd5, 0x79, 0x98, 0x69, 0x27, 0x71, 0x4f, 0xb9, 0x6d, 0x78, 0xdd, 0x94, 0xb9, 0xe2, 0xa2,
+	0x81, 0x43, 0x8b, 0xf2, 0xd0, 0xf3, 0xff, 0xeb, 0x93, 0x50, 0x15, 0x81, 0x10, 0x87, 0xf6, 0xe0,
+	0xc9, 0x1b, 0x73, 0x69, 0xdf, 0x1b, 0x73, 0x1f, 0x26, 0x5b, 0x2c, 0xac, 0x4a, 0x28, 0x38, 0xe3,
+	0x18, 0x8b, 0x45, 0xed, 0x78, 0x98, 0x96, 0xae, 0x13, 0xff, 0x46, 0x21, 0x87, 0x46, 0x8a, 0x9c,
+	0x68, 0xc5, 0x51, 0x44, 0x5a, 0xfa, 0x0c, 0xae, 0x8c, 0xed, 0x5f, 0x5e, 0xb1, 0x39, 0xea, 0x3d,
+	0x2e, 0x87, 0xc0, 0xbc, 0x6c, 0xf7, 0x27, 0x60, 0x96, 0xf7, 0xd6, 0xcb, 0x24, 0x61, 0x43, 0x37,
+	0xc1, 0x3a, 0x4b, 0xad, 0xc5, 0xa6, 0x89, 0x44, 0x9b, 0x96, 0xda, 0xe7, 0x95, 0xed, 0x82, 0x9b,
+	0x95, 0x85, 0x7d, 0x5e, 0x19, 0x37, 0x52, 0x34, 0x28, 0xa8, 0xa7, 0xa6, 0xcb, 0x0d, 0x67, 0x55,
+	0xb6, 0x75, 0x5c, 0x1b, 0xbf, 0xbb, 0x97, 0x4c, 0xfb, 0x98, 0xea, 0x74, 0x0e, 0x44, 0x21, 0xcd,
+	0xfd, 0x92, 0x03, 0xd3, 0x7e, 0x14, 0xc5, 0x99, 0x88, 0x67, 0x9a, 0x3a, 0x5b, 0x1e, 0xd3, 0xcd,
+	0x21, 0xa5, 0x2f, 0x6b, 0xae, 0xbc, 0x0a, 0x7a, 0x69, 0x6b, 0x0c, 0x9a, 0xc2, 0x4f, 0x7f, 0x10,
+	0xa6, 0x8f, 0x69, 0x9a, 0x3b, 0xfd, 0x21, 0x98, 0xcf, 0x0b, 0x3c, 0x92, 0x69, 0xef, 0x8f, 0xcb,
+	0x30, 0x6b, 0x4d, 0x53, 0xf7, 0xfd, 0x30, 0x35, 0x48, 0x49, 0x62, 0x18, 0x96, 0x94, 0xbf, 0xf9,
+	0x25, 0x01, 0x47, 0x45, 0x41, 0xa9, 0xfb, 0x7e, 0x9a, 0xde, 0x8a, 0x13, 0x69, 0x17, 0x53, 0xd4,
+	0x1b, 0x02, 0x8e, 0x8a, 0x82, 0x6e, 0x30, 0x5b, 0xc4, 0x4f, 0x48, 0xb2, 0x19, 0xef, 0x90, 0xa1,
+	0xc0, 0xad, 0x86, 0x46, 0xa1, 0x49, 0xc7, 0x56, 0x48, 0xd6, 0x4d, 0x57, 0xba, 0x21, 0x89, 0x32,
+	0x5e, 0xcd, 0x02, 0x56, 0xc8, 0xe6, 0x7a, 0xd3, 0xe4, 0xa8, 0x57, 0x48, 0x0e, 0x81, 0x79, 0xd9,
+	0x2c, 0xf6, 0xc5, 0xbf, 0x95, 0xea, 0x10, 0xcc, 0xfa, 0xc4, 0xd8, 0x7b, 0x85, 0x15, 0xd2, 0xc9,
+	0x63, 0x5f, 0x2c, 0x10, 0xda, 0x12, 0xbd, 0x6f, 0x3b, 0x20, 0x43, 0x3b, 0x1f, 0x42, 0x58, 0x41,
+	0xc7, 0x0e, 0x2b, 0x68, 0x8c, 0xbf, 0x4e, 0xf6, 0x09, 0x29, 0xb8, 0x06, 0x55, 0x6a, 0xab, 0xf6,
+	0xa3, 0xc0, 0x7d, 0x12, 0xaa, 0x2d, 0xfe, 0x53, 0xe8, 0x93, 0xcc, 0x0c, 0x21, 0xb0, 0x28, 0x71,
+	0xee, 0xe3, 0x50, 0xf1, 0x93, 0x8e, 0xd4, 0x21, 0x99, 0x3f, 0x7e, 0x39, 0xe9, 0xa4, 0xc8, 0xa0,
+	0xde, 0x3f, 0x3a, 0x30, 0x47, 0x8b, 0x84, 0xd9, 0x55, 0xd9, 0x96, 0xf7, 0xc3, 0x54, 0x62, 0x9f,
+	0x4a, 0xaa, 0xe5, 0xea, 0x44, 0x52, 0x14, 0xf4, 0x64, 0xf1, 0x07, 0xd9, 0x76, 0x9c, 0xe4, 0x4f,
+	0xa3, 0x65, 0x06, 0x45, 0x81, 0x75, 0xd7, 0xa1, 0x12, 0xd0, 0x9d, 0xbb, 0x7c, 0x64, 0x0d, 0x50,
+	0x9d, 0x42, 0xab, 0x74, 0x3b, 0x66, 0x5c, 0xcc, 0xb0, 0x96, 0xca, 0x01, 0x61, 0x2d, 0xaf, 0x97,
+	0x00, 0x56, 0xe2, 0x5e, 0xdf, 0x4f, 0x48, 0xb0, 0x19, 0xff, 0x9f, 0xb7, 0xbe, 0x7a, 0xaf, 0x39,
+	0xe0, 0xd2, 0xfe, 0x88, 0x23, 0x12, 0x69, 0x8f, 0x12, 0x35, 0xbc, 0xb7, 0x24, 0x54, 0x0c, 0xbb,
+	0x32, 0xa9, 0x28, 0x72, 0xd4, 0x34, 0x87, 0x50, 0x15, 0x9e, 0x90, 0xbb, 0x6a, 0xd9, 0x76, 0x1a,
+	0x30, 0x07, 0xa4, 0xd8, 0x64, 0xbd, 0x5f, 0x2d, 0xc1, 0x63, 0x7c, 0xc9, 0x5e, 0xf5, 0x23, 0xbf,
+	0x43, 0xa8, 0xff, 0xec, 0xd0, 0xe6, 0xfb, 0x4f, 0x52, 0x3b, 0x68, 0x28, 0x9d, 0xf0, 0x63, 0xad,
+	0x3a, 0xbe, 0x5a, 0xf8, 0xfa, 0x58, 0x8b, 0xc2, 0x0c, 0x19, 0x67, 0xb7, 0x0f, 0x53, 0x32, 0xbe,
+	0xbc, 0x5e, 0x2e, 0x4c, 0x8a, 0x5a, 0x50, 0x2f, 0x08, 0xde, 0xa8, 0xa4, 0x78, 0x5f, 0x77, 0x20,
+	0xaf, 0x83, 0x30, 0xf5, 0x8d, 0x07, 0xba, 0xe5, 0xd5, 0x37, 0x3b, 0x34, 0xed, 0xf0, 0xd1, 0x5e,
+	0xee, 0xc7, 0x61, 0xda, 0xcf, 0x32, 0xd2, 0xeb, 0x67, 0xcc, 0xa2, 0x50, 0x3e, 0x9e, 0x45, 0xe1,
+	0x6a, 0x1c, 0x84, 0xed, 0x90, 0x72, 0x40, 0x93, 0x9d, 0xf7, 0x22, 0x4c, 0x49, 0x8f, 0xc8, 0x21,
+	0x86, 0xf1, 0x09, 0xeb, 0xf8, 0xdd, 0x67, 0xa2, 0xf8, 0x30, 0x63, 0x1a, 0xc4, 0x1e, 0x40, 0x9f,
+	0x78, 0x37, 0x60, 0x61, 0xc8, 0x4d, 0x7f, 0x88, 0xea, 0x1f, 0x78, 0x71, 0xf0, 0x5e, 0x77, 0x60,
+	0xd6, 0x8a, 0x8c, 0x28, 0xa8, 0x53, 0xa8, 0xc2, 0xd0, 0x8e, 0x99, 0x11, 0x34, 0x09, 0xa3, 0x4e,
+	0xfe, 0x46, 0x72, 0x51, 0xa3, 0xd0, 0xa4, 0xf3, 0x7e, 0xa7, 0x04, 0xd3, 0xcc, 0x90, 0xf0, 0x52,
+	0x9f, 0x6d, 0xa7, 0x5f, 0x74, 0x60, 0x6e, 0xdb, 0xac, 0x9f, 0xbc, 0x20, 0x17, 0x17, 0x0a, 0xa2,
+	0xa2, 0x1d, 0x2c, 0x70, 0x8a, 0x39, 0xb9, 0xee, 0x75, 0x38, 0xb1, 0x63, 0xb9, 0x92, 0xe5, 0xc9,
+	0xf5, 0x24, 0x55, 0x3d, 0x6c, 0x2f, 0xf3, 0x28, 0xc7, 0x73, 0xbe, 0x34, 0xdd, 0xd8, 0xb4, 0x23,
+	0x83, 0x77, 0x90, 0xda, 0xd8, 0x46, 0xf9, 0x1e, 0xbc, 0xab, 0xc0, 0xfc, 0x20, 0x45, 0xcd, 0xdb,
+	0x17, 0x61, 0x8a, 0xb2, 0xa3, 0xa7, 0x78, 0x51, 0x2c, 0x9b, 0x30, 0x75, 0xf9, 0xc6, 0x26, 0xd7,
+	0xfd, 0x3c, 0x28, 0x87, 0x3e, 0xdf, 0xb1, 0xcb, 0x7a, 0x5f, 0x59, 0x4b, 0xd3, 0x01, 0x5b, 0x95,
+	0x14, 0xe9, 0x3e, 0x01, 0x65, 0x72, 0xbb, 0xcf, 0x58, 0x96, 0x75, 0xe3, 0x2f, 0xdc, 0xee, 0x87,
+	0x09, 0x49, 0x29, 0x11, 0xb9, 0xdd, 0xf7, 0x06, 0x00, 0x3a, 0x52, 0xa2, 0xa8, 0xf9, 0x79, 0x16,
+	0x2a, 0xad, 0x38, 0x20, 0xa2, 0xdf, 0x15, 0x9b, 0x95, 0x38, 0x20, 0xc8, 0x30, 0xde, 0x97, 0x1c,
+	0x98, 0xcf, 0x87, 0x37, 0xbc, 0x65, 0x87, 0xd1, 0x3a, 0xcc, 0xab, 0xe9, 0x74, 0xbd, 0xcf, 0x6d,
+	0xcc, 0xe7, 0x61, 0x66, 0x6b, 0x10, 0x76, 0x03, 0xf1, 0x9d, 0xbf, 0xa8, 0x37, 0x0c, 0x1c, 0x5a,
+	0x94, 0x5e, 0x06, 0x76, 0x78, 0x36, 0x65, 0xd5, 0xf3, 0x6f, 0xa3, 0xe1, 0x04, 0xa1, 0x03, 0xa2,
+	0x58, 0x5d, 0x35, 0x70, 0x68, 0x51, 0xb2, 0x4d, 0xcc, 0xbf, 0xdd, 0x0c, 0x5f, 0xe5, 0x4d, 0x2c,
+	0x1b, 0x9b, 0x18, 0x07, 0xa3, 0xc4, 0x7b, 0xf7, 0x1c, 0xd0, 0x41, 0xc4, 0x6e, 0x5b, 0x38, 0x3e,
+	0x9c, 0xb1, 0x15, 0x70, 0x6a, 0x0b, 0x55, 0x7c, 0xf9, 0x39, 0x69, 0xf8, 0x3d, 0x3e, 0xef, 0xd0,
+	0x58, 0xab, 0x30, 0x0b, 0xa9, 0x91, 0xa3, 0xb1, 0x57, 0x2f, 0x8d, 0x6d, 0xfb, 0x55, 0xb2, 0xd6,
+	0x38, 0xdb, 0x38, 0x31, 0x43, 0xb7, 0x94, 0x24, 0x34, 0xc5, 0xd2, 0x68, 0x00, 0x77, 0xb8, 0xe0,
+	0x11, 0xef, 0x6c, 0xe7, 0xa0, 0xe6, 0x4b, 0x7b, 0x4d, 0xbd, 0x64, 0xef, 0x18, 0xda, 0x90, 0xa3,
+	0x69, 0xd8, 0x51, 0xc4, 0x75, 0xca, 0x72, 0xee, 0x28, 0xb2, 0xb4, 0x40, 0xef, 0xf7, 0x2b, 0x90,
+	0xb3, 0xf3, 0xbb, 0x03, 0x33, 0x98, 0xdc, 0x29, 0x30, 0x98, 0x5c, 0xd5, 0x78, 0x54, 0x40, 0xb9,
+	0xfb, 0x3c, 0x4c, 0xf4, 0xb7, 0xfd, 0x54, 0x2e, 0x98, 0x45, 0x15, 0x15, 0x42, 0x81, 0xf7, 0x4c,
+	0x77, 0x04, 0x83, 0x20, 0xa7, 0x36, 0xcf, 0xd2, 0xf2, 0x01, 0xfa, 0xc5, 0x67, 0xb8, 0xe7, 0x1e,
+	0x49, 0x3a, 0xe8, 0x66, 0xe2, 0x36, 0x7a, 0xad, 0xa8, 0xe9, 0xc7, 0xb9, 0x6a, 0x17, 0x3e, 0xff,
+	0x46, 0x43, 0xa2, 0xfb, 0x31, 0xa8, 0xa5, 0x99, 0x9f, 0x64, 0xc7, 0xf4, 0x0b, 0xa9, 0xee, 0x6b,
+	0x4a, 0x26, 0xa8, 0xf9, 0x51, 0x6f, 0x4c, 0x3b, 0x8c, 0xc2, 0x74, 0x9b, 0x71, 0xaf, 0x1e, 0x4f,
+	0x77, 0xba, 0xa8, 0x38, 0xa0, 0xc1, 0xcd, 0xfb, 0x29, 0x38, 0x7b, 0x50, 0x76, 0x0e, 0xbd, 0xd3,
+	0xdd, 0xf2, 0x93, 0x48, 0xc4, 0xa9, 0xb2, 0xb5, 0x78, 0xc3, 0x4f, 0x22, 0x64, 0x50, 0xef, 0xb7,
+	0xcb, 0x30, 0x6d, 0x24, 0x60, 0x1d, 0x62, 0x2f, 0xcf, 0x25, 0x8c, 0x95, 0x0e, 0x99, 0x30, 0xf6,
+	0x14, 0x4c, 0xf5, 0xe3, 0x6e, 0xd8, 0x0a, 0x55, 0x50, 0x18, 0xf3, 0x08, 0x6f, 0x08, 0x18, 0x2a,
+	0xac, 0x9b, 0x41, 0xed, 0x95, 0x5b, 0x19, 0x3b, 0xb1, 0x64, 0x08, 0xd8, 0x38, 0xd1, 0x36, 0xf2,
+	0xf4, 0xd3, 0xc3, 0x24, 0x21, 0x29, 0x6a, 0x41, 0xd4, 0x81, 0xc2, 0x22, 0x93, 0xb8, 0x7f, 0x52,
+	0x38, 0x50, 0x58, 0xc8, 0x52, 0x8a, 0x02, 0x43, 0x3d, 0x02, 0x37, 0x59, 0x7a, 0xce, 0xe4, 0xd8,
+	0xde, 0x22, 0xa3, 0xcf, 0x79, 0x86, 0x0e, 0xf3, 0x5d, 0xb0, 0x9f, 0xc8, 0x85, 0x78, 0xbf, 0xe6,
+	0xc0, 0x7c, 0x9e, 0xcc, 0x5d, 0xa6, 0x2e, 0x1c, 0x66, 0x2a, 0x4e, 0x37, 0x48, 0x72, 0x29, 0x1e,
+	0x24, 0xe2, 0x64, 0x30, 0xfc, 0x2e, 0x16, 0x1a, 0xf3, 0xf4, 0xf4, 0x64, 0xa1, 0x73, 0x5f, 0x95,
+	0x2f, 0xd9, 0x27, 0x4b, 0xd3, 0xc0, 0xa1, 0x45, 0xe9, 0xbd, 0x59, 0x82, 0x13, 0xa2, 0x46, 0x9b,
+	0xa4, 0xd7, 0xef, 0xfa, 0xd9, 0x03, 0x9c, 0x30, 0x5f, 0x70, 0xac, 0xc0, 0xc8, 0xf2, 0xd8, 0x46,
+	0xc5, 0x5c, 0xcd, 0x0f, 0x1f, 0x79, 0x2c, 0x13, 0x68, 0x2b, 0x0f, 0x23, 0x81, 0xf6, 0xaf, 0x1c,
+	0xa8, 0xef, 0x57, 0xd3, 0x07, 0xd7, 0xd9, 0x4f, 0x43, 0x35, 0x20, 0x6d, 0x9f, 0x6e, 0xbf, 0xb9,
+	0xcd, 0x7a, 0x95, 0x83, 0x51, 0xe2, 0xb9, 0xc9, 0xe7, 0xe6, 0x20, 0x4c, 0x48, 0xc0, 0x7a, 0x64,
+	0xca, 0x34, 0xf9, 0x70, 0x38, 0x2a, 0x0a, 0xef, 0x73, 0x25, 0x98, 0xb3, 0x3d, 0x81, 0xee, 0x07,
+	0x2d, 0x47, 0xd2, 0x93, 0x39, 0x47, 0xd2, 0x3e, 0x6e, 0x63, 0x56, 0xe4, 0x10, 0xaa, 0xdb, 0xd3,
+	0x50, 0xdd, 0x15, 0xa6, 0xf6, 0x5c, 0x43, 0xa4, 0x91, 0x5d, 0xe2, 0x69, 0x60, 0xab, 0xdf, 0xef,
+	0x0b, 0xb0, 0x30, 0x0d, 0xa9, 0xa9, 0xb0, 0xac, 0x30, 0x68, 0x50, 0xd1, 0x32, 0x01, 0xa1, 0x6e,
+	0x4a, 0x12, 0xb5, 0xf6, 0x44, 0x90, 0xb8, 0x2a, 0xb3, 0xaa, 0x30, 0x68, 0x50, 0x79, 0xdf, 0x9a,
+	0x04, 0x60, 0x99, 0xbf, 0x21, 0x8b, 0x86, 0x38, 0x0b, 0x95, 0x84, 0xf4, 0xe3, 0xfc, 0x18, 0x52,
+	0x0a, 0x64, 0x18, 0x4b, 0x03, 0x29, 0x1d, 0xc9, 0x6a, 0x5c, 0x3e, 0xd0, 0x6a, 0x4c, 0x1d, 0x12,
+	0xe9, 0xf6, 0x46, 0x12, 0xee, 0xfa, 0x19, 0xb9, 0x42, 0xf6, 0xea, 0x95, 0x9c, 0x43, 0xa2, 0x79,
+	0x49, 0x23, 0xd1, 0xa6, 0x1d, 0xe9, 0x5d, 0x99, 0x78, 0x0b, 0xbd, 0x2b, 0x4d, 0x38, 0x15, 0x46,
+	0x29, 0xcd, 0xb3, 0x10, 0x51, 0x72, 0x97, 0xe2, 0x34, 0xa3, 0x8d, 0x9a, 0x64, 0x03, 0xf3, 0x6e,
+	0xc1, 0xe8, 0xd4, 0xda, 0x28, 0x22, 0x1c, 0x5d, 0x96, 0xf6, 0xa7, 0x44, 0x88, 0x78, 0x79, 0x7d,
+	0x53, 0x12, 0x70, 0x54, 0x14, 0x54, 0xff, 0x23, 0x91, 0xbf, 0xd5, 0x25, 0xeb, 0xed, 0xb4, 0x3e,
+	0x65, 0xeb, 0x7f, 0x17, 0x38, 0xe2, 0x62, 0x13, 0x35, 0x8d, 0xfb, 0x02, 0x2c, 0x68, 0x13, 0x38,
+	0x49, 0xb2, 0x55, 0x6a, 0x64, 0xe6, 0x71, 0x14, 0x2a, 0xae, 0x4f, 0x1b, 0xcd, 0x05, 0x01, 0x0e,
+	0x97, 0x71, 0x57, 0x61, 0xde, 0x02, 0x5e, 0x21, 0x3c, 0x8a, 0xa2, 0xd6, 0xa8, 0x0b, 0x3e, 0xf3,
+	0x16, 0x1f, 0xda, 0xe4, 0xa1, 0x12, 0xf4, 0x3c, 0xd1, 0x30, 0x9f, 0x55, 0x66, 0x9a, 0x31, 0x19,
+	0x61, 0xc1, 0x5f, 0x66, 0x55, 0xc9, 0xd3, 0xab, 0xc4, 0xc2, 0x99, 0x7d, 0x13, 0x0b, 0xe5, 0xb2,
+	0x9d, 0xbd, 0x5f, 0x28, 0xef, 0x2d, 0xb2, 0xb5, 0x1d, 0xc7, 0x3b, 0x6b, 0xab, 0xf5, 0x39, 0xfb,
+	0x12, 0x77, 0x43, 0x22, 0x50, 0xd3, 0x78, 0x5f, 0x2c, 0xc1, 0x29, 0xbd, 0xa8, 0x68, 0x6b, 0x78,
+	0x60, 0x05, 0x8b, 0x57, 0xe7, 0x6e, 0x34, 0xe3, 0x01, 0x07, 0xb5, 0x44, 0x9b, 0x0a, 0x83, 0x06,
+	0x15, 0x1d, 0xf3, 0x16, 0x49, 0x98, 0x83, 0x3a, 0xbf, 0xe2, 0x56, 0x04, 0x1c, 0x15, 0x05, 0x7b,
+	0x23, 0x82, 0x24, 0x59, 0x73, 0xb0, 0xc5, 0x0a, 0xe4, 0x3c, 0x2f, 0x2b, 0x1a, 0x85, 0x26, 0x1d,
+	0xd5, 0x80, 0x5a, 0x72, 0xc0, 0xe9, 0xaa, 0x9b, 0xe1, 0x1a, 0x90, 0x1a, 0x63, 0x85, 0x95, 0xd5,
+	0xa1, 0xa6, 0x80, 0xfa, 0xc4, 0x70, 0x75, 0x28, 0x1c, 0x15, 0x85, 0xf7, 0xef, 0x0e, 0xbc, 0x73,
+	0x64, 0x57, 0x3c, 0x04, 0x5f, 0xc6, 0xc0, 0xf6, 0x65, 0x6c, 0x8c, 0x15, 0xac, 0x30, 0xa2, 0x09,
+	0xfb, 0x78, 0x36, 0xfe, 0xa2, 0x0c, 0x0b, 0x9a, 0x9e, 0x66, 0x5a, 0xd3, 0xb5, 0x78, 0xf0, 0xce,
+	0xca, 0x72, 0x88, 0x98, 0x36, 0x64, 0x0c, 0xb5, 0x91, 0x43, 0xa4, 0x50, 0x68, 0xd2, 0x1d, 0xe5,
+	0x2a, 0xf3, 0x3c, 0x4c, 0x53, 0x27, 0x86, 0xa8, 0x92, 0x38, 0x20, 0xb5, 0xd7, 0x52, 0xa3, 0xd0,
+	0xa4, 0xa3, 0x23, 0xde, 0xe6, 0x3f, 0x79, 0xea, 0x91, 0x61, 0x9e, 0x11, 0x24, 0x29, 0x2a, 0x0a,
+	0xf7, 0x23, 0x9c, 0xfa, 0xb8, 0x61, 0x6c, 0x26, 0x67, 0x76, 0xa5, 0x50, 0xdc, 0xdc, 0x10, 0x4e,
+	0x74, 0xfd, 0x34, 0x6b, 0x0e, 0x5a, 0x2d, 0x42, 0x82, 0x63, 0xde, 0x58, 0x1e, 0xa5, 0xdb, 0xc6,
+	0xba, 0xcd, 0x06, 0xf3, 0x7c, 0xa9, 0x31, 0xe7, 0xd4, 0xd0, 0x18, 0xb2, 0x29, 0x7b, 0x53, 0x4e,
+	0x2a, 0x67, 0xec, 0x4c, 0xaa, 0x21, 0x01, 0xfb, 0x4c, 0xa8, 0xbf, 0x71, 0x60, 0x4e, 0xd3, 0x3e,
+	0x84, 0x85, 0xd3, 0x2e, 0xee, 0xd9, 0x12, 0x5d, 0xef, 0x46, 0x6d, 0xa8, 0x61, 0x5f, 0x63, 0x0d,
+	0xe3, 0x57, 0xc3, 0xe5, 0x96, 0x4c, 0x04, 0x3f, 0x40, 0x89, 0xa4, 0x29, 0x9f, 0x54, 0xe7, 0x94,
+	0xb5, 0xbb, 0x56, 0x40, 0x0c, 0x12, 0x17, 0xce, 0x54, 0x59, 0x6d, 0xf3, 0x60, 0x9f, 0x29, 0x0a,
+	0x69, 0x5e, 0x0f, 0xea, 0x36, 0xf9, 0x2a, 0x69, 0x33, 0x8b, 0xcd, 0xa1, 0x6a, 0x4d, 0x4d, 0x31,
+	0xac, 0xd4, 0xfa, 0xc0, 0xcf, 0x67, 0x94, 0x2f, 0x4b, 0x04, 0x6a, 0x1a, 0xef, 0x0f, 0x1d, 0x78,
+	0x74, 0x44, 0xf5, 0x0a, 0xb4, 0x67, 0x66, 0xfa, 0x7c, 0xd8, 0x27, 0xe1, 0x5e, 0x6a, 0xdd, 0x95,
+	0xfb, 0x6b, 0xdd, 0xde, 0xbf, 0x3a, 0x70, 0xc2, 0xae, 0x6b, 0xea, 0x5e, 0x06, 0x97, 0x37, 0x66,
+	0x35, 0x4c, 0x5b, 0xf1, 0x2e, 0x49, 0xf6, 0x68, 0xcb, 0x79, 0xad, 0x4f, 0x0b, 0x4e, 0xee, 0xf2,
+	0x10, 0x05, 0x8e, 0x28, 0xc5, 0x62, 0x38, 0x02, 0xd5, 0xdb, 0x72, 0xe0, 0x9b, 0x85, 0x0d, 0xbc,
+	0x1e, 0x49, 0xf3, 0x36, 0xa2, 0xe4, 0xa1, 0x29, 0xdc, 0xfb, 0xa3, 0x0a, 0xcc, 0xc8, 0xe2, 0x34,
+	0x95, 0xa1, 0xa8, 0x94, 0x22, 0x2b, 0x61, 0xa8, 0x7c, 0x70, 0xc2, 0x90, 0x9a, 0x09, 0x95, 0xfb,
+	0xdd, 0xb7, 0x78, 0xf2, 0x94, 0xd6, 0x86, 0x8d, 0x13, 0x65, 0x53, 0xa3, 0xd0, 0xa4, 0xa3, 0x35,
+	0xe9, 0x86, 0xbb, 0x84, 0x17, 0x9a, 0xb4, 0x6b, 0xb2, 0x2e, 0x11, 0xa8, 0x69, 0x68, 0x4d, 0x82,
+	0xb0, 0xdd, 0xae, 0x57, 0xed, 0x9a, 0xd0, 0xde, 0x41, 0x86, 0xa1, 0x14, 0x54, 0x37, 0x12, 0x4a,
+	0xa8, 0xa2, 0xa0, 0x81, 0xfd, 0xc8, 0x30, 0x54, 0x7d, 0x9f, 0x4f, 0x49, 0x2b, 0x21, 0x54, 0xf3,
+	0x5b, 0xd9, 0xf6, 0x23, 0xea, 0x30, 0xa9, 0x8d, 0x1f, 0xf8, 0x9a, 0x63, 0xd9, 0x38, 0x49, 0x75,
+	0xcf, 0x3c, 0x14, 0x87, 0x44, 0xd3, 0xf9, 0xdb, 0x4f, 0x48, 0x10, 0xb6, 0x32, 0x12, 0xa8, 0x46,
+	0xd7, 0xc1, 0x9e, 0xbf, 0x1b, 0x43, 0x14, 0x38, 0xa2, 0x94, 0xf7, 0x8d, 0x92, 0x9e, 0x32, 0xb4,
+	0xc9, 0x6f, 0xdf, 0x2c, 0x34, 0xf7, 0x29, 0x31, 0x50, 0xdc, 0xce, 0x74, 0x52, 0x0e, 0xd2, 0xbd,
+	0x3b, 0x8b, 0x53, 0xf4, 0x2f, 0xdf, 0x1f, 0xd8, 0x80, 0x3d, 0x05, 0x53, 0xd4, 0xfe, 0x72, 0xc3,
+	0xdf, 0xe5, 0x93, 0xa4, 0xcc, 0x35, 0xc6, 0xa6, 0x80, 0xa1, 0xc2, 0xba, 0x97, 0xe8, 0x93, 0x52,
+	0x5d, 0x92, 0x11, 0x91, 0xfd, 0x54, 0x65, 0xbc, 0xff, 0x1f, 0x7f, 0xfb, 0x49, 0xc3, 0xef, 0xdd,
+	0x59, 0x9c, 0xa7, 0x32, 0x4c, 0x18, 0x5a, 0x25, 0xbd, 0xef, 0x31, 0x6d, 0x72, 0x9f, 0xd4, 0xa3,
+	0xb7, 0x71, 0xaf, 0x3e, 0x07, 0x33, 0x34, 0xe3, 0x7d, 0x23, 0x0e, 0x23, 0x66, 0x2f, 0x9a, 0xd0,
+	0x61, 0xd3, 0x97, 0x9b, 0xd7, 0xaf, 0x49, 0x38, 0x5a, 0x54, 0x1e, 0xea, 0x59, 0xb3, 0x1e, 0x46,
+	0x6c, 0xd6, 0x64, 0x61, 0xd6, 0x25, 0xf9, 0xf6, 0x6d, 0x52, 0x20, 0x72, 0x9c, 0xfb, 0x6e, 0x28,
+	0x0f, 0x92, 0xae, 0x68, 0xde, 0xb4, 0x20, 0x29, 0xd3, 0xc7, 0x38, 0x28, 0xdc, 0xfb, 0xfa, 0x04,
+	0x3c, 0xa6, 0x22, 0x6f, 0x49, 0x76, 0x2b, 0x4e, 0x76, 0xc2, 0xa8, 0xc3, 0xdc, 0x84, 0x5f, 0x71,
+	0x60, 0x86, 0x6f, 0x03, 0x22, 0xc3, 0x95, 0x6b, 0x38, 0xad, 0x22, 0x62, 0x7c, 0x2d, 0x49, 0x4b,
+	0x9b, 0x86, 0x94, 0x5c, 0x76, 0xab, 0x89, 0x42, 0xab, 0x3a, 0xee, 0xab, 0x00, 0xfc, 0x1b, 0x49,
+	0xbb, 0x88, 0x67, 0x4f, 0x64, 0xe5, 0x90, 0xb4, 0xf5, 0x1d, 0x6c, 0x53, 0x49, 0x40, 0x43, 0x1a,
+	0xcd, 0x9e, 0x90, 0xe1, 0x8b, 0xdc, 0xd6, 0xf7, 0xd3, 0xc5, 0xf7, 0xca, 0x61, 0xa2, 0x19, 0x11,
+	0xaa, 0x61, 0xd4, 0x49, 0x48, 0x2a, 0x8d, 0xcf, 0xef, 0x35, 0xd4, 0xbe, 0xa5, 0x56, 0x9c, 0x10,
+	0xa6, 0xe4, 0xc5, 0x7e, 0xd0, 0xf0, 0xbb, 0x7e, 0xd4, 0x22, 0xc9, 0x1a, 0x27, 0xd7, 0xa7, 0xb7,
+	0x00, 0xa0, 0x64, 0x34, 0x14, 0xd3, 0x3f, 0x71, 0x98, 0x98, 0x7e, 0x9a, 0x6b, 0x3c, 0x34, 0x8c,
+	0x47, 0x0a, 0x68, 0x3c, 0x7e, 0x2c, 0x24, 0x0d, 0x08, 0x9e, 0x31, 0xc3, 0xc7, 0x69, 0xc4, 0x76,
+	0xa2, 0x47, 0x53, 0x68, 0xc4, 0x45, 0xcd, 0x0d, 0xe3, 0x0a, 0xa6, 0x80, 0x68, 0xca, 0xa3, 0x33,
+	0xb3, 0xef, 0x27, 0x24, 0x7a, 0xa0, 0x33, 0x73, 0x43, 0x49, 0x40, 0x43, 0x9a, 0x4b, 0x44, 0x06,
+	0x65, 0x79, 0x6c, 0x5f, 0x84, 0x74, 0xee, 0x8f, 0xcc, 0xa2, 0x7c, 0xdd, 0x81, 0xb9, 0xc8, 0x9a,
+	0xaf, 0xf5, 0xca, 0xd8, 0xa1, 0x5f, 0xa3, 0x17, 0x02, 0x4f, 0x23, 0xb2, 0x61, 0x98, 0x13, 0xce,
+	0x5d, 0x0d, 0xbc, 0xb4, 0x1d, 0xbd, 0x6c, 0xb8, 0x1a, 0x2c, 0x34, 0xe6, 0xe9, 0x8d, 0xac, 0x94,
+	0xc9, 0x7d, 0xb3, 0x52, 0x76, 0x54, 0x16, 0x5c, 0xb5, 0xd8, 0x2c, 0x38, 0x18, 0x91, 0x01, 0xd7,
+	0x85, 0x89, 0x6e, 0x18, 0xed, 0xc8, 0x18, 0xe5, 0x22, 0x92, 0x2b, 0xe8, 0xb9, 0xa1, 0x0f, 0x0a,
+	0xfa, 0x95, 0x22, 0x17, 0xe2, 0xfd, 0x41, 0x19, 0xe6, 0x25, 0xd9, 0xf5, 0x5d, 0x92, 0x24, 0x61,
+	0xc0, 0x4e, 0x36, 0x5e, 0x19, 0xad, 0xac, 0xab, 0x93, 0xed, 0x92, 0x44, 0xa0, 0xa6, 0xa1, 0x16,
+	0xc3, 0xe1, 0xfc, 0xe2, 0x92, 0x6d, 0x31, 0x3c, 0x54, 0x26, 0xf0, 0xd3, 0x50, 0xe5, 0x9a, 0x7f,
+	0x9a, 0x37, 0x63, 0x88, 0x1b, 0x05, 0x4a, 0xbc, 0xfb, 0x71, 0xa8, 0xf3, 0x0a, 0x6c, 0x24, 0x31,
+	0xdb, 0xc2, 0xc2, 0xa8, 0x43, 0xef, 0xf6, 0xf1, 0x40, 0x5e, 0x55, 0xd4, 0xfb, 0x89, 0x97, 0xf6,
+	0xa1, 0xc3, 0x7d, 0x39, 0xd0, 0x99, 0xc5, 0x71, 0x34, 0x3e, 0x83, 0xea, 0x1e, 0x41, 0x7e, 0x66,
+	0x5d, 0xb2, 0xd1, 0x98, 0xa7, 0xa7, 0xba, 0x23, 0x07, 0x89, 0x97, 0x59, 0x78, 0xd2, 0x2c, 0xb7,
+	0xfb, 0x2a, 0xdd, 0xf1, 0xd2, 0x10, 0x05, 0x8e, 0x28, 0xe5, 0xfd, 0x87, 0x03, 0xe6, 0xc6, 0x73,
+	0x38, 0x25, 0xc7, 0x70, 0x34, 0x94, 0x0e, 0x70, 0x34, 0x48, 0x7d, 0xa8, 0x7c, 0xb8, 0x8b, 0x49,
+	0xe5, 0x08, 0x17, 0x93, 0x89, 0x7d, 0x15, 0x28, 0xaa, 0xa4, 0x84, 0x41, 0x7d, 0x32, 0xa7, 0xa4,
+	0xac, 0xad, 0x22, 0x85, 0x7b, 0xff, 0x5c, 0xd6, 0x76, 0x01, 0xe1, 0x05, 0xff, 0xa1, 0x68, 0xf6,
+	0x73, 0x2a, 0xd2, 0x8f, 0xb7, 0xfc, 0x71, 0x3b, 0xd2, 0xef, 0xde, 0x9d, 0x45, 0xe0, 0xcd, 0x65,
+	0x61, 0x45, 0x23, 0xe2, 0xfe, 0xaa, 0x07, 0x18, 0xf8, 0xce, 0xc3, 0xd4, 0xb6, 0xd0, 0xd2, 0xeb,
+	0x53, 0x96, 0x08, 0xa5, 0xbd, 0x5b, 0x9a, 0xbc, 0xa2, 0x76, 0x97, 0xa1, 0x46, 0x7f, 0xb3, 0x20,
+	0x09, 0x61, 0xf1, 0x7f, 0x42, 0x2d, 0x7c, 0x89, 0x18, 0x11, 0x4f, 0xa1, 0x4b, 0xd1, 0x0e, 0x63,
+	0x2f, 0x0f, 0x30, 0x16, 0x60, 0x77, 0x58, 0x53, 0x22, 0x50, 0xd3, 0x78, 0x7f, 0x32, 0xa1, 0x87,
+	0x59, 0xc4, 0x42, 0xfe, 0x50, 0x0c, 0xf3, 0xf9, 0xdc, 0x30, 0x9f, 0x1d, 0x1a, 0xe6, 0x39, 0x9d,
+	0x7c, 0x6d, 0x0d, 0xf5, 0x43, 0x3d, 0x6e, 0x0e, 0xbe, 0x93, 0x0b, 0x7f, 0x7e, 0x98, 0x90, 0x74,
+	0x23, 0x19, 0x44, 0x34, 0x30, 0xb3, 0xc6, 0x88, 0x2d, 0x7f, 0xbe, 0x81, 0xc6, 0x3c, 0xbd, 0xdb,
+	0x86, 0xb9, 0x78, 0x90, 0x5d, 0x6f, 0xb3, 0x06, 0x87, 0x91, 0x78, 0x89, 0xf4, 0x68, 0x26, 0x5b,
+	0x9e, 0x56, 0x6c, 0x71, 0xc1, 0x1c, 0x57, 0xb7, 0x0b, 0xf3, 0x7d, 0xbd, 0x97, 0x73, 0x49, 0xd3,
+	0x47, 0x96, 0xc4, 0x8c, 0x03, 0x1b, 0x39, 0x3e, 0x38, 0xc4, 0xd9, 0xfb, 0x81, 0x43, 0x4d, 0xfc,
+	0x3c, 0x71, 0x80, 0x1b, 0x0c, 0xba, 0x71, 0xe7, 0x88, 0xf9, 0x06, 0xc3, 0x0f, 0x1e, 0x96, 0x8e,
+	0xf4, 0xe0, 0x61, 0xc6, 0xb3, 0x26, 0xc2, 0xac, 0x88, 0x3c, 0x59, 0x3b, 0x73, 0x42, 0x2f, 0x28,
+	0x0e, 0x4f, 0x51, 0x8a, 0xf2, 0xbe, 0x3f, 0x01, 0x27, 0x64, 0x15, 0x44, 0x2e, 0xbd, 0xd5, 0xee,
+	0xd2, 0x81, 0xed, 0xfe, 0x04, 0xf3, 0x52, 0x77, 0xe3, 0x3d, 0x66, 0xc0, 0xaf, 0x1c, 0x7d, 0x36,
+	0x18, 0x1e, 0x6d, 0xc1, 0x05, 0x0d, 0x8e, 0xee, 0x69, 0x28, 0x85, 0x81, 0xf0, 0x53, 0x80, 0xa0,
+	0x2d, 0xad, 0xad, 0x62, 0x29, 0x0c, 0x8c, 0x9c, 0x89, 0xc9, 0x87, 0x98, 0x33, 0x91, 0x0f, 0x29,
+	0xac, 0xbe, 0x25, 0x21, 0x85, 0xee, 0x1e, 0x4c, 0x87, 0x3a, 0x54, 0x5a, 0xa4, 0xde, 0x8f, 0x73,
+	0x4d, 0x31, 0x02, 0xaf, 0xf9, 0xab, 0xe2, 0x06, 0x00, 0x4d, 0x59, 0xee, 0x97, 0x1d, 0x58, 0xf0,
+	0xf3, 0x99, 0xa3, 0xf5, 0xda, 0xf8, 0x63, 0x90, 0xe7, 0xc9, 0x9f, 0x7b, 0x1e, 0x02, 0xe3, 0xb0,
+	0x74, 0x1a, 0xeb, 0xd8, 0x0f, 0xa3, 0x88, 0x04, 0xe2, 0x6d, 0x64, 0x6d, 0xf7, 0x67, 0x50, 0x14,
+	0x58, 0xef, 0x0b, 0x25, 0xaa, 0x27, 0xf3, 0xc9, 0xab, 0x52, 0x8b, 0x74, 0xb2, 0x90, 0x73, 0xa8,
+	0x64, 0xa1, 0x52, 0x21, 0xc9, 0x42, 0x8f, 0x43, 0x25, 0xf3, 0x3b, 0x32, 0x44, 0x8d, 0x45, 0xcb,
+	0x6d, 0xfa, 0x34, 0x03, 0x8a, 0x42, 0x8f, 0x90, 0x4a, 0x44, 0xaf, 0xfc, 0x2d, 0xb6, 0x6d, 0x05,
+	0xfc, 0x79, 0x45, 0xe3, 0xca, 0xbf, 0x62, 0xc0, 0xd1, 0xa2, 0xf2, 0x3e, 0xef, 0xc0, 0x90, 0xe5,
+	0xd4, 0x5d, 0x84, 0x09, 0x3f, 0x08, 0x88, 0x4c, 0xdd, 0x62, 0x4e, 0x9e, 0x65, 0x0a, 0x40, 0x0e,
+	0xa7, 0xd9, 0x5d, 0x09, 0xe9, 0xc5, 0xbb, 0x2c, 0x04, 0x55, 0x65, 0x77, 0x21, 0x07, 0xa1, 0xc4,
+	0x51, 0x73, 0x62, 0x4f, 0xe4, 0x60, 0x98, 0x21, 0x78, 0x32, 0x2f, 0x03, 0x15, 0xd6, 0xfb, 0x37,
+	0x07, 0x66, 0xcc, 0xd7, 0x5b, 0xe8, 0xcb, 0x21, 0xc2, 0xf7, 0x2e, 0x6e, 0xfe, 0xd7, 0x0a, 0x7a,
+	0x17, 0x46, 0x38, 0xf7, 0x79, 0x8d, 0xc5, 0x07, 0x4a, 0x59, 0x2e, 0x81, 0xf2, 0x2b, 0xf1, 0x56,
+	0x01, 0x2f, 0x44, 0x9b, 0x22, 0x2f, 0xc7, 0x5b, 0xfc, 0xe5, 0xad, 0xcb, 0xf1, 0x16, 0x52, 0xfe,
+	0xde, 0x57, 0xcb, 0x70, 0x22, 0x47, 0x41, 0xd5, 0x24, 0xb6, 0xbc, 0xf2, 0x6a, 0x12, 0xcf, 0x1c,
+	0xe0, 0x38, 0x33, 0xad, 0xae, 0x74, 0x88, 0xb4, 0xba, 0xf2, 0xa8, 0xb4, 0x3a, 0xf9, 0xae, 0x58,
+	0xe5, 0x01, 0xbd, 0x2b, 0x46, 0xaf, 0x4a, 0x34, 0xd4, 0x21, 0xa4, 0xae, 0x98, 0x56, 0x3c, 0x88,
+	0xb2, 0x6b, 0x5a, 0xb7, 0x52, 0x57, 0xa5, 0xe6, 0x10, 0x05, 0x8e, 0x28, 0xc5, 0x02, 0xdc, 0xfd,
+	0xd6, 0x4e, 0xdc, 0x6e, 0xf3, 0x37, 0x96, 0x26, 0xed, 0xd8, 0xc1, 0x86, 0x81, 0x43, 0x8b, 0x92,
+	0x9d, 0xc5, 0xfc, 0xfa, 0xd7, 0x24, 0xad, 0x38, 0x0a, 0xf8, 0xcb, 0xf4, 0x65, 0xe3, 0x2c, 0xb6,
+	0xb0, 0x98, 0xa3, 0xf6, 0x76, 0xc1, 0x35, 0x87, 0x48, 0xdc, 0x59, 0x54, 0x6c, 0xb2, 0x73, 0xdc,
+	0xd8, 0xe4, 0x83, 0xf2, 0x7c, 0x32, 0x78, 0x74, 0xc4, 0x7c, 0x95, 0x36, 0x60, 0x67, 0xb4, 0x0d,
+	0x78, 0x44, 0x6b, 0x4b, 0x47, 0x6a, 0xed, 0x9f, 0x57, 0x61, 0xd6, 0x8a, 0x62, 0x3e, 0xa2, 0xe6,
+	0x43, 0x5f, 0xf2, 0x4b, 0x06, 0x11, 0x11, 0x21, 0xe9, 0xfa, 0x25, 0x3f, 0x0a, 0x44, 0x8e, 0xa3,
+	0x3b, 0x6c, 0x90, 0xec, 0xe1, 0x20, 0x12, 0x29, 0x17, 0x6a, 0x87, 0x5d, 0x65, 0x50, 0x14, 0x58,
+	0xf7, 0xd3, 0x3c, 0x60, 0xb4, 0x99, 0x25, 0x7e, 0x46, 0x3a, 0xf2, 0x69, 0xb5, 0x17, 0xc6, 0x7e,
+	0x18, 0x89, 0xb3, 0xe3, 0x7b, 0xa2, 0x09, 0x41, 0x4b, 0x1c, 0xcd, 0x10, 0x36, 0x1e, 0x83, 0x9a,
+	0x1c, 0x3b, 0xd0, 0x24, 0x1f, 0x1d, 0xce, 0x35, 0x8b, 0xfb, 0xbf, 0x09, 0xd5, 0x57, 0x5a, 0x4d,
+	0xf5, 0x01, 0x68, 0x35, 0x30, 0x42, 0xa3, 0x79, 0x1f, 0xd4, 0xe4, 0x03, 0xfd, 0xdc, 0x5a, 0x55,
+	0xe3, 0x4f, 0xa0, 0xc9, 0x2c, 0x91, 0x14, 0x35, 0x9e, 0x0e, 0xb7, 0x1f, 0xc4, 0xfd, 0xac, 0x5e,
+	0xb3, 0x87, 0x7b, 0x99, 0x02, 0x91, 0xe3, 0xf2, 0xca, 0x09, 0xbc, 0xe5, 0xca, 0xc9, 0xf4, 0xdb,
+	0x44, 0x39, 0x99, 0xb9, 0x9f, 0x72, 0xc2, 0x1e, 0xf2, 0xa0, 0xcb, 0x65, 0x39, 0xe9, 0xc4, 0x2b,
+	0xab, 0xf5, 0x59, 0x3b, 0xa2, 0x67, 0x43, 0xa3, 0xd0, 0xa4, 0xf3, 0x3e, 0xe7, 0xc0, 0xa9, 0x91,
+	0x33, 0xed, 0xa1, 0xf9, 0xd0, 0xbc, 0xaf, 0x95, 0xe1, 0xd1, 0x7c, 0x15, 0xe8, 0xa6, 0xb9, 0xfb,
+	0x60, 0x1e, 0x57, 0xe3, 0xdc, 0xf9, 0x2c, 0x1d, 0xb9, 0x88, 0x8e, 0x76, 0x89, 0xc9, 0xac, 0x44,
+	0x99, 0x87, 0x75, 0x91, 0xb8, 0x65, 0xbc, 0x80, 0x57, 0x19, 0xfb, 0x12, 0x31, 0x7c, 0x62, 0xed,
+	0xfb, 0x0e, 0xde, 0x3d, 0x07, 0x8c, 0x17, 0x26, 0xdd, 0x9f, 0x35, 0xf3, 0x8a, 0x8a, 0x51, 0xb9,
+	0x38, 0x67, 0xb5, 0x36, 0xf8, 0x40, 0x8d, 0xcc, 0x51, 0x8a, 0x61, 0x92, 0xbd, 0x54, 0x25, 0x53,
+	0xb3, 0xae, 0x14, 0x22, 0x99, 0x3d, 0x85, 0xb5, 0xc7, 0x37, 0x3b, 0xfe, 0x1b, 0x85, 0x18, 0x6f,
+	0x1b, 0x1e, 0xd5, 0x74, 0xaa, 0x4a, 0xfa, 0x14, 0x73, 0xee, 0x73, 0x8a, 0xbd, 0x1f, 0xa6, 0x52,
+	0xd2, 0x6d, 0x53, 0xcb, 0x8b, 0x38, 0xed, 0xd4, 0xac, 0x6a, 0x0a, 0x38, 0x2a, 0x0a, 0xba, 0x2c,
+	0xe7, 0xf3, 0x55, 0x1a, 0x71, 0x5a, 0x3b, 0x47, 0x39, 0xad, 0xd9, 0xc4, 0x96, 0x9b, 0x5a, 0xae,
+	0x0a, 0x6a, 0x07, 0x52, 0x14, 0xde, 0xb7, 0xa7, 0x40, 0x24, 0x22, 0xf5, 0xe3, 0x44, 0x5e, 0xa6,
+	0x9d, 0x91, 0x97, 0xe9, 0xff, 0x0d, 0x2b, 0x46, 0xa9, 0x60, 0x95, 0xe3, 0xaa, 0x60, 0x13, 0x07,
+	0x5c, 0xa5, 0xb4, 0x9e, 0x32, 0x79, 0x5f, 0x3d, 0xe5, 0x6d, 0x62, 0x04, 0xb0, 0xb2, 0xc9, 0xa6,
+	0x0a, 0xce, 0x26, 0xfb, 0x84, 0x95, 0x4d, 0x56, 0x3b, 0xbe, 0x69, 0x67, 0x74, 0x46, 0x19, 0xb5,
+	0x47, 0x06, 0x03, 0x91, 0x74, 0x28, 0xd6, 0x02, 0xd8, 0xf9, 0x45, 0xab, 0x36, 0x1a, 0xf3, 0xf4,
+	0xf4, 0xfd, 0x77, 0xd6, 0x99, 0x24, 0xa8, 0x4f, 0x17, 0x7d, 0xb8, 0xb0, 0xfb, 0xd5, 0x32, 0xe7,
+	0x8e, 0x52, 0x0c, 0xfd, 0x1f, 0x70, 0xdb, 0xcc, 0xff, 0x33, 0x53, 0xb4, 0x3c, 0x76, 0xd7, 0xe6,
+	0x9e, 0x23, 0x2e, 0xc2, 0xed, 0xc1, 0x24, 0xdb, 0x77, 0x82, 0xfa, 0x6c, 0xd1, 0xc2, 0xf8, 0x7f,
+	0xc1, 0x60, 0xcc, 0x51, 0x08, 0xa1, 0x77, 0x76, 0x9a, 0xa7, 0x17, 0x46, 0x9d, 0xb4, 0x3e, 0xa7,
+	0xef, 0xec, 0x37, 0x04, 0x0c, 0x15, 0xd6, 0xfb, 0xbe, 0x38, 0x40, 0x84, 0x99, 0xff, 0x7c, 0xee,
+	0xc9, 0x83, 0xc3, 0x5b, 0xc8, 0xf7, 0xe8, 0x6b, 0x9d, 0xf2, 0x0d, 0x94, 0x02, 0x5e, 0x41, 0xd5,
+	0x0f, 0xaa, 0x98, 0x6f, 0x74, 0x4a, 0x18, 0x1a, 0xc2, 0xac, 0xfd, 0xae, 0x7c, 0xd0, 0x7e, 0xe7,
+	0xfd, 0x8b, 0xb0, 0x52, 0xa8, 0x9b, 0x42, 0x0f, 0x26, 0x68, 0x0d, 0xf6, 0x0a, 0x78, 0xae, 0xc5,
+	0xe4, 0x4b, 0xe7, 0x9b, 0x88, 0xad, 0x65, 0x3f, 0x91, 0x4b, 0x71, 0x43, 0x61, 0xdd, 0x2f, 0xe6,
+	0x90, 0x94, 0xd2, 0xd8, 0x73, 0xbd, 0x53, 0xb6, 0x9b, 0xc0, 0x3b, 0x0f, 0x0b, 0x43, 0x35, 0xa2,
+	0xc7, 0x23, 0x7b, 0xa8, 0x21, 0x7f, 0x3c, 0xb2, 0xa7, 0x1c, 0x90, 0xe3, 0xbc, 0xaf, 0x89, 0x03,
+	0xcf, 0x64, 0xef, 0xfe, 0xa6, 0x03, 0x0b, 0x69, 0x9e, 0xdf, 0x03, 0xe9, 0x35, 0xe5, 0xa1, 0x1e,
+	0x42, 0xe1, 0x70, 0x0d, 0xbc, 0x2f, 0x97, 0x79, 0x65, 0xcd, 0x57, 0x33, 0xdd, 0x9f, 0xb4, 0xef,
+	0xf8, 0xef, 0xc9, 0x1f, 0x30, 0xa7, 0xf2, 0x25, 0xac, 0x73, 0xe6, 0x68, 0x47, 0xe8, 0x47, 0x78,
+	0xc4, 0xdd, 0x31, 0x9f, 0x39, 0xd1, 0x8a, 0x87, 0xe0, 0x81, 0x8a, 0x1b, 0xe5, 0x1c, 0x10, 0x3f,
+	0xe8, 0x86, 0x11, 0xa9, 0x57, 0x8e, 0xcf, 0x79, 0x55, 0xf0, 0x40, 0xc5, 0xed, 0x28, 0x27, 0xe9,
+	0xb3, 0x00, 0x54, 0x0d, 0x21, 0x01, 0x7b, 0xe0, 0x62, 0xd2, 0x4e, 0x5f, 0x43, 0x85, 0x41, 0x83,
+	0x8a, 0xae, 0xb2, 0xfc, 0x2b, 0x5e, 0x56, 0x8e, 0x94, 0x73, 0x60, 0x8e, 0x94, 0x9d, 0x91, 0x53,
+	0x3a, 0x54, 0x46, 0x8e, 0x99, 0x2c, 0x53, 0xbe, 0x6f, 0xb2, 0xcc, 0x93, 0x50, 0xdd, 0x21, 0x7b,
+	0x46, 0x56, 0x0d, 0xff, 0x5f, 0x4a, 0x1c, 0x84, 0x12, 0x47, 0x43, 0x51, 0x5a, 0x3c, 0xbf, 0x69,
+	0x82, 0x51, 0xb1, 0xcd, 0x56, 0xa4, 0x34, 0x09, 0x4c, 0x63, 0xe9, 0x8d, 0x37, 0xcf, 0x3c, 0xf2,
+	0xcd, 0x37, 0xcf, 0x3c, 0xf2, 0x9d, 0x37, 0xcf, 0x3c, 0xf2, 0xb9, 0xbb, 0x67, 0x9c, 0x37, 0xee,
+	0x9e, 0x71, 0xbe, 0x79, 0xf7, 0x8c, 0xf3, 0x9d, 0xbb, 0x67, 0x9c, 0x7f, 0xba, 0x7b, 0xc6, 0xf9,
+	0xf5, 0xef, 0x9e, 0x79, 0xe4, 0xa3, 0x53, 0x72, 0xba, 0xff, 0xcf, 0x00, 0x07, 0x54, 0x19, 0x0f,
+	0xb6, 0x77, 0x00, 0x00,
 }
@@ -27,60 +27,87 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ApplicationSourceJsonnet":         schema_pkg_apis_application_v1alpha1_ApplicationSourceJsonnet(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ApplicationSourceKsonnet":         schema_pkg_apis_application_v1alpha1_ApplicationSourceKsonnet(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ApplicationSourceKustomize":       schema_pkg_apis_application_v1alpha1_ApplicationSourceKustomize(ref),
+		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ApplicationSourcePatch":           schema_pkg_apis_application_v1alpha1_ApplicationSourcePatch(ref),
+		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ApplicationSourcePatchTarget":     schema_pkg_apis_application_v1alpha1_ApplicationSourcePatchTarget(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ApplicationSourcePlugin":          schema_pkg_apis_application_v1alpha1_ApplicationSourcePlugin(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ApplicationSpec":                  schema_pkg_apis_application_v1alpha1_ApplicationSpec(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ApplicationStatus":                schema_pkg_apis_application_v1alpha1_ApplicationStatus(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ApplicationSummary":               schema_pkg_apis_application_v1alpha1_ApplicationSummary(ref),
+		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ApplicationSuspend":               schema_pkg_apis_application_v1alpha1_ApplicationSuspend(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ApplicationTree":                  schema_pkg_apis_application_v1alpha1_ApplicationTree(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ApplicationWatchEvent":            schema_pkg_apis_application_v1alpha1_ApplicationWatchEvent(ref),
+		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ApplicationWriteBack":             schema_pkg_apis_application_v1alpha1_ApplicationWriteBack(ref),
+		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.AutomatedRollback":                schema_pkg_apis_application_v1alpha1_AutomatedRollback(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.Cluster":                          schema_pkg_apis_application_v1alpha1_Cluster(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ClusterConfig":                    schema_pkg_apis_application_v1alpha1_ClusterConfig(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ClusterList":                      schema_pkg_apis_application_v1alpha1_ClusterList(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.Command":                          schema_pkg_apis_application_v1alpha1_Command(ref),
+		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.CommitMetadata":                   schema_pkg_apis_application_v1alpha1_CommitMetadata(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ComparedTo":                       schema_pkg_apis_application_v1alpha1_ComparedTo(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ComponentParameter":               schema_pkg_apis_application_v1alpha1_ComponentParameter(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ConfigManagementPlugin":           schema_pkg_apis_application_v1alpha1_ConfigManagementPlugin(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ConnectionState":                  schema_pkg_apis_application_v1alpha1_ConnectionState(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.EnvEntry":                         schema_pkg_apis_application_v1alpha1_EnvEntry(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.HealthStatus":                     schema_pkg_apis_application_v1alpha1_HealthStatus(ref),
+		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.HelmFileParameter":                schema_pkg_apis_application_v1alpha1_HelmFileParameter(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.HelmParameter":                    schema_pkg_apis_application_v1alpha1_HelmParameter(ref),
+		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ImageUpdate":                      schema_pkg_apis_application_v1alpha1_ImageUpdate(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.Info":                             schema_pkg_apis_application_v1alpha1_Info(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.InfoItem":                         schema_pkg_apis_application_v1alpha1_InfoItem(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.JWTToken":                         schema_pkg_apis_application_v1alpha1_JWTToken(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.JsonnetVar":                       schema_pkg_apis_application_v1alpha1_JsonnetVar(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.KsonnetParameter":                 schema_pkg_apis_application_v1alpha1_KsonnetParameter(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.KustomizeOptions":                 schema_pkg_apis_application_v1alpha1_KustomizeOptions(ref),
+		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ManifestQuota":                    schema_pkg_apis_application_v1alpha1_ManifestQuota(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.Operation":                        schema_pkg_apis_application_v1alpha1_Operation(ref),
+		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.OperationInitiator":               schema_pkg_apis_application_v1alpha1_OperationInitiator(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.OperationState":                   schema_pkg_apis_application_v1alpha1_OperationState(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.OrphanedResourcesMonitorSettings": schema_pkg_apis_application_v1alpha1_OrphanedResourcesMonitorSettings(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ProjectRole":                      schema_pkg_apis_application_v1alpha1_ProjectRole(ref),
+		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ProjectRoleQuota":                 schema_pkg_apis_application_v1alpha1_ProjectRoleQuota(ref),
+		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ProjectTemplate":                  schema_pkg_apis_application_v1alpha1_ProjectTemplate(ref),
+		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ProjectTemplateParameter":         schema_pkg_apis_application_v1alpha1_ProjectTemplateParameter(ref),
+		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.RenderedSource":                   schema_pkg_apis_application_v1alpha1_RenderedSource(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.Repository":                       schema_pkg_apis_application_v1alpha1_Repository(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.RepositoryCertificate":            schema_pkg_apis_application_v1alpha1_RepositoryCertificate(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.RepositoryCertificateList":        schema_pkg_apis_application_v1alpha1_RepositoryCertificateList(ref),
+		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.RepositoryFailure":                schema_pkg_apis_application_v1alpha1_RepositoryFailure(ref),
+		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.RepositoryFailureList":            schema_pkg_apis_application_v1alpha1_RepositoryFailureList(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.RepositoryList":                   schema_pkg_apis_application_v1alpha1_RepositoryList(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ResourceAction":                   schema_pkg_apis_application_v1alpha1_ResourceAction(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ResourceActionDefinition":         schema_pkg_apis_application_v1alpha1_ResourceActionDefinition(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ResourceActionParam":              schema_pkg_apis_application_v1alpha1_ResourceActionParam(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ResourceActions":                  schema_pkg_apis_application_v1alpha1_ResourceActions(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ResourceDiff":                     schema_pkg_apis_application_v1alpha1_ResourceDiff(ref),
+		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ResourceHook":                     schema_pkg_apis_application_v1alpha1_ResourceHook(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ResourceIgnoreDifferences":        schema_pkg_apis_application_v1alpha1_ResourceIgnoreDifferences(ref),
+		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ResourceLink":                     schema_pkg_apis_application_v1alpha1_ResourceLink(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ResourceNetworkingInfo":           schema_pkg_apis_application_v1alpha1_ResourceNetworkingInfo(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ResourceNode":                     schema_pkg_apis_application_v1alpha1_ResourceNode(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ResourceOverride":                 schema_pkg_apis_application_v1alpha1_ResourceOverride(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ResourceRef":                      schema_pkg_apis_application_v1alpha1_ResourceRef(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ResourceResult":                   schema_pkg_apis_application_v1alpha1_ResourceResult(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ResourceStatus":                   schema_pkg_apis_application_v1alpha1_ResourceStatus(ref),
+		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.RevisionChangelog":                schema_pkg_apis_application_v1alpha1_RevisionChangelog(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.RevisionHistory":                  schema_pkg_apis_application_v1alpha1_RevisionHistory(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.RevisionMetadata":                 schema_pkg_apis_application_v1alpha1_RevisionMetadata(ref),
+		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.SecretKeyChanges":                 schema_pkg_apis_application_v1alpha1_SecretKeyChanges(ref),
+		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.SyncAnalysis":                     schema_pkg_apis_application_v1alpha1_SyncAnalysis(ref),
+		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.SyncAnalysisJob":                  schema_pkg_apis_application_v1alpha1_SyncAnalysisJob(ref),
+		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.SyncAnalysisResult":               schema_pkg_apis_application_v1alpha1_SyncAnalysisResult(ref),
+		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.SyncAnalysisWebhook":              schema_pkg_apis_application_v1alpha1_SyncAnalysisWebhook(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.SyncOperation":                    schema_pkg_apis_application_v1alpha1_SyncOperation(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.SyncOperationResource":            schema_pkg_apis_application_v1alpha1_SyncOperationResource(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.SyncOperationResult":              schema_pkg_apis_application_v1alpha1_SyncOperationResult(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.SyncPolicy":                       schema_pkg_apis_application_v1alpha1_SyncPolicy(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.SyncPolicyAutomated":              schema_pkg_apis_application_v1alpha1_SyncPolicyAutomated(ref),
+		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.SyncPolicyVerify":                 schema_pkg_apis_application_v1alpha1_SyncPolicyVerify(ref),
+		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.SyncReport":                       schema_pkg_apis_application_v1alpha1_SyncReport(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.SyncStatus":                       schema_pkg_apis_application_v1alpha1_SyncStatus(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.SyncStrategy":                     schema_pkg_apis_application_v1alpha1_SyncStrategy(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.SyncStrategyApply":                schema_pkg_apis_application_v1alpha1_SyncStrategyApply(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.SyncStrategyHook":                 schema_pkg_apis_application_v1alpha1_SyncStrategyHook(ref),
+		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.SyncVerification":                 schema_pkg_apis_application_v1alpha1_SyncVerification(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.TLSClientConfig":                  schema_pkg_apis_application_v1alpha1_TLSClientConfig(ref),
 		"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.objectMeta":                       schema_pkg_apis_application_v1alpha1_objectMeta(ref),
 	}
@@ -286,11 +313,44 @@ func schema_pkg_apis_application_v1alpha1_AppProjectSpec(ref common.ReferenceCal
 							Ref:         ref("github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.OrphanedResourcesMonitorSettings"),
 						},
 					},
+					"syncPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SyncPolicy is the default sync policy of applications in the project which do not define their own",
+							Ref:         ref("github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.SyncPolicy"),
+						},
+					},
+					"sourceDecryptionKeys": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SourceDecryptionKeys contains list of names of the SOPS key sets of the repo server, which may contain wildcards, whose keys can be used to decrypt the encrypted files of the project's applications",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"failOnSharedResource": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailOnSharedResource fails the sync of applications in the project if they would overwrite resources which are tracked by another application, instead of only reporting a warning condition",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"manifestQuota": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ManifestQuota limits the number of resources and the size of the manifests which the project's applications may render",
+							Ref:         ref("github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ManifestQuota"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ApplicationDestination", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.OrphanedResourcesMonitorSettings", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ProjectRole", "k8s.io/apimachinery/pkg/apis/meta/v1.GroupKind"},
+			"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ApplicationDestination", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ManifestQuota", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.OrphanedResourcesMonitorSettings", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ProjectRole", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.SyncPolicy", "k8s.io/apimachinery/pkg/apis/meta/v1.GroupKind"},
 	}
 }
 
@@ -504,12 +564,25 @@ func schema_pkg_apis_application_v1alpha1_ApplicationSource(ref common.Reference
 							Ref:         ref("github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ApplicationSourcePlugin"),
 						},
 					},
+					"patches": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Patches are applied to the rendered manifests, regardless of the source type",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ApplicationSourcePatch"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"repoURL", "path"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ApplicationSourceDirectory", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ApplicationSourceHelm", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ApplicationSourceKsonnet", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ApplicationSourceKustomize", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ApplicationSourcePlugin"},
+			"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ApplicationSourceDirectory", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ApplicationSourceHelm", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ApplicationSourceKsonnet", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ApplicationSourceKustomize", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ApplicationSourcePatch", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ApplicationSourcePlugin"},
 	}
 }
 
@@ -586,11 +659,38 @@ func schema_pkg_apis_application_v1alpha1_ApplicationSourceHelm(ref common.Refer
 							Format:      "",
 						},
 					},
+					"includeCRDs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IncludeCRDs renders the custom resource definitions in the crds/ directory of the chart along with its templates",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"fileParameters": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FileParameters are file parameters to the helm template",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.HelmFileParameter"),
+									},
+								},
+							},
+						},
+					},
+					"allowValueFilesOutsideChart": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowValueFilesOutsideChart allows value files outside of the chart directory, as long as they are within the same repository",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.HelmParameter"},
+			"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.HelmFileParameter", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.HelmParameter"},
 	}
 }
 
@@ -719,6 +819,74 @@ func schema_pkg_apis_application_v1alpha1_ApplicationSourceKustomize(ref common.
 	}
 }
 
+func schema_pkg_apis_application_v1alpha1_ApplicationSourcePatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ApplicationSourcePatch patches the rendered manifests which match its target",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"target": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Target selects the resources which are patched",
+							Ref:         ref("github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ApplicationSourcePatchTarget"),
+						},
+					},
+					"patch": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Patch is either a JSON6902 patch, i.e. a list of operations, or a strategic merge patch, in JSON or YAML",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"target", "patch"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ApplicationSourcePatchTarget"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_ApplicationSourcePatchTarget(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ApplicationSourcePatchTarget selects the resources which are patched",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"group": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the resource, all resources of the kind match if empty",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"kind"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_application_v1alpha1_ApplicationSourcePlugin(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -810,12 +978,50 @@ func schema_pkg_apis_application_v1alpha1_ApplicationSpec(ref common.ReferenceCa
 							},
 						},
 					},
+					"writeBack": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WriteBack configures parameter overrides to be committed to the git repository instead of being stored in the spec",
+							Ref:         ref("github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ApplicationWriteBack"),
+						},
+					},
+					"revisionHistoryLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RevisionHistoryLimit is the number of entries kept in the history of the application. Defaults to the application.revisionHistoryLimit setting of the argocd-cm ConfigMap.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"suspend": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Suspend pauses the automated sync and self-heal of the application. The sync status is still reported.",
+							Ref:         ref("github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ApplicationSuspend"),
+						},
+					},
+					"resourceHooks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceHooks define the hook behavior of resources which cannot be annotated, e.g. resources of third-party charts",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ResourceHook"),
+									},
+								},
+							},
+						},
+					},
+					"analysis": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Analysis gates the result of sync operations on a smoke test or analysis which runs after the PostSync hooks",
+							Ref:         ref("github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.SyncAnalysis"),
+						},
+					},
 				},
 				Required: []string{"source", "destination", "project"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ApplicationDestination", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ApplicationSource", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.Info", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ResourceIgnoreDifferences", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.SyncPolicy"},
+			"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ApplicationDestination", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ApplicationSource", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ApplicationSuspend", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ApplicationWriteBack", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.Info", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ResourceHook", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ResourceIgnoreDifferences", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.SyncAnalysis", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.SyncPolicy"},
 	}
 }
 
@@ -898,11 +1104,17 @@ func schema_pkg_apis_application_v1alpha1_ApplicationStatus(ref common.Reference
 							Ref: ref("github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ApplicationSummary"),
 						},
 					},
+					"verification": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Verification is the verification of the health of the application after its last sync",
+							Ref:         ref("github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.SyncVerification"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ApplicationCondition", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ApplicationSummary", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.HealthStatus", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.OperationState", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ResourceStatus", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.RevisionHistory", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.SyncStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ApplicationCondition", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ApplicationSummary", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.HealthStatus", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.OperationState", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ResourceStatus", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.RevisionHistory", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.SyncStatus", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.SyncVerification", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -940,9 +1152,52 @@ func schema_pkg_apis_application_v1alpha1_ApplicationSummary(ref common.Referenc
 							},
 						},
 					},
+					"renderedSources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RenderedSources holds the Helm charts and remote kustomize bases which the manifests were last rendered from",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.RenderedSource"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.RenderedSource"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_ApplicationSuspend(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ApplicationSuspend pauses the automated sync of an application, e.g. during incident response or maintenance",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"until": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Until is the time at which the automated sync resumes. The application is suspended until it is resumed manually if not set.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason describes why the application is suspended",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -1015,6 +1270,68 @@ func schema_pkg_apis_application_v1alpha1_ApplicationWatchEvent(ref common.Refer
 	}
 }
 
+func schema_pkg_apis_application_v1alpha1_ApplicationWriteBack(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ApplicationWriteBack configures how parameter overrides are written back to git",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"branch": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Branch is the branch which overrides are committed to",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the path of the overrides file, relative to the application path. Defaults to .argocd-source.yaml",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"pullRequest": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PullRequest pushes overrides to a new branch and opens a pull request against the branch instead of committing to it directly",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_AutomatedRollback(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AutomatedRollback records why the controller rolled an application back to its previous revision",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"fromRevision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FromRevision is the revision which failed its verification after the sync",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is why the verification of the revision failed",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"fromRevision"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_application_v1alpha1_Cluster(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1055,6 +1372,50 @@ func schema_pkg_apis_application_v1alpha1_Cluster(ref common.ReferenceCallback)
 							Format:      "",
 						},
 					},
+					"namespaces": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespaces restricts the destination namespaces of applications to the namespaces matching one of the patterns. All namespaces are permitted if it is empty.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels group clusters, e.g. by environment or region. They are stored as labels of the cluster secret, so RBAC policies and label selectors might refer to them.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Annotations hold arbitrary metadata of the cluster, and are stored as annotations of the cluster secret",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"server", "name", "config"},
 			},
@@ -1184,6 +1545,49 @@ func schema_pkg_apis_application_v1alpha1_Command(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_application_v1alpha1_CommitMetadata(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CommitMetadata is the metadata of a commit of a revision changelog",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"revision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "the SHA of the commit",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"author": {
+						SchemaProps: spec.SchemaProps{
+							Description: "who authored the commit, typically their name and email",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"date": {
+						SchemaProps: spec.SchemaProps{
+							Description: "when the commit was authored",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "the first line of the commit message",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"revision", "date"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_application_v1alpha1_ComparedTo(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1358,6 +1762,33 @@ func schema_pkg_apis_application_v1alpha1_HealthStatus(ref common.ReferenceCallb
 	}
 }
 
+func schema_pkg_apis_application_v1alpha1_HelmFileParameter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HelmFileParameter is a file parameter to a helm template, whose value is the contents of a file",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the helm parameter",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the path of the file, relative to the application path",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_application_v1alpha1_HelmParameter(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1392,6 +1823,55 @@ func schema_pkg_apis_application_v1alpha1_HelmParameter(ref common.ReferenceCall
 	}
 }
 
+func schema_pkg_apis_application_v1alpha1_ImageUpdate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ImageUpdate holds the Helm parameters and Kustomize images which were overridden by an image updater",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"helmParameters": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HelmParameters are the Helm parameters which were overridden",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.HelmParameter"),
+									},
+								},
+							},
+						},
+					},
+					"kustomizeImages": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KustomizeImages are the Kustomize images which were overridden",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"writeBack": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WriteBack is whether the overrides were also written back to the application spec",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.HelmParameter"},
+	}
+}
+
 func schema_pkg_apis_application_v1alpha1_Info(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1555,6 +2035,33 @@ func schema_pkg_apis_application_v1alpha1_KustomizeOptions(ref common.ReferenceC
 	}
 }
 
+func schema_pkg_apis_application_v1alpha1_ManifestQuota(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ManifestQuota limits the manifests rendered from the source of an application. Zero values are unlimited.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxResources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxResources is the maximum number of resources, including hooks",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"maxSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxSize is the maximum total size of the manifests in bytes",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_application_v1alpha1_Operation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1567,11 +2074,50 @@ func schema_pkg_apis_application_v1alpha1_Operation(ref common.ReferenceCallback
 							Ref: ref("github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.SyncOperation"),
 						},
 					},
+					"initiatedBy": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.OperationInitiator"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.SyncOperation"},
+			"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.OperationInitiator", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.SyncOperation"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_OperationInitiator(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OperationInitiator holds information about who initiated an operation",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"username": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Username is the name of the user or the project token which requested the operation",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"automated": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Automated is true if the operation was initiated by the automated sync policy of the application",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"source": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Source is the client the operation was requested from: ui, cli or api",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -1711,143 +2257,323 @@ func schema_pkg_apis_application_v1alpha1_ProjectRole(ref common.ReferenceCallba
 							},
 						},
 					},
+					"quota": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Quota limits the usage of each JWT token of this role",
+							Ref:         ref("github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ProjectRoleQuota"),
+						},
+					},
 				},
 				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.JWTToken"},
+			"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.JWTToken", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ProjectRoleQuota"},
 	}
 }
 
-func schema_pkg_apis_application_v1alpha1_Repository(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_application_v1alpha1_ProjectRoleQuota(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Repository is a repository holding application configurations",
+				Description: "ProjectRoleQuota limits the usage of the JWT tokens of a project role, so that a misconfigured pipeline cannot overwhelm the API server and the controller. Zero means no limit.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"repo": {
+					"requestsPerHour": {
 						SchemaProps: spec.SchemaProps{
-							Description: "URL of the repo",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "RequestsPerHour is the number of API requests a token may make per hour",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"username": {
+					"syncsPerHour": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Username for authenticating at the repo server",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "SyncsPerHour is the number of syncs, including rollbacks and image updates, a token may start per hour",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"password": {
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_ProjectTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProjectTemplate is a template from which projects are created. String values of the spec may reference parameters using '{{param}}' placeholders; the 'name' parameter is the name of the created project.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Password for authenticating at the repo server",
+							Description: "Name is the name of the template",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"sshPrivateKey": {
+					"description": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SSH private key data for authenticating at the repo server only for Git repos",
+							Description: "Description is a description of the template",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"connectionState": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Current state of repository server connecting",
-							Ref:         ref("github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ConnectionState"),
-						},
-					},
-					"insecureIgnoreHostKey": {
-						SchemaProps: spec.SchemaProps{
-							Description: "InsecureIgnoreHostKey should not be used anymore, Insecure is favoured only for Git repos",
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-					"insecure": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Whether the repo is insecure",
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-					"enableLfs": {
+					"parameters": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Whether git-lfs support should be enabled for this repo",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "Parameters are the parameters which can be used in the spec",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ProjectTemplateParameter"),
+									},
+								},
+							},
 						},
 					},
-					"tlsClientCertData": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TLS client cert data for authenticating at the repo server",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Spec is the spec of the created projects",
+							Ref:         ref("github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.AppProjectSpec"),
 						},
 					},
-					"tlsClientCertKey": {
+				},
+				Required: []string{"name", "spec"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.AppProjectSpec", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ProjectTemplateParameter"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_ProjectTemplateParameter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProjectTemplateParameter is a parameter of a project template",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TLS client cert key for authenticating at the repo server",
+							Description: "Name is the name of the parameter",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"tlsClientCaData": {
+					"description": {
 						SchemaProps: spec.SchemaProps{
-							Description: "only for Helm repos",
+							Description: "Description is a description of the parameter",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"type": {
+					"default": {
 						SchemaProps: spec.SchemaProps{
-							Description: "type of the repo, maybe \"git or \"helm, \"git\" is assumed if empty or absent",
+							Description: "Default is the value used if the parameter is not provided",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"name": {
+					"required": {
 						SchemaProps: spec.SchemaProps{
-							Description: "only for Helm repos",
-							Type:        []string{"string"},
+							Description: "Required indicates that the parameter must be provided",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"repo"},
+				Required: []string{"name"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ConnectionState"},
 	}
 }
 
-func schema_pkg_apis_application_v1alpha1_RepositoryCertificate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_application_v1alpha1_RenderedSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "A RepositoryCertificate is either SSH known hosts entry or TLS certificate",
+				Description: "RenderedSource is a Helm chart, or a remote kustomize base, which the manifests of an application were rendered from",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"serverName": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name of the server the certificate is intended for",
+							Description: "Type is the type of the source, i.e. Helm or Kustomize",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"certType": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type of certificate - currently \"https\" or \"ssh\"",
+							Description: "Name is the name of the Helm chart, or the URL of the kustomize base without its revision",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"certSubType": {
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version is the version of the Helm chart, or the revision of the kustomize base",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"appVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AppVersion is the version of the application packaged by the Helm chart",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dependency": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Dependency is true for the dependencies of the Helm chart of the application",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"type", "name"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_Repository(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Repository is a repository holding application configurations",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"repo": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL of the repo",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"username": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Username for authenticating at the repo server",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"password": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Password for authenticating at the repo server",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"sshPrivateKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SSH private key data for authenticating at the repo server only for Git repos",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"connectionState": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Current state of repository server connecting",
+							Ref:         ref("github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ConnectionState"),
+						},
+					},
+					"insecureIgnoreHostKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InsecureIgnoreHostKey should not be used anymore, Insecure is favoured only for Git repos",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"insecure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Whether the repo is insecure",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"enableLfs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Whether git-lfs support should be enabled for this repo",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"tlsClientCertData": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLS client cert data for authenticating at the repo server",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tlsClientCertKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLS client cert key for authenticating at the repo server",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tlsClientCaData": {
+						SchemaProps: spec.SchemaProps{
+							Description: "only for Helm repos",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "type of the repo, maybe \"git or \"helm, \"git\" is assumed if empty or absent",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "only for Helm repos",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"webhookID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID of the push webhook which Argo CD created in the repository, deleted together with the repository",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"repo"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ConnectionState"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_RepositoryCertificate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "A RepositoryCertificate is either SSH known hosts entry or TLS certificate",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"serverName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the server the certificate is intended for",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"certType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type of certificate - currently \"https\" or \"ssh\"",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"certSubType": {
 						SchemaProps: spec.SchemaProps{
 							Description: "The sub type of the cert, i.e. \"ssh-rsa\"",
 							Type:        []string{"string"},
@@ -1909,6 +2635,97 @@ func schema_pkg_apis_application_v1alpha1_RepositoryCertificateList(ref common.R
 	}
 }
 
+func schema_pkg_apis_application_v1alpha1_RepositoryFailure(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RepositoryFailure describes the most recent failed request of the repo server to a repository",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"repo": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Repo is the URL of the repository",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"requestType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequestType is the type of the failed request, e.g. 'fetch' or 'ls-remote'",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is the error of the failed request",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"authFailure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AuthFailure is true if the request failed because the credentials of the repository were rejected",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"failures": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Failures is the number of consecutive failed requests. Zero if the repository was reached again since the failure.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"failedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailedAt is the time of the failed request",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastSucceededAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastSucceededAt is the time of the last successful request preceding or following the failure",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"repo", "requestType", "message", "failures", "failedAt"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_RepositoryFailureList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RepositoryFailureList is a collection of RepositoryFailure",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.RepositoryFailure"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.RepositoryFailure"},
+	}
+}
+
 func schema_pkg_apis_application_v1alpha1_RepositoryList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2123,17 +2940,32 @@ func schema_pkg_apis_application_v1alpha1_ResourceDiff(ref common.ReferenceCallb
 							Format: "",
 						},
 					},
+					"secretKeyChanges": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretKeyChanges lists the keys which differ between the target and live state of secrets, whose data values are hidden from the target state, live state and diff",
+							Ref:         ref("github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.SecretKeyChanges"),
+						},
+					},
+					"predictedLiveState": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PredictedLiveState is the live state with the diff applied, i.e. the state of the resource after a sync as normalized by the controller. It is only set if the target and live state differ.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.SecretKeyChanges"},
 	}
 }
 
-func schema_pkg_apis_application_v1alpha1_ResourceIgnoreDifferences(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_application_v1alpha1_ResourceHook(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ResourceIgnoreDifferences contains resource filter and list of json paths which should be ignored during comparison with live state.",
+				Description: "ResourceHook defines the hook behavior of the resources which match the group, kind and name, as if they were annotated with the hook annotations. The annotations of a resource take precedence.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"group": {
@@ -2150,8 +2982,9 @@ func schema_pkg_apis_application_v1alpha1_ResourceIgnoreDifferences(ref common.R
 					},
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Name of the resource, all resources of the kind match if empty",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 					"namespace": {
@@ -2160,9 +2993,10 @@ func schema_pkg_apis_application_v1alpha1_ResourceIgnoreDifferences(ref common.R
 							Format: "",
 						},
 					},
-					"jsonPointers": {
+					"hook": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Hook is the list of hook types, like the argocd.argoproj.io/hook annotation",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
@@ -2173,19 +3007,119 @@ func schema_pkg_apis_application_v1alpha1_ResourceIgnoreDifferences(ref common.R
 							},
 						},
 					},
-				},
-				Required: []string{"group", "kind", "jsonPointers"},
-			},
-		},
-	}
-}
-
-func schema_pkg_apis_application_v1alpha1_ResourceNetworkingInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ResourceNetworkingInfo holds networking resource related information",
-				Type:        []string{"object"},
+					"syncWave": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SyncWave is the wave of the sync the resource is in, like the argocd.argoproj.io/sync-wave annotation",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"deletePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeletePolicy is the list of hook delete policies, like the argocd.argoproj.io/hook-delete-policy annotation",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"kind"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_ResourceIgnoreDifferences(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ResourceIgnoreDifferences contains resource filter and list of json paths which should be ignored during comparison with live state.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"group": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"jsonPointers": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"group", "kind", "jsonPointers"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_ResourceLink(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ResourceLink is a link from a resource to an external page, such as a dashboard, logs or a runbook",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"title": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Title is a human readable title of the link",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL is the address of the page",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"title", "url"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_ResourceNetworkingInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ResourceNetworkingInfo holds networking resource related information",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"targetLabels": {
 						SchemaProps: spec.SchemaProps{
@@ -2357,11 +3291,23 @@ func schema_pkg_apis_application_v1alpha1_ResourceNode(ref common.ReferenceCallb
 							Ref: ref("github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.HealthStatus"),
 						},
 					},
+					"links": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ResourceLink"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.HealthStatus", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.InfoItem", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ResourceNetworkingInfo", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ResourceRef"},
+			"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.HealthStatus", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.InfoItem", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ResourceLink", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ResourceNetworkingInfo", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ResourceRef"},
 	}
 }
 
@@ -2390,6 +3336,27 @@ func schema_pkg_apis_application_v1alpha1_ResourceOverride(ref common.ReferenceC
 							Format: "",
 						},
 					},
+					"health.progressingTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HealthProgressingTimeout is the duration after which a resource which stays progressing is reported degraded, e.g. '15m'",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"health.completed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HealthCompleted is the assessment of completed jobs and workflows, which is one of 'Healthy', 'Suspended' or 'Ignore'",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"health.includeHooks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HealthIncludeHooks allows the hooks of the kind to affect the health of the application",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -2572,111 +3539,419 @@ func schema_pkg_apis_application_v1alpha1_ResourceStatus(ref common.ReferenceCal
 							Ref: ref("github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.HealthStatus"),
 						},
 					},
-					"hook": {
+					"hook": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"requiresPruning": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"outOfSyncSince": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OutOfSyncSince is the time at which the resource was first detected to be out of sync, kept while it stays out of sync",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"progressingSince": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProgressingSince is the time at which the resource was first detected to be progressing, kept while it stays progressing",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.HealthStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_RevisionChangelog(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RevisionChangelog is the list of commits between the deployed and the target revision of an application",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"revision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "the revision which is deployed",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"targetRevision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "the revision which will be deployed by the next sync",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"commits": {
+						SchemaProps: spec.SchemaProps{
+							Description: "the commits which are reachable from the target revision but not from the deployed revision, newest first",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.CommitMetadata"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"revision", "targetRevision", "commits"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.CommitMetadata"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_RevisionHistory(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RevisionHistory contains information relevant to an application deployment",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"revision": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"deployedAt": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int64",
+						},
+					},
+					"source": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ApplicationSource"),
+						},
+					},
+					"initiatedBy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InitiatedBy is the initiator of the operation which deployed the revision",
+							Ref:         ref("github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.OperationInitiator"),
+						},
+					},
+					"imageUpdate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImageUpdate holds the image overrides if the revision was deployed by an image updater",
+							Ref:         ref("github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ImageUpdate"),
+						},
+					},
+					"automatedRollback": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AutomatedRollback holds why the controller rolled back to the revision, if it was deployed by an automated rollback",
+							Ref:         ref("github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.AutomatedRollback"),
+						},
+					},
+					"pinned": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Pinned is set if the revision was explicitly requested instead of being the target revision of the application",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"revision", "deployedAt", "id"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ApplicationSource", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.AutomatedRollback", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ImageUpdate", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.OperationInitiator", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_RevisionMetadata(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "data about a specific revision within a repo",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"author": {
+						SchemaProps: spec.SchemaProps{
+							Description: "who authored this revision, typically their name and email, e.g. \"John Doe <john_doe@my-company.com>\", but might not match this example",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"date": {
+						SchemaProps: spec.SchemaProps{
+							Description: "when the revision was authored",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"tags": {
+						SchemaProps: spec.SchemaProps{
+							Description: "tags on the revision, note - tags can move from one revision to another",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "the message associated with the revision, probably the commit message, this is truncated to the first newline or 64 characters (which ever comes first), unless the full metadata is requested",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"changedFiles": {
+						SchemaProps: spec.SchemaProps{
+							Description: "the files, relative to the root of the repository, which the revision changed, only set if the full metadata is requested",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"date"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_SecretKeyChanges(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SecretKeyChanges are the keys of the data of a secret which are added, removed or modified by its target state",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"added": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"removed": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"modified": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_SyncAnalysis(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SyncAnalysis is run after all the PostSync hooks of a sync operation completed successfully. The operation fails if the analysis fails. Either or both of a webhook and a job may be given; the job runs first.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"webhook": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Webhook is called with the details of the sync operation, which fails unless it responds with a 2xx status code",
+							Ref:         ref("github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.SyncAnalysisWebhook"),
+						},
+					},
+					"job": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Job is run in the destination namespace as the last PostSync hook, and the operation fails unless it completes",
+							Ref:         ref("github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.SyncAnalysisJob"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.SyncAnalysisJob", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.SyncAnalysisWebhook"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_SyncAnalysisJob(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SyncAnalysisJob is a template of the Kubernetes Job which analyses a sync operation. The ARGOCD_APP_NAME, ARGOCD_APP_NAMESPACE and ARGOCD_APP_REVISION environment variables are set in its container.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"image": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"command": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"args": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"env": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.EnvEntry"),
+									},
+								},
+							},
+						},
+					},
+					"serviceAccountName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServiceAccountName is the service account of the pods of the job",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"backoffLimit": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Description: "BackoffLimit is the number of retries before the job is considered failed (default: 0)",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"requiresPruning": {
+					"timeoutSeconds": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Description: "TimeoutSeconds is how long the job may run before it is considered failed",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
 				},
+				Required: []string{"image"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.HealthStatus"},
+			"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.EnvEntry"},
 	}
 }
 
-func schema_pkg_apis_application_v1alpha1_RevisionHistory(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_application_v1alpha1_SyncAnalysisResult(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "RevisionHistory contains information relevant to an application deployment",
+				Description: "SyncAnalysisResult is the result of the analysis of a sync operation",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"revision": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"deployedAt": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"id": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int64",
-						},
-					},
-					"source": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ApplicationSource"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
 				},
-				Required: []string{"revision", "deployedAt", "id"},
+				Required: []string{"phase"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ApplicationSource", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_application_v1alpha1_RevisionMetadata(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_application_v1alpha1_SyncAnalysisWebhook(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "data about a specific revision within a repo",
+				Description: "SyncAnalysisWebhook is an external URL which analyses a sync operation",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"author": {
+					"url": {
 						SchemaProps: spec.SchemaProps{
-							Description: "who authored this revision, typically their name and email, e.g. \"John Doe <john_doe@my-company.com>\", but might not match this example",
+							Description: "URL is sent a POST request with the application, project, destination and revision of the sync as JSON",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"date": {
-						SchemaProps: spec.SchemaProps{
-							Description: "when the revision was authored",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"tags": {
-						SchemaProps: spec.SchemaProps{
-							Description: "tags on the revision, note - tags can move from one revision to another",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
-						},
-					},
-					"message": {
+					"timeoutSeconds": {
 						SchemaProps: spec.SchemaProps{
-							Description: "the message associated with the revision, probably the commit message, this is truncated to the first newline or 64 characters (which ever comes first)",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "TimeoutSeconds is how long the webhook may take to respond (default: 30, maximum: 300)",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
 				},
-				Required: []string{"date"},
+				Required: []string{"url"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -2747,11 +4022,44 @@ func schema_pkg_apis_application_v1alpha1_SyncOperation(ref common.ReferenceCall
 							},
 						},
 					},
+					"adopt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Adopt takes ownership of resources which already exist in the cluster but are not tracked by any application",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"imageUpdate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImageUpdate records the image overrides requested by an image updater, if the sync was initiated by one",
+							Ref:         ref("github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ImageUpdate"),
+						},
+					},
+					"automatedRollback": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AutomatedRollback records why the controller rolled the application back, if the sync is such a rollback",
+							Ref:         ref("github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.AutomatedRollback"),
+						},
+					},
+					"pinned": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Pinned is set if the sync deploys an explicitly requested revision instead of the target revision of the application, e.g. a hotfix. The target revision of the application is left unchanged.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"pruneArgoCD": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PruneArgoCD confirms that the sync may prune the argocd-server and argocd-application-controller deployments, e.g. of the application which manages Argo CD itself",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ApplicationSource", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.SyncOperationResource", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.SyncStrategy"},
+			"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ApplicationSource", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.AutomatedRollback", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ImageUpdate", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.SyncOperationResource", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.SyncStrategy"},
 	}
 }
 
@@ -2820,12 +4128,18 @@ func schema_pkg_apis_application_v1alpha1_SyncOperationResult(ref common.Referen
 							Ref:         ref("github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ApplicationSource"),
 						},
 					},
+					"analysis": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Analysis holds the result of the analysis of the sync, if the application defines one",
+							Ref:         ref("github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.SyncAnalysisResult"),
+						},
+					},
 				},
 				Required: []string{"revision"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ApplicationSource", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ResourceResult"},
+			"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ApplicationSource", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ResourceResult", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.SyncAnalysisResult"},
 	}
 }
 
@@ -2842,11 +4156,17 @@ func schema_pkg_apis_application_v1alpha1_SyncPolicy(ref common.ReferenceCallbac
 							Ref:         ref("github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.SyncPolicyAutomated"),
 						},
 					},
+					"verify": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Verify waits for the application to become healthy after each sync, and optionally rolls it back if it does not",
+							Ref:         ref("github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.SyncPolicyVerify"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.SyncPolicyAutomated"},
+			"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.SyncPolicyAutomated", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.SyncPolicyVerify"},
 	}
 }
 
@@ -2877,6 +4197,166 @@ func schema_pkg_apis_application_v1alpha1_SyncPolicyAutomated(ref common.Referen
 	}
 }
 
+func schema_pkg_apis_application_v1alpha1_SyncPolicyVerify(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SyncPolicyVerify controls the verification of the health of an application after a sync",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"timeoutSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimeoutSeconds is how long the application may take to become healthy after a sync (default: 300)",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"rollback": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rollback rolls the application back to the previously deployed revision if it does not become healthy in time",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_SyncReport(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SyncReport is a machine-readable report of a completed sync operation, which can be archived as deployment evidence",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID identifies the report among the reports of the application. It is the start time of the operation in Unix seconds.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"revision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Revision is the revision which was synced",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"source": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Source is the application source which was synced",
+							Ref:         ref("github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ApplicationSource"),
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the final phase of the operation",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is the final message of the operation",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dryRun": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DryRun indicates the operation did not change the cluster",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"initiatedBy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InitiatedBy is the initiator of the operation",
+							Ref:         ref("github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.OperationInitiator"),
+						},
+					},
+					"startedAt": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"finishedAt": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"durationSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DurationSeconds is the duration of the operation, including waiting for hooks and sync waves",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"applied": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Applied holds the results of the resources which were applied",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ResourceResult"),
+									},
+								},
+							},
+						},
+					},
+					"hooks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hooks holds the results of the hooks which were run",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ResourceResult"),
+									},
+								},
+							},
+						},
+					},
+					"pruned": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Pruned holds the results of the resources which were pruned, or required pruning",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ResourceResult"),
+									},
+								},
+							},
+						},
+					},
+					"warnings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Warnings holds the warning conditions of the application when the operation completed",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"id", "phase", "startedAt", "finishedAt", "durationSeconds"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ApplicationSource", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.OperationInitiator", "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1.ResourceResult", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_application_v1alpha1_SyncStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2977,6 +4457,62 @@ func schema_pkg_apis_application_v1alpha1_SyncStrategyHook(ref common.ReferenceC
 	}
 }
 
+func schema_pkg_apis_application_v1alpha1_SyncVerification(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SyncVerification is the verification that an application became healthy after a sync",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the phase of the verification",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"revision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Revision is the revision which was synced",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"syncedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SyncedAt is the time the verified sync finished",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"deadline": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Deadline is the time by which the application must be healthy",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is the outcome of the verification",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"rolledBack": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RolledBack is true if the application was rolled back since the verification failed",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"phase", "revision", "syncedAt", "deadline"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_application_v1alpha1_TLSClientConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -7,7 +7,9 @@ import (
 	"path/filepath"
 	"reflect"
 	"regexp"
+	"sort"
 	"strings"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -31,6 +33,7 @@ import (
 // +genclient:noStatus
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +kubebuilder:resource:path=applications,shortName=app;apps
+// +kubebuilder:subresource:status
 type Application struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata" protobuf:"bytes,1,opt,name=metadata"`
@@ -53,6 +56,150 @@ type ApplicationSpec struct {
 	IgnoreDifferences []ResourceIgnoreDifferences `json:"ignoreDifferences,omitempty" protobuf:"bytes,5,name=ignoreDifferences"`
 	// Infos contains a list of useful information (URLs, email addresses, and plain text) that relates to the application
 	Info []Info `json:"info,omitempty" protobuf:"bytes,6,name=info"`
+	// WriteBack configures parameter overrides to be committed to the git repository instead of being stored in the spec
+	WriteBack *ApplicationWriteBack `json:"writeBack,omitempty" protobuf:"bytes,7,opt,name=writeBack"`
+	// RevisionHistoryLimit is the number of entries kept in the history of the application. Defaults to the
+	// application.revisionHistoryLimit setting of the argocd-cm ConfigMap.
+	RevisionHistoryLimit *int64 `json:"revisionHistoryLimit,omitempty" protobuf:"bytes,8,opt,name=revisionHistoryLimit"`
+	// Suspend pauses the automated sync and self-heal of the application. The sync status is still reported.
+	Suspend *ApplicationSuspend `json:"suspend,omitempty" protobuf:"bytes,9,opt,name=suspend"`
+	// ResourceHooks define the hook behavior of resources which cannot be annotated, e.g. resources of third-party charts
+	ResourceHooks []ResourceHook `json:"resourceHooks,omitempty" protobuf:"bytes,10,rep,name=resourceHooks"`
+	// Analysis gates the result of sync operations on a smoke test or analysis which runs after the PostSync hooks
+	Analysis *SyncAnalysis `json:"analysis,omitempty" protobuf:"bytes,11,opt,name=analysis"`
+}
+
+const (
+	// DefaultSyncAnalysisWebhookTimeoutSeconds is how long the analysis webhook may take to respond by default
+	DefaultSyncAnalysisWebhookTimeoutSeconds = 30
+	// MaxSyncAnalysisWebhookTimeoutSeconds is the longest the analysis webhook may take to respond
+	MaxSyncAnalysisWebhookTimeoutSeconds = 300
+)
+
+// SyncAnalysis is run after all the PostSync hooks of a sync operation completed successfully. The operation fails if the
+// analysis fails. Either or both of a webhook and a job may be given; the job runs first.
+type SyncAnalysis struct {
+	// Webhook is called with the details of the sync operation, which fails unless it responds with a 2xx status code
+	Webhook *SyncAnalysisWebhook `json:"webhook,omitempty" protobuf:"bytes,1,opt,name=webhook"`
+	// Job is run in the destination namespace as the last PostSync hook, and the operation fails unless it completes
+	Job *SyncAnalysisJob `json:"job,omitempty" protobuf:"bytes,2,opt,name=job"`
+}
+
+// SyncAnalysisWebhook is an external URL which analyses a sync operation
+type SyncAnalysisWebhook struct {
+	// URL is sent a POST request with the application, project, destination and revision of the sync as JSON
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// TimeoutSeconds is how long the webhook may take to respond (default: 30, maximum: 300)
+	TimeoutSeconds int64 `json:"timeoutSeconds,omitempty" protobuf:"varint,2,opt,name=timeoutSeconds"`
+}
+
+// GetTimeout returns how long the webhook may take to respond
+func (w *SyncAnalysisWebhook) GetTimeout() time.Duration {
+	if w.TimeoutSeconds > MaxSyncAnalysisWebhookTimeoutSeconds {
+		return MaxSyncAnalysisWebhookTimeoutSeconds * time.Second
+	}
+	if w.TimeoutSeconds > 0 {
+		return time.Duration(w.TimeoutSeconds) * time.Second
+	}
+	return DefaultSyncAnalysisWebhookTimeoutSeconds * time.Second
+}
+
+// SyncAnalysisJob is a template of the Kubernetes Job which analyses a sync operation. The ARGOCD_APP_NAME,
+// ARGOCD_APP_NAMESPACE and ARGOCD_APP_REVISION environment variables are set in its container.
+type SyncAnalysisJob struct {
+	Image   string   `json:"image" protobuf:"bytes,1,opt,name=image"`
+	Command []string `json:"command,omitempty" protobuf:"bytes,2,rep,name=command"`
+	Args    []string `json:"args,omitempty" protobuf:"bytes,3,rep,name=args"`
+	Env     Env      `json:"env,omitempty" protobuf:"bytes,4,rep,name=env"`
+	// ServiceAccountName is the service account of the pods of the job
+	ServiceAccountName string `json:"serviceAccountName,omitempty" protobuf:"bytes,5,opt,name=serviceAccountName"`
+	// BackoffLimit is the number of retries before the job is considered failed (default: 0)
+	BackoffLimit int64 `json:"backoffLimit,omitempty" protobuf:"varint,6,opt,name=backoffLimit"`
+	// TimeoutSeconds is how long the job may run before it is considered failed
+	TimeoutSeconds int64 `json:"timeoutSeconds,omitempty" protobuf:"varint,7,opt,name=timeoutSeconds"`
+}
+
+// SyncAnalysisResult is the result of the analysis of a sync operation
+type SyncAnalysisResult struct {
+	Phase   OperationPhase `json:"phase" protobuf:"bytes,1,opt,name=phase"`
+	Message string         `json:"message,omitempty" protobuf:"bytes,2,opt,name=message"`
+}
+
+// ResourceHook defines the hook behavior of the resources which match the group, kind and name, as if they were annotated
+// with the hook annotations. The annotations of a resource take precedence.
+type ResourceHook struct {
+	Group string `json:"group,omitempty" protobuf:"bytes,1,opt,name=group"`
+	Kind  string `json:"kind" protobuf:"bytes,2,opt,name=kind"`
+	// Name of the resource, all resources of the kind match if empty
+	Name      string `json:"name,omitempty" protobuf:"bytes,3,opt,name=name"`
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,4,opt,name=namespace"`
+	// Hook is the list of hook types, like the argocd.argoproj.io/hook annotation
+	Hook []HookType `json:"hook,omitempty" protobuf:"bytes,5,rep,name=hook,casttype=HookType"`
+	// SyncWave is the wave of the sync the resource is in, like the argocd.argoproj.io/sync-wave annotation
+	SyncWave *int64 `json:"syncWave,omitempty" protobuf:"bytes,6,opt,name=syncWave"`
+	// DeletePolicy is the list of hook delete policies, like the argocd.argoproj.io/hook-delete-policy annotation
+	DeletePolicy []HookDeletePolicy `json:"deletePolicy,omitempty" protobuf:"bytes,7,rep,name=deletePolicy,casttype=HookDeletePolicy"`
+}
+
+// Matches returns true if the resource hook applies to the given resource
+func (h *ResourceHook) Matches(obj *unstructured.Unstructured) bool {
+	gvk := obj.GroupVersionKind()
+	return gvk.Group == h.Group && gvk.Kind == h.Kind &&
+		(h.Name == "" || h.Name == obj.GetName()) &&
+		(h.Namespace == "" || h.Namespace == obj.GetNamespace())
+}
+
+// ApplicationSuspend pauses the automated sync of an application, e.g. during incident response or maintenance
+type ApplicationSuspend struct {
+	// Until is the time at which the automated sync resumes. The application is suspended until it is resumed manually
+	// if not set.
+	Until *metav1.Time `json:"until,omitempty" protobuf:"bytes,1,opt,name=until"`
+	// Reason describes why the application is suspended
+	Reason string `json:"reason,omitempty" protobuf:"bytes,2,opt,name=reason"`
+}
+
+// IsSuspended returns true if the automated sync of the application is suspended at the given time
+func (spec *ApplicationSpec) IsSuspended(now time.Time) bool {
+	if spec.Suspend == nil {
+		return false
+	}
+	return spec.Suspend.Until == nil || now.Before(spec.Suspend.Until.Time)
+}
+
+// GetRevisionHistoryLimit returns the number of entries kept in the history of the application
+func (spec *ApplicationSpec) GetRevisionHistoryLimit(defaultLimit int) int {
+	if spec.RevisionHistoryLimit != nil {
+		return int(*spec.RevisionHistoryLimit)
+	}
+	return defaultLimit
+}
+
+// ApplicationWriteBack configures how parameter overrides are written back to git
+type ApplicationWriteBack struct {
+	// Branch is the branch which overrides are committed to
+	Branch string `json:"branch,omitempty" protobuf:"bytes,1,opt,name=branch"`
+	// Path is the path of the overrides file, relative to the application path. Defaults to .argocd-source.yaml
+	Path string `json:"path,omitempty" protobuf:"bytes,2,opt,name=path"`
+	// PullRequest pushes overrides to a new branch and opens a pull request against the branch instead of committing
+	// to it directly
+	PullRequest bool `json:"pullRequest,omitempty" protobuf:"bytes,3,opt,name=pullRequest"`
+}
+
+// GetParameterOverridesFile returns the path of the parameter overrides file, relative to the application path, or an
+// empty string if the application does not write parameter overrides back to git
+func (spec *ApplicationSpec) GetParameterOverridesFile() string {
+	if spec.WriteBack == nil {
+		return ""
+	}
+	return spec.WriteBack.GetPath()
+}
+
+// GetPath returns the path of the overrides file, relative to the application path
+func (w *ApplicationWriteBack) GetPath() string {
+	if w == nil || w.Path == "" {
+		return common.DefaultParameterOverridesFile
+	}
+	return w.Path
 }
 
 // ResourceIgnoreDifferences contains resource filter and list of json paths which should be ignored during comparison with live state.
@@ -110,6 +257,33 @@ type ApplicationSource struct {
 	Directory *ApplicationSourceDirectory `json:"directory,omitempty" protobuf:"bytes,10,opt,name=directory"`
 	// ConfigManagementPlugin holds config management plugin specific options
 	Plugin *ApplicationSourcePlugin `json:"plugin,omitempty" protobuf:"bytes,11,opt,name=plugin"`
+	// Patches are applied to the rendered manifests, regardless of the source type
+	Patches []ApplicationSourcePatch `json:"patches,omitempty" protobuf:"bytes,12,rep,name=patches"`
+}
+
+// ApplicationSourcePatch patches the rendered manifests which match its target
+type ApplicationSourcePatch struct {
+	// Target selects the resources which are patched
+	Target ApplicationSourcePatchTarget `json:"target" protobuf:"bytes,1,opt,name=target"`
+	// Patch is either a JSON6902 patch, i.e. a list of operations, or a strategic merge patch, in JSON or YAML
+	Patch string `json:"patch" protobuf:"bytes,2,opt,name=patch"`
+}
+
+// ApplicationSourcePatchTarget selects the resources which are patched
+type ApplicationSourcePatchTarget struct {
+	Group string `json:"group,omitempty" protobuf:"bytes,1,opt,name=group"`
+	Kind  string `json:"kind" protobuf:"bytes,2,opt,name=kind"`
+	// Name of the resource, all resources of the kind match if empty
+	Name      string `json:"name,omitempty" protobuf:"bytes,3,opt,name=name"`
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,4,opt,name=namespace"`
+}
+
+// Matches returns true if the resource is selected by the target
+func (t *ApplicationSourcePatchTarget) Matches(obj *unstructured.Unstructured) bool {
+	gvk := obj.GroupVersionKind()
+	return gvk.Group == t.Group && gvk.Kind == t.Kind &&
+		(t.Name == "" || t.Name == obj.GetName()) &&
+		(t.Namespace == "" || t.Namespace == obj.GetNamespace())
 }
 
 func (a *ApplicationSource) IsZero() bool {
@@ -121,7 +295,8 @@ func (a *ApplicationSource) IsZero() bool {
 			a.Kustomize.IsZero() &&
 			a.Ksonnet.IsZero() &&
 			a.Directory.IsZero() &&
-			a.Plugin.IsZero()
+			a.Plugin.IsZero() &&
+			len(a.Patches) == 0
 }
 
 type ApplicationSourceType string
@@ -151,6 +326,13 @@ type ApplicationSourceHelm struct {
 	ReleaseName string `json:"releaseName,omitempty" protobuf:"bytes,3,opt,name=releaseName"`
 	// Values is Helm values, typically defined as a block
 	Values string `json:"values,omitempty" protobuf:"bytes,4,opt,name=values"`
+	// IncludeCRDs renders the custom resource definitions in the crds/ directory of the chart along with its templates
+	IncludeCRDs bool `json:"includeCRDs,omitempty" protobuf:"bytes,5,opt,name=includeCRDs"`
+	// FileParameters are file parameters to the helm template
+	FileParameters []HelmFileParameter `json:"fileParameters,omitempty" protobuf:"bytes,6,opt,name=fileParameters"`
+	// AllowValueFilesOutsideChart allows value files outside of the chart directory, as long as they are within the
+	// same repository
+	AllowValueFilesOutsideChart bool `json:"allowValueFilesOutsideChart,omitempty" protobuf:"bytes,7,opt,name=allowValueFilesOutsideChart"`
 }
 
 // HelmParameter is a parameter to a helm template
@@ -163,6 +345,14 @@ type HelmParameter struct {
 	ForceString bool `json:"forceString,omitempty" protobuf:"bytes,3,opt,name=forceString"`
 }
 
+// HelmFileParameter is a file parameter to a helm template, whose value is the contents of a file
+type HelmFileParameter struct {
+	// Name is the name of the helm parameter
+	Name string `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
+	// Path is the path of the file, relative to the application path
+	Path string `json:"path,omitempty" protobuf:"bytes,2,opt,name=path"`
+}
+
 var helmParameterRx = regexp.MustCompile(`([^\\]),`)
 
 func NewHelmParameter(text string, forceString bool) (*HelmParameter, error) {
@@ -191,8 +381,29 @@ func (in *ApplicationSourceHelm) AddParameter(p HelmParameter) {
 	}
 }
 
+func NewHelmFileParameter(text string) (*HelmFileParameter, error) {
+	parts := strings.SplitN(text, "=", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Expected helm file parameter of the form: param=path. Received: %s", text)
+	}
+	return &HelmFileParameter{
+		Name: parts[0],
+		Path: parts[1],
+	}, nil
+}
+
+func (in *ApplicationSourceHelm) AddFileParameter(p HelmFileParameter) {
+	for i, cp := range in.FileParameters {
+		if cp.Name == p.Name {
+			in.FileParameters[i] = p
+			return
+		}
+	}
+	in.FileParameters = append(in.FileParameters, p)
+}
+
 func (h *ApplicationSourceHelm) IsZero() bool {
-	return h == nil || (h.ReleaseName == "") && len(h.ValueFiles) == 0 && len(h.Parameters) == 0 && h.Values == ""
+	return h == nil || (h.ReleaseName == "") && len(h.ValueFiles) == 0 && len(h.Parameters) == 0 && len(h.FileParameters) == 0 && h.Values == "" && !h.IncludeCRDs && !h.AllowValueFilesOutsideChart
 }
 
 type KustomizeImage string
@@ -328,11 +539,58 @@ type ApplicationStatus struct {
 	ObservedAt     *metav1.Time           `json:"observedAt,omitempty" protobuf:"bytes,8,opt,name=observedAt"`
 	SourceType     ApplicationSourceType  `json:"sourceType,omitempty" protobuf:"bytes,9,opt,name=sourceType"`
 	Summary        ApplicationSummary     `json:"summary,omitempty" protobuf:"bytes,10,opt,name=summary"`
+	// Verification is the verification of the health of the application after its last sync
+	Verification *SyncVerification `json:"verification,omitempty" protobuf:"bytes,11,opt,name=verification"`
+}
+
+// SyncVerificationPhase is the phase of the verification of an application after a sync
+type SyncVerificationPhase string
+
+const (
+	SyncVerificationRunning   SyncVerificationPhase = "Running"
+	SyncVerificationSucceeded SyncVerificationPhase = "Succeeded"
+	SyncVerificationFailed    SyncVerificationPhase = "Failed"
+)
+
+// SyncVerification is the verification that an application became healthy after a sync
+type SyncVerification struct {
+	// Phase is the phase of the verification
+	Phase SyncVerificationPhase `json:"phase" protobuf:"bytes,1,opt,name=phase"`
+	// Revision is the revision which was synced
+	Revision string `json:"revision" protobuf:"bytes,2,opt,name=revision"`
+	// SyncedAt is the time the verified sync finished
+	SyncedAt metav1.Time `json:"syncedAt" protobuf:"bytes,3,opt,name=syncedAt"`
+	// Deadline is the time by which the application must be healthy
+	Deadline metav1.Time `json:"deadline" protobuf:"bytes,4,opt,name=deadline"`
+	// Message is the outcome of the verification
+	Message string `json:"message,omitempty" protobuf:"bytes,5,opt,name=message"`
+	// RolledBack is true if the application was rolled back since the verification failed
+	RolledBack bool `json:"rolledBack,omitempty" protobuf:"varint,6,opt,name=rolledBack"`
 }
 
 // Operation contains requested operation parameters.
 type Operation struct {
-	Sync *SyncOperation `json:"sync,omitempty" protobuf:"bytes,1,opt,name=sync"`
+	Sync        *SyncOperation     `json:"sync,omitempty" protobuf:"bytes,1,opt,name=sync"`
+	InitiatedBy OperationInitiator `json:"initiatedBy,omitempty" protobuf:"bytes,2,opt,name=initiatedBy"`
+}
+
+const (
+	// OperationSourceUI indicates an operation requested from the web UI
+	OperationSourceUI = "ui"
+	// OperationSourceCLI indicates an operation requested using the argocd CLI
+	OperationSourceCLI = "cli"
+	// OperationSourceAPI indicates an operation requested by any other API client
+	OperationSourceAPI = "api"
+)
+
+// OperationInitiator holds information about who initiated an operation
+type OperationInitiator struct {
+	// Username is the name of the user or the project token which requested the operation
+	Username string `json:"username,omitempty" protobuf:"bytes,1,opt,name=username"`
+	// Automated is true if the operation was initiated by the automated sync policy of the application
+	Automated bool `json:"automated,omitempty" protobuf:"bytes,2,opt,name=automated"`
+	// Source is the client the operation was requested from: ui, cli or api
+	Source string `json:"source,omitempty" protobuf:"bytes,3,opt,name=source"`
 }
 
 // SyncOperationResource contains resources to sync.
@@ -368,6 +626,56 @@ type SyncOperation struct {
 	Source *ApplicationSource `json:"source,omitempty" protobuf:"bytes,7,opt,name=source"`
 	// Manifests is an optional field that overrides sync source with a local directory for development
 	Manifests []string `json:"manifests,omitempty" protobuf:"bytes,8,opt,name=manifests"`
+	// Adopt takes ownership of resources which already exist in the cluster but are not tracked by any application
+	Adopt bool `json:"adopt,omitempty" protobuf:"bytes,9,opt,name=adopt"`
+	// ImageUpdate records the image overrides requested by an image updater, if the sync was initiated by one
+	ImageUpdate *ImageUpdate `json:"imageUpdate,omitempty" protobuf:"bytes,10,opt,name=imageUpdate"`
+	// AutomatedRollback records why the controller rolled the application back, if the sync is such a rollback
+	AutomatedRollback *AutomatedRollback `json:"automatedRollback,omitempty" protobuf:"bytes,11,opt,name=automatedRollback"`
+	// Pinned is set if the sync deploys an explicitly requested revision instead of the target revision of the
+	// application, e.g. a hotfix. The target revision of the application is left unchanged.
+	Pinned bool `json:"pinned,omitempty" protobuf:"bytes,12,opt,name=pinned"`
+	// PruneArgoCD confirms that the sync may prune the argocd-server and argocd-application-controller deployments,
+	// e.g. of the application which manages Argo CD itself
+	PruneArgoCD bool `json:"pruneArgoCD,omitempty" protobuf:"bytes,13,opt,name=pruneArgoCD"`
+}
+
+// AutomatedRollback records why the controller rolled an application back to its previous revision
+type AutomatedRollback struct {
+	// FromRevision is the revision which failed its verification after the sync
+	FromRevision string `json:"fromRevision" protobuf:"bytes,1,opt,name=fromRevision"`
+	// Reason is why the verification of the revision failed
+	Reason string `json:"reason,omitempty" protobuf:"bytes,2,opt,name=reason"`
+}
+
+// ImageUpdate holds the Helm parameters and Kustomize images which were overridden by an image updater
+type ImageUpdate struct {
+	// HelmParameters are the Helm parameters which were overridden
+	HelmParameters []HelmParameter `json:"helmParameters,omitempty" protobuf:"bytes,1,opt,name=helmParameters"`
+	// KustomizeImages are the Kustomize images which were overridden
+	KustomizeImages KustomizeImages `json:"kustomizeImages,omitempty" protobuf:"bytes,2,opt,name=kustomizeImages"`
+	// WriteBack is whether the overrides were also written back to the application spec
+	WriteBack bool `json:"writeBack,omitempty" protobuf:"bytes,3,opt,name=writeBack"`
+}
+
+// Apply merges the image overrides into the given source
+func (u *ImageUpdate) Apply(source *ApplicationSource) {
+	if len(u.HelmParameters) > 0 {
+		if source.Helm == nil {
+			source.Helm = &ApplicationSourceHelm{}
+		}
+		for _, p := range u.HelmParameters {
+			source.Helm.AddParameter(p)
+		}
+	}
+	if len(u.KustomizeImages) > 0 {
+		if source.Kustomize == nil {
+			source.Kustomize = &ApplicationSourceKustomize{}
+		}
+		for _, image := range u.KustomizeImages {
+			source.Kustomize.MergeImage(image)
+		}
+	}
 }
 
 func (o *SyncOperation) IsApplyStrategy() bool {
@@ -429,6 +737,27 @@ type Info struct {
 type SyncPolicy struct {
 	// Automated will keep an application synced to the target revision
 	Automated *SyncPolicyAutomated `json:"automated,omitempty" protobuf:"bytes,1,opt,name=automated"`
+	// Verify waits for the application to become healthy after each sync, and optionally rolls it back if it does not
+	Verify *SyncPolicyVerify `json:"verify,omitempty" protobuf:"bytes,2,opt,name=verify"`
+}
+
+// DefaultSyncVerifyTimeoutSeconds is how long an application may take to become healthy after a sync by default
+const DefaultSyncVerifyTimeoutSeconds = 300
+
+// SyncPolicyVerify controls the verification of the health of an application after a sync
+type SyncPolicyVerify struct {
+	// TimeoutSeconds is how long the application may take to become healthy after a sync (default: 300)
+	TimeoutSeconds int64 `json:"timeoutSeconds,omitempty" protobuf:"varint,1,opt,name=timeoutSeconds"`
+	// Rollback rolls the application back to the previously deployed revision if it does not become healthy in time
+	Rollback bool `json:"rollback,omitempty" protobuf:"varint,2,opt,name=rollback"`
+}
+
+// GetTimeout returns how long the application may take to become healthy after a sync
+func (v *SyncPolicyVerify) GetTimeout() time.Duration {
+	if v.TimeoutSeconds <= 0 {
+		return DefaultSyncVerifyTimeoutSeconds * time.Second
+	}
+	return time.Duration(v.TimeoutSeconds) * time.Second
 }
 
 // SyncPolicyAutomated controls the behavior of an automated sync
@@ -523,8 +852,33 @@ type RevisionMetadata struct {
 	Tags []string `json:"tags,omitempty" protobuf:"bytes,3,opt,name=tags"`
 	// the message associated with the revision,
 	// probably the commit message,
-	// this is truncated to the first newline or 64 characters (which ever comes first)
+	// this is truncated to the first newline or 64 characters (which ever comes first), unless the full metadata is requested
 	Message string `json:"message,omitempty" protobuf:"bytes,4,opt,name=message"`
+	// the files, relative to the root of the repository, which the revision changed,
+	// only set if the full metadata is requested
+	ChangedFiles []string `json:"changedFiles,omitempty" protobuf:"bytes,5,rep,name=changedFiles"`
+}
+
+// CommitMetadata is the metadata of a commit of a revision changelog
+type CommitMetadata struct {
+	// the SHA of the commit
+	Revision string `json:"revision" protobuf:"bytes,1,opt,name=revision"`
+	// who authored the commit, typically their name and email
+	Author string `json:"author,omitempty" protobuf:"bytes,2,opt,name=author"`
+	// when the commit was authored
+	Date metav1.Time `json:"date" protobuf:"bytes,3,opt,name=date"`
+	// the first line of the commit message
+	Message string `json:"message,omitempty" protobuf:"bytes,4,opt,name=message"`
+}
+
+// RevisionChangelog is the list of commits between the deployed and the target revision of an application
+type RevisionChangelog struct {
+	// the revision which is deployed
+	Revision string `json:"revision" protobuf:"bytes,1,opt,name=revision"`
+	// the revision which will be deployed by the next sync
+	TargetRevision string `json:"targetRevision" protobuf:"bytes,2,opt,name=targetRevision"`
+	// the commits which are reachable from the target revision but not from the deployed revision, newest first
+	Commits []CommitMetadata `json:"commits" protobuf:"bytes,3,rep,name=commits"`
 }
 
 // SyncOperationResult represent result of sync operation
@@ -535,6 +889,8 @@ type SyncOperationResult struct {
 	Revision string `json:"revision" protobuf:"bytes,2,opt,name=revision"`
 	// Source records the application source information of the sync, used for comparing auto-sync
 	Source ApplicationSource `json:"source,omitempty" protobuf:"bytes,3,opt,name=source"`
+	// Analysis holds the result of the analysis of the sync, if the application defines one
+	Analysis *SyncAnalysisResult `json:"analysis,omitempty" protobuf:"bytes,4,opt,name=analysis"`
 }
 
 type ResultCode string
@@ -612,12 +968,50 @@ func (r ResourceResults) PruningRequired() (num int) {
 	return num
 }
 
+// SyncReport is a machine-readable report of a completed sync operation, which can be archived as deployment evidence
+type SyncReport struct {
+	// ID identifies the report among the reports of the application. It is the start time of the operation in Unix seconds.
+	ID int64 `json:"id" protobuf:"bytes,1,opt,name=id"`
+	// Revision is the revision which was synced
+	Revision string `json:"revision,omitempty" protobuf:"bytes,2,opt,name=revision"`
+	// Source is the application source which was synced
+	Source ApplicationSource `json:"source,omitempty" protobuf:"bytes,3,opt,name=source"`
+	// Phase is the final phase of the operation
+	Phase OperationPhase `json:"phase" protobuf:"bytes,4,opt,name=phase"`
+	// Message is the final message of the operation
+	Message string `json:"message,omitempty" protobuf:"bytes,5,opt,name=message"`
+	// DryRun indicates the operation did not change the cluster
+	DryRun bool `json:"dryRun,omitempty" protobuf:"bytes,6,opt,name=dryRun"`
+	// InitiatedBy is the initiator of the operation
+	InitiatedBy OperationInitiator `json:"initiatedBy,omitempty" protobuf:"bytes,7,opt,name=initiatedBy"`
+	StartedAt   metav1.Time        `json:"startedAt" protobuf:"bytes,8,opt,name=startedAt"`
+	FinishedAt  metav1.Time        `json:"finishedAt" protobuf:"bytes,9,opt,name=finishedAt"`
+	// DurationSeconds is the duration of the operation, including waiting for hooks and sync waves
+	DurationSeconds int64 `json:"durationSeconds" protobuf:"bytes,10,opt,name=durationSeconds"`
+	// Applied holds the results of the resources which were applied
+	Applied ResourceResults `json:"applied,omitempty" protobuf:"bytes,11,opt,name=applied"`
+	// Hooks holds the results of the hooks which were run
+	Hooks ResourceResults `json:"hooks,omitempty" protobuf:"bytes,12,opt,name=hooks"`
+	// Pruned holds the results of the resources which were pruned, or required pruning
+	Pruned ResourceResults `json:"pruned,omitempty" protobuf:"bytes,13,opt,name=pruned"`
+	// Warnings holds the warning conditions of the application when the operation completed
+	Warnings []string `json:"warnings,omitempty" protobuf:"bytes,14,opt,name=warnings"`
+}
+
 // RevisionHistory contains information relevant to an application deployment
 type RevisionHistory struct {
 	Revision   string            `json:"revision" protobuf:"bytes,2,opt,name=revision"`
 	DeployedAt metav1.Time       `json:"deployedAt" protobuf:"bytes,4,opt,name=deployedAt"`
 	ID         int64             `json:"id" protobuf:"bytes,5,opt,name=id"`
 	Source     ApplicationSource `json:"source,omitempty" protobuf:"bytes,6,opt,name=source"`
+	// InitiatedBy is the initiator of the operation which deployed the revision
+	InitiatedBy OperationInitiator `json:"initiatedBy,omitempty" protobuf:"bytes,7,opt,name=initiatedBy"`
+	// ImageUpdate holds the image overrides if the revision was deployed by an image updater
+	ImageUpdate *ImageUpdate `json:"imageUpdate,omitempty" protobuf:"bytes,8,opt,name=imageUpdate"`
+	// AutomatedRollback holds why the controller rolled back to the revision, if it was deployed by an automated rollback
+	AutomatedRollback *AutomatedRollback `json:"automatedRollback,omitempty" protobuf:"bytes,9,opt,name=automatedRollback"`
+	// Pinned is set if the revision was explicitly requested instead of being the target revision of the application
+	Pinned bool `json:"pinned,omitempty" protobuf:"bytes,10,opt,name=pinned"`
 }
 
 // ApplicationWatchEvent contains information about application change.
@@ -674,6 +1068,8 @@ const (
 	ApplicationConditionSyncError = "SyncError"
 	// ApplicationConditionUnknownError indicates an unknown controller error
 	ApplicationConditionUnknownError = "UnknownError"
+	// ApplicationConditionManifestQuotaError indicates that the manifests of the application exceed the quota of its project
+	ApplicationConditionManifestQuotaError = "ManifestQuotaError"
 	// ApplicationConditionSharedResourceWarning indicates that controller detected resources which belongs to more than one application
 	ApplicationConditionSharedResourceWarning = "SharedResourceWarning"
 	// ApplicationConditionRepeatedResourceWarning indicates that application source has resource with same Group, Kind, Name, Namespace multiple times
@@ -682,6 +1078,12 @@ const (
 	ApplicationConditionExcludedResourceWarning = "ExcludedResourceWarning"
 	// ApplicationConditionOrphanedResourceWarning indicates that application has orphaned resources
 	ApplicationConditionOrphanedResourceWarning = "OrphanedResourceWarning"
+	// ApplicationConditionStaleManifestsWarning indicates that the repository is unreachable and application was compared to the last generated manifests
+	ApplicationConditionStaleManifestsWarning = "StaleManifestsWarning"
+	// ApplicationConditionArgoCDPruneWarning indicates that components of Argo CD require pruning
+	ApplicationConditionArgoCDPruneWarning = "ArgoCDPruneWarning"
+	// ApplicationConditionIncompleteComparisonWarning indicates that resources of some kinds are not cached, so that extra resources of these kinds are not detected
+	ApplicationConditionIncompleteComparisonWarning = "IncompleteComparisonWarning"
 )
 
 // ApplicationCondition contains details about current application condition
@@ -752,6 +1154,22 @@ type ApplicationSummary struct {
 	ExternalURLs []string `json:"externalURLs,omitempty" protobuf:"bytes,1,opt,name=externalURLs"`
 	// Images holds all images of application child resources.
 	Images []string `json:"images,omitempty" protobuf:"bytes,2,opt,name=images"`
+	// RenderedSources holds the Helm charts and remote kustomize bases which the manifests were last rendered from
+	RenderedSources []RenderedSource `json:"renderedSources,omitempty" protobuf:"bytes,3,rep,name=renderedSources"`
+}
+
+// RenderedSource is a Helm chart, or a remote kustomize base, which the manifests of an application were rendered from
+type RenderedSource struct {
+	// Type is the type of the source, i.e. Helm or Kustomize
+	Type ApplicationSourceType `json:"type" protobuf:"bytes,1,opt,name=type,casttype=ApplicationSourceType"`
+	// Name is the name of the Helm chart, or the URL of the kustomize base without its revision
+	Name string `json:"name" protobuf:"bytes,2,opt,name=name"`
+	// Version is the version of the Helm chart, or the revision of the kustomize base
+	Version string `json:"version,omitempty" protobuf:"bytes,3,opt,name=version"`
+	// AppVersion is the version of the application packaged by the Helm chart
+	AppVersion string `json:"appVersion,omitempty" protobuf:"bytes,4,opt,name=appVersion"`
+	// Dependency is true for the dependencies of the Helm chart of the application
+	Dependency bool `json:"dependency,omitempty" protobuf:"bytes,5,opt,name=dependency"`
 }
 
 func (t *ApplicationTree) FindNode(group string, kind string, namespace string, name string) *ResourceNode {
@@ -784,6 +1202,9 @@ func (t *ApplicationTree) GetSummary() ApplicationSummary {
 	for image := range imagesSet {
 		images = append(images, image)
 	}
+	// sorted so that the summary in the application status does not change from one reconciliation to the next
+	sort.Strings(urls)
+	sort.Strings(images)
 	return ApplicationSummary{ExternalURLs: urls, Images: images}
 }
 
@@ -806,6 +1227,15 @@ type ResourceNode struct {
 	ResourceVersion string                  `json:"resourceVersion,omitempty" protobuf:"bytes,5,opt,name=resourceVersion"`
 	Images          []string                `json:"images,omitempty" protobuf:"bytes,6,opt,name=images"`
 	Health          *HealthStatus           `json:"health,omitempty" protobuf:"bytes,7,opt,name=health"`
+	Links           []ResourceLink          `json:"links,omitempty" protobuf:"bytes,8,opt,name=links"`
+}
+
+// ResourceLink is a link from a resource to an external page, such as a dashboard, logs or a runbook
+type ResourceLink struct {
+	// Title is a human readable title of the link
+	Title string `json:"title" protobuf:"bytes,1,opt,name=title"`
+	// URL is the address of the page
+	URL string `json:"url" protobuf:"bytes,2,opt,name=url"`
 }
 
 func (n *ResourceNode) GroupKindVersion() schema.GroupVersionKind {
@@ -827,6 +1257,10 @@ type ResourceStatus struct {
 	Health          *HealthStatus  `json:"health,omitempty" protobuf:"bytes,7,opt,name=health"`
 	Hook            bool           `json:"hook,omitempty" protobuf:"bytes,8,opt,name=hook"`
 	RequiresPruning bool           `json:"requiresPruning,omitempty" protobuf:"bytes,9,opt,name=requiresPruning"`
+	// OutOfSyncSince is the time at which the resource was first detected to be out of sync, kept while it stays out of sync
+	OutOfSyncSince *metav1.Time `json:"outOfSyncSince,omitempty" protobuf:"bytes,10,opt,name=outOfSyncSince"`
+	// ProgressingSince is the time at which the resource was first detected to be progressing, kept while it stays progressing
+	ProgressingSince *metav1.Time `json:"progressingSince,omitempty" protobuf:"bytes,11,opt,name=progressingSince"`
 }
 
 func (r *ResourceStatus) GroupVersionKind() schema.GroupVersionKind {
@@ -843,6 +1277,41 @@ type ResourceDiff struct {
 	LiveState   string `json:"liveState,omitempty" protobuf:"bytes,6,opt,name=liveState"`
 	Diff        string `json:"diff,omitempty" protobuf:"bytes,7,opt,name=diff"`
 	Hook        bool   `json:"hook,omitempty" protobuf:"bytes,8,opt,name=hook"`
+	// SecretKeyChanges lists the keys which differ between the target and live state of secrets, whose data values are
+	// hidden from the target state, live state and diff
+	SecretKeyChanges *SecretKeyChanges `json:"secretKeyChanges,omitempty" protobuf:"bytes,9,opt,name=secretKeyChanges"`
+	// PredictedLiveState is the live state with the diff applied, i.e. the state of the resource after a sync as
+	// normalized by the controller. It is only set if the target and live state differ.
+	PredictedLiveState string `json:"predictedLiveState,omitempty" protobuf:"bytes,10,opt,name=predictedLiveState"`
+}
+
+// SecretKeyChanges are the keys of the data of a secret which are added, removed or modified by its target state
+type SecretKeyChanges struct {
+	Added    []string `json:"added,omitempty" protobuf:"bytes,1,rep,name=added"`
+	Removed  []string `json:"removed,omitempty" protobuf:"bytes,2,rep,name=removed"`
+	Modified []string `json:"modified,omitempty" protobuf:"bytes,3,rep,name=modified"`
+}
+
+// IsEmpty returns true if no keys changed
+func (c *SecretKeyChanges) IsEmpty() bool {
+	return c == nil || len(c.Added) == 0 && len(c.Removed) == 0 && len(c.Modified) == 0
+}
+
+// Summary returns a summary of the changed keys, e.g. "added: a, b; modified: c"
+func (c *SecretKeyChanges) Summary() string {
+	if c == nil {
+		return ""
+	}
+	var parts []string
+	for _, change := range []struct {
+		name string
+		keys []string
+	}{{"added", c.Added}, {"removed", c.Removed}, {"modified", c.Modified}} {
+		if len(change.keys) > 0 {
+			parts = append(parts, fmt.Sprintf("%s: %s", change.name, strings.Join(change.keys, ", ")))
+		}
+	}
+	return strings.Join(parts, "; ")
 }
 
 // ConnectionStatus represents connection status
@@ -872,6 +1341,27 @@ type Cluster struct {
 	ConnectionState ConnectionState `json:"connectionState,omitempty" protobuf:"bytes,4,opt,name=connectionState"`
 	// The server version
 	ServerVersion string `json:"serverVersion,omitempty" protobuf:"bytes,5,opt,name=serverVersion"`
+	// Namespaces restricts the destination namespaces of applications to the namespaces matching one of the patterns.
+	// All namespaces are permitted if it is empty.
+	Namespaces []string `json:"namespaces,omitempty" protobuf:"bytes,6,rep,name=namespaces"`
+	// Labels group clusters, e.g. by environment or region. They are stored as labels of the cluster secret, so RBAC
+	// policies and label selectors might refer to them.
+	Labels map[string]string `json:"labels,omitempty" protobuf:"bytes,7,rep,name=labels"`
+	// Annotations hold arbitrary metadata of the cluster, and are stored as annotations of the cluster secret
+	Annotations map[string]string `json:"annotations,omitempty" protobuf:"bytes,8,rep,name=annotations"`
+}
+
+// IsNamespacePermitted returns whether applications may be deployed into the namespace of the cluster
+func (c *Cluster) IsNamespacePermitted(namespace string) bool {
+	if len(c.Namespaces) == 0 {
+		return true
+	}
+	for _, pattern := range c.Namespaces {
+		if globMatch(pattern, namespace) {
+			return true
+		}
+	}
+	return false
 }
 
 // ClusterList is a collection of Clusters.
@@ -906,6 +1396,11 @@ type ClusterConfig struct {
 
 	// AWSAuthConfig contains IAM authentication configuration
 	AWSAuthConfig *AWSAuthConfig `json:"awsAuthConfig,omitempty" protobuf:"bytes,5,opt,name=awsAuthConfig"`
+
+	// InCluster connects to the cluster Argo CD runs in with the service account of Argo CD, regardless of the server
+	// address. It is only set by the database for the aliases of the in-cluster destination, and is neither stored in
+	// cluster secrets nor accepted by the API.
+	InCluster bool `json:"-"`
 }
 
 // TLSClientConfig contains settings to enable transport layer security
@@ -932,6 +1427,12 @@ type ResourceOverride struct {
 	HealthLua         string `json:"health.lua,omitempty" protobuf:"bytes,1,opt,name=healthLua"`
 	Actions           string `json:"actions,omitempty" protobuf:"bytes,3,opt,name=actions"`
 	IgnoreDifferences string `json:"ignoreDifferences,omitempty" protobuf:"bytes,2,opt,name=ignoreDifferences"`
+	// HealthProgressingTimeout is the duration after which a resource which stays progressing is reported degraded, e.g. '15m'
+	HealthProgressingTimeout string `json:"health.progressingTimeout,omitempty" protobuf:"bytes,4,opt,name=healthProgressingTimeout"`
+	// HealthCompleted is the assessment of completed jobs and workflows, which is one of 'Healthy', 'Suspended' or 'Ignore'
+	HealthCompleted string `json:"health.completed,omitempty" protobuf:"bytes,5,opt,name=healthCompleted"`
+	// HealthIncludeHooks allows the hooks of the kind to affect the health of the application
+	HealthIncludeHooks bool `json:"health.includeHooks,omitempty" protobuf:"bytes,6,opt,name=healthIncludeHooks"`
 }
 
 func (o *ResourceOverride) GetActions() (ResourceActions, error) {
@@ -995,6 +1496,8 @@ type Repository struct {
 	Type string `json:"type,omitempty" protobuf:"bytes,12,opt,name=type"`
 	// only for Helm repos
 	Name string `json:"name,omitempty" protobuf:"bytes,13,opt,name=name"`
+	// ID of the push webhook which Argo CD created in the repository, deleted together with the repository
+	WebhookID string `json:"webhookID,omitempty" protobuf:"bytes,14,opt,name=webhookID"`
 }
 
 func (repo *Repository) IsInsecure() bool {
@@ -1040,6 +1543,29 @@ type RepositoryList struct {
 	Items           Repositories `json:"items" protobuf:"bytes,2,rep,name=items"`
 }
 
+// RepositoryFailure describes the most recent failed request of the repo server to a repository
+type RepositoryFailure struct {
+	// Repo is the URL of the repository
+	Repo string `json:"repo" protobuf:"bytes,1,opt,name=repo"`
+	// RequestType is the type of the failed request, e.g. 'fetch' or 'ls-remote'
+	RequestType string `json:"requestType" protobuf:"bytes,2,opt,name=requestType"`
+	// Message is the error of the failed request
+	Message string `json:"message" protobuf:"bytes,3,opt,name=message"`
+	// AuthFailure is true if the request failed because the credentials of the repository were rejected
+	AuthFailure bool `json:"authFailure,omitempty" protobuf:"varint,4,opt,name=authFailure"`
+	// Failures is the number of consecutive failed requests. Zero if the repository was reached again since the failure.
+	Failures int64 `json:"failures" protobuf:"varint,5,opt,name=failures"`
+	// FailedAt is the time of the failed request
+	FailedAt metav1.Time `json:"failedAt" protobuf:"bytes,6,opt,name=failedAt"`
+	// LastSucceededAt is the time of the last successful request preceding or following the failure
+	LastSucceededAt *metav1.Time `json:"lastSucceededAt,omitempty" protobuf:"bytes,7,opt,name=lastSucceededAt"`
+}
+
+// RepositoryFailureList is a collection of RepositoryFailure
+type RepositoryFailureList struct {
+	Items []RepositoryFailure `json:"items" protobuf:"bytes,1,rep,name=items"`
+}
+
 // A RepositoryCertificate is either SSH known hosts entry or TLS certificate
 type RepositoryCertificate struct {
 	// Name of the server the certificate is intended for
@@ -1324,6 +1850,45 @@ type AppProjectSpec struct {
 	NamespaceResourceBlacklist []metav1.GroupKind `json:"namespaceResourceBlacklist,omitempty" protobuf:"bytes,6,opt,name=namespaceResourceBlacklist"`
 	// OrphanedResources specifies if controller should monitor orphaned resources of apps in this project
 	OrphanedResources *OrphanedResourcesMonitorSettings `json:"orphanedResources,omitempty" protobuf:"bytes,7,opt,name=orphanedResources"`
+	// SyncPolicy is the default sync policy of applications in the project which do not define their own
+	SyncPolicy *SyncPolicy `json:"syncPolicy,omitempty" protobuf:"bytes,8,opt,name=syncPolicy"`
+	// SourceDecryptionKeys contains list of names of the SOPS key sets of the repo server, which may contain wildcards,
+	// whose keys can be used to decrypt the encrypted files of the project's applications
+	SourceDecryptionKeys []string `json:"sourceDecryptionKeys,omitempty" protobuf:"bytes,9,rep,name=sourceDecryptionKeys"`
+	// FailOnSharedResource fails the sync of applications in the project if they would overwrite resources which are
+	// tracked by another application, instead of only reporting a warning condition
+	FailOnSharedResource bool `json:"failOnSharedResource,omitempty" protobuf:"bytes,10,opt,name=failOnSharedResource"`
+	// ManifestQuota limits the number of resources and the size of the manifests which the project's applications may
+	// render
+	ManifestQuota *ManifestQuota `json:"manifestQuota,omitempty" protobuf:"bytes,11,opt,name=manifestQuota"`
+}
+
+// ManifestQuota limits the manifests rendered from the source of an application. Zero values are unlimited.
+type ManifestQuota struct {
+	// MaxResources is the maximum number of resources, including hooks
+	MaxResources int64 `json:"maxResources,omitempty" protobuf:"varint,1,opt,name=maxResources"`
+	// MaxSize is the maximum total size of the manifests in bytes
+	MaxSize int64 `json:"maxSize,omitempty" protobuf:"varint,2,opt,name=maxSize"`
+}
+
+// Check returns an error if the manifests exceed the quota
+func (q *ManifestQuota) Check(manifests []string) error {
+	if q == nil {
+		return nil
+	}
+	if q.MaxResources > 0 && int64(len(manifests)) > q.MaxResources {
+		return fmt.Errorf("manifests exceed the quota of the project: %d resources rendered, at most %d allowed", len(manifests), q.MaxResources)
+	}
+	if q.MaxSize > 0 {
+		var size int64
+		for _, manifest := range manifests {
+			size += int64(len(manifest))
+		}
+		if size > q.MaxSize {
+			return fmt.Errorf("manifests exceed the quota of the project: %d bytes rendered, at most %d allowed", size, q.MaxSize)
+		}
+	}
+	return nil
 }
 
 func (d AppProjectSpec) DestinationClusters() []string {
@@ -1348,6 +1913,17 @@ type ProjectRole struct {
 	JWTTokens []JWTToken `json:"jwtTokens,omitempty" protobuf:"bytes,4,rep,name=jwtTokens"`
 	// Groups are a list of OIDC group claims bound to this role
 	Groups []string `json:"groups,omitempty" protobuf:"bytes,5,rep,name=groups"`
+	// Quota limits the usage of each JWT token of this role
+	Quota *ProjectRoleQuota `json:"quota,omitempty" protobuf:"bytes,6,opt,name=quota"`
+}
+
+// ProjectRoleQuota limits the usage of the JWT tokens of a project role, so that a misconfigured pipeline cannot
+// overwhelm the API server and the controller. Zero means no limit.
+type ProjectRoleQuota struct {
+	// RequestsPerHour is the number of API requests a token may make per hour
+	RequestsPerHour int64 `json:"requestsPerHour,omitempty" protobuf:"varint,1,opt,name=requestsPerHour"`
+	// SyncsPerHour is the number of syncs, including rollbacks and image updates, a token may start per hour
+	SyncsPerHour int64 `json:"syncsPerHour,omitempty" protobuf:"varint,2,opt,name=syncsPerHour"`
 }
 
 // JWTToken holds the issuedAt and expiresAt values of a token
@@ -1356,6 +1932,113 @@ type JWTToken struct {
 	ExpiresAt int64 `json:"exp,omitempty" protobuf:"int64,2,opt,name=exp"`
 }
 
+// ProjectTemplate is a template from which projects are created. String values of the spec may reference parameters
+// using '{{param}}' placeholders; the 'name' parameter is the name of the created project.
+type ProjectTemplate struct {
+	// Name is the name of the template
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Description is a description of the template
+	Description string `json:"description,omitempty" protobuf:"bytes,2,opt,name=description"`
+	// Parameters are the parameters which can be used in the spec
+	Parameters []ProjectTemplateParameter `json:"parameters,omitempty" protobuf:"bytes,3,rep,name=parameters"`
+	// Spec is the spec of the created projects
+	Spec AppProjectSpec `json:"spec" protobuf:"bytes,4,opt,name=spec"`
+}
+
+// ProjectTemplateParameter is a parameter of a project template
+type ProjectTemplateParameter struct {
+	// Name is the name of the parameter
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Description is a description of the parameter
+	Description string `json:"description,omitempty" protobuf:"bytes,2,opt,name=description"`
+	// Default is the value used if the parameter is not provided
+	Default string `json:"default,omitempty" protobuf:"bytes,3,opt,name=default"`
+	// Required indicates that the parameter must be provided
+	Required bool `json:"required,omitempty" protobuf:"varint,4,opt,name=required"`
+}
+
+var (
+	templatePlaceholderRegexp = regexp.MustCompile(`{{\s*([\w.-]+)\s*}}`)
+	// templateParameterValueRegexp matches the values which can be passed to the parameters of a template. Wildcards,
+	// commas and whitespace are not allowed, so that values cannot widen the patterns or inject RBAC policies.
+	templateParameterValueRegexp = regexp.MustCompile(`^[\w.:/@-]*$`)
+)
+
+// Render returns a project with the given name created from the template, substituting the given parameters. The
+// parameters are substituted into the string values of the spec, so they cannot change its structure.
+func (t *ProjectTemplate) Render(name string, params map[string]string) (*AppProject, error) {
+	values := map[string]string{"name": name}
+	declared := map[string]bool{"name": true}
+	for _, p := range t.Parameters {
+		declared[p.Name] = true
+		if value, ok := params[p.Name]; ok {
+			if !templateParameterValueRegexp.MatchString(value) {
+				return nil, status.Errorf(codes.InvalidArgument, "value '%s' of parameter '%s' may only contain alphanumeric characters, '_', '.', ':', '/', '@' and '-'", value, p.Name)
+			}
+			values[p.Name] = value
+		} else if p.Required {
+			return nil, status.Errorf(codes.InvalidArgument, "parameter '%s' of template '%s' is required", p.Name, t.Name)
+		} else {
+			values[p.Name] = p.Default
+		}
+	}
+	for param := range params {
+		if !declared[param] {
+			return nil, status.Errorf(codes.InvalidArgument, "template '%s' does not have parameter '%s'", t.Name, param)
+		}
+	}
+
+	data, err := json.Marshal(t.Spec)
+	if err != nil {
+		return nil, err
+	}
+	var spec interface{}
+	if err = json.Unmarshal(data, &spec); err != nil {
+		return nil, err
+	}
+	var undeclared []string
+	var substitute func(val interface{}) interface{}
+	substitute = func(val interface{}) interface{} {
+		switch v := val.(type) {
+		case string:
+			return templatePlaceholderRegexp.ReplaceAllStringFunc(v, func(placeholder string) string {
+				param := templatePlaceholderRegexp.FindStringSubmatch(placeholder)[1]
+				value, ok := values[param]
+				if !ok {
+					undeclared = append(undeclared, param)
+				}
+				return value
+			})
+		case []interface{}:
+			for i := range v {
+				v[i] = substitute(v[i])
+			}
+		case map[string]interface{}:
+			for k := range v {
+				v[k] = substitute(v[k])
+			}
+		}
+		return val
+	}
+	spec = substitute(spec)
+	if len(undeclared) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "template '%s' references undeclared parameters: %s", t.Name, strings.Join(undeclared, ", "))
+	}
+	proj := AppProject{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Annotations: map[string]string{common.AnnotationKeyProjectTemplate: t.Name},
+		},
+	}
+	if data, err = json.Marshal(spec); err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(data, &proj.Spec); err != nil {
+		return nil, err
+	}
+	return &proj, nil
+}
+
 // Command holds binary path and arguments list
 type Command struct {
 	Command []string `json:"command,omitempty" protobuf:"bytes,1,name=command"`
@@ -1422,6 +2105,15 @@ func (app *Application) IsRefreshRequested() (RefreshType, bool) {
 	return refreshType, true
 }
 
+// GetHelmReleaseName returns the name of the Helm release which the application is rendered as: the release name
+// override of the Helm source, or the application name
+func (app *Application) GetHelmReleaseName() string {
+	if app.Spec.Source.Helm != nil && app.Spec.Source.Helm.ReleaseName != "" {
+		return app.Spec.Source.Helm.ReleaseName
+	}
+	return app.Name
+}
+
 // SetCascadedDeletion sets or remove resources finalizer
 func (app *Application) SetCascadedDeletion(prune bool) {
 	index := app.getFinalizerIndex(common.ResourcesFinalizerName)
@@ -1516,6 +2208,15 @@ func (spec ApplicationSpec) GetProject() string {
 	return spec.Project
 }
 
+// GetSyncPolicy returns the sync policy of the application, falling back to the default sync policy of the project.
+// An application opts out of the project default by setting an empty sync policy.
+func (spec ApplicationSpec) GetSyncPolicy(proj *AppProject) *SyncPolicy {
+	if spec.SyncPolicy == nil && proj != nil {
+		return proj.Spec.SyncPolicy
+	}
+	return spec.SyncPolicy
+}
+
 func isResourceInList(res metav1.GroupKind, list []metav1.GroupKind) bool {
 	for _, item := range list {
 		ok, err := filepath.Match(item.Kind, res.Kind)
@@ -1560,6 +2261,24 @@ func (proj AppProject) IsSourcePermitted(src ApplicationSource) bool {
 	return false
 }
 
+// ScopeRepositoryCredentials returns copies of the repositories without the credentials of the repositories which are
+// not permitted as sources of the project. The dependencies of the project's applications, e.g. the dependencies of
+// Helm charts, can be fetched from all repositories, but only with the credentials of permitted ones.
+func (proj AppProject) ScopeRepositoryCredentials(repos Repositories) Repositories {
+	scoped := make(Repositories, len(repos))
+	for i, repo := range repos {
+		scoped[i] = repo.DeepCopy()
+		if !proj.IsSourcePermitted(ApplicationSource{RepoURL: repo.Repo}) {
+			scoped[i].Username = ""
+			scoped[i].Password = ""
+			scoped[i].SSHPrivateKey = ""
+			scoped[i].TLSClientCertData = ""
+			scoped[i].TLSClientCertKey = ""
+		}
+	}
+	return scoped
+}
+
 // IsDestinationPermitted validates if the provided application's destination is one of the allowed destinations for the project
 func (proj AppProject) IsDestinationPermitted(dst ApplicationDestination) bool {
 	for _, item := range proj.Spec.Destinations {
@@ -1574,9 +2293,9 @@ func (proj AppProject) IsDestinationPermitted(dst ApplicationDestination) bool {
 func (c *Cluster) RESTConfig() *rest.Config {
 	var config *rest.Config
 	var err error
-	if c.Server == common.KubernetesInternalAPIServerAddr && os.Getenv(common.EnvVarFakeInClusterConfig) == "true" {
+	if (c.Server == common.KubernetesInternalAPIServerAddr || c.Config.InCluster) && os.Getenv(common.EnvVarFakeInClusterConfig) == "true" {
 		config, err = clientcmd.BuildConfigFromFlags("", filepath.Join(os.Getenv("HOME"), ".kube", "config"))
-	} else if c.Server == common.KubernetesInternalAPIServerAddr && c.Config.Username == "" && c.Config.Password == "" && c.Config.BearerToken == "" {
+	} else if c.Config.InCluster || c.Server == common.KubernetesInternalAPIServerAddr && c.Config.Username == "" && c.Config.Password == "" && c.Config.BearerToken == "" {
 		config, err = rest.InClusterConfig()
 	} else {
 		tlsClientConfig := rest.TLSClientConfig{
@@ -3,6 +3,7 @@ package v1alpha1
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -114,6 +115,16 @@ func TestAppProject_IsDestinationPermitted(t *testing.T) {
 	}
 }
 
+func TestCluster_IsNamespacePermitted(t *testing.T) {
+	cluster := Cluster{}
+	assert.True(t, cluster.IsNamespacePermitted("default"))
+
+	cluster.Namespaces = []string{"default", "team-*"}
+	assert.True(t, cluster.IsNamespacePermitted("default"))
+	assert.True(t, cluster.IsNamespacePermitted("team-a"))
+	assert.False(t, cluster.IsNamespacePermitted("kube-system"))
+}
+
 func TestAppProject_GetRoleByName(t *testing.T) {
 	t.Run("NotExists", func(t *testing.T) {
 		p := &AppProject{}
@@ -378,6 +389,43 @@ func TestAppDestinationEquality(t *testing.T) {
 	assert.False(t, left.Equals(*right))
 }
 
+func TestProjectTemplate_Render(t *testing.T) {
+	template := ProjectTemplate{
+		Name: "team",
+		Parameters: []ProjectTemplateParameter{
+			{Name: "team", Required: true},
+			{Name: "cluster", Default: "https://kubernetes.default.svc"},
+		},
+		Spec: AppProjectSpec{
+			Description:  "Project of team {{ team }}",
+			Destinations: []ApplicationDestination{{Server: "{{cluster}}", Namespace: "{{name}}-*"}},
+		},
+	}
+
+	proj, err := template.Render("payments", map[string]string{"team": "billing"})
+	assert.NoError(t, err)
+	assert.Equal(t, "payments", proj.Name)
+	assert.Equal(t, "Project of team billing", proj.Spec.Description)
+	assert.Equal(t, []ApplicationDestination{{Server: "https://kubernetes.default.svc", Namespace: "payments-*"}}, proj.Spec.Destinations)
+
+	_, err = template.Render("payments", nil)
+	assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = parameter 'team' of template 'team' is required")
+
+	_, err = template.Render("payments", map[string]string{"team": "billing", "env": "prod"})
+	assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = template 'team' does not have parameter 'env'")
+
+	_, err = template.Render("payments", map[string]string{"team": "billing, applications, *, */*, allow"})
+	assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = value 'billing, applications, *, */*, allow' of parameter 'team' may only contain alphanumeric characters, '_', '.', ':', '/', '@' and '-'")
+
+	proj, err = template.Render("payments", map[string]string{"team": "billing", "cluster": "https://10.0.0.1:6443"})
+	assert.NoError(t, err)
+	assert.Equal(t, "https://10.0.0.1:6443", proj.Spec.Destinations[0].Server)
+
+	template.Spec.Description = "{{owner}}"
+	_, err = template.Render("payments", map[string]string{"team": "billing"})
+	assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = template 'team' references undeclared parameters: owner")
+}
+
 func TestAppProjectSpec_DestinationClusters(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -889,3 +937,80 @@ func TestApplicationSourceKustomize_MergeImage(t *testing.T) {
 		assert.Equal(t, KustomizeImages{"foo=2"}, k.Images)
 	})
 }
+
+func TestApplicationSpec_GetSyncPolicy(t *testing.T) {
+	automated := &SyncPolicy{Automated: &SyncPolicyAutomated{Prune: true}}
+	proj := &AppProject{Spec: AppProjectSpec{SyncPolicy: automated}}
+
+	t.Run("NoProject", func(t *testing.T) {
+		assert.Nil(t, ApplicationSpec{}.GetSyncPolicy(nil))
+	})
+	t.Run("Inherited", func(t *testing.T) {
+		assert.Equal(t, automated, ApplicationSpec{}.GetSyncPolicy(proj))
+	})
+	t.Run("OptOut", func(t *testing.T) {
+		assert.Equal(t, &SyncPolicy{}, ApplicationSpec{SyncPolicy: &SyncPolicy{}}.GetSyncPolicy(proj))
+	})
+	t.Run("Overridden", func(t *testing.T) {
+		policy := &SyncPolicy{Automated: &SyncPolicyAutomated{SelfHeal: true}}
+		assert.Equal(t, policy, ApplicationSpec{SyncPolicy: policy}.GetSyncPolicy(proj))
+	})
+}
+
+func TestApplicationTree_GetSummary(t *testing.T) {
+	tree := ApplicationTree{Nodes: []ResourceNode{{
+		Images:         []string{"nginx:1.17", "busybox"},
+		NetworkingInfo: &ResourceNetworkingInfo{ExternalURLs: []string{"https://guestbook.com"}},
+	}, {
+		Images:         []string{"busybox"},
+		NetworkingInfo: &ResourceNetworkingInfo{ExternalURLs: []string{"http://10.0.0.1:8080"}},
+	}}}
+	assert.Equal(t, ApplicationSummary{
+		ExternalURLs: []string{"http://10.0.0.1:8080", "https://guestbook.com"},
+		Images:       []string{"busybox", "nginx:1.17"},
+	}, tree.GetSummary())
+}
+
+func TestSecretKeyChanges_Summary(t *testing.T) {
+	var changes *SecretKeyChanges
+	assert.True(t, changes.IsEmpty())
+	assert.Equal(t, "", changes.Summary())
+
+	changes = &SecretKeyChanges{Added: []string{"a", "b"}, Modified: []string{"c"}}
+	assert.False(t, changes.IsEmpty())
+	assert.Equal(t, "added: a, b; modified: c", changes.Summary())
+}
+
+func TestManifestQuota_Check(t *testing.T) {
+	manifests := []string{`{"kind":"ConfigMap"}`, `{"kind":"Secret"}`}
+	var quota *ManifestQuota
+	assert.NoError(t, quota.Check(manifests))
+	assert.NoError(t, (&ManifestQuota{}).Check(manifests))
+	assert.NoError(t, (&ManifestQuota{MaxResources: 2, MaxSize: 37}).Check(manifests))
+	assert.EqualError(t, (&ManifestQuota{MaxResources: 1}).Check(manifests), "manifests exceed the quota of the project: 2 resources rendered, at most 1 allowed")
+	assert.EqualError(t, (&ManifestQuota{MaxSize: 36}).Check(manifests), "manifests exceed the quota of the project: 37 bytes rendered, at most 36 allowed")
+}
+
+func TestAppProject_ScopeRepositoryCredentials(t *testing.T) {
+	repos := Repositories{
+		{Repo: "https://charts.example.com/stable", Type: "helm", Name: "stable", Username: "user", Password: "pass"},
+		{Repo: "https://charts.example.com/private", Type: "helm", Name: "private", Username: "user", Password: "pass", TLSClientCertData: "cert", TLSClientCertKey: "key", TLSClientCAData: "ca"},
+		{Repo: "https://github.com/argoproj/argocd-example-apps.git", SSHPrivateKey: "key"},
+	}
+	proj := AppProject{Spec: AppProjectSpec{SourceRepos: []string{"https://charts.example.com/stable", "https://github.com/argoproj/*"}}}
+	assert.Equal(t, Repositories{
+		repos[0],
+		{Repo: "https://charts.example.com/private", Type: "helm", Name: "private", TLSClientCAData: "ca"},
+		repos[2],
+	}, proj.ScopeRepositoryCredentials(repos))
+	assert.Equal(t, "pass", repos[1].Password)
+
+	proj.Spec.SourceRepos = []string{"*"}
+	assert.Equal(t, repos, proj.ScopeRepositoryCredentials(repos))
+}
+
+func TestSyncAnalysisWebhook_GetTimeout(t *testing.T) {
+	assert.Equal(t, 30*time.Second, (&SyncAnalysisWebhook{}).GetTimeout())
+	assert.Equal(t, 10*time.Second, (&SyncAnalysisWebhook{TimeoutSeconds: 10}).GetTimeout())
+	assert.Equal(t, 5*time.Minute, (&SyncAnalysisWebhook{TimeoutSeconds: 3600}).GetTimeout())
+}
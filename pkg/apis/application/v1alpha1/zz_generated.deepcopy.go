@@ -121,6 +121,21 @@ func (in *AppProjectSpec) DeepCopyInto(out *AppProjectSpec) {
 		*out = new(OrphanedResourcesMonitorSettings)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SyncPolicy != nil {
+		in, out := &in.SyncPolicy, &out.SyncPolicy
+		*out = new(SyncPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SourceDecryptionKeys != nil {
+		in, out := &in.SourceDecryptionKeys, &out.SourceDecryptionKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ManifestQuota != nil {
+		in, out := &in.ManifestQuota, &out.ManifestQuota
+		*out = new(ManifestQuota)
+		**out = **in
+	}
 	return
 }
 
@@ -260,6 +275,11 @@ func (in *ApplicationSource) DeepCopyInto(out *ApplicationSource) {
 		*out = new(ApplicationSourcePlugin)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Patches != nil {
+		in, out := &in.Patches, &out.Patches
+		*out = make([]ApplicationSourcePatch, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -303,6 +323,11 @@ func (in *ApplicationSourceHelm) DeepCopyInto(out *ApplicationSourceHelm) {
 		*out = make([]HelmParameter, len(*in))
 		copy(*out, *in)
 	}
+	if in.FileParameters != nil {
+		in, out := &in.FileParameters, &out.FileParameters
+		*out = make([]HelmFileParameter, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -391,6 +416,39 @@ func (in *ApplicationSourceKustomize) DeepCopy() *ApplicationSourceKustomize {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSourcePatch) DeepCopyInto(out *ApplicationSourcePatch) {
+	*out = *in
+	out.Target = in.Target
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSourcePatch.
+func (in *ApplicationSourcePatch) DeepCopy() *ApplicationSourcePatch {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSourcePatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSourcePatchTarget) DeepCopyInto(out *ApplicationSourcePatchTarget) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSourcePatchTarget.
+func (in *ApplicationSourcePatchTarget) DeepCopy() *ApplicationSourcePatchTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSourcePatchTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationSourcePlugin) DeepCopyInto(out *ApplicationSourcePlugin) {
 	*out = *in
@@ -440,6 +498,33 @@ func (in *ApplicationSpec) DeepCopyInto(out *ApplicationSpec) {
 		*out = make([]Info, len(*in))
 		copy(*out, *in)
 	}
+	if in.WriteBack != nil {
+		in, out := &in.WriteBack, &out.WriteBack
+		*out = new(ApplicationWriteBack)
+		**out = **in
+	}
+	if in.RevisionHistoryLimit != nil {
+		in, out := &in.RevisionHistoryLimit, &out.RevisionHistoryLimit
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Suspend != nil {
+		in, out := &in.Suspend, &out.Suspend
+		*out = new(ApplicationSuspend)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourceHooks != nil {
+		in, out := &in.ResourceHooks, &out.ResourceHooks
+		*out = make([]ResourceHook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Analysis != nil {
+		in, out := &in.Analysis, &out.Analysis
+		*out = new(SyncAnalysis)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -491,6 +576,11 @@ func (in *ApplicationStatus) DeepCopyInto(out *ApplicationStatus) {
 		*out = (*in).DeepCopy()
 	}
 	in.Summary.DeepCopyInto(&out.Summary)
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(SyncVerification)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -517,6 +607,11 @@ func (in *ApplicationSummary) DeepCopyInto(out *ApplicationSummary) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.RenderedSources != nil {
+		in, out := &in.RenderedSources, &out.RenderedSources
+		*out = make([]RenderedSource, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -530,6 +625,26 @@ func (in *ApplicationSummary) DeepCopy() *ApplicationSummary {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSuspend) DeepCopyInto(out *ApplicationSuspend) {
+	*out = *in
+	if in.Until != nil {
+		in, out := &in.Until, &out.Until
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSuspend.
+func (in *ApplicationSuspend) DeepCopy() *ApplicationSuspend {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSuspend)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationTree) DeepCopyInto(out *ApplicationTree) {
 	*out = *in
@@ -577,11 +692,62 @@ func (in *ApplicationWatchEvent) DeepCopy() *ApplicationWatchEvent {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationWriteBack) DeepCopyInto(out *ApplicationWriteBack) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationWriteBack.
+func (in *ApplicationWriteBack) DeepCopy() *ApplicationWriteBack {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationWriteBack)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutomatedRollback) DeepCopyInto(out *AutomatedRollback) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutomatedRollback.
+func (in *AutomatedRollback) DeepCopy() *AutomatedRollback {
+	if in == nil {
+		return nil
+	}
+	out := new(AutomatedRollback)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Cluster) DeepCopyInto(out *Cluster) {
 	*out = *in
 	in.Config.DeepCopyInto(&out.Config)
 	in.ConnectionState.DeepCopyInto(&out.ConnectionState)
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -667,6 +833,23 @@ func (in *Command) DeepCopy() *Command {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CommitMetadata) DeepCopyInto(out *CommitMetadata) {
+	*out = *in
+	in.Date.DeepCopyInto(&out.Date)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommitMetadata.
+func (in *CommitMetadata) DeepCopy() *CommitMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(CommitMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ComparedTo) DeepCopyInto(out *ComparedTo) {
 	*out = *in
@@ -801,6 +984,22 @@ func (in *HealthStatus) DeepCopy() *HealthStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmFileParameter) DeepCopyInto(out *HelmFileParameter) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmFileParameter.
+func (in *HelmFileParameter) DeepCopy() *HelmFileParameter {
+	if in == nil {
+		return nil
+	}
+	out := new(HelmFileParameter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HelmParameter) DeepCopyInto(out *HelmParameter) {
 	*out = *in
@@ -817,6 +1016,32 @@ func (in *HelmParameter) DeepCopy() *HelmParameter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageUpdate) DeepCopyInto(out *ImageUpdate) {
+	*out = *in
+	if in.HelmParameters != nil {
+		in, out := &in.HelmParameters, &out.HelmParameters
+		*out = make([]HelmParameter, len(*in))
+		copy(*out, *in)
+	}
+	if in.KustomizeImages != nil {
+		in, out := &in.KustomizeImages, &out.KustomizeImages
+		*out = make(KustomizeImages, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageUpdate.
+func (in *ImageUpdate) DeepCopy() *ImageUpdate {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageUpdate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Info) DeepCopyInto(out *Info) {
 	*out = *in
@@ -933,6 +1158,22 @@ func (in *KustomizeOptions) DeepCopy() *KustomizeOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManifestQuota) DeepCopyInto(out *ManifestQuota) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManifestQuota.
+func (in *ManifestQuota) DeepCopy() *ManifestQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(ManifestQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Operation) DeepCopyInto(out *Operation) {
 	*out = *in
@@ -941,6 +1182,7 @@ func (in *Operation) DeepCopyInto(out *Operation) {
 		*out = new(SyncOperation)
 		(*in).DeepCopyInto(*out)
 	}
+	out.InitiatedBy = in.InitiatedBy
 	return
 }
 
@@ -954,6 +1196,22 @@ func (in *Operation) DeepCopy() *Operation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperationInitiator) DeepCopyInto(out *OperationInitiator) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperationInitiator.
+func (in *OperationInitiator) DeepCopy() *OperationInitiator {
+	if in == nil {
+		return nil
+	}
+	out := new(OperationInitiator)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OperationState) DeepCopyInto(out *OperationState) {
 	*out = *in
@@ -1020,6 +1278,11 @@ func (in *ProjectRole) DeepCopyInto(out *ProjectRole) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Quota != nil {
+		in, out := &in.Quota, &out.Quota
+		*out = new(ProjectRoleQuota)
+		**out = **in
+	}
 	return
 }
 
@@ -1033,6 +1296,76 @@ func (in *ProjectRole) DeepCopy() *ProjectRole {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectRoleQuota) DeepCopyInto(out *ProjectRoleQuota) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectRoleQuota.
+func (in *ProjectRoleQuota) DeepCopy() *ProjectRoleQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectRoleQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectTemplate) DeepCopyInto(out *ProjectTemplate) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]ProjectTemplateParameter, len(*in))
+		copy(*out, *in)
+	}
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectTemplate.
+func (in *ProjectTemplate) DeepCopy() *ProjectTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectTemplateParameter) DeepCopyInto(out *ProjectTemplateParameter) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectTemplateParameter.
+func (in *ProjectTemplateParameter) DeepCopy() *ProjectTemplateParameter {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectTemplateParameter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RenderedSource) DeepCopyInto(out *RenderedSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RenderedSource.
+func (in *RenderedSource) DeepCopy() *RenderedSource {
+	if in == nil {
+		return nil
+	}
+	out := new(RenderedSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in Repositories) DeepCopyInto(out *Repositories) {
 	{
@@ -1121,6 +1454,50 @@ func (in *RepositoryCertificateList) DeepCopy() *RepositoryCertificateList {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryFailure) DeepCopyInto(out *RepositoryFailure) {
+	*out = *in
+	in.FailedAt.DeepCopyInto(&out.FailedAt)
+	if in.LastSucceededAt != nil {
+		in, out := &in.LastSucceededAt, &out.LastSucceededAt
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryFailure.
+func (in *RepositoryFailure) DeepCopy() *RepositoryFailure {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryFailure)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryFailureList) DeepCopyInto(out *RepositoryFailureList) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RepositoryFailure, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryFailureList.
+func (in *RepositoryFailureList) DeepCopy() *RepositoryFailureList {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryFailureList)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RepositoryList) DeepCopyInto(out *RepositoryList) {
 	*out = *in
@@ -1226,6 +1603,11 @@ func (in *ResourceActions) DeepCopy() *ResourceActions {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceDiff) DeepCopyInto(out *ResourceDiff) {
 	*out = *in
+	if in.SecretKeyChanges != nil {
+		in, out := &in.SecretKeyChanges, &out.SecretKeyChanges
+		*out = new(SecretKeyChanges)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1239,6 +1621,37 @@ func (in *ResourceDiff) DeepCopy() *ResourceDiff {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceHook) DeepCopyInto(out *ResourceHook) {
+	*out = *in
+	if in.Hook != nil {
+		in, out := &in.Hook, &out.Hook
+		*out = make([]HookType, len(*in))
+		copy(*out, *in)
+	}
+	if in.SyncWave != nil {
+		in, out := &in.SyncWave, &out.SyncWave
+		*out = new(int64)
+		**out = **in
+	}
+	if in.DeletePolicy != nil {
+		in, out := &in.DeletePolicy, &out.DeletePolicy
+		*out = make([]HookDeletePolicy, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceHook.
+func (in *ResourceHook) DeepCopy() *ResourceHook {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceIgnoreDifferences) DeepCopyInto(out *ResourceIgnoreDifferences) {
 	*out = *in
@@ -1260,6 +1673,22 @@ func (in *ResourceIgnoreDifferences) DeepCopy() *ResourceIgnoreDifferences {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceLink) DeepCopyInto(out *ResourceLink) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceLink.
+func (in *ResourceLink) DeepCopy() *ResourceLink {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceLink)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceNetworkingInfo) DeepCopyInto(out *ResourceNetworkingInfo) {
 	*out = *in
@@ -1334,6 +1763,11 @@ func (in *ResourceNode) DeepCopyInto(out *ResourceNode) {
 		*out = new(HealthStatus)
 		**out = **in
 	}
+	if in.Links != nil {
+		in, out := &in.Links, &out.Links
+		*out = make([]ResourceLink, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1429,6 +1863,14 @@ func (in *ResourceStatus) DeepCopyInto(out *ResourceStatus) {
 		*out = new(HealthStatus)
 		**out = **in
 	}
+	if in.OutOfSyncSince != nil {
+		in, out := &in.OutOfSyncSince, &out.OutOfSyncSince
+		*out = (*in).DeepCopy()
+	}
+	if in.ProgressingSince != nil {
+		in, out := &in.ProgressingSince, &out.ProgressingSince
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -1442,11 +1884,45 @@ func (in *ResourceStatus) DeepCopy() *ResourceStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RevisionChangelog) DeepCopyInto(out *RevisionChangelog) {
+	*out = *in
+	if in.Commits != nil {
+		in, out := &in.Commits, &out.Commits
+		*out = make([]CommitMetadata, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RevisionChangelog.
+func (in *RevisionChangelog) DeepCopy() *RevisionChangelog {
+	if in == nil {
+		return nil
+	}
+	out := new(RevisionChangelog)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RevisionHistory) DeepCopyInto(out *RevisionHistory) {
 	*out = *in
 	in.DeployedAt.DeepCopyInto(&out.DeployedAt)
 	in.Source.DeepCopyInto(&out.Source)
+	out.InitiatedBy = in.InitiatedBy
+	if in.ImageUpdate != nil {
+		in, out := &in.ImageUpdate, &out.ImageUpdate
+		*out = new(ImageUpdate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AutomatedRollback != nil {
+		in, out := &in.AutomatedRollback, &out.AutomatedRollback
+		*out = new(AutomatedRollback)
+		**out = **in
+	}
 	return
 }
 
@@ -1469,6 +1945,11 @@ func (in *RevisionMetadata) DeepCopyInto(out *RevisionMetadata) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ChangedFiles != nil {
+		in, out := &in.ChangedFiles, &out.ChangedFiles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1482,6 +1963,132 @@ func (in *RevisionMetadata) DeepCopy() *RevisionMetadata {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretKeyChanges) DeepCopyInto(out *SecretKeyChanges) {
+	*out = *in
+	if in.Added != nil {
+		in, out := &in.Added, &out.Added
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Removed != nil {
+		in, out := &in.Removed, &out.Removed
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Modified != nil {
+		in, out := &in.Modified, &out.Modified
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretKeyChanges.
+func (in *SecretKeyChanges) DeepCopy() *SecretKeyChanges {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretKeyChanges)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncAnalysis) DeepCopyInto(out *SyncAnalysis) {
+	*out = *in
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(SyncAnalysisWebhook)
+		**out = **in
+	}
+	if in.Job != nil {
+		in, out := &in.Job, &out.Job
+		*out = new(SyncAnalysisJob)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncAnalysis.
+func (in *SyncAnalysis) DeepCopy() *SyncAnalysis {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncAnalysis)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncAnalysisJob) DeepCopyInto(out *SyncAnalysisJob) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make(Env, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(EnvEntry)
+				**out = **in
+			}
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncAnalysisJob.
+func (in *SyncAnalysisJob) DeepCopy() *SyncAnalysisJob {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncAnalysisJob)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncAnalysisResult) DeepCopyInto(out *SyncAnalysisResult) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncAnalysisResult.
+func (in *SyncAnalysisResult) DeepCopy() *SyncAnalysisResult {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncAnalysisResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncAnalysisWebhook) DeepCopyInto(out *SyncAnalysisWebhook) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncAnalysisWebhook.
+func (in *SyncAnalysisWebhook) DeepCopy() *SyncAnalysisWebhook {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncAnalysisWebhook)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncOperation) DeepCopyInto(out *SyncOperation) {
 	*out = *in
@@ -1505,6 +2112,16 @@ func (in *SyncOperation) DeepCopyInto(out *SyncOperation) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ImageUpdate != nil {
+		in, out := &in.ImageUpdate, &out.ImageUpdate
+		*out = new(ImageUpdate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AutomatedRollback != nil {
+		in, out := &in.AutomatedRollback, &out.AutomatedRollback
+		*out = new(AutomatedRollback)
+		**out = **in
+	}
 	return
 }
 
@@ -1549,6 +2166,11 @@ func (in *SyncOperationResult) DeepCopyInto(out *SyncOperationResult) {
 		}
 	}
 	in.Source.DeepCopyInto(&out.Source)
+	if in.Analysis != nil {
+		in, out := &in.Analysis, &out.Analysis
+		*out = new(SyncAnalysisResult)
+		**out = **in
+	}
 	return
 }
 
@@ -1570,6 +2192,11 @@ func (in *SyncPolicy) DeepCopyInto(out *SyncPolicy) {
 		*out = new(SyncPolicyAutomated)
 		**out = **in
 	}
+	if in.Verify != nil {
+		in, out := &in.Verify, &out.Verify
+		*out = new(SyncPolicyVerify)
+		**out = **in
+	}
 	return
 }
 
@@ -1599,6 +2226,80 @@ func (in *SyncPolicyAutomated) DeepCopy() *SyncPolicyAutomated {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncPolicyVerify) DeepCopyInto(out *SyncPolicyVerify) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncPolicyVerify.
+func (in *SyncPolicyVerify) DeepCopy() *SyncPolicyVerify {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncPolicyVerify)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncReport) DeepCopyInto(out *SyncReport) {
+	*out = *in
+	in.Source.DeepCopyInto(&out.Source)
+	out.InitiatedBy = in.InitiatedBy
+	in.StartedAt.DeepCopyInto(&out.StartedAt)
+	in.FinishedAt.DeepCopyInto(&out.FinishedAt)
+	if in.Applied != nil {
+		in, out := &in.Applied, &out.Applied
+		*out = make(ResourceResults, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ResourceResult)
+				**out = **in
+			}
+		}
+	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = make(ResourceResults, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ResourceResult)
+				**out = **in
+			}
+		}
+	}
+	if in.Pruned != nil {
+		in, out := &in.Pruned, &out.Pruned
+		*out = make(ResourceResults, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ResourceResult)
+				**out = **in
+			}
+		}
+	}
+	if in.Warnings != nil {
+		in, out := &in.Warnings, &out.Warnings
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncReport.
+func (in *SyncReport) DeepCopy() *SyncReport {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncStatus) DeepCopyInto(out *SyncStatus) {
 	*out = *in
@@ -1675,6 +2376,24 @@ func (in *SyncStrategyHook) DeepCopy() *SyncStrategyHook {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncVerification) DeepCopyInto(out *SyncVerification) {
+	*out = *in
+	in.SyncedAt.DeepCopyInto(&out.SyncedAt)
+	in.Deadline.DeepCopyInto(&out.Deadline)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncVerification.
+func (in *SyncVerification) DeepCopy() *SyncVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TLSClientConfig) DeepCopyInto(out *TLSClientConfig) {
 	*out = *in
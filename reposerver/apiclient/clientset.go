@@ -2,15 +2,31 @@ package apiclient
 
 import (
 	"crypto/tls"
+	"math/rand"
+	"net"
+	"sync"
 	"time"
 
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/keepalive"
 
 	"github.com/argoproj/argo-cd/util"
 	argogrpc "github.com/argoproj/argo-cd/util/grpc"
+	tlsutil "github.com/argoproj/argo-cd/util/tls"
+)
+
+const (
+	// MinKeepAliveTime is the minimum interval of the keepalive pings of clients which the repo server accepts. Clients
+	// which ping more often are disconnected by the server.
+	MinKeepAliveTime = 10 * time.Second
+	// maxRetryBackoff caps the exponential backoff between retries
+	maxRetryBackoff = 10 * time.Second
 )
 
 // Clientset represets repository server api clients
@@ -18,32 +34,144 @@ type Clientset interface {
 	NewRepoServerClient() (util.Closer, RepoServerServiceClient, error)
 }
 
+// ClientOptions configures the connections to the repository server
+type ClientOptions struct {
+	// TimeoutSeconds limits the duration of a call, including its retries. No limit if zero.
+	TimeoutSeconds int
+	// KeepAliveTime is the interval of the keepalive pings of idle connections, which detect repo servers which went
+	// away without closing the connection. No pings are sent if zero.
+	KeepAliveTime time.Duration
+	// MaxRetries is the number of times a call which failed because the repo server was unavailable is retried
+	MaxRetries uint
+	// RetryBackoff is the wait before the first retry, which doubles with every further retry
+	RetryBackoff time.Duration
+	// Connections is the number of connections which are kept open and shared by all clients, so that calls are
+	// spread across the replicas of the repo server behind its service. A connection is opened per client if zero.
+	Connections int
+	// InternalCerts are presented to the repo server, which must have a certificate of their CA. The certificate of
+	// the repo server is not verified if nil.
+	InternalCerts *tlsutil.InternalCerts
+}
+
 type clientSet struct {
-	address        string
-	timeoutSeconds int
+	address string
+	opts    ClientOptions
+
+	lock sync.Mutex
+	pool []*grpc.ClientConn
+	next int
 }
 
-func (c *clientSet) NewRepoServerClient() (util.Closer, RepoServerServiceClient, error) {
+// backoffExponential returns a backoff which doubles the wait with every retry, up to maxRetryBackoff, and adds up to
+// 10% of jitter, so that the clients of a restarted repo server do not retry at the same time
+func backoffExponential(base time.Duration) grpc_retry.BackoffFunc {
+	return func(attempt uint) time.Duration {
+		backoff := maxRetryBackoff
+		if attempt < 16 {
+			if exp := base * time.Duration(1<<attempt); exp < maxRetryBackoff {
+				backoff = exp
+			}
+		}
+		return backoff + time.Duration(rand.Int63n(int64(backoff)/10+1))
+	}
+}
+
+func (c *clientSet) dialOptions() []grpc.DialOption {
+	// all RPCs of the repo server only read repositories or replace settings, so they are safe to retry
 	retryOpts := []grpc_retry.CallOption{
-		grpc_retry.WithMax(3),
-		grpc_retry.WithBackoff(grpc_retry.BackoffLinear(1000 * time.Millisecond)),
+		// the maximum includes the first attempt
+		grpc_retry.WithMax(c.opts.MaxRetries + 1),
+		grpc_retry.WithBackoff(backoffExponential(c.opts.RetryBackoff)),
+		grpc_retry.WithCodes(codes.Unavailable, codes.ResourceExhausted),
+	}
+	unaryInterceptors := []grpc.UnaryClientInterceptor{grpc_retry.UnaryClientInterceptor(retryOpts...)}
+	if c.opts.TimeoutSeconds > 0 {
+		// the timeout comes first, so that it limits the call including its retries
+		unaryInterceptors = append([]grpc.UnaryClientInterceptor{argogrpc.WithTimeout(time.Duration(c.opts.TimeoutSeconds) * time.Second)}, unaryInterceptors...)
+	}
+	tlsConfig := &tls.Config{InsecureSkipVerify: true}
+	if c.opts.InternalCerts != nil {
+		host, _, err := net.SplitHostPort(c.address)
+		if err != nil {
+			host = c.address
+		}
+		tlsConfig = c.opts.InternalCerts.ClientConfig(host)
 	}
 	opts := []grpc.DialOption{
-		grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{InsecureSkipVerify: true})),
+		grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)),
+		// manifests of large applications are megabytes of YAML, so responses are compressed on the wire
+		grpc.WithDefaultCallOptions(grpc.UseCompressor(gzip.Name)),
 		grpc.WithStreamInterceptor(grpc_retry.StreamClientInterceptor(retryOpts...)),
-		grpc.WithUnaryInterceptor(grpc_retry.UnaryClientInterceptor(retryOpts...))}
-	if c.timeoutSeconds > 0 {
-		opts = append(opts, grpc.WithUnaryInterceptor(argogrpc.WithTimeout(time.Duration(c.timeoutSeconds)*time.Second)))
+		grpc.WithUnaryInterceptor(grpc_middleware.ChainUnaryClient(unaryInterceptors...))}
+	if c.opts.KeepAliveTime > 0 {
+		opts = append(opts, grpc.WithKeepaliveParams(keepAliveParams(c.opts.KeepAliveTime)))
+	}
+	return opts
+}
+
+// keepAliveParams returns the keepalive parameters of the given interval, which is raised to the minimum which the
+// repo server accepts
+func keepAliveParams(keepAliveTime time.Duration) keepalive.ClientParameters {
+	if keepAliveTime < MinKeepAliveTime {
+		keepAliveTime = MinKeepAliveTime
+	}
+	return keepalive.ClientParameters{
+		Time:                keepAliveTime,
+		Timeout:             keepAliveTime,
+		PermitWithoutStream: true,
 	}
-	conn, err := grpc.Dial(c.address, opts...)
+}
+
+func (c *clientSet) dial() (*grpc.ClientConn, error) {
+	conn, err := grpc.Dial(c.address, c.dialOptions()...)
 	if err != nil {
 		log.Errorf("Unable to connect to repository service with address %s", c.address)
+		return nil, err
+	}
+	return conn, nil
+}
+
+// pooledConn returns the connections of the pool in turn. The connections are opened on first use and reconnect on
+// their own, e.g. to another replica after the repo server restarted.
+func (c *clientSet) pooledConn() (*grpc.ClientConn, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.pool == nil {
+		pool := make([]*grpc.ClientConn, c.opts.Connections)
+		for i := range pool {
+			conn, err := c.dial()
+			if err != nil {
+				for _, opened := range pool[:i] {
+					_ = opened.Close()
+				}
+				return nil, err
+			}
+			pool[i] = conn
+		}
+		c.pool = pool
+	}
+	conn := c.pool[c.next%len(c.pool)]
+	c.next = (c.next + 1) % len(c.pool)
+	return conn, nil
+}
+
+func (c *clientSet) NewRepoServerClient() (util.Closer, RepoServerServiceClient, error) {
+	if c.opts.Connections > 0 {
+		conn, err := c.pooledConn()
+		if err != nil {
+			return nil, nil, err
+		}
+		// pooled connections stay open when the client is closed
+		return util.NewCloser(func() error { return nil }), NewRepoServerServiceClient(conn), nil
+	}
+	conn, err := c.dial()
+	if err != nil {
 		return nil, nil, err
 	}
 	return conn, NewRepoServerServiceClient(conn), nil
 }
 
 // NewRepoServerClientset creates new instance of repo server Clientset
-func NewRepoServerClientset(address string, timeoutSeconds int) Clientset {
-	return &clientSet{address: address, timeoutSeconds: timeoutSeconds}
+func NewRepoServerClientset(address string, opts ClientOptions) Clientset {
+	return &clientSet{address: address, opts: opts}
 }
@@ -0,0 +1,120 @@
+package apiclient
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+
+	tlsutil "github.com/argoproj/argo-cd/util/tls"
+)
+
+// fakeRepoServer fails the first calls of GetVersion with the given error
+type fakeRepoServer struct {
+	RepoServerServiceServer
+	lock     sync.Mutex
+	calls    int
+	failures int
+	err      error
+}
+
+func (s *fakeRepoServer) GetVersion(ctx context.Context, q *RepoServerVersionRequest) (*RepoServerVersionResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.calls++
+	if s.calls <= s.failures {
+		return nil, s.err
+	}
+	return &RepoServerVersionResponse{Version: "v1.2.3"}, nil
+}
+
+// startRepoServer serves the fake repo server using a self-signed certificate and returns its address
+func startRepoServer(t *testing.T, srv *fakeRepoServer) (string, func()) {
+	cert, err := tlsutil.GenerateX509KeyPair(tlsutil.CertOptions{Hosts: []string{"localhost"}, Organization: "Argo CD", ECDSACurve: "P256"})
+	assert.NoError(t, err)
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	server := grpc.NewServer(grpc.Creds(credentials.NewTLS(&tls.Config{Certificates: []tls.Certificate{*cert}})))
+	RegisterRepoServerServiceServer(server, srv)
+	go func() { _ = server.Serve(listener) }()
+	return listener.Addr().String(), server.Stop
+}
+
+func TestBackoffExponential(t *testing.T) {
+	backoff := backoffExponential(time.Second)
+	for attempt, expected := range map[uint]time.Duration{0: time.Second, 2: 4 * time.Second, 4: maxRetryBackoff, 100: maxRetryBackoff} {
+		wait := backoff(attempt)
+		assert.True(t, wait >= expected, "attempt %d waits %v", attempt, wait)
+		assert.True(t, wait <= expected+expected/10, "attempt %d waits %v", attempt, wait)
+	}
+}
+
+func TestKeepAliveParams(t *testing.T) {
+	params := keepAliveParams(time.Minute)
+	assert.Equal(t, time.Minute, params.Time)
+	assert.True(t, params.PermitWithoutStream)
+
+	// the repo server disconnects clients which ping more often than the minimum
+	params = keepAliveParams(time.Second)
+	assert.Equal(t, MinKeepAliveTime, params.Time)
+}
+
+func TestNewRepoServerClient_Retry(t *testing.T) {
+	srv := &fakeRepoServer{failures: 2, err: status.Errorf(codes.Unavailable, "connection refused")}
+	address, stop := startRepoServer(t, srv)
+	defer stop()
+
+	clientset := NewRepoServerClientset(address, ClientOptions{MaxRetries: 3, RetryBackoff: time.Millisecond})
+	closer, client, err := clientset.NewRepoServerClient()
+	assert.NoError(t, err)
+	defer func() { _ = closer.Close() }()
+	res, err := client.GetVersion(context.Background(), &RepoServerVersionRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, "v1.2.3", res.Version)
+	assert.Equal(t, 3, srv.calls)
+
+	// calls are retried at most MaxRetries times
+	srv.calls = 0
+	srv.failures = 5
+	_, err = client.GetVersion(context.Background(), &RepoServerVersionRequest{})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, 4, srv.calls)
+
+	// errors other than an unavailable repo server are not retried
+	srv.calls = 0
+	srv.err = status.Errorf(codes.InvalidArgument, "invalid request")
+	_, err = client.GetVersion(context.Background(), &RepoServerVersionRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, 1, srv.calls)
+}
+
+func TestNewRepoServerClient_Pool(t *testing.T) {
+	address, stop := startRepoServer(t, &fakeRepoServer{})
+	defer stop()
+
+	clientset := NewRepoServerClientset(address, ClientOptions{Connections: 2, KeepAliveTime: time.Minute})
+	var conns []*grpc.ClientConn
+	for i := 0; i < 3; i++ {
+		closer, client, err := clientset.NewRepoServerClient()
+		assert.NoError(t, err)
+		res, err := client.GetVersion(context.Background(), &RepoServerVersionRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, "v1.2.3", res.Version)
+		// pooled connections stay open when the client is closed
+		assert.NoError(t, closer.Close())
+		conns = append(conns, client.(*repoServerServiceClient).cc)
+	}
+	// the connections of the pool are used in turn
+	assert.NotEqual(t, conns[0], conns[1])
+	assert.Equal(t, conns[0], conns[2])
+	_, err := NewRepoServerServiceClient(conns[0]).GetVersion(context.Background(), &RepoServerVersionRequest{})
+	assert.NoError(t, err)
+}
@@ -79,6 +79,96 @@ func (_m *RepoServerServiceClient) GetAppDetails(ctx context.Context, in *apicli
 	return r0, r1
 }
 
+// GetLocks provides a mock function with given fields: ctx, in, opts
+func (_m *RepoServerServiceClient) GetLocks(ctx context.Context, in *apiclient.LocksRequest, opts ...grpc.CallOption) (*apiclient.LocksResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *apiclient.LocksResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *apiclient.LocksRequest, ...grpc.CallOption) *apiclient.LocksResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*apiclient.LocksResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *apiclient.LocksRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProfile provides a mock function with given fields: ctx, in, opts
+func (_m *RepoServerServiceClient) GetProfile(ctx context.Context, in *apiclient.ProfileRequest, opts ...grpc.CallOption) (*apiclient.ProfileResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *apiclient.ProfileResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *apiclient.ProfileRequest, ...grpc.CallOption) *apiclient.ProfileResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*apiclient.ProfileResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *apiclient.ProfileRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRevisionChangelog provides a mock function with given fields: ctx, in, opts
+func (_m *RepoServerServiceClient) GetRevisionChangelog(ctx context.Context, in *apiclient.RepoServerRevisionChangelogRequest, opts ...grpc.CallOption) (*v1alpha1.RevisionChangelog, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *v1alpha1.RevisionChangelog
+	if rf, ok := ret.Get(0).(func(context.Context, *apiclient.RepoServerRevisionChangelogRequest, ...grpc.CallOption) *v1alpha1.RevisionChangelog); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.RevisionChangelog)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *apiclient.RepoServerRevisionChangelogRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetRevisionMetadata provides a mock function with given fields: ctx, in, opts
 func (_m *RepoServerServiceClient) GetRevisionMetadata(ctx context.Context, in *apiclient.RepoServerRevisionMetadataRequest, opts ...grpc.CallOption) (*v1alpha1.RevisionMetadata, error) {
 	_va := make([]interface{}, len(opts))
@@ -109,6 +199,36 @@ func (_m *RepoServerServiceClient) GetRevisionMetadata(ctx context.Context, in *
 	return r0, r1
 }
 
+// GetVersion provides a mock function with given fields: ctx, in, opts
+func (_m *RepoServerServiceClient) GetVersion(ctx context.Context, in *apiclient.RepoServerVersionRequest, opts ...grpc.CallOption) (*apiclient.RepoServerVersionResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *apiclient.RepoServerVersionResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *apiclient.RepoServerVersionRequest, ...grpc.CallOption) *apiclient.RepoServerVersionResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*apiclient.RepoServerVersionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *apiclient.RepoServerVersionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListApps provides a mock function with given fields: ctx, in, opts
 func (_m *RepoServerServiceClient) ListApps(ctx context.Context, in *apiclient.ListAppsRequest, opts ...grpc.CallOption) (*apiclient.AppList, error) {
 	_va := make([]interface{}, len(opts))
@@ -138,3 +258,33 @@ func (_m *RepoServerServiceClient) ListApps(ctx context.Context, in *apiclient.L
 
 	return r0, r1
 }
+
+// SetProfiling provides a mock function with given fields: ctx, in, opts
+func (_m *RepoServerServiceClient) SetProfiling(ctx context.Context, in *apiclient.ProfilingRequest, opts ...grpc.CallOption) (*apiclient.ProfilingResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *apiclient.ProfilingResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *apiclient.ProfilingRequest, ...grpc.CallOption) *apiclient.ProfilingResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*apiclient.ProfilingResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *apiclient.ProfilingRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -38,27 +38,41 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
 // ManifestRequest is a query for manifest generation.
 type ManifestRequest struct {
-	Repo                 *v1alpha1.Repository               `protobuf:"bytes,1,opt,name=repo" json:"repo,omitempty"`
-	Revision             string                             `protobuf:"bytes,2,opt,name=revision,proto3" json:"revision,omitempty"`
-	NoCache              bool                               `protobuf:"varint,3,opt,name=noCache,proto3" json:"noCache,omitempty"`
-	AppLabelKey          string                             `protobuf:"bytes,4,opt,name=appLabelKey,proto3" json:"appLabelKey,omitempty"`
-	AppLabelValue        string                             `protobuf:"bytes,5,opt,name=appLabelValue,proto3" json:"appLabelValue,omitempty"`
-	Namespace            string                             `protobuf:"bytes,8,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	ApplicationSource    *v1alpha1.ApplicationSource        `protobuf:"bytes,10,opt,name=applicationSource" json:"applicationSource,omitempty"`
-	Repos                []*v1alpha1.Repository             `protobuf:"bytes,11,rep,name=repos" json:"repos,omitempty"`
-	Plugins              []*v1alpha1.ConfigManagementPlugin `protobuf:"bytes,12,rep,name=plugins" json:"plugins,omitempty"`
-	KustomizeOptions     *v1alpha1.KustomizeOptions         `protobuf:"bytes,13,opt,name=kustomizeOptions" json:"kustomizeOptions,omitempty"`
-	KubeVersion          string                             `protobuf:"bytes,14,opt,name=kubeVersion,proto3" json:"kubeVersion,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
-	XXX_unrecognized     []byte                             `json:"-"`
-	XXX_sizecache        int32                              `json:"-"`
+	Repo              *v1alpha1.Repository               `protobuf:"bytes,1,opt,name=repo" json:"repo,omitempty"`
+	Revision          string                             `protobuf:"bytes,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	NoCache           bool                               `protobuf:"varint,3,opt,name=noCache,proto3" json:"noCache,omitempty"`
+	AppLabelKey       string                             `protobuf:"bytes,4,opt,name=appLabelKey,proto3" json:"appLabelKey,omitempty"`
+	AppLabelValue     string                             `protobuf:"bytes,5,opt,name=appLabelValue,proto3" json:"appLabelValue,omitempty"`
+	Namespace         string                             `protobuf:"bytes,8,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ApplicationSource *v1alpha1.ApplicationSource        `protobuf:"bytes,10,opt,name=applicationSource" json:"applicationSource,omitempty"`
+	Repos             []*v1alpha1.Repository             `protobuf:"bytes,11,rep,name=repos" json:"repos,omitempty"`
+	Plugins           []*v1alpha1.ConfigManagementPlugin `protobuf:"bytes,12,rep,name=plugins" json:"plugins,omitempty"`
+	KustomizeOptions  *v1alpha1.KustomizeOptions         `protobuf:"bytes,13,opt,name=kustomizeOptions" json:"kustomizeOptions,omitempty"`
+	KubeVersion       string                             `protobuf:"bytes,14,opt,name=kubeVersion,proto3" json:"kubeVersion,omitempty"`
+	// DecryptionKeys are the SOPS keys which may be used to decrypt the files of the application
+	DecryptionKeys []string `protobuf:"bytes,15,rep,name=decryptionKeys" json:"decryptionKeys,omitempty"`
+	// ParameterOverridesFile is the path of the file, relative to the application path, which holds parameter overrides
+	// written back to git
+	ParameterOverridesFile string `protobuf:"bytes,16,opt,name=parameterOverridesFile,proto3" json:"parameterOverridesFile,omitempty"`
+	// ManifestGeneratePaths are the paths, relative to the root of the repository, which the manifests are generated from
+	ManifestGeneratePaths []string `protobuf:"bytes,17,rep,name=manifestGeneratePaths" json:"manifestGeneratePaths,omitempty"`
+	// PreviousRevision is the revision the manifests were last generated from. Its cached manifests are reused if no
+	// file within the manifest generate paths was changed since.
+	PreviousRevision string `protobuf:"bytes,18,opt,name=previousRevision,proto3" json:"previousRevision,omitempty"`
+	// ApiVersions are the API versions served by the destination cluster, which are passed to `helm template`
+	ApiVersions []string `protobuf:"bytes,19,rep,name=apiVersions" json:"apiVersions,omitempty"`
+	// ManifestQuota limits the number of resources and the size of the rendered manifests
+	ManifestQuota        *v1alpha1.ManifestQuota `protobuf:"bytes,20,opt,name=manifestQuota" json:"manifestQuota,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
 func (m *ManifestRequest) Reset()         { *m = ManifestRequest{} }
 func (m *ManifestRequest) String() string { return proto.CompactTextString(m) }
 func (*ManifestRequest) ProtoMessage()    {}
 func (*ManifestRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_d8b025f090bb11d8, []int{0}
+	return fileDescriptor_repository_b644f9156bbe390d, []int{0}
 }
 func (m *ManifestRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -164,22 +178,68 @@ func (m *ManifestRequest) GetKubeVersion() string {
 	return ""
 }
 
+func (m *ManifestRequest) GetDecryptionKeys() []string {
+	if m != nil {
+		return m.DecryptionKeys
+	}
+	return nil
+}
+
+func (m *ManifestRequest) GetParameterOverridesFile() string {
+	if m != nil {
+		return m.ParameterOverridesFile
+	}
+	return ""
+}
+
+func (m *ManifestRequest) GetManifestGeneratePaths() []string {
+	if m != nil {
+		return m.ManifestGeneratePaths
+	}
+	return nil
+}
+
+func (m *ManifestRequest) GetPreviousRevision() string {
+	if m != nil {
+		return m.PreviousRevision
+	}
+	return ""
+}
+
+func (m *ManifestRequest) GetApiVersions() []string {
+	if m != nil {
+		return m.ApiVersions
+	}
+	return nil
+}
+
+func (m *ManifestRequest) GetManifestQuota() *v1alpha1.ManifestQuota {
+	if m != nil {
+		return m.ManifestQuota
+	}
+	return nil
+}
+
 type ManifestResponse struct {
-	Manifests            []string `protobuf:"bytes,1,rep,name=manifests" json:"manifests,omitempty"`
-	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Server               string   `protobuf:"bytes,3,opt,name=server,proto3" json:"server,omitempty"`
-	Revision             string   `protobuf:"bytes,4,opt,name=revision,proto3" json:"revision,omitempty"`
-	SourceType           string   `protobuf:"bytes,6,opt,name=sourceType,proto3" json:"sourceType,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Manifests  []string `protobuf:"bytes,1,rep,name=manifests" json:"manifests,omitempty"`
+	Namespace  string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Server     string   `protobuf:"bytes,3,opt,name=server,proto3" json:"server,omitempty"`
+	Revision   string   `protobuf:"bytes,4,opt,name=revision,proto3" json:"revision,omitempty"`
+	SourceType string   `protobuf:"bytes,6,opt,name=sourceType,proto3" json:"sourceType,omitempty"`
+	// Stale is true if the manifests were served from the cache because the repository was unreachable
+	Stale bool `protobuf:"varint,7,opt,name=stale,proto3" json:"stale,omitempty"`
+	// RenderedSources are the Helm charts and remote kustomize bases which the manifests were rendered from
+	RenderedSources      []v1alpha1.RenderedSource `protobuf:"bytes,8,rep,name=renderedSources" json:"renderedSources"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
 
 func (m *ManifestResponse) Reset()         { *m = ManifestResponse{} }
 func (m *ManifestResponse) String() string { return proto.CompactTextString(m) }
 func (*ManifestResponse) ProtoMessage()    {}
 func (*ManifestResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_d8b025f090bb11d8, []int{1}
+	return fileDescriptor_repository_b644f9156bbe390d, []int{1}
 }
 func (m *ManifestResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -243,6 +303,20 @@ func (m *ManifestResponse) GetSourceType() string {
 	return ""
 }
 
+func (m *ManifestResponse) GetStale() bool {
+	if m != nil {
+		return m.Stale
+	}
+	return false
+}
+
+func (m *ManifestResponse) GetRenderedSources() []v1alpha1.RenderedSource {
+	if m != nil {
+		return m.RenderedSources
+	}
+	return nil
+}
+
 // ListAppsRequest requests a repository directory structure
 type ListAppsRequest struct {
 	Repo                 *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo" json:"repo,omitempty"`
@@ -256,7 +330,7 @@ func (m *ListAppsRequest) Reset()         { *m = ListAppsRequest{} }
 func (m *ListAppsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListAppsRequest) ProtoMessage()    {}
 func (*ListAppsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_d8b025f090bb11d8, []int{2}
+	return fileDescriptor_repository_b644f9156bbe390d, []int{2}
 }
 func (m *ListAppsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -311,7 +385,7 @@ func (m *AppList) Reset()         { *m = AppList{} }
 func (m *AppList) String() string { return proto.CompactTextString(m) }
 func (*AppList) ProtoMessage()    {}
 func (*AppList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_d8b025f090bb11d8, []int{3}
+	return fileDescriptor_repository_b644f9156bbe390d, []int{3}
 }
 func (m *AppList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -366,7 +440,7 @@ func (m *RepoServerAppDetailsQuery) Reset()         { *m = RepoServerAppDetailsQ
 func (m *RepoServerAppDetailsQuery) String() string { return proto.CompactTextString(m) }
 func (*RepoServerAppDetailsQuery) ProtoMessage()    {}
 func (*RepoServerAppDetailsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_d8b025f090bb11d8, []int{4}
+	return fileDescriptor_repository_b644f9156bbe390d, []int{4}
 }
 func (m *RepoServerAppDetailsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -452,17 +526,19 @@ func (m *RepoServerAppDetailsQuery) GetKustomizeOptions() *v1alpha1.KustomizeOpt
 }
 
 type HelmAppDetailsQuery struct {
-	ValueFiles           []string `protobuf:"bytes,1,rep,name=valueFiles" json:"valueFiles,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	ValueFiles []string `protobuf:"bytes,1,rep,name=valueFiles" json:"valueFiles,omitempty"`
+	// AllowValueFilesOutsideChart allows value files outside of the chart directory, within the same repository
+	AllowValueFilesOutsideChart bool     `protobuf:"varint,2,opt,name=allowValueFilesOutsideChart,proto3" json:"allowValueFilesOutsideChart,omitempty"`
+	XXX_NoUnkeyedLiteral        struct{} `json:"-"`
+	XXX_unrecognized            []byte   `json:"-"`
+	XXX_sizecache               int32    `json:"-"`
 }
 
 func (m *HelmAppDetailsQuery) Reset()         { *m = HelmAppDetailsQuery{} }
 func (m *HelmAppDetailsQuery) String() string { return proto.CompactTextString(m) }
 func (*HelmAppDetailsQuery) ProtoMessage()    {}
 func (*HelmAppDetailsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_d8b025f090bb11d8, []int{5}
+	return fileDescriptor_repository_b644f9156bbe390d, []int{5}
 }
 func (m *HelmAppDetailsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -498,6 +574,13 @@ func (m *HelmAppDetailsQuery) GetValueFiles() []string {
 	return nil
 }
 
+func (m *HelmAppDetailsQuery) GetAllowValueFilesOutsideChart() bool {
+	if m != nil {
+		return m.AllowValueFilesOutsideChart
+	}
+	return false
+}
+
 type KsonnetAppDetailsQuery struct {
 	Environment          string   `protobuf:"bytes,1,opt,name=environment,proto3" json:"environment,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -509,7 +592,7 @@ func (m *KsonnetAppDetailsQuery) Reset()         { *m = KsonnetAppDetailsQuery{}
 func (m *KsonnetAppDetailsQuery) String() string { return proto.CompactTextString(m) }
 func (*KsonnetAppDetailsQuery) ProtoMessage()    {}
 func (*KsonnetAppDetailsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_d8b025f090bb11d8, []int{6}
+	return fileDescriptor_repository_b644f9156bbe390d, []int{6}
 }
 func (m *KsonnetAppDetailsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -561,7 +644,7 @@ func (m *RepoAppDetailsResponse) Reset()         { *m = RepoAppDetailsResponse{}
 func (m *RepoAppDetailsResponse) String() string { return proto.CompactTextString(m) }
 func (*RepoAppDetailsResponse) ProtoMessage()    {}
 func (*RepoAppDetailsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_d8b025f090bb11d8, []int{7}
+	return fileDescriptor_repository_b644f9156bbe390d, []int{7}
 }
 func (m *RepoAppDetailsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -631,7 +714,9 @@ type RepoServerRevisionMetadataRequest struct {
 	// the app name
 	App string `protobuf:"bytes,2,opt,name=app,proto3" json:"app,omitempty"`
 	// the revision within the repo
-	Revision             string   `protobuf:"bytes,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	Revision string `protobuf:"bytes,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	// returns the untruncated message and the changed files of the revision
+	Full                 bool     `protobuf:"varint,4,opt,name=full,proto3" json:"full,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -641,7 +726,7 @@ func (m *RepoServerRevisionMetadataRequest) Reset()         { *m = RepoServerRev
 func (m *RepoServerRevisionMetadataRequest) String() string { return proto.CompactTextString(m) }
 func (*RepoServerRevisionMetadataRequest) ProtoMessage()    {}
 func (*RepoServerRevisionMetadataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_d8b025f090bb11d8, []int{8}
+	return fileDescriptor_repository_b644f9156bbe390d, []int{8}
 }
 func (m *RepoServerRevisionMetadataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -691,6 +776,88 @@ func (m *RepoServerRevisionMetadataRequest) GetRevision() string {
 	return ""
 }
 
+func (m *RepoServerRevisionMetadataRequest) GetFull() bool {
+	if m != nil {
+		return m.Full
+	}
+	return false
+}
+
+type RepoServerRevisionChangelogRequest struct {
+	// the repo
+	Repo *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo" json:"repo,omitempty"`
+	// the app name
+	App string `protobuf:"bytes,2,opt,name=app,proto3" json:"app,omitempty"`
+	// the deployed revision within the repo
+	Revision string `protobuf:"bytes,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	// the target revision within the repo, e.g. a branch, which is resolved before the commits are listed
+	TargetRevision       string   `protobuf:"bytes,4,opt,name=targetRevision,proto3" json:"targetRevision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RepoServerRevisionChangelogRequest) Reset()         { *m = RepoServerRevisionChangelogRequest{} }
+func (m *RepoServerRevisionChangelogRequest) String() string { return proto.CompactTextString(m) }
+func (*RepoServerRevisionChangelogRequest) ProtoMessage()    {}
+func (*RepoServerRevisionChangelogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_repository_b644f9156bbe390d, []int{9}
+}
+func (m *RepoServerRevisionChangelogRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RepoServerRevisionChangelogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RepoServerRevisionChangelogRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *RepoServerRevisionChangelogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepoServerRevisionChangelogRequest.Merge(dst, src)
+}
+func (m *RepoServerRevisionChangelogRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RepoServerRevisionChangelogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepoServerRevisionChangelogRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepoServerRevisionChangelogRequest proto.InternalMessageInfo
+
+func (m *RepoServerRevisionChangelogRequest) GetRepo() *v1alpha1.Repository {
+	if m != nil {
+		return m.Repo
+	}
+	return nil
+}
+
+func (m *RepoServerRevisionChangelogRequest) GetApp() string {
+	if m != nil {
+		return m.App
+	}
+	return ""
+}
+
+func (m *RepoServerRevisionChangelogRequest) GetRevision() string {
+	if m != nil {
+		return m.Revision
+	}
+	return ""
+}
+
+func (m *RepoServerRevisionChangelogRequest) GetTargetRevision() string {
+	if m != nil {
+		return m.TargetRevision
+	}
+	return ""
+}
+
 // KsonnetAppSpec contains Ksonnet app response
 // This roughly reflects: ksonnet/ksonnet/metadata/app/schema.go
 type KsonnetAppSpec struct {
@@ -706,7 +873,7 @@ func (m *KsonnetAppSpec) Reset()         { *m = KsonnetAppSpec{} }
 func (m *KsonnetAppSpec) String() string { return proto.CompactTextString(m) }
 func (*KsonnetAppSpec) ProtoMessage()    {}
 func (*KsonnetAppSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_d8b025f090bb11d8, []int{9}
+	return fileDescriptor_repository_b644f9156bbe390d, []int{10}
 }
 func (m *KsonnetAppSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -763,7 +930,9 @@ type HelmAppSpec struct {
 	// the output of `helm inspect values`
 	Parameters []*v1alpha1.HelmParameter `protobuf:"bytes,4,rep,name=parameters" json:"parameters,omitempty"`
 	// the contents of values.yaml
-	Values               string   `protobuf:"bytes,5,opt,name=values,proto3" json:"values,omitempty"`
+	Values string `protobuf:"bytes,5,opt,name=values,proto3" json:"values,omitempty"`
+	// HasCrds is true if the chart bundles custom resource definitions in its crds/ directory
+	HasCrds              bool     `protobuf:"varint,6,opt,name=hasCrds,proto3" json:"hasCrds,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -773,7 +942,7 @@ func (m *HelmAppSpec) Reset()         { *m = HelmAppSpec{} }
 func (m *HelmAppSpec) String() string { return proto.CompactTextString(m) }
 func (*HelmAppSpec) ProtoMessage()    {}
 func (*HelmAppSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_d8b025f090bb11d8, []int{10}
+	return fileDescriptor_repository_b644f9156bbe390d, []int{11}
 }
 func (m *HelmAppSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -830,6 +999,13 @@ func (m *HelmAppSpec) GetValues() string {
 	return ""
 }
 
+func (m *HelmAppSpec) GetHasCrds() bool {
+	if m != nil {
+		return m.HasCrds
+	}
+	return false
+}
+
 // KustomizeAppSpec contains kustomize images
 type KustomizeAppSpec struct {
 	// images is a list of available images.
@@ -843,7 +1019,7 @@ func (m *KustomizeAppSpec) Reset()         { *m = KustomizeAppSpec{} }
 func (m *KustomizeAppSpec) String() string { return proto.CompactTextString(m) }
 func (*KustomizeAppSpec) ProtoMessage()    {}
 func (*KustomizeAppSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_d8b025f090bb11d8, []int{11}
+	return fileDescriptor_repository_b644f9156bbe390d, []int{12}
 }
 func (m *KustomizeAppSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -895,7 +1071,7 @@ func (m *KsonnetEnvironment) Reset()         { *m = KsonnetEnvironment{} }
 func (m *KsonnetEnvironment) String() string { return proto.CompactTextString(m) }
 func (*KsonnetEnvironment) ProtoMessage()    {}
 func (*KsonnetEnvironment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_d8b025f090bb11d8, []int{12}
+	return fileDescriptor_repository_b644f9156bbe390d, []int{13}
 }
 func (m *KsonnetEnvironment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -959,7 +1135,7 @@ func (m *KsonnetEnvironmentDestination) Reset()         { *m = KsonnetEnvironmen
 func (m *KsonnetEnvironmentDestination) String() string { return proto.CompactTextString(m) }
 func (*KsonnetEnvironmentDestination) ProtoMessage()    {}
 func (*KsonnetEnvironmentDestination) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_d8b025f090bb11d8, []int{13}
+	return fileDescriptor_repository_b644f9156bbe390d, []int{14}
 }
 func (m *KsonnetEnvironmentDestination) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1013,7 +1189,7 @@ func (m *DirectoryAppSpec) Reset()         { *m = DirectoryAppSpec{} }
 func (m *DirectoryAppSpec) String() string { return proto.CompactTextString(m) }
 func (*DirectoryAppSpec) ProtoMessage()    {}
 func (*DirectoryAppSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_d8b025f090bb11d8, []int{14}
+	return fileDescriptor_repository_b644f9156bbe390d, []int{15}
 }
 func (m *DirectoryAppSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1042,456 +1218,887 @@ func (m *DirectoryAppSpec) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DirectoryAppSpec proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*ManifestRequest)(nil), "repository.ManifestRequest")
-	proto.RegisterType((*ManifestResponse)(nil), "repository.ManifestResponse")
-	proto.RegisterType((*ListAppsRequest)(nil), "repository.ListAppsRequest")
-	proto.RegisterType((*AppList)(nil), "repository.AppList")
-	proto.RegisterMapType((map[string]string)(nil), "repository.AppList.AppsEntry")
-	proto.RegisterType((*RepoServerAppDetailsQuery)(nil), "repository.RepoServerAppDetailsQuery")
-	proto.RegisterType((*HelmAppDetailsQuery)(nil), "repository.HelmAppDetailsQuery")
-	proto.RegisterType((*KsonnetAppDetailsQuery)(nil), "repository.KsonnetAppDetailsQuery")
-	proto.RegisterType((*RepoAppDetailsResponse)(nil), "repository.RepoAppDetailsResponse")
-	proto.RegisterType((*RepoServerRevisionMetadataRequest)(nil), "repository.RepoServerRevisionMetadataRequest")
-	proto.RegisterType((*KsonnetAppSpec)(nil), "repository.KsonnetAppSpec")
-	proto.RegisterMapType((map[string]*KsonnetEnvironment)(nil), "repository.KsonnetAppSpec.EnvironmentsEntry")
-	proto.RegisterType((*HelmAppSpec)(nil), "repository.HelmAppSpec")
-	proto.RegisterType((*KustomizeAppSpec)(nil), "repository.KustomizeAppSpec")
-	proto.RegisterType((*KsonnetEnvironment)(nil), "repository.KsonnetEnvironment")
-	proto.RegisterType((*KsonnetEnvironmentDestination)(nil), "repository.KsonnetEnvironmentDestination")
-	proto.RegisterType((*DirectoryAppSpec)(nil), "repository.DirectoryAppSpec")
+// ProfilingRequest enables or disables serving the profiles of the repo server
+type ProfilingRequest struct {
+	Enabled              bool     `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *ProfilingRequest) Reset()         { *m = ProfilingRequest{} }
+func (m *ProfilingRequest) String() string { return proto.CompactTextString(m) }
+func (*ProfilingRequest) ProtoMessage()    {}
+func (*ProfilingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_repository_b644f9156bbe390d, []int{16}
+}
+func (m *ProfilingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProfilingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProfilingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ProfilingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProfilingRequest.Merge(dst, src)
+}
+func (m *ProfilingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProfilingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProfilingRequest.DiscardUnknown(m)
+}
 
-// Client API for RepoServerService service
+var xxx_messageInfo_ProfilingRequest proto.InternalMessageInfo
 
-type RepoServerServiceClient interface {
-	// GenerateManifest generates manifest for application in specified repo name and revision
-	GenerateManifest(ctx context.Context, in *ManifestRequest, opts ...grpc.CallOption) (*ManifestResponse, error)
-	// ListApps returns a list of apps in the repo
-	ListApps(ctx context.Context, in *ListAppsRequest, opts ...grpc.CallOption) (*AppList, error)
-	// Generate manifest for application in specified repo name and revision
-	GetAppDetails(ctx context.Context, in *RepoServerAppDetailsQuery, opts ...grpc.CallOption) (*RepoAppDetailsResponse, error)
-	// Get the meta-data (author, date, tags, message) for a specific revision of the repo
-	GetRevisionMetadata(ctx context.Context, in *RepoServerRevisionMetadataRequest, opts ...grpc.CallOption) (*v1alpha1.RevisionMetadata, error)
+func (m *ProfilingRequest) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
 }
 
-type repoServerServiceClient struct {
-	cc *grpc.ClientConn
+type ProfilingResponse struct {
+	Enabled              bool     `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func NewRepoServerServiceClient(cc *grpc.ClientConn) RepoServerServiceClient {
-	return &repoServerServiceClient{cc}
+func (m *ProfilingResponse) Reset()         { *m = ProfilingResponse{} }
+func (m *ProfilingResponse) String() string { return proto.CompactTextString(m) }
+func (*ProfilingResponse) ProtoMessage()    {}
+func (*ProfilingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_repository_b644f9156bbe390d, []int{17}
 }
-
-func (c *repoServerServiceClient) GenerateManifest(ctx context.Context, in *ManifestRequest, opts ...grpc.CallOption) (*ManifestResponse, error) {
-	out := new(ManifestResponse)
-	err := c.cc.Invoke(ctx, "/repository.RepoServerService/GenerateManifest", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ProfilingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProfilingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProfilingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (dst *ProfilingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProfilingResponse.Merge(dst, src)
+}
+func (m *ProfilingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProfilingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProfilingResponse.DiscardUnknown(m)
 }
 
-func (c *repoServerServiceClient) ListApps(ctx context.Context, in *ListAppsRequest, opts ...grpc.CallOption) (*AppList, error) {
-	out := new(AppList)
-	err := c.cc.Invoke(ctx, "/repository.RepoServerService/ListApps", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ProfilingResponse proto.InternalMessageInfo
+
+func (m *ProfilingResponse) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
 	}
-	return out, nil
+	return false
 }
 
-func (c *repoServerServiceClient) GetAppDetails(ctx context.Context, in *RepoServerAppDetailsQuery, opts ...grpc.CallOption) (*RepoAppDetailsResponse, error) {
-	out := new(RepoAppDetailsResponse)
-	err := c.cc.Invoke(ctx, "/repository.RepoServerService/GetAppDetails", in, out, opts...)
-	if err != nil {
-		return nil, err
+// ProfileRequest is a request for a runtime profile of the repo server, e.g. 'heap' or 'goroutine'
+type ProfileRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Dump writes the profile to the profile directory of the repo server instead of returning it
+	Dump                 bool     `protobuf:"varint,2,opt,name=dump,proto3" json:"dump,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProfileRequest) Reset()         { *m = ProfileRequest{} }
+func (m *ProfileRequest) String() string { return proto.CompactTextString(m) }
+func (*ProfileRequest) ProtoMessage()    {}
+func (*ProfileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_repository_b644f9156bbe390d, []int{18}
+}
+func (m *ProfileRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProfileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProfileRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (dst *ProfileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProfileRequest.Merge(dst, src)
+}
+func (m *ProfileRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProfileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProfileRequest.DiscardUnknown(m)
 }
 
-func (c *repoServerServiceClient) GetRevisionMetadata(ctx context.Context, in *RepoServerRevisionMetadataRequest, opts ...grpc.CallOption) (*v1alpha1.RevisionMetadata, error) {
-	out := new(v1alpha1.RevisionMetadata)
-	err := c.cc.Invoke(ctx, "/repository.RepoServerService/GetRevisionMetadata", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ProfileRequest proto.InternalMessageInfo
+
+func (m *ProfileRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return out, nil
+	return ""
 }
 
-// Server API for RepoServerService service
+func (m *ProfileRequest) GetDump() bool {
+	if m != nil {
+		return m.Dump
+	}
+	return false
+}
 
-type RepoServerServiceServer interface {
-	// GenerateManifest generates manifest for application in specified repo name and revision
-	GenerateManifest(context.Context, *ManifestRequest) (*ManifestResponse, error)
-	// ListApps returns a list of apps in the repo
-	ListApps(context.Context, *ListAppsRequest) (*AppList, error)
-	// Generate manifest for application in specified repo name and revision
-	GetAppDetails(context.Context, *RepoServerAppDetailsQuery) (*RepoAppDetailsResponse, error)
-	// Get the meta-data (author, date, tags, message) for a specific revision of the repo
-	GetRevisionMetadata(context.Context, *RepoServerRevisionMetadataRequest) (*v1alpha1.RevisionMetadata, error)
+type ProfileResponse struct {
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// Path is the path of the dumped profile
+	Path                 string   `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func RegisterRepoServerServiceServer(s *grpc.Server, srv RepoServerServiceServer) {
-	s.RegisterService(&_RepoServerService_serviceDesc, srv)
+func (m *ProfileResponse) Reset()         { *m = ProfileResponse{} }
+func (m *ProfileResponse) String() string { return proto.CompactTextString(m) }
+func (*ProfileResponse) ProtoMessage()    {}
+func (*ProfileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_repository_b644f9156bbe390d, []int{19}
 }
-
-func _RepoServerService_GenerateManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ManifestRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RepoServerServiceServer).GenerateManifest(ctx, in)
+func (m *ProfileResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProfileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProfileResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/repository.RepoServerService/GenerateManifest",
+}
+func (dst *ProfileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProfileResponse.Merge(dst, src)
+}
+func (m *ProfileResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProfileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProfileResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProfileResponse proto.InternalMessageInfo
+
+func (m *ProfileResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RepoServerServiceServer).GenerateManifest(ctx, req.(*ManifestRequest))
+	return nil
+}
+
+func (m *ProfileResponse) GetPath() string {
+	if m != nil {
+		return m.Path
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _RepoServerService_ListApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAppsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+type LocksRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LocksRequest) Reset()         { *m = LocksRequest{} }
+func (m *LocksRequest) String() string { return proto.CompactTextString(m) }
+func (*LocksRequest) ProtoMessage()    {}
+func (*LocksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_repository_b644f9156bbe390d, []int{20}
+}
+func (m *LocksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LocksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(RepoServerServiceServer).ListApps(ctx, in)
+}
+func (dst *LocksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LocksRequest.Merge(dst, src)
+}
+func (m *LocksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *LocksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LocksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LocksRequest proto.InternalMessageInfo
+
+// Lock is a held lock of a repository or of a checkout of a revision
+type Lock struct {
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Owner is the operation which holds the lock
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// HeldSeconds is the number of seconds for which the lock is held
+	HeldSeconds int64 `protobuf:"varint,3,opt,name=heldSeconds,proto3" json:"heldSeconds,omitempty"`
+	// Waiting is the number of operations which wait for the lock
+	Waiting              int64    `protobuf:"varint,4,opt,name=waiting,proto3" json:"waiting,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Lock) Reset()         { *m = Lock{} }
+func (m *Lock) String() string { return proto.CompactTextString(m) }
+func (*Lock) ProtoMessage()    {}
+func (*Lock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_repository_b644f9156bbe390d, []int{21}
+}
+func (m *Lock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Lock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Lock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/repository.RepoServerService/ListApps",
+}
+func (dst *Lock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Lock.Merge(dst, src)
+}
+func (m *Lock) XXX_Size() int {
+	return m.Size()
+}
+func (m *Lock) XXX_DiscardUnknown() {
+	xxx_messageInfo_Lock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Lock proto.InternalMessageInfo
+
+func (m *Lock) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RepoServerServiceServer).ListApps(ctx, req.(*ListAppsRequest))
+	return ""
+}
+
+func (m *Lock) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _RepoServerService_GetAppDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RepoServerAppDetailsQuery)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *Lock) GetHeldSeconds() int64 {
+	if m != nil {
+		return m.HeldSeconds
 	}
-	if interceptor == nil {
-		return srv.(RepoServerServiceServer).GetAppDetails(ctx, in)
+	return 0
+}
+
+func (m *Lock) GetWaiting() int64 {
+	if m != nil {
+		return m.Waiting
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/repository.RepoServerService/GetAppDetails",
+	return 0
+}
+
+type LocksResponse struct {
+	Locks                []*Lock  `protobuf:"bytes,1,rep,name=locks" json:"locks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LocksResponse) Reset()         { *m = LocksResponse{} }
+func (m *LocksResponse) String() string { return proto.CompactTextString(m) }
+func (*LocksResponse) ProtoMessage()    {}
+func (*LocksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_repository_b644f9156bbe390d, []int{22}
+}
+func (m *LocksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LocksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LocksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RepoServerServiceServer).GetAppDetails(ctx, req.(*RepoServerAppDetailsQuery))
+}
+func (dst *LocksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LocksResponse.Merge(dst, src)
+}
+func (m *LocksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *LocksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LocksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LocksResponse proto.InternalMessageInfo
+
+func (m *LocksResponse) GetLocks() []*Lock {
+	if m != nil {
+		return m.Locks
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _RepoServerService_GetRevisionMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RepoServerRevisionMetadataRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+type RepoServerVersionRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RepoServerVersionRequest) Reset()         { *m = RepoServerVersionRequest{} }
+func (m *RepoServerVersionRequest) String() string { return proto.CompactTextString(m) }
+func (*RepoServerVersionRequest) ProtoMessage()    {}
+func (*RepoServerVersionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_repository_b644f9156bbe390d, []int{23}
+}
+func (m *RepoServerVersionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RepoServerVersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RepoServerVersionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(RepoServerServiceServer).GetRevisionMetadata(ctx, in)
+}
+func (dst *RepoServerVersionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepoServerVersionRequest.Merge(dst, src)
+}
+func (m *RepoServerVersionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RepoServerVersionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepoServerVersionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepoServerVersionRequest proto.InternalMessageInfo
+
+// RepoServerVersionResponse is the version of the repo server, of the tools it uses to generate manifests and its enabled features
+type RepoServerVersionResponse struct {
+	Version              string   `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	HelmVersion          string   `protobuf:"bytes,2,opt,name=helmVersion,proto3" json:"helmVersion,omitempty"`
+	KustomizeVersion     string   `protobuf:"bytes,3,opt,name=kustomizeVersion,proto3" json:"kustomizeVersion,omitempty"`
+	KsonnetVersion       string   `protobuf:"bytes,4,opt,name=ksonnetVersion,proto3" json:"ksonnetVersion,omitempty"`
+	GitVersion           string   `protobuf:"bytes,5,opt,name=gitVersion,proto3" json:"gitVersion,omitempty"`
+	Features             []string `protobuf:"bytes,6,rep,name=features" json:"features,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RepoServerVersionResponse) Reset()         { *m = RepoServerVersionResponse{} }
+func (m *RepoServerVersionResponse) String() string { return proto.CompactTextString(m) }
+func (*RepoServerVersionResponse) ProtoMessage()    {}
+func (*RepoServerVersionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_repository_b644f9156bbe390d, []int{24}
+}
+func (m *RepoServerVersionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RepoServerVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RepoServerVersionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/repository.RepoServerService/GetRevisionMetadata",
+}
+func (dst *RepoServerVersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepoServerVersionResponse.Merge(dst, src)
+}
+func (m *RepoServerVersionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RepoServerVersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepoServerVersionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepoServerVersionResponse proto.InternalMessageInfo
+
+func (m *RepoServerVersionResponse) GetVersion() string {
+	if m != nil {
+		return m.Version
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RepoServerServiceServer).GetRevisionMetadata(ctx, req.(*RepoServerRevisionMetadataRequest))
+	return ""
+}
+
+func (m *RepoServerVersionResponse) GetHelmVersion() string {
+	if m != nil {
+		return m.HelmVersion
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _RepoServerService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "repository.RepoServerService",
-	HandlerType: (*RepoServerServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GenerateManifest",
-			Handler:    _RepoServerService_GenerateManifest_Handler,
-		},
-		{
-			MethodName: "ListApps",
-			Handler:    _RepoServerService_ListApps_Handler,
-		},
-		{
-			MethodName: "GetAppDetails",
-			Handler:    _RepoServerService_GetAppDetails_Handler,
-		},
-		{
-			MethodName: "GetRevisionMetadata",
-			Handler:    _RepoServerService_GetRevisionMetadata_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "reposerver/repository/repository.proto",
+func (m *RepoServerVersionResponse) GetKustomizeVersion() string {
+	if m != nil {
+		return m.KustomizeVersion
+	}
+	return ""
 }
 
-func (m *ManifestRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *RepoServerVersionResponse) GetKsonnetVersion() string {
+	if m != nil {
+		return m.KsonnetVersion
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ManifestRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Repo != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRepository(dAtA, i, uint64(m.Repo.Size()))
-		n1, err := m.Repo.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n1
-	}
-	if len(m.Revision) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintRepository(dAtA, i, uint64(len(m.Revision)))
-		i += copy(dAtA[i:], m.Revision)
-	}
-	if m.NoCache {
-		dAtA[i] = 0x18
-		i++
-		if m.NoCache {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if len(m.AppLabelKey) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintRepository(dAtA, i, uint64(len(m.AppLabelKey)))
-		i += copy(dAtA[i:], m.AppLabelKey)
-	}
-	if len(m.AppLabelValue) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintRepository(dAtA, i, uint64(len(m.AppLabelValue)))
-		i += copy(dAtA[i:], m.AppLabelValue)
-	}
-	if len(m.Namespace) > 0 {
-		dAtA[i] = 0x42
-		i++
-		i = encodeVarintRepository(dAtA, i, uint64(len(m.Namespace)))
-		i += copy(dAtA[i:], m.Namespace)
-	}
-	if m.ApplicationSource != nil {
-		dAtA[i] = 0x52
-		i++
-		i = encodeVarintRepository(dAtA, i, uint64(m.ApplicationSource.Size()))
-		n2, err := m.ApplicationSource.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n2
-	}
-	if len(m.Repos) > 0 {
-		for _, msg := range m.Repos {
-			dAtA[i] = 0x5a
-			i++
-			i = encodeVarintRepository(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if len(m.Plugins) > 0 {
-		for _, msg := range m.Plugins {
-			dAtA[i] = 0x62
-			i++
-			i = encodeVarintRepository(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if m.KustomizeOptions != nil {
-		dAtA[i] = 0x6a
-		i++
-		i = encodeVarintRepository(dAtA, i, uint64(m.KustomizeOptions.Size()))
-		n3, err := m.KustomizeOptions.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n3
+func (m *RepoServerVersionResponse) GetGitVersion() string {
+	if m != nil {
+		return m.GitVersion
 	}
-	if len(m.KubeVersion) > 0 {
-		dAtA[i] = 0x72
-		i++
-		i = encodeVarintRepository(dAtA, i, uint64(len(m.KubeVersion)))
-		i += copy(dAtA[i:], m.KubeVersion)
+	return ""
+}
+
+func (m *RepoServerVersionResponse) GetFeatures() []string {
+	if m != nil {
+		return m.Features
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ManifestRequest)(nil), "repository.ManifestRequest")
+	proto.RegisterType((*ManifestResponse)(nil), "repository.ManifestResponse")
+	proto.RegisterType((*ListAppsRequest)(nil), "repository.ListAppsRequest")
+	proto.RegisterType((*AppList)(nil), "repository.AppList")
+	proto.RegisterMapType((map[string]string)(nil), "repository.AppList.AppsEntry")
+	proto.RegisterType((*RepoServerAppDetailsQuery)(nil), "repository.RepoServerAppDetailsQuery")
+	proto.RegisterType((*HelmAppDetailsQuery)(nil), "repository.HelmAppDetailsQuery")
+	proto.RegisterType((*KsonnetAppDetailsQuery)(nil), "repository.KsonnetAppDetailsQuery")
+	proto.RegisterType((*RepoAppDetailsResponse)(nil), "repository.RepoAppDetailsResponse")
+	proto.RegisterType((*RepoServerRevisionMetadataRequest)(nil), "repository.RepoServerRevisionMetadataRequest")
+	proto.RegisterType((*RepoServerRevisionChangelogRequest)(nil), "repository.RepoServerRevisionChangelogRequest")
+	proto.RegisterType((*KsonnetAppSpec)(nil), "repository.KsonnetAppSpec")
+	proto.RegisterMapType((map[string]*KsonnetEnvironment)(nil), "repository.KsonnetAppSpec.EnvironmentsEntry")
+	proto.RegisterType((*HelmAppSpec)(nil), "repository.HelmAppSpec")
+	proto.RegisterType((*KustomizeAppSpec)(nil), "repository.KustomizeAppSpec")
+	proto.RegisterType((*KsonnetEnvironment)(nil), "repository.KsonnetEnvironment")
+	proto.RegisterType((*KsonnetEnvironmentDestination)(nil), "repository.KsonnetEnvironmentDestination")
+	proto.RegisterType((*DirectoryAppSpec)(nil), "repository.DirectoryAppSpec")
+	proto.RegisterType((*ProfilingRequest)(nil), "repository.ProfilingRequest")
+	proto.RegisterType((*ProfilingResponse)(nil), "repository.ProfilingResponse")
+	proto.RegisterType((*ProfileRequest)(nil), "repository.ProfileRequest")
+	proto.RegisterType((*ProfileResponse)(nil), "repository.ProfileResponse")
+	proto.RegisterType((*LocksRequest)(nil), "repository.LocksRequest")
+	proto.RegisterType((*Lock)(nil), "repository.Lock")
+	proto.RegisterType((*LocksResponse)(nil), "repository.LocksResponse")
+	proto.RegisterType((*RepoServerVersionRequest)(nil), "repository.RepoServerVersionRequest")
+	proto.RegisterType((*RepoServerVersionResponse)(nil), "repository.RepoServerVersionResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for RepoServerService service
+
+type RepoServerServiceClient interface {
+	// GenerateManifest generates manifest for application in specified repo name and revision
+	GenerateManifest(ctx context.Context, in *ManifestRequest, opts ...grpc.CallOption) (*ManifestResponse, error)
+	// ListApps returns a list of apps in the repo
+	ListApps(ctx context.Context, in *ListAppsRequest, opts ...grpc.CallOption) (*AppList, error)
+	// Generate manifest for application in specified repo name and revision
+	GetAppDetails(ctx context.Context, in *RepoServerAppDetailsQuery, opts ...grpc.CallOption) (*RepoAppDetailsResponse, error)
+	// Get the meta-data (author, date, tags, message) for a specific revision of the repo
+	GetRevisionMetadata(ctx context.Context, in *RepoServerRevisionMetadataRequest, opts ...grpc.CallOption) (*v1alpha1.RevisionMetadata, error)
+	// Get the commits between a deployed revision and a target revision of the repo
+	GetRevisionChangelog(ctx context.Context, in *RepoServerRevisionChangelogRequest, opts ...grpc.CallOption) (*v1alpha1.RevisionChangelog, error)
+	// SetProfiling enables or disables serving profiles on the metrics port of the repo server
+	SetProfiling(ctx context.Context, in *ProfilingRequest, opts ...grpc.CallOption) (*ProfilingResponse, error)
+	// GetProfile returns or dumps a runtime profile of the repo server
+	GetProfile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*ProfileResponse, error)
+	// GetLocks returns the held repository and checkout locks, to diagnose stuck manifest generations
+	GetLocks(ctx context.Context, in *LocksRequest, opts ...grpc.CallOption) (*LocksResponse, error)
+	// GetVersion returns the version of the repo server and of its tools
+	GetVersion(ctx context.Context, in *RepoServerVersionRequest, opts ...grpc.CallOption) (*RepoServerVersionResponse, error)
+}
+
+type repoServerServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewRepoServerServiceClient(cc *grpc.ClientConn) RepoServerServiceClient {
+	return &repoServerServiceClient{cc}
+}
+
+func (c *repoServerServiceClient) GenerateManifest(ctx context.Context, in *ManifestRequest, opts ...grpc.CallOption) (*ManifestResponse, error) {
+	out := new(ManifestResponse)
+	err := c.cc.Invoke(ctx, "/repository.RepoServerService/GenerateManifest", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *ManifestResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *repoServerServiceClient) ListApps(ctx context.Context, in *ListAppsRequest, opts ...grpc.CallOption) (*AppList, error) {
+	out := new(AppList)
+	err := c.cc.Invoke(ctx, "/repository.RepoServerService/ListApps", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ManifestResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Manifests) > 0 {
-		for _, s := range m.Manifests {
-			dAtA[i] = 0xa
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
+func (c *repoServerServiceClient) GetAppDetails(ctx context.Context, in *RepoServerAppDetailsQuery, opts ...grpc.CallOption) (*RepoAppDetailsResponse, error) {
+	out := new(RepoAppDetailsResponse)
+	err := c.cc.Invoke(ctx, "/repository.RepoServerService/GetAppDetails", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Namespace) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintRepository(dAtA, i, uint64(len(m.Namespace)))
-		i += copy(dAtA[i:], m.Namespace)
+	return out, nil
+}
+
+func (c *repoServerServiceClient) GetRevisionMetadata(ctx context.Context, in *RepoServerRevisionMetadataRequest, opts ...grpc.CallOption) (*v1alpha1.RevisionMetadata, error) {
+	out := new(v1alpha1.RevisionMetadata)
+	err := c.cc.Invoke(ctx, "/repository.RepoServerService/GetRevisionMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Server) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintRepository(dAtA, i, uint64(len(m.Server)))
-		i += copy(dAtA[i:], m.Server)
+	return out, nil
+}
+
+func (c *repoServerServiceClient) GetRevisionChangelog(ctx context.Context, in *RepoServerRevisionChangelogRequest, opts ...grpc.CallOption) (*v1alpha1.RevisionChangelog, error) {
+	out := new(v1alpha1.RevisionChangelog)
+	err := c.cc.Invoke(ctx, "/repository.RepoServerService/GetRevisionChangelog", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Revision) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintRepository(dAtA, i, uint64(len(m.Revision)))
-		i += copy(dAtA[i:], m.Revision)
+	return out, nil
+}
+
+func (c *repoServerServiceClient) SetProfiling(ctx context.Context, in *ProfilingRequest, opts ...grpc.CallOption) (*ProfilingResponse, error) {
+	out := new(ProfilingResponse)
+	err := c.cc.Invoke(ctx, "/repository.RepoServerService/SetProfiling", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.SourceType) > 0 {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintRepository(dAtA, i, uint64(len(m.SourceType)))
-		i += copy(dAtA[i:], m.SourceType)
+	return out, nil
+}
+
+func (c *repoServerServiceClient) GetProfile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*ProfileResponse, error) {
+	out := new(ProfileResponse)
+	err := c.cc.Invoke(ctx, "/repository.RepoServerService/GetProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return out, nil
+}
+
+func (c *repoServerServiceClient) GetLocks(ctx context.Context, in *LocksRequest, opts ...grpc.CallOption) (*LocksResponse, error) {
+	out := new(LocksResponse)
+	err := c.cc.Invoke(ctx, "/repository.RepoServerService/GetLocks", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *ListAppsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *repoServerServiceClient) GetVersion(ctx context.Context, in *RepoServerVersionRequest, opts ...grpc.CallOption) (*RepoServerVersionResponse, error) {
+	out := new(RepoServerVersionResponse)
+	err := c.cc.Invoke(ctx, "/repository.RepoServerService/GetVersion", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ListAppsRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Repo != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRepository(dAtA, i, uint64(m.Repo.Size()))
-		n4, err := m.Repo.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n4
+// Server API for RepoServerService service
+
+type RepoServerServiceServer interface {
+	// GenerateManifest generates manifest for application in specified repo name and revision
+	GenerateManifest(context.Context, *ManifestRequest) (*ManifestResponse, error)
+	// ListApps returns a list of apps in the repo
+	ListApps(context.Context, *ListAppsRequest) (*AppList, error)
+	// Generate manifest for application in specified repo name and revision
+	GetAppDetails(context.Context, *RepoServerAppDetailsQuery) (*RepoAppDetailsResponse, error)
+	// Get the meta-data (author, date, tags, message) for a specific revision of the repo
+	GetRevisionMetadata(context.Context, *RepoServerRevisionMetadataRequest) (*v1alpha1.RevisionMetadata, error)
+	// Get the commits between a deployed revision and a target revision of the repo
+	GetRevisionChangelog(context.Context, *RepoServerRevisionChangelogRequest) (*v1alpha1.RevisionChangelog, error)
+	// SetProfiling enables or disables serving profiles on the metrics port of the repo server
+	SetProfiling(context.Context, *ProfilingRequest) (*ProfilingResponse, error)
+	// GetProfile returns or dumps a runtime profile of the repo server
+	GetProfile(context.Context, *ProfileRequest) (*ProfileResponse, error)
+	// GetLocks returns the held repository and checkout locks, to diagnose stuck manifest generations
+	GetLocks(context.Context, *LocksRequest) (*LocksResponse, error)
+	// GetVersion returns the version of the repo server and of its tools
+	GetVersion(context.Context, *RepoServerVersionRequest) (*RepoServerVersionResponse, error)
+}
+
+func RegisterRepoServerServiceServer(s *grpc.Server, srv RepoServerServiceServer) {
+	s.RegisterService(&_RepoServerService_serviceDesc, srv)
+}
+
+func _RepoServerService_GenerateManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ManifestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Revision) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintRepository(dAtA, i, uint64(len(m.Revision)))
-		i += copy(dAtA[i:], m.Revision)
+	if interceptor == nil {
+		return srv.(RepoServerServiceServer).GenerateManifest(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/repository.RepoServerService/GenerateManifest",
 	}
-	return i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepoServerServiceServer).GenerateManifest(ctx, req.(*ManifestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AppList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _RepoServerService_ListApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAppsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(RepoServerServiceServer).ListApps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/repository.RepoServerService/ListApps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepoServerServiceServer).ListApps(ctx, req.(*ListAppsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AppList) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Apps) > 0 {
-		for k, _ := range m.Apps {
-			dAtA[i] = 0xa
-			i++
-			v := m.Apps[k]
-			mapSize := 1 + len(k) + sovRepository(uint64(len(k))) + 1 + len(v) + sovRepository(uint64(len(v)))
-			i = encodeVarintRepository(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintRepository(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintRepository(dAtA, i, uint64(len(v)))
-			i += copy(dAtA[i:], v)
-		}
+func _RepoServerService_GetAppDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepoServerAppDetailsQuery)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(RepoServerServiceServer).GetAppDetails(ctx, in)
 	}
-	return i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/repository.RepoServerService/GetAppDetails",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepoServerServiceServer).GetAppDetails(ctx, req.(*RepoServerAppDetailsQuery))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RepoServerAppDetailsQuery) Marshal() (dAtA []byte, err error) {
+func _RepoServerService_GetRevisionMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepoServerRevisionMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepoServerServiceServer).GetRevisionMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/repository.RepoServerService/GetRevisionMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepoServerServiceServer).GetRevisionMetadata(ctx, req.(*RepoServerRevisionMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepoServerService_GetRevisionChangelog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepoServerRevisionChangelogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepoServerServiceServer).GetRevisionChangelog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/repository.RepoServerService/GetRevisionChangelog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepoServerServiceServer).GetRevisionChangelog(ctx, req.(*RepoServerRevisionChangelogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepoServerService_SetProfiling_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProfilingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepoServerServiceServer).SetProfiling(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/repository.RepoServerService/SetProfiling",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepoServerServiceServer).SetProfiling(ctx, req.(*ProfilingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepoServerService_GetProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepoServerServiceServer).GetProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/repository.RepoServerService/GetProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepoServerServiceServer).GetProfile(ctx, req.(*ProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepoServerService_GetLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepoServerServiceServer).GetLocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/repository.RepoServerService/GetLocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepoServerServiceServer).GetLocks(ctx, req.(*LocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepoServerService_GetVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepoServerVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepoServerServiceServer).GetVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/repository.RepoServerService/GetVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepoServerServiceServer).GetVersion(ctx, req.(*RepoServerVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _RepoServerService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "repository.RepoServerService",
+	HandlerType: (*RepoServerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GenerateManifest",
+			Handler:    _RepoServerService_GenerateManifest_Handler,
+		},
+		{
+			MethodName: "ListApps",
+			Handler:    _RepoServerService_ListApps_Handler,
+		},
+		{
+			MethodName: "GetAppDetails",
+			Handler:    _RepoServerService_GetAppDetails_Handler,
+		},
+		{
+			MethodName: "GetRevisionMetadata",
+			Handler:    _RepoServerService_GetRevisionMetadata_Handler,
+		},
+		{
+			MethodName: "GetRevisionChangelog",
+			Handler:    _RepoServerService_GetRevisionChangelog_Handler,
+		},
+		{
+			MethodName: "SetProfiling",
+			Handler:    _RepoServerService_SetProfiling_Handler,
+		},
+		{
+			MethodName: "GetProfile",
+			Handler:    _RepoServerService_GetProfile_Handler,
+		},
+		{
+			MethodName: "GetLocks",
+			Handler:    _RepoServerService_GetLocks_Handler,
+		},
+		{
+			MethodName: "GetVersion",
+			Handler:    _RepoServerService_GetVersion_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "reposerver/repository/repository.proto",
+}
+
+func (m *ManifestRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1501,7 +2108,7 @@ func (m *RepoServerAppDetailsQuery) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RepoServerAppDetailsQuery) MarshalTo(dAtA []byte) (int, error) {
+func (m *ManifestRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -1510,11 +2117,11 @@ func (m *RepoServerAppDetailsQuery) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRepository(dAtA, i, uint64(m.Repo.Size()))
-		n5, err := m.Repo.MarshalTo(dAtA[i:])
+		n1, err := m.Repo.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n5
+		i += n1
 	}
 	if len(m.Revision) > 0 {
 		dAtA[i] = 0x12
@@ -1522,27 +2129,59 @@ func (m *RepoServerAppDetailsQuery) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintRepository(dAtA, i, uint64(len(m.Revision)))
 		i += copy(dAtA[i:], m.Revision)
 	}
-	if len(m.App) > 0 {
-		dAtA[i] = 0x1a
+	if m.NoCache {
+		dAtA[i] = 0x18
 		i++
-		i = encodeVarintRepository(dAtA, i, uint64(len(m.App)))
-		i += copy(dAtA[i:], m.App)
-	}
-	if len(m.Repos) > 0 {
-		for _, msg := range m.Repos {
-			dAtA[i] = 0x22
-			i++
-			i = encodeVarintRepository(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+		if m.NoCache {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i++
 	}
-	if len(m.Plugins) > 0 {
+	if len(m.AppLabelKey) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.AppLabelKey)))
+		i += copy(dAtA[i:], m.AppLabelKey)
+	}
+	if len(m.AppLabelValue) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.AppLabelValue)))
+		i += copy(dAtA[i:], m.AppLabelValue)
+	}
+	if len(m.Namespace) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Namespace)))
+		i += copy(dAtA[i:], m.Namespace)
+	}
+	if m.ApplicationSource != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(m.ApplicationSource.Size()))
+		n2, err := m.ApplicationSource.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if len(m.Repos) > 0 {
+		for _, msg := range m.Repos {
+			dAtA[i] = 0x5a
+			i++
+			i = encodeVarintRepository(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Plugins) > 0 {
 		for _, msg := range m.Plugins {
-			dAtA[i] = 0x2a
+			dAtA[i] = 0x62
 			i++
 			i = encodeVarintRepository(dAtA, i, uint64(msg.Size()))
 			n, err := msg.MarshalTo(dAtA[i:])
@@ -1552,35 +2191,98 @@ func (m *RepoServerAppDetailsQuery) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
-	if m.Helm != nil {
-		dAtA[i] = 0x32
+	if m.KustomizeOptions != nil {
+		dAtA[i] = 0x6a
 		i++
-		i = encodeVarintRepository(dAtA, i, uint64(m.Helm.Size()))
-		n6, err := m.Helm.MarshalTo(dAtA[i:])
+		i = encodeVarintRepository(dAtA, i, uint64(m.KustomizeOptions.Size()))
+		n3, err := m.KustomizeOptions.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n6
+		i += n3
 	}
-	if m.Ksonnet != nil {
-		dAtA[i] = 0x3a
+	if len(m.KubeVersion) > 0 {
+		dAtA[i] = 0x72
 		i++
-		i = encodeVarintRepository(dAtA, i, uint64(m.Ksonnet.Size()))
-		n7, err := m.Ksonnet.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.KubeVersion)))
+		i += copy(dAtA[i:], m.KubeVersion)
+	}
+	if len(m.DecryptionKeys) > 0 {
+		for _, s := range m.DecryptionKeys {
+			dAtA[i] = 0x7a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
-		i += n7
 	}
-	if m.KustomizeOptions != nil {
-		dAtA[i] = 0x42
+	if len(m.ParameterOverridesFile) > 0 {
+		dAtA[i] = 0x82
 		i++
-		i = encodeVarintRepository(dAtA, i, uint64(m.KustomizeOptions.Size()))
-		n8, err := m.KustomizeOptions.MarshalTo(dAtA[i:])
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.ParameterOverridesFile)))
+		i += copy(dAtA[i:], m.ParameterOverridesFile)
+	}
+	if len(m.ManifestGeneratePaths) > 0 {
+		for _, s := range m.ManifestGeneratePaths {
+			dAtA[i] = 0x8a
+			i++
+			dAtA[i] = 0x1
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.PreviousRevision) > 0 {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.PreviousRevision)))
+		i += copy(dAtA[i:], m.PreviousRevision)
+	}
+	if len(m.ApiVersions) > 0 {
+		for _, s := range m.ApiVersions {
+			dAtA[i] = 0x9a
+			i++
+			dAtA[i] = 0x1
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.ManifestQuota != nil {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(m.ManifestQuota.Size()))
+		n4, err := m.ManifestQuota.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n8
+		i += n4
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -1588,7 +2290,7 @@ func (m *RepoServerAppDetailsQuery) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *HelmAppDetailsQuery) Marshal() (dAtA []byte, err error) {
+func (m *ManifestResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1598,13 +2300,13 @@ func (m *HelmAppDetailsQuery) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HelmAppDetailsQuery) MarshalTo(dAtA []byte) (int, error) {
+func (m *ManifestResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.ValueFiles) > 0 {
-		for _, s := range m.ValueFiles {
+	if len(m.Manifests) > 0 {
+		for _, s := range m.Manifests {
 			dAtA[i] = 0xa
 			i++
 			l = len(s)
@@ -1618,13 +2320,59 @@ func (m *HelmAppDetailsQuery) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
+	if len(m.Namespace) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Namespace)))
+		i += copy(dAtA[i:], m.Namespace)
+	}
+	if len(m.Server) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Server)))
+		i += copy(dAtA[i:], m.Server)
+	}
+	if len(m.Revision) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Revision)))
+		i += copy(dAtA[i:], m.Revision)
+	}
+	if len(m.SourceType) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.SourceType)))
+		i += copy(dAtA[i:], m.SourceType)
+	}
+	if m.Stale {
+		dAtA[i] = 0x38
+		i++
+		if m.Stale {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.RenderedSources) > 0 {
+		for _, msg := range m.RenderedSources {
+			dAtA[i] = 0x42
+			i++
+			i = encodeVarintRepository(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *KsonnetAppDetailsQuery) Marshal() (dAtA []byte, err error) {
+func (m *ListAppsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1634,16 +2382,26 @@ func (m *KsonnetAppDetailsQuery) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *KsonnetAppDetailsQuery) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListAppsRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Environment) > 0 {
+	if m.Repo != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintRepository(dAtA, i, uint64(len(m.Environment)))
-		i += copy(dAtA[i:], m.Environment)
+		i = encodeVarintRepository(dAtA, i, uint64(m.Repo.Size()))
+		n5, err := m.Repo.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if len(m.Revision) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Revision)))
+		i += copy(dAtA[i:], m.Revision)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -1651,7 +2409,7 @@ func (m *KsonnetAppDetailsQuery) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *RepoAppDetailsResponse) Marshal() (dAtA []byte, err error) {
+func (m *AppList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1661,56 +2419,27 @@ func (m *RepoAppDetailsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RepoAppDetailsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *AppList) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Type) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRepository(dAtA, i, uint64(len(m.Type)))
-		i += copy(dAtA[i:], m.Type)
-	}
-	if m.Ksonnet != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintRepository(dAtA, i, uint64(m.Ksonnet.Size()))
-		n9, err := m.Ksonnet.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n9
-	}
-	if m.Helm != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintRepository(dAtA, i, uint64(m.Helm.Size()))
-		n10, err := m.Helm.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n10
-	}
-	if m.Kustomize != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintRepository(dAtA, i, uint64(m.Kustomize.Size()))
-		n11, err := m.Kustomize.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n11
-	}
-	if m.Directory != nil {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintRepository(dAtA, i, uint64(m.Directory.Size()))
-		n12, err := m.Directory.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	if len(m.Apps) > 0 {
+		for k, _ := range m.Apps {
+			dAtA[i] = 0xa
+			i++
+			v := m.Apps[k]
+			mapSize := 1 + len(k) + sovRepository(uint64(len(k))) + 1 + len(v) + sovRepository(uint64(len(v)))
+			i = encodeVarintRepository(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintRepository(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintRepository(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
 		}
-		i += n12
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -1718,7 +2447,7 @@ func (m *RepoAppDetailsResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *RepoServerRevisionMetadataRequest) Marshal() (dAtA []byte, err error) {
+func (m *RepoServerAppDetailsQuery) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1728,7 +2457,7 @@ func (m *RepoServerRevisionMetadataRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RepoServerRevisionMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RepoServerAppDetailsQuery) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -1737,82 +2466,39 @@ func (m *RepoServerRevisionMetadataRequest) MarshalTo(dAtA []byte) (int, error)
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRepository(dAtA, i, uint64(m.Repo.Size()))
-		n13, err := m.Repo.MarshalTo(dAtA[i:])
+		n6, err := m.Repo.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n13
+		i += n6
 	}
-	if len(m.App) > 0 {
+	if len(m.Revision) > 0 {
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintRepository(dAtA, i, uint64(len(m.App)))
-		i += copy(dAtA[i:], m.App)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Revision)))
+		i += copy(dAtA[i:], m.Revision)
 	}
-	if len(m.Revision) > 0 {
+	if len(m.App) > 0 {
 		dAtA[i] = 0x1a
 		i++
-		i = encodeVarintRepository(dAtA, i, uint64(len(m.Revision)))
-		i += copy(dAtA[i:], m.Revision)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.App)))
+		i += copy(dAtA[i:], m.App)
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
-}
-
-func (m *KsonnetAppSpec) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *KsonnetAppSpec) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRepository(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
-	}
-	if len(m.Environments) > 0 {
-		for k, _ := range m.Environments {
-			dAtA[i] = 0x1a
-			i++
-			v := m.Environments[k]
-			msgSize := 0
-			if v != nil {
-				msgSize = v.Size()
-				msgSize += 1 + sovRepository(uint64(msgSize))
-			}
-			mapSize := 1 + len(k) + sovRepository(uint64(len(k))) + msgSize
-			i = encodeVarintRepository(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
+	if len(m.Repos) > 0 {
+		for _, msg := range m.Repos {
+			dAtA[i] = 0x22
 			i++
-			i = encodeVarintRepository(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			if v != nil {
-				dAtA[i] = 0x12
-				i++
-				i = encodeVarintRepository(dAtA, i, uint64(v.Size()))
-				n14, err := v.MarshalTo(dAtA[i:])
-				if err != nil {
-					return 0, err
-				}
-				i += n14
+			i = encodeVarintRepository(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
 			}
+			i += n
 		}
 	}
-	if len(m.Parameters) > 0 {
-		for _, msg := range m.Parameters {
-			dAtA[i] = 0x22
+	if len(m.Plugins) > 0 {
+		for _, msg := range m.Plugins {
+			dAtA[i] = 0x2a
 			i++
 			i = encodeVarintRepository(dAtA, i, uint64(msg.Size()))
 			n, err := msg.MarshalTo(dAtA[i:])
@@ -1822,13 +2508,43 @@ func (m *KsonnetAppSpec) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.Helm != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(m.Helm.Size()))
+		n7, err := m.Helm.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	if m.Ksonnet != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(m.Ksonnet.Size()))
+		n8, err := m.Ksonnet.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
+	if m.KustomizeOptions != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(m.KustomizeOptions.Size()))
+		n9, err := m.KustomizeOptions.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *HelmAppSpec) Marshal() (dAtA []byte, err error) {
+func (m *HelmAppDetailsQuery) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1838,20 +2554,14 @@ func (m *HelmAppSpec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HelmAppSpec) MarshalTo(dAtA []byte) (int, error) {
+func (m *HelmAppDetailsQuery) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Name) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRepository(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
-	}
 	if len(m.ValueFiles) > 0 {
 		for _, s := range m.ValueFiles {
-			dAtA[i] = 0x1a
+			dAtA[i] = 0xa
 			i++
 			l = len(s)
 			for l >= 1<<7 {
@@ -1864,23 +2574,15 @@ func (m *HelmAppSpec) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
-	if len(m.Parameters) > 0 {
-		for _, msg := range m.Parameters {
-			dAtA[i] = 0x22
-			i++
-			i = encodeVarintRepository(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+	if m.AllowValueFilesOutsideChart {
+		dAtA[i] = 0x10
+		i++
+		if m.AllowValueFilesOutsideChart {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-	}
-	if len(m.Values) > 0 {
-		dAtA[i] = 0x2a
 		i++
-		i = encodeVarintRepository(dAtA, i, uint64(len(m.Values)))
-		i += copy(dAtA[i:], m.Values)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -1888,7 +2590,7 @@ func (m *HelmAppSpec) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *KustomizeAppSpec) Marshal() (dAtA []byte, err error) {
+func (m *KsonnetAppDetailsQuery) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1898,25 +2600,16 @@ func (m *KustomizeAppSpec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *KustomizeAppSpec) MarshalTo(dAtA []byte) (int, error) {
+func (m *KsonnetAppDetailsQuery) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Images) > 0 {
-		for _, s := range m.Images {
-			dAtA[i] = 0x1a
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
+	if len(m.Environment) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Environment)))
+		i += copy(dAtA[i:], m.Environment)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -1924,7 +2617,7 @@ func (m *KustomizeAppSpec) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *KsonnetEnvironment) Marshal() (dAtA []byte, err error) {
+func (m *RepoAppDetailsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1934,32 +2627,56 @@ func (m *KsonnetEnvironment) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *KsonnetEnvironment) MarshalTo(dAtA []byte) (int, error) {
+func (m *RepoAppDetailsResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Name) > 0 {
+	if len(m.Type) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintRepository(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Type)))
+		i += copy(dAtA[i:], m.Type)
 	}
-	if len(m.K8SVersion) > 0 {
+	if m.Ksonnet != nil {
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintRepository(dAtA, i, uint64(len(m.K8SVersion)))
-		i += copy(dAtA[i:], m.K8SVersion)
+		i = encodeVarintRepository(dAtA, i, uint64(m.Ksonnet.Size()))
+		n10, err := m.Ksonnet.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
 	}
-	if m.Destination != nil {
+	if m.Helm != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(m.Helm.Size()))
+		n11, err := m.Helm.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
+	}
+	if m.Kustomize != nil {
 		dAtA[i] = 0x22
 		i++
-		i = encodeVarintRepository(dAtA, i, uint64(m.Destination.Size()))
-		n15, err := m.Destination.MarshalTo(dAtA[i:])
+		i = encodeVarintRepository(dAtA, i, uint64(m.Kustomize.Size()))
+		n12, err := m.Kustomize.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n15
+		i += n12
+	}
+	if m.Directory != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(m.Directory.Size()))
+		n13, err := m.Directory.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n13
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -1967,7 +2684,7 @@ func (m *KsonnetEnvironment) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *KsonnetEnvironmentDestination) Marshal() (dAtA []byte, err error) {
+func (m *RepoServerRevisionMetadataRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1977,22 +2694,42 @@ func (m *KsonnetEnvironmentDestination) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *KsonnetEnvironmentDestination) MarshalTo(dAtA []byte) (int, error) {
+func (m *RepoServerRevisionMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Server) > 0 {
+	if m.Repo != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintRepository(dAtA, i, uint64(len(m.Server)))
-		i += copy(dAtA[i:], m.Server)
+		i = encodeVarintRepository(dAtA, i, uint64(m.Repo.Size()))
+		n14, err := m.Repo.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
 	}
-	if len(m.Namespace) > 0 {
+	if len(m.App) > 0 {
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintRepository(dAtA, i, uint64(len(m.Namespace)))
-		i += copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.App)))
+		i += copy(dAtA[i:], m.App)
+	}
+	if len(m.Revision) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Revision)))
+		i += copy(dAtA[i:], m.Revision)
+	}
+	if m.Full {
+		dAtA[i] = 0x20
+		i++
+		if m.Full {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -2000,7 +2737,7 @@ func (m *KsonnetEnvironmentDestination) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *DirectoryAppSpec) Marshal() (dAtA []byte, err error) {
+func (m *RepoServerRevisionChangelogRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2010,407 +2747,2326 @@ func (m *DirectoryAppSpec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DirectoryAppSpec) MarshalTo(dAtA []byte) (int, error) {
+func (m *RepoServerRevisionChangelogRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
+	if m.Repo != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(m.Repo.Size()))
+		n15, err := m.Repo.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n15
+	}
+	if len(m.App) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.App)))
+		i += copy(dAtA[i:], m.App)
+	}
+	if len(m.Revision) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Revision)))
+		i += copy(dAtA[i:], m.Revision)
+	}
+	if len(m.TargetRevision) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.TargetRevision)))
+		i += copy(dAtA[i:], m.TargetRevision)
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func encodeVarintRepository(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *KsonnetAppSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
 }
-func (m *ManifestRequest) Size() (n int) {
+
+func (m *KsonnetAppSpec) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovRepository(uint64(l))
-	}
-	l = len(m.Revision)
-	if l > 0 {
-		n += 1 + l + sovRepository(uint64(l))
-	}
-	if m.NoCache {
-		n += 2
-	}
-	l = len(m.AppLabelKey)
-	if l > 0 {
-		n += 1 + l + sovRepository(uint64(l))
-	}
-	l = len(m.AppLabelValue)
-	if l > 0 {
-		n += 1 + l + sovRepository(uint64(l))
-	}
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovRepository(uint64(l))
-	}
-	if m.ApplicationSource != nil {
-		l = m.ApplicationSource.Size()
-		n += 1 + l + sovRepository(uint64(l))
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
-	if len(m.Repos) > 0 {
-		for _, e := range m.Repos {
-			l = e.Size()
-			n += 1 + l + sovRepository(uint64(l))
+	if len(m.Environments) > 0 {
+		for k, _ := range m.Environments {
+			dAtA[i] = 0x1a
+			i++
+			v := m.Environments[k]
+			msgSize := 0
+			if v != nil {
+				msgSize = v.Size()
+				msgSize += 1 + sovRepository(uint64(msgSize))
+			}
+			mapSize := 1 + len(k) + sovRepository(uint64(len(k))) + msgSize
+			i = encodeVarintRepository(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintRepository(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			if v != nil {
+				dAtA[i] = 0x12
+				i++
+				i = encodeVarintRepository(dAtA, i, uint64(v.Size()))
+				n16, err := v.MarshalTo(dAtA[i:])
+				if err != nil {
+					return 0, err
+				}
+				i += n16
+			}
 		}
 	}
-	if len(m.Plugins) > 0 {
-		for _, e := range m.Plugins {
-			l = e.Size()
-			n += 1 + l + sovRepository(uint64(l))
+	if len(m.Parameters) > 0 {
+		for _, msg := range m.Parameters {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintRepository(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	if m.KustomizeOptions != nil {
-		l = m.KustomizeOptions.Size()
-		n += 1 + l + sovRepository(uint64(l))
-	}
-	l = len(m.KubeVersion)
-	if l > 0 {
-		n += 1 + l + sovRepository(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ManifestResponse) Size() (n int) {
+func (m *HelmAppSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HelmAppSpec) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Manifests) > 0 {
-		for _, s := range m.Manifests {
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.ValueFiles) > 0 {
+		for _, s := range m.ValueFiles {
+			dAtA[i] = 0x1a
+			i++
 			l = len(s)
-			n += 1 + l + sovRepository(uint64(l))
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
 	}
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovRepository(uint64(l))
-	}
-	l = len(m.Server)
-	if l > 0 {
-		n += 1 + l + sovRepository(uint64(l))
+	if len(m.Parameters) > 0 {
+		for _, msg := range m.Parameters {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintRepository(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	l = len(m.Revision)
-	if l > 0 {
-		n += 1 + l + sovRepository(uint64(l))
+	if len(m.Values) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Values)))
+		i += copy(dAtA[i:], m.Values)
 	}
-	l = len(m.SourceType)
-	if l > 0 {
-		n += 1 + l + sovRepository(uint64(l))
+	if m.HasCrds {
+		dAtA[i] = 0x30
+		i++
+		if m.HasCrds {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ListAppsRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovRepository(uint64(l))
-	}
-	l = len(m.Revision)
-	if l > 0 {
-		n += 1 + l + sovRepository(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *KustomizeAppSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AppList) Size() (n int) {
+func (m *KustomizeAppSpec) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Apps) > 0 {
-		for k, v := range m.Apps {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovRepository(uint64(len(k))) + 1 + len(v) + sovRepository(uint64(len(v)))
-			n += mapEntrySize + 1 + sovRepository(uint64(mapEntrySize))
+	if len(m.Images) > 0 {
+		for _, s := range m.Images {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *RepoServerAppDetailsQuery) Size() (n int) {
+func (m *KsonnetEnvironment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KsonnetEnvironment) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovRepository(uint64(l))
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
-	l = len(m.Revision)
-	if l > 0 {
-		n += 1 + l + sovRepository(uint64(l))
+	if len(m.K8SVersion) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.K8SVersion)))
+		i += copy(dAtA[i:], m.K8SVersion)
 	}
-	l = len(m.App)
-	if l > 0 {
-		n += 1 + l + sovRepository(uint64(l))
-	}
-	if len(m.Repos) > 0 {
-		for _, e := range m.Repos {
-			l = e.Size()
-			n += 1 + l + sovRepository(uint64(l))
-		}
-	}
-	if len(m.Plugins) > 0 {
-		for _, e := range m.Plugins {
-			l = e.Size()
-			n += 1 + l + sovRepository(uint64(l))
-		}
-	}
-	if m.Helm != nil {
-		l = m.Helm.Size()
-		n += 1 + l + sovRepository(uint64(l))
-	}
-	if m.Ksonnet != nil {
-		l = m.Ksonnet.Size()
-		n += 1 + l + sovRepository(uint64(l))
-	}
-	if m.KustomizeOptions != nil {
-		l = m.KustomizeOptions.Size()
-		n += 1 + l + sovRepository(uint64(l))
+	if m.Destination != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(m.Destination.Size()))
+		n17, err := m.Destination.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n17
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *HelmAppDetailsQuery) Size() (n int) {
+func (m *KsonnetEnvironmentDestination) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KsonnetEnvironmentDestination) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.ValueFiles) > 0 {
-		for _, s := range m.ValueFiles {
-			l = len(s)
-			n += 1 + l + sovRepository(uint64(l))
-		}
+	if len(m.Server) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Server)))
+		i += copy(dAtA[i:], m.Server)
+	}
+	if len(m.Namespace) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Namespace)))
+		i += copy(dAtA[i:], m.Namespace)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *KsonnetAppDetailsQuery) Size() (n int) {
+func (m *DirectoryAppSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DirectoryAppSpec) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Environment)
-	if l > 0 {
-		n += 1 + l + sovRepository(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *RepoAppDetailsResponse) Size() (n int) {
+func (m *ProfilingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProfilingRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovRepository(uint64(l))
-	}
-	if m.Ksonnet != nil {
-		l = m.Ksonnet.Size()
-		n += 1 + l + sovRepository(uint64(l))
-	}
-	if m.Helm != nil {
-		l = m.Helm.Size()
-		n += 1 + l + sovRepository(uint64(l))
-	}
-	if m.Kustomize != nil {
-		l = m.Kustomize.Size()
-		n += 1 + l + sovRepository(uint64(l))
-	}
-	if m.Directory != nil {
-		l = m.Directory.Size()
-		n += 1 + l + sovRepository(uint64(l))
+	if m.Enabled {
+		dAtA[i] = 0x8
+		i++
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *RepoServerRevisionMetadataRequest) Size() (n int) {
+func (m *ProfilingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProfilingResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovRepository(uint64(l))
-	}
-	l = len(m.App)
-	if l > 0 {
-		n += 1 + l + sovRepository(uint64(l))
-	}
-	l = len(m.Revision)
-	if l > 0 {
-		n += 1 + l + sovRepository(uint64(l))
+	if m.Enabled {
+		dAtA[i] = 0x8
+		i++
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *KsonnetAppSpec) Size() (n int) {
+func (m *ProfileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProfileRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovRepository(uint64(l))
-	}
-	if len(m.Environments) > 0 {
-		for k, v := range m.Environments {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovRepository(uint64(l))
-			}
-			mapEntrySize := 1 + len(k) + sovRepository(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovRepository(uint64(mapEntrySize))
-		}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
-	if len(m.Parameters) > 0 {
-		for _, e := range m.Parameters {
-			l = e.Size()
-			n += 1 + l + sovRepository(uint64(l))
+	if m.Dump {
+		dAtA[i] = 0x10
+		i++
+		if m.Dump {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i++
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *HelmAppSpec) Size() (n int) {
+func (m *ProfileResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProfileResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovRepository(uint64(l))
-	}
-	if len(m.ValueFiles) > 0 {
-		for _, s := range m.ValueFiles {
-			l = len(s)
-			n += 1 + l + sovRepository(uint64(l))
-		}
-	}
-	if len(m.Parameters) > 0 {
-		for _, e := range m.Parameters {
-			l = e.Size()
-			n += 1 + l + sovRepository(uint64(l))
-		}
+	if len(m.Data) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
 	}
-	l = len(m.Values)
-	if l > 0 {
-		n += 1 + l + sovRepository(uint64(l))
+	if len(m.Path) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Path)))
+		i += copy(dAtA[i:], m.Path)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *KustomizeAppSpec) Size() (n int) {
+func (m *LocksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LocksRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Images) > 0 {
-		for _, s := range m.Images {
-			l = len(s)
-			n += 1 + l + sovRepository(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Lock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Lock) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if len(m.Owner) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Owner)))
+		i += copy(dAtA[i:], m.Owner)
+	}
+	if m.HeldSeconds != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(m.HeldSeconds))
+	}
+	if m.Waiting != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(m.Waiting))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *LocksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LocksResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Locks) > 0 {
+		for _, msg := range m.Locks {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintRepository(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RepoServerVersionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RepoServerVersionRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RepoServerVersionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RepoServerVersionResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Version) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Version)))
+		i += copy(dAtA[i:], m.Version)
+	}
+	if len(m.HelmVersion) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.HelmVersion)))
+		i += copy(dAtA[i:], m.HelmVersion)
+	}
+	if len(m.KustomizeVersion) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.KustomizeVersion)))
+		i += copy(dAtA[i:], m.KustomizeVersion)
+	}
+	if len(m.KsonnetVersion) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.KsonnetVersion)))
+		i += copy(dAtA[i:], m.KsonnetVersion)
+	}
+	if len(m.GitVersion) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.GitVersion)))
+		i += copy(dAtA[i:], m.GitVersion)
+	}
+	if len(m.Features) > 0 {
+		for _, s := range m.Features {
+			dAtA[i] = 0x32
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func encodeVarintRepository(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *ManifestRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Revision)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.NoCache {
+		n += 2
+	}
+	l = len(m.AppLabelKey)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.AppLabelValue)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.ApplicationSource != nil {
+		l = m.ApplicationSource.Size()
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if len(m.Repos) > 0 {
+		for _, e := range m.Repos {
+			l = e.Size()
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if len(m.Plugins) > 0 {
+		for _, e := range m.Plugins {
+			l = e.Size()
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if m.KustomizeOptions != nil {
+		l = m.KustomizeOptions.Size()
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.KubeVersion)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if len(m.DecryptionKeys) > 0 {
+		for _, s := range m.DecryptionKeys {
+			l = len(s)
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	l = len(m.ParameterOverridesFile)
+	if l > 0 {
+		n += 2 + l + sovRepository(uint64(l))
+	}
+	if len(m.ManifestGeneratePaths) > 0 {
+		for _, s := range m.ManifestGeneratePaths {
+			l = len(s)
+			n += 2 + l + sovRepository(uint64(l))
+		}
+	}
+	l = len(m.PreviousRevision)
+	if l > 0 {
+		n += 2 + l + sovRepository(uint64(l))
+	}
+	if len(m.ApiVersions) > 0 {
+		for _, s := range m.ApiVersions {
+			l = len(s)
+			n += 2 + l + sovRepository(uint64(l))
+		}
+	}
+	if m.ManifestQuota != nil {
+		l = m.ManifestQuota.Size()
+		n += 2 + l + sovRepository(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ManifestResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Manifests) > 0 {
+		for _, s := range m.Manifests {
+			l = len(s)
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Server)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Revision)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.SourceType)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.Stale {
+		n += 2
+	}
+	if len(m.RenderedSources) > 0 {
+		for _, e := range m.RenderedSources {
+			l = e.Size()
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListAppsRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Revision)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AppList) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Apps) > 0 {
+		for k, v := range m.Apps {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovRepository(uint64(len(k))) + 1 + len(v) + sovRepository(uint64(len(v)))
+			n += mapEntrySize + 1 + sovRepository(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RepoServerAppDetailsQuery) Size() (n int) {
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Revision)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.App)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if len(m.Repos) > 0 {
+		for _, e := range m.Repos {
+			l = e.Size()
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if len(m.Plugins) > 0 {
+		for _, e := range m.Plugins {
+			l = e.Size()
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if m.Helm != nil {
+		l = m.Helm.Size()
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.Ksonnet != nil {
+		l = m.Ksonnet.Size()
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.KustomizeOptions != nil {
+		l = m.KustomizeOptions.Size()
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HelmAppDetailsQuery) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.ValueFiles) > 0 {
+		for _, s := range m.ValueFiles {
+			l = len(s)
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if m.AllowValueFilesOutsideChart {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *KsonnetAppDetailsQuery) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Environment)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RepoAppDetailsResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.Ksonnet != nil {
+		l = m.Ksonnet.Size()
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.Helm != nil {
+		l = m.Helm.Size()
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.Kustomize != nil {
+		l = m.Kustomize.Size()
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.Directory != nil {
+		l = m.Directory.Size()
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RepoServerRevisionMetadataRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.App)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Revision)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.Full {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RepoServerRevisionChangelogRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.App)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Revision)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.TargetRevision)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *KsonnetAppSpec) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if len(m.Environments) > 0 {
+		for k, v := range m.Environments {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovRepository(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovRepository(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovRepository(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Parameters) > 0 {
+		for _, e := range m.Parameters {
+			l = e.Size()
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HelmAppSpec) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if len(m.ValueFiles) > 0 {
+		for _, s := range m.ValueFiles {
+			l = len(s)
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if len(m.Parameters) > 0 {
+		for _, e := range m.Parameters {
+			l = e.Size()
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	l = len(m.Values)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.HasCrds {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *KustomizeAppSpec) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Images) > 0 {
+		for _, s := range m.Images {
+			l = len(s)
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *KsonnetEnvironment) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.K8SVersion)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.Destination != nil {
+		l = m.Destination.Size()
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *KsonnetEnvironmentDestination) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Server)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DirectoryAppSpec) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProfilingRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProfilingResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProfileRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.Dump {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProfileResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LocksRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Lock) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.HeldSeconds != 0 {
+		n += 1 + sovRepository(uint64(m.HeldSeconds))
+	}
+	if m.Waiting != 0 {
+		n += 1 + sovRepository(uint64(m.Waiting))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LocksResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Locks) > 0 {
+		for _, e := range m.Locks {
+			l = e.Size()
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RepoServerVersionRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RepoServerVersionResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.HelmVersion)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.KustomizeVersion)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.KsonnetVersion)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.GitVersion)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if len(m.Features) > 0 {
+		for _, s := range m.Features {
+			l = len(s)
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovRepository(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozRepository(x uint64) (n int) {
+	return sovRepository(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ManifestRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ManifestRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ManifestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &v1alpha1.Repository{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoCache", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NoCache = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppLabelKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppLabelKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppLabelValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppLabelValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationSource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ApplicationSource == nil {
+				m.ApplicationSource = &v1alpha1.ApplicationSource{}
+			}
+			if err := m.ApplicationSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repos = append(m.Repos, &v1alpha1.Repository{})
+			if err := m.Repos[len(m.Repos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plugins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Plugins = append(m.Plugins, &v1alpha1.ConfigManagementPlugin{})
+			if err := m.Plugins[len(m.Plugins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KustomizeOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KustomizeOptions == nil {
+				m.KustomizeOptions = &v1alpha1.KustomizeOptions{}
+			}
+			if err := m.KustomizeOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubeVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KubeVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecryptionKeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DecryptionKeys = append(m.DecryptionKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParameterOverridesFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParameterOverridesFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManifestGeneratePaths", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ManifestGeneratePaths = append(m.ManifestGeneratePaths, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousRevision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousRevision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiVersions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiVersions = append(m.ApiVersions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManifestQuota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ManifestQuota == nil {
+				m.ManifestQuota = &v1alpha1.ManifestQuota{}
+			}
+			if err := m.ManifestQuota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ManifestResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ManifestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ManifestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manifests", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Manifests = append(m.Manifests, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Server = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stale", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stale = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenderedSources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RenderedSources = append(m.RenderedSources, v1alpha1.RenderedSource{})
+			if err := m.RenderedSources[len(m.RenderedSources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListAppsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListAppsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListAppsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &v1alpha1.Repository{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AppList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Apps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Apps == nil {
+				m.Apps = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRepository
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRepository
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthRepository
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRepository(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthRepository
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Apps[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *KsonnetEnvironment) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovRepository(uint64(l))
-	}
-	l = len(m.K8SVersion)
-	if l > 0 {
-		n += 1 + l + sovRepository(uint64(l))
-	}
-	if m.Destination != nil {
-		l = m.Destination.Size()
-		n += 1 + l + sovRepository(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *KsonnetEnvironmentDestination) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Server)
-	if l > 0 {
-		n += 1 + l + sovRepository(uint64(l))
-	}
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovRepository(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *DirectoryAppSpec) Size() (n int) {
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
 
-func sovRepository(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-func sozRepository(x uint64) (n int) {
-	return sovRepository(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *ManifestRequest) Unmarshal(dAtA []byte) error {
+func (m *RepoServerAppDetailsQuery) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2433,15 +5089,168 @@ func (m *ManifestRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ManifestRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RepoServerAppDetailsQuery: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ManifestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RepoServerAppDetailsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &v1alpha1.Repository{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field App", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.App = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repos = append(m.Repos, &v1alpha1.Repository{})
+			if err := m.Repos[len(m.Repos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plugins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Plugins = append(m.Plugins, &v1alpha1.ConfigManagementPlugin{})
+			if err := m.Plugins[len(m.Plugins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Helm", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2465,18 +5274,18 @@ func (m *ManifestRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &v1alpha1.Repository{}
+			if m.Helm == nil {
+				m.Helm = &HelmAppDetailsQuery{}
 			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Helm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ksonnet", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -2486,26 +5295,30 @@ func (m *ManifestRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
+			if m.Ksonnet == nil {
+				m.Ksonnet = &KsonnetAppDetailsQuery{}
+			}
+			if err := m.Ksonnet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoCache", wireType)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KustomizeOptions", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -2515,15 +5328,79 @@ func (m *ManifestRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.NoCache = bool(v != 0)
-		case 4:
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KustomizeOptions == nil {
+				m.KustomizeOptions = &v1alpha1.KustomizeOptions{}
+			}
+			if err := m.KustomizeOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HelmAppDetailsQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HelmAppDetailsQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HelmAppDetailsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppLabelKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueFiles", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2548,13 +5425,13 @@ func (m *ManifestRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AppLabelKey = string(dAtA[iNdEx:postIndex])
+			m.ValueFiles = append(m.ValueFiles, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppLabelValue", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowValueFilesOutsideChart", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -2564,24 +5441,66 @@ func (m *ManifestRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			m.AllowValueFilesOutsideChart = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AppLabelValue = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KsonnetAppDetailsQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KsonnetAppDetailsQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KsonnetAppDetailsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Environment", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2606,13 +5525,64 @@ func (m *ManifestRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Environment = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepoAppDetailsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepoAppDetailsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepoAppDetailsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationSource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -2622,28 +5592,24 @@ func (m *ManifestRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ApplicationSource == nil {
-				m.ApplicationSource = &v1alpha1.ApplicationSource{}
-			}
-			if err := m.ApplicationSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ksonnet", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2667,14 +5633,16 @@ func (m *ManifestRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Repos = append(m.Repos, &v1alpha1.Repository{})
-			if err := m.Repos[len(m.Repos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Ksonnet == nil {
+				m.Ksonnet = &KsonnetAppSpec{}
+			}
+			if err := m.Ksonnet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Plugins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Helm", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2698,14 +5666,16 @@ func (m *ManifestRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Plugins = append(m.Plugins, &v1alpha1.ConfigManagementPlugin{})
-			if err := m.Plugins[len(m.Plugins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Helm == nil {
+				m.Helm = &HelmAppSpec{}
+			}
+			if err := m.Helm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KustomizeOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kustomize", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2729,18 +5699,18 @@ func (m *ManifestRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KustomizeOptions == nil {
-				m.KustomizeOptions = &v1alpha1.KustomizeOptions{}
+			if m.Kustomize == nil {
+				m.Kustomize = &KustomizeAppSpec{}
 			}
-			if err := m.KustomizeOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Kustomize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KubeVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Directory", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -2750,20 +5720,24 @@ func (m *ManifestRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KubeVersion = string(dAtA[iNdEx:postIndex])
+			if m.Directory == nil {
+				m.Directory = &DirectoryAppSpec{}
+			}
+			if err := m.Directory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2787,7 +5761,7 @@ func (m *ManifestRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ManifestResponse) Unmarshal(dAtA []byte) error {
+func (m *RepoServerRevisionMetadataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2810,17 +5784,17 @@ func (m *ManifestResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ManifestResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RepoServerRevisionMetadataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ManifestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RepoServerRevisionMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Manifests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -2830,53 +5804,28 @@ func (m *ManifestResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Manifests = append(m.Manifests, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRepository
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRepository
+			if m.Repo == nil {
+				m.Repo = &v1alpha1.Repository{}
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field App", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2901,9 +5850,9 @@ func (m *ManifestResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Server = string(dAtA[iNdEx:postIndex])
+			m.App = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
@@ -2932,11 +5881,11 @@ func (m *ManifestResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Full", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -2946,21 +5895,12 @@ func (m *ManifestResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRepository
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SourceType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Full = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -2983,7 +5923,7 @@ func (m *ManifestResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListAppsRequest) Unmarshal(dAtA []byte) error {
+func (m *RepoServerRevisionChangelogRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3006,10 +5946,10 @@ func (m *ListAppsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListAppsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RepoServerRevisionChangelogRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListAppsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RepoServerRevisionChangelogRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3046,6 +5986,35 @@ func (m *ListAppsRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field App", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.App = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
@@ -3074,6 +6043,35 @@ func (m *ListAppsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetRevision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetRevision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -3096,7 +6094,7 @@ func (m *ListAppsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AppList) Unmarshal(dAtA []byte) error {
+func (m *KsonnetAppSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3119,15 +6117,44 @@ func (m *AppList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AppList: wiretype end group for non-group")
+			return fmt.Errorf("proto: KsonnetAppSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AppList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KsonnetAppSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Apps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Environments", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3151,11 +6178,11 @@ func (m *AppList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Apps == nil {
-				m.Apps = make(map[string]string)
+			if m.Environments == nil {
+				m.Environments = make(map[string]*KsonnetEnvironment)
 			}
 			var mapkey string
-			var mapvalue string
+			var mapvalue *KsonnetEnvironment
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -3201,7 +6228,7 @@ func (m *AppList) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
+					var mapmsglen int
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowRepository
@@ -3211,21 +6238,26 @@ func (m *AppList) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						mapmsglen |= (int(b) & 0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
+					if mapmsglen < 0 {
 						return ErrInvalidLengthRepository
 					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue > l {
+					postmsgIndex := iNdEx + mapmsglen
+					if mapmsglen < 0 {
+						return ErrInvalidLengthRepository
+					}
+					if postmsgIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
+					mapvalue = &KsonnetEnvironment{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipRepository(dAtA[iNdEx:])
@@ -3241,7 +6273,38 @@ func (m *AppList) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Apps[mapkey] = mapvalue
+			m.Environments[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, &v1alpha1.KsonnetParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3265,7 +6328,7 @@ func (m *AppList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RepoServerAppDetailsQuery) Unmarshal(dAtA []byte) error {
+func (m *HelmAppSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3288,17 +6351,17 @@ func (m *RepoServerAppDetailsQuery) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RepoServerAppDetailsQuery: wiretype end group for non-group")
+			return fmt.Errorf("proto: HelmAppSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepoServerAppDetailsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HelmAppSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -3308,28 +6371,24 @@ func (m *RepoServerAppDetailsQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &v1alpha1.Repository{}
-			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueFiles", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3354,11 +6413,42 @@ func (m *RepoServerAppDetailsQuery) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
+			m.ValueFiles = append(m.ValueFiles, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field App", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, &v1alpha1.HelmParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3383,13 +6473,13 @@ func (m *RepoServerAppDetailsQuery) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.App = string(dAtA[iNdEx:postIndex])
+			m.Values = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repos", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasCrds", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -3399,28 +6489,68 @@ func (m *RepoServerAppDetailsQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			m.HasCrds = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Repos = append(m.Repos, &v1alpha1.Repository{})
-			if err := m.Repos[len(m.Repos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KustomizeAppSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
 			}
-			iNdEx = postIndex
-		case 5:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KustomizeAppSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KustomizeAppSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Plugins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -3430,28 +6560,77 @@ func (m *RepoServerAppDetailsQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Plugins = append(m.Plugins, &v1alpha1.ConfigManagementPlugin{})
-			if err := m.Plugins[len(m.Plugins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Images = append(m.Images, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 6:
+			if skippy < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KsonnetEnvironment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KsonnetEnvironment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KsonnetEnvironment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Helm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -3461,30 +6640,26 @@ func (m *RepoServerAppDetailsQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Helm == nil {
-				m.Helm = &HelmAppDetailsQuery{}
-			}
-			if err := m.Helm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ksonnet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field K8SVersion", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -3494,28 +6669,24 @@ func (m *RepoServerAppDetailsQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Ksonnet == nil {
-				m.Ksonnet = &KsonnetAppDetailsQuery{}
-			}
-			if err := m.Ksonnet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.K8SVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KustomizeOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3539,10 +6710,10 @@ func (m *RepoServerAppDetailsQuery) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KustomizeOptions == nil {
-				m.KustomizeOptions = &v1alpha1.KustomizeOptions{}
+			if m.Destination == nil {
+				m.Destination = &KsonnetEnvironmentDestination{}
 			}
-			if err := m.KustomizeOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3568,7 +6739,7 @@ func (m *RepoServerAppDetailsQuery) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HelmAppDetailsQuery) Unmarshal(dAtA []byte) error {
+func (m *KsonnetEnvironmentDestination) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3591,15 +6762,15 @@ func (m *HelmAppDetailsQuery) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HelmAppDetailsQuery: wiretype end group for non-group")
+			return fmt.Errorf("proto: KsonnetEnvironmentDestination: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HelmAppDetailsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KsonnetEnvironmentDestination: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueFiles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3624,7 +6795,36 @@ func (m *HelmAppDetailsQuery) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValueFiles = append(m.ValueFiles, string(dAtA[iNdEx:postIndex]))
+			m.Server = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3648,7 +6848,7 @@ func (m *HelmAppDetailsQuery) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KsonnetAppDetailsQuery) Unmarshal(dAtA []byte) error {
+func (m *DirectoryAppSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3671,41 +6871,12 @@ func (m *KsonnetAppDetailsQuery) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KsonnetAppDetailsQuery: wiretype end group for non-group")
+			return fmt.Errorf("proto: DirectoryAppSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KsonnetAppDetailsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DirectoryAppSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Environment", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRepository
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRepository
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Environment = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -3728,7 +6899,7 @@ func (m *KsonnetAppDetailsQuery) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RepoAppDetailsResponse) Unmarshal(dAtA []byte) error {
+func (m *ProfilingRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3751,46 +6922,17 @@ func (m *RepoAppDetailsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RepoAppDetailsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProfilingRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepoAppDetailsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProfilingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRepository
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRepository
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Type = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ksonnet", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -3800,96 +6942,68 @@ func (m *RepoAppDetailsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRepository
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Ksonnet == nil {
-				m.Ksonnet = &KsonnetAppSpec{}
-			}
-			if err := m.Ksonnet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Helm", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRepository
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Helm == nil {
-				m.Helm = &HelmAppSpec{}
-			}
-			if err := m.Helm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kustomize", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRepository
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRepository
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProfilingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Kustomize == nil {
-				m.Kustomize = &KustomizeAppSpec{}
-			}
-			if err := m.Kustomize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Directory", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProfilingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProfilingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -3899,25 +7013,12 @@ func (m *RepoAppDetailsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRepository
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Directory == nil {
-				m.Directory = &DirectoryAppSpec{}
-			}
-			if err := m.Directory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -3940,7 +7041,7 @@ func (m *RepoAppDetailsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RepoServerRevisionMetadataRequest) Unmarshal(dAtA []byte) error {
+func (m *ProfileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3963,48 +7064,15 @@ func (m *RepoServerRevisionMetadataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RepoServerRevisionMetadataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProfileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepoServerRevisionMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProfileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRepository
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRepository
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Repo == nil {
-				m.Repo = &v1alpha1.Repository{}
-			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field App", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4029,13 +7097,13 @@ func (m *RepoServerRevisionMetadataRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.App = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dump", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -4045,21 +7113,12 @@ func (m *RepoServerRevisionMetadataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRepository
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Dump = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -4082,7 +7141,7 @@ func (m *RepoServerRevisionMetadataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KsonnetAppSpec) Unmarshal(dAtA []byte) error {
+func (m *ProfileResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4105,17 +7164,17 @@ func (m *KsonnetAppSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KsonnetAppSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProfileResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KsonnetAppSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProfileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -4125,26 +7184,28 @@ func (m *KsonnetAppSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Environments", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -4154,146 +7215,72 @@ func (m *KsonnetAppSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Environments == nil {
-				m.Environments = make(map[string]*KsonnetEnvironment)
-			}
-			var mapkey string
-			var mapvalue *KsonnetEnvironment
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRepository
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRepository
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthRepository
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRepository
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= (int(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthRepository
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if mapmsglen < 0 {
-						return ErrInvalidLengthRepository
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &KsonnetEnvironment{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipRepository(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthRepository
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Environments[mapkey] = mapvalue
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRepository
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameters = append(m.Parameters, &v1alpha1.KsonnetParameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LocksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
 			}
-			iNdEx = postIndex
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LocksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -4316,7 +7303,7 @@ func (m *KsonnetAppSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HelmAppSpec) Unmarshal(dAtA []byte) error {
+func (m *Lock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4339,15 +7326,15 @@ func (m *HelmAppSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HelmAppSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: Lock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HelmAppSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Lock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4372,11 +7359,11 @@ func (m *HelmAppSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueFiles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4401,13 +7388,13 @@ func (m *HelmAppSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValueFiles = append(m.ValueFiles, string(dAtA[iNdEx:postIndex]))
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeldSeconds", wireType)
 			}
-			var msglen int
+			m.HeldSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -4417,28 +7404,16 @@ func (m *HelmAppSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.HeldSeconds |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRepository
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Parameters = append(m.Parameters, &v1alpha1.HelmParameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Waiting", wireType)
 			}
-			var stringLen uint64
+			m.Waiting = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -4448,21 +7423,11 @@ func (m *HelmAppSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Waiting |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRepository
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Values = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -4485,7 +7450,7 @@ func (m *HelmAppSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KustomizeAppSpec) Unmarshal(dAtA []byte) error {
+func (m *LocksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4508,17 +7473,17 @@ func (m *KustomizeAppSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KustomizeAppSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: LocksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KustomizeAppSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 3:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Locks", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -4528,20 +7493,22 @@ func (m *KustomizeAppSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Images = append(m.Images, string(dAtA[iNdEx:postIndex]))
+			m.Locks = append(m.Locks, &Lock{})
+			if err := m.Locks[len(m.Locks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4565,7 +7532,7 @@ func (m *KustomizeAppSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KsonnetEnvironment) Unmarshal(dAtA []byte) error {
+func (m *RepoServerVersionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4588,15 +7555,66 @@ func (m *KsonnetEnvironment) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KsonnetEnvironment: wiretype end group for non-group")
+			return fmt.Errorf("proto: RepoServerVersionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KsonnetEnvironment: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RepoServerVersionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepoServerVersionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepoServerVersionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepoServerVersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4621,11 +7639,11 @@ func (m *KsonnetEnvironment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field K8SVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HelmVersion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4650,13 +7668,13 @@ func (m *KsonnetEnvironment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.K8SVersion = string(dAtA[iNdEx:postIndex])
+			m.HelmVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KustomizeVersion", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -4666,79 +7684,24 @@ func (m *KsonnetEnvironment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Destination == nil {
-				m.Destination = &KsonnetEnvironmentDestination{}
-			}
-			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.KustomizeVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRepository(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRepository
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *KsonnetEnvironmentDestination) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRepository
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: KsonnetEnvironmentDestination: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KsonnetEnvironmentDestination: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KsonnetVersion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4763,11 +7726,11 @@ func (m *KsonnetEnvironmentDestination) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Server = string(dAtA[iNdEx:postIndex])
+			m.KsonnetVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GitVersion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4792,59 +7755,37 @@ func (m *KsonnetEnvironmentDestination) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.GitVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRepository(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRepository
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DirectoryAppSpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRepository
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DirectoryAppSpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DirectoryAppSpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.Features = append(m.Features, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -4973,81 +7914,116 @@ var (
 )
 
 func init() {
-	proto.RegisterFile("reposerver/repository/repository.proto", fileDescriptor_repository_d8b025f090bb11d8)
-}
-
-var fileDescriptor_repository_d8b025f090bb11d8 = []byte{
-	// 1151 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xda, 0xce, 0x87, 0x9f, 0x9b, 0xd6, 0x99, 0x54, 0x61, 0x31, 0x69, 0x30, 0x2b, 0x40,
-	0xe1, 0xa3, 0x6b, 0xe2, 0x16, 0x11, 0x45, 0xa8, 0x52, 0x68, 0x42, 0x8a, 0x9c, 0xa8, 0xe9, 0x06,
-	0x2a, 0xf1, 0x21, 0x55, 0x93, 0xf5, 0xeb, 0x66, 0xb0, 0xbd, 0x3b, 0xec, 0xac, 0x8d, 0xd2, 0x7f,
-	0x00, 0xee, 0x08, 0x89, 0x33, 0x67, 0xfe, 0x0b, 0x38, 0x70, 0xe4, 0xcc, 0x09, 0xe5, 0xc6, 0x7f,
-	0x81, 0x66, 0x76, 0xd7, 0x3b, 0x5e, 0x6f, 0x72, 0x31, 0x6d, 0x2f, 0xc9, 0xcc, 0x9b, 0xf7, 0xb1,
-	0xf3, 0x7b, 0xef, 0xfd, 0xfc, 0x06, 0xde, 0x0e, 0x91, 0x07, 0x02, 0xc3, 0x11, 0x86, 0x2d, 0xb5,
-	0x64, 0x51, 0x10, 0x9e, 0x6b, 0x4b, 0x9b, 0x87, 0x41, 0x14, 0x10, 0xc8, 0x24, 0x8d, 0x9b, 0x5e,
-	0xe0, 0x05, 0x4a, 0xdc, 0x92, 0xab, 0x58, 0xa3, 0xb1, 0xee, 0x05, 0x81, 0xd7, 0xc7, 0x16, 0xe5,
-	0xac, 0x45, 0x7d, 0x3f, 0x88, 0x68, 0xc4, 0x02, 0x5f, 0x24, 0xa7, 0x56, 0x6f, 0x5b, 0xd8, 0x2c,
-	0x50, 0xa7, 0x6e, 0x10, 0x62, 0x6b, 0xb4, 0xd5, 0xf2, 0xd0, 0xc7, 0x90, 0x46, 0xd8, 0x4d, 0x74,
-	0x3e, 0xf3, 0x58, 0x74, 0x36, 0x3c, 0xb5, 0xdd, 0x60, 0xd0, 0xa2, 0xa1, 0x0a, 0xf1, 0xad, 0x5a,
-	0xdc, 0x76, 0xbb, 0x2d, 0xde, 0xf3, 0xa4, 0xb1, 0x68, 0x51, 0xce, 0xfb, 0xcc, 0x55, 0xce, 0x5b,
-	0xa3, 0x2d, 0xda, 0xe7, 0x67, 0x74, 0xca, 0x95, 0xf5, 0xef, 0x3c, 0xdc, 0x38, 0xa2, 0x3e, 0x7b,
-	0x8a, 0x22, 0x72, 0xf0, 0xbb, 0x21, 0x8a, 0x88, 0x7c, 0x09, 0x15, 0x79, 0x09, 0xd3, 0x68, 0x1a,
-	0x9b, 0xb5, 0xf6, 0xbe, 0x9d, 0x45, 0xb3, 0xd3, 0x68, 0x6a, 0xf1, 0xc4, 0xed, 0xda, 0xbc, 0xe7,
-	0xd9, 0x32, 0x9a, 0xad, 0x45, 0xb3, 0xd3, 0x68, 0xb6, 0x33, 0xc6, 0xc2, 0x51, 0x2e, 0x49, 0x03,
-	0x96, 0x42, 0x1c, 0x31, 0xc1, 0x02, 0xdf, 0x2c, 0x35, 0x8d, 0xcd, 0xaa, 0x33, 0xde, 0x13, 0x13,
-	0x16, 0xfd, 0xe0, 0x3e, 0x75, 0xcf, 0xd0, 0x2c, 0x37, 0x8d, 0xcd, 0x25, 0x27, 0xdd, 0x92, 0x26,
-	0xd4, 0x28, 0xe7, 0x87, 0xf4, 0x14, 0xfb, 0x1d, 0x3c, 0x37, 0x2b, 0xca, 0x50, 0x17, 0x91, 0x37,
-	0x61, 0x39, 0xdd, 0x3e, 0xa6, 0xfd, 0x21, 0x9a, 0xf3, 0x4a, 0x67, 0x52, 0x48, 0xd6, 0xa1, 0xea,
-	0xd3, 0x01, 0x0a, 0x4e, 0x5d, 0x34, 0x97, 0x94, 0x46, 0x26, 0x20, 0xcf, 0x60, 0x45, 0xbb, 0xc4,
-	0x49, 0x30, 0x0c, 0x5d, 0x34, 0x41, 0x61, 0x70, 0x38, 0x03, 0x06, 0xbb, 0x79, 0x9f, 0xce, 0x74,
-	0x18, 0xf2, 0x35, 0xcc, 0xab, 0xba, 0x31, 0x6b, 0xcd, 0xf2, 0xff, 0x87, 0x79, 0xec, 0x93, 0xf4,
-	0x60, 0x91, 0xf7, 0x87, 0x1e, 0xf3, 0x85, 0x79, 0x4d, 0xb9, 0x7f, 0x34, 0x83, 0xfb, 0xfb, 0x81,
-	0xff, 0x94, 0x79, 0x47, 0xd4, 0xa7, 0x1e, 0x0e, 0xd0, 0x8f, 0x8e, 0x95, 0x67, 0x27, 0x8d, 0x40,
-	0xbe, 0x87, 0x7a, 0x6f, 0x28, 0xa2, 0x60, 0xc0, 0x9e, 0xe1, 0x43, 0xae, 0x2a, 0xdb, 0x5c, 0x56,
-	0x20, 0x76, 0x66, 0x88, 0xda, 0xc9, 0xb9, 0x74, 0xa6, 0x82, 0xc8, 0x22, 0xe9, 0x0d, 0x4f, 0xf1,
-	0x31, 0x86, 0xaa, 0xba, 0xae, 0xc7, 0x45, 0xa2, 0x89, 0xac, 0x5f, 0x0d, 0xa8, 0x67, 0xb5, 0x2e,
-	0x78, 0xe0, 0x0b, 0x55, 0x13, 0x83, 0x44, 0x26, 0x4c, 0xa3, 0x59, 0x96, 0x35, 0x31, 0x16, 0x4c,
-	0x56, 0x4c, 0x29, 0x5f, 0x31, 0x6b, 0xb0, 0x10, 0x33, 0x82, 0x2a, 0xd8, 0xaa, 0x93, 0xec, 0x26,
-	0xaa, 0xbc, 0x92, 0xab, 0xf2, 0x0d, 0x00, 0xa1, 0x72, 0xfe, 0xf9, 0x39, 0x47, 0x73, 0x41, 0x9d,
-	0x6a, 0x12, 0xeb, 0x47, 0x03, 0x6e, 0x1c, 0x32, 0x11, 0xed, 0x72, 0x2e, 0x5e, 0x6e, 0x43, 0x5a,
-	0x43, 0x58, 0xdc, 0xe5, 0x5c, 0x7e, 0x0c, 0xd9, 0x82, 0x0a, 0xe5, 0x3c, 0x06, 0xa8, 0xd6, 0xbe,
-	0x65, 0x6b, 0xb4, 0x97, 0xa8, 0xc8, 0xff, 0x62, 0xdf, 0x8f, 0xa4, 0x67, 0xa9, 0xda, 0xf8, 0x08,
-	0xaa, 0x63, 0x11, 0xa9, 0x43, 0xb9, 0x87, 0xe7, 0xea, 0x02, 0x55, 0x47, 0x2e, 0xc9, 0x4d, 0x98,
-	0x1f, 0xa9, 0x4e, 0x8d, 0xa3, 0xc6, 0x9b, 0x9d, 0xd2, 0xb6, 0x61, 0xfd, 0x5d, 0x81, 0x57, 0xe5,
-	0x77, 0x9e, 0x28, 0x30, 0x77, 0x39, 0xdf, 0xc3, 0x88, 0xb2, 0xbe, 0x78, 0x34, 0xc4, 0xf0, 0xfc,
-	0x65, 0x91, 0x53, 0x1d, 0xca, 0x94, 0xf3, 0x24, 0xcf, 0x72, 0x99, 0xb5, 0x6c, 0xe5, 0xf9, 0xb6,
-	0xec, 0xfc, 0x73, 0x6f, 0xd9, 0x3b, 0x50, 0x39, 0xc3, 0xfe, 0x40, 0x15, 0x63, 0xad, 0xfd, 0xba,
-	0x9e, 0xdc, 0x07, 0xd8, 0x1f, 0xe4, 0x32, 0xe0, 0x28, 0x65, 0xf2, 0x31, 0x2c, 0xf6, 0x44, 0xe0,
-	0xfb, 0x18, 0x99, 0x8b, 0xca, 0xce, 0xd2, 0xed, 0x3a, 0xf1, 0x51, 0xde, 0x34, 0x35, 0x29, 0x64,
-	0x89, 0xa5, 0x17, 0xc0, 0x12, 0xd6, 0x87, 0xb0, 0x5a, 0x70, 0x27, 0xd9, 0x95, 0xaa, 0x00, 0x3f,
-	0x65, 0x7d, 0x4c, 0x69, 0x40, 0x93, 0x58, 0x3b, 0xb0, 0x56, 0x7c, 0x25, 0x49, 0x3b, 0xe8, 0x8f,
-	0x58, 0x18, 0xf8, 0x12, 0xda, 0xa4, 0xc2, 0x75, 0x91, 0xf5, 0x43, 0x09, 0xd6, 0x64, 0x86, 0x33,
-	0xcb, 0x31, 0xf9, 0x10, 0xa8, 0x44, 0x92, 0x06, 0x62, 0x2b, 0xb5, 0x26, 0x77, 0x33, 0x60, 0x4b,
-	0x0a, 0x91, 0x46, 0x31, 0xb0, 0x27, 0x1c, 0xdd, 0x0c, 0xd0, 0xf7, 0x92, 0x1c, 0x96, 0x95, 0xc9,
-	0x2b, 0x05, 0x39, 0x54, 0xfa, 0x71, 0xee, 0x76, 0xa0, 0x3a, 0x06, 0x46, 0x11, 0x54, 0xad, 0xbd,
-	0x3e, 0x11, 0x24, 0x3d, 0x4c, 0xcd, 0x32, 0x75, 0x69, 0xdb, 0x65, 0x21, 0xba, 0x52, 0x51, 0xfd,
-	0xca, 0xe6, 0x6c, 0xf7, 0xd2, 0xc3, 0xb1, 0xed, 0x58, 0xdd, 0xfa, 0xcd, 0x80, 0x37, 0xb2, 0xce,
-	0x76, 0x92, 0xde, 0x3a, 0xc2, 0x88, 0x76, 0x69, 0x44, 0x5f, 0x00, 0xdb, 0x25, 0x5d, 0x5c, 0xca,
-	0xba, 0x58, 0xef, 0xf9, 0x72, 0x8e, 0xff, 0xfe, 0x28, 0xc1, 0xf5, 0x49, 0xbc, 0x65, 0xc2, 0x24,
-	0xfd, 0xa7, 0x09, 0x93, 0x6b, 0x72, 0x0c, 0xd7, 0xb4, 0x74, 0x0b, 0xb3, 0xac, 0x1a, 0xf6, 0xfd,
-	0xcb, 0xb3, 0x66, 0xef, 0x6b, 0xea, 0x31, 0x65, 0x4e, 0x78, 0x20, 0x3d, 0x00, 0x4e, 0x43, 0x3a,
-	0xc0, 0x08, 0xc3, 0x94, 0x5f, 0x66, 0xea, 0x8b, 0x38, 0xfc, 0x71, 0xea, 0xd3, 0xd1, 0xdc, 0x37,
-	0x9e, 0xc0, 0xca, 0xd4, 0xf7, 0x14, 0xf0, 0xf5, 0x5d, 0x9d, 0xaf, 0x6b, 0xed, 0x8d, 0x82, 0xeb,
-	0x69, 0x6e, 0x74, 0x3e, 0xff, 0xdd, 0x80, 0x9a, 0x56, 0x83, 0x85, 0x18, 0x4e, 0xf6, 0x5f, 0x39,
-	0xdf, 0x7f, 0xe4, 0xac, 0x00, 0x91, 0x07, 0x33, 0x20, 0x22, 0xbf, 0xa7, 0x10, 0x0e, 0xf9, 0x9b,
-	0xae, 0xe2, 0x8a, 0x64, 0x84, 0x4c, 0x76, 0xd6, 0xbb, 0x50, 0xcf, 0xb7, 0x85, 0xd4, 0x65, 0x03,
-	0xea, 0x8d, 0xbf, 0x38, 0xd9, 0x59, 0x3f, 0x1b, 0x40, 0xa6, 0x31, 0xb9, 0xec, 0xe2, 0xbd, 0x6d,
-	0x91, 0x0e, 0x2d, 0x71, 0x61, 0x6a, 0x12, 0xd2, 0x81, 0x5a, 0x17, 0x45, 0xc4, 0x7c, 0x75, 0x81,
-	0xa4, 0x59, 0xdf, 0xb9, 0x1a, 0xfc, 0xbd, 0xcc, 0xc0, 0xd1, 0xad, 0xad, 0x2f, 0xe0, 0xd6, 0x95,
-	0xda, 0xda, 0x40, 0x63, 0x4c, 0x0c, 0x34, 0x57, 0x8e, 0x41, 0x16, 0x81, 0x7a, 0xbe, 0xeb, 0xdb,
-	0xbf, 0x94, 0x61, 0x25, 0x6b, 0x75, 0xf9, 0x97, 0xb9, 0x48, 0x1e, 0x42, 0xfd, 0x20, 0x79, 0x80,
-	0xa4, 0x83, 0x18, 0x79, 0x4d, 0xbf, 0x4c, 0xee, 0x29, 0xd2, 0x58, 0x2f, 0x3e, 0x8c, 0xe9, 0xd3,
-	0x9a, 0x23, 0xf7, 0x60, 0x29, 0x1d, 0x96, 0x26, 0x1d, 0xe5, 0x46, 0xa8, 0xc6, 0x6a, 0xc1, 0xc8,
-	0x62, 0xcd, 0x91, 0x6f, 0x60, 0xf9, 0x40, 0xe7, 0x74, 0xf2, 0x96, 0xae, 0x77, 0xe9, 0x14, 0xd2,
-	0xb0, 0xf2, 0x6a, 0xd3, 0xe4, 0x6e, 0xcd, 0x91, 0x9f, 0x0c, 0x58, 0x3d, 0xc0, 0x28, 0x4f, 0x74,
-	0xe4, 0x76, 0x71, 0x90, 0x4b, 0x08, 0xb1, 0xd1, 0x99, 0x89, 0x02, 0x27, 0x7d, 0x5a, 0x73, 0x9f,
-	0xdc, 0xfb, 0xf3, 0x62, 0xc3, 0xf8, 0xeb, 0x62, 0xc3, 0xf8, 0xe7, 0x62, 0xc3, 0xf8, 0xea, 0x83,
-	0xab, 0xde, 0x92, 0xda, 0x9b, 0x97, 0x72, 0xe6, 0xf6, 0x19, 0xfa, 0xd1, 0xe9, 0x82, 0x7a, 0x39,
-	0xde, 0xf9, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x27, 0x2b, 0xc9, 0xba, 0x12, 0x0f, 0x00, 0x00,
+	proto.RegisterFile("reposerver/repository/repository.proto", fileDescriptor_repository_b644f9156bbe390d)
+}
+
+var fileDescriptor_repository_b644f9156bbe390d = []byte{
+	// 1712 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4f, 0x6f, 0xdc, 0xc6,
+	0x15, 0x17, 0xb5, 0x2b, 0x6b, 0xf5, 0x56, 0x7f, 0x56, 0x63, 0xd7, 0xa5, 0x69, 0x5b, 0x51, 0x89,
+	0xc4, 0x70, 0xd3, 0x78, 0xb7, 0xde, 0x18, 0x8d, 0x6b, 0x14, 0x41, 0x15, 0xd9, 0x55, 0x02, 0x49,
+	0x90, 0x4c, 0xb5, 0x06, 0xda, 0x14, 0x08, 0x46, 0xcb, 0x27, 0x2e, 0xb3, 0x5c, 0x92, 0xe5, 0xcc,
+	0xae, 0xb0, 0xf9, 0x02, 0xed, 0xbd, 0x68, 0xbf, 0x48, 0xcf, 0x05, 0x7a, 0xe8, 0x21, 0xc7, 0x5e,
+	0x7a, 0x29, 0xd0, 0xa2, 0xf0, 0xa5, 0xe8, 0xb7, 0x28, 0x66, 0xc8, 0x21, 0x87, 0x5c, 0x4a, 0x3d,
+	0x6c, 0x12, 0xe7, 0x22, 0xcd, 0xbc, 0x79, 0x7f, 0xe6, 0xbd, 0x79, 0xef, 0xf7, 0x1e, 0x17, 0x1e,
+	0x24, 0x18, 0x47, 0x0c, 0x93, 0x29, 0x26, 0x3d, 0xb9, 0xf4, 0x79, 0x94, 0xcc, 0xb4, 0x65, 0x37,
+	0x4e, 0x22, 0x1e, 0x11, 0x28, 0x28, 0xd6, 0x2d, 0x2f, 0xf2, 0x22, 0x49, 0xee, 0x89, 0x55, 0xca,
+	0x61, 0xdd, 0xf3, 0xa2, 0xc8, 0x0b, 0xb0, 0x47, 0x63, 0xbf, 0x47, 0xc3, 0x30, 0xe2, 0x94, 0xfb,
+	0x51, 0xc8, 0xb2, 0x53, 0x7b, 0xf4, 0x94, 0x75, 0xfd, 0x48, 0x9e, 0x0e, 0xa2, 0x04, 0x7b, 0xd3,
+	0xc7, 0x3d, 0x0f, 0x43, 0x4c, 0x28, 0x47, 0x37, 0xe3, 0xf9, 0xc4, 0xf3, 0xf9, 0x70, 0x72, 0xde,
+	0x1d, 0x44, 0xe3, 0x1e, 0x4d, 0xa4, 0x89, 0xcf, 0xe5, 0xe2, 0xd1, 0xc0, 0xed, 0xc5, 0x23, 0x4f,
+	0x08, 0xb3, 0x1e, 0x8d, 0xe3, 0xc0, 0x1f, 0x48, 0xe5, 0xbd, 0xe9, 0x63, 0x1a, 0xc4, 0x43, 0x3a,
+	0xa7, 0xca, 0xfe, 0x73, 0x0b, 0xb6, 0x8e, 0x69, 0xe8, 0x5f, 0x20, 0xe3, 0x0e, 0xfe, 0x66, 0x82,
+	0x8c, 0x93, 0x5f, 0x42, 0x53, 0x38, 0x61, 0x1a, 0xbb, 0xc6, 0xc3, 0x76, 0xff, 0x45, 0xb7, 0xb0,
+	0xd6, 0x55, 0xd6, 0xe4, 0xe2, 0xb3, 0x81, 0xdb, 0x8d, 0x47, 0x5e, 0x57, 0x58, 0xeb, 0x6a, 0xd6,
+	0xba, 0xca, 0x5a, 0xd7, 0xc9, 0x63, 0xe1, 0x48, 0x95, 0xc4, 0x82, 0x56, 0x82, 0x53, 0x9f, 0xf9,
+	0x51, 0x68, 0x2e, 0xef, 0x1a, 0x0f, 0xd7, 0x9c, 0x7c, 0x4f, 0x4c, 0x58, 0x0d, 0xa3, 0x7d, 0x3a,
+	0x18, 0xa2, 0xd9, 0xd8, 0x35, 0x1e, 0xb6, 0x1c, 0xb5, 0x25, 0xbb, 0xd0, 0xa6, 0x71, 0x7c, 0x44,
+	0xcf, 0x31, 0x38, 0xc4, 0x99, 0xd9, 0x94, 0x82, 0x3a, 0x89, 0xbc, 0x0d, 0x1b, 0x6a, 0xfb, 0x8a,
+	0x06, 0x13, 0x34, 0x57, 0x24, 0x4f, 0x99, 0x48, 0xee, 0xc1, 0x5a, 0x48, 0xc7, 0xc8, 0x62, 0x3a,
+	0x40, 0xb3, 0x25, 0x39, 0x0a, 0x02, 0xf9, 0x02, 0xb6, 0x35, 0x27, 0xce, 0xa2, 0x49, 0x32, 0x40,
+	0x13, 0x64, 0x0c, 0x8e, 0x16, 0x88, 0xc1, 0x5e, 0x55, 0xa7, 0x33, 0x6f, 0x86, 0x7c, 0x0a, 0x2b,
+	0x32, 0x6f, 0xcc, 0xf6, 0x6e, 0xe3, 0xab, 0x8b, 0x79, 0xaa, 0x93, 0x8c, 0x60, 0x35, 0x0e, 0x26,
+	0x9e, 0x1f, 0x32, 0x73, 0x5d, 0xaa, 0x7f, 0xb9, 0x80, 0xfa, 0xfd, 0x28, 0xbc, 0xf0, 0xbd, 0x63,
+	0x1a, 0x52, 0x0f, 0xc7, 0x18, 0xf2, 0x53, 0xa9, 0xd9, 0x51, 0x16, 0xc8, 0x25, 0x74, 0x46, 0x13,
+	0xc6, 0xa3, 0xb1, 0xff, 0x05, 0x9e, 0xc4, 0x42, 0x96, 0x99, 0x1b, 0x32, 0x88, 0x87, 0x0b, 0x58,
+	0x3d, 0xac, 0xa8, 0x74, 0xe6, 0x8c, 0x88, 0x24, 0x19, 0x4d, 0xce, 0xf1, 0x15, 0x26, 0x32, 0xbb,
+	0x36, 0xd3, 0x24, 0xd1, 0x48, 0xe4, 0x01, 0x6c, 0xba, 0x38, 0x48, 0x66, 0x52, 0xe0, 0x10, 0x67,
+	0xcc, 0xdc, 0xda, 0x6d, 0x3c, 0x5c, 0x73, 0x2a, 0x54, 0xf2, 0x23, 0xb8, 0x1d, 0xd3, 0x84, 0x8e,
+	0x91, 0x63, 0x72, 0x32, 0xc5, 0x24, 0xf1, 0x5d, 0x64, 0x3f, 0xf3, 0x03, 0x34, 0x3b, 0x52, 0xe9,
+	0x15, 0xa7, 0xe4, 0x09, 0x7c, 0x67, 0x9c, 0x95, 0xd2, 0x41, 0x56, 0x66, 0xa7, 0x94, 0x0f, 0x99,
+	0xb9, 0x2d, 0xcd, 0xd4, 0x1f, 0x92, 0x77, 0xa1, 0x13, 0x8b, 0x1a, 0x88, 0x26, 0xcc, 0x51, 0xa5,
+	0x41, 0xa4, 0x9d, 0x39, 0x7a, 0x5a, 0x08, 0x7e, 0xe6, 0x0f, 0x33, 0x6f, 0x4a, 0xbd, 0x3a, 0x89,
+	0x84, 0xb0, 0xa1, 0xcc, 0xbc, 0x9c, 0x44, 0x9c, 0x9a, 0xb7, 0x64, 0xec, 0x3f, 0x5e, 0x20, 0xf6,
+	0xc7, 0xba, 0x3e, 0xa7, 0xac, 0xde, 0xfe, 0xd3, 0x32, 0x74, 0x0a, 0xfc, 0x60, 0x71, 0x14, 0x32,
+	0x59, 0x67, 0x8a, 0x8b, 0x99, 0x86, 0xbc, 0x64, 0x41, 0x28, 0x57, 0xe1, 0x72, 0xb5, 0x0a, 0x6f,
+	0xc3, 0x8d, 0x14, 0x65, 0x25, 0x08, 0xac, 0x39, 0xd9, 0xae, 0x84, 0x1c, 0xcd, 0x0a, 0x72, 0xec,
+	0x00, 0x30, 0x59, 0x47, 0x3f, 0x9f, 0xc5, 0x68, 0xde, 0x90, 0xa7, 0x1a, 0x85, 0xdc, 0x82, 0x15,
+	0xc6, 0x69, 0x80, 0xe6, 0xaa, 0xc4, 0x95, 0x74, 0x43, 0x66, 0xb0, 0x95, 0x60, 0xe8, 0x62, 0x82,
+	0x6e, 0x5a, 0x85, 0xcc, 0x6c, 0xc9, 0xf2, 0xf8, 0x64, 0xa1, 0xea, 0xd3, 0x35, 0x7e, 0xd4, 0xfc,
+	0xf2, 0x5f, 0x6f, 0x2d, 0x39, 0x55, 0x3b, 0xf6, 0xef, 0x0c, 0xd8, 0x3a, 0xf2, 0x19, 0xdf, 0x8b,
+	0x63, 0xf6, 0x66, 0x51, 0xd7, 0x9e, 0xc0, 0xea, 0x5e, 0x1c, 0x8b, 0xcb, 0x90, 0xc7, 0xd0, 0xa4,
+	0x71, 0x9c, 0xbe, 0x58, 0xbb, 0x7f, 0xbf, 0xab, 0xf5, 0xb6, 0x8c, 0x45, 0xfc, 0x67, 0x2f, 0x42,
+	0x2e, 0x34, 0x0b, 0x56, 0xeb, 0x03, 0x58, 0xcb, 0x49, 0xa4, 0x03, 0x8d, 0x11, 0xce, 0xa4, 0x03,
+	0x6b, 0x8e, 0x58, 0x8a, 0xc0, 0x4f, 0x25, 0x1c, 0xa7, 0x56, 0xd3, 0xcd, 0xb3, 0xe5, 0xa7, 0x86,
+	0xfd, 0x8f, 0x26, 0xdc, 0x11, 0xf7, 0x3c, 0x93, 0xaf, 0xbb, 0x17, 0xc7, 0xcf, 0x91, 0x53, 0x3f,
+	0x60, 0x2f, 0x27, 0x98, 0xcc, 0xde, 0x54, 0x07, 0xea, 0x40, 0x83, 0xc6, 0x71, 0x96, 0x78, 0x62,
+	0x59, 0xe0, 0x72, 0xf3, 0xeb, 0xc5, 0xe5, 0x95, 0xaf, 0x1d, 0x97, 0xdf, 0x87, 0xe6, 0x10, 0x83,
+	0xb1, 0xac, 0x8e, 0x76, 0xff, 0x2d, 0xfd, 0x71, 0x3f, 0xc6, 0x60, 0x5c, 0x79, 0x01, 0x47, 0x32,
+	0x93, 0x9f, 0xc0, 0xea, 0x88, 0x45, 0x61, 0x88, 0x5c, 0x96, 0x4e, 0xbb, 0x6f, 0xeb, 0x72, 0x87,
+	0xe9, 0x51, 0x55, 0x54, 0x89, 0xd4, 0xb6, 0x82, 0xd6, 0x37, 0xd0, 0x0a, 0xec, 0x4b, 0xb8, 0x59,
+	0xe3, 0x93, 0x80, 0x09, 0x99, 0x80, 0x02, 0xac, 0x15, 0x2e, 0x69, 0x14, 0xf2, 0x53, 0xb8, 0x4b,
+	0x83, 0x20, 0xba, 0x7c, 0x95, 0x93, 0x4e, 0x26, 0x9c, 0xf9, 0x2e, 0xee, 0x0f, 0x69, 0xc2, 0x65,
+	0xb6, 0xb4, 0x9c, 0xeb, 0x58, 0xec, 0x67, 0x70, 0xbb, 0x3e, 0x28, 0x02, 0xb9, 0x31, 0x9c, 0xfa,
+	0x49, 0x14, 0x8a, 0xc7, 0xc9, 0x6a, 0x44, 0x27, 0xd9, 0xbf, 0x5d, 0x86, 0xdb, 0x22, 0x47, 0x0a,
+	0xc9, 0x1c, 0x4f, 0x09, 0x34, 0xb9, 0x40, 0xb6, 0x54, 0x4a, 0xae, 0xc9, 0x93, 0xe2, 0x69, 0x96,
+	0x65, 0x4c, 0xad, 0xfa, 0xa7, 0x39, 0x8b, 0x71, 0x50, 0x3c, 0xc9, 0x0f, 0xb2, 0x2c, 0x68, 0x48,
+	0x91, 0xef, 0xd6, 0x64, 0x81, 0xe4, 0x4f, 0x5f, 0xff, 0x19, 0xac, 0xe5, 0xa1, 0x95, 0x98, 0xdb,
+	0xee, 0xdf, 0x2b, 0x19, 0x51, 0x87, 0x4a, 0xac, 0x60, 0x17, 0xb2, 0xae, 0x9f, 0xe0, 0x40, 0x30,
+	0x9a, 0x2b, 0xf3, 0xb2, 0xcf, 0xd5, 0x61, 0x2e, 0x9b, 0xb3, 0xdb, 0x7f, 0x31, 0xe0, 0x7b, 0x05,
+	0x36, 0xa8, 0xe6, 0x77, 0x8c, 0x9c, 0xba, 0x94, 0xd3, 0x6f, 0x00, 0x2f, 0x33, 0x1c, 0x58, 0x2e,
+	0x70, 0x40, 0x47, 0x8d, 0x46, 0x05, 0x35, 0x08, 0x34, 0x2f, 0x26, 0x41, 0x20, 0x23, 0xd4, 0x72,
+	0xe4, 0xda, 0xfe, 0xbb, 0x01, 0xf6, 0xbc, 0x0b, 0xfb, 0x43, 0x1a, 0x7a, 0x18, 0x44, 0xde, 0xb7,
+	0xce, 0x87, 0x07, 0xb0, 0xc9, 0x69, 0xe2, 0x21, 0x77, 0xca, 0x3d, 0xb6, 0x42, 0xb5, 0xff, 0xba,
+	0x0c, 0x9b, 0xe5, 0xdc, 0x12, 0xee, 0x8b, 0xee, 0xad, 0x92, 0x53, 0xac, 0xc9, 0x29, 0xac, 0x6b,
+	0xa9, 0xcd, 0xcc, 0x86, 0x84, 0xb7, 0xf7, 0xae, 0xce, 0xd0, 0xee, 0x0b, 0x8d, 0x3d, 0x6d, 0x30,
+	0x25, 0x0d, 0x64, 0x04, 0x90, 0x4f, 0x5d, 0x0a, 0x8d, 0x17, 0x42, 0x91, 0xd4, 0xfc, 0xa9, 0xd2,
+	0xe9, 0x68, 0xea, 0xad, 0xcf, 0x60, 0x7b, 0xee, 0x3e, 0x35, 0xdd, 0xed, 0x89, 0xde, 0xdd, 0xda,
+	0xfd, 0x9d, 0x1a, 0xf7, 0x34, 0x35, 0x7a, 0xf7, 0xfb, 0xa7, 0x01, 0x6d, 0xad, 0xde, 0x6a, 0x63,
+	0x58, 0x46, 0xab, 0xc6, 0x1c, 0x5a, 0x0d, 0x6b, 0x22, 0xb2, 0xc8, 0x98, 0x27, 0xee, 0x53, 0x1b,
+	0x0e, 0x31, 0x92, 0x49, 0xbb, 0x2c, 0xfb, 0xaa, 0xca, 0x76, 0xe2, 0x83, 0x6d, 0x48, 0xd9, 0x7e,
+	0xe2, 0x32, 0xd9, 0x55, 0x5a, 0x8e, 0xda, 0xda, 0xef, 0x42, 0xa7, 0x0a, 0x0e, 0x42, 0x8b, 0x3f,
+	0xa6, 0x5e, 0xee, 0x4b, 0xb6, 0xb3, 0xff, 0x60, 0x00, 0x99, 0x8f, 0xd6, 0x55, 0x21, 0x19, 0x3d,
+	0x65, 0x6a, 0xc2, 0x4f, 0x53, 0x5b, 0xa3, 0x90, 0x43, 0x68, 0xbb, 0xc8, 0xb8, 0x1f, 0x52, 0xae,
+	0x52, 0xb8, 0xdd, 0xff, 0xfe, 0xf5, 0xcf, 0xf2, 0xbc, 0x10, 0x70, 0x74, 0x69, 0xfb, 0x17, 0x70,
+	0xff, 0x5a, 0x6e, 0x6d, 0x52, 0x35, 0x4a, 0x93, 0xea, 0xb5, 0xf3, 0xad, 0x4d, 0xa0, 0x53, 0xc5,
+	0x3e, 0xfb, 0x3d, 0xe8, 0x9c, 0x26, 0xd1, 0x85, 0x1f, 0xf8, 0x61, 0x0e, 0x0d, 0x26, 0xac, 0x62,
+	0x48, 0xcf, 0x03, 0x74, 0xa5, 0xfa, 0x96, 0xa3, 0xb6, 0xf6, 0x23, 0xd8, 0xd6, 0xb8, 0xb3, 0x16,
+	0x71, 0x35, 0xfb, 0x53, 0xd8, 0x4c, 0xd9, 0x51, 0xa9, 0xae, 0x0b, 0x2d, 0x81, 0xa6, 0x3b, 0x19,
+	0xc7, 0x59, 0x93, 0x93, 0x6b, 0xfb, 0xc7, 0xb0, 0x95, 0x4b, 0x16, 0x9d, 0x48, 0x60, 0xb0, 0x14,
+	0x5d, 0x77, 0xe4, 0x5a, 0xd0, 0x62, 0xca, 0x87, 0x99, 0xab, 0x72, 0x6d, 0x6f, 0xc2, 0xfa, 0x51,
+	0x34, 0x18, 0xa9, 0xe1, 0xd6, 0xfe, 0x1c, 0x9a, 0x62, 0x5f, 0x3f, 0x22, 0x46, 0x97, 0x21, 0x26,
+	0x6a, 0x44, 0x94, 0x1b, 0xd1, 0x2e, 0x87, 0x18, 0xb8, 0x67, 0x38, 0x88, 0x42, 0x97, 0x49, 0xb8,
+	0x6a, 0x38, 0x3a, 0x49, 0x38, 0x7c, 0x49, 0x7d, 0xee, 0x87, 0x9e, 0x7c, 0xe7, 0x86, 0xa3, 0xb6,
+	0xf6, 0x07, 0xb0, 0x91, 0xd9, 0xce, 0x2e, 0xfd, 0x00, 0x56, 0x02, 0x41, 0xc8, 0x06, 0xdb, 0x8e,
+	0x9e, 0x10, 0x82, 0xd3, 0x49, 0x8f, 0x6d, 0x0b, 0xcc, 0x02, 0xb3, 0xb3, 0x9c, 0x52, 0x0e, 0xfc,
+	0xc7, 0x80, 0x3b, 0x35, 0x87, 0x45, 0xf4, 0xa7, 0x59, 0x56, 0xa6, 0xae, 0xa9, 0x6d, 0xe6, 0xc8,
+	0xb8, 0x9c, 0xb3, 0x3a, 0x49, 0x7c, 0xff, 0xe5, 0x6d, 0x53, 0xb1, 0xa5, 0xf0, 0x3c, 0x47, 0x17,
+	0x30, 0x9d, 0x75, 0x72, 0xc5, 0x99, 0xc1, 0x74, 0x99, 0x2a, 0x0a, 0xc5, 0xf3, 0x73, 0x9e, 0xb4,
+	0x6a, 0x35, 0x8a, 0x68, 0x05, 0x17, 0x48, 0xf9, 0x24, 0x41, 0x51, 0xba, 0xa2, 0x1a, 0xf3, 0x7d,
+	0xff, 0xbf, 0x37, 0x60, 0xbb, 0xf0, 0x54, 0xfc, 0xf5, 0x07, 0x48, 0x4e, 0xa0, 0xa3, 0x3e, 0x5b,
+	0xd5, 0xe7, 0x1e, 0xb9, 0xab, 0x07, 0xb2, 0xf2, 0x23, 0x92, 0x75, 0xaf, 0xfe, 0x30, 0x0d, 0x98,
+	0xbd, 0x44, 0x3e, 0x84, 0x96, 0xfa, 0x02, 0x2a, 0x2b, 0xaa, 0x7c, 0x17, 0x59, 0x37, 0x6b, 0xbe,
+	0x43, 0xec, 0x25, 0xf2, 0x6b, 0xd8, 0x38, 0xd0, 0xc7, 0x2c, 0xf2, 0x8e, 0xce, 0x77, 0xe5, 0xa7,
+	0x85, 0x65, 0x57, 0xd9, 0xe6, 0xe7, 0x2d, 0x7b, 0x89, 0xfc, 0xde, 0x80, 0x9b, 0x07, 0xc8, 0xab,
+	0xb3, 0x07, 0x79, 0x54, 0x6f, 0xe4, 0x8a, 0x19, 0xc5, 0x3a, 0x5c, 0xa8, 0xa3, 0x97, 0x75, 0xda,
+	0x4b, 0xe4, 0x8f, 0x06, 0xdc, 0xd2, 0x6e, 0x95, 0x8f, 0x13, 0xa4, 0x7b, 0xfd, 0xb5, 0xaa, 0x73,
+	0x87, 0x75, 0xf4, 0x15, 0xdc, 0x2b, 0x57, 0x6a, 0x2f, 0x91, 0x63, 0x58, 0x3f, 0x43, 0x9e, 0xa3,
+	0x12, 0x29, 0x3d, 0x7e, 0x15, 0xda, 0xac, 0xfb, 0x57, 0x9c, 0xe6, 0xd1, 0x3f, 0x00, 0x38, 0x50,
+	0xea, 0x90, 0x58, 0xf3, 0xec, 0x0a, 0xca, 0xac, 0xbb, 0xb5, 0x67, 0xb9, 0xa2, 0x3d, 0x68, 0x1d,
+	0x20, 0x97, 0x68, 0x40, 0xcc, 0x6a, 0xd9, 0xe7, 0x19, 0x76, 0xa7, 0xe6, 0x24, 0x57, 0xf1, 0xa9,
+	0xbc, 0x8b, 0x2a, 0x9c, 0xb7, 0xeb, 0x03, 0x5d, 0x06, 0x0b, 0xeb, 0x9d, 0xff, 0xc3, 0xa5, 0x94,
+	0x7f, 0xf4, 0xe1, 0x97, 0xaf, 0x77, 0x8c, 0xbf, 0xbd, 0xde, 0x31, 0xfe, 0xfd, 0x7a, 0xc7, 0xf8,
+	0xd5, 0x0f, 0xaf, 0xfb, 0x59, 0x57, 0xfb, 0xf9, 0x99, 0xc6, 0xfe, 0x20, 0xf0, 0x31, 0xe4, 0xe7,
+	0x37, 0xe4, 0x8f, 0xb8, 0xef, 0xff, 0x6f, 0x00, 0x77, 0x62, 0x56, 0x9e, 0x9d, 0x16, 0x00, 0x00,
 }
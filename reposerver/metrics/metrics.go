@@ -2,20 +2,38 @@ package metrics
 
 import (
 	"net/http"
+	"sync"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/cache"
+	"github.com/argoproj/argo-cd/util/git"
 	"github.com/argoproj/argo-cd/util/repo"
 	"github.com/argoproj/argo-cd/util/repo/factory"
 	"github.com/argoproj/argo-cd/util/repo/metrics"
 )
 
 type MetricsServer struct {
-	handler           http.Handler
-	gitRequestCounter *prometheus.CounterVec
-	factory           factory.Factory
+	handler                  http.Handler
+	gitRequestCounter        *prometheus.CounterVec
+	gitRequestHistogram      *prometheus.HistogramVec
+	gitRequestFailureCounter *prometheus.CounterVec
+	gitAuthFailureCounter    *prometheus.CounterVec
+	gitLastFetchGauge        *prometheus.GaugeVec
+	gitCheckoutRepairCounter *prometheus.CounterVec
+	manifestRequestCounter   *prometheus.CounterVec
+	factory                  factory.Factory
+	cache                    *cache.Cache
+	// failures holds the most recent failure of each repository
+	failures map[string]*v1alpha1.RepositoryFailure
+	// lastSuccess holds the time of the last successful request to each repository
+	lastSuccess map[string]metav1.Time
+	lock        sync.Mutex
 }
 
 type GitRequestType string
@@ -25,8 +43,9 @@ const (
 	GitRequestTypeFetch    = "fetch"
 )
 
-// NewMetricsServer returns a new prometheus server which collects application metrics
-func NewMetricsServer(factory factory.Factory) *MetricsServer {
+// NewMetricsServer returns a new prometheus server which collects application metrics. Failed requests to
+// repositories are recorded in the given cache, so that they can be listed by the API server.
+func NewMetricsServer(factory factory.Factory, cache *cache.Cache) *MetricsServer {
 	registry := prometheus.NewRegistry()
 	registry.MustRegister(prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}))
 	registry.MustRegister(prometheus.NewGoCollector())
@@ -40,10 +59,74 @@ func NewMetricsServer(factory factory.Factory) *MetricsServer {
 	)
 	registry.MustRegister(gitRequestCounter)
 
+	gitRequestHistogram := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "argocd_git_request_duration_seconds",
+			Help:    "Git requests duration.",
+			Buckets: []float64{0.1, 0.25, .5, 1, 2, 4, 10, 20, 60},
+		},
+		[]string{"repo", "request_type"},
+	)
+	registry.MustRegister(gitRequestHistogram)
+
+	gitRequestFailureCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_git_request_failure_total",
+			Help: "Number of failed git requests performed by repo server",
+		},
+		[]string{"repo", "request_type"},
+	)
+	registry.MustRegister(gitRequestFailureCounter)
+
+	gitAuthFailureCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_git_auth_failure_total",
+			Help: "Number of git requests which failed because the repository credentials were rejected",
+		},
+		[]string{"repo"},
+	)
+	registry.MustRegister(gitAuthFailureCounter)
+
+	gitLastFetchGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "argocd_git_last_successful_fetch_timestamp_seconds",
+			Help: "Time of the last successful git fetch of a repository",
+		},
+		[]string{"repo"},
+	)
+	registry.MustRegister(gitLastFetchGauge)
+
+	gitCheckoutRepairCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_git_checkout_repair_total",
+			Help: "Number of corrupted local repositories which were removed and cloned again",
+		},
+		[]string{"repo"},
+	)
+	registry.MustRegister(gitCheckoutRepairCounter)
+
+	manifestRequestCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_manifest_request_total",
+			Help: "Number of manifest requests, which are coalesced if an identical request is in progress",
+		},
+		[]string{"repo", "coalesced"},
+	)
+	registry.MustRegister(manifestRequestCounter)
+
 	return &MetricsServer{
-		factory:           factory,
-		handler:           promhttp.HandlerFor(registry, promhttp.HandlerOpts{}),
-		gitRequestCounter: gitRequestCounter,
+		factory:                  factory,
+		cache:                    cache,
+		handler:                  promhttp.HandlerFor(registry, promhttp.HandlerOpts{}),
+		gitRequestCounter:        gitRequestCounter,
+		gitRequestHistogram:      gitRequestHistogram,
+		gitRequestFailureCounter: gitRequestFailureCounter,
+		gitAuthFailureCounter:    gitAuthFailureCounter,
+		gitLastFetchGauge:        gitLastFetchGauge,
+		gitCheckoutRepairCounter: gitCheckoutRepairCounter,
+		manifestRequestCounter:   manifestRequestCounter,
+		failures:                 make(map[string]*v1alpha1.RepositoryFailure),
+		lastSuccess:              make(map[string]metav1.Time),
 	}
 }
 
@@ -56,12 +139,98 @@ func (m *MetricsServer) IncGitRequest(repo string, requestType GitRequestType) {
 	m.gitRequestCounter.WithLabelValues(repo, string(requestType)).Inc()
 }
 
-func (m *MetricsServer) Event(repo string, requestType string) {
-	switch requestType {
+func getGitRequestType(event string) (GitRequestType, bool) {
+	switch event {
 	case "GitRequestTypeLsRemote":
-		m.IncGitRequest(repo, GitRequestTypeLsRemote)
+		return GitRequestTypeLsRemote, true
 	case "GitRequestTypeFetch":
-		m.IncGitRequest(repo, GitRequestTypeFetch)
+		return GitRequestTypeFetch, true
+	}
+	return "", false
+}
+
+func (m *MetricsServer) Event(repo string, event string) {
+	switch event {
+	case "GitCheckoutRepair":
+		m.gitCheckoutRepairCounter.WithLabelValues(repo).Inc()
+		return
+	case "ManifestRequest":
+		m.manifestRequestCounter.WithLabelValues(repo, "false").Inc()
+		return
+	case "ManifestRequestCoalesced":
+		m.manifestRequestCounter.WithLabelValues(repo, "true").Inc()
+		return
+	}
+	if requestType, ok := getGitRequestType(event); ok {
+		m.IncGitRequest(repo, requestType)
+	}
+}
+
+// Observe records the duration and the outcome of a git request
+func (m *MetricsServer) Observe(repo string, event string, duration time.Duration, err error) {
+	requestType, ok := getGitRequestType(event)
+	if !ok {
+		return
+	}
+	m.gitRequestHistogram.WithLabelValues(repo, string(requestType)).Observe(duration.Seconds())
+	if err != nil {
+		authFailure := git.IsAuthError(err)
+		m.gitRequestFailureCounter.WithLabelValues(repo, string(requestType)).Inc()
+		if authFailure {
+			m.gitAuthFailureCounter.WithLabelValues(repo).Inc()
+		}
+		m.recordFailure(repo, requestType, err, authFailure)
+		return
+	}
+	if requestType == GitRequestTypeFetch {
+		m.gitLastFetchGauge.WithLabelValues(repo).SetToCurrentTime()
+	}
+	m.recordSuccess(repo)
+}
+
+func (m *MetricsServer) recordFailure(repo string, requestType GitRequestType, err error, authFailure bool) {
+	m.lock.Lock()
+	failure, ok := m.failures[repo]
+	if !ok {
+		failure = &v1alpha1.RepositoryFailure{Repo: repo}
+		m.failures[repo] = failure
+	}
+	if lastSuccess, ok := m.lastSuccess[repo]; ok {
+		failure.LastSucceededAt = &lastSuccess
+	}
+	failure.RequestType = string(requestType)
+	failure.Message = err.Error()
+	failure.AuthFailure = authFailure
+	failure.Failures++
+	failure.FailedAt = metav1.Now()
+	persisted := failure.DeepCopy()
+	m.lock.Unlock()
+	m.persistFailure(persisted)
+}
+
+func (m *MetricsServer) recordSuccess(repo string) {
+	m.lock.Lock()
+	now := metav1.Now()
+	m.lastSuccess[repo] = now
+	failure, ok := m.failures[repo]
+	if !ok || failure.Failures == 0 {
+		m.lock.Unlock()
+		return
+	}
+	// the failure is kept, so that recent failures are listed even if the repository is reachable again
+	failure.Failures = 0
+	failure.LastSucceededAt = &now
+	persisted := failure.DeepCopy()
+	m.lock.Unlock()
+	m.persistFailure(persisted)
+}
+
+func (m *MetricsServer) persistFailure(failure *v1alpha1.RepositoryFailure) {
+	if m.cache == nil {
+		return
+	}
+	if err := m.cache.SetRepoFailure(failure.Repo, failure); err != nil {
+		log.Warnf("repository failure cache set error %s: %v", failure.Repo, err)
 	}
 }
 
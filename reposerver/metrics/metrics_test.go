@@ -1,11 +1,14 @@
 package metrics
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
+	"github.com/argoproj/argo-cd/util/cache"
 	factorymocks "github.com/argoproj/argo-cd/util/repo/factory/mocks"
 	repomocks "github.com/argoproj/argo-cd/util/repo/mocks"
 )
@@ -14,7 +17,7 @@ func TestNewMetricsServer(t *testing.T) {
 	factory := &factorymocks.Factory{}
 	repo := &repomocks.Repo{}
 	factory.On("NewRepo", mock.Anything, mock.Anything).Return(repo, nil)
-	server := NewMetricsServer(factory)
+	server := NewMetricsServer(factory, nil)
 	_, err := server.NewRepo(nil, nil)
 	assert.NoError(t, err)
 	server.Event("foo", "GitRequestTypeFetch")
@@ -25,4 +28,39 @@ func TestNewMetricsServer(t *testing.T) {
 	counter, err = server.gitRequestCounter.GetMetricWithLabelValues("foo", "ls-remote")
 	assert.NoError(t, err)
 	assert.NotNil(t, counter)
+	server.Event("foo", "GitCheckoutRepair")
+	counter, err = server.gitCheckoutRepairCounter.GetMetricWithLabelValues("foo")
+	assert.NoError(t, err)
+	assert.NotNil(t, counter)
+	server.Event("foo", "ManifestRequestCoalesced")
+	counter, err = server.manifestRequestCounter.GetMetricWithLabelValues("foo", "true")
+	assert.NoError(t, err)
+	assert.NotNil(t, counter)
+}
+
+func TestObserve(t *testing.T) {
+	repoCache := cache.NewCache(cache.NewInMemoryCache(time.Hour))
+	server := NewMetricsServer(&factorymocks.Factory{}, repoCache)
+
+	server.Observe("foo", "GitRequestTypeFetch", time.Second, nil)
+	_, err := repoCache.GetRepoFailure("foo")
+	assert.Equal(t, cache.ErrCacheMiss, err)
+
+	server.Observe("foo", "GitRequestTypeLsRemote", time.Second, fmt.Errorf("authentication required"))
+	server.Observe("foo", "GitRequestTypeFetch", time.Second, fmt.Errorf("connection refused"))
+	failure, err := repoCache.GetRepoFailure("foo")
+	assert.NoError(t, err)
+	assert.Equal(t, "foo", failure.Repo)
+	assert.Equal(t, "fetch", failure.RequestType)
+	assert.Equal(t, "connection refused", failure.Message)
+	assert.False(t, failure.AuthFailure)
+	assert.Equal(t, int64(2), failure.Failures)
+	assert.NotNil(t, failure.LastSucceededAt)
+
+	// the failure is kept once the repository is reachable again
+	server.Observe("foo", "GitRequestTypeFetch", time.Second, nil)
+	failure, err = repoCache.GetRepoFailure("foo")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), failure.Failures)
+	assert.Equal(t, "connection refused", failure.Message)
 }
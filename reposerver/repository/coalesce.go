@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/argoproj/argo-cd/reposerver/apiclient"
+)
+
+const (
+	// EventManifestRequest is reported for manifest requests which start a manifest generation
+	EventManifestRequest = "ManifestRequest"
+	// EventManifestRequestCoalesced is reported for manifest requests which share the generation of an identical
+	// request in progress
+	EventManifestRequestCoalesced = "ManifestRequestCoalesced"
+)
+
+// manifestGeneration is a manifest generation in progress, whose result is shared by identical requests
+type manifestGeneration struct {
+	done chan struct{}
+	res  *apiclient.ManifestResponse
+	err  error
+}
+
+// generateManifestCoalesced generates the manifests of the request, unless an identical request is in progress, e.g.
+// of several applications with the same source or of the UI and the controller, whose result is returned instead.
+// The generation is not cancelled with the context of the request, since other requests might wait for it. Its
+// manifests are cached even if all requests were cancelled, and shutdown waits for it like for the background refreshes.
+func (s *Service) generateManifestCoalesced(ctx context.Context, q *apiclient.ManifestRequest) (*apiclient.ManifestResponse, error) {
+	key := fmt.Sprintf("%x", sha256.Sum256([]byte(q.String())))
+	generation := &manifestGeneration{done: make(chan struct{})}
+	repoURL := ""
+	if q.Repo != nil {
+		repoURL = q.Repo.Repo
+	}
+	if inProgress, coalesced := s.inFlightManifests.LoadOrStore(key, generation); coalesced {
+		generation = inProgress.(*manifestGeneration)
+		s.reportEvent(repoURL, EventManifestRequestCoalesced)
+	} else {
+		s.reportEvent(repoURL, EventManifestRequest)
+		s.backgroundRefreshes.Add(1)
+		go func() {
+			defer s.backgroundRefreshes.Done()
+			defer close(generation.done)
+			defer s.inFlightManifests.Delete(key)
+			generation.res, generation.err = s.generateManifest(context.Background(), q)
+		}()
+	}
+	select {
+	case <-generation.done:
+		return generation.res, generation.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (s *Service) reportEvent(repoURL string, event string) {
+	if s.reporter != nil {
+		s.reporter.Event(repoURL, event)
+	}
+}
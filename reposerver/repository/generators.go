@@ -0,0 +1,191 @@
+package repository
+
+import (
+	"fmt"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/util/creds"
+	"github.com/argoproj/argo-cd/util/helm"
+	"github.com/argoproj/argo-cd/util/kustomize"
+)
+
+// GeneratedManifests holds the objects which a ManifestGenerator generated from an application source
+type GeneratedManifests struct {
+	Objects []*unstructured.Unstructured
+	// Destination overrides the destination of the application if not nil, e.g. with the one of a ksonnet environment
+	Destination *v1alpha1.ApplicationDestination
+	// RenderedSources are the charts or remote bases which the objects were rendered from
+	RenderedSources []v1alpha1.RenderedSource
+}
+
+// ManifestGenerator generates the manifests of the applications of one source type
+type ManifestGenerator interface {
+	// Generate generates the manifests of the application in appPath. Labels, patches and parameter overrides are
+	// applied by the caller.
+	Generate(appPath string, q *apiclient.ManifestRequest) (*GeneratedManifests, error)
+}
+
+// ManifestGeneratorFunc is a function which implements ManifestGenerator
+type ManifestGeneratorFunc func(appPath string, q *apiclient.ManifestRequest) (*GeneratedManifests, error)
+
+// Generate calls the function
+func (f ManifestGeneratorFunc) Generate(appPath string, q *apiclient.ManifestRequest) (*GeneratedManifests, error) {
+	return f(appPath, q)
+}
+
+// SourceTypeDetector is implemented by manifest generators which recognize the directories of their applications, so
+// that their source type is used for applications which do not set a source type explicitly
+type SourceTypeDetector interface {
+	// Detect returns true if the application in appPath is of the source type of the generator
+	Detect(appPath string) (bool, error)
+}
+
+var (
+	generatorsLock sync.RWMutex
+	generators     = make(map[v1alpha1.ApplicationSourceType]ManifestGenerator)
+	// detectors holds the source types whose generators implement SourceTypeDetector, in the order of registration
+	detectors []v1alpha1.ApplicationSourceType
+)
+
+func init() {
+	RegisterManifestGenerator(v1alpha1.ApplicationSourceTypeKsonnet, ManifestGeneratorFunc(generateKsonnetManifests))
+	RegisterManifestGenerator(v1alpha1.ApplicationSourceTypeHelm, ManifestGeneratorFunc(generateHelmManifests))
+	RegisterManifestGenerator(v1alpha1.ApplicationSourceTypeKustomize, ManifestGeneratorFunc(generateKustomizeManifests))
+	RegisterManifestGenerator(v1alpha1.ApplicationSourceTypePlugin, ManifestGeneratorFunc(generatePluginManifests))
+	RegisterManifestGenerator(v1alpha1.ApplicationSourceTypeDirectory, ManifestGeneratorFunc(generateDirectoryManifests))
+}
+
+// RegisterManifestGenerator registers the manifest generator of the given source type, replacing the generator which
+// was registered for it before. Generators which implement SourceTypeDetector are asked to recognize applications
+// without explicit source type before the built-in source types are detected.
+func RegisterManifestGenerator(sourceType v1alpha1.ApplicationSourceType, generator ManifestGenerator) {
+	generatorsLock.Lock()
+	defer generatorsLock.Unlock()
+	generators[sourceType] = generator
+	for i, t := range detectors {
+		if t == sourceType {
+			detectors = append(detectors[:i], detectors[i+1:]...)
+			break
+		}
+	}
+	if _, ok := generator.(SourceTypeDetector); ok {
+		detectors = append(detectors, sourceType)
+	}
+}
+
+// getManifestGenerator returns the manifest generator of the given source type
+func getManifestGenerator(sourceType v1alpha1.ApplicationSourceType) (ManifestGenerator, error) {
+	generatorsLock.RLock()
+	defer generatorsLock.RUnlock()
+	generator, ok := generators[sourceType]
+	if !ok {
+		return nil, fmt.Errorf("source type '%s' is not supported", sourceType)
+	}
+	return generator, nil
+}
+
+// detectSourceType returns the source type of the first registered detector which recognizes the application in
+// appPath, or "" if none does
+func detectSourceType(appPath string) (v1alpha1.ApplicationSourceType, error) {
+	generatorsLock.RLock()
+	defer generatorsLock.RUnlock()
+	for _, sourceType := range detectors {
+		ok, err := generators[sourceType].(SourceTypeDetector).Detect(appPath)
+		if err != nil {
+			return "", err
+		}
+		if ok {
+			return sourceType, nil
+		}
+	}
+	return "", nil
+}
+
+func generateKsonnetManifests(appPath string, q *apiclient.ManifestRequest) (*GeneratedManifests, error) {
+	targetObjs, dest, err := ksShow(q.AppLabelKey, appPath, q.ApplicationSource.Ksonnet)
+	if err != nil {
+		return nil, err
+	}
+	return &GeneratedManifests{Objects: targetObjs, Destination: dest}, nil
+}
+
+func generateHelmManifests(appPath string, q *apiclient.ManifestRequest) (*GeneratedManifests, error) {
+	h, err := helm.NewHelmApp(appPath, q.Repos)
+	if err != nil {
+		return nil, err
+	}
+	defer h.Dispose()
+	err = h.Init()
+	if err != nil {
+		return nil, err
+	}
+	err = checkValueFiles(appPath, repoRootPath(appPath, q.ApplicationSource.Path, q.Repo), q.ApplicationSource.Helm)
+	if err != nil {
+		return nil, err
+	}
+	opts, cleanup, err := decryptValueFiles(appPath, q.ApplicationSource.Helm, q.DecryptionKeys)
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+	targetObjs, err := h.Template(q.AppLabelValue, q.Namespace, q.KubeVersion, q.ApiVersions, opts)
+	if err != nil {
+		if !helm.IsMissingDependencyErr(err) {
+			return nil, err
+		}
+		err = h.DependencyBuild()
+		if err != nil {
+			return nil, err
+		}
+		targetObjs, err = h.Template(q.AppLabelValue, q.Namespace, q.KubeVersion, q.ApiVersions, opts)
+		if err != nil {
+			return nil, err
+		}
+	}
+	renderedSources, err := helm.ChartSources(appPath)
+	if err != nil {
+		return nil, err
+	}
+	return &GeneratedManifests{Objects: targetObjs, RenderedSources: renderedSources}, nil
+}
+
+func generateKustomizeManifests(appPath string, q *apiclient.ManifestRequest) (*GeneratedManifests, error) {
+	repoURL := ""
+	if q.Repo != nil {
+		repoURL = q.Repo.Repo
+	}
+	k := kustomize.NewKustomizeApp(appPath, creds.GetRepoCreds(q.Repo), repoURL)
+	targetObjs, _, err := k.Build(q.ApplicationSource.Kustomize, q.KustomizeOptions)
+	if err != nil {
+		return nil, err
+	}
+	renderedSources, err := kustomize.RenderedSources(appPath)
+	if err != nil {
+		return nil, err
+	}
+	return &GeneratedManifests{Objects: targetObjs, RenderedSources: renderedSources}, nil
+}
+
+func generatePluginManifests(appPath string, q *apiclient.ManifestRequest) (*GeneratedManifests, error) {
+	targetObjs, err := runConfigManagementPlugin(appPath, q, creds.GetRepoCreds(q.Repo))
+	if err != nil {
+		return nil, err
+	}
+	return &GeneratedManifests{Objects: targetObjs}, nil
+}
+
+func generateDirectoryManifests(appPath string, q *apiclient.ManifestRequest) (*GeneratedManifests, error) {
+	var directory *v1alpha1.ApplicationSourceDirectory
+	if directory = q.ApplicationSource.Directory; directory == nil {
+		directory = &v1alpha1.ApplicationSourceDirectory{}
+	}
+	targetObjs, err := findManifests(appPath, repoRootPath(appPath, q.ApplicationSource.Path, q.Repo), *directory)
+	if err != nil {
+		return nil, err
+	}
+	return &GeneratedManifests{Objects: targetObjs}, nil
+}
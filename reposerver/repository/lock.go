@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/argoproj/argo-cd/util"
+	"github.com/argoproj/argo-cd/util/repo"
+)
+
+// checkoutLock holds the lock of a repository while it is fetched and the requested revision is checked out, and the
+// lock of the checkout while its files are used. The repository lock is released once the revision is checked out, so
+// that manifests of other revisions of the same repository are generated concurrently.
+type checkoutLock struct {
+	keyLock *util.KeyLock
+	repo    repo.Repo
+	// owner describes the operation which holds the locks, e.g. "GenerateManifest guestbook@master"
+	owner       string
+	timeout     time.Duration
+	repoKey     string
+	checkoutKey string
+	repoLocked  bool
+}
+
+// lockRepo locks the given repository on behalf of the owner
+func (s *Service) lockRepo(r repo.Repo, owner string) (*checkoutLock, error) {
+	l := &checkoutLock{keyLock: s.repoLock, repo: r, owner: owner, timeout: s.lockTimeout, repoKey: r.LockKey()}
+	if err := l.keyLock.LockWithTimeout(l.repoKey, owner, l.timeout); err != nil {
+		return nil, lockError(err)
+	}
+	l.repoLocked = true
+	return l, nil
+}
+
+// lockCheckout locks the checkout with the given key. The repository stays locked until unlockRepo is called.
+func (l *checkoutLock) lockCheckout(key string) error {
+	if key == l.repoKey || l.checkoutKey != "" {
+		return nil
+	}
+	// the checkout is always locked while holding the repository lock, so that the locks are acquired in order
+	if err := l.keyLock.LockWithTimeout(key, l.owner, l.timeout); err != nil {
+		return lockError(err)
+	}
+	l.checkoutKey = key
+	return nil
+}
+
+// unlockRepo releases the repository lock, unless the checkout shares the lock of the repository
+func (l *checkoutLock) unlockRepo() {
+	if l.repoLocked && l.checkoutKey != "" {
+		l.keyLock.Unlock(l.repoKey)
+		l.repoLocked = false
+	}
+}
+
+// unlock releases the checkouts which were used, if the repository keeps them from being removed, and all locks which
+// are still held
+func (l *checkoutLock) unlock() {
+	if releasable, ok := l.repo.(repo.ReleasableRepo); ok {
+		releasable.Release()
+	}
+	if l.repoLocked {
+		l.keyLock.Unlock(l.repoKey)
+		l.repoLocked = false
+	}
+	if l.checkoutKey != "" {
+		l.keyLock.Unlock(l.checkoutKey)
+		l.checkoutKey = ""
+	}
+}
+
+// lockError returns the error of a lock which was not acquired within the lock timeout. The holder of the lock is
+// logged, since it is likely stuck.
+func lockError(err error) error {
+	if timeoutErr, ok := err.(*util.KeyLockTimeoutError); ok {
+		log.WithFields(log.Fields{"key": timeoutErr.Key, "owner": timeoutErr.Owner, "heldSince": timeoutErr.HeldSince}).Warn("Lock timeout")
+		return status.Error(codes.Aborted, err.Error())
+	}
+	return err
+}
@@ -10,6 +10,8 @@ import (
 	"path/filepath"
 	"regexp"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/TomOnTime/utfutil"
 	argoexec "github.com/argoproj/pkg/exec"
@@ -18,6 +20,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/sync/semaphore"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -28,24 +31,38 @@ import (
 	"github.com/argoproj/argo-cd/reposerver/apiclient"
 	"github.com/argoproj/argo-cd/util"
 	"github.com/argoproj/argo-cd/util/app/discovery"
+	apppath "github.com/argoproj/argo-cd/util/app/path"
 	"github.com/argoproj/argo-cd/util/cache"
 	"github.com/argoproj/argo-cd/util/config"
 	"github.com/argoproj/argo-cd/util/creds"
 	"github.com/argoproj/argo-cd/util/git"
 	gitrepo "github.com/argoproj/argo-cd/util/git/repo"
+	grpc_util "github.com/argoproj/argo-cd/util/grpc"
 	"github.com/argoproj/argo-cd/util/helm"
 	"github.com/argoproj/argo-cd/util/ksonnet"
 	"github.com/argoproj/argo-cd/util/kube"
 	"github.com/argoproj/argo-cd/util/kustomize"
+	"github.com/argoproj/argo-cd/util/profile"
 	"github.com/argoproj/argo-cd/util/repo"
 	"github.com/argoproj/argo-cd/util/repo/factory"
 	"github.com/argoproj/argo-cd/util/repo/metrics"
+	"github.com/argoproj/argo-cd/util/resource"
+	"github.com/argoproj/argo-cd/util/sops"
 	"github.com/argoproj/argo-cd/util/text"
+	"github.com/argoproj/argo-cd/util/writeback"
 )
 
 const (
 	PluginEnvAppName      = "ARGOCD_APP_NAME"
 	PluginEnvAppNamespace = "ARGOCD_APP_NAMESPACE"
+
+	// staleManifestsRefreshDelay is the delay before manifests which were served stale are generated again
+	staleManifestsRefreshDelay = 30 * time.Second
+
+	// The optional features of the repo server reported by GetVersion
+	FeatureStaleManifests = "staleManifests"
+	FeatureSparseCheckout = "sparseCheckout"
+	FeatureProfiling      = "profiling"
 )
 
 // Service implements ManifestService interface
@@ -54,19 +71,81 @@ type Service struct {
 	repoFactory               factory.Factory
 	cache                     *cache.Cache
 	parallelismLimitSemaphore *semaphore.Weighted
+	allowStaleManifests       bool
+	sparseCheckout            bool
+	revisionMessageLength     int
+	lockTimeout               time.Duration
+	profiler                  *profile.Profiler
+	// profilerTokenPath is the file of the token which the profiling requests of the API server must carry
+	profilerTokenPath string
+	// staleManifestsRefreshes holds the requests which are scheduled to be generated again, keyed by stale manifests cache key
+	staleManifestsRefreshes sync.Map
+	// backgroundRefreshes tracks the scheduled and running refreshes and the manifest generations of coalesced requests,
+	// so that shutdown can wait for their cache writes
+	backgroundRefreshes sync.WaitGroup
+	// shutdownLock protects shuttingDown, which is set once shutdown begins and closes shutdownCh
+	shutdownLock sync.Mutex
+	shuttingDown bool
+	shutdownCh   chan struct{}
+	// inFlightManifests holds the manifest generations in progress, keyed by the hash of their request, so that
+	// identical requests are coalesced
+	inFlightManifests sync.Map
+	// reporter records whether manifest requests were coalesced
+	reporter metrics.Reporter
+	// toolVersions holds the versions of the tools, which are determined once by the first GetVersion call
+	toolVersions     apiclient.RepoServerVersionResponse
+	toolVersionsOnce sync.Once
 }
 
 // NewService returns a new instance of the Manifest service
-func NewService(repoFactory factory.Factory, cache *cache.Cache, parallelismLimit int64) *Service {
+func NewService(repoFactory factory.Factory, cache *cache.Cache, parallelismLimit int64, allowStaleManifests bool, sparseCheckout bool, revisionMessageLength int, lockTimeout time.Duration, profiler *profile.Profiler) *Service {
 	var parallelismLimitSemaphore *semaphore.Weighted
 	if parallelismLimit > 0 {
 		parallelismLimitSemaphore = semaphore.NewWeighted(parallelismLimit)
 	}
+	// the factory of the repo server is its metrics server, which records the events of the repositories
+	reporter, ok := repoFactory.(metrics.Reporter)
+	if !ok {
+		reporter = metrics.NopReporter
+	}
 	return &Service{
 		parallelismLimitSemaphore: parallelismLimitSemaphore,
 		repoLock:                  util.NewKeyLock(),
 		repoFactory:               repoFactory,
 		cache:                     cache,
+		allowStaleManifests:       allowStaleManifests,
+		sparseCheckout:            sparseCheckout,
+		revisionMessageLength:     revisionMessageLength,
+		lockTimeout:               lockTimeout,
+		profiler:                  profiler,
+		profilerTokenPath:         common.DefaultPathRepoServerProfilerToken,
+		reporter:                  reporter,
+		shutdownCh:                make(chan struct{}),
+	}
+}
+
+// Shutdown cancels the refreshes which have not started yet and waits until the running ones and the manifest
+// generations of requests which were cancelled have written their manifests to the cache, or until the context is
+// done. Callers stop serving RPCs first, so that in-flight manifest generations finish and release their repository
+// locks, and no further generations are started.
+func (s *Service) Shutdown(ctx context.Context) error {
+	s.shutdownLock.Lock()
+	if !s.shuttingDown {
+		s.shuttingDown = true
+		close(s.shutdownCh)
+	}
+	s.shutdownLock.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		s.backgroundRefreshes.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
 	}
 }
 
@@ -76,8 +155,11 @@ func (s *Service) ListApps(ctx context.Context, q *apiclient.ListAppsRequest) (*
 	if err != nil {
 		return nil, err
 	}
-	s.repoLock.Lock(r.LockKey())
-	defer s.repoLock.Unlock(r.LockKey())
+	lock, err := s.lockRepo(r, fmt.Sprintf("ListApps %s", q.Revision))
+	if err != nil {
+		return nil, err
+	}
+	defer lock.unlock()
 	err = r.Init()
 	if err != nil {
 		return nil, err
@@ -94,7 +176,11 @@ func (s *Service) ListApps(ctx context.Context, q *apiclient.ListAppsRequest) (*
 		log.Infof("cache hit: %s/%s", q.Repo.Repo, q.Revision)
 		return &apiclient.AppList{Apps: apps}, nil
 	}
+	if err := lock.lockCheckout(r.AppLockKey(resolvedRevision)); err != nil {
+		return nil, err
+	}
 	apps, err := r.ListApps(resolvedRevision)
+	lock.unlockRepo()
 	if err != nil {
 		return nil, err
 	}
@@ -108,17 +194,92 @@ func (s *Service) ListApps(ctx context.Context, q *apiclient.ListAppsRequest) (*
 }
 
 func (s *Service) GenerateManifest(c context.Context, q *apiclient.ManifestRequest) (*apiclient.ManifestResponse, error) {
+	res, err := s.generateManifestCoalesced(c, q)
+	if err != nil {
+		if !s.allowStaleManifests || grpc_util.GetErrorReason(err) != grpc_util.ErrorReasonRepositoryUnreachable {
+			return nil, err
+		}
+		var stale apiclient.ManifestResponse
+		if cacheErr := s.cache.GetStaleManifests(q.Revision, q.ApplicationSource, q.Namespace, q.AppLabelKey, q.AppLabelValue, &stale); cacheErr != nil {
+			return nil, err
+		}
+		log.Warnf("serving stale manifests of %s/%s since repository is unreachable: %v", q.ApplicationSource.String(), stale.Revision, err)
+		s.scheduleStaleManifestsRefresh(q)
+		stale.Stale = true
+		res = &stale
+	}
+	// cached manifests might have been generated before the quota was set
+	if err := checkManifestQuota(q, res.Manifests); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// checkManifestQuota returns a ResourceExhausted error if the manifests exceed the quota of the request
+func checkManifestQuota(q *apiclient.ManifestRequest, manifests []string) error {
+	if err := q.ManifestQuota.Check(manifests); err != nil {
+		return grpc_util.WrapError(err, codes.ResourceExhausted, grpc_util.ErrorReasonManifestQuotaExceeded)
+	}
+	return nil
+}
+
+// scheduleStaleManifestsRefresh generates the manifests again in the background, so that the cache is up to date as soon
+// as the repository is reachable again. At most one refresh is scheduled for the same manifests.
+func (s *Service) scheduleStaleManifestsRefresh(q *apiclient.ManifestRequest) {
+	key := fmt.Sprintf("%s|%s|%s|%s|%s", q.Revision, q.ApplicationSource.String(), q.Namespace, q.AppLabelKey, q.AppLabelValue)
+	s.shutdownLock.Lock()
+	defer s.shutdownLock.Unlock()
+	if s.shuttingDown {
+		return
+	}
+	if _, scheduled := s.staleManifestsRefreshes.LoadOrStore(key, true); scheduled {
+		return
+	}
+	s.backgroundRefreshes.Add(1)
+	go func() {
+		defer s.backgroundRefreshes.Done()
+		defer s.staleManifestsRefreshes.Delete(key)
+		select {
+		case <-time.After(staleManifestsRefreshDelay):
+		case <-s.shutdownCh:
+			return
+		}
+		if _, err := s.generateManifest(context.Background(), q); err != nil {
+			log.Warnf("failed to refresh stale manifests of %s: %v", q.ApplicationSource.String(), err)
+		} else {
+			log.Infof("refreshed stale manifests of %s", q.ApplicationSource.String())
+		}
+	}()
+}
+
+func (s *Service) generateManifest(c context.Context, q *apiclient.ManifestRequest) (*apiclient.ManifestResponse, error) {
+	res, err := s.generateManifestFromCheckout(c, q, s.sparseCheckout)
+	// the paths of the sparse checkout might not include all files which the manifests are generated from
+	if err != nil && s.sparseCheckout && grpc_util.GetErrorReason(err) == grpc_util.ErrorReasonRenderFailed {
+		log.Warnf("Failed to generate manifests of %s from a sparse checkout, checking out all paths: %v", q.ApplicationSource.String(), err)
+		res, err = s.generateManifestFromCheckout(c, q, false)
+	}
+	return res, err
+}
+
+func (s *Service) generateManifestFromCheckout(c context.Context, q *apiclient.ManifestRequest, sparse bool) (*apiclient.ManifestResponse, error) {
 	r, err := s.repoFactory.NewRepo(q.Repo, metrics.NopReporter)
 	if err != nil {
 		return nil, err
 	}
-	s.repoLock.Lock(r.LockKey())
-	defer s.repoLock.Unlock(r.LockKey())
-	err = r.Init()
+	lock, err := s.lockRepo(r, fmt.Sprintf("GenerateManifest %s@%s", q.ApplicationSource.Path, q.Revision))
 	if err != nil {
 		return nil, err
 	}
+	defer lock.unlock()
+	err = r.Init()
+	if err != nil {
+		return nil, grpc_util.WrapError(err, codes.Unknown, grpc_util.ErrorReasonRepositoryUnreachable)
+	}
 	resolvedRevision, err := r.ResolveAppRevision(q.ApplicationSource.Path, q.Revision)
+	if err != nil {
+		return nil, grpc_util.WrapError(err, codes.Unknown, grpc_util.ErrorReasonRepositoryUnreachable)
+	}
 	getCached := func() *apiclient.ManifestResponse {
 		var res apiclient.ManifestResponse
 		if !q.NoCache {
@@ -140,12 +301,25 @@ func (s *Service) GenerateManifest(c context.Context, q *apiclient.ManifestReque
 	if cached != nil {
 		return cached, nil
 	}
+	if cached = s.getUnchangedManifests(r, q, resolvedRevision); cached != nil {
+		return cached, nil
+	}
 
+	// the manifests might have been generated by another request while waiting for the checkout
+	if err := lock.lockCheckout(r.AppLockKey(resolvedRevision)); err != nil {
+		return nil, err
+	}
 	cached = getCached()
 	if cached != nil {
 		return cached, nil
 	}
 
+	appPath, err := s.getApp(r, q, resolvedRevision, sparse)
+	lock.unlockRepo()
+	if err != nil {
+		return nil, grpc_util.WrapError(err, codes.Unknown, grpc_util.ErrorReasonRepositoryUnreachable)
+	}
+
 	if s.parallelismLimitSemaphore != nil {
 		err = s.parallelismLimitSemaphore.Acquire(c, 1)
 		if err != nil {
@@ -154,12 +328,12 @@ func (s *Service) GenerateManifest(c context.Context, q *apiclient.ManifestReque
 		defer s.parallelismLimitSemaphore.Release(1)
 	}
 
-	appPath, err := r.GetApp(q.ApplicationSource.Path, resolvedRevision)
-	if err != nil {
-		return nil, err
-	}
 	genRes, err := GenerateManifests(appPath, q)
 	if err != nil {
+		return nil, grpc_util.WrapError(err, codes.Unknown, grpc_util.ErrorReasonRenderFailed)
+	}
+	// manifests which exceed the quota are not cached
+	if err := checkManifestQuota(q, genRes.Manifests); err != nil {
 		return nil, err
 	}
 	res := *genRes
@@ -168,62 +342,113 @@ func (s *Service) GenerateManifest(c context.Context, q *apiclient.ManifestReque
 	if err != nil {
 		log.Warnf("manifest cache set error %s/%s: %v", q.ApplicationSource.String(), resolvedRevision, err)
 	}
+	if s.allowStaleManifests {
+		err = s.cache.SetStaleManifests(q.Revision, q.ApplicationSource, q.Namespace, q.AppLabelKey, q.AppLabelValue, &res)
+		if err != nil {
+			log.Warnf("stale manifest cache set error %s/%s: %v", q.ApplicationSource.String(), resolvedRevision, err)
+		}
+	}
 	return &res, nil
 }
 
-// GenerateManifests generates manifests from a path
-func GenerateManifests(appPath string, q *apiclient.ManifestRequest) (*apiclient.ManifestResponse, error) {
-	var targetObjs []*unstructured.Unstructured
-	var dest *v1alpha1.ApplicationDestination
+// getApp checks out the app of the request. With sparse checkouts, only the paths which the manifests are generated
+// from and the Helm value files are checked out, if the repo supports it.
+func (s *Service) getApp(r repo.Repo, q *apiclient.ManifestRequest, resolvedRevision string, sparse bool) (string, error) {
+	if sparseRepo, ok := r.(repo.SparseRepo); ok && sparse {
+		paths := append(append([]string{}, q.ManifestGeneratePaths...), helmValueFilePaths(q.ApplicationSource)...)
+		return sparseRepo.GetSparseApp(q.ApplicationSource.Path, resolvedRevision, paths)
+	}
+	return r.GetApp(q.ApplicationSource.Path, resolvedRevision)
+}
 
-	appSourceType, err := GetAppSourceType(q.ApplicationSource, appPath)
-	creds := creds.GetRepoCreds(q.Repo)
-	repoURL := ""
-	if q.Repo != nil {
-		repoURL = q.Repo.Repo
+// helmValueFilePaths returns the value files of a Helm source which are files of the repository, relative to the root
+// of the repository
+func helmValueFilePaths(source *v1alpha1.ApplicationSource) []string {
+	if source.Helm == nil {
+		return nil
 	}
-	switch appSourceType {
-	case v1alpha1.ApplicationSourceTypeKsonnet:
-		targetObjs, dest, err = ksShow(q.AppLabelKey, appPath, q.ApplicationSource.Ksonnet)
-	case v1alpha1.ApplicationSourceTypeHelm:
-		h, err := helm.NewHelmApp(appPath, q.Repos)
-		if err != nil {
-			return nil, err
-		}
-		defer h.Dispose()
-		err = h.Init()
-		if err != nil {
-			return nil, err
-		}
-		targetObjs, err = h.Template(q.AppLabelValue, q.Namespace, q.KubeVersion, q.ApplicationSource.Helm)
-		if err != nil {
-			if !helm.IsMissingDependencyErr(err) {
-				return nil, err
-			}
-			err = h.DependencyBuild()
-			if err != nil {
-				return nil, err
-			}
-			targetObjs, err = h.Template(q.AppLabelValue, q.Namespace, q.KubeVersion, q.ApplicationSource.Helm)
-			if err != nil {
-				return nil, err
-			}
+	var paths []string
+	for _, file := range source.Helm.ValueFiles {
+		if strings.Contains(file, "://") || filepath.IsAbs(file) {
+			continue
 		}
-	case v1alpha1.ApplicationSourceTypeKustomize:
-		k := kustomize.NewKustomizeApp(appPath, creds, repoURL)
-		targetObjs, _, err = k.Build(q.ApplicationSource.Kustomize, q.KustomizeOptions)
-	case v1alpha1.ApplicationSourceTypePlugin:
-		targetObjs, err = runConfigManagementPlugin(appPath, q, creds)
-	case v1alpha1.ApplicationSourceTypeDirectory:
-		var directory *v1alpha1.ApplicationSourceDirectory
-		if directory = q.ApplicationSource.Directory; directory == nil {
-			directory = &v1alpha1.ApplicationSourceDirectory{}
+		path := filepath.Join(source.Path, file)
+		if path == ".." || strings.HasPrefix(path, "../") {
+			continue
 		}
-		targetObjs, err = findManifests(appPath, *directory)
+		paths = append(paths, path)
+	}
+	return paths
+}
+
+// getUnchangedManifests returns the cached manifests of the previous revision, stored as the manifests of the resolved
+// revision, if none of the files which the manifests are generated from were changed since the previous revision.
+// Returns nil if the manifests need to be generated.
+func (s *Service) getUnchangedManifests(r repo.Repo, q *apiclient.ManifestRequest, resolvedRevision string) *apiclient.ManifestResponse {
+	if q.NoCache || len(q.ManifestGeneratePaths) == 0 || q.PreviousRevision == "" || q.PreviousRevision == resolvedRevision {
+		return nil
+	}
+	var res apiclient.ManifestResponse
+	if err := s.cache.GetManifests(q.PreviousRevision, q.ApplicationSource, q.Namespace, q.AppLabelKey, q.AppLabelValue, &res); err != nil {
+		return nil
+	}
+	changedFiles, err := r.ChangedFiles(q.PreviousRevision, resolvedRevision)
+	if err != nil {
+		log.Warnf("failed to list files changed between %s and %s: %v", q.PreviousRevision, resolvedRevision, err)
+		return nil
+	}
+	if apppath.FilesChanged(q.ManifestGeneratePaths, changedFiles) {
+		return nil
 	}
+	log.Infof("manifest paths unchanged: %s/%s reuses manifests of %s", q.ApplicationSource.String(), resolvedRevision, q.PreviousRevision)
+	res.Revision = resolvedRevision
+	if err := s.cache.SetManifests(resolvedRevision, q.ApplicationSource, q.Namespace, q.AppLabelKey, q.AppLabelValue, &res); err != nil {
+		log.Warnf("manifest cache set error %s/%s: %v", q.ApplicationSource.String(), resolvedRevision, err)
+	}
+	return &res
+}
+
+// applyParameterOverrides returns a copy of the request with the parameter overrides, which were written back to the
+// overrides file in the application path, merged into the application source
+func applyParameterOverrides(appPath string, q *apiclient.ManifestRequest) (*apiclient.ManifestRequest, error) {
+	if q.ParameterOverridesFile == "" {
+		return q, nil
+	}
+	path, err := writeback.FilePath(appPath, q.ParameterOverridesFile)
 	if err != nil {
 		return nil, err
 	}
+	overrides, err := writeback.ReadOverrides(path)
+	if err != nil || overrides == nil {
+		return q, err
+	}
+	source := q.ApplicationSource.DeepCopy()
+	overrides.Apply(source)
+	req := *q
+	req.ApplicationSource = source
+	return &req, nil
+}
+
+// GenerateManifests generates manifests from a path, using the manifest generator of the source type of the application
+func GenerateManifests(appPath string, q *apiclient.ManifestRequest) (*apiclient.ManifestResponse, error) {
+	q, err := applyParameterOverrides(appPath, q)
+	if err != nil {
+		return nil, err
+	}
+	appSourceType, err := GetAppSourceType(q.ApplicationSource, appPath)
+	if err != nil {
+		return nil, err
+	}
+	generator, err := getManifestGenerator(appSourceType)
+	if err != nil {
+		return nil, err
+	}
+	generated, err := generator.Generate(appPath, q)
+	if err != nil {
+		return nil, err
+	}
+	targetObjs := generated.Objects
+	dest := generated.Destination
 
 	manifests := make([]string, 0)
 	for _, obj := range targetObjs {
@@ -247,6 +472,10 @@ func GenerateManifests(appPath string, q *apiclient.ManifestRequest) (*apiclient
 		}
 
 		for _, target := range targets {
+			target, err = applySourcePatches(target, q.ApplicationSource.Patches)
+			if err != nil {
+				return nil, err
+			}
 			if q.AppLabelKey != "" && q.AppLabelValue != "" && !kube.IsCRD(target) {
 				err = kube.SetAppInstanceLabel(target, q.AppLabelKey, q.AppLabelValue)
 				if err != nil {
@@ -262,8 +491,9 @@ func GenerateManifests(appPath string, q *apiclient.ManifestRequest) (*apiclient
 	}
 
 	res := apiclient.ManifestResponse{
-		Manifests:  manifests,
-		SourceType: string(appSourceType),
+		Manifests:       manifests,
+		SourceType:      string(appSourceType),
+		RenderedSources: generated.RenderedSources,
 	}
 	if dest != nil {
 		res.Namespace = dest.Namespace
@@ -272,6 +502,21 @@ func GenerateManifests(appPath string, q *apiclient.ManifestRequest) (*apiclient
 	return &res, nil
 }
 
+// applySourcePatches applies the patches of the application source which target the resource, in order
+func applySourcePatches(obj *unstructured.Unstructured, patches []v1alpha1.ApplicationSourcePatch) (*unstructured.Unstructured, error) {
+	for i := range patches {
+		if !patches[i].Target.Matches(obj) {
+			continue
+		}
+		patched, err := resource.ApplyPatch(obj, patches[i].Patch)
+		if err != nil {
+			return nil, fmt.Errorf("failed to apply patch to %s/%s: %v", obj.GetKind(), obj.GetName(), err)
+		}
+		obj = patched
+	}
+	return obj, nil
+}
+
 // GetAppSourceType returns explicit application source type or examines a directory and determines its application source type
 func GetAppSourceType(source *v1alpha1.ApplicationSource, path string) (v1alpha1.ApplicationSourceType, error) {
 	appSourceType, err := source.ExplicitType()
@@ -281,6 +526,9 @@ func GetAppSourceType(source *v1alpha1.ApplicationSource, path string) (v1alpha1
 	if appSourceType != nil {
 		return *appSourceType, nil
 	}
+	if detected, err := detectSourceType(path); err != nil || detected != "" {
+		return detected, err
+	}
 	appType, err := discovery.AppType(path)
 	if err != nil {
 		return "", err
@@ -342,8 +590,9 @@ func ksShow(appLabelKey, appPath string, ksonnetOpts *v1alpha1.ApplicationSource
 
 var manifestFile = regexp.MustCompile(`^.*\.(yaml|yml|json|jsonnet)$`)
 
-// findManifests looks at all yaml files in a directory and unmarshals them into a list of unstructured objects
-func findManifests(appPath string, directory v1alpha1.ApplicationSourceDirectory) ([]*unstructured.Unstructured, error) {
+// findManifests looks at all yaml files in a directory and unmarshals them into a list of unstructured objects. Files
+// and jsonnet imports must be within the root of the repository.
+func findManifests(appPath string, repoRoot string, directory v1alpha1.ApplicationSourceDirectory) ([]*unstructured.Unstructured, error) {
 	var objs []*unstructured.Unstructured
 	err := filepath.Walk(appPath, func(path string, f os.FileInfo, err error) error {
 		if err != nil {
@@ -360,6 +609,10 @@ func findManifests(appPath string, directory v1alpha1.ApplicationSourceDirectory
 		if !manifestFile.MatchString(f.Name()) {
 			return nil
 		}
+		// manifests might be symbolic links to files outside of the repository
+		if _, err := apppath.InRoot(repoRoot, path); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid manifest %v", err)
+		}
 		out, err := utfutil.ReadFile(path, utfutil.UTF8)
 		if err != nil {
 			return err
@@ -373,8 +626,9 @@ func findManifests(appPath string, directory v1alpha1.ApplicationSourceDirectory
 			objs = append(objs, &obj)
 		} else if strings.HasSuffix(f.Name(), ".jsonnet") {
 			vm := makeJsonnetVm(directory.Jsonnet)
-			vm.Importer(&jsonnet.FileImporter{
-				JPaths: []string{appPath},
+			vm.Importer(&sandboxedImporter{
+				root:     repoRoot,
+				importer: jsonnet.FileImporter{JPaths: []string{appPath}},
 			})
 			jsonStr, err := vm.EvaluateSnippet(f.Name(), string(out))
 			if err != nil {
@@ -416,6 +670,24 @@ func findManifests(appPath string, directory v1alpha1.ApplicationSourceDirectory
 	return objs, nil
 }
 
+// sandboxedImporter imports jsonnet files like the file importer it wraps, but fails if the imported file is not within
+// root, e.g. because the import is absolute, traverses out of root or follows a symbolic link which escapes root
+type sandboxedImporter struct {
+	root     string
+	importer jsonnet.FileImporter
+}
+
+func (i *sandboxedImporter) Import(codeDir string, importedPath string) (*jsonnet.ImportedData, error) {
+	data, err := i.importer.Import(codeDir, importedPath)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := apppath.InRoot(i.root, data.FoundHere); err != nil {
+		return nil, fmt.Errorf("invalid import %v", err)
+	}
+	return data, nil
+}
+
 func makeJsonnetVm(sourceJsonnet v1alpha1.ApplicationSourceJsonnet) *jsonnet.VM {
 	vm := jsonnet.MakeVM()
 
@@ -456,11 +728,203 @@ func findPlugin(plugins []*v1alpha1.ConfigManagementPlugin, name string) *v1alph
 	return nil
 }
 
+// repoRootPath returns the root of the checkout which the application path belongs to. Charts of Helm repositories are
+// not part of a checkout, so their root is the application path itself.
+func repoRootPath(appPath string, sourcePath string, repo *v1alpha1.Repository) string {
+	appPath = filepath.Clean(appPath)
+	sourcePath = filepath.Clean(sourcePath)
+	if (repo != nil && repo.Type == "helm") || sourcePath == "." || filepath.IsAbs(sourcePath) {
+		return appPath
+	}
+	if strings.HasSuffix(appPath, string(filepath.Separator)+sourcePath) {
+		return filepath.Clean(strings.TrimSuffix(appPath, sourcePath))
+	}
+	return appPath
+}
+
+// checkValueFiles ensures that the value files of a Helm source are within the chart directory, or within the
+// repository if value files outside of the chart are allowed. Remote value files are not checked.
+func checkValueFiles(appPath string, repoRoot string, opts *v1alpha1.ApplicationSourceHelm) error {
+	if opts == nil {
+		return nil
+	}
+	root := appPath
+	if opts.AllowValueFilesOutsideChart {
+		root = repoRoot
+	}
+	for _, file := range opts.ValueFiles {
+		if strings.HasPrefix(file, "http://") || strings.HasPrefix(file, "https://") {
+			continue
+		}
+		if _, err := apppath.ResolveFilePath(root, appPath, file); err != nil {
+			if !opts.AllowValueFilesOutsideChart {
+				return status.Errorf(codes.InvalidArgument, "invalid value file %v; set allowValueFilesOutsideChart to use value files outside of the chart directory", err)
+			}
+			return status.Errorf(codes.InvalidArgument, "invalid value file %v", err)
+		}
+	}
+	return nil
+}
+
+// decryptValueFiles returns a copy of the Helm options in which SOPS encrypted value files are replaced by decrypted
+// temporary files. The returned function removes the decrypted files.
+func decryptValueFiles(appPath string, opts *v1alpha1.ApplicationSourceHelm, keys []string) (*v1alpha1.ApplicationSourceHelm, func(), error) {
+	noop := func() {}
+	if opts == nil || len(opts.ValueFiles) == 0 {
+		return opts, noop, nil
+	}
+	var tmpDir string
+	cleanup := func() {
+		if tmpDir != "" {
+			_ = os.RemoveAll(tmpDir)
+		}
+	}
+	res := opts.DeepCopy()
+	for i, file := range opts.ValueFiles {
+		if strings.HasPrefix(file, "http://") || strings.HasPrefix(file, "https://") {
+			continue
+		}
+		path := file
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(appPath, file)
+		}
+		data, err := ioutil.ReadFile(path)
+		if err != nil || !sops.IsEncrypted(data) {
+			// missing files are reported by helm
+			continue
+		}
+		if tmpDir == "" {
+			tmpDir, err = ioutil.TempDir("", "values")
+			if err != nil {
+				return nil, noop, err
+			}
+		}
+		dest := filepath.Join(tmpDir, fmt.Sprintf("%d-%s", i, filepath.Base(file)))
+		if err = sops.DecryptFile(path, dest, keys); err != nil {
+			cleanup()
+			return nil, noop, err
+		}
+		res.ValueFiles[i] = dest
+	}
+	return res, cleanup, nil
+}
+
+// isEncryptedFile returns whether the file at path is a SOPS encrypted YAML or JSON file
+func isEncryptedFile(path string) bool {
+	if ext := filepath.Ext(path); ext != ".yaml" && ext != ".yml" && ext != ".json" {
+		return false
+	}
+	data, err := ioutil.ReadFile(path)
+	return err == nil && sops.IsEncrypted(data)
+}
+
+// decryptAppDir returns a copy of the application directory, in a temporary directory, in which the SOPS encrypted YAML
+// and JSON files are decrypted, so that the decrypted files never appear in the shared checkout. If the directory does
+// not contain encrypted files, it is returned itself. The returned function removes the copy.
+func decryptAppDir(appPath string, keys []string) (string, func(), error) {
+	noop := func() {}
+	encrypted := false
+	err := filepath.Walk(appPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() && info.Name() == ".git" {
+			return filepath.SkipDir
+		}
+		if info.Mode().IsRegular() && isEncryptedFile(path) {
+			encrypted = true
+		}
+		return nil
+	})
+	if err != nil || !encrypted {
+		return appPath, noop, err
+	}
+	tmpDir, err := ioutil.TempDir("", "decrypted")
+	if err != nil {
+		return "", noop, err
+	}
+	cleanup := func() {
+		_ = os.RemoveAll(tmpDir)
+	}
+	err = filepath.Walk(appPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		relPath, err := filepath.Rel(appPath, path)
+		if err != nil {
+			return err
+		}
+		dest := filepath.Join(tmpDir, relPath)
+		switch {
+		case info.IsDir():
+			if info.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			return os.MkdirAll(dest, 0700)
+		case info.Mode()&os.ModeSymlink != 0:
+			target, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+			return os.Symlink(target, dest)
+		case !info.Mode().IsRegular():
+			return nil
+		case isEncryptedFile(path):
+			return sops.DecryptFile(path, dest, keys)
+		}
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return ioutil.WriteFile(dest, data, info.Mode())
+	})
+	if err != nil {
+		cleanup()
+		return "", noop, err
+	}
+	return tmpDir, cleanup, nil
+}
+
+// checkPluginFiles ensures that the symbolic links in the directory which a config management plugin runs in resolve to
+// files within one of the given roots, so that the plugin does not read files of the repo server on behalf of the
+// repository. Dangling links are ignored.
+func checkPluginFiles(dir string, roots ...string) error {
+	return filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() && info.Name() == ".git" {
+			return filepath.SkipDir
+		}
+		if info.Mode()&os.ModeSymlink == 0 {
+			return nil
+		}
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			return nil
+		}
+		for _, root := range roots {
+			if _, err = apppath.InRoot(root, path); err == nil {
+				return nil
+			}
+		}
+		return status.Errorf(codes.InvalidArgument, "invalid plugin input %v", err)
+	})
+}
+
 func runConfigManagementPlugin(appPath string, q *apiclient.ManifestRequest, creds git.Creds) ([]*unstructured.Unstructured, error) {
 	plugin := findPlugin(q.Plugins, q.ApplicationSource.Plugin.Name)
 	if plugin == nil {
 		return nil, fmt.Errorf("Config management plugin with name '%s' is not supported.", q.ApplicationSource.Plugin.Name)
 	}
+	repoRoot := repoRootPath(appPath, q.ApplicationSource.Path, q.Repo)
+	appPath, cleanup, err := decryptAppDir(appPath, q.DecryptionKeys)
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+	if err := checkPluginFiles(appPath, repoRoot, appPath); err != nil {
+		return nil, err
+	}
 	env := append(os.Environ(), fmt.Sprintf("%s=%s", PluginEnvAppName, q.AppLabelValue), fmt.Sprintf("%s=%s", PluginEnvAppNamespace, q.Namespace))
 	if creds != nil {
 		closer, environ, err := creds.Environ()
@@ -476,6 +940,10 @@ func runConfigManagementPlugin(appPath string, q *apiclient.ManifestRequest, cre
 		if err != nil {
 			return nil, err
 		}
+		// the init command might create links to files outside of the repository, e.g. by fetching dependencies
+		if err := checkPluginFiles(appPath, repoRoot, appPath); err != nil {
+			return nil, err
+		}
 	}
 	out, err := runCommand(plugin.Generate, appPath, env)
 	if err != nil {
@@ -489,8 +957,11 @@ func (s *Service) GetAppDetails(ctx context.Context, q *apiclient.RepoServerAppD
 	if err != nil {
 		return nil, err
 	}
-	s.repoLock.Lock(r.LockKey())
-	defer s.repoLock.Unlock(r.LockKey())
+	lock, err := s.lockRepo(r, fmt.Sprintf("GetAppDetails %s@%s", q.App, q.Revision))
+	if err != nil {
+		return nil, err
+	}
+	defer lock.unlock()
 	err = r.Init()
 	if err != nil {
 		return nil, err
@@ -517,12 +988,16 @@ func (s *Service) GetAppDetails(ctx context.Context, q *apiclient.RepoServerAppD
 	if cached != nil {
 		return cached, nil
 	}
+	if err := lock.lockCheckout(r.AppLockKey(resolvedRevision)); err != nil {
+		return nil, err
+	}
 	cached = getCached()
 	if cached != nil {
 		return cached, nil
 	}
 
 	appPath, err := r.GetApp(q.App, resolvedRevision)
+	lock.unlockRepo()
 	if err != nil {
 		return nil, err
 	}
@@ -576,6 +1051,15 @@ func (s *Service) GetAppDetails(ctx context.Context, q *apiclient.RepoServerAppD
 				res.Helm.ValueFiles = append(res.Helm.ValueFiles, fName)
 			}
 		}
+		if q.Helm != nil {
+			err = checkValueFiles(appPath, repoRootPath(appPath, q.App, q.Repo), &v1alpha1.ApplicationSourceHelm{
+				ValueFiles:                  q.Helm.ValueFiles,
+				AllowValueFilesOutsideChart: q.Helm.AllowValueFilesOutsideChart,
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
 		h, err := helm.NewHelmApp(appPath, q.Repos)
 		if err != nil {
 			return nil, err
@@ -599,6 +1083,11 @@ func (s *Service) GetAppDetails(ctx context.Context, q *apiclient.RepoServerAppD
 			return nil, err
 		}
 		res.Helm.Parameters = params
+		crds, err := h.GetCRDs()
+		if err != nil {
+			return nil, err
+		}
+		res.Helm.HasCrds = len(crds) > 0
 	case v1alpha1.ApplicationSourceTypeKustomize:
 		res.Kustomize = &apiclient.KustomizeAppSpec{}
 		k := kustomize.NewKustomizeApp(appPath, creds.GetRepoCreds(q.Repo), q.Repo.Repo)
@@ -611,40 +1100,115 @@ func (s *Service) GetAppDetails(ctx context.Context, q *apiclient.RepoServerAppD
 	return &res, nil
 }
 
-func (s *Service) getRevisionMetadata(repo *v1alpha1.Repository, app, revision string) (*repo.RevisionMetadata, error) {
+// getRevisionMetadata returns the untruncated metadata and the changed files of a revision. The changed files are
+// omitted for repositories which do not support them, e.g. Helm repositories.
+func (s *Service) getRevisionMetadata(repo *v1alpha1.Repository, app, revision string) (*v1alpha1.RevisionMetadata, error) {
 	r, err := s.repoFactory.NewRepo(repo, metrics.NopReporter)
 	if err != nil {
 		return nil, err
 	}
-	s.repoLock.Lock(r.LockKey())
+	if err := s.repoLock.LockWithTimeout(r.LockKey(), fmt.Sprintf("GetRevisionMetadata %s", revision), s.lockTimeout); err != nil {
+		return nil, lockError(err)
+	}
 	defer s.repoLock.Unlock(r.LockKey())
 	err = r.Init()
 	if err != nil {
 		return nil, err
 	}
-	return r.RevisionMetadata(app, revision)
+	m, err := r.RevisionMetadata(app, revision)
+	if err != nil {
+		return nil, err
+	}
+	metadata := &v1alpha1.RevisionMetadata{Author: m.Author, Date: metav1.Time{Time: m.Date}, Tags: m.Tags, Message: m.Message}
+	// the revision is passed to git as is, so only the changed files of commit SHAs are listed
+	if !git.IsCommitSHA(revision) {
+		return metadata, nil
+	}
+	changedFiles, err := r.ChangedFiles(revision+"^", revision)
+	if err != nil {
+		log.WithFields(log.Fields{"repoURL": repo.Repo, "revision": revision, "err": err}).Debug("failed to list changed files")
+	} else {
+		metadata.ChangedFiles = changedFiles
+	}
+	return metadata, nil
 }
 
+// GetRevisionMetadata returns the metadata of a revision. Unless the full metadata is requested, the message is
+// truncated to its first line and the configured length, and the changed files are omitted. The full metadata is
+// cached, since it never changes.
 func (s *Service) GetRevisionMetadata(ctx context.Context, q *apiclient.RepoServerRevisionMetadataRequest) (*v1alpha1.RevisionMetadata, error) {
 	metadata, err := s.cache.GetRevisionMetadata(q.Repo.Repo, q.App, q.Revision)
 	if err == nil {
 		log.WithFields(log.Fields{"repoURL": q.Repo.Repo, "app": q.App, "revision": q.Revision}).Debug("cache hit")
-		return metadata, nil
-	}
-	if err == cache.ErrCacheMiss {
+	} else if err == cache.ErrCacheMiss {
 		log.WithFields(log.Fields{"repoURL": q.Repo.Repo, "app": q.App, "revision": q.Revision}).Debug("cache miss")
-		m, err := s.getRevisionMetadata(q.Repo, q.App, q.Revision)
+		metadata, err = s.getRevisionMetadata(q.Repo, q.App, q.Revision)
 		if err != nil {
 			return nil, err
 		}
-		// discard anything after the first new line and then truncate to 64 chars
-		message := text.Trunc(strings.SplitN(m.Message, "\n", 2)[0], 64)
-		metadata = &v1alpha1.RevisionMetadata{Author: m.Author, Date: metav1.Time{Time: m.Date}, Tags: m.Tags, Message: message}
 		_ = s.cache.SetRevisionMetadata(q.Repo.Repo, q.App, q.Revision, metadata)
+	} else {
+		log.WithFields(log.Fields{"repoURL": q.Repo.Repo, "app": q.App, "revision": q.Revision, "err": err}).Debug("cache error")
+		return nil, err
+	}
+	if q.Full {
 		return metadata, nil
 	}
-	log.WithFields(log.Fields{"repoURL": q.Repo.Repo, "app": q.App, "revision": q.Revision, "err": err}).Debug("cache error")
-	return nil, err
+	// discard anything after the first new line and then truncate to the configured length
+	message := strings.SplitN(metadata.Message, "\n", 2)[0]
+	if s.revisionMessageLength > 0 {
+		message = text.Trunc(message, s.revisionMessageLength)
+	}
+	return &v1alpha1.RevisionMetadata{Author: metadata.Author, Date: metadata.Date, Tags: metadata.Tags, Message: message}, nil
+}
+
+func (s *Service) getRevisionChangelog(q *apiclient.RepoServerRevisionChangelogRequest) (*v1alpha1.RevisionChangelog, error) {
+	r, err := s.repoFactory.NewRepo(q.Repo, metrics.NopReporter)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repoLock.LockWithTimeout(r.LockKey(), fmt.Sprintf("GetRevisionChangelog %s..%s", q.Revision, q.TargetRevision), s.lockTimeout); err != nil {
+		return nil, lockError(err)
+	}
+	defer s.repoLock.Unlock(r.LockKey())
+	err = r.Init()
+	if err != nil {
+		return nil, err
+	}
+	targetRevision, err := r.ResolveAppRevision(q.App, q.TargetRevision)
+	if err != nil {
+		return nil, err
+	}
+	commits, err := r.Commits(q.Revision, targetRevision)
+	if err != nil {
+		return nil, err
+	}
+	changelog := &v1alpha1.RevisionChangelog{Revision: q.Revision, TargetRevision: targetRevision, Commits: make([]v1alpha1.CommitMetadata, len(commits))}
+	for i, commit := range commits {
+		changelog.Commits[i] = v1alpha1.CommitMetadata{Revision: commit.Revision, Author: commit.Author, Date: metav1.Time{Time: commit.Date}, Message: commit.Message}
+	}
+	return changelog, nil
+}
+
+// GetRevisionChangelog returns the commits between the deployed revision and the target revision of the request. The
+// changelogs between resolved target revisions are cached, since they never change.
+func (s *Service) GetRevisionChangelog(ctx context.Context, q *apiclient.RepoServerRevisionChangelogRequest) (*v1alpha1.RevisionChangelog, error) {
+	if git.IsCommitSHA(q.TargetRevision) {
+		changelog, err := s.cache.GetRevisionChangelog(q.Repo.Repo, q.Revision, q.TargetRevision)
+		if err == nil {
+			log.WithFields(log.Fields{"repoURL": q.Repo.Repo, "revision": q.Revision, "targetRevision": q.TargetRevision}).Debug("cache hit")
+			return changelog, nil
+		}
+		if err != cache.ErrCacheMiss {
+			log.WithFields(log.Fields{"repoURL": q.Repo.Repo, "revision": q.Revision, "targetRevision": q.TargetRevision, "err": err}).Debug("cache error")
+		}
+	}
+	changelog, err := s.getRevisionChangelog(q)
+	if err != nil {
+		return nil, err
+	}
+	_ = s.cache.SetRevisionChangelog(q.Repo.Repo, changelog.Revision, changelog.TargetRevision, changelog)
+	return changelog, nil
 }
 
 func valueFiles(q *apiclient.RepoServerAppDetailsQuery) []string {
@@ -653,3 +1217,106 @@ func valueFiles(q *apiclient.RepoServerAppDetailsQuery) []string {
 	}
 	return q.Helm.ValueFiles
 }
+
+// SetProfiling enables or disables serving profiles on the metrics port of the repo server
+func (s *Service) SetProfiling(ctx context.Context, q *apiclient.ProfilingRequest) (*apiclient.ProfilingResponse, error) {
+	if err := s.authorizeProfiling(ctx); err != nil {
+		return nil, err
+	}
+	if s.profiler == nil {
+		return nil, status.Error(codes.Unimplemented, "profiling is not available")
+	}
+	s.profiler.SetEnabled(q.Enabled)
+	return &apiclient.ProfilingResponse{Enabled: s.profiler.Enabled()}, nil
+}
+
+// GetProfile returns the requested runtime profile, or dumps it to the profile directory
+func (s *Service) GetProfile(ctx context.Context, q *apiclient.ProfileRequest) (*apiclient.ProfileResponse, error) {
+	if err := s.authorizeProfiling(ctx); err != nil {
+		return nil, err
+	}
+	if s.profiler == nil {
+		return nil, status.Error(codes.Unimplemented, "profiling is not available")
+	}
+	if q.Dump {
+		path, err := s.profiler.Dump(q.Name)
+		if err != nil {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return &apiclient.ProfileResponse{Path: path}, nil
+	}
+	data, err := s.profiler.Get(q.Name)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return &apiclient.ProfileResponse{Data: data}, nil
+}
+
+// authorizeProfiling returns an error unless the request carries the profiler token, which the repo server reads from
+// the mounted argocd-secret for every request, so that it is picked up once the API server initialized it
+func (s *Service) authorizeProfiling(ctx context.Context) error {
+	token, err := ioutil.ReadFile(s.profilerTokenPath)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	var authorization string
+	if md, ok := metadata.FromIncomingContext(ctx); ok && len(md.Get("authorization")) > 0 {
+		authorization = md.Get("authorization")[0]
+	}
+	if !profile.IsAuthorized(strings.TrimSpace(string(token)), authorization) {
+		return status.Error(codes.Unauthenticated, "invalid profiler token")
+	}
+	return nil
+}
+
+// GetLocks returns the held repository and checkout locks
+func (s *Service) GetLocks(ctx context.Context, q *apiclient.LocksRequest) (*apiclient.LocksResponse, error) {
+	res := &apiclient.LocksResponse{}
+	for _, lock := range s.repoLock.Locks() {
+		res.Locks = append(res.Locks, &apiclient.Lock{
+			Key:         lock.Key,
+			Owner:       lock.Owner,
+			HeldSeconds: int64(time.Since(lock.HeldSince).Seconds()),
+			Waiting:     int64(lock.Waiting),
+		})
+	}
+	return res, nil
+}
+
+// GetVersion returns the version of the repo server, of the tools it uses to generate manifests and its enabled
+// features. The version of a tool which cannot be determined is left empty.
+func (s *Service) GetVersion(ctx context.Context, q *apiclient.RepoServerVersionRequest) (*apiclient.RepoServerVersionResponse, error) {
+	s.toolVersionsOnce.Do(func() {
+		versions := []struct {
+			tool    string
+			version *string
+			get     func() (string, error)
+		}{
+			{"helm", &s.toolVersions.HelmVersion, helm.Version},
+			{"kustomize", &s.toolVersions.KustomizeVersion, kustomize.Version},
+			{"ksonnet", &s.toolVersions.KsonnetVersion, ksonnet.KsonnetVersion},
+			{"git", &s.toolVersions.GitVersion, git.Version},
+		}
+		for _, v := range versions {
+			version, err := v.get()
+			if err != nil {
+				log.Warnf("Failed to determine the version of %s: %v", v.tool, err)
+				continue
+			}
+			*v.version = version
+		}
+	})
+	res := s.toolVersions
+	res.Version = common.GetVersion().Version
+	res.Features = nil
+	if s.allowStaleManifests {
+		res.Features = append(res.Features, FeatureStaleManifests)
+	}
+	if s.sparseCheckout {
+		res.Features = append(res.Features, FeatureSparseCheckout)
+	}
+	if s.profiler != nil {
+		res.Features = append(res.Features, FeatureProfiling)
+	}
+	return &res, nil
+}
@@ -3,24 +3,34 @@ package repository
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 	"reflect"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/argoproj/pkg/exec"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
+	"github.com/argoproj/argo-cd/common"
 	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/reposerver/apiclient"
 	"github.com/argoproj/argo-cd/util"
+	apppath "github.com/argoproj/argo-cd/util/app/path"
 	"github.com/argoproj/argo-cd/util/cache"
+	grpc_util "github.com/argoproj/argo-cd/util/grpc"
+	"github.com/argoproj/argo-cd/util/profile"
 	"github.com/argoproj/argo-cd/util/repo"
 	"github.com/argoproj/argo-cd/util/repo/metrics"
 	repomocks "github.com/argoproj/argo-cd/util/repo/mocks"
@@ -39,9 +49,11 @@ func newFixtures(root, path string) *fixtures {
 		revisionMetadata: &repo.RevisionMetadata{Author: "foo", Message: strings.Repeat("x", 99), Tags: []string{"bar"}},
 	}
 	service := &Service{
-		repoLock:    util.NewKeyLock(),
-		repoFactory: factory,
-		cache:       cache.NewCache(cache.NewInMemoryCache(1 * time.Hour)),
+		repoLock:              util.NewKeyLock(),
+		repoFactory:           factory,
+		cache:                 cache.NewCache(cache.NewInMemoryCache(1 * time.Hour)),
+		revisionMessageLength: common.DefaultRevisionMessageLength,
+		shutdownCh:            make(chan struct{}),
 	}
 	return &fixtures{factory, service}
 }
@@ -51,6 +63,25 @@ type fakeFactory struct {
 	path             string
 	revision         string
 	revisionMetadata *repo.RevisionMetadata
+	initErr          error
+	// initBlock blocks the initialization of the repositories until it is closed
+	initBlock    chan struct{}
+	changedFiles []string
+	commits      []repo.Commit
+	// sparsePath is the path of the app in sparse checkouts, if the repositories support them
+	sparsePath string
+	// sparsePaths are the paths of the sparse checkouts
+	sparsePaths [][]string
+}
+
+type fakeSparseRepo struct {
+	*repomocks.Repo
+	factory *fakeFactory
+}
+
+func (r *fakeSparseRepo) GetSparseApp(app, resolvedRevision string, paths []string) (string, error) {
+	r.factory.sparsePaths = append(r.factory.sparsePaths, paths)
+	return r.factory.sparsePath, nil
 }
 
 func (f *fakeFactory) NewRepo(repo *v1alpha1.Repository, reporter metrics.Reporter) (repo.Repo, error) {
@@ -60,11 +91,21 @@ func (f *fakeFactory) NewRepo(repo *v1alpha1.Repository, reporter metrics.Report
 		root = f.root
 	}
 	r.On("LockKey").Return(root)
-	r.On("Init").Return(nil)
+	r.On("AppLockKey", mock.Anything).Return(root)
+	r.On("Init").Return(f.initErr).Run(func(args mock.Arguments) {
+		if f.initBlock != nil {
+			<-f.initBlock
+		}
+	})
 	r.On("GetApp", mock.Anything, mock.Anything).Return(filepath.Join(root, f.path), nil)
 	r.On("ResolveAppRevision", mock.Anything, mock.Anything).Return(f.revision, nil)
 	r.On("ListApps", mock.Anything).Return(map[string]string{}, nil)
 	r.On("RevisionMetadata", mock.Anything, f.revision).Return(f.revisionMetadata, nil)
+	r.On("ChangedFiles", mock.Anything, f.revision).Return(f.changedFiles, nil)
+	r.On("Commits", mock.Anything, f.revision).Return(f.commits, nil)
+	if f.sparsePath != "" {
+		return &fakeSparseRepo{Repo: &r, factory: f}, nil
+	}
 	return &r, nil
 }
 
@@ -97,6 +138,289 @@ func TestService_ListApps(t *testing.T) {
 	}, apps)
 }
 
+func TestGenerateManifest_StaleManifests(t *testing.T) {
+	fixtures := newFixtures("", "concatenated")
+	fixtures.allowStaleManifests = true
+	q := &apiclient.ManifestRequest{
+		Repo:              &argoappv1.Repository{Repo: "my-repo"},
+		Revision:          "master",
+		ApplicationSource: &argoappv1.ApplicationSource{Path: "concatenated"},
+	}
+	res, err := fixtures.Service.GenerateManifest(context.Background(), q)
+	assert.NoError(t, err)
+	assert.False(t, res.Stale)
+	assert.Len(t, res.Manifests, 3)
+
+	fixtures.fakeFactory.initErr = fmt.Errorf("connection refused")
+	res, err = fixtures.Service.GenerateManifest(context.Background(), q)
+	assert.NoError(t, err)
+	assert.True(t, res.Stale)
+	assert.Len(t, res.Manifests, 3)
+	assert.Equal(t, fixtures.fakeFactory.revision, res.Revision)
+	_, scheduled := fixtures.Service.staleManifestsRefreshes.Load(fmt.Sprintf("master|%s|||", q.ApplicationSource.String()))
+	assert.True(t, scheduled)
+
+	// only the last manifests of the same revision are served
+	q.Revision = "other"
+	_, err = fixtures.Service.GenerateManifest(context.Background(), q)
+	assert.Error(t, err)
+
+	// stale manifests are not served unless allowed
+	fixtures.allowStaleManifests = false
+	q.Revision = "master"
+	_, err = fixtures.Service.GenerateManifest(context.Background(), q)
+	assert.Error(t, err)
+}
+
+type fakeReporter struct {
+	lock   sync.Mutex
+	events []string
+}
+
+func (r *fakeReporter) Event(repoURL, event string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.events = append(r.events, event)
+}
+
+func (r *fakeReporter) Observe(repoURL, event string, duration time.Duration, err error) {
+}
+
+func (r *fakeReporter) eventCount() int {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return len(r.events)
+}
+
+func TestGenerateManifest_SparseCheckout(t *testing.T) {
+	fixtures := newFixtures("", "concatenated")
+	fixtures.sparseCheckout = true
+	fixtures.fakeFactory.sparsePath = "./testdata/concatenated"
+	q := &apiclient.ManifestRequest{
+		Repo:                  &argoappv1.Repository{Repo: "my-repo"},
+		Revision:              "master",
+		ApplicationSource:     &argoappv1.ApplicationSource{Path: "apps/guestbook"},
+		ManifestGeneratePaths: []string{"bases/guestbook"},
+	}
+	res, err := fixtures.Service.GenerateManifest(context.Background(), q)
+	assert.NoError(t, err)
+	assert.Len(t, res.Manifests, 3)
+	assert.Equal(t, [][]string{{"bases/guestbook"}}, fixtures.fakeFactory.sparsePaths)
+}
+
+func Test_helmValueFilePaths(t *testing.T) {
+	assert.Nil(t, helmValueFilePaths(&argoappv1.ApplicationSource{Path: "apps/guestbook"}))
+	assert.Equal(t, []string{"apps/guestbook/values.yaml", "envs/prod.yaml"}, helmValueFilePaths(&argoappv1.ApplicationSource{
+		Path: "apps/guestbook",
+		Helm: &argoappv1.ApplicationSourceHelm{ValueFiles: []string{"values.yaml", "../../envs/prod.yaml", "../../../outside.yaml", "/etc/values.yaml", "https://example.com/values.yaml"}},
+	}))
+}
+
+func TestGenerateManifest_SparseCheckoutFallback(t *testing.T) {
+	fixtures := newFixtures("", "concatenated")
+	fixtures.sparseCheckout = true
+	// files which the manifests are generated from are missing in the sparse checkout
+	fixtures.fakeFactory.sparsePath = "./testdata/missing"
+	q := &apiclient.ManifestRequest{
+		Repo:              &argoappv1.Repository{Repo: "my-repo"},
+		Revision:          "master",
+		ApplicationSource: &argoappv1.ApplicationSource{Path: "concatenated"},
+	}
+	res, err := fixtures.Service.GenerateManifest(context.Background(), q)
+	assert.NoError(t, err)
+	assert.Len(t, res.Manifests, 3)
+	assert.Len(t, fixtures.fakeFactory.sparsePaths, 1)
+}
+
+func TestGenerateManifest_Coalesced(t *testing.T) {
+	fixtures := newFixtures("", "concatenated")
+	fixtures.fakeFactory.initBlock = make(chan struct{})
+	reporter := &fakeReporter{}
+	fixtures.Service.reporter = reporter
+	q := &apiclient.ManifestRequest{
+		Repo:              &argoappv1.Repository{Repo: "my-repo"},
+		Revision:          "master",
+		ApplicationSource: &argoappv1.ApplicationSource{Path: "concatenated"},
+	}
+
+	responses := make(chan *apiclient.ManifestResponse, 3)
+	for i := 0; i < 3; i++ {
+		go func() {
+			res, err := fixtures.Service.GenerateManifest(context.Background(), q)
+			assert.NoError(t, err)
+			responses <- res
+		}()
+	}
+	for i := 0; i < 1000 && reporter.eventCount() < 3; i++ {
+		time.Sleep(time.Millisecond)
+	}
+	// a request which is cancelled while waiting does not cancel the generation
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := fixtures.Service.GenerateManifest(ctx, q)
+	assert.Equal(t, context.Canceled, err)
+
+	close(fixtures.fakeFactory.initBlock)
+	first := <-responses
+	assert.Len(t, first.Manifests, 3)
+	assert.Equal(t, first, <-responses)
+	assert.Equal(t, first, <-responses)
+	assert.ElementsMatch(t, []string{EventManifestRequest, EventManifestRequestCoalesced, EventManifestRequestCoalesced, EventManifestRequestCoalesced}, reporter.events)
+}
+
+// TestGenerateManifest_CoalescedShutdown verifies shutdown waits for generations whose requests were cancelled
+func TestGenerateManifest_CoalescedShutdown(t *testing.T) {
+	fixtures := newFixtures("", "concatenated")
+	fixtures.fakeFactory.initBlock = make(chan struct{})
+	q := &apiclient.ManifestRequest{
+		Repo:              &argoappv1.Repository{Repo: "my-repo"},
+		Revision:          "master",
+		ApplicationSource: &argoappv1.ApplicationSource{Path: "concatenated"},
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := fixtures.Service.GenerateManifest(ctx, q)
+	assert.Equal(t, context.DeadlineExceeded, err)
+
+	shutdownCtx, cancelShutdown := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancelShutdown()
+	assert.Equal(t, context.DeadlineExceeded, fixtures.Service.Shutdown(shutdownCtx))
+
+	close(fixtures.fakeFactory.initBlock)
+	shutdownCtx, cancelShutdown = context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancelShutdown()
+	assert.NoError(t, fixtures.Service.Shutdown(shutdownCtx))
+	var cached apiclient.ManifestResponse
+	err = fixtures.Service.cache.GetManifests(fixtures.fakeFactory.revision, q.ApplicationSource, q.Namespace, q.AppLabelKey, q.AppLabelValue, &cached)
+	assert.NoError(t, err)
+}
+
+func TestGenerateManifest_UnchangedManifestGeneratePaths(t *testing.T) {
+	fixtures := newFixtures("", "concatenated")
+	q := &apiclient.ManifestRequest{
+		Repo:                  &argoappv1.Repository{Repo: "my-repo"},
+		Revision:              "master",
+		ApplicationSource:     &argoappv1.ApplicationSource{Path: "concatenated"},
+		ManifestGeneratePaths: []string{"concatenated", "bases"},
+		PreviousRevision:      "previous",
+	}
+	err := fixtures.Service.cache.SetManifests("previous", q.ApplicationSource, q.Namespace, q.AppLabelKey, q.AppLabelValue, &apiclient.ManifestResponse{
+		Manifests: []string{"{}"},
+		Revision:  "previous",
+	})
+	assert.NoError(t, err)
+
+	// the manifests of the previous revision are reused if none of the paths were changed
+	fixtures.fakeFactory.changedFiles = []string{"README.md", "other/deployment.yaml"}
+	res, err := fixtures.Service.GenerateManifest(context.Background(), q)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"{}"}, res.Manifests)
+	assert.Equal(t, fixtures.fakeFactory.revision, res.Revision)
+
+	// the manifests are generated if any of the paths were changed
+	fixtures.fakeFactory.revision = "changed"
+	fixtures.fakeFactory.changedFiles = []string{"bases/kustomization.yaml"}
+	res, err = fixtures.Service.GenerateManifest(context.Background(), q)
+	assert.NoError(t, err)
+	assert.Len(t, res.Manifests, 3)
+	assert.Equal(t, "changed", res.Revision)
+}
+
+func TestGenerateManifest_UnannotatedApp(t *testing.T) {
+	fixtures := newFixtures("", "concatenated")
+	app := &argoappv1.Application{Spec: argoappv1.ApplicationSpec{Source: argoappv1.ApplicationSource{Path: "concatenated"}}}
+	source := app.Spec.Source
+	q := &apiclient.ManifestRequest{
+		Repo:                  &argoappv1.Repository{Repo: "my-repo"},
+		Revision:              "master",
+		ApplicationSource:     &source,
+		ManifestGeneratePaths: apppath.ManifestGeneratePaths(app),
+		PreviousRevision:      "previous",
+	}
+	err := fixtures.Service.cache.SetManifests("previous", q.ApplicationSource, q.Namespace, q.AppLabelKey, q.AppLabelValue, &apiclient.ManifestResponse{
+		Manifests: []string{"{}"},
+		Revision:  "previous",
+	})
+	assert.NoError(t, err)
+
+	// the manifests of apps which are not annotated are generated again, since they might depend on a changed base
+	// outside of their path
+	fixtures.fakeFactory.changedFiles = []string{"bases/kustomization.yaml"}
+	res, err := fixtures.Service.GenerateManifest(context.Background(), q)
+	assert.NoError(t, err)
+	assert.Len(t, res.Manifests, 3)
+}
+
+func TestGenerateManifest_ManifestQuota(t *testing.T) {
+	fixtures := newFixtures("", "concatenated")
+	q := &apiclient.ManifestRequest{
+		Repo:              &argoappv1.Repository{Repo: "my-repo"},
+		Revision:          "master",
+		ApplicationSource: &argoappv1.ApplicationSource{Path: "concatenated"},
+		ManifestQuota:     &argoappv1.ManifestQuota{MaxResources: 2},
+	}
+	_, err := fixtures.Service.GenerateManifest(context.Background(), q)
+	assert.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Equal(t, grpc_util.ErrorReasonManifestQuotaExceeded, grpc_util.GetErrorReason(err))
+	assert.Contains(t, err.Error(), "3 resources rendered, at most 2 allowed")
+
+	// manifests which exceed the quota are not cached
+	var cached apiclient.ManifestResponse
+	err = fixtures.Service.cache.GetManifests(fixtures.fakeFactory.revision, q.ApplicationSource, q.Namespace, q.AppLabelKey, q.AppLabelValue, &cached)
+	assert.Equal(t, cache.ErrCacheMiss, err)
+
+	q.ManifestQuota.MaxResources = 3
+	res, err := fixtures.Service.GenerateManifest(context.Background(), q)
+	assert.NoError(t, err)
+	assert.Len(t, res.Manifests, 3)
+
+	// the quota also applies to cached manifests
+	q.ManifestQuota.MaxSize = 10
+	_, err = fixtures.Service.GenerateManifest(context.Background(), q)
+	assert.Equal(t, grpc_util.ErrorReasonManifestQuotaExceeded, grpc_util.GetErrorReason(err))
+}
+
+func TestService_Shutdown(t *testing.T) {
+	fixtures := newFixtures("", "concatenated")
+	fixtures.allowStaleManifests = true
+	q := &apiclient.ManifestRequest{
+		Repo:              &argoappv1.Repository{Repo: "my-repo"},
+		Revision:          "master",
+		ApplicationSource: &argoappv1.ApplicationSource{Path: "concatenated"},
+	}
+	_, err := fixtures.Service.GenerateManifest(context.Background(), q)
+	assert.NoError(t, err)
+	fixtures.fakeFactory.initErr = fmt.Errorf("connection refused")
+	res, err := fixtures.Service.GenerateManifest(context.Background(), q)
+	assert.NoError(t, err)
+	assert.True(t, res.Stale)
+
+	// the scheduled refresh is cancelled rather than awaited
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	assert.NoError(t, fixtures.Service.Shutdown(ctx))
+	key := fmt.Sprintf("master|%s|||", q.ApplicationSource.String())
+	_, scheduled := fixtures.Service.staleManifestsRefreshes.Load(key)
+	assert.False(t, scheduled)
+
+	// no refreshes are scheduled once shutdown began
+	_, err = fixtures.Service.GenerateManifest(context.Background(), q)
+	assert.NoError(t, err)
+	_, scheduled = fixtures.Service.staleManifestsRefreshes.Load(key)
+	assert.False(t, scheduled)
+}
+
+func TestService_GetVersion(t *testing.T) {
+	fixtures := newFixtures("", "")
+	fixtures.allowStaleManifests = true
+	res, err := fixtures.Service.GetVersion(context.Background(), &apiclient.RepoServerVersionRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, common.GetVersion().Version, res.Version)
+	assert.NotEmpty(t, res.GitVersion)
+	assert.Equal(t, []string{FeatureStaleManifests}, res.Features)
+}
+
 func TestRecurseManifestsInDir(t *testing.T) {
 	q := apiclient.ManifestRequest{
 		ApplicationSource: &argoappv1.ApplicationSource{},
@@ -107,6 +431,55 @@ func TestRecurseManifestsInDir(t *testing.T) {
 	assert.Equal(t, 2, len(res1.Manifests))
 }
 
+func TestFindManifests_OutOfBounds(t *testing.T) {
+	dir, err := ioutil.TempDir("", "repo")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	repoRoot := filepath.Join(dir, "repo")
+	appPath := filepath.Join(repoRoot, "app")
+	assert.NoError(t, os.MkdirAll(appPath, 0755))
+	secret := filepath.Join(dir, "secret.libsonnet")
+	assert.NoError(t, ioutil.WriteFile(secret, []byte(`{apiVersion: "v1", kind: "Secret", metadata: {name: "secret"}}`), 0644))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(repoRoot, "lib.libsonnet"), []byte(`{apiVersion: "v1", kind: "ConfigMap", metadata: {name: "lib"}}`), 0644))
+
+	writeManifest := func(name, content string) {
+		assert.NoError(t, os.RemoveAll(appPath))
+		assert.NoError(t, os.MkdirAll(appPath, 0755))
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(appPath, name), []byte(content), 0644))
+	}
+
+	t.Run("ImportWithinRepo", func(t *testing.T) {
+		writeManifest("main.jsonnet", `import "../lib.libsonnet"`)
+		objs, err := findManifests(appPath, repoRoot, argoappv1.ApplicationSourceDirectory{})
+		assert.NoError(t, err)
+		assert.Len(t, objs, 1)
+	})
+	t.Run("ImportTraversal", func(t *testing.T) {
+		writeManifest("main.jsonnet", `import "../../secret.libsonnet"`)
+		_, err := findManifests(appPath, repoRoot, argoappv1.ApplicationSourceDirectory{})
+		assert.Error(t, err)
+	})
+	t.Run("ImportAbsolute", func(t *testing.T) {
+		writeManifest("main.jsonnet", fmt.Sprintf(`import %q`, secret))
+		_, err := findManifests(appPath, repoRoot, argoappv1.ApplicationSourceDirectory{})
+		assert.Error(t, err)
+	})
+	t.Run("ImportSymlink", func(t *testing.T) {
+		writeManifest("main.jsonnet", `import "link.libsonnet"`)
+		assert.NoError(t, os.Symlink(secret, filepath.Join(appPath, "link.libsonnet")))
+		_, err := findManifests(appPath, repoRoot, argoappv1.ApplicationSourceDirectory{})
+		assert.Error(t, err)
+	})
+	t.Run("ManifestSymlink", func(t *testing.T) {
+		writeManifest("README.md", "")
+		outside := filepath.Join(dir, "secret.yaml")
+		assert.NoError(t, ioutil.WriteFile(outside, []byte("apiVersion: v1\nkind: Secret\nmetadata:\n  name: secret\n"), 0644))
+		assert.NoError(t, os.Symlink(outside, filepath.Join(appPath, "secret.yaml")))
+		_, err := findManifests(appPath, repoRoot, argoappv1.ApplicationSourceDirectory{})
+		assert.Error(t, err)
+	})
+}
+
 func TestGenerateJsonnetManifestInDir(t *testing.T) {
 	q := apiclient.ManifestRequest{
 		ApplicationSource: &argoappv1.ApplicationSource{
@@ -140,6 +513,39 @@ func TestGenerateHelmChartWithDependencies(t *testing.T) {
 	res1, err := GenerateManifests("../../util/helm/testdata/wordpress", &q)
 	assert.Nil(t, err)
 	assert.Len(t, res1.Manifests, 12)
+	assert.Equal(t, []argoappv1.RenderedSource{
+		{Type: argoappv1.ApplicationSourceTypeHelm, Name: "wordpress", Version: "2.1.10", AppVersion: "4.9.8"},
+		{Type: argoappv1.ApplicationSourceTypeHelm, Name: "mariadb", Version: "4.3.1", Dependency: true},
+	}, res1.RenderedSources)
+}
+
+func TestRepoRootPath(t *testing.T) {
+	assert.Equal(t, "/tmp/repo", repoRootPath("/tmp/repo/charts/guestbook", "charts/guestbook", &argoappv1.Repository{}))
+	assert.Equal(t, "/tmp/repo", repoRootPath("/tmp/repo/charts/guestbook/", "./charts/guestbook", nil))
+	assert.Equal(t, "/tmp/repo", repoRootPath("/tmp/repo", ".", nil))
+	assert.Equal(t, "/tmp/charts/guestbook", repoRootPath("/tmp/charts/guestbook", "guestbook", &argoappv1.Repository{Type: "helm"}))
+	assert.Equal(t, "/tmp/local", repoRootPath("/tmp/local", "guestbook", nil))
+}
+
+func TestCheckValueFiles(t *testing.T) {
+	repoRoot := "../../util/helm/testdata"
+	appPath := "../../util/helm/testdata/redis"
+	err := checkValueFiles(appPath, repoRoot, &argoappv1.ApplicationSourceHelm{
+		ValueFiles: []string{"values-production.yaml", "https://example.com/values.yaml"},
+	})
+	assert.NoError(t, err)
+
+	outside := []string{"../minio/values.yaml"}
+	err = checkValueFiles(appPath, repoRoot, &argoappv1.ApplicationSourceHelm{ValueFiles: outside})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "allowValueFilesOutsideChart")
+	err = checkValueFiles(appPath, repoRoot, &argoappv1.ApplicationSourceHelm{ValueFiles: outside, AllowValueFilesOutsideChart: true})
+	assert.NoError(t, err)
+
+	err = checkValueFiles(appPath, repoRoot, &argoappv1.ApplicationSourceHelm{ValueFiles: []string{"../../helm.go"}, AllowValueFilesOutsideChart: true})
+	assert.Error(t, err)
+	err = checkValueFiles(appPath, repoRoot, &argoappv1.ApplicationSourceHelm{ValueFiles: []string{"/etc/passwd"}, AllowValueFilesOutsideChart: true})
+	assert.Error(t, err)
 }
 
 func TestGenerateNullList(t *testing.T) {
@@ -161,6 +567,80 @@ func TestGenerateNullList(t *testing.T) {
 	assert.Equal(t, 2, len(res2.Manifests))
 }
 
+func TestGenerateManifestsWithPatches(t *testing.T) {
+	q := apiclient.ManifestRequest{
+		ApplicationSource: &argoappv1.ApplicationSource{
+			Patches: []argoappv1.ApplicationSourcePatch{{
+				Target: argoappv1.ApplicationSourcePatchTarget{Kind: "ServiceAccount", Name: "sa2"},
+				Patch:  "metadata:\n  annotations:\n    foo: bar",
+			}, {
+				Target: argoappv1.ApplicationSourcePatchTarget{Kind: "ServiceAccount", Name: "sa3"},
+				Patch:  `[{"op": "add", "path": "/automountServiceAccountToken", "value": false}]`,
+			}},
+		},
+	}
+	res, err := GenerateManifests("./testdata/concatenated", &q)
+	assert.NoError(t, err)
+	assert.Len(t, res.Manifests, 3)
+	assert.NotContains(t, res.Manifests[0], "foo")
+	assert.Contains(t, res.Manifests[1], `"annotations":{"foo":"bar"}`)
+	assert.Contains(t, res.Manifests[2], `"automountServiceAccountToken":false`)
+
+	q.ApplicationSource.Patches[1].Patch = `[{"op": "remove", "path": "/spec"}]`
+	_, err = GenerateManifests("./testdata/concatenated", &q)
+	assert.Error(t, err)
+}
+
+// fakeGenerator generates a config map for applications which contain a fake.yaml file
+type fakeGenerator struct{}
+
+func (g fakeGenerator) Generate(appPath string, q *apiclient.ManifestRequest) (*GeneratedManifests, error) {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion("v1")
+	obj.SetKind("ConfigMap")
+	obj.SetName(q.AppLabelValue)
+	return &GeneratedManifests{Objects: []*unstructured.Unstructured{obj}}, nil
+}
+
+func (g fakeGenerator) Detect(appPath string) (bool, error) {
+	_, err := os.Stat(filepath.Join(appPath, "fake.yaml"))
+	return err == nil, nil
+}
+
+func TestRegisterManifestGenerator(t *testing.T) {
+	const fakeSourceType = argoappv1.ApplicationSourceType("Fake")
+	RegisterManifestGenerator(fakeSourceType, fakeGenerator{})
+	defer func() {
+		generatorsLock.Lock()
+		delete(generators, fakeSourceType)
+		detectors = nil
+		generatorsLock.Unlock()
+	}()
+	dir, err := ioutil.TempDir("", "fake-generator-test")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	// applications which are not recognized by the generator are generated by the built-in generators
+	q := apiclient.ManifestRequest{ApplicationSource: &argoappv1.ApplicationSource{}, AppLabelValue: "guestbook"}
+	res, err := GenerateManifests(dir, &q)
+	assert.NoError(t, err)
+	assert.Equal(t, string(argoappv1.ApplicationSourceTypeDirectory), res.SourceType)
+	assert.Empty(t, res.Manifests)
+
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "fake.yaml"), nil, 0644))
+	res, err = GenerateManifests(dir, &q)
+	assert.NoError(t, err)
+	assert.Equal(t, string(fakeSourceType), res.SourceType)
+	if assert.Len(t, res.Manifests, 1) {
+		assert.Contains(t, res.Manifests[0], `"name":"guestbook"`)
+	}
+}
+
+func TestGenerateManifests_UnsupportedSourceType(t *testing.T) {
+	_, err := getManifestGenerator("Unknown")
+	assert.EqualError(t, err, "source type 'Unknown' is not supported")
+}
+
 func TestIdentifyAppSourceTypeByAppDirWithKustomizations(t *testing.T) {
 	sourceType, err := GetAppSourceType(&argoappv1.ApplicationSource{}, "./testdata/kustomization_yaml")
 	assert.Nil(t, err)
@@ -209,6 +689,176 @@ func TestRunCustomTool(t *testing.T) {
 	assert.Equal(t, "bar", obj.GetAnnotations()["GIT_PASSWORD"])
 }
 
+func TestRunCustomTool_OutOfBounds(t *testing.T) {
+	dir, err := ioutil.TempDir("", "repo")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	repoRoot := filepath.Join(dir, "repo")
+	appPath := filepath.Join(repoRoot, "app")
+	assert.NoError(t, os.MkdirAll(appPath, 0755))
+	outside := filepath.Join(dir, "secret.yaml")
+	assert.NoError(t, ioutil.WriteFile(outside, []byte("apiVersion: v1\nkind: Secret\nmetadata:\n  name: secret\n"), 0644))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(repoRoot, "config.yaml"), []byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: config\n"), 0644))
+
+	q := &apiclient.ManifestRequest{
+		ApplicationSource: &argoappv1.ApplicationSource{Path: "app", Plugin: &argoappv1.ApplicationSourcePlugin{Name: "test"}},
+		Plugins: []*argoappv1.ConfigManagementPlugin{{
+			Name:     "test",
+			Generate: argoappv1.Command{Command: []string{"sh", "-c", "cat *.yaml"}},
+		}},
+	}
+
+	t.Run("LinkWithinRepo", func(t *testing.T) {
+		assert.NoError(t, os.Symlink("../config.yaml", filepath.Join(appPath, "config.yaml")))
+		defer func() { _ = os.Remove(filepath.Join(appPath, "config.yaml")) }()
+		objs, err := runConfigManagementPlugin(appPath, q, nil)
+		assert.NoError(t, err)
+		assert.Len(t, objs, 1)
+	})
+	t.Run("LinkOutsideRepo", func(t *testing.T) {
+		assert.NoError(t, os.Symlink(outside, filepath.Join(appPath, "secret.yaml")))
+		defer func() { _ = os.Remove(filepath.Join(appPath, "secret.yaml")) }()
+		_, err := runConfigManagementPlugin(appPath, q, nil)
+		assert.Error(t, err)
+	})
+	t.Run("LinkCreatedByInit", func(t *testing.T) {
+		defer func() { _ = os.Remove(filepath.Join(appPath, "secret.yaml")) }()
+		withInit := *q
+		withInit.Plugins = []*argoappv1.ConfigManagementPlugin{{
+			Name:     "test",
+			Init:     &argoappv1.Command{Command: []string{"ln", "-s", outside, "secret.yaml"}},
+			Generate: q.Plugins[0].Generate,
+		}}
+		_, err := runConfigManagementPlugin(appPath, &withInit, nil)
+		assert.Error(t, err)
+	})
+}
+
+const encryptedSecrets = `password: ENC[AES256_GCM,data:Zm9v,iv:YmFy,tag:YmF6,type:str]
+sops:
+  age:
+  - recipient: age1ql3z7hjy54pw3hyww5ayyfg7zqgvc7w3j2elw8zmrj2kg5sfn9aqmcac8p
+  mac: ENC[AES256_GCM,data:bWFj,iv:YmFy,tag:YmF6,type:str]
+`
+
+// withFakeSOPS runs f with a sops command which "decrypts" files by replacing them with "password: foo", and with a
+// key set named team-a
+func withFakeSOPS(t *testing.T, f func()) {
+	dir, err := ioutil.TempDir("", "sops")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "sops"), []byte(`#!/bin/sh
+while [ $# -gt 1 ]; do
+  if [ "$1" = "--output" ]; then out=$2; fi
+  shift
+done
+echo "password: foo" > "$out"
+`), 0700))
+	assert.NoError(t, os.MkdirAll(filepath.Join(dir, "keys", "team-a"), 0700))
+	path := os.Getenv("PATH")
+	defer func() {
+		_ = os.Setenv("PATH", path)
+		_ = os.Unsetenv(common.EnvVarSOPSKeysPath)
+	}()
+	assert.NoError(t, os.Setenv("PATH", dir+string(os.PathListSeparator)+path))
+	assert.NoError(t, os.Setenv(common.EnvVarSOPSKeysPath, filepath.Join(dir, "keys")))
+	f()
+}
+
+func TestDecryptValueFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "values")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "values.yaml"), []byte("replicas: 1"), 0644))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "secrets.yaml"), []byte(encryptedSecrets), 0644))
+
+	opts := &argoappv1.ApplicationSourceHelm{ValueFiles: []string{"values.yaml", "https://example.com/values.yaml"}}
+	res, cleanup, err := decryptValueFiles(dir, opts, nil)
+	assert.NoError(t, err)
+	cleanup()
+	assert.Equal(t, opts.ValueFiles, res.ValueFiles)
+
+	opts.ValueFiles = append(opts.ValueFiles, "secrets.yaml")
+	withFakeSOPS(t, func() {
+		_, _, err = decryptValueFiles(dir, opts, []string{"age1ql3z7hjy54pw3hyww5ayyfg7zqgvc7w3j2elw8zmrj2kg5sfn9aqmcac8p"})
+		assert.EqualError(t, err, "secrets.yaml cannot be decrypted: the project does not permit any decryption keys of the repo server")
+
+		res, cleanup, err = decryptValueFiles(dir, opts, []string{"team-a"})
+		assert.NoError(t, err)
+		defer cleanup()
+		decrypted, err := ioutil.ReadFile(res.ValueFiles[2])
+		assert.NoError(t, err)
+		assert.Equal(t, "password: foo\n", string(decrypted))
+	})
+}
+
+func TestDecryptAppDir(t *testing.T) {
+	dir, err := ioutil.TempDir("", "app")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	assert.NoError(t, os.Mkdir(filepath.Join(dir, "overlays"), 0755))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "values.yaml"), []byte("replicas: 1"), 0644))
+
+	// directories without encrypted files are used as they are
+	appPath, cleanup, err := decryptAppDir(dir, nil)
+	assert.NoError(t, err)
+	cleanup()
+	assert.Equal(t, dir, appPath)
+
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "overlays", "secrets.yaml"), []byte(encryptedSecrets), 0644))
+	withFakeSOPS(t, func() {
+		_, _, err = decryptAppDir(dir, nil)
+		assert.EqualError(t, err, "secrets.yaml cannot be decrypted: the project does not permit any decryption keys of the repo server")
+
+		appPath, cleanup, err := decryptAppDir(dir, []string{"team-a"})
+		assert.NoError(t, err)
+		assert.NotEqual(t, dir, appPath)
+		decrypted, err := ioutil.ReadFile(filepath.Join(appPath, "overlays", "secrets.yaml"))
+		assert.NoError(t, err)
+		assert.Equal(t, "password: foo\n", string(decrypted))
+		values, err := ioutil.ReadFile(filepath.Join(appPath, "values.yaml"))
+		assert.NoError(t, err)
+		assert.Equal(t, "replicas: 1", string(values))
+		cleanup()
+		_, err = os.Stat(appPath)
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	// the checkout itself is never modified
+	encrypted, err := ioutil.ReadFile(filepath.Join(dir, "overlays", "secrets.yaml"))
+	assert.NoError(t, err)
+	assert.Equal(t, encryptedSecrets, string(encrypted))
+}
+
+func TestApplyParameterOverrides(t *testing.T) {
+	dir, err := ioutil.TempDir("", "overrides")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, ".argocd-source.yaml"), []byte(`
+helm:
+  parameters:
+  - name: image.tag
+    value: v2
+`), 0644))
+
+	q := &apiclient.ManifestRequest{ApplicationSource: &argoappv1.ApplicationSource{
+		Helm: &argoappv1.ApplicationSourceHelm{Parameters: []argoappv1.HelmParameter{{Name: "image.tag", Value: "v1"}}},
+	}}
+	res, err := applyParameterOverrides(dir, q)
+	assert.NoError(t, err)
+	assert.Equal(t, q, res)
+
+	q.ParameterOverridesFile = ".argocd-source.yaml"
+	res, err = applyParameterOverrides(dir, q)
+	assert.NoError(t, err)
+	assert.Equal(t, []argoappv1.HelmParameter{{Name: "image.tag", Value: "v2"}}, res.ApplicationSource.Helm.Parameters)
+	assert.Equal(t, "v1", q.ApplicationSource.Helm.Parameters[0].Value)
+
+	q.ParameterOverridesFile = "../.argocd-source.yaml"
+	_, err = applyParameterOverrides(dir, q)
+	assert.Error(t, err)
+}
+
 func TestGenerateFromUTF16(t *testing.T) {
 	q := apiclient.ManifestRequest{
 		ApplicationSource: &argoappv1.ApplicationSource{},
@@ -326,3 +976,132 @@ func TestService_GetRevisionMetadata(t *testing.T) {
 		})
 	}
 }
+
+func TestService_GetRevisionMetadata_Full(t *testing.T) {
+	fixtures := newFixtures(".", "empty-list")
+	fixtures.fakeFactory.revisionMetadata.Message = strings.Repeat("x", 99) + "\n\nbody"
+	fixtures.fakeFactory.changedFiles = []string{"apps/guestbook/deployment.yaml"}
+	q := &apiclient.RepoServerRevisionMetadataRequest{Repo: &argoappv1.Repository{}, App: "empty-list", Revision: fixtures.fakeFactory.revision, Full: true}
+
+	metadata, err := fixtures.Service.GetRevisionMetadata(context.Background(), q)
+	assert.NoError(t, err)
+	assert.Equal(t, fixtures.fakeFactory.revisionMetadata.Message, metadata.Message)
+	assert.Equal(t, []string{"apps/guestbook/deployment.yaml"}, metadata.ChangedFiles)
+
+	// the truncated metadata is served from the cached full metadata
+	fixtures.Service.revisionMessageLength = 10
+	q.Full = false
+	metadata, err = fixtures.Service.GetRevisionMetadata(context.Background(), q)
+	assert.NoError(t, err)
+	assert.Equal(t, strings.Repeat("x", 7)+"...", metadata.Message)
+	assert.Empty(t, metadata.ChangedFiles)
+
+	fixtures.Service.revisionMessageLength = 0
+	metadata, err = fixtures.Service.GetRevisionMetadata(context.Background(), q)
+	assert.NoError(t, err)
+	assert.Equal(t, strings.Repeat("x", 99), metadata.Message)
+}
+
+func TestService_GetRevisionMetadata_NotCommitSHA(t *testing.T) {
+	fixtures := newFixtures(".", "empty-list")
+	fixtures.fakeFactory.revision = "--output=/tmp/changed-files"
+	fixtures.fakeFactory.changedFiles = []string{"apps/guestbook/deployment.yaml"}
+	q := &apiclient.RepoServerRevisionMetadataRequest{Repo: &argoappv1.Repository{}, App: "empty-list", Revision: fixtures.fakeFactory.revision, Full: true}
+
+	metadata, err := fixtures.Service.GetRevisionMetadata(context.Background(), q)
+	assert.NoError(t, err)
+	assert.Equal(t, fixtures.fakeFactory.revisionMetadata.Author, metadata.Author)
+	assert.Empty(t, metadata.ChangedFiles)
+}
+
+func TestService_GetRevisionChangelog(t *testing.T) {
+	fixtures := newFixtures(".", "empty-list")
+	date := time.Unix(1565000000, 0)
+	fixtures.fakeFactory.commits = []repo.Commit{{Revision: fixtures.fakeFactory.revision, Author: "foo", Date: date, Message: "bump image"}}
+	q := &apiclient.RepoServerRevisionChangelogRequest{Repo: &argoappv1.Repository{Repo: "my-repo"}, App: "empty-list", Revision: "deployed", TargetRevision: "master"}
+
+	changelog, err := fixtures.Service.GetRevisionChangelog(context.Background(), q)
+	assert.NoError(t, err)
+	assert.Equal(t, &v1alpha1.RevisionChangelog{
+		Revision:       "deployed",
+		TargetRevision: fixtures.fakeFactory.revision,
+		Commits:        []v1alpha1.CommitMetadata{{Revision: fixtures.fakeFactory.revision, Author: "foo", Date: metav1.Time{Time: date}, Message: "bump image"}},
+	}, changelog)
+
+	cached, err := fixtures.Service.cache.GetRevisionChangelog("my-repo", "deployed", fixtures.fakeFactory.revision)
+	assert.NoError(t, err)
+	assert.Len(t, cached.Commits, 1)
+}
+
+func TestCheckoutLock(t *testing.T) {
+	s := &Service{repoLock: util.NewKeyLock()}
+	r := &repomocks.Repo{}
+	r.On("LockKey").Return("repo")
+
+	// the repository is unlocked once the checkout is locked, so that other revisions are checked out concurrently
+	first, err := s.lockRepo(r, "first")
+	assert.NoError(t, err)
+	assert.NoError(t, first.lockCheckout("repo@first"))
+	first.unlockRepo()
+	second, err := s.lockRepo(r, "second")
+	assert.NoError(t, err)
+	assert.NoError(t, second.lockCheckout("repo@second"))
+	second.unlockRepo()
+	first.unlock()
+	second.unlock()
+
+	// the repository stays locked if all revisions share its checkout
+	shared, err := s.lockRepo(r, "shared")
+	assert.NoError(t, err)
+	assert.NoError(t, shared.lockCheckout("repo"))
+	shared.unlockRepo()
+	assert.True(t, shared.repoLocked)
+	shared.unlock()
+	assert.False(t, shared.repoLocked)
+}
+
+func TestCheckoutLock_Timeout(t *testing.T) {
+	s := &Service{repoLock: util.NewKeyLock(), lockTimeout: 10 * time.Millisecond}
+	r := &repomocks.Repo{}
+	r.On("LockKey").Return("repo")
+
+	stuck, err := s.lockRepo(r, "GenerateManifest guestbook@master")
+	assert.NoError(t, err)
+	defer stuck.unlock()
+
+	locks, err := s.GetLocks(context.Background(), &apiclient.LocksRequest{})
+	assert.NoError(t, err)
+	if assert.Len(t, locks.Locks, 1) {
+		assert.Equal(t, "repo", locks.Locks[0].Key)
+		assert.Equal(t, "GenerateManifest guestbook@master", locks.Locks[0].Owner)
+	}
+
+	_, err = s.lockRepo(r, "ListApps master")
+	assert.Equal(t, codes.Aborted, status.Code(err))
+	assert.Contains(t, err.Error(), "held by 'GenerateManifest guestbook@master'")
+}
+
+func TestService_SetProfiling_Unauthorized(t *testing.T) {
+	dir, err := ioutil.TempDir("", "profiler")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	s := newFixtures(".", "empty-list").Service
+	s.profiler = profile.NewProfiler(dir)
+	s.profilerTokenPath = filepath.Join(dir, "token")
+
+	// requests are rejected until the token is mounted
+	_, err = s.SetProfiling(context.Background(), &apiclient.ProfilingRequest{Enabled: true})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	assert.NoError(t, ioutil.WriteFile(s.profilerTokenPath, []byte("secret"), 0600))
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer other"))
+	_, err = s.SetProfiling(ctx, &apiclient.ProfilingRequest{Enabled: true})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = s.GetProfile(ctx, &apiclient.ProfileRequest{Name: "heap"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer secret"))
+	res, err := s.SetProfiling(ctx, &apiclient.ProfilingRequest{Enabled: true})
+	assert.NoError(t, err)
+	assert.True(t, res.Enabled)
+}
@@ -1,7 +1,9 @@
 package reposerver
 
 import (
+	"context"
 	"crypto/tls"
+	"time"
 
 	versionpkg "github.com/argoproj/argo-cd/pkg/apiclient/version"
 	"github.com/argoproj/argo-cd/reposerver/apiclient"
@@ -9,6 +11,7 @@ import (
 	"github.com/argoproj/argo-cd/server/version"
 	"github.com/argoproj/argo-cd/util/cache"
 	grpc_util "github.com/argoproj/argo-cd/util/grpc"
+	"github.com/argoproj/argo-cd/util/profile"
 	"github.com/argoproj/argo-cd/util/repo/factory"
 	tlsutil "github.com/argoproj/argo-cd/util/tls"
 
@@ -17,36 +20,50 @@ import (
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	// registers the gzip compressor, so that requests of clients which negotiate compression are answered compressed
+	_ "google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/reflection"
 )
 
 // ArgoCDRepoServer is the repo server implementation
 type ArgoCDRepoServer struct {
-	log              *log.Entry
-	clientFactory    factory.Factory
-	cache            *cache.Cache
-	opts             []grpc.ServerOption
-	parallelismLimit int64
+	log                   *log.Entry
+	clientFactory         factory.Factory
+	cache                 *cache.Cache
+	opts                  []grpc.ServerOption
+	parallelismLimit      int64
+	allowStaleManifests   bool
+	sparseCheckout        bool
+	revisionMessageLength int
+	lockTimeout           time.Duration
+	profiler              *profile.Profiler
+	manifestService       *repository.Service
 }
 
-// NewServer returns a new instance of the Argo CD Repo server
-func NewServer(clientFactory factory.Factory, cache *cache.Cache, tlsConfCustomizer tlsutil.ConfigCustomizer, parallelismLimit int64) (*ArgoCDRepoServer, error) {
-	// generate TLS cert
-	hosts := []string{
-		"localhost",
-		"argocd-repo-server",
-	}
-	cert, err := tlsutil.GenerateX509KeyPair(tlsutil.CertOptions{
-		Hosts:        hosts,
-		Organization: "Argo CD",
-		IsCA:         true,
-	})
+// NewServer returns a new instance of the Argo CD Repo server. Clients must present a certificate of the CA of the
+// internal certificates if they are not nil.
+func NewServer(clientFactory factory.Factory, cache *cache.Cache, tlsConfCustomizer tlsutil.ConfigCustomizer, internalCerts *tlsutil.InternalCerts, parallelismLimit int64, allowStaleManifests bool, sparseCheckout bool, revisionMessageLength int, lockTimeout time.Duration, profiler *profile.Profiler) (*ArgoCDRepoServer, error) {
+	var tlsConfig *tls.Config
+	if internalCerts != nil {
+		tlsConfig = internalCerts.ServerConfig()
+	} else {
+		// generate TLS cert
+		hosts := []string{
+			"localhost",
+			"argocd-repo-server",
+		}
+		cert, err := tlsutil.GenerateX509KeyPair(tlsutil.CertOptions{
+			Hosts:        hosts,
+			Organization: "Argo CD",
+			IsCA:         true,
+		})
 
-	if err != nil {
-		return nil, err
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig = &tls.Config{Certificates: []tls.Certificate{*cert}}
 	}
-
-	tlsConfig := &tls.Config{Certificates: []tls.Certificate{*cert}}
 	tlsConfCustomizer(tlsConfig)
 
 	serverLog := log.NewEntry(log.StandardLogger())
@@ -54,14 +71,21 @@ func NewServer(clientFactory factory.Factory, cache *cache.Cache, tlsConfCustomi
 	unaryInterceptors := []grpc.UnaryServerInterceptor{grpc_logrus.UnaryServerInterceptor(serverLog), grpc_util.PanicLoggerUnaryServerInterceptor(serverLog)}
 
 	return &ArgoCDRepoServer{
-		log:              serverLog,
-		clientFactory:    clientFactory,
-		cache:            cache,
-		parallelismLimit: parallelismLimit,
+		log:                   serverLog,
+		clientFactory:         clientFactory,
+		cache:                 cache,
+		parallelismLimit:      parallelismLimit,
+		allowStaleManifests:   allowStaleManifests,
+		sparseCheckout:        sparseCheckout,
+		revisionMessageLength: revisionMessageLength,
+		lockTimeout:           lockTimeout,
+		profiler:              profiler,
 		opts: []grpc.ServerOption{
 			grpc.Creds(credentials.NewTLS(tlsConfig)),
 			grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(unaryInterceptors...)),
 			grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(streamInterceptors...)),
+			// accept the keepalive pings of idle clients, which would be disconnected as abusive otherwise
+			grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{MinTime: apiclient.MinKeepAliveTime, PermitWithoutStream: true}),
 		},
 	}, nil
 }
@@ -70,11 +94,38 @@ func NewServer(clientFactory factory.Factory, cache *cache.Cache, tlsConfCustomi
 func (a *ArgoCDRepoServer) CreateGRPC() *grpc.Server {
 	server := grpc.NewServer(a.opts...)
 	versionpkg.RegisterVersionServiceServer(server, &version.Server{})
-	manifestService := repository.NewService(a.clientFactory, a.cache, a.parallelismLimit)
-	apiclient.RegisterRepoServerServiceServer(server, manifestService)
+	a.manifestService = repository.NewService(a.clientFactory, a.cache, a.parallelismLimit, a.allowStaleManifests, a.sparseCheckout, a.revisionMessageLength, a.lockTimeout, a.profiler)
+	apiclient.RegisterRepoServerServiceServer(server, a.manifestService)
 
 	// Register reflection service on gRPC server.
 	reflection.Register(server)
 
 	return server
 }
+
+// Shutdown gracefully stops the given gRPC server: new RPCs are refused, while in-flight RPCs (e.g. manifest
+// generations) may finish within the grace period, so that they release their repository locks and write their
+// results to the cache instead of leaving half-updated checkouts behind. RPCs which are still running when the grace
+// period expires are cancelled.
+func (a *ArgoCDRepoServer) Shutdown(server *grpc.Server, gracePeriod time.Duration) {
+	ctx, cancel := context.WithTimeout(context.Background(), gracePeriod)
+	defer cancel()
+
+	stopped := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+		a.log.Info("In-flight requests finished")
+	case <-ctx.Done():
+		a.log.Warnf("In-flight requests did not finish within %v, cancelling them", gracePeriod)
+		server.Stop()
+	}
+	if a.manifestService != nil {
+		if err := a.manifestService.Shutdown(ctx); err != nil {
+			a.log.Warnf("Background manifest refreshes did not finish within %v: %v", gracePeriod, err)
+		}
+	}
+}
@@ -63,3 +63,49 @@ func (s *Server) UpdatePassword(ctx context.Context, q *account.UpdatePasswordRe
 	return &account.UpdatePasswordResponse{}, nil
 
 }
+
+// localAccount returns the local account of the request, since only the tokens of local accounts are issued by Argo CD
+func localAccount(ctx context.Context) (string, error) {
+	sub := session.Sub(ctx)
+	if sub != common.ArgoCDAdminUsername || session.Iss(ctx) != session.SessionManagerClaimsIssuer {
+		return "", status.Errorf(codes.InvalidArgument, "tokens can only be managed for local users, not user %q", sub)
+	}
+	return sub, nil
+}
+
+// ListTokens returns the outstanding tokens of the local account
+func (s *Server) ListTokens(ctx context.Context, q *account.ListTokensRequest) (*account.TokensList, error) {
+	username, err := localAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tokens, err := s.settingsMgr.GetAccountTokens(username)
+	if err != nil {
+		return nil, err
+	}
+	currentID := session.TokenID(ctx)
+	list := &account.TokensList{Items: make([]*account.Token, 0, len(tokens))}
+	for _, token := range tokens {
+		list.Items = append(list.Items, &account.Token{
+			Id:        token.ID,
+			IssuedAt:  token.IssuedAt,
+			ExpiresAt: token.ExpiresAt,
+			Current:   token.ID == currentID,
+		})
+	}
+	return list, nil
+}
+
+// DeleteToken revokes an outstanding token of the local account
+func (s *Server) DeleteToken(ctx context.Context, q *account.DeleteTokenRequest) (*account.DeleteTokenResponse, error) {
+	username, err := localAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = s.sessionMgr.RevokeAccountToken(username, q.Id)
+	if err != nil {
+		return nil, err
+	}
+	log.Infof("user '%s' revoked token '%s'", username, q.Id)
+	return &account.DeleteTokenResponse{}, nil
+}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/dgrijalva/jwt-go"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
@@ -86,3 +88,50 @@ func TestUpdatePassword(t *testing.T) {
 	_, err = sessionServer.Create(ctx, &sessionpkg.SessionCreateRequest{Username: "admin", Password: "newpassword"})
 	assert.NoError(t, err)
 }
+
+func TestTokens(t *testing.T) {
+	ctx := context.Background()
+	_, accountServer, sessionServer := newTestAccountServer(ctx)
+
+	login := func() (string, context.Context) {
+		res, err := sessionServer.Create(ctx, &sessionpkg.SessionCreateRequest{Username: "admin", Password: "oldpassword"})
+		assert.NoError(t, err)
+		claims, err := accountServer.sessionMgr.Parse(res.Token)
+		assert.NoError(t, err)
+		return res.Token, context.WithValue(ctx, "claims", claims)
+	}
+	token1, ctx1 := login()
+	token2, ctx2 := login()
+
+	tokens, err := accountServer.ListTokens(ctx1, &account.ListTokensRequest{})
+	assert.NoError(t, err)
+	if assert.Len(t, tokens.Items, 2) {
+		assert.True(t, tokens.Items[0].Current)
+		assert.False(t, tokens.Items[1].Current)
+		assert.Equal(t, sessionutil.TokenID(ctx2), tokens.Items[1].Id)
+	}
+
+	// logging out revokes the token of the session
+	_, err = sessionServer.Delete(ctx1, &sessionpkg.SessionDeleteRequest{})
+	assert.NoError(t, err)
+	_, err = accountServer.sessionMgr.Parse(token1)
+	assert.EqualError(t, err, "Token has been revoked")
+	_, err = accountServer.sessionMgr.Parse(token2)
+	assert.NoError(t, err)
+
+	_, err = accountServer.DeleteToken(ctx2, &account.DeleteTokenRequest{Id: sessionutil.TokenID(ctx2)})
+	assert.NoError(t, err)
+	_, err = accountServer.sessionMgr.Parse(token2)
+	assert.Error(t, err)
+	tokens, err = accountServer.ListTokens(ctx2, &account.ListTokensRequest{})
+	assert.NoError(t, err)
+	assert.Empty(t, tokens.Items)
+
+	_, err = accountServer.DeleteToken(ctx2, &account.DeleteTokenRequest{Id: "does-not-exist"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// the tokens of SSO users are not issued by Argo CD
+	ssoCtx := context.WithValue(ctx, "claims", &jwt.StandardClaims{Subject: "admin", Issuer: "https://dex.example.com"})
+	_, err = accountServer.ListTokens(ssoCtx, &account.ListTokensRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
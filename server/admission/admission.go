@@ -0,0 +1,118 @@
+package admission
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/pkg/apis/application"
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/pkg/client/clientset/versioned"
+	"github.com/argoproj/argo-cd/util/argo"
+	"github.com/argoproj/argo-cd/util/db"
+)
+
+// NewHandler creates a handler serving the validating admission webhook for applications and projects
+func NewHandler(appClientset versioned.Interface, db db.ArgoDB, namespace string) http.Handler {
+	return &Handler{appClientset: appClientset, db: db, namespace: namespace}
+}
+
+// Handler validates Application and AppProject objects on create and update, so that invalid specs are rejected
+// by the Kubernetes API server rather than reported at reconcile time
+type Handler struct {
+	namespace    string
+	appClientset versioned.Interface
+	db           db.ArgoDB
+}
+
+// ServeHTTP decodes an admission review, validates the object under review and writes the review response
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "admission reviews must be posted", http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var review admissionv1beta1.AdmissionReview
+	if err := json.Unmarshal(body, &review); err != nil || review.Request == nil {
+		http.Error(w, "invalid admission review", http.StatusBadRequest)
+		return
+	}
+	response := &admissionv1beta1.AdmissionResponse{UID: review.Request.UID, Allowed: true}
+	if err := h.validate(r.Context(), review.Request); err != nil {
+		response.Allowed = false
+		response.Result = &metav1.Status{Message: err.Error(), Reason: metav1.StatusReasonInvalid}
+	}
+	review.Response = response
+	review.Request = nil
+	out, err := json.Marshal(review)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(out)
+}
+
+func (h *Handler) validate(ctx context.Context, req *admissionv1beta1.AdmissionRequest) error {
+	if req.Operation != admissionv1beta1.Create && req.Operation != admissionv1beta1.Update {
+		return nil
+	}
+	switch req.Kind.Kind {
+	case application.ApplicationKind:
+		var app appv1.Application
+		if err := json.Unmarshal(req.Object.Raw, &app); err != nil {
+			return err
+		}
+		if req.Operation == admissionv1beta1.Update {
+			// the controller updates the status of applications constantly, so only spec changes are validated
+			var oldApp appv1.Application
+			if err := json.Unmarshal(req.OldObject.Raw, &oldApp); err == nil && reflect.DeepEqual(oldApp.Spec, app.Spec) {
+				return nil
+			}
+		}
+		return h.validateApplication(ctx, &app)
+	case application.AppProjectKind:
+		var proj appv1.AppProject
+		if err := json.Unmarshal(req.Object.Raw, &proj); err != nil {
+			return err
+		}
+		return proj.ValidateProject()
+	}
+	return nil
+}
+
+func (h *Handler) validateApplication(ctx context.Context, app *appv1.Application) error {
+	proj, err := h.appClientset.ArgoprojV1alpha1().AppProjects(h.namespace).Get(app.Spec.GetProject(), metav1.GetOptions{})
+	if err != nil {
+		if apierr.IsNotFound(err) {
+			return fmt.Errorf("application references project %s which does not exist", app.Spec.GetProject())
+		}
+		return err
+	}
+	violations, err := argo.GetProjectRuleViolations(ctx, &app.Spec, proj, h.db)
+	if err != nil {
+		log.Warnf("Failed to validate application %s: %v", app.Name, err)
+		return err
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+	messages := make([]string, len(violations))
+	for i, violation := range violations {
+		messages[i] = fmt.Sprintf("%s: %s", violation.Rule, violation.Message)
+	}
+	return fmt.Errorf("application spec is invalid: %s", strings.Join(messages, "; "))
+}
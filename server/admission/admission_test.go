@@ -0,0 +1,110 @@
+package admission
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/pkg/apis/application"
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	appclientset "github.com/argoproj/argo-cd/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-cd/util/db"
+	"github.com/argoproj/argo-cd/util/settings"
+)
+
+var testProj = appv1.AppProject{
+	ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "default"},
+	Spec: appv1.AppProjectSpec{
+		SourceRepos:  []string{"https://github.com/argoproj/*"},
+		Destinations: []appv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+	},
+}
+
+func newTestApp(repoURL string) *appv1.Application {
+	return &appv1.Application{
+		TypeMeta:   metav1.TypeMeta{Kind: application.ApplicationKind, APIVersion: "argoproj.io/v1alpha1"},
+		ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: "default"},
+		Spec: appv1.ApplicationSpec{
+			Source:      appv1.ApplicationSource{RepoURL: repoURL, Path: "guestbook"},
+			Destination: appv1.ApplicationDestination{Server: common.KubernetesInternalAPIServerAddr, Namespace: "default"},
+		},
+	}
+}
+
+func review(t *testing.T, kind string, operation admissionv1beta1.Operation, obj runtime.Object, oldObj runtime.Object) *admissionv1beta1.AdmissionResponse {
+	kubeclientset := fake.NewSimpleClientset()
+	argoDB := db.NewDB("default", settings.NewSettingsManager(context.Background(), kubeclientset, "default"), kubeclientset)
+	handler := NewHandler(appclientset.NewSimpleClientset(&testProj), argoDB, "default")
+
+	req := &admissionv1beta1.AdmissionRequest{
+		UID:       "123",
+		Kind:      metav1.GroupVersionKind{Group: "argoproj.io", Version: "v1alpha1", Kind: kind},
+		Operation: operation,
+	}
+	raw, err := json.Marshal(obj)
+	assert.NoError(t, err)
+	req.Object.Raw = raw
+	if oldObj != nil {
+		raw, err = json.Marshal(oldObj)
+		assert.NoError(t, err)
+		req.OldObject.Raw = raw
+	}
+	body, err := json.Marshal(admissionv1beta1.AdmissionReview{Request: req})
+	assert.NoError(t, err)
+
+	httpReq, err := http.NewRequest("POST", "/api/admission", bytes.NewReader(body))
+	assert.NoError(t, err)
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, httpReq)
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var res admissionv1beta1.AdmissionReview
+	assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &res))
+	assert.Equal(t, req.UID, res.Response.UID)
+	return res.Response
+}
+
+func TestValidApplication(t *testing.T) {
+	res := review(t, application.ApplicationKind, admissionv1beta1.Create, newTestApp("https://github.com/argoproj/argocd-example-apps"), nil)
+	assert.True(t, res.Allowed)
+}
+
+func TestSourceRepoNotPermitted(t *testing.T) {
+	res := review(t, application.ApplicationKind, admissionv1beta1.Create, newTestApp("https://gitlab.com/other/repo"), nil)
+	assert.False(t, res.Allowed)
+	assert.Contains(t, res.Result.Message, "sourceRepos: application repo https://gitlab.com/other/repo is not permitted")
+}
+
+func TestUnknownProject(t *testing.T) {
+	app := newTestApp("https://github.com/argoproj/argocd-example-apps")
+	app.Spec.Project = "unknown"
+	res := review(t, application.ApplicationKind, admissionv1beta1.Create, app, nil)
+	assert.False(t, res.Allowed)
+	assert.Contains(t, res.Result.Message, "project unknown which does not exist")
+}
+
+func TestStatusUpdateOfInvalidApplication(t *testing.T) {
+	app := newTestApp("https://gitlab.com/other/repo")
+	updated := app.DeepCopy()
+	updated.Status.Sync.Status = appv1.SyncStatusCodeSynced
+	res := review(t, application.ApplicationKind, admissionv1beta1.Update, updated, app)
+	assert.True(t, res.Allowed)
+}
+
+func TestInvalidProject(t *testing.T) {
+	proj := testProj.DeepCopy()
+	proj.TypeMeta = metav1.TypeMeta{Kind: application.AppProjectKind, APIVersion: "argoproj.io/v1alpha1"}
+	proj.Spec.Roles = []appv1.ProjectRole{{Name: "bad name!"}}
+	res := review(t, application.AppProjectKind, admissionv1beta1.Create, proj, nil)
+	assert.False(t, res.Allowed)
+}
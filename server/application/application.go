@@ -2,27 +2,37 @@ package application
 
 import (
 	"bufio"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/ghodss/yaml"
+	gocache "github.com/patrickmn/go-cache"
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	v1 "k8s.io/api/core/v1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
+	k8scache "k8s.io/client-go/tools/cache"
 
+	"github.com/argoproj/argo-cd/common"
 	"github.com/argoproj/argo-cd/pkg/apiclient/application"
 	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
@@ -36,13 +46,24 @@ import (
 	"github.com/argoproj/argo-cd/util/db"
 	"github.com/argoproj/argo-cd/util/diff"
 	"github.com/argoproj/argo-cd/util/git"
+	grpc_util "github.com/argoproj/argo-cd/util/grpc"
+	"github.com/argoproj/argo-cd/util/hook"
 	"github.com/argoproj/argo-cd/util/kube"
 	"github.com/argoproj/argo-cd/util/lua"
 	"github.com/argoproj/argo-cd/util/rbac"
 	"github.com/argoproj/argo-cd/util/repo/factory"
 	"github.com/argoproj/argo-cd/util/repo/metrics"
+	"github.com/argoproj/argo-cd/util/resource"
 	"github.com/argoproj/argo-cd/util/session"
 	"github.com/argoproj/argo-cd/util/settings"
+	"github.com/argoproj/argo-cd/util/tokenusage"
+	"github.com/argoproj/argo-cd/util/writeback"
+)
+
+const (
+	// responseCacheExpiration is how long responses of expensive read endpoints are kept in memory. Responses are
+	// keyed by the resource version of the application, so that any change to the application invalidates them.
+	responseCacheExpiration = 5 * time.Second
 )
 
 // Server provides a Application service
@@ -59,6 +80,10 @@ type Server struct {
 	clientFactory factory.Factory
 	settingsMgr   *settings.SettingsManager
 	cache         *cache.Cache
+	responseCache *gocache.Cache
+	tokenUsage    *tokenusage.Tracker
+	appInformer   k8scache.SharedIndexInformer
+	events        *eventBroadcaster
 }
 
 // NewServer returns a new instance of the Application service
@@ -73,7 +98,11 @@ func NewServer(
 	enf *rbac.Enforcer,
 	projectLock *util.KeyLock,
 	settingsMgr *settings.SettingsManager,
+	tokenUsage *tokenusage.Tracker,
+	appInformer k8scache.SharedIndexInformer,
 ) application.ApplicationServiceServer {
+	events := newEventBroadcaster()
+	appInformer.AddEventHandler(events)
 
 	return &Server{
 		ns:            namespace,
@@ -88,6 +117,10 @@ func NewServer(
 		auditLogger:   argo.NewAuditLogger(namespace, kubeclientset, "argocd-server"),
 		clientFactory: factory.NewFactory(),
 		settingsMgr:   settingsMgr,
+		responseCache: gocache.New(responseCacheExpiration, time.Minute),
+		tokenUsage:    tokenUsage,
+		appInformer:   appInformer,
+		events:        events,
 	}
 }
 
@@ -96,25 +129,224 @@ func appRBACName(app appv1.Application) string {
 	return fmt.Sprintf("%s/%s", app.Spec.GetProject(), app.Name)
 }
 
-// List returns list of applications
+// List returns list of applications. Applications are served from the informer cache, which is indexed by project, and
+// are filtered by label selector and name, and sorted, before they are returned.
 func (s *Server) List(ctx context.Context, q *application.ApplicationQuery) (*appv1.ApplicationList, error) {
-	appList, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).List(metav1.ListOptions{})
+	selector, err := labels.Parse(q.Selector)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid selector '%s': %v", q.Selector, err)
+	}
+	apps, err := listApplications(s.appInformer.GetIndexer(), q.Projects, selector, q.Search)
 	if err != nil {
 		return nil, err
 	}
+	if err = sortApplications(apps, q.SortBy); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	newItems := make([]appv1.Application, 0)
+	for _, a := range apps {
+		if s.enf.Enforce(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, appRBACName(*a)) {
+			newItems = append(newItems, *a.DeepCopy())
+		}
+	}
+	return &appv1.ApplicationList{Items: newItems}, nil
+}
+
+// ListResourceConflicts returns the pairs of applications which manage the same resources. Only applications
+// visible to the caller are considered.
+func (s *Server) ListResourceConflicts(ctx context.Context, q *application.ResourceConflictsQuery) (*application.ResourceConflictList, error) {
+	appList, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	apps := make([]*appv1.Application, 0)
+	for i := range appList.Items {
+		if s.enf.Enforce(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, appRBACName(appList.Items[i])) {
+			apps = append(apps, &appList.Items[i])
+		}
+	}
+	items := make([]application.ResourceConflict, 0)
+	for _, conflict := range argoutil.GetResourceConflicts(apps) {
+		if q.Name != "" {
+			if _, ok := conflict.GetConflictingApplication(q.Name); !ok {
+				continue
+			}
+		}
+		items = append(items, application.ResourceConflict{
+			Server:                 conflict.Server,
+			Group:                  conflict.Group,
+			Kind:                   conflict.Kind,
+			Namespace:              conflict.Namespace,
+			Name:                   conflict.Name,
+			Application:            conflict.Application,
+			ConflictingApplication: conflict.ConflictingApplication,
+		})
+	}
+	return &application.ResourceConflictList{Items: items}, nil
+}
+
+// DriftReport returns the resources of the applications of a project which drifted from their target state, ordered by
+// application and resource so that the report can be paged through
+func (s *Server) DriftReport(ctx context.Context, q *application.ApplicationDriftReportQuery) (*application.ApplicationDriftReport, error) {
+	var since time.Time
+	if q.Since != "" {
+		var err error
+		if since, err = time.Parse(time.RFC3339, q.Since); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid since time '%s': %v", q.Since, err)
+		}
+	}
+	if q.Limit < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must not be negative")
+	}
+	var after string
+	if q.Continue != "" {
+		data, err := base64.RawURLEncoding.DecodeString(q.Continue)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid continue token")
+		}
+		after = string(data)
+	}
+	appList, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	items := make([]application.DriftedResource, 0)
+	for i := range appList.Items {
+		a := &appList.Items[i]
+		if a.Spec.GetProject() != q.Project || !s.enf.Enforce(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, appRBACName(*a)) {
+			continue
+		}
+		var diffs map[kube.ResourceKey]string
+		for _, res := range a.Status.Resources {
+			if res.Status != appv1.SyncStatusCodeOutOfSync || (!since.IsZero() && (res.OutOfSyncSince == nil || res.OutOfSyncSince.Time.Before(since))) {
+				continue
+			}
+			item := application.DriftedResource{
+				Application:     a.Name,
+				Group:           res.Group,
+				Kind:            res.Kind,
+				Namespace:       res.Namespace,
+				Name:            res.Name,
+				Missing:         res.Health != nil && res.Health.Status == appv1.HealthStatusMissing,
+				RequiresPruning: res.RequiresPruning,
+				DetectedAt:      res.OutOfSyncSince,
+			}
+			if after != "" && driftedResourceKey(item) <= after {
+				continue
+			}
+			if diffs == nil {
+				diffs = s.getManagedResourceDiffs(a)
+			}
+			if fields, err := diff.ModifiedPaths(diffs[kube.NewResourceKey(res.Group, res.Kind, res.Namespace, res.Name)]); err == nil {
+				item.Fields = fields
+			}
+			items = append(items, item)
+		}
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return driftedResourceKey(items[i]) < driftedResourceKey(items[j])
+	})
+	report := &application.ApplicationDriftReport{Items: items}
+	if q.Limit > 0 && int64(len(items)) > q.Limit {
+		report.Items = items[:q.Limit]
+		report.Continue = base64.RawURLEncoding.EncodeToString([]byte(driftedResourceKey(report.Items[q.Limit-1])))
+	}
+	return report, nil
+}
+
+// getManagedResourceDiffs returns the diffs of the managed resources of the application which are cached by the
+// controller, or none if they are not cached
+func (s *Server) getManagedResourceDiffs(a *appv1.Application) map[kube.ResourceKey]string {
+	diffs := make(map[kube.ResourceKey]string)
+	managedResources, err := s.cache.GetAppManagedResources(a.Name)
+	if err != nil {
+		log.Warnf("Failed to get the managed resources of application '%s': %v", a.Name, err)
+		return diffs
+	}
+	for _, res := range managedResources {
+		diffs[kube.NewResourceKey(res.Group, res.Kind, res.Namespace, res.Name)] = res.Diff
+	}
+	return diffs
+}
+
+// driftedResourceKey returns the key by which the resources of a drift report are ordered
+func driftedResourceKey(res application.DriftedResource) string {
+	return strings.Join([]string{res.Application, res.Group, res.Kind, res.Namespace, res.Name}, "/")
+}
+
+// StatusMatrix aggregates the sync and health statuses and the revisions of the selected applications which are
+// visible to the caller into a matrix of groups and destination clusters
+func (s *Server) StatusMatrix(ctx context.Context, q *application.ApplicationStatusMatrixQuery) (*application.ApplicationStatusMatrix, error) {
+	if _, err := labels.Parse(q.Selector); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid selector '%s': %v", q.Selector, err)
+	}
+	appList, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).List(metav1.ListOptions{LabelSelector: q.Selector})
+	if err != nil {
+		return nil, err
+	}
+	apps := make([]appv1.Application, 0)
+	servers := make(map[string]bool)
 	for _, a := range appList.Items {
 		if s.enf.Enforce(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, appRBACName(a)) {
-			newItems = append(newItems, a)
+			apps = append(apps, a)
+			servers[a.Spec.Destination.Server] = true
 		}
 	}
-	newItems = argoutil.FilterByProjects(newItems, q.Projects)
-	for i := range newItems {
-		app := newItems[i]
-		newItems[i] = app
+	clusterNames := make(map[string]string)
+	if clusters, err := s.db.ListClusters(ctx); err != nil {
+		log.Warnf("Failed to list clusters: %v", err)
+	} else {
+		for _, c := range clusters.Items {
+			clusterNames[c.Server] = c.Name
+		}
 	}
-	appList.Items = newItems
-	return appList, nil
+	matrix := &application.ApplicationStatusMatrix{
+		Clusters: make([]application.ApplicationStatusMatrixCluster, 0),
+		Rows:     make([]application.ApplicationStatusMatrixRow, 0),
+	}
+	columns := make(map[string]int32)
+	for server := range servers {
+		matrix.Clusters = append(matrix.Clusters, application.ApplicationStatusMatrixCluster{Server: server, Name: clusterNames[server]})
+	}
+	sort.Slice(matrix.Clusters, func(i, j int) bool {
+		return matrix.Clusters[i].Server < matrix.Clusters[j].Server
+	})
+	for i, c := range matrix.Clusters {
+		columns[c.Server] = int32(i)
+	}
+	rows := make(map[string]*application.ApplicationStatusMatrixRow)
+	for _, a := range apps {
+		group := a.Name
+		if value, ok := a.Labels[q.GroupBy]; ok && q.GroupBy != "" {
+			group = value
+		}
+		row, ok := rows[group]
+		if !ok {
+			row = &application.ApplicationStatusMatrixRow{Name: group, Cells: make([]application.ApplicationStatusMatrixCell, 0)}
+			rows[group] = row
+		}
+		row.Cells = append(row.Cells, application.ApplicationStatusMatrixCell{
+			Cluster:      columns[a.Spec.Destination.Server],
+			Application:  a.Name,
+			Namespace:    a.Spec.Destination.Namespace,
+			SyncStatus:   string(a.Status.Sync.Status),
+			HealthStatus: string(a.Status.Health.Status),
+			Revision:     a.Status.Sync.Revision,
+		})
+	}
+	for _, row := range rows {
+		sort.Slice(row.Cells, func(i, j int) bool {
+			if row.Cells[i].Cluster != row.Cells[j].Cluster {
+				return row.Cells[i].Cluster < row.Cells[j].Cluster
+			}
+			return row.Cells[i].Application < row.Cells[j].Application
+		})
+		matrix.Rows = append(matrix.Rows, *row)
+	}
+	sort.Slice(matrix.Rows, func(i, j int) bool {
+		return matrix.Rows[i].Name < matrix.Rows[j].Name
+	})
+	return matrix, nil
 }
 
 // Create creates an application
@@ -167,18 +399,26 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, appRBACName(*a)); err != nil {
 		return nil, err
 	}
+	return s.generateManifests(ctx, a, q.Revision)
+}
+
+// generateManifests generates the target manifests of the application at the given revision (or target revision if empty)
+func (s *Server) generateManifests(ctx context.Context, a *appv1.Application, revision string) (*apiclient.ManifestResponse, error) {
 	repo, err := s.db.GetRepository(ctx, a.Spec.Source.RepoURL)
 	if err != nil {
 		return nil, err
 	}
+	proj, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Get(a.Spec.GetProject(), metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
 	conn, repoClient, err := s.repoClientset.NewRepoServerClient()
 	if err != nil {
 		return nil, err
 	}
 	defer util.Close(conn)
-	revision := a.Spec.Source.TargetRevision
-	if q.Revision != "" {
-		revision = q.Revision
+	if revision == "" {
+		revision = a.Spec.Source.TargetRevision
 	}
 	appInstanceLabelKey, err := s.settingsMgr.GetAppInstanceLabelKey()
 	if err != nil {
@@ -188,6 +428,7 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 	if err != nil {
 		return nil, err
 	}
+	repos = proj.ScopeRepositoryCredentials(repos)
 
 	plugins, err := s.plugins()
 	if err != nil {
@@ -209,17 +450,25 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 	if err != nil {
 		return nil, err
 	}
+	apiVersions, err := s.kubectl.GetAPIVersions(cluster.RESTConfig())
+	if err != nil {
+		return nil, err
+	}
 	manifestInfo, err := repoClient.GenerateManifest(ctx, &apiclient.ManifestRequest{
-		Repo:              repo,
-		Revision:          revision,
-		AppLabelKey:       appInstanceLabelKey,
-		AppLabelValue:     a.Name,
-		Namespace:         a.Spec.Destination.Namespace,
-		ApplicationSource: &a.Spec.Source,
-		Repos:             repos,
-		Plugins:           plugins,
-		KustomizeOptions:  &kustomizeOptions,
-		KubeVersion:       cluster.ServerVersion,
+		Repo:                   repo,
+		Revision:               revision,
+		AppLabelKey:            appInstanceLabelKey,
+		AppLabelValue:          a.Name,
+		Namespace:              a.Spec.Destination.Namespace,
+		ApplicationSource:      &a.Spec.Source,
+		Repos:                  repos,
+		Plugins:                plugins,
+		KustomizeOptions:       &kustomizeOptions,
+		KubeVersion:            cluster.ServerVersion,
+		ApiVersions:            apiVersions,
+		DecryptionKeys:         proj.Spec.SourceDecryptionKeys,
+		ParameterOverridesFile: a.Spec.GetParameterOverridesFile(),
+		ManifestQuota:          proj.Spec.ManifestQuota,
 	})
 	if err != nil {
 		return nil, err
@@ -228,6 +477,65 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 	return manifestInfo, nil
 }
 
+// Preview performs a server-side dry-run apply of the application target manifests, so that admission webhooks, quotas and
+// schema validation are evaluated by the destination cluster without persisting any change.
+func (s *Server) Preview(ctx context.Context, q *application.ApplicationPreviewRequest) (*application.ApplicationPreviewResponse, error) {
+	a, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(*q.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionSync, appRBACName(*a)); err != nil {
+		return nil, err
+	}
+	manifestInfo, err := s.generateManifests(ctx, a, q.Revision)
+	if err != nil {
+		return nil, err
+	}
+	targetObjs := make([]*unstructured.Unstructured, 0)
+	for _, manifest := range manifestInfo.Manifests {
+		obj, err := appv1.UnmarshalToUnstructured(manifest)
+		if err != nil {
+			return nil, err
+		}
+		// hooks are created with generated names during the sync and cannot be previewed using apply
+		if hook.IsHook(obj) {
+			continue
+		}
+		targetObjs = append(targetObjs, obj)
+	}
+	config, namespace, err := s.getApplicationClusterConfig(a.Name)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]application.ResourcePreviewResult, len(targetObjs))
+	err = util.RunAllAsync(len(targetObjs), func(i int) error {
+		obj := targetObjs[i]
+		objNamespace := obj.GetNamespace()
+		if objNamespace == "" {
+			objNamespace = namespace
+		}
+		result := application.ResourcePreviewResult{
+			Group:     obj.GroupVersionKind().Group,
+			Kind:      obj.GetKind(),
+			Namespace: objNamespace,
+			Name:      obj.GetName(),
+			Status:    application.PreviewStatusPassed,
+		}
+		message, err := s.kubectl.ApplyResource(config, obj, objNamespace, kube.DryRunServer, false, true)
+		if err != nil {
+			result.Status = application.PreviewStatusFailed
+			message = err.Error()
+		}
+		result.Message = message
+		items[i] = result
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &application.ApplicationPreviewResponse{Items: items}, nil
+}
+
 // Get returns an application by name
 func (s *Server) Get(ctx context.Context, q *application.ApplicationQuery) (*appv1.Application, error) {
 	appIf := s.appclientset.ArgoprojV1alpha1().Applications(s.ns)
@@ -239,9 +547,9 @@ func (s *Server) Get(ctx context.Context, q *application.ApplicationQuery) (*app
 		return nil, err
 	}
 	if q.Refresh != nil {
-		refreshType := appv1.RefreshTypeNormal
-		if *q.Refresh == string(appv1.RefreshTypeHard) {
-			refreshType = appv1.RefreshTypeHard
+		refreshType := appv1.RefreshType(*q.Refresh)
+		if refreshType != appv1.RefreshTypeNormal && refreshType != appv1.RefreshTypeHard {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid refresh type '%s': must be '%s' or '%s'", *q.Refresh, appv1.RefreshTypeNormal, appv1.RefreshTypeHard)
 		}
 		_, err = argoutil.RefreshApp(appIf, *q.Name, refreshType)
 		if err != nil {
@@ -336,11 +644,20 @@ func (s *Server) UpdateSpec(ctx context.Context, q *application.ApplicationUpdat
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionUpdate, appRBACName(*a)); err != nil {
 		return nil, err
 	}
+	oldSource := a.Spec.Source
 	a.Spec = q.Spec
 	err = s.validateAndNormalizeApp(ctx, a)
 	if err != nil {
 		return nil, err
 	}
+	if a.Spec.WriteBack != nil {
+		overrides := writeback.Extract(oldSource, &a.Spec.Source)
+		if !overrides.IsZero() {
+			if _, err := s.writeBack(ctx, a, overrides); err != nil {
+				return nil, err
+			}
+		}
+	}
 	normalizedSpec := a.Spec.DeepCopy()
 
 	for i := 0; i < 10; i++ {
@@ -361,6 +678,33 @@ func (s *Server) UpdateSpec(ctx context.Context, q *application.ApplicationUpdat
 	return nil, status.Errorf(codes.Internal, "Failed to update application spec. Too many conflicts")
 }
 
+// ValidateSpec explains which rules of the application's project, if any, reject the given spec
+func (s *Server) ValidateSpec(ctx context.Context, q *application.ApplicationValidateSpecRequest) (*application.ApplicationValidateSpecResponse, error) {
+	a := appv1.Application{ObjectMeta: metav1.ObjectMeta{Name: *q.Name}, Spec: q.Spec}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, appRBACName(a)); err != nil {
+		return nil, err
+	}
+	proj, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Get(q.Spec.GetProject(), metav1.GetOptions{})
+	if err != nil {
+		if apierr.IsNotFound(err) {
+			return &application.ApplicationValidateSpecResponse{Violations: []application.ProjectRuleViolation{{
+				Rule:    "project",
+				Message: fmt.Sprintf("application references project %s which does not exist", q.Spec.GetProject()),
+			}}}, nil
+		}
+		return nil, err
+	}
+	violations, err := argo.GetProjectRuleViolations(ctx, &q.Spec, proj, s.db)
+	if err != nil {
+		return nil, err
+	}
+	res := &application.ApplicationValidateSpecResponse{Violations: make([]application.ProjectRuleViolation, len(violations))}
+	for i, violation := range violations {
+		res.Violations[i] = application.ProjectRuleViolation{Rule: violation.Rule, Message: violation.Message, Allowed: violation.Allowed}
+	}
+	return res, nil
+}
+
 // Patch patches an application
 func (s *Server) Patch(ctx context.Context, q *application.ApplicationPatchRequest) (*appv1.Application, error) {
 
@@ -541,6 +885,28 @@ func (s *Server) Watch(q *application.ApplicationQuery, ws application.Applicati
 	return nil
 }
 
+// WatchEvents streams the significant events of the applications which match the query and which the caller is
+// permitted to get
+func (s *Server) WatchEvents(q *application.ApplicationEventStreamQuery, ws application.ApplicationService_WatchEventsServer) error {
+	claims := ws.Context().Value("claims")
+	events, unsubscribe := s.events.subscribe()
+	defer unsubscribe()
+	for {
+		select {
+		case <-ws.Context().Done():
+			return nil
+		case next := <-events:
+			if !matchesEventQuery(q, next.event) || !s.enf.Enforce(claims, rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, appRBACName(*next.app)) {
+				continue
+			}
+			if err := ws.Send(next.event); err != nil {
+				log.Warnf("Unable to send stream message: %v", err)
+				return err
+			}
+		}
+	}
+}
+
 func (s *Server) validateAndNormalizeApp(ctx context.Context, app *appv1.Application) error {
 	proj, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Get(app.Spec.GetProject(), metav1.GetOptions{})
 	if err != nil {
@@ -570,6 +936,36 @@ func (s *Server) validateAndNormalizeApp(ctx context.Context, app *appv1.Applica
 		}
 	}
 
+	if app.Spec.RevisionHistoryLimit != nil && *app.Spec.RevisionHistoryLimit < 1 {
+		return status.Errorf(codes.InvalidArgument, "application spec is invalid: revisionHistoryLimit must be at least 1")
+	}
+	if app.Spec.WriteBack != nil && app.Spec.WriteBack.Branch == "" {
+		return status.Errorf(codes.InvalidArgument, "application spec is invalid: branch of write back must be set")
+	}
+	for _, resourceHook := range app.Spec.ResourceHooks {
+		if resourceHook.Kind == "" {
+			return status.Errorf(codes.InvalidArgument, "application spec is invalid: kind of resource hook must be set")
+		}
+		for _, hookType := range resourceHook.Hook {
+			if _, ok := appv1.NewHookType(string(hookType)); !ok {
+				return status.Errorf(codes.InvalidArgument, "application spec is invalid: unknown hook type '%s'", hookType)
+			}
+		}
+		for _, policy := range resourceHook.DeletePolicy {
+			if _, ok := appv1.NewHookDeletePolicy(string(policy)); !ok {
+				return status.Errorf(codes.InvalidArgument, "application spec is invalid: unknown hook delete policy '%s'", policy)
+			}
+		}
+	}
+	for _, patch := range app.Spec.Source.Patches {
+		if patch.Target.Kind == "" {
+			return status.Errorf(codes.InvalidArgument, "application spec is invalid: kind of patch target must be set")
+		}
+		if err := resource.ValidatePatch(patch.Patch); err != nil {
+			return status.Errorf(codes.InvalidArgument, "application spec is invalid: patch of %s is invalid: %v", patch.Target.Kind, err)
+		}
+	}
+
 	buildOptions, err := s.settingsMgr.GetKustomizeBuildOptions()
 	if err != nil {
 		return err
@@ -582,7 +978,7 @@ func (s *Server) validateAndNormalizeApp(ctx context.Context, app *appv1.Applica
 		return err
 	}
 
-	conditions, err := argo.ValidateRepo(ctx, &app.Spec, s.repoClientset, s.db, &kustomizeOptions, plugins, s.kubectl)
+	conditions, err := argo.ValidateRepo(ctx, &app.Spec, proj, s.repoClientset, s.db, &kustomizeOptions, plugins, s.kubectl)
 	if err != nil {
 		return err
 	}
@@ -594,6 +990,19 @@ func (s *Server) validateAndNormalizeApp(ctx context.Context, app *appv1.Applica
 	if err != nil {
 		return err
 	}
+	if len(conditions) > 0 {
+		return grpc_util.NewError(codes.InvalidArgument, grpc_util.ErrorReasonProjectPermissionDenied, "application spec is invalid: %s", argo.FormatAppConditions(conditions))
+	}
+
+	appList, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	apps := make([]*appv1.Application, len(appList.Items))
+	for i := range appList.Items {
+		apps[i] = &appList.Items[i]
+	}
+	conditions = argo.ValidateHelmReleaseName(app, apps)
 	if len(conditions) > 0 {
 		return status.Errorf(codes.InvalidArgument, "application spec is invalid: %s", argo.FormatAppConditions(conditions))
 	}
@@ -602,6 +1011,15 @@ func (s *Server) validateAndNormalizeApp(ctx context.Context, app *appv1.Applica
 	return nil
 }
 
+// getSyncPolicy returns the sync policy of the application, taking the default sync policy of its project into account
+func (s *Server) getSyncPolicy(a *appv1.Application) (*appv1.SyncPolicy, error) {
+	proj, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Get(a.Spec.GetProject(), metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return a.Spec.GetSyncPolicy(proj), nil
+}
+
 func (s *Server) getApplicationClusterConfig(applicationName string) (*rest.Config, string, error) {
 	server, namespace, err := s.getApplicationDestination(applicationName)
 	if err != nil {
@@ -615,8 +1033,29 @@ func (s *Server) getApplicationClusterConfig(applicationName string) (*rest.Conf
 	return config, namespace, err
 }
 
-func (s *Server) getAppResources(q *application.ResourcesQuery) (*appv1.ApplicationTree, error) {
-	return s.cache.GetAppResourcesTree(*q.ApplicationName)
+// getCachedResponse returns the response of the given endpoint for the application from the response cache, loading
+// and caching it if the application changed since the response was cached
+func (s *Server) getCachedResponse(endpoint string, a *appv1.Application, load func() (interface{}, error)) (interface{}, error) {
+	key := fmt.Sprintf("%s|%s|%s", endpoint, a.Name, a.ResourceVersion)
+	if res, ok := s.responseCache.Get(key); ok {
+		return res, nil
+	}
+	res, err := load()
+	if err != nil {
+		return nil, err
+	}
+	s.responseCache.SetDefault(key, res)
+	return res, nil
+}
+
+func (s *Server) getAppResources(a *appv1.Application) (*appv1.ApplicationTree, error) {
+	res, err := s.getCachedResponse("resource-tree", a, func() (interface{}, error) {
+		return s.cache.GetAppResourcesTree(a.Name)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*appv1.ApplicationTree), nil
 }
 
 func (s *Server) getAppResource(ctx context.Context, action string, q *application.ApplicationResourceRequest) (*appv1.ResourceNode, *rest.Config, *appv1.Application, error) {
@@ -628,7 +1067,7 @@ func (s *Server) getAppResource(ctx context.Context, action string, q *applicati
 		return nil, nil, nil, err
 	}
 
-	tree, err := s.getAppResources(&application.ResourcesQuery{ApplicationName: &a.Name})
+	tree, err := s.getAppResources(a)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -749,10 +1188,89 @@ func (s *Server) ResourceTree(ctx context.Context, q *application.ResourcesQuery
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, appRBACName(*a)); err != nil {
 		return nil, err
 	}
-	return s.getAppResources(q)
+	tree, err := s.getAppResources(a)
+	if err != nil {
+		return nil, err
+	}
+	return filterResourceTree(tree, q), nil
+}
+
+// filterResourceTree returns the nodes of the tree which match the kinds, health statuses, namespaces and orphaned
+// toggle of the query. The tree is shared with the response cache and therefore not modified.
+func filterResourceTree(tree *appv1.ApplicationTree, q *application.ResourcesQuery) *appv1.ApplicationTree {
+	if len(q.Kinds) == 0 && len(q.HealthStatuses) == 0 && len(q.Namespaces) == 0 && q.Orphaned == nil {
+		return tree
+	}
+	kinds := groupKindFilter{}
+	for _, kind := range q.Kinds {
+		kinds[schema.ParseGroupKind(kind)] = true
+	}
+	healthStatuses := make(map[appv1.HealthStatusCode]bool)
+	for _, healthStatus := range q.HealthStatuses {
+		healthStatuses[healthStatus] = true
+	}
+	namespaces := make(map[string]bool)
+	for _, namespace := range q.Namespaces {
+		namespaces[namespace] = true
+	}
+	filterNodes := func(nodes []appv1.ResourceNode) []appv1.ResourceNode {
+		var filtered []appv1.ResourceNode
+		for _, node := range nodes {
+			if len(kinds) > 0 && !kinds[schema.GroupKind{Group: node.Group, Kind: node.Kind}] {
+				continue
+			}
+			if len(healthStatuses) > 0 && (node.Health == nil || !healthStatuses[node.Health.Status]) {
+				continue
+			}
+			if len(namespaces) > 0 && !namespaces[node.Namespace] {
+				continue
+			}
+			filtered = append(filtered, node)
+		}
+		return filtered
+	}
+	filtered := &appv1.ApplicationTree{}
+	if q.Orphaned == nil || !*q.Orphaned {
+		filtered.Nodes = filterNodes(tree.Nodes)
+	}
+	if q.Orphaned == nil || *q.Orphaned {
+		filtered.OrphanedNodes = filterNodes(tree.OrphanedNodes)
+	}
+	return filtered
 }
 
 func (s *Server) RevisionMetadata(ctx context.Context, q *application.RevisionMetadataQuery) (*v1alpha1.RevisionMetadata, error) {
+	return s.getRevisionMetadata(ctx, q, false)
+}
+
+// RevisionDetails returns the untruncated metadata and the changed files of a revision
+func (s *Server) RevisionDetails(ctx context.Context, q *application.RevisionMetadataQuery) (*v1alpha1.RevisionMetadata, error) {
+	return s.getRevisionMetadata(ctx, q, true)
+}
+
+func (s *Server) getRevisionMetadata(ctx context.Context, q *application.RevisionMetadataQuery, full bool) (*v1alpha1.RevisionMetadata, error) {
+	a, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(q.GetName(), metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, appRBACName(*a)); err != nil {
+		return nil, err
+	}
+	repo, err := s.db.GetRepository(ctx, a.Spec.Source.RepoURL)
+	if err != nil {
+		return nil, err
+	}
+	conn, repoClient, err := s.repoClientset.NewRepoServerClient()
+	if err != nil {
+		return nil, err
+	}
+	defer util.Close(conn)
+	return repoClient.GetRevisionMetadata(ctx, &apiclient.RepoServerRevisionMetadataRequest{Repo: repo, App: a.Spec.Source.Path, Revision: q.GetRevision(), Full: full})
+}
+
+// RevisionChangelog returns the commits between the revision which was deployed by the last sync and the target
+// revision of the application
+func (s *Server) RevisionChangelog(ctx context.Context, q *application.ApplicationRevisionChangelogQuery) (*v1alpha1.RevisionChangelog, error) {
 	a, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(q.GetName(), metav1.GetOptions{})
 	if err != nil {
 		return nil, err
@@ -760,6 +1278,13 @@ func (s *Server) RevisionMetadata(ctx context.Context, q *application.RevisionMe
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, appRBACName(*a)); err != nil {
 		return nil, err
 	}
+	if len(a.Status.History) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "application '%s' was never deployed", a.Name)
+	}
+	targetRevision := q.TargetRevision
+	if targetRevision == "" {
+		targetRevision = a.Spec.Source.TargetRevision
+	}
 	repo, err := s.db.GetRepository(ctx, a.Spec.Source.RepoURL)
 	if err != nil {
 		return nil, err
@@ -769,7 +1294,12 @@ func (s *Server) RevisionMetadata(ctx context.Context, q *application.RevisionMe
 		return nil, err
 	}
 	defer util.Close(conn)
-	return repoClient.GetRevisionMetadata(ctx, &apiclient.RepoServerRevisionMetadataRequest{Repo: repo, App: a.Spec.Source.Path, Revision: q.GetRevision()})
+	return repoClient.GetRevisionChangelog(ctx, &apiclient.RepoServerRevisionChangelogRequest{
+		Repo:           repo,
+		App:            a.Spec.Source.Path,
+		Revision:       a.Status.History[len(a.Status.History)-1].Revision,
+		TargetRevision: targetRevision,
+	})
 }
 
 func (s *Server) ManagedResources(ctx context.Context, q *application.ResourcesQuery) (*application.ManagedResourcesResponse, error) {
@@ -780,13 +1310,264 @@ func (s *Server) ManagedResources(ctx context.Context, q *application.ResourcesQ
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, appRBACName(*a)); err != nil {
 		return nil, err
 	}
-	items, err := s.cache.GetAppManagedResources(a.Name)
+	res, err := s.getCachedResponse("managed-resources", a, func() (interface{}, error) {
+		items, err := s.cache.GetAppManagedResources(a.Name)
+		if err != nil {
+			return nil, err
+		}
+		return &application.ManagedResourcesResponse{Items: items}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	managedResources := res.(*application.ManagedResourcesResponse)
+	if len(q.RelistKinds) == 0 {
+		return managedResources, nil
+	}
+	items, err := s.relistLiveStates(a, managedResources.Items, q.RelistKinds)
 	if err != nil {
 		return nil, err
 	}
 	return &application.ManagedResourcesResponse{Items: items}, nil
 }
 
+// SyncReports returns the reports of the last sync operations of an application
+func (s *Server) SyncReports(ctx context.Context, q *application.ApplicationSyncReportsQuery) (*application.ApplicationSyncReportsResponse, error) {
+	a, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(*q.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, appRBACName(*a)); err != nil {
+		return nil, err
+	}
+	reports, err := s.cache.GetAppSyncReports(a.Name)
+	if err != nil && err != cache.ErrCacheMiss {
+		return nil, err
+	}
+	return &application.ApplicationSyncReportsResponse{Items: reports}, nil
+}
+
+const (
+	manifestSourceGit  = "git"
+	manifestSourceLive = "live"
+)
+
+// ManagedManifests returns the target or live manifests of the resources managed by an application as a YAML stream
+func (s *Server) ManagedManifests(ctx context.Context, q *application.ApplicationManagedManifestsQuery) (*application.ApplicationManagedManifestsResponse, error) {
+	source := q.Source
+	if source == "" {
+		source = manifestSourceGit
+	}
+	if source != manifestSourceGit && source != manifestSourceLive {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown manifest source '%s', must be one of: %s|%s", source, manifestSourceLive, manifestSourceGit)
+	}
+	a, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(*q.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, appRBACName(*a)); err != nil {
+		return nil, err
+	}
+	items, err := s.cache.GetAppManagedResources(a.Name)
+	if err != nil {
+		return nil, err
+	}
+	manifests, err := managedManifests(items, source)
+	if err != nil {
+		return nil, err
+	}
+	return &application.ApplicationManagedManifestsResponse{Manifests: manifests}, nil
+}
+
+// managedManifests joins the target or live states of the given resources into a YAML stream. Fields which are set by
+// the cluster are removed from live states so that the manifests can be re-applied.
+func managedManifests(items []*appv1.ResourceDiff, source string) (string, error) {
+	var docs []string
+	for _, item := range items {
+		getObject := item.TargetObject
+		if source == manifestSourceLive {
+			getObject = item.LiveObject
+		}
+		obj, err := getObject()
+		if err != nil {
+			return "", err
+		}
+		if obj == nil {
+			continue
+		}
+		if source == manifestSourceLive {
+			sanitizeLiveObject(obj)
+		}
+		data, err := yaml.Marshal(obj)
+		if err != nil {
+			return "", err
+		}
+		docs = append(docs, "---\n"+string(data))
+	}
+	return strings.Join(docs, ""), nil
+}
+
+// sanitizeLiveObject removes the status and the metadata which is set by the cluster from a live object
+func sanitizeLiveObject(obj *unstructured.Unstructured) {
+	unstructured.RemoveNestedField(obj.Object, "status")
+	for _, field := range []string{"uid", "resourceVersion", "selfLink", "creationTimestamp", "generation", "managedFields"} {
+		unstructured.RemoveNestedField(obj.Object, "metadata", field)
+	}
+	annotations := obj.GetAnnotations()
+	if _, ok := annotations[v1.LastAppliedConfigAnnotation]; ok {
+		delete(annotations, v1.LastAppliedConfigAnnotation)
+		if len(annotations) == 0 {
+			annotations = nil
+		}
+		obj.SetAnnotations(annotations)
+	}
+}
+
+// groupKindFilter excludes all resources except the ones of the given kinds
+type groupKindFilter map[schema.GroupKind]bool
+
+func (f groupKindFilter) IsExcludedResource(group, kind, _ string) bool {
+	return !f[schema.GroupKind{Group: group, Kind: kind}]
+}
+
+// relistLiveStates returns a copy of the managed resources in which the live states of the resources of the given kinds
+// are listed from the destination cluster. The cluster cache of the application controller is left untouched, so
+// suspected stale resources are compared without invalidating the cache of the whole cluster.
+func (s *Server) relistLiveStates(a *appv1.Application, items []*appv1.ResourceDiff, kinds []string) ([]*appv1.ResourceDiff, error) {
+	filter := groupKindFilter{}
+	for _, kind := range kinds {
+		gk := schema.ParseGroupKind(kind)
+		if gk.Group == "" && gk.Kind == kube.SecretKind {
+			return nil, status.Errorf(codes.InvalidArgument, "live state of secrets cannot be relisted since their data is hidden")
+		}
+		filter[gk] = true
+	}
+	cluster, err := s.db.GetCluster(context.Background(), a.Spec.Destination.Server)
+	if err != nil {
+		return nil, err
+	}
+	config := cluster.RESTConfig()
+	apiResources, err := s.kubectl.GetAPIResources(config, filter)
+	if err != nil {
+		return nil, err
+	}
+	appInstanceLabelKey, err := s.settingsMgr.GetAppInstanceLabelKey()
+	if err != nil {
+		return nil, err
+	}
+	listed := make(map[schema.GroupKind]bool)
+	liveObjs := make(map[kube.ResourceKey]*unstructured.Unstructured)
+	for _, apiResource := range apiResources {
+		if !filter[apiResource.GroupKind] {
+			continue
+		}
+		list, err := apiResource.Interface.List(metav1.ListOptions{LabelSelector: fmt.Sprintf("%s=%s", appInstanceLabelKey, a.Name)})
+		if err != nil {
+			return nil, err
+		}
+		listed[apiResource.GroupKind] = true
+		for i := range list.Items {
+			obj := &list.Items[i]
+			// resources created by controllers are not managed by the application
+			if len(obj.GetOwnerReferences()) > 0 {
+				continue
+			}
+			liveObjs[kube.GetResourceKey(obj)] = obj
+		}
+	}
+	for gk := range filter {
+		if !listed[gk] {
+			return nil, status.Errorf(codes.InvalidArgument, "kind '%s' is not served by cluster %s", gk.String(), a.Spec.Destination.Server)
+		}
+	}
+
+	overrides, err := s.settingsMgr.GetResourceOverrides()
+	if err != nil {
+		return nil, err
+	}
+	normalizer, err := argo.NewDiffNormalizer(a.Spec.IgnoreDifferences, overrides)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*appv1.ResourceDiff, 0, len(items))
+	for _, item := range items {
+		if !filter[schema.GroupKind{Group: item.Group, Kind: item.Kind}] {
+			res = append(res, item)
+			continue
+		}
+		var target *unstructured.Unstructured
+		if err := json.Unmarshal([]byte(item.TargetState), &target); err != nil {
+			return nil, err
+		}
+		key := kube.NewResourceKey(item.Group, item.Kind, item.Namespace, item.Name)
+		live, ok := liveObjs[key]
+		delete(liveObjs, key)
+		if !ok && target != nil {
+			// resources of the application are managed even if their app instance label was removed
+			live, err = s.kubectl.GetResource(config, target.GroupVersionKind(), item.Name, item.Namespace)
+			if apierr.IsNotFound(err) {
+				live = nil
+			} else if err != nil {
+				return nil, err
+			}
+		}
+		relisted, err := newResourceDiff(key, target, live, item.Hook, normalizer)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, relisted)
+	}
+	// resources which were created since the cluster cache was last updated
+	keys := make([]kube.ResourceKey, 0, len(liveObjs))
+	for key := range liveObjs {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].String() < keys[j].String()
+	})
+	for _, key := range keys {
+		relisted, err := newResourceDiff(key, nil, liveObjs[key], false, normalizer)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, relisted)
+	}
+	return res, nil
+}
+
+// newResourceDiff returns the diff of the given target and live state of a resource, as stored by the controller
+func newResourceDiff(key kube.ResourceKey, target, live *unstructured.Unstructured, isHook bool, normalizer diff.Normalizer) (*appv1.ResourceDiff, error) {
+	item := &appv1.ResourceDiff{
+		Group:       key.Group,
+		Kind:        key.Kind,
+		Namespace:   key.Namespace,
+		Name:        key.Name,
+		Hook:        isHook,
+		TargetState: "null",
+		LiveState:   "null",
+	}
+	if target != nil {
+		data, err := json.Marshal(target)
+		if err != nil {
+			return nil, err
+		}
+		item.TargetState = string(data)
+	}
+	if live != nil {
+		data, err := json.Marshal(live)
+		if err != nil {
+			return nil, err
+		}
+		item.LiveState = string(data)
+	}
+	jsonDiff, err := diff.Diff(target, live, normalizer).JSONFormat()
+	if err != nil {
+		return nil, err
+	}
+	item.Diff = jsonDiff
+	return item, nil
+}
+
 func (s *Server) PodLogs(q *application.ApplicationPodLogsQuery, ws application.ApplicationService_PodLogsServer) error {
 	pod, config, _, err := s.getAppResource(ws.Context(), rbacpolicy.ActionGet, &application.ApplicationResourceRequest{
 		Name:         q.Name,
@@ -887,18 +1668,25 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionSync, appRBACName(*a)); err != nil {
 		return nil, err
 	}
+	if err := s.tokenUsage.RecordSync(ctx); err != nil {
+		return nil, err
+	}
+	syncPolicy, err := s.getSyncPolicy(a)
+	if err != nil {
+		return nil, err
+	}
 	if syncReq.Manifests != nil {
 		if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionOverride, appRBACName(*a)); err != nil {
 			return nil, err
 		}
-		if a.Spec.SyncPolicy != nil {
+		if syncPolicy != nil && syncPolicy.Automated != nil {
 			return nil, status.Error(codes.FailedPrecondition, "Cannot use local sync when Automatic Sync Policy is enabled")
 		}
 	}
 	if a.DeletionTimestamp != nil {
 		return nil, status.Errorf(codes.FailedPrecondition, "application is deleting")
 	}
-	if a.Spec.SyncPolicy != nil && a.Spec.SyncPolicy.Automated != nil {
+	if syncPolicy != nil && syncPolicy.Automated != nil {
 		if syncReq.Revision != "" && syncReq.Revision != util.FirstNonEmpty(a.Spec.Source.TargetRevision, "HEAD") {
 			return nil, status.Errorf(codes.FailedPrecondition, "Cannot sync to %s: auto-sync currently set to %s", syncReq.Revision, a.Spec.Source.TargetRevision)
 		}
@@ -908,6 +1696,9 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 	if err != nil {
 		return nil, status.Errorf(codes.FailedPrecondition, err.Error())
 	}
+	// syncs to a revision other than the target revision of the application deploy a pinned revision, e.g. a hotfix,
+	// which is recorded in the history while the spec is left unchanged
+	pinned := syncReq.Revision != "" && syncReq.Revision != util.FirstNonEmpty(a.Spec.Source.TargetRevision, "HEAD") && syncReq.Manifests == nil
 
 	op := appv1.Operation{
 		Sync: &appv1.SyncOperation{
@@ -917,7 +1708,11 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 			SyncStrategy: syncReq.Strategy,
 			Resources:    syncReq.Resources,
 			Manifests:    syncReq.Manifests,
+			Adopt:        syncReq.Adopt,
+			Pinned:       pinned,
+			PruneArgoCD:  syncReq.PruneArgoCD,
 		},
+		InitiatedBy: getOperationInitiator(ctx),
 	}
 	a, err = argo.SetAppOperation(appIf, *syncReq.Name, &op)
 	if err == nil {
@@ -925,6 +1720,9 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 		if len(syncReq.Resources) > 0 {
 			partial = "partial "
 		}
+		if pinned {
+			partial += "pinned "
+		}
 		s.logEvent(a, ctx, argo.EventReasonOperationStarted, fmt.Sprintf("initiated %ssync to %s", partial, displayRevision))
 	}
 	return a, err
@@ -939,10 +1737,17 @@ func (s *Server) Rollback(ctx context.Context, rollbackReq *application.Applicat
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionSync, appRBACName(*a)); err != nil {
 		return nil, err
 	}
+	if err := s.tokenUsage.RecordSync(ctx); err != nil {
+		return nil, err
+	}
 	if a.DeletionTimestamp != nil {
 		return nil, status.Errorf(codes.FailedPrecondition, "application is deleting")
 	}
-	if a.Spec.SyncPolicy != nil && a.Spec.SyncPolicy.Automated != nil {
+	syncPolicy, err := s.getSyncPolicy(a)
+	if err != nil {
+		return nil, err
+	}
+	if syncPolicy != nil && syncPolicy.Automated != nil {
 		return nil, status.Errorf(codes.FailedPrecondition, "rollback cannot be initiated when auto-sync is enabled")
 	}
 
@@ -971,6 +1776,7 @@ func (s *Server) Rollback(ctx context.Context, rollbackReq *application.Applicat
 			SyncStrategy: &appv1.SyncStrategy{Apply: &appv1.SyncStrategyApply{}},
 			Source:       &deploymentInfo.Source,
 		},
+		InitiatedBy: getOperationInitiator(ctx),
 	}
 	a, err = argo.SetAppOperation(appIf, *rollbackReq.Name, &op)
 	if err == nil {
@@ -979,6 +1785,114 @@ func (s *Server) Rollback(ctx context.Context, rollbackReq *application.Applicat
 	return a, err
 }
 
+// UpdateImages is used by image updaters to override the Helm parameters or Kustomize images of an application. The
+// overrides are deployed using a sync operation and optionally written back to the application spec. It is gated by
+// a dedicated RBAC action so that image updaters do not need permissions to update the whole application.
+func (s *Server) UpdateImages(ctx context.Context, req *application.ApplicationUpdateImagesRequest) (*appv1.Application, error) {
+	appIf := s.appclientset.ArgoprojV1alpha1().Applications(s.ns)
+	a, err := appIf.Get(*req.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionUpdateImages, appRBACName(*a)); err != nil {
+		return nil, err
+	}
+	if err := s.tokenUsage.RecordSync(ctx); err != nil {
+		return nil, err
+	}
+	if a.DeletionTimestamp != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "application is deleting")
+	}
+	if len(req.HelmParameters) == 0 && len(req.KustomizeImages) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "at least one Helm parameter or Kustomize image must be specified")
+	}
+	sourceType := a.Status.SourceType
+	if explicitType, err := a.Spec.Source.ExplicitType(); err == nil && explicitType != nil {
+		sourceType = *explicitType
+	}
+	if len(req.HelmParameters) > 0 && sourceType != appv1.ApplicationSourceTypeHelm {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot set Helm parameters on application of type %s", sourceType)
+	}
+	if len(req.KustomizeImages) > 0 && sourceType != appv1.ApplicationSourceTypeKustomize {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot set Kustomize images on application of type %s", sourceType)
+	}
+	syncPolicy, err := s.getSyncPolicy(a)
+	if err != nil {
+		return nil, err
+	}
+	if !req.WriteBack && syncPolicy != nil && syncPolicy.Automated != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "images cannot be updated without write back when auto-sync is enabled")
+	}
+
+	imageUpdate := &appv1.ImageUpdate{HelmParameters: req.HelmParameters, WriteBack: req.WriteBack}
+	for _, image := range req.KustomizeImages {
+		imageUpdate.KustomizeImages = append(imageUpdate.KustomizeImages, appv1.KustomizeImage(image))
+	}
+	source := a.Spec.Source.DeepCopy()
+	imageUpdate.Apply(source)
+
+	commitSHA, _, err := s.resolveRevision(ctx, a, &application.ApplicationSyncRequest{})
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	op := appv1.Operation{
+		Sync: &appv1.SyncOperation{
+			Revision:    commitSHA,
+			DryRun:      req.DryRun,
+			ImageUpdate: imageUpdate,
+		},
+		InitiatedBy: getOperationInitiator(ctx),
+	}
+	if req.WriteBack && !req.DryRun && a.Spec.WriteBack != nil {
+		// the overrides are written back to git rather than to the application spec
+		res, err := s.writeBack(ctx, a, writeback.Overrides{
+			Helm:      &writeback.HelmOverrides{Parameters: imageUpdate.HelmParameters},
+			Kustomize: &writeback.KustomizeOverrides{Images: imageUpdate.KustomizeImages},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if a.Spec.WriteBack.PullRequest {
+			// the overrides are deployed once the pull request is merged
+			return a, nil
+		}
+		op.Sync.Revision = res.Revision
+	} else if req.WriteBack && !req.DryRun {
+		a.Spec.Source = *source
+		a, err = appIf.Update(a)
+		if err != nil {
+			return nil, err
+		}
+		s.logEvent(a, ctx, argo.EventReasonResourceUpdated, "updated application images")
+	} else {
+		op.Sync.Source = source
+	}
+	a, err = argo.SetAppOperation(appIf, *req.Name, &op)
+	if err == nil {
+		s.logEvent(a, ctx, argo.EventReasonOperationStarted, fmt.Sprintf("initiated image update sync to %s", commitSHA))
+	}
+	return a, err
+}
+
+// writeBack commits the parameter overrides to the git repository of the application
+func (s *Server) writeBack(ctx context.Context, a *appv1.Application, overrides writeback.Overrides) (*writeback.Result, error) {
+	repo, err := s.db.GetRepository(ctx, a.Spec.Source.RepoURL)
+	if err != nil {
+		return nil, err
+	}
+	message := fmt.Sprintf("Update parameters of application %s", a.Name)
+	if user := session.Username(ctx); user != "" {
+		message = fmt.Sprintf("%s\n\nRequested by %s", message, user)
+	}
+	res, err := writeback.Commit(repo, a, overrides, message)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to write parameter overrides back to git: %v", err)
+	}
+	s.logEvent(a, ctx, argo.EventReasonResourceUpdated, res.String())
+	return res, nil
+}
+
 // resolveRevision resolves the revision specified either in the sync request, or the
 // application source, into a concrete revision that will be used for a sync operation.
 func (s *Server) resolveRevision(ctx context.Context, app *appv1.Application, syncReq *application.ApplicationSyncRequest) (string, string, error) {
@@ -1019,8 +1933,21 @@ func (s *Server) TerminateOperation(ctx context.Context, termOpReq *application.
 		if a.Operation == nil || a.Status.OperationState == nil {
 			return nil, status.Errorf(codes.InvalidArgument, "Unable to terminate operation. No operation is in progress")
 		}
-		a.Status.OperationState.Phase = appv1.OperationTerminating
-		_, err = s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Update(a)
+		// the resource version makes the patch fail with a conflict if the operation completed in the meantime
+		patch, err := json.Marshal(map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"resourceVersion": a.ResourceVersion,
+			},
+			"status": map[string]interface{}{
+				"operationState": map[string]interface{}{
+					"phase": appv1.OperationTerminating,
+				},
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		_, err = s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Patch(a.Name, types.MergePatchType, patch, "status")
 		if err == nil {
 			return &application.OperationTerminateResponse{}, nil
 		}
@@ -1038,6 +1965,151 @@ func (s *Server) TerminateOperation(ctx context.Context, termOpReq *application.
 	return nil, status.Errorf(codes.Internal, "Failed to terminate app. Too many conflicts")
 }
 
+// bulkOperationParallelism is the number of applications a bulk operation processes at the same time
+const bulkOperationParallelism = 10
+
+// bulkApplicationNames returns the names of the applications of a bulk operation. Applications matched by the selector
+// which the caller is not permitted to see are omitted, while named applications are reported as failures of the
+// operation itself.
+func (s *Server) bulkApplicationNames(ctx context.Context, names []string, selector string) ([]string, error) {
+	if len(names) > 0 {
+		return names, nil
+	}
+	if selector == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "either names or a selector of applications are required")
+	}
+	if _, err := labels.Parse(selector); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid selector '%s': %v", selector, err)
+	}
+	appList, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).List(metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, err
+	}
+	names = make([]string, 0)
+	for _, a := range appList.Items {
+		if s.enf.Enforce(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, appRBACName(a)) {
+			names = append(names, a.Name)
+		}
+	}
+	return names, nil
+}
+
+// runBulkOperation applies the operation to the applications, at most bulkOperationParallelism at a time, and returns
+// the result of every application ordered by name
+func runBulkOperation(names []string, operation func(name string) error) *application.ApplicationBulkResponse {
+	results := make([]application.ApplicationBulkResult, len(names))
+	sem := make(chan struct{}, bulkOperationParallelism)
+	var wg sync.WaitGroup
+	for i := range names {
+		name := names[i]
+		results[i].Name = &name
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(result *application.ApplicationBulkResult) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			if err := operation(*result.Name); err != nil {
+				result.Error = status.Convert(err).Message()
+			}
+		}(&results[i])
+	}
+	wg.Wait()
+	sort.Slice(results, func(i, j int) bool {
+		return *results[i].Name < *results[j].Name
+	})
+	return &application.ApplicationBulkResponse{Results: results}
+}
+
+// BulkSync syncs the applications of a list or selector
+func (s *Server) BulkSync(ctx context.Context, q *application.ApplicationBulkSyncRequest) (*application.ApplicationBulkResponse, error) {
+	names, err := s.bulkApplicationNames(ctx, q.Names, q.Selector)
+	if err != nil {
+		return nil, err
+	}
+	return runBulkOperation(names, func(name string) error {
+		_, err := s.Sync(ctx, &application.ApplicationSyncRequest{Name: &name, DryRun: q.DryRun, Prune: q.Prune, Strategy: q.Strategy})
+		return err
+	}), nil
+}
+
+// BulkRefresh requests the refresh of the applications of a list or selector. Unlike Get, it does not wait for the
+// refreshes to complete.
+func (s *Server) BulkRefresh(ctx context.Context, q *application.ApplicationBulkRequest) (*application.ApplicationBulkResponse, error) {
+	refreshType := appv1.RefreshTypeNormal
+	if q.Refresh != "" {
+		refreshType = appv1.RefreshType(q.Refresh)
+	}
+	if refreshType != appv1.RefreshTypeNormal && refreshType != appv1.RefreshTypeHard {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid refresh type '%s': must be '%s' or '%s'", q.Refresh, appv1.RefreshTypeNormal, appv1.RefreshTypeHard)
+	}
+	names, err := s.bulkApplicationNames(ctx, q.Names, q.Selector)
+	if err != nil {
+		return nil, err
+	}
+	appIf := s.appclientset.ArgoprojV1alpha1().Applications(s.ns)
+	return runBulkOperation(names, func(name string) error {
+		a, err := appIf.Get(name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, appRBACName(*a)); err != nil {
+			return err
+		}
+		_, err = argoutil.RefreshApp(appIf, name, refreshType)
+		return err
+	}), nil
+}
+
+// BulkTerminateOperation terminates the running operations of the applications of a list or selector
+func (s *Server) BulkTerminateOperation(ctx context.Context, q *application.ApplicationBulkRequest) (*application.ApplicationBulkResponse, error) {
+	names, err := s.bulkApplicationNames(ctx, q.Names, q.Selector)
+	if err != nil {
+		return nil, err
+	}
+	return runBulkOperation(names, func(name string) error {
+		_, err := s.TerminateOperation(ctx, &application.OperationTerminateRequest{Name: &name})
+		return err
+	}), nil
+}
+
+// BulkDelete deletes the applications of a list or selector
+func (s *Server) BulkDelete(ctx context.Context, q *application.ApplicationBulkRequest) (*application.ApplicationBulkResponse, error) {
+	names, err := s.bulkApplicationNames(ctx, q.Names, q.Selector)
+	if err != nil {
+		return nil, err
+	}
+	return runBulkOperation(names, func(name string) error {
+		_, err := s.Delete(ctx, &application.ApplicationDeleteRequest{Name: &name, Cascade: q.Cascade})
+		return err
+	}), nil
+}
+
+// getOperationInitiator returns the user who requested an operation, and the client the request was made from
+func getOperationInitiator(ctx context.Context) appv1.OperationInitiator {
+	initiator := appv1.OperationInitiator{Username: session.Username(ctx), Source: appv1.OperationSourceAPI}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return initiator
+	}
+	if len(md["grpcgateway-user-agent"]) > 0 {
+		// requests proxied by the REST gateway are made from the UI if they are authenticated by the session cookie
+		for _, cookie := range md["grpcgateway-cookie"] {
+			if strings.Contains(cookie, common.AuthCookieName+"=") {
+				initiator.Source = appv1.OperationSourceUI
+			}
+		}
+		return initiator
+	}
+	for _, userAgent := range md["user-agent"] {
+		if strings.HasPrefix(userAgent, common.ArgoCDUserAgentName+"/") {
+			initiator.Source = appv1.OperationSourceCLI
+		}
+	}
+	return initiator
+}
+
 func (s *Server) logEvent(a *appv1.Application, ctx context.Context, reason string, action string) {
 	eventInfo := argo.EventInfo{Type: v1.EventTypeNormal, Reason: reason}
 	user := session.Username(ctx)
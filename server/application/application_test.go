@@ -2,6 +2,9 @@ package application
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -9,13 +12,19 @@ import (
 	"github.com/ghodss/yaml"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
 	"k8s.io/client-go/kubernetes/fake"
 	kubetesting "k8s.io/client-go/testing"
+	k8scache "k8s.io/client-go/tools/cache"
 
 	"github.com/argoproj/argo-cd/common"
 	"github.com/argoproj/argo-cd/errors"
@@ -30,7 +39,9 @@ import (
 	"github.com/argoproj/argo-cd/test"
 	"github.com/argoproj/argo-cd/util"
 	"github.com/argoproj/argo-cd/util/assets"
+	"github.com/argoproj/argo-cd/util/cache"
 	"github.com/argoproj/argo-cd/util/db"
+	"github.com/argoproj/argo-cd/util/kube"
 	"github.com/argoproj/argo-cd/util/kube/kubetest"
 	"github.com/argoproj/argo-cd/util/rbac"
 	"github.com/argoproj/argo-cd/util/settings"
@@ -100,6 +111,17 @@ func newTestAppServer(objects ...runtime.Object) *Server {
 	mockRepoServiceClient.On("ListApps", mock.Anything, mock.Anything).Return(fakeAppList(), nil)
 	mockRepoServiceClient.On("GenerateManifest", mock.Anything, mock.Anything).Return(&apiclient.ManifestResponse{}, nil)
 	mockRepoServiceClient.On("GetAppDetails", mock.Anything, mock.Anything).Return(&apiclient.RepoAppDetailsResponse{}, nil)
+	mockRepoServiceClient.On("GetRevisionMetadata", mock.Anything, mock.Anything).Return(
+		func(_ context.Context, q *apiclient.RepoServerRevisionMetadataRequest, _ ...grpc.CallOption) *appsv1.RevisionMetadata {
+			if q.Full {
+				return &appsv1.RevisionMetadata{Message: "full message", ChangedFiles: []string{"README.md"}}
+			}
+			return &appsv1.RevisionMetadata{Message: "truncated"}
+		}, nil)
+	mockRepoServiceClient.On("GetRevisionChangelog", mock.Anything, mock.Anything).Return(
+		func(_ context.Context, q *apiclient.RepoServerRevisionChangelogRequest, _ ...grpc.CallOption) *appsv1.RevisionChangelog {
+			return &appsv1.RevisionChangelog{Revision: q.Revision, TargetRevision: q.TargetRevision}
+		}, nil)
 
 	mockRepoClient := &mockrepo.Clientset{}
 	mockRepoClient.On("NewRepoServerClient").Return(&fakeCloser{}, &mockRepoServiceClient, nil)
@@ -123,6 +145,12 @@ func newTestAppServer(objects ...runtime.Object) *Server {
 	fakeAppsClientset := apps.NewSimpleClientset(objects...)
 	factory := appinformer.NewFilteredSharedInformerFactory(fakeAppsClientset, 0, "", func(options *metav1.ListOptions) {})
 	fakeProjLister := factory.Argoproj().V1alpha1().AppProjects().Lister().AppProjects(testNamespace)
+	appInformer := factory.Argoproj().V1alpha1().Applications().Informer()
+	errors.CheckError(appInformer.AddIndexers(ListIndexers))
+	go appInformer.Run(context.Background().Done())
+	if !k8scache.WaitForCacheSync(context.Background().Done(), appInformer.HasSynced) {
+		panic("Timed out waiting for application cache to sync")
+	}
 
 	enforcer := rbac.NewEnforcer(kubeclientset, testNamespace, common.ArgoCDRBACConfigMapName, nil)
 	_ = enforcer.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
@@ -142,6 +170,8 @@ func newTestAppServer(objects ...runtime.Object) *Server {
 		enforcer,
 		util.NewKeyLock(),
 		settingsMgr,
+		nil,
+		appInformer,
 	)
 	return server.(*Server)
 }
@@ -187,6 +217,14 @@ func TestCreateApp(t *testing.T) {
 	assert.Equal(t, app.Spec.Project, "default")
 }
 
+func TestGetApp_InvalidRefreshType(t *testing.T) {
+	testApp := newTestApp()
+	appServer := newTestAppServer(testApp)
+	refresh := "true"
+	_, err := appServer.Get(context.Background(), &application.ApplicationQuery{Name: &testApp.Name, Refresh: &refresh})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
 func TestUpdateApp(t *testing.T) {
 	testApp := newTestApp()
 	appServer := newTestAppServer(testApp)
@@ -298,6 +336,41 @@ func TestSyncAndTerminate(t *testing.T) {
 	assert.Equal(t, appsv1.OperationTerminating, app.Status.OperationState.Phase)
 }
 
+func TestBulkRefresh(t *testing.T) {
+	ctx := context.Background()
+	guestbook := newTestApp()
+	guestbook.Labels = map[string]string{"team": "a"}
+	other := newTestApp()
+	other.Name = "other"
+	other.Labels = map[string]string{"team": "b"}
+	appServer := newTestAppServer(guestbook, other)
+
+	_, err := appServer.BulkRefresh(ctx, &application.ApplicationBulkRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = appServer.BulkRefresh(ctx, &application.ApplicationBulkRequest{Selector: "team=a", Refresh: "true"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	resp, err := appServer.BulkRefresh(ctx, &application.ApplicationBulkRequest{Selector: "team=a", Refresh: string(appsv1.RefreshTypeHard)})
+	assert.NoError(t, err)
+	assert.Equal(t, []application.ApplicationBulkResult{{Name: &guestbook.Name}}, resp.Results)
+	app, err := appServer.appclientset.ArgoprojV1alpha1().Applications(appServer.ns).Get(guestbook.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, string(appsv1.RefreshTypeHard), app.Annotations[common.AnnotationKeyRefresh])
+	app, err = appServer.appclientset.ArgoprojV1alpha1().Applications(appServer.ns).Get(other.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Empty(t, app.Annotations[common.AnnotationKeyRefresh])
+
+	// the applications of a list are processed independently of each other
+	resp, err = appServer.BulkRefresh(ctx, &application.ApplicationBulkRequest{Names: []string{other.Name, "missing"}})
+	assert.NoError(t, err)
+	if assert.Len(t, resp.Results, 2) {
+		assert.Equal(t, "missing", *resp.Results[0].Name)
+		assert.NotEmpty(t, resp.Results[0].Error)
+		assert.Equal(t, application.ApplicationBulkResult{Name: &other.Name}, resp.Results[1])
+	}
+}
+
 func TestRollbackApp(t *testing.T) {
 	testApp := newTestApp()
 	testApp.Status.History = []appsv1.RevisionHistory{{
@@ -320,6 +393,49 @@ func TestRollbackApp(t *testing.T) {
 	assert.Equal(t, "abc", updatedApp.Operation.Sync.Revision)
 }
 
+func TestUpdateImages(t *testing.T) {
+	testApp := newTestApp()
+	testApp.Spec.Source.Ksonnet = nil
+	testApp.Spec.Source.TargetRevision = "a5e4b36f3b8a5b6e2a7a48b55ac8c5f3a1f1b26a"
+	testApp.Spec.Source.Helm = &appsv1.ApplicationSourceHelm{
+		Parameters: []appsv1.HelmParameter{{Name: "image.tag", Value: "v1"}},
+	}
+	appServer := newTestAppServer(testApp)
+	ctx := context.Background()
+
+	updatedApp, err := appServer.UpdateImages(ctx, &application.ApplicationUpdateImagesRequest{
+		Name:           &testApp.Name,
+		HelmParameters: []appsv1.HelmParameter{{Name: "image.tag", Value: "v2"}},
+	})
+	assert.NoError(t, err)
+	assert.NotNil(t, updatedApp.Operation.Sync.ImageUpdate)
+	assert.Equal(t, testApp.Spec.Source.TargetRevision, updatedApp.Operation.Sync.Revision)
+	if assert.NotNil(t, updatedApp.Operation.Sync.Source) {
+		assert.Equal(t, []appsv1.HelmParameter{{Name: "image.tag", Value: "v2"}}, updatedApp.Operation.Sync.Source.Helm.Parameters)
+	}
+	assert.Equal(t, []appsv1.HelmParameter{{Name: "image.tag", Value: "v1"}}, updatedApp.Spec.Source.Helm.Parameters)
+
+	updatedApp.Operation = nil
+	_, err = appServer.appclientset.ArgoprojV1alpha1().Applications(appServer.ns).Update(updatedApp)
+	assert.NoError(t, err)
+
+	updatedApp, err = appServer.UpdateImages(ctx, &application.ApplicationUpdateImagesRequest{
+		Name:           &testApp.Name,
+		HelmParameters: []appsv1.HelmParameter{{Name: "image.tag", Value: "v3"}},
+		WriteBack:      true,
+	})
+	assert.NoError(t, err)
+	assert.Nil(t, updatedApp.Operation.Sync.Source)
+	assert.True(t, updatedApp.Operation.Sync.ImageUpdate.WriteBack)
+	assert.Equal(t, []appsv1.HelmParameter{{Name: "image.tag", Value: "v3"}}, updatedApp.Spec.Source.Helm.Parameters)
+
+	_, err = appServer.UpdateImages(ctx, &application.ApplicationUpdateImagesRequest{
+		Name:            &testApp.Name,
+		KustomizeImages: []string{"nginx:1.17"},
+	})
+	assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = cannot set Kustomize images on application of type Helm")
+}
+
 func TestUpdateAppProject(t *testing.T) {
 	testApp := newTestApp()
 	ctx := context.Background()
@@ -404,3 +520,484 @@ func TestAppMergePatch(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "foo", app.Spec.Source.Path)
 }
+
+func TestPreviewApp(t *testing.T) {
+	testApp := newTestApp()
+	appServer := newTestAppServer(testApp)
+	res, err := appServer.Preview(context.Background(), &application.ApplicationPreviewRequest{Name: &testApp.Name})
+	assert.NoError(t, err)
+	assert.Empty(t, res.Items)
+
+	var manifests []string
+	for _, name := range []string{"guestbook", "rejected"} {
+		data, err := json.Marshal(newTestDeployment(name, testApp.Name, "guestbook:v1"))
+		assert.NoError(t, err)
+		manifests = append(manifests, string(data))
+	}
+	manifests = append(manifests, `{"apiVersion":"batch/v1","kind":"Job","metadata":{"name":"migrate","annotations":{"argocd.argoproj.io/hook":"PreSync"}}}`)
+	mockRepoServiceClient := mocks.RepoServerServiceClient{}
+	mockRepoServiceClient.On("GenerateManifest", mock.Anything, mock.Anything).Return(&apiclient.ManifestResponse{Manifests: manifests}, nil)
+	mockRepoClient := &mockrepo.Clientset{}
+	mockRepoClient.On("NewRepoServerClient").Return(&fakeCloser{}, &mockRepoServiceClient, nil)
+	appServer.repoClientset = mockRepoClient
+	appServer.kubectl = &kubetest.MockKubectlCmd{Commands: map[string]kubetest.KubectlOutput{
+		"guestbook": {Output: "deployment.apps/guestbook configured (server dry run)"},
+		"rejected":  {Err: fmt.Errorf("admission webhook denied the request")},
+	}}
+
+	// hooks are not previewed
+	res, err = appServer.Preview(context.Background(), &application.ApplicationPreviewRequest{Name: &testApp.Name})
+	assert.NoError(t, err)
+	assert.Equal(t, []application.ResourcePreviewResult{{
+		Group:     "apps",
+		Kind:      "Deployment",
+		Namespace: test.FakeDestNamespace,
+		Name:      "guestbook",
+		Status:    application.PreviewStatusPassed,
+		Message:   "deployment.apps/guestbook configured (server dry run)",
+	}, {
+		Group:     "apps",
+		Kind:      "Deployment",
+		Namespace: test.FakeDestNamespace,
+		Name:      "rejected",
+		Status:    application.PreviewStatusFailed,
+		Message:   "admission webhook denied the request",
+	}}, res.Items)
+
+	missingApp := "missing-app"
+	_, err = appServer.Preview(context.Background(), &application.ApplicationPreviewRequest{Name: &missingApp})
+	assert.Error(t, err)
+}
+
+func TestListApps(t *testing.T) {
+	newApp := func(name string, project string, sync appsv1.SyncStatusCode, health appsv1.HealthStatusCode, syncedAt time.Time) *appsv1.Application {
+		app := newTestApp()
+		app.Name = name
+		app.Labels = map[string]string{"team": project}
+		app.Spec.Project = project
+		app.Status.Sync.Status = sync
+		app.Status.Health.Status = health
+		finishedAt := metav1.NewTime(syncedAt)
+		app.Status.OperationState = &appsv1.OperationState{Phase: appsv1.OperationSucceeded, FinishedAt: &finishedAt}
+		return app
+	}
+	now := time.Now()
+	appServer := newTestAppServer(
+		newApp("guestbook", "default", appsv1.SyncStatusCodeSynced, appsv1.HealthStatusHealthy, now.Add(-time.Hour)),
+		newApp("guestbook-dev", "my-proj", appsv1.SyncStatusCodeOutOfSync, appsv1.HealthStatusDegraded, now),
+		newApp("helm-app", "default", appsv1.SyncStatusCodeOutOfSync, appsv1.HealthStatusProgressing, now.Add(-2*time.Hour)),
+	)
+	names := func(query application.ApplicationQuery) []string {
+		list, err := appServer.List(context.Background(), &query)
+		assert.NoError(t, err)
+		var names []string
+		for _, app := range list.Items {
+			names = append(names, app.Name)
+		}
+		return names
+	}
+
+	assert.Equal(t, []string{"guestbook", "guestbook-dev", "helm-app"}, names(application.ApplicationQuery{}))
+	assert.Equal(t, []string{"helm-app", "guestbook-dev", "guestbook"}, names(application.ApplicationQuery{SortBy: "-name"}))
+	assert.Equal(t, []string{"guestbook", "guestbook-dev", "helm-app"}, names(application.ApplicationQuery{SortBy: "sync"}))
+	assert.Equal(t, []string{"guestbook", "helm-app", "guestbook-dev"}, names(application.ApplicationQuery{SortBy: "health"}))
+	assert.Equal(t, []string{"guestbook-dev", "guestbook", "helm-app"}, names(application.ApplicationQuery{SortBy: "-lastSync"}))
+	assert.Equal(t, []string{"guestbook", "helm-app"}, names(application.ApplicationQuery{Projects: []string{"default"}}))
+	assert.Equal(t, []string{"guestbook-dev"}, names(application.ApplicationQuery{Selector: "team=my-proj"}))
+	assert.Equal(t, []string{"guestbook", "guestbook-dev"}, names(application.ApplicationQuery{Search: "GUEST"}))
+	assert.Equal(t, []string{"helm-app"}, names(application.ApplicationQuery{Projects: []string{"default"}, Search: "helm", SortBy: "-health"}))
+
+	_, err := appServer.List(context.Background(), &application.ApplicationQuery{SortBy: "size"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = appServer.List(context.Background(), &application.ApplicationQuery{Selector: "team in"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestSyncPinnedRevision(t *testing.T) {
+	testApp := newTestApp()
+	testApp.Spec.Source.TargetRevision = "master"
+	appServer := newTestAppServer(testApp)
+	revision := "aaaaaaaaaabbbbbbbbbbccccccccccdddddddddd"
+
+	app, err := appServer.Sync(context.Background(), &application.ApplicationSyncRequest{Name: &testApp.Name, Revision: revision})
+	assert.NoError(t, err)
+	assert.Equal(t, revision, app.Operation.Sync.Revision)
+	assert.True(t, app.Operation.Sync.Pinned)
+	assert.Equal(t, "master", app.Spec.Source.TargetRevision)
+
+	testApp.Spec.Source.TargetRevision = revision
+	appServer = newTestAppServer(testApp)
+	app, err = appServer.Sync(context.Background(), &application.ApplicationSyncRequest{Name: &testApp.Name, Revision: revision})
+	assert.NoError(t, err)
+	assert.False(t, app.Operation.Sync.Pinned)
+}
+
+func TestRevisionDetails(t *testing.T) {
+	testApp := newTestApp()
+	appServer := newTestAppServer(testApp)
+	revision := "abc"
+
+	metadata, err := appServer.RevisionMetadata(context.Background(), &application.RevisionMetadataQuery{Name: &testApp.Name, Revision: &revision})
+	assert.NoError(t, err)
+	assert.Equal(t, "truncated", metadata.Message)
+
+	metadata, err = appServer.RevisionDetails(context.Background(), &application.RevisionMetadataQuery{Name: &testApp.Name, Revision: &revision})
+	assert.NoError(t, err)
+	assert.Equal(t, "full message", metadata.Message)
+	assert.Equal(t, []string{"README.md"}, metadata.ChangedFiles)
+}
+
+func TestRevisionChangelog(t *testing.T) {
+	testApp := newTestApp()
+	testApp.Spec.Source.TargetRevision = "master"
+	appServer := newTestAppServer(testApp)
+
+	_, err := appServer.RevisionChangelog(context.Background(), &application.ApplicationRevisionChangelogQuery{Name: &testApp.Name})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	testApp.Status.History = []appsv1.RevisionHistory{{ID: 1, Revision: "abc"}, {ID: 2, Revision: "def"}}
+	appServer = newTestAppServer(testApp)
+
+	changelog, err := appServer.RevisionChangelog(context.Background(), &application.ApplicationRevisionChangelogQuery{Name: &testApp.Name})
+	assert.NoError(t, err)
+	assert.Equal(t, "def", changelog.Revision)
+	assert.Equal(t, "master", changelog.TargetRevision)
+
+	changelog, err = appServer.RevisionChangelog(context.Background(), &application.ApplicationRevisionChangelogQuery{Name: &testApp.Name, TargetRevision: "v2"})
+	assert.NoError(t, err)
+	assert.Equal(t, "v2", changelog.TargetRevision)
+}
+
+func TestListResourceConflicts(t *testing.T) {
+	svc := appsv1.ResourceStatus{Kind: "Service", Namespace: test.FakeDestNamespace, Name: "guestbook"}
+	testApp := newTestApp()
+	testApp.Status.Resources = []appsv1.ResourceStatus{svc}
+	otherApp := newTestApp()
+	otherApp.Name = "other-app"
+	otherApp.Status.Resources = []appsv1.ResourceStatus{svc}
+	appServer := newTestAppServer(testApp, otherApp)
+
+	res, err := appServer.ListResourceConflicts(context.Background(), &application.ResourceConflictsQuery{})
+	assert.NoError(t, err)
+	assert.Equal(t, []application.ResourceConflict{{
+		Server:                 "https://cluster-api.com",
+		Kind:                   "Service",
+		Namespace:              test.FakeDestNamespace,
+		Name:                   "guestbook",
+		Application:            "other-app",
+		ConflictingApplication: "test-app",
+	}}, res.Items)
+
+	res, err = appServer.ListResourceConflicts(context.Background(), &application.ResourceConflictsQuery{Name: "unrelated-app"})
+	assert.NoError(t, err)
+	assert.Empty(t, res.Items)
+}
+
+func TestValidateSpec(t *testing.T) {
+	testApp := newTestApp()
+	appServer := newTestAppServer()
+
+	res, err := appServer.ValidateSpec(context.Background(), &application.ApplicationValidateSpecRequest{Name: &testApp.Name, Spec: testApp.Spec})
+	assert.NoError(t, err)
+	assert.Empty(t, res.Violations)
+
+	testApp.Spec.Destination.Server = "https://unknown-cluster"
+	res, err = appServer.ValidateSpec(context.Background(), &application.ApplicationValidateSpecRequest{Name: &testApp.Name, Spec: testApp.Spec})
+	assert.NoError(t, err)
+	assert.Equal(t, []application.ProjectRuleViolation{{
+		Rule:    "cluster",
+		Message: "cluster 'https://unknown-cluster' has not been configured",
+	}}, res.Violations)
+
+	testApp.Spec.Project = "missing-proj"
+	res, err = appServer.ValidateSpec(context.Background(), &application.ApplicationValidateSpecRequest{Name: &testApp.Name, Spec: testApp.Spec})
+	assert.NoError(t, err)
+	if assert.Len(t, res.Violations, 1) {
+		assert.Equal(t, "project", res.Violations[0].Rule)
+	}
+}
+
+func TestResourceTreeResponseCache(t *testing.T) {
+	testApp := newTestApp()
+	testApp.ResourceVersion = "1"
+	appServer := newTestAppServer(testApp)
+	appServer.cache = cache.NewCache(cache.NewInMemoryCache(time.Hour))
+
+	setTree := func(names ...string) {
+		tree := &appsv1.ApplicationTree{}
+		for _, name := range names {
+			tree.Nodes = append(tree.Nodes, appsv1.ResourceNode{ResourceRef: appsv1.ResourceRef{Kind: "Pod", Name: name}})
+		}
+		assert.NoError(t, appServer.cache.SetAppResourcesTree(testApp.Name, tree))
+	}
+	getTree := func() *appsv1.ApplicationTree {
+		tree, err := appServer.ResourceTree(context.Background(), &application.ResourcesQuery{ApplicationName: &testApp.Name})
+		assert.NoError(t, err)
+		return tree
+	}
+
+	setTree("a")
+	assert.Len(t, getTree().Nodes, 1)
+
+	// the tree is served from the response cache while the application is unchanged
+	setTree("a", "b")
+	assert.Len(t, getTree().Nodes, 1)
+
+	// any change to the application invalidates the response
+	testApp.ResourceVersion = "2"
+	_, err := appServer.appclientset.ArgoprojV1alpha1().Applications(testNamespace).Update(testApp)
+	assert.NoError(t, err)
+	assert.Len(t, getTree().Nodes, 2)
+}
+
+func TestFilterResourceTree(t *testing.T) {
+	healthy := &appsv1.HealthStatus{Status: appsv1.HealthStatusHealthy}
+	degraded := &appsv1.HealthStatus{Status: appsv1.HealthStatusDegraded}
+	tree := &appsv1.ApplicationTree{
+		Nodes: []appsv1.ResourceNode{
+			{ResourceRef: appsv1.ResourceRef{Group: "apps", Kind: "Deployment", Namespace: "ns1", Name: "deploy"}, Health: healthy},
+			{ResourceRef: appsv1.ResourceRef{Kind: "Pod", Namespace: "ns1", Name: "pod1"}, Health: degraded},
+			{ResourceRef: appsv1.ResourceRef{Kind: "Pod", Namespace: "ns2", Name: "pod2"}, Health: healthy},
+			{ResourceRef: appsv1.ResourceRef{Kind: "ConfigMap", Namespace: "ns1", Name: "config"}},
+		},
+		OrphanedNodes: []appsv1.ResourceNode{
+			{ResourceRef: appsv1.ResourceRef{Kind: "Pod", Namespace: "ns1", Name: "orphan"}, Health: degraded},
+		},
+	}
+	names := func(nodes []appsv1.ResourceNode) []string {
+		var res []string
+		for _, node := range nodes {
+			res = append(res, node.Name)
+		}
+		return res
+	}
+	yes, no := true, false
+
+	assert.Equal(t, tree, filterResourceTree(tree, &application.ResourcesQuery{}))
+
+	filtered := filterResourceTree(tree, &application.ResourcesQuery{Kinds: []string{"Pod"}})
+	assert.Equal(t, []string{"pod1", "pod2"}, names(filtered.Nodes))
+	assert.Equal(t, []string{"orphan"}, names(filtered.OrphanedNodes))
+
+	filtered = filterResourceTree(tree, &application.ResourcesQuery{Kinds: []string{"Deployment.apps", "ConfigMap"}})
+	assert.Equal(t, []string{"deploy", "config"}, names(filtered.Nodes))
+
+	filtered = filterResourceTree(tree, &application.ResourcesQuery{HealthStatuses: []string{appsv1.HealthStatusDegraded}, Orphaned: &no})
+	assert.Equal(t, []string{"pod1"}, names(filtered.Nodes))
+	assert.Empty(t, filtered.OrphanedNodes)
+
+	filtered = filterResourceTree(tree, &application.ResourcesQuery{Namespaces: []string{"ns1"}, Orphaned: &yes})
+	assert.Empty(t, filtered.Nodes)
+	assert.Equal(t, []string{"orphan"}, names(filtered.OrphanedNodes))
+
+	// the tree is not modified
+	assert.Len(t, tree.Nodes, 4)
+	assert.Len(t, tree.OrphanedNodes, 1)
+}
+
+func TestDriftReport(t *testing.T) {
+	earlier := metav1.NewTime(time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC))
+	later := metav1.NewTime(time.Date(2019, 10, 2, 0, 0, 0, 0, time.UTC))
+	newApp := func(name, project string, resources ...appsv1.ResourceStatus) *appsv1.Application {
+		a := newTestApp()
+		a.Name = name
+		a.Spec.Project = project
+		a.Status.Resources = resources
+		return a
+	}
+	appServer := newTestAppServer(
+		newApp("app1", "default",
+			appsv1.ResourceStatus{Group: "apps", Kind: "Deployment", Namespace: "ns", Name: "guestbook", Status: appsv1.SyncStatusCodeOutOfSync, OutOfSyncSince: &earlier},
+			appsv1.ResourceStatus{Kind: "Service", Namespace: "ns", Name: "guestbook", Status: appsv1.SyncStatusCodeSynced},
+			appsv1.ResourceStatus{Kind: "ConfigMap", Namespace: "ns", Name: "extra", Status: appsv1.SyncStatusCodeOutOfSync, RequiresPruning: true, OutOfSyncSince: &later},
+		),
+		newApp("app2", "default",
+			appsv1.ResourceStatus{Kind: "Service", Namespace: "ns", Name: "missing", Status: appsv1.SyncStatusCodeOutOfSync,
+				Health: &appsv1.HealthStatus{Status: appsv1.HealthStatusMissing}, OutOfSyncSince: &later},
+		),
+		newApp("app3", "other", appsv1.ResourceStatus{Kind: "Service", Namespace: "ns", Name: "other", Status: appsv1.SyncStatusCodeOutOfSync}),
+	)
+	appServer.cache = cache.NewCache(cache.NewInMemoryCache(time.Hour))
+	assert.NoError(t, appServer.cache.SetAppManagedResources("app1", []*appsv1.ResourceDiff{
+		{Group: "apps", Kind: "Deployment", Namespace: "ns", Name: "guestbook", Diff: `{"spec": {"replicas": [1, 2]}}`},
+	}))
+	names := func(report *application.ApplicationDriftReport) []string {
+		var res []string
+		for _, item := range report.Items {
+			res = append(res, item.Application+"/"+item.Name)
+		}
+		return res
+	}
+
+	report, err := appServer.DriftReport(context.Background(), &application.ApplicationDriftReportQuery{Project: "default"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"app1/extra", "app1/guestbook", "app2/missing"}, names(report))
+	assert.Equal(t, []string{"/spec/replicas"}, report.Items[1].Fields)
+	assert.Equal(t, &earlier, report.Items[1].DetectedAt)
+	assert.True(t, report.Items[0].RequiresPruning)
+	assert.True(t, report.Items[2].Missing)
+	assert.Empty(t, report.Continue)
+
+	report, err = appServer.DriftReport(context.Background(), &application.ApplicationDriftReportQuery{Project: "default", Since: "2019-10-01T12:00:00Z"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"app1/extra", "app2/missing"}, names(report))
+
+	report, err = appServer.DriftReport(context.Background(), &application.ApplicationDriftReportQuery{Project: "default", Limit: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"app1/extra", "app1/guestbook"}, names(report))
+	assert.NotEmpty(t, report.Continue)
+	report, err = appServer.DriftReport(context.Background(), &application.ApplicationDriftReportQuery{Project: "default", Limit: 2, Continue: report.Continue})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"app2/missing"}, names(report))
+	assert.Empty(t, report.Continue)
+
+	_, err = appServer.DriftReport(context.Background(), &application.ApplicationDriftReportQuery{Project: "default", Since: "yesterday"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestStatusMatrix(t *testing.T) {
+	newApp := func(name, group, server string, syncStatus appsv1.SyncStatusCode, healthStatus appsv1.HealthStatusCode) *appsv1.Application {
+		a := newTestApp()
+		a.Name = name
+		a.Labels = map[string]string{"team": "payments"}
+		if group != "" {
+			a.Labels["app.kubernetes.io/name"] = group
+		}
+		a.Spec.Destination.Server = server
+		a.Status.Sync = appsv1.SyncStatus{Status: syncStatus, Revision: "abc123"}
+		a.Status.Health = appsv1.HealthStatus{Status: healthStatus}
+		return a
+	}
+	appServer := newTestAppServer(
+		newApp("guestbook-prod", "guestbook", "https://prod.example.com", appsv1.SyncStatusCodeOutOfSync, appsv1.HealthStatusDegraded),
+		newApp("guestbook-dev", "guestbook", "https://cluster-api.com", appsv1.SyncStatusCodeSynced, appsv1.HealthStatusHealthy),
+		newApp("standalone", "", "https://cluster-api.com", appsv1.SyncStatusCodeSynced, appsv1.HealthStatusHealthy),
+	)
+
+	matrix, err := appServer.StatusMatrix(context.Background(), &application.ApplicationStatusMatrixQuery{Selector: "team=payments", GroupBy: "app.kubernetes.io/name"})
+	assert.NoError(t, err)
+	assert.Equal(t, []application.ApplicationStatusMatrixCluster{
+		{Server: "https://cluster-api.com", Name: "fake-cluster"},
+		{Server: "https://prod.example.com"},
+	}, matrix.Clusters)
+	if assert.Len(t, matrix.Rows, 2) {
+		assert.Equal(t, "guestbook", matrix.Rows[0].Name)
+		assert.Equal(t, []application.ApplicationStatusMatrixCell{
+			{Cluster: 0, Application: "guestbook-dev", Namespace: test.FakeDestNamespace, SyncStatus: "Synced", HealthStatus: "Healthy", Revision: "abc123"},
+			{Cluster: 1, Application: "guestbook-prod", Namespace: test.FakeDestNamespace, SyncStatus: "OutOfSync", HealthStatus: "Degraded", Revision: "abc123"},
+		}, matrix.Rows[0].Cells)
+		assert.Equal(t, "standalone", matrix.Rows[1].Name)
+	}
+
+	matrix, err = appServer.StatusMatrix(context.Background(), &application.ApplicationStatusMatrixQuery{Selector: "team=other"})
+	assert.NoError(t, err)
+	assert.Empty(t, matrix.Rows)
+
+	_, err = appServer.StatusMatrix(context.Background(), &application.ApplicationStatusMatrixQuery{Selector: "team in ("})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGetOperationInitiator(t *testing.T) {
+	ctx := context.WithValue(context.Background(), "claims", &jwt.MapClaims{"iss": "argocd", "sub": "admin"})
+
+	initiator := getOperationInitiator(metadata.NewIncomingContext(ctx, metadata.Pairs("user-agent", "argocd-client/v1.2.0 grpc-go/1.15.0")))
+	assert.Equal(t, appsv1.OperationInitiator{Username: "admin", Source: appsv1.OperationSourceCLI}, initiator)
+
+	initiator = getOperationInitiator(metadata.NewIncomingContext(ctx, metadata.Pairs(
+		"user-agent", "argocd-client/v1.2.0 grpc-go/1.15.0", "grpcgateway-user-agent", "Mozilla/5.0", "grpcgateway-cookie", "argocd.token=abc")))
+	assert.Equal(t, appsv1.OperationInitiator{Username: "admin", Source: appsv1.OperationSourceUI}, initiator)
+
+	initiator = getOperationInitiator(metadata.NewIncomingContext(ctx, metadata.Pairs(
+		"user-agent", "argocd-client/v1.2.0 grpc-go/1.15.0", "grpcgateway-user-agent", "curl/7.58.0")))
+	assert.Equal(t, appsv1.OperationInitiator{Username: "admin", Source: appsv1.OperationSourceAPI}, initiator)
+
+	initiator = getOperationInitiator(ctx)
+	assert.Equal(t, appsv1.OperationInitiator{Username: "admin", Source: appsv1.OperationSourceAPI}, initiator)
+}
+
+func newTestDeployment(name, appName, image string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]interface{}{
+			"name":      name,
+			"namespace": test.FakeDestNamespace,
+			"labels":    map[string]interface{}{common.LabelKeyAppInstance: appName},
+		},
+		"spec": map[string]interface{}{"template": map[string]interface{}{"spec": map[string]interface{}{
+			"containers": []interface{}{map[string]interface{}{"name": "main", "image": image}},
+		}}},
+	}}
+}
+
+func TestManagedResources_RelistLiveStates(t *testing.T) {
+	testApp := newTestApp()
+	appServer := newTestAppServer(testApp)
+	dynamicIf := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(),
+		newTestDeployment("guestbook", testApp.Name, "guestbook:v2"),
+		newTestDeployment("created", testApp.Name, "created:v1"),
+		newTestDeployment("other", "other-app", "other:v1"),
+	)
+	gvr := schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+	appServer.kubectl = &kubetest.MockKubectlCmd{APIResources: []kube.APIResourceInfo{{
+		GroupKind: schema.GroupKind{Group: "apps", Kind: "Deployment"},
+		Meta:      metav1.APIResource{Name: "deployments", Namespaced: true},
+		Interface: dynamicIf.Resource(gvr),
+	}}}
+
+	target := newTestDeployment("guestbook", testApp.Name, "guestbook:v2")
+	targetState, err := json.Marshal(target)
+	assert.NoError(t, err)
+	staleState, err := json.Marshal(newTestDeployment("guestbook", testApp.Name, "guestbook:v1"))
+	assert.NoError(t, err)
+	service := &appsv1.ResourceDiff{Kind: "Service", Namespace: test.FakeDestNamespace, Name: "guestbook", TargetState: "{}", LiveState: "{}"}
+	items := []*appsv1.ResourceDiff{
+		{Group: "apps", Kind: "Deployment", Namespace: test.FakeDestNamespace, Name: "guestbook", TargetState: string(targetState), LiveState: string(staleState)},
+		service,
+	}
+
+	res, err := appServer.relistLiveStates(testApp, items, []string{"Deployment.apps"})
+	assert.NoError(t, err)
+	assert.Len(t, res, 3)
+	assert.Contains(t, res[0].LiveState, "guestbook:v2")
+	assert.NotContains(t, res[0].Diff, "guestbook:v1")
+	assert.Equal(t, service, res[1])
+	assert.Equal(t, "created", res[2].Name)
+	assert.Equal(t, "null", res[2].TargetState)
+
+	_, err = appServer.relistLiveStates(testApp, items, []string{"Secret"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = appServer.relistLiveStates(testApp, items, []string{"StatefulSet.apps"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestManagedManifests(t *testing.T) {
+	target := newTestDeployment("guestbook", "guestbook", "guestbook:v2")
+	targetState, err := json.Marshal(target)
+	assert.NoError(t, err)
+	live := newTestDeployment("guestbook", "guestbook", "guestbook:v1")
+	live.SetUID("2b3c3f0e")
+	live.SetResourceVersion("123")
+	live.SetAnnotations(map[string]string{"kubectl.kubernetes.io/last-applied-configuration": "{}"})
+	live.Object["status"] = map[string]interface{}{"replicas": int64(1)}
+	liveState, err := json.Marshal(live)
+	assert.NoError(t, err)
+	items := []*appsv1.ResourceDiff{
+		{Group: "apps", Kind: "Deployment", Name: "guestbook", TargetState: string(targetState), LiveState: string(liveState)},
+		{Kind: "Service", Name: "pruned", TargetState: "null", LiveState: `{"apiVersion":"v1","kind":"Service","metadata":{"name":"pruned"}}`},
+	}
+
+	manifests, err := managedManifests(items, manifestSourceGit)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, strings.Count(manifests, "---\n"))
+	assert.Contains(t, manifests, "guestbook:v2")
+
+	manifests, err = managedManifests(items, manifestSourceLive)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, strings.Count(manifests, "---\n"))
+	assert.Contains(t, manifests, "guestbook:v1")
+	assert.Contains(t, manifests, "name: pruned")
+	for _, field := range []string{"uid:", "resourceVersion:", "status:", "last-applied-configuration", "annotations:"} {
+		assert.NotContains(t, manifests, field)
+	}
+}
@@ -0,0 +1,149 @@
+package application
+
+import (
+	"fmt"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/pkg/apiclient/application"
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+const (
+	EventTypeSyncStarted   = "SyncStarted"
+	EventTypeSyncSucceeded = "SyncSucceeded"
+	EventTypeSyncFailed    = "SyncFailed"
+	EventTypeHealthChanged = "HealthChanged"
+	EventTypeDriftDetected = "DriftDetected"
+)
+
+// eventBufferSize is the number of events buffered for each subscriber. Events are dropped for subscribers which
+// fall further behind.
+const eventBufferSize = 100
+
+// appEvent is an event of an application, together with the application for RBAC checks
+type appEvent struct {
+	app   *appv1.Application
+	event *application.ApplicationEvent
+}
+
+// eventBroadcaster derives the significant events of applications from the updates of the application informer and
+// publishes them to the subscribers of the event stream
+type eventBroadcaster struct {
+	lock        sync.Mutex
+	subscribers map[chan *appEvent]bool
+}
+
+func newEventBroadcaster() *eventBroadcaster {
+	return &eventBroadcaster{subscribers: make(map[chan *appEvent]bool)}
+}
+
+// subscribe returns a channel of the events, and a function which unsubscribes it
+func (b *eventBroadcaster) subscribe() (chan *appEvent, func()) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	ch := make(chan *appEvent, eventBufferSize)
+	b.subscribers[ch] = true
+	return ch, func() {
+		b.lock.Lock()
+		defer b.lock.Unlock()
+		delete(b.subscribers, ch)
+	}
+}
+
+func (b *eventBroadcaster) OnAdd(obj interface{}) {}
+
+func (b *eventBroadcaster) OnDelete(obj interface{}) {}
+
+func (b *eventBroadcaster) OnUpdate(oldObj, newObj interface{}) {
+	oldApp, oldOK := oldObj.(*appv1.Application)
+	newApp, newOK := newObj.(*appv1.Application)
+	if !oldOK || !newOK {
+		return
+	}
+	events := appEvents(oldApp, newApp)
+	if len(events) == 0 {
+		return
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	for _, event := range events {
+		for ch := range b.subscribers {
+			select {
+			case ch <- &appEvent{app: newApp, event: event}:
+			default:
+				log.Warnf("Dropped %s event of application '%s' for slow subscriber", event.Type, newApp.Name)
+			}
+		}
+	}
+}
+
+// appEvents returns the significant events of the update of an application
+func appEvents(oldApp, newApp *appv1.Application) []*application.ApplicationEvent {
+	var events []*application.ApplicationEvent
+	newEvent := func(eventType string, message string, revision string, at *metav1.Time) {
+		if at == nil {
+			now := metav1.Now()
+			at = &now
+		}
+		events = append(events, &application.ApplicationEvent{
+			Type:        eventType,
+			Application: newApp.Name,
+			Project:     newApp.Spec.GetProject(),
+			Message:     message,
+			Revision:    revision,
+			Time:        at,
+		})
+	}
+
+	oldOp, newOp := oldApp.Status.OperationState, newApp.Status.OperationState
+	if newOp != nil {
+		started := oldOp == nil || !oldOp.StartedAt.Equal(&newOp.StartedAt)
+		revision := ""
+		if newOp.Operation.Sync != nil {
+			revision = newOp.Operation.Sync.Revision
+		}
+		if started {
+			startedAt := newOp.StartedAt
+			newEvent(EventTypeSyncStarted, "Sync operation started", revision, &startedAt)
+		}
+		if newOp.Phase.Completed() && (started || !oldOp.Phase.Completed()) {
+			if newOp.SyncResult != nil {
+				revision = newOp.SyncResult.Revision
+			}
+			if newOp.Phase.Successful() {
+				newEvent(EventTypeSyncSucceeded, newOp.Message, revision, newOp.FinishedAt)
+			} else {
+				newEvent(EventTypeSyncFailed, newOp.Message, revision, newOp.FinishedAt)
+			}
+		}
+	}
+	if oldApp.Status.Health.Status != newApp.Status.Health.Status {
+		message := fmt.Sprintf("Health status changed: %s -> %s", oldApp.Status.Health.Status, newApp.Status.Health.Status)
+		newEvent(EventTypeHealthChanged, message, newApp.Status.Sync.Revision, nil)
+	}
+	if oldApp.Status.Sync.Status == appv1.SyncStatusCodeSynced && newApp.Status.Sync.Status == appv1.SyncStatusCodeOutOfSync {
+		newEvent(EventTypeDriftDetected, "Application drifted from the synced state", newApp.Status.Sync.Revision, nil)
+	}
+	return events
+}
+
+// matchesEventQuery returns whether the event matches the filters of the query
+func matchesEventQuery(q *application.ApplicationEventStreamQuery, event *application.ApplicationEvent) bool {
+	if q.Name != "" && q.Name != event.Application {
+		return false
+	}
+	return (len(q.Projects) == 0 || containsString(q.Projects, event.Project)) &&
+		(len(q.Types) == 0 || containsString(q.Types, event.Type))
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,98 @@
+package application
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/pkg/apiclient/application"
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+func newEventTestApp(syncStatus appv1.SyncStatusCode, healthStatus appv1.HealthStatusCode, op *appv1.OperationState) *appv1.Application {
+	return &appv1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook"},
+		Spec:       appv1.ApplicationSpec{Project: "default"},
+		Status: appv1.ApplicationStatus{
+			Sync:           appv1.SyncStatus{Status: syncStatus, Revision: "abc"},
+			Health:         appv1.HealthStatus{Status: healthStatus},
+			OperationState: op,
+		},
+	}
+}
+
+func eventTypes(events []*application.ApplicationEvent) []string {
+	var types []string
+	for _, event := range events {
+		types = append(types, event.Type)
+	}
+	return types
+}
+
+func TestAppEvents(t *testing.T) {
+	startedAt := metav1.Now()
+	running := &appv1.OperationState{
+		Operation: appv1.Operation{Sync: &appv1.SyncOperation{Revision: "abc"}},
+		Phase:     appv1.OperationRunning,
+		StartedAt: startedAt,
+	}
+	succeeded := running.DeepCopy()
+	succeeded.Phase = appv1.OperationSucceeded
+	succeeded.FinishedAt = &startedAt
+	failed := running.DeepCopy()
+	failed.Phase = appv1.OperationFailed
+
+	synced := newEventTestApp(appv1.SyncStatusCodeSynced, appv1.HealthStatusHealthy, nil)
+
+	t.Run("NoChange", func(t *testing.T) {
+		assert.Empty(t, appEvents(synced, synced.DeepCopy()))
+	})
+	t.Run("SyncStarted", func(t *testing.T) {
+		events := appEvents(synced, newEventTestApp(appv1.SyncStatusCodeSynced, appv1.HealthStatusHealthy, running))
+		assert.Equal(t, []string{EventTypeSyncStarted}, eventTypes(events))
+		assert.Equal(t, "guestbook", events[0].Application)
+		assert.Equal(t, "default", events[0].Project)
+		assert.Equal(t, "abc", events[0].Revision)
+	})
+	t.Run("SyncSucceeded", func(t *testing.T) {
+		events := appEvents(newEventTestApp(appv1.SyncStatusCodeSynced, appv1.HealthStatusHealthy, running),
+			newEventTestApp(appv1.SyncStatusCodeSynced, appv1.HealthStatusHealthy, succeeded))
+		assert.Equal(t, []string{EventTypeSyncSucceeded}, eventTypes(events))
+	})
+	t.Run("SyncStartedAndFailed", func(t *testing.T) {
+		events := appEvents(synced, newEventTestApp(appv1.SyncStatusCodeSynced, appv1.HealthStatusHealthy, failed))
+		assert.Equal(t, []string{EventTypeSyncStarted, EventTypeSyncFailed}, eventTypes(events))
+	})
+	t.Run("HealthChangedAndDriftDetected", func(t *testing.T) {
+		events := appEvents(synced, newEventTestApp(appv1.SyncStatusCodeOutOfSync, appv1.HealthStatusDegraded, nil))
+		assert.Equal(t, []string{EventTypeHealthChanged, EventTypeDriftDetected}, eventTypes(events))
+		assert.Equal(t, "Health status changed: Healthy -> Degraded", events[0].Message)
+	})
+}
+
+func TestMatchesEventQuery(t *testing.T) {
+	event := &application.ApplicationEvent{Type: EventTypeSyncFailed, Application: "guestbook", Project: "default"}
+	assert.True(t, matchesEventQuery(&application.ApplicationEventStreamQuery{}, event))
+	assert.True(t, matchesEventQuery(&application.ApplicationEventStreamQuery{Name: "guestbook", Projects: []string{"default"}}, event))
+	assert.True(t, matchesEventQuery(&application.ApplicationEventStreamQuery{Types: []string{EventTypeSyncSucceeded, EventTypeSyncFailed}}, event))
+	assert.False(t, matchesEventQuery(&application.ApplicationEventStreamQuery{Name: "other"}, event))
+	assert.False(t, matchesEventQuery(&application.ApplicationEventStreamQuery{Projects: []string{"other"}}, event))
+	assert.False(t, matchesEventQuery(&application.ApplicationEventStreamQuery{Types: []string{EventTypeHealthChanged}}, event))
+}
+
+func TestEventBroadcaster(t *testing.T) {
+	b := newEventBroadcaster()
+	events, unsubscribe := b.subscribe()
+
+	b.OnUpdate(newEventTestApp(appv1.SyncStatusCodeSynced, appv1.HealthStatusHealthy, nil),
+		newEventTestApp(appv1.SyncStatusCodeOutOfSync, appv1.HealthStatusHealthy, nil))
+	next := <-events
+	assert.Equal(t, EventTypeDriftDetected, next.event.Type)
+	assert.Equal(t, "guestbook", next.app.Name)
+
+	unsubscribe()
+	b.OnUpdate(newEventTestApp(appv1.SyncStatusCodeSynced, appv1.HealthStatusHealthy, nil),
+		newEventTestApp(appv1.SyncStatusCodeOutOfSync, appv1.HealthStatusHealthy, nil))
+	assert.Len(t, events, 0)
+}
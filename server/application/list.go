@@ -0,0 +1,145 @@
+package application
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/health"
+)
+
+const (
+	// projectIndex indexes the applications of the informer cache by project
+	projectIndex = "project"
+
+	sortByName     = "name"
+	sortBySync     = "sync"
+	sortByHealth   = "health"
+	sortByLastSync = "lastSync"
+)
+
+// ListIndexers are the indexers of the application informer used by the List API. They must be added to the informer
+// before it is started.
+var ListIndexers = cache.Indexers{
+	projectIndex: func(obj interface{}) ([]string, error) {
+		app, ok := obj.(*appv1.Application)
+		if !ok {
+			return nil, nil
+		}
+		return []string{app.Spec.GetProject()}, nil
+	},
+}
+
+// syncOrder is a list of sync status codes in the order in which applications are sorted by sync status
+var syncOrder = []appv1.SyncStatusCode{
+	appv1.SyncStatusCodeSynced,
+	appv1.SyncStatusCodeOutOfSync,
+	appv1.SyncStatusCodeUnknown,
+}
+
+func syncRank(app *appv1.Application) int {
+	for i, code := range syncOrder {
+		if app.Status.Sync.Status == code {
+			return i
+		}
+	}
+	return len(syncOrder)
+}
+
+// lastSyncTime returns the time of the last sync operation of the application, or the zero time if it was never synced
+func lastSyncTime(app *appv1.Application) metav1.Time {
+	state := app.Status.OperationState
+	if state == nil {
+		return metav1.Time{}
+	}
+	if state.FinishedAt != nil {
+		return *state.FinishedAt
+	}
+	return state.StartedAt
+}
+
+// listApplications returns the applications of the indexer which belong to one of the projects, if any, and match
+// the label selector and the search string
+func listApplications(indexer cache.Indexer, projects []string, selector labels.Selector, search string) ([]*appv1.Application, error) {
+	var objs []interface{}
+	if len(projects) == 0 {
+		objs = indexer.List()
+	} else {
+		for _, project := range projects {
+			projObjs, err := indexer.ByIndex(projectIndex, project)
+			if err != nil {
+				return nil, err
+			}
+			objs = append(objs, projObjs...)
+		}
+	}
+	search = strings.ToLower(search)
+	apps := make([]*appv1.Application, 0, len(objs))
+	for _, obj := range objs {
+		app, ok := obj.(*appv1.Application)
+		if !ok {
+			continue
+		}
+		if !selector.Matches(labels.Set(app.Labels)) {
+			continue
+		}
+		if search != "" && !strings.Contains(strings.ToLower(app.Name), search) {
+			continue
+		}
+		apps = append(apps, app)
+	}
+	return apps, nil
+}
+
+// sortApplications sorts the applications by the given field, and by name within equal values. A '-' prefix sorts
+// the applications in descending order.
+func sortApplications(apps []*appv1.Application, sortBy string) error {
+	descending := strings.HasPrefix(sortBy, "-")
+	sortBy = strings.TrimPrefix(sortBy, "-")
+	var compare func(a, b *appv1.Application) int
+	switch sortBy {
+	case "", sortByName:
+		compare = func(a, b *appv1.Application) int { return 0 }
+	case sortBySync:
+		compare = func(a, b *appv1.Application) int { return syncRank(a) - syncRank(b) }
+	case sortByHealth:
+		compare = func(a, b *appv1.Application) int {
+			switch {
+			case health.IsWorse(a.Status.Health.Status, b.Status.Health.Status):
+				return -1
+			case health.IsWorse(b.Status.Health.Status, a.Status.Health.Status):
+				return 1
+			}
+			return 0
+		}
+	case sortByLastSync:
+		compare = func(a, b *appv1.Application) int {
+			aTime, bTime := lastSyncTime(a), lastSyncTime(b)
+			switch {
+			case aTime.Before(&bTime):
+				return -1
+			case bTime.Before(&aTime):
+				return 1
+			}
+			return 0
+		}
+	default:
+		return fmt.Errorf("unknown sort field '%s', must be one of %s, %s, %s or %s", sortBy, sortByName, sortBySync, sortByHealth, sortByLastSync)
+	}
+	sort.Slice(apps, func(i, j int) bool {
+		a, b := apps[i], apps[j]
+		if descending {
+			a, b = b, a
+		}
+		if c := compare(a, b); c != 0 {
+			return c < 0
+		}
+		return a.Name < b.Name
+	})
+	return nil
+}
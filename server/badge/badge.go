@@ -1,6 +1,7 @@
 package badge
 
 import (
+	"crypto/subtle"
 	"fmt"
 	"net/http"
 	"regexp"
@@ -12,12 +13,16 @@ import (
 
 	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/pkg/client/clientset/versioned"
+	"github.com/argoproj/argo-cd/server/rbacpolicy"
 	"github.com/argoproj/argo-cd/util/assets"
+	"github.com/argoproj/argo-cd/util/rbac"
+	"github.com/argoproj/argo-cd/util/session"
 )
 
-//NewHandler creates handler serving to do api/badge endpoint
-func NewHandler(appClientset versioned.Interface, settingsMrg *settings.SettingsManager, namespace string) http.Handler {
-	return &Handler{appClientset: appClientset, namespace: namespace, settingsMgr: settingsMrg}
+//NewHandler creates handler serving to do api/badge endpoint. The enforcer is optional and, if given, grants badges
+//of the applications which the anonymous user may see when anonymous access is enabled.
+func NewHandler(appClientset versioned.Interface, settingsMrg *settings.SettingsManager, namespace string, enf *rbac.Enforcer) http.Handler {
+	return &Handler{appClientset: appClientset, namespace: namespace, settingsMgr: settingsMrg, enf: enf}
 }
 
 //Handler used to get application in order to access health/sync
@@ -25,6 +30,7 @@ type Handler struct {
 	namespace    string
 	appClientset versioned.Interface
 	settingsMgr  *settings.SettingsManager
+	enf          *rbac.Enforcer
 }
 
 const (
@@ -62,24 +68,40 @@ func replaceFirstGroupSubMatch(re *regexp.Regexp, str string, repl string) strin
 	return result + str[lastIndex:]
 }
 
+//isEnabled returns whether the badge of the application is enabled and, if the badge is protected by a token, whether
+//the correct token was given. Badges are also enabled for applications which the anonymous user is allowed to get.
+//Disabled badges are rendered as unknown so that they do not reveal which applications exist.
+func (h *Handler) isEnabled(app *appv1.Application, token string) bool {
+	sets, err := h.settingsMgr.GetSettings()
+	if err != nil {
+		return false
+	}
+	if sets.StatusBadgeToken == "" || subtle.ConstantTimeCompare([]byte(sets.StatusBadgeToken), []byte(token)) == 1 {
+		if sets.IsStatusBadgeEnabled(app.Name) {
+			return true
+		}
+	}
+	if h.enf != nil && sets.AnonymousUserEnabled {
+		claims := &session.AnonymousClaims{Role: sets.AnonymousUserRole}
+		return h.enf.Enforce(claims, rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, app.Spec.GetProject()+"/"+app.Name)
+	}
+	return false
+}
+
 //ServeHTTP returns badge with health and sync status for application
 //(or an error badge if wrong query or application name is given)
 func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	health := appv1.HealthStatusUnknown
 	status := appv1.SyncStatusCodeUnknown
-	enabled := false
-	if sets, err := h.settingsMgr.GetSettings(); err == nil {
-		enabled = sets.StatusBadgeEnabled
-	}
 
 	//Sample url: http://localhost:8080/api/badge?name=123
-	if keys, ok := r.URL.Query()["name"]; ok && enabled {
+	if keys, ok := r.URL.Query()["name"]; ok {
 		key := keys[0]
 		//if another query is added after the application name and is separated by a / this will make sure it only looks at
 		//what is between the name= and / and will open the applicaion by that name
 		q := strings.Split(key, "/")
 		key = q[0]
-		if app, err := h.appClientset.ArgoprojV1alpha1().Applications(h.namespace).Get(key, v1.GetOptions{}); err == nil {
+		if app, err := h.appClientset.ArgoprojV1alpha1().Applications(h.namespace).Get(key, v1.GetOptions{}); err == nil && h.isEnabled(app, r.URL.Query().Get("token")) {
 			health = app.Status.Health.Status
 			status = app.Status.Sync.Status
 		}
@@ -121,6 +143,8 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	badge = replaceFirstGroupSubMatch(rightText1Pattern, badge, rightText)
 	badge = replaceFirstGroupSubMatch(rightText2Pattern, badge, rightText)
 	w.Header().Set("Content-Type", "image/svg+xml")
+	//prevent image proxies, such as GitHub's camo, from caching a stale status
+	w.Header().Set("Cache-Control", "private, no-store")
 	w.WriteHeader(http.StatusOK)
 	_, _ = w.Write([]byte(badge))
 }
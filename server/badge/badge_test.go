@@ -6,8 +6,13 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/argoproj/argo-cd/common"
 	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-cd/server/rbacpolicy"
+	"github.com/argoproj/argo-cd/test"
+	"github.com/argoproj/argo-cd/util/assets"
+	"github.com/argoproj/argo-cd/util/rbac"
 	"github.com/argoproj/argo-cd/util/settings"
 
 	"github.com/stretchr/testify/assert"
@@ -47,7 +52,7 @@ var (
 
 func TestHandlerFeatureIsEnabled(t *testing.T) {
 	settingsMgr := settings.NewSettingsManager(context.Background(), fake.NewSimpleClientset(&argoCDCm, &argoCDSecret), "default")
-	handler := NewHandler(appclientset.NewSimpleClientset(&testApp), settingsMgr, "default")
+	handler := NewHandler(appclientset.NewSimpleClientset(&testApp), settingsMgr, "default", nil)
 	req, err := http.NewRequest("GET", "/api/badge?name=testApp", nil)
 	assert.NoError(t, err)
 
@@ -67,7 +72,7 @@ func TestHandlerFeatureIsDisabled(t *testing.T) {
 	delete(argoCDCmDisabled.Data, "statusbadge.enabled")
 
 	settingsMgr := settings.NewSettingsManager(context.Background(), fake.NewSimpleClientset(argoCDCmDisabled, &argoCDSecret), "default")
-	handler := NewHandler(appclientset.NewSimpleClientset(&testApp), settingsMgr, "default")
+	handler := NewHandler(appclientset.NewSimpleClientset(&testApp), settingsMgr, "default", nil)
 	req, err := http.NewRequest("GET", "/api/badge?name=testApp", nil)
 	assert.NoError(t, err)
 
@@ -80,3 +85,72 @@ func TestHandlerFeatureIsDisabled(t *testing.T) {
 	assert.Equal(t, "Unknown", leftText1Pattern.FindStringSubmatch(response)[1])
 	assert.Equal(t, "Unknown", rightText1Pattern.FindStringSubmatch(response)[1])
 }
+
+func TestHandlerApplicationIsNotEnabled(t *testing.T) {
+	argoCDCmApps := argoCDCm.DeepCopy()
+	argoCDCmApps.Data["statusbadge.applications"] = "guestbook, frontend-*"
+
+	settingsMgr := settings.NewSettingsManager(context.Background(), fake.NewSimpleClientset(argoCDCmApps, &argoCDSecret), "default")
+	handler := NewHandler(appclientset.NewSimpleClientset(&testApp), settingsMgr, "default", nil)
+	req, err := http.NewRequest("GET", "/api/badge?name=testApp", nil)
+	assert.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+
+	response := rr.Body.String()
+	assert.Equal(t, "Unknown", leftText1Pattern.FindStringSubmatch(response)[1])
+	assert.Equal(t, "Unknown", rightText1Pattern.FindStringSubmatch(response)[1])
+}
+
+func TestHandlerTokenProtected(t *testing.T) {
+	argoCDSecretToken := argoCDSecret.DeepCopy()
+	argoCDSecretToken.Data["statusbadge.token"] = []byte("s3cret")
+
+	settingsMgr := settings.NewSettingsManager(context.Background(), fake.NewSimpleClientset(&argoCDCm, argoCDSecretToken), "default")
+	handler := NewHandler(appclientset.NewSimpleClientset(&testApp), settingsMgr, "default", nil)
+
+	for url, expected := range map[string]string{
+		"/api/badge?name=testApp":              "Unknown",
+		"/api/badge?name=testApp&token=wrong":  "Unknown",
+		"/api/badge?name=testApp&token=s3cret": "Healthy",
+	} {
+		req, err := http.NewRequest("GET", url, nil)
+		assert.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+
+		assert.Equal(t, expected, leftText1Pattern.FindStringSubmatch(rr.Body.String())[1], url)
+		assert.Equal(t, "private, no-store", rr.Header().Get("Cache-Control"))
+	}
+}
+
+func TestHandlerAnonymousAccess(t *testing.T) {
+	argoCDCmAnonymous := argoCDCm.DeepCopy()
+	delete(argoCDCmAnonymous.Data, "statusbadge.enabled")
+	argoCDCmAnonymous.Data["users.anonymous.enabled"] = "true"
+
+	kubeclientset := fake.NewSimpleClientset(argoCDCmAnonymous, &argoCDSecret)
+	settingsMgr := settings.NewSettingsManager(context.Background(), kubeclientset, "default")
+	enf := rbac.NewEnforcer(kubeclientset, "default", common.ArgoCDRBACConfigMapName, nil)
+	_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+	enf.SetClaimsEnforcerFunc(rbacpolicy.NewRBACPolicyEnforcer(enf, test.NewFakeProjLister()).EnforceClaims)
+	handler := NewHandler(appclientset.NewSimpleClientset(&testApp), settingsMgr, "default", enf)
+
+	req, err := http.NewRequest("GET", "/api/badge?name=testApp", nil)
+	assert.NoError(t, err)
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	assert.Equal(t, "Healthy", leftText1Pattern.FindStringSubmatch(rr.Body.String())[1])
+
+	argoCDCmAnonymous.Data["users.anonymous.role"] = "role:none"
+	_, err = kubeclientset.CoreV1().ConfigMaps("default").Update(argoCDCmAnonymous)
+	assert.NoError(t, err)
+	settingsMgr = settings.NewSettingsManager(context.Background(), kubeclientset, "default")
+	handler = NewHandler(appclientset.NewSimpleClientset(&testApp), settingsMgr, "default", enf)
+
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	assert.Equal(t, "Unknown", leftText1Pattern.FindStringSubmatch(rr.Body.String())[1])
+}
@@ -10,6 +10,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/argoproj/argo-cd/pkg/apiclient/cluster"
@@ -74,15 +75,49 @@ func (s *Server) getConnectionState(cluster appv1.Cluster, errorMessage string)
 	return connectionState
 }
 
+// enforce returns whether the claims are permitted to perform the action on the cluster, which RBAC policies might
+// refer to by its server address or by one of its labels
+func (s *Server) enforce(claims interface{}, action string, clust *appv1.Cluster) bool {
+	if s.enf.Enforce(claims, rbacpolicy.ResourceClusters, action, clust.Server) {
+		return true
+	}
+	for k, v := range clust.Labels {
+		if s.enf.Enforce(claims, rbacpolicy.ResourceClusters, action, rbacpolicy.ClusterLabelObject(k, v)) {
+			return true
+		}
+	}
+	return false
+}
+
+// enforceErr returns a permission denied error unless the claims are permitted to perform the action on the cluster
+// with the server address. Its labels are only consulted if the cluster exists.
+func (s *Server) enforceErr(ctx context.Context, action string, server string) error {
+	err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceClusters, action, server)
+	if err == nil {
+		return nil
+	}
+	if clust, getErr := s.db.GetCluster(ctx, server); getErr == nil && s.enforce(ctx.Value("claims"), action, clust) {
+		return nil
+	}
+	return err
+}
+
 // List returns list of clusters
 func (s *Server) List(ctx context.Context, q *cluster.ClusterQuery) (*appv1.ClusterList, error) {
+	selector, err := labels.Parse(q.Selector)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid selector '%s': %v", q.Selector, err)
+	}
 	clusterList, err := s.db.ListClusters(ctx)
 	if err != nil {
 		return nil, err
 	}
 	clustersByServer := make(map[string][]appv1.Cluster)
 	for _, clust := range clusterList.Items {
-		if s.enf.Enforce(ctx.Value("claims"), rbacpolicy.ResourceClusters, rbacpolicy.ActionGet, clust.Server) {
+		if !selector.Matches(labels.Set(clust.Labels)) {
+			continue
+		}
+		if s.enforce(ctx.Value("claims"), rbacpolicy.ActionGet, &clust) {
 			clustersByServer[clust.Server] = append(clustersByServer[clust.Server], clust)
 		}
 	}
@@ -119,8 +154,8 @@ func (s *Server) List(ctx context.Context, q *cluster.ClusterQuery) (*appv1.Clus
 
 // Create creates a cluster
 func (s *Server) Create(ctx context.Context, q *cluster.ClusterCreateRequest) (*appv1.Cluster, error) {
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceClusters, rbacpolicy.ActionCreate, q.Cluster.Server); err != nil {
-		return nil, err
+	if !s.enforce(ctx.Value("claims"), rbacpolicy.ActionCreate, q.Cluster) {
+		return nil, s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceClusters, rbacpolicy.ActionCreate, q.Cluster.Server)
 	}
 	c := q.Cluster
 	err := kube.TestConfig(q.Cluster.RESTConfig())
@@ -152,7 +187,7 @@ func (s *Server) Create(ctx context.Context, q *cluster.ClusterCreateRequest) (*
 
 // Get returns a cluster from a query
 func (s *Server) Get(ctx context.Context, q *cluster.ClusterQuery) (*appv1.Cluster, error) {
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceClusters, rbacpolicy.ActionGet, q.Server); err != nil {
+	if err := s.enforceErr(ctx, rbacpolicy.ActionGet, q.Server); err != nil {
 		return nil, err
 	}
 	c, err := s.db.GetCluster(ctx, q.Server)
@@ -168,9 +203,13 @@ func (s *Server) Get(ctx context.Context, q *cluster.ClusterQuery) (*appv1.Clust
 
 // Update updates a cluster
 func (s *Server) Update(ctx context.Context, q *cluster.ClusterUpdateRequest) (*appv1.Cluster, error) {
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceClusters, rbacpolicy.ActionUpdate, q.Cluster.Server); err != nil {
+	if err := s.enforceErr(ctx, rbacpolicy.ActionUpdate, q.Cluster.Server); err != nil {
 		return nil, err
 	}
+	// the cluster must not be relabeled out of the permissions of the claims
+	if !s.enforce(ctx.Value("claims"), rbacpolicy.ActionUpdate, q.Cluster) {
+		return nil, s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceClusters, rbacpolicy.ActionUpdate, q.Cluster.Server)
+	}
 	err := kube.TestConfig(q.Cluster.RESTConfig())
 	if err != nil {
 		return nil, err
@@ -181,7 +220,7 @@ func (s *Server) Update(ctx context.Context, q *cluster.ClusterUpdateRequest) (*
 
 // Delete deletes a cluster by name
 func (s *Server) Delete(ctx context.Context, q *cluster.ClusterQuery) (*cluster.ClusterResponse, error) {
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceClusters, rbacpolicy.ActionDelete, q.Server); err != nil {
+	if err := s.enforceErr(ctx, rbacpolicy.ActionDelete, q.Server); err != nil {
 		return nil, err
 	}
 	err := s.db.DeleteCluster(ctx, q.Server)
@@ -190,7 +229,7 @@ func (s *Server) Delete(ctx context.Context, q *cluster.ClusterQuery) (*cluster.
 
 // RotateAuth rotates the bearer token used for a cluster
 func (s *Server) RotateAuth(ctx context.Context, q *cluster.ClusterQuery) (*cluster.ClusterResponse, error) {
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceClusters, rbacpolicy.ActionUpdate, q.Server); err != nil {
+	if err := s.enforceErr(ctx, rbacpolicy.ActionUpdate, q.Server); err != nil {
 		return nil, err
 	}
 	logCtx := log.WithField("cluster", q.Server)
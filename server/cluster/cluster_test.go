@@ -0,0 +1,73 @@
+package cluster
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/common"
+	clusterpkg "github.com/argoproj/argo-cd/pkg/apiclient/cluster"
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/cache"
+	"github.com/argoproj/argo-cd/util/db"
+	"github.com/argoproj/argo-cd/util/kube/kubetest"
+	"github.com/argoproj/argo-cd/util/rbac"
+	"github.com/argoproj/argo-cd/util/settings"
+)
+
+const testNamespace = "default"
+
+func TestClusterLabels(t *testing.T) {
+	kubeclientset := fake.NewSimpleClientset(&v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      common.ArgoCDConfigMapName,
+			Namespace: testNamespace,
+			Labels:    map[string]string{"app.kubernetes.io/part-of": "argocd"},
+		},
+	}, &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDSecretName, Namespace: testNamespace},
+		Data:       map[string][]byte{"server.secretkey": []byte("test")},
+	})
+	argoDB := db.NewDB(testNamespace, settings.NewSettingsManager(context.Background(), kubeclientset, testNamespace), kubeclientset)
+	clusterCache := cache.NewCache(cache.NewInMemoryCache(time.Hour))
+	for _, clust := range []appv1.Cluster{
+		{Server: "https://prod", Labels: map[string]string{"env": "prod"}},
+		{Server: "https://dev", Labels: map[string]string{"env": "dev"}},
+	} {
+		_, err := argoDB.CreateCluster(context.Background(), &clust)
+		assert.NoError(t, err)
+		assert.NoError(t, clusterCache.SetClusterConnectionState(clust.Server, &appv1.ConnectionState{Status: appv1.ConnectionStatusSuccessful}))
+	}
+
+	enf := rbac.NewEnforcer(kubeclientset, testNamespace, common.ArgoCDRBACConfigMapName, nil)
+	assert.NoError(t, enf.SetUserPolicy("p, alice, clusters, get, label:env=dev, allow\np, admin, clusters, get, https://*, allow"))
+	server := NewServer(argoDB, enf, clusterCache, &kubetest.MockKubectlCmd{})
+	ctx := context.WithValue(context.Background(), "claims", "alice")
+
+	// policies might refer to clusters by label
+	clusters, err := server.List(ctx, &clusterpkg.ClusterQuery{})
+	assert.NoError(t, err)
+	if assert.Len(t, clusters.Items, 1) {
+		assert.Equal(t, "https://dev", clusters.Items[0].Server)
+	}
+	_, err = server.Get(ctx, &clusterpkg.ClusterQuery{Server: "https://dev"})
+	assert.NoError(t, err)
+	_, err = server.Get(ctx, &clusterpkg.ClusterQuery{Server: "https://prod"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	clusters, err = server.List(context.WithValue(context.Background(), "claims", "admin"), &clusterpkg.ClusterQuery{Selector: "env=prod"})
+	assert.NoError(t, err)
+	if assert.Len(t, clusters.Items, 1) {
+		assert.Equal(t, "https://prod", clusters.Items[0].Server)
+	}
+
+	_, err = server.List(ctx, &clusterpkg.ClusterQuery{Selector: "env in ("})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,176 @@
+package debug
+
+import (
+	"net/url"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	debugpkg "github.com/argoproj/argo-cd/pkg/apiclient/debug"
+	"github.com/argoproj/argo-cd/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/server/rbacpolicy"
+	"github.com/argoproj/argo-cd/util"
+	"github.com/argoproj/argo-cd/util/profile"
+	"github.com/argoproj/argo-cd/util/rbac"
+	"github.com/argoproj/argo-cd/util/settings"
+	tlsutil "github.com/argoproj/argo-cd/util/tls"
+)
+
+const (
+	// ComponentApplicationController is the application controller, which is reached at its metrics port
+	ComponentApplicationController = "application-controller"
+	// ComponentRepoServer is the repo server, which is reached using its gRPC API
+	ComponentRepoServer = "repo-server"
+)
+
+// Server provides a Debug service
+type Server struct {
+	enf            *rbac.Enforcer
+	repoClientset  apiclient.Clientset
+	settingsMgr    *settings.SettingsManager
+	controllerAddr string
+	internalCerts  *tlsutil.InternalCerts
+}
+
+// NewServer returns a new instance of the Debug service. The application controller is reached over mutual TLS if the
+// internal certificates are given.
+func NewServer(enf *rbac.Enforcer, repoClientset apiclient.Clientset, settingsMgr *settings.SettingsManager, controllerAddr string, internalCerts *tlsutil.InternalCerts) *Server {
+	return &Server{
+		enf:            enf,
+		repoClientset:  repoClientset,
+		settingsMgr:    settingsMgr,
+		controllerAddr: controllerAddr,
+		internalCerts:  internalCerts,
+	}
+}
+
+// SetProfiling enables or disables serving profiles on the metrics port of a component
+func (s *Server) SetProfiling(ctx context.Context, q *debugpkg.ProfilingRequest) (*debugpkg.ProfilingResponse, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceDebug, rbacpolicy.ActionUpdate, q.Component); err != nil {
+		return nil, err
+	}
+	switch q.Component {
+	case ComponentApplicationController:
+		client, err := s.newControllerClient()
+		if err != nil {
+			return nil, err
+		}
+		enabled, err := client.SetEnabled(q.Enabled)
+		if err != nil {
+			return nil, status.Error(codes.Unavailable, err.Error())
+		}
+		return &debugpkg.ProfilingResponse{Enabled: enabled}, nil
+	case ComponentRepoServer:
+		conn, repoClient, err := s.repoClientset.NewRepoServerClient()
+		if err != nil {
+			return nil, err
+		}
+		defer util.Close(conn)
+		ctx, err = s.withRepoServerProfilerToken(ctx)
+		if err != nil {
+			return nil, err
+		}
+		res, err := repoClient.SetProfiling(ctx, &apiclient.ProfilingRequest{Enabled: q.Enabled})
+		if err != nil {
+			return nil, err
+		}
+		return &debugpkg.ProfilingResponse{Enabled: res.Enabled}, nil
+	default:
+		return nil, unknownComponentError(q.Component)
+	}
+}
+
+// GetProfile returns or dumps a runtime profile of a component
+func (s *Server) GetProfile(ctx context.Context, q *debugpkg.ProfileRequest) (*debugpkg.ProfileResponse, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceDebug, rbacpolicy.ActionGet, q.Component); err != nil {
+		return nil, err
+	}
+	switch q.Component {
+	case ComponentApplicationController:
+		client, err := s.newControllerClient()
+		if err != nil {
+			return nil, err
+		}
+		data, path, err := client.GetProfile(q.Name, q.Dump)
+		if err != nil {
+			return nil, status.Error(codes.Unavailable, err.Error())
+		}
+		return &debugpkg.ProfileResponse{Data: data, Path: path}, nil
+	case ComponentRepoServer:
+		conn, repoClient, err := s.repoClientset.NewRepoServerClient()
+		if err != nil {
+			return nil, err
+		}
+		defer util.Close(conn)
+		ctx, err = s.withRepoServerProfilerToken(ctx)
+		if err != nil {
+			return nil, err
+		}
+		res, err := repoClient.GetProfile(ctx, &apiclient.ProfileRequest{Name: q.Name, Dump: q.Dump})
+		if err != nil {
+			return nil, err
+		}
+		return &debugpkg.ProfileResponse{Data: res.Data, Path: res.Path}, nil
+	default:
+		return nil, unknownComponentError(q.Component)
+	}
+}
+
+// GetLocks returns the held locks of a component. Only the repo server holds locks, of repositories and checkouts.
+func (s *Server) GetLocks(ctx context.Context, q *debugpkg.LocksRequest) (*debugpkg.LocksResponse, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceDebug, rbacpolicy.ActionGet, q.Component); err != nil {
+		return nil, err
+	}
+	if q.Component != ComponentRepoServer {
+		return nil, status.Errorf(codes.InvalidArgument, "component '%s' does not report locks: must be '%s'", q.Component, ComponentRepoServer)
+	}
+	conn, repoClient, err := s.repoClientset.NewRepoServerClient()
+	if err != nil {
+		return nil, err
+	}
+	defer util.Close(conn)
+	res, err := repoClient.GetLocks(ctx, &apiclient.LocksRequest{})
+	if err != nil {
+		return nil, err
+	}
+	locks := &debugpkg.LocksResponse{}
+	for _, lock := range res.Locks {
+		locks.Locks = append(locks.Locks, &debugpkg.Lock{Key: lock.Key, Owner: lock.Owner, HeldSeconds: lock.HeldSeconds, Waiting: lock.Waiting})
+	}
+	return locks, nil
+}
+
+// newControllerClient returns a client of the profiler control endpoints of the application controller, which
+// authenticates using a token derived from the server signature. With internal certificates, the controller serves
+// its metrics port over TLS, so the address is always reached over HTTPS.
+func (s *Server) newControllerClient() (*profile.Client, error) {
+	argoSettings, err := s.settingsMgr.GetSettings()
+	if err != nil {
+		return nil, err
+	}
+	if s.internalCerts == nil {
+		return profile.NewClient(s.controllerAddr, argoSettings.ProfilerToken(), nil), nil
+	}
+	addr, err := url.Parse(s.controllerAddr)
+	if err != nil {
+		return nil, err
+	}
+	addr.Scheme = "https"
+	return profile.NewClient(addr.String(), argoSettings.ProfilerToken(), s.internalCerts.ClientConfig(addr.Hostname())), nil
+}
+
+// withRepoServerProfilerToken returns the context of the profiling requests to the repo server, which carry the token
+// of argocd-secret that is mounted into the repo server
+func (s *Server) withRepoServerProfilerToken(ctx context.Context) (context.Context, error) {
+	argoSettings, err := s.settingsMgr.GetSettings()
+	if err != nil {
+		return nil, err
+	}
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+argoSettings.RepoServerProfilerToken), nil
+}
+
+func unknownComponentError(component string) error {
+	return status.Errorf(codes.InvalidArgument, "unknown component '%s': must be one of '%s' or '%s'", component, ComponentApplicationController, ComponentRepoServer)
+}
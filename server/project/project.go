@@ -25,6 +25,8 @@ import (
 	jwtutil "github.com/argoproj/argo-cd/util/jwt"
 	"github.com/argoproj/argo-cd/util/rbac"
 	"github.com/argoproj/argo-cd/util/session"
+	"github.com/argoproj/argo-cd/util/settings"
+	"github.com/argoproj/argo-cd/util/tokenusage"
 )
 
 const (
@@ -41,12 +43,14 @@ type Server struct {
 	auditLogger   *argo.AuditLogger
 	projectLock   *util.KeyLock
 	sessionMgr    *session.SessionManager
+	settingsMgr   *settings.SettingsManager
+	tokenUsage    *tokenusage.Tracker
 }
 
 // NewServer returns a new instance of the Project service
-func NewServer(ns string, kubeclientset kubernetes.Interface, appclientset appclientset.Interface, enf *rbac.Enforcer, projectLock *util.KeyLock, sessionMgr *session.SessionManager) *Server {
+func NewServer(ns string, kubeclientset kubernetes.Interface, appclientset appclientset.Interface, enf *rbac.Enforcer, projectLock *util.KeyLock, sessionMgr *session.SessionManager, settingsMgr *settings.SettingsManager, tokenUsage *tokenusage.Tracker) *Server {
 	auditLogger := argo.NewAuditLogger(ns, kubeclientset, "argocd-server")
-	return &Server{enf: enf, appclientset: appclientset, kubeclientset: kubeclientset, ns: ns, projectLock: projectLock, auditLogger: auditLogger, sessionMgr: sessionMgr}
+	return &Server{enf: enf, appclientset: appclientset, kubeclientset: kubeclientset, ns: ns, projectLock: projectLock, auditLogger: auditLogger, sessionMgr: sessionMgr, settingsMgr: settingsMgr, tokenUsage: tokenUsage}
 }
 
 // CreateToken creates a new token to access a project
@@ -135,6 +139,36 @@ func (s *Server) DeleteToken(ctx context.Context, q *project.ProjectTokenDeleteR
 	return &project.EmptyResponse{}, nil
 }
 
+// GetTokenUsage returns the usage of the tokens of a project role
+func (s *Server) GetTokenUsage(ctx context.Context, q *project.ProjectTokenUsageQuery) (*project.ProjectTokenUsageList, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceProjects, rbacpolicy.ActionGet, q.Project); err != nil {
+		return nil, err
+	}
+	prj, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Get(q.Project, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if _, _, err := prj.GetRoleByName(q.Role); err != nil {
+		return nil, status.Errorf(codes.NotFound, "project '%s' does not have role '%s'", q.Project, q.Role)
+	}
+	items := make([]*project.ProjectTokenUsage, 0)
+	if s.tokenUsage != nil {
+		for _, usage := range s.tokenUsage.Usage(q.Project, q.Role) {
+			items = append(items, &project.ProjectTokenUsage{
+				Iat:           usage.IssuedAt,
+				Requests:      usage.Requests,
+				Syncs:         usage.Syncs,
+				Rejected:      usage.Rejected,
+				QuotaRequests: usage.QuotaRequests,
+				QuotaSyncs:    usage.QuotaSyncs,
+				QuotaResetAt:  usage.QuotaResetAt.Unix(),
+				LastUsedAt:    usage.LastUsedAt.Unix(),
+			})
+		}
+	}
+	return &project.ProjectTokenUsageList{Items: items}, nil
+}
+
 // Create a new project.
 func (s *Server) Create(ctx context.Context, q *project.ProjectCreateRequest) (*v1alpha1.AppProject, error) {
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceProjects, rbacpolicy.ActionCreate, q.Project.Name); err != nil {
@@ -170,6 +204,42 @@ func (s *Server) Create(ctx context.Context, q *project.ProjectCreateRequest) (*
 	return res, err
 }
 
+// ListTemplates returns the list of project templates whose names the caller is allowed to get projects of
+func (s *Server) ListTemplates(ctx context.Context, q *project.ProjectTemplatesQuery) (*project.ProjectTemplateList, error) {
+	templates, err := s.settingsMgr.GetProjectTemplates()
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*v1alpha1.ProjectTemplate, 0)
+	for i := range templates {
+		if s.enf.Enforce(ctx.Value("claims"), rbacpolicy.ResourceProjects, rbacpolicy.ActionGet, templates[i].Name) {
+			items = append(items, &templates[i])
+		}
+	}
+	return &project.ProjectTemplateList{Items: items}, nil
+}
+
+// CreateFromTemplate creates a new project from a template
+func (s *Server) CreateFromTemplate(ctx context.Context, q *project.ProjectCreateFromTemplateRequest) (*v1alpha1.AppProject, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceProjects, rbacpolicy.ActionCreate, q.Name); err != nil {
+		return nil, err
+	}
+	templates, err := s.settingsMgr.GetProjectTemplates()
+	if err != nil {
+		return nil, err
+	}
+	for i := range templates {
+		if templates[i].Name == q.Template {
+			proj, err := templates[i].Render(q.Name, q.Parameters)
+			if err != nil {
+				return nil, err
+			}
+			return s.Create(ctx, &project.ProjectCreateRequest{Project: proj, Upsert: q.Upsert})
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "project template '%s' does not exist", q.Template)
+}
+
 // List returns list of projects
 func (s *Server) List(ctx context.Context, q *project.ProjectQuery) (*v1alpha1.AppProjectList, error) {
 	list, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).List(metav1.ListOptions{})
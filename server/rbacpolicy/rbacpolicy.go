@@ -1,6 +1,7 @@
 package rbacpolicy
 
 import (
+	"fmt"
 	"strings"
 
 	jwt "github.com/dgrijalva/jwt-go"
@@ -8,8 +9,10 @@ import (
 
 	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	applister "github.com/argoproj/argo-cd/pkg/client/listers/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/groupsync"
 	jwtutil "github.com/argoproj/argo-cd/util/jwt"
 	"github.com/argoproj/argo-cd/util/rbac"
+	"github.com/argoproj/argo-cd/util/session"
 )
 
 const (
@@ -18,26 +21,36 @@ const (
 	ResourceApplications = "applications"
 	ResourceRepositories = "repositories"
 	ResourceCertificates = "certificates"
-
-	ActionGet      = "get"
-	ActionCreate   = "create"
-	ActionUpdate   = "update"
-	ActionDelete   = "delete"
-	ActionSync     = "sync"
-	ActionOverride = "override"
+	ResourceDebug        = "debug"
+	ResourceMaintenance  = "maintenance"
+	ResourceSettings     = "settings"
+
+	ActionGet          = "get"
+	ActionCreate       = "create"
+	ActionUpdate       = "update"
+	ActionDelete       = "delete"
+	ActionSync         = "sync"
+	ActionOverride     = "override"
+	ActionUpdateImages = "update-images"
 )
 
 var (
 	defaultScopes = []string{"groups"}
 )
 
+// ClusterLabelObject returns the object by which RBAC policies refer to the clusters with the label, e.g. 'label:env=prod'
+func ClusterLabelObject(key string, value string) string {
+	return fmt.Sprintf("label:%s=%s", key, value)
+}
+
 // RBACPolicyEnforcer provides an RBAC Claims Enforcer which additionally consults AppProject
 // roles, jwt tokens, and groups. It is backed by a AppProject informer/lister cache and does not
 // make any API calls during enforcement.
 type RBACPolicyEnforcer struct {
-	enf        *rbac.Enforcer
-	projLister applister.AppProjectNamespaceLister
-	scopes     []string
+	enf         *rbac.Enforcer
+	projLister  applister.AppProjectNamespaceLister
+	scopes      []string
+	groupSyncer *groupsync.Syncer
 }
 
 // NewRBACPolicyEnforcer returns a new RBAC Enforcer for the Argo CD API Server
@@ -53,8 +66,19 @@ func (p *RBACPolicyEnforcer) SetScopes(scopes []string) {
 	p.scopes = scopes
 }
 
+// SetGroupSyncer sets the syncer of group memberships from the identity provider, which are consulted in addition
+// to the groups of the token
+func (p *RBACPolicyEnforcer) SetGroupSyncer(groupSyncer *groupsync.Syncer) {
+	p.groupSyncer = groupSyncer
+}
+
 // EnforceClaims is an RBAC claims enforcer specific to the Argo CD API server
 func (p *RBACPolicyEnforcer) EnforceClaims(claims jwt.Claims, rvals ...interface{}) bool {
+	if anonymous, ok := claims.(*session.AnonymousClaims); ok {
+		// the anonymous user is only granted its role, and never project roles
+		vals := append([]interface{}{anonymous.Role}, rvals[1:]...)
+		return anonymous.Role != "" && p.enf.EnforceRuntimePolicy("", vals...)
+	}
 	mapClaims, err := jwtutil.MapClaims(claims)
 	if err != nil {
 		return false
@@ -85,6 +109,7 @@ func (p *RBACPolicyEnforcer) EnforceClaims(claims jwt.Claims, rvals ...interface
 	}
 	// Finally check if any of the user's groups grant them permissions
 	groups := jwtutil.GetScopeValues(mapClaims, scopes)
+	groups = append(groups, p.groupSyncer.GetGroups(mapClaims)...)
 	for _, group := range groups {
 		vals := append([]interface{}{group}, rvals[1:]...)
 		if p.enf.EnforceRuntimePolicy(runtimePolicy, vals...) {
@@ -1,6 +1,8 @@
 package rbacpolicy
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	jwt "github.com/dgrijalva/jwt-go"
@@ -11,7 +13,9 @@ import (
 	"github.com/argoproj/argo-cd/common"
 	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/test"
+	"github.com/argoproj/argo-cd/util/groupsync"
 	"github.com/argoproj/argo-cd/util/rbac"
+	"github.com/argoproj/argo-cd/util/session"
 )
 
 func newFakeProj() *argoappv1.AppProject {
@@ -41,6 +45,54 @@ func newFakeProj() *argoappv1.AppProject {
 	}
 }
 
+func TestEnforceAnonymous(t *testing.T) {
+	kubeclientset := fake.NewSimpleClientset(test.NewFakeConfigMap())
+	projLister := test.NewFakeProjLister(newFakeProj())
+	enf := rbac.NewEnforcer(kubeclientset, test.FakeArgoCDNamespace, common.ArgoCDConfigMapName, nil)
+	_ = enf.SetBuiltinPolicy(`p, role:readonly, applications, get, */*, allow
+p, role:admin, applications, create, */*, allow`)
+	rbacEnf := NewRBACPolicyEnforcer(enf, projLister)
+	enf.SetClaimsEnforcerFunc(rbacEnf.EnforceClaims)
+
+	claims := &session.AnonymousClaims{Role: "role:readonly"}
+	assert.True(t, enf.Enforce(claims, "applications", "get", "my-proj/my-app"))
+	assert.False(t, enf.Enforce(claims, "applications", "create", "my-proj/my-app"))
+	// project roles are never granted to the anonymous user
+	claims = &session.AnonymousClaims{Role: "proj:my-proj:my-role"}
+	assert.False(t, enf.Enforce(claims, "applications", "create", "my-proj/my-app"))
+	assert.False(t, enf.Enforce(&session.AnonymousClaims{}, "applications", "get", "my-proj/my-app"))
+}
+
+func TestEnforceSyncedGroups(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/groups":
+			_, _ = w.Write([]byte(`[{"id": "00g1", "profile": {"name": "my-org:my-team"}}]`))
+		case "/api/v1/groups/00g1/users":
+			_, _ = w.Write([]byte(`[{"profile": {"email": "alice@example.com"}}]`))
+		}
+	}))
+	defer ts.Close()
+
+	kubeclientset := fake.NewSimpleClientset(test.NewFakeConfigMap())
+	projLister := test.NewFakeProjLister(newFakeProj())
+	enf := rbac.NewEnforcer(kubeclientset, test.FakeArgoCDNamespace, common.ArgoCDConfigMapName, nil)
+	_ = enf.SetBuiltinPolicy(`p, my-org:my-team, applications, create, my-proj/*, allow`)
+	rbacEnf := NewRBACPolicyEnforcer(enf, projLister)
+	enf.SetClaimsEnforcerFunc(rbacEnf.EnforceClaims)
+	groupSyncer := groupsync.NewSyncer(func() (*groupsync.Config, error) {
+		return &groupsync.Config{Type: groupsync.ProviderTypeOkta, Okta: &groupsync.OktaConfig{URL: ts.URL, Token: "token"}}, nil
+	})
+	_, err := groupSyncer.Sync()
+	assert.NoError(t, err)
+
+	claims := jwt.MapClaims{"sub": "alice", "email": "alice@example.com"}
+	assert.False(t, enf.Enforce(claims, "applications", "create", "my-proj/my-app"))
+	rbacEnf.SetGroupSyncer(groupSyncer)
+	assert.True(t, enf.Enforce(claims, "applications", "create", "my-proj/my-app"))
+	assert.False(t, enf.Enforce(jwt.MapClaims{"sub": "bob", "email": "bob@example.com"}, "applications", "create", "my-proj/my-app"))
+}
+
 func TestEnforceAllPolicies(t *testing.T) {
 	kubeclientset := fake.NewSimpleClientset(test.NewFakeConfigMap())
 	projLister := test.NewFakeProjLister(newFakeProj())
@@ -3,6 +3,8 @@ package repository
 import (
 	"fmt"
 	"reflect"
+	"sort"
+	"strings"
 
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
@@ -21,8 +23,20 @@ import (
 	"github.com/argoproj/argo-cd/util/repo/factory"
 	"github.com/argoproj/argo-cd/util/repo/metrics"
 	"github.com/argoproj/argo-cd/util/settings"
+	"github.com/argoproj/argo-cd/util/webhook"
 )
 
+// repoHookClient creates and deletes the webhooks of a repository
+type repoHookClient interface {
+	IsGitLab() bool
+	Create(hookURL string, secret string) (string, error)
+	Delete(id string) error
+}
+
+func newRepoHookClient(repoURL string, token string) (repoHookClient, error) {
+	return webhook.NewRepoHookClient(repoURL, token)
+}
+
 // Server provides a Repository service
 type Server struct {
 	db            db.ArgoDB
@@ -30,6 +44,7 @@ type Server struct {
 	enf           *rbac.Enforcer
 	cache         *cache.Cache
 	settings      *settings.SettingsManager
+	newHookClient func(repoURL string, token string) (repoHookClient, error)
 }
 
 // NewServer returns a new instance of the Repository service
@@ -46,6 +61,7 @@ func NewServer(
 		enf:           enf,
 		cache:         cache,
 		settings:      settings,
+		newHookClient: newRepoHookClient,
 	}
 }
 
@@ -104,6 +120,35 @@ func (s *Server) List(ctx context.Context, q *repositorypkg.RepoQuery) (*appsv1.
 	return &appsv1.RepositoryList{Items: items}, nil
 }
 
+// ListFailures returns the most recent failed requests of the repo server to repositories
+func (s *Server) ListFailures(ctx context.Context, q *repositorypkg.RepoQuery) (*appsv1.RepositoryFailureList, error) {
+	repos, err := s.db.ListRepositories(ctx)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]appsv1.RepositoryFailure, 0)
+	for _, repo := range repos {
+		if q.Repo != "" && q.Repo != repo.Repo {
+			continue
+		}
+		if !s.enf.Enforce(ctx.Value("claims"), rbacpolicy.ResourceRepositories, rbacpolicy.ActionGet, repo.Repo) {
+			continue
+		}
+		failure, err := s.cache.GetRepoFailure(repo.Repo)
+		if err != nil {
+			if err != cache.ErrCacheMiss {
+				log.Warnf("repository failure cache get error %s: %v", repo.Repo, err)
+			}
+			continue
+		}
+		items = append(items, *failure)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[j].FailedAt.Before(&items[i].FailedAt)
+	})
+	return &appsv1.RepositoryFailureList{Items: items}, nil
+}
+
 // ListApps returns list of apps in the repo
 func (s *Server) ListApps(ctx context.Context, q *repositorypkg.RepoAppsQuery) (*repositorypkg.RepoAppsResponse, error) {
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceRepositories, rbacpolicy.ActionGet, q.Repo); err != nil {
@@ -192,6 +237,15 @@ func (s *Server) Create(ctx context.Context, q *repositorypkg.RepoCreateRequest)
 
 	r := q.Repo
 	r.ConnectionState = appsv1.ConnectionState{Status: appsv1.ConnectionStatusSuccessful}
+	r.WebhookID = ""
+	var hook *webhookRequest
+	if q.CreateWebhook {
+		// the webhook is only created once the repository is saved, but requests which cannot succeed fail early
+		hook, err = s.newWebhookRequest(r)
+		if err != nil {
+			return nil, err
+		}
+	}
 	repo, err := s.db.CreateRepository(ctx, r)
 	if status.Convert(err).Code() == codes.AlreadyExists {
 		// act idempotent if existing spec matches new spec
@@ -200,17 +254,29 @@ func (s *Server) Create(ctx context.Context, q *repositorypkg.RepoCreateRequest)
 			return nil, status.Errorf(codes.Internal, "unable to check existing repository details: %v", getErr)
 		}
 
-		// repository ConnectionState may differ, so make consistent before testing
+		// repository ConnectionState may differ, and the webhook of the existing repository is kept
 		existing.ConnectionState = r.ConnectionState
-		if reflect.DeepEqual(existing, r) {
+		r.WebhookID = existing.WebhookID
+		if existing.WebhookID != "" {
+			hook = nil
+		}
+		if reflect.DeepEqual(existing, r) && hook == nil {
 			repo, err = existing, nil
 		} else if q.Upsert {
-			return s.Update(ctx, &repositorypkg.RepoUpdateRequest{Repo: r})
+			repo, err = s.Update(ctx, &repositorypkg.RepoUpdateRequest{Repo: r})
+		} else if hook != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "existing repository has no webhook or its spec is different; use upsert flag to force update")
 		} else {
 			return nil, status.Errorf(codes.InvalidArgument, "existing repository spec is different; use upsert flag to force update")
 		}
 	}
-	return &appsv1.Repository{Repo: repo.Repo, Type: repo.Type, Name: repo.Name}, err
+	if err == nil && hook != nil {
+		err = s.createWebhook(ctx, r, hook)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &appsv1.Repository{Repo: repo.Repo, Type: repo.Type, Name: repo.Name}, nil
 }
 
 // Update updates a repository
@@ -233,10 +299,78 @@ func (s *Server) Delete(ctx context.Context, q *repositorypkg.RepoQuery) (*repos
 		log.Errorf("error invalidating cache: %v", err)
 	}
 
-	err := s.db.DeleteRepository(ctx, q.Repo)
+	repo, err := s.db.GetRepository(ctx, q.Repo)
+	if err != nil {
+		return nil, err
+	}
+	err = s.db.DeleteRepository(ctx, q.Repo)
+	if err == nil && repo.WebhookID != "" {
+		s.deleteWebhook(repo)
+	}
 	return &repositorypkg.RepoResponse{}, err
 }
 
+// webhookRequest is a webhook which is about to be created in a repository
+type webhookRequest struct {
+	hooks  repoHookClient
+	url    string
+	secret string
+}
+
+// newWebhookRequest returns the webhook which delivers the push events of the repository to the webhook endpoint of
+// the API server
+func (s *Server) newWebhookRequest(repo *appsv1.Repository) (*webhookRequest, error) {
+	if repo.Password == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "creating a webhook requires an API token as password of the repository")
+	}
+	argoSettings, err := s.settings.GetSettings()
+	if err != nil {
+		return nil, err
+	}
+	if argoSettings.URL == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "creating a webhook requires the 'url' setting of Argo CD")
+	}
+	hooks, err := s.newHookClient(repo.Repo, repo.Password)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	secret := argoSettings.WebhookGitHubSecret
+	if hooks.IsGitLab() {
+		secret = argoSettings.WebhookGitLabSecret
+	}
+	return &webhookRequest{hooks: hooks, url: strings.TrimSuffix(argoSettings.URL, "/") + "/api/webhook", secret: secret}, nil
+}
+
+// createWebhook creates the webhook in the saved repository and stores its ID. The webhook is deleted again if its ID
+// cannot be stored.
+func (s *Server) createWebhook(ctx context.Context, repo *appsv1.Repository, hook *webhookRequest) error {
+	id, err := hook.hooks.Create(hook.url, hook.secret)
+	if err != nil {
+		return status.Errorf(codes.Internal, "repository '%s' was saved, but failed to create webhook: %v", repo.Repo, err)
+	}
+	log.Infof("Created webhook %s in repository '%s'", id, repo.Repo)
+	repo.WebhookID = id
+	if _, err := s.db.UpdateRepository(ctx, repo); err != nil {
+		s.deleteWebhook(repo)
+		return err
+	}
+	return nil
+}
+
+// deleteWebhook deletes the webhook which Argo CD created in the repository. Failures are only logged, since the
+// webhook may have been deleted at the provider already.
+func (s *Server) deleteWebhook(repo *appsv1.Repository) {
+	hooks, err := s.newHookClient(repo.Repo, repo.Password)
+	if err == nil {
+		err = hooks.Delete(repo.WebhookID)
+	}
+	if err != nil {
+		log.Warnf("Failed to delete webhook %s of repository '%s': %v", repo.WebhookID, repo.Repo, err)
+		return
+	}
+	log.Infof("Deleted webhook %s of repository '%s'", repo.WebhookID, repo.Repo)
+}
+
 // ValidateAccess checks whether access to a repository is possible with the
 // given URL and credentials.
 func (s *Server) ValidateAccess(ctx context.Context, q *repositorypkg.RepoAccessQuery) (*repositorypkg.RepoResponse, error) {
@@ -0,0 +1,184 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/common"
+	repositorypkg "github.com/argoproj/argo-cd/pkg/apiclient/repository"
+	appsv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/cache"
+	"github.com/argoproj/argo-cd/util/db"
+	"github.com/argoproj/argo-cd/util/rbac"
+	"github.com/argoproj/argo-cd/util/settings"
+)
+
+const (
+	testNamespace = "default"
+	testRepo      = "https://github.com/argoproj/argocd-example-apps"
+)
+
+// fakeHookClient records the webhooks which are created and deleted
+type fakeHookClient struct {
+	created   []string
+	deleted   []string
+	createErr error
+}
+
+func (c *fakeHookClient) IsGitLab() bool {
+	return false
+}
+
+func (c *fakeHookClient) Create(hookURL string, secret string) (string, error) {
+	if c.createErr != nil {
+		return "", c.createErr
+	}
+	id := fmt.Sprintf("%d", len(c.created)+1)
+	c.created = append(c.created, hookURL)
+	return id, nil
+}
+
+func (c *fakeHookClient) Delete(id string) error {
+	c.deleted = append(c.deleted, id)
+	return nil
+}
+
+type testServer struct {
+	*Server
+	db          db.ArgoDB
+	settingsMgr *settings.SettingsManager
+	hooks       *fakeHookClient
+}
+
+// getRepository returns the saved repository, once the informers have seen the changes of the server
+func (s *testServer) getRepository(t *testing.T) *appsv1.Repository {
+	assert.NoError(t, s.settingsMgr.ResyncInformers())
+	repo, err := s.db.GetRepository(context.Background(), testRepo)
+	assert.NoError(t, err)
+	return repo
+}
+
+func newTestServer(t *testing.T) *testServer {
+	kubeclientset := fake.NewSimpleClientset(&v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      common.ArgoCDConfigMapName,
+			Namespace: testNamespace,
+			Labels:    map[string]string{"app.kubernetes.io/part-of": "argocd"},
+		},
+		Data: map[string]string{"url": "https://argocd.example.com/"},
+	}, &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      common.ArgoCDSecretName,
+			Namespace: testNamespace,
+			Labels:    map[string]string{"app.kubernetes.io/part-of": "argocd"},
+		},
+		Data: map[string][]byte{"admin.password": []byte("test"), "server.secretkey": []byte("test"), "webhook.github.secret": []byte("secret")},
+	})
+	settingsMgr := settings.NewSettingsManager(context.Background(), kubeclientset, testNamespace)
+	argoDB := db.NewDB(testNamespace, settingsMgr, kubeclientset)
+	enf := rbac.NewEnforcer(kubeclientset, testNamespace, common.ArgoCDRBACConfigMapName, nil)
+	assert.NoError(t, enf.SetUserPolicy("p, admin, repositories, create, *, allow\np, admin, repositories, update, *, allow\np, admin, repositories, delete, *, allow"))
+	server := NewServer(nil, argoDB, enf, cache.NewCache(cache.NewInMemoryCache(time.Hour)), settingsMgr)
+	hooks := &fakeHookClient{}
+	server.newHookClient = func(repoURL string, token string) (repoHookClient, error) {
+		return hooks, nil
+	}
+	return &testServer{Server: server, db: argoDB, settingsMgr: settingsMgr, hooks: hooks}
+}
+
+func TestCreate_Webhook(t *testing.T) {
+	server := newTestServer(t)
+	ctx := context.WithValue(context.Background(), "claims", "admin")
+
+	_, err := server.Create(ctx, &repositorypkg.RepoCreateRequest{
+		Repo:          &appsv1.Repository{Repo: testRepo, Username: "user", Password: "token"},
+		CreateWebhook: true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"https://argocd.example.com/api/webhook"}, server.hooks.created)
+	assert.Equal(t, "1", server.getRepository(t).WebhookID)
+
+	// the webhook is deleted along with the repository
+	_, err = server.Delete(ctx, &repositorypkg.RepoQuery{Repo: testRepo})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1"}, server.hooks.deleted)
+}
+
+func TestCreate_WebhookRequiresToken(t *testing.T) {
+	server := newTestServer(t)
+	ctx := context.WithValue(context.Background(), "claims", "admin")
+
+	_, err := server.Create(ctx, &repositorypkg.RepoCreateRequest{
+		Repo:          &appsv1.Repository{Repo: testRepo},
+		CreateWebhook: true,
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Empty(t, server.hooks.created)
+	assert.NoError(t, server.settingsMgr.ResyncInformers())
+	repos, err := server.db.ListRepositories(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, repos)
+}
+
+func TestCreate_UpsertWebhook(t *testing.T) {
+	server := newTestServer(t)
+	ctx := context.WithValue(context.Background(), "claims", "admin")
+	_, err := server.Create(ctx, &repositorypkg.RepoCreateRequest{
+		Repo: &appsv1.Repository{Repo: testRepo, Username: "user", Password: "token"},
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, server.settingsMgr.ResyncInformers())
+
+	// the webhook of an existing repository is only created on upsert
+	_, err = server.Create(ctx, &repositorypkg.RepoCreateRequest{
+		Repo:          &appsv1.Repository{Repo: testRepo, Username: "user", Password: "token"},
+		CreateWebhook: true,
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Empty(t, server.hooks.created)
+
+	_, err = server.Create(ctx, &repositorypkg.RepoCreateRequest{
+		Repo:          &appsv1.Repository{Repo: testRepo, Username: "user", Password: "new-token"},
+		Upsert:        true,
+		CreateWebhook: true,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, server.hooks.created, 1)
+	assert.Empty(t, server.hooks.deleted)
+	repo := server.getRepository(t)
+	assert.Equal(t, "1", repo.WebhookID)
+	assert.Equal(t, "new-token", repo.Password)
+
+	// the existing webhook is kept
+	_, err = server.Create(ctx, &repositorypkg.RepoCreateRequest{
+		Repo:          &appsv1.Repository{Repo: testRepo, Username: "user", Password: "new-token"},
+		Upsert:        true,
+		CreateWebhook: true,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, server.hooks.created, 1)
+	assert.Equal(t, "1", server.getRepository(t).WebhookID)
+}
+
+func TestCreate_WebhookFailure(t *testing.T) {
+	server := newTestServer(t)
+	server.hooks.createErr = fmt.Errorf("forbidden")
+	ctx := context.WithValue(context.Background(), "claims", "admin")
+
+	_, err := server.Create(ctx, &repositorypkg.RepoCreateRequest{
+		Repo:          &appsv1.Repository{Repo: testRepo, Username: "user", Password: "token"},
+		CreateWebhook: true,
+	})
+	assert.Equal(t, codes.Internal, status.Code(err))
+	assert.Contains(t, err.Error(), "was saved, but failed to create webhook")
+	assert.Empty(t, server.getRepository(t).WebhookID)
+}
@@ -13,6 +13,7 @@ import (
 	"path"
 	"regexp"
 	"strings"
+	"sync"
 	"time"
 
 	"gopkg.in/yaml.v2"
@@ -26,6 +27,7 @@ import (
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/improbable-eng/grpc-web/go/grpcweb"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	log "github.com/sirupsen/logrus"
 	"github.com/soheilhy/cmux"
@@ -34,6 +36,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -49,6 +52,7 @@ import (
 	applicationpkg "github.com/argoproj/argo-cd/pkg/apiclient/application"
 	certificatepkg "github.com/argoproj/argo-cd/pkg/apiclient/certificate"
 	clusterpkg "github.com/argoproj/argo-cd/pkg/apiclient/cluster"
+	debugpkg "github.com/argoproj/argo-cd/pkg/apiclient/debug"
 	projectpkg "github.com/argoproj/argo-cd/pkg/apiclient/project"
 	repositorypkg "github.com/argoproj/argo-cd/pkg/apiclient/repository"
 	sessionpkg "github.com/argoproj/argo-cd/pkg/apiclient/session"
@@ -59,10 +63,12 @@ import (
 	appinformer "github.com/argoproj/argo-cd/pkg/client/informers/externalversions"
 	repoapiclient "github.com/argoproj/argo-cd/reposerver/apiclient"
 	"github.com/argoproj/argo-cd/server/account"
+	"github.com/argoproj/argo-cd/server/admission"
 	"github.com/argoproj/argo-cd/server/application"
 	"github.com/argoproj/argo-cd/server/badge"
 	"github.com/argoproj/argo-cd/server/certificate"
 	"github.com/argoproj/argo-cd/server/cluster"
+	"github.com/argoproj/argo-cd/server/debug"
 	"github.com/argoproj/argo-cd/server/project"
 	"github.com/argoproj/argo-cd/server/rbacpolicy"
 	"github.com/argoproj/argo-cd/server/repository"
@@ -73,8 +79,8 @@ import (
 	"github.com/argoproj/argo-cd/util/assets"
 	argocache "github.com/argoproj/argo-cd/util/cache"
 	"github.com/argoproj/argo-cd/util/db"
-	"github.com/argoproj/argo-cd/util/dex"
 	dexutil "github.com/argoproj/argo-cd/util/dex"
+	"github.com/argoproj/argo-cd/util/groupsync"
 	grpc_util "github.com/argoproj/argo-cd/util/grpc"
 	"github.com/argoproj/argo-cd/util/healthz"
 	httputil "github.com/argoproj/argo-cd/util/http"
@@ -87,12 +93,13 @@ import (
 	settings_util "github.com/argoproj/argo-cd/util/settings"
 	"github.com/argoproj/argo-cd/util/swagger"
 	tlsutil "github.com/argoproj/argo-cd/util/tls"
+	"github.com/argoproj/argo-cd/util/tokenusage"
 	"github.com/argoproj/argo-cd/util/webhook"
 )
 
 var (
 	// ErrNoSession indicates no auth token was supplied as part of a request
-	ErrNoSession = status.Errorf(codes.Unauthenticated, "no session information")
+	ErrNoSession = grpc_util.NewError(codes.Unauthenticated, grpc_util.ErrorReasonAuthFailed, "no session information")
 )
 
 var noCacheHeaders = map[string]string{
@@ -125,7 +132,15 @@ type ArgoCDServer struct {
 	settingsMgr    *settings_util.SettingsManager
 	enf            *rbac.Enforcer
 	projInformer   cache.SharedIndexInformer
+	appInformer    cache.SharedIndexInformer
 	policyEnforcer *rbacpolicy.RBACPolicyEnforcer
+	groupSyncer    *groupsync.Syncer
+	tokenUsage     *tokenusage.Tracker
+	webhookHandler *webhook.ArgoCDWebhookHandler
+
+	// certificate is the TLS certificate of the settings, which is read by the handshakes while the settings are watched
+	certificate     *tls.Certificate
+	certificateLock sync.RWMutex
 
 	// stopCh is the channel which when closed, will shutdown the Argo CD server
 	stopCh chan struct{}
@@ -136,8 +151,10 @@ type ArgoCDServerOpts struct {
 	Insecure            bool
 	ListenPort          int
 	MetricsPort         int
+	AdmissionPort       int
 	Namespace           string
 	DexServerAddr       string
+	AppControllerAddr   string
 	StaticAssetsDir     string
 	BaseHRef            string
 	KubeClientset       kubernetes.Interface
@@ -145,6 +162,44 @@ type ArgoCDServerOpts struct {
 	RepoClientset       repoapiclient.Clientset
 	Cache               *argocache.Cache
 	TLSConfigCustomizer tlsutil.ConfigCustomizer
+	// InternalCerts secure the connection to the application controller with mutual TLS, if they are not nil
+	InternalCerts *tlsutil.InternalCerts
+	// HSTSMaxAge is the max-age of the Strict-Transport-Security header of HTTPS responses. No header if zero
+	HSTSMaxAge time.Duration
+	// HSTSIncludeSubdomains extends the Strict-Transport-Security header to the subdomains of the host
+	HSTSIncludeSubdomains bool
+	// RateLimit limits the requests of each user. Requests are not limited if neither rate nor concurrency are set
+	RateLimit grpc_util.RateLimiterOptions
+}
+
+// expensiveMethods are the methods which render manifests or start operations, and count towards the concurrent
+// requests which a user may have in flight
+var expensiveMethods = []string{
+	"/application.ApplicationService/Sync",
+	"/application.ApplicationService/Rollback",
+	"/application.ApplicationService/GetManifests",
+	"/application.ApplicationService/Create",
+	"/application.ApplicationService/Update",
+	"/application.ApplicationService/UpdateSpec",
+	"/application.ApplicationService/BulkSync",
+	"/application.ApplicationService/BulkDelete",
+	"/repository.RepositoryService/ListApps",
+	"/repository.RepositoryService/GetAppDetails",
+}
+
+// rateLimitIdentity returns the subject of the token of a request. Anonymous requests are limited by the address of
+// their client, which is the API server itself for requests through the gateway.
+func rateLimitIdentity(ctx context.Context) string {
+	if sub := util_session.Sub(ctx); sub != "" {
+		return sub
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		if host, _, err := net.SplitHostPort(p.Addr.String()); err == nil {
+			return host
+		}
+		return p.Addr.String()
+	}
+	return ""
 }
 
 // initializeDefaultProject creates the default project if it does not already exist
@@ -177,6 +232,9 @@ func NewServer(ctx context.Context, opts ArgoCDServerOpts) *ArgoCDServer {
 	factory := appinformer.NewFilteredSharedInformerFactory(opts.AppClientset, 0, opts.Namespace, func(options *metav1.ListOptions) {})
 	projInformer := factory.Argoproj().V1alpha1().AppProjects().Informer()
 	projLister := factory.Argoproj().V1alpha1().AppProjects().Lister().AppProjects(opts.Namespace)
+	appInformer := factory.Argoproj().V1alpha1().Applications().Informer()
+	err = appInformer.AddIndexers(application.ListIndexers)
+	errors.CheckError(err)
 
 	enf := rbac.NewEnforcer(opts.KubeClientset, opts.Namespace, common.ArgoCDRBACConfigMapName, nil)
 	enf.EnableEnforce(!opts.DisableAuth)
@@ -186,16 +244,23 @@ func NewServer(ctx context.Context, opts ArgoCDServerOpts) *ArgoCDServer {
 
 	policyEnf := rbacpolicy.NewRBACPolicyEnforcer(enf, projLister)
 	enf.SetClaimsEnforcerFunc(policyEnf.EnforceClaims)
+	groupSyncer := groupsync.NewSyncer(settingsMgr.GetGroupSyncConfig)
+	policyEnf.SetGroupSyncer(groupSyncer)
 
 	return &ArgoCDServer{
 		ArgoCDServerOpts: opts,
 		log:              log.NewEntry(log.StandardLogger()),
 		settings:         settings,
+		certificate:      settings.Certificate,
 		sessionMgr:       sessionMgr,
 		settingsMgr:      settingsMgr,
 		enf:              enf,
 		projInformer:     projInformer,
+		appInformer:      appInformer,
 		policyEnforcer:   policyEnf,
+		groupSyncer:      groupSyncer,
+		tokenUsage:       tokenusage.NewTracker(projLister),
+		webhookHandler:   webhook.NewHandler(opts.Namespace, opts.AppClientset, settings),
 	}
 }
 
@@ -214,7 +279,7 @@ func (a *ArgoCDServer) Run(ctx context.Context, port int, metricsPort int) {
 	} else {
 		httpS = a.newHTTPServer(ctx, port, grpcWebS)
 	}
-	metricsServ := newAPIServerMetricsServer(metricsPort)
+	metricsServ := newAPIServerMetricsServer(metricsPort, a.tokenUsage, a.webhookHandler)
 
 	// Start listener
 	var conn net.Listener
@@ -244,9 +309,7 @@ func (a *ArgoCDServer) Run(ctx context.Context, port int, metricsPort int) {
 
 		// If not matched, we assume that its TLS.
 		tlsl := tcpm.Match(cmux.Any())
-		tlsConfig := tls.Config{
-			Certificates: []tls.Certificate{*a.settings.Certificate},
-		}
+		tlsConfig := tls.Config{GetCertificate: a.getCertificate}
 		if a.TLSConfigCustomizer != nil {
 			a.TLSConfigCustomizer(&tlsConfig)
 		}
@@ -263,6 +326,7 @@ func (a *ArgoCDServer) Run(ctx context.Context, port int, metricsPort int) {
 		common.GetVersion(), port, a.settings.URL, a.useTLS(), a.Namespace, a.settings.IsSSOConfigured())
 
 	go a.projInformer.Run(ctx.Done())
+	go a.appInformer.Run(ctx.Done())
 	go func() { a.checkServeErr("grpcS", grpcS.Serve(grpcL)) }()
 	go func() { a.checkServeErr("httpS", httpS.Serve(httpL)) }()
 	if a.useTLS() {
@@ -271,10 +335,15 @@ func (a *ArgoCDServer) Run(ctx context.Context, port int, metricsPort int) {
 	}
 	go a.watchSettings()
 	go a.rbacPolicyLoader(ctx)
+	go a.groupSyncer.Run(ctx)
 	go func() { a.checkServeErr("tcpm", tcpm.Serve()) }()
 	go func() { a.checkServeErr("metrics", metricsServ.ListenAndServe()) }()
-	if !cache.WaitForCacheSync(ctx.Done(), a.projInformer.HasSynced) {
-		log.Fatal("Timed out waiting for project cache to sync")
+	if a.AdmissionPort > 0 {
+		admissionServ := a.newAdmissionServer(a.AdmissionPort)
+		go func() { a.checkServeErr("admission", admissionServ.ListenAndServeTLS("", "")) }()
+	}
+	if !cache.WaitForCacheSync(ctx.Done(), a.projInformer.HasSynced, a.appInformer.HasSynced) {
+		log.Fatal("Timed out waiting for project and application caches to sync")
 	}
 
 	a.stopCh = make(chan struct{})
@@ -314,13 +383,15 @@ func (a *ArgoCDServer) watchSettings() {
 
 	prevURL := a.settings.URL
 	prevOIDCConfig := a.settings.OIDCConfigRAW
-	prevDexCfgBytes, err := dex.GenerateDexConfigYAML(a.settings)
-	errors.CheckError(err)
+	prevDexConfigured := a.settings.IsDexConfigured()
+	prevDexClientSecret := a.settings.DexOAuth2ClientSecret()
 	prevGitHubSecret := a.settings.WebhookGitHubSecret
 	prevGitLabSecret := a.settings.WebhookGitLabSecret
 	prevBitbucketUUID := a.settings.WebhookBitbucketUUID
 	prevBitbucketServerSecret := a.settings.WebhookBitbucketServerSecret
 	prevGogsSecret := a.settings.WebhookGogsSecret
+	prevWebhookRequireSecret := a.settings.WebhookRequireSecret
+	prevWebhookReplayWindow := a.settings.WebhookReplayWindow
 	var prevCert, prevCertKey string
 	if a.settings.Certificate != nil && !a.ArgoCDServerOpts.Insecure {
 		prevCert, prevCertKey = tlsutil.EncodeX509KeyPairString(*a.settings.Certificate)
@@ -329,12 +400,17 @@ func (a *ArgoCDServer) watchSettings() {
 	for {
 		newSettings := <-updateCh
 		a.settings = newSettings
-		newDexCfgBytes, err := dex.GenerateDexConfigYAML(a.settings)
-		errors.CheckError(err)
-		if string(newDexCfgBytes) != string(prevDexCfgBytes) {
+		a.setCertificate(newSettings.Certificate)
+		// changes to the dex connectors are picked up by dex itself, and only require a restart if dex was
+		// enabled, disabled, or its client secret changed
+		if prevDexConfigured != a.settings.IsDexConfigured() {
 			log.Infof("dex config modified. restarting")
 			break
 		}
+		if prevDexClientSecret != a.settings.DexOAuth2ClientSecret() {
+			log.Infof("dex client secret modified. restarting")
+			break
+		}
 		if prevOIDCConfig != a.settings.OIDCConfigRAW {
 			log.Infof("odic config modified. restarting")
 			break
@@ -363,14 +439,27 @@ func (a *ArgoCDServer) watchSettings() {
 			log.Infof("gogs secret modified. restarting")
 			break
 		}
+		if prevWebhookRequireSecret != a.settings.WebhookRequireSecret {
+			log.Infof("webhook secret requirement modified. restarting")
+			break
+		}
+		if prevWebhookReplayWindow != a.settings.WebhookReplayWindow {
+			log.Infof("webhook replay window modified. restarting")
+			break
+		}
 		if !a.ArgoCDServerOpts.Insecure {
 			var newCert, newCertKey string
 			if a.settings.Certificate != nil {
 				newCert, newCertKey = tlsutil.EncodeX509KeyPairString(*a.settings.Certificate)
 			}
 			if newCert != prevCert || newCertKey != prevCertKey {
-				log.Infof("tls certificate modified. restarting")
-				break
+				// a rotated certificate is picked up by the next handshake, only enabling or disabling TLS requires a restart
+				if prevCert == "" || newCert == "" {
+					log.Infof("tls certificate modified. restarting")
+					break
+				}
+				log.Infof("tls certificate rotated")
+				prevCert, prevCertKey = newCert, newCertKey
 			}
 		}
 	}
@@ -424,29 +513,43 @@ func (a *ArgoCDServer) newGRPCServer() *grpc.Server {
 	}
 	// NOTE: notice we do not configure the gRPC server here with TLS (e.g. grpc.Creds(creds))
 	// This is because TLS handshaking occurs in cmux handling
-	sOpts = append(sOpts, grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
+	streamInterceptors := []grpc.StreamServerInterceptor{
 		grpc_logrus.StreamServerInterceptor(a.log),
 		grpc_prometheus.StreamServerInterceptor,
 		grpc_auth.StreamServerInterceptor(a.Authenticate),
 		grpc_util.UserAgentStreamServerInterceptor(common.ArgoCDUserAgentName, clientConstraint),
-		grpc_util.PayloadStreamServerInterceptor(a.log, true, func(ctx netCtx.Context, fullMethodName string, servingObject interface{}) bool {
-			return !sensitiveMethods[fullMethodName]
-		}),
-		grpc_util.ErrorCodeStreamServerInterceptor(),
-		grpc_util.PanicLoggerStreamServerInterceptor(a.log),
-	)))
-	sOpts = append(sOpts, grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
+		a.tokenUsage.StreamServerInterceptor(),
+	}
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
 		bug21955WorkaroundInterceptor,
 		grpc_logrus.UnaryServerInterceptor(a.log),
 		grpc_prometheus.UnaryServerInterceptor,
 		grpc_auth.UnaryServerInterceptor(a.Authenticate),
 		grpc_util.UserAgentUnaryServerInterceptor(common.ArgoCDUserAgentName, clientConstraint),
+		a.tokenUsage.UnaryServerInterceptor(),
+	}
+	if a.RateLimit.RequestsPerSecond > 0 || a.RateLimit.MaxConcurrent > 0 {
+		// the limits apply to the authenticated caller, so the rate limiter comes after the authentication
+		rateLimitOpts := a.RateLimit
+		rateLimitOpts.ExpensiveMethods = expensiveMethods
+		rateLimiter := grpc_util.NewRateLimiter(rateLimitOpts, rateLimitIdentity)
+		streamInterceptors = append(streamInterceptors, rateLimiter.StreamServerInterceptor())
+		unaryInterceptors = append(unaryInterceptors, rateLimiter.UnaryServerInterceptor())
+	}
+	sOpts = append(sOpts, grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(append(streamInterceptors,
+		grpc_util.PayloadStreamServerInterceptor(a.log, true, func(ctx netCtx.Context, fullMethodName string, servingObject interface{}) bool {
+			return !sensitiveMethods[fullMethodName]
+		}),
+		grpc_util.ErrorCodeStreamServerInterceptor(),
+		grpc_util.PanicLoggerStreamServerInterceptor(a.log),
+	)...)))
+	sOpts = append(sOpts, grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(append(unaryInterceptors,
 		grpc_util.PayloadUnaryServerInterceptor(a.log, true, func(ctx netCtx.Context, fullMethodName string, servingObject interface{}) bool {
 			return !sensitiveMethods[fullMethodName]
 		}),
 		grpc_util.ErrorCodeUnaryServerInterceptor(),
 		grpc_util.PanicLoggerUnaryServerInterceptor(a.log),
-	)))
+	)...)))
 	grpcS := grpc.NewServer(sOpts...)
 	db := db.NewDB(a.Namespace, a.settingsMgr, a.KubeClientset)
 	kubectl := kube.KubectlCmd{}
@@ -454,12 +557,13 @@ func (a *ArgoCDServer) newGRPCServer() *grpc.Server {
 	repoService := repository.NewServer(a.RepoClientset, db, a.enf, a.Cache, a.settingsMgr)
 	sessionService := session.NewServer(a.sessionMgr, a)
 	projectLock := util.NewKeyLock()
-	applicationService := application.NewServer(a.Namespace, a.KubeClientset, a.AppClientset, a.RepoClientset, a.Cache, kubectl, db, a.enf, projectLock, a.settingsMgr)
-	projectService := project.NewServer(a.Namespace, a.KubeClientset, a.AppClientset, a.enf, projectLock, a.sessionMgr)
-	settingsService := settings.NewServer(a.settingsMgr)
+	applicationService := application.NewServer(a.Namespace, a.KubeClientset, a.AppClientset, a.RepoClientset, a.Cache, kubectl, db, a.enf, projectLock, a.settingsMgr, a.tokenUsage, a.appInformer)
+	projectService := project.NewServer(a.Namespace, a.KubeClientset, a.AppClientset, a.enf, projectLock, a.sessionMgr, a.settingsMgr, a.tokenUsage)
+	settingsService := settings.NewServer(a.settingsMgr, a, a.DexServerAddr, a.groupSyncer, a.enf)
 	accountService := account.NewServer(a.sessionMgr, a.settingsMgr)
 	certificateService := certificate.NewServer(a.RepoClientset, db, a.enf, a.Cache)
-	versionpkg.RegisterVersionServiceServer(grpcS, &version.Server{})
+	debugService := debug.NewServer(a.enf, a.RepoClientset, a.settingsMgr, a.AppControllerAddr, a.InternalCerts)
+	versionpkg.RegisterVersionServiceServer(grpcS, version.NewServer(a.RepoClientset, a.Cache, a.settingsMgr, a))
 	clusterpkg.RegisterClusterServiceServer(grpcS, clusterService)
 	applicationpkg.RegisterApplicationServiceServer(grpcS, applicationService)
 	repositorypkg.RegisterRepositoryServiceServer(grpcS, repoService)
@@ -468,6 +572,7 @@ func (a *ArgoCDServer) newGRPCServer() *grpc.Server {
 	projectpkg.RegisterProjectServiceServer(grpcS, projectService)
 	accountpkg.RegisterAccountServiceServer(grpcS, accountService)
 	certificatepkg.RegisterCertificateServiceServer(grpcS, certificateService)
+	debugpkg.RegisterDebugServiceServer(grpcS, debugService)
 	// Register reflection service on gRPC server.
 	reflection.Register(grpcS)
 	grpc_prometheus.Register(grpcS)
@@ -502,18 +607,22 @@ func (a *ArgoCDServer) translateGrpcCookieHeader(ctx context.Context, w http.Res
 func (a *ArgoCDServer) newHTTPServer(ctx context.Context, port int, grpcWebHandler http.Handler) *http.Server {
 	endpoint := fmt.Sprintf("localhost:%d", port)
 	mux := http.NewServeMux()
-	httpS := http.Server{
-		Addr: endpoint,
-		Handler: &handlerSwitcher{
-			handler: &bug21955Workaround{handler: mux},
-			urlToHandler: map[string]http.Handler{
-				"/api/badge": badge.NewHandler(a.AppClientset, a.settingsMgr, a.Namespace),
-			},
-			contentTypeToHandler: map[string]http.Handler{
-				"application/grpc-web+proto": grpcWebHandler,
-			},
+	var handler http.Handler = &handlerSwitcher{
+		handler: &bug21955Workaround{handler: mux},
+		urlToHandler: map[string]http.Handler{
+			"/api/badge": badge.NewHandler(a.AppClientset, a.settingsMgr, a.Namespace, a.enf),
+		},
+		contentTypeToHandler: map[string]http.Handler{
+			"application/grpc-web+proto": grpcWebHandler,
 		},
 	}
+	if a.useTLS() && a.HSTSMaxAge > 0 {
+		handler = &hstsHandler{handler: handler, maxAge: a.HSTSMaxAge, includeSubdomains: a.HSTSIncludeSubdomains}
+	}
+	httpS := http.Server{
+		Addr:    endpoint,
+		Handler: handler,
+	}
 	var dOpts []grpc.DialOption
 	dOpts = append(dOpts, grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(apiclient.MaxGRPCMessageSize)))
 	dOpts = append(dOpts, grpc.WithUserAgent(fmt.Sprintf("%s/%s", common.ArgoCDUserAgentName, common.GetVersion().Version)))
@@ -539,18 +648,14 @@ func (a *ArgoCDServer) newHTTPServer(ctx context.Context, port int, grpcWebHandl
 	// golang/protobuf. Which does not support types such as time.Time. gogo/protobuf does support
 	// time.Time, but does not support custom UnmarshalJSON() and MarshalJSON() methods. Therefore
 	// we use our own Marshaler
+	runtime.HTTPError = grpc_util.HTTPError
 	gwMuxOpts := runtime.WithMarshalerOption(runtime.MIMEWildcard, new(jsonutil.JSONMarshaler))
 	gwCookieOpts := runtime.WithForwardResponseOption(a.translateGrpcCookieHeader)
 	gwmux := runtime.NewServeMux(gwMuxOpts, gwCookieOpts)
 	mux.Handle("/api/", gwmux)
-	mustRegisterGWHandler(versionpkg.RegisterVersionServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dOpts)
-	mustRegisterGWHandler(clusterpkg.RegisterClusterServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dOpts)
-	mustRegisterGWHandler(applicationpkg.RegisterApplicationServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dOpts)
-	mustRegisterGWHandler(repositorypkg.RegisterRepositoryServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dOpts)
-	mustRegisterGWHandler(sessionpkg.RegisterSessionServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dOpts)
-	mustRegisterGWHandler(settingspkg.RegisterSettingsServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dOpts)
-	mustRegisterGWHandler(projectpkg.RegisterProjectServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dOpts)
-	mustRegisterGWHandler(certificatepkg.RegisterCertificateServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dOpts)
+	for _, gwHandler := range gwHandlers {
+		mustRegisterGWHandler(gwHandler.register, ctx, gwmux, endpoint, dOpts)
+	}
 
 	// Swagger UI
 	swagger.ServeSwaggerUI(mux, assets.SwaggerJSON, "/swagger-ui")
@@ -563,8 +668,7 @@ func (a *ArgoCDServer) newHTTPServer(ctx context.Context, port int, grpcWebHandl
 	a.registerDexHandlers(mux)
 
 	// Webhook handler for git events
-	acdWebhookHandler := webhook.NewHandler(a.Namespace, a.AppClientset, a.settings)
-	mux.HandleFunc("/api/webhook", acdWebhookHandler.Handler)
+	mux.HandleFunc("/api/webhook", a.webhookHandler.Handler)
 
 	// Serve cli binaries directly from API server
 	registerDownloadHandlers(mux, "/download")
@@ -658,10 +762,44 @@ func indexFilePath(srcPath string, baseHRef string) (string, error) {
 	return filePath, nil
 }
 
-// newAPIServerMetricsServer returns HTTP server which serves prometheus metrics on gRPC requests
-func newAPIServerMetricsServer(port int) *http.Server {
+// getCertificate returns the certificate of the server. The certificate is looked up for every handshake, so that a
+// rotated certificate is served without a restart.
+func (a *ArgoCDServer) getCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	a.certificateLock.RLock()
+	defer a.certificateLock.RUnlock()
+	return a.certificate, nil
+}
+
+func (a *ArgoCDServer) setCertificate(certificate *tls.Certificate) {
+	a.certificateLock.Lock()
+	defer a.certificateLock.Unlock()
+	a.certificate = certificate
+}
+
+// newAdmissionServer returns the HTTPS server which serves the validating admission webhook. It listens on its own
+// port, which is only meant to be reachable by the Kubernetes API server, rather than on the public port of the API
+// server, since admission reviews are not authenticated.
+func (a *ArgoCDServer) newAdmissionServer(port int) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle("/api/admission", admission.NewHandler(a.AppClientset, db.NewDB(a.Namespace, a.settingsMgr, a.KubeClientset), a.Namespace))
+	tlsConfig := &tls.Config{GetCertificate: a.getCertificate}
+	if a.TLSConfigCustomizer != nil {
+		a.TLSConfigCustomizer(tlsConfig)
+	}
+	return &http.Server{
+		Addr:      fmt.Sprintf("0.0.0.0:%d", port),
+		Handler:   mux,
+		TLSConfig: tlsConfig,
+	}
+}
+
+// newAPIServerMetricsServer returns HTTP server which serves prometheus metrics on gRPC requests, on the usage of
+// project tokens and on rejected webhook events
+func newAPIServerMetricsServer(port int, collectors ...prometheus.Collector) *http.Server {
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(collectors...)
 	mux := http.NewServeMux()
-	mux.Handle("/metrics", promhttp.Handler())
+	mux.Handle("/metrics", promhttp.HandlerFor(prometheus.Gatherers{prometheus.DefaultGatherer, registry}, promhttp.HandlerOpts{}))
 	return &http.Server{
 		Addr:    fmt.Sprintf("0.0.0.0:%d", port),
 		Handler: mux,
@@ -707,6 +845,24 @@ func newStaticAssetsHandler(dir string, baseHRef string) func(http.ResponseWrite
 
 type registerFunc func(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) error
 
+// gwHandlers are the REST gateway handlers of the gRPC services served by the API server, so that every service is
+// reachable by clients which do not speak gRPC
+var gwHandlers = []struct {
+	service  string
+	register registerFunc
+}{
+	{"version.VersionService", versionpkg.RegisterVersionServiceHandlerFromEndpoint},
+	{"cluster.ClusterService", clusterpkg.RegisterClusterServiceHandlerFromEndpoint},
+	{"application.ApplicationService", applicationpkg.RegisterApplicationServiceHandlerFromEndpoint},
+	{"repository.RepositoryService", repositorypkg.RegisterRepositoryServiceHandlerFromEndpoint},
+	{"session.SessionService", sessionpkg.RegisterSessionServiceHandlerFromEndpoint},
+	{"cluster.SettingsService", settingspkg.RegisterSettingsServiceHandlerFromEndpoint},
+	{"project.ProjectService", projectpkg.RegisterProjectServiceHandlerFromEndpoint},
+	{"account.AccountService", accountpkg.RegisterAccountServiceHandlerFromEndpoint},
+	{"certificate.CertificateService", certificatepkg.RegisterCertificateServiceHandlerFromEndpoint},
+	{"debug.DebugService", debugpkg.RegisterDebugServiceHandlerFromEndpoint},
+}
+
 // mustRegisterGWHandler is a convenience function to register a gateway handler
 func mustRegisterGWHandler(register registerFunc, ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) {
 	err := register(ctx, mux, endpoint, opts)
@@ -728,6 +884,7 @@ func (a *ArgoCDServer) Authenticate(ctx context.Context) (context.Context, error
 		if !argoCDSettings.AnonymousUserEnabled {
 			return ctx, claimsErr
 		}
+		ctx = context.WithValue(ctx, "claims", &util_session.AnonymousClaims{Role: argoCDSettings.AnonymousUserRole})
 	} else {
 		// Add claims to the context to inspect for RBAC
 		ctx = context.WithValue(ctx, "claims", claims)
@@ -747,7 +904,7 @@ func (a *ArgoCDServer) getClaims(ctx context.Context) (jwt.Claims, error) {
 	}
 	claims, err := a.sessionMgr.VerifyToken(tokenString)
 	if err != nil {
-		return nil, status.Errorf(codes.Unauthenticated, "invalid session: %v", err)
+		return nil, grpc_util.NewError(codes.Unauthenticated, grpc_util.ErrorReasonAuthFailed, "invalid session: %v", err)
 	}
 	return claims, nil
 }
@@ -807,6 +964,22 @@ func (s *handlerSwitcher) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// hstsHandler adds the Strict-Transport-Security header to responses, which tells browsers to only use HTTPS
+type hstsHandler struct {
+	handler           http.Handler
+	maxAge            time.Duration
+	includeSubdomains bool
+}
+
+func (h *hstsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	value := fmt.Sprintf("max-age=%d", int64(h.maxAge.Seconds()))
+	if h.includeSubdomains {
+		value += "; includeSubDomains"
+	}
+	w.Header().Set("Strict-Transport-Security", value)
+	h.handler.ServeHTTP(w, r)
+}
+
 // Workaround for https://github.com/golang/go/issues/21955 to support escaped URLs in URL path.
 type bug21955Workaround struct {
 	handler http.Handler
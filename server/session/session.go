@@ -38,15 +38,22 @@ func (s *Server) Create(ctx context.Context, q *session.SessionCreateRequest) (*
 	if err != nil {
 		return nil, err
 	}
-	jwtToken, err := s.mgr.Create(q.Username, 0)
+	jwtToken, err := s.mgr.CreateLoginToken(q.Username)
 	if err != nil {
 		return nil, err
 	}
 	return &session.SessionResponse{Token: jwtToken}, nil
 }
 
-// Delete an authentication cookie from the client.  This makes sense only for the Web client.
+// Delete revokes the token of the session, if Argo CD issued it to a local account, and deletes the authentication
+// cookie from the Web client.
 func (s *Server) Delete(ctx context.Context, q *session.SessionDeleteRequest) (*session.SessionResponse, error) {
+	if id := sessionmgr.TokenID(ctx); id != "" && sessionmgr.Iss(ctx) == sessionmgr.SessionManagerClaimsIssuer {
+		err := s.mgr.RevokeAccountToken(sessionmgr.Sub(ctx), id)
+		if err != nil && status.Code(err) != codes.NotFound {
+			return nil, err
+		}
+	}
 	return &session.SessionResponse{Token: ""}, nil
 }
 
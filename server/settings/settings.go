@@ -1,23 +1,46 @@
 package settings
 
 import (
+	"time"
+
 	"github.com/ghodss/yaml"
+	log "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	settingspkg "github.com/argoproj/argo-cd/pkg/apiclient/settings"
 	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/server/rbacpolicy"
+	"github.com/argoproj/argo-cd/util/dex"
+	"github.com/argoproj/argo-cd/util/groupsync"
+	"github.com/argoproj/argo-cd/util/rbac"
+	"github.com/argoproj/argo-cd/util/session"
 	"github.com/argoproj/argo-cd/util/settings"
 )
 
 // Server provides a Settings service
 type Server struct {
-	mgr *settings.SettingsManager
+	mgr           *settings.SettingsManager
+	authenticator Authenticator
+	dexServerAddr string
+	groupSyncer   *groupsync.Syncer
+	enf           *rbac.Enforcer
+}
+
+type Authenticator interface {
+	Authenticate(ctx context.Context) (context.Context, error)
 }
 
 // NewServer returns a new instance of the Settings service
-func NewServer(mgr *settings.SettingsManager) *Server {
+func NewServer(mgr *settings.SettingsManager, authenticator Authenticator, dexServerAddr string, groupSyncer *groupsync.Syncer, enf *rbac.Enforcer) *Server {
 	return &Server{
-		mgr: mgr,
+		mgr:           mgr,
+		authenticator: authenticator,
+		dexServerAddr: dexServerAddr,
+		groupSyncer:   groupSyncer,
+		enf:           enf,
 	}
 }
 
@@ -43,6 +66,15 @@ func (s *Server) Get(ctx context.Context, q *settingspkg.SettingsQuery) (*settin
 	if err != nil {
 		return nil, err
 	}
+	maintenance, err := s.mgr.GetMaintenanceMode()
+	if err != nil {
+		return nil, err
+	}
+	// invalid announcements must not break the login, which needs the rest of the settings
+	announcements, err := s.mgr.GetAnnouncements()
+	if err != nil {
+		log.Warnf("Failed to load announcements: %v", err)
+	}
 
 	overrides := make(map[string]*v1alpha1.ResourceOverride)
 	for k := range resourceOverrides {
@@ -67,6 +99,26 @@ func (s *Server) Get(ctx context.Context, q *settingspkg.SettingsQuery) (*settin
 			ChatText: help.ChatText,
 		},
 	}
+	if maintenance.Enabled {
+		// the settings are available without logging in, so the user who enabled the maintenance mode is not revealed
+		set.Maintenance = &settingspkg.Maintenance{
+			Enabled:   true,
+			Message:   maintenance.Message,
+			EnabledAt: maintenance.EnabledAt,
+		}
+	}
+	now := time.Now()
+	for _, announcement := range announcements {
+		if announcement.IsExpired(now) {
+			continue
+		}
+		set.Announcements = append(set.Announcements, &settingspkg.Announcement{
+			Message:   announcement.Message,
+			Severity:  announcement.Severity,
+			URL:       announcement.URL,
+			ExpiresAt: announcement.ExpiresAt,
+		})
+	}
 	if argoCDSettings.DexConfig != "" {
 		var cfg settingspkg.DexConfig
 		err = yaml.Unmarshal([]byte(argoCDSettings.DexConfig), &cfg)
@@ -89,7 +141,103 @@ func (s *Server) Get(ctx context.Context, q *settingspkg.SettingsQuery) (*settin
 	return &set, nil
 }
 
-// AuthFuncOverride disables authentication for settings service
+// GetDexConnectorsHealth returns the health of each configured dex connector
+func (s *Server) GetDexConnectorsHealth(ctx context.Context, q *settingspkg.DexConnectorsHealthQuery) (*settingspkg.DexConnectorsHealth, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceSettings, rbacpolicy.ActionGet, "*"); err != nil {
+		return nil, err
+	}
+	argoCDSettings, err := s.mgr.GetSettings()
+	if err != nil {
+		return nil, err
+	}
+	connectors, err := dex.GetConnectorsHealth(argoCDSettings, s.dexServerAddr)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	res := settingspkg.DexConnectorsHealth{}
+	for _, c := range connectors {
+		res.Connectors = append(res.Connectors, &settingspkg.ConnectorHealth{
+			ID:      c.ID,
+			Name:    c.Name,
+			Type:    c.Type,
+			Healthy: c.Healthy,
+			Message: c.Message,
+		})
+	}
+	return &res, nil
+}
+
+// GetGroupSyncStatus returns the status of the sync of group memberships from the identity provider
+func (s *Server) GetGroupSyncStatus(ctx context.Context, q *settingspkg.GroupSyncQuery) (*settingspkg.GroupSyncStatus, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceSettings, rbacpolicy.ActionGet, "*"); err != nil {
+		return nil, err
+	}
+	return toGroupSyncStatus(s.groupSyncer.Status()), nil
+}
+
+// SyncGroups immediately syncs the group memberships from the identity provider
+func (s *Server) SyncGroups(ctx context.Context, q *settingspkg.GroupSyncQuery) (*settingspkg.GroupSyncStatus, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceSettings, rbacpolicy.ActionUpdate, "*"); err != nil {
+		return nil, err
+	}
+	config, err := s.mgr.GetGroupSyncConfig()
+	if err != nil {
+		return nil, err
+	}
+	if config == nil {
+		return nil, status.Error(codes.FailedPrecondition, "group sync is not configured")
+	}
+	syncStatus, err := s.groupSyncer.Sync()
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to sync groups: %v", err)
+	}
+	return toGroupSyncStatus(syncStatus), nil
+}
+
+// SetMaintenance enables or disables the maintenance mode
+func (s *Server) SetMaintenance(ctx context.Context, q *settingspkg.MaintenanceRequest) (*settingspkg.Maintenance, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceMaintenance, rbacpolicy.ActionUpdate, "*"); err != nil {
+		return nil, err
+	}
+	maintenance := settings.MaintenanceMode{}
+	if q.Enabled {
+		now := metav1.Now()
+		maintenance = settings.MaintenanceMode{
+			Enabled:   true,
+			Message:   q.Message,
+			EnabledBy: session.Username(ctx),
+			EnabledAt: &now,
+		}
+	}
+	if err := s.mgr.SaveMaintenanceMode(maintenance); err != nil {
+		return nil, err
+	}
+	return &settingspkg.Maintenance{
+		Enabled:   maintenance.Enabled,
+		Message:   maintenance.Message,
+		EnabledBy: maintenance.EnabledBy,
+		EnabledAt: maintenance.EnabledAt,
+	}, nil
+}
+
+func toGroupSyncStatus(syncStatus groupsync.Status) *settingspkg.GroupSyncStatus {
+	res := &settingspkg.GroupSyncStatus{
+		Enabled: syncStatus.Enabled,
+		Users:   int64(syncStatus.Users),
+		Error:   syncStatus.Error,
+	}
+	if !syncStatus.LastSyncTime.IsZero() {
+		lastSyncTime := metav1.NewTime(syncStatus.LastSyncTime)
+		res.LastSyncTime = &lastSyncTime
+	}
+	return res
+}
+
+// AuthFuncOverride disables authentication for retrieving the settings, which are needed before logging in. All other
+// methods require authentication, since they may reveal details of the identity providers.
 func (s *Server) AuthFuncOverride(ctx context.Context, fullMethodName string) (context.Context, error) {
-	return ctx, nil
+	if fullMethodName == "/cluster.SettingsService/Get" {
+		return ctx, nil
+	}
+	return s.authenticator.Authenticate(ctx)
 }
@@ -0,0 +1,43 @@
+package settings
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/common"
+	settingspkg "github.com/argoproj/argo-cd/pkg/apiclient/settings"
+	"github.com/argoproj/argo-cd/util/assets"
+	"github.com/argoproj/argo-cd/util/groupsync"
+	"github.com/argoproj/argo-cd/util/rbac"
+)
+
+func newEnforcer(defaultRole string) *rbac.Enforcer {
+	enforcer := rbac.NewEnforcer(fake.NewSimpleClientset(), "default", common.ArgoCDRBACConfigMapName, nil)
+	_ = enforcer.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+	enforcer.SetDefaultRole(defaultRole)
+	return enforcer
+}
+
+func TestGetDexConnectorsHealth_Denied(t *testing.T) {
+	s := NewServer(nil, nil, "", nil, newEnforcer(""))
+	_, err := s.GetDexConnectorsHealth(context.Background(), &settingspkg.DexConnectorsHealthQuery{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestGroupSync_Denied(t *testing.T) {
+	s := NewServer(nil, nil, "", groupsync.NewSyncer(nil), newEnforcer(""))
+	_, err := s.GetGroupSyncStatus(context.Background(), &settingspkg.GroupSyncQuery{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// read only users can get the status, but not sync groups
+	s.enf = newEnforcer("role:readonly")
+	_, err = s.GetGroupSyncStatus(context.Background(), &settingspkg.GroupSyncQuery{})
+	assert.NoError(t, err)
+	_, err = s.SyncGroups(context.Background(), &settingspkg.GroupSyncQuery{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
@@ -2,23 +2,62 @@ package version
 
 import (
 	"github.com/golang/protobuf/ptypes/empty"
+	log "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 
 	"github.com/argoproj/argo-cd/common"
 	"github.com/argoproj/argo-cd/pkg/apiclient/version"
+	"github.com/argoproj/argo-cd/reposerver/apiclient"
+	sessionserver "github.com/argoproj/argo-cd/server/session"
+	"github.com/argoproj/argo-cd/util"
+	"github.com/argoproj/argo-cd/util/cache"
 	ksutil "github.com/argoproj/argo-cd/util/ksonnet"
+	sessionmgr "github.com/argoproj/argo-cd/util/session"
+	"github.com/argoproj/argo-cd/util/settings"
 )
 
-type Server struct{}
+// The optional features of the API server reported by the version
+const (
+	FeatureStatusBadge          = "statusBadge"
+	FeatureAnonymousAccess      = "anonymousAccess"
+	FeatureSSO                  = "sso"
+	FeatureWebhookRequireSecret = "webhookRequireSecret"
+	FeatureMaintenance          = "maintenance"
+)
+
+// authenticatedKey is the context key which marks requests with a valid session
+type authenticatedKey struct{}
+
+type Server struct {
+	repoClientset apiclient.Clientset
+	cache         *cache.Cache
+	settingsMgr   *settings.SettingsManager
+	authenticator sessionserver.Authenticator
+}
+
+// NewServer returns a new instance of the Version service
+func NewServer(repoClientset apiclient.Clientset, cache *cache.Cache, settingsMgr *settings.SettingsManager, authenticator sessionserver.Authenticator) *Server {
+	return &Server{
+		repoClientset: repoClientset,
+		cache:         cache,
+		settingsMgr:   settingsMgr,
+		authenticator: authenticator,
+	}
+}
 
-// Version returns the version of the API server
-func (s *Server) Version(context.Context, *empty.Empty) (*version.VersionMessage, error) {
+// Version returns the version of the API server, of the application controller and of the repo server and its tools,
+// and the enabled features. The versions of the components which are not available are left empty. Unauthenticated
+// callers only get the version of the API server.
+func (s *Server) Version(ctx context.Context, _ *empty.Empty) (*version.VersionMessage, error) {
 	vers := common.GetVersion()
+	if authenticated, _ := ctx.Value(authenticatedKey{}).(bool); !authenticated {
+		return &version.VersionMessage{Version: vers.Version}, nil
+	}
 	ksonnetVersion, err := ksutil.KsonnetVersion()
 	if err != nil {
 		return nil, err
 	}
-	return &version.VersionMessage{
+	res := &version.VersionMessage{
 		Version:        vers.Version,
 		BuildDate:      vers.BuildDate,
 		GitCommit:      vers.GitCommit,
@@ -28,10 +67,78 @@ func (s *Server) Version(context.Context, *empty.Empty) (*version.VersionMessage
 		Compiler:       vers.Compiler,
 		Platform:       vers.Platform,
 		KsonnetVersion: ksonnetVersion,
-	}, nil
+		Features:       s.features(),
+	}
+	if s.cache != nil {
+		if res.ControllerVersion, err = s.cache.GetControllerVersion(); err != nil {
+			log.Warnf("Failed to get the controller version: %v", err)
+		}
+	}
+	if s.repoClientset != nil {
+		repoVersion, err := s.getRepoServerVersion(ctx)
+		if err != nil {
+			log.Warnf("Failed to get the repo server version: %v", err)
+		} else {
+			res.RepoServerVersion = repoVersion.Version
+			res.HelmVersion = repoVersion.HelmVersion
+			res.KustomizeVersion = repoVersion.KustomizeVersion
+			res.GitVersion = repoVersion.GitVersion
+			res.Features = append(res.Features, repoVersion.Features...)
+		}
+	}
+	return res, nil
+}
+
+func (s *Server) getRepoServerVersion(ctx context.Context) (*apiclient.RepoServerVersionResponse, error) {
+	conn, repoClient, err := s.repoClientset.NewRepoServerClient()
+	if err != nil {
+		return nil, err
+	}
+	defer util.Close(conn)
+	return repoClient.GetVersion(ctx, &apiclient.RepoServerVersionRequest{})
 }
 
-// AuthFuncOverride allows the version to be returned without auth
+// features returns the optional features which are enabled in the settings
+func (s *Server) features() []string {
+	if s.settingsMgr == nil {
+		return nil
+	}
+	var features []string
+	argoSettings, err := s.settingsMgr.GetSettings()
+	if err != nil {
+		log.Warnf("Failed to get the settings: %v", err)
+	} else {
+		if argoSettings.StatusBadgeEnabled {
+			features = append(features, FeatureStatusBadge)
+		}
+		if argoSettings.AnonymousUserEnabled {
+			features = append(features, FeatureAnonymousAccess)
+		}
+		if argoSettings.IsSSOConfigured() {
+			features = append(features, FeatureSSO)
+		}
+		if argoSettings.WebhookRequireSecret {
+			features = append(features, FeatureWebhookRequireSecret)
+		}
+	}
+	maintenance, err := s.settingsMgr.GetMaintenanceMode()
+	if err != nil {
+		log.Warnf("Failed to get the maintenance mode: %v", err)
+	} else if maintenance.Enabled {
+		features = append(features, FeatureMaintenance)
+	}
+	return features
+}
+
+// AuthFuncOverride allows the version to be returned without auth, e.g. to warn about version skew before logging in.
+// Requests with a valid session, which is not the session of the anonymous user, are marked as authenticated.
 func (s *Server) AuthFuncOverride(ctx context.Context, fullMethodName string) (context.Context, error) {
-	return ctx, nil
+	authCtx, err := s.authenticator.Authenticate(ctx)
+	if err != nil {
+		return ctx, nil
+	}
+	if _, anonymous := authCtx.Value("claims").(*sessionmgr.AnonymousClaims); anonymous {
+		return ctx, nil
+	}
+	return context.WithValue(authCtx, authenticatedKey{}, true), nil
 }
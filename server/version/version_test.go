@@ -0,0 +1,56 @@
+package version
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-cd/common"
+	sessionmgr "github.com/argoproj/argo-cd/util/session"
+)
+
+type fakeAuthenticator struct {
+	claims jwt.Claims
+	err    error
+}
+
+func (a fakeAuthenticator) Authenticate(ctx context.Context) (context.Context, error) {
+	if a.err != nil {
+		return ctx, a.err
+	}
+	return context.WithValue(ctx, "claims", a.claims), nil
+}
+
+func TestVersion(t *testing.T) {
+	s := NewServer(nil, nil, nil, fakeAuthenticator{claims: &jwt.MapClaims{"sub": "admin"}})
+	ctx, err := s.AuthFuncOverride(context.Background(), "/version.VersionService/Version")
+	assert.NoError(t, err)
+	res, err := s.Version(ctx, &empty.Empty{})
+	assert.NoError(t, err)
+	assert.Equal(t, common.GetVersion().Version, res.Version)
+	assert.NotEmpty(t, res.GoVersion)
+	assert.NotEmpty(t, res.KsonnetVersion)
+}
+
+func TestVersion_Unauthenticated(t *testing.T) {
+	for name, authenticator := range map[string]fakeAuthenticator{
+		"Unauthenticated": {err: errors.New("no session information")},
+		"Anonymous":       {claims: &sessionmgr.AnonymousClaims{Role: "role:readonly"}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			s := NewServer(nil, nil, nil, authenticator)
+			ctx, err := s.AuthFuncOverride(context.Background(), "/version.VersionService/Version")
+			assert.NoError(t, err)
+			res, err := s.Version(ctx, &empty.Empty{})
+			assert.NoError(t, err)
+			assert.Equal(t, common.GetVersion().Version, res.Version)
+			assert.Empty(t, res.GoVersion)
+			assert.Empty(t, res.KsonnetVersion)
+			assert.Empty(t, res.Features)
+		})
+	}
+}
@@ -3,15 +3,19 @@ package test
 import (
 	"encoding/json"
 
+	jsonpatch "github.com/evanphx/json-patch"
 	appsv1 "k8s.io/api/apps/v1"
 	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	testcore "k8s.io/client-go/testing"
 
 	"github.com/argoproj/argo-cd/common"
 	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	apps "github.com/argoproj/argo-cd/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-cd/pkg/client/clientset/versioned/scheme"
 	appinformer "github.com/argoproj/argo-cd/pkg/client/informers/externalversions"
 	applister "github.com/argoproj/argo-cd/pkg/client/listers/application/v1alpha1"
 )
@@ -241,3 +245,53 @@ func NewFakeProjLister(objects ...runtime.Object) applister.AppProjectNamespaceL
 	defer cancel()
 	return factory.Argoproj().V1alpha1().AppProjects().Lister().AppProjects(FakeArgoCDNamespace)
 }
+
+// NewFakeAppClientsetWithStatusSubresource returns a fake clientset whose updates of applications keep their status, like
+// the API server does for resources with the status subresource
+func NewFakeAppClientsetWithStatusSubresource(objects ...runtime.Object) *apps.Clientset {
+	tracker := testcore.NewObjectTracker(scheme.Scheme, scheme.Codecs.UniversalDecoder())
+	for _, obj := range objects {
+		if err := tracker.Add(obj); err != nil {
+			panic(err)
+		}
+	}
+	clientset := &apps.Clientset{}
+	clientset.AddReactor("update", "applications", func(action testcore.Action) (bool, runtime.Object, error) {
+		update := action.(testcore.UpdateAction)
+		if update.GetSubresource() != "" {
+			return false, nil, nil
+		}
+		app := update.GetObject().(*v1alpha1.Application).DeepCopy()
+		current, err := tracker.Get(action.GetResource(), action.GetNamespace(), app.Name)
+		if err != nil {
+			return true, nil, err
+		}
+		app.Status = current.(*v1alpha1.Application).Status
+		return true, app, tracker.Update(action.GetResource(), app, action.GetNamespace())
+	})
+	// the merge patches of the fake clientset cannot remove fields
+	clientset.AddReactor("patch", "applications", func(action testcore.Action) (bool, runtime.Object, error) {
+		patch := action.(testcore.PatchAction)
+		if patch.GetPatchType() != types.MergePatchType {
+			return false, nil, nil
+		}
+		current, err := tracker.Get(action.GetResource(), action.GetNamespace(), patch.GetName())
+		if err != nil {
+			return true, nil, err
+		}
+		data, err := json.Marshal(current)
+		if err != nil {
+			return true, nil, err
+		}
+		if data, err = jsonpatch.MergePatch(data, patch.GetPatch()); err != nil {
+			return true, nil, err
+		}
+		var app v1alpha1.Application
+		if err = json.Unmarshal(data, &app); err != nil {
+			return true, nil, err
+		}
+		return true, &app, tracker.Update(action.GetResource(), &app, action.GetNamespace())
+	})
+	clientset.AddReactor("*", "*", testcore.ObjectReaction(tracker))
+	return clientset
+}
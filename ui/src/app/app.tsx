@@ -19,6 +19,7 @@ import applications from './applications';
 import help from './help';
 import login from './login';
 import settings from './settings';
+import {Announcements} from './shared/components';
 import {Provider} from './shared/context';
 import {services} from './shared/services';
 import requests from './shared/services/requests';
@@ -150,6 +151,7 @@ export class App extends React.Component<{}, { popupProps: PopupProps, error: Er
                 <PageContext.Provider value={{title: 'Argo CD'}}>
                 <Provider value={{history, popup: this.popupManager, notifications: this.notificationsManager, navigation: this.navigationManager}}>
                     {this.state.popupProps && <Popup {...this.state.popupProps}/>}
+                    <DataLoader load={() => services.authService.settings()}>{(s) => <Announcements settings={s}/>}</DataLoader>
                     <Router history={history}>
                         <Switch>
                             <Redirect exact={true} path='/' to='/applications'/>
@@ -46,6 +46,7 @@ export const ApplicationDeploymentHistory = ({
                             </div>
                             <div className='columns small-9'>
                                 <Revision repoUrl={info.source.repoURL} revision={info.revision}/>
+                                {info.pinned && <span title='Deployed an explicitly requested revision instead of the target revision'> (pinned)</span>}
                                 <div className='application-deployment-history__item-menu'>
                                     <DropDownMenu anchor={() => <button
                                         className='argo-button argo-button--light argo-button--lg argo-button--short'>
@@ -182,6 +182,15 @@ export const ApplicationParameters = (props: {
                 </div>
             ),
         });
+        if (props.details.helm.hasCrds) {
+            attributes.push({
+                title: 'INCLUDE CRDS',
+                view: (!!(app.spec.source.helm && app.spec.source.helm.includeCRDs)).toString(),
+                edit: (formApi: FormApi) => (
+                    <FormField formApi={formApi} field='spec.source.helm.includeCRDs' component={CheckboxField}/>
+                ),
+            });
+        }
         const paramsByName = new Map<string, models.HelmParameter>();
         (props.details.helm.parameters || []).forEach((param) => paramsByName.set(param.name, param));
         const overridesByName = new Map<string, number>();
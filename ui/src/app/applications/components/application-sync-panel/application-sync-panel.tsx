@@ -6,6 +6,7 @@ import {Consumer} from '../../../shared/context';
 import * as models from '../../../shared/models';
 import {services} from '../../../shared/services';
 import {ComparisonStatusIcon, nodeKey} from '../utils';
+import {RevisionChangelog} from './revision-changelog';
 
 export const ApplicationSyncPanel = ({application, selectedResource, hide}: {
     application: models.Application,
@@ -71,6 +72,9 @@ export const ApplicationSyncPanel = ({application, selectedResource, hide}: {
                             <div className='argo-form-row'>
                                 <FormField formApi={formApi} label='Revision' field='revision' component={Text}/>
                             </div>
+                            <div className='argo-form-row'>
+                                <RevisionChangelog applicationName={application.metadata.name} targetRevision={formApi.values.revision}/>
+                            </div>
 
                             <div className='argo-form-row'>
                                 <div>
@@ -0,0 +1,28 @@
+import {DataLoader} from 'argo-ui';
+import * as React from 'react';
+import {Timestamp} from '../../../shared/components/timestamp';
+import {RevisionChangelog as Changelog} from '../../../shared/models';
+import {services} from '../../../shared/services';
+
+// RevisionChangelog shows the commits which a sync of the target revision deploys. Nothing is shown for applications
+// which were never deployed, or which source does not have a history.
+export const RevisionChangelog = (props: {
+    applicationName: string;
+    targetRevision: string;
+}) => (
+    <DataLoader input={props}
+                load={(input) => services.applications.revisionChangelog(input.applicationName, input.targetRevision).catch(() => null)}
+    >{(changelog: Changelog) => changelog && (
+        <div>
+            <h6>What will this deploy</h6>
+            {(changelog.commits || []).length === 0 && <p>No new commits since the deployed revision {changelog.revision.substr(0, 7)}</p>}
+            {(changelog.commits || []).map((commit) => (
+                <div className='row' key={commit.revision}>
+                    <div className='columns small-2'>{commit.revision.substr(0, 7)}</div>
+                    <div className='columns small-6'>{commit.message}</div>
+                    <div className='columns small-4'>{commit.author || 'unknown'}<br/>{commit.date && <Timestamp date={commit.date}/>}</div>
+                </div>
+            ))}
+        </div>
+    )}</DataLoader>
+);
@@ -226,7 +226,7 @@ export const ApplicationsList = (props: RouteComponentProps<{}>) => {
                                     ) || (
                                         <ApplicationsTable applications={data}
                                             syncApplication={(appName) => ctx.navigation.goto('.', { syncApp: appName })}
-                                            refreshApplication={(appName) => services.applications.get(appName, 'normal')}
+                                            refreshApplication={(appName, refreshType) => services.applications.get(appName, refreshType || 'normal')}
                                             deleteApplication={(appName) => AppUtils.deleteApplication(appName, ctx)}
                                         />
                                     )
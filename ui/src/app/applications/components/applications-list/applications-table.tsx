@@ -18,7 +18,7 @@ function shortRepo(repo: string) {
 export const ApplicationsTable = (props: {
     applications: models.Application[];
     syncApplication: (appName: string) => any;
-    refreshApplication: (appName: string) => any;
+    refreshApplication: (appName: string, refreshType?: 'normal' | 'hard') => any;
     deleteApplication: (appName: string) => any;
 }) => (
     <Consumer>
@@ -65,6 +65,7 @@ export const ApplicationsTable = (props: {
                         } items={[
                             { title: 'Sync', action: () => props.syncApplication(app.metadata.name) },
                             { title: 'Refresh', action: () => props.refreshApplication(app.metadata.name) },
+                            { title: 'Hard Refresh', action: () => props.refreshApplication(app.metadata.name, 'hard') },
                             { title: 'Delete', action: () => props.deleteApplication(app.metadata.name) },
                         ]} />
                     </div>
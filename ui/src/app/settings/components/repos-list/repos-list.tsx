@@ -30,6 +30,7 @@ interface NewHTTPSRepoParams {
     tlsClientCertKey: string;
     insecure: boolean;
     enableLfs: boolean;
+    createWebhook: boolean;
 }
 
 export class ReposList extends React.Component<RouteComponentProps<any>> {
@@ -60,8 +61,9 @@ export class ReposList extends React.Component<RouteComponentProps<any>> {
             }}>
                 <div className='repos-list'>
                     <div className='argo-container'>
-                        <DataLoader load={() => services.repos.list()} ref={(loader) => this.loader = loader}>
-                            {(repos: models.Repository[]) => (
+                        <DataLoader ref={(loader) => this.loader = loader} load={() => Promise.all([services.repos.list(), services.repos.failures().catch(() => [])])
+                            .then(([repos, failures]) => ({repos, failures}))}>
+                            {({repos, failures}: {repos: models.Repository[], failures: models.RepositoryFailure[]}) => (
                                 repos.length > 0 && (
                                     <div className='argo-table-list'>
                                         <div className='argo-table-list__head'>
@@ -89,6 +91,7 @@ export class ReposList extends React.Component<RouteComponentProps<any>> {
                                                     <div className='columns small-3'>
                                                         <ConnectionStateIcon
                                                             state={repo.connectionState}/> {repo.connectionState.status}
+                                                        {this.renderFailure(failures.find((failure) => failure.repo === repo.repo))}
                                                         <DropDownMenu anchor={() => <button
                                                             className='argo-button argo-button--light argo-button--lg argo-button--short'>
                                                             <i className='fa fa-ellipsis-v'/>
@@ -169,6 +172,10 @@ export class ReposList extends React.Component<RouteComponentProps<any>> {
                                 <div className='argo-form-row'>
                                     <FormField formApi={formApi} label='Enable LFS support (Git only)' field='enableLfs' component={CheckboxField}/>
                                 </div>
+                                <div className='argo-form-row'>
+                                    <FormField formApi={formApi} label='Create push webhook (GitHub/GitLab, password must be an admin API token)' field='createWebhook'
+                                               component={CheckboxField}/>
+                                </div>
                             </form>
                         )}
                     </Form>
@@ -261,6 +268,18 @@ export class ReposList extends React.Component<RouteComponentProps<any>> {
         }
     }
 
+    private renderFailure(failure: models.RepositoryFailure) {
+        if (!failure || failure.failures === 0) {
+            return null;
+        }
+        const reason = failure.authFailure ? 'credentials rejected' : `${failure.failures} failed ${failure.requestType} requests`;
+        return (
+            <div className='repos-list__failure' title={`${failure.failedAt}: ${failure.message}`}>
+                <i className='fa fa-exclamation-triangle'/> {reason}
+            </div>
+        );
+    }
+
     private async disconnectRepo(repo: string) {
         const confirmed = await this.appContext.apis.popup.confirm(
             'Disconnect repository', `Are you sure you want to disconnect '${repo}'?`);
@@ -0,0 +1,35 @@
+import * as React from 'react';
+import * as models from '../models';
+
+import { COLORS } from './colors';
+
+const bannerStyle = (color: string): React.CSSProperties => ({
+    padding: '0.5em 1em',
+    borderLeft: `4px solid ${color}`,
+    background: 'white',
+    boxShadow: '1px 1px 3px #CCD6DD',
+    marginBottom: '0.5em',
+});
+
+export const Announcements = (props: { settings: models.AuthSettings }) => {
+    const maintenance = props.settings.maintenance;
+    const announcements = props.settings.announcements || [];
+    if (!(maintenance && maintenance.enabled) && announcements.length === 0) {
+        return null;
+    }
+    return (
+        <div style={{position: 'fixed', top: 10, left: '50%', transform: 'translateX(-50%)', zIndex: 100, maxWidth: '60%'}}>
+            {maintenance && maintenance.enabled && (
+                <div style={bannerStyle(COLORS.announcement.warning)}>
+                    <i className='fa fa-wrench'/> Argo CD is in maintenance mode, automated syncs are paused{maintenance.message && `: ${maintenance.message}`}
+                </div>
+            )}
+            {announcements.map((announcement, i) => (
+                <div key={i} style={bannerStyle(COLORS.announcement[announcement.severity] || COLORS.announcement.info)}>
+                    <i className={announcement.severity === 'info' ? 'fa fa-info-circle' : 'fa fa-exclamation-triangle'}/> {announcement.message}
+                    {announcement.url && <React.Fragment> <a href={announcement.url} target='_blank'>Details</a></React.Fragment>}
+                </div>
+            ))}
+        </div>
+    );
+};
@@ -5,6 +5,11 @@ const ARGO_RUNNING_COLOR = '#0DADEA';
 const ARGO_GRAY4_COLOR = '#CCD6DD';
 
 export const COLORS = {
+    announcement: {
+        info: ARGO_RUNNING_COLOR,
+        warning: ARGO_WARNING_COLOR,
+        critical: ARGO_FAILED_COLOR,
+    },
     connection_status: {
         failed: ARGO_FAILED_COLOR,
         successful: ARGO_SUCCESS_COLOR,
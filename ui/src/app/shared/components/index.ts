@@ -3,6 +3,7 @@ export { DataLoader, ErrorNotification } from 'argo-ui';
 export * from './page';
 export * from './checkbox/checkbox-field';
 export * from './colors';
+export * from './announcements';
 export * from './cluster';
 export * from './connection-state-icon';
 export * from './query';
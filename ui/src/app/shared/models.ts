@@ -71,6 +71,20 @@ export interface RevisionMetadata {
     date: models.Time;
     tags?: string[];
     message?: string;
+    changedFiles?: string[];
+}
+
+export interface CommitMetadata {
+    revision: string;
+    author?: string;
+    date: models.Time;
+    message?: string;
+}
+
+export interface RevisionChangelog {
+    revision: string;
+    targetRevision: string;
+    commits: CommitMetadata[];
 }
 
 export interface SyncOperationResult {
@@ -159,6 +173,8 @@ export interface ApplicationSourceHelm {
     valueFiles: string[];
     values?: string;
     parameters: HelmParameter[];
+    fileParameters?: HelmFileParameter[];
+    includeCRDs?: boolean;
 }
 
 export interface ApplicationSourceKustomize {
@@ -200,6 +216,7 @@ export interface RevisionHistory {
     revision: string;
     source: ApplicationSource;
     deployedAt: models.Time;
+    pinned?: boolean;
 }
 
 export type SyncStatusCode = 'Unknown' | 'Synced' | 'OutOfSync';
@@ -338,6 +355,21 @@ export interface AuthSettings {
         chatUrl: string;
         chatText: string;
     };
+    maintenance?: {
+        enabled: boolean;
+        message: string;
+        enabledAt: models.Time;
+    };
+    announcements?: Announcement[];
+}
+
+export type AnnouncementSeverity = 'info' | 'warning' | 'critical';
+
+export interface Announcement {
+    message: string;
+    severity: AnnouncementSeverity;
+    url?: string;
+    expiresAt?: models.Time;
 }
 
 export interface UserInfo  {
@@ -376,10 +408,21 @@ export interface Repository {
     type?: string;
     name?: string;
     connectionState: ConnectionState;
+    webhookID?: string;
 }
 
 export interface RepositoryList extends ItemsList<Repository> { }
 
+export interface RepositoryFailure {
+    repo: string;
+    requestType: string;
+    message: string;
+    authFailure?: boolean;
+    failures: number;
+    failedAt: models.Time;
+    lastSucceededAt?: models.Time;
+}
+
 export interface Cluster {
     name: string;
     server: string;
@@ -429,12 +472,18 @@ export interface HelmParameter {
     value: string;
 }
 
+export interface HelmFileParameter {
+    name: string;
+    path: string;
+}
+
 export interface HelmAppSpec {
     name: string;
     path: string;
     valueFiles: string[];
     values?: string;
     parameters: HelmParameter[];
+    hasCrds?: boolean;
 }
 
 export interface KustomizeAppSpec {
@@ -511,6 +560,7 @@ export interface ProjectSpec {
     clusterResourceWhitelist: GroupKind[];
     namespaceResourceBlacklist: GroupKind[];
     orphanedResources?: { warn?: boolean };
+    manifestQuota?: { maxResources?: number; maxSize?: number };
 }
 
 export interface Project {
@@ -9,6 +9,12 @@ interface QueryOptions {
     exclude?: boolean;
 }
 
+export interface ListOptions {
+    search?: string;
+    selector?: string;
+    sortBy?: string;
+}
+
 function optionsToSearch(options?: QueryOptions) {
     if (options) {
         return { fields: (options.exclude ? '-' : '') + options.fields.join(',') };
@@ -17,10 +23,9 @@ function optionsToSearch(options?: QueryOptions) {
 }
 
 export class ApplicationsService {
-    public list(projects: string[], options?: QueryOptions): Promise<models.Application[]> {
-        return requests.get('/applications').query({ project: projects, ...optionsToSearch(options) }).then((res) => res.body as models.ApplicationList).then((list) => {
-            return (list.items || []).map((app) => this.parseAppFields(app));
-        });
+    public list(projects: string[], options?: QueryOptions, listOptions?: ListOptions): Promise<models.Application[]> {
+        return requests.get('/applications').query({ project: projects, ...listOptions, ...optionsToSearch(options) }).then((res) => res.body as models.ApplicationList)
+            .then((list) => (list.items || []).map((app) => this.parseAppFields(app)));
     }
 
     public get(name: string, refresh?: 'normal' | 'hard'): Promise<models.Application> {
@@ -36,6 +41,16 @@ export class ApplicationsService {
             .then((res) => res.body as models.RevisionMetadata);
     }
 
+    public revisionDetails(name: string, revision: string): Promise<models.RevisionMetadata> {
+        return requests.get(`/applications/${name}/revisions/${revision}/details`)
+            .then((res) => res.body as models.RevisionMetadata);
+    }
+
+    public revisionChangelog(name: string, targetRevision?: string): Promise<models.RevisionChangelog> {
+        return requests.get(`/applications/${name}/changelog`).query({ targetRevision: targetRevision || '' })
+            .then((res) => res.body as models.RevisionChangelog);
+    }
+
     public resourceTree(name: string): Promise<models.ApplicationTree> {
         return requests.get(`/applications/${name}/resource-tree`).then((res) => res.body as models.ApplicationTree);
     }
@@ -6,10 +6,15 @@ export class RepositoriesService {
         return requests.get('/repositories').then((res) => res.body as models.RepositoryList).then((list) => list.items || []);
     }
 
-    public createHTTPS({type, name, url, username, password, tlsClientCertData, tlsClientCertKey, insecure, enableLfs}:
+    public failures(): Promise<models.RepositoryFailure[]> {
+        return requests.get('/repositories/failures').then((res) => res.body.items as models.RepositoryFailure[] || []);
+    }
+
+    public createHTTPS({type, name, url, username, password, tlsClientCertData, tlsClientCertKey, insecure, enableLfs, createWebhook}:
         {type: string, name: string, url: string, username: string, password: string, tlsClientCertData: string, tlsClientCertKey: string,
-            insecure: boolean, enableLfs: boolean}): Promise<models.Repository> {
-        return requests.post('/repositories').send({type, name, repo: url, username, password, tlsClientCertData, tlsClientCertKey, insecure, enableLfs })
+            insecure: boolean, enableLfs: boolean, createWebhook?: boolean}): Promise<models.Repository> {
+        return requests.post('/repositories').query({createWebhook: !!createWebhook})
+            .send({type, name, repo: url, username, password, tlsClientCertData, tlsClientCertKey, insecure, enableLfs })
             .then((res) => res.body as models.Repository);
     }
 
@@ -5,6 +5,9 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+
+	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 )
 
 func Path(root, path string) (string, error) {
@@ -25,5 +28,113 @@ func Path(root, path string) (string, error) {
 	if !info.IsDir() {
 		return "", fmt.Errorf("%s: app path is not a directory", path)
 	}
+	// the app path might be a symbolic link to a directory outside of the repository
+	if _, err := InRoot(root, appPath); err != nil {
+		return "", fmt.Errorf("%s: app path outside root", path)
+	}
 	return appPath, nil
 }
+
+// ManifestGeneratePaths returns the paths, relative to the root of the repository, which the manifests of the given
+// application are generated from: the source path and the paths listed in the manifest-generate-paths annotation.
+// Returns nil if the application is not annotated, since its manifests might depend on any file of the repository.
+func ManifestGeneratePaths(app *v1alpha1.Application) []string {
+	sourcePath := app.Spec.Source.Path
+	var paths []string
+	for _, item := range strings.Split(app.Annotations[common.AnnotationKeyManifestGeneratePaths], ";") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		if strings.HasPrefix(item, "/") {
+			paths = append(paths, cleanRepoPath(item))
+		} else {
+			paths = append(paths, cleanRepoPath(filepath.Join(sourcePath, item)))
+		}
+	}
+	if len(paths) == 0 {
+		return nil
+	}
+	return append([]string{cleanRepoPath(sourcePath)}, paths...)
+}
+
+// cleanRepoPath returns the given path relative to the root of the repository, or "." for the root itself
+func cleanRepoPath(path string) string {
+	path = strings.TrimPrefix(filepath.Clean("/"+path), "/")
+	if path == "" {
+		return "."
+	}
+	return path
+}
+
+// FilesChanged returns whether any of the changed files, relative to the root of the repository, is within any of the
+// given paths
+func FilesChanged(paths []string, changedFiles []string) bool {
+	for _, path := range paths {
+		path = cleanRepoPath(path)
+		if path == "." {
+			return true
+		}
+		for _, file := range changedFiles {
+			file = cleanRepoPath(file)
+			if file == path || strings.HasPrefix(file, path+"/") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ResolveFilePath returns the absolute path of the given file, relative to dir, after following symbolic links.
+// Returns an error if the file is absolute, does not exist or is not within root.
+func ResolveFilePath(root, dir, file string) (string, error) {
+	if filepath.IsAbs(file) {
+		return "", fmt.Errorf("%s: file path is absolute", file)
+	}
+	root, err := evalAbsPath(root)
+	if err != nil {
+		return "", err
+	}
+	dir, err = evalAbsPath(dir)
+	if err != nil {
+		return "", err
+	}
+	resolved, err := filepath.EvalSymlinks(filepath.Join(dir, file))
+	if err != nil {
+		return "", fmt.Errorf("%s: failed to resolve file path: %v", file, err)
+	}
+	if !within(root, resolved) {
+		return "", fmt.Errorf("%s: file path is outside of %s", file, root)
+	}
+	return resolved, nil
+}
+
+// InRoot returns the absolute path of the given path after following symbolic links. Returns an error if it does not
+// exist or is not within root, e.g. because it is a symbolic link which escapes root.
+func InRoot(root, path string) (string, error) {
+	root, err := evalAbsPath(root)
+	if err != nil {
+		return "", err
+	}
+	resolved, err := evalAbsPath(path)
+	if err != nil {
+		return "", fmt.Errorf("%s: failed to resolve path: %v", path, err)
+	}
+	if !within(root, resolved) {
+		return "", fmt.Errorf("%s: path is outside of %s", path, root)
+	}
+	return resolved, nil
+}
+
+// within returns whether the given path is root or a path below it. Both paths must be absolute and resolved.
+func within(root, path string) bool {
+	return path == root || strings.HasPrefix(path, strings.TrimSuffix(root, string(filepath.Separator))+string(filepath.Separator))
+}
+
+func evalAbsPath(path string) (string, error) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+	return filepath.EvalSymlinks(path)
+}
@@ -1,9 +1,15 @@
 package path
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 )
 
 func TestPathRoot(t *testing.T) {
@@ -45,3 +51,98 @@ func TestPathNotDir(t *testing.T) {
 	_, err := Path("./testdata", "file.txt")
 	assert.EqualError(t, err, "file.txt: app path is not a directory")
 }
+
+func TestPathSymlinkOutsideRoot(t *testing.T) {
+	root, err := ioutil.TempDir("", "path")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(root) }()
+	assert.NoError(t, os.Symlink("/etc", filepath.Join(root, "etc")))
+	assert.NoError(t, os.Mkdir(filepath.Join(root, "app"), 0755))
+	assert.NoError(t, os.Symlink("app", filepath.Join(root, "link")))
+
+	_, err = Path(root, "etc")
+	assert.EqualError(t, err, "etc: app path outside root")
+	_, err = Path(root, "link")
+	assert.NoError(t, err)
+}
+
+func TestManifestGeneratePaths(t *testing.T) {
+	// the manifests of apps which are not annotated might depend on any file
+	app := &v1alpha1.Application{Spec: v1alpha1.ApplicationSpec{Source: v1alpha1.ApplicationSource{Path: "apps/guestbook"}}}
+	assert.Nil(t, ManifestGeneratePaths(app))
+	app.Annotations = map[string]string{common.AnnotationKeyManifestGeneratePaths: " ; "}
+	assert.Nil(t, ManifestGeneratePaths(app))
+
+	app.Annotations = map[string]string{common.AnnotationKeyManifestGeneratePaths: "../../bases/guestbook; /shared/ ;"}
+	assert.Equal(t, []string{"apps/guestbook", "bases/guestbook", "shared"}, ManifestGeneratePaths(app))
+
+	app.Annotations = map[string]string{common.AnnotationKeyManifestGeneratePaths: "."}
+	assert.Equal(t, []string{"apps/guestbook", "apps/guestbook"}, ManifestGeneratePaths(app))
+}
+
+func TestFilesChanged(t *testing.T) {
+	paths := []string{"apps/guestbook", "bases/guestbook"}
+	assert.True(t, FilesChanged(paths, []string{"apps/guestbook/deployment.yaml"}))
+	assert.True(t, FilesChanged(paths, []string{"README.md", "bases/guestbook/kustomization.yaml"}))
+	assert.True(t, FilesChanged(paths, []string{"/bases/guestbook"}))
+	assert.False(t, FilesChanged(paths, []string{"apps/guestbook-ui/deployment.yaml", "README.md"}))
+	assert.False(t, FilesChanged(paths, nil))
+	assert.True(t, FilesChanged([]string{"."}, []string{"README.md"}))
+}
+
+func TestResolveFilePath(t *testing.T) {
+	root, err := ioutil.TempDir("", "path")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(root) }()
+	chart := filepath.Join(root, "charts", "guestbook")
+	assert.NoError(t, os.MkdirAll(chart, 0755))
+	assert.NoError(t, os.MkdirAll(filepath.Join(root, "env", "prod"), 0755))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(chart, "values.yaml"), []byte("{}"), 0644))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(root, "env", "prod", "values.yaml"), []byte("{}"), 0644))
+	assert.NoError(t, os.Symlink("/etc/passwd", filepath.Join(chart, "passwd")))
+
+	p, err := ResolveFilePath(chart, chart, "values.yaml")
+	assert.NoError(t, err)
+	assert.Equal(t, "values.yaml", filepath.Base(p))
+	assert.True(t, filepath.IsAbs(p))
+
+	_, err = ResolveFilePath(chart, chart, "../../env/prod/values.yaml")
+	assert.Error(t, err)
+	p, err = ResolveFilePath(root, chart, "../../env/prod/values.yaml")
+	assert.NoError(t, err)
+	assert.Equal(t, "prod", filepath.Base(filepath.Dir(p)))
+
+	_, err = ResolveFilePath(root, chart, "../../../values.yaml")
+	assert.Error(t, err)
+	_, err = ResolveFilePath(root, chart, "/etc/passwd")
+	assert.Error(t, err)
+	_, err = ResolveFilePath(root, chart, "passwd")
+	assert.Error(t, err)
+	_, err = ResolveFilePath(root, chart, "missing.yaml")
+	assert.Error(t, err)
+}
+
+func TestInRoot(t *testing.T) {
+	root, err := ioutil.TempDir("", "path")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(root) }()
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(root, "values.yaml"), []byte("{}"), 0644))
+	assert.NoError(t, os.Symlink("/etc/passwd", filepath.Join(root, "passwd")))
+	assert.NoError(t, os.Symlink("values.yaml", filepath.Join(root, "link.yaml")))
+
+	p, err := InRoot(root, filepath.Join(root, "values.yaml"))
+	assert.NoError(t, err)
+	assert.Equal(t, "values.yaml", filepath.Base(p))
+	p, err = InRoot(root, filepath.Join(root, "link.yaml"))
+	assert.NoError(t, err)
+	assert.Equal(t, "values.yaml", filepath.Base(p))
+	_, err = InRoot(root, root)
+	assert.NoError(t, err)
+
+	_, err = InRoot(root, filepath.Join(root, "passwd"))
+	assert.Error(t, err)
+	_, err = InRoot(root, filepath.Join(root, "..", filepath.Base(root)+"-other"))
+	assert.Error(t, err)
+	_, err = InRoot(root, "/etc/passwd")
+	assert.Error(t, err)
+}
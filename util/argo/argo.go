@@ -131,6 +131,7 @@ func WaitForRefresh(ctx context.Context, appIf v1alpha1.ApplicationInterface, na
 func ValidateRepo(
 	ctx context.Context,
 	spec *argoappv1.ApplicationSpec,
+	proj *argoappv1.AppProject,
 	repoClientset apiclient.Clientset,
 	db db.ArgoDB,
 	kustomizeOptions *argoappv1.KustomizeOptions,
@@ -177,11 +178,15 @@ func ValidateRepo(
 	if err != nil {
 		return nil, err
 	}
+	repos = proj.ScopeRepositoryCredentials(repos)
 
 	// can we actually read the app from the repo
 	var helm *apiclient.HelmAppDetailsQuery
 	if spec.Source.Helm != nil {
-		helm = &apiclient.HelmAppDetailsQuery{ValueFiles: spec.Source.Helm.ValueFiles}
+		helm = &apiclient.HelmAppDetailsQuery{
+			ValueFiles:                  spec.Source.Helm.ValueFiles,
+			AllowValueFilesOutsideChart: spec.Source.Helm.AllowValueFilesOutsideChart,
+		}
 	}
 	var ksonnet *apiclient.KsonnetAppDetailsQuery
 	if spec.Source.Ksonnet != nil {
@@ -219,7 +224,11 @@ func ValidateRepo(
 	if err != nil {
 		return nil, err
 	}
-	conditions = append(conditions, verifyGenerateManifests(ctx, repo, repos, spec, repoClient, kustomizeOptions, plugins, cluster.ServerVersion)...)
+	apiVersions, err := kubectl.GetAPIVersions(cluster.RESTConfig())
+	if err != nil {
+		return nil, err
+	}
+	conditions = append(conditions, verifyGenerateManifests(ctx, repo, repos, spec, proj, repoClient, kustomizeOptions, plugins, cluster.ServerVersion, apiVersions)...)
 
 	return conditions, nil
 }
@@ -239,49 +248,114 @@ func enrichSpec(spec *argoappv1.ApplicationSpec, appDetails *apiclient.RepoAppDe
 	}
 }
 
-// ValidatePermissions ensures that the referenced cluster has been added to Argo CD and the app source repo and destination namespace/cluster are permitted in app project
-func ValidatePermissions(ctx context.Context, spec *argoappv1.ApplicationSpec, proj *argoappv1.AppProject, db db.ArgoDB) ([]argoappv1.ApplicationCondition, error) {
-	conditions := make([]argoappv1.ApplicationCondition, 0)
+// ProjectRuleViolation explains which rule rejects an application spec
+type ProjectRuleViolation struct {
+	// Rule is the name of the violated rule, e.g. the project field sourceRepos or destinations
+	Rule string
+	// Message describes the violation
+	Message string
+	// Allowed lists the values the rule permits, if any
+	Allowed []string
+}
+
+// GetProjectRuleViolations returns every rule of the project and of Argo CD which the application spec violates
+func GetProjectRuleViolations(ctx context.Context, spec *argoappv1.ApplicationSpec, proj *argoappv1.AppProject, db db.ArgoDB) ([]ProjectRuleViolation, error) {
+	violations := make([]ProjectRuleViolation, 0)
 	if spec.Source.RepoURL == "" || spec.Source.Path == "" {
-		conditions = append(conditions, argoappv1.ApplicationCondition{
-			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+		violations = append(violations, ProjectRuleViolation{
+			Rule:    "source",
 			Message: "spec.source.repoURL and spec.source.path are required",
 		})
-		return conditions, nil
+		return violations, nil
 	}
 
 	if !proj.IsSourcePermitted(spec.Source) {
-		conditions = append(conditions, argoappv1.ApplicationCondition{
-			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+		violations = append(violations, ProjectRuleViolation{
+			Rule:    "sourceRepos",
 			Message: fmt.Sprintf("application repo %s is not permitted in project '%s'", spec.Source.RepoURL, spec.Project),
+			Allowed: proj.Spec.SourceRepos,
 		})
 	}
 
 	if spec.Destination.Server != "" && spec.Destination.Namespace != "" {
 		if !proj.IsDestinationPermitted(spec.Destination) {
-			conditions = append(conditions, argoappv1.ApplicationCondition{
-				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			allowed := make([]string, len(proj.Spec.Destinations))
+			for i, dest := range proj.Spec.Destinations {
+				allowed[i] = fmt.Sprintf("%s, namespace %s", dest.Server, dest.Namespace)
+			}
+			violations = append(violations, ProjectRuleViolation{
+				Rule:    "destinations",
 				Message: fmt.Sprintf("application destination %v is not permitted in project '%s'", spec.Destination, spec.Project),
+				Allowed: allowed,
 			})
 		}
 		// Ensure the k8s cluster the app is referencing, is configured in Argo CD
-		_, err := db.GetCluster(ctx, spec.Destination.Server)
+		cluster, err := db.GetCluster(ctx, spec.Destination.Server)
 		if err != nil {
 			if errStatus, ok := status.FromError(err); ok && errStatus.Code() == codes.NotFound {
-				conditions = append(conditions, argoappv1.ApplicationCondition{
-					Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				violations = append(violations, ProjectRuleViolation{
+					Rule:    "cluster",
 					Message: fmt.Sprintf("cluster '%s' has not been configured", spec.Destination.Server),
 				})
 			} else {
 				return nil, err
 			}
+		} else if !cluster.IsNamespacePermitted(spec.Destination.Namespace) {
+			violations = append(violations, ProjectRuleViolation{
+				Rule:    "cluster",
+				Message: fmt.Sprintf("namespace '%s' is not permitted in cluster '%s'", spec.Destination.Namespace, spec.Destination.Server),
+				Allowed: cluster.Namespaces,
+			})
 		}
 	} else {
-		conditions = append(conditions, argoappv1.ApplicationCondition{Type: argoappv1.ApplicationConditionInvalidSpecError, Message: errDestinationMissing})
+		violations = append(violations, ProjectRuleViolation{Rule: "destination", Message: errDestinationMissing})
+	}
+	return violations, nil
+}
+
+// ValidatePermissions ensures that the referenced cluster has been added to Argo CD and the app source repo and destination namespace/cluster are permitted in app project
+func ValidatePermissions(ctx context.Context, spec *argoappv1.ApplicationSpec, proj *argoappv1.AppProject, db db.ArgoDB) ([]argoappv1.ApplicationCondition, error) {
+	violations, err := GetProjectRuleViolations(ctx, spec, proj, db)
+	if err != nil {
+		return nil, err
+	}
+	conditions := make([]argoappv1.ApplicationCondition, 0)
+	for _, violation := range violations {
+		conditions = append(conditions, argoappv1.ApplicationCondition{
+			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			Message: violation.Message,
+		})
 	}
 	return conditions, nil
 }
 
+// ValidateHelmReleaseName ensures that no other Helm application is rendered as a release of the same name in the
+// same destination, which is possible once release names are overridden
+func ValidateHelmReleaseName(app *argoappv1.Application, apps []*argoappv1.Application) []argoappv1.ApplicationCondition {
+	conditions := make([]argoappv1.ApplicationCondition, 0)
+	if !isHelmApp(app) {
+		return conditions
+	}
+	releaseName := app.GetHelmReleaseName()
+	for _, other := range apps {
+		if other.Name == app.Name || !isHelmApp(other) || other.GetHelmReleaseName() != releaseName {
+			continue
+		}
+		if other.Spec.Destination.Server != app.Spec.Destination.Server || other.Spec.Destination.Namespace != app.Spec.Destination.Namespace {
+			continue
+		}
+		conditions = append(conditions, argoappv1.ApplicationCondition{
+			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			Message: fmt.Sprintf("Helm release name '%s' is already used by application '%s' in the same destination", releaseName, other.Name),
+		})
+	}
+	return conditions
+}
+
+func isHelmApp(app *argoappv1.Application) bool {
+	return app.Spec.Source.Helm != nil || app.Status.SourceType == argoappv1.ApplicationSourceTypeHelm
+}
+
 // GetAppProject returns a project from an application
 func GetAppProject(spec *argoappv1.ApplicationSpec, projLister applicationsv1.AppProjectLister, ns string) (*argoappv1.AppProject, error) {
 	return projLister.AppProjects(ns).Get(spec.GetProject())
@@ -293,10 +367,12 @@ func verifyGenerateManifests(
 	repoRes *argoappv1.Repository,
 	repos argoappv1.Repositories,
 	spec *argoappv1.ApplicationSpec,
+	proj *argoappv1.AppProject,
 	repoClient apiclient.RepoServerServiceClient,
 	kustomizeOptions *argoappv1.KustomizeOptions,
 	plugins []*argoappv1.ConfigManagementPlugin,
 	kubeVersion string,
+	apiVersions []string,
 ) []argoappv1.ApplicationCondition {
 
 	var conditions []argoappv1.ApplicationCondition
@@ -313,13 +389,17 @@ func verifyGenerateManifests(
 			Type: repoRes.Type,
 			Name: repoRes.Name,
 		},
-		Repos:             repos,
-		Revision:          spec.Source.TargetRevision,
-		Namespace:         spec.Destination.Namespace,
-		ApplicationSource: &spec.Source,
-		Plugins:           plugins,
-		KustomizeOptions:  kustomizeOptions,
-		KubeVersion:       kubeVersion,
+		Repos:                  repos,
+		Revision:               spec.Source.TargetRevision,
+		Namespace:              spec.Destination.Namespace,
+		ApplicationSource:      &spec.Source,
+		Plugins:                plugins,
+		KustomizeOptions:       kustomizeOptions,
+		KubeVersion:            kubeVersion,
+		ApiVersions:            apiVersions,
+		DecryptionKeys:         proj.Spec.SourceDecryptionKeys,
+		ParameterOverridesFile: spec.GetParameterOverridesFile(),
+		ManifestQuota:          proj.Spec.ManifestQuota,
 	}
 	req.Repo.CopyCredentialsFrom(repoRes)
 
@@ -346,8 +426,14 @@ func SetAppOperation(appIf v1alpha1.ApplicationInterface, appName string, op *ar
 		if a.Operation != nil {
 			return nil, status.Errorf(codes.FailedPrecondition, "another operation is already in progress")
 		}
+		if a.Status.OperationState != nil {
+			// the status is a subresource, so the state of the previous operation is not cleared by the update below
+			a, err = appIf.Patch(appName, types.MergePatchType, []byte(`{"status":{"operationState":null}}`), "status")
+			if err != nil {
+				return nil, err
+			}
+		}
 		a.Operation = op
-		a.Status.OperationState = nil
 		a, err = appIf.Update(a)
 		if op.Sync == nil {
 			return nil, status.Errorf(codes.InvalidArgument, "Operation unspecified")
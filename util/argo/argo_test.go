@@ -7,19 +7,47 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes/fake"
 	testcore "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
 
+	"github.com/argoproj/argo-cd/common"
 	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/pkg/client/clientset/versioned/fake"
 	"github.com/argoproj/argo-cd/pkg/client/informers/externalversions/application/v1alpha1"
 	applisters "github.com/argoproj/argo-cd/pkg/client/listers/application/v1alpha1"
 	"github.com/argoproj/argo-cd/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/test"
+	"github.com/argoproj/argo-cd/util/db"
+	"github.com/argoproj/argo-cd/util/settings"
 )
 
+func TestSetAppOperation(t *testing.T) {
+	testApp := argoappv1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: "default"},
+		Status: argoappv1.ApplicationStatus{
+			OperationState: &argoappv1.OperationState{Phase: argoappv1.OperationSucceeded},
+		},
+	}
+	appIf := test.NewFakeAppClientsetWithStatusSubresource(&testApp).ArgoprojV1alpha1().Applications("default")
+	op := &argoappv1.Operation{Sync: &argoappv1.SyncOperation{Revision: "HEAD"}}
+
+	_, err := SetAppOperation(appIf, "test-app", op)
+	assert.NoError(t, err)
+
+	app, err := appIf.Get("test-app", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, op, app.Operation)
+	assert.Nil(t, app.Status.OperationState)
+
+	_, err = SetAppOperation(appIf, "test-app", op)
+	assert.Error(t, err)
+}
+
 func TestRefreshApp(t *testing.T) {
 	var testApp argoappv1.Application
 	testApp.Name = "test-app"
@@ -148,6 +176,101 @@ func TestValidatePermissionsEmptyDestination(t *testing.T) {
 	assert.ElementsMatch(t, conditions, []argoappv1.ApplicationCondition{{Type: argoappv1.ApplicationConditionInvalidSpecError, Message: "Destination server and/or namespace missing from app spec"}})
 }
 
+func TestGetProjectRuleViolations(t *testing.T) {
+	proj := &argoappv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-proj"},
+		Spec: argoappv1.AppProjectSpec{
+			SourceRepos:  []string{"https://github.com/argoproj/*"},
+			Destinations: []argoappv1.ApplicationDestination{{Server: "*", Namespace: "team-*"}},
+		},
+	}
+	kubeclientset := fake.NewSimpleClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDConfigMapName, Namespace: "default", Labels: map[string]string{"app.kubernetes.io/part-of": "argocd"}},
+		Data: map[string]string{"cluster.inCluster": `
+aliases:
+- server: https://team-b.in-cluster
+  namespaces: [team-b]`},
+	})
+	argoDB := db.NewDB("default", settings.NewSettingsManager(context.Background(), kubeclientset, "default"), kubeclientset)
+
+	violations, err := GetProjectRuleViolations(context.Background(), &argoappv1.ApplicationSpec{
+		Project:     "my-proj",
+		Source:      argoappv1.ApplicationSource{RepoURL: "https://github.com/argoproj/argo-cd", Path: "."},
+		Destination: argoappv1.ApplicationDestination{Server: common.KubernetesInternalAPIServerAddr, Namespace: "team-a"},
+	}, proj, argoDB)
+	assert.NoError(t, err)
+	assert.Empty(t, violations)
+
+	violations, err = GetProjectRuleViolations(context.Background(), &argoappv1.ApplicationSpec{
+		Project:     "my-proj",
+		Source:      argoappv1.ApplicationSource{RepoURL: "https://github.com/argoproj/argo-cd", Path: "."},
+		Destination: argoappv1.ApplicationDestination{Server: "https://unknown-cluster", Namespace: "kube-system"},
+	}, proj, argoDB)
+	assert.NoError(t, err)
+	assert.Equal(t, []ProjectRuleViolation{{
+		Rule:    "destinations",
+		Message: "application destination {https://unknown-cluster kube-system} is not permitted in project 'my-proj'",
+		Allowed: []string{"*, namespace team-*"},
+	}, {
+		Rule:    "cluster",
+		Message: "cluster 'https://unknown-cluster' has not been configured",
+	}}, violations)
+
+	violations, err = GetProjectRuleViolations(context.Background(), &argoappv1.ApplicationSpec{
+		Project:     "my-proj",
+		Source:      argoappv1.ApplicationSource{RepoURL: "https://github.com/argoproj/argo-cd", Path: "."},
+		Destination: argoappv1.ApplicationDestination{Server: "https://team-b.in-cluster", Namespace: "team-a"},
+	}, proj, argoDB)
+	assert.NoError(t, err)
+	assert.Equal(t, []ProjectRuleViolation{{
+		Rule:    "cluster",
+		Message: "namespace 'team-a' is not permitted in cluster 'https://team-b.in-cluster'",
+		Allowed: []string{"team-b"},
+	}}, violations)
+
+	violations, err = GetProjectRuleViolations(context.Background(), &argoappv1.ApplicationSpec{
+		Project: "my-proj",
+		Source:  argoappv1.ApplicationSource{RepoURL: "https://gitlab.com/other/repo", Path: "."},
+	}, proj, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []ProjectRuleViolation{{
+		Rule:    "sourceRepos",
+		Message: "application repo https://gitlab.com/other/repo is not permitted in project 'my-proj'",
+		Allowed: []string{"https://github.com/argoproj/*"},
+	}, {
+		Rule:    "destination",
+		Message: "Destination server and/or namespace missing from app spec",
+	}}, violations)
+}
+
+func TestValidateHelmReleaseName(t *testing.T) {
+	newApp := func(name, releaseName, namespace string) *argoappv1.Application {
+		return &argoappv1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: argoappv1.ApplicationSpec{
+				Source:      argoappv1.ApplicationSource{Helm: &argoappv1.ApplicationSourceHelm{ReleaseName: releaseName}},
+				Destination: argoappv1.ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: namespace},
+			},
+		}
+	}
+	existing := newApp("guestbook", "", "default")
+	existing.Spec.Source.Helm = nil
+	existing.Status.SourceType = argoappv1.ApplicationSourceTypeHelm
+	directory := newApp("directory", "", "default")
+	directory.Spec.Source.Helm = nil
+	apps := []*argoappv1.Application{existing, newApp("redis", "cache", "default"), directory}
+
+	conditions := ValidateHelmReleaseName(newApp("guestbook-2", "guestbook", "default"), apps)
+	if assert.Len(t, conditions, 1) {
+		assert.Equal(t, argoappv1.ApplicationConditionInvalidSpecError, conditions[0].Type)
+		assert.Contains(t, conditions[0].Message, "'guestbook'")
+	}
+	assert.Len(t, ValidateHelmReleaseName(newApp("cache", "", "default"), apps), 1)
+	assert.Len(t, ValidateHelmReleaseName(newApp("redis", "cache", "default"), apps), 0)
+	assert.Len(t, ValidateHelmReleaseName(newApp("guestbook-2", "guestbook", "other"), apps), 0)
+	assert.Len(t, ValidateHelmReleaseName(newApp("directory-2", "directory", "default"), apps), 0)
+}
+
 func Test_enrichSpec(t *testing.T) {
 	t.Run("Empty", func(t *testing.T) {
 		spec := &argoappv1.ApplicationSpec{}
@@ -0,0 +1,90 @@
+package argo
+
+import (
+	"sort"
+
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+// ResourceConflict is a live resource which is managed by two applications at the same time
+type ResourceConflict struct {
+	Server                 string
+	Group                  string
+	Kind                   string
+	Namespace              string
+	Name                   string
+	Application            string
+	ConflictingApplication string
+}
+
+type conflictKey struct {
+	server    string
+	group     string
+	kind      string
+	namespace string
+	name      string
+}
+
+// GetResourceConflicts returns every pair of applications whose status claims the same resource on the
+// same cluster. Hooks and resources which are only waiting to be pruned are not considered.
+func GetResourceConflicts(apps []*v1alpha1.Application) []ResourceConflict {
+	claims := make(map[conflictKey]map[string]bool)
+	for _, app := range apps {
+		for _, res := range app.Status.Resources {
+			if res.Hook || res.RequiresPruning {
+				continue
+			}
+			key := conflictKey{app.Spec.Destination.Server, res.Group, res.Kind, res.Namespace, res.Name}
+			if claims[key] == nil {
+				claims[key] = make(map[string]bool)
+			}
+			claims[key][app.Name] = true
+		}
+	}
+	conflicts := make([]ResourceConflict, 0)
+	for key, appNames := range claims {
+		if len(appNames) < 2 {
+			continue
+		}
+		names := make([]string, 0, len(appNames))
+		for name := range appNames {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for i := range names {
+			for j := i + 1; j < len(names); j++ {
+				conflicts = append(conflicts, ResourceConflict{
+					Server:                 key.server,
+					Group:                  key.group,
+					Kind:                   key.kind,
+					Namespace:              key.namespace,
+					Name:                   key.name,
+					Application:            names[i],
+					ConflictingApplication: names[j],
+				})
+			}
+		}
+	}
+	sort.Slice(conflicts, func(i, j int) bool {
+		a, b := conflicts[i], conflicts[j]
+		for _, pair := range [][2]string{{a.Server, b.Server}, {a.Group, b.Group}, {a.Kind, b.Kind}, {a.Namespace, b.Namespace},
+			{a.Name, b.Name}, {a.Application, b.Application}} {
+			if pair[0] != pair[1] {
+				return pair[0] < pair[1]
+			}
+		}
+		return a.ConflictingApplication < b.ConflictingApplication
+	})
+	return conflicts
+}
+
+// GetConflictingApplication returns the application a conflict is shared with, if the conflict involves the given application
+func (c ResourceConflict) GetConflictingApplication(appName string) (string, bool) {
+	switch appName {
+	case c.Application:
+		return c.ConflictingApplication, true
+	case c.ConflictingApplication:
+		return c.Application, true
+	}
+	return "", false
+}
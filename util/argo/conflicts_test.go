@@ -0,0 +1,62 @@
+package argo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+func newConflictTestApp(name string, server string, resources ...argoappv1.ResourceStatus) *argoappv1.Application {
+	return &argoappv1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       argoappv1.ApplicationSpec{Destination: argoappv1.ApplicationDestination{Server: server}},
+		Status:     argoappv1.ApplicationStatus{Resources: resources},
+	}
+}
+
+func TestGetResourceConflicts(t *testing.T) {
+	svc := argoappv1.ResourceStatus{Kind: "Service", Namespace: "default", Name: "guestbook"}
+	deploy := argoappv1.ResourceStatus{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook"}
+	hook := argoappv1.ResourceStatus{Kind: "Pod", Namespace: "default", Name: "hook", Hook: true}
+	extra := argoappv1.ResourceStatus{Kind: "ConfigMap", Namespace: "default", Name: "extra", RequiresPruning: true}
+
+	t.Run("NoConflicts", func(t *testing.T) {
+		conflicts := GetResourceConflicts([]*argoappv1.Application{
+			newConflictTestApp("a", "https://cluster", svc),
+			newConflictTestApp("b", "https://cluster", deploy),
+			newConflictTestApp("c", "https://other-cluster", svc),
+		})
+		assert.Empty(t, conflicts)
+	})
+
+	t.Run("IgnoresHooksAndPrunedResources", func(t *testing.T) {
+		conflicts := GetResourceConflicts([]*argoappv1.Application{
+			newConflictTestApp("a", "https://cluster", hook, extra),
+			newConflictTestApp("b", "https://cluster", hook, extra),
+		})
+		assert.Empty(t, conflicts)
+	})
+
+	t.Run("Pairs", func(t *testing.T) {
+		conflicts := GetResourceConflicts([]*argoappv1.Application{
+			newConflictTestApp("c", "https://cluster", svc),
+			newConflictTestApp("b", "https://cluster", svc, deploy),
+			newConflictTestApp("a", "https://cluster", svc, deploy),
+		})
+		assert.Equal(t, []ResourceConflict{
+			{Server: "https://cluster", Group: "", Kind: "Service", Namespace: "default", Name: "guestbook", Application: "a", ConflictingApplication: "b"},
+			{Server: "https://cluster", Group: "", Kind: "Service", Namespace: "default", Name: "guestbook", Application: "a", ConflictingApplication: "c"},
+			{Server: "https://cluster", Group: "", Kind: "Service", Namespace: "default", Name: "guestbook", Application: "b", ConflictingApplication: "c"},
+			{Server: "https://cluster", Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook", Application: "a", ConflictingApplication: "b"},
+		}, conflicts)
+
+		other, ok := conflicts[0].GetConflictingApplication("b")
+		assert.True(t, ok)
+		assert.Equal(t, "a", other)
+		_, ok = conflicts[0].GetConflictingApplication("c")
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,50 @@
+package argo
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/util/kube"
+)
+
+const (
+	labelKeyPartOf = "app.kubernetes.io/part-of"
+	labelKeyName   = "app.kubernetes.io/name"
+)
+
+var (
+	// argoCDCRDs are never pruned, since their deletion deletes all applications and projects
+	argoCDCRDs = map[string]bool{
+		"applications.argoproj.io": true,
+		"appprojects.argoproj.io":  true,
+	}
+	// criticalArgoCDComponents are the deployments without which Argo CD cannot redeploy itself once they are pruned
+	criticalArgoCDComponents = map[string]bool{
+		"argocd-server":                 true,
+		"argocd-application-controller": true,
+	}
+)
+
+// IsArgoCDComponent returns whether the object is part of an Argo CD installation, e.g. because it is managed by the
+// application which manages Argo CD itself
+func IsArgoCDComponent(obj *unstructured.Unstructured) bool {
+	return obj.GetLabels()[labelKeyPartOf] == "argocd" || IsArgoCDCRD(obj)
+}
+
+// IsArgoCDCRD returns whether the object is the CRD of applications or projects
+func IsArgoCDCRD(obj *unstructured.Unstructured) bool {
+	return kube.IsCRD(obj) && argoCDCRDs[obj.GetName()]
+}
+
+// IsCriticalArgoCDComponent returns whether the object is the argocd-server or the argocd-application-controller
+// deployment, which are identified by their app.kubernetes.io/name label, or by their name if they are not labeled
+func IsCriticalArgoCDComponent(obj *unstructured.Unstructured) bool {
+	gvk := obj.GroupVersionKind()
+	if (gvk.Group != "apps" && gvk.Group != "extensions") || gvk.Kind != kube.DeploymentKind {
+		return false
+	}
+	name := obj.GetLabels()[labelKeyName]
+	if name == "" {
+		name = obj.GetName()
+	}
+	return criticalArgoCDComponents[name]
+}
@@ -0,0 +1,30 @@
+package argo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func newObj(apiVersion string, kind string, name string, labels map[string]string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+	obj.SetName(name)
+	obj.SetLabels(labels)
+	return obj
+}
+
+func TestIsArgoCDComponent(t *testing.T) {
+	assert.True(t, IsArgoCDComponent(newObj("v1", "ConfigMap", "argocd-cm", map[string]string{"app.kubernetes.io/part-of": "argocd"})))
+	assert.True(t, IsArgoCDComponent(newObj("apiextensions.k8s.io/v1beta1", "CustomResourceDefinition", "applications.argoproj.io", nil)))
+	assert.False(t, IsArgoCDComponent(newObj("v1", "ConfigMap", "guestbook", nil)))
+}
+
+func TestIsCriticalArgoCDComponent(t *testing.T) {
+	assert.True(t, IsCriticalArgoCDComponent(newObj("apps/v1", "Deployment", "argocd-server", nil)))
+	assert.True(t, IsCriticalArgoCDComponent(newObj("apps/v1", "Deployment", "prod-argocd-application-controller", map[string]string{"app.kubernetes.io/name": "argocd-application-controller"})))
+	assert.False(t, IsCriticalArgoCDComponent(newObj("apps/v1", "Deployment", "argocd-repo-server", nil)))
+	assert.False(t, IsCriticalArgoCDComponent(newObj("v1", "Service", "argocd-server", nil)))
+}
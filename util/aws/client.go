@@ -0,0 +1,163 @@
+package aws
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	timeFormat      = "20060102T150405Z"
+	dateFormat      = "20060102"
+	contentTypeJSON = "application/x-amz-json-1.1"
+)
+
+// Client calls the JSON APIs of AWS services, such as Secrets Manager and KMS. Requests are signed using signature
+// version 4, with the credentials of the AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY and AWS_SESSION_TOKEN environment
+// variables.
+type Client struct {
+	service      string
+	targetPrefix string
+	region       string
+	endpoint     string
+	client       *http.Client
+	now          func() time.Time
+}
+
+// Error is an error returned by an AWS API
+type Error struct {
+	Type    string `json:"__type"`
+	Message string `json:"message"`
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %s", e.Type, e.Message)
+}
+
+// IsErrorType returns whether the error was returned by an AWS API with the given type, e.g. ResourceNotFoundException
+func IsErrorType(err error, errorType string) bool {
+	awsErr, ok := err.(*Error)
+	return ok && awsErr.Type == errorType
+}
+
+// NewClient returns a client of the given service, e.g. 'kms'. The target prefix is prepended to the action in the
+// X-Amz-Target header, e.g. 'TrentService.'. The endpoint defaults to the regional endpoint of the service.
+func NewClient(service string, targetPrefix string, region string, endpoint string) *Client {
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://%s.%s.amazonaws.com", service, region)
+	}
+	return &Client{
+		service:      service,
+		targetPrefix: targetPrefix,
+		region:       region,
+		endpoint:     strings.TrimSuffix(endpoint, "/"),
+		client:       &http.Client{Timeout: 30 * time.Second},
+		now:          time.Now,
+	}
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	_, _ = h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+func sha256Hex(data []byte) string {
+	h := sha256.Sum256(data)
+	return hex.EncodeToString(h[:])
+}
+
+// sign signs the request using AWS signature version 4
+func (c *Client) sign(req *http.Request, body []byte) {
+	now := c.now().UTC()
+	amzDate := now.Format(timeFormat)
+	date := now.Format(dateFormat)
+	req.Header.Set("X-Amz-Date", amzDate)
+	if token := os.Getenv("AWS_SESSION_TOKEN"); token != "" {
+		req.Header.Set("X-Amz-Security-Token", token)
+	}
+
+	signedHeaders := []string{"content-type", "host", "x-amz-date"}
+	if req.Header.Get("X-Amz-Security-Token") != "" {
+		signedHeaders = append(signedHeaders, "x-amz-security-token")
+	}
+	signedHeaders = append(signedHeaders, "x-amz-target")
+	var canonicalHeaders strings.Builder
+	for _, h := range signedHeaders {
+		value := req.Header.Get(h)
+		if h == "host" {
+			value = req.URL.Host
+		}
+		canonicalHeaders.WriteString(fmt.Sprintf("%s:%s\n", h, strings.TrimSpace(value)))
+	}
+	path := req.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		path,
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		strings.Join(signedHeaders, ";"),
+		sha256Hex(body),
+	}, "\n")
+
+	scope := fmt.Sprintf("%s/%s/%s/aws4_request", date, c.region, c.service)
+	stringToSign := strings.Join([]string{"AWS4-HMAC-SHA256", amzDate, scope, sha256Hex([]byte(canonicalRequest))}, "\n")
+	key := hmacSHA256([]byte("AWS4"+os.Getenv("AWS_SECRET_ACCESS_KEY")), date)
+	key = hmacSHA256(key, c.region)
+	key = hmacSHA256(key, c.service)
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		os.Getenv("AWS_ACCESS_KEY_ID"), scope, strings.Join(signedHeaders, ";"), signature))
+}
+
+// Call invokes an action of the API and decodes its response into out, unless out is nil
+func (c *Client) Call(action string, in interface{}, out interface{}) error {
+	body, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, c.endpoint+"/", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentTypeJSON)
+	req.Header.Set("X-Amz-Target", c.targetPrefix+action)
+	c.sign(req, body)
+	res, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = res.Body.Close() }()
+	data, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode != http.StatusOK {
+		awsErr := Error{}
+		if err := json.Unmarshal(data, &awsErr); err != nil || awsErr.Type == "" {
+			return fmt.Errorf("%s %s failed: %s", c.service, action, res.Status)
+		}
+		// the type might be qualified, e.g. 'com.amazonaws.secretsmanager#ResourceNotFoundException'
+		if i := strings.LastIndex(awsErr.Type, "#"); i >= 0 {
+			awsErr.Type = awsErr.Type[i+1:]
+		}
+		return &awsErr
+	}
+	if out != nil {
+		return json.Unmarshal(data, out)
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package aws
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSign(t *testing.T) {
+	client := NewClient("secretsmanager", "secretsmanager.", "us-east-1", "")
+	client.now = func() time.Time { return time.Date(2019, 7, 1, 12, 0, 0, 0, time.UTC) }
+	req, err := http.NewRequest(http.MethodPost, "https://secretsmanager.us-east-1.amazonaws.com/", nil)
+	assert.NoError(t, err)
+	req.Header.Set("Content-Type", contentTypeJSON)
+	req.Header.Set("X-Amz-Target", "secretsmanager.GetSecretValue")
+	client.sign(req, []byte("{}"))
+
+	assert.Equal(t, "20190701T120000Z", req.Header.Get("X-Amz-Date"))
+	auth := req.Header.Get("Authorization")
+	assert.Contains(t, auth, "/20190701/us-east-1/secretsmanager/aws4_request")
+	assert.Contains(t, auth, "SignedHeaders=content-type;host;x-amz-date")
+}
+
+func TestCall_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "TrentService.Sign", r.Header.Get("X-Amz-Target"))
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"__type":"com.amazonaws.kms#NotFoundException","message":"not found"}`))
+	}))
+	defer server.Close()
+	client := NewClient("kms", "TrentService.", "us-east-1", server.URL)
+
+	err := client.Call("Sign", map[string]string{}, nil)
+	assert.True(t, IsErrorType(err, "NotFoundException"))
+}
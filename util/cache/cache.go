@@ -3,6 +3,7 @@ package cache
 import (
 	"encoding/json"
 	"fmt"
+	"net"
 	"os"
 	"strings"
 	"time"
@@ -12,7 +13,9 @@ import (
 
 	"github.com/argoproj/argo-cd/common"
 	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/encryption"
 	"github.com/argoproj/argo-cd/util/hash"
+	tlsutil "github.com/argoproj/argo-cd/util/tls"
 )
 
 const (
@@ -21,6 +24,14 @@ const (
 	appStateCacheExpiration         = 1 * time.Hour
 	repoCacheExpiration             = 24 * time.Hour
 	oidcCacheExpiration             = 3 * time.Minute
+	staleManifestCacheExpiration    = 7 * 24 * time.Hour
+	syncReportCacheExpiration       = 7 * 24 * time.Hour
+	// controllerVersionCacheExpiration is longer than the interval in which the controller updates its version, so
+	// that the version expires only when the controller stopped
+	controllerVersionCacheExpiration = 1 * time.Hour
+
+	// syncReportsLimit is the number of sync reports which are kept per application
+	syncReportsLimit = 10
 
 	// envRedisPassword is a env variable name which stores redis password
 	envRedisPassword = "REDIS_PASSWORD"
@@ -42,32 +53,55 @@ func AddCacheFlagsToCmd(cmd *cobra.Command) func() (*Cache, error) {
 	sentinelAddresses := make([]string, 0)
 	sentinelMaster := ""
 	redisDB := 0
+	redisTLSDir := ""
 
 	cmd.Flags().StringVar(&redisAddress, "redis", "", "Redis server hostname and port (e.g. argocd-redis:6379). ")
 	cmd.Flags().IntVar(&redisDB, "redisdb", 0, "Redis database.")
 	cmd.Flags().StringArrayVar(&sentinelAddresses, "sentinel", []string{}, "Redis sentinel hostname and port (e.g. argocd-redis-ha-announce-0:6379). ")
 	cmd.Flags().StringVar(&sentinelMaster, "sentinelmaster", "master", "Redis sentinel master group name.")
+	cmd.Flags().StringVar(&redisTLSDir, "redis-tls-dir", "", "Directory of the certificate (tls.crt, tls.key) and CA (ca.crt) which secure the connections to Redis with mutual TLS.")
 	return func() (*Cache, error) {
 		password := os.Getenv(envRedisPassword)
+		encryptionKey := encryption.NewKeyFromEnv(encryption.PurposeCache)
+		var certs *tlsutil.InternalCerts
+		if redisTLSDir != "" {
+			var err error
+			if certs, err = tlsutil.NewInternalCerts(redisTLSDir); err != nil {
+				return nil, err
+			}
+		}
 		if len(sentinelAddresses) > 0 {
-			client := redis.NewFailoverClient(&redis.FailoverOptions{
+			options := &redis.FailoverOptions{
 				MasterName:    sentinelMaster,
 				SentinelAddrs: sentinelAddresses,
 				DB:            redisDB,
 				Password:      password,
-			})
-			return NewCache(NewRedisCache(client, defaultCacheExpiration)), nil
+			}
+			if certs != nil {
+				// the master is only known once the sentinels were asked, so its host name is not verified
+				options.TLSConfig = certs.ClientConfig("")
+			}
+			client := redis.NewFailoverClient(options)
+			return NewCache(NewRedisCache(client, defaultCacheExpiration, encryptionKey)), nil
 		}
 
 		if redisAddress == "" {
 			redisAddress = common.DefaultRedisAddr
 		}
-		client := redis.NewClient(&redis.Options{
+		options := &redis.Options{
 			Addr:     redisAddress,
 			Password: password,
 			DB:       redisDB,
-		})
-		return NewCache(NewRedisCache(client, defaultCacheExpiration)), nil
+		}
+		if certs != nil {
+			host, _, err := net.SplitHostPort(redisAddress)
+			if err != nil {
+				return nil, err
+			}
+			options.TLSConfig = certs.ClientConfig(host)
+		}
+		client := redis.NewClient(options)
+		return NewCache(NewRedisCache(client, defaultCacheExpiration, encryptionKey)), nil
 	}
 }
 
@@ -84,6 +118,10 @@ func appResourcesTreeKey(appName string) string {
 	return fmt.Sprintf("app|resources-tree|%s", appName)
 }
 
+func appSyncReportsKey(appName string) string {
+	return fmt.Sprintf("app|sync-reports|%s", appName)
+}
+
 func clusterConnectionStateKey(server string) string {
 	return fmt.Sprintf("cluster|%s|connection-state", server)
 }
@@ -92,6 +130,10 @@ func repoConnectionStateKey(repo string) string {
 	return fmt.Sprintf("repo|%s|connection-state", repo)
 }
 
+func repoFailureKey(repo string) string {
+	return fmt.Sprintf("repo|%s|failure", repo)
+}
+
 func listApps(repoURL, revision string) string {
 	return fmt.Sprintf("ldir|%s|%s", repoURL, revision)
 }
@@ -100,6 +142,14 @@ func oidcStateKey(key string) string {
 	return fmt.Sprintf("oidc|%s", key)
 }
 
+func controllerVersionKey() string {
+	return "controller|version"
+}
+
+func pingKey() string {
+	return "ping"
+}
+
 func manifestCacheKey(commitSHA string, appSrc *appv1.ApplicationSource, namespace string, appLabelKey string, appLabelValue string) string {
 	appSrc = appSrc.DeepCopy()
 	appSrc.RepoURL = ""        // superceded by commitSHA
@@ -109,6 +159,16 @@ func manifestCacheKey(commitSHA string, appSrc *appv1.ApplicationSource, namespa
 	return fmt.Sprintf("mfst|%s|%s|%s|%s|%d", appLabelKey, appLabelValue, commitSHA, namespace, fnva)
 }
 
+// staleManifestCacheKey identifies the last manifests generated for a source and a revision, which are served while
+// the repository is unreachable and the revision cannot be resolved to a commit
+func staleManifestCacheKey(revision string, appSrc *appv1.ApplicationSource, namespace string, appLabelKey string, appLabelValue string) string {
+	appSrc = appSrc.DeepCopy()
+	appSrc.TargetRevision = "" // superceded by revision
+	appSrcStr, _ := json.Marshal(appSrc)
+	fnva := hash.FNVa(string(appSrcStr))
+	return fmt.Sprintf("mfst-stale|%s|%s|%s|%s|%d", appLabelKey, appLabelValue, revision, namespace, fnva)
+}
+
 func appDetailsCacheKey(commitSHA, path string, valueFiles []string) string {
 	valuesStr := strings.Join(valueFiles, ",")
 	return fmt.Sprintf("appdetails|%s|%s|%s", commitSHA, path, valuesStr)
@@ -118,6 +178,10 @@ func revisionMetadataKey(repoURL, path, revision string) string {
 	return fmt.Sprintf("revisionmetadata|%s|%s|%s", repoURL, path, revision)
 }
 
+func revisionChangelogKey(repoURL, revision, targetRevision string) string {
+	return fmt.Sprintf("revisionchangelog|%s|%s|%s", repoURL, revision, targetRevision)
+}
+
 func (c *Cache) setItem(key string, item interface{}, expiration time.Duration, delete bool) error {
 	key = fmt.Sprintf("%s|%s", key, common.CacheVersion)
 	if delete {
@@ -152,6 +216,32 @@ func (c *Cache) SetAppResourcesTree(appName string, resourcesTree *appv1.Applica
 	return c.setItem(appResourcesTreeKey(appName), resourcesTree, appStateCacheExpiration, resourcesTree == nil)
 }
 
+// GetAppSyncReports returns the reports of the last sync operations of an application, newest first
+func (c *Cache) GetAppSyncReports(appName string) ([]*appv1.SyncReport, error) {
+	res := make([]*appv1.SyncReport, 0)
+	err := c.getItem(appSyncReportsKey(appName), &res)
+	return res, err
+}
+
+// AddAppSyncReport adds the report of a sync operation to the reports of an application, dropping the oldest reports
+// beyond the limit
+func (c *Cache) AddAppSyncReport(appName string, report *appv1.SyncReport) error {
+	reports, err := c.GetAppSyncReports(appName)
+	if err != nil && err != ErrCacheMiss {
+		return err
+	}
+	reports = append([]*appv1.SyncReport{report}, reports...)
+	if len(reports) > syncReportsLimit {
+		reports = reports[:syncReportsLimit]
+	}
+	return c.setItem(appSyncReportsKey(appName), reports, syncReportCacheExpiration, false)
+}
+
+// SetAppSyncReports replaces the sync reports of an application. Reports are deleted if nil.
+func (c *Cache) SetAppSyncReports(appName string, reports []*appv1.SyncReport) error {
+	return c.setItem(appSyncReportsKey(appName), reports, syncReportCacheExpiration, reports == nil)
+}
+
 func (c *Cache) GetClusterConnectionState(server string) (appv1.ConnectionState, error) {
 	res := appv1.ConnectionState{}
 	err := c.getItem(clusterConnectionStateKey(server), &res)
@@ -171,6 +261,16 @@ func (c *Cache) GetRepoConnectionState(repo string) (appv1.ConnectionState, erro
 func (c *Cache) SetRepoConnectionState(repo string, state *appv1.ConnectionState) error {
 	return c.setItem(repoConnectionStateKey(repo), &state, connectionStatusCacheExpiration, state == nil)
 }
+func (c *Cache) GetRepoFailure(repo string) (*appv1.RepositoryFailure, error) {
+	res := appv1.RepositoryFailure{}
+	err := c.getItem(repoFailureKey(repo), &res)
+	return &res, err
+}
+
+func (c *Cache) SetRepoFailure(repo string, failure *appv1.RepositoryFailure) error {
+	return c.setItem(repoFailureKey(repo), failure, repoCacheExpiration, failure == nil)
+}
+
 func (c *Cache) ListApps(repoUrl, revision string) (map[string]string, error) {
 	res := make(map[string]string)
 	err := c.getItem(listApps(repoUrl, revision), &res)
@@ -189,6 +289,14 @@ func (c *Cache) SetManifests(commitSHA string, appSrc *appv1.ApplicationSource,
 	return c.setItem(manifestCacheKey(commitSHA, appSrc, namespace, appLabelKey, appLabelValue), res, repoCacheExpiration, res == nil)
 }
 
+func (c *Cache) GetStaleManifests(revision string, appSrc *appv1.ApplicationSource, namespace string, appLabelKey string, appLabelValue string, res interface{}) error {
+	return c.getItem(staleManifestCacheKey(revision, appSrc, namespace, appLabelKey, appLabelValue), res)
+}
+
+func (c *Cache) SetStaleManifests(revision string, appSrc *appv1.ApplicationSource, namespace string, appLabelKey string, appLabelValue string, res interface{}) error {
+	return c.setItem(staleManifestCacheKey(revision, appSrc, namespace, appLabelKey, appLabelValue), res, staleManifestCacheExpiration, res == nil)
+}
+
 func (c *Cache) GetAppDetails(commitSHA, path string, valueFiles []string, res interface{}) error {
 	return c.getItem(appDetailsCacheKey(commitSHA, path, valueFiles), res)
 }
@@ -206,6 +314,15 @@ func (c *Cache) SetRevisionMetadata(repoURL, path, revision string, item *appv1.
 	return c.setItem(revisionMetadataKey(repoURL, path, revision), item, repoCacheExpiration, false)
 }
 
+func (c *Cache) GetRevisionChangelog(repoURL, revision, targetRevision string) (*appv1.RevisionChangelog, error) {
+	item := &appv1.RevisionChangelog{}
+	return item, c.getItem(revisionChangelogKey(repoURL, revision, targetRevision), item)
+}
+
+func (c *Cache) SetRevisionChangelog(repoURL, revision, targetRevision string, item *appv1.RevisionChangelog) error {
+	return c.setItem(revisionChangelogKey(repoURL, revision, targetRevision), item, repoCacheExpiration, false)
+}
+
 func (c *Cache) GetOIDCState(key string) (*OIDCState, error) {
 	res := OIDCState{}
 	err := c.getItem(oidcStateKey(key), &res)
@@ -215,3 +332,23 @@ func (c *Cache) GetOIDCState(key string) (*OIDCState, error) {
 func (c *Cache) SetOIDCState(key string, state *OIDCState) error {
 	return c.setItem(oidcStateKey(key), state, oidcCacheExpiration, state == nil)
 }
+
+// GetControllerVersion returns the version of the running application controller
+func (c *Cache) GetControllerVersion() (string, error) {
+	var version string
+	err := c.getItem(controllerVersionKey(), &version)
+	return version, err
+}
+
+func (c *Cache) SetControllerVersion(version string) error {
+	return c.setItem(controllerVersionKey(), version, controllerVersionCacheExpiration, false)
+}
+
+// Ping verifies the connectivity to the cache by writing an item and reading it back
+func (c *Cache) Ping() error {
+	if err := c.setItem(pingKey(), "pong", time.Minute, false); err != nil {
+		return err
+	}
+	var res string
+	return c.getItem(pingKey(), &res)
+}
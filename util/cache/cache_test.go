@@ -42,3 +42,25 @@ func TestCache_Apps(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, map[string]string{"foo": "bar"}, apps)
 }
+
+func TestCache_AppSyncReports(t *testing.T) {
+	cache := newFixtures().Cache
+	// cache miss
+	_, err := cache.GetAppSyncReports("my-app")
+	assert.Equal(t, ErrCacheMiss, err)
+	// newest first, up to the limit
+	for i := 1; i <= syncReportsLimit+2; i++ {
+		err = cache.AddAppSyncReport("my-app", &v1alpha1.SyncReport{ID: int64(i)})
+		assert.NoError(t, err)
+	}
+	reports, err := cache.GetAppSyncReports("my-app")
+	assert.NoError(t, err)
+	assert.Len(t, reports, syncReportsLimit)
+	assert.Equal(t, int64(syncReportsLimit+2), reports[0].ID)
+	assert.Equal(t, int64(3), reports[syncReportsLimit-1].ID)
+	// delete
+	err = cache.SetAppSyncReports("my-app", nil)
+	assert.NoError(t, err)
+	_, err = cache.GetAppSyncReports("my-app")
+	assert.Equal(t, ErrCacheMiss, err)
+}
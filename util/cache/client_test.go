@@ -1,10 +1,14 @@
 package cache
 
 import (
+	"bytes"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-cd/util/encryption"
 )
 
 type testStruct struct {
@@ -36,3 +40,46 @@ func TestCache(t *testing.T) {
 	err = c.Get("key", &obj)
 	assert.Equal(t, err, ErrCacheMiss)
 }
+
+func TestCompression(t *testing.T) {
+	small := []byte("small")
+	b, err := compress(small)
+	assert.NoError(t, err)
+	assert.Equal(t, small, b)
+
+	large := bytes.Repeat([]byte("apiVersion: v1\nkind: ConfigMap\n"), 1000)
+	b, err = compress(large)
+	assert.NoError(t, err)
+	assert.True(t, len(b) < len(large))
+
+	b, err = decompress(b)
+	assert.NoError(t, err)
+	assert.Equal(t, large, b)
+
+	b, err = decompress(small)
+	assert.NoError(t, err)
+	assert.Equal(t, small, b)
+}
+
+func TestRedisCache_Encryption(t *testing.T) {
+	key := encryption.NewKey([]byte("secret"), encryption.PurposeCache)
+	c := NewRedisCache(nil, time.Hour, key).(*redisCache)
+	obj := testStruct{Foo: strings.Repeat("manifest", 1000), Bar: []byte("bar")}
+
+	b, err := c.codec.Marshal(&obj)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(b), "manifest")
+	var res testStruct
+	assert.NoError(t, c.codec.Unmarshal(b, &res))
+	assert.Equal(t, obj, res)
+
+	// entries written with another key or without encryption are not readable
+	other := NewRedisCache(nil, time.Hour, encryption.NewKey([]byte("other"), encryption.PurposeCache)).(*redisCache)
+	assert.Equal(t, encryption.ErrDecryption, other.codec.Unmarshal(b, &res))
+	plain, err := NewRedisCache(nil, time.Hour, nil).(*redisCache).codec.Marshal(&obj)
+	assert.NoError(t, err)
+	assert.Equal(t, encryption.ErrDecryption, c.codec.Unmarshal(plain, &res))
+
+	assert.NotContains(t, c.redisKey("repo|https://github.com/argoproj/argo-cd|connection-state"), "github")
+	assert.Equal(t, "repo|https://github.com/argoproj/argo-cd|connection-state", NewRedisCache(nil, time.Hour, nil).(*redisCache).redisKey("repo|https://github.com/argoproj/argo-cd|connection-state"))
+}
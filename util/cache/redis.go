@@ -1,31 +1,100 @@
 package cache
 
 import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
 	"time"
 
 	rediscache "github.com/go-redis/cache"
 	"github.com/go-redis/redis"
 	"github.com/vmihailenco/msgpack"
+
+	"github.com/argoproj/argo-cd/util/encryption"
 )
 
-func NewRedisCache(client *redis.Client, expiration time.Duration) CacheClient {
+// NewRedisCache returns a cache client backed by redis. If an encryption key is given, entries are encrypted, and
+// keys are hashed, so that neither rendered manifests nor repository URLs can be read from redis.
+func NewRedisCache(client *redis.Client, expiration time.Duration, encryptionKey *encryption.Key) CacheClient {
 	return &redisCache{
-		expiration: expiration,
+		expiration:    expiration,
+		encryptionKey: encryptionKey,
 		codec: &rediscache.Codec{
 			Redis: client,
 			Marshal: func(v interface{}) ([]byte, error) {
-				return msgpack.Marshal(v)
+				b, err := msgpack.Marshal(v)
+				if err != nil {
+					return nil, err
+				}
+				if b, err = compress(b); err != nil {
+					return nil, err
+				}
+				if encryptionKey != nil {
+					return encryptionKey.Encrypt(b)
+				}
+				return b, nil
 			},
 			Unmarshal: func(b []byte, v interface{}) error {
+				var err error
+				if encryptionKey != nil {
+					if b, err = encryptionKey.Decrypt(b); err != nil {
+						return err
+					}
+				}
+				b, err = decompress(b)
+				if err != nil {
+					return err
+				}
 				return msgpack.Unmarshal(b, v)
 			},
 		},
 	}
 }
 
+// compressionThreshold is the size above which cache entries are compressed before they are stored
+const compressionThreshold = 1024
+
+func compress(b []byte) ([]byte, error) {
+	if len(b) <= compressionThreshold {
+		return b, nil
+	}
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(b); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// decompress returns the entry as is unless it starts with the gzip header, so that small entries, and entries
+// stored before compression was introduced, remain readable
+func decompress(b []byte) ([]byte, error) {
+	if len(b) < 2 || b[0] != 0x1f || b[1] != 0x8b {
+		return b, nil
+	}
+	r, err := gzip.NewReader(bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = r.Close() }()
+	return ioutil.ReadAll(r)
+}
+
 type redisCache struct {
-	expiration time.Duration
-	codec      *rediscache.Codec
+	expiration    time.Duration
+	encryptionKey *encryption.Key
+	codec         *rediscache.Codec
+}
+
+// redisKey returns the key the entry is stored at
+func (r *redisCache) redisKey(key string) string {
+	if r.encryptionKey == nil {
+		return key
+	}
+	return r.encryptionKey.Hash(key)
 }
 
 func (r *redisCache) Set(item *Item) error {
@@ -34,20 +103,21 @@ func (r *redisCache) Set(item *Item) error {
 		expiration = r.expiration
 	}
 	return r.codec.Set(&rediscache.Item{
-		Key:        item.Key,
+		Key:        r.redisKey(item.Key),
 		Object:     item.Object,
 		Expiration: expiration,
 	})
 }
 
 func (r *redisCache) Get(key string, obj interface{}) error {
-	err := r.codec.Get(key, obj)
-	if err == rediscache.ErrCacheMiss {
+	err := r.codec.Get(r.redisKey(key), obj)
+	// entries which cannot be decrypted were written with another key, or were tampered with
+	if err == rediscache.ErrCacheMiss || err == encryption.ErrDecryption {
 		return ErrCacheMiss
 	}
 	return err
 }
 
 func (r *redisCache) Delete(key string) error {
-	return r.codec.Delete(key)
+	return r.codec.Delete(r.redisKey(key))
 }
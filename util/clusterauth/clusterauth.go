@@ -35,6 +35,62 @@ var ArgoCDManagerPolicyRules = []rbacv1.PolicyRule{
 	},
 }
 
+// ArgoCDManagerReadOnlyPolicyRules are the policies to give argocd-manager if it may only observe resources
+var ArgoCDManagerReadOnlyPolicyRules = []rbacv1.PolicyRule{
+	{
+		APIGroups: []string{"*"},
+		Resources: []string{"*"},
+		Verbs:     []string{"get", "list", "watch"},
+	},
+	{
+		NonResourceURLs: []string{"*"},
+		Verbs:           []string{"get"},
+	},
+}
+
+// ClusterManagerRBACOptions are the permissions to give argocd-manager
+type ClusterManagerRBACOptions struct {
+	// ReadOnly grants permissions to observe all resources, but not to change them
+	ReadOnly bool
+	// Namespaces restricts the permissions to change resources to the given namespaces. Argo CD caches the resources of
+	// the whole cluster, so permissions to observe all resources are granted nevertheless.
+	Namespaces []string
+	// Rules replace the default rules granted in the namespaces, or in the whole cluster if no namespaces are given
+	Rules []rbacv1.PolicyRule
+}
+
+// Validate returns an error if the options are contradictory
+func (o ClusterManagerRBACOptions) Validate() error {
+	if o.ReadOnly && (len(o.Namespaces) > 0 || len(o.Rules) > 0) {
+		return fmt.Errorf("read-only permissions cannot be combined with namespaces or custom rules")
+	}
+	return nil
+}
+
+// clusterRules returns the rules of the ClusterRole of argocd-manager
+func (o ClusterManagerRBACOptions) clusterRules() []rbacv1.PolicyRule {
+	switch {
+	case o.ReadOnly || len(o.Namespaces) > 0:
+		return ArgoCDManagerReadOnlyPolicyRules
+	case len(o.Rules) > 0:
+		return o.Rules
+	default:
+		return ArgoCDManagerPolicyRules
+	}
+}
+
+// namespaceRules returns the rules of the Roles of argocd-manager in each of the namespaces
+func (o ClusterManagerRBACOptions) namespaceRules() []rbacv1.PolicyRule {
+	if len(o.Rules) > 0 {
+		return o.Rules
+	}
+	return []rbacv1.PolicyRule{{
+		APIGroups: []string{"*"},
+		Resources: []string{"*"},
+		Verbs:     []string{"*"},
+	}}
+}
+
 // CreateServiceAccount creates a service account in a given namespace
 func CreateServiceAccount(
 	clientset kubernetes.Interface,
@@ -137,15 +193,96 @@ func CreateClusterRoleBinding(
 	return nil
 }
 
+// CreateRole creates a role in a given namespace, or updates its rules if it already exists
+func CreateRole(
+	clientset kubernetes.Interface,
+	roleName string,
+	namespace string,
+	rules []rbacv1.PolicyRule,
+) error {
+	role := rbacv1.Role{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "rbac.authorization.k8s.io/v1",
+			Kind:       "Role",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      roleName,
+			Namespace: namespace,
+		},
+		Rules: rules,
+	}
+	roleClient := clientset.RbacV1().Roles(namespace)
+	_, err := roleClient.Create(&role)
+	if err != nil {
+		if !apierr.IsAlreadyExists(err) {
+			return fmt.Errorf("Failed to create Role %q in namespace %q: %v", roleName, namespace, err)
+		}
+		_, err = roleClient.Update(&role)
+		if err != nil {
+			return fmt.Errorf("Failed to update Role %q in namespace %q: %v", roleName, namespace, err)
+		}
+		log.Infof("Role %q updated in namespace %q", roleName, namespace)
+	} else {
+		log.Infof("Role %q created in namespace %q", roleName, namespace)
+	}
+	return nil
+}
+
+// CreateRoleBinding creates a RoleBinding of a service account to a role in a given namespace
+func CreateRoleBinding(
+	clientset kubernetes.Interface,
+	roleBindingName,
+	serviceAccountName,
+	roleName string,
+	namespace string,
+	serviceAccountNamespace string,
+) error {
+	roleBinding := rbacv1.RoleBinding{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "rbac.authorization.k8s.io/v1",
+			Kind:       "RoleBinding",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      roleBindingName,
+			Namespace: namespace,
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: "rbac.authorization.k8s.io",
+			Kind:     "Role",
+			Name:     roleName,
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Kind:      rbacv1.ServiceAccountKind,
+				Name:      serviceAccountName,
+				Namespace: serviceAccountNamespace,
+			},
+		},
+	}
+	_, err := clientset.RbacV1().RoleBindings(namespace).Create(&roleBinding)
+	if err != nil {
+		if !apierr.IsAlreadyExists(err) {
+			return fmt.Errorf("Failed to create RoleBinding %q in namespace %q: %v", roleBindingName, namespace, err)
+		}
+		log.Infof("RoleBinding %q already exists in namespace %q", roleBindingName, namespace)
+		return nil
+	}
+	log.Infof("RoleBinding %q created in namespace %q, bound %q to %q", roleBindingName, namespace, serviceAccountName, roleName)
+	return nil
+}
+
 // InstallClusterManagerRBAC installs RBAC resources for a cluster manager to operate a cluster. Returns a token
-func InstallClusterManagerRBAC(clientset kubernetes.Interface, ns string) (string, error) {
+func InstallClusterManagerRBAC(clientset kubernetes.Interface, ns string, opts ClusterManagerRBACOptions) (string, error) {
+	if err := opts.Validate(); err != nil {
+		return "", err
+	}
 
 	err := CreateServiceAccount(clientset, ArgoCDManagerServiceAccount, ns)
 	if err != nil {
 		return "", err
 	}
 
-	err = CreateClusterRole(clientset, ArgoCDManagerClusterRole, ArgoCDManagerPolicyRules)
+	err = CreateClusterRole(clientset, ArgoCDManagerClusterRole, opts.clusterRules())
 	if err != nil {
 		return "", err
 	}
@@ -155,6 +292,17 @@ func InstallClusterManagerRBAC(clientset kubernetes.Interface, ns string) (strin
 		return "", err
 	}
 
+	for _, namespace := range opts.Namespaces {
+		err = CreateRole(clientset, ArgoCDManagerClusterRole, namespace, opts.namespaceRules())
+		if err != nil {
+			return "", err
+		}
+		err = CreateRoleBinding(clientset, ArgoCDManagerClusterRoleBinding, ArgoCDManagerServiceAccount, ArgoCDManagerClusterRole, namespace, ns)
+		if err != nil {
+			return "", err
+		}
+	}
+
 	var serviceAccount *corev1.ServiceAccount
 	var secretName string
 	err = wait.Poll(500*time.Millisecond, 30*time.Second, func() (bool, error) {
@@ -7,6 +7,7 @@ import (
 	"github.com/ghodss/yaml"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -100,3 +101,57 @@ func TestRotateServiceAccountSecrets(t *testing.T) {
 	_, err = secretsClient.Get(testClaims.SecretName, metav1.GetOptions{})
 	assert.True(t, apierr.IsNotFound(err))
 }
+
+func TestInstallClusterManagerRBAC(t *testing.T) {
+	t.Run("Default", func(t *testing.T) {
+		kubeclientset := fake.NewSimpleClientset(newServiceAccount(), newServiceAccountSecret())
+		token, err := InstallClusterManagerRBAC(kubeclientset, "kube-system", ClusterManagerRBACOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, testToken, token)
+		clusterRole, err := kubeclientset.RbacV1().ClusterRoles().Get(ArgoCDManagerClusterRole, metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, ArgoCDManagerPolicyRules, clusterRole.Rules)
+	})
+	t.Run("ReadOnly", func(t *testing.T) {
+		kubeclientset := fake.NewSimpleClientset(newServiceAccount(), newServiceAccountSecret())
+		_, err := InstallClusterManagerRBAC(kubeclientset, "kube-system", ClusterManagerRBACOptions{ReadOnly: true})
+		assert.NoError(t, err)
+		clusterRole, err := kubeclientset.RbacV1().ClusterRoles().Get(ArgoCDManagerClusterRole, metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, ArgoCDManagerReadOnlyPolicyRules, clusterRole.Rules)
+		roles, err := kubeclientset.RbacV1().Roles("").List(metav1.ListOptions{})
+		assert.NoError(t, err)
+		assert.Empty(t, roles.Items)
+	})
+	t.Run("Namespaced", func(t *testing.T) {
+		rules := []rbacv1.PolicyRule{{APIGroups: []string{"apps"}, Resources: []string{"deployments"}, Verbs: []string{"*"}}}
+		kubeclientset := fake.NewSimpleClientset(newServiceAccount(), newServiceAccountSecret())
+		_, err := InstallClusterManagerRBAC(kubeclientset, "kube-system", ClusterManagerRBACOptions{Namespaces: []string{"ns1", "ns2"}, Rules: rules})
+		assert.NoError(t, err)
+		clusterRole, err := kubeclientset.RbacV1().ClusterRoles().Get(ArgoCDManagerClusterRole, metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, ArgoCDManagerReadOnlyPolicyRules, clusterRole.Rules)
+		for _, ns := range []string{"ns1", "ns2"} {
+			role, err := kubeclientset.RbacV1().Roles(ns).Get(ArgoCDManagerClusterRole, metav1.GetOptions{})
+			assert.NoError(t, err)
+			assert.Equal(t, rules, role.Rules)
+			binding, err := kubeclientset.RbacV1().RoleBindings(ns).Get(ArgoCDManagerClusterRoleBinding, metav1.GetOptions{})
+			assert.NoError(t, err)
+			assert.Equal(t, "Role", binding.RoleRef.Kind)
+			assert.Equal(t, "kube-system", binding.Subjects[0].Namespace)
+		}
+	})
+	t.Run("CustomRules", func(t *testing.T) {
+		rules := []rbacv1.PolicyRule{{APIGroups: []string{""}, Resources: []string{"configmaps"}, Verbs: []string{"*"}}}
+		kubeclientset := fake.NewSimpleClientset(newServiceAccount(), newServiceAccountSecret())
+		_, err := InstallClusterManagerRBAC(kubeclientset, "kube-system", ClusterManagerRBACOptions{Rules: rules})
+		assert.NoError(t, err)
+		clusterRole, err := kubeclientset.RbacV1().ClusterRoles().Get(ArgoCDManagerClusterRole, metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, rules, clusterRole.Rules)
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := InstallClusterManagerRBAC(fake.NewSimpleClientset(), "kube-system", ClusterManagerRBACOptions{ReadOnly: true, Namespaces: []string{"ns1"}})
+		assert.Error(t, err)
+	})
+}
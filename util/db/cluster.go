@@ -7,7 +7,9 @@ import (
 	"net/url"
 	"reflect"
 	"strings"
+	"time"
 
+	log "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -20,15 +22,50 @@ import (
 
 	"github.com/argoproj/argo-cd/common"
 	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/secrets"
+	"github.com/argoproj/argo-cd/util/settings"
+)
+
+const (
+	// The name of the key storing the cluster config in the secret
+	clusterConfig = "config"
+	// The name of the key storing the comma separated namespaces of the cluster in the secret
+	clusterNamespaces = "namespaces"
 )
 
 var (
+	// secretMetadataKeys are the labels and annotations of cluster secrets which Argo CD and kubectl maintain, rather
+	// than labels and annotations of the cluster
+	secretMetadataKeys = map[string]bool{
+		common.LabelKeySecretType:         true,
+		common.AnnotationKeyManagedBy:     true,
+		apiv1.LastAppliedConfigAnnotation: true,
+	}
 	localCluster = appv1.Cluster{
 		Server:          common.KubernetesInternalAPIServerAddr,
 		ConnectionState: appv1.ConnectionState{Status: appv1.ConnectionStatusSuccessful},
 	}
 )
 
+// newLocalCluster returns the implicit in-cluster destination
+func newLocalCluster(inCluster settings.InClusterSettings) *appv1.Cluster {
+	cluster := localCluster
+	cluster.Name = inCluster.Name
+	cluster.Namespaces = inCluster.Namespaces
+	return &cluster
+}
+
+// newInClusterAlias returns a destination which connects to the cluster Argo CD runs in under another server address
+func newInClusterAlias(alias settings.InClusterAlias) *appv1.Cluster {
+	return &appv1.Cluster{
+		Server:          alias.Server,
+		Name:            alias.Name,
+		Namespaces:      alias.Namespaces,
+		Config:          appv1.ClusterConfig{InCluster: true},
+		ConnectionState: localCluster.ConnectionState,
+	}
+}
+
 func (db *db) listClusterSecrets() ([]*apiv1.Secret, error) {
 	labelSelector := labels.NewSelector()
 	req, err := labels.NewRequirement(common.LabelKeySecretType, selection.Equals, []string{common.LabelValueSecretTypeCluster})
@@ -54,19 +91,29 @@ func (db *db) ListClusters(ctx context.Context) (*appv1.ClusterList, error) {
 	if err != nil {
 		return nil, err
 	}
+	inCluster, err := db.settingsMgr.GetInClusterSettings()
+	if err != nil {
+		return nil, err
+	}
 	clusterList := appv1.ClusterList{
 		Items: make([]appv1.Cluster, len(clusterSecrets)),
 	}
-	hasInClusterCredentials := false
+	servers := make(map[string]bool)
 	for i, clusterSecret := range clusterSecrets {
-		cluster := *secretToCluster(clusterSecret)
-		clusterList.Items[i] = cluster
-		if cluster.Server == common.KubernetesInternalAPIServerAddr {
-			hasInClusterCredentials = true
+		cluster, err := db.secretToCluster(clusterSecret)
+		if err != nil {
+			return nil, err
 		}
+		clusterList.Items[i] = *cluster
+		servers[cluster.Server] = true
 	}
-	if !hasInClusterCredentials {
-		clusterList.Items = append(clusterList.Items, localCluster)
+	if !servers[common.KubernetesInternalAPIServerAddr] && !inCluster.Disabled {
+		clusterList.Items = append(clusterList.Items, *newLocalCluster(inCluster))
+	}
+	for _, alias := range inCluster.Aliases {
+		if !servers[alias.Server] {
+			clusterList.Items = append(clusterList.Items, *newInClusterAlias(alias))
+		}
 	}
 	return &clusterList, nil
 }
@@ -88,7 +135,14 @@ func (db *db) CreateCluster(ctx context.Context, c *appv1.Cluster) (*appv1.Clust
 			},
 		},
 	}
-	clusterSecret.Data = clusterToData(c)
+	setClusterMetadata(clusterSecret, c)
+	if _, err = db.kubeclientset.CoreV1().Secrets(db.ns).Get(secName, metav1.GetOptions{}); err == nil {
+		return nil, status.Errorf(codes.AlreadyExists, "cluster %q already exists", c.Server)
+	}
+	clusterSecret.Data, err = db.storeClusterConfig(secName, clusterToData(c))
+	if err != nil {
+		return nil, err
+	}
 	clusterSecret, err = db.kubeclientset.CoreV1().Secrets(db.ns).Create(clusterSecret)
 	if err != nil {
 		if apierr.IsAlreadyExists(err) {
@@ -96,7 +150,11 @@ func (db *db) CreateCluster(ctx context.Context, c *appv1.Cluster) (*appv1.Clust
 		}
 		return nil, err
 	}
-	return secretToCluster(clusterSecret), db.settingsMgr.ResyncInformers()
+	cluster, err := db.secretToCluster(clusterSecret)
+	if err != nil {
+		return nil, err
+	}
+	return cluster, db.settingsMgr.ResyncInformers()
 }
 
 // ClusterEvent contains information about cluster event
@@ -120,28 +178,58 @@ func (db *db) WatchClusters(ctx context.Context, callback func(*ClusterEvent)) e
 		return err
 	}
 
-	localCls, err := db.GetCluster(ctx, common.KubernetesInternalAPIServerAddr)
+	inCluster, err := db.settingsMgr.GetInClusterSettings()
 	if err != nil {
 		return err
 	}
+	localCls, err := db.GetCluster(ctx, common.KubernetesInternalAPIServerAddr)
+	if err != nil {
+		if errorStatus, ok := status.FromError(err); !ok || errorStatus.Code() != codes.NotFound {
+			return err
+		}
+		// the in-cluster destination is disabled
+		localCls = nil
+	}
 
 	defer w.Stop()
 	done := make(chan bool)
 
-	// trigger callback with event for local cluster since it always considered added
-	callback(&ClusterEvent{Type: watch.Added, Cluster: localCls})
+	// trigger callback with event for local cluster and its aliases since they are always considered added
+	if localCls != nil {
+		callback(&ClusterEvent{Type: watch.Added, Cluster: localCls})
+	}
+	for _, alias := range inCluster.Aliases {
+		if aliasCls, err := db.GetCluster(ctx, alias.Server); err == nil && aliasCls.Config.InCluster {
+			callback(&ClusterEvent{Type: watch.Added, Cluster: aliasCls})
+		}
+	}
+
+	stopRotationWatch, err := db.watchRotatedClusters(callback)
+	if err != nil {
+		return err
+	}
+	defer stopRotationWatch()
 
 	go func() {
 		for next := range w.ResultChan() {
 			secret := next.Object.(*apiv1.Secret)
-			cluster := secretToCluster(secret)
+			cluster, err := db.secretToCluster(secret)
+			if err != nil {
+				log.Warnf("Failed to load cluster %s: %v", string(secret.Data["server"]), err)
+				continue
+			}
 
-			// change local cluster event to modified or deleted, since it cannot be re-added or deleted
+			// change local cluster event to modified or deleted, since it cannot be re-added or deleted unless it is disabled
 			if cluster.Server == common.KubernetesInternalAPIServerAddr {
 				if next.Type == watch.Deleted {
-					next.Type = watch.Modified
-					cluster = &localCluster
-				} else if next.Type == watch.Added {
+					if inCluster.Disabled {
+						localCls = nil
+					} else {
+						next.Type = watch.Modified
+						cluster = newLocalCluster(inCluster)
+						localCls = cluster
+					}
+				} else if next.Type == watch.Added && localCls != nil {
 					if !reflect.DeepEqual(localCls.Config, cluster.Config) {
 						localCls = cluster
 						next.Type = watch.Modified
@@ -168,13 +256,61 @@ func (db *db) WatchClusters(ctx context.Context, callback func(*ClusterEvent)) e
 	return nil
 }
 
+// watchRotatedClusters periodically refreshes the cluster credentials cached from an external secrets backend, and
+// emits a modification event for each cluster whose credentials were rotated. The returned function stops watching.
+func (db *db) watchRotatedClusters(callback func(*ClusterEvent)) (func(), error) {
+	backend, _, err := db.getSecretsBackend()
+	if err != nil {
+		return nil, err
+	}
+	cachingBackend, ok := backend.(*secrets.CachingBackend)
+	if !ok {
+		return func() {}, nil
+	}
+	unsubscribe := cachingBackend.OnRotate(func(name string) {
+		secretsLister, err := db.settingsMgr.GetSecretsLister()
+		if err != nil {
+			log.Warnf("Failed to get secrets lister: %v", err)
+			return
+		}
+		secret, err := secretsLister.Secrets(db.ns).Get(name)
+		if err != nil || secret.Labels[common.LabelKeySecretType] != common.LabelValueSecretTypeCluster {
+			return
+		}
+		cluster, err := db.secretToCluster(secret)
+		if err != nil {
+			log.Warnf("Failed to load rotated credentials of cluster %s: %v", string(secret.Data["server"]), err)
+			return
+		}
+		log.Infof("Credentials of cluster %s were rotated", cluster.Server)
+		callback(&ClusterEvent{Type: watch.Modified, Cluster: cluster})
+	})
+	ticker := time.NewTicker(cachingBackend.Expiration())
+	done := make(chan bool)
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				cachingBackend.Refresh()
+			case <-done:
+				return
+			}
+		}
+	}()
+	return func() {
+		ticker.Stop()
+		close(done)
+		unsubscribe()
+	}, nil
+}
+
 func (db *db) getClusterSecret(server string) (*apiv1.Secret, error) {
 	clusterSecrets, err := db.listClusterSecrets()
 	if err != nil {
 		return nil, err
 	}
 	for _, clusterSecret := range clusterSecrets {
-		if secretToCluster(clusterSecret).Server == server {
+		if string(clusterSecret.Data["server"]) == server {
 			return clusterSecret, nil
 		}
 	}
@@ -186,13 +322,24 @@ func (db *db) getClusterSecret(server string) (*apiv1.Secret, error) {
 func (db *db) GetCluster(ctx context.Context, server string) (*appv1.Cluster, error) {
 	clusterSecret, err := db.getClusterSecret(server)
 	if err != nil {
-		if errorStatus, ok := status.FromError(err); ok && errorStatus.Code() == codes.NotFound && server == common.KubernetesInternalAPIServerAddr {
-			return &localCluster, nil
-		} else {
+		if errorStatus, ok := status.FromError(err); !ok || errorStatus.Code() != codes.NotFound {
 			return nil, err
 		}
+		inCluster, settingsErr := db.settingsMgr.GetInClusterSettings()
+		if settingsErr != nil {
+			return nil, settingsErr
+		}
+		if server == common.KubernetesInternalAPIServerAddr && !inCluster.Disabled {
+			return newLocalCluster(inCluster), nil
+		}
+		for _, alias := range inCluster.Aliases {
+			if alias.Server == server {
+				return newInClusterAlias(alias), nil
+			}
+		}
+		return nil, err
 	}
-	return secretToCluster(clusterSecret), nil
+	return db.secretToCluster(clusterSecret)
 }
 
 // UpdateCluster updates a cluster
@@ -201,12 +348,21 @@ func (db *db) UpdateCluster(ctx context.Context, c *appv1.Cluster) (*appv1.Clust
 	if err != nil {
 		return nil, err
 	}
-	clusterSecret.Data = clusterToData(c)
+	clusterSecret = clusterSecret.DeepCopy()
+	setClusterMetadata(clusterSecret, c)
+	clusterSecret.Data, err = db.storeClusterConfig(clusterSecret.Name, clusterToData(c))
+	if err != nil {
+		return nil, err
+	}
 	clusterSecret, err = db.kubeclientset.CoreV1().Secrets(db.ns).Update(clusterSecret)
 	if err != nil {
 		return nil, err
 	}
-	return secretToCluster(clusterSecret), db.settingsMgr.ResyncInformers()
+	cluster, err := db.secretToCluster(clusterSecret)
+	if err != nil {
+		return nil, err
+	}
+	return cluster, db.settingsMgr.ResyncInformers()
 }
 
 // Delete deletes a cluster by name
@@ -222,7 +378,16 @@ func (db *db) DeleteCluster(ctx context.Context, name string) error {
 
 	canDelete := secret.Annotations != nil && secret.Annotations[common.AnnotationKeyManagedBy] == common.AnnotationValueManagedByArgoCD
 
+	backend, external, err := db.getSecretsBackend()
+	if err != nil {
+		return err
+	}
 	if canDelete {
+		if external {
+			if err = backend.Update(secret.Name, map[string][]byte{clusterConfig: nil}); err != nil {
+				return err
+			}
+		}
 		err = db.kubeclientset.CoreV1().Secrets(db.ns).Delete(secret.Name, &metav1.DeleteOptions{})
 	} else {
 		delete(secret.Labels, common.LabelKeySecretType)
@@ -260,21 +425,95 @@ func clusterToData(c *appv1.Cluster) map[string][]byte {
 	if err != nil {
 		panic(err)
 	}
-	data["config"] = configBytes
+	data[clusterConfig] = configBytes
+	if len(c.Namespaces) > 0 {
+		data[clusterNamespaces] = []byte(strings.Join(c.Namespaces, ","))
+	}
 	return data
 }
 
-// secretToCluster converts a secret into a repository object
-func secretToCluster(s *apiv1.Secret) *appv1.Cluster {
+// setClusterMetadata replaces the labels and annotations of the cluster secret with those of the cluster, but keeps
+// those which Argo CD and kubectl maintain
+func setClusterMetadata(s *apiv1.Secret, c *appv1.Cluster) {
+	merge := func(secretMetadata map[string]string, clusterMetadata map[string]string) map[string]string {
+		res := make(map[string]string)
+		for k, v := range secretMetadata {
+			if secretMetadataKeys[k] {
+				res[k] = v
+			}
+		}
+		for k, v := range clusterMetadata {
+			if !secretMetadataKeys[k] {
+				res[k] = v
+			}
+		}
+		return res
+	}
+	s.Labels = merge(s.Labels, c.Labels)
+	s.Annotations = merge(s.Annotations, c.Annotations)
+}
+
+// clusterMetadata returns the labels or annotations of a cluster secret without those which Argo CD and kubectl
+// maintain, or nil if there are none
+func clusterMetadata(secretMetadata map[string]string) map[string]string {
+	var res map[string]string
+	for k, v := range secretMetadata {
+		if !secretMetadataKeys[k] {
+			if res == nil {
+				res = make(map[string]string)
+			}
+			res[k] = v
+		}
+	}
+	return res
+}
+
+// storeClusterConfig stores the cluster config in the secrets backend if it is external, and returns the data of the
+// cluster secret without it. The data is returned unchanged otherwise.
+func (db *db) storeClusterConfig(secretName string, data map[string][]byte) (map[string][]byte, error) {
+	backend, external, err := db.getSecretsBackend()
+	if err != nil {
+		return nil, err
+	}
+	if !external {
+		return data, nil
+	}
+	if err = backend.Update(secretName, map[string][]byte{clusterConfig: data[clusterConfig]}); err != nil {
+		return nil, err
+	}
+	delete(data, clusterConfig)
+	return data, nil
+}
+
+// secretToCluster converts a secret into a cluster object. The cluster config is loaded from the secrets backend
+// if the secret does not contain it.
+func (db *db) secretToCluster(s *apiv1.Secret) (*appv1.Cluster, error) {
+	configBytes, ok := s.Data[clusterConfig]
+	if !ok {
+		backend, _, err := db.getSecretsBackend()
+		if err != nil {
+			return nil, err
+		}
+		data, err := backend.Get(s.Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load config of cluster %s: %v", string(s.Data["server"]), err)
+		}
+		configBytes = data[clusterConfig]
+	}
 	var config appv1.ClusterConfig
-	err := json.Unmarshal(s.Data["config"], &config)
+	err := json.Unmarshal(configBytes, &config)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 	cluster := appv1.Cluster{
-		Server: string(s.Data["server"]),
-		Name:   string(s.Data["name"]),
-		Config: config,
+		Server:      string(s.Data["server"]),
+		Name:        string(s.Data["name"]),
+		Config:      config,
+		Labels:      clusterMetadata(s.Labels),
+		Annotations: clusterMetadata(s.Annotations),
 	}
-	return &cluster
+	if namespaces := string(s.Data[clusterNamespaces]); namespaces != "" {
+		cluster.Namespaces = strings.Split(namespaces, ",")
+	}
+	return &cluster, nil
 }
@@ -2,11 +2,15 @@ package db
 
 import (
 	"context"
+	"sync"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/kubernetes"
 
 	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/secrets"
 	"github.com/argoproj/argo-cd/util/settings"
 )
 
@@ -47,44 +51,80 @@ type db struct {
 	ns            string
 	kubeclientset kubernetes.Interface
 	settingsMgr   *settings.SettingsManager
+	// newSecretsBackend creates the backend which stores repository and cluster credentials
+	newSecretsBackend func(config *secrets.Config) (secrets.Backend, error)
+	// secretsBackend stores repository and cluster credentials. It is created on first use, so the backend
+	// configuration is read once. secretsBackendExternal is whether it stores credentials outside of the Argo CD
+	// namespace. Both are guarded by secretsBackendLock.
+	secretsBackend         secrets.Backend
+	secretsBackendExternal bool
+	secretsBackendLock     sync.Mutex
 }
 
 // NewDB returns a new instance of the argo database
 func NewDB(namespace string, settingsMgr *settings.SettingsManager, kubeclientset kubernetes.Interface) ArgoDB {
+	return newDB(namespace, settingsMgr, kubeclientset, func(config *secrets.Config) (secrets.Backend, error) {
+		return secrets.NewBackend(config, kubeclientset, namespace, settingsMgr.GetSecretsLister)
+	})
+}
+
+func newDB(namespace string, settingsMgr *settings.SettingsManager, kubeclientset kubernetes.Interface, newSecretsBackend func(config *secrets.Config) (secrets.Backend, error)) *db {
 	return &db{
-		settingsMgr:   settingsMgr,
-		ns:            namespace,
-		kubeclientset: kubeclientset,
+		settingsMgr:       settingsMgr,
+		ns:                namespace,
+		kubeclientset:     kubeclientset,
+		newSecretsBackend: newSecretsBackend,
 	}
 }
 
-func (db *db) getSecret(name string, cache map[string]*v1.Secret) (*v1.Secret, error) {
-	secret, ok := cache[name]
+// getSecretsBackend returns the backend storing repository and cluster credentials, and whether it stores them
+// outside of the Argo CD namespace
+func (db *db) getSecretsBackend() (secrets.Backend, bool, error) {
+	db.secretsBackendLock.Lock()
+	defer db.secretsBackendLock.Unlock()
+	if db.secretsBackend != nil {
+		return db.secretsBackend, db.secretsBackendExternal, nil
+	}
+	config, err := db.settingsMgr.GetSecretsBackendConfig()
+	if err != nil {
+		return nil, false, err
+	}
+	backend, err := db.newSecretsBackend(config)
+	if err != nil {
+		return nil, false, err
+	}
+	db.secretsBackend = backend
+	db.secretsBackendExternal = config.IsExternal()
+	return backend, db.secretsBackendExternal, nil
+}
+
+func (db *db) getSecret(name string, cache map[string]map[string][]byte) (map[string][]byte, error) {
+	data, ok := cache[name]
 	if !ok {
-		secretsLister, err := db.settingsMgr.GetSecretsLister()
+		backend, _, err := db.getSecretsBackend()
 		if err != nil {
 			return nil, err
 		}
-		secret, err = secretsLister.Secrets(db.ns).Get(name)
+		data, err = backend.Get(name)
 		if err != nil {
+			if err == secrets.ErrNotFound {
+				return nil, status.Errorf(codes.NotFound, "secret %s not found", name)
+			}
 			return nil, err
 		}
-		if secret.Data == nil {
-			secret.Data = make(map[string][]byte)
-		}
-		cache[name] = secret
+		cache[name] = data
 	}
-	return secret, nil
+	return data, nil
 }
 
-func (db *db) unmarshalFromSecretsStr(secrets map[*string]*v1.SecretKeySelector, cache map[string]*v1.Secret) error {
+func (db *db) unmarshalFromSecretsStr(secrets map[*string]*v1.SecretKeySelector, cache map[string]map[string][]byte) error {
 	for dst, src := range secrets {
 		if src != nil {
-			secret, err := db.getSecret(src.Name, cache)
+			data, err := db.getSecret(src.Name, cache)
 			if err != nil {
 				return err
 			}
-			*dst = string(secret.Data[src.Key])
+			*dst = string(data[src.Key])
 		}
 	}
 	return nil
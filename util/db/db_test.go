@@ -16,6 +16,7 @@ import (
 
 	"github.com/argoproj/argo-cd/common"
 	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/secrets"
 	"github.com/argoproj/argo-cd/util/settings"
 )
 
@@ -309,6 +310,196 @@ func TestCreateClusterSuccessful(t *testing.T) {
 	assert.Equal(t, common.AnnotationValueManagedByArgoCD, secret.Annotations[common.AnnotationKeyManagedBy])
 }
 
+func TestInClusterDestinations(t *testing.T) {
+	inCluster := `
+name: in-cluster
+namespaces: [default]
+aliases:
+- server: https://prod.in-cluster
+  name: prod
+  namespaces: [prod-*]`
+	clientset := getClientset(map[string]string{"cluster.inCluster": inCluster})
+	db := NewDB(testNamespace, settings.NewSettingsManager(context.Background(), clientset, testNamespace), clientset)
+
+	cluster, err := db.GetCluster(context.Background(), common.KubernetesInternalAPIServerAddr)
+	assert.NoError(t, err)
+	assert.Equal(t, "in-cluster", cluster.Name)
+	assert.Equal(t, []string{"default"}, cluster.Namespaces)
+
+	alias, err := db.GetCluster(context.Background(), "https://prod.in-cluster")
+	assert.NoError(t, err)
+	assert.Equal(t, "prod", alias.Name)
+	assert.True(t, alias.Config.InCluster)
+	assert.True(t, alias.IsNamespacePermitted("prod-a"))
+	assert.False(t, alias.IsNamespacePermitted("default"))
+
+	clusters, err := db.ListClusters(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, clusters.Items, 2)
+
+	clientset = getClientset(map[string]string{"cluster.inCluster": "disabled: true"})
+	db = NewDB(testNamespace, settings.NewSettingsManager(context.Background(), clientset, testNamespace), clientset)
+	_, err = db.GetCluster(context.Background(), common.KubernetesInternalAPIServerAddr)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	clusters, err = db.ListClusters(context.Background())
+	assert.NoError(t, err)
+	assert.Empty(t, clusters.Items)
+}
+
+func TestClusterInClusterConfigNotStored(t *testing.T) {
+	clientset := getClientset(nil)
+	db := NewDB(testNamespace, settings.NewSettingsManager(context.Background(), clientset, testNamespace), clientset)
+
+	// only aliases of the in-cluster destination connect with the service account of Argo CD
+	_, err := db.CreateCluster(context.Background(), &v1alpha1.Cluster{Server: "https://mycluster", Config: v1alpha1.ClusterConfig{InCluster: true}})
+	assert.NoError(t, err)
+	secret, err := clientset.CoreV1().Secrets(testNamespace).Get("cluster-mycluster-3274446258", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.NotContains(t, string(secret.Data["config"]), "inCluster")
+
+	secret.Data["config"] = []byte(`{"inCluster":true}`)
+	_, err = clientset.CoreV1().Secrets(testNamespace).Update(secret)
+	assert.NoError(t, err)
+	cluster, err := db.GetCluster(context.Background(), "https://mycluster")
+	assert.NoError(t, err)
+	assert.False(t, cluster.Config.InCluster)
+}
+
+func TestClusterNamespaces(t *testing.T) {
+	clientset := getClientset(nil)
+	db := NewDB(testNamespace, settings.NewSettingsManager(context.Background(), clientset, testNamespace), clientset)
+
+	_, err := db.CreateCluster(context.Background(), &v1alpha1.Cluster{Server: "https://mycluster", Namespaces: []string{"ns1", "ns2"}})
+	assert.NoError(t, err)
+	cluster, err := db.GetCluster(context.Background(), "https://mycluster")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ns1", "ns2"}, cluster.Namespaces)
+}
+
+func TestClusterLabels(t *testing.T) {
+	clientset := getClientset(nil)
+	db := NewDB(testNamespace, settings.NewSettingsManager(context.Background(), clientset, testNamespace), clientset)
+
+	_, err := db.CreateCluster(context.Background(), &v1alpha1.Cluster{
+		Server:      "https://mycluster",
+		Labels:      map[string]string{"env": "prod"},
+		Annotations: map[string]string{"owner": "payments"},
+	})
+	assert.NoError(t, err)
+	cluster, err := db.GetCluster(context.Background(), "https://mycluster")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"env": "prod"}, cluster.Labels)
+	assert.Equal(t, map[string]string{"owner": "payments"}, cluster.Annotations)
+
+	cluster.Labels = map[string]string{"region": "eu"}
+	cluster.Annotations = nil
+	_, err = db.UpdateCluster(context.Background(), cluster)
+	assert.NoError(t, err)
+	secret, err := clientset.CoreV1().Secrets(testNamespace).Get("cluster-mycluster-3274446258", metav1.GetOptions{})
+	assert.NoError(t, err)
+	// the labels and annotations which Argo CD maintains are kept
+	assert.Equal(t, map[string]string{"region": "eu", common.LabelKeySecretType: common.LabelValueSecretTypeCluster}, secret.Labels)
+	assert.Equal(t, map[string]string{common.AnnotationKeyManagedBy: common.AnnotationValueManagedByArgoCD}, secret.Annotations)
+}
+
+type fakeSecretsBackend struct {
+	secrets map[string]map[string][]byte
+}
+
+func (b *fakeSecretsBackend) Get(name string) (map[string][]byte, error) {
+	data, ok := b.secrets[name]
+	if !ok {
+		return nil, secrets.ErrNotFound
+	}
+	return data, nil
+}
+
+func (b *fakeSecretsBackend) Update(name string, data map[string][]byte) error {
+	secret := b.secrets[name]
+	if secret == nil {
+		secret = make(map[string][]byte)
+	}
+	for k, v := range data {
+		if len(v) == 0 {
+			delete(secret, k)
+		} else {
+			secret[k] = v
+		}
+	}
+	if len(secret) == 0 {
+		delete(b.secrets, name)
+	} else {
+		b.secrets[name] = secret
+	}
+	return nil
+}
+
+// newDBWithExternalSecretsBackend returns a database which is configured to store credentials in a vault, and uses
+// the given backend instead
+func newDBWithExternalSecretsBackend(t *testing.T, clientset *fake.Clientset, backend secrets.Backend) ArgoDB {
+	settingsMgr := settings.NewSettingsManager(context.Background(), clientset, testNamespace)
+	return newDB(testNamespace, settingsMgr, clientset, func(config *secrets.Config) (secrets.Backend, error) {
+		assert.Equal(t, secrets.BackendTypeVault, config.Type)
+		return backend, nil
+	})
+}
+
+func TestClusterWithExternalSecretsBackend(t *testing.T) {
+	clusterURL := "https://mycluster"
+	clusterName := "cluster-mycluster-3274446258"
+	clientset := getClientset(map[string]string{"secrets.backend": "type: vault"})
+	backend := &fakeSecretsBackend{secrets: make(map[string]map[string][]byte)}
+	argoDB := newDBWithExternalSecretsBackend(t, clientset, backend)
+
+	_, err := argoDB.CreateCluster(context.Background(), &v1alpha1.Cluster{
+		Server: clusterURL,
+		Config: v1alpha1.ClusterConfig{BearerToken: "token"},
+	})
+	assert.NoError(t, err)
+
+	secret, err := clientset.CoreV1().Secrets(testNamespace).Get(clusterName, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, clusterURL, string(secret.Data["server"]))
+	assert.NotContains(t, secret.Data, "config")
+	assert.Contains(t, string(backend.secrets[clusterName]["config"]), "token")
+
+	cluster, err := argoDB.GetCluster(context.Background(), clusterURL)
+	assert.NoError(t, err)
+	assert.Equal(t, "token", cluster.Config.BearerToken)
+
+	// the backend is initialized by the deletion itself, e.g. in a new process
+	argoDB = newDBWithExternalSecretsBackend(t, clientset, backend)
+	err = argoDB.DeleteCluster(context.Background(), clusterURL)
+	assert.NoError(t, err)
+	assert.Empty(t, backend.secrets)
+}
+
+func TestRepositoryWithExternalSecretsBackend(t *testing.T) {
+	clientset := getClientset(map[string]string{"secrets.backend": "type: vault"})
+	backend := &fakeSecretsBackend{secrets: make(map[string]map[string][]byte)}
+	argoDB := newDBWithExternalSecretsBackend(t, clientset, backend)
+	repoURL := "https://github.com/argoproj/argocd-example-apps"
+
+	_, err := argoDB.CreateRepository(context.Background(), &v1alpha1.Repository{
+		Repo:     repoURL,
+		Username: "test-username",
+		Password: "test-password",
+	})
+	assert.NoError(t, err)
+	_, err = clientset.CoreV1().Secrets(testNamespace).Get(repoURLToSecretName(repoURL), metav1.GetOptions{})
+	assert.True(t, errors.IsNotFound(err))
+	assert.Equal(t, "test-password", string(backend.secrets[repoURLToSecretName(repoURL)][password]))
+
+	repo, err := argoDB.GetRepository(context.Background(), repoURL)
+	assert.NoError(t, err)
+	assert.Equal(t, "test-username", repo.Username)
+	assert.Equal(t, "test-password", repo.Password)
+
+	err = argoDB.DeleteRepository(context.Background(), repoURL)
+	assert.NoError(t, err)
+	assert.Empty(t, backend.secrets)
+}
+
 func TestDeleteClusterWithManagedSecret(t *testing.T) {
 	clusterURL := "https://mycluster"
 	clusterName := "cluster-mycluster-3274446258"
@@ -10,10 +10,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	apiv1 "k8s.io/api/core/v1"
-	apierr "k8s.io/apimachinery/pkg/api/errors"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
-	"github.com/argoproj/argo-cd/common"
 	appsv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/util/git"
 	"github.com/argoproj/argo-cd/util/settings"
@@ -61,6 +58,7 @@ func (db *db) CreateRepository(ctx context.Context, r *appsv1.Repository) (*apps
 		InsecureIgnoreHostKey: r.IsInsecure(),
 		Insecure:              r.IsInsecure(),
 		EnableLFS:             r.EnableLFS,
+		WebhookID:             r.WebhookID,
 	}
 	err = db.updateSecrets(&repoInfo, r)
 	if err != nil {
@@ -139,6 +137,7 @@ func (db *db) credentialsToRepository(repoInfo settings.RepoCredentials) (*appsv
 		InsecureIgnoreHostKey: repoInfo.InsecureIgnoreHostKey,
 		Insecure:              repoInfo.Insecure,
 		EnableLFS:             repoInfo.EnableLFS,
+		WebhookID:             repoInfo.WebhookID,
 	}
 	err := db.unmarshalFromSecretsStr(map[*string]*apiv1.SecretKeySelector{
 		&repo.Username:          repoInfo.UsernameSecret,
@@ -147,7 +146,7 @@ func (db *db) credentialsToRepository(repoInfo settings.RepoCredentials) (*appsv
 		&repo.TLSClientCertData: repoInfo.TLSClientCertDataSecret,
 		&repo.TLSClientCertKey:  repoInfo.TLSClientCertKeySecret,
 		&repo.TLSClientCAData:   repoInfo.TLSClientCASecret,
-	}, make(map[string]*apiv1.Secret))
+	}, make(map[string]map[string][]byte))
 	return repo, err
 }
 
@@ -173,6 +172,10 @@ func (db *db) UpdateRepository(ctx context.Context, r *appsv1.Repository) (*apps
 	repoInfo.InsecureIgnoreHostKey = r.IsInsecure()
 	repoInfo.Insecure = r.IsInsecure()
 	repoInfo.EnableLFS = r.EnableLFS
+	// clients do not know the webhook which Argo CD created, so it is only replaced by another one
+	if r.WebhookID != "" {
+		repoInfo.WebhookID = r.WebhookID
+	}
 
 	repos[index] = repoInfo
 	err = db.settingsMgr.SaveRepositories(repos)
@@ -223,9 +226,8 @@ func (db *db) updateSecrets(repoInfo *settings.RepoCredentials, r *appsv1.Reposi
 			if !ok {
 				data = map[string][]byte{}
 			}
-			if value != "" {
-				data[secretKey.Key] = []byte(value)
-			}
+			// an empty value removes the key from the secret
+			data[secretKey.Key] = []byte(value)
 			secretsData[secretKey.Name] = data
 		}
 
@@ -241,8 +243,12 @@ func (db *db) updateSecrets(repoInfo *settings.RepoCredentials, r *appsv1.Reposi
 	repoInfo.SSHPrivateKeySecret = setSecretData(repoInfo.SSHPrivateKeySecret, r.SSHPrivateKey, sshPrivateKey)
 	repoInfo.TLSClientCertDataSecret = setSecretData(repoInfo.TLSClientCertDataSecret, r.TLSClientCertData, tlsClientCertData)
 	repoInfo.TLSClientCertKeySecret = setSecretData(repoInfo.TLSClientCertKeySecret, r.TLSClientCertKey, tlsClientCertKey)
+	backend, _, err := db.getSecretsBackend()
+	if err != nil {
+		return err
+	}
 	for k, v := range secretsData {
-		err := db.upsertSecret(k, v)
+		err := backend.Update(k, v)
 		if err != nil {
 			return err
 		}
@@ -250,54 +256,6 @@ func (db *db) updateSecrets(repoInfo *settings.RepoCredentials, r *appsv1.Reposi
 	return nil
 }
 
-func (db *db) upsertSecret(name string, data map[string][]byte) error {
-	secret, err := db.kubeclientset.CoreV1().Secrets(db.ns).Get(name, metav1.GetOptions{})
-	if err != nil {
-		if apierr.IsNotFound(err) {
-			if len(data) == 0 {
-				return nil
-			}
-			_, err = db.kubeclientset.CoreV1().Secrets(db.ns).Create(&apiv1.Secret{
-				ObjectMeta: metav1.ObjectMeta{
-					Name: name,
-					Annotations: map[string]string{
-						common.AnnotationKeyManagedBy: common.AnnotationValueManagedByArgoCD,
-					},
-				},
-				Data: data,
-			})
-			if err != nil {
-				return err
-			}
-		}
-	} else {
-		for _, key := range []string{username, password, sshPrivateKey, tlsClientCertData, tlsClientCertKey} {
-			if secret.Data == nil {
-				secret.Data = make(map[string][]byte)
-			}
-			if val, ok := data[key]; ok && len(val) > 0 {
-				secret.Data[key] = val
-			} else {
-				delete(secret.Data, key)
-			}
-		}
-		if len(secret.Data) == 0 {
-			isManagedByArgo := (secret.Annotations != nil && secret.Annotations[common.AnnotationKeyManagedBy] == common.AnnotationValueManagedByArgoCD) ||
-				(secret.Labels != nil && secret.Labels[common.LabelKeySecretType] == "repository")
-			if isManagedByArgo {
-				return db.kubeclientset.CoreV1().Secrets(db.ns).Delete(name, &metav1.DeleteOptions{})
-			}
-			return nil
-		} else {
-			_, err = db.kubeclientset.CoreV1().Secrets(db.ns).Update(secret)
-			if err != nil {
-				return err
-			}
-		}
-	}
-	return nil
-}
-
 func getRepositoryIndex(repos []settings.RepoCredentials, repoURL string) int {
 	for i, repo := range repos {
 		if git.SameURL(repo.URL, repoURL) {
@@ -58,14 +58,20 @@ func GenerateDexConfigYAML(settings *settings.ArgoCDSettings) ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
-	connectors := dexCfg["connectors"].([]interface{})
+	connectors, err := getConnectors(dexCfg)
+	if err != nil {
+		return nil, err
+	}
 	for i, connectorIf := range connectors {
 		connector := connectorIf.(map[string]interface{})
-		connectorType := connector["type"].(string)
+		connectorType, _ := connector["type"].(string)
 		if !needsRedirectURI(connectorType) {
 			continue
 		}
-		connectorCfg := connector["config"].(map[string]interface{})
+		connectorCfg, ok := connector["config"].(map[string]interface{})
+		if !ok {
+			connectorCfg = make(map[string]interface{})
+		}
 		connectorCfg["redirectURI"] = dexRedirectURL
 		connector["config"] = connectorCfg
 		connectors[i] = connector
@@ -75,6 +81,33 @@ func GenerateDexConfigYAML(settings *settings.ArgoCDSettings) ([]byte, error) {
 	return yaml.Marshal(dexCfg)
 }
 
+// getConnectors returns the connectors of the dex config, failing if any of them is malformed
+func getConnectors(dexCfg map[string]interface{}) ([]interface{}, error) {
+	connectorsIf, ok := dexCfg["connectors"]
+	if !ok {
+		return []interface{}{}, nil
+	}
+	connectors, ok := connectorsIf.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("dex.config: connectors must be a list")
+	}
+	for i, connectorIf := range connectors {
+		connector, ok := connectorIf.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("dex.config: connector %d must be an object", i)
+		}
+		if _, ok := connector["type"].(string); !ok {
+			return nil, fmt.Errorf("dex.config: connector %d: type is missing", i)
+		}
+		if configIf, ok := connector["config"]; ok {
+			if _, ok := configIf.(map[string]interface{}); !ok {
+				return nil, fmt.Errorf("dex.config: connector %d: config must be an object", i)
+			}
+		}
+	}
+	return connectors, nil
+}
+
 // replaceMapSecrets takes a json object and recursively looks for any secret key references in the
 // object and replaces the value with the secret value
 func replaceMapSecrets(obj map[string]interface{}, secretValues map[string]string) map[string]interface{} {
@@ -0,0 +1,125 @@
+package dex
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ghodss/yaml"
+
+	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/util/settings"
+)
+
+// ConnectorHealth describes whether a dex connector is able to log users in
+type ConnectorHealth struct {
+	ID      string
+	Name    string
+	Type    string
+	Healthy bool
+	// Message is the last error reported for the connector
+	Message string
+}
+
+// GetConnectorsHealth validates the configuration of each configured dex connector and then asks the dex server to
+// start a login with it. Dex fails to start a login if the connector could not be opened (e.g. a malformed SAML
+// certificate or an unreachable OIDC issuer), in which case the error reported by dex is returned.
+func GetConnectorsHealth(argoSettings *settings.ArgoCDSettings, dexServerAddr string) ([]ConnectorHealth, error) {
+	if !argoSettings.IsDexConfigured() {
+		return nil, nil
+	}
+	var dexCfg map[string]interface{}
+	if err := yaml.Unmarshal([]byte(argoSettings.DexConfig), &dexCfg); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal dex.config from configmap: %v", err)
+	}
+	connectors, err := getConnectors(dexCfg)
+	if err != nil {
+		return nil, err
+	}
+	redirectURL, err := argoSettings.RedirectURL()
+	if err != nil {
+		return nil, err
+	}
+	client := &http.Client{
+		Timeout: 10 * time.Second,
+		// the login redirects to the identity provider, which is not followed
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	res := make([]ConnectorHealth, len(connectors))
+	for i, connectorIf := range connectors {
+		connector := connectorIf.(map[string]interface{})
+		health := ConnectorHealth{}
+		health.ID, _ = connector["id"].(string)
+		health.Name, _ = connector["name"].(string)
+		health.Type, _ = connector["type"].(string)
+		if missing := missingSecrets(connector, argoSettings.Secrets); len(missing) > 0 {
+			sort.Strings(missing)
+			health.Message = fmt.Sprintf("config references keys which do not exist in secret: %s", strings.Join(missing, ", "))
+		} else if health.ID == "" {
+			health.Message = "id is missing"
+		} else if err := probeConnector(client, dexServerAddr, health.ID, redirectURL); err != nil {
+			health.Message = err.Error()
+		} else {
+			health.Healthy = true
+		}
+		res[i] = health
+	}
+	return res, nil
+}
+
+// probeConnector starts a login with the given connector, without following the redirect to the identity provider
+func probeConnector(client *http.Client, dexServerAddr string, id string, redirectURL string) error {
+	query := url.Values{}
+	query.Set("client_id", common.ArgoCDClientAppID)
+	query.Set("redirect_uri", redirectURL)
+	query.Set("response_type", "code")
+	query.Set("scope", "openid")
+	query.Set("state", "health")
+	authURL := fmt.Sprintf("%s%s/auth/%s?%s", strings.TrimSuffix(dexServerAddr, "/"), common.DexAPIEndpoint, url.PathEscape(id), query.Encode())
+	resp, err := client.Get(authURL)
+	if err != nil {
+		return fmt.Errorf("dex server is unreachable: %v", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode < http.StatusBadRequest {
+		return nil
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if matches := messageRe.FindSubmatch(body); len(matches) > 1 {
+		return errors.New(html.UnescapeString(string(matches[1])))
+	}
+	return fmt.Errorf("dex returned %s", resp.Status)
+}
+
+// missingSecrets returns the secret keys which are referenced by the given object, but do not exist
+func missingSecrets(obj interface{}, secretValues map[string]string) []string {
+	var missing []string
+	switch val := obj.(type) {
+	case map[string]interface{}:
+		for _, v := range val {
+			missing = append(missing, missingSecrets(v, secretValues)...)
+		}
+	case []interface{}:
+		for _, v := range val {
+			missing = append(missing, missingSecrets(v, secretValues)...)
+		}
+	case string:
+		if strings.HasPrefix(val, "$") {
+			if _, ok := secretValues[val[1:]]; !ok {
+				missing = append(missing, val)
+			}
+		}
+	}
+	return missing
+}
@@ -0,0 +1,69 @@
+package dex
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-cd/util/settings"
+)
+
+const testDexConfig = `
+connectors:
+- type: github
+  id: github
+  name: GitHub
+  config:
+    clientID: aabbccddeeff00112233
+    clientSecret: $dex.github.clientSecret
+- type: saml
+  id: saml
+  name: SAML
+  config:
+    ssoURL: https://idp.example.com/sso
+- type: ldap
+  id: ldap
+  name: LDAP
+  config:
+    bindPW: $dex.ldap.bindPW
+`
+
+func TestGetConnectorsHealth(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/dex/auth/github":
+			assert.Equal(t, "argo-cd", r.URL.Query().Get("client_id"))
+			http.Redirect(w, r, "https://github.com/login/oauth/authorize", http.StatusFound)
+		case "/api/dex/auth/saml":
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`<html><body><p>Failed to open connector: ca must be set</p></body></html>`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	argoSettings := &settings.ArgoCDSettings{
+		URL:       "https://argocd.example.com",
+		DexConfig: testDexConfig,
+		Secrets:   map[string]string{"dex.github.clientSecret": "secret"},
+	}
+	connectors, err := GetConnectorsHealth(argoSettings, ts.URL)
+	assert.NoError(t, err)
+	assert.Equal(t, []ConnectorHealth{
+		{ID: "github", Name: "GitHub", Type: "github", Healthy: true},
+		{ID: "saml", Name: "SAML", Type: "saml", Message: "Failed to open connector: ca must be set"},
+		{ID: "ldap", Name: "LDAP", Type: "ldap", Message: "config references keys which do not exist in secret: $dex.ldap.bindPW"},
+	}, connectors)
+}
+
+func TestGetConnectorsHealth_NotConfigured(t *testing.T) {
+	connectors, err := GetConnectorsHealth(&settings.ArgoCDSettings{URL: "https://argocd.example.com"}, "http://localhost")
+	assert.NoError(t, err)
+	assert.Empty(t, connectors)
+
+	_, err = GetConnectorsHealth(&settings.ArgoCDSettings{URL: "https://argocd.example.com", DexConfig: "connectors: {}"}, "http://localhost")
+	assert.Error(t, err)
+}
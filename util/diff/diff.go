@@ -9,9 +9,13 @@ import (
 	"os/exec"
 	"path"
 	"reflect"
+	"sort"
+	"strings"
+	"syscall"
 
 	"github.com/ghodss/yaml"
 	"github.com/google/shlex"
+	"github.com/pmezard/go-difflib/difflib"
 	log "github.com/sirupsen/logrus"
 	"github.com/yudai/gojsondiff"
 	"github.com/yudai/gojsondiff/formatter"
@@ -25,6 +29,13 @@ import (
 	jsonutil "github.com/argoproj/argo-cd/util/json"
 )
 
+const (
+	// DiffFormatUnified renders diffs in the unified format, as produced by 'diff -u'
+	DiffFormatUnified = "unified"
+	// DiffFormatSideBySide renders diffs in two columns, as produced by 'diff -y'
+	DiffFormatSideBySide = "side-by-side"
+)
+
 type DiffResult struct {
 	Diff     gojsondiff.Diff
 	Modified bool
@@ -349,6 +360,48 @@ func (d *DiffResult) JSONFormat() (string, error) {
 	return jsonFmt.Format(d.Diff)
 }
 
+// ModifiedPaths returns the sorted JSON pointers of the fields which are added, modified or removed by a diff in the
+// delta format returned by JSONFormat
+func ModifiedPaths(delta string) ([]string, error) {
+	paths := make([]string, 0)
+	if delta == "" {
+		return paths, nil
+	}
+	var deltaObj map[string]interface{}
+	if err := json.Unmarshal([]byte(delta), &deltaObj); err != nil {
+		return nil, err
+	}
+	var collect func(prefix string, obj map[string]interface{})
+	collect = func(prefix string, obj map[string]interface{}) {
+		_, isArray := obj["_t"]
+		for key, val := range obj {
+			if isArray {
+				if key == "_t" {
+					continue
+				}
+				// removed array items are keyed by their index in the original array, prefixed with an underscore
+				key = strings.TrimPrefix(key, "_")
+			}
+			fieldPath := prefix + "/" + strings.NewReplacer("~", "~0", "/", "~1").Replace(key)
+			if nested, ok := val.(map[string]interface{}); ok {
+				collect(fieldPath, nested)
+			} else {
+				paths = append(paths, fieldPath)
+			}
+		}
+	}
+	collect("", deltaObj)
+	sort.Strings(paths)
+	// an array item which is replaced is both removed and added at the same index
+	unique := paths[:0]
+	for i, fieldPath := range paths {
+		if i == 0 || fieldPath != paths[i-1] {
+			unique = append(unique, fieldPath)
+		}
+	}
+	return unique, nil
+}
+
 // CreateTwoWayMergePatch is a helper to construct a two-way merge patch from objects (instead of bytes)
 func CreateTwoWayMergePatch(orig, new, dataStruct interface{}) ([]byte, bool, error) {
 	origBytes, err := json.Marshal(orig)
@@ -441,6 +494,44 @@ func HideSecretData(target *unstructured.Unstructured, live *unstructured.Unstru
 	return target, live, nil
 }
 
+// SecretKeyChanges returns the keys of the data of a secret which are added, removed or modified by its target state
+// compared to its live state, in alphabetical order. The values of secrets are hidden from diffs, so the changed keys
+// can be shown instead. Nothing is changed if the secret is pruned.
+func SecretKeyChanges(target, live *unstructured.Unstructured, normalizer Normalizer) (added, removed, modified []string, err error) {
+	if target == nil {
+		return nil, nil, nil, nil
+	}
+	var targetData, liveData map[string]interface{}
+	target = target.DeepCopy()
+	Normalize(target, normalizer)
+	if targetData, _, err = unstructured.NestedMap(target.Object, "data"); err != nil {
+		return nil, nil, nil, err
+	}
+	if live != nil {
+		live = live.DeepCopy()
+		Normalize(live, normalizer)
+		if liveData, _, err = unstructured.NestedMap(live.Object, "data"); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	for k, val := range targetData {
+		if liveVal, ok := liveData[k]; !ok {
+			added = append(added, k)
+		} else if toString(val) != toString(liveVal) {
+			modified = append(modified, k)
+		}
+	}
+	for k := range liveData {
+		if _, ok := targetData[k]; !ok {
+			removed = append(removed, k)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+	sort.Strings(modified)
+	return added, removed, modified, nil
+}
+
 func toString(val interface{}) string {
 	if val == nil {
 		return ""
@@ -528,5 +619,96 @@ func PrintDiff(name string, live *unstructured.Unstructured, target *unstructure
 	cmd := exec.Command(cmdBinary, append(args, liveFile, targetFile)...)
 	cmd.Stderr = os.Stderr
 	cmd.Stdout = os.Stdout
-	return cmd.Run()
+	err = cmd.Run()
+	// diff utilities exit with 1 if the files differ, which is the expected outcome
+	if exitErr, ok := err.(*exec.ExitError); ok {
+		if status, ok := exitErr.Sys().(syscall.WaitStatus); ok && status.ExitStatus() == 1 {
+			return nil
+		}
+	}
+	return err
+}
+
+// FormatDiff returns a diff between two unstructured objects in the given format, which is either DiffFormatUnified
+// or DiffFormatSideBySide
+func FormatDiff(name string, live *unstructured.Unstructured, target *unstructured.Unstructured, format string) (string, error) {
+	var liveLines, targetLines []string
+	for _, item := range []struct {
+		obj   *unstructured.Unstructured
+		lines *[]string
+	}{{live, &liveLines}, {target, &targetLines}} {
+		if item.obj == nil {
+			continue
+		}
+		data, err := yaml.Marshal(item.obj)
+		if err != nil {
+			return "", err
+		}
+		*item.lines = difflib.SplitLines(strings.TrimSuffix(string(data), "\n"))
+	}
+	liveName := fmt.Sprintf("%s-live.yaml", name)
+	switch format {
+	case DiffFormatUnified:
+		return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        liveLines,
+			B:        targetLines,
+			FromFile: liveName,
+			ToFile:   name,
+			Context:  3,
+		})
+	case DiffFormatSideBySide:
+		return sideBySideDiff(liveName, name, liveLines, targetLines), nil
+	default:
+		return "", fmt.Errorf("unknown diff format '%s', must be one of: %s, %s", format, DiffFormatUnified, DiffFormatSideBySide)
+	}
+}
+
+// sideBySideDiff renders the lines of a and b in two columns, marking changed lines with '|', removed lines with '<'
+// and added lines with '>'
+func sideBySideDiff(nameA, nameB string, a, b []string) string {
+	for _, lines := range [][]string{a, b} {
+		for i := range lines {
+			lines[i] = strings.TrimRight(lines[i], "\n")
+		}
+	}
+	width := len(nameA)
+	for _, line := range a {
+		if len(line) > width {
+			width = len(line)
+		}
+	}
+	var buf strings.Builder
+	row := func(left, marker, right string) {
+		buf.WriteString(strings.TrimRight(fmt.Sprintf("%-*s %s %s", width, left, marker, right), " "))
+		buf.WriteString("\n")
+	}
+	row(nameA, " ", nameB)
+	for _, op := range difflib.NewMatcher(a, b).GetOpCodes() {
+		n := op.I2 - op.I1
+		if op.J2-op.J1 > n {
+			n = op.J2 - op.J1
+		}
+		for k := 0; k < n; k++ {
+			var left, right string
+			hasLeft, hasRight := op.I1+k < op.I2, op.J1+k < op.J2
+			if hasLeft {
+				left = a[op.I1+k]
+			}
+			if hasRight {
+				right = b[op.J1+k]
+			}
+			marker := " "
+			switch {
+			case op.Tag == 'e':
+			case hasLeft && hasRight:
+				marker = "|"
+			case hasLeft:
+				marker = "<"
+			default:
+				marker = ">"
+			}
+			row(left, marker, right)
+		}
+	}
+	return buf.String()
 }
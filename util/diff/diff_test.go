@@ -569,6 +569,63 @@ func TestHideSecretDataDifferentKeysDifferentValues(t *testing.T) {
 	assert.Equal(t, map[string]interface{}{"key2": replacement2, "key3": replacement1}, secretData(live))
 }
 
+func TestSecretKeyChanges(t *testing.T) {
+	target := createSecret(map[string]string{"key1": "test", "key2": "test", "key4": "test"})
+	unstructured.RemoveNestedField(target.Object, "data", "key4")
+	target.Object["stringData"] = map[string]interface{}{"key4": "test-1"}
+	added, removed, modified, err := SecretKeyChanges(
+		target,
+		createSecret(map[string]string{"key2": "test-1", "key3": "test", "key4": "test"}), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"key1"}, added)
+	assert.Equal(t, []string{"key3"}, removed)
+	assert.Equal(t, []string{"key2", "key4"}, modified)
+
+	// the changes are the same once the values are hidden
+	hiddenTarget, hiddenLive, err := HideSecretData(target, createSecret(map[string]string{"key2": "test-1", "key3": "test", "key4": "test"}))
+	assert.NoError(t, err)
+	hiddenAdded, hiddenRemoved, hiddenModified, err := SecretKeyChanges(hiddenTarget, hiddenLive, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, added, hiddenAdded)
+	assert.Equal(t, removed, hiddenRemoved)
+	assert.Equal(t, modified, hiddenModified)
+
+	added, removed, modified, err = SecretKeyChanges(nil, createSecret(map[string]string{"key1": "test"}), nil)
+	assert.NoError(t, err)
+	assert.Empty(t, added)
+	assert.Empty(t, removed)
+	assert.Empty(t, modified)
+}
+
+func TestFormatDiff(t *testing.T) {
+	live := createSecret(map[string]string{"key1": "test", "key2": "test"})
+	target := createSecret(map[string]string{"key1": "test", "key2": "test-1", "key3": "test"})
+
+	out, err := FormatDiff("my-secret", live, target, DiffFormatUnified)
+	assert.NoError(t, err)
+	assert.Contains(t, out, "--- my-secret-live.yaml\n+++ my-secret\n")
+	assert.Contains(t, out, "-  key2: dGVzdA==\n+  key2: dGVzdC0x\n+  key3: dGVzdA==\n")
+
+	out, err = FormatDiff("my-secret", live, target, DiffFormatSideBySide)
+	assert.NoError(t, err)
+	assert.Regexp(t, "\n  key1: dGVzdA== +  key1: dGVzdA==\n", out)
+	assert.Regexp(t, "\n  key2: dGVzdA== +\\|   key2: dGVzdC0x\n", out)
+	assert.Regexp(t, "\n +>   key3: dGVzdA==\n", out)
+
+	out, err = FormatDiff("my-secret", nil, target, DiffFormatSideBySide)
+	assert.NoError(t, err)
+	assert.Contains(t, out, "> kind: Secret\n")
+
+	_, err = FormatDiff("my-secret", live, target, "context")
+	assert.Error(t, err)
+}
+
+func TestPrintDiff(t *testing.T) {
+	// diff exits with 1 if the files differ, which is not an error
+	err := PrintDiff("my-secret", createSecret(map[string]string{"key1": "test"}), createSecret(map[string]string{"key1": "test-1"}))
+	assert.NoError(t, err)
+}
+
 func TestHideSecretDataLastAppliedConfig(t *testing.T) {
 	lastAppliedSecret := createSecret(map[string]string{"key1": "test1"})
 	targetSecret := createSecret(map[string]string{"key1": "test2"})
@@ -588,6 +645,29 @@ func TestHideSecretDataLastAppliedConfig(t *testing.T) {
 
 }
 
+func TestModifiedPaths(t *testing.T) {
+	config := test.NewPod()
+	config.SetAnnotations(map[string]string{"example.com/foo": "bar"})
+	live := test.NewPod()
+	containers, _, _ := unstructured.NestedSlice(live.Object, "spec", "containers")
+	containers[0].(map[string]interface{})["image"] = "nginx:1.8"
+	assert.NoError(t, unstructured.SetNestedSlice(live.Object, containers, "spec", "containers"))
+
+	delta, err := TwoWayDiff(config, live).JSONFormat()
+	assert.NoError(t, err)
+	paths, err := ModifiedPaths(delta)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/metadata/annotations", "/spec/containers/0/image"}, paths)
+
+	paths, err = ModifiedPaths("")
+	assert.NoError(t, err)
+	assert.Empty(t, paths)
+
+	paths, err = ModifiedPaths(`{"metadata": {"annotations": {"example.com~/foo": ["bar"]}}, "items": {"_t": "a", "_1": ["a", 0, 0], "1": ["b"]}}`)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/items/1", "/metadata/annotations/example.com~0~1foo"}, paths)
+}
+
 func TestRemarshal(t *testing.T) {
 	manifest := []byte(`
 apiVersion: v1
@@ -0,0 +1,132 @@
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+const (
+	// EnvEncryptionKey is the environment variable holding the secret from which encryption keys are derived. It is
+	// populated from the argocd-encryption-key Kubernetes secret.
+	EnvEncryptionKey = "ARGOCD_ENCRYPTION_KEY"
+	// encryptedValuePrefix marks encrypted string values
+	encryptedValuePrefix = "encrypted:"
+
+	// PurposeCache is the purpose of the key which encrypts cache entries
+	PurposeCache = "cache"
+	// PurposeSettings is the purpose of the key which encrypts sensitive settings
+	PurposeSettings = "settings"
+)
+
+// ErrDecryption is returned if data was not encrypted with the key, or was tampered with
+var ErrDecryption = errors.New("failed to decrypt data")
+
+// Key encrypts and authenticates data using AES-256-GCM
+type Key struct {
+	aead   cipher.AEAD
+	macKey []byte
+}
+
+func derive(secret []byte, info string) []byte {
+	h := hmac.New(sha256.New, secret)
+	_, _ = h.Write([]byte(info))
+	return h.Sum(nil)
+}
+
+// NewKey derives a key for the given purpose from the secret, so that the same secret can be used to encrypt
+// different kinds of data
+func NewKey(secret []byte, purpose string) *Key {
+	block, err := aes.NewCipher(derive(secret, purpose+"|encryption"))
+	if err != nil {
+		// AES-256 keys derived using SHA-256 are always valid
+		panic(err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		panic(err)
+	}
+	return &Key{aead: aead, macKey: derive(secret, purpose+"|mac")}
+}
+
+// NewKeyFromEnv returns the key for the given purpose derived from the ARGOCD_ENCRYPTION_KEY environment variable, or
+// nil if it is not set
+func NewKeyFromEnv(purpose string) *Key {
+	secret := os.Getenv(EnvEncryptionKey)
+	if secret == "" {
+		return nil
+	}
+	return NewKey([]byte(secret), purpose)
+}
+
+// Encrypt encrypts the data, and prepends the random nonce to the result
+func (k *Key) Encrypt(plaintext []byte) ([]byte, error) {
+	nonce := make([]byte, k.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return k.aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+// Decrypt decrypts data returned by Encrypt
+func (k *Key) Decrypt(ciphertext []byte) ([]byte, error) {
+	nonceSize := k.aead.NonceSize()
+	if len(ciphertext) < nonceSize {
+		return nil, ErrDecryption
+	}
+	plaintext, err := k.aead.Open(nil, ciphertext[:nonceSize], ciphertext[nonceSize:], nil)
+	if err != nil {
+		return nil, ErrDecryption
+	}
+	return plaintext, nil
+}
+
+// Hash returns a keyed hash of the value, which identifies it without revealing it
+func (k *Key) Hash(value string) string {
+	h := hmac.New(sha256.New, k.macKey)
+	_, _ = h.Write([]byte(value))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// EncryptString encrypts the value into a string of the form 'encrypted:<base64>'
+func (k *Key) EncryptString(value string) (string, error) {
+	ciphertext, err := k.Encrypt([]byte(value))
+	if err != nil {
+		return "", err
+	}
+	return encryptedValuePrefix + base64.StdEncoding.EncodeToString(ciphertext), nil
+}
+
+// IsEncryptedString returns whether the value was returned by EncryptString
+func IsEncryptedString(value string) bool {
+	return strings.HasPrefix(value, encryptedValuePrefix)
+}
+
+// DecryptString decrypts a value returned by EncryptString. Values which are not encrypted are returned as is, so
+// that encryption can be enabled without migrating existing values. The key might be nil if no value is encrypted.
+func (k *Key) DecryptString(value string) (string, error) {
+	if !IsEncryptedString(value) {
+		return value, nil
+	}
+	if k == nil {
+		return "", fmt.Errorf("value is encrypted, but %s is not set", EnvEncryptionKey)
+	}
+	ciphertext, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(value, encryptedValuePrefix))
+	if err != nil {
+		return "", ErrDecryption
+	}
+	plaintext, err := k.Decrypt(ciphertext)
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
@@ -0,0 +1,54 @@
+package encryption
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncrypt(t *testing.T) {
+	key := NewKey([]byte("secret"), PurposeCache)
+	ciphertext, err := key.Encrypt([]byte("manifests"))
+	assert.NoError(t, err)
+	assert.NotContains(t, string(ciphertext), "manifests")
+
+	plaintext, err := key.Decrypt(ciphertext)
+	assert.NoError(t, err)
+	assert.Equal(t, "manifests", string(plaintext))
+
+	// keys of other secrets or purposes cannot decrypt the data
+	_, err = NewKey([]byte("other"), PurposeCache).Decrypt(ciphertext)
+	assert.Equal(t, ErrDecryption, err)
+	_, err = NewKey([]byte("secret"), PurposeSettings).Decrypt(ciphertext)
+	assert.Equal(t, ErrDecryption, err)
+
+	ciphertext[len(ciphertext)-1] ^= 1
+	_, err = key.Decrypt(ciphertext)
+	assert.Equal(t, ErrDecryption, err)
+}
+
+func TestEncryptString(t *testing.T) {
+	key := NewKey([]byte("secret"), PurposeSettings)
+	value, err := key.EncryptString("webhook-secret")
+	assert.NoError(t, err)
+	assert.True(t, IsEncryptedString(value))
+
+	decrypted, err := key.DecryptString(value)
+	assert.NoError(t, err)
+	assert.Equal(t, "webhook-secret", decrypted)
+
+	decrypted, err = key.DecryptString("plain")
+	assert.NoError(t, err)
+	assert.Equal(t, "plain", decrypted)
+
+	var noKey *Key
+	_, err = noKey.DecryptString(value)
+	assert.Error(t, err)
+}
+
+func TestHash(t *testing.T) {
+	key := NewKey([]byte("secret"), PurposeCache)
+	assert.Equal(t, key.Hash("repo|https://github.com/argoproj/argo-cd"), key.Hash("repo|https://github.com/argoproj/argo-cd"))
+	assert.NotEqual(t, key.Hash("repo|https://github.com/argoproj/argo-cd"), NewKey([]byte("other"), PurposeCache).Hash("repo|https://github.com/argoproj/argo-cd"))
+	assert.NotContains(t, key.Hash("repo|https://github.com/argoproj/argo-cd"), "github")
+}
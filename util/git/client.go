@@ -8,6 +8,7 @@ import (
 	"net/url"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
@@ -37,10 +38,23 @@ type RevisionMetadata struct {
 	Message string
 }
 
+// Commit is a commit of the history of a repository
+type Commit struct {
+	SHA     string
+	Author  string
+	Date    time.Time
+	Message string
+}
+
 // Client is a generic git client interface
 type Client interface {
 	Root() string
 	Init() error
+	Verify() error
+	Reinit() error
+	Worktree(revision string, paths ...string) (Client, error)
+	RemoveWorktree(revision string, paths ...string) error
+	Release()
 	Fetch() error
 	Checkout(revision string) error
 	LsRemote(revision string) (string, error)
@@ -48,6 +62,9 @@ type Client interface {
 	LsLargeFiles() ([]string, error)
 	CommitSHA() (string, error)
 	RevisionMetadata(revision string) (*RevisionMetadata, error)
+	ChangedFiles(revision, targetRevision string) ([]string, error)
+	Commits(revision, targetRevision string) ([]Commit, error)
+	CommitAndPush(branch, message string) (string, error)
 }
 
 // nativeGitClient implements Client interface using git CLI
@@ -64,6 +81,10 @@ type nativeGitClient struct {
 	enableLfs bool
 	// metrics reporter
 	reporter metrics.Reporter
+	// Paths which are checked out in a sparse working tree, the whole repository is checked out if empty
+	sparsePaths []string
+	// Whether the client uses a working tree, which is not removed until the client is released
+	acquired bool
 }
 
 var (
@@ -209,20 +230,25 @@ func (m *nativeGitClient) Root() string {
 	return m.root
 }
 
-// Init initializes a local git repository and sets the remote origin
+// Init initializes a local git repository and sets the remote origin. An existing local repository which cannot be
+// opened, e.g. because its config was only partially written, is initialized again.
 func (m *nativeGitClient) Init() error {
 	_, err := git.PlainOpen(m.root)
 	if err == nil {
-		return nil
+		return m.removeStaleLockFiles()
 	}
 	if err != git.ErrRepositoryNotExists {
-		return err
+		log.Warnf("Unable to open local repository %s of %s, initializing it again: %v", m.root, m.repoURL, err)
+		m.reporter.Event(m.repoURL, "GitCheckoutRepair")
 	}
 	log.Infof("Initializing %s to %s", m.repoURL, m.root)
 	_, err = argoexec.RunCommand("rm", argoconfig.CmdOpts(), "-rf", m.root)
 	if err != nil {
 		return fmt.Errorf("unable to clean repo at %s: %v", m.root, err)
 	}
+	if err := m.removeUnusedWorktrees(); err != nil {
+		return fmt.Errorf("unable to clean working trees of repo at %s: %v", m.root, err)
+	}
 	err = os.MkdirAll(m.root, 0755)
 	if err != nil {
 		return err
@@ -238,25 +264,61 @@ func (m *nativeGitClient) Init() error {
 	return err
 }
 
+// removeStaleLockFiles removes the lock files which git commands leave behind when they are interrupted, e.g. when
+// the process is killed during a fetch. Subsequent commands would otherwise fail until the lock files are removed.
+// Callers hold the lock of the repository, so no other git command is running in it.
+func (m *nativeGitClient) removeStaleLockFiles() error {
+	gitDir := filepath.Join(m.root, ".git")
+	return filepath.Walk(gitDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		// the working trees are locked separately, so their lock files might be held by running checkouts
+		if info.IsDir() && (path == filepath.Join(gitDir, "objects") || path == filepath.Join(gitDir, "worktrees")) {
+			return filepath.SkipDir
+		}
+		if !info.IsDir() && strings.HasSuffix(path, ".lock") {
+			log.Warnf("Removing stale lock file %s", path)
+			return os.Remove(path)
+		}
+		return nil
+	})
+}
+
+// Verify checks the integrity of the objects and the index of the local repository, which might be corrupted by an
+// interrupted fetch or a full disk. Untracked files are ignored, since checkouts remove them.
+func (m *nativeGitClient) Verify() error {
+	if _, err := m.runCmd("fsck", "--connectivity-only", "--no-dangling", "--no-progress"); err != nil {
+		return err
+	}
+	_, err := m.runCmd("status", "--porcelain", "--untracked-files=no")
+	return err
+}
+
+// Reinit removes the local repository and initializes it again, so that it is fetched from scratch
+func (m *nativeGitClient) Reinit() error {
+	log.Warnf("Removing local repository %s of %s", m.root, m.repoURL)
+	m.reporter.Event(m.repoURL, "GitCheckoutRepair")
+	if err := os.RemoveAll(m.root); err != nil {
+		return fmt.Errorf("unable to remove repo at %s: %v", m.root, err)
+	}
+	return m.Init()
+}
+
 // Returns true if the repository is LFS enabled
 func (m *nativeGitClient) IsLFSEnabled() bool {
 	return m.enableLfs
 }
 
 // Fetch fetches latest updates from origin
-func (m *nativeGitClient) Fetch() error {
+func (m *nativeGitClient) Fetch() (err error) {
 	m.reporter.Event(m.repoURL, "GitRequestTypeFetch")
-	_, err := m.runCredentialedCmd("git", "fetch", "origin", "--tags", "--force")
-	// When we have LFS support enabled, check for large files and fetch them too.
-	if err == nil && m.IsLFSEnabled() {
-		largeFiles, err := m.LsLargeFiles()
-		if err == nil && len(largeFiles) > 0 {
-			_, err = m.runCredentialedCmd("git", "lfs", "fetch", "--all")
-			if err != nil {
-				return err
-			}
-		}
-	}
+	start := time.Now()
+	defer func() {
+		m.reporter.Observe(m.repoURL, "GitRequestTypeFetch", time.Since(start), err)
+	}()
+	// LFS objects are fetched on checkout, only for the checked out revision
+	_, err = m.runCredentialedCmd("git", "fetch", "origin", "--tags", "--force")
 	return err
 }
 
@@ -277,7 +339,12 @@ func (m *nativeGitClient) LsLargeFiles() ([]string, error) {
 	if err != nil {
 		return nil, err
 	}
-	ss := strings.Split(out, "\n")
+	var ss []string
+	for _, s := range strings.Split(out, "\n") {
+		if s != "" {
+			ss = append(ss, s)
+		}
+	}
 	return ss, nil
 }
 
@@ -286,15 +353,25 @@ func (m *nativeGitClient) Checkout(revision string) error {
 	if revision == "" || revision == "HEAD" {
 		revision = "origin/HEAD"
 	}
-	if _, err := m.runCmd("checkout", "--force", revision); err != nil {
+	args := []string{"checkout", "--force", "--detach", revision}
+	if len(m.sparsePaths) > 0 {
+		// only for this working tree, the patterns are in its own git directory
+		args = append([]string{"-c", "core.sparseCheckout=true"}, args...)
+	}
+	// detached, since a branch cannot be checked out in more than one working tree
+	if _, err := m.runCmd(args...); err != nil {
 		return err
 	}
-	// We must populate LFS content by using lfs checkout, if we have at least
-	// one LFS reference in the current revision.
+	// We must fetch and populate LFS content by using lfs checkout, if we have
+	// at least one LFS reference in the current revision.
 	if m.IsLFSEnabled() {
 		if largeFiles, err := m.LsLargeFiles(); err == nil {
+			largeFiles = m.filterSparsePaths(largeFiles)
 			if len(largeFiles) > 0 {
-				if _, err := m.runCmd("lfs", "checkout"); err != nil {
+				if err := m.fetchLargeFiles(largeFiles); err != nil {
+					return err
+				}
+				if _, err := m.runCmd(append([]string{"lfs", "checkout"}, m.sparsePaths...)...); err != nil {
 					return err
 				}
 			}
@@ -342,8 +419,10 @@ func (m *nativeGitClient) lsRemote(revision string) (string, error) {
 		return "", err
 	}
 	m.reporter.Event(m.repoURL, "GitRequestTypeLsRemote")
+	start := time.Now()
 	//refs, err := remote.List(&git.ListOptions{Auth: auth})
 	refs, err := listRemote(remote, &git.ListOptions{Auth: auth}, m.insecure, m.creds)
+	m.reporter.Observe(m.repoURL, "GitRequestTypeLsRemote", time.Since(start), err)
 	if err != nil {
 		return "", err
 	}
@@ -427,6 +506,59 @@ func (m *nativeGitClient) RevisionMetadata(revision string) (*RevisionMetadata,
 	return &RevisionMetadata{author, time.Unix(authorDateUnixTimestamp, 0), tags, message}, nil
 }
 
+// ChangedFiles returns the files, relative to the root of the repository, which differ between the given revisions
+func (m *nativeGitClient) ChangedFiles(revision, targetRevision string) ([]string, error) {
+	out, err := m.runCmd("diff", "--name-only", "--no-renames", "-z", revision, targetRevision, "--")
+	if err != nil {
+		return nil, err
+	}
+	// remove last element, which is blank regardless of whether we're using nullbyte or newline
+	ss := strings.Split(out, "\000")
+	return ss[:len(ss)-1], nil
+}
+
+// Commits returns the commits which are reachable from the target revision but not from the revision, newest first.
+// The message of each commit is its subject line.
+func (m *nativeGitClient) Commits(revision, targetRevision string) ([]Commit, error) {
+	out, err := m.runCmd("log", "--format=%H|%an <%ae>|%at|%s", revision+".."+targetRevision, "--")
+	if err != nil {
+		return nil, err
+	}
+	commits := make([]Commit, 0)
+	for _, line := range strings.Split(out, "\n") {
+		if line == "" {
+			continue
+		}
+		segments := strings.SplitN(line, "|", 4)
+		if len(segments) != 4 {
+			return nil, fmt.Errorf("expected 4 segments, got %v", segments)
+		}
+		authorDateUnixTimestamp, _ := strconv.ParseInt(segments[2], 10, 64)
+		commits = append(commits, Commit{
+			SHA:     segments[0],
+			Author:  segments[1],
+			Date:    time.Unix(authorDateUnixTimestamp, 0),
+			Message: segments[3],
+		})
+	}
+	return commits, nil
+}
+
+// CommitAndPush commits all changes in the working tree and pushes the commit to the given branch of origin. Returns
+// the SHA of the new commit.
+func (m *nativeGitClient) CommitAndPush(branch, message string) (string, error) {
+	if _, err := m.runCmd("add", "--all"); err != nil {
+		return "", err
+	}
+	if _, err := m.runCmd("-c", "user.name="+common.ArgoCDGitUserName, "-c", "user.email="+common.ArgoCDGitUserEmail, "commit", "-m", message); err != nil {
+		return "", err
+	}
+	if _, err := m.runCredentialedCmd("git", "push", "origin", "HEAD:refs/heads/"+branch); err != nil {
+		return "", err
+	}
+	return m.CommitSHA()
+}
+
 // runCmd is a convenience function to run a command in a given directory and return its output
 func (m *nativeGitClient) runCmd(args ...string) (string, error) {
 	cmd := exec.Command("git", args...)
@@ -476,3 +608,12 @@ func (m *nativeGitClient) runCmdOutput(cmd *exec.Cmd) (string, error) {
 	}
 	return argoexec.RunCommandExt(cmd, argoconfig.CmdOpts())
 }
+
+// Version returns the version of the git client, e.g. 2.20.1
+func Version() (string, error) {
+	out, err := argoexec.RunCommandExt(exec.Command("git", "version"), argoconfig.CmdOpts())
+	if err != nil {
+		return "", fmt.Errorf("unable to determine git version: %v", err)
+	}
+	return strings.TrimPrefix(strings.TrimSpace(out), "git version "), nil
+}
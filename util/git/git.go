@@ -4,6 +4,8 @@ import (
 	"net/url"
 	"regexp"
 	"strings"
+
+	"gopkg.in/src-d/go-git.v4/plumbing/transport"
 )
 
 // EnsurePrefix idempotently ensures that a base string has a given prefix.
@@ -63,6 +65,35 @@ func NormalizeGitURL(repo string) string {
 	return strings.TrimPrefix(normalized, "ssh://")
 }
 
+// authErrorMessages are fragments of git CLI and go-git error messages which indicate that the credentials were rejected
+var authErrorMessages = []string{
+	"authentication required",
+	"authentication failed",
+	"authorization failed",
+	"could not read username",
+	"could not read password",
+	"permission denied (publickey",
+	"unable to authenticate",
+	"invalid username or password",
+}
+
+// IsAuthError returns whether an error of a git request was caused by missing or invalid credentials
+func IsAuthError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if err == transport.ErrAuthenticationRequired || err == transport.ErrAuthorizationFailed {
+		return true
+	}
+	message := strings.ToLower(err.Error())
+	for _, fragment := range authErrorMessages {
+		if strings.Contains(message, fragment) {
+			return true
+		}
+	}
+	return false
+}
+
 // IsSSHURL returns true if supplied URL is SSH URL
 func IsSSHURL(url string) (bool, string) {
 	matches := sshURLRegex.FindStringSubmatch(url)
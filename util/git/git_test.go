@@ -6,13 +6,19 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	argoexec "github.com/argoproj/pkg/exec"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gopkg.in/src-d/go-git.v4/plumbing/transport"
 
 	"github.com/argoproj/argo-cd/test/fixture/log"
 	"github.com/argoproj/argo-cd/test/fixture/path"
 	"github.com/argoproj/argo-cd/test/fixture/test"
+	argoconfig "github.com/argoproj/argo-cd/util/config"
 	"github.com/argoproj/argo-cd/util/repo/metrics/mocks"
 )
 
@@ -28,6 +34,14 @@ func TestIsCommitSHA(t *testing.T) {
 	assert.False(t, IsTruncatedCommitSHA("branch-name"))
 }
 
+func TestIsAuthError(t *testing.T) {
+	assert.False(t, IsAuthError(nil))
+	assert.False(t, IsAuthError(fmt.Errorf("dial tcp: lookup github.com: no such host")))
+	assert.True(t, IsAuthError(transport.ErrAuthenticationRequired))
+	assert.True(t, IsAuthError(fmt.Errorf("`git fetch origin --tags --force` failed exit status 128: fatal: Authentication failed for 'https://github.com/argoproj/argo-cd.git/'")))
+	assert.True(t, IsAuthError(fmt.Errorf("git@github.com: Permission denied (publickey).")))
+}
+
 func TestEnsurePrefix(t *testing.T) {
 	data := [][]string{
 		{"world", "hello", "helloworld"},
@@ -177,6 +191,7 @@ func TestCustomHTTPClient(t *testing.T) {
 func TestLsRemote(t *testing.T) {
 	eventReporter := &mocks.EventReporter{}
 	eventReporter.On("Event", "https://github.com/argoproj/argo-cd.git", "GitRequestTypeLsRemote").Return()
+	eventReporter.On("Observe", "https://github.com/argoproj/argo-cd.git", "GitRequestTypeLsRemote", mock.Anything, mock.Anything).Return()
 	clnt, err := NewClient("https://github.com/argoproj/argo-cd.git", "/tmp", NopCreds{}, false, false, eventReporter)
 	assert.NoError(t, err)
 	xpass := []string{
@@ -254,6 +269,24 @@ func TestLFSClient(t *testing.T) {
 	}
 }
 
+func TestParseLFSPointerSize(t *testing.T) {
+	size, err := parseLFSPointerSize(`version https://git-lfs.github.com/spec/v1
+oid sha256:4d7a214614ab2935c943f9e0ff69d22eadbb8f32b1258daaa5e2ca24d17e2393
+size 12345
+`)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(12345), size)
+
+	_, err = parseLFSPointerSize("apiVersion: v1\nkind: ConfigMap\n")
+	assert.Error(t, err)
+}
+
+func TestLFSConfigArgs(t *testing.T) {
+	args := lfsConfigArgs([]string{"GIT_ASKPASS=git-ask-pass.sh", "GIT_SSL_CERT=/tmp/cert", "GIT_SSL_KEY=/tmp/key"})
+	assert.Equal(t, []string{"-c", "http.sslCert=/tmp/cert", "-c", "http.sslKey=/tmp/key"}, args)
+	assert.Empty(t, lfsConfigArgs([]string{"GIT_SSH_COMMAND=ssh -i /tmp/key"}))
+}
+
 func TestNewFactory(t *testing.T) {
 	addBinDirToPath := path.NewBinDirToPath()
 	defer addBinDirToPath.Close()
@@ -284,6 +317,7 @@ func TestNewFactory(t *testing.T) {
 		metrics := &mocks.EventReporter{}
 		metrics.On("Event", tt.args.url, "GitRequestTypeLsRemote").Return()
 		metrics.On("Event", tt.args.url, "GitRequestTypeFetch").Return()
+		metrics.On("Observe", tt.args.url, mock.Anything, mock.Anything, mock.Anything).Return()
 		client, err := NewClient(tt.args.url, dirName, NopCreds{}, tt.args.insecureIgnoreHostKey, false, metrics)
 		assert.NoError(t, err)
 		commitSHA, err := client.LsRemote("HEAD")
@@ -316,3 +350,235 @@ func TestNewFactory(t *testing.T) {
 		assert.Equal(t, commitSHA, commitSHA2)
 	}
 }
+
+func TestInit_RepairsLocalRepository(t *testing.T) {
+	dir, err := ioutil.TempDir("", "git-client-init-test-")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	eventReporter := &mocks.EventReporter{}
+	eventReporter.On("Event", "https://github.com/argoproj/argo-cd.git", "GitCheckoutRepair").Return()
+	client, err := NewClient("https://github.com/argoproj/argo-cd.git", dir, NopCreds{}, false, false, eventReporter)
+	assert.NoError(t, err)
+	assert.NoError(t, client.Init())
+	assert.NoError(t, client.Verify())
+
+	// lock files of interrupted commands are removed
+	lockFile := filepath.Join(dir, ".git", "index.lock")
+	assert.NoError(t, ioutil.WriteFile(lockFile, nil, 0644))
+	assert.NoError(t, client.Init())
+	_, err = os.Stat(lockFile)
+	assert.True(t, os.IsNotExist(err))
+
+	// a repository which cannot be opened is initialized again
+	assert.NoError(t, os.RemoveAll(filepath.Join(dir, ".git")))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, ".git"), []byte("gitdir"), 0644))
+	assert.NoError(t, client.Init())
+	assert.NoError(t, client.Verify())
+	eventReporter.AssertNumberOfCalls(t, "Event", 1)
+
+	assert.NoError(t, client.Reinit())
+	eventReporter.AssertNumberOfCalls(t, "Event", 2)
+}
+
+func TestWorktree(t *testing.T) {
+	dir, err := ioutil.TempDir("", "git-client-worktree-test-")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	root := filepath.Join(dir, "repo")
+	_, err = argoexec.RunCommand("git", argoconfig.CmdOpts(), "init", root)
+	assert.NoError(t, err)
+	for _, args := range [][]string{
+		{"-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "--allow-empty", "-m", "first"},
+		{"tag", "first"},
+		{"-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "--allow-empty", "-m", "second"},
+	} {
+		_, err = argoexec.RunCommand("git", argoconfig.CmdOpts(), append([]string{"-C", root}, args...)...)
+		assert.NoError(t, err)
+	}
+
+	client, err := NewClient("https://github.com/argoproj/argo-cd.git", root, NopCreds{}, false, false, &mocks.EventReporter{})
+	assert.NoError(t, err)
+	first, err := client.Worktree("first")
+	assert.NoError(t, err)
+	assert.NoError(t, first.Checkout("first"))
+	second, err := client.Worktree("master")
+	assert.NoError(t, err)
+	assert.NoError(t, second.Checkout("master"))
+	assert.NotEqual(t, first.Root(), second.Root())
+
+	firstSHA, err := first.CommitSHA()
+	assert.NoError(t, err)
+	secondSHA, err := second.CommitSHA()
+	assert.NoError(t, err)
+	assert.NotEqual(t, firstSHA, secondSHA)
+
+	// stale lock files of interrupted checkouts are removed when the working tree is used again
+	gitDir, err := argoexec.RunCommand("git", argoconfig.CmdOpts(), "-C", first.Root(), "rev-parse", "--git-dir")
+	assert.NoError(t, err)
+	lockFile := filepath.Join(strings.TrimSpace(gitDir), "index.lock")
+	assert.NoError(t, ioutil.WriteFile(lockFile, nil, 0644))
+	first, err = client.Worktree("first")
+	assert.NoError(t, err)
+	assert.NoError(t, first.Checkout("first"))
+
+	// removed working trees are added again
+	assert.NoError(t, client.RemoveWorktree("first"))
+	_, err = os.Stat(first.Root())
+	assert.True(t, os.IsNotExist(err))
+	first, err = client.Worktree("first")
+	assert.NoError(t, err)
+	assert.NoError(t, first.Checkout("first"))
+}
+
+func TestWorktree_InUse(t *testing.T) {
+	dir, err := ioutil.TempDir("", "git-client-worktree-in-use-test-")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	root := filepath.Join(dir, "repo")
+	_, err = argoexec.RunCommand("git", argoconfig.CmdOpts(), "init", root)
+	assert.NoError(t, err)
+	for _, args := range [][]string{
+		{"-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "--allow-empty", "-m", "first"},
+		{"tag", "first"},
+		{"-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "--allow-empty", "-m", "second"},
+		{"tag", "second"},
+	} {
+		_, err = argoexec.RunCommand("git", argoconfig.CmdOpts(), append([]string{"-C", root}, args...)...)
+		assert.NoError(t, err)
+	}
+	defer func(max int, age time.Duration) {
+		maxWorktrees = max
+		minWorktreeAge = age
+	}(maxWorktrees, minWorktreeAge)
+	maxWorktrees = 1
+	minWorktreeAge = 0
+
+	eventReporter := &mocks.EventReporter{}
+	eventReporter.On("Event", "https://github.com/argoproj/argo-cd.git", "GitCheckoutRepair").Return()
+	client, err := NewClient("https://github.com/argoproj/argo-cd.git", root, NopCreds{}, false, false, eventReporter)
+	assert.NoError(t, err)
+	first, err := client.Worktree("first")
+	assert.NoError(t, err)
+	second, err := client.Worktree("second")
+	assert.NoError(t, err)
+
+	// working trees which are in use are not pruned
+	_, err = os.Stat(first.Root())
+	assert.NoError(t, err)
+	first.Release()
+	third, err := client.Worktree("master")
+	assert.NoError(t, err)
+	_, err = os.Stat(first.Root())
+	assert.True(t, os.IsNotExist(err))
+
+	// working trees which are in use are kept when the repository is initialized again
+	third.Release()
+	assert.NoError(t, client.Reinit())
+	_, err = os.Stat(second.Root())
+	assert.NoError(t, err)
+	_, err = os.Stat(third.Root())
+	assert.True(t, os.IsNotExist(err))
+	second.Release()
+}
+
+func TestSparseWorktree(t *testing.T) {
+	dir, err := ioutil.TempDir("", "git-client-sparse-worktree-test-")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	root := filepath.Join(dir, "repo")
+	_, err = argoexec.RunCommand("git", argoconfig.CmdOpts(), "init", root)
+	assert.NoError(t, err)
+	for _, file := range []string{"apps/guestbook/deployment.yaml", "apps/other/deployment.yaml", "bases/common.yaml"} {
+		assert.NoError(t, os.MkdirAll(filepath.Join(root, filepath.Dir(file)), 0755))
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(root, file), []byte(file), 0644))
+	}
+	for _, args := range [][]string{
+		{"add", "."},
+		{"-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "-m", "first"},
+	} {
+		_, err = argoexec.RunCommand("git", argoconfig.CmdOpts(), append([]string{"-C", root}, args...)...)
+		assert.NoError(t, err)
+	}
+
+	client, err := NewClient("https://github.com/argoproj/argo-cd.git", root, NopCreds{}, false, false, &mocks.EventReporter{})
+	assert.NoError(t, err)
+	sparse, err := client.Worktree("master", "apps/guestbook", "/bases/common.yaml")
+	assert.NoError(t, err)
+	assert.NoError(t, sparse.Checkout("master"))
+	for file, exists := range map[string]bool{"apps/guestbook/deployment.yaml": true, "bases/common.yaml": true, "apps/other/deployment.yaml": false} {
+		_, err = os.Stat(filepath.Join(sparse.Root(), file))
+		assert.Equal(t, exists, err == nil, file)
+	}
+	// files which are not checked out are still known
+	files, err := sparse.LsFiles("apps/other")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"apps/other/deployment.yaml"}, files)
+
+	// the working trees of other paths are separate
+	full, err := client.Worktree("master")
+	assert.NoError(t, err)
+	assert.NoError(t, full.Checkout("master"))
+	assert.NotEqual(t, sparse.Root(), full.Root())
+	_, err = os.Stat(filepath.Join(full.Root(), "apps/other/deployment.yaml"))
+	assert.NoError(t, err)
+	same, err := client.Worktree("master", "bases/common.yaml", "apps/guestbook/")
+	assert.NoError(t, err)
+	assert.Equal(t, sparse.Root(), same.Root())
+
+	// the root of the repository means a full checkout
+	root2, err := client.Worktree("master", ".")
+	assert.NoError(t, err)
+	assert.Equal(t, full.Root(), root2.Root())
+}
+
+func TestChangedFiles(t *testing.T) {
+	root, err := ioutil.TempDir("", "git-client-changed-files-test-")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(root) }()
+	_, err = argoexec.RunCommand("git", argoconfig.CmdOpts(), "init", root)
+	assert.NoError(t, err)
+	commit := func(file string) {
+		assert.NoError(t, os.MkdirAll(filepath.Join(root, filepath.Dir(file)), 0755))
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(root, file), []byte(file), 0644))
+		for _, args := range [][]string{
+			{"add", "--all"},
+			{"-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "-m", file},
+		} {
+			_, err = argoexec.RunCommand("git", argoconfig.CmdOpts(), append([]string{"-C", root}, args...)...)
+			assert.NoError(t, err)
+		}
+	}
+	client, err := NewClient("https://github.com/argoproj/argo-cd.git", root, NopCreds{}, false, false, &mocks.EventReporter{})
+	assert.NoError(t, err)
+
+	commit("apps/guestbook/deployment.yaml")
+	first, err := client.CommitSHA()
+	assert.NoError(t, err)
+	commit("bases/guestbook/kustomization.yaml")
+	commit("README.md")
+	last, err := client.CommitSHA()
+	assert.NoError(t, err)
+
+	files, err := client.ChangedFiles(first, last)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"bases/guestbook/kustomization.yaml", "README.md"}, files)
+
+	files, err = client.ChangedFiles(last, last)
+	assert.NoError(t, err)
+	assert.Empty(t, files)
+
+	commits, err := client.Commits(first, last)
+	assert.NoError(t, err)
+	if assert.Len(t, commits, 2) {
+		assert.Equal(t, last, commits[0].SHA)
+		assert.Equal(t, "README.md", commits[0].Message)
+		assert.Equal(t, "test <test@example.com>", commits[0].Author)
+		assert.NotEmpty(t, commits[0].Date)
+		assert.Equal(t, "bases/guestbook/kustomization.yaml", commits[1].Message)
+	}
+
+	commits, err = client.Commits(last, first)
+	assert.NoError(t, err)
+	assert.Empty(t, commits)
+}
@@ -0,0 +1,101 @@
+package git
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/argoproj/argo-cd/common"
+)
+
+var (
+	// lfsMaxSize is the maximum total size in bytes of the LFS objects of a checked out revision, 0 means no limit
+	lfsMaxSize int64
+)
+
+func init() {
+	if sizeStr := os.Getenv(common.EnvGitLFSMaxSize); sizeStr != "" {
+		size, err := resource.ParseQuantity(sizeStr)
+		if err != nil {
+			panic(fmt.Sprintf("Invalid value in %s env variable: %v", common.EnvGitLFSMaxSize, err))
+		}
+		lfsMaxSize = size.Value()
+	}
+}
+
+// lfsConfigFromEnv maps the environment variables of git which git-lfs does not read to the equivalent configuration,
+// so that git-lfs authenticates with the same client certificate as git
+var lfsConfigFromEnv = map[string]string{
+	"GIT_SSL_CERT": "http.sslCert",
+	"GIT_SSL_KEY":  "http.sslKey",
+}
+
+// lfsConfigArgs returns the git arguments which pass the client certificate of the given environment to git-lfs
+func lfsConfigArgs(environ []string) []string {
+	var args []string
+	for _, env := range environ {
+		parts := strings.SplitN(env, "=", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		if key, ok := lfsConfigFromEnv[parts[0]]; ok {
+			args = append(args, "-c", fmt.Sprintf("%s=%s", key, parts[1]))
+		}
+	}
+	return args
+}
+
+// parseLFSPointerSize returns the size of the object referenced by the given LFS pointer file
+func parseLFSPointerSize(pointer string) (int64, error) {
+	for _, line := range strings.Split(pointer, "\n") {
+		if strings.HasPrefix(line, "size ") {
+			return strconv.ParseInt(strings.TrimSpace(strings.TrimPrefix(line, "size ")), 10, 64)
+		}
+	}
+	return 0, fmt.Errorf("not a git-lfs pointer file")
+}
+
+// fetchLargeFiles downloads the LFS objects of the checked out revision, after verifying that their total size does
+// not exceed the configured maximum
+func (m *nativeGitClient) fetchLargeFiles(largeFiles []string) error {
+	if lfsMaxSize > 0 {
+		var total int64
+		for _, path := range largeFiles {
+			// the blob stored in git is the pointer file, regardless of what is in the working tree
+			pointer, err := m.runCmd("cat-file", "-p", "HEAD:"+path)
+			if err != nil {
+				return err
+			}
+			size, err := parseLFSPointerSize(pointer)
+			if err != nil {
+				return fmt.Errorf("failed to read size of large file %s: %v", path, err)
+			}
+			total += size
+		}
+		if total > lfsMaxSize {
+			return fmt.Errorf("large files of revision total %d bytes, which exceeds the maximum of %d bytes", total, lfsMaxSize)
+		}
+	}
+	args := []string{"fetch", "origin", "HEAD"}
+	if len(m.sparsePaths) > 0 {
+		args = append(args, "--include", strings.Join(m.sparsePaths, ","))
+	}
+	_, err := m.runCredentialedLFSCmd(args...)
+	return err
+}
+
+// runCredentialedLFSCmd runs a git-lfs command with the credentials of the repository
+func (m *nativeGitClient) runCredentialedLFSCmd(args ...string) (string, error) {
+	closer, environ, err := m.creds.Environ()
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = closer.Close() }()
+	cmd := exec.Command("git", append(append(lfsConfigArgs(environ), "lfs"), args...)...)
+	cmd.Env = append(cmd.Env, environ...)
+	return m.runCmdOutput(cmd)
+}
@@ -13,6 +13,29 @@ type Client struct {
 	mock.Mock
 }
 
+// ChangedFiles provides a mock function with given fields: revision, targetRevision
+func (_m *Client) ChangedFiles(revision string, targetRevision string) ([]string, error) {
+	ret := _m.Called(revision, targetRevision)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(string, string) []string); ok {
+		r0 = rf(revision, targetRevision)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(revision, targetRevision)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Checkout provides a mock function with given fields: revision
 func (_m *Client) Checkout(revision string) error {
 	ret := _m.Called(revision)
@@ -27,6 +50,50 @@ func (_m *Client) Checkout(revision string) error {
 	return r0
 }
 
+// Commits provides a mock function with given fields: revision, targetRevision
+func (_m *Client) Commits(revision string, targetRevision string) ([]git.Commit, error) {
+	ret := _m.Called(revision, targetRevision)
+
+	var r0 []git.Commit
+	if rf, ok := ret.Get(0).(func(string, string) []git.Commit); ok {
+		r0 = rf(revision, targetRevision)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]git.Commit)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(revision, targetRevision)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CommitAndPush provides a mock function with given fields: branch, message
+func (_m *Client) CommitAndPush(branch string, message string) (string, error) {
+	ret := _m.Called(branch, message)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string, string) string); ok {
+		r0 = rf(branch, message)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(branch, message)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CommitSHA provides a mock function with given fields:
 func (_m *Client) CommitSHA() (string, error) {
 	ret := _m.Called()
@@ -143,6 +210,46 @@ func (_m *Client) LsRemote(revision string) (string, error) {
 	return r0, r1
 }
 
+// Reinit provides a mock function with given fields:
+func (_m *Client) Reinit() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Release provides a mock function with given fields:
+func (_m *Client) Release() {
+	_m.Called()
+}
+
+// RemoveWorktree provides a mock function with given fields: revision, paths
+func (_m *Client) RemoveWorktree(revision string, paths ...string) error {
+	_va := make([]interface{}, len(paths))
+	for _i := range paths {
+		_va[_i] = paths[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, revision)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, ...string) error); ok {
+		r0 = rf(revision, paths...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // RevisionMetadata provides a mock function with given fields: revision
 func (_m *Client) RevisionMetadata(revision string) (*git.RevisionMetadata, error) {
 	ret := _m.Called(revision)
@@ -179,3 +286,47 @@ func (_m *Client) Root() string {
 
 	return r0
 }
+
+// Verify provides a mock function with given fields:
+func (_m *Client) Verify() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Worktree provides a mock function with given fields: revision, paths
+func (_m *Client) Worktree(revision string, paths ...string) (git.Client, error) {
+	_va := make([]interface{}, len(paths))
+	for _i := range paths {
+		_va[_i] = paths[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, revision)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 git.Client
+	if rf, ok := ret.Get(0).(func(string, ...string) git.Client); ok {
+		r0 = rf(revision, paths...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(git.Client)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, ...string) error); ok {
+		r1 = rf(revision, paths...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
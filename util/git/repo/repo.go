@@ -1,18 +1,75 @@
 package repo
 
 import (
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
 	"github.com/argoproj/argo-cd/util/app/path"
 	"github.com/argoproj/argo-cd/util/git"
+	"github.com/argoproj/argo-cd/util/kustomize"
 	"github.com/argoproj/argo-cd/util/repo"
 	"github.com/argoproj/argo-cd/util/repo/metrics"
 )
 
+// verifyInterval is the minimum interval between integrity checks of the same checkout, so that failures which are
+// not caused by the checkout (e.g. an unreachable repository) do not check it on every request
+var verifyInterval = 5 * time.Minute
+
+// lastVerified holds the time at which each checkout was last found intact, keyed by the root of the checkout
+var lastVerified sync.Map
+
 type GitRepo struct {
 	client git.Client
 	disco  func(root string) (map[string]string, error)
+	// worktrees holds the working trees which were checked out, so that they are released once they are not used anymore
+	worktrees *usedWorktrees
 }
 
+// usedWorktrees are the working trees which a repo checked out and which are in use until the repo is released
+type usedWorktrees struct {
+	lock    sync.Mutex
+	clients []git.Client
+}
+
+// use keeps the given working tree from being removed until the repo is released
+func (g GitRepo) use(worktree git.Client) {
+	if g.worktrees == nil {
+		return
+	}
+	g.worktrees.lock.Lock()
+	defer g.worktrees.lock.Unlock()
+	g.worktrees.clients = append(g.worktrees.clients, worktree)
+}
+
+// Release releases the working trees which were checked out, so that they may be removed
+func (g GitRepo) Release() {
+	if g.worktrees == nil {
+		return
+	}
+	g.worktrees.lock.Lock()
+	defer g.worktrees.lock.Unlock()
+	for _, worktree := range g.worktrees.clients {
+		worktree.Release()
+	}
+	g.worktrees.clients = nil
+}
+
+// Init initializes and fetches the repository. A corrupted checkout is cloned again rather than failing every request
+// until the pod is restarted.
 func (g GitRepo) Init() error {
+	err := g.init()
+	if err != nil && g.repair(err) {
+		return g.init()
+	}
+	return err
+}
+
+func (g GitRepo) init() error {
 	err := g.client.Init()
 	if err != nil {
 		return err
@@ -20,33 +77,176 @@ func (g GitRepo) Init() error {
 	return g.client.Fetch()
 }
 
+// checkout checks out the given revision in its own working tree and returns the root of the working tree, cloning
+// the repository again if the checkout is corrupted
+func (g GitRepo) checkout(revision string) (string, error) {
+	root, err := g.checkoutWorktree(revision)
+	if err != nil && g.repair(err) {
+		if err := g.init(); err != nil {
+			return "", err
+		}
+		return g.checkoutWorktree(revision)
+	}
+	return root, err
+}
+
+// checkoutWorktree checks out the given revision in its working tree. A working tree in which the checkout fails is
+// added again once, since it is restored from the local repository without fetching.
+func (g GitRepo) checkoutWorktree(revision string) (string, error) {
+	worktree, err := g.client.Worktree(revision)
+	if err == nil {
+		if err = worktree.Checkout(revision); err == nil {
+			g.use(worktree)
+			return worktree.Root(), nil
+		}
+		worktree.Release()
+	}
+	log.Warnf("Failed to check out %s in its working tree, adding it again: %v", revision, err)
+	if err := g.client.RemoveWorktree(revision); err != nil {
+		return "", err
+	}
+	worktree, err = g.client.Worktree(revision)
+	if err != nil {
+		return "", err
+	}
+	if err := worktree.Checkout(revision); err != nil {
+		worktree.Release()
+		return "", err
+	}
+	g.use(worktree)
+	return worktree.Root(), nil
+}
+
+// repair verifies the integrity of the checkout after the given error occurred, and removes the checkout if it is
+// corrupted, so that it is cloned again. Returns whether the checkout was removed.
+func (g GitRepo) repair(cause error) bool {
+	root := g.client.Root()
+	if verifiedAt, ok := lastVerified.Load(root); ok && time.Since(verifiedAt.(time.Time)) < verifyInterval {
+		return false
+	}
+	verifyErr := g.client.Verify()
+	if verifyErr == nil {
+		lastVerified.Store(root, time.Now())
+		return false
+	}
+	log.Warnf("Checkout %s is corrupted (%v) after error: %v", root, verifyErr, cause)
+	if err := g.client.Reinit(); err != nil {
+		log.Warnf("Failed to repair checkout %s: %v", root, err)
+		return false
+	}
+	lastVerified.Delete(root)
+	return true
+}
+
 func (g GitRepo) LockKey() string {
 	return g.client.Root()
 }
 
+// AppLockKey returns the lock key of the working tree of the given revision, which is separate from the lock of the
+// repository, so that different revisions are used concurrently
+func (g GitRepo) AppLockKey(resolvedRevision string) string {
+	return g.client.Root() + "@" + resolvedRevision
+}
+
 func (g GitRepo) GetApp(app, resolvedRevision string) (string, error) {
-	err := g.client.Checkout(resolvedRevision)
+	root, err := g.checkout(resolvedRevision)
+	if err != nil {
+		return "", err
+	}
+	appPath, err := path.Path(root, app)
+	if err != nil {
+		return "", err
+	}
+	return appPath, nil
+}
+
+// GetSparseApp checks out only the app and the given paths, relative to the root of the repository, which the app
+// depends on. The whole revision is checked out if kustomizations within these paths refer to files outside of them.
+func (g GitRepo) GetSparseApp(app, resolvedRevision string, paths []string) (string, error) {
+	paths = append([]string{app}, paths...)
+	worktree, err := g.client.Worktree(resolvedRevision, paths...)
+	if err == nil {
+		if err = worktree.Checkout(resolvedRevision); err != nil {
+			worktree.Release()
+		}
+	}
+	if err != nil {
+		log.Warnf("Failed to check out %s of %s sparsely, checking out all paths: %v", strings.Join(paths, ", "), resolvedRevision, err)
+		_ = g.client.RemoveWorktree(resolvedRevision, paths...)
+		return g.GetApp(app, resolvedRevision)
+	}
+	g.use(worktree)
+	appPath, err := path.Path(worktree.Root(), app)
 	if err != nil {
 		return "", err
 	}
-	appPath, err := path.Path(g.client.Root(), app)
+	outside, err := referencesOutside(worktree, appPath, paths)
 	if err != nil {
 		return "", err
 	}
+	if outside != "" {
+		log.Infof("Checking out all paths of %s, since %s is referenced by a kustomization of %s", resolvedRevision, outside, app)
+		return g.GetApp(app, resolvedRevision)
+	}
 	return appPath, nil
 }
 
+// referencesOutside returns the first file or directory, relative to the root of the repository, which is referenced
+// by the kustomization of the app or of its local bases but is not within the given paths, or "" if there is none
+func referencesOutside(worktree git.Client, appPath string, paths []string) (string, error) {
+	root := filepath.Clean(worktree.Root())
+	visited := map[string]bool{}
+	queue := []string{appPath}
+	for len(queue) > 0 {
+		dir := queue[0]
+		queue = queue[1:]
+		if visited[dir] {
+			continue
+		}
+		visited[dir] = true
+		refs, err := kustomize.References(dir)
+		if err != nil {
+			return "", err
+		}
+		for _, ref := range refs {
+			if strings.Contains(ref, "://") || filepath.IsAbs(ref) {
+				continue
+			}
+			target := filepath.Join(dir, ref)
+			rel, err := filepath.Rel(root, target)
+			if err != nil || rel == ".." || strings.HasPrefix(rel, "../") {
+				continue
+			}
+			if !path.FilesChanged(paths, []string{rel}) {
+				// remote bases, e.g. github.com/org/repo//path, are not files of the repository
+				files, err := worktree.LsFiles(rel)
+				if err != nil {
+					return "", err
+				}
+				if len(files) > 0 {
+					return rel, nil
+				}
+				continue
+			}
+			if info, err := os.Stat(target); err == nil && info.IsDir() {
+				queue = append(queue, target)
+			}
+		}
+	}
+	return "", nil
+}
+
 // convert an ambiguous revision (e.g. "", "master" or "HEAD") into a specific revision (e.g. "231345034boc" or "5.8.0")
 func (g GitRepo) ResolveRevision(revision string) (resolvedRevision string, err error) {
 	return g.client.LsRemote(revision)
 }
 
 func (g GitRepo) ListApps(resolvedRevision string) (map[string]string, error) {
-	err := g.client.Checkout(resolvedRevision)
+	root, err := g.checkout(resolvedRevision)
 	if err != nil {
 		return nil, err
 	}
-	apps, err := g.disco(g.client.Root())
+	apps, err := g.disco(root)
 	return apps, err
 }
 
@@ -68,6 +268,29 @@ func (g GitRepo) RevisionMetadata(_, resolvedRevision string) (*repo.RevisionMet
 	return out, err
 }
 
+// ChangedFiles returns the files which differ between the given revisions, which must have been fetched
+func (g GitRepo) ChangedFiles(resolvedRevision, targetResolvedRevision string) ([]string, error) {
+	return g.client.ChangedFiles(resolvedRevision, targetResolvedRevision)
+}
+
+// Commits returns the commits between the given revisions, which must have been fetched
+func (g GitRepo) Commits(resolvedRevision, targetResolvedRevision string) ([]repo.Commit, error) {
+	commits, err := g.client.Commits(resolvedRevision, targetResolvedRevision)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]repo.Commit, len(commits))
+	for i, commit := range commits {
+		out[i] = repo.Commit{
+			Revision: commit.SHA,
+			Author:   commit.Author,
+			Date:     commit.Date,
+			Message:  commit.Message,
+		}
+	}
+	return out, nil
+}
+
 func NewRepo(url string, creds git.Creds, insecure, enableLfs bool, disco func(root string) (map[string]string, error), reporter metrics.Reporter) (repo.Repo, error) {
 	workDir, err := repo.WorkDir(url)
 	if err != nil {
@@ -77,5 +300,5 @@ func NewRepo(url string, creds git.Creds, insecure, enableLfs bool, disco func(r
 	if err != nil {
 		return nil, err
 	}
-	return &GitRepo{client, disco}, nil
+	return &GitRepo{client: client, disco: disco, worktrees: &usedWorktrees{}}, nil
 }
@@ -1,6 +1,7 @@
 package repo
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -16,10 +17,11 @@ func fixtures() (*GitRepo, git.Client, map[string]string) {
 	client.On("Checkout", mock.Anything, mock.Anything).Return(nil)
 	client.On("Root").Return("./testdata")
 	client.On("LsRemote", mock.Anything).Return("1.0.0", nil)
+	client.On("Worktree", mock.Anything).Return(client, nil)
 	m := &git.RevisionMetadata{}
 	client.On("RevisionMetadata", mock.Anything).Return(m, nil)
 	apps := make(map[string]string)
-	r := &GitRepo{client, func(root string) (map[string]string, error) {
+	r := &GitRepo{client: client, disco: func(root string) (map[string]string, error) {
 		return apps, nil
 	}}
 	return r, client, apps
@@ -64,3 +66,96 @@ func Test_GitRepo_RevisionMetadata(t *testing.T) {
 	assert.Equal(t, repo.RevisionMetadata{}, *m)
 
 }
+
+func Test_GitRepo_Init_RepairsCorruptedCheckout(t *testing.T) {
+	client := &mocks.Client{}
+	client.On("Root").Return("corrupted")
+	client.On("Init").Return(nil)
+	client.On("Fetch").Return(fmt.Errorf("bad object HEAD")).Once()
+	client.On("Fetch").Return(nil)
+	client.On("Verify").Return(fmt.Errorf("missing blob"))
+	client.On("Reinit").Return(nil)
+	r := &GitRepo{client: client}
+
+	assert.NoError(t, r.Init())
+	client.AssertNumberOfCalls(t, "Reinit", 1)
+	client.AssertNumberOfCalls(t, "Fetch", 2)
+}
+
+func Test_GitRepo_GetApp_IntactCheckout(t *testing.T) {
+	client := &mocks.Client{}
+	client.On("Root").Return("intact")
+	client.On("Worktree", "master").Return(client, nil)
+	client.On("RemoveWorktree", "master").Return(nil)
+	client.On("Checkout", "master").Return(fmt.Errorf("pathspec 'master' did not match"))
+	client.On("Release").Return()
+	client.On("Verify").Return(nil)
+	r := &GitRepo{client: client}
+
+	_, err := r.GetApp(".", "master")
+	assert.Error(t, err)
+	client.AssertNumberOfCalls(t, "RemoveWorktree", 1)
+	client.AssertNotCalled(t, "Reinit")
+
+	// the checkout is not verified again until the verify interval elapsed
+	_, err = r.GetApp(".", "master")
+	assert.Error(t, err)
+	client.AssertNumberOfCalls(t, "Verify", 1)
+}
+
+func Test_GitRepo_GetSparseApp(t *testing.T) {
+	worktree := &mocks.Client{}
+	worktree.On("Root").Return("./testdata")
+	worktree.On("Checkout", "1.0.0").Return(nil)
+	client := &mocks.Client{}
+	client.On("Root").Return("repo")
+	client.On("Worktree", "1.0.0", "apps/guestbook", "bases/guestbook").Return(worktree, nil)
+	r := &GitRepo{client: client}
+
+	appPath, err := r.GetSparseApp("apps/guestbook", "1.0.0", []string{"bases/guestbook"})
+	assert.NoError(t, err)
+	assert.Equal(t, "testdata/apps/guestbook", appPath)
+	worktree.AssertNotCalled(t, "LsFiles", mock.Anything)
+}
+
+func Test_GitRepo_GetSparseApp_BaseOutsidePaths(t *testing.T) {
+	sparse := &mocks.Client{}
+	sparse.On("Root").Return("./testdata")
+	sparse.On("Checkout", "1.0.0").Return(nil)
+	sparse.On("LsFiles", "bases/guestbook").Return([]string{"bases/guestbook/kustomization.yaml"}, nil)
+	full := &mocks.Client{}
+	full.On("Root").Return("./testdata")
+	full.On("Checkout", "1.0.0").Return(nil)
+	client := &mocks.Client{}
+	client.On("Root").Return("repo")
+	client.On("Worktree", "1.0.0", "apps/guestbook").Return(sparse, nil)
+	client.On("Worktree", "1.0.0").Return(full, nil)
+	r := &GitRepo{client: client}
+
+	appPath, err := r.GetSparseApp("apps/guestbook", "1.0.0", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "testdata/apps/guestbook", appPath)
+	full.AssertNumberOfCalls(t, "Checkout", 1)
+}
+
+func Test_GitRepo_GetApp_Worktree(t *testing.T) {
+	worktree := &mocks.Client{}
+	worktree.On("Root").Return("./testdata")
+	worktree.On("Checkout", "1.0.0").Return(nil)
+	client := &mocks.Client{}
+	client.On("Root").Return("repo")
+	client.On("Worktree", "1.0.0").Return(worktree, nil)
+	r := &GitRepo{client: client, worktrees: &usedWorktrees{}}
+
+	appPath, err := r.GetApp("foo", "1.0.0")
+	assert.NoError(t, err)
+	assert.Equal(t, "testdata/foo", appPath)
+	client.AssertNotCalled(t, "Checkout", mock.Anything)
+	assert.Equal(t, "repo@1.0.0", r.AppLockKey("1.0.0"))
+
+	// the working tree is in use until the repo is released
+	worktree.AssertNotCalled(t, "Release")
+	worktree.On("Release").Return()
+	r.Release()
+	worktree.AssertNumberOfCalls(t, "Release", 1)
+}
@@ -0,0 +1,288 @@
+package git
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+var (
+	// maxWorktrees is the number of working trees of a repository above which the least recently used ones are removed
+	maxWorktrees = 10
+	// minWorktreeAge is how long a working tree is kept after it was last used, regardless of maxWorktrees, so that
+	// working trees are not removed while manifests are generated from them
+	minWorktreeAge = 10 * time.Minute
+)
+
+// worktreeUsers counts the users of each working tree, keyed by its path. Working trees which are in use are not
+// removed, since manifests are generated from their files after the lock of the repository was released.
+var worktreeUsers = struct {
+	sync.Mutex
+	counts map[string]int
+}{counts: make(map[string]int)}
+
+func acquireWorktree(path string) {
+	worktreeUsers.Lock()
+	defer worktreeUsers.Unlock()
+	worktreeUsers.counts[path]++
+}
+
+func releaseWorktree(path string) {
+	worktreeUsers.Lock()
+	defer worktreeUsers.Unlock()
+	if worktreeUsers.counts[path] <= 1 {
+		delete(worktreeUsers.counts, path)
+	} else {
+		worktreeUsers.counts[path]--
+	}
+}
+
+func isWorktreeInUse(path string) bool {
+	worktreeUsers.Lock()
+	defer worktreeUsers.Unlock()
+	return worktreeUsers.counts[path] > 0
+}
+
+// worktreesDir returns the directory which holds the working trees of the repository
+func (m *nativeGitClient) worktreesDir() string {
+	return m.root + "-worktrees"
+}
+
+// worktreePath returns the path of the working tree of the given revision and sparse paths. Revisions are hashed,
+// since they might be branch or tag names which are not valid directory names.
+func (m *nativeGitClient) worktreePath(revision string, paths []string) string {
+	if IsCommitSHA(revision) && len(paths) == 0 {
+		return filepath.Join(m.worktreesDir(), revision)
+	}
+	key := revision
+	if len(paths) > 0 {
+		key += "\n" + strings.Join(paths, "\n")
+	}
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(m.worktreesDir(), hex.EncodeToString(sum[:])[:40])
+}
+
+// sparsePaths returns the given paths relative to the root of the repository, sorted and without duplicates, or nil
+// if the whole repository has to be checked out
+func sparsePaths(paths []string) []string {
+	unique := map[string]bool{}
+	for _, path := range paths {
+		path = strings.TrimPrefix(filepath.Clean("/"+path), "/")
+		if path == "" {
+			return nil
+		}
+		unique[path] = true
+	}
+	var res []string
+	for path := range unique {
+		res = append(res, path)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// filterSparsePaths returns the files, relative to the root of the repository, which are within the sparse paths of the
+// working tree, or all files if the whole repository is checked out
+func (m *nativeGitClient) filterSparsePaths(files []string) []string {
+	if len(m.sparsePaths) == 0 {
+		return files
+	}
+	var res []string
+	for _, file := range files {
+		for _, path := range m.sparsePaths {
+			if file == path || strings.HasPrefix(file, path+"/") {
+				res = append(res, file)
+				break
+			}
+		}
+	}
+	return res
+}
+
+// Worktree returns a client of a linked working tree of the repository, in which the given revision is checked out
+// without affecting the working trees of other revisions. The working tree is added if it does not exist yet, or if
+// it cannot be used. If paths are given, only these paths are checked out (sparse checkout), in a working tree which
+// is separate from the working trees of other paths. The working tree is not removed by other clients until the
+// returned client is released.
+func (m *nativeGitClient) Worktree(revision string, paths ...string) (Client, error) {
+	paths = sparsePaths(paths)
+	path := m.worktreePath(revision, paths)
+	if err := m.removeWorktreeLockFiles(path); err != nil {
+		if !os.IsNotExist(err) {
+			log.Warnf("Working tree %s cannot be used, adding it again: %v", path, err)
+		}
+		if err := m.addWorktree(path, revision, paths); err != nil {
+			return nil, err
+		}
+	}
+	now := time.Now()
+	if err := os.Chtimes(path, now, now); err != nil {
+		return nil, err
+	}
+	acquireWorktree(path)
+	m.pruneWorktrees()
+	return &nativeGitClient{
+		repoURL:     m.repoURL,
+		root:        path,
+		creds:       m.creds,
+		insecure:    m.insecure,
+		enableLfs:   m.enableLfs,
+		reporter:    m.reporter,
+		sparsePaths: paths,
+		acquired:    true,
+	}, nil
+}
+
+// Release releases the working tree of a client which was returned by Worktree, so that it may be removed once no
+// other client uses it
+func (m *nativeGitClient) Release() {
+	if m.acquired {
+		releaseWorktree(m.root)
+		m.acquired = false
+	}
+}
+
+// removeUnusedWorktrees removes the working trees of the repository which are not in use. Callers hold the lock of
+// the repository, so no working tree is acquired concurrently.
+func (m *nativeGitClient) removeUnusedWorktrees() error {
+	infos, err := ioutil.ReadDir(m.worktreesDir())
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, info := range infos {
+		path := filepath.Join(m.worktreesDir(), info.Name())
+		if isWorktreeInUse(path) {
+			log.Infof("Keeping working tree %s, which is in use", path)
+			continue
+		}
+		if err := os.RemoveAll(path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RemoveWorktree removes the working tree of the given revision and sparse paths, e.g. if checkouts in it fail
+func (m *nativeGitClient) RemoveWorktree(revision string, paths ...string) error {
+	path := m.worktreePath(revision, sparsePaths(paths))
+	log.Infof("Removing working tree %s", path)
+	if err := os.RemoveAll(path); err != nil {
+		return err
+	}
+	_, err := m.runCmd("worktree", "prune")
+	return err
+}
+
+func (m *nativeGitClient) addWorktree(path string, revision string, paths []string) error {
+	if err := os.MkdirAll(m.worktreesDir(), 0700); err != nil {
+		return err
+	}
+	if err := os.RemoveAll(path); err != nil {
+		return err
+	}
+	// forget working trees which were removed, so that the path can be added again
+	if _, err := m.runCmd("worktree", "prune"); err != nil {
+		return err
+	}
+	if revision == "" || revision == "HEAD" {
+		revision = "origin/HEAD"
+	}
+	if len(paths) == 0 {
+		_, err := m.runCmd("worktree", "add", "--detach", "--force", path, revision)
+		return err
+	}
+	// the files are checked out by the first checkout, once the sparse checkout patterns are in place
+	if _, err := m.runCmd("worktree", "add", "--detach", "--force", "--no-checkout", path, revision); err != nil {
+		return err
+	}
+	gitDir, err := worktreeGitDir(path)
+	if err != nil {
+		return err
+	}
+	var patterns strings.Builder
+	for _, p := range paths {
+		patterns.WriteString("/" + p + "\n")
+	}
+	if err := os.MkdirAll(filepath.Join(gitDir, "info"), 0700); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(gitDir, "info", "sparse-checkout"), []byte(patterns.String()), 0600)
+}
+
+// worktreeGitDir returns the git directory of the given linked working tree. Returns an error if the working tree does
+// not exist or is not linked to the repository.
+func worktreeGitDir(path string) (string, error) {
+	data, err := ioutil.ReadFile(filepath.Join(path, ".git"))
+	if err != nil {
+		return "", err
+	}
+	content := strings.TrimSpace(string(data))
+	if !strings.HasPrefix(content, "gitdir:") {
+		return "", fmt.Errorf("%s is not a linked working tree", path)
+	}
+	gitDir := strings.TrimSpace(strings.TrimPrefix(content, "gitdir:"))
+	if _, err := os.Stat(gitDir); err != nil {
+		return "", err
+	}
+	return gitDir, nil
+}
+
+// removeWorktreeLockFiles removes the index lock file of the given working tree, which is left behind by interrupted
+// checkouts. Returns an error if the working tree does not exist or is not linked to the repository.
+func (m *nativeGitClient) removeWorktreeLockFiles(path string) error {
+	gitDir, err := worktreeGitDir(path)
+	if err != nil {
+		return err
+	}
+	lockFile := filepath.Join(gitDir, "index.lock")
+	if err := os.Remove(lockFile); err == nil {
+		log.Warnf("Removed stale lock file %s", lockFile)
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// pruneWorktrees removes the least recently used working trees while there are more than maxWorktrees of them. Working
+// trees which are in use, or which were used within minWorktreeAge, are kept.
+func (m *nativeGitClient) pruneWorktrees() {
+	infos, err := ioutil.ReadDir(m.worktreesDir())
+	if err != nil || len(infos) <= maxWorktrees {
+		return
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].ModTime().Before(infos[j].ModTime())
+	})
+	removed := false
+	for _, info := range infos[:len(infos)-maxWorktrees] {
+		if time.Since(info.ModTime()) < minWorktreeAge {
+			break
+		}
+		path := filepath.Join(m.worktreesDir(), info.Name())
+		if isWorktreeInUse(path) {
+			continue
+		}
+		log.Infof("Removing least recently used working tree %s", path)
+		if err := os.RemoveAll(path); err != nil {
+			log.Warnf("Failed to remove working tree %s: %v", path, err)
+			continue
+		}
+		removed = true
+	}
+	if removed {
+		if _, err := m.runCmd("worktree", "prune"); err != nil {
+			log.Warnf("Failed to prune working trees of %s: %v", m.root, err)
+		}
+	}
+}
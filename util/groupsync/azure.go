@@ -0,0 +1,134 @@
+package groupsync
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+var (
+	// azureLoginURL is the URL of the Microsoft identity platform which issues access tokens
+	azureLoginURL = "https://login.microsoftonline.com"
+	// azureGraphURL is the URL of the Microsoft Graph API
+	azureGraphURL = "https://graph.microsoft.com"
+)
+
+// AzureConfig configures the sync of Azure Active Directory groups. The application must be granted the
+// GroupMember.Read.All and User.Read.All application permissions of the Microsoft Graph API.
+type AzureConfig struct {
+	// TenantID is the ID of the Azure Active Directory tenant
+	TenantID string `json:"tenantID"`
+	// ClientID is the ID of the application which reads the groups
+	ClientID string `json:"clientID"`
+	// ClientSecret is the secret of the application which reads the groups
+	ClientSecret string `json:"clientSecret"`
+}
+
+type azureProvider struct {
+	config AzureConfig
+	client *http.Client
+}
+
+// NewAzureProvider returns a provider which retrieves group memberships using the Microsoft Graph API. Users are
+// identified by their user principal name and email address.
+func NewAzureProvider(config AzureConfig) Provider {
+	return &azureProvider{
+		config: config,
+		client: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+type azureGroup struct {
+	ID          string `json:"id"`
+	DisplayName string `json:"displayName"`
+}
+
+type azureMember struct {
+	Type              string `json:"@odata.type"`
+	Mail              string `json:"mail"`
+	UserPrincipalName string `json:"userPrincipalName"`
+}
+
+func (p *azureProvider) GetUserGroups() (map[string][]string, error) {
+	if p.config.TenantID == "" || p.config.ClientID == "" || p.config.ClientSecret == "" {
+		return nil, fmt.Errorf("azure tenantID, clientID and clientSecret are required")
+	}
+	token, err := p.getToken()
+	if err != nil {
+		return nil, err
+	}
+	groupItems, err := p.list(azureGraphURL+"/v1.0/groups?$select=id,displayName&$top=999", token)
+	if err != nil {
+		return nil, err
+	}
+	userGroups := make(map[string][]string)
+	for _, groupItem := range groupItems {
+		var group azureGroup
+		if err := json.Unmarshal(groupItem, &group); err != nil {
+			return nil, err
+		}
+		memberItems, err := p.list(fmt.Sprintf("%s/v1.0/groups/%s/members?$select=mail,userPrincipalName&$top=999", azureGraphURL, url.PathEscape(group.ID)), token)
+		if err != nil {
+			return nil, err
+		}
+		for _, memberItem := range memberItems {
+			var member azureMember
+			if err := json.Unmarshal(memberItem, &member); err != nil {
+				return nil, err
+			}
+			// groups may also contain devices, service principals and nested groups
+			if member.Type != "#microsoft.graph.user" {
+				continue
+			}
+			for _, id := range []string{member.UserPrincipalName, member.Mail} {
+				if id != "" {
+					userGroups[id] = appendUnique(userGroups[id], group.DisplayName)
+				}
+			}
+		}
+	}
+	return userGroups, nil
+}
+
+// getToken retrieves an access token for the Microsoft Graph API using the client credentials grant
+func (p *azureProvider) getToken() (string, error) {
+	form := url.Values{}
+	form.Set("client_id", p.config.ClientID)
+	form.Set("client_secret", p.config.ClientSecret)
+	form.Set("scope", azureGraphURL+"/.default")
+	form.Set("grant_type", "client_credentials")
+	resp, err := p.client.PostForm(fmt.Sprintf("%s/%s/oauth2/v2.0/token", azureLoginURL, url.PathEscape(p.config.TenantID)), form)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to retrieve azure access token: %s", resp.Status)
+	}
+	var token struct {
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return "", err
+	}
+	return token.AccessToken, nil
+}
+
+// list retrieves all pages of the given Microsoft Graph collection, following the next links of the responses
+func (p *azureProvider) list(pageURL string, token string) ([]json.RawMessage, error) {
+	var items []json.RawMessage
+	for pageURL != "" {
+		var page struct {
+			Value    []json.RawMessage `json:"value"`
+			NextLink string            `json:"@odata.nextLink"`
+		}
+		if _, err := getJSON(p.client, pageURL, "Bearer "+token, &page); err != nil {
+			return nil, err
+		}
+		items = append(items, page.Value...)
+		pageURL = page.NextLink
+	}
+	return items, nil
+}
@@ -0,0 +1,53 @@
+package groupsync
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAzureProvider_GetUserGroups(t *testing.T) {
+	var ts *httptest.Server
+	ts = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/tenant/oauth2/v2.0/token":
+			assert.NoError(t, r.ParseForm())
+			assert.Equal(t, "client_credentials", r.PostForm.Get("grant_type"))
+			if r.PostForm.Get("client_secret") != "secret" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, _ = w.Write([]byte(`{"access_token": "token"}`))
+			return
+		}
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		switch r.URL.Path {
+		case "/v1.0/groups":
+			_, _ = w.Write([]byte(`{"value": [{"id": "g1", "displayName": "admins"}], "@odata.nextLink": "` + ts.URL + `/v1.0/groups/page2"}`))
+		case "/v1.0/groups/page2":
+			_, _ = w.Write([]byte(`{"value": [{"id": "g2", "displayName": "developers"}]}`))
+		case "/v1.0/groups/g1/members":
+			_, _ = w.Write([]byte(`{"value": [{"@odata.type": "#microsoft.graph.user", "userPrincipalName": "alice@example.onmicrosoft.com", "mail": "alice@example.com"}]}`))
+		case "/v1.0/groups/g2/members":
+			_, _ = w.Write([]byte(`{"value": [{"@odata.type": "#microsoft.graph.group", "mail": "nested@example.com"}, {"@odata.type": "#microsoft.graph.user", "userPrincipalName": "bob@example.onmicrosoft.com"}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+	azureLoginURL = ts.URL
+	azureGraphURL = ts.URL
+
+	userGroups, err := NewAzureProvider(AzureConfig{TenantID: "tenant", ClientID: "client", ClientSecret: "secret"}).GetUserGroups()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]string{
+		"alice@example.onmicrosoft.com": {"admins"},
+		"alice@example.com":             {"admins"},
+		"bob@example.onmicrosoft.com":   {"developers"},
+	}, userGroups)
+
+	_, err = NewAzureProvider(AzureConfig{TenantID: "tenant", ClientID: "client", ClientSecret: "wrong"}).GetUserGroups()
+	assert.Error(t, err)
+}
@@ -0,0 +1,260 @@
+package groupsync
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	log "github.com/sirupsen/logrus"
+
+	jwtutil "github.com/argoproj/argo-cd/util/jwt"
+)
+
+const (
+	// ProviderTypeOkta syncs the groups of Okta
+	ProviderTypeOkta = "okta"
+	// ProviderTypeAzure syncs the groups of Azure Active Directory using the Microsoft Graph API
+	ProviderTypeAzure = "azure"
+
+	defaultRefreshInterval = 10 * time.Minute
+	defaultUserClaim       = "email"
+)
+
+// Provider retrieves the group memberships of the users of an identity provider
+type Provider interface {
+	// GetUserGroups returns the names of the groups of each user, keyed by the identifiers of the user (e.g. the email
+	// address and the login name)
+	GetUserGroups() (map[string][]string, error)
+}
+
+// Config is the configuration of the group sync, which is set in the argocd-cm ConfigMap
+type Config struct {
+	// Type is one of 'okta' or 'azure'
+	Type string `json:"type"`
+	// RefreshInterval is how often group memberships are synced, e.g. '10m'. Defaults to 10 minutes.
+	RefreshInterval string `json:"refreshInterval,omitempty"`
+	// UserClaim is the token claim which identifies the user in the identity provider. Defaults to 'email'.
+	UserClaim string `json:"userClaim,omitempty"`
+	// Okta configures the sync of Okta groups
+	Okta *OktaConfig `json:"okta,omitempty"`
+	// Azure configures the sync of Azure Active Directory groups
+	Azure *AzureConfig `json:"azure,omitempty"`
+}
+
+// GetRefreshInterval returns how often group memberships are synced
+func (c *Config) GetRefreshInterval() (time.Duration, error) {
+	if c.RefreshInterval == "" {
+		return defaultRefreshInterval, nil
+	}
+	interval, err := time.ParseDuration(c.RefreshInterval)
+	if err != nil {
+		return 0, fmt.Errorf("invalid group sync refresh interval: %v", err)
+	}
+	if interval <= 0 {
+		return 0, fmt.Errorf("invalid group sync refresh interval: must be positive")
+	}
+	return interval, nil
+}
+
+// GetUserClaim returns the token claim which identifies the user in the identity provider
+func (c *Config) GetUserClaim() string {
+	if c.UserClaim == "" {
+		return defaultUserClaim
+	}
+	return c.UserClaim
+}
+
+// NewProvider creates the provider of the given configuration
+func NewProvider(config *Config) (Provider, error) {
+	switch config.Type {
+	case ProviderTypeOkta:
+		if config.Okta == nil {
+			return nil, fmt.Errorf("okta group sync is not configured")
+		}
+		return NewOktaProvider(*config.Okta), nil
+	case ProviderTypeAzure:
+		if config.Azure == nil {
+			return nil, fmt.Errorf("azure group sync is not configured")
+		}
+		return NewAzureProvider(*config.Azure), nil
+	default:
+		return nil, fmt.Errorf("unknown group sync provider type '%s'", config.Type)
+	}
+}
+
+// Status describes the result of the last group sync
+type Status struct {
+	// Enabled is whether the group sync is configured
+	Enabled bool
+	// LastSyncTime is the time of the last successful sync
+	LastSyncTime time.Time
+	// Users is the number of user identifiers (e.g. email addresses) which have at least one group
+	Users int
+	// Error is the error of the last sync, if it failed
+	Error string
+}
+
+// Syncer periodically syncs the group memberships of the users from the identity provider, so that RBAC policies
+// can refer to groups which the identity provider does not include in tokens
+type Syncer struct {
+	getConfig func() (*Config, error)
+	// syncLock ensures that only one sync is in progress
+	syncLock sync.Mutex
+	// mutex protects the synced groups and the status
+	mutex      sync.RWMutex
+	userClaim  string
+	userGroups map[string][]string
+	status     Status
+}
+
+// NewSyncer returns a syncer which retrieves its configuration from the given function on every sync. The group
+// sync is disabled while the function returns nil.
+func NewSyncer(getConfig func() (*Config, error)) *Syncer {
+	return &Syncer{getConfig: getConfig}
+}
+
+// Run syncs the group memberships periodically until the context is done
+func (s *Syncer) Run(ctx context.Context) {
+	for {
+		interval, err := s.sync()
+		if err != nil {
+			log.Warnf("Failed to sync groups: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+	}
+}
+
+// Sync immediately syncs the group memberships and returns the resulting status
+func (s *Syncer) Sync() (Status, error) {
+	_, err := s.sync()
+	return s.Status(), err
+}
+
+// Status returns the status of the last sync
+func (s *Syncer) Status() Status {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.status
+}
+
+// GetGroups returns the synced groups of the user identified by the given claims
+func (s *Syncer) GetGroups(claims jwt.MapClaims) []string {
+	if s == nil {
+		return nil
+	}
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	if len(s.userGroups) == 0 {
+		return nil
+	}
+	user := jwtutil.GetField(claims, s.userClaim)
+	if user == "" {
+		return nil
+	}
+	return s.userGroups[strings.ToLower(user)]
+}
+
+// sync syncs the group memberships and returns the interval until the next sync. The previously synced groups are
+// kept if the sync fails.
+func (s *Syncer) sync() (time.Duration, error) {
+	s.syncLock.Lock()
+	defer s.syncLock.Unlock()
+	config, err := s.getConfig()
+	if err != nil {
+		s.setError(err)
+		return defaultRefreshInterval, err
+	}
+	if config == nil {
+		s.mutex.Lock()
+		s.userGroups = nil
+		s.status = Status{}
+		s.mutex.Unlock()
+		return defaultRefreshInterval, nil
+	}
+	interval, err := config.GetRefreshInterval()
+	if err != nil {
+		s.setError(err)
+		return defaultRefreshInterval, err
+	}
+	provider, err := NewProvider(config)
+	if err != nil {
+		s.setError(err)
+		return interval, err
+	}
+	userGroups, err := provider.GetUserGroups()
+	if err != nil {
+		s.setError(err)
+		return interval, err
+	}
+	normalized := make(map[string][]string)
+	for user, groups := range userGroups {
+		user = strings.ToLower(user)
+		normalized[user] = appendUnique(normalized[user], groups...)
+	}
+	for _, groups := range normalized {
+		sort.Strings(groups)
+	}
+	s.mutex.Lock()
+	s.userClaim = config.GetUserClaim()
+	s.userGroups = normalized
+	s.status = Status{Enabled: true, LastSyncTime: time.Now(), Users: len(normalized)}
+	s.mutex.Unlock()
+	log.Infof("Synced groups of %d users from %s", len(normalized), config.Type)
+	return interval, nil
+}
+
+func (s *Syncer) setError(err error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.status.Enabled = true
+	s.status.Error = err.Error()
+}
+
+func appendUnique(values []string, newValues ...string) []string {
+	for _, newValue := range newValues {
+		exists := false
+		for _, value := range values {
+			if value == newValue {
+				exists = true
+				break
+			}
+		}
+		if !exists {
+			values = append(values, newValue)
+		}
+	}
+	return values
+}
+
+// getJSON sends a GET request with the given authorization header and decodes the JSON response into the given
+// object. Returns the response so that callers can follow pagination links.
+func getJSON(client *http.Client, url string, authorization string, out interface{}) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", authorization)
+	req.Header.Set("Accept", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: %s", req.URL.Path, resp.Status)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
@@ -0,0 +1,78 @@
+package groupsync
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncer(t *testing.T) {
+	available := true
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !available {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		switch r.URL.Path {
+		case "/api/v1/groups":
+			_, _ = w.Write([]byte(`[{"id": "00g1", "profile": {"name": "admins"}}]`))
+		case "/api/v1/groups/00g1/users":
+			_, _ = w.Write([]byte(`[{"profile": {"login": "Alice@example.com"}}]`))
+		}
+	}))
+	defer ts.Close()
+
+	var config *Config
+	syncer := NewSyncer(func() (*Config, error) {
+		return config, nil
+	})
+	claims := jwt.MapClaims{"sub": "alice", "email": "alice@example.com"}
+
+	status, err := syncer.Sync()
+	assert.NoError(t, err)
+	assert.False(t, status.Enabled)
+	assert.Empty(t, syncer.GetGroups(claims))
+
+	config = &Config{Type: ProviderTypeOkta, Okta: &OktaConfig{URL: ts.URL, Token: "token"}}
+	status, err = syncer.Sync()
+	assert.NoError(t, err)
+	assert.True(t, status.Enabled)
+	assert.Equal(t, 1, status.Users)
+	assert.Equal(t, []string{"admins"}, syncer.GetGroups(claims))
+	assert.Empty(t, syncer.GetGroups(jwt.MapClaims{"sub": "bob", "email": "bob@example.com"}))
+
+	// previously synced groups are kept if the sync fails
+	available = false
+	status, err = syncer.Sync()
+	assert.Error(t, err)
+	assert.NotEmpty(t, status.Error)
+	assert.False(t, status.LastSyncTime.IsZero())
+	assert.Equal(t, []string{"admins"}, syncer.GetGroups(claims))
+
+	config.UserClaim = "sub"
+	available = true
+	_, err = syncer.Sync()
+	assert.NoError(t, err)
+	assert.Empty(t, syncer.GetGroups(claims))
+}
+
+func TestSyncer_InvalidConfig(t *testing.T) {
+	syncer := NewSyncer(func() (*Config, error) {
+		return nil, errors.New("invalid config")
+	})
+	status, err := syncer.Sync()
+	assert.Error(t, err)
+	assert.Equal(t, "invalid config", status.Error)
+
+	_, err = NewProvider(&Config{Type: "ldap"})
+	assert.Error(t, err)
+	_, err = (&Config{RefreshInterval: "-1m"}).GetRefreshInterval()
+	assert.Error(t, err)
+
+	var nilSyncer *Syncer
+	assert.Nil(t, nilSyncer.GetGroups(jwt.MapClaims{"email": "alice@example.com"}))
+}
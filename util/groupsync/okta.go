@@ -0,0 +1,104 @@
+package groupsync
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// linkNextRegex extracts the URL of the next page from a Link header
+var linkNextRegex = regexp.MustCompile(`<([^>]+)>;\s*rel="next"`)
+
+// OktaConfig configures the sync of Okta groups
+type OktaConfig struct {
+	// URL is the URL of the Okta organization, e.g. https://acme.okta.com
+	URL string `json:"url"`
+	// Token is an Okta API token with read access to groups and users
+	Token string `json:"token"`
+}
+
+type oktaProvider struct {
+	config OktaConfig
+	client *http.Client
+}
+
+// NewOktaProvider returns a provider which retrieves group memberships using the Okta groups API. Users are
+// identified by their login and email address.
+func NewOktaProvider(config OktaConfig) Provider {
+	return &oktaProvider{
+		config: config,
+		client: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+type oktaGroup struct {
+	ID      string `json:"id"`
+	Profile struct {
+		Name string `json:"name"`
+	} `json:"profile"`
+}
+
+type oktaUser struct {
+	Profile struct {
+		Login string `json:"login"`
+		Email string `json:"email"`
+	} `json:"profile"`
+}
+
+func (p *oktaProvider) GetUserGroups() (map[string][]string, error) {
+	if p.config.URL == "" || p.config.Token == "" {
+		return nil, fmt.Errorf("okta url and token are required")
+	}
+	baseURL := strings.TrimSuffix(p.config.URL, "/")
+	groupItems, err := p.list(baseURL + "/api/v1/groups?limit=200")
+	if err != nil {
+		return nil, err
+	}
+	userGroups := make(map[string][]string)
+	for _, groupItem := range groupItems {
+		var group oktaGroup
+		if err := json.Unmarshal(groupItem, &group); err != nil {
+			return nil, err
+		}
+		userItems, err := p.list(fmt.Sprintf("%s/api/v1/groups/%s/users?limit=200", baseURL, url.PathEscape(group.ID)))
+		if err != nil {
+			return nil, err
+		}
+		for _, userItem := range userItems {
+			var user oktaUser
+			if err := json.Unmarshal(userItem, &user); err != nil {
+				return nil, err
+			}
+			for _, id := range []string{user.Profile.Login, user.Profile.Email} {
+				if id != "" {
+					userGroups[id] = appendUnique(userGroups[id], group.Profile.Name)
+				}
+			}
+		}
+	}
+	return userGroups, nil
+}
+
+// list retrieves all pages of the given Okta collection, following the next links of the responses
+func (p *oktaProvider) list(pageURL string) ([]json.RawMessage, error) {
+	var items []json.RawMessage
+	for pageURL != "" {
+		var page []json.RawMessage
+		resp, err := getJSON(p.client, pageURL, "SSWS "+p.config.Token, &page)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, page...)
+		pageURL = ""
+		for _, link := range resp.Header["Link"] {
+			if match := linkNextRegex.FindStringSubmatch(link); match != nil {
+				pageURL = match[1]
+			}
+		}
+	}
+	return items, nil
+}
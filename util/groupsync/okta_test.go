@@ -0,0 +1,45 @@
+package groupsync
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOktaProvider_GetUserGroups(t *testing.T) {
+	var ts *httptest.Server
+	ts = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "SSWS token", r.Header.Get("Authorization"))
+		switch r.URL.Path {
+		case "/api/v1/groups":
+			if r.URL.Query().Get("after") == "" {
+				w.Header().Add("Link", fmt.Sprintf(`<%s/api/v1/groups?limit=200>; rel="self"`, ts.URL))
+				w.Header().Add("Link", fmt.Sprintf(`<%s/api/v1/groups?after=00g1&limit=200>; rel="next"`, ts.URL))
+				_, _ = w.Write([]byte(`[{"id": "00g1", "profile": {"name": "admins"}}]`))
+			} else {
+				_, _ = w.Write([]byte(`[{"id": "00g2", "profile": {"name": "developers"}}]`))
+			}
+		case "/api/v1/groups/00g1/users":
+			_, _ = w.Write([]byte(`[{"profile": {"login": "alice@example.com", "email": "alice@example.com"}}]`))
+		case "/api/v1/groups/00g2/users":
+			_, _ = w.Write([]byte(`[{"profile": {"login": "alice", "email": "alice@example.com"}}, {"profile": {"login": "bob@example.com"}}]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	userGroups, err := NewOktaProvider(OktaConfig{URL: ts.URL, Token: "token"}).GetUserGroups()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]string{
+		"alice@example.com": {"admins", "developers"},
+		"alice":             {"developers"},
+		"bob@example.com":   {"developers"},
+	}, userGroups)
+
+	_, err = NewOktaProvider(OktaConfig{URL: ts.URL, Token: ""}).GetUserGroups()
+	assert.Error(t, err)
+}
@@ -1,6 +1,11 @@
 package grpc
 
 import (
+	"fmt"
+	"net/http"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -8,6 +13,84 @@ import (
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 )
 
+// ErrorReason is a machine readable classification of an API error. It is attached to gRPC statuses as a detail,
+// so that clients are able to act on errors without parsing their messages.
+type ErrorReason string
+
+const (
+	// ErrorReasonAuthFailed indicates the request carried no valid credentials
+	ErrorReasonAuthFailed ErrorReason = "AuthFailed"
+	// ErrorReasonRepositoryUnreachable indicates a repository could not be fetched or resolved
+	ErrorReasonRepositoryUnreachable ErrorReason = "RepositoryUnreachable"
+	// ErrorReasonRenderFailed indicates manifests could not be generated from the application source
+	ErrorReasonRenderFailed ErrorReason = "RenderFailed"
+	// ErrorReasonManifestQuotaExceeded indicates the rendered manifests exceed the manifest quota of the project
+	ErrorReasonManifestQuotaExceeded ErrorReason = "ManifestQuotaExceeded"
+	// ErrorReasonProjectPermissionDenied indicates the application violates the rules of its project
+	ErrorReasonProjectPermissionDenied ErrorReason = "ProjectPermissionDenied"
+	// ErrorReasonTimeout indicates the request did not complete before its deadline
+	ErrorReasonTimeout ErrorReason = "Timeout"
+)
+
+// ErrorReasonHeader is the HTTP header which carries the reason of errors returned by the gRPC gateway
+const ErrorReasonHeader = "X-Argocd-Error-Reason"
+
+// NewError returns a gRPC status error with the given code and message, which carries the given reason
+func NewError(code codes.Code, reason ErrorReason, format string, args ...interface{}) error {
+	return withReason(status.New(code, fmt.Sprintf(format, args...)), reason)
+}
+
+// WrapError attaches the reason to err. The code of err is preserved if it is already a gRPC status error,
+// otherwise the given code is used.
+func WrapError(err error, code codes.Code, reason ErrorReason) error {
+	if err == nil {
+		return nil
+	}
+	s, ok := status.FromError(err)
+	if !ok {
+		s = status.New(code, err.Error())
+	}
+	return withReason(s, reason)
+}
+
+func withReason(s *status.Status, reason ErrorReason) error {
+	if GetErrorReason(s.Err()) != "" {
+		return s.Err()
+	}
+	detailed, err := s.WithDetails(&ErrorInfo{Reason: string(reason)})
+	if err != nil {
+		return s.Err()
+	}
+	return detailed.Err()
+}
+
+// GetErrorReason returns the reason attached to a gRPC status error, or an empty string if there is none
+func GetErrorReason(err error) ErrorReason {
+	s, ok := status.FromError(err)
+	if !ok || s == nil {
+		return ""
+	}
+	for _, detail := range s.Proto().GetDetails() {
+		var info ErrorInfo
+		if ptypes.UnmarshalAny(detail, &info) == nil {
+			return ErrorReason(info.Reason)
+		}
+	}
+	return ""
+}
+
+// HTTPError is a gRPC gateway error handler which exposes the reason of the error in a response header, before
+// writing the error the same way as the default handler
+func HTTPError(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	if reason := GetErrorReason(err); reason != "" {
+		w.Header().Set(ErrorReasonHeader, string(reason))
+		if reason == ErrorReasonRateLimited {
+			w = writeRateLimitedHeaders(ctx, w)
+		}
+	}
+	runtime.DefaultHTTPError(ctx, mux, marshaler, w, r, err)
+}
+
 func kubeErrToGRPC(err error) error {
 	/*
 		Unmapped source Kubernetes API errors as of 2018-04-16:
@@ -33,6 +116,8 @@ func kubeErrToGRPC(err error) error {
 	}
 
 	switch {
+	case err == context.DeadlineExceeded:
+		err = NewError(codes.DeadlineExceeded, ErrorReasonTimeout, "%v", err)
 	case apierr.IsNotFound(err):
 		err = rewrapError(err, codes.NotFound)
 	case apierr.IsAlreadyExists(err):
@@ -46,14 +131,15 @@ func kubeErrToGRPC(err error) error {
 	case apierr.IsBadRequest(err):
 		err = rewrapError(err, codes.FailedPrecondition)
 	case apierr.IsUnauthorized(err):
-		err = rewrapError(err, codes.Unauthenticated)
+		err = WrapError(err, codes.Unauthenticated, ErrorReasonAuthFailed)
 	case apierr.IsForbidden(err):
 		err = rewrapError(err, codes.PermissionDenied)
 	case apierr.IsTimeout(err):
-		err = rewrapError(err, codes.DeadlineExceeded)
+		err = WrapError(err, codes.DeadlineExceeded, ErrorReasonTimeout)
 	case apierr.IsInternalError(err):
 		err = rewrapError(err, codes.Internal)
-
+	case status.Code(err) == codes.DeadlineExceeded:
+		err = WrapError(err, codes.DeadlineExceeded, ErrorReasonTimeout)
 	}
 	return err
 }
@@ -0,0 +1,69 @@
+package grpc
+
+import (
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestNewError(t *testing.T) {
+	err := NewError(codes.InvalidArgument, ErrorReasonProjectPermissionDenied, "destination %s is not permitted", "in-cluster")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, "destination in-cluster is not permitted", status.Convert(err).Message())
+	assert.Equal(t, ErrorReasonProjectPermissionDenied, GetErrorReason(err))
+
+	// the reason survives a round trip through the wire format
+	assert.Equal(t, ErrorReasonProjectPermissionDenied, GetErrorReason(status.ErrorProto(status.Convert(err).Proto())))
+}
+
+func TestWrapError(t *testing.T) {
+	assert.Nil(t, WrapError(nil, codes.Unknown, ErrorReasonRenderFailed))
+
+	err := WrapError(errors.New("connection refused"), codes.Unknown, ErrorReasonRepositoryUnreachable)
+	assert.Equal(t, codes.Unknown, status.Code(err))
+	assert.Equal(t, ErrorReasonRepositoryUnreachable, GetErrorReason(err))
+
+	err = WrapError(status.Errorf(codes.FailedPrecondition, "invalid jsonnet"), codes.Unknown, ErrorReasonRenderFailed)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Equal(t, ErrorReasonRenderFailed, GetErrorReason(err))
+
+	// the original reason is kept
+	err = WrapError(err, codes.Unknown, ErrorReasonTimeout)
+	assert.Equal(t, ErrorReasonRenderFailed, GetErrorReason(err))
+}
+
+func TestGetErrorReason(t *testing.T) {
+	assert.Equal(t, ErrorReason(""), GetErrorReason(nil))
+	assert.Equal(t, ErrorReason(""), GetErrorReason(errors.New("plain")))
+	assert.Equal(t, ErrorReason(""), GetErrorReason(status.Errorf(codes.Internal, "no reason")))
+}
+
+func TestKubeErrToGRPC(t *testing.T) {
+	err := kubeErrToGRPC(context.DeadlineExceeded)
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	assert.Equal(t, ErrorReasonTimeout, GetErrorReason(err))
+
+	err = kubeErrToGRPC(apierr.NewUnauthorized("token expired"))
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	assert.Equal(t, ErrorReasonAuthFailed, GetErrorReason(err))
+
+	err = kubeErrToGRPC(apierr.NewNotFound(schema.GroupResource{Resource: "applications"}, "guestbook"))
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Equal(t, ErrorReason(""), GetErrorReason(err))
+}
+
+func TestHTTPError(t *testing.T) {
+	w := httptest.NewRecorder()
+	HTTPError(context.Background(), runtime.NewServeMux(), &runtime.JSONPb{}, w, httptest.NewRequest("GET", "/api/v1/applications", nil),
+		NewError(codes.Unauthenticated, ErrorReasonAuthFailed, "no session information"))
+	assert.Equal(t, 401, w.Code)
+	assert.Equal(t, string(ErrorReasonAuthFailed), w.Header().Get(ErrorReasonHeader))
+}
@@ -0,0 +1,189 @@
+package grpc
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"golang.org/x/net/context"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// ErrorReasonRateLimited indicates the caller exceeded its request rate or number of concurrent requests
+	ErrorReasonRateLimited ErrorReason = "RateLimited"
+	// retryAfterMetadataKey is the response header which tells rate limited callers how many seconds to wait
+	retryAfterMetadataKey = "retry-after"
+	// idleCallerExpiration is the time after which the state of callers without requests is dropped
+	idleCallerExpiration = 10 * time.Minute
+)
+
+// RateLimiterOptions configures the limits of a RateLimiter
+type RateLimiterOptions struct {
+	// RequestsPerSecond is the sustained rate of requests of each caller. No limit if zero
+	RequestsPerSecond float64
+	// Burst is the number of requests a caller may make at once, on top of the sustained rate
+	Burst int
+	// MaxConcurrent is the number of expensive requests each caller may have in flight. No limit if zero
+	MaxConcurrent int
+	// ExpensiveMethods are the full names of the methods which count towards MaxConcurrent, e.g.
+	// /application.ApplicationService/Sync
+	ExpensiveMethods []string
+}
+
+type caller struct {
+	limiter  *rate.Limiter
+	running  int
+	lastSeen time.Time
+}
+
+// RateLimiter limits the requests of each caller, so that runaway automation of a single user cannot starve the others
+type RateLimiter struct {
+	opts      RateLimiterOptions
+	expensive map[string]bool
+	// identity returns the caller of a request, e.g. the subject of its token
+	identity func(ctx context.Context) string
+
+	lock      sync.Mutex
+	callers   map[string]*caller
+	lastPrune time.Time
+}
+
+// NewRateLimiter returns a rate limiter which identifies the callers of requests using the given function
+func NewRateLimiter(opts RateLimiterOptions, identity func(ctx context.Context) string) *RateLimiter {
+	expensive := make(map[string]bool)
+	for _, method := range opts.ExpensiveMethods {
+		expensive[method] = true
+	}
+	if opts.Burst < 1 {
+		opts.Burst = 1
+	}
+	return &RateLimiter{opts: opts, expensive: expensive, identity: identity, callers: make(map[string]*caller)}
+}
+
+// pruneLocked drops the state of callers which were idle for a while
+func (l *RateLimiter) pruneLocked(now time.Time) {
+	if now.Sub(l.lastPrune) < time.Minute {
+		return
+	}
+	l.lastPrune = now
+	for id, c := range l.callers {
+		if c.running == 0 && now.Sub(c.lastSeen) > idleCallerExpiration {
+			delete(l.callers, id)
+		}
+	}
+}
+
+// acquire admits a request of the caller, or returns the time after which it should be retried and whether the
+// concurrent requests rather than the request rate were exceeded. The returned function must be called once an
+// admitted request finished.
+func (l *RateLimiter) acquire(id string, expensive bool) (func(), time.Duration, bool) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	now := time.Now()
+	l.pruneLocked(now)
+	c, ok := l.callers[id]
+	if !ok {
+		limit := rate.Inf
+		if l.opts.RequestsPerSecond > 0 {
+			limit = rate.Limit(l.opts.RequestsPerSecond)
+		}
+		c = &caller{limiter: rate.NewLimiter(limit, l.opts.Burst)}
+		l.callers[id] = c
+	}
+	c.lastSeen = now
+	if expensive && l.opts.MaxConcurrent > 0 && c.running >= l.opts.MaxConcurrent {
+		return nil, time.Second, true
+	}
+	reservation := c.limiter.ReserveN(now, 1)
+	if !reservation.OK() {
+		return nil, time.Second, false
+	}
+	if delay := reservation.DelayFrom(now); delay > 0 {
+		reservation.CancelAt(now)
+		return nil, delay, false
+	}
+	if !expensive {
+		return func() {}, 0, false
+	}
+	c.running++
+	return func() {
+		l.lock.Lock()
+		defer l.lock.Unlock()
+		c.running--
+	}, 0, false
+}
+
+// admit returns a release function if the request may proceed, or a ResourceExhausted error
+func (l *RateLimiter) admit(ctx context.Context, fullMethod string, expensive bool) (func(), error) {
+	id := l.identity(ctx)
+	release, retryAfter, concurrent := l.acquire(id, expensive)
+	if release != nil {
+		return release, nil
+	}
+	if concurrent {
+		return nil, NewRateLimitedError(ctx, retryAfter, "too many requests of %s: at most %d concurrent requests like %s are allowed", id, l.opts.MaxConcurrent, fullMethod)
+	}
+	return nil, NewRateLimitedError(ctx, retryAfter, "too many requests of %s: at most %g requests per second are allowed", id, l.opts.RequestsPerSecond)
+}
+
+// NewRateLimitedError returns a ResourceExhausted error which tells the caller how long to wait before retrying, both
+// in its message and in the Retry-After header of the response
+func NewRateLimitedError(ctx context.Context, retryAfter time.Duration, format string, args ...interface{}) error {
+	seconds := int64(math.Ceil(retryAfter.Seconds()))
+	_ = grpc.SetHeader(ctx, metadata.Pairs(retryAfterMetadataKey, fmt.Sprintf("%d", seconds)))
+	return NewError(codes.ResourceExhausted, ErrorReasonRateLimited, "%s, retry after %ds", fmt.Sprintf(format, args...), seconds)
+}
+
+// UnaryServerInterceptor returns an interceptor which rejects the requests of callers which exceeded their limits.
+// It must come after the interceptor which authenticates the caller.
+func (l *RateLimiter) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		release, err := l.admit(ctx, info.FullMethod, l.expensive[info.FullMethod])
+		if err != nil {
+			return nil, err
+		}
+		defer release()
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor returns an interceptor which rejects the streams of callers which exceeded their request
+// rate. Streams, e.g. watches, are long lived and never count towards the concurrent requests.
+func (l *RateLimiter) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		release, err := l.admit(ss.Context(), info.FullMethod, false)
+		if err != nil {
+			return err
+		}
+		defer release()
+		return handler(srv, ss)
+	}
+}
+
+// writeRateLimitedHeaders translates the status of rate limited requests to 429 Too Many Requests with a Retry-After
+// header, since the gateway would answer 403 Forbidden
+func writeRateLimitedHeaders(ctx context.Context, w http.ResponseWriter) http.ResponseWriter {
+	if md, ok := runtime.ServerMetadataFromContext(ctx); ok {
+		if values := md.HeaderMD.Get(retryAfterMetadataKey); len(values) > 0 {
+			w.Header().Set("Retry-After", values[0])
+		}
+	}
+	return &statusOverrideWriter{ResponseWriter: w, status: http.StatusTooManyRequests}
+}
+
+// statusOverrideWriter writes a fixed status code instead of the one of the wrapped handler
+type statusOverrideWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+func (w *statusOverrideWriter) WriteHeader(int) {
+	w.ResponseWriter.WriteHeader(w.status)
+}
@@ -0,0 +1,104 @@
+package grpc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type callerKey struct{}
+
+func callerIdentity(ctx context.Context) string {
+	id, _ := ctx.Value(callerKey{}).(string)
+	return id
+}
+
+func TestRateLimiter_RequestsPerSecond(t *testing.T) {
+	limiter := NewRateLimiter(RateLimiterOptions{RequestsPerSecond: 0.1, Burst: 2}, callerIdentity)
+	interceptor := limiter.UnaryServerInterceptor()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+	info := &grpc.UnaryServerInfo{FullMethod: "/application.ApplicationService/Get"}
+	alice := context.WithValue(context.Background(), callerKey{}, "alice")
+	bob := context.WithValue(context.Background(), callerKey{}, "bob")
+
+	for i := 0; i < 2; i++ {
+		_, err := interceptor(alice, nil, info, handler)
+		assert.NoError(t, err)
+	}
+	_, err := interceptor(alice, nil, info, handler)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Equal(t, ErrorReasonRateLimited, GetErrorReason(err))
+	assert.Contains(t, err.Error(), "at most 0.1 requests per second")
+
+	// the limits are per caller
+	_, err = interceptor(bob, nil, info, handler)
+	assert.NoError(t, err)
+}
+
+func TestRateLimiter_MaxConcurrent(t *testing.T) {
+	limiter := NewRateLimiter(RateLimiterOptions{MaxConcurrent: 1, ExpensiveMethods: []string{"/application.ApplicationService/Sync"}}, callerIdentity)
+	interceptor := limiter.UnaryServerInterceptor()
+	alice := context.WithValue(context.Background(), callerKey{}, "alice")
+	sync := &grpc.UnaryServerInfo{FullMethod: "/application.ApplicationService/Sync"}
+	get := &grpc.UnaryServerInfo{FullMethod: "/application.ApplicationService/Get"}
+
+	started := make(chan struct{})
+	finish := make(chan struct{})
+	done := make(chan error)
+	go func() {
+		_, err := interceptor(alice, nil, sync, func(ctx context.Context, req interface{}) (interface{}, error) {
+			close(started)
+			<-finish
+			return nil, nil
+		})
+		done <- err
+	}()
+	<-started
+
+	okHandler := func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil }
+	_, err := interceptor(alice, nil, sync, okHandler)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Contains(t, err.Error(), "at most 1 concurrent requests")
+	// cheap requests are not affected
+	_, err = interceptor(alice, nil, get, okHandler)
+	assert.NoError(t, err)
+
+	close(finish)
+	assert.NoError(t, <-done)
+	_, err = interceptor(alice, nil, sync, okHandler)
+	assert.NoError(t, err)
+}
+
+// TestRateLimiter_ExpensiveRequestsPerSecond verifies expensive requests which exceed the request rate, but not the
+// concurrent requests, are rejected because of the request rate
+func TestRateLimiter_ExpensiveRequestsPerSecond(t *testing.T) {
+	limiter := NewRateLimiter(RateLimiterOptions{RequestsPerSecond: 0.1, Burst: 1, MaxConcurrent: 5, ExpensiveMethods: []string{"/application.ApplicationService/Sync"}}, callerIdentity)
+	interceptor := limiter.UnaryServerInterceptor()
+	alice := context.WithValue(context.Background(), callerKey{}, "alice")
+	sync := &grpc.UnaryServerInfo{FullMethod: "/application.ApplicationService/Sync"}
+	okHandler := func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil }
+
+	_, err := interceptor(alice, nil, sync, okHandler)
+	assert.NoError(t, err)
+	_, err = interceptor(alice, nil, sync, okHandler)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Contains(t, err.Error(), "at most 0.1 requests per second")
+	assert.NotContains(t, err.Error(), "concurrent")
+}
+
+func TestHTTPError_RateLimited(t *testing.T) {
+	ctx := runtime.NewServerMetadataContext(context.Background(), runtime.ServerMetadata{HeaderMD: metadata.Pairs(retryAfterMetadataKey, "3")})
+	w := httptest.NewRecorder()
+	HTTPError(ctx, runtime.NewServeMux(), &runtime.JSONPb{}, w, httptest.NewRequest("GET", "/api/v1/applications", nil),
+		NewError(codes.ResourceExhausted, ErrorReasonRateLimited, "too many requests"))
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "3", w.Header().Get("Retry-After"))
+}
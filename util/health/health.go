@@ -1,15 +1,21 @@
 package health
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/apps/v1"
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
 	batchv1 "k8s.io/api/batch/v1"
 	coreV1 "k8s.io/api/core/v1"
 	extv1beta1 "k8s.io/api/extensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	apiregistrationv1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
 	apiregistrationv1beta1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1beta1"
 	podutil "k8s.io/kubernetes/pkg/api/v1/pod"
@@ -23,10 +29,24 @@ import (
 	"github.com/argoproj/argo-cd/util/resource/ignore"
 )
 
-// SetApplicationHealth updates the health statuses of all resources performed in the comparison
-func SetApplicationHealth(resStatuses []appv1.ResourceStatus, liveObjs []*unstructured.Unstructured, resourceOverrides map[string]appv1.ResourceOverride, filter func(obj *unstructured.Unstructured) bool) (*appv1.HealthStatus, error) {
+// The assessments of completed resources, i.e. succeeded or failed jobs and workflows, which might be configured in the
+// health.completed field of the resource overrides
+const (
+	// CompletedHealthy reports succeeded resources healthy, which is the default
+	CompletedHealthy = "Healthy"
+	// CompletedSuspended reports succeeded resources suspended
+	CompletedSuspended = "Suspended"
+	// CompletedIgnore does not allow completed resources to affect the health of the application
+	CompletedIgnore = "Ignore"
+)
+
+// SetApplicationHealth updates the health statuses of all resources performed in the comparison. Resources which stay
+// progressing for longer than the progressing timeout, or than the timeout of their kind in the resource overrides if it
+// is zero, are reported degraded. The resource statuses are expected to carry the previous ProgressingSince times.
+func SetApplicationHealth(resStatuses []appv1.ResourceStatus, liveObjs []*unstructured.Unstructured, resourceOverrides map[string]appv1.ResourceOverride, progressingTimeout time.Duration, filter func(obj *unstructured.Unstructured) bool) (*appv1.HealthStatus, error) {
 	var savedErr error
 	appHealth := appv1.HealthStatus{Status: appv1.HealthStatusHealthy}
+	now := metav1.Now()
 	for i, liveObj := range liveObjs {
 		var resHealth *appv1.HealthStatus
 		var err error
@@ -41,8 +61,20 @@ func SetApplicationHealth(resStatuses []appv1.ResourceStatus, liveObjs []*unstru
 			}
 		}
 		if resHealth != nil {
+			if liveObj != nil {
+				timeout := progressingTimeout
+				if timeout <= 0 {
+					timeout, err = getProgressingTimeout(liveObj, resourceOverrides)
+					if err != nil && savedErr == nil {
+						savedErr = err
+					}
+				}
+				resHealth = applyProgressingTimeout(&resStatuses[i], resHealth, timeout, now)
+			} else {
+				resStatuses[i].ProgressingSince = nil
+			}
 			resStatuses[i].Health = resHealth
-			ignore := ignoreLiveObjectHealth(liveObj, *resHealth)
+			ignore := ignoreLiveObjectHealth(liveObj, *resHealth, resourceOverrides)
 			if !ignore && IsWorse(appHealth.Status, resHealth.Status) {
 				appHealth.Status = resHealth.Status
 			}
@@ -51,12 +83,61 @@ func SetApplicationHealth(resStatuses []appv1.ResourceStatus, liveObjs []*unstru
 	return &appHealth, savedErr
 }
 
+// getResourceOverride returns the key of the kind of the object in the resource overrides, and its override
+func getResourceOverride(obj *unstructured.Unstructured, resourceOverrides map[string]appv1.ResourceOverride) (string, appv1.ResourceOverride) {
+	gvk := obj.GroupVersionKind()
+	key := gvk.Kind
+	if gvk.Group != "" {
+		key = fmt.Sprintf("%s/%s", gvk.Group, gvk.Kind)
+	}
+	return key, resourceOverrides[key]
+}
+
+// getProgressingTimeout returns the progressing timeout of the kind of the object in the resource overrides, or zero if
+// there is none
+func getProgressingTimeout(obj *unstructured.Unstructured, resourceOverrides map[string]appv1.ResourceOverride) (time.Duration, error) {
+	key, override := getResourceOverride(obj, resourceOverrides)
+	if override.HealthProgressingTimeout == "" {
+		return 0, nil
+	}
+	timeout, err := time.ParseDuration(override.HealthProgressingTimeout)
+	if err != nil {
+		return 0, fmt.Errorf("invalid health.progressingTimeout of %s: %v", key, err)
+	}
+	return timeout, nil
+}
+
+// applyProgressingTimeout keeps the time since which the resource is progressing, and returns a degraded health if it
+// stays progressing for longer than the timeout. A timeout of zero or less lets it progress indefinitely.
+func applyProgressingTimeout(resStatus *appv1.ResourceStatus, resHealth *appv1.HealthStatus, timeout time.Duration, now metav1.Time) *appv1.HealthStatus {
+	if resHealth.Status != appv1.HealthStatusProgressing {
+		resStatus.ProgressingSince = nil
+		return resHealth
+	}
+	if resStatus.ProgressingSince == nil {
+		resStatus.ProgressingSince = &now
+	}
+	if timeout <= 0 || now.Sub(resStatus.ProgressingSince.Time) <= timeout {
+		return resHealth
+	}
+	message := fmt.Sprintf("Progressing for longer than %v", timeout)
+	if resHealth.Message != "" {
+		message = fmt.Sprintf("%s: %s", message, resHealth.Message)
+	}
+	return &appv1.HealthStatus{Status: appv1.HealthStatusDegraded, Message: message}
+}
+
 // ignoreLiveObjectHealth determines if we should not allow the live object to affect the overall
-// health of the application (e.g. hooks, missing child applications)
-func ignoreLiveObjectHealth(liveObj *unstructured.Unstructured, resHealth appv1.HealthStatus) bool {
+// health of the application (e.g. hooks, missing child applications, completed jobs configured to be ignored)
+func ignoreLiveObjectHealth(liveObj *unstructured.Unstructured, resHealth appv1.HealthStatus, resourceOverrides map[string]appv1.ResourceOverride) bool {
 	if liveObj != nil {
-		if hookutil.IsHook(liveObj) {
-			// Don't allow resource hooks to affect health status
+		_, override := getResourceOverride(liveObj, resourceOverrides)
+		if hookutil.IsHook(liveObj) && !override.HealthIncludeHooks {
+			// Don't allow resource hooks to affect health status, unless configured for their kind
+			return true
+		}
+		completed := resHealth.Status == appv1.HealthStatusHealthy || resHealth.Status == appv1.HealthStatusDegraded
+		if override.HealthCompleted == CompletedIgnore && isCompletable(liveObj) && completed {
 			return true
 		}
 		if ignore.Ignore(liveObj) {
@@ -97,6 +178,16 @@ func GetResourceHealth(obj *unstructured.Unstructured, resourceOverrides map[str
 
 	gvk := obj.GroupVersionKind()
 	switch gvk.Group {
+	case "networking.k8s.io":
+		switch gvk.Kind {
+		case kube.IngressKind:
+			health, err = getIngressHealth(obj)
+		}
+	case "autoscaling":
+		switch gvk.Kind {
+		case kube.HorizontalPodAutoscalerKind:
+			health, err = getHPAHealth(obj)
+		}
 	case "apps", "extensions":
 		switch gvk.Kind {
 		case kube.DeploymentKind:
@@ -114,6 +205,8 @@ func GetResourceHealth(obj *unstructured.Unstructured, resourceOverrides map[str
 		switch gvk.Kind {
 		case "Application":
 			health, err = getApplicationHealth(obj)
+		case "Workflow":
+			health, err = getWorkflowHealth(obj)
 		}
 	case "apiregistration.k8s.io":
 		switch gvk.Kind {
@@ -135,6 +228,9 @@ func GetResourceHealth(obj *unstructured.Unstructured, resourceOverrides map[str
 			health, err = getJobHealth(obj)
 		}
 	}
+	if err == nil && health != nil && isCompletable(obj) {
+		health, err = getCompletedHealth(obj, health, resourceOverrides)
+	}
 	if err != nil {
 		health = &appv1.HealthStatus{
 			Status:  appv1.HealthStatusUnknown,
@@ -144,6 +240,29 @@ func GetResourceHealth(obj *unstructured.Unstructured, resourceOverrides map[str
 	return health, err
 }
 
+// isCompletable returns whether the object runs to completion, in which case it is healthy once it succeeded and degraded
+// once it failed
+func isCompletable(obj *unstructured.Unstructured) bool {
+	gvk := obj.GroupVersionKind()
+	return (gvk.Group == "batch" && gvk.Kind == kube.JobKind) || (gvk.Group == "argoproj.io" && gvk.Kind == "Workflow")
+}
+
+// getCompletedHealth returns the health of a completed resource as configured in the health.completed field of the
+// resource overrides
+func getCompletedHealth(obj *unstructured.Unstructured, health *appv1.HealthStatus, resourceOverrides map[string]appv1.ResourceOverride) (*appv1.HealthStatus, error) {
+	key, override := getResourceOverride(obj, resourceOverrides)
+	switch override.HealthCompleted {
+	case "", CompletedHealthy, CompletedIgnore:
+		return health, nil
+	case CompletedSuspended:
+		if health.Status == appv1.HealthStatusHealthy {
+			return &appv1.HealthStatus{Status: appv1.HealthStatusSuspended, Message: health.Message}, nil
+		}
+		return health, nil
+	}
+	return nil, fmt.Errorf("invalid health.completed of %s: '%s', expected one of %s, %s, %s", key, override.HealthCompleted, CompletedHealthy, CompletedSuspended, CompletedIgnore)
+}
+
 // healthOrder is a list of health codes in order of most healthy to least healthy
 var healthOrder = []appv1.HealthStatusCode{
 	appv1.HealthStatusHealthy,
@@ -206,8 +325,9 @@ func getPVCHealth(obj *unstructured.Unstructured) (*appv1.HealthStatus, error) {
 }
 
 func getIngressHealth(obj *unstructured.Unstructured) (*appv1.HealthStatus, error) {
+	// the status of extensions and networking.k8s.io ingresses is the same
 	ingress := &extv1beta1.Ingress{}
-	err := scheme.Scheme.Convert(obj, ingress, nil)
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, ingress)
 	if err != nil {
 		return nil, fmt.Errorf("failed to convert %T to %T: %v", obj, ingress, err)
 	}
@@ -220,6 +340,45 @@ func getIngressHealth(obj *unstructured.Unstructured) (*appv1.HealthStatus, erro
 	return &health, nil
 }
 
+// hpaConditionsAnnotation holds the conditions of autoscaling/v1 horizontal pod autoscalers, which have no conditions
+// in their status
+const hpaConditionsAnnotation = "autoscaling.alpha.kubernetes.io/conditions"
+
+func getHPAHealth(obj *unstructured.Unstructured) (*appv1.HealthStatus, error) {
+	// the status of autoscaling/v2beta1 and v2beta2 horizontal pod autoscalers is the same, and fields of autoscaling/v1
+	// ones which are unknown to v2beta2 are ignored
+	hpa := &autoscalingv2beta2.HorizontalPodAutoscaler{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, hpa)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert %T to %T: %v", obj, hpa, err)
+	}
+	conditions := hpa.Status.Conditions
+	if value, ok := obj.GetAnnotations()[hpaConditionsAnnotation]; ok && len(conditions) == 0 {
+		if err := json.Unmarshal([]byte(value), &conditions); err != nil {
+			return nil, fmt.Errorf("failed to parse %s annotation: %v", hpaConditionsAnnotation, err)
+		}
+	}
+	if len(conditions) == 0 {
+		return &appv1.HealthStatus{Status: appv1.HealthStatusProgressing, Message: "Waiting to be processed"}, nil
+	}
+	health := &appv1.HealthStatus{Status: appv1.HealthStatusHealthy}
+	for _, c := range conditions {
+		switch c.Type {
+		case autoscalingv2beta2.AbleToScale:
+			if c.Status == coreV1.ConditionFalse {
+				return &appv1.HealthStatus{Status: appv1.HealthStatusDegraded, Message: fmt.Sprintf("%s: %s", c.Reason, c.Message)}, nil
+			}
+		case autoscalingv2beta2.ScalingActive:
+			// scaling is disabled if the target is scaled to zero replicas, which is not a failure
+			if c.Status == coreV1.ConditionFalse && c.Reason != "ScalingDisabled" {
+				return &appv1.HealthStatus{Status: appv1.HealthStatusDegraded, Message: fmt.Sprintf("%s: %s", c.Reason, c.Message)}, nil
+			}
+			health.Message = fmt.Sprintf("%s: %s", c.Reason, c.Message)
+		}
+	}
+	return health, nil
+}
+
 func getServiceHealth(obj *unstructured.Unstructured) (*appv1.HealthStatus, error) {
 	service := &coreV1.Service{}
 	err := scheme.Scheme.Convert(obj, service, nil)
@@ -301,6 +460,22 @@ func getApplicationHealth(obj *unstructured.Unstructured) (*appv1.HealthStatus,
 	return &application.Status.Health, nil
 }
 
+func getWorkflowHealth(obj *unstructured.Unstructured) (*appv1.HealthStatus, error) {
+	var wf wfv1.Workflow
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &wf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert %T to %T: %v", obj, &wf, err)
+	}
+	switch wf.Status.Phase {
+	case "", wfv1.NodePending, wfv1.NodeRunning:
+		return &appv1.HealthStatus{Status: appv1.HealthStatusProgressing, Message: wf.Status.Message}, nil
+	case wfv1.NodeSucceeded:
+		return &appv1.HealthStatus{Status: appv1.HealthStatusHealthy, Message: wf.Status.Message}, nil
+	default:
+		return &appv1.HealthStatus{Status: appv1.HealthStatusDegraded, Message: wf.Status.Message}, nil
+	}
+}
+
 func getDaemonSetHealth(obj *unstructured.Unstructured) (*appv1.HealthStatus, error) {
 	daemon := &appsv1.DaemonSet{}
 	err := scheme.Scheme.Convert(obj, daemon, nil)
@@ -3,6 +3,7 @@ package health
 import (
 	"io/ioutil"
 	"testing"
+	"time"
 
 	"github.com/ghodss/yaml"
 	"github.com/stretchr/testify/assert"
@@ -58,6 +59,15 @@ func TestIngressHealth(t *testing.T) {
 	assertAppHealth(t, "./testdata/ingress.yaml", appv1.HealthStatusHealthy)
 	assertAppHealth(t, "./testdata/ingress-unassigned.yaml", appv1.HealthStatusProgressing)
 	assertAppHealth(t, "./testdata/ingress-nonemptylist.yaml", appv1.HealthStatusHealthy)
+	assertAppHealth(t, "./testdata/ingress-networking.yaml", appv1.HealthStatusHealthy)
+}
+
+func TestHPAHealth(t *testing.T) {
+	assertAppHealth(t, "./testdata/hpa-v1-healthy.yaml", appv1.HealthStatusHealthy)
+	assertAppHealth(t, "./testdata/hpa-v2beta2-progressing.yaml", appv1.HealthStatusProgressing)
+	health := getHealthStatus("./testdata/hpa-v2beta2-degraded.yaml", t)
+	assert.Equal(t, appv1.HealthStatusDegraded, health.Status)
+	assert.Equal(t, "FailedGetResourceMetric: the HPA was unable to compute the replica count: missing request for cpu", health.Message)
 }
 
 func TestCRD(t *testing.T) {
@@ -70,6 +80,11 @@ func TestJob(t *testing.T) {
 	assertAppHealth(t, "./testdata/job-succeeded.yaml", appv1.HealthStatusHealthy)
 }
 
+func TestWorkflow(t *testing.T) {
+	assertAppHealth(t, "./testdata/workflow-failed.yaml", appv1.HealthStatusDegraded)
+	assertAppHealth(t, "./testdata/workflow-succeeded.yaml", appv1.HealthStatusHealthy)
+}
+
 func TestPod(t *testing.T) {
 	assertAppHealth(t, "./testdata/pod-pending.yaml", appv1.HealthStatusProgressing)
 	assertAppHealth(t, "./testdata/pod-running-not-ready.yaml", appv1.HealthStatusProgressing)
@@ -122,7 +137,7 @@ func TestAppOfAppsHealth(t *testing.T) {
 	{
 		missingAndHealthyStatuses := []appv1.ResourceStatus{missingStatus, healthyStatus}
 		missingAndHealthyLiveObjects := []*unstructured.Unstructured{missingApp, healthyApp}
-		healthStatus, err := SetApplicationHealth(missingAndHealthyStatuses, missingAndHealthyLiveObjects, nil, noFilter)
+		healthStatus, err := SetApplicationHealth(missingAndHealthyStatuses, missingAndHealthyLiveObjects, nil, 0, noFilter)
 		assert.NoError(t, err)
 		assert.Equal(t, appv1.HealthStatusHealthy, healthStatus.Status)
 	}
@@ -131,7 +146,7 @@ func TestAppOfAppsHealth(t *testing.T) {
 	{
 		degradedAndHealthyStatuses := []appv1.ResourceStatus{degradedStatus, healthyStatus}
 		degradedAndHealthyLiveObjects := []*unstructured.Unstructured{degradedApp, healthyApp}
-		healthStatus, err := SetApplicationHealth(degradedAndHealthyStatuses, degradedAndHealthyLiveObjects, nil, noFilter)
+		healthStatus, err := SetApplicationHealth(degradedAndHealthyStatuses, degradedAndHealthyLiveObjects, nil, 0, noFilter)
 		assert.NoError(t, err)
 		assert.Equal(t, appv1.HealthStatusDegraded, healthStatus.Status)
 	}
@@ -169,17 +184,94 @@ func TestSetApplicationHealth(t *testing.T) {
 		&runningPod,
 		&failedJob,
 	}
-	healthStatus, err := SetApplicationHealth(resources, liveObjs, nil, noFilter)
+	healthStatus, err := SetApplicationHealth(resources, liveObjs, nil, 0, noFilter)
 	assert.NoError(t, err)
 	assert.Equal(t, appv1.HealthStatusDegraded, healthStatus.Status)
 
 	// now mark the job as a hook and retry. it should ignore the hook and consider the app healthy
 	failedJob.SetAnnotations(map[string]string{common.AnnotationKeyHook: "PreSync"})
-	healthStatus, err = SetApplicationHealth(resources, liveObjs, nil, noFilter)
+	healthStatus, err = SetApplicationHealth(resources, liveObjs, nil, 0, noFilter)
 	assert.NoError(t, err)
 	assert.Equal(t, appv1.HealthStatusHealthy, healthStatus.Status)
 }
 
+func TestSetApplicationHealth_ProgressingTimeout(t *testing.T) {
+	yamlBytes, err := ioutil.ReadFile("./testdata/deployment-progressing.yaml")
+	assert.Nil(t, err)
+	var deployment unstructured.Unstructured
+	err = yaml.Unmarshal(yamlBytes, &deployment)
+	assert.Nil(t, err)
+	liveObjs := []*unstructured.Unstructured{&deployment}
+	overrides := map[string]appv1.ResourceOverride{"extensions/Deployment": {HealthProgressingTimeout: "10m"}}
+
+	// the time since which the resource is progressing is recorded
+	resources := []appv1.ResourceStatus{{Group: "extensions", Version: "v1beta1", Kind: "Deployment", Name: deployment.GetName()}}
+	healthStatus, err := SetApplicationHealth(resources, liveObjs, overrides, 0, noFilter)
+	assert.NoError(t, err)
+	assert.Equal(t, appv1.HealthStatusProgressing, healthStatus.Status)
+	assert.NotNil(t, resources[0].ProgressingSince)
+
+	// the resource is degraded once it stays progressing for longer than the timeout of its kind
+	since := metav1.NewTime(time.Now().Add(-time.Hour))
+	resources[0].ProgressingSince = &since
+	healthStatus, err = SetApplicationHealth(resources, liveObjs, overrides, 0, noFilter)
+	assert.NoError(t, err)
+	assert.Equal(t, appv1.HealthStatusDegraded, healthStatus.Status)
+	assert.Contains(t, resources[0].Health.Message, "Progressing for longer than 10m0s")
+	assert.Equal(t, since, *resources[0].ProgressingSince)
+
+	// the timeout of the application overrides the timeout of the kind
+	healthStatus, err = SetApplicationHealth(resources, liveObjs, overrides, 2*time.Hour, noFilter)
+	assert.NoError(t, err)
+	assert.Equal(t, appv1.HealthStatusProgressing, healthStatus.Status)
+
+	overrides["extensions/Deployment"] = appv1.ResourceOverride{HealthProgressingTimeout: "invalid"}
+	_, err = SetApplicationHealth(resources, liveObjs, overrides, 0, noFilter)
+	assert.Error(t, err)
+}
+
+func TestSetApplicationHealth_Completed(t *testing.T) {
+	readObj := func(path string) *unstructured.Unstructured {
+		yamlBytes, err := ioutil.ReadFile(path)
+		assert.Nil(t, err)
+		var obj unstructured.Unstructured
+		err = yaml.Unmarshal(yamlBytes, &obj)
+		assert.Nil(t, err)
+		return &obj
+	}
+	succeededWorkflow := readObj("./testdata/workflow-succeeded.yaml")
+	failedJob := readObj("./testdata/job-failed.yaml")
+	resources := []appv1.ResourceStatus{{Group: "argoproj.io", Kind: "Workflow"}, {Group: "batch", Kind: "Job"}}
+	liveObjs := []*unstructured.Unstructured{succeededWorkflow, failedJob}
+
+	// succeeded resources might be reported suspended
+	overrides := map[string]appv1.ResourceOverride{"argoproj.io/Workflow": {HealthCompleted: CompletedSuspended}}
+	health, err := GetResourceHealth(succeededWorkflow, overrides)
+	assert.NoError(t, err)
+	assert.Equal(t, appv1.HealthStatusSuspended, health.Status)
+
+	// completed resources might not affect the health of the application
+	overrides = map[string]appv1.ResourceOverride{"batch/Job": {HealthCompleted: CompletedIgnore}}
+	healthStatus, err := SetApplicationHealth(resources, liveObjs, overrides, 0, noFilter)
+	assert.NoError(t, err)
+	assert.Equal(t, appv1.HealthStatusHealthy, healthStatus.Status)
+	assert.Equal(t, appv1.HealthStatusDegraded, resources[1].Health.Status)
+
+	// hooks might affect the health of the application
+	failedJob.SetAnnotations(map[string]string{common.AnnotationKeyHook: "PreSync"})
+	healthStatus, err = SetApplicationHealth(resources, liveObjs, nil, 0, noFilter)
+	assert.NoError(t, err)
+	assert.Equal(t, appv1.HealthStatusHealthy, healthStatus.Status)
+	overrides = map[string]appv1.ResourceOverride{"batch/Job": {HealthIncludeHooks: true}}
+	healthStatus, err = SetApplicationHealth(resources, liveObjs, overrides, 0, noFilter)
+	assert.NoError(t, err)
+	assert.Equal(t, appv1.HealthStatusDegraded, healthStatus.Status)
+
+	overrides = map[string]appv1.ResourceOverride{"batch/Job": {HealthCompleted: "Deleted"}}
+	_, err = SetApplicationHealth(resources, liveObjs, overrides, 0, noFilter)
+	assert.Error(t, err)
+}
+
 func TestAPIService(t *testing.T) {
 	assertAppHealth(t, "./testdata/apiservice-v1-true.yaml", appv1.HealthStatusHealthy)
 	assertAppHealth(t, "./testdata/apiservice-v1-false.yaml", appv1.HealthStatusProgressing)
@@ -6,6 +6,7 @@ import (
 	"os"
 	"os/exec"
 	"regexp"
+	"strings"
 
 	argoexec "github.com/argoproj/pkg/exec"
 )
@@ -137,8 +138,10 @@ type templateOpts struct {
 	name        string
 	namespace   string
 	kubeVersion string
+	apiVersions []string
 	set         map[string]string
 	setString   map[string]string
+	setFile     map[string]string
 	values      []string
 }
 
@@ -151,12 +154,18 @@ func (c *Cmd) template(chart string, opts templateOpts) (string, error) {
 	if opts.kubeVersion != "" {
 		args = append(args, "--kube-version", opts.kubeVersion)
 	}
+	for _, apiVersion := range opts.apiVersions {
+		args = append(args, "--api-versions", apiVersion)
+	}
 	for key, val := range opts.set {
 		args = append(args, "--set", key+"="+val)
 	}
 	for key, val := range opts.setString {
 		args = append(args, "--set-string", key+"="+val)
 	}
+	for key, val := range opts.setFile {
+		args = append(args, "--set-file", key+"="+val)
+	}
 	for _, val := range opts.values {
 		args = append(args, "--values", val)
 	}
@@ -167,3 +176,18 @@ func (c *Cmd) template(chart string, opts templateOpts) (string, error) {
 func (c *Cmd) Close() {
 	_ = os.RemoveAll(c.helmHome)
 }
+
+// Version returns the version of the helm client, e.g. v2.14.3+g0e7f3b6
+func Version() (string, error) {
+	out, err := argoexec.RunCommandExt(exec.Command("helm", "version", "--client", "--short"), argoexec.CmdOpts{})
+	if err != nil {
+		return "", fmt.Errorf("unable to determine helm version: %v", err)
+	}
+	return parseVersion(out), nil
+}
+
+// parseVersion returns the version of the output of `helm version --client --short`, which is prefixed by "Client: "
+// in Helm 2 but not in Helm 3
+func parseVersion(out string) string {
+	return strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(out), "Client:"))
+}
@@ -12,6 +12,11 @@ func Test_cmd_redactor(t *testing.T) {
 	assert.Equal(t, "--password ******", redactor("--password bar"))
 }
 
+func Test_parseVersion(t *testing.T) {
+	assert.Equal(t, "v2.14.3+g0e7f3b6", parseVersion("Client: v2.14.3+g0e7f3b6\n"))
+	assert.Equal(t, "v3.0.0+ge29ce2a", parseVersion("v3.0.0+ge29ce2a\n"))
+}
+
 func TestCmd_template_kubeVersion(t *testing.T) {
 	cmd, err := NewCmd(".")
 	assert.NoError(t, err)
@@ -21,3 +26,13 @@ func TestCmd_template_kubeVersion(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotEmpty(t, s)
 }
+
+func TestCmd_template_apiVersions(t *testing.T) {
+	cmd, err := NewCmd(".")
+	assert.NoError(t, err)
+	s, err := cmd.template("testdata/redis", templateOpts{
+		apiVersions: []string{"v1", "apps/v1"},
+	})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, s)
+}
@@ -14,15 +14,22 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	apppath "github.com/argoproj/argo-cd/util/app/path"
 	"github.com/argoproj/argo-cd/util/config"
 	"github.com/argoproj/argo-cd/util/kube"
 	"github.com/argoproj/argo-cd/util/text"
 )
 
+const crdsDir = "crds"
+
+var crdFile = regexp.MustCompile(`\.(yaml|yml|json)$`)
+
 // Helm provides wrapper functionality around the `helm` command.
 type Helm interface {
 	// Template returns a list of unstructured objects from a `helm template` command
-	Template(appName, namespace, kubeVersion string, opts *argoappv1.ApplicationSourceHelm) ([]*unstructured.Unstructured, error)
+	Template(appName, namespace, kubeVersion string, apiVersions []string, opts *argoappv1.ApplicationSourceHelm) ([]*unstructured.Unstructured, error)
+	// GetCRDs returns the custom resource definitions in the crds/ directory of the chart
+	GetCRDs() ([]*unstructured.Unstructured, error)
 	// GetParameters returns a list of chart parameters taking into account values in provided YAML files.
 	GetParameters(valuesFiles []string) ([]*argoappv1.HelmParameter, error)
 	// DependencyBuild runs `helm dependency build` to download a chart's dependencies
@@ -39,12 +46,60 @@ func NewHelmApp(workDir string, repos argoappv1.Repositories) (Helm, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &helm{repos: &repos, cmd: *cmd}, nil
+	return &helm{repos: repos, cmd: *cmd}, nil
 }
 
 type helm struct {
 	cmd   Cmd
-	repos *argoappv1.Repositories
+	repos argoappv1.Repositories
+	// reposAdded is true once the Helm repositories were added
+	reposAdded bool
+}
+
+// chartMetadata holds the fields of Chart.yaml and of the dependencies in requirements.lock which identify a chart
+type chartMetadata struct {
+	Name       string `json:"name"`
+	Version    string `json:"version"`
+	AppVersion string `json:"appVersion,omitempty"`
+}
+
+// ChartSources returns the chart in the given directory and its locked dependencies, if any
+func ChartSources(chartPath string) ([]argoappv1.RenderedSource, error) {
+	data, err := ioutil.ReadFile(path.Join(chartPath, "Chart.yaml"))
+	if err != nil {
+		return nil, err
+	}
+	var chart chartMetadata
+	if err := yaml.Unmarshal(data, &chart); err != nil {
+		return nil, fmt.Errorf("failed to parse Chart.yaml: %v", err)
+	}
+	sources := []argoappv1.RenderedSource{{
+		Type:       argoappv1.ApplicationSourceTypeHelm,
+		Name:       chart.Name,
+		Version:    chart.Version,
+		AppVersion: chart.AppVersion,
+	}}
+	data, err = ioutil.ReadFile(path.Join(chartPath, "requirements.lock"))
+	if os.IsNotExist(err) {
+		return sources, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var lock struct {
+		Dependencies []chartMetadata `json:"dependencies"`
+	}
+	if err := yaml.Unmarshal(data, &lock); err != nil {
+		return nil, fmt.Errorf("failed to parse requirements.lock: %v", err)
+	}
+	for _, dep := range lock.Dependencies {
+		sources = append(sources, argoappv1.RenderedSource{
+			Type:       argoappv1.ApplicationSourceTypeHelm,
+			Name:       dep.Name,
+			Version:    dep.Version,
+			Dependency: true,
+		})
+	}
+	return sources, nil
 }
 
 // IsMissingDependencyErr tests if the error is related to a missing chart dependency
@@ -52,13 +107,15 @@ func IsMissingDependencyErr(err error) bool {
 	return strings.Contains(err.Error(), "found in requirements.yaml, but missing in charts")
 }
 
-func (h *helm) Template(appName, namespace, kubeVersion string, opts *argoappv1.ApplicationSourceHelm) ([]*unstructured.Unstructured, error) {
+func (h *helm) Template(appName, namespace, kubeVersion string, apiVersions []string, opts *argoappv1.ApplicationSourceHelm) ([]*unstructured.Unstructured, error) {
 	templateOpts := templateOpts{
 		name:        appName,
 		namespace:   namespace,
 		kubeVersion: text.SemVer(kubeVersion),
+		apiVersions: apiVersions,
 		set:         map[string]string{},
 		setString:   map[string]string{},
+		setFile:     map[string]string{},
 	}
 	if opts != nil {
 		if opts.ReleaseName != "" {
@@ -85,6 +142,13 @@ func (h *helm) Template(appName, namespace, kubeVersion string, opts *argoappv1.
 				templateOpts.set[p.Name] = p.Value
 			}
 		}
+		for _, p := range opts.FileParameters {
+			filePath, err := apppath.ResolveFilePath(h.cmd.WorkDir, h.cmd.WorkDir, p.Path)
+			if err != nil {
+				return nil, err
+			}
+			templateOpts.setFile[p.Name] = filePath
+		}
 	}
 	if templateOpts.name == "" {
 		templateOpts.name = appName
@@ -94,29 +158,117 @@ func (h *helm) Template(appName, namespace, kubeVersion string, opts *argoappv1.
 	if err != nil {
 		return nil, err
 	}
-	return kube.SplitYAML(out)
+	objs, err := kube.SplitYAML(out)
+	if err != nil {
+		return nil, err
+	}
+	// CRDs are only rendered if requested, since rendering them by default would add resources to existing apps
+	if opts == nil || !opts.IncludeCRDs {
+		return objs, nil
+	}
+	crds, err := h.GetCRDs()
+	if err != nil {
+		return nil, err
+	}
+	return append(crds, objs...), nil
 }
 
-func (h *helm) reposInitialized() bool {
-	return h.repos != nil
+// GetCRDs returns the custom resource definitions in the crds/ directory of the chart. Like Helm 3, the files are not
+// templated.
+func (h *helm) GetCRDs() ([]*unstructured.Unstructured, error) {
+	dir := path.Join(h.cmd.WorkDir, crdsDir)
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var crds []*unstructured.Unstructured
+	for _, f := range files {
+		if f.IsDir() || !crdFile.MatchString(f.Name()) {
+			continue
+		}
+		data, err := ioutil.ReadFile(path.Join(dir, f.Name()))
+		if err != nil {
+			return nil, err
+		}
+		objs, err := kube.SplitYAML(string(data))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s/%s: %v", crdsDir, f.Name(), err)
+		}
+		crds = append(crds, objs...)
+	}
+	return crds, nil
 }
 
+// DependencyRepositories returns the Helm repositories, of the given ones, which the dependencies of the chart in the
+// given directory are fetched from. Repositories are matched by URL, ignoring trailing slashes, or by name if the
+// dependency refers to the repository by its alias, e.g. "@stable" or "alias:stable".
+func DependencyRepositories(chartPath string, repos argoappv1.Repositories) (argoappv1.Repositories, error) {
+	data, err := ioutil.ReadFile(path.Join(chartPath, "requirements.yaml"))
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var requirements struct {
+		Dependencies []struct {
+			Repository string `json:"repository"`
+		} `json:"dependencies"`
+	}
+	if err := yaml.Unmarshal(data, &requirements); err != nil {
+		return nil, fmt.Errorf("failed to parse requirements.yaml: %v", err)
+	}
+	urls := make(map[string]bool)
+	aliases := make(map[string]bool)
+	for _, dep := range requirements.Dependencies {
+		switch {
+		case strings.HasPrefix(dep.Repository, "@"):
+			aliases[strings.TrimPrefix(dep.Repository, "@")] = true
+		case strings.HasPrefix(dep.Repository, "alias:"):
+			aliases[strings.TrimPrefix(dep.Repository, "alias:")] = true
+		default:
+			urls[strings.TrimSuffix(dep.Repository, "/")] = true
+		}
+	}
+	return repos.Filter(func(r *argoappv1.Repository) bool {
+		return r.Type == "helm" && (urls[strings.TrimSuffix(r.Repo, "/")] || (r.Name != "" && aliases[r.Name]))
+	}), nil
+}
+
+// DependencyBuild adds all Helm repositories by name, so that dependencies can refer to them by alias, and downloads
+// the dependencies of the chart. Credentials are only passed to the repositories which the dependencies are fetched
+// from.
 func (h *helm) DependencyBuild() error {
-	if !h.reposInitialized() {
-		for _, repo := range h.repos.Filter(func(r *argoappv1.Repository) bool { return r.Type == "helm" }) {
-			_, err := h.cmd.RepoAdd(repo.Name, repo.Repo, RepoAddOpts{
-				Username: repo.Username,
-				Password: repo.Password,
-				CAData:   []byte(repo.TLSClientCAData),
-				CertData: []byte(repo.TLSClientCertData),
-				KeyData:  []byte(repo.TLSClientCertKey),
-			})
+	if !h.reposAdded {
+		deps, err := DependencyRepositories(h.cmd.WorkDir, h.repos)
+		if err != nil {
+			return err
+		}
+		isDependency := make(map[string]bool)
+		for _, repo := range deps {
+			isDependency[repo.Repo] = true
+		}
+		for i, repo := range h.repos.Filter(func(r *argoappv1.Repository) bool { return r.Type == "helm" }) {
+			name := repo.Name
+			if name == "" {
+				name = fmt.Sprintf("repository-%d", i)
+			}
+			opts := RepoAddOpts{CAData: []byte(repo.TLSClientCAData)}
+			if isDependency[repo.Repo] {
+				opts.Username = repo.Username
+				opts.Password = repo.Password
+				opts.CertData = []byte(repo.TLSClientCertData)
+				opts.KeyData = []byte(repo.TLSClientCertKey)
+			}
+			_, err := h.cmd.RepoAdd(name, repo.Repo, opts)
 
 			if err != nil {
 				return err
 			}
 		}
-		h.repos = nil
+		h.reposAdded = true
 	}
 	_, err := h.cmd.dependencyBuild()
 	return err
@@ -1,7 +1,9 @@
 package helm
 
 import (
+	"io/ioutil"
 	"os"
+	"path"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -21,6 +23,17 @@ func findParameter(params []*argoappv1.HelmParameter, name string) *argoappv1.He
 	return nil
 }
 
+func TestChartSources(t *testing.T) {
+	sources, err := ChartSources("./testdata/redis")
+	assert.NoError(t, err)
+	assert.Equal(t, []argoappv1.RenderedSource{
+		{Type: argoappv1.ApplicationSourceTypeHelm, Name: "redis", Version: "3.6.5", AppVersion: "4.0.10"},
+	}, sources)
+
+	_, err = ChartSources("./testdata")
+	assert.Error(t, err)
+}
+
 func TestHelmTemplateParams(t *testing.T) {
 	h, err := NewHelmApp("./testdata/minio", argoappv1.Repositories{})
 	assert.NoError(t, err)
@@ -41,7 +54,7 @@ func TestHelmTemplateParams(t *testing.T) {
 			},
 		},
 	}
-	objs, err := h.Template("test", "", "", &opts)
+	objs, err := h.Template("test", "", "", nil, &opts)
 	assert.Nil(t, err)
 	assert.Equal(t, 5, len(objs))
 
@@ -63,7 +76,7 @@ func TestHelmTemplateValues(t *testing.T) {
 	opts := argoappv1.ApplicationSourceHelm{
 		ValueFiles: []string{"values-production.yaml"},
 	}
-	objs, err := h.Template("test", "", "", &opts)
+	objs, err := h.Template("test", "", "", nil, &opts)
 	assert.Nil(t, err)
 	assert.Equal(t, 8, len(objs))
 
@@ -83,7 +96,7 @@ func TestHelmTemplateValuesURL(t *testing.T) {
 	opts := argoappv1.ApplicationSourceHelm{
 		ValueFiles: []string{"https://raw.githubusercontent.com/argoproj/argo-cd/master/util/helm/testdata/redis/values-production.yaml"},
 	}
-	objs, err := h.Template("test", "", "", &opts)
+	objs, err := h.Template("test", "", "", nil, &opts)
 	assert.Nil(t, err)
 	assert.Equal(t, 8, len(objs))
 	params, err := h.GetParameters(opts.ValueFiles)
@@ -123,11 +136,11 @@ func TestHelmDependencyBuild(t *testing.T) {
 	assert.NoError(t, err)
 	err = h.Init()
 	assert.NoError(t, err)
-	_, err = h.Template("wordpress", "", "", nil)
+	_, err = h.Template("wordpress", "", "", nil, nil)
 	assert.Error(t, err)
 	err = h.DependencyBuild()
 	assert.NoError(t, err)
-	_, err = h.Template("wordpress", "", "", nil)
+	_, err = h.Template("wordpress", "", "", nil, nil)
 	assert.NoError(t, err)
 }
 
@@ -137,7 +150,7 @@ func TestHelmTemplateReleaseNameOverwrite(t *testing.T) {
 	opts := argoappv1.ApplicationSourceHelm{
 		ReleaseName: "my-release",
 	}
-	objs, err := h.Template("test", "", "", &opts)
+	objs, err := h.Template("test", "", "", nil, &opts)
 	assert.Nil(t, err)
 	assert.Equal(t, 5, len(objs))
 
@@ -155,7 +168,7 @@ func TestHelmTemplateReleaseName(t *testing.T) {
 	h, err := NewHelmApp("./testdata/redis", argoappv1.Repositories{})
 	assert.NoError(t, err)
 	opts := argoappv1.ApplicationSourceHelm{}
-	objs, err := h.Template("test", "", "", &opts)
+	objs, err := h.Template("test", "", "", nil, &opts)
 	assert.Nil(t, err)
 	assert.Equal(t, 5, len(objs))
 
@@ -197,7 +210,7 @@ func TestHelmValues(t *testing.T) {
   slaveCount: 2
 `,
 	}
-	objs, err := h.Template("test", "", "1.4+", &opts)
+	objs, err := h.Template("test", "", "1.4+", nil, &opts)
 	assert.NoError(t, err)
 	for _, obj := range objs {
 		if obj.GetKind() == "Deployment" && obj.GetName() == "test-redis-slave" {
@@ -208,3 +221,74 @@ func TestHelmValues(t *testing.T) {
 		}
 	}
 }
+
+func TestHelmGetCRDs(t *testing.T) {
+	h, err := NewHelmApp("./testdata/crds", argoappv1.Repositories{})
+	assert.NoError(t, err)
+	defer h.Dispose()
+	crds, err := h.GetCRDs()
+	assert.NoError(t, err)
+	if assert.Len(t, crds, 1) {
+		assert.Equal(t, "CustomResourceDefinition", crds[0].GetKind())
+		assert.Equal(t, "crontabs.stable.example.com", crds[0].GetName())
+	}
+
+	h, err = NewHelmApp("./testdata/redis", argoappv1.Repositories{})
+	assert.NoError(t, err)
+	defer h.Dispose()
+	crds, err = h.GetCRDs()
+	assert.NoError(t, err)
+	assert.Empty(t, crds)
+}
+
+func TestHelmTemplateIncludeCRDs(t *testing.T) {
+	h, err := NewHelmApp("./testdata/crds", argoappv1.Repositories{})
+	assert.NoError(t, err)
+	defer h.Dispose()
+	objs, err := h.Template("test", "", "", nil, nil)
+	assert.NoError(t, err)
+	if assert.Len(t, objs, 1) {
+		assert.Equal(t, "CronTab", objs[0].GetKind())
+	}
+
+	objs, err = h.Template("test", "", "", nil, &argoappv1.ApplicationSourceHelm{IncludeCRDs: true})
+	assert.NoError(t, err)
+	assert.Len(t, objs, 2)
+}
+
+func TestDependencyRepositories(t *testing.T) {
+	repos := argoappv1.Repositories{
+		{Repo: "https://kubernetes-charts.storage.googleapis.com", Type: "helm", Name: "stable", Username: "user", Password: "pass"},
+		{Repo: "https://charts.example.com/", Type: "helm", Name: "example"},
+		{Repo: "https://kubernetes-charts.storage.googleapis.com/", Type: "git"},
+	}
+	deps, err := DependencyRepositories("./testdata/wordpress", repos)
+	assert.NoError(t, err)
+	assert.Equal(t, argoappv1.Repositories{repos[0]}, deps)
+
+	// charts without requirements have no dependency repositories
+	deps, err = DependencyRepositories("./testdata/minio", repos)
+	assert.NoError(t, err)
+	assert.Empty(t, deps)
+}
+
+func TestDependencyRepositories_Alias(t *testing.T) {
+	chartPath, err := ioutil.TempDir("", "chart")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(chartPath) }()
+	assert.NoError(t, ioutil.WriteFile(path.Join(chartPath, "requirements.yaml"), []byte(`dependencies:
+- name: common
+  repository: "@example"
+- name: mysql
+  repository: alias:stable
+`), 0644))
+	repos := argoappv1.Repositories{
+		{Repo: "https://kubernetes-charts.storage.googleapis.com", Type: "helm", Name: "stable", Username: "user", Password: "pass"},
+		{Repo: "https://charts.example.com/", Type: "helm", Name: "example"},
+		{Repo: "https://charts.other.com/", Type: "helm", Name: "other"},
+		{Repo: "https://charts.unnamed.com/", Type: "helm"},
+	}
+	deps, err := DependencyRepositories(chartPath, repos)
+	assert.NoError(t, err)
+	assert.Equal(t, argoappv1.Repositories{repos[0], repos[1]}, deps)
+}
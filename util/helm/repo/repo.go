@@ -41,6 +41,11 @@ func (c helmRepo) LockKey() string {
 	return c.cmd.WorkDir
 }
 
+// AppLockKey returns the lock key of the repository, since charts of all versions are fetched to the same directory
+func (c helmRepo) AppLockKey(_ string) string {
+	return c.cmd.WorkDir
+}
+
 func (c helmRepo) ResolveAppRevision(app, revision string) (string, error) {
 	if revision != "" {
 		return revision, nil
@@ -150,6 +155,16 @@ func (c helmRepo) GetApp(app string, resolvedRevision string) (string, error) {
 	return filepath.Join(c.cmd.WorkDir, app), err
 }
 
+// ChangedFiles is not supported, since chart versions do not share a history
+func (c helmRepo) ChangedFiles(_, _ string) ([]string, error) {
+	return nil, fmt.Errorf("changed files are not supported by Helm repositories")
+}
+
+// Commits is not supported, since chart versions do not share a history
+func (c helmRepo) Commits(_, _ string) ([]repo.Commit, error) {
+	return nil, fmt.Errorf("commits are not supported by Helm repositories")
+}
+
 func (c helmRepo) checkKnownChart(chartName string) error {
 	knownChart, err := c.isKnownChart(chartName)
 	if err != nil {
@@ -1,6 +1,9 @@
 package hook
 
 import (
+	"strconv"
+	"strings"
+
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/argoproj/argo-cd/common"
@@ -26,6 +29,44 @@ func Skip(obj *unstructured.Unstructured) bool {
 	return false
 }
 
+// ApplyResourceHooks annotates the given resource with the hook behavior defined by the matching resource hooks of the
+// application spec. Hook types, sync wave and delete policies which are already annotated, including Helm hook
+// annotations, take precedence.
+func ApplyResourceHooks(obj *unstructured.Unstructured, resourceHooks []v1alpha1.ResourceHook) {
+	for i := range resourceHooks {
+		resourceHook := resourceHooks[i]
+		if !resourceHook.Matches(obj) {
+			continue
+		}
+		annotations := obj.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		if _, ok := annotations[common.AnnotationKeyHook]; !ok && !helmhook.IsHook(obj) && len(resourceHook.Hook) > 0 {
+			types := make([]string, len(resourceHook.Hook))
+			for j := range resourceHook.Hook {
+				types[j] = string(resourceHook.Hook[j])
+			}
+			annotations[common.AnnotationKeyHook] = strings.Join(types, ",")
+		}
+		if _, ok := annotations[common.AnnotationSyncWave]; !ok && resourceHook.SyncWave != nil {
+			if _, ok := annotations["helm.sh/hook-weight"]; !ok {
+				annotations[common.AnnotationSyncWave] = strconv.FormatInt(*resourceHook.SyncWave, 10)
+			}
+		}
+		if _, ok := annotations[common.AnnotationKeyHookDeletePolicy]; !ok && len(resourceHook.DeletePolicy) > 0 {
+			if _, ok := annotations["helm.sh/hook-delete-policy"]; !ok {
+				policies := make([]string, len(resourceHook.DeletePolicy))
+				for j := range resourceHook.DeletePolicy {
+					policies[j] = string(resourceHook.DeletePolicy[j])
+				}
+				annotations[common.AnnotationKeyHookDeletePolicy] = strings.Join(policies, ",")
+			}
+		}
+		obj.SetAnnotations(annotations)
+	}
+}
+
 func Types(obj *unstructured.Unstructured) []v1alpha1.HookType {
 	var types []v1alpha1.HookType
 	for _, text := range resource.GetAnnotationCSVs(obj, common.AnnotationKeyHook) {
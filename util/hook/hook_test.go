@@ -93,6 +93,41 @@ func TestBothHooks(t *testing.T) {
 	assert.Equal(t, []HookType{HookTypeSync}, Types(obj))
 }
 
+func TestApplyResourceHooks(t *testing.T) {
+	wave := int64(-1)
+	resourceHooks := []ResourceHook{{
+		Kind:         "Pod",
+		Name:         "my-pod",
+		Hook:         []HookType{HookTypePreSync},
+		SyncWave:     &wave,
+		DeletePolicy: []HookDeletePolicy{HookDeletePolicyBeforeHookCreation},
+	}}
+
+	t.Run("Matching", func(t *testing.T) {
+		obj := NewPod()
+		ApplyResourceHooks(obj, resourceHooks)
+		assert.True(t, IsHook(obj))
+		assert.Equal(t, []HookType{HookTypePreSync}, Types(obj))
+		assert.Equal(t, []HookDeletePolicy{HookDeletePolicyBeforeHookCreation}, DeletePolicies(obj))
+		assert.Equal(t, "-1", obj.GetAnnotations()["argocd.argoproj.io/sync-wave"])
+	})
+
+	t.Run("NotMatching", func(t *testing.T) {
+		obj := NewPod()
+		obj.SetName("other-pod")
+		ApplyResourceHooks(obj, resourceHooks)
+		assert.False(t, IsHook(obj))
+	})
+
+	t.Run("Annotated", func(t *testing.T) {
+		obj := Annotate(example("PostSync"), "helm.sh/hook-weight", "2")
+		ApplyResourceHooks(obj, resourceHooks)
+		assert.Equal(t, []HookType{HookTypePostSync}, Types(obj))
+		assert.Equal(t, []HookDeletePolicy{HookDeletePolicyBeforeHookCreation}, DeletePolicies(obj))
+		assert.NotContains(t, obj.GetAnnotations(), "argocd.argoproj.io/sync-wave")
+	})
+}
+
 func example(hook string) *unstructured.Unstructured {
 	return Annotate(NewPod(), "argocd.argoproj.io/hook", hook)
 }
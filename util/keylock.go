@@ -1,48 +1,131 @@
 package util
 
-import "sync"
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
 
-// Allows to lock by string key
+// KeyLockTimeoutError is returned if the lock of a key is not acquired within the timeout
+type KeyLockTimeoutError struct {
+	Key     string
+	Timeout time.Duration
+	// Owner and HeldSince describe the holder of the lock when the timeout expired
+	Owner     string
+	HeldSince time.Time
+}
+
+func (e *KeyLockTimeoutError) Error() string {
+	return fmt.Sprintf("timed out after %v waiting for the lock of '%s', which is held by '%s' since %s", e.Timeout, e.Key, e.Owner, e.HeldSince.Format(time.RFC3339))
+}
+
+// KeyLockStatus describes a held lock
+type KeyLockStatus struct {
+	Key       string
+	Owner     string
+	HeldSince time.Time
+	// Waiting is the number of goroutines waiting for the lock
+	Waiting int
+}
+
+type keyLockWaiter struct {
+	owner    string
+	acquired chan struct{}
+}
+
+// keyLockState is the state of a held lock. The lock is handed over to the first waiter when it is released.
+type keyLockState struct {
+	owner     string
+	heldSince time.Time
+	waiters   []*keyLockWaiter
+}
+
+// Allows to lock by string key. The lock of a key is granted in the order in which it was requested.
 type KeyLock struct {
-	giantLock sync.RWMutex
-	locks     map[string]*sync.Mutex
+	mutex sync.Mutex
+	// locks holds the state of the held locks only, so that the keys of released locks do not accumulate
+	locks map[string]*keyLockState
 }
 
 // NewKeyLock creates new instance of KeyLock
 func NewKeyLock() *KeyLock {
 	return &KeyLock{
-		giantLock: sync.RWMutex{},
-		locks:     map[string]*sync.Mutex{},
+		locks: map[string]*keyLockState{},
 	}
 }
 
-func (keyLock *KeyLock) getLock(key string) *sync.Mutex {
-	keyLock.giantLock.RLock()
-	if lock, ok := keyLock.locks[key]; ok {
-		keyLock.giantLock.RUnlock()
-		return lock
-	}
+// Lock blocks goroutine until the lock of the key is acquired
+func (keyLock *KeyLock) Lock(key string) {
+	_ = keyLock.LockWithTimeout(key, "", 0)
+}
 
-	keyLock.giantLock.RUnlock()
-	keyLock.giantLock.Lock()
+// LockWithTimeout acquires the lock of the key on behalf of the owner, which is reported by Locks, and returns a
+// *KeyLockTimeoutError if the lock is not acquired within the timeout. A timeout of zero or less waits indefinitely.
+func (keyLock *KeyLock) LockWithTimeout(key string, owner string, timeout time.Duration) error {
+	keyLock.mutex.Lock()
+	state, held := keyLock.locks[key]
+	if !held {
+		keyLock.locks[key] = &keyLockState{owner: owner, heldSince: time.Now()}
+		keyLock.mutex.Unlock()
+		return nil
+	}
+	waiter := &keyLockWaiter{owner: owner, acquired: make(chan struct{})}
+	state.waiters = append(state.waiters, waiter)
+	keyLock.mutex.Unlock()
 
-	if lock, ok := keyLock.locks[key]; ok {
-		keyLock.giantLock.Unlock()
-		return lock
+	if timeout <= 0 {
+		<-waiter.acquired
+		return nil
+	}
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-waiter.acquired:
+		return nil
+	case <-timer.C:
 	}
 
-	lock := &sync.Mutex{}
-	keyLock.locks[key] = lock
-	keyLock.giantLock.Unlock()
-	return lock
+	keyLock.mutex.Lock()
+	defer keyLock.mutex.Unlock()
+	for i := range state.waiters {
+		if state.waiters[i] == waiter {
+			state.waiters = append(state.waiters[:i], state.waiters[i+1:]...)
+			return &KeyLockTimeoutError{Key: key, Timeout: timeout, Owner: state.owner, HeldSince: state.heldSince}
+		}
+	}
+	// the lock was handed over to the waiter while the timeout expired
+	return nil
 }
 
-// Lock blocks goroutine using key specific mutex
-func (keyLock *KeyLock) Lock(key string) {
-	keyLock.getLock(key).Lock()
+// Unlock releases the lock of the key, and hands it over to the goroutine which waits for it the longest
+func (keyLock *KeyLock) Unlock(key string) {
+	keyLock.mutex.Lock()
+	defer keyLock.mutex.Unlock()
+	state, held := keyLock.locks[key]
+	if !held {
+		panic(fmt.Sprintf("unlock of unlocked key '%s'", key))
+	}
+	if len(state.waiters) == 0 {
+		delete(keyLock.locks, key)
+		return
+	}
+	waiter := state.waiters[0]
+	state.waiters = state.waiters[1:]
+	state.owner, state.heldSince = waiter.owner, time.Now()
+	close(waiter.acquired)
 }
 
-// Unlock releases key specific mutex
-func (keyLock *KeyLock) Unlock(key string) {
-	keyLock.getLock(key).Unlock()
+// Locks returns the held locks sorted by key, to diagnose stuck lock holders
+func (keyLock *KeyLock) Locks() []KeyLockStatus {
+	keyLock.mutex.Lock()
+	defer keyLock.mutex.Unlock()
+	locks := make([]KeyLockStatus, 0, len(keyLock.locks))
+	for key, state := range keyLock.locks {
+		locks = append(locks, KeyLockStatus{Key: key, Owner: state.owner, HeldSince: state.heldSince, Waiting: len(state.waiters)})
+	}
+	sort.Slice(locks, func(i, j int) bool {
+		return locks[i].Key < locks[j].Key
+	})
+	return locks
 }
@@ -0,0 +1,87 @@
+package util
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// waitForWaiters waits until the given number of goroutines wait for the lock of the key
+func waitForWaiters(t *testing.T, keyLock *KeyLock, key string, waiting int) {
+	for i := 0; i < 1000; i++ {
+		for _, lock := range keyLock.Locks() {
+			if lock.Key == key && lock.Waiting == waiting {
+				return
+			}
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatalf("timed out waiting for %d waiters of '%s'", waiting, key)
+}
+
+func TestKeyLock_Fairness(t *testing.T) {
+	keyLock := NewKeyLock()
+	keyLock.Lock("repo")
+
+	var order []int
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			keyLock.Lock("repo")
+			order = append(order, i)
+			keyLock.Unlock("repo")
+		}(i)
+		waitForWaiters(t, keyLock, "repo", i+1)
+	}
+	keyLock.Unlock("repo")
+	wg.Wait()
+
+	assert.Equal(t, []int{0, 1, 2}, order)
+	assert.Empty(t, keyLock.Locks())
+}
+
+func TestKeyLock_LockWithTimeout(t *testing.T) {
+	keyLock := NewKeyLock()
+	assert.NoError(t, keyLock.LockWithTimeout("repo", "GenerateManifest", time.Second))
+
+	err := keyLock.LockWithTimeout("repo", "ListApps", 10*time.Millisecond)
+	timeoutErr, ok := err.(*KeyLockTimeoutError)
+	if assert.True(t, ok) {
+		assert.Equal(t, "repo", timeoutErr.Key)
+		assert.Equal(t, "GenerateManifest", timeoutErr.Owner)
+	}
+
+	// the waiter which timed out does not receive the lock
+	locks := keyLock.Locks()
+	if assert.Len(t, locks, 1) {
+		assert.Equal(t, "GenerateManifest", locks[0].Owner)
+		assert.Equal(t, 0, locks[0].Waiting)
+	}
+
+	acquired := make(chan error)
+	go func() {
+		acquired <- keyLock.LockWithTimeout("repo", "GetAppDetails", time.Minute)
+	}()
+	waitForWaiters(t, keyLock, "repo", 1)
+	keyLock.Unlock("repo")
+	assert.NoError(t, <-acquired)
+	locks = keyLock.Locks()
+	if assert.Len(t, locks, 1) {
+		assert.Equal(t, "GetAppDetails", locks[0].Owner)
+	}
+	keyLock.Unlock("repo")
+}
+
+func TestKeyLock_Unlock(t *testing.T) {
+	keyLock := NewKeyLock()
+	keyLock.Lock("a")
+	keyLock.Lock("b")
+	assert.Equal(t, []string{"a", "b"}, []string{keyLock.Locks()[0].Key, keyLock.Locks()[1].Key})
+	keyLock.Unlock("a")
+	keyLock.Unlock("b")
+	assert.Panics(t, func() { keyLock.Unlock("a") })
+}
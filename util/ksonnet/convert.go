@@ -0,0 +1,261 @@
+package ksonnet
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/google/go-jsonnet"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	// FormatKustomize converts a ksonnet app into plain manifests with a kustomization.yaml
+	FormatKustomize = "kustomize"
+	// FormatJsonnet converts a ksonnet app into a directory of jsonnet files
+	FormatJsonnet = "jsonnet"
+
+	environmentFile = "environment.libsonnet"
+)
+
+var (
+	paramsExtVar      = regexp.MustCompile(`std\.extVar\(\s*["']__ksonnet/params["']\s*\)`)
+	environmentExtVar = regexp.MustCompile(`std\.extVar\(\s*["']__ksonnet/environments["']\s*\)`)
+	nonAlphanumeric   = regexp.MustCompile(`[^a-z0-9.-]+`)
+)
+
+// Convert converts the given environment of a ksonnet app into a directory which is deployed without ksonnet, using
+// either the kustomize or the jsonnet format. The output directory must not exist yet.
+func Convert(appPath string, environment string, outDir string, format string) error {
+	ksApp, err := NewEmbeddedKsonnetApp(appPath)
+	if err != nil {
+		return err
+	}
+	app := ksApp.(*embeddedApp)
+	if _, err := os.Stat(outDir); err == nil {
+		return fmt.Errorf("output directory %s already exists", outDir)
+	}
+	objs, err := app.Show(environment)
+	if err != nil {
+		return err
+	}
+	for _, obj := range objs {
+		removeComponentLabel(obj)
+	}
+	if err := os.MkdirAll(outDir, 0755); err != nil {
+		return err
+	}
+	switch format {
+	case FormatKustomize:
+		err = convertToKustomize(objs, outDir)
+	case FormatJsonnet:
+		err = app.convertToJsonnet(environment, objs, outDir)
+	default:
+		err = fmt.Errorf("unknown format '%s', must be one of: %s, %s", format, FormatKustomize, FormatJsonnet)
+	}
+	if err != nil {
+		_ = os.RemoveAll(outDir)
+	}
+	return err
+}
+
+func removeComponentLabel(obj *unstructured.Unstructured) {
+	labels := obj.GetLabels()
+	delete(labels, componentLabel)
+	if len(labels) == 0 {
+		unstructured.RemoveNestedField(obj.Object, "metadata", "labels")
+	} else {
+		obj.SetLabels(labels)
+	}
+}
+
+// convertToKustomize writes each object into its own file and lists the files as the resources of a kustomization
+func convertToKustomize(objs []*unstructured.Unstructured, outDir string) error {
+	var resources []string
+	for _, obj := range objs {
+		name := nonAlphanumeric.ReplaceAllString(strings.ToLower(obj.GetKind()+"-"+obj.GetName()), "-") + ".yaml"
+		for i := 2; contains(resources, name); i++ {
+			name = nonAlphanumeric.ReplaceAllString(strings.ToLower(fmt.Sprintf("%s-%s-%d", obj.GetKind(), obj.GetName(), i)), "-") + ".yaml"
+		}
+		data, err := yaml.Marshal(obj.Object)
+		if err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(filepath.Join(outDir, name), data, 0644); err != nil {
+			return err
+		}
+		resources = append(resources, name)
+	}
+	data, err := yaml.Marshal(map[string]interface{}{
+		"apiVersion": "kustomize.config.k8s.io/v1beta1",
+		"kind":       "Kustomization",
+		"resources":  resources,
+	})
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(outDir, "kustomization.yaml"), data, 0644)
+}
+
+func contains(items []string, item string) bool {
+	for _, i := range items {
+		if i == item {
+			return true
+		}
+	}
+	return false
+}
+
+// convertToJsonnet copies the components into the output directory and replaces the ksonnet external variables with
+// imports of the evaluated parameters and environment. Environment overrides in main.jsonnet cannot be converted, so
+// the output is evaluated the same way as a directory app and compared with the objects rendered by ksonnet.
+func (k *embeddedApp) convertToJsonnet(environment string, expected []*unstructured.Unstructured, outDir string) error {
+	env, err := k.environment(environment)
+	if err != nil {
+		return err
+	}
+	params, err := k.params(env)
+	if err != nil {
+		return err
+	}
+	paramsJSON, err := json.MarshalIndent(params, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(filepath.Join(outDir, paramsFile), paramsJSON, 0644); err != nil {
+		return err
+	}
+	envJSON, err := json.MarshalIndent(map[string]string{
+		"name":      env.Path,
+		"server":    env.Destination.Server,
+		"namespace": env.Destination.Namespace,
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(filepath.Join(outDir, environmentFile), envJSON, 0644); err != nil {
+		return err
+	}
+	for _, lib := range []string{"k.libsonnet", "k8s.libsonnet"} {
+		data, err := ioutil.ReadFile(filepath.Join(k.Root(), "lib", "ksonnet-lib", env.K8sVersion, lib))
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(filepath.Join(outDir, lib), data, 0644); err != nil {
+			return err
+		}
+	}
+
+	componentsDir := filepath.Join(k.Root(), "components")
+	infos, err := ioutil.ReadDir(componentsDir)
+	if err != nil {
+		return err
+	}
+	for _, info := range infos {
+		if info.IsDir() || info.Name() == paramsFile {
+			continue
+		}
+		data, err := ioutil.ReadFile(filepath.Join(componentsDir, info.Name()))
+		if err != nil {
+			return err
+		}
+		if filepath.Ext(info.Name()) == ".jsonnet" {
+			data = paramsExtVar.ReplaceAll(data, []byte(fmt.Sprintf(`(import "%s")`, paramsFile)))
+			data = environmentExtVar.ReplaceAll(data, []byte(fmt.Sprintf(`(import "%s")`, environmentFile)))
+		}
+		if err := ioutil.WriteFile(filepath.Join(outDir, info.Name()), data, 0644); err != nil {
+			return err
+		}
+	}
+
+	actual, err := evaluateJsonnetDir(outDir)
+	if err != nil {
+		return fmt.Errorf("converted app cannot be evaluated, use the %s format instead: %v", FormatKustomize, err)
+	}
+	if !sameObjects(expected, actual) {
+		return fmt.Errorf("converted app does not render the same objects as ksonnet, e.g. because of environment overrides; use the %s format instead", FormatKustomize)
+	}
+	return nil
+}
+
+// evaluateJsonnetDir evaluates the files of a directory like the repo server does for directory apps
+func evaluateJsonnetDir(dir string) ([]*unstructured.Unstructured, error) {
+	infos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var objs []*unstructured.Unstructured
+	for _, info := range infos {
+		path := filepath.Join(dir, info.Name())
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		var out string
+		switch filepath.Ext(info.Name()) {
+		case ".jsonnet":
+			vm := jsonnet.MakeVM()
+			vm.Importer(&jsonnet.FileImporter{JPaths: []string{dir}})
+			out, err = vm.EvaluateSnippet(info.Name(), string(data))
+			if err != nil {
+				return nil, err
+			}
+		case ".json":
+			out = string(data)
+		case ".yaml", ".yml":
+			values, err := splitYAMLObjects(data)
+			if err != nil {
+				return nil, err
+			}
+			for _, value := range values {
+				if obj, ok := value.(map[string]interface{}); ok {
+					objs = append(objs, &unstructured.Unstructured{Object: obj})
+				}
+			}
+			continue
+		default:
+			continue
+		}
+		var list []map[string]interface{}
+		if err := json.Unmarshal([]byte(out), &list); err != nil {
+			var obj map[string]interface{}
+			if err := json.Unmarshal([]byte(out), &obj); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal %q: %v", info.Name(), err)
+			}
+			list = []map[string]interface{}{obj}
+		}
+		for _, obj := range list {
+			objs = append(objs, &unstructured.Unstructured{Object: obj})
+		}
+	}
+	return objs, nil
+}
+
+// sameObjects returns whether both lists contain the same objects, regardless of their order
+func sameObjects(a, b []*unstructured.Unstructured) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	normalize := func(objs []*unstructured.Unstructured) []string {
+		var res []string
+		for _, obj := range objs {
+			data, err := json.Marshal(obj.Object)
+			if err != nil {
+				return nil
+			}
+			res = append(res, string(data))
+		}
+		sort.Strings(res)
+		return res
+	}
+	return reflect.DeepEqual(normalize(a), normalize(b))
+}
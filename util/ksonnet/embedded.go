@@ -0,0 +1,371 @@
+package ksonnet
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/google/go-jsonnet"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+const (
+	// EmbeddedVersion is reported as the ksonnet version if apps are rendered without the `ks` binary
+	EmbeddedVersion = "embedded"
+	// componentLabel is the label which `ks show` sets to the name of the component of each object
+	componentLabel = "ksonnet.io/component"
+	paramsFile     = "params.libsonnet"
+)
+
+// embeddedApp renders ksonnet applications using the jsonnet library rather than the `ks` command, so that existing
+// apps keep working after the `ks` binary is removed. It follows the conventions of ksonnet: component parameters are
+// passed as the `__ksonnet/params` external variable and the evaluated components as `__ksonnet/components`.
+// Parameter overrides are kept in memory rather than written to the environment parameters.
+type embeddedApp struct {
+	ksonnetApp
+	overrides []v1alpha1.KsonnetParameter
+}
+
+// NewEmbeddedKsonnetApp returns a ksonnet app which is rendered without the `ks` command
+func NewEmbeddedKsonnetApp(path string) (KsonnetApp, error) {
+	ksApp := ksonnetApp{rootDir: path}
+	if _, err := ksApp.appYamlPath(); err != nil {
+		return nil, err
+	}
+	return &embeddedApp{ksonnetApp: ksApp}, nil
+}
+
+// environmentSpec is an environment of the app spec in app.yaml
+type environmentSpec struct {
+	Destination v1alpha1.ApplicationDestination `json:"destination"`
+	K8sVersion  string                          `json:"k8sVersion"`
+	Path        string                          `json:"path"`
+}
+
+func (k *embeddedApp) environment(environment string) (*environmentSpec, error) {
+	p, err := k.appYamlPath()
+	if err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadFile(p)
+	if err != nil {
+		return nil, err
+	}
+	var appSpec struct {
+		Environments map[string]environmentSpec `json:"environments"`
+	}
+	if err := yaml.Unmarshal(data, &appSpec); err != nil {
+		return nil, fmt.Errorf("could not unmarshal ksonnet spec app.yaml: %v", err)
+	}
+	env, ok := appSpec.Environments[environment]
+	if !ok {
+		return nil, fmt.Errorf("environment '%s' does not exist in ksonnet app", environment)
+	}
+	if env.Path == "" {
+		env.Path = environment
+	}
+	return &env, nil
+}
+
+// newVM returns a jsonnet VM which resolves imports the same way as ksonnet, including the ksonnet-lib which was
+// generated into the app for the Kubernetes version of the environment. Without an environment, only the app-wide
+// directories are searched.
+func (k *embeddedApp) newVM(env *environmentSpec) *jsonnet.VM {
+	var jpaths []string
+	if env != nil {
+		envDir := filepath.Join(k.Root(), "environments", env.Path)
+		jpaths = append(jpaths, envDir, filepath.Join(envDir, ".metadata"))
+	}
+	jpaths = append(jpaths, filepath.Join(k.Root(), "environments"), filepath.Join(k.Root(), "lib"))
+	if env != nil {
+		jpaths = append(jpaths, filepath.Join(k.Root(), "lib", "ksonnet-lib", env.K8sVersion))
+	}
+	jpaths = append(jpaths, filepath.Join(k.Root(), "vendor"))
+	vm := jsonnet.MakeVM()
+	vm.Importer(&jsonnet.FileImporter{JPaths: jpaths})
+	return vm
+}
+
+// params returns the component parameters of the given environment, including the parameter overrides. If env is
+// nil, the parameters of the components are returned without any environment overrides, like `ks param list` does.
+func (k *embeddedApp) params(env *environmentSpec) (map[string]interface{}, error) {
+	componentParams := filepath.Join(k.Root(), "components", paramsFile)
+	vm := k.newVM(env)
+	vm.ExtCode("__ksonnet/params", fmt.Sprintf("import %q", componentParams))
+	file := componentParams
+	if env != nil {
+		envParams := filepath.Join(k.Root(), "environments", env.Path, paramsFile)
+		if _, err := os.Stat(envParams); !os.IsNotExist(err) {
+			file = envParams
+		}
+	}
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	out, err := vm.EvaluateSnippet(file, string(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate parameters: %v", err)
+	}
+	var params map[string]interface{}
+	if err := json.Unmarshal([]byte(out), &params); err != nil {
+		return nil, err
+	}
+	components, _ := params["components"].(map[string]interface{})
+	if components == nil {
+		components = make(map[string]interface{})
+		params["components"] = components
+	}
+	for _, override := range k.overrides {
+		component, _ := components[override.Component].(map[string]interface{})
+		if component == nil {
+			component = make(map[string]interface{})
+			components[override.Component] = component
+		}
+		component[override.Name] = parseParamValue(override.Value)
+	}
+	return params, nil
+}
+
+// parseParamValue returns numbers, booleans and null as such and any other value as a string, like `ks param set`
+func parseParamValue(value string) interface{} {
+	var parsed interface{}
+	if err := json.Unmarshal([]byte(value), &parsed); err == nil {
+		switch parsed.(type) {
+		case float64, bool, nil:
+			return parsed
+		}
+	}
+	return value
+}
+
+// components evaluates the components of the app, keyed by the component name
+func (k *embeddedApp) components(env *environmentSpec, params map[string]interface{}) (map[string]interface{}, error) {
+	paramsJSON, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+	envJSON, err := json.Marshal(map[string]string{
+		"name":      env.Path,
+		"server":    env.Destination.Server,
+		"namespace": env.Destination.Namespace,
+	})
+	if err != nil {
+		return nil, err
+	}
+	componentsDir := filepath.Join(k.Root(), "components")
+	infos, err := ioutil.ReadDir(componentsDir)
+	if err != nil {
+		return nil, err
+	}
+	components := make(map[string]interface{})
+	for _, info := range infos {
+		path := filepath.Join(componentsDir, info.Name())
+		if info.IsDir() {
+			if _, err := os.Stat(filepath.Join(path, paramsFile)); err == nil {
+				return nil, fmt.Errorf("component module '%s' is not supported without the ks binary", info.Name())
+			}
+			continue
+		}
+		ext := filepath.Ext(info.Name())
+		name := strings.TrimSuffix(info.Name(), ext)
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		var out string
+		switch ext {
+		case ".jsonnet":
+			vm := k.newVM(env)
+			vm.ExtCode("__ksonnet/params", string(paramsJSON))
+			vm.ExtCode("__ksonnet/environments", string(envJSON))
+			out, err = vm.EvaluateSnippet(path, string(data))
+			if err != nil {
+				return nil, fmt.Errorf("failed to evaluate component '%s': %v", name, err)
+			}
+		case ".json":
+			out = string(data)
+		case ".yaml", ".yml":
+			objs, err := splitYAMLObjects(data)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse component '%s': %v", name, err)
+			}
+			components[name] = objs
+			continue
+		default:
+			continue
+		}
+		var component interface{}
+		if err := json.Unmarshal([]byte(out), &component); err != nil {
+			return nil, fmt.Errorf("failed to parse component '%s': %v", name, err)
+		}
+		components[name] = component
+	}
+	return components, nil
+}
+
+func splitYAMLObjects(data []byte) ([]interface{}, error) {
+	var objs []interface{}
+	for _, doc := range strings.Split(string(data), "\n---") {
+		if strings.TrimSpace(doc) == "" {
+			continue
+		}
+		var obj interface{}
+		if err := yaml.Unmarshal([]byte(doc), &obj); err != nil {
+			return nil, err
+		}
+		if obj != nil {
+			objs = append(objs, obj)
+		}
+	}
+	return objs, nil
+}
+
+// Show evaluates the main file of the environment with the evaluated components
+func (k *embeddedApp) Show(environment string) ([]*unstructured.Unstructured, error) {
+	env, err := k.environment(environment)
+	if err != nil {
+		return nil, err
+	}
+	params, err := k.params(env)
+	if err != nil {
+		return nil, err
+	}
+	components, err := k.components(env, params)
+	if err != nil {
+		return nil, err
+	}
+	main := filepath.Join(k.Root(), "environments", env.Path, "main.jsonnet")
+	if data, err := ioutil.ReadFile(main); err == nil {
+		componentsJSON, err := json.Marshal(components)
+		if err != nil {
+			return nil, err
+		}
+		vm := k.newVM(env)
+		vm.ExtCode("__ksonnet/components", string(componentsJSON))
+		out, err := vm.EvaluateSnippet(main, string(data))
+		if err != nil {
+			return nil, fmt.Errorf("failed to evaluate environment '%s': %v", environment, err)
+		}
+		components = nil
+		if err := json.Unmarshal([]byte(out), &components); err != nil {
+			return nil, err
+		}
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(components))
+	for name := range components {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var objs []*unstructured.Unstructured
+	for _, name := range names {
+		for _, obj := range flatten(components[name]) {
+			// decode the objects again, so that numbers are int64 like in objects decoded by `ks show`
+			data, err := json.Marshal(obj)
+			if err != nil {
+				return nil, err
+			}
+			un := &unstructured.Unstructured{}
+			if err := un.UnmarshalJSON(data); err != nil {
+				return nil, err
+			}
+			labels := un.GetLabels()
+			if labels == nil {
+				labels = make(map[string]string)
+			}
+			labels[componentLabel] = name
+			un.SetLabels(labels)
+			objs = append(objs, un)
+		}
+	}
+	return objs, nil
+}
+
+// flatten returns the Kubernetes objects within the value of a component, which is either an object, a list, an
+// array or an object of any of these
+func flatten(value interface{}) []map[string]interface{} {
+	var objs []map[string]interface{}
+	switch v := value.(type) {
+	case []interface{}:
+		for _, item := range v {
+			objs = append(objs, flatten(item)...)
+		}
+	case map[string]interface{}:
+		_, hasKind := v["kind"]
+		_, hasAPIVersion := v["apiVersion"]
+		if hasKind && hasAPIVersion {
+			if v["kind"] == "List" {
+				return flatten(v["items"])
+			}
+			return []map[string]interface{}{v}
+		}
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			objs = append(objs, flatten(v[key])...)
+		}
+	}
+	return objs
+}
+
+// ListParams returns the component parameters of the environment
+func (k *embeddedApp) ListParams(environment string) ([]*v1alpha1.KsonnetParameter, error) {
+	var env *environmentSpec
+	if environment != "" {
+		var err error
+		env, err = k.environment(environment)
+		if err != nil {
+			return nil, err
+		}
+	}
+	params, err := k.params(env)
+	if err != nil {
+		return nil, err
+	}
+	components, _ := params["components"].(map[string]interface{})
+	componentNames := make([]string, 0, len(components))
+	for name := range components {
+		componentNames = append(componentNames, name)
+	}
+	sort.Strings(componentNames)
+	var res []*v1alpha1.KsonnetParameter
+	for _, component := range componentNames {
+		componentParams, _ := components[component].(map[string]interface{})
+		names := make([]string, 0, len(componentParams))
+		for name := range componentParams {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			value, ok := componentParams[name].(string)
+			if !ok {
+				data, err := json.Marshal(componentParams[name])
+				if err != nil {
+					return nil, err
+				}
+				value = string(data)
+			}
+			res = append(res, &v1alpha1.KsonnetParameter{Component: component, Name: name, Value: value})
+		}
+	}
+	return res, nil
+}
+
+// SetComponentParams overrides a component parameter when the app is rendered
+func (k *embeddedApp) SetComponentParams(environment string, component string, param string, value string) error {
+	k.overrides = append(k.overrides, v1alpha1.KsonnetParameter{Component: component, Name: param, Value: value})
+	return nil
+}
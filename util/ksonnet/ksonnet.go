@@ -57,8 +57,12 @@ type KsonnetApp interface {
 	SetComponentParams(environment string, component string, param string, value string) error
 }
 
-// KsonnetVersion returns the version of ksonnet used when running ksonnet commands
+// KsonnetVersion returns the version of ksonnet used when running ksonnet commands, or EmbeddedVersion if the `ks`
+// command is not installed and apps are rendered by the embedded renderer
 func KsonnetVersion() (string, error) {
+	if !ksInstalled() {
+		return EmbeddedVersion, nil
+	}
 	ksApp := ksonnetApp{}
 	out, err := ksApp.ksCmd("", "version")
 	if err != nil {
@@ -76,8 +80,12 @@ type ksonnetApp struct {
 	rootDir string
 }
 
-// NewKsonnetApp tries to create a new wrapper to run commands on the `ks` command-line tool.
+// NewKsonnetApp tries to create a new wrapper to run commands on the `ks` command-line tool. If the `ks` command is not
+// installed, the app is rendered by the embedded renderer instead.
 func NewKsonnetApp(path string) (KsonnetApp, error) {
+	if !ksInstalled() {
+		return NewEmbeddedKsonnetApp(path)
+	}
 	ksApp := ksonnetApp{rootDir: path}
 	// ensure that the file exists
 	if _, err := ksApp.appYamlPath(); err != nil {
@@ -86,6 +94,11 @@ func NewKsonnetApp(path string) (KsonnetApp, error) {
 	return &ksApp, nil
 }
 
+func ksInstalled() bool {
+	_, err := exec.LookPath("ks")
+	return err == nil
+}
+
 func (k *ksonnetApp) appYamlPath() (string, error) {
 	const appYamlName = "app.yaml"
 	p := filepath.Join(k.Root(), appYamlName)
@@ -2,12 +2,15 @@ package ksonnet
 
 import (
 	"encoding/json"
+	"io/ioutil"
+	"os"
 	"path/filepath"
 	"runtime"
 	"testing"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
 var (
@@ -44,3 +47,85 @@ func TestShow(t *testing.T) {
 		log.Infof("%v", string(jsonBytes))
 	}
 }
+
+func TestEmbeddedShow(t *testing.T) {
+	ksApp, err := NewEmbeddedKsonnetApp(filepath.Join(testDataDir, testAppName))
+	assert.NoError(t, err)
+	objs, err := ksApp.Show(testEnvName)
+	assert.NoError(t, err)
+	if assert.Len(t, objs, 2) {
+		assert.Equal(t, "Service", objs[0].GetKind())
+		assert.Equal(t, "ks-guestbook-ui", objs[0].GetName())
+		assert.Equal(t, "guestbook-ui", objs[0].GetLabels()[componentLabel])
+		serviceType, _, _ := unstructured.NestedString(objs[0].Object, "spec", "type")
+		assert.Equal(t, "LoadBalancer", serviceType)
+		assert.Equal(t, "Deployment", objs[1].GetKind())
+	}
+
+	err = ksApp.SetComponentParams(testEnvName, "guestbook-ui", "replicas", "3")
+	assert.NoError(t, err)
+	objs, err = ksApp.Show(testEnvName)
+	assert.NoError(t, err)
+	if assert.Len(t, objs, 2) {
+		replicas, _, _ := unstructured.NestedInt64(objs[1].Object, "spec", "replicas")
+		assert.Equal(t, int64(3), replicas)
+	}
+
+	params, err := ksApp.ListParams(testEnvName)
+	assert.NoError(t, err)
+	values := make(map[string]string)
+	for _, p := range params {
+		values[p.Component+"/"+p.Name] = p.Value
+	}
+	assert.Equal(t, "3", values["guestbook-ui/replicas"])
+	assert.Equal(t, "LoadBalancer", values["guestbook-ui/type"])
+	assert.Equal(t, "ks-guestbook-ui", values["guestbook-ui/name"])
+
+	params, err = ksApp.ListParams("")
+	assert.NoError(t, err)
+	values = make(map[string]string)
+	for _, p := range params {
+		values[p.Component+"/"+p.Name] = p.Value
+	}
+	assert.Equal(t, "3", values["guestbook-ui/replicas"])
+	assert.Equal(t, "ClusterIP", values["guestbook-ui/type"])
+
+	_, err = ksApp.Show("unknown")
+	assert.Error(t, err)
+}
+
+func TestConvert(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ksonnet-convert")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	appPath := filepath.Join(testDataDir, testAppName)
+
+	kustomizeDir := filepath.Join(dir, "kustomize")
+	err = Convert(appPath, testEnvName, kustomizeDir, FormatKustomize)
+	assert.NoError(t, err)
+	data, err := ioutil.ReadFile(filepath.Join(kustomizeDir, "kustomization.yaml"))
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), "service-ks-guestbook-ui.yaml")
+	assert.Contains(t, string(data), "deployment-ks-guestbook-ui.yaml")
+	data, err = ioutil.ReadFile(filepath.Join(kustomizeDir, "service-ks-guestbook-ui.yaml"))
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), "LoadBalancer")
+	assert.NotContains(t, string(data), componentLabel)
+
+	jsonnetDir := filepath.Join(dir, "jsonnet")
+	err = Convert(appPath, testEnvName, jsonnetDir, FormatJsonnet)
+	assert.NoError(t, err)
+	data, err = ioutil.ReadFile(filepath.Join(jsonnetDir, "guestbook-ui.jsonnet"))
+	assert.NoError(t, err)
+	assert.NotContains(t, string(data), "__ksonnet")
+	objs, err := evaluateJsonnetDir(jsonnetDir)
+	assert.NoError(t, err)
+	assert.Len(t, objs, 2)
+
+	err = Convert(appPath, testEnvName, jsonnetDir, FormatJsonnet)
+	assert.Error(t, err)
+	err = Convert(appPath, testEnvName, filepath.Join(dir, "unknown"), "unknown")
+	assert.Error(t, err)
+	_, err = os.Stat(filepath.Join(dir, "unknown"))
+	assert.True(t, os.IsNotExist(err))
+}
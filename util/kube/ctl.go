@@ -24,14 +24,27 @@ import (
 	"github.com/argoproj/argo-cd/util/diff"
 )
 
+// DryRunStrategy controls whether and where the changes made by kubectl are persisted
+type DryRunStrategy string
+
+const (
+	// DryRunNone persists the changes
+	DryRunNone DryRunStrategy = ""
+	// DryRunClient only prints the object that would be sent, without sending it
+	DryRunClient DryRunStrategy = "client"
+	// DryRunServer submits the request to the API server (including admission webhooks) without persisting the changes
+	DryRunServer DryRunStrategy = "server"
+)
+
 type Kubectl interface {
-	ApplyResource(config *rest.Config, obj *unstructured.Unstructured, namespace string, dryRun, force, validate bool) (string, error)
+	ApplyResource(config *rest.Config, obj *unstructured.Unstructured, namespace string, dryRunStrategy DryRunStrategy, force, validate bool) (string, error)
 	ConvertToVersion(obj *unstructured.Unstructured, group, version string) (*unstructured.Unstructured, error)
 	DeleteResource(config *rest.Config, gvk schema.GroupVersionKind, name string, namespace string, forceDelete bool) error
 	GetResource(config *rest.Config, gvk schema.GroupVersionKind, name string, namespace string) (*unstructured.Unstructured, error)
 	PatchResource(config *rest.Config, gvk schema.GroupVersionKind, name string, namespace string, patchType types.PatchType, patchBytes []byte) (*unstructured.Unstructured, error)
 	GetAPIResources(config *rest.Config, resourceFilter ResourceFilter) ([]APIResourceInfo, error)
 	GetServerVersion(config *rest.Config) (string, error)
+	GetAPIVersions(config *rest.Config) ([]string, error)
 	SetOnKubectlRun(onKubectlRun func(command string) (util.Closer, error))
 }
 
@@ -182,7 +195,7 @@ func (k KubectlCmd) DeleteResource(config *rest.Config, gvk schema.GroupVersionK
 }
 
 // ApplyResource performs an apply of a unstructured resource
-func (k KubectlCmd) ApplyResource(config *rest.Config, obj *unstructured.Unstructured, namespace string, dryRun, force, validate bool) (string, error) {
+func (k KubectlCmd) ApplyResource(config *rest.Config, obj *unstructured.Unstructured, namespace string, dryRunStrategy DryRunStrategy, force, validate bool) (string, error) {
 	log.Infof("Applying resource %s/%s in cluster: %s, namespace: %s", obj.GetKind(), obj.GetName(), config.Host, namespace)
 	f, err := ioutil.TempFile(util.TempDir, "")
 	if err != nil {
@@ -203,12 +216,13 @@ func (k KubectlCmd) ApplyResource(config *rest.Config, obj *unstructured.Unstruc
 	// `kubectl apply`, which cannot tolerate changes in roleRef, which is an immutable field.
 	// See: https://github.com/kubernetes/kubernetes/issues/66353
 	// `auth reconcile` will delete and recreate the resource if necessary
-	if obj.GetAPIVersion() == "rbac.authorization.k8s.io/v1" {
+	// `kubectl auth reconcile` does not support server-side dry-run, so only `kubectl apply` is used in that case.
+	if obj.GetAPIVersion() == "rbac.authorization.k8s.io/v1" && dryRunStrategy != DryRunServer {
 		// `kubectl auth reconcile` has a side effect of auto-creating namespaces if it doesn't exist.
 		// See: https://github.com/kubernetes/kubernetes/issues/71185. This is behavior which we do
 		// not want. We need to check if the namespace exists, before know if it is safe to run this
 		// command. Skip this for dryRuns.
-		if !dryRun && namespace != "" {
+		if dryRunStrategy == DryRunNone && namespace != "" {
 			kubeClient, err := kubernetes.NewForConfig(config)
 			if err != nil {
 				return "", err
@@ -218,7 +232,7 @@ func (k KubectlCmd) ApplyResource(config *rest.Config, obj *unstructured.Unstruc
 				return "", err
 			}
 		}
-		outReconcile, err := k.runKubectl(f.Name(), namespace, []string{"auth", "reconcile"}, manifestBytes, dryRun)
+		outReconcile, err := k.runKubectl(f.Name(), namespace, []string{"auth", "reconcile"}, manifestBytes, dryRunStrategy)
 		if err != nil {
 			return "", err
 		}
@@ -235,7 +249,7 @@ func (k KubectlCmd) ApplyResource(config *rest.Config, obj *unstructured.Unstruc
 	if !validate {
 		applyArgs = append(applyArgs, "--validate=false")
 	}
-	outApply, err := k.runKubectl(f.Name(), namespace, applyArgs, manifestBytes, dryRun)
+	outApply, err := k.runKubectl(f.Name(), namespace, applyArgs, manifestBytes, dryRunStrategy)
 	if err != nil {
 		return "", err
 	}
@@ -269,7 +283,7 @@ func (k *KubectlCmd) processKubectlRun(args []string) (util.Closer, error) {
 	}), nil
 }
 
-func (k *KubectlCmd) runKubectl(kubeconfigPath string, namespace string, args []string, manifestBytes []byte, dryRun bool) (string, error) {
+func (k *KubectlCmd) runKubectl(kubeconfigPath string, namespace string, args []string, manifestBytes []byte, dryRunStrategy DryRunStrategy) (string, error) {
 	closer, err := k.processKubectlRun(args)
 	if err != nil {
 		return "", err
@@ -280,8 +294,11 @@ func (k *KubectlCmd) runKubectl(kubeconfigPath string, namespace string, args []
 	if namespace != "" {
 		cmdArgs = append(cmdArgs, "-n", namespace)
 	}
-	if dryRun {
+	switch dryRunStrategy {
+	case DryRunClient:
 		cmdArgs = append(cmdArgs, "--dry-run")
+	case DryRunServer:
+		cmdArgs = append(cmdArgs, "--server-dry-run")
 	}
 	cmd := exec.Command("kubectl", cmdArgs...)
 	if log.IsLevelEnabled(log.DebugLevel) {
@@ -367,6 +384,19 @@ func (k KubectlCmd) GetServerVersion(config *rest.Config) (string, error) {
 	return fmt.Sprintf("%s.%s", v.Major, v.Minor), nil
 }
 
+// GetAPIVersions returns the group versions served by the cluster, e.g. "v1" or "apps/v1"
+func (k KubectlCmd) GetAPIVersions(config *rest.Config) ([]string, error) {
+	client, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := client.ServerGroups()
+	if err != nil {
+		return nil, err
+	}
+	return metav1.ExtractGroupVersions(groups), nil
+}
+
 func (k KubectlCmd) SetOnKubectlRun(onKubectlRun func(command string) (util.Closer, error)) {
 	k.OnKubectlRun = onKubectlRun
 }
@@ -60,7 +60,7 @@ func TestRunKubectl(t *testing.T) {
 		},
 	}
 
-	_, _ = kubectl.runKubectl("/dev/null", "default", []string{"command-name"}, nil, false)
+	_, _ = kubectl.runKubectl("/dev/null", "default", []string{"command-name"}, nil, DryRunNone)
 	assert.True(t, callbackExecuted)
 	assert.True(t, closerExecuted)
 }
@@ -48,6 +48,7 @@ const (
 	CustomResourceDefinitionKind = "CustomResourceDefinition"
 	PodKind                      = "Pod"
 	APIServiceKind               = "APIService"
+	HorizontalPodAutoscalerKind  = "HorizontalPodAutoscaler"
 )
 
 type ResourceKey struct {
@@ -18,6 +18,7 @@ type KubectlOutput struct {
 
 type MockKubectlCmd struct {
 	APIResources []kube.APIResourceInfo
+	APIVersions  []string
 	Commands     map[string]KubectlOutput
 	Events       chan watch.Event
 	LastValidate bool
@@ -43,7 +44,7 @@ func (k *MockKubectlCmd) DeleteResource(config *rest.Config, gvk schema.GroupVer
 	return command.Err
 }
 
-func (k *MockKubectlCmd) ApplyResource(config *rest.Config, obj *unstructured.Unstructured, namespace string, dryRun, force, validate bool) (string, error) {
+func (k *MockKubectlCmd) ApplyResource(config *rest.Config, obj *unstructured.Unstructured, namespace string, dryRunStrategy kube.DryRunStrategy, force, validate bool) (string, error) {
 	k.LastValidate = validate
 	command, ok := k.Commands[obj.GetName()]
 	if !ok {
@@ -61,5 +62,9 @@ func (k *MockKubectlCmd) GetServerVersion(config *rest.Config) (string, error) {
 	return "", nil
 }
 
+func (k *MockKubectlCmd) GetAPIVersions(config *rest.Config) ([]string, error) {
+	return k.APIVersions, nil
+}
+
 func (k *MockKubectlCmd) SetOnKubectlRun(onKubectlRun func(command string) (util.Closer, error)) {
 }
@@ -2,14 +2,17 @@ package kustomize
 
 import (
 	"fmt"
+	"io/ioutil"
 	"net/url"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"regexp"
 	"sort"
 	"strings"
 
 	argoexec "github.com/argoproj/pkg/exec"
+	"github.com/ghodss/yaml"
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -162,6 +165,151 @@ func (k *kustomize) findKustomization() (string, error) {
 	return "", errors.New("did not find kustomization in " + k.path)
 }
 
+// kustomizationReferences holds the fields of a kustomization which refer to other files or directories
+type kustomizationReferences struct {
+	Bases                 []string         `json:"bases,omitempty"`
+	Resources             []string         `json:"resources,omitempty"`
+	Components            []string         `json:"components,omitempty"`
+	Crds                  []string         `json:"crds,omitempty"`
+	PatchesStrategicMerge []string         `json:"patchesStrategicMerge,omitempty"`
+	PatchesJson6902       []patchReference `json:"patchesJson6902,omitempty"`
+	Patches               []patchReference `json:"patches,omitempty"`
+	ConfigMapGenerator    []generatorArgs  `json:"configMapGenerator,omitempty"`
+	SecretGenerator       []generatorArgs  `json:"secretGenerator,omitempty"`
+}
+
+// patchReference is a patch of a kustomization, which is either inline or read from a file
+type patchReference struct {
+	Path string `json:"path,omitempty"`
+}
+
+// generatorArgs holds the fields of a config map or secret generator which refer to files
+type generatorArgs struct {
+	// Files are either paths or `key=path` pairs
+	Files []string `json:"files,omitempty"`
+	Envs  []string `json:"envs,omitempty"`
+	Env   string   `json:"env,omitempty"`
+}
+
+// remote returns the references which might be remote bases, i.e. the bases, resources and components
+func (refs *kustomizationReferences) remote() []string {
+	var res []string
+	for _, items := range [][]string{refs.Bases, refs.Resources, refs.Components} {
+		res = append(res, items...)
+	}
+	return res
+}
+
+// all returns all references, including the files of patches and generators, which are always local
+func (refs *kustomizationReferences) all() []string {
+	res := append(refs.remote(), refs.Crds...)
+	res = append(res, refs.PatchesStrategicMerge...)
+	for _, patches := range [][]patchReference{refs.PatchesJson6902, refs.Patches} {
+		for _, patch := range patches {
+			if patch.Path != "" {
+				res = append(res, patch.Path)
+			}
+		}
+	}
+	for _, generators := range [][]generatorArgs{refs.ConfigMapGenerator, refs.SecretGenerator} {
+		for _, generator := range generators {
+			for _, file := range generator.Files {
+				if i := strings.Index(file, "="); i >= 0 {
+					file = file[i+1:]
+				}
+				res = append(res, file)
+			}
+			res = append(res, generator.Envs...)
+			if generator.Env != "" {
+				res = append(res, generator.Env)
+			}
+		}
+	}
+	return res
+}
+
+func readReferences(path string) (*kustomizationReferences, error) {
+	k := &kustomize{path: path}
+	kustomization, err := k.findKustomization()
+	if err != nil {
+		return nil, nil
+	}
+	if info, err := os.Stat(kustomization); err != nil || !info.Mode().IsRegular() {
+		return nil, nil
+	}
+	data, err := ioutil.ReadFile(kustomization)
+	if err != nil {
+		return nil, err
+	}
+	var refs kustomizationReferences
+	if err := yaml.Unmarshal(data, &refs); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %v", kustomization, err)
+	}
+	return &refs, nil
+}
+
+// References returns the bases, resources, components, CRDs, the files of patches and the files of config map and
+// secret generators listed in the kustomization of the given directory, which are either paths relative to the
+// directory or remote URLs. Returns nil if the directory has no kustomization.
+func References(path string) ([]string, error) {
+	refs, err := readReferences(path)
+	if err != nil || refs == nil {
+		return nil, err
+	}
+	return refs.all(), nil
+}
+
+// RenderedSources returns the remote bases, with their revisions, of the kustomization of the given directory and of
+// its local bases
+func RenderedSources(path string) ([]v1alpha1.RenderedSource, error) {
+	unique := map[v1alpha1.RenderedSource]bool{}
+	visited := map[string]bool{}
+	queue := []string{path}
+	for len(queue) > 0 {
+		dir := queue[0]
+		queue = queue[1:]
+		if visited[dir] {
+			continue
+		}
+		visited[dir] = true
+		refs, err := readReferences(dir)
+		if err != nil {
+			return nil, err
+		}
+		if refs == nil {
+			continue
+		}
+		for _, ref := range refs.remote() {
+			// like kustomize, references which are not local files or directories are remote
+			if info, err := os.Stat(filepath.Join(dir, ref)); err == nil {
+				if info.IsDir() {
+					queue = append(queue, filepath.Join(dir, ref))
+				}
+				continue
+			}
+			source := v1alpha1.RenderedSource{Type: v1alpha1.ApplicationSourceTypeKustomize, Name: ref}
+			if i := strings.Index(ref, "?"); i >= 0 {
+				source.Name = ref[:i]
+				if query, err := url.ParseQuery(ref[i+1:]); err == nil {
+					source.Version = query.Get("ref")
+				}
+			}
+			unique[source] = true
+		}
+	}
+	var sources []v1alpha1.RenderedSource
+	for source := range unique {
+		sources = append(sources, source)
+	}
+	sort.Slice(sources, func(i, j int) bool {
+		if sources[i].Name != sources[j].Name {
+			return sources[i].Name < sources[j].Name
+		}
+		return sources[i].Version < sources[j].Version
+	})
+	return sources, nil
+}
+
 func IsKustomization(path string) bool {
 	for _, kustomization := range KustomizationNames {
 		if path == kustomization {
@@ -207,3 +355,23 @@ func getImages(object map[string]interface{}) []Image {
 	}
 	return images
 }
+
+var versionRegex = regexp.MustCompile(`v\d+\.\d+\.\d+`)
+
+// Version returns the version of kustomize, e.g. v3.1.0
+func Version() (string, error) {
+	out, err := argoexec.RunCommandExt(exec.Command("kustomize", "version"), config.CmdOpts())
+	if err != nil {
+		return "", fmt.Errorf("unable to determine kustomize version: %v", err)
+	}
+	return parseVersion(out), nil
+}
+
+// parseVersion returns the semantic version of the output of `kustomize version`, whose format differs between the
+// releases of kustomize, or else the whole output
+func parseVersion(out string) string {
+	if version := versionRegex.FindString(out); version != "" {
+		return version
+	}
+	return strings.TrimSpace(out)
+}
@@ -2,6 +2,7 @@ package kustomize
 
 import (
 	"io/ioutil"
+	"os"
 	"path"
 	"path/filepath"
 	"testing"
@@ -86,6 +87,71 @@ func testFindKustomization(t *testing.T, set string, expected string) {
 	assert.Equal(t, "testdata/"+set+"/"+expected, kustomization)
 }
 
+func TestReferences(t *testing.T) {
+	refs, err := References("testdata/" + kustomization1)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"./deployment.yaml", "./statefullset.yaml"}, refs)
+
+	refs, err = References("testdata")
+	assert.NoError(t, err)
+	assert.Nil(t, refs)
+}
+
+func TestReferences_PatchesAndGenerators(t *testing.T) {
+	dir, err := ioutil.TempDir("", "kustomize-references-test")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "kustomization.yaml"), []byte(`patchesJson6902:
+- target: {kind: Deployment, name: guestbook}
+  path: ../patches/replicas.yaml
+patches:
+- path: ../patches/image.yaml
+- patch: '[{"op": "remove", "path": "/spec/replicas"}]'
+configMapGenerator:
+- name: config
+  files:
+  - ../config/app.properties
+  - settings.json=../config/settings.json
+  envs:
+  - ../config/app.env
+secretGenerator:
+- name: secret
+  env: ../config/secret.env
+`), 0644))
+
+	refs, err := References(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"../patches/replicas.yaml",
+		"../patches/image.yaml",
+		"../config/app.properties",
+		"../config/settings.json",
+		"../config/app.env",
+		"../config/secret.env",
+	}, refs)
+}
+
+func TestRenderedSources(t *testing.T) {
+	dir, err := ioutil.TempDir("", "kustomize-rendered-sources-test")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	for file, content := range map[string]string{
+		"overlay/kustomization.yaml": "bases:\n- ../base\n- github.com/argoproj/argocd-example-apps//kustomize-guestbook?ref=v1.0.0\npatches:\n- path: missing.yaml\n",
+		"base/kustomization.yaml":    "resources:\n- deployment.yaml\n- github.com/argoproj/argo-cd//manifests/cluster-install\n",
+		"base/deployment.yaml":       "kind: Deployment\n",
+	} {
+		assert.NoError(t, os.MkdirAll(filepath.Join(dir, filepath.Dir(file)), 0755))
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, file), []byte(content), 0644))
+	}
+
+	sources, err := RenderedSources(filepath.Join(dir, "overlay"))
+	assert.NoError(t, err)
+	assert.Equal(t, []v1alpha1.RenderedSource{
+		{Type: v1alpha1.ApplicationSourceTypeKustomize, Name: "github.com/argoproj/argo-cd//manifests/cluster-install"},
+		{Type: v1alpha1.ApplicationSourceTypeKustomize, Name: "github.com/argoproj/argocd-example-apps//kustomize-guestbook", Version: "v1.0.0"},
+	}, sources)
+}
+
 func TestIsKustomization(t *testing.T) {
 	assert.True(t, IsKustomization("kustomization.yaml"))
 	assert.True(t, IsKustomization("kustomization.yml"))
@@ -97,3 +163,9 @@ func TestParseKustomizeBuildOptions(t *testing.T) {
 	built := parseKustomizeBuildOptions("guestbook", "-v 6 --logtostderr")
 	assert.Equal(t, []string{"build", "guestbook", "-v", "6", "--logtostderr"}, built)
 }
+
+func TestParseVersion(t *testing.T) {
+	assert.Equal(t, "v1.0.11", parseVersion("Version: {KustomizeVersion:v1.0.11 GitCommit:8f701a00417a812558a7b785e8354957afa469ae BuildDate:2018-12-04T18:42:24Z GoOs:linux GoArch:amd64}"))
+	assert.Equal(t, "v3.2.0", parseVersion("{Version:kustomize/v3.2.0 GitCommit:a3103f1e62ddb5b696daa3fd359bb6f2e8333532 BuildDate:2019-09-18T16:26:36Z GoOs:linux GoArch:amd64}"))
+	assert.Equal(t, "unknown", parseVersion("unknown\n"))
+}
@@ -0,0 +1,178 @@
+package profile
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// ControlPathPrefix is the path of the endpoints which control the profiler
+	ControlPathPrefix = "/debug/profiler/"
+
+	profilingPath = ControlPathPrefix + "profiling"
+	profilesPath  = ControlPathPrefix + "profiles/"
+)
+
+type profilingState struct {
+	Enabled bool `json:"enabled"`
+}
+
+type dumpResult struct {
+	Path string `json:"path"`
+}
+
+// controlHandler allows the API server to toggle profiling and to retrieve profiles of processes which do not serve
+// a gRPC API, such as the application controller
+type controlHandler struct {
+	profiler *Profiler
+	getToken func() (string, error)
+}
+
+// NewControlHandler returns a handler of the endpoints below ControlPathPrefix. Requests must be authenticated with
+// a bearer token which is shared with the API server.
+func NewControlHandler(profiler *Profiler, getToken func() (string, error)) http.Handler {
+	return &controlHandler{profiler: profiler, getToken: getToken}
+}
+
+func (h *controlHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	token, err := h.getToken()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if !IsAuthorized(token, r.Header.Get("Authorization")) {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	switch {
+	case r.URL.Path == profilingPath && r.Method == http.MethodPost:
+		var state profilingState
+		if err := json.NewDecoder(r.Body).Decode(&state); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		h.profiler.SetEnabled(state.Enabled)
+		writeJSON(w, profilingState{Enabled: h.profiler.Enabled()})
+	case strings.HasPrefix(r.URL.Path, profilesPath) && r.Method == http.MethodGet:
+		name := strings.TrimPrefix(r.URL.Path, profilesPath)
+		if r.URL.Query().Get("dump") == "true" {
+			path, err := h.profiler.Dump(name)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			writeJSON(w, dumpResult{Path: path})
+			return
+		}
+		data, err := h.profiler.Get(name)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/octet-stream")
+		_, _ = w.Write(data)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// IsAuthorized returns whether the given authorization header carries the bearer token. An empty token is never
+// accepted.
+func IsAuthorized(token string, authorization string) bool {
+	given := strings.TrimPrefix(authorization, "Bearer ")
+	return token != "" && subtle.ConstantTimeCompare([]byte(given), []byte(token)) == 1
+}
+
+func writeJSON(w http.ResponseWriter, obj interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(obj)
+}
+
+// Client calls the profiler control endpoints of a remote process
+type Client struct {
+	addr   string
+	token  string
+	client *http.Client
+}
+
+// NewClient returns a client of the profiler control endpoints served at the given address, e.g.
+// http://argocd-metrics:8082. The connections use the TLS configuration if it is not nil.
+func NewClient(addr string, token string, tlsConfig *tls.Config) *Client {
+	client := &http.Client{Timeout: 60 * time.Second}
+	if tlsConfig != nil {
+		client.Transport = &http.Transport{TLSClientConfig: tlsConfig}
+	}
+	return &Client{
+		addr:   strings.TrimSuffix(addr, "/"),
+		token:  token,
+		client: client,
+	}
+}
+
+// SetEnabled enables or disables profiling and returns whether profiling is enabled
+func (c *Client) SetEnabled(enabled bool) (bool, error) {
+	body, err := json.Marshal(profilingState{Enabled: enabled})
+	if err != nil {
+		return false, err
+	}
+	data, err := c.do(http.MethodPost, profilingPath, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	var state profilingState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return false, err
+	}
+	return state.Enabled, nil
+}
+
+// GetProfile returns the named profile, or dumps it to the profile directory of the remote process and returns the
+// path of the written file
+func (c *Client) GetProfile(name string, dump bool) ([]byte, string, error) {
+	path := profilesPath + url.PathEscape(name)
+	if dump {
+		path += "?dump=true"
+	}
+	data, err := c.do(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	if !dump {
+		return data, "", nil
+	}
+	var res dumpResult
+	if err := json.Unmarshal(data, &res); err != nil {
+		return nil, "", err
+	}
+	return nil, res.Path, nil
+}
+
+func (c *Client) do(method string, path string, body io.Reader) ([]byte, error) {
+	req, err := http.NewRequest(method, c.addr+path, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s %s: %s: %s", method, path, resp.Status, strings.TrimSpace(string(data)))
+	}
+	return data, nil
+}
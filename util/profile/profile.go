@@ -0,0 +1,138 @@
+package profile
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/pprof"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// PathPrefix is the path at which profiles are served while profiling is enabled
+	PathPrefix = "/debug/pprof/"
+
+	defaultCPUProfileSeconds = 30
+	maxCPUProfileSeconds     = 300
+)
+
+// Profiler serves and dumps the runtime profiles of the process (e.g. heap and goroutine profiles). Profiles are only
+// served over HTTP while profiling is enabled, so that they can be turned on in production when diagnosing issues.
+type Profiler struct {
+	enabled int32
+	// dir is the directory which profiles are dumped to, e.g. the mount path of a persistent volume
+	dir string
+}
+
+// NewProfiler returns a new profiler which dumps profiles to the given directory. Profiles cannot be dumped if the
+// directory is empty.
+func NewProfiler(dir string) *Profiler {
+	return &Profiler{dir: dir}
+}
+
+// SetEnabled enables or disables serving profiles over HTTP
+func (p *Profiler) SetEnabled(enabled bool) {
+	var value int32
+	if enabled {
+		value = 1
+	}
+	atomic.StoreInt32(&p.enabled, value)
+	log.Infof("Profiling enabled: %v", enabled)
+}
+
+// Enabled returns whether profiles are served over HTTP
+func (p *Profiler) Enabled() bool {
+	return atomic.LoadInt32(&p.enabled) == 1
+}
+
+// Get returns the named profile (e.g. 'heap' or 'goroutine') in the format understood by `go tool pprof`
+func (p *Profiler) Get(name string) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := writeProfile(&buf, name, 0); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Dump writes the named profile to the profile directory and returns the path of the written file
+func (p *Profiler) Dump(name string) (string, error) {
+	if p.dir == "" {
+		return "", fmt.Errorf("profiles cannot be dumped since no profile directory is configured")
+	}
+	data, err := p.Get(name)
+	if err != nil {
+		return "", err
+	}
+	hostname, _ := os.Hostname()
+	path := filepath.Join(p.dir, fmt.Sprintf("%s-%s-%s.pprof", hostname, name, time.Now().UTC().Format("20060102T150405Z")))
+	if err := ioutil.WriteFile(path, data, 0644); err != nil {
+		return "", err
+	}
+	log.Infof("Dumped %s profile to %s", name, path)
+	return path, nil
+}
+
+// ServeHTTP serves the profiles below PathPrefix while profiling is enabled, e.g. /debug/pprof/heap, or
+// /debug/pprof/profile?seconds=30 for a CPU profile. Goroutine stacks are returned as text with ?debug=2.
+func (p *Profiler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !p.Enabled() {
+		http.NotFound(w, r)
+		return
+	}
+	name := strings.TrimPrefix(r.URL.Path, PathPrefix)
+	debug, _ := strconv.Atoi(r.URL.Query().Get("debug"))
+	var err error
+	if name == "profile" {
+		seconds, _ := strconv.Atoi(r.URL.Query().Get("seconds"))
+		err = writeCPUProfile(w, seconds)
+	} else {
+		if debug > 0 {
+			w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		} else {
+			w.Header().Set("Content-Type", "application/octet-stream")
+		}
+		err = writeProfile(w, name, debug)
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+	}
+}
+
+// writeProfile writes the named profile. The heap profile is written after a garbage collection, so that it reflects
+// the live objects.
+func writeProfile(w io.Writer, name string, debug int) error {
+	profile := pprof.Lookup(name)
+	if profile == nil {
+		return fmt.Errorf("unknown profile '%s'", name)
+	}
+	if name == "heap" {
+		runtime.GC()
+	}
+	return profile.WriteTo(w, debug)
+}
+
+func writeCPUProfile(w http.ResponseWriter, seconds int) error {
+	if seconds <= 0 {
+		seconds = defaultCPUProfileSeconds
+	}
+	if seconds > maxCPUProfileSeconds {
+		seconds = maxCPUProfileSeconds
+	}
+	w.Header().Set("Content-Type", "application/octet-stream")
+	if err := pprof.StartCPUProfile(w); err != nil {
+		return err
+	}
+	time.Sleep(time.Duration(seconds) * time.Second)
+	pprof.StopCPUProfile()
+	return nil
+}
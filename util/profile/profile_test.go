@@ -0,0 +1,93 @@
+package profile
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProfiler_ServeHTTP(t *testing.T) {
+	profiler := NewProfiler("")
+	ts := httptest.NewServer(profiler)
+	defer ts.Close()
+
+	resp, err := http.Get(ts.URL + PathPrefix + "heap")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	profiler.SetEnabled(true)
+	resp, err = http.Get(ts.URL + PathPrefix + "goroutine?debug=2")
+	assert.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	data, err := ioutil.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), "goroutine")
+
+	resp, err = http.Get(ts.URL + PathPrefix + "unknown")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestProfiler_Dump(t *testing.T) {
+	_, err := NewProfiler("").Dump("heap")
+	assert.Error(t, err)
+
+	dir, err := ioutil.TempDir("", "profiles")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	path, err := NewProfiler(dir).Dump("heap")
+	assert.NoError(t, err)
+	assert.Equal(t, dir, filepath.Dir(path))
+	assert.True(t, strings.Contains(filepath.Base(path), "-heap-"))
+	info, err := os.Stat(path)
+	assert.NoError(t, err)
+	assert.True(t, info.Size() > 0)
+}
+
+func TestControlHandler(t *testing.T) {
+	profiler := NewProfiler("")
+	ts := httptest.NewServer(NewControlHandler(profiler, func() (string, error) {
+		return "token", nil
+	}))
+	defer ts.Close()
+
+	_, err := NewClient(ts.URL, "wrong", nil).SetEnabled(true)
+	assert.Error(t, err)
+	assert.False(t, profiler.Enabled())
+
+	client := NewClient(ts.URL, "token", nil)
+	enabled, err := client.SetEnabled(true)
+	assert.NoError(t, err)
+	assert.True(t, enabled)
+	assert.True(t, profiler.Enabled())
+
+	data, path, err := client.GetProfile("goroutine", false)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, data)
+	assert.Empty(t, path)
+
+	_, _, err = client.GetProfile("goroutine", true)
+	assert.Error(t, err)
+
+	enabled, err = client.SetEnabled(false)
+	assert.NoError(t, err)
+	assert.False(t, enabled)
+}
+
+func TestControlHandler_NoToken(t *testing.T) {
+	ts := httptest.NewServer(NewControlHandler(NewProfiler(""), func() (string, error) {
+		return "", nil
+	}))
+	defer ts.Close()
+
+	_, err := NewClient(ts.URL, "", nil).SetEnabled(true)
+	assert.Error(t, err)
+}
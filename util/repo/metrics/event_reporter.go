@@ -1,7 +1,11 @@
 package metrics
 
+import "time"
+
 type Reporter interface {
 	Event(repoURL, event string)
+	// Observe reports the duration and the outcome of a request which was previously reported by Event
+	Observe(repoURL, event string, duration time.Duration, err error)
 }
 
 var NopReporter = nopReporter{}
@@ -11,3 +15,6 @@ type nopReporter struct {
 
 func (n nopReporter) Event(repoURL, event string) {
 }
+
+func (n nopReporter) Observe(repoURL, event string, duration time.Duration, err error) {
+}
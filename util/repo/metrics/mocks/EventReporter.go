@@ -3,6 +3,7 @@
 package mocks
 
 import mock "github.com/stretchr/testify/mock"
+import time "time"
 
 // Reporter is an autogenerated mock type for the Reporter type
 type EventReporter struct {
@@ -13,3 +14,8 @@ type EventReporter struct {
 func (_m *EventReporter) Event(repoURL string, event string) {
 	_m.Called(repoURL, event)
 }
+
+// Observe provides a mock function with given fields: repoURL, event, duration, err
+func (_m *EventReporter) Observe(repoURL string, event string, duration time.Duration, err error) {
+	_m.Called(repoURL, event, duration, err)
+}
@@ -13,6 +13,66 @@ type Repo struct {
 	mock.Mock
 }
 
+// AppLockKey provides a mock function with given fields: resolvedRevision
+func (_m *Repo) AppLockKey(resolvedRevision string) string {
+	ret := _m.Called(resolvedRevision)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(resolvedRevision)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// ChangedFiles provides a mock function with given fields: resolvedRevision, targetResolvedRevision
+func (_m *Repo) ChangedFiles(resolvedRevision string, targetResolvedRevision string) ([]string, error) {
+	ret := _m.Called(resolvedRevision, targetResolvedRevision)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(string, string) []string); ok {
+		r0 = rf(resolvedRevision, targetResolvedRevision)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(resolvedRevision, targetResolvedRevision)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Commits provides a mock function with given fields: resolvedRevision, targetResolvedRevision
+func (_m *Repo) Commits(resolvedRevision string, targetResolvedRevision string) ([]repo.Commit, error) {
+	ret := _m.Called(resolvedRevision, targetResolvedRevision)
+
+	var r0 []repo.Commit
+	if rf, ok := ret.Get(0).(func(string, string) []repo.Commit); ok {
+		r0 = rf(resolvedRevision, targetResolvedRevision)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repo.Commit)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(resolvedRevision, targetResolvedRevision)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetApp provides a mock function with given fields: app, resolvedRevision
 func (_m *Repo) GetApp(app string, resolvedRevision string) (string, error) {
 	ret := _m.Called(app, resolvedRevision)
@@ -11,10 +11,20 @@ type RevisionMetadata struct {
 	Message string
 }
 
+type Commit struct {
+	Revision string
+	Author   string
+	Date     time.Time
+	Message  string
+}
+
 // Repo is a generic repo client interface
 type Repo interface {
 	// return a key suitable for use for locking this object
 	LockKey() string
+	// return a key suitable for locking the checkout of a resolved revision while its files are used, which may equal
+	// LockKey if all revisions share the same checkout
+	AppLockKey(resolvedRevision string) string
 	// init
 	Init() error
 	// list apps for an ambiguous revision,
@@ -25,4 +35,21 @@ type Repo interface {
 	GetApp(app, resolvedRevision string) (path string, err error)
 	// return the revision meta-data for the checked out code
 	RevisionMetadata(app, resolvedRevision string) (*RevisionMetadata, error)
+	// return the files, relative to the root of the repository, which differ between two resolved revisions
+	ChangedFiles(resolvedRevision, targetResolvedRevision string) ([]string, error)
+	// return the commits between two resolved revisions, newest first
+	Commits(resolvedRevision, targetResolvedRevision string) ([]Commit, error)
+}
+
+// ReleasableRepo is implemented by repos which keep the checkouts which they use from being removed until they are
+// released
+type ReleasableRepo interface {
+	// release the checkouts which were used, so that they may be removed
+	Release()
+}
+
+// SparseRepo is implemented by repos which are able to check out only some paths of a revision
+type SparseRepo interface {
+	// checkout an app and the given paths, relative to the root of the repo, which the app depends on
+	GetSparseApp(app, resolvedRevision string, paths []string) (path string, err error)
 }
@@ -0,0 +1,83 @@
+package resource
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/ghodss/yaml"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/kubernetes/pkg/kubectl/scheme"
+)
+
+// parsePatch converts the patch to JSON and decodes the operations if it is a JSON6902 patch. The operations are nil if
+// it is a merge patch.
+func parsePatch(patch string) ([]byte, jsonpatch.Patch, error) {
+	patchBytes, err := yaml.YAMLToJSON([]byte(patch))
+	if err != nil {
+		return nil, nil, err
+	}
+	if bytes.HasPrefix(bytes.TrimSpace(patchBytes), []byte("[")) {
+		operations, err := jsonpatch.DecodePatch(patchBytes)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, operation := range operations {
+			var op string
+			if raw, ok := operation["op"]; ok && raw != nil {
+				_ = json.Unmarshal(*raw, &op)
+			}
+			switch op {
+			case "add", "remove", "replace", "move", "copy", "test":
+			default:
+				return nil, nil, fmt.Errorf("unknown patch operation '%s'", op)
+			}
+		}
+		return patchBytes, operations, nil
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(patchBytes, &fields); err != nil {
+		return nil, nil, fmt.Errorf("patch is neither a list of operations nor an object: %v", err)
+	}
+	if fields == nil {
+		return nil, nil, fmt.Errorf("patch is empty")
+	}
+	return patchBytes, nil, nil
+}
+
+// ValidatePatch returns an error if the patch is neither a JSON6902 patch nor a strategic merge patch
+func ValidatePatch(patch string) error {
+	_, _, err := parsePatch(patch)
+	return err
+}
+
+// ApplyPatch applies a JSON6902 or strategic merge patch, in JSON or YAML, to the object. Merge patches of kinds
+// without a known schema, e.g. custom resources, are applied as JSON merge patches.
+func ApplyPatch(obj *unstructured.Unstructured, patch string) (*unstructured.Unstructured, error) {
+	patchBytes, operations, err := parsePatch(patch)
+	if err != nil {
+		return nil, err
+	}
+	objBytes, err := json.Marshal(obj.Object)
+	if err != nil {
+		return nil, err
+	}
+	var patchedBytes []byte
+	if operations != nil {
+		patchedBytes, err = operations.Apply(objBytes)
+	} else if versionedObject, schemeErr := scheme.Scheme.New(obj.GroupVersionKind()); schemeErr == nil {
+		patchedBytes, err = strategicpatch.StrategicMergePatch(objBytes, patchBytes, versionedObject)
+	} else {
+		patchedBytes, err = jsonpatch.MergePatch(objBytes, patchBytes)
+	}
+	if err != nil {
+		return nil, err
+	}
+	var patched unstructured.Unstructured
+	if err := json.Unmarshal(patchedBytes, &patched); err != nil {
+		return nil, err
+	}
+	return &patched, nil
+}
@@ -0,0 +1,49 @@
+package resource
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/test"
+)
+
+func TestValidatePatch(t *testing.T) {
+	assert.NoError(t, ValidatePatch(`{"metadata": {"labels": {"foo": "bar"}}}`))
+	assert.NoError(t, ValidatePatch("- op: remove\n  path: /spec"))
+	assert.Error(t, ValidatePatch(""))
+	assert.Error(t, ValidatePatch("foo"))
+	assert.Error(t, ValidatePatch(`[{"op": "foo"}]`))
+}
+
+func TestApplyPatch(t *testing.T) {
+	t.Run("JSON6902", func(t *testing.T) {
+		patched, err := ApplyPatch(test.NewPod(), `[{"op": "replace", "path": "/spec/containers/0/image", "value": "nginx:1.8"}]`)
+		assert.NoError(t, err)
+		containers, _, _ := unstructured.NestedSlice(patched.Object, "spec", "containers")
+		assert.Equal(t, "nginx:1.8", containers[0].(map[string]interface{})["image"])
+	})
+	t.Run("StrategicMerge", func(t *testing.T) {
+		// containers are merged by name, rather than replaced
+		patched, err := ApplyPatch(test.NewPod(), "spec:\n  containers:\n  - name: sidecar\n    image: busybox")
+		assert.NoError(t, err)
+		containers, _, _ := unstructured.NestedSlice(patched.Object, "spec", "containers")
+		assert.Len(t, containers, 2)
+	})
+	t.Run("MergeOfUnknownKind", func(t *testing.T) {
+		obj := &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "example.com/v1",
+			"kind":       "Foo",
+			"metadata":   map[string]interface{}{"name": "my-foo"},
+			"spec":       map[string]interface{}{"items": []interface{}{"a"}, "replicas": int64(1)},
+		}}
+		patched, err := ApplyPatch(obj, `{"spec": {"items": ["b"]}}`)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{"items": []interface{}{"b"}, "replicas": int64(1)}, patched.Object["spec"])
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := ApplyPatch(test.NewPod(), `[{"op": "remove", "path": "/status"}]`)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,96 @@
+package secrets
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/argoproj/argo-cd/util/aws"
+)
+
+const awsNotFoundType = "ResourceNotFoundException"
+
+// AWSConfig configures the AWS Secrets Manager backend. Credentials are taken from the AWS_ACCESS_KEY_ID,
+// AWS_SECRET_ACCESS_KEY and AWS_SESSION_TOKEN environment variables.
+type AWSConfig struct {
+	// Region is the AWS region of the secrets, e.g. us-east-1
+	Region string `json:"region"`
+	// Prefix is prepended to the names of the secrets. Defaults to 'argocd/'.
+	Prefix string `json:"prefix,omitempty"`
+	// Endpoint overrides the endpoint of the Secrets Manager API
+	Endpoint string `json:"endpoint,omitempty"`
+}
+
+type awsBackend struct {
+	config AWSConfig
+	client *aws.Client
+}
+
+// NewAWSBackend returns a backend which stores credentials in AWS Secrets Manager. Each secret is stored as a JSON
+// object in the secret string.
+func NewAWSBackend(config AWSConfig) Backend {
+	if config.Prefix == "" {
+		config.Prefix = "argocd/"
+	}
+	return &awsBackend{
+		config: config,
+		client: aws.NewClient("secretsmanager", "secretsmanager.", config.Region, config.Endpoint),
+	}
+}
+
+func (b *awsBackend) Get(name string) (map[string][]byte, error) {
+	var out struct {
+		SecretString string `json:"SecretString"`
+	}
+	err := b.client.Call("GetSecretValue", map[string]string{"SecretId": b.config.Prefix + name}, &out)
+	if err != nil {
+		if aws.IsErrorType(err, awsNotFoundType) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	values := make(map[string]string)
+	if out.SecretString != "" {
+		if err := json.Unmarshal([]byte(out.SecretString), &values); err != nil {
+			return nil, fmt.Errorf("secret %s is not a JSON object: %v", name, err)
+		}
+	}
+	data := make(map[string][]byte)
+	for k, v := range values {
+		data[k] = []byte(v)
+	}
+	return data, nil
+}
+
+func (b *awsBackend) Update(name string, update map[string][]byte) error {
+	data, err := b.Get(name)
+	exists := true
+	if err == ErrNotFound {
+		exists = false
+	} else if err != nil {
+		return err
+	}
+	data = merge(data, update)
+	id := b.config.Prefix + name
+	if len(data) == 0 {
+		if !exists {
+			return nil
+		}
+		err = b.client.Call("DeleteSecret", map[string]interface{}{"SecretId": id, "ForceDeleteWithoutRecovery": true}, nil)
+		if aws.IsErrorType(err, awsNotFoundType) {
+			return nil
+		}
+		return err
+	}
+	values := make(map[string]string)
+	for k, v := range data {
+		values[k] = string(v)
+	}
+	secretString, err := json.Marshal(values)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return b.client.Call("PutSecretValue", map[string]string{"SecretId": id, "SecretString": string(secretString)}, nil)
+	}
+	return b.client.Call("CreateSecret", map[string]string{"Name": id, "SecretString": string(secretString)}, nil)
+}
@@ -0,0 +1,58 @@
+package secrets
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAWSBackend(t *testing.T) {
+	secrets := make(map[string]string)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.True(t, strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential="))
+		var in map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&in))
+		notFound := func() {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"__type":"ResourceNotFoundException","message":"not found"}`))
+		}
+		switch r.Header.Get("X-Amz-Target") {
+		case "secretsmanager.GetSecretValue":
+			value, ok := secrets[in["SecretId"].(string)]
+			if !ok {
+				notFound()
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]string{"SecretString": value})
+		case "secretsmanager.CreateSecret":
+			secrets[in["Name"].(string)] = in["SecretString"].(string)
+		case "secretsmanager.PutSecretValue":
+			secrets[in["SecretId"].(string)] = in["SecretString"].(string)
+		case "secretsmanager.DeleteSecret":
+			assert.Equal(t, true, in["ForceDeleteWithoutRecovery"])
+			delete(secrets, in["SecretId"].(string))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+	backend := NewAWSBackend(AWSConfig{Region: "us-east-1", Endpoint: server.URL})
+
+	_, err := backend.Get("repo")
+	assert.Equal(t, ErrNotFound, err)
+
+	assert.NoError(t, backend.Update("repo", map[string][]byte{"password": []byte("foo")}))
+	assert.Equal(t, `{"password":"foo"}`, secrets["argocd/repo"])
+
+	assert.NoError(t, backend.Update("repo", map[string][]byte{"username": []byte("admin")}))
+	data, err := backend.Get("repo")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"username": []byte("admin"), "password": []byte("foo")}, data)
+
+	assert.NoError(t, backend.Update("repo", map[string][]byte{"username": nil, "password": nil}))
+	assert.Empty(t, secrets)
+}
@@ -0,0 +1,100 @@
+package secrets
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"k8s.io/client-go/kubernetes"
+	v1listers "k8s.io/client-go/listers/core/v1"
+)
+
+// ErrNotFound is returned by backends if the requested secret does not exist
+var ErrNotFound = errors.New("secret not found")
+
+const (
+	// BackendTypeKubernetes stores credentials in Kubernetes secrets of the Argo CD namespace
+	BackendTypeKubernetes = "kubernetes"
+	// BackendTypeVault stores credentials in the KV secrets engine (version 2) of HashiCorp Vault
+	BackendTypeVault = "vault"
+	// BackendTypeAWS stores credentials in AWS Secrets Manager
+	BackendTypeAWS = "aws"
+
+	defaultCacheExpiration = 5 * time.Minute
+)
+
+// Backend stores the sensitive data of repository and cluster credentials, such as passwords, tokens and SSH keys
+type Backend interface {
+	// Get returns the data of the named secret, or ErrNotFound if it does not exist
+	Get(name string) (map[string][]byte, error)
+	// Update sets the given keys of the named secret, and removes the keys which are given empty values. Other keys
+	// are left untouched. A secret which has no keys left is deleted, unless it was not created by Argo CD.
+	Update(name string, data map[string][]byte) error
+}
+
+// Config is the configuration of the secrets backend, which is set in the argocd-cm ConfigMap
+type Config struct {
+	// Type is one of 'kubernetes' (the default), 'vault' or 'aws'
+	Type string `json:"type,omitempty"`
+	// CacheExpiration is how long credentials of external backends are cached, e.g. '5m'
+	CacheExpiration string `json:"cacheExpiration,omitempty"`
+	// Vault configures the HashiCorp Vault backend
+	Vault *VaultConfig `json:"vault,omitempty"`
+	// AWS configures the AWS Secrets Manager backend
+	AWS *AWSConfig `json:"aws,omitempty"`
+}
+
+// IsExternal returns whether credentials are stored outside of the Argo CD namespace
+func (c *Config) IsExternal() bool {
+	return c != nil && c.Type != "" && c.Type != BackendTypeKubernetes
+}
+
+// NewBackend creates the backend of the given configuration. Credentials of external backends are cached.
+func NewBackend(config *Config, clientset kubernetes.Interface, namespace string, secretsLister func() (v1listers.SecretLister, error)) (Backend, error) {
+	if !config.IsExternal() {
+		return NewKubernetesBackend(clientset, namespace, secretsLister), nil
+	}
+	expiration := defaultCacheExpiration
+	if config.CacheExpiration != "" {
+		var err error
+		expiration, err = time.ParseDuration(config.CacheExpiration)
+		if err != nil {
+			return nil, fmt.Errorf("invalid secrets backend cache expiration: %v", err)
+		}
+		if expiration <= 0 {
+			return nil, fmt.Errorf("invalid secrets backend cache expiration: must be positive")
+		}
+	}
+	var backend Backend
+	switch config.Type {
+	case BackendTypeVault:
+		if config.Vault == nil {
+			return nil, fmt.Errorf("vault secrets backend is not configured")
+		}
+		backend = NewVaultBackend(*config.Vault)
+	case BackendTypeAWS:
+		if config.AWS == nil {
+			return nil, fmt.Errorf("aws secrets backend is not configured")
+		}
+		backend = NewAWSBackend(*config.AWS)
+	default:
+		return nil, fmt.Errorf("unknown secrets backend type '%s'", config.Type)
+	}
+	return NewCachingBackend(backend, expiration), nil
+}
+
+// merge applies an update to the data of a secret
+func merge(data map[string][]byte, update map[string][]byte) map[string][]byte {
+	res := make(map[string][]byte)
+	for k, v := range data {
+		res[k] = v
+	}
+	for k, v := range update {
+		if len(v) == 0 {
+			delete(res, k)
+		} else {
+			res[k] = v
+		}
+	}
+	return res
+}
@@ -0,0 +1,112 @@
+package secrets
+
+import (
+	"reflect"
+	"sync"
+	"time"
+)
+
+type cacheEntry struct {
+	data      map[string][]byte
+	expiresAt time.Time
+}
+
+// CachingBackend caches the secrets of an external backend, so that credentials are not fetched on every request.
+// Rotation hooks are notified when a refresh returns different data than the cached one.
+type CachingBackend struct {
+	backend    Backend
+	expiration time.Duration
+	entries    map[string]cacheEntry
+	hooks      map[int]func(name string)
+	nextHookID int
+	lock       sync.Mutex
+	now        func() time.Time
+}
+
+// NewCachingBackend returns a backend which caches secrets of the given backend for the given duration
+func NewCachingBackend(backend Backend, expiration time.Duration) *CachingBackend {
+	return &CachingBackend{
+		backend:    backend,
+		expiration: expiration,
+		entries:    make(map[string]cacheEntry),
+		hooks:      make(map[int]func(name string)),
+		now:        time.Now,
+	}
+}
+
+func (c *CachingBackend) Get(name string) (map[string][]byte, error) {
+	c.lock.Lock()
+	entry, ok := c.entries[name]
+	c.lock.Unlock()
+	if ok && c.now().Before(entry.expiresAt) {
+		return entry.data, nil
+	}
+	return c.fetch(name)
+}
+
+// fetch gets the secret from the backend, caches it and notifies the rotation hooks if it has changed
+func (c *CachingBackend) fetch(name string) (map[string][]byte, error) {
+	data, err := c.backend.Get(name)
+	if err != nil && err != ErrNotFound {
+		return nil, err
+	}
+	c.lock.Lock()
+	previous, cached := c.entries[name]
+	if err == ErrNotFound {
+		delete(c.entries, name)
+	} else {
+		c.entries[name] = cacheEntry{data: data, expiresAt: c.now().Add(c.expiration)}
+	}
+	var hooks []func(name string)
+	if cached && !reflect.DeepEqual(previous.data, data) {
+		for _, hook := range c.hooks {
+			hooks = append(hooks, hook)
+		}
+	}
+	c.lock.Unlock()
+	for _, hook := range hooks {
+		hook(name)
+	}
+	return data, err
+}
+
+func (c *CachingBackend) Update(name string, data map[string][]byte) error {
+	err := c.backend.Update(name, data)
+	c.lock.Lock()
+	delete(c.entries, name)
+	c.lock.Unlock()
+	return err
+}
+
+// Expiration returns how long secrets are cached
+func (c *CachingBackend) Expiration() time.Duration {
+	return c.expiration
+}
+
+// OnRotate registers a hook which is called with the name of a cached secret whenever its data has changed in the
+// backend. The returned function unregisters the hook.
+func (c *CachingBackend) OnRotate(hook func(name string)) func() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	id := c.nextHookID
+	c.nextHookID++
+	c.hooks[id] = hook
+	return func() {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+		delete(c.hooks, id)
+	}
+}
+
+// Refresh fetches all cached secrets from the backend again, so that rotated credentials are picked up
+func (c *CachingBackend) Refresh() {
+	c.lock.Lock()
+	names := make([]string, 0, len(c.entries))
+	for name := range c.entries {
+		names = append(names, name)
+	}
+	c.lock.Unlock()
+	for _, name := range names {
+		_, _ = c.fetch(name)
+	}
+}
@@ -0,0 +1,85 @@
+package secrets
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeBackend struct {
+	secrets map[string]map[string][]byte
+	gets    int
+}
+
+func (b *fakeBackend) Get(name string) (map[string][]byte, error) {
+	b.gets++
+	data, ok := b.secrets[name]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return data, nil
+}
+
+func (b *fakeBackend) Update(name string, data map[string][]byte) error {
+	b.secrets[name] = merge(b.secrets[name], data)
+	if len(b.secrets[name]) == 0 {
+		delete(b.secrets, name)
+	}
+	return nil
+}
+
+func TestCachingBackend_Get(t *testing.T) {
+	backend := &fakeBackend{secrets: map[string]map[string][]byte{"repo": {"password": []byte("foo")}}}
+	now := time.Now()
+	cache := NewCachingBackend(backend, time.Minute)
+	cache.now = func() time.Time { return now }
+
+	data, err := cache.Get("repo")
+	assert.NoError(t, err)
+	assert.Equal(t, "foo", string(data["password"]))
+	_, _ = cache.Get("repo")
+	assert.Equal(t, 1, backend.gets)
+
+	now = now.Add(2 * time.Minute)
+	_, _ = cache.Get("repo")
+	assert.Equal(t, 2, backend.gets)
+
+	_, err = cache.Get("missing")
+	assert.Equal(t, ErrNotFound, err)
+}
+
+func TestCachingBackend_Update(t *testing.T) {
+	backend := &fakeBackend{secrets: map[string]map[string][]byte{"repo": {"password": []byte("foo")}}}
+	cache := NewCachingBackend(backend, time.Minute)
+
+	_, _ = cache.Get("repo")
+	assert.NoError(t, cache.Update("repo", map[string][]byte{"password": []byte("bar")}))
+	data, err := cache.Get("repo")
+	assert.NoError(t, err)
+	assert.Equal(t, "bar", string(data["password"]))
+}
+
+func TestCachingBackend_OnRotate(t *testing.T) {
+	backend := &fakeBackend{secrets: map[string]map[string][]byte{"repo": {"password": []byte("foo")}}}
+	cache := NewCachingBackend(backend, time.Minute)
+	var rotated []string
+	unsubscribe := cache.OnRotate(func(name string) {
+		rotated = append(rotated, name)
+	})
+
+	_, _ = cache.Get("repo")
+	cache.Refresh()
+	assert.Empty(t, rotated)
+
+	backend.secrets["repo"] = map[string][]byte{"password": []byte("bar")}
+	cache.Refresh()
+	assert.Equal(t, []string{"repo"}, rotated)
+	data, _ := cache.Get("repo")
+	assert.Equal(t, "bar", string(data["password"]))
+
+	unsubscribe()
+	backend.secrets["repo"] = map[string][]byte{"password": []byte("baz")}
+	cache.Refresh()
+	assert.Equal(t, []string{"repo"}, rotated)
+}
@@ -0,0 +1,76 @@
+package secrets
+
+import (
+	apiv1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	v1listers "k8s.io/client-go/listers/core/v1"
+
+	"github.com/argoproj/argo-cd/common"
+)
+
+type kubernetesBackend struct {
+	clientset     kubernetes.Interface
+	namespace     string
+	secretsLister func() (v1listers.SecretLister, error)
+}
+
+// NewKubernetesBackend returns a backend which stores credentials in Kubernetes secrets of the given namespace.
+// Secrets are read from the informer cache of the given lister.
+func NewKubernetesBackend(clientset kubernetes.Interface, namespace string, secretsLister func() (v1listers.SecretLister, error)) Backend {
+	return &kubernetesBackend{clientset: clientset, namespace: namespace, secretsLister: secretsLister}
+}
+
+func (b *kubernetesBackend) Get(name string) (map[string][]byte, error) {
+	lister, err := b.secretsLister()
+	if err != nil {
+		return nil, err
+	}
+	secret, err := lister.Secrets(b.namespace).Get(name)
+	if err != nil {
+		if apierr.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	if secret.Data == nil {
+		return map[string][]byte{}, nil
+	}
+	return secret.Data, nil
+}
+
+func (b *kubernetesBackend) Update(name string, data map[string][]byte) error {
+	secrets := b.clientset.CoreV1().Secrets(b.namespace)
+	secret, err := secrets.Get(name, metav1.GetOptions{})
+	if err != nil {
+		if !apierr.IsNotFound(err) {
+			return err
+		}
+		data = merge(nil, data)
+		if len(data) == 0 {
+			return nil
+		}
+		_, err = secrets.Create(&apiv1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+				Annotations: map[string]string{
+					common.AnnotationKeyManagedBy: common.AnnotationValueManagedByArgoCD,
+				},
+			},
+			Data: data,
+		})
+		return err
+	}
+	secret.Data = merge(secret.Data, data)
+	if len(secret.Data) == 0 {
+		isManagedByArgo := (secret.Annotations != nil && secret.Annotations[common.AnnotationKeyManagedBy] == common.AnnotationValueManagedByArgoCD) ||
+			(secret.Labels != nil && secret.Labels[common.LabelKeySecretType] == "repository")
+		if isManagedByArgo {
+			return secrets.Delete(name, &metav1.DeleteOptions{})
+		}
+		return nil
+	}
+	_, err = secrets.Update(secret)
+	return err
+}
@@ -0,0 +1,65 @@
+package secrets
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	v1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-cd/common"
+)
+
+const testNamespace = "default"
+
+func newTestKubernetesBackend(objects ...*apiv1.Secret) (Backend, *fake.Clientset) {
+	clientset := fake.NewSimpleClientset()
+	for _, obj := range objects {
+		_, _ = clientset.CoreV1().Secrets(testNamespace).Create(obj)
+	}
+	lister := func() (v1listers.SecretLister, error) {
+		indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+		list, err := clientset.CoreV1().Secrets(testNamespace).List(metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for i := range list.Items {
+			_ = indexer.Add(&list.Items[i])
+		}
+		return v1listers.NewSecretLister(indexer), nil
+	}
+	return NewKubernetesBackend(clientset, testNamespace, lister), clientset
+}
+
+func TestKubernetesBackend(t *testing.T) {
+	backend, clientset := newTestKubernetesBackend()
+
+	_, err := backend.Get("repo")
+	assert.Equal(t, ErrNotFound, err)
+
+	assert.NoError(t, backend.Update("repo", map[string][]byte{"password": []byte("foo")}))
+	secret, err := clientset.CoreV1().Secrets(testNamespace).Get("repo", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, common.AnnotationValueManagedByArgoCD, secret.Annotations[common.AnnotationKeyManagedBy])
+	data, err := backend.Get("repo")
+	assert.NoError(t, err)
+	assert.Equal(t, "foo", string(data["password"]))
+
+	assert.NoError(t, backend.Update("repo", map[string][]byte{"password": nil}))
+	_, err = backend.Get("repo")
+	assert.Equal(t, ErrNotFound, err)
+}
+
+func TestKubernetesBackend_UnmanagedSecret(t *testing.T) {
+	backend, clientset := newTestKubernetesBackend(&apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "repo", Namespace: testNamespace},
+		Data:       map[string][]byte{"password": []byte("foo")},
+	})
+
+	assert.NoError(t, backend.Update("repo", map[string][]byte{"password": nil}))
+	_, err := clientset.CoreV1().Secrets(testNamespace).Get("repo", metav1.GetOptions{})
+	assert.NoError(t, err)
+}
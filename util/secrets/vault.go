@@ -0,0 +1,84 @@
+package secrets
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/argoproj/argo-cd/util/vault"
+)
+
+// VaultConfig configures the HashiCorp Vault backend
+type VaultConfig struct {
+	// Address is the URL of the Vault server, e.g. https://vault:8200
+	Address string `json:"address"`
+	// Mount is the mount path of the KV secrets engine (version 2). Defaults to 'secret'.
+	Mount string `json:"mount,omitempty"`
+	// Path is the path below the mount where credentials are stored. Defaults to 'argocd'.
+	Path string `json:"path,omitempty"`
+	// Role is the role used to log in using the Kubernetes auth method. The VAULT_TOKEN environment variable is
+	// used if no role is set.
+	Role string `json:"role,omitempty"`
+	// AuthPath is the mount path of the Kubernetes auth method. Defaults to 'kubernetes'.
+	AuthPath string `json:"authPath,omitempty"`
+}
+
+type vaultBackend struct {
+	config VaultConfig
+	client *vault.Client
+}
+
+// NewVaultBackend returns a backend which stores credentials in the KV secrets engine of HashiCorp Vault
+func NewVaultBackend(config VaultConfig) Backend {
+	if config.Mount == "" {
+		config.Mount = "secret"
+	}
+	if config.Path == "" {
+		config.Path = "argocd"
+	}
+	return &vaultBackend{
+		config: config,
+		client: vault.NewClient(config.Address, config.Role, config.AuthPath),
+	}
+}
+
+func (b *vaultBackend) secretPath(kind string, name string) string {
+	return fmt.Sprintf("/v1/%s/%s/%s/%s", b.config.Mount, kind, b.config.Path, name)
+}
+
+func (b *vaultBackend) Get(name string) (map[string][]byte, error) {
+	var secret struct {
+		Data map[string]string `json:"data"`
+	}
+	found, err := b.client.Read(b.secretPath("data", name), &secret)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ErrNotFound
+	}
+	data := make(map[string][]byte)
+	for k, v := range secret.Data {
+		data[k] = []byte(v)
+	}
+	return data, nil
+}
+
+func (b *vaultBackend) Update(name string, update map[string][]byte) error {
+	data, err := b.Get(name)
+	if err == ErrNotFound {
+		data = nil
+	} else if err != nil {
+		return err
+	}
+	data = merge(data, update)
+	if len(data) == 0 {
+		_, err = b.client.Write(http.MethodDelete, b.secretPath("metadata", name), nil, nil)
+		return err
+	}
+	values := make(map[string]string)
+	for k, v := range data {
+		values[k] = string(v)
+	}
+	_, err = b.client.Write(http.MethodPost, b.secretPath("data", name), map[string]interface{}{"data": values}, nil)
+	return err
+}
@@ -0,0 +1,76 @@
+package secrets
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newFakeVault returns a server implementing the KV version 2 API and the Kubernetes login of Vault
+func newFakeVault(t *testing.T) (*httptest.Server, map[string]map[string]string) {
+	secrets := make(map[string]map[string]string)
+	var lock sync.Mutex
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		if r.URL.Path == "/v1/auth/kubernetes/login" {
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"auth": map[string]string{"client_token": "token"}})
+			return
+		}
+		if r.Header.Get("X-Vault-Token") != "token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		switch {
+		case strings.HasPrefix(r.URL.Path, "/v1/secret/data/argocd/"):
+			name := strings.TrimPrefix(r.URL.Path, "/v1/secret/data/argocd/")
+			if r.Method == http.MethodGet {
+				data, ok := secrets[name]
+				if !ok {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]interface{}{"data": data}})
+				return
+			}
+			var body struct {
+				Data map[string]string `json:"data"`
+			}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			secrets[name] = body.Data
+		case strings.HasPrefix(r.URL.Path, "/v1/secret/metadata/argocd/") && r.Method == http.MethodDelete:
+			delete(secrets, strings.TrimPrefix(r.URL.Path, "/v1/secret/metadata/argocd/"))
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	return server, secrets
+}
+
+func TestVaultBackend(t *testing.T) {
+	server, secrets := newFakeVault(t)
+	defer server.Close()
+	backend := NewVaultBackend(VaultConfig{Address: server.URL, Role: "argocd"}).(*vaultBackend)
+	backend.client.ReadJWT = func() ([]byte, error) { return []byte("jwt"), nil }
+
+	_, err := backend.Get("repo")
+	assert.Equal(t, ErrNotFound, err)
+
+	assert.NoError(t, backend.Update("repo", map[string][]byte{"username": []byte("admin"), "password": []byte("foo")}))
+	assert.Equal(t, map[string]string{"username": "admin", "password": "foo"}, secrets["repo"])
+
+	assert.NoError(t, backend.Update("repo", map[string][]byte{"password": nil}))
+	data, err := backend.Get("repo")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"username": []byte("admin")}, data)
+
+	assert.NoError(t, backend.Update("repo", map[string][]byte{"username": nil}))
+	_, ok := secrets["repo"]
+	assert.False(t, ok)
+}
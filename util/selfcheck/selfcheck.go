@@ -0,0 +1,174 @@
+package selfcheck
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-cd/util/cache"
+	"github.com/argoproj/argo-cd/util/settings"
+)
+
+// Check is a verification of the environment of a component, which runs at its startup
+type Check struct {
+	Name string
+	// Fatal checks detect misconfigurations which prevent the component from working at all, rather than degrading it
+	Fatal bool
+	Run   func() error
+}
+
+// Result is the result of a check
+type Result struct {
+	Name  string
+	Fatal bool
+	Err   error
+}
+
+// Report is the result of the checks of a component
+type Report struct {
+	Component string
+	Results   []Result
+}
+
+// Run runs the checks of the component and returns their report
+func Run(component string, checks []Check) *Report {
+	report := &Report{Component: component}
+	for _, check := range checks {
+		report.Results = append(report.Results, Result{Name: check.Name, Fatal: check.Fatal, Err: check.Run()})
+	}
+	return report
+}
+
+// FatalErrors returns the errors of the failed fatal checks
+func (r *Report) FatalErrors() []string {
+	var errs []string
+	for _, res := range r.Results {
+		if res.Fatal && res.Err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", res.Name, res.Err))
+		}
+	}
+	return errs
+}
+
+// Err returns an error which lists the failed fatal checks, or nil if they all passed
+func (r *Report) Err() error {
+	if errs := r.FatalErrors(); len(errs) > 0 {
+		return fmt.Errorf("%s self-check failed: %s", r.Component, strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// Log logs a line per check and a summary line. Failed checks are logged as errors if fatal and as warnings otherwise.
+func (r *Report) Log() {
+	failed := 0
+	for _, res := range r.Results {
+		logCtx := log.WithFields(log.Fields{"component": r.Component, "check": res.Name, "fatal": res.Fatal})
+		switch {
+		case res.Err == nil:
+			logCtx.WithField("status", "passed").Info("Self-check passed")
+		case res.Fatal:
+			failed++
+			logCtx.WithField("status", "failed").Errorf("Self-check failed: %v", res.Err)
+		default:
+			failed++
+			logCtx.WithField("status", "failed").Warnf("Self-check failed: %v", res.Err)
+		}
+	}
+	log.WithFields(log.Fields{
+		"component": r.Component,
+		"checks":    len(r.Results),
+		"failed":    failed,
+		"fatal":     len(r.FatalErrors()),
+	}).Info("Self-check completed")
+}
+
+// Binary checks that the binary is found in the path
+func Binary(name string, fatal bool) Check {
+	return Check{
+		Name:  fmt.Sprintf("binary %s", name),
+		Fatal: fatal,
+		Run: func() error {
+			_, err := exec.LookPath(name)
+			return err
+		},
+	}
+}
+
+// Cache checks that an item can be written to and read from the cache
+func Cache(c *cache.Cache) Check {
+	return Check{Name: "cache", Fatal: true, Run: c.Ping}
+}
+
+// Settings checks that the settings can be read and parsed
+func Settings(settingsMgr *settings.SettingsManager) Check {
+	return Check{
+		Name:  "settings",
+		Fatal: true,
+		Run: func() error {
+			getters := []struct {
+				name string
+				get  func() error
+			}{
+				{"settings", func() error { _, err := settingsMgr.GetSettings(); return err }},
+				{"resource overrides", func() error { _, err := settingsMgr.GetResourceOverrides(); return err }},
+				{"resource exclusions", func() error { _, err := settingsMgr.GetResourcesFilter(); return err }},
+				{"cluster cache settings", func() error { _, err := settingsMgr.GetClusterCacheSettings(); return err }},
+				{"in-cluster settings", func() error { _, err := settingsMgr.GetInClusterSettings(); return err }},
+				{"kind order", func() error { _, err := settingsMgr.GetKindOrder(); return err }},
+			}
+			for _, getter := range getters {
+				if err := getter.get(); err != nil {
+					return fmt.Errorf("invalid %s: %v", getter.name, err)
+				}
+			}
+			return nil
+		},
+	}
+}
+
+// Permission checks that the service account of the component is allowed to perform the verb on the resource in the
+// namespace, or across namespaces if the namespace is empty
+func Permission(kubeClient kubernetes.Interface, attrs authorizationv1.ResourceAttributes, fatal bool) Check {
+	resource := attrs.Resource
+	if attrs.Group != "" {
+		resource = fmt.Sprintf("%s.%s", attrs.Resource, attrs.Group)
+	}
+	name := fmt.Sprintf("permission %s %s", attrs.Verb, resource)
+	if attrs.Namespace != "" {
+		name = fmt.Sprintf("%s in namespace %s", name, attrs.Namespace)
+	}
+	return Check{
+		Name:  name,
+		Fatal: fatal,
+		Run: func() error {
+			review, err := kubeClient.AuthorizationV1().SelfSubjectAccessReviews().Create(&authorizationv1.SelfSubjectAccessReview{
+				Spec: authorizationv1.SelfSubjectAccessReviewSpec{ResourceAttributes: &attrs},
+			})
+			if err != nil {
+				return err
+			}
+			if !review.Status.Allowed {
+				return fmt.Errorf("not allowed: %s", review.Status.Reason)
+			}
+			return nil
+		},
+	}
+}
+
+// Permissions returns the checks of the verbs on the resource
+func Permissions(kubeClient kubernetes.Interface, namespace string, group string, resource string, verbs []string, fatal bool) []Check {
+	var checks []Check
+	for _, verb := range verbs {
+		checks = append(checks, Permission(kubeClient, authorizationv1.ResourceAttributes{
+			Namespace: namespace,
+			Verb:      verb,
+			Group:     group,
+			Resource:  resource,
+		}, fatal))
+	}
+	return checks
+}
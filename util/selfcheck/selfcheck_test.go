@@ -0,0 +1,51 @@
+package selfcheck
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	kubetesting "k8s.io/client-go/testing"
+)
+
+func TestRun(t *testing.T) {
+	report := Run("argocd-repo-server", []Check{
+		{Name: "passed", Fatal: true, Run: func() error { return nil }},
+		{Name: "degraded", Run: func() error { return fmt.Errorf("not found") }},
+	})
+	assert.Len(t, report.Results, 2)
+	assert.Empty(t, report.FatalErrors())
+	assert.NoError(t, report.Err())
+
+	report = Run("argocd-repo-server", []Check{
+		{Name: "failed", Fatal: true, Run: func() error { return fmt.Errorf("not found") }},
+	})
+	assert.Equal(t, []string{"failed: not found"}, report.FatalErrors())
+	assert.EqualError(t, report.Err(), "argocd-repo-server self-check failed: failed: not found")
+}
+
+func TestBinary(t *testing.T) {
+	assert.NoError(t, Binary("sh", true).Run())
+	assert.Error(t, Binary("does-not-exist", true).Run())
+}
+
+func TestPermission(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset()
+	kubeClient.PrependReactor("create", "selfsubjectaccessreviews", func(action kubetesting.Action) (bool, runtime.Object, error) {
+		review := action.(kubetesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+		review.Status.Allowed = review.Spec.ResourceAttributes.Verb == "get"
+		if !review.Status.Allowed {
+			review.Status.Reason = "forbidden"
+		}
+		return true, review, nil
+	})
+
+	checks := Permissions(kubeClient, "argocd", "argoproj.io", "applications", []string{"get", "update"}, true)
+	assert.Len(t, checks, 2)
+	assert.Equal(t, "permission get applications.argoproj.io in namespace argocd", checks[0].Name)
+	assert.NoError(t, checks[0].Run())
+	assert.EqualError(t, checks[1].Run(), "not allowed: forbidden")
+}
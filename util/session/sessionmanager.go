@@ -2,10 +2,14 @@ package session
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
 	"net"
 	"net/http"
 	"os"
+	"reflect"
+	"sync"
 	"time"
 
 	"github.com/dgrijalva/jwt-go"
@@ -19,6 +23,7 @@ import (
 	jwtutil "github.com/argoproj/argo-cd/util/jwt"
 	oidcutil "github.com/argoproj/argo-cd/util/oidc"
 	passwordutil "github.com/argoproj/argo-cd/util/password"
+	"github.com/argoproj/argo-cd/util/session/signing"
 	"github.com/argoproj/argo-cd/util/settings"
 )
 
@@ -27,6 +32,11 @@ type SessionManager struct {
 	settingsMgr *settings.SettingsManager
 	client      *http.Client
 	prov        oidcutil.Provider
+	// signingKey is the external key which signs tokens, if configured. It is created again if its configuration
+	// changes.
+	signingKey       signing.Key
+	signingKeyConfig *signing.Config
+	signingKeyLock   sync.Mutex
 }
 
 const (
@@ -37,8 +47,20 @@ const (
 	invalidLoginError  = "Invalid username or password"
 	blankPasswordError = "Blank passwords are not allowed"
 	badUserError       = "Bad local superuser username"
+
+	// maxAccountTokens is the maximum number of outstanding tokens of a local account. The oldest tokens are revoked
+	// when more tokens are issued.
+	maxAccountTokens = 50
 )
 
+// AnonymousClaims are the claims of unauthenticated requests when anonymous access is enabled. They cannot be
+// obtained by parsing a token, so they are only ever created by the API server itself.
+type AnonymousClaims struct {
+	jwt.StandardClaims
+	// Role is the RBAC role which is granted to the anonymous user
+	Role string `json:"-"`
+}
+
 // NewSessionManager creates a new session manager from Argo CD settings
 func NewSessionManager(settingsMgr *settings.SettingsManager, dexServerAddr string) *SessionManager {
 	s := SessionManager{
@@ -76,6 +98,10 @@ func NewSessionManager(settingsMgr *settings.SettingsManager, dexServerAddr stri
 // Create creates a new token for a given subject (user) and returns it as a string.
 // Passing a value of `0` for secondsBeforeExpiry creates a token that never expires.
 func (mgr *SessionManager) Create(subject string, secondsBeforeExpiry int64) (string, error) {
+	return mgr.signClaims(newClaims(subject, secondsBeforeExpiry))
+}
+
+func newClaims(subject string, secondsBeforeExpiry int64) jwt.StandardClaims {
 	// Create a new token object, specifying signing method and the claims
 	// you would like it to contain.
 	now := time.Now().UTC()
@@ -89,12 +115,104 @@ func (mgr *SessionManager) Create(subject string, secondsBeforeExpiry int64) (st
 		expires := now.Add(time.Duration(secondsBeforeExpiry) * time.Second)
 		claims.ExpiresAt = expires.Unix()
 	}
+	return claims
+}
 
-	return mgr.signClaims(claims)
+// CreateLoginToken creates a new token for a local account which logged in. The token expires after the user session
+// duration configured in argocd-cm.
+func (mgr *SessionManager) CreateLoginToken(username string) (string, error) {
+	duration, err := mgr.settingsMgr.GetUserSessionDuration()
+	if err != nil {
+		return "", err
+	}
+	return mgr.CreateAccountToken(username, int64(duration.Seconds()))
+}
+
+// CreateAccountToken creates a new token for a local account, and records it as an outstanding token of the account,
+// so that it can be listed and revoked. The tokens of the account which expired, or which were issued before the
+// password changed, are forgotten, and the oldest tokens are revoked if the account has more than maxAccountTokens.
+func (mgr *SessionManager) CreateAccountToken(username string, secondsBeforeExpiry int64) (string, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", err
+	}
+	claims := newClaims(username, secondsBeforeExpiry)
+	claims.Id = hex.EncodeToString(id)
+	tokenString, err := mgr.signClaims(claims)
+	if err != nil {
+		return "", err
+	}
+	argoCDSettings, err := mgr.settingsMgr.GetSettings()
+	if err != nil {
+		return "", err
+	}
+	now := time.Now().Unix()
+	err = mgr.settingsMgr.UpdateAccountTokens(username, func(tokens []settings.AccountToken) ([]settings.AccountToken, error) {
+		outstanding := []settings.AccountToken{}
+		for _, token := range tokens {
+			if (token.ExpiresAt == 0 || token.ExpiresAt > now) && !time.Unix(token.IssuedAt, 0).Before(argoCDSettings.AdminPasswordMtime) {
+				outstanding = append(outstanding, token)
+			}
+		}
+		if len(outstanding) >= maxAccountTokens {
+			outstanding = outstanding[len(outstanding)-maxAccountTokens+1:]
+		}
+		return append(outstanding, settings.AccountToken{ID: claims.Id, IssuedAt: claims.IssuedAt, ExpiresAt: claims.ExpiresAt}), nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return tokenString, nil
+}
+
+// RevokeAccountToken revokes the outstanding token of a local account. The informers are resynced, so that the token
+// is rejected immediately.
+func (mgr *SessionManager) RevokeAccountToken(username string, id string) error {
+	err := mgr.settingsMgr.UpdateAccountTokens(username, func(tokens []settings.AccountToken) ([]settings.AccountToken, error) {
+		for i := range tokens {
+			if tokens[i].ID == id {
+				return append(tokens[:i], tokens[i+1:]...), nil
+			}
+		}
+		return nil, status.Errorf(codes.NotFound, "token '%s' of account '%s' does not exist", id, username)
+	})
+	if err != nil {
+		return err
+	}
+	return mgr.settingsMgr.ResyncInformers()
+}
+
+// getSigningKey returns the external key which signs tokens, or nil if tokens are signed with the server secret key
+func (mgr *SessionManager) getSigningKey() (signing.Key, error) {
+	config, err := mgr.settingsMgr.GetSessionSigningKeyConfig()
+	if err != nil {
+		return nil, err
+	}
+	mgr.signingKeyLock.Lock()
+	defer mgr.signingKeyLock.Unlock()
+	if reflect.DeepEqual(config, mgr.signingKeyConfig) {
+		return mgr.signingKey, nil
+	}
+	var key signing.Key
+	if config != nil {
+		if key, err = signing.NewKey(config); err != nil {
+			return nil, err
+		}
+	}
+	mgr.signingKey = key
+	mgr.signingKeyConfig = config
+	return key, nil
 }
 
 func (mgr *SessionManager) signClaims(claims jwt.Claims) (string, error) {
 	log.Infof("Issuing claims: %v", claims)
+	signingKey, err := mgr.getSigningKey()
+	if err != nil {
+		return "", err
+	}
+	if signingKey != nil {
+		return signingKey.Sign(claims)
+	}
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 	settings, err := mgr.settingsMgr.GetSettings()
 	if err != nil {
@@ -116,10 +234,21 @@ func (mgr *SessionManager) Parse(tokenString string) (jwt.Claims, error) {
 	}
 	token, err := jwt.ParseWithClaims(tokenString, &claims, func(token *jwt.Token) (interface{}, error) {
 		// Don't forget to validate the alg is what you expect:
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("Unexpected signing method: %v", token.Header["alg"])
+		switch token.Method.(type) {
+		case *jwt.SigningMethodHMAC:
+			// tokens signed with the server secret key stay valid after switching to an external signing key
+			return settings.ServerSignature, nil
+		case *jwt.SigningMethodRSA, *jwt.SigningMethodECDSA:
+			signingKey, err := mgr.getSigningKey()
+			if err != nil {
+				return nil, err
+			}
+			if signingKey == nil {
+				return nil, fmt.Errorf("No signing key is configured to verify tokens signed with %v", token.Header["alg"])
+			}
+			return signingKey.VerificationKey(token)
 		}
-		return settings.ServerSignature, nil
+		return nil, fmt.Errorf("Unexpected signing method: %v", token.Header["alg"])
 	})
 	if err != nil {
 		return nil, err
@@ -129,9 +258,37 @@ func (mgr *SessionManager) Parse(tokenString string) (jwt.Claims, error) {
 	if issuedAt.Before(settings.AdminPasswordMtime) {
 		return nil, fmt.Errorf("Password for superuser has changed since token issued")
 	}
+	// the tokens of local accounts which have an ID are valid until they are revoked
+	if subject, _ := claims["sub"].(string); subject == common.ArgoCDAdminUsername {
+		if id, _ := claims["jti"].(string); id != "" {
+			tokens, err := settings.GetAccountTokens(subject)
+			if err != nil {
+				return nil, err
+			}
+			// tokens which were just issued are not yet observed by the informer
+			if !containsAccountToken(tokens, id) {
+				tokens, err = mgr.settingsMgr.GetAccountTokens(subject)
+				if err != nil {
+					return nil, err
+				}
+			}
+			if !containsAccountToken(tokens, id) {
+				return nil, fmt.Errorf("Token has been revoked")
+			}
+		}
+	}
 	return token.Claims, nil
 }
 
+func containsAccountToken(tokens []settings.AccountToken, id string) bool {
+	for _, token := range tokens {
+		if token.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
 // VerifyUsernamePassword verifies if a username/password combo is correct
 func (mgr *SessionManager) VerifyUsernamePassword(username, password string) error {
 	if username != common.ArgoCDAdminUsername {
@@ -223,6 +380,16 @@ func Iss(ctx context.Context) string {
 	return jwtutil.GetField(mapClaims, "iss")
 }
 
+// TokenID returns the ID of the token of the request, which is only set for the tokens which Argo CD issued to local
+// accounts
+func TokenID(ctx context.Context) string {
+	mapClaims, ok := mapClaims(ctx)
+	if !ok {
+		return ""
+	}
+	return jwtutil.GetField(mapClaims, "jti")
+}
+
 func Sub(ctx context.Context) string {
 	mapClaims, ok := mapClaims(ctx)
 	if !ok {
@@ -2,7 +2,16 @@ package session
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/dgrijalva/jwt-go"
 	"github.com/stretchr/testify/assert"
@@ -62,6 +71,100 @@ func TestSessionManager(t *testing.T) {
 	}
 }
 
+func TestSessionManager_AccountTokens(t *testing.T) {
+	kubeclientset := fake.NewSimpleClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "argocd-cm", Namespace: "argocd", Labels: map[string]string{"app.kubernetes.io/part-of": "argocd"}},
+		Data:       map[string]string{"users.session.duration": "1h"},
+	}, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "argocd-secret", Namespace: "argocd"},
+		Data: map[string][]byte{
+			"admin.password":   []byte("$2a$10$hDj12Tw9xVmvybSahN1Y0.f9DZixxN8oybyA32Uy/eqWklFU4Mo8O"),
+			"server.secretkey": []byte("Hello, world!"),
+		},
+	})
+	settingsMgr := settings.NewSettingsManager(context.Background(), kubeclientset, "argocd")
+	mgr := NewSessionManager(settingsMgr, "")
+
+	// the token is valid before the informer observed it
+	token, err := mgr.CreateLoginToken("admin")
+	assert.NoError(t, err)
+	claims, err := mgr.Parse(token)
+	assert.NoError(t, err)
+	mapClaims := *(claims.(*jwt.MapClaims))
+	expiresAt := time.Unix(int64(mapClaims["exp"].(float64)), 0)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), expiresAt, time.Minute)
+
+	assert.NoError(t, mgr.RevokeAccountToken("admin", mapClaims["jti"].(string)))
+	_, err = mgr.Parse(token)
+	assert.EqualError(t, err, "Token has been revoked")
+
+	// the oldest tokens are revoked once the account has too many
+	for i := 0; i < maxAccountTokens+1; i++ {
+		_, err = mgr.CreateLoginToken("admin")
+		assert.NoError(t, err)
+	}
+	tokens, err := settingsMgr.GetAccountTokens("admin")
+	assert.NoError(t, err)
+	assert.Len(t, tokens, maxAccountTokens)
+}
+
+func TestSessionManager_SigningKey(t *testing.T) {
+	dir, err := ioutil.TempDir("", "session")
+	errors.CheckError(err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	errors.CheckError(err)
+	der, err := x509.MarshalECPrivateKey(key)
+	errors.CheckError(err)
+	bcrypt, err := password.HashPassword("password")
+	errors.CheckError(err)
+	keyPath := filepath.Join(dir, "tls.key")
+	errors.CheckError(ioutil.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}), 0600))
+
+	kubeclientset := fake.NewSimpleClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "argocd-cm",
+			Namespace: "argocd",
+			Labels: map[string]string{
+				"app.kubernetes.io/part-of": "argocd",
+			},
+		},
+		Data: map[string]string{
+			"session.signingKey": "type: file\nfile:\n  path: " + keyPath,
+		},
+	}, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "argocd-secret",
+			Namespace: "argocd",
+		},
+		Data: map[string][]byte{
+			"admin.password":   []byte(bcrypt),
+			"server.secretkey": []byte("Hello, world!"),
+		},
+	})
+	settingsMgr := settings.NewSettingsManager(context.Background(), kubeclientset, "argocd")
+	mgr := NewSessionManager(settingsMgr, "")
+
+	token, err := mgr.Create("argo", 0)
+	assert.NoError(t, err)
+	parsed, _, err := new(jwt.Parser).ParseUnverified(token, &jwt.MapClaims{})
+	assert.NoError(t, err)
+	assert.Equal(t, "ES256", parsed.Method.Alg())
+	claims, err := mgr.Parse(token)
+	assert.NoError(t, err)
+	assert.Equal(t, "argo", (*claims.(*jwt.MapClaims))["sub"])
+
+	// tokens signed with the server secret key before switching to the signing key are still accepted
+	legacyToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.StandardClaims{
+		Subject:  "argo",
+		Issuer:   SessionManagerClaimsIssuer,
+		IssuedAt: time.Now().Unix(),
+	}).SignedString([]byte("Hello, world!"))
+	assert.NoError(t, err)
+	_, err = mgr.Parse(legacyToken)
+	assert.NoError(t, err)
+}
+
 var loggedOutContext = context.Background()
 var loggedInContext = context.WithValue(context.Background(), "claims", &jwt.MapClaims{"iss": "qux", "sub": "foo", "email": "bar", "groups": []string{"baz"}})
 
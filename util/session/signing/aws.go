@@ -0,0 +1,161 @@
+package signing
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"fmt"
+	"hash"
+	"math/big"
+	"sync"
+
+	"github.com/dgrijalva/jwt-go"
+
+	"github.com/argoproj/argo-cd/util/aws"
+)
+
+// AWSConfig configures an asymmetric signing key of AWS KMS. Since KMS does not rotate asymmetric keys, the key is
+// rotated by creating a new key, and moving the previous one to the verification keys. Credentials are taken from the
+// AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY and AWS_SESSION_TOKEN environment variables.
+type AWSConfig struct {
+	// Region is the AWS region of the keys, e.g. us-east-1
+	Region string `json:"region"`
+	// KeyID is the ID, ARN or alias of the key which signs tokens
+	KeyID string `json:"keyId"`
+	// VerificationKeyIDs are the IDs of previous signing keys, whose tokens are still accepted
+	VerificationKeyIDs []string `json:"verificationKeyIds,omitempty"`
+	// Endpoint overrides the endpoint of the KMS API
+	Endpoint string `json:"endpoint,omitempty"`
+}
+
+type kmsPublicKey struct {
+	arn string
+	key interface{}
+}
+
+type awsKey struct {
+	config AWSConfig
+	client *aws.Client
+	// publicKeys holds the public keys of the configured keys. Asymmetric keys of KMS never change.
+	publicKeys map[string]kmsPublicKey
+	lock       sync.Mutex
+}
+
+// NewAWSKey returns a key which signs tokens using AWS KMS, so the private key never leaves KMS
+func NewAWSKey(config AWSConfig) Key {
+	return &awsKey{
+		config:     config,
+		client:     aws.NewClient("kms", "TrentService.", config.Region, config.Endpoint),
+		publicKeys: make(map[string]kmsPublicKey),
+	}
+}
+
+// getPublicKey returns the ARN and the public key of the given key ID
+func (k *awsKey) getPublicKey(keyID string) (kmsPublicKey, error) {
+	k.lock.Lock()
+	defer k.lock.Unlock()
+	if publicKey, ok := k.publicKeys[keyID]; ok {
+		return publicKey, nil
+	}
+	var out struct {
+		KeyId     string `json:"KeyId"`
+		PublicKey []byte `json:"PublicKey"`
+	}
+	if err := k.client.Call("GetPublicKey", map[string]string{"KeyId": keyID}, &out); err != nil {
+		return kmsPublicKey{}, err
+	}
+	key, err := x509.ParsePKIXPublicKey(out.PublicKey)
+	if err != nil {
+		return kmsPublicKey{}, err
+	}
+	publicKey := kmsPublicKey{arn: out.KeyId, key: key}
+	k.publicKeys[keyID] = publicKey
+	return publicKey, nil
+}
+
+func (k *awsKey) Sign(claims jwt.Claims) (string, error) {
+	publicKey, err := k.getPublicKey(k.config.KeyID)
+	if err != nil {
+		return "", err
+	}
+	method, err := signingMethod(publicKey.key)
+	if err != nil {
+		return "", err
+	}
+	input, err := signingString(method, publicKey.arn, claims)
+	if err != nil {
+		return "", err
+	}
+	var h hash.Hash
+	var algorithm string
+	switch method {
+	case jwt.SigningMethodRS256:
+		h, algorithm = sha256.New(), "RSASSA_PKCS1_V1_5_SHA_256"
+	case jwt.SigningMethodES256:
+		h, algorithm = sha256.New(), "ECDSA_SHA_256"
+	case jwt.SigningMethodES384:
+		h, algorithm = sha512.New384(), "ECDSA_SHA_384"
+	default:
+		h, algorithm = sha512.New(), "ECDSA_SHA_512"
+	}
+	_, _ = h.Write([]byte(input))
+	var out struct {
+		Signature []byte `json:"Signature"`
+	}
+	err = k.client.Call("Sign", map[string]interface{}{
+		"KeyId":            k.config.KeyID,
+		"Message":          h.Sum(nil),
+		"MessageType":      "DIGEST",
+		"SigningAlgorithm": algorithm,
+	}, &out)
+	if err != nil {
+		return "", err
+	}
+	signature := out.Signature
+	if ecKey, ok := publicKey.key.(*ecdsa.PublicKey); ok {
+		if signature, err = asn1ToJWSSignature(signature, ecKey); err != nil {
+			return "", err
+		}
+	}
+	return input + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// asn1ToJWSSignature converts an ASN.1 encoded ECDSA signature, as returned by KMS, to the concatenation of R and S
+// used by JWS
+func asn1ToJWSSignature(signature []byte, key *ecdsa.PublicKey) ([]byte, error) {
+	var sig struct {
+		R, S *big.Int
+	}
+	if _, err := asn1.Unmarshal(signature, &sig); err != nil {
+		return nil, err
+	}
+	size := (key.Curve.Params().BitSize + 7) / 8
+	res := make([]byte, 2*size)
+	rBytes, sBytes := sig.R.Bytes(), sig.S.Bytes()
+	if len(rBytes) > size || len(sBytes) > size {
+		return nil, fmt.Errorf("invalid signature")
+	}
+	copy(res[size-len(rBytes):size], rBytes)
+	copy(res[2*size-len(sBytes):], sBytes)
+	return res, nil
+}
+
+func (k *awsKey) VerificationKey(token *jwt.Token) (interface{}, error) {
+	kid, err := tokenKeyID(token)
+	if err != nil {
+		return nil, err
+	}
+	for _, keyID := range append([]string{k.config.KeyID}, k.config.VerificationKeyIDs...) {
+		publicKey, err := k.getPublicKey(keyID)
+		if err != nil {
+			return nil, err
+		}
+		if publicKey.arn == kid {
+			return publicKey.key, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown signing key %s", kid)
+}
@@ -0,0 +1,63 @@
+package signing
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAWSKey(t *testing.T) {
+	keys := map[string]*ecdsa.PrivateKey{}
+	for _, id := range []string{"current", "previous"} {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		assert.NoError(t, err)
+		keys[id] = key
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var in struct {
+			KeyId            string
+			Message          []byte
+			MessageType      string
+			SigningAlgorithm string
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&in))
+		key := keys[in.KeyId]
+		switch r.Header.Get("X-Amz-Target") {
+		case "TrentService.GetPublicKey":
+			der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+			assert.NoError(t, err)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"KeyId": "arn:aws:kms:us-east-1:1:key/" + in.KeyId, "PublicKey": der})
+		case "TrentService.Sign":
+			assert.Equal(t, "DIGEST", in.MessageType)
+			assert.Equal(t, "ECDSA_SHA_256", in.SigningAlgorithm)
+			signature, err := key.Sign(rand.Reader, in.Message, nil)
+			assert.NoError(t, err)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"Signature": signature})
+		}
+	}))
+	defer server.Close()
+
+	previous := NewAWSKey(AWSConfig{Region: "us-east-1", KeyID: "previous", Endpoint: server.URL})
+	token, err := previous.Sign(jwt.StandardClaims{Subject: "admin"})
+	assert.NoError(t, err)
+	parsed, err := verify(previous, token)
+	assert.NoError(t, err)
+	assert.Equal(t, "ES256", parsed.Method.Alg())
+	assert.Equal(t, "arn:aws:kms:us-east-1:1:key/previous", parsed.Header["kid"])
+
+	key := NewAWSKey(AWSConfig{Region: "us-east-1", KeyID: "current", VerificationKeyIDs: []string{"previous"}, Endpoint: server.URL})
+	_, err = verify(key, token)
+	assert.NoError(t, err)
+
+	key = NewAWSKey(AWSConfig{Region: "us-east-1", KeyID: "current", Endpoint: server.URL})
+	_, err = verify(key, token)
+	assert.Error(t, err)
+}
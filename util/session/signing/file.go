@@ -0,0 +1,148 @@
+package signing
+
+import (
+	"crypto"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+)
+
+// FileConfig configures a signing key which is mounted into the API server, e.g. from a Kubernetes secret
+type FileConfig struct {
+	// Path is the path of the PEM encoded RSA or ECDSA private key which signs tokens
+	Path string `json:"path"`
+	// VerificationKeyPaths are the paths of PEM encoded public keys or certificates of previous signing keys, whose
+	// tokens are still accepted
+	VerificationKeyPaths []string `json:"verificationKeyPaths,omitempty"`
+}
+
+type loadedFile struct {
+	modTime time.Time
+	key     interface{}
+}
+
+type fileKey struct {
+	config FileConfig
+	// files caches the parsed keys of each file, which are parsed again once the file is modified
+	files map[string]loadedFile
+	lock  sync.Mutex
+}
+
+// NewFileKey returns a key which signs tokens with a private key file. The file is read again once it is modified,
+// so the key can be rotated by updating the mounted secret.
+func NewFileKey(config FileConfig) Key {
+	return &fileKey{config: config, files: make(map[string]loadedFile)}
+}
+
+// parsePEMKey parses a PEM encoded private key, public key or certificate
+func parsePEMKey(data []byte) (interface{}, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data found")
+	}
+	switch block.Type {
+	case "CERTIFICATE":
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		return cert.PublicKey, nil
+	case "PUBLIC KEY":
+		return x509.ParsePKIXPublicKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		return x509.ParseECPrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		return x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	return nil, fmt.Errorf("unsupported PEM block type %s", block.Type)
+}
+
+// loadKey returns the key of the given file
+func (k *fileKey) loadKey(path string) (interface{}, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	k.lock.Lock()
+	defer k.lock.Unlock()
+	if loaded, ok := k.files[path]; ok && loaded.modTime.Equal(info.ModTime()) {
+		return loaded.key, nil
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	key, err := parsePEMKey(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse key %s: %v", path, err)
+	}
+	k.files[path] = loadedFile{modTime: info.ModTime(), key: key}
+	return key, nil
+}
+
+// publicKey returns the public key of a private or public key
+func publicKey(key interface{}) interface{} {
+	if signer, ok := key.(crypto.Signer); ok {
+		return signer.Public()
+	}
+	return key
+}
+
+// publicKeyID derives the key ID from the public key
+func publicKeyID(key interface{}) (string, error) {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(der)
+	return hex.EncodeToString(sum[:8]), nil
+}
+
+func (k *fileKey) Sign(claims jwt.Claims) (string, error) {
+	key, err := k.loadKey(k.config.Path)
+	if err != nil {
+		return "", err
+	}
+	if _, ok := key.(crypto.Signer); !ok {
+		return "", fmt.Errorf("%s is not a private key", k.config.Path)
+	}
+	method, err := signingMethod(publicKey(key))
+	if err != nil {
+		return "", err
+	}
+	kid, err := publicKeyID(publicKey(key))
+	if err != nil {
+		return "", err
+	}
+	token := jwt.NewWithClaims(method, claims)
+	token.Header["kid"] = kid
+	return token.SignedString(key)
+}
+
+func (k *fileKey) VerificationKey(token *jwt.Token) (interface{}, error) {
+	kid, err := tokenKeyID(token)
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range append([]string{k.config.Path}, k.config.VerificationKeyPaths...) {
+		key, err := k.loadKey(path)
+		if err != nil {
+			return nil, err
+		}
+		public := publicKey(key)
+		if id, err := publicKeyID(public); err == nil && id == kid {
+			return public, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown signing key %s", kid)
+}
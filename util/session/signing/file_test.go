@@ -0,0 +1,74 @@
+package signing
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func writeKey(t *testing.T, path string, key interface{}) {
+	var block *pem.Block
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		block = &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(k)}
+	case *ecdsa.PrivateKey:
+		der, err := x509.MarshalECPrivateKey(k)
+		assert.NoError(t, err)
+		block = &pem.Block{Type: "EC PRIVATE KEY", Bytes: der}
+	default:
+		der, err := x509.MarshalPKIXPublicKey(k)
+		assert.NoError(t, err)
+		block = &pem.Block{Type: "PUBLIC KEY", Bytes: der}
+	}
+	assert.NoError(t, ioutil.WriteFile(path, pem.EncodeToMemory(block), 0600))
+}
+
+func verify(key Key, tokenString string) (*jwt.Token, error) {
+	return jwt.Parse(tokenString, key.VerificationKey)
+}
+
+func TestFileKey(t *testing.T) {
+	dir, err := ioutil.TempDir("", "signing")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	writeKey(t, filepath.Join(dir, "tls.key"), rsaKey)
+
+	key := NewFileKey(FileConfig{Path: filepath.Join(dir, "tls.key")})
+	token, err := key.Sign(jwt.StandardClaims{Subject: "admin"})
+	assert.NoError(t, err)
+	parsed, err := verify(key, token)
+	assert.NoError(t, err)
+	assert.Equal(t, "RS256", parsed.Method.Alg())
+
+	// rotate the key, and keep the previous one for verification
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	writeKey(t, filepath.Join(dir, "new.key"), ecKey)
+	writeKey(t, filepath.Join(dir, "previous.pub"), &rsaKey.PublicKey)
+	key = NewFileKey(FileConfig{Path: filepath.Join(dir, "new.key"), VerificationKeyPaths: []string{filepath.Join(dir, "previous.pub")}})
+
+	_, err = verify(key, token)
+	assert.NoError(t, err)
+	newToken, err := key.Sign(jwt.StandardClaims{Subject: "admin"})
+	assert.NoError(t, err)
+	parsed, err = verify(key, newToken)
+	assert.NoError(t, err)
+	assert.Equal(t, "ES256", parsed.Method.Alg())
+
+	// tokens of keys which are no longer configured are rejected
+	key = NewFileKey(FileConfig{Path: filepath.Join(dir, "new.key")})
+	_, err = verify(key, token)
+	assert.Error(t, err)
+}
@@ -0,0 +1,98 @@
+package signing
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"fmt"
+
+	"github.com/dgrijalva/jwt-go"
+)
+
+const (
+	// KeyTypeFile signs tokens with a PEM encoded private key mounted into the API server
+	KeyTypeFile = "file"
+	// KeyTypeVault signs tokens with a key of the transit secrets engine of HashiCorp Vault
+	KeyTypeVault = "vault"
+	// KeyTypeAWS signs tokens with an asymmetric key of AWS KMS
+	KeyTypeAWS = "aws"
+)
+
+// Key signs session tokens, and provides the public keys to verify them. The ID of the signing key is set in the 'kid'
+// header of tokens, so that tokens signed by a previous key stay valid as long as that key is configured for
+// verification.
+type Key interface {
+	// Sign signs the claims and returns the token
+	Sign(claims jwt.Claims) (string, error)
+	// VerificationKey returns the public key which verifies the signature of the token, based on its 'kid' header
+	VerificationKey(token *jwt.Token) (interface{}, error)
+}
+
+// Config is the configuration of the key which signs session tokens, which is set in the argocd-cm ConfigMap
+type Config struct {
+	// Type is one of 'file', 'vault' or 'aws'
+	Type string `json:"type"`
+	// File configures a key mounted into the API server
+	File *FileConfig `json:"file,omitempty"`
+	// Vault configures a key of the transit secrets engine of HashiCorp Vault
+	Vault *VaultConfig `json:"vault,omitempty"`
+	// AWS configures a key of AWS KMS
+	AWS *AWSConfig `json:"aws,omitempty"`
+}
+
+// NewKey returns the signing key of the given configuration
+func NewKey(config *Config) (Key, error) {
+	switch config.Type {
+	case KeyTypeFile:
+		if config.File == nil {
+			return nil, fmt.Errorf("file signing key is not configured")
+		}
+		return NewFileKey(*config.File), nil
+	case KeyTypeVault:
+		if config.Vault == nil {
+			return nil, fmt.Errorf("vault signing key is not configured")
+		}
+		return NewVaultKey(*config.Vault), nil
+	case KeyTypeAWS:
+		if config.AWS == nil {
+			return nil, fmt.Errorf("aws signing key is not configured")
+		}
+		return NewAWSKey(*config.AWS), nil
+	}
+	return nil, fmt.Errorf("unknown signing key type '%s'", config.Type)
+}
+
+// signingMethod returns the JWT signing method of the given public key
+func signingMethod(publicKey interface{}) (jwt.SigningMethod, error) {
+	switch key := publicKey.(type) {
+	case *rsa.PublicKey:
+		return jwt.SigningMethodRS256, nil
+	case *ecdsa.PublicKey:
+		switch key.Curve {
+		case elliptic.P256():
+			return jwt.SigningMethodES256, nil
+		case elliptic.P384():
+			return jwt.SigningMethodES384, nil
+		case elliptic.P521():
+			return jwt.SigningMethodES512, nil
+		}
+		return nil, fmt.Errorf("unsupported elliptic curve %s", key.Curve.Params().Name)
+	}
+	return nil, fmt.Errorf("unsupported key type %T", publicKey)
+}
+
+// tokenKeyID returns the 'kid' header of the token
+func tokenKeyID(token *jwt.Token) (string, error) {
+	kid, ok := token.Header["kid"].(string)
+	if !ok || kid == "" {
+		return "", fmt.Errorf("token has no key id")
+	}
+	return kid, nil
+}
+
+// signingString returns the token to sign with the ID of the signing key set
+func signingString(method jwt.SigningMethod, kid string, claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(method, claims)
+	token.Header["kid"] = kid
+	return token.SigningString()
+}
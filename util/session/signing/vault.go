@@ -0,0 +1,199 @@
+package signing
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+
+	"github.com/argoproj/argo-cd/util/vault"
+)
+
+const (
+	// vaultKeyInfoExpiration is how long the versions of a transit key are cached, before checking whether it was
+	// rotated
+	vaultKeyInfoExpiration = time.Minute
+	// vaultKeyInfoRefreshInterval is how often the versions of a transit key are refreshed at most when a token of an
+	// unknown version is verified, so that tokens cannot be used to flood Vault with requests
+	vaultKeyInfoRefreshInterval = 10 * time.Second
+	// vaultMaxVersionsAhead is how many versions beyond the latest known version a token may claim to be signed by,
+	// e.g. since the key was rotated by another replica of the API server
+	vaultMaxVersionsAhead = 5
+)
+
+// VaultConfig configures a signing key of the transit secrets engine of HashiCorp Vault. The key must be of an RSA or
+// ECDSA type. Tokens signed by previous versions of the key stay valid, unless the versions are trimmed in Vault.
+type VaultConfig struct {
+	// Address is the URL of the Vault server, e.g. https://vault:8200
+	Address string `json:"address"`
+	// Mount is the mount path of the transit secrets engine. Defaults to 'transit'.
+	Mount string `json:"mount,omitempty"`
+	// Key is the name of the transit key
+	Key string `json:"key"`
+	// Role is the role used to log in using the Kubernetes auth method. The VAULT_TOKEN environment variable is
+	// used if no role is set.
+	Role string `json:"role,omitempty"`
+	// AuthPath is the mount path of the Kubernetes auth method. Defaults to 'kubernetes'.
+	AuthPath string `json:"authPath,omitempty"`
+}
+
+type vaultKey struct {
+	config VaultConfig
+	client *vault.Client
+	// publicKeys holds the public key of each version of the transit key
+	publicKeys    map[int]interface{}
+	latestVersion int
+	keyType       string
+	fetchedAt     time.Time
+	lock          sync.Mutex
+}
+
+// NewVaultKey returns a key which signs tokens using the transit secrets engine of HashiCorp Vault, so the private
+// key never leaves Vault. The key is rotated with 'vault write -f transit/keys/<key>/rotate'.
+func NewVaultKey(config VaultConfig) Key {
+	if config.Mount == "" {
+		config.Mount = "transit"
+	}
+	return &vaultKey{
+		config:     config,
+		client:     vault.NewClient(config.Address, config.Role, config.AuthPath),
+		publicKeys: make(map[int]interface{}),
+	}
+}
+
+// fetchKeyInfo fetches the versions and public keys of the transit key
+func (k *vaultKey) fetchKeyInfo() error {
+	var info struct {
+		Type          string `json:"type"`
+		LatestVersion int    `json:"latest_version"`
+		Keys          map[string]struct {
+			PublicKey string `json:"public_key"`
+		} `json:"keys"`
+	}
+	found, err := k.client.Read(fmt.Sprintf("/v1/%s/keys/%s", k.config.Mount, k.config.Key), &info)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return fmt.Errorf("transit key %s not found", k.config.Key)
+	}
+	publicKeys := make(map[int]interface{})
+	for version, key := range info.Keys {
+		v, err := strconv.Atoi(version)
+		if err != nil {
+			return fmt.Errorf("invalid version %s of transit key %s", version, k.config.Key)
+		}
+		block, _ := pem.Decode([]byte(key.PublicKey))
+		if block == nil {
+			return fmt.Errorf("transit key %s is not an asymmetric key", k.config.Key)
+		}
+		publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return err
+		}
+		publicKeys[v] = publicKey
+	}
+	k.keyType = info.Type
+	k.latestVersion = info.LatestVersion
+	k.publicKeys = publicKeys
+	k.fetchedAt = time.Now()
+	return nil
+}
+
+func (k *vaultKey) keyID(version int) string {
+	return fmt.Sprintf("%s:v%d", k.config.Key, version)
+}
+
+func (k *vaultKey) Sign(claims jwt.Claims) (string, error) {
+	k.lock.Lock()
+	if time.Since(k.fetchedAt) > vaultKeyInfoExpiration {
+		if err := k.fetchKeyInfo(); err != nil {
+			k.lock.Unlock()
+			return "", err
+		}
+	}
+	version := k.latestVersion
+	publicKey, ok := k.publicKeys[version]
+	k.lock.Unlock()
+	if !ok {
+		return "", fmt.Errorf("version %d of transit key %s not found", version, k.config.Key)
+	}
+	method, err := signingMethod(publicKey)
+	if err != nil {
+		return "", err
+	}
+	input, err := signingString(method, k.keyID(version), claims)
+	if err != nil {
+		return "", err
+	}
+	request := map[string]interface{}{
+		"input":                base64.StdEncoding.EncodeToString([]byte(input)),
+		"key_version":          version,
+		"marshaling_algorithm": "jws",
+	}
+	hashAlgorithm := "sha2-256"
+	switch method {
+	case jwt.SigningMethodRS256:
+		request["signature_algorithm"] = "pkcs1v15"
+	case jwt.SigningMethodES384:
+		hashAlgorithm = "sha2-384"
+	case jwt.SigningMethodES512:
+		hashAlgorithm = "sha2-512"
+	}
+	var signed struct {
+		Signature string `json:"signature"`
+	}
+	_, err = k.client.Write(http.MethodPost, fmt.Sprintf("/v1/%s/sign/%s/%s", k.config.Mount, k.config.Key, hashAlgorithm), request, &signed)
+	if err != nil {
+		return "", err
+	}
+	// the signature is formatted as 'vault:v<version>:<signature>'
+	parts := strings.SplitN(signed.Signature, ":", 3)
+	if len(parts) != 3 {
+		return "", fmt.Errorf("unexpected signature format")
+	}
+	// RSA signatures are standard base64 encoded, even if the JWS marshaling is requested
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		if signature, err = base64.StdEncoding.DecodeString(parts[2]); err != nil {
+			return "", err
+		}
+	}
+	return input + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+func (k *vaultKey) VerificationKey(token *jwt.Token) (interface{}, error) {
+	kid, err := tokenKeyID(token)
+	if err != nil {
+		return nil, err
+	}
+	prefix := k.config.Key + ":v"
+	if !strings.HasPrefix(kid, prefix) {
+		return nil, fmt.Errorf("unknown signing key %s", kid)
+	}
+	version, err := strconv.Atoi(strings.TrimPrefix(kid, prefix))
+	if err != nil {
+		return nil, fmt.Errorf("unknown signing key %s", kid)
+	}
+	k.lock.Lock()
+	defer k.lock.Unlock()
+	publicKey, ok := k.publicKeys[version]
+	// the key might have been rotated by another replica of the API server
+	if !ok && (k.fetchedAt.IsZero() || (version > k.latestVersion && version <= k.latestVersion+vaultMaxVersionsAhead && time.Since(k.fetchedAt) > vaultKeyInfoRefreshInterval)) {
+		if err := k.fetchKeyInfo(); err != nil {
+			return nil, err
+		}
+		publicKey, ok = k.publicKeys[version]
+	}
+	if !ok {
+		return nil, fmt.Errorf("unknown signing key %s", kid)
+	}
+	return publicKey, nil
+}
@@ -0,0 +1,128 @@
+package signing
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeTransit implements the key and sign endpoints of the transit secrets engine of Vault for an RSA key
+type fakeTransit struct {
+	t    *testing.T
+	keys []*rsa.PrivateKey
+	// keyRequests is the number of requests of the key endpoint
+	keyRequests int
+}
+
+func (f *fakeTransit) rotate() {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(f.t, err)
+	f.keys = append(f.keys, key)
+}
+
+func (f *fakeTransit) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/v1/transit/keys/argocd":
+		f.keyRequests++
+		keys := make(map[string]interface{})
+		for i, key := range f.keys {
+			der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+			assert.NoError(f.t, err)
+			keys[strconv.Itoa(i+1)] = map[string]string{"public_key": string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))}
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]interface{}{
+			"type":           "rsa-2048",
+			"latest_version": len(f.keys),
+			"keys":           keys,
+		}})
+	case "/v1/transit/sign/argocd/sha2-256":
+		var req struct {
+			Input      string `json:"input"`
+			KeyVersion int    `json:"key_version"`
+		}
+		assert.NoError(f.t, json.NewDecoder(r.Body).Decode(&req))
+		input, err := base64.StdEncoding.DecodeString(req.Input)
+		assert.NoError(f.t, err)
+		digest := sha256.Sum256(input)
+		signature, err := rsa.SignPKCS1v15(rand.Reader, f.keys[req.KeyVersion-1], crypto.SHA256, digest[:])
+		assert.NoError(f.t, err)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]string{
+			"signature": fmt.Sprintf("vault:v%d:%s", req.KeyVersion, base64.StdEncoding.EncodeToString(signature)),
+		}})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestVaultKey(t *testing.T) {
+	transit := &fakeTransit{t: t}
+	transit.rotate()
+	server := httptest.NewServer(transit)
+	defer server.Close()
+	key := NewVaultKey(VaultConfig{Address: server.URL, Key: "argocd"})
+
+	token, err := key.Sign(jwt.StandardClaims{Subject: "admin"})
+	assert.NoError(t, err)
+	parsed, err := verify(key, token)
+	assert.NoError(t, err)
+	assert.Equal(t, "argocd:v1", parsed.Header["kid"])
+
+	// tokens of the previous version stay valid after the key is rotated by another replica
+	transit.rotate()
+	key.(*vaultKey).fetchedAt = key.(*vaultKey).fetchedAt.Add(-vaultKeyInfoExpiration)
+	newToken, err := key.Sign(jwt.StandardClaims{Subject: "admin"})
+	assert.NoError(t, err)
+	parsed, err = verify(key, newToken)
+	assert.NoError(t, err)
+	assert.Equal(t, "argocd:v2", parsed.Header["kid"])
+	_, err = verify(NewVaultKey(VaultConfig{Address: server.URL, Key: "argocd"}), token)
+	assert.NoError(t, err)
+
+	_, err = verify(NewVaultKey(VaultConfig{Address: server.URL, Key: "other"}), token)
+	assert.Error(t, err)
+}
+
+func TestVaultKey_UnknownVersion(t *testing.T) {
+	transit := &fakeTransit{t: t}
+	transit.rotate()
+	server := httptest.NewServer(transit)
+	defer server.Close()
+	key := NewVaultKey(VaultConfig{Address: server.URL, Key: "argocd"})
+	_, err := key.Sign(jwt.StandardClaims{Subject: "admin"})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, transit.keyRequests)
+
+	tokenOfVersion := func(kid string) *jwt.Token {
+		return &jwt.Token{Header: map[string]interface{}{"kid": kid}}
+	}
+
+	// versions far beyond the latest version are rejected without asking Vault
+	_, err = key.VerificationKey(tokenOfVersion("argocd:v1000"))
+	assert.EqualError(t, err, "unknown signing key argocd:v1000")
+	assert.Equal(t, 1, transit.keyRequests)
+
+	// the versions are refreshed at most once per interval
+	_, err = key.VerificationKey(tokenOfVersion("argocd:v2"))
+	assert.Error(t, err)
+	assert.Equal(t, 1, transit.keyRequests)
+
+	key.(*vaultKey).fetchedAt = key.(*vaultKey).fetchedAt.Add(-vaultKeyInfoRefreshInterval)
+	_, err = key.VerificationKey(tokenOfVersion("argocd:v2"))
+	assert.Error(t, err)
+	_, err = key.VerificationKey(tokenOfVersion("argocd:v3"))
+	assert.Error(t, err)
+	assert.Equal(t, 2, transit.keyRequests)
+}
@@ -6,10 +6,12 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"net/url"
 	"os"
 	"path"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -25,12 +27,17 @@ import (
 	"k8s.io/client-go/kubernetes"
 	v1listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
 
 	"github.com/argoproj/argo-cd/common"
 	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/server/settings/oidc"
 	"github.com/argoproj/argo-cd/util"
+	"github.com/argoproj/argo-cd/util/encryption"
+	"github.com/argoproj/argo-cd/util/groupsync"
 	"github.com/argoproj/argo-cd/util/password"
+	"github.com/argoproj/argo-cd/util/secrets"
+	"github.com/argoproj/argo-cd/util/session/signing"
 	tlsutil "github.com/argoproj/argo-cd/util/tls"
 )
 
@@ -41,6 +48,11 @@ type ArgoCDSettings struct {
 	URL string `json:"url,omitempty"`
 	// Indicates if status badge is enabled or not.
 	StatusBadgeEnabled bool `json:"statusBadgeEnable"`
+	// StatusBadgeApplications are the name patterns of applications which have a status badge. All applications have a
+	// badge if empty.
+	StatusBadgeApplications []string `json:"statusBadgeApplications,omitempty"`
+	// StatusBadgeToken is the token which must be passed to the status badge endpoint, if set
+	StatusBadgeToken string `json:"-"`
 	// Admin superuser password storage
 	AdminPasswordHash  string    `json:"adminPasswordHash,omitempty"`
 	AdminPasswordMtime time.Time `json:"adminPasswordMtime,omitempty"`
@@ -50,9 +62,14 @@ type ArgoCDSettings struct {
 	OIDCConfigRAW string `json:"oidcConfig,omitempty"`
 	// ServerSignature holds the key used to generate JWT tokens.
 	ServerSignature []byte `json:"serverSignature,omitempty"`
+	// RepoServerProfilerToken authenticates the profiling requests of the API server to the repo server
+	RepoServerProfilerToken string `json:"-"`
 	// Certificate holds the certificate/private key for the Argo CD API server.
 	// If nil, will run insecure without TLS.
 	Certificate *tls.Certificate `json:"-"`
+	// CertificateIsExternal is true if the certificate was read from the argocd-server-tls secret, which is managed
+	// outside of Argo CD and therefore never written to argocd-secret
+	CertificateIsExternal bool `json:"-"`
 	// WebhookGitLabSecret holds the shared secret for authenticating GitHub webhook events
 	WebhookGitHubSecret string `json:"webhookGitHubSecret,omitempty"`
 	// WebhookGitLabSecret holds the shared secret for authenticating GitLab webhook events
@@ -69,6 +86,13 @@ type ArgoCDSettings struct {
 	KustomizeBuildOptions string
 	// Indicates if anonymous user is enabled or not
 	AnonymousUserEnabled bool
+	// AnonymousUserRole is the RBAC role which is granted to the anonymous user
+	AnonymousUserRole string
+	// WebhookRequireSecret rejects the webhook events of providers whose secret is not configured
+	WebhookRequireSecret bool
+	// WebhookReplayWindow is the period in which repeated deliveries of a webhook event are rejected. Signed events
+	// which were pushed longer ago are rejected as stale. Replays are not detected if zero.
+	WebhookReplayWindow time.Duration
 }
 
 type GoogleAnalytics struct {
@@ -84,6 +108,90 @@ type Help struct {
 	ChatText string `json:"chatText,omitempty"`
 }
 
+// ClusterCacheSettings limits the memory used by the controller to cache the resources of managed clusters
+type ClusterCacheSettings struct {
+	// MaxObjectsPerKind is the maximum number of objects of a single kind which are cached per cluster. Kinds with more
+	// objects are not cached and their objects are loaded from the cluster when needed. Zero means no limit.
+	MaxObjectsPerKind int `json:"maxObjectsPerKind,omitempty"`
+	// StripManagedFields removes metadata.managedFields from the cached objects
+	StripManagedFields bool `json:"stripManagedFields,omitempty"`
+	// StripLastAppliedConfig removes the last-applied-configuration annotation from the cached objects
+	StripLastAppliedConfig bool `json:"stripLastAppliedConfig,omitempty"`
+}
+
+// IgnoreResourceUpdatesSettings configures which changes of the resources of managed clusters do not cause the
+// reconciliation of applications
+type IgnoreResourceUpdatesSettings struct {
+	// Enabled turns on ignoring updates which only change ignored fields
+	Enabled bool `json:"enabled,omitempty"`
+	// JSONPointers are the fields which are ignored in addition to the status, metadata.resourceVersion,
+	// metadata.generation and metadata.managedFields
+	JSONPointers []string `json:"jsonPointers,omitempty"`
+}
+
+// KindOrder inserts a kind into the order in which the resources of the same sync wave are applied, e.g. to apply the
+// custom resources of an operator before the custom resources which depend on them
+type KindOrder struct {
+	Kind string `json:"kind"`
+	// Before or After is a built-in kind, or a kind inserted by a previous entry, before or after which the kind is
+	// applied. Kinds without either are applied after all the built-in kinds, but before the kinds which are not ordered.
+	Before string `json:"before,omitempty"`
+	After  string `json:"after,omitempty"`
+}
+
+// InClusterSettings configures the implicit destination of the cluster Argo CD runs in
+type InClusterSettings struct {
+	// Disabled removes the implicit in-cluster destination. Credentials which were added for it are still used.
+	Disabled bool `json:"disabled,omitempty"`
+	// Name is the name of the in-cluster destination
+	Name string `json:"name,omitempty"`
+	// Namespaces restricts the in-cluster destination to the namespaces matching one of the patterns
+	Namespaces []string `json:"namespaces,omitempty"`
+	// Aliases are additional destinations of the cluster Argo CD runs in, each with its own server address and namespaces
+	Aliases []InClusterAlias `json:"aliases,omitempty"`
+}
+
+// InClusterAlias is an additional destination of the cluster Argo CD runs in
+type InClusterAlias struct {
+	// Server is the address by which applications refer to the alias. It is not used to connect to the cluster.
+	Server     string   `json:"server"`
+	Name       string   `json:"name,omitempty"`
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+// MaintenanceMode pauses the automated syncs of all applications, e.g. during upgrades of Argo CD or incidents
+type MaintenanceMode struct {
+	Enabled bool `json:"enabled"`
+	// Message is shown to the users, e.g. the reason of the maintenance
+	Message string `json:"message,omitempty"`
+	// EnabledBy is the user who enabled the maintenance mode
+	EnabledBy string `json:"enabledBy,omitempty"`
+	// EnabledAt is the time at which the maintenance mode was enabled
+	EnabledAt *metav1.Time `json:"enabledAt,omitempty"`
+}
+
+// Announcement is a message to all users of Argo CD, e.g. about planned maintenance
+type Announcement struct {
+	Message string `json:"message"`
+	// Severity is one of info, warning or critical, info if empty
+	Severity string `json:"severity,omitempty"`
+	// URL links to the details of the announcement
+	URL string `json:"url,omitempty"`
+	// ExpiresAt is the time after which the announcement is no longer shown
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+}
+
+const (
+	AnnouncementSeverityInfo     = "info"
+	AnnouncementSeverityWarning  = "warning"
+	AnnouncementSeverityCritical = "critical"
+)
+
+// IsExpired returns true if the announcement is no longer shown at the given time
+func (a Announcement) IsExpired(now time.Time) bool {
+	return a.ExpiresAt != nil && !now.Before(a.ExpiresAt.Time)
+}
+
 type OIDCConfig struct {
 	Name                   string                 `json:"name,omitempty"`
 	Issuer                 string                 `json:"issuer,omitempty"`
@@ -120,6 +228,8 @@ type RepoCredentials struct {
 	TLSClientCertKeySecret *apiv1.SecretKeySelector `json:"tlsClientCertKeySecret,omitempty"`
 	// The CA secret for TLS client cert. Helm only
 	TLSClientCASecret *apiv1.SecretKeySelector `json:"tlsClientCertCaSecret,omitempty"`
+	// ID of the push webhook which Argo CD created in the repository
+	WebhookID string `json:"webhookID,omitempty"`
 }
 
 const (
@@ -129,6 +239,9 @@ const (
 	settingAdminPasswordMtimeKey = "admin.passwordMtime"
 	// settingServerSignatureKey designates the key for a server secret key inside a Kubernetes secret.
 	settingServerSignatureKey = "server.secretkey"
+	// settingRepoServerProfilerTokenKey designates the key of the token which authenticates the profiling requests of
+	// the API server to the repo server. The key is mounted into the repo server.
+	settingRepoServerProfilerTokenKey = "reposerver.profiler.token"
 	// gaTrackingID holds Google Analytics tracking id
 	gaTrackingID = "ga.trackingid"
 	// the URL for getting chat help, this will typically be your Slack channel for support
@@ -153,6 +266,10 @@ const (
 	settingsOIDCConfigKey = "oidc.config"
 	// statusBadgeEnabledKey holds the key which enables of disables status badge feature
 	statusBadgeEnabledKey = "statusbadge.enabled"
+	// statusBadgeApplicationsKey holds the comma-separated name patterns of applications which have a status badge
+	statusBadgeApplicationsKey = "statusbadge.applications"
+	// statusBadgeTokenKey is the key of the token which protects the status badge endpoint
+	statusBadgeTokenKey = "statusbadge.token"
 	// settingsWebhookGitHubSecret is the key for the GitHub shared webhook secret
 	settingsWebhookGitHubSecretKey = "webhook.github.secret"
 	// settingsWebhookGitLabSecret is the key for the GitLab shared webhook secret
@@ -177,6 +294,50 @@ const (
 	kustomizeBuildOptions = "kustomize.buildOptions"
 	// anonymousUserEnabledKey is the key which enables or disables anonymous user
 	anonymousUserEnabledKey = "users.anonymous.enabled"
+	// anonymousUserRoleKey is the key of the RBAC role which is granted to the anonymous user
+	anonymousUserRoleKey = "users.anonymous.role"
+	// reconciliationTimeoutKey is the key of the default interval between periodic reconciliations of applications
+	reconciliationTimeoutKey = "timeout.reconciliation"
+	// reconciliationJitterKey is the key of the maximum random delay added to the reconciliation interval of applications
+	reconciliationJitterKey = "timeout.reconciliation.jitter"
+	// revisionHistoryLimitKey is the key of the default number of entries kept in the history of applications
+	revisionHistoryLimitKey = "application.revisionHistoryLimit"
+	// secretsBackendKey is the key of the configuration of the store of repository and cluster credentials
+	secretsBackendKey = "secrets.backend"
+	// sessionSigningKeyKey is the key of the configuration of the external key which signs session tokens
+	sessionSigningKeyKey = "session.signingKey"
+	// projectTemplatesKey is the key to the list of templates from which projects can be created
+	projectTemplatesKey = "projectTemplates"
+	// groupSyncKey is the key of the configuration of the sync of group memberships from the identity provider
+	groupSyncKey = "groups.sync"
+	// clusterCacheKey is the key of the limits of the cache of the resources of managed clusters
+	clusterCacheKey = "controller.clusterCache"
+	// ignoreResourceUpdatesKey is the key of the configuration of the resource updates which do not cause reconciliations
+	ignoreResourceUpdatesKey = "resource.ignoreResourceUpdates"
+	// resourceKindOrderKey is the key of the kinds which are inserted into the order in which resources are applied
+	resourceKindOrderKey = "resource.kindOrder"
+	// inClusterKey is the key of the configuration of the in-cluster destination and its aliases
+	inClusterKey = "cluster.inCluster"
+	// maintenanceKey is the key of the maintenance mode
+	maintenanceKey = "maintenance"
+	// announcementsKey is the key of the list of announcements to all users
+	announcementsKey = "announcements"
+	// webhookRequireSecretKey is the key which rejects the webhook events of providers without secret
+	webhookRequireSecretKey = "webhook.requireSecret"
+	// webhookReplayWindowKey is the key of the period in which repeated deliveries of webhook events are rejected
+	webhookReplayWindowKey = "webhook.replayWindow"
+	// analysisWebhookURLsKey is the key of the URLs which analysis webhooks of applications may call
+	analysisWebhookURLsKey = "analysis.webhookURLs"
+	// accountTokensKeyFormat is the format of the key of the outstanding tokens of a local account inside argocd-secret
+	accountTokensKeyFormat = "accounts.%s.tokens"
+	// userSessionDurationKey is the key of the duration after which the tokens issued to local accounts on login expire
+	userSessionDurationKey = "users.session.duration"
+	// defaultUserSessionDuration is the duration after which login tokens expire unless configured otherwise
+	defaultUserSessionDuration = 24 * time.Hour
+	// defaultAnonymousUserRole is the RBAC role which is granted to the anonymous user unless configured otherwise
+	defaultAnonymousUserRole = "role:readonly"
+	// defaultWebhookReplayWindow is the replay window of webhook events unless configured otherwise
+	defaultWebhookReplayWindow = time.Hour
 )
 
 // SettingsManager holds config info for a new manager with which to access Kubernetes ConfigMaps.
@@ -191,6 +352,9 @@ type SettingsManager struct {
 	// mutex protects concurrency sensitive parts of settings manager: access to subscribers list and initialization flag
 	mutex             *sync.Mutex
 	initContextCancel func()
+	// encryptionKey encrypts the sensitive values which are saved in argocd-secret. Values are stored in plain text
+	// if it is nil.
+	encryptionKey *encryption.Key
 }
 
 type incompleteSettingsError struct {
@@ -292,6 +456,22 @@ func (mgr *SettingsManager) GetConfigManagementPlugins() ([]v1alpha1.ConfigManag
 	return plugins, nil
 }
 
+// GetProjectTemplates loads the project templates from argocd-cm ConfigMap
+func (mgr *SettingsManager) GetProjectTemplates() ([]v1alpha1.ProjectTemplate, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, err
+	}
+	templates := make([]v1alpha1.ProjectTemplate, 0)
+	if value, ok := argoCDCM.Data[projectTemplatesKey]; ok {
+		err := yaml.Unmarshal([]byte(value), &templates)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return templates, nil
+}
+
 // GetResourceOverrides loads Resource Overrides from argocd-cm ConfigMap
 func (mgr *SettingsManager) GetResourceOverrides() (map[string]v1alpha1.ResourceOverride, error) {
 	argoCDCM, err := mgr.getConfigMap()
@@ -309,6 +489,381 @@ func (mgr *SettingsManager) GetResourceOverrides() (map[string]v1alpha1.Resource
 	return resourceOverrides, nil
 }
 
+// GetReconciliationTimeout returns the default interval between periodic reconciliations of applications, or zero
+// if it is not configured in argocd-cm ConfigMap
+func (mgr *SettingsManager) GetReconciliationTimeout() (time.Duration, error) {
+	return mgr.getDuration(reconciliationTimeoutKey)
+}
+
+// GetReconciliationJitter returns the maximum delay added to the reconciliation interval of each application, so that
+// applications created at the same time are not all reconciled at once
+func (mgr *SettingsManager) GetReconciliationJitter() (time.Duration, error) {
+	return mgr.getDuration(reconciliationJitterKey)
+}
+
+// GetRevisionHistoryLimit returns the default number of entries which are kept in the history of an application
+func (mgr *SettingsManager) GetRevisionHistoryLimit() (int, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return 0, err
+	}
+	value, ok := argoCDCM.Data[revisionHistoryLimitKey]
+	if !ok || value == "" {
+		return common.RevisionHistoryLimit, nil
+	}
+	limit, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value of %s: %v", revisionHistoryLimitKey, err)
+	}
+	if limit < 1 {
+		return 0, fmt.Errorf("invalid value of %s: must be at least 1", revisionHistoryLimitKey)
+	}
+	return limit, nil
+}
+
+// GetSecretsBackendConfig returns the configuration of the backend which stores repository and cluster credentials.
+// Credentials are stored in Kubernetes secrets if no backend is configured in argocd-cm ConfigMap.
+func (mgr *SettingsManager) GetSecretsBackendConfig() (*secrets.Config, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, err
+	}
+	config := &secrets.Config{Type: secrets.BackendTypeKubernetes}
+	if value, ok := argoCDCM.Data[secretsBackendKey]; ok {
+		err := yaml.Unmarshal([]byte(value), config)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return config, nil
+}
+
+// GetSessionSigningKeyConfig returns the configuration of the external key which signs session tokens, or nil if
+// tokens are signed with the server secret key of argocd-secret
+func (mgr *SettingsManager) GetSessionSigningKeyConfig() (*signing.Config, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, err
+	}
+	value, ok := argoCDCM.Data[sessionSigningKeyKey]
+	if !ok || value == "" {
+		return nil, nil
+	}
+	config := &signing.Config{}
+	err = yaml.Unmarshal([]byte(value), config)
+	if err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// GetGroupSyncConfig returns the configuration of the sync of group memberships from the identity provider, or nil
+// if groups are not synced. Values which start with '$' are replaced with the values of argocd-secret.
+func (mgr *SettingsManager) GetGroupSyncConfig() (*groupsync.Config, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, err
+	}
+	value, ok := argoCDCM.Data[groupSyncKey]
+	if !ok || value == "" {
+		return nil, nil
+	}
+	config := &groupsync.Config{}
+	err = yaml.Unmarshal([]byte(value), config)
+	if err != nil {
+		return nil, err
+	}
+	argoSettings, err := mgr.GetSettings()
+	if err != nil {
+		return nil, err
+	}
+	if config.Okta != nil {
+		config.Okta.Token = ReplaceStringSecret(config.Okta.Token, argoSettings.Secrets)
+	}
+	if config.Azure != nil {
+		config.Azure.ClientSecret = ReplaceStringSecret(config.Azure.ClientSecret, argoSettings.Secrets)
+	}
+	return config, nil
+}
+
+// GetClusterCacheSettings returns the limits of the cache of the resources of managed clusters. The cache is not
+// limited unless configured in argocd-cm ConfigMap.
+func (mgr *SettingsManager) GetClusterCacheSettings() (ClusterCacheSettings, error) {
+	cacheSettings := ClusterCacheSettings{}
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return cacheSettings, err
+	}
+	if value, ok := argoCDCM.Data[clusterCacheKey]; ok {
+		err = yaml.Unmarshal([]byte(value), &cacheSettings)
+		if err != nil {
+			return cacheSettings, err
+		}
+		if cacheSettings.MaxObjectsPerKind < 0 {
+			return cacheSettings, fmt.Errorf("invalid value of %s: maxObjectsPerKind must not be negative", clusterCacheKey)
+		}
+	}
+	return cacheSettings, nil
+}
+
+// GetIgnoreResourceUpdatesSettings returns which updates of the resources of managed clusters do not cause the
+// reconciliation of applications. All updates cause reconciliations unless configured in argocd-cm ConfigMap.
+func (mgr *SettingsManager) GetIgnoreResourceUpdatesSettings() (IgnoreResourceUpdatesSettings, error) {
+	ignoreSettings := IgnoreResourceUpdatesSettings{}
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return ignoreSettings, err
+	}
+	if value, ok := argoCDCM.Data[ignoreResourceUpdatesKey]; ok {
+		err = yaml.Unmarshal([]byte(value), &ignoreSettings)
+		if err != nil {
+			return ignoreSettings, err
+		}
+	}
+	return ignoreSettings, nil
+}
+
+// GetKindOrder returns the kinds which are inserted into the built-in order in which the resources of a sync wave are
+// applied
+func (mgr *SettingsManager) GetKindOrder() ([]KindOrder, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, err
+	}
+	kindOrder := make([]KindOrder, 0)
+	if value, ok := argoCDCM.Data[resourceKindOrderKey]; ok {
+		err = yaml.Unmarshal([]byte(value), &kindOrder)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, entry := range kindOrder {
+		if entry.Kind == "" {
+			return nil, fmt.Errorf("%s: kind is required", resourceKindOrderKey)
+		}
+		if entry.Before != "" && entry.After != "" {
+			return nil, fmt.Errorf("%s: kind %s can only be applied either before or after another kind", resourceKindOrderKey, entry.Kind)
+		}
+	}
+	return kindOrder, nil
+}
+
+// GetAnalysisWebhookURLs returns the URLs which the analysis webhooks of applications may call. The URL of a webhook
+// must have the scheme and host of an allowed URL and be below its path. No webhook may be called by default.
+func (mgr *SettingsManager) GetAnalysisWebhookURLs() ([]*url.URL, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, err
+	}
+	urls := make([]string, 0)
+	if value, ok := argoCDCM.Data[analysisWebhookURLsKey]; ok {
+		err = yaml.Unmarshal([]byte(value), &urls)
+		if err != nil {
+			return nil, err
+		}
+	}
+	allowed := make([]*url.URL, 0)
+	for _, entry := range urls {
+		allowedURL, err := url.Parse(entry)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", analysisWebhookURLsKey, err)
+		}
+		if (allowedURL.Scheme != "http" && allowedURL.Scheme != "https") || allowedURL.Host == "" {
+			return nil, fmt.Errorf("%s: '%s' is not an absolute http or https URL", analysisWebhookURLsKey, entry)
+		}
+		allowed = append(allowed, allowedURL)
+	}
+	return allowed, nil
+}
+
+// GetInClusterSettings returns the configuration of the implicit destination of the cluster Argo CD runs in
+func (mgr *SettingsManager) GetInClusterSettings() (InClusterSettings, error) {
+	inCluster := InClusterSettings{}
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		// the in-cluster destination is available by default, even if argocd-cm does not exist yet
+		if apierr.IsNotFound(err) {
+			return inCluster, nil
+		}
+		return inCluster, err
+	}
+	if value, ok := argoCDCM.Data[inClusterKey]; ok {
+		err = yaml.Unmarshal([]byte(value), &inCluster)
+		if err != nil {
+			return inCluster, err
+		}
+	}
+	servers := map[string]bool{common.KubernetesInternalAPIServerAddr: true}
+	for _, alias := range inCluster.Aliases {
+		if alias.Server == "" {
+			return inCluster, fmt.Errorf("%s: server of alias is required", inClusterKey)
+		}
+		if servers[alias.Server] {
+			return inCluster, fmt.Errorf("%s: server %s is used more than once", inClusterKey, alias.Server)
+		}
+		servers[alias.Server] = true
+	}
+	return inCluster, nil
+}
+
+// GetMaintenanceMode returns the maintenance mode, which is disabled unless enabled in argocd-cm ConfigMap
+func (mgr *SettingsManager) GetMaintenanceMode() (MaintenanceMode, error) {
+	maintenance := MaintenanceMode{}
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return maintenance, err
+	}
+	if value, ok := argoCDCM.Data[maintenanceKey]; ok {
+		err = yaml.Unmarshal([]byte(value), &maintenance)
+		if err != nil {
+			return maintenance, fmt.Errorf("invalid value of %s: %v", maintenanceKey, err)
+		}
+	}
+	return maintenance, nil
+}
+
+// GetAnnouncements returns the announcements configured in argocd-cm ConfigMap, including the expired ones
+func (mgr *SettingsManager) GetAnnouncements() ([]Announcement, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, err
+	}
+	var announcements []Announcement
+	if value, ok := argoCDCM.Data[announcementsKey]; ok {
+		err = yaml.Unmarshal([]byte(value), &announcements)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value of %s: %v", announcementsKey, err)
+		}
+	}
+	for i := range announcements {
+		if announcements[i].Message == "" {
+			return nil, fmt.Errorf("%s: message of announcement is required", announcementsKey)
+		}
+		switch announcements[i].Severity {
+		case "":
+			announcements[i].Severity = AnnouncementSeverityInfo
+		case AnnouncementSeverityInfo, AnnouncementSeverityWarning, AnnouncementSeverityCritical:
+		default:
+			return nil, fmt.Errorf("%s: unknown severity '%s'", announcementsKey, announcements[i].Severity)
+		}
+	}
+	return announcements, nil
+}
+
+// SaveMaintenanceMode saves the maintenance mode in argocd-cm ConfigMap
+func (mgr *SettingsManager) SaveMaintenanceMode(maintenance MaintenanceMode) error {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return err
+	}
+	argoCDCM = argoCDCM.DeepCopy()
+	if maintenance.Enabled {
+		yamlStr, err := yaml.Marshal(maintenance)
+		if err != nil {
+			return err
+		}
+		argoCDCM.Data[maintenanceKey] = string(yamlStr)
+	} else {
+		delete(argoCDCM.Data, maintenanceKey)
+	}
+	_, err = mgr.clientset.CoreV1().ConfigMaps(mgr.namespace).Update(argoCDCM)
+	if err != nil {
+		return err
+	}
+	return mgr.ResyncInformers()
+}
+
+// AccountToken is a token which Argo CD issued to a local account, e.g. when the account logged in
+type AccountToken struct {
+	ID        string `json:"id"`
+	IssuedAt  int64  `json:"iat"`
+	ExpiresAt int64  `json:"exp,omitempty"`
+}
+
+// GetUserSessionDuration returns the duration after which the tokens issued to local accounts on login expire
+func (mgr *SettingsManager) GetUserSessionDuration() (time.Duration, error) {
+	duration, err := mgr.getDuration(userSessionDurationKey)
+	if err != nil || duration > 0 {
+		return duration, err
+	}
+	return defaultUserSessionDuration, nil
+}
+
+// GetAccountTokens returns the outstanding tokens of the local account, which are read from argocd-secret rather than
+// the informer, so that tokens which were just issued are included
+func (mgr *SettingsManager) GetAccountTokens(username string) ([]AccountToken, error) {
+	argoCDSecret, err := mgr.clientset.CoreV1().Secrets(mgr.namespace).Get(common.ArgoCDSecretName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	key := fmt.Sprintf(accountTokensKeyFormat, username)
+	return parseAccountTokens(key, argoCDSecret.Data[key])
+}
+
+// UpdateAccountTokens updates the outstanding tokens of the local account in argocd-secret, and retries the update on
+// conflicts with concurrent updates. The informers are not resynced, so the settings only include the updated tokens
+// once the informer observed the update.
+func (mgr *SettingsManager) UpdateAccountTokens(username string, update func(tokens []AccountToken) ([]AccountToken, error)) error {
+	key := fmt.Sprintf(accountTokensKeyFormat, username)
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		argoCDSecret, err := mgr.clientset.CoreV1().Secrets(mgr.namespace).Get(common.ArgoCDSecretName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		tokens, err := parseAccountTokens(key, argoCDSecret.Data[key])
+		if err != nil {
+			return err
+		}
+		tokens, err = update(tokens)
+		if err != nil {
+			return err
+		}
+		if argoCDSecret.Data == nil {
+			argoCDSecret.Data = make(map[string][]byte)
+		}
+		if len(tokens) > 0 {
+			value, err := json.Marshal(tokens)
+			if err != nil {
+				return err
+			}
+			argoCDSecret.Data[key] = value
+		} else {
+			delete(argoCDSecret.Data, key)
+		}
+		_, err = mgr.clientset.CoreV1().Secrets(mgr.namespace).Update(argoCDSecret)
+		return err
+	})
+}
+
+func parseAccountTokens(key string, value []byte) ([]AccountToken, error) {
+	var tokens []AccountToken
+	if len(value) > 0 {
+		if err := json.Unmarshal(value, &tokens); err != nil {
+			return nil, fmt.Errorf("invalid value of %s: %v", key, err)
+		}
+	}
+	return tokens, nil
+}
+
+func (mgr *SettingsManager) getDuration(key string) (time.Duration, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return 0, err
+	}
+	value, ok := argoCDCM.Data[key]
+	if !ok || value == "" {
+		return 0, nil
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value of %s: %v", key, err)
+	}
+	if duration < 0 {
+		return 0, fmt.Errorf("invalid value of %s: must not be negative", key)
+	}
+	return duration, nil
+}
+
 // GetKustomizeBuildOptions loads the kustomize build options from argocd-cm ConfigMap
 func (mgr *SettingsManager) GetKustomizeBuildOptions() (string, error) {
 	argoCDCM, err := mgr.getConfigMap()
@@ -409,18 +964,41 @@ func (mgr *SettingsManager) GetSettings() (*ArgoCDSettings, error) {
 	if err != nil {
 		return nil, err
 	}
+	argoCDSecret, err = mgr.decryptSecret(argoCDSecret)
+	if err != nil {
+		return nil, err
+	}
 	var settings ArgoCDSettings
 	var errs []error
 	updateSettingsFromConfigMap(&settings, argoCDCM)
 	if err := updateSettingsFromSecret(&settings, argoCDSecret); err != nil {
 		errs = append(errs, err)
 	}
+	serverTLSSecret, err := mgr.secrets.Secrets(mgr.namespace).Get(common.ArgoCDServerTLSSecretName)
+	if err == nil {
+		if err := updateSettingsFromServerTLSSecret(&settings, serverTLSSecret); err != nil {
+			errs = append(errs, err)
+		}
+	} else if !apierr.IsNotFound(err) {
+		return nil, err
+	}
 	if len(errs) > 0 {
 		return &settings, errs[0]
 	}
 	return &settings, nil
 }
 
+// updateSettingsFromServerTLSSecret replaces the certificate of argocd-secret with the one of a kubernetes.io/tls secret
+func updateSettingsFromServerTLSSecret(settings *ArgoCDSettings, serverTLSSecret *apiv1.Secret) error {
+	cert, err := tls.X509KeyPair(serverTLSSecret.Data[apiv1.TLSCertKey], serverTLSSecret.Data[apiv1.TLSPrivateKeyKey])
+	if err != nil {
+		return &incompleteSettingsError{message: fmt.Sprintf("invalid x509 key pair in secret %s: %s", serverTLSSecret.Name, err)}
+	}
+	settings.Certificate = &cert
+	settings.CertificateIsExternal = true
+	return nil
+}
+
 func (mgr *SettingsManager) initialize(ctx context.Context) error {
 	tweakConfigMap := func(options *metav1.ListOptions) {
 		//cmFieldSelector := fields.ParseSelectorOrDie(fmt.Sprintf("metadata.name=%s", common.ArgoCDConfigMapName))
@@ -502,7 +1080,26 @@ func updateSettingsFromConfigMap(settings *ArgoCDSettings, argoCDCM *apiv1.Confi
 	settings.OIDCConfigRAW = argoCDCM.Data[settingsOIDCConfigKey]
 	settings.URL = argoCDCM.Data[settingURLKey]
 	settings.StatusBadgeEnabled = argoCDCM.Data[statusBadgeEnabledKey] == "true"
+	settings.StatusBadgeApplications = nil
+	for _, pattern := range strings.Split(argoCDCM.Data[statusBadgeApplicationsKey], ",") {
+		if pattern = strings.TrimSpace(pattern); pattern != "" {
+			settings.StatusBadgeApplications = append(settings.StatusBadgeApplications, pattern)
+		}
+	}
 	settings.AnonymousUserEnabled = argoCDCM.Data[anonymousUserEnabledKey] == "true"
+	settings.AnonymousUserRole = argoCDCM.Data[anonymousUserRoleKey]
+	if settings.AnonymousUserRole == "" {
+		settings.AnonymousUserRole = defaultAnonymousUserRole
+	}
+	settings.WebhookRequireSecret = argoCDCM.Data[webhookRequireSecretKey] == "true"
+	settings.WebhookReplayWindow = defaultWebhookReplayWindow
+	if value := argoCDCM.Data[webhookReplayWindowKey]; value != "" {
+		if window, err := time.ParseDuration(value); err != nil || window < 0 {
+			log.Warnf("Invalid value of %s '%s', using %v", webhookReplayWindowKey, value, defaultWebhookReplayWindow)
+		} else {
+			settings.WebhookReplayWindow = window
+		}
+	}
 }
 
 // updateSettingsFromSecret transfers settings from a Kubernetes secret into an ArgoCDSettings struct.
@@ -526,6 +1123,9 @@ func updateSettingsFromSecret(settings *ArgoCDSettings, argoCDSecret *apiv1.Secr
 	} else {
 		errs = append(errs, &incompleteSettingsError{message: "server.secretkey is missing"})
 	}
+	if repoServerProfilerToken := argoCDSecret.Data[settingRepoServerProfilerTokenKey]; len(repoServerProfilerToken) > 0 {
+		settings.RepoServerProfilerToken = string(repoServerProfilerToken)
+	}
 	if githubWebhookSecret := argoCDSecret.Data[settingsWebhookGitHubSecretKey]; len(githubWebhookSecret) > 0 {
 		settings.WebhookGitHubSecret = string(githubWebhookSecret)
 	}
@@ -541,6 +1141,7 @@ func updateSettingsFromSecret(settings *ArgoCDSettings, argoCDSecret *apiv1.Secr
 	if gogsWebhookSecret := argoCDSecret.Data[settingsWebhookGogsSecretKey]; len(gogsWebhookSecret) > 0 {
 		settings.WebhookGogsSecret = string(gogsWebhookSecret)
 	}
+	settings.StatusBadgeToken = string(argoCDSecret.Data[statusBadgeTokenKey])
 
 	serverCert, certOk := argoCDSecret.Data[settingServerCertificate]
 	serverKey, keyOk := argoCDSecret.Data[settingServerPrivateKey]
@@ -564,6 +1165,28 @@ func updateSettingsFromSecret(settings *ArgoCDSettings, argoCDSecret *apiv1.Secr
 }
 
 // SaveSettings serializes ArgoCDSettings and upserts it into K8s secret/configmap
+// decryptSecret returns a copy of the secret with its encrypted values decrypted
+func (mgr *SettingsManager) decryptSecret(secret *apiv1.Secret) (*apiv1.Secret, error) {
+	secret = secret.DeepCopy()
+	for k, v := range secret.Data {
+		value, err := mgr.encryptionKey.DecryptString(string(v))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt %s of secret %s: %v", k, secret.Name, err)
+		}
+		secret.Data[k] = []byte(value)
+	}
+	return secret, nil
+}
+
+// encryptValue encrypts the value if an encryption key is configured
+func (mgr *SettingsManager) encryptValue(value []byte) ([]byte, error) {
+	if mgr.encryptionKey == nil {
+		return value, nil
+	}
+	encrypted, err := mgr.encryptionKey.EncryptString(string(value))
+	return []byte(encrypted), err
+}
+
 func (mgr *SettingsManager) SaveSettings(settings *ArgoCDSettings) error {
 	err := mgr.ensureSynced(false)
 	if err != nil {
@@ -634,6 +1257,10 @@ func (mgr *SettingsManager) SaveSettings(settings *ArgoCDSettings) error {
 	argoCDSecret.Data[settingServerSignatureKey] = settings.ServerSignature
 	argoCDSecret.Data[settingAdminPasswordHashKey] = []byte(settings.AdminPasswordHash)
 	argoCDSecret.Data[settingAdminPasswordMtimeKey] = []byte(settings.AdminPasswordMtime.Format(time.RFC3339))
+	// the token is not encrypted, since the repo server reads it from the mounted secret
+	if settings.RepoServerProfilerToken != "" {
+		argoCDSecret.Data[settingRepoServerProfilerTokenKey] = []byte(settings.RepoServerProfilerToken)
+	}
 	if settings.WebhookGitHubSecret != "" {
 		argoCDSecret.Data[settingsWebhookGitHubSecretKey] = []byte(settings.WebhookGitHubSecret)
 	}
@@ -650,13 +1277,32 @@ func (mgr *SettingsManager) SaveSettings(settings *ArgoCDSettings) error {
 		argoCDSecret.Data[settingsWebhookGogsSecretKey] = []byte(settings.WebhookGogsSecret)
 	}
 	if settings.Certificate != nil {
-		cert, key := tlsutil.EncodeX509KeyPair(*settings.Certificate)
-		argoCDSecret.Data[settingServerCertificate] = cert
-		argoCDSecret.Data[settingServerPrivateKey] = key
+		if !settings.CertificateIsExternal {
+			cert, key := tlsutil.EncodeX509KeyPair(*settings.Certificate)
+			argoCDSecret.Data[settingServerCertificate] = cert
+			argoCDSecret.Data[settingServerPrivateKey] = key
+		}
 	} else {
 		delete(argoCDSecret.Data, settingServerCertificate)
 		delete(argoCDSecret.Data, settingServerPrivateKey)
 	}
+	for _, key := range []string{
+		settingServerSignatureKey,
+		settingAdminPasswordHashKey,
+		settingServerPrivateKey,
+		settingsWebhookGitHubSecretKey,
+		settingsWebhookGitLabSecretKey,
+		settingsWebhookBitbucketUUIDKey,
+		settingsWebhookBitbucketServerSecretKey,
+		settingsWebhookGogsSecretKey,
+	} {
+		// values which were left untouched might be encrypted already
+		if value, ok := argoCDSecret.Data[key]; ok && !encryption.IsEncryptedString(string(value)) {
+			if argoCDSecret.Data[key], err = mgr.encryptValue(value); err != nil {
+				return err
+			}
+		}
+	}
 	if createSecret {
 		_, err = mgr.clientset.CoreV1().Secrets(mgr.namespace).Create(argoCDSecret)
 	} else {
@@ -718,10 +1364,11 @@ func (mgr *SettingsManager) SaveTLSCertificateData(ctx context.Context, tlsCerti
 func NewSettingsManager(ctx context.Context, clientset kubernetes.Interface, namespace string) *SettingsManager {
 
 	mgr := &SettingsManager{
-		ctx:       ctx,
-		clientset: clientset,
-		namespace: namespace,
-		mutex:     &sync.Mutex{},
+		ctx:           ctx,
+		clientset:     clientset,
+		namespace:     namespace,
+		mutex:         &sync.Mutex{},
+		encryptionKey: encryption.NewKeyFromEnv(encryption.PurposeSettings),
 	}
 
 	return mgr
@@ -731,6 +1378,25 @@ func (mgr *SettingsManager) ResyncInformers() error {
 	return mgr.ensureSynced(true)
 }
 
+// IsStatusBadgeEnabled returns whether the status badge of the given application is enabled
+func (a *ArgoCDSettings) IsStatusBadgeEnabled(appName string) bool {
+	if !a.StatusBadgeEnabled {
+		return false
+	}
+	for _, pattern := range a.StatusBadgeApplications {
+		if match(pattern, appName) {
+			return true
+		}
+	}
+	return len(a.StatusBadgeApplications) == 0
+}
+
+// GetAccountTokens returns the outstanding tokens of the local account
+func (a *ArgoCDSettings) GetAccountTokens(username string) ([]AccountToken, error) {
+	key := fmt.Sprintf(accountTokensKeyFormat, username)
+	return parseAccountTokens(key, []byte(a.Secrets[key]))
+}
+
 // IsSSOConfigured returns whether or not single-sign-on is configured
 func (a *ArgoCDSettings) IsSSOConfigured() bool {
 	if a.IsDexConfigured() {
@@ -846,6 +1512,21 @@ func (a *ArgoCDSettings) DexOAuth2ClientSecret() string {
 	return base64.URLEncoding.EncodeToString(sha)[:40]
 }
 
+// ProfilerToken calculates a token derived from the server secret, which authenticates the requests of the API server
+// to the profiler of the application controller. Like the dex client secret, both sides derive it independently.
+// Returns an empty token, which is never accepted, if the server secret is not yet initialized.
+func (a *ArgoCDSettings) ProfilerToken() string {
+	if len(a.ServerSignature) == 0 {
+		return ""
+	}
+	h := sha256.New()
+	_, err := h.Write(append([]byte("profiler:"), a.ServerSignature...))
+	if err != nil {
+		panic(err)
+	}
+	return base64.URLEncoding.EncodeToString(h.Sum(nil))
+}
+
 // Subscribe registers a channel in which to subscribe to settings updates
 func (mgr *SettingsManager) Subscribe(subCh chan<- *ArgoCDSettings) {
 	mgr.mutex.Lock()
@@ -901,6 +1582,14 @@ func (mgr *SettingsManager) InitializeSettings(insecureModeEnabled bool) (*ArgoC
 		cdSettings.ServerSignature = signature
 		log.Info("Initialized server signature")
 	}
+	if cdSettings.RepoServerProfilerToken == "" {
+		token, err := util.MakeSignature(32)
+		if err != nil {
+			return nil, err
+		}
+		cdSettings.RepoServerProfilerToken = base64.URLEncoding.EncodeToString(token)
+		log.Info("Initialized repo server profiler token")
+	}
 	if cdSettings.AdminPasswordHash == "" {
 		defaultPassword, err := os.Hostname()
 		if err != nil {
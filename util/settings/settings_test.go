@@ -3,9 +3,12 @@ package settings
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/argoproj/argo-cd/common"
 	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/encryption"
+	tlsutil "github.com/argoproj/argo-cd/util/tls"
 
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
@@ -88,6 +91,25 @@ func TestGetConfigManagementPlugins(t *testing.T) {
 	}}, plugins)
 }
 
+func TestGetProjectTemplates(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{
+		"projectTemplates": `
+      - name: team
+        parameters:
+        - name: team
+          required: true
+        spec:
+          sourceRepos: ['https://github.com/org/{{team}}-*']`,
+	})
+	templates, err := settingsManager.GetProjectTemplates()
+	assert.NoError(t, err)
+	assert.Equal(t, []v1alpha1.ProjectTemplate{{
+		Name:       "team",
+		Parameters: []v1alpha1.ProjectTemplateParameter{{Name: "team", Required: true}},
+		Spec:       v1alpha1.AppProjectSpec{SourceRepos: []string{"https://github.com/org/{{team}}-*"}},
+	}}, templates)
+}
+
 func TestGetAppInstanceLabelKey(t *testing.T) {
 	_, settingsManager := fixtures(map[string]string{
 		"application.instanceLabelKey": "testLabel",
@@ -97,6 +119,127 @@ func TestGetAppInstanceLabelKey(t *testing.T) {
 	assert.Equal(t, "testLabel", label)
 }
 
+func TestGetReconciliationTimeout(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{})
+	timeout, err := settingsManager.GetReconciliationTimeout()
+	assert.NoError(t, err)
+	assert.Equal(t, time.Duration(0), timeout)
+
+	_, settingsManager = fixtures(map[string]string{
+		"timeout.reconciliation":        "5m",
+		"timeout.reconciliation.jitter": "30s",
+	})
+	timeout, err = settingsManager.GetReconciliationTimeout()
+	assert.NoError(t, err)
+	assert.Equal(t, 5*time.Minute, timeout)
+	jitter, err := settingsManager.GetReconciliationJitter()
+	assert.NoError(t, err)
+	assert.Equal(t, 30*time.Second, jitter)
+
+	_, settingsManager = fixtures(map[string]string{
+		"timeout.reconciliation": "five minutes",
+	})
+	_, err = settingsManager.GetReconciliationTimeout()
+	assert.Error(t, err)
+}
+
+func TestGetRevisionHistoryLimit(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{})
+	limit, err := settingsManager.GetRevisionHistoryLimit()
+	assert.NoError(t, err)
+	assert.Equal(t, common.RevisionHistoryLimit, limit)
+
+	_, settingsManager = fixtures(map[string]string{"application.revisionHistoryLimit": "3"})
+	limit, err = settingsManager.GetRevisionHistoryLimit()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, limit)
+
+	_, settingsManager = fixtures(map[string]string{"application.revisionHistoryLimit": "0"})
+	_, err = settingsManager.GetRevisionHistoryLimit()
+	assert.Error(t, err)
+}
+
+func TestGetClusterCacheSettings(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{})
+	cacheSettings, err := settingsManager.GetClusterCacheSettings()
+	assert.NoError(t, err)
+	assert.Equal(t, ClusterCacheSettings{}, cacheSettings)
+
+	_, settingsManager = fixtures(map[string]string{
+		"controller.clusterCache": `
+maxObjectsPerKind: 5000
+stripManagedFields: true`,
+	})
+	cacheSettings, err = settingsManager.GetClusterCacheSettings()
+	assert.NoError(t, err)
+	assert.Equal(t, ClusterCacheSettings{MaxObjectsPerKind: 5000, StripManagedFields: true}, cacheSettings)
+
+	_, settingsManager = fixtures(map[string]string{"controller.clusterCache": "maxObjectsPerKind: -1"})
+	_, err = settingsManager.GetClusterCacheSettings()
+	assert.Error(t, err)
+}
+
+func TestGetIgnoreResourceUpdatesSettings(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{})
+	ignoreSettings, err := settingsManager.GetIgnoreResourceUpdatesSettings()
+	assert.NoError(t, err)
+	assert.False(t, ignoreSettings.Enabled)
+
+	_, settingsManager = fixtures(map[string]string{
+		"resource.ignoreResourceUpdates": `
+enabled: true
+jsonPointers:
+- /metadata/annotations/prometheus.io~1scrape`,
+	})
+	ignoreSettings, err = settingsManager.GetIgnoreResourceUpdatesSettings()
+	assert.NoError(t, err)
+	assert.True(t, ignoreSettings.Enabled)
+	assert.Equal(t, []string{"/metadata/annotations/prometheus.io~1scrape"}, ignoreSettings.JSONPointers)
+}
+
+func TestGetSecretsBackendConfig(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{})
+	config, err := settingsManager.GetSecretsBackendConfig()
+	assert.NoError(t, err)
+	assert.False(t, config.IsExternal())
+
+	_, settingsManager = fixtures(map[string]string{
+		"secrets.backend": `
+type: vault
+cacheExpiration: 1m
+vault:
+  address: https://vault:8200
+  role: argocd`,
+	})
+	config, err = settingsManager.GetSecretsBackendConfig()
+	assert.NoError(t, err)
+	assert.True(t, config.IsExternal())
+	assert.Equal(t, "1m", config.CacheExpiration)
+	assert.Equal(t, "https://vault:8200", config.Vault.Address)
+	assert.Equal(t, "argocd", config.Vault.Role)
+}
+
+func TestGetSessionSigningKeyConfig(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{})
+	config, err := settingsManager.GetSessionSigningKeyConfig()
+	assert.NoError(t, err)
+	assert.Nil(t, config)
+
+	_, settingsManager = fixtures(map[string]string{
+		"session.signingKey": `
+type: file
+file:
+  path: /app/config/session/tls.key
+  verificationKeyPaths:
+  - /app/config/session/previous.pub`,
+	})
+	config, err = settingsManager.GetSessionSigningKeyConfig()
+	assert.NoError(t, err)
+	assert.Equal(t, "file", config.Type)
+	assert.Equal(t, "/app/config/session/tls.key", config.File.Path)
+	assert.Equal(t, []string{"/app/config/session/previous.pub"}, config.File.VerificationKeyPaths)
+}
+
 func TestGetResourceOverrides(t *testing.T) {
 	_, settingsManager := fixtures(map[string]string{
 		"resource.customizations": `
@@ -206,6 +349,36 @@ func TestGetOIDCConfig(t *testing.T) {
 	assert.Equal(t, true, claim.Essential)
 }
 
+func TestSettingsEncryption(t *testing.T) {
+	kubeClient, settingsManager := fixtures(map[string]string{})
+	settingsManager.encryptionKey = encryption.NewKey([]byte("secret"), encryption.PurposeSettings)
+
+	err := settingsManager.SaveSettings(&ArgoCDSettings{
+		AdminPasswordHash:   "hash",
+		ServerSignature:     []byte("signature"),
+		WebhookGitHubSecret: "github-secret",
+	})
+	assert.NoError(t, err)
+
+	secret, err := kubeClient.CoreV1().Secrets("default").Get(common.ArgoCDSecretName, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.True(t, encryption.IsEncryptedString(string(secret.Data["webhook.github.secret"])))
+	assert.True(t, encryption.IsEncryptedString(string(secret.Data["server.secretkey"])))
+	assert.False(t, encryption.IsEncryptedString(string(secret.Data["admin.passwordMtime"])))
+
+	settings, err := settingsManager.GetSettings()
+	assert.NoError(t, err)
+	assert.Equal(t, "github-secret", settings.WebhookGitHubSecret)
+	assert.Equal(t, "signature", string(settings.ServerSignature))
+	assert.Equal(t, "hash", settings.AdminPasswordHash)
+	assert.Equal(t, "github-secret", settings.Secrets["webhook.github.secret"])
+
+	// encrypted values cannot be read without the key
+	settingsManager = NewSettingsManager(context.Background(), kubeClient, "default")
+	_, err = settingsManager.GetSettings()
+	assert.Error(t, err)
+}
+
 func TestRedirectURL(t *testing.T) {
 	cases := map[string][]string{
 		"https://localhost:4000":         {"https://localhost:4000/auth/callback", "https://localhost:4000/api/dex/callback"},
@@ -223,3 +396,161 @@ func TestRedirectURL(t *testing.T) {
 		assert.Equal(t, expected[1], dexRedirectURL)
 	}
 }
+
+func TestGetSettings_ServerTLSSecret(t *testing.T) {
+	kubeClient, settingsManager := fixtures(map[string]string{})
+	err := settingsManager.SaveSettings(&ArgoCDSettings{AdminPasswordHash: "hash", ServerSignature: []byte("signature")})
+	assert.NoError(t, err)
+	settings, err := settingsManager.GetSettings()
+	assert.NoError(t, err)
+	assert.Nil(t, settings.Certificate)
+
+	cert, err := tlsutil.GenerateX509KeyPair(tlsutil.CertOptions{Hosts: []string{"argocd.example.com"}, Organization: "Example", ECDSACurve: "P256"})
+	assert.NoError(t, err)
+	certPEM, keyPEM := tlsutil.EncodeX509KeyPair(*cert)
+	_, err = kubeClient.CoreV1().Secrets("default").Create(&v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDServerTLSSecretName, Namespace: "default"},
+		Type:       v1.SecretTypeTLS,
+		Data:       map[string][]byte{v1.TLSCertKey: certPEM, v1.TLSPrivateKeyKey: keyPEM},
+	})
+	assert.NoError(t, err)
+	// wait for the informer to pick up the secret
+	for i := 0; i < 100; i++ {
+		settings, err = settingsManager.GetSettings()
+		if err == nil && settings.Certificate != nil {
+			break
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	assert.NoError(t, err)
+	assert.True(t, settings.CertificateIsExternal)
+	assert.Equal(t, cert.Certificate, settings.Certificate.Certificate)
+
+	// the external certificate is not copied to argocd-secret
+	assert.NoError(t, settingsManager.SaveSettings(settings))
+	secret, err := kubeClient.CoreV1().Secrets("default").Get(common.ArgoCDSecretName, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.NotContains(t, secret.Data, "tls.crt")
+}
+
+func TestGetInClusterSettings(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{})
+	inCluster, err := settingsManager.GetInClusterSettings()
+	assert.NoError(t, err)
+	assert.Equal(t, InClusterSettings{}, inCluster)
+
+	_, settingsManager = fixtures(map[string]string{
+		"cluster.inCluster": `
+name: in-cluster
+aliases:
+- server: https://prod.in-cluster
+  namespaces: [prod-*]`,
+	})
+	inCluster, err = settingsManager.GetInClusterSettings()
+	assert.NoError(t, err)
+	assert.Equal(t, InClusterSettings{
+		Name:    "in-cluster",
+		Aliases: []InClusterAlias{{Server: "https://prod.in-cluster", Namespaces: []string{"prod-*"}}},
+	}, inCluster)
+
+	_, settingsManager = fixtures(map[string]string{"cluster.inCluster": "aliases:\n- server: https://kubernetes.default.svc"})
+	_, err = settingsManager.GetInClusterSettings()
+	assert.Error(t, err)
+
+	_, settingsManager = fixtures(map[string]string{"cluster.inCluster": "aliases:\n- name: prod"})
+	_, err = settingsManager.GetInClusterSettings()
+	assert.Error(t, err)
+}
+
+func TestSaveMaintenanceMode(t *testing.T) {
+	kubeClient, settingsManager := fixtures(map[string]string{})
+	maintenance, err := settingsManager.GetMaintenanceMode()
+	assert.NoError(t, err)
+	assert.False(t, maintenance.Enabled)
+
+	err = settingsManager.SaveMaintenanceMode(MaintenanceMode{Enabled: true, Message: "upgrading", EnabledBy: "admin"})
+	assert.NoError(t, err)
+	maintenance, err = settingsManager.GetMaintenanceMode()
+	assert.NoError(t, err)
+	assert.Equal(t, MaintenanceMode{Enabled: true, Message: "upgrading", EnabledBy: "admin"}, maintenance)
+
+	err = settingsManager.SaveMaintenanceMode(MaintenanceMode{})
+	assert.NoError(t, err)
+	cm, err := kubeClient.CoreV1().ConfigMaps("default").Get(common.ArgoCDConfigMapName, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.NotContains(t, cm.Data, "maintenance")
+}
+
+func TestGetAnnouncements(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{})
+	announcements, err := settingsManager.GetAnnouncements()
+	assert.NoError(t, err)
+	assert.Empty(t, announcements)
+
+	_, settingsManager = fixtures(map[string]string{
+		"announcements": `
+- message: Argo CD is upgraded on Friday
+  severity: warning
+  url: https://status.example.com
+  expiresAt: "2019-10-18T18:00:00Z"
+- message: Welcome`,
+	})
+	announcements, err = settingsManager.GetAnnouncements()
+	assert.NoError(t, err)
+	if assert.Len(t, announcements, 2) {
+		assert.Equal(t, AnnouncementSeverityWarning, announcements[0].Severity)
+		assert.Equal(t, "https://status.example.com", announcements[0].URL)
+		assert.False(t, announcements[0].IsExpired(time.Date(2019, 10, 18, 17, 0, 0, 0, time.UTC)))
+		assert.True(t, announcements[0].IsExpired(time.Date(2019, 10, 18, 18, 0, 0, 0, time.UTC)))
+		assert.Equal(t, AnnouncementSeverityInfo, announcements[1].Severity)
+		assert.False(t, announcements[1].IsExpired(time.Now()))
+	}
+
+	_, settingsManager = fixtures(map[string]string{"announcements": "- message: Welcome\n  severity: urgent"})
+	_, err = settingsManager.GetAnnouncements()
+	assert.Error(t, err)
+
+	_, settingsManager = fixtures(map[string]string{"announcements": "- severity: info"})
+	_, err = settingsManager.GetAnnouncements()
+	assert.Error(t, err)
+}
+
+func TestGetKindOrder(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{})
+	kindOrder, err := settingsManager.GetKindOrder()
+	assert.NoError(t, err)
+	assert.Empty(t, kindOrder)
+
+	_, settingsManager = fixtures(map[string]string{
+		"resource.kindOrder": `
+- kind: Issuer
+  before: Secret
+- kind: Certificate
+  after: Issuer`,
+	})
+	kindOrder, err = settingsManager.GetKindOrder()
+	assert.NoError(t, err)
+	assert.Equal(t, []KindOrder{{Kind: "Issuer", Before: "Secret"}, {Kind: "Certificate", After: "Issuer"}}, kindOrder)
+
+	_, settingsManager = fixtures(map[string]string{"resource.kindOrder": "- kind: Issuer\n  before: Secret\n  after: Namespace"})
+	_, err = settingsManager.GetKindOrder()
+	assert.Error(t, err)
+}
+
+func TestGetAnalysisWebhookURLs(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{})
+	urls, err := settingsManager.GetAnalysisWebhookURLs()
+	assert.NoError(t, err)
+	assert.Empty(t, urls)
+
+	_, settingsManager = fixtures(map[string]string{"analysis.webhookURLs": "- https://analysis.example.com/argocd/"})
+	urls, err = settingsManager.GetAnalysisWebhookURLs()
+	assert.NoError(t, err)
+	if assert.Len(t, urls, 1) {
+		assert.Equal(t, "https://analysis.example.com/argocd/", urls[0].String())
+	}
+
+	_, settingsManager = fixtures(map[string]string{"analysis.webhookURLs": "- /argocd"})
+	_, err = settingsManager.GetAnalysisWebhookURLs()
+	assert.Error(t, err)
+}
@@ -0,0 +1,168 @@
+package sops
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	argoexec "github.com/argoproj/pkg/exec"
+	"github.com/ghodss/yaml"
+
+	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/util/config"
+)
+
+const (
+	// ageKeysFile is the file of a key set which contains age identities
+	ageKeysFile = "age.txt"
+	// pgpKeysFile is the file of a key set which contains armored PGP private keys
+	pgpKeysFile = "pgp.asc"
+	// awsCredentialsFile is the file of a key set which contains AWS credentials which are allowed to use KMS keys
+	awsCredentialsFile = "aws-credentials"
+	// gcpCredentialsFile is the file of a key set which contains a GCP service account key which is allowed to use KMS
+	// keys
+	gcpCredentialsFile = "gcp-credentials.json"
+)
+
+// metadata is the `sops` section which SOPS adds to encrypted files
+type metadata struct {
+	MAC string `json:"mac"`
+}
+
+func parseMetadata(data []byte) *metadata {
+	var file struct {
+		SOPS *metadata `json:"sops"`
+	}
+	if err := yaml.Unmarshal(data, &file); err != nil || file.SOPS == nil || file.SOPS.MAC == "" {
+		return nil
+	}
+	return file.SOPS
+}
+
+// IsEncrypted returns whether the given YAML or JSON document has been encrypted using SOPS
+func IsEncrypted(data []byte) bool {
+	return parseMetadata(data) != nil
+}
+
+// GetKeysPath returns the directory which contains the key sets of the repo server, one subdirectory per key set. If
+// ARGOCD_SOPS_KEYS_PATH environment is set, path is taken from there, otherwise the default will be returned.
+func GetKeysPath() string {
+	if envPath := os.Getenv(common.EnvVarSOPSKeysPath); envPath != "" {
+		return envPath
+	}
+	return common.DefaultPathSOPSKeys
+}
+
+// permittedKeySets returns the directories of the key sets in keysPath whose names match one of the permitted patterns
+func permittedKeySets(keysPath string, permitted []string) ([]string, error) {
+	entries, err := ioutil.ReadDir(keysPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var dirs []string
+	for _, entry := range entries {
+		// mounted secrets and config maps contain hidden directories, e.g. ..data
+		if strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		if info, err := os.Stat(filepath.Join(keysPath, entry.Name())); err != nil || !info.IsDir() {
+			continue
+		}
+		for _, pattern := range permitted {
+			if ok, err := filepath.Match(pattern, entry.Name()); pattern == "*" || (ok && err == nil) {
+				dirs = append(dirs, filepath.Join(keysPath, entry.Name()))
+				break
+			}
+		}
+	}
+	return dirs, nil
+}
+
+// keySetEnviron returns the environment in which sops only has access to the keys of the key set in dir. home is an
+// empty directory which is used as the home directory, so that keys in the default locations of the repo server are
+// not used either.
+func keySetEnviron(dir string, home string) ([]string, error) {
+	env := []string{
+		"PATH=" + os.Getenv("PATH"),
+		"HOME=" + home,
+		"GNUPGHOME=" + filepath.Join(home, ".gnupg"),
+		"SOPS_AGE_KEY_FILE=" + filepath.Join(dir, ageKeysFile),
+		// the instance credentials of the repo server must not be used to decrypt files
+		"AWS_EC2_METADATA_DISABLED=true",
+		"AWS_SHARED_CREDENTIALS_FILE=" + filepath.Join(dir, awsCredentialsFile),
+		"AWS_CONFIG_FILE=" + filepath.Join(home, ".aws", "config"),
+		"GCE_METADATA_HOST=127.0.0.1:1",
+	}
+	if _, err := os.Stat(filepath.Join(dir, gcpCredentialsFile)); err == nil {
+		env = append(env, "GOOGLE_APPLICATION_CREDENTIALS="+filepath.Join(dir, gcpCredentialsFile))
+	}
+	if err := os.Mkdir(filepath.Join(home, ".gnupg"), 0700); err != nil {
+		return nil, err
+	}
+	if _, err := os.Stat(filepath.Join(dir, pgpKeysFile)); err == nil {
+		cmd := exec.Command("gpg", "--batch", "--import", filepath.Join(dir, pgpKeysFile))
+		cmd.Env = env
+		if _, err := argoexec.RunCommandExt(cmd, config.CmdOpts()); err != nil {
+			return nil, err
+		}
+	}
+	return env, nil
+}
+
+// DecryptFile decrypts the SOPS encrypted file at src into dest using the `sops` command-line tool. sops only has
+// access to the key sets of the repo server whose names match one of the permitted patterns, which are tried one after
+// another, so the recipients which the file claims are not trusted.
+func DecryptFile(src string, dest string, permittedKeys []string) error {
+	return decryptFile(GetKeysPath(), src, dest, permittedKeys)
+}
+
+func decryptFile(keysPath string, src string, dest string, permittedKeys []string) error {
+	data, err := ioutil.ReadFile(src)
+	if err != nil {
+		return err
+	}
+	if !IsEncrypted(data) {
+		return fmt.Errorf("%s is not encrypted using SOPS", filepath.Base(src))
+	}
+	keySets, err := permittedKeySets(keysPath, permittedKeys)
+	if err != nil {
+		return err
+	}
+	if len(keySets) == 0 {
+		return fmt.Errorf("%s cannot be decrypted: the project does not permit any decryption keys of the repo server", filepath.Base(src))
+	}
+	format := "yaml"
+	if filepath.Ext(src) == ".json" {
+		format = "json"
+	}
+	for _, dir := range keySets {
+		err = decryptWithKeySet(dir, src, dest, format)
+		if err == nil {
+			return nil
+		}
+	}
+	return fmt.Errorf("%s cannot be decrypted with the decryption keys permitted by the project: %v", filepath.Base(src), err)
+}
+
+func decryptWithKeySet(dir string, src string, dest string, format string) error {
+	home, err := ioutil.TempDir("", "sops")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.RemoveAll(home) }()
+	env, err := keySetEnviron(dir, home)
+	if err != nil {
+		return err
+	}
+	// decrypted data is written to the destination file rather than stdout so that it does not end up in debug logs
+	cmd := exec.Command("sops", "--decrypt", "--input-type", format, "--output-type", format, "--output", dest, src)
+	cmd.Env = env
+	_, err = argoexec.RunCommandExt(cmd, config.CmdOpts())
+	return err
+}
@@ -0,0 +1,120 @@
+package sops
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const encryptedValues = `password: ENC[AES256_GCM,data:Zm9v,iv:YmFy,tag:YmF6,type:str]
+sops:
+  kms:
+  - arn: arn:aws:kms:us-east-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab
+  age:
+  - recipient: age1ql3z7hjy54pw3hyww5ayyfg7zqgvc7w3j2elw8zmrj2kg5sfn9aqmcac8p
+  pgp:
+  - fp: fbc7b9e2a4f9289ac0c1d4843d16ceee9d47e2fb
+  mac: ENC[AES256_GCM,data:bWFj,iv:YmFy,tag:YmF6,type:str]
+  version: 3.7.1
+`
+
+// fakeSOPS copies the age keys which it has access to into the output file, and fails without age keys
+const fakeSOPS = `#!/bin/sh
+while [ $# -gt 1 ]; do
+  if [ "$1" = "--output" ]; then out=$2; fi
+  shift
+done
+[ -f "$SOPS_AGE_KEY_FILE" ] || exit 1
+cp "$SOPS_AGE_KEY_FILE" "$out"
+`
+
+func TestIsEncrypted(t *testing.T) {
+	assert.True(t, IsEncrypted([]byte(encryptedValues)))
+	assert.False(t, IsEncrypted([]byte("password: foo")))
+	assert.False(t, IsEncrypted([]byte("sops:\n  enabled: true")))
+	assert.False(t, IsEncrypted([]byte("not: [valid")))
+}
+
+// newKeySets creates the key sets team-a, which has an age key, and team-b and team-c, which do not
+func newKeySets(t *testing.T) string {
+	keysPath, err := ioutil.TempDir("", "keys")
+	assert.NoError(t, err)
+	for _, name := range []string{"team-a", "team-b", "team-c", "..data"} {
+		assert.NoError(t, os.Mkdir(filepath.Join(keysPath, name), 0700))
+	}
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(keysPath, "team-a", ageKeysFile), []byte("AGE-SECRET-KEY-TEAM-A"), 0600))
+	return keysPath
+}
+
+func TestPermittedKeySets(t *testing.T) {
+	keysPath := newKeySets(t)
+	defer func() { _ = os.RemoveAll(keysPath) }()
+
+	dirs, err := permittedKeySets(keysPath, []string{"team-b"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(keysPath, "team-b")}, dirs)
+
+	dirs, err = permittedKeySets(keysPath, []string{"team-[ab]", "team-a"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(keysPath, "team-a"), filepath.Join(keysPath, "team-b")}, dirs)
+
+	dirs, err = permittedKeySets(keysPath, []string{"*"})
+	assert.NoError(t, err)
+	assert.Len(t, dirs, 3)
+
+	dirs, err = permittedKeySets(keysPath, []string{"age1ql3z7hjy54pw3hyww5ayyfg7zqgvc7w3j2elw8zmrj2kg5sfn9aqmcac8p", "../*"})
+	assert.NoError(t, err)
+	assert.Empty(t, dirs)
+
+	dirs, err = permittedKeySets(filepath.Join(keysPath, "missing"), []string{"*"})
+	assert.NoError(t, err)
+	assert.Empty(t, dirs)
+}
+
+func TestKeySetEnviron(t *testing.T) {
+	keysPath := newKeySets(t)
+	defer func() { _ = os.RemoveAll(keysPath) }()
+	home, err := ioutil.TempDir("", "home")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(home) }()
+
+	env, err := keySetEnviron(filepath.Join(keysPath, "team-a"), home)
+	assert.NoError(t, err)
+	assert.Contains(t, env, "HOME="+home)
+	assert.Contains(t, env, "SOPS_AGE_KEY_FILE="+filepath.Join(keysPath, "team-a", ageKeysFile))
+	assert.Contains(t, env, "AWS_EC2_METADATA_DISABLED=true")
+	for _, entry := range env {
+		assert.NotContains(t, entry, "GOOGLE_APPLICATION_CREDENTIALS")
+	}
+}
+
+func TestDecryptFile(t *testing.T) {
+	keysPath := newKeySets(t)
+	defer func() { _ = os.RemoveAll(keysPath) }()
+	dir, err := ioutil.TempDir("", "sops")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	src := filepath.Join(dir, "secrets.yaml")
+	assert.NoError(t, ioutil.WriteFile(src, []byte(encryptedValues), 0600))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "sops"), []byte(fakeSOPS), 0700))
+	path := os.Getenv("PATH")
+	defer func() { _ = os.Setenv("PATH", path) }()
+	assert.NoError(t, os.Setenv("PATH", dir+string(os.PathListSeparator)+path))
+	dest := filepath.Join(dir, "decrypted.yaml")
+
+	// the recipients listed in the file do not grant access to any keys
+	err = decryptFile(keysPath, src, dest, []string{"age1ql3z7hjy54pw3hyww5ayyfg7zqgvc7w3j2elw8zmrj2kg5sfn9aqmcac8p"})
+	assert.EqualError(t, err, "secrets.yaml cannot be decrypted: the project does not permit any decryption keys of the repo server")
+
+	err = decryptFile(keysPath, src, dest, []string{"team-b"})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "secrets.yaml cannot be decrypted with the decryption keys permitted by the project")
+
+	assert.NoError(t, decryptFile(keysPath, src, dest, []string{"team-b", "team-a"}))
+	decrypted, err := ioutil.ReadFile(dest)
+	assert.NoError(t, err)
+	assert.Equal(t, "AGE-SECRET-KEY-TEAM-A", string(decrypted))
+}
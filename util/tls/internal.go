@@ -0,0 +1,179 @@
+package tls
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	// InternalCertFileName is the name of the file of the certificate of internal connections
+	InternalCertFileName = "tls.crt"
+	// InternalKeyFileName is the name of the file of the private key of the certificate
+	InternalKeyFileName = "tls.key"
+	// InternalCAFileName is the name of the file of the CA which issues the certificates of all components
+	InternalCAFileName = "ca.crt"
+)
+
+// InternalCerts secures the connections between the Argo CD components with mutual TLS. The certificates are loaded
+// from a directory with the layout of the kubernetes.io/tls secrets which cert-manager issues, i.e. tls.crt, tls.key
+// and ca.crt, and are reloaded when the files change, so that rotated certificates are used without a restart.
+type InternalCerts struct {
+	dir string
+
+	lock    sync.Mutex
+	modTime time.Time
+	cert    *tls.Certificate
+	caPool  *x509.CertPool
+}
+
+// NewInternalCerts returns the certificates of the directory, or an error if they cannot be loaded
+func NewInternalCerts(dir string) (*InternalCerts, error) {
+	c := &InternalCerts{dir: dir}
+	if _, _, err := c.load(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// AddInternalTLSFlagsToCmd adds the flag of the directory of the certificates of internal connections. The returned
+// function returns nil if the flag is not set, i.e. if internal connections do not use mutual TLS.
+func AddInternalTLSFlagsToCmd(cmd *cobra.Command) func() (*InternalCerts, error) {
+	dir := ""
+	cmd.Flags().StringVar(&dir, "internal-tls-dir", "", "Directory of the certificate (tls.crt, tls.key) and CA (ca.crt) which secure the connections between Argo CD components with mutual TLS")
+	return func() (*InternalCerts, error) {
+		if dir == "" {
+			return nil, nil
+		}
+		return NewInternalCerts(dir)
+	}
+}
+
+// load returns the certificate and CA pool, which are read again if any of the files was modified since they were last
+// read. Secret volumes replace all files at once, so a certificate never mismatches its key.
+func (c *InternalCerts) load() (*tls.Certificate, *x509.CertPool, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	var modTime time.Time
+	for _, name := range []string{InternalCertFileName, InternalKeyFileName, InternalCAFileName} {
+		info, err := os.Stat(filepath.Join(c.dir, name))
+		if err != nil {
+			return nil, nil, err
+		}
+		if info.ModTime().After(modTime) {
+			modTime = info.ModTime()
+		}
+	}
+	if c.cert != nil && modTime.Equal(c.modTime) {
+		return c.cert, c.caPool, nil
+	}
+	cert, err := tls.LoadX509KeyPair(filepath.Join(c.dir, InternalCertFileName), filepath.Join(c.dir, InternalKeyFileName))
+	if err != nil {
+		return nil, nil, err
+	}
+	caPEM, err := ioutil.ReadFile(filepath.Join(c.dir, InternalCAFileName))
+	if err != nil {
+		return nil, nil, err
+	}
+	caPool := x509.NewCertPool()
+	if !caPool.AppendCertsFromPEM(caPEM) {
+		return nil, nil, fmt.Errorf("%s contains no certificates", filepath.Join(c.dir, InternalCAFileName))
+	}
+	c.cert, c.caPool, c.modTime = &cert, caPool, modTime
+	return c.cert, c.caPool, nil
+}
+
+// verify returns an error unless the certificate chain was issued by the CA, for the host name if it is not empty
+func (c *InternalCerts) verify(rawCerts [][]byte, dnsName string, usage x509.ExtKeyUsage) error {
+	if len(rawCerts) == 0 {
+		return fmt.Errorf("no certificate presented")
+	}
+	_, caPool, err := c.load()
+	if err != nil {
+		return err
+	}
+	certs := make([]*x509.Certificate, len(rawCerts))
+	for i, raw := range rawCerts {
+		if certs[i], err = x509.ParseCertificate(raw); err != nil {
+			return err
+		}
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, err = certs[0].Verify(x509.VerifyOptions{
+		Roots:         caPool,
+		Intermediates: intermediates,
+		DNSName:       dnsName,
+		KeyUsages:     []x509.ExtKeyUsage{usage},
+	})
+	return err
+}
+
+// ServerConfig returns the TLS configuration of servers which only accept clients with a certificate of the CA.
+// Certificates and CA are verified against the files at every handshake, since the standard verification would pin
+// the CA which was loaded at start up.
+func (c *InternalCerts) ServerConfig() *tls.Config {
+	return &tls.Config{
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			cert, _, err := c.load()
+			return cert, err
+		},
+		ClientAuth: tls.RequireAnyClientCert,
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			return c.verify(rawCerts, "", x509.ExtKeyUsageClientAuth)
+		},
+	}
+}
+
+// OptionalClientCertServerConfig returns the TLS configuration of servers which also accept clients without a
+// certificate, such as Prometheus scraping a metrics port. A certificate which is presented must be of the CA, and
+// RequireClientCert guards the handlers which only other components may reach.
+func (c *InternalCerts) OptionalClientCertServerConfig() *tls.Config {
+	config := c.ServerConfig()
+	config.ClientAuth = tls.RequestClientCert
+	config.VerifyPeerCertificate = func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+		if len(rawCerts) == 0 {
+			return nil
+		}
+		return c.verify(rawCerts, "", x509.ExtKeyUsageClientAuth)
+	}
+	return config
+}
+
+// RequireClientCert rejects the requests of clients which did not present a certificate. Servers configured with
+// OptionalClientCertServerConfig only complete the handshake of clients whose certificate is of the CA.
+func RequireClientCert(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.TLS == nil || len(r.TLS.PeerCertificates) == 0 {
+			http.Error(w, "client certificate required", http.StatusUnauthorized)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// ClientConfig returns the TLS configuration of clients which present their certificate and only accept servers with
+// a certificate of the CA for the given host name
+func (c *InternalCerts) ClientConfig(serverName string) *tls.Config {
+	return &tls.Config{
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			cert, _, err := c.load()
+			return cert, err
+		},
+		// the server certificate is verified by VerifyPeerCertificate against the current CA
+		InsecureSkipVerify: true,
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			return c.verify(rawCerts, serverName, x509.ExtKeyUsageServerAuth)
+		},
+	}
+}
@@ -0,0 +1,135 @@
+package tls_test
+
+import (
+	"crypto/tls"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	argocdtls "github.com/argoproj/argo-cd/util/tls"
+)
+
+// certsModTime is advanced for every write of certificates, so that their modification time changes even on file
+// systems with a coarse resolution
+var certsModTime = time.Now()
+
+func generateCA(t *testing.T) *tls.Certificate {
+	ca, err := argocdtls.GenerateX509KeyPair(argocdtls.CertOptions{Hosts: []string{"ca"}, Organization: "Argo CD", IsCA: true, ClientAuth: true, ECDSACurve: "P256"})
+	assert.NoError(t, err)
+	return ca
+}
+
+// writeInternalCerts writes a certificate for the host issued by the CA to the directory
+func writeInternalCerts(t *testing.T, dir string, ca *tls.Certificate, host string) {
+	cert, err := argocdtls.GenerateX509KeyPair(argocdtls.CertOptions{Hosts: []string{host}, Organization: "Argo CD", Issuer: ca, ClientAuth: true, ECDSACurve: "P256"})
+	assert.NoError(t, err)
+	certPEM, keyPEM := argocdtls.EncodeX509KeyPair(*cert)
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.Certificate[0]})
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, argocdtls.InternalCertFileName), certPEM, 0600))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, argocdtls.InternalKeyFileName), keyPEM, 0600))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, argocdtls.InternalCAFileName), caPEM, 0600))
+	certsModTime = certsModTime.Add(time.Second)
+	for _, name := range []string{argocdtls.InternalCertFileName, argocdtls.InternalKeyFileName, argocdtls.InternalCAFileName} {
+		assert.NoError(t, os.Chtimes(filepath.Join(dir, name), certsModTime, certsModTime))
+	}
+}
+
+func newInternalCerts(t *testing.T, ca *tls.Certificate, host string) *argocdtls.InternalCerts {
+	dir, err := ioutil.TempDir("", "internal-tls")
+	assert.NoError(t, err)
+	writeInternalCerts(t, dir, ca, host)
+	certs, err := argocdtls.NewInternalCerts(dir)
+	assert.NoError(t, err)
+	return certs
+}
+
+// handshake returns the errors of the client and the server of a TLS handshake
+func handshake(t *testing.T, clientConfig, serverConfig *tls.Config) (error, error) {
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", serverConfig)
+	assert.NoError(t, err)
+	defer func() { _ = listener.Close() }()
+	serverErr := make(chan error, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			serverErr <- err
+			return
+		}
+		defer func() { _ = conn.Close() }()
+		serverErr <- conn.(*tls.Conn).Handshake()
+	}()
+	conn, err := tls.Dial("tcp", listener.Addr().String(), clientConfig)
+	if err == nil {
+		// the server may verify the client certificate after the client finished its handshake, so wait for its verdict
+		_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+		_, _ = conn.Read(make([]byte, 1))
+		_ = conn.Close()
+	}
+	return err, <-serverErr
+}
+
+func TestInternalCerts(t *testing.T) {
+	ca := generateCA(t)
+	serverCerts := newInternalCerts(t, ca, "argocd-repo-server")
+	clientCerts := newInternalCerts(t, ca, "argocd-server")
+
+	t.Run("Mutual", func(t *testing.T) {
+		clientErr, serverErr := handshake(t, clientCerts.ClientConfig("argocd-repo-server"), serverCerts.ServerConfig())
+		assert.NoError(t, clientErr)
+		assert.NoError(t, serverErr)
+	})
+
+	t.Run("WrongHost", func(t *testing.T) {
+		clientErr, _ := handshake(t, clientCerts.ClientConfig("argocd-redis"), serverCerts.ServerConfig())
+		assert.Error(t, clientErr)
+	})
+
+	t.Run("NoClientCertificate", func(t *testing.T) {
+		_, serverErr := handshake(t, &tls.Config{InsecureSkipVerify: true}, serverCerts.ServerConfig())
+		assert.Error(t, serverErr)
+	})
+
+	t.Run("OtherCA", func(t *testing.T) {
+		otherCerts := newInternalCerts(t, generateCA(t), "argocd-server")
+		_, serverErr := handshake(t, otherCerts.ClientConfig("argocd-repo-server"), serverCerts.ServerConfig())
+		assert.Error(t, serverErr)
+	})
+
+	t.Run("OptionalClientCertificate", func(t *testing.T) {
+		clientErr, serverErr := handshake(t, clientCerts.ClientConfig("argocd-repo-server"), serverCerts.OptionalClientCertServerConfig())
+		assert.NoError(t, clientErr)
+		assert.NoError(t, serverErr)
+		clientErr, serverErr = handshake(t, &tls.Config{InsecureSkipVerify: true}, serverCerts.OptionalClientCertServerConfig())
+		assert.NoError(t, clientErr)
+		assert.NoError(t, serverErr)
+		otherCerts := newInternalCerts(t, generateCA(t), "argocd-server")
+		_, serverErr = handshake(t, otherCerts.ClientConfig("argocd-repo-server"), serverCerts.OptionalClientCertServerConfig())
+		assert.Error(t, serverErr)
+	})
+}
+
+func TestInternalCerts_Rotation(t *testing.T) {
+	ca := generateCA(t)
+	dir, err := ioutil.TempDir("", "internal-tls")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	writeInternalCerts(t, dir, ca, "argocd-repo-server")
+	serverCerts, err := argocdtls.NewInternalCerts(dir)
+	assert.NoError(t, err)
+
+	newCA := generateCA(t)
+	clientCerts := newInternalCerts(t, newCA, "argocd-server")
+	_, serverErr := handshake(t, clientCerts.ClientConfig("argocd-repo-server"), serverCerts.ServerConfig())
+	assert.Error(t, serverErr)
+
+	// the server accepts clients of the new CA once its files were replaced
+	writeInternalCerts(t, dir, newCA, "argocd-repo-server")
+	clientErr, serverErr := handshake(t, clientCerts.ClientConfig("argocd-repo-server"), serverCerts.ServerConfig())
+	assert.NoError(t, clientErr)
+	assert.NoError(t, serverErr)
+}
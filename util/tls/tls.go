@@ -14,6 +14,7 @@ import (
 	"math/big"
 	"net"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -28,6 +29,25 @@ var (
 		"1.0": tls.VersionTLS10,
 		"1.1": tls.VersionTLS11,
 		"1.2": tls.VersionTLS12,
+		"1.3": tls.VersionTLS13,
+	}
+	// cipherSuitesByName are the TLS 1.0-1.2 cipher suites which may be configured. The cipher suites of TLS 1.3 are
+	// not configurable.
+	cipherSuitesByName = map[string]uint16{
+		"TLS_RSA_WITH_AES_128_CBC_SHA":            tls.TLS_RSA_WITH_AES_128_CBC_SHA,
+		"TLS_RSA_WITH_AES_256_CBC_SHA":            tls.TLS_RSA_WITH_AES_256_CBC_SHA,
+		"TLS_RSA_WITH_AES_128_GCM_SHA256":         tls.TLS_RSA_WITH_AES_128_GCM_SHA256,
+		"TLS_RSA_WITH_AES_256_GCM_SHA384":         tls.TLS_RSA_WITH_AES_256_GCM_SHA384,
+		"TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA":    tls.TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA,
+		"TLS_ECDHE_ECDSA_WITH_AES_256_CBC_SHA":    tls.TLS_ECDHE_ECDSA_WITH_AES_256_CBC_SHA,
+		"TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA":      tls.TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA,
+		"TLS_ECDHE_RSA_WITH_AES_256_CBC_SHA":      tls.TLS_ECDHE_RSA_WITH_AES_256_CBC_SHA,
+		"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256":   tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+		"TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256": tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
+		"TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384":   tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+		"TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384": tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
+		"TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305":    tls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305,
+		"TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305":  tls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305,
 	}
 )
 
@@ -46,6 +66,10 @@ type CertOptions struct {
 	RSABits int
 	// ECDSA curve to use to generate a key. Valid values are P224, P256 (recommended), P384, P521
 	ECDSACurve string
+	// Issuer is the CA which signs the certificate. The certificate is self-signed if nil
+	Issuer *tls.Certificate
+	// whether the certificate may also be used to authenticate clients, e.g. for mutual TLS
+	ClientAuth bool
 }
 
 type ConfigCustomizer = func(*tls.Config)
@@ -69,11 +93,29 @@ func getTLSVersionByString(version string) (uint16, error) {
 	return 0, fmt.Errorf("%s is not valid TLS version", version)
 }
 
+// getCipherSuitesByString parses a colon separated list of cipher suite names
+func getCipherSuitesByString(cipherSuites string) ([]uint16, error) {
+	if cipherSuites == "" {
+		return nil, nil
+	}
+	var res []uint16
+	for _, name := range strings.Split(cipherSuites, ":") {
+		suite, ok := cipherSuitesByName[strings.TrimSpace(name)]
+		if !ok {
+			return nil, fmt.Errorf("%s is not a supported cipher suite", name)
+		}
+		res = append(res, suite)
+	}
+	return res, nil
+}
+
 func AddTLSFlagsToCmd(cmd *cobra.Command) func() (ConfigCustomizer, error) {
 	minVersionStr := ""
 	maxVersionStr := ""
-	cmd.Flags().StringVar(&minVersionStr, "tlsminversion", "", "The minimum SSL/TLS version that is acceptable (one of: 1.0|1.1|1.2)")
-	cmd.Flags().StringVar(&maxVersionStr, "tlsmaxversion", "", "The maximum SSL/TLS version that is acceptable (one of: 1.0|1.1|1.2)")
+	cipherSuitesStr := ""
+	cmd.Flags().StringVar(&minVersionStr, "tlsminversion", "", "The minimum SSL/TLS version that is acceptable (one of: 1.0|1.1|1.2|1.3)")
+	cmd.Flags().StringVar(&maxVersionStr, "tlsmaxversion", "", "The maximum SSL/TLS version that is acceptable (one of: 1.0|1.1|1.2|1.3)")
+	cmd.Flags().StringVar(&cipherSuitesStr, "tlsciphers", "", "Colon separated list of the cipher suites which are acceptable for TLS 1.2 and earlier (e.g. TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256:TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384). All secure cipher suites if empty")
 
 	return func() (ConfigCustomizer, error) {
 		minVersion, err := getTLSVersionByString(minVersionStr)
@@ -84,9 +126,14 @@ func AddTLSFlagsToCmd(cmd *cobra.Command) func() (ConfigCustomizer, error) {
 		if err != nil {
 			return nil, err
 		}
+		cipherSuites, err := getCipherSuitesByString(cipherSuitesStr)
+		if err != nil {
+			return nil, err
+		}
 		return func(config *tls.Config) {
 			config.MinVersion = minVersion
 			config.MaxVersion = maxVersion
+			config.CipherSuites = cipherSuites
 		}, nil
 	}
 }
@@ -153,8 +200,8 @@ func generate(opts CertOptions) ([]byte, crypto.PrivateKey, error) {
 	} else {
 		notBefore = opts.ValidFrom
 	}
-	var validFor time.Duration
-	if opts.ValidFor == 0 {
+	validFor := opts.ValidFor
+	if validFor == 0 {
 		validFor = 365 * 24 * time.Hour
 	}
 	notAfter := notBefore.Add(validFor)
@@ -193,8 +240,24 @@ func generate(opts CertOptions) ([]byte, crypto.PrivateKey, error) {
 		template.IsCA = true
 		template.KeyUsage |= x509.KeyUsageCertSign
 	}
+	if opts.ClientAuth {
+		template.ExtKeyUsage = append(template.ExtKeyUsage, x509.ExtKeyUsageClientAuth)
+	}
+
+	parent := &template
+	var signer crypto.PrivateKey = privateKey
+	if opts.Issuer != nil {
+		if len(opts.Issuer.Certificate) == 0 {
+			return nil, nil, fmt.Errorf("issuer has no certificate")
+		}
+		parent, err = x509.ParseCertificate(opts.Issuer.Certificate[0])
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse issuer certificate: %s", err)
+		}
+		signer = opts.Issuer.PrivateKey
+	}
 
-	certBytes, err := x509.CreateCertificate(rand.Reader, &template, &template, publicKey(privateKey), privateKey)
+	certBytes, err := x509.CreateCertificate(rand.Reader, &template, parent, publicKey(privateKey), signer)
 	if err != nil {
 		return nil, nil, fmt.Errorf("Failed to create certificate: %s", err)
 	}
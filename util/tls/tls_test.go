@@ -7,6 +7,9 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+
 	argocdtls "github.com/argoproj/argo-cd/util/tls"
 )
 
@@ -116,3 +119,20 @@ func TestEncodeX509KeyPairString(t *testing.T) {
 	}
 
 }
+
+func TestAddTLSFlagsToCmd(t *testing.T) {
+	cmd := &cobra.Command{}
+	customizerSrc := argocdtls.AddTLSFlagsToCmd(cmd)
+	assert.NoError(t, cmd.Flags().Set("tlsminversion", "1.2"))
+	assert.NoError(t, cmd.Flags().Set("tlsciphers", "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256:TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384"))
+	customizer, err := customizerSrc()
+	assert.NoError(t, err)
+	config := &tls.Config{}
+	customizer(config)
+	assert.Equal(t, uint16(tls.VersionTLS12), config.MinVersion)
+	assert.Equal(t, []uint16{tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256, tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384}, config.CipherSuites)
+
+	assert.NoError(t, cmd.Flags().Set("tlsciphers", "TLS_RSA_WITH_RC4_128_SHA"))
+	_, err = customizerSrc()
+	assert.Error(t, err)
+}
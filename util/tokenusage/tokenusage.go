@@ -0,0 +1,236 @@
+package tokenusage
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	applister "github.com/argoproj/argo-cd/pkg/client/listers/application/v1alpha1"
+	grpc_util "github.com/argoproj/argo-cd/util/grpc"
+	jwtutil "github.com/argoproj/argo-cd/util/jwt"
+)
+
+// quotaWindow is the period of the quotas of project roles
+const quotaWindow = time.Hour
+
+var (
+	descRequests = prometheus.NewDesc(
+		"argocd_project_token_requests_total",
+		"Number of API requests made with a project token.",
+		[]string{"project", "role", "token"}, nil,
+	)
+	descSyncs = prometheus.NewDesc(
+		"argocd_project_token_syncs_total",
+		"Number of syncs started with a project token.",
+		[]string{"project", "role", "token"}, nil,
+	)
+	descRejected = prometheus.NewDesc(
+		"argocd_project_token_rejected_total",
+		"Number of API requests and syncs of a project token which were rejected because they exceeded the quota of its role.",
+		[]string{"project", "role", "token"}, nil,
+	)
+)
+
+// Token identifies a JWT token of a project role
+type Token struct {
+	Project  string
+	Role     string
+	IssuedAt int64
+}
+
+// Usage is the usage of a token since the API server started
+type Usage struct {
+	IssuedAt int64
+	// Requests and Syncs are the total numbers of API requests and syncs
+	Requests int64
+	Syncs    int64
+	// Rejected is the number of API requests and syncs which exceeded the quota
+	Rejected int64
+	// QuotaRequests and QuotaSyncs are the numbers of API requests and syncs which count towards the quota until
+	// QuotaResetAt
+	QuotaRequests int64
+	QuotaSyncs    int64
+	QuotaResetAt  time.Time
+	LastUsedAt    time.Time
+}
+
+// Tracker counts the API requests and syncs of the JWT tokens of project roles and enforces the quotas of the roles.
+// The usage is kept in memory, so every replica of the API server counts and limits the requests which it serves.
+type Tracker struct {
+	projLister applister.AppProjectNamespaceLister
+	now        func() time.Time
+
+	lock   sync.Mutex
+	tokens map[Token]*Usage
+}
+
+// NewTracker returns a tracker which looks up the quotas of the roles in the projects of the lister
+func NewTracker(projLister applister.AppProjectNamespaceLister) *Tracker {
+	return &Tracker{projLister: projLister, now: time.Now, tokens: make(map[Token]*Usage)}
+}
+
+// TokenFromContext returns the project token of the claims of a request, if any
+func TokenFromContext(ctx context.Context) (Token, bool) {
+	claims := jwtutil.Claims(ctx.Value("claims"))
+	if claims == nil {
+		return Token{}, false
+	}
+	mapClaims, err := jwtutil.MapClaims(claims)
+	if err != nil {
+		return Token{}, false
+	}
+	subjectSplit := strings.Split(jwtutil.GetField(mapClaims, "sub"), ":")
+	if len(subjectSplit) != 3 || subjectSplit[0] != "proj" {
+		return Token{}, false
+	}
+	iat, err := jwtutil.GetIssuedAt(mapClaims)
+	if err != nil {
+		return Token{}, false
+	}
+	return Token{Project: subjectSplit[1], Role: subjectSplit[2], IssuedAt: iat}, true
+}
+
+// quota returns the quota of the role of the token, or nil if the role has none
+func (t *Tracker) quota(token Token) *v1alpha1.ProjectRoleQuota {
+	proj, err := t.projLister.Get(token.Project)
+	if err != nil {
+		return nil
+	}
+	role, _, err := proj.GetRoleByName(token.Role)
+	if err != nil {
+		return nil
+	}
+	return role.Quota
+}
+
+// record counts a request or sync of the token of the request, unless it exceeds the quota of the role of the token.
+// Nothing is counted by a nil tracker.
+func (t *Tracker) record(ctx context.Context, isSync bool) error {
+	if t == nil {
+		return nil
+	}
+	token, ok := TokenFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	quota := t.quota(token)
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	now := t.now()
+	usage, ok := t.tokens[token]
+	if !ok {
+		usage = &Usage{IssuedAt: token.IssuedAt}
+		t.tokens[token] = usage
+	}
+	usage.LastUsedAt = now
+	if !now.Before(usage.QuotaResetAt) {
+		usage.QuotaRequests, usage.QuotaSyncs, usage.QuotaResetAt = 0, 0, now.Add(quotaWindow)
+	}
+	if isSync {
+		if quota != nil && quota.SyncsPerHour > 0 && usage.QuotaSyncs >= quota.SyncsPerHour {
+			usage.Rejected++
+			return grpc_util.NewRateLimitedError(ctx, usage.QuotaResetAt.Sub(now), "token of role '%s' of project '%s' exceeded its quota of %d syncs per hour", token.Role, token.Project, quota.SyncsPerHour)
+		}
+		usage.Syncs++
+		usage.QuotaSyncs++
+		return nil
+	}
+	if quota != nil && quota.RequestsPerHour > 0 && usage.QuotaRequests >= quota.RequestsPerHour {
+		usage.Rejected++
+		return grpc_util.NewRateLimitedError(ctx, usage.QuotaResetAt.Sub(now), "token of role '%s' of project '%s' exceeded its quota of %d requests per hour", token.Role, token.Project, quota.RequestsPerHour)
+	}
+	usage.Requests++
+	usage.QuotaRequests++
+	return nil
+}
+
+// RecordRequest counts an API request of the project token of the request, or returns a ResourceExhausted error if
+// the token exceeded the request quota of its role
+func (t *Tracker) RecordRequest(ctx context.Context) error {
+	return t.record(ctx, false)
+}
+
+// RecordSync counts a sync started with the project token of the request, or returns a ResourceExhausted error if the
+// token exceeded the sync quota of its role
+func (t *Tracker) RecordSync(ctx context.Context) error {
+	return t.record(ctx, true)
+}
+
+// Usage returns the usage of the tokens of the role which were used since the API server started, ordered by issue time
+func (t *Tracker) Usage(project string, role string) []Usage {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	now := t.now()
+	result := make([]Usage, 0)
+	for token, usage := range t.tokens {
+		if token.Project != project || token.Role != role {
+			continue
+		}
+		u := *usage
+		if !now.Before(u.QuotaResetAt) {
+			u.QuotaRequests, u.QuotaSyncs = 0, 0
+		}
+		result = append(result, u)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].IssuedAt < result[j].IssuedAt
+	})
+	return result
+}
+
+// UnaryServerInterceptor returns an interceptor which counts the requests of project tokens. It must come after the
+// interceptor which authenticates the caller.
+func (t *Tracker) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := t.RecordRequest(ctx); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor returns an interceptor which counts the streams of project tokens. It must come after the
+// interceptor which authenticates the caller.
+func (t *Tracker) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := t.RecordRequest(ss.Context()); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+// Describe implements prometheus.Collector
+func (t *Tracker) Describe(ch chan<- *prometheus.Desc) {
+	ch <- descRequests
+	ch <- descSyncs
+	ch <- descRejected
+}
+
+// Collect implements prometheus.Collector. The usage of tokens which were deleted is dropped.
+func (t *Tracker) Collect(ch chan<- prometheus.Metric) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	for token, usage := range t.tokens {
+		proj, err := t.projLister.Get(token.Project)
+		if err == nil {
+			_, _, err = proj.GetJWTToken(token.Role, token.IssuedAt)
+		}
+		if err != nil {
+			delete(t.tokens, token)
+			continue
+		}
+		labels := []string{token.Project, token.Role, strconv.FormatInt(token.IssuedAt, 10)}
+		ch <- prometheus.MustNewConstMetric(descRequests, prometheus.CounterValue, float64(usage.Requests), labels...)
+		ch <- prometheus.MustNewConstMetric(descSyncs, prometheus.CounterValue, float64(usage.Syncs), labels...)
+		ch <- prometheus.MustNewConstMetric(descRejected, prometheus.CounterValue, float64(usage.Rejected), labels...)
+	}
+}
@@ -0,0 +1,116 @@
+package tokenusage
+
+import (
+	"testing"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/test"
+)
+
+func newFakeProj() *argoappv1.AppProject {
+	return &argoappv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-proj", Namespace: test.FakeArgoCDNamespace},
+		Spec: argoappv1.AppProjectSpec{
+			Roles: []argoappv1.ProjectRole{{
+				Name:      "ci",
+				JWTTokens: []argoappv1.JWTToken{{IssuedAt: 1234}, {IssuedAt: 5678}},
+				Quota:     &argoappv1.ProjectRoleQuota{RequestsPerHour: 3, SyncsPerHour: 1},
+			}},
+		},
+	}
+}
+
+func tokenContext(sub string, iat int64) context.Context {
+	return context.WithValue(context.Background(), "claims", jwt.MapClaims{"sub": sub, "iat": float64(iat)})
+}
+
+func TestTokenFromContext(t *testing.T) {
+	token, ok := TokenFromContext(tokenContext("proj:my-proj:ci", 1234))
+	assert.True(t, ok)
+	assert.Equal(t, Token{Project: "my-proj", Role: "ci", IssuedAt: 1234}, token)
+
+	_, ok = TokenFromContext(tokenContext("admin", 1234))
+	assert.False(t, ok)
+	_, ok = TokenFromContext(context.Background())
+	assert.False(t, ok)
+}
+
+func TestTracker_Quota(t *testing.T) {
+	tracker := NewTracker(test.NewFakeProjLister(newFakeProj()))
+	now := time.Unix(1000, 0)
+	tracker.now = func() time.Time { return now }
+	ctx := tokenContext("proj:my-proj:ci", 1234)
+
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, tracker.RecordRequest(ctx))
+	}
+	err := tracker.RecordRequest(ctx)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "retry after 3600s")
+
+	assert.NoError(t, tracker.RecordSync(ctx))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(tracker.RecordSync(ctx)))
+
+	// every token has its own quota
+	assert.NoError(t, tracker.RecordRequest(tokenContext("proj:my-proj:ci", 5678)))
+	// users other than project tokens are not counted
+	assert.NoError(t, tracker.RecordSync(tokenContext("admin", 1234)))
+	assert.NoError(t, tracker.RecordSync(tokenContext("admin", 1234)))
+
+	usage := tracker.Usage("my-proj", "ci")
+	if assert.Len(t, usage, 2) {
+		assert.Equal(t, Usage{
+			IssuedAt:      1234,
+			Requests:      3,
+			Syncs:         1,
+			Rejected:      2,
+			QuotaRequests: 3,
+			QuotaSyncs:    1,
+			QuotaResetAt:  now.Add(time.Hour),
+			LastUsedAt:    now,
+		}, usage[0])
+		assert.Equal(t, int64(5678), usage[1].IssuedAt)
+	}
+
+	// the quota is reset after an hour
+	now = now.Add(time.Hour)
+	assert.NoError(t, tracker.RecordRequest(ctx))
+	assert.NoError(t, tracker.RecordSync(ctx))
+	usage = tracker.Usage("my-proj", "ci")
+	assert.Equal(t, int64(4), usage[0].Requests)
+	assert.Equal(t, int64(1), usage[0].QuotaRequests)
+}
+
+func TestTracker_Collect(t *testing.T) {
+	tracker := NewTracker(test.NewFakeProjLister(newFakeProj()))
+	assert.NoError(t, tracker.RecordRequest(tokenContext("proj:my-proj:ci", 1234)))
+	assert.NoError(t, tracker.RecordSync(tokenContext("proj:my-proj:ci", 1234)))
+	// the token was deleted from the project
+	assert.NoError(t, tracker.RecordRequest(tokenContext("proj:my-proj:ci", 999)))
+
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(tracker)
+	families, err := registry.Gather()
+	assert.NoError(t, err)
+	values := make(map[string]float64)
+	for _, family := range families {
+		for _, metric := range family.Metric {
+			values[family.GetName()+"/"+metric.Label[2].GetValue()] = metric.Counter.GetValue()
+		}
+	}
+	assert.Equal(t, map[string]float64{
+		"argocd_project_token_requests_total/1234": 1,
+		"argocd_project_token_syncs_total/1234":    1,
+		"argocd_project_token_rejected_total/1234": 0,
+	}, values)
+	assert.Len(t, tracker.Usage("my-proj", "ci"), 1)
+}
@@ -0,0 +1,146 @@
+package vault
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// envVaultToken is the environment variable holding the Vault token, if Kubernetes authentication is not used
+	envVaultToken = "VAULT_TOKEN"
+	// serviceAccountTokenPath is the path of the token used to log in to Vault using Kubernetes authentication
+	serviceAccountTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+)
+
+// Client sends requests to the HTTP API of HashiCorp Vault. If a role is set, the client logs in using the Kubernetes
+// auth method with the token of the service account, and logs in again once the Vault token is rejected. The token of
+// the VAULT_TOKEN environment variable is used otherwise.
+type Client struct {
+	address   string
+	role      string
+	authPath  string
+	client    *http.Client
+	token     string
+	tokenLock sync.Mutex
+	// ReadJWT returns the service account token used to log in
+	ReadJWT func() ([]byte, error)
+}
+
+// NewClient returns a client of the Vault server at the given address. The auth path defaults to 'kubernetes'.
+func NewClient(address string, role string, authPath string) *Client {
+	if authPath == "" {
+		authPath = "kubernetes"
+	}
+	return &Client{
+		address:  strings.TrimSuffix(address, "/"),
+		role:     role,
+		authPath: authPath,
+		client:   &http.Client{Timeout: 30 * time.Second},
+		ReadJWT:  func() ([]byte, error) { return ioutil.ReadFile(serviceAccountTokenPath) },
+	}
+}
+
+func (c *Client) getToken(renew bool) (string, error) {
+	c.tokenLock.Lock()
+	defer c.tokenLock.Unlock()
+	if c.role == "" {
+		return os.Getenv(envVaultToken), nil
+	}
+	if c.token != "" && !renew {
+		return c.token, nil
+	}
+	jwt, err := c.ReadJWT()
+	if err != nil {
+		return "", err
+	}
+	body, err := json.Marshal(map[string]string{"role": c.role, "jwt": string(jwt)})
+	if err != nil {
+		return "", err
+	}
+	res, err := c.client.Post(fmt.Sprintf("%s/v1/auth/%s/login", c.address, c.authPath), "application/json", bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = res.Body.Close() }()
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to log in to vault: %s", res.Status)
+	}
+	var login struct {
+		Auth struct {
+			ClientToken string `json:"client_token"`
+		} `json:"auth"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&login); err != nil {
+		return "", err
+	}
+	c.token = login.Auth.ClientToken
+	return c.token, nil
+}
+
+// Do sends a request to the given path of the API, e.g. '/v1/secret/data/argocd', and encodes the body as JSON
+func (c *Client) Do(method string, path string, body interface{}) (*http.Response, error) {
+	var data []byte
+	if body != nil {
+		var err error
+		if data, err = json.Marshal(body); err != nil {
+			return nil, err
+		}
+	}
+	for attempt := 0; ; attempt++ {
+		token, err := c.getToken(attempt > 0)
+		if err != nil {
+			return nil, err
+		}
+		req, err := http.NewRequest(method, c.address+path, bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("X-Vault-Token", token)
+		req.Header.Set("Content-Type", "application/json")
+		res, err := c.client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		if res.StatusCode == http.StatusForbidden && c.role != "" && attempt == 0 {
+			_ = res.Body.Close()
+			continue
+		}
+		return res, nil
+	}
+}
+
+// Read sends a GET request and decodes the 'data' field of the response into out. It returns false if nothing
+// exists at the path.
+func (c *Client) Read(path string, out interface{}) (bool, error) {
+	return c.Write(http.MethodGet, path, nil, out)
+}
+
+// Write sends a request with the given body and decodes the 'data' field of the response into out, unless out is
+// nil. It returns false if nothing exists at the path.
+func (c *Client) Write(method string, path string, body interface{}, out interface{}) (bool, error) {
+	res, err := c.Do(method, path, body)
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = res.Body.Close() }()
+	if res.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+	if res.StatusCode >= 300 {
+		return false, fmt.Errorf("vault request %s %s failed: %s", method, path, res.Status)
+	}
+	if out == nil {
+		return true, nil
+	}
+	response := struct {
+		Data interface{} `json:"data"`
+	}{Data: out}
+	return true, json.NewDecoder(res.Body).Decode(&response)
+}
@@ -0,0 +1,47 @@
+package vault
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_Relogin(t *testing.T) {
+	logins := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/auth/kubernetes/login" {
+			logins++
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"auth": map[string]string{"client_token": "token"}})
+			return
+		}
+		if r.Header.Get("X-Vault-Token") != "token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		switch r.URL.Path {
+		case "/v1/secret/data/argocd/repo":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]string{"password": "foo"}})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	client := NewClient(server.URL, "argocd", "")
+	client.ReadJWT = func() ([]byte, error) { return []byte("jwt"), nil }
+	client.token = "expired"
+
+	var data map[string]string
+	found, err := client.Read("/v1/secret/data/argocd/repo", &data)
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "foo", data["password"])
+	assert.Equal(t, 1, logins)
+
+	found, err = client.Read("/v1/secret/data/argocd/missing", &data)
+	assert.NoError(t, err)
+	assert.False(t, found)
+	assert.Equal(t, 1, logins)
+}
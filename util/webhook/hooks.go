@@ -0,0 +1,157 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RepoHookClient creates and deletes the push webhooks of GitHub and GitLab repositories, which deliver the push
+// events to the webhook endpoint of Argo CD
+type RepoHookClient struct {
+	httpClient *http.Client
+	provider   string
+	// apiURL is the base URL of the REST API of the provider
+	apiURL string
+	// project is the path of the repository at the provider, e.g. "argoproj/argo-cd"
+	project string
+	token   string
+}
+
+// NewRepoHookClient returns a client for the hooks of the GitHub or GitLab repository of the URL, which authenticates
+// with the token. The provider is inferred from the host name of the URL.
+func NewRepoHookClient(repoURL string, token string) (*RepoHookClient, error) {
+	host, project, err := parseRepoURL(repoURL)
+	if err != nil {
+		return nil, err
+	}
+	client := &RepoHookClient{
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+		project:    project,
+		token:      token,
+	}
+	switch {
+	case host == "github.com":
+		client.provider, client.apiURL = providerGitHub, "https://api.github.com"
+	case strings.Contains(host, "github"):
+		// GitHub Enterprise
+		client.provider, client.apiURL = providerGitHub, fmt.Sprintf("https://%s/api/v3", host)
+	case strings.Contains(host, "gitlab"):
+		client.provider, client.apiURL = providerGitLab, fmt.Sprintf("https://%s/api/v4", host)
+	default:
+		return nil, fmt.Errorf("cannot create webhooks for repository '%s': only GitHub and GitLab repositories are supported", repoURL)
+	}
+	return client, nil
+}
+
+// parseRepoURL returns the host and the path, without the .git suffix, of a HTTPS, SSH or SCP-like repository URL
+func parseRepoURL(repoURL string) (string, string, error) {
+	var host, path string
+	if !strings.Contains(repoURL, "://") && strings.Contains(repoURL, ":") {
+		// SCP-like URL, e.g. git@github.com:argoproj/argo-cd.git
+		parts := strings.SplitN(repoURL, ":", 2)
+		host, path = parts[0][strings.LastIndex(parts[0], "@")+1:], parts[1]
+	} else {
+		u, err := url.Parse(repoURL)
+		if err != nil {
+			return "", "", err
+		}
+		host, path = u.Hostname(), u.Path
+	}
+	path = strings.TrimSuffix(strings.Trim(path, "/"), ".git")
+	if host == "" || !strings.Contains(path, "/") {
+		return "", "", fmt.Errorf("cannot infer the repository of URL '%s'", repoURL)
+	}
+	return strings.ToLower(host), path, nil
+}
+
+// IsGitLab returns whether the repository is hosted by GitLab rather than GitHub
+func (c *RepoHookClient) IsGitLab() bool {
+	return c.provider == providerGitLab
+}
+
+// Create creates a webhook for the push events of the repository, which posts them to the URL and signs them with
+// the secret, and returns the ID of the hook
+func (c *RepoHookClient) Create(hookURL string, secret string) (string, error) {
+	var hook interface{}
+	if c.provider == providerGitHub {
+		hook = map[string]interface{}{
+			"name":   "web",
+			"active": true,
+			"events": []string{"push"},
+			"config": map[string]string{
+				"url":          hookURL,
+				"content_type": "json",
+				"secret":       secret,
+			},
+		}
+	} else {
+		hook = map[string]interface{}{
+			"url":                     hookURL,
+			"push_events":             true,
+			"token":                   secret,
+			"enable_ssl_verification": true,
+		}
+	}
+	body, err := json.Marshal(hook)
+	if err != nil {
+		return "", err
+	}
+	res, err := c.do("POST", c.hooksURL(), body)
+	if err != nil {
+		return "", err
+	}
+	var created struct {
+		ID int64 `json:"id"`
+	}
+	if err := json.Unmarshal(res, &created); err != nil {
+		return "", fmt.Errorf("failed to parse the created webhook: %v", err)
+	}
+	return strconv.FormatInt(created.ID, 10), nil
+}
+
+// Delete deletes the webhook with the ID
+func (c *RepoHookClient) Delete(id string) error {
+	_, err := c.do("DELETE", fmt.Sprintf("%s/%s", c.hooksURL(), id), nil)
+	return err
+}
+
+func (c *RepoHookClient) hooksURL() string {
+	if c.provider == providerGitHub {
+		return fmt.Sprintf("%s/repos/%s/hooks", c.apiURL, c.project)
+	}
+	// GitLab identifies projects by their URL-encoded path
+	return fmt.Sprintf("%s/projects/%s/hooks", c.apiURL, strings.Replace(url.PathEscape(c.project), "/", "%2F", -1))
+}
+
+func (c *RepoHookClient) do(method string, reqURL string, body []byte) ([]byte, error) {
+	req, err := http.NewRequest(method, reqURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.provider == providerGitHub {
+		req.Header.Set("Authorization", "token "+c.token)
+	} else {
+		req.Header.Set("Private-Token", c.token)
+	}
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = res.Body.Close() }()
+	data, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return nil, fmt.Errorf("%s %s failed with status %d: %s", method, reqURL, res.StatusCode, strings.TrimSpace(string(data)))
+	}
+	return data, nil
+}
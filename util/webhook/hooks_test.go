@@ -0,0 +1,120 @@
+package webhook
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRepoURL(t *testing.T) {
+	for _, repoURL := range []string{
+		"https://github.com/argoproj/argo-cd.git",
+		"https://user@github.com/argoproj/argo-cd/",
+		"ssh://git@github.com/argoproj/argo-cd.git",
+		"git@github.com:argoproj/argo-cd.git",
+	} {
+		host, project, err := parseRepoURL(repoURL)
+		assert.NoError(t, err, repoURL)
+		assert.Equal(t, "github.com", host, repoURL)
+		assert.Equal(t, "argoproj/argo-cd", project, repoURL)
+	}
+	_, _, err := parseRepoURL("https://github.com/argo-cd")
+	assert.Error(t, err)
+}
+
+func TestNewRepoHookClient(t *testing.T) {
+	client, err := NewRepoHookClient("https://github.com/argoproj/argo-cd.git", "token")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://api.github.com/repos/argoproj/argo-cd/hooks", client.hooksURL())
+
+	client, err = NewRepoHookClient("https://github.example.com/argoproj/argo-cd.git", "token")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://github.example.com/api/v3/repos/argoproj/argo-cd/hooks", client.hooksURL())
+
+	client, err = NewRepoHookClient("git@gitlab.com:argoproj/apps/argo-cd.git", "token")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://gitlab.com/api/v4/projects/argoproj%2Fapps%2Fargo-cd/hooks", client.hooksURL())
+	assert.True(t, client.IsGitLab())
+
+	_, err = NewRepoHookClient("https://bitbucket.org/argoproj/argo-cd.git", "token")
+	assert.Error(t, err)
+}
+
+func newTestRepoHookClient(provider string, handler http.HandlerFunc) (*RepoHookClient, func()) {
+	server := httptest.NewServer(handler)
+	return &RepoHookClient{
+		httpClient: server.Client(),
+		provider:   provider,
+		apiURL:     server.URL,
+		project:    "argoproj/argo-cd",
+		token:      "token",
+	}, server.Close
+}
+
+func TestRepoHookClient_GitHub(t *testing.T) {
+	var requests []string
+	client, closer := newTestRepoHookClient(providerGitHub, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		assert.Equal(t, "token token", r.Header.Get("Authorization"))
+		if r.Method == "POST" {
+			data, err := ioutil.ReadAll(r.Body)
+			assert.NoError(t, err)
+			var hook struct {
+				Events []string          `json:"events"`
+				Config map[string]string `json:"config"`
+			}
+			assert.NoError(t, json.Unmarshal(data, &hook))
+			assert.Equal(t, []string{"push"}, hook.Events)
+			assert.Equal(t, "https://argocd.example.com/api/webhook", hook.Config["url"])
+			assert.Equal(t, "secret", hook.Config["secret"])
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id": 42}`))
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer closer()
+
+	id, err := client.Create("https://argocd.example.com/api/webhook", "secret")
+	assert.NoError(t, err)
+	assert.Equal(t, "42", id)
+	assert.NoError(t, client.Delete(id))
+	assert.Equal(t, []string{"POST /repos/argoproj/argo-cd/hooks", "DELETE /repos/argoproj/argo-cd/hooks/42"}, requests)
+}
+
+func TestRepoHookClient_GitLab(t *testing.T) {
+	var requests []string
+	client, closer := newTestRepoHookClient(providerGitLab, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.EscapedPath())
+		assert.Equal(t, "token", r.Header.Get("Private-Token"))
+		if r.Method == "POST" {
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id": 7}`))
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer closer()
+
+	id, err := client.Create("https://argocd.example.com/api/webhook", "secret")
+	assert.NoError(t, err)
+	assert.Equal(t, "7", id)
+	assert.NoError(t, client.Delete(id))
+	assert.Equal(t, []string{"POST /projects/argoproj%2Fargo-cd/hooks", "DELETE /projects/argoproj%2Fargo-cd/hooks/7"}, requests)
+}
+
+func TestRepoHookClient_Error(t *testing.T) {
+	client, closer := newTestRepoHookClient(providerGitHub, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+	})
+	defer closer()
+
+	_, err := client.Create("https://argocd.example.com/api/webhook", "secret")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "status 404")
+}
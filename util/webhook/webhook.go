@@ -1,12 +1,19 @@
 package webhook
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/url"
 	"regexp"
 	"strings"
+	"time"
 
 	gogsclient "github.com/gogits/go-gogs-client"
+	gocache "github.com/patrickmn/go-cache"
+	"github.com/prometheus/client_golang/prometheus"
 	log "github.com/sirupsen/logrus"
 	"gopkg.in/go-playground/webhooks.v5/bitbucket"
 	bitbucketserver "gopkg.in/go-playground/webhooks.v5/bitbucket-server"
@@ -17,10 +24,38 @@ import (
 
 	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/pkg/client/clientset/versioned"
+	"github.com/argoproj/argo-cd/util/app/path"
 	"github.com/argoproj/argo-cd/util/argo"
 	"github.com/argoproj/argo-cd/util/settings"
 )
 
+const (
+	providerGitHub          = "github"
+	providerGitLab          = "gitlab"
+	providerBitbucket       = "bitbucket"
+	providerBitbucketServer = "bitbucketserver"
+	providerGogs            = "gogs"
+)
+
+const (
+	rejectReasonSecretMissing    = "secret_missing"
+	rejectReasonInvalidSignature = "invalid_signature"
+	rejectReasonStale            = "stale"
+	rejectReasonReplayed         = "replayed"
+)
+
+// maxPayloadSize is the maximum size of the payload of webhook events, which is the limit of GitHub
+const maxPayloadSize = 25 * 1024 * 1024
+
+// deliveryIDHeaders are the headers of the IDs which the providers assign to every delivery of an event
+var deliveryIDHeaders = map[string]string{
+	providerGitHub:          "X-GitHub-Delivery",
+	providerGitLab:          "X-Gitlab-Event-UUID",
+	providerBitbucket:       "X-Request-UUID",
+	providerBitbucketServer: "X-Request-Id",
+	providerGogs:            "X-Gogs-Delivery",
+}
+
 type ArgoCDWebhookHandler struct {
 	ns              string
 	appClientset    appclientset.Interface
@@ -29,6 +64,14 @@ type ArgoCDWebhookHandler struct {
 	bitbucket       *bitbucket.Webhook
 	bitbucketserver *bitbucketserver.Webhook
 	gogs            *gogs.Webhook
+	// secrets holds the providers whose secret is configured
+	secrets       map[string]bool
+	requireSecret bool
+	replayWindow  time.Duration
+	// deliveries holds the deliveries which were handled within the replay window
+	deliveries *gocache.Cache
+	rejected   *prometheus.CounterVec
+	now        func() time.Time
 }
 
 func NewHandler(namespace string, appClientset appclientset.Interface, set *settings.ArgoCDSettings) *ArgoCDWebhookHandler {
@@ -62,17 +105,46 @@ func NewHandler(namespace string, appClientset appclientset.Interface, set *sett
 		bitbucket:       bitbucketWebhook,
 		bitbucketserver: bitbucketserverWebhook,
 		gogs:            gogsWebhook,
+		secrets: map[string]bool{
+			providerGitHub:          set.WebhookGitHubSecret != "",
+			providerGitLab:          set.WebhookGitLabSecret != "",
+			providerBitbucket:       set.WebhookBitbucketUUID != "",
+			providerBitbucketServer: set.WebhookBitbucketServerSecret != "",
+			providerGogs:            set.WebhookGogsSecret != "",
+		},
+		requireSecret: set.WebhookRequireSecret,
+		replayWindow:  set.WebhookReplayWindow,
+		rejected: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "argocd_webhook_requests_rejected_total",
+			Help: "Number of webhook events which were rejected.",
+		}, []string{"provider", "reason"}),
+		now: time.Now,
+	}
+	if acdWebhook.replayWindow > 0 {
+		acdWebhook.deliveries = gocache.New(acdWebhook.replayWindow, acdWebhook.replayWindow)
 	}
 
 	return &acdWebhook
 }
 
+// Describe implements the prometheus.Collector interface
+func (a *ArgoCDWebhookHandler) Describe(ch chan<- *prometheus.Desc) {
+	a.rejected.Describe(ch)
+}
+
+// Collect implements the prometheus.Collector interface
+func (a *ArgoCDWebhookHandler) Collect(ch chan<- prometheus.Metric) {
+	a.rejected.Collect(ch)
+}
+
 // affectedRevisionInfo examines a payload from a webhook event, and extracts the repo web URL,
-// the revision, and whether or not this affected origin/HEAD (the default branch of the repository)
-func affectedRevisionInfo(payloadIf interface{}) (string, string, bool) {
+// the revision, whether or not this affected origin/HEAD (the default branch of the repository), and
+// the files changed by the pushed commits. The changed files are nil if the payload does not list them.
+func affectedRevisionInfo(payloadIf interface{}) (string, string, bool, []string) {
 	var webURL string
 	var revision string
 	var touchedHead bool
+	var changedFiles []string
 
 	parseRef := func(ref string) string {
 		refParts := strings.SplitN(ref, "/", 3)
@@ -85,12 +157,22 @@ func affectedRevisionInfo(payloadIf interface{}) (string, string, bool) {
 		webURL = payload.Repository.HTMLURL
 		revision = parseRef(payload.Ref)
 		touchedHead = bool(payload.Repository.DefaultBranch == revision)
+		for _, commit := range payload.Commits {
+			changedFiles = append(changedFiles, commit.Added...)
+			changedFiles = append(changedFiles, commit.Modified...)
+			changedFiles = append(changedFiles, commit.Removed...)
+		}
 	case gitlab.PushEventPayload:
 		// See: https://docs.gitlab.com/ee/user/project/integrations/webhooks.html
 		// NOTE: this is untested
 		webURL = payload.Project.WebURL
 		revision = parseRef(payload.Ref)
 		touchedHead = bool(payload.Project.DefaultBranch == revision)
+		for _, commit := range payload.Commits {
+			changedFiles = append(changedFiles, commit.Added...)
+			changedFiles = append(changedFiles, commit.Modified...)
+			changedFiles = append(changedFiles, commit.Removed...)
+		}
 	case gitlab.TagEventPayload:
 		// See: https://docs.gitlab.com/ee/user/project/integrations/webhooks.html
 		// NOTE: this is untested
@@ -135,13 +217,18 @@ func affectedRevisionInfo(payloadIf interface{}) (string, string, bool) {
 		webURL = payload.Repo.HTMLURL
 		revision = parseRef(payload.Ref)
 		touchedHead = bool(payload.Repo.DefaultBranch == revision)
+		for _, commit := range payload.Commits {
+			changedFiles = append(changedFiles, commit.Added...)
+			changedFiles = append(changedFiles, commit.Modified...)
+			changedFiles = append(changedFiles, commit.Removed...)
+		}
 	}
-	return webURL, revision, touchedHead
+	return webURL, revision, touchedHead, changedFiles
 }
 
 // HandleEvent handles webhook events for repo push events
 func (a *ArgoCDWebhookHandler) HandleEvent(payload interface{}) {
-	webURL, revision, touchedHead := affectedRevisionInfo(payload)
+	webURL, revision, touchedHead, changedFiles := affectedRevisionInfo(payload)
 	// NOTE: the webURL does not include the .git extension
 	if webURL == "" {
 		log.Info("Ignoring webhook event")
@@ -179,6 +266,11 @@ func (a *ArgoCDWebhookHandler) HandleEvent(payload interface{}) {
 		} else if targetRev != revision {
 			continue
 		}
+		// pushes which list their changed files only refresh the annotated apps whose manifests are generated from them
+		if paths := path.ManifestGeneratePaths(&app); changedFiles != nil && paths != nil && !path.FilesChanged(paths, changedFiles) {
+			log.Debugf("Skipping refresh of app '%s' since none of its paths were changed", app.ObjectMeta.Name)
+			continue
+		}
 		_, err = argo.RefreshApp(appIf, app.ObjectMeta.Name, v1alpha1.RefreshTypeNormal)
 		if err != nil {
 			log.Warnf("Failed to refresh app '%s' for controller reprocessing: %v", app.ObjectMeta.Name, err)
@@ -187,32 +279,130 @@ func (a *ArgoCDWebhookHandler) HandleEvent(payload interface{}) {
 	}
 }
 
-func (a *ArgoCDWebhookHandler) Handler(w http.ResponseWriter, r *http.Request) {
-
-	var payload interface{}
-	var err error
-
+// eventProvider returns the provider which sent the webhook event, or "" if the event is unknown
+func eventProvider(r *http.Request) string {
 	switch {
 	//Gogs needs to be checked before Github since it carries both Gogs and (incompatible) Github headers
 	case r.Header.Get("X-Gogs-Event") != "":
-		payload, err = a.gogs.Parse(r, gogs.PushEvent)
+		return providerGogs
 	case r.Header.Get("X-GitHub-Event") != "":
-		payload, err = a.github.Parse(r, github.PushEvent)
+		return providerGitHub
 	case r.Header.Get("X-Gitlab-Event") != "":
-		payload, err = a.gitlab.Parse(r, gitlab.PushEvents, gitlab.TagEvents)
+		return providerGitLab
 	case r.Header.Get("X-Hook-UUID") != "":
-		payload, err = a.bitbucket.Parse(r, bitbucket.RepoPushEvent)
+		return providerBitbucket
 	case r.Header.Get("X-Event-Key") != "":
-		payload, err = a.bitbucketserver.Parse(r, bitbucketserver.RepositoryReferenceChangedEvent)
+		return providerBitbucketServer
+	}
+	return ""
+}
+
+func (a *ArgoCDWebhookHandler) parse(provider string, r *http.Request) (interface{}, error) {
+	switch provider {
+	case providerGogs:
+		return a.gogs.Parse(r, gogs.PushEvent)
+	case providerGitHub:
+		return a.github.Parse(r, github.PushEvent)
+	case providerGitLab:
+		return a.gitlab.Parse(r, gitlab.PushEvents, gitlab.TagEvents)
+	case providerBitbucket:
+		return a.bitbucket.Parse(r, bitbucket.RepoPushEvent)
 	default:
+		return a.bitbucketserver.Parse(r, bitbucketserver.RepositoryReferenceChangedEvent)
+	}
+}
+
+// isVerificationError returns true if the error is caused by a missing or invalid signature of the event
+func isVerificationError(err error) bool {
+	switch err {
+	case github.ErrHMACVerificationFailed, github.ErrMissingHubSignatureHeader,
+		gitlab.ErrGitLabTokenVerificationFailed,
+		bitbucket.ErrUUIDVerificationFailed,
+		bitbucketserver.ErrHMACVerificationFailed, bitbucketserver.ErrMissingHubSignatureHeader,
+		gogs.ErrHMACVerificationFailed, gogs.ErrMissingGogsSignatureHeader:
+		return true
+	}
+	return false
+}
+
+// pushedAt returns the time of the push of the payload, if the payload contains it
+func pushedAt(payloadIf interface{}) (time.Time, bool) {
+	switch payload := payloadIf.(type) {
+	case github.PushPayload:
+		if payload.Repository.PushedAt > 0 {
+			return time.Unix(payload.Repository.PushedAt, 0), true
+		}
+	case bitbucketserver.RepositoryReferenceChangedPayload:
+		if date := time.Time(payload.Date); !date.IsZero() {
+			return date, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// replayed records the delivery of an event and returns true if the event was delivered before within the replay
+// window. Deliveries are identified by their delivery ID and by the digest of their payload, since the delivery ID is
+// not covered by the signature.
+func (a *ArgoCDWebhookHandler) replayed(provider string, r *http.Request, body []byte) bool {
+	keys := []string{fmt.Sprintf("%s/sha256:%x", provider, sha256.Sum256(body))}
+	if id := r.Header.Get(deliveryIDHeaders[provider]); id != "" {
+		keys = append(keys, fmt.Sprintf("%s/delivery:%s", provider, id))
+	}
+	replayed := false
+	for _, key := range keys {
+		if err := a.deliveries.Add(key, true, gocache.DefaultExpiration); err != nil {
+			replayed = true
+		}
+	}
+	return replayed
+}
+
+func (a *ArgoCDWebhookHandler) reject(w http.ResponseWriter, provider string, reason string, code int, message string) {
+	log.Warnf("Rejected %s webhook event: %s", provider, message)
+	a.rejected.WithLabelValues(provider, reason).Inc()
+	http.Error(w, message, code)
+}
+
+func (a *ArgoCDWebhookHandler) Handler(w http.ResponseWriter, r *http.Request) {
+	provider := eventProvider(r)
+	if provider == "" {
 		log.Debug("Ignoring unknown webhook event")
 		return
 	}
+	if a.requireSecret && !a.secrets[provider] {
+		a.reject(w, provider, rejectReasonSecretMissing, http.StatusUnauthorized, "webhook secret is not configured")
+		return
+	}
+
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxPayloadSize))
+	if err != nil {
+		log.Infof("Webhook processing failed: %s", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
 
+	payload, err := a.parse(provider, r)
 	if err != nil {
+		if isVerificationError(err) {
+			a.reject(w, provider, rejectReasonInvalidSignature, http.StatusUnauthorized, err.Error())
+			return
+		}
 		log.Infof("Webhook processing failed: %s", err)
 		return
 	}
 
+	if a.replayWindow > 0 {
+		// the push time can only be trusted if the payload is signed
+		if t, ok := pushedAt(payload); ok && a.secrets[provider] && a.now().Sub(t) > a.replayWindow {
+			a.reject(w, provider, rejectReasonStale, http.StatusBadRequest, fmt.Sprintf("event was pushed at %s, which is older than %v", t.UTC().Format(time.RFC3339), a.replayWindow))
+			return
+		}
+		if a.replayed(provider, r, body) {
+			a.reject(w, provider, rejectReasonReplayed, http.StatusConflict, "event was already delivered")
+			return
+		}
+	}
+
 	a.HandleEvent(payload)
 }
@@ -2,14 +2,22 @@ package webhook
 
 import (
 	"bytes"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/hex"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
+	dto "github.com/prometheus/client_model/go"
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/pkg/client/clientset/versioned/fake"
 	"github.com/argoproj/argo-cd/util/settings"
 )
@@ -81,3 +89,105 @@ func TestGogsPushEvent(t *testing.T) {
 	assert.Equal(t, expectedLogResult, hook.LastEntry().Message)
 	hook.Reset()
 }
+
+func TestGitHubCommitEvent_ManifestGeneratePaths(t *testing.T) {
+	newApp := func(name, path string, annotations map[string]string) *v1alpha1.Application {
+		return &v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Annotations: annotations},
+			Spec: v1alpha1.ApplicationSpec{Source: v1alpha1.ApplicationSource{
+				RepoURL: "https://github.com/jessesuen/test-repo.git", Path: path, TargetRevision: "HEAD",
+			}},
+		}
+	}
+	appClientset := appclientset.NewSimpleClientset(
+		newApp("changed", "ksapps/test-app", map[string]string{common.AnnotationKeyManifestGeneratePaths: "."}),
+		newApp("unchanged", "ksapps/other-app", map[string]string{common.AnnotationKeyManifestGeneratePaths: "."}),
+		newApp("shared", "ksapps/other-app", map[string]string{common.AnnotationKeyManifestGeneratePaths: "../test-app/environments"}),
+		// the manifests of apps which are not annotated might depend on any file, e.g. on a base outside of their path
+		newApp("unannotated", "ksapps/other-app", nil),
+	)
+	h := NewHandler("", appClientset, &settings.ArgoCDSettings{})
+	req := httptest.NewRequest("POST", "/api/webhook", nil)
+	req.Header.Set("X-GitHub-Event", "push")
+	eventJSON, err := ioutil.ReadFile("github-commit-event.json")
+	assert.NoError(t, err)
+	req.Body = ioutil.NopCloser(bytes.NewReader(eventJSON))
+	w := httptest.NewRecorder()
+	h.Handler(w, req)
+	assert.Equal(t, w.Code, http.StatusOK)
+
+	refreshed := func(name string) bool {
+		app, err := appClientset.ArgoprojV1alpha1().Applications("").Get(name, metav1.GetOptions{})
+		assert.NoError(t, err)
+		_, ok := app.Annotations[common.AnnotationKeyRefresh]
+		return ok
+	}
+	assert.True(t, refreshed("changed"))
+	assert.False(t, refreshed("unchanged"))
+	assert.True(t, refreshed("shared"))
+	assert.True(t, refreshed("unannotated"))
+}
+
+func newSignedGitHubRequest(t *testing.T, secret string, deliveryID string) *http.Request {
+	eventJSON, err := ioutil.ReadFile("github-commit-event.json")
+	assert.NoError(t, err)
+	req := httptest.NewRequest("POST", "/api/webhook", bytes.NewReader(eventJSON))
+	req.Header.Set("X-GitHub-Event", "push")
+	req.Header.Set("X-GitHub-Delivery", deliveryID)
+	mac := hmac.New(sha1.New, []byte(secret))
+	_, _ = mac.Write(eventJSON)
+	req.Header.Set("X-Hub-Signature", "sha1="+hex.EncodeToString(mac.Sum(nil)))
+	return req
+}
+
+func rejectedCount(h *ArgoCDWebhookHandler, provider, reason string) float64 {
+	m := &dto.Metric{}
+	_ = h.rejected.WithLabelValues(provider, reason).Write(m)
+	return m.GetCounter().GetValue()
+}
+
+func TestHandler_RequireSecret(t *testing.T) {
+	h := NewHandler("", appclientset.NewSimpleClientset(), &settings.ArgoCDSettings{WebhookRequireSecret: true})
+	w := httptest.NewRecorder()
+	h.Handler(w, newSignedGitHubRequest(t, "", "1"))
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.Equal(t, float64(1), rejectedCount(h, providerGitHub, rejectReasonSecretMissing))
+}
+
+func TestHandler_InvalidSignature(t *testing.T) {
+	h := NewHandler("", appclientset.NewSimpleClientset(), &settings.ArgoCDSettings{WebhookGitHubSecret: "secret"})
+	w := httptest.NewRecorder()
+	h.Handler(w, newSignedGitHubRequest(t, "wrong", "1"))
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.Equal(t, float64(1), rejectedCount(h, providerGitHub, rejectReasonInvalidSignature))
+}
+
+func TestHandler_ReplayProtection(t *testing.T) {
+	newHandler := func() *ArgoCDWebhookHandler {
+		h := NewHandler("", appclientset.NewSimpleClientset(), &settings.ArgoCDSettings{WebhookGitHubSecret: "secret", WebhookReplayWindow: time.Hour})
+		// the push time of the event
+		h.now = func() time.Time { return time.Unix(1525473610, 0).Add(time.Minute) }
+		return h
+	}
+
+	t.Run("Replayed", func(t *testing.T) {
+		h := newHandler()
+		w := httptest.NewRecorder()
+		h.Handler(w, newSignedGitHubRequest(t, "secret", "1"))
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		// a replay with a different delivery ID is detected by the digest of the payload
+		w = httptest.NewRecorder()
+		h.Handler(w, newSignedGitHubRequest(t, "secret", "2"))
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.Equal(t, float64(1), rejectedCount(h, providerGitHub, rejectReasonReplayed))
+	})
+	t.Run("Stale", func(t *testing.T) {
+		h := newHandler()
+		h.now = func() time.Time { return time.Unix(1525473610, 0).Add(2 * time.Hour) }
+		w := httptest.NewRecorder()
+		h.Handler(w, newSignedGitHubRequest(t, "secret", "1"))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, float64(1), rejectedCount(h, providerGitHub, rejectReasonStale))
+	})
+}
@@ -0,0 +1,305 @@
+package writeback
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/ghodss/yaml"
+
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/creds"
+	"github.com/argoproj/argo-cd/util/git"
+	"github.com/argoproj/argo-cd/util/repo/metrics"
+)
+
+var (
+	// githubAPIURL is the URL of the GitHub API which is used to open pull requests
+	githubAPIURL = "https://api.github.com"
+	// githubRepoRegex extracts the owner and name of repositories hosted on github.com
+	githubRepoRegex = regexp.MustCompile(`github\.com[:/]([^/]+)/([^/]+?)(\.git)?/?$`)
+)
+
+// HelmOverrides are the overridden Helm parameters
+type HelmOverrides struct {
+	Parameters []v1alpha1.HelmParameter `json:"parameters,omitempty"`
+}
+
+// KustomizeOverrides are the overridden Kustomize images
+type KustomizeOverrides struct {
+	Images v1alpha1.KustomizeImages `json:"images,omitempty"`
+}
+
+// Overrides are the parameter overrides which are stored in the overrides file. The file uses the same structure as
+// the application source, e.g.:
+//
+//	helm:
+//	  parameters:
+//	  - name: image.tag
+//	    value: v1.2.3
+type Overrides struct {
+	Helm      *HelmOverrides      `json:"helm,omitempty"`
+	Kustomize *KustomizeOverrides `json:"kustomize,omitempty"`
+}
+
+// IsZero returns whether there are no overrides
+func (o *Overrides) IsZero() bool {
+	return o == nil || (o.Helm == nil || len(o.Helm.Parameters) == 0) && (o.Kustomize == nil || len(o.Kustomize.Images) == 0)
+}
+
+// Apply merges the overrides into the given source
+func (o *Overrides) Apply(source *v1alpha1.ApplicationSource) {
+	if o.Helm != nil && len(o.Helm.Parameters) > 0 {
+		if source.Helm == nil {
+			source.Helm = &v1alpha1.ApplicationSourceHelm{}
+		}
+		for _, p := range o.Helm.Parameters {
+			source.Helm.AddParameter(p)
+		}
+	}
+	if o.Kustomize != nil && len(o.Kustomize.Images) > 0 {
+		if source.Kustomize == nil {
+			source.Kustomize = &v1alpha1.ApplicationSourceKustomize{}
+		}
+		for _, image := range o.Kustomize.Images {
+			source.Kustomize.MergeImage(image)
+		}
+	}
+}
+
+// Merge merges the other overrides into these overrides
+func (o *Overrides) Merge(other Overrides) {
+	source := v1alpha1.ApplicationSource{}
+	o.Apply(&source)
+	other.Apply(&source)
+	if source.Helm != nil {
+		o.Helm = &HelmOverrides{Parameters: source.Helm.Parameters}
+	}
+	if source.Kustomize != nil {
+		o.Kustomize = &KustomizeOverrides{Images: source.Kustomize.Images}
+	}
+}
+
+// Extract returns the parameters which were added or changed between the old and the new source as overrides. The
+// extracted parameters are reverted to their old values in the new source, so that they are only stored in git.
+func Extract(old v1alpha1.ApplicationSource, new *v1alpha1.ApplicationSource) Overrides {
+	var overrides Overrides
+	if new.Helm != nil {
+		oldValues := make(map[string]v1alpha1.HelmParameter)
+		if old.Helm != nil {
+			for _, p := range old.Helm.Parameters {
+				oldValues[p.Name] = p
+			}
+		}
+		var params []v1alpha1.HelmParameter
+		for _, p := range new.Helm.Parameters {
+			oldParam, ok := oldValues[p.Name]
+			if ok && oldParam == p {
+				params = append(params, p)
+				continue
+			}
+			if overrides.Helm == nil {
+				overrides.Helm = &HelmOverrides{}
+			}
+			overrides.Helm.Parameters = append(overrides.Helm.Parameters, p)
+			if ok {
+				params = append(params, oldParam)
+			}
+		}
+		new.Helm.Parameters = params
+	}
+	if new.Kustomize != nil {
+		var oldImages v1alpha1.KustomizeImages
+		if old.Kustomize != nil {
+			oldImages = old.Kustomize.Images
+		}
+		var images v1alpha1.KustomizeImages
+		for _, image := range new.Kustomize.Images {
+			i := oldImages.Find(image)
+			if i >= 0 && oldImages[i] == image {
+				images = append(images, image)
+				continue
+			}
+			if overrides.Kustomize == nil {
+				overrides.Kustomize = &KustomizeOverrides{}
+			}
+			overrides.Kustomize.Images = append(overrides.Kustomize.Images, image)
+			if i >= 0 {
+				images = append(images, oldImages[i])
+			}
+		}
+		new.Kustomize.Images = images
+	}
+	return overrides
+}
+
+// FilePath returns the path of the overrides file with the given name in the application directory
+func FilePath(appPath string, file string) (string, error) {
+	if filepath.IsAbs(file) {
+		return "", fmt.Errorf("%s: overrides file path is absolute", file)
+	}
+	path := filepath.Join(appPath, file)
+	if !strings.HasPrefix(path, filepath.Clean(appPath)+string(filepath.Separator)) {
+		return "", fmt.Errorf("%s: overrides file path is outside of the application path", file)
+	}
+	return path, nil
+}
+
+// ReadOverrides reads the overrides file at the given path. Returns nil if the file does not exist.
+func ReadOverrides(path string) (*Overrides, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var overrides Overrides
+	if err := yaml.Unmarshal(data, &overrides); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %v", filepath.Base(path), err)
+	}
+	return &overrides, nil
+}
+
+// Result describes where overrides were written to
+type Result struct {
+	// Branch is the branch which the overrides were pushed to
+	Branch string
+	// Revision is the commit SHA which contains the overrides
+	Revision string
+	// PullRequestURL is the URL of the pull request which was opened for the overrides, if any
+	PullRequestURL string
+}
+
+func (r *Result) String() string {
+	if r.PullRequestURL != "" {
+		return fmt.Sprintf("opened pull request %s", r.PullRequestURL)
+	}
+	return fmt.Sprintf("committed parameter overrides to %s (%s)", r.Branch, r.Revision)
+}
+
+// Commit merges the overrides into the overrides file of the application and pushes the change to the application's
+// git repository, either directly to the configured branch or to a new branch with a pull request.
+func Commit(repo *v1alpha1.Repository, app *v1alpha1.Application, overrides Overrides, message string) (*Result, error) {
+	writeBack := app.Spec.WriteBack
+	if writeBack == nil {
+		return nil, fmt.Errorf("application %s is not configured to write back to git", app.Name)
+	}
+	// the branch is not derived from the target revision, which might be a tag, a commit SHA or any other revision
+	branch := writeBack.Branch
+	if branch == "" {
+		return nil, fmt.Errorf("application %s has no write back branch configured", app.Name)
+	}
+	dir, err := ioutil.TempDir("", "writeback")
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	client, err := git.NewClient(repo.Repo, dir, creds.GetRepoCreds(repo), repo.IsInsecure(), repo.EnableLFS, metrics.NopReporter)
+	if err != nil {
+		return nil, err
+	}
+	if err := client.Init(); err != nil {
+		return nil, err
+	}
+	if err := client.Fetch(); err != nil {
+		return nil, err
+	}
+	if err := client.Checkout("origin/" + branch); err != nil {
+		return nil, err
+	}
+	path, err := FilePath(filepath.Join(dir, app.Spec.Source.Path), writeBack.GetPath())
+	if err != nil {
+		return nil, err
+	}
+	existing, err := ReadOverrides(path)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		existing = &Overrides{}
+	}
+	before, err := yaml.Marshal(existing)
+	if err != nil {
+		return nil, err
+	}
+	existing.Merge(overrides)
+	after, err := yaml.Marshal(existing)
+	if err != nil {
+		return nil, err
+	}
+	if bytes.Equal(before, after) {
+		revision, err := client.CommitSHA()
+		if err != nil {
+			return nil, err
+		}
+		return &Result{Branch: branch, Revision: revision}, nil
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(path, after, 0644); err != nil {
+		return nil, err
+	}
+
+	res := &Result{Branch: branch}
+	if writeBack.PullRequest {
+		res.Branch = fmt.Sprintf("argocd/%s-%d", app.Name, time.Now().Unix())
+	}
+	res.Revision, err = client.CommitAndPush(res.Branch, message)
+	if err != nil {
+		return nil, err
+	}
+	if writeBack.PullRequest {
+		res.PullRequestURL, err = openPullRequest(repo, res.Branch, branch, message)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// openPullRequest opens a pull request from the head branch to the base branch. Pull requests can only be opened for
+// repositories hosted on github.com which are accessed using HTTPS credentials. For any other repository the head branch
+// is left for the user to open a pull request from, and an empty URL is returned.
+func openPullRequest(repo *v1alpha1.Repository, head string, base string, title string) (string, error) {
+	match := githubRepoRegex.FindStringSubmatch(repo.Repo)
+	if match == nil || repo.Password == "" {
+		return "", nil
+	}
+	body, err := json.Marshal(map[string]string{"title": title, "head": head, "base": base})
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/repos/%s/%s/pulls", githubAPIURL, match[1], match[2]), bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.SetBasicAuth(repo.Username, repo.Password)
+	req.Header.Set("Content-Type", "application/json")
+	client := &http.Client{Timeout: 30 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusCreated {
+		data, _ := ioutil.ReadAll(resp.Body)
+		return "", fmt.Errorf("failed to open pull request: %s: %s", resp.Status, string(data))
+	}
+	var pr struct {
+		HTMLURL string `json:"html_url"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&pr); err != nil {
+		return "", err
+	}
+	return pr.HTMLURL, nil
+}
@@ -0,0 +1,162 @@
+package writeback
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+func TestExtract(t *testing.T) {
+	old := v1alpha1.ApplicationSource{
+		Helm: &v1alpha1.ApplicationSourceHelm{Parameters: []v1alpha1.HelmParameter{
+			{Name: "replicas", Value: "1"},
+			{Name: "image.tag", Value: "v1"},
+		}},
+	}
+	new := old.DeepCopy()
+	new.Helm.Parameters = []v1alpha1.HelmParameter{
+		{Name: "replicas", Value: "1"},
+		{Name: "image.tag", Value: "v2"},
+		{Name: "debug", Value: "true"},
+	}
+
+	overrides := Extract(old, new)
+
+	assert.Equal(t, []v1alpha1.HelmParameter{{Name: "image.tag", Value: "v2"}, {Name: "debug", Value: "true"}}, overrides.Helm.Parameters)
+	assert.Nil(t, overrides.Kustomize)
+	assert.Equal(t, old.Helm.Parameters, new.Helm.Parameters)
+}
+
+func TestExtract_KustomizeImages(t *testing.T) {
+	old := v1alpha1.ApplicationSource{Kustomize: &v1alpha1.ApplicationSourceKustomize{Images: v1alpha1.KustomizeImages{"nginx:1.16"}}}
+	new := old.DeepCopy()
+	new.Kustomize.Images = v1alpha1.KustomizeImages{"nginx:1.17", "redis:5"}
+
+	overrides := Extract(old, new)
+
+	assert.Equal(t, v1alpha1.KustomizeImages{"nginx:1.17", "redis:5"}, overrides.Kustomize.Images)
+	assert.Equal(t, v1alpha1.KustomizeImages{"nginx:1.16"}, new.Kustomize.Images)
+	unchanged := Extract(old, old.DeepCopy())
+	assert.True(t, unchanged.IsZero())
+}
+
+func TestOverrides_Merge(t *testing.T) {
+	overrides := Overrides{Helm: &HelmOverrides{Parameters: []v1alpha1.HelmParameter{{Name: "image.tag", Value: "v1"}}}}
+	overrides.Merge(Overrides{
+		Helm:      &HelmOverrides{Parameters: []v1alpha1.HelmParameter{{Name: "image.tag", Value: "v2"}}},
+		Kustomize: &KustomizeOverrides{Images: v1alpha1.KustomizeImages{"nginx:1.17"}},
+	})
+	assert.Equal(t, []v1alpha1.HelmParameter{{Name: "image.tag", Value: "v2"}}, overrides.Helm.Parameters)
+	assert.Equal(t, v1alpha1.KustomizeImages{"nginx:1.17"}, overrides.Kustomize.Images)
+}
+
+func TestFilePath(t *testing.T) {
+	path, err := FilePath("/repo/app", ".argocd-source.yaml")
+	assert.NoError(t, err)
+	assert.Equal(t, "/repo/app/.argocd-source.yaml", path)
+
+	_, err = FilePath("/repo/app", "../other/.argocd-source.yaml")
+	assert.Error(t, err)
+	_, err = FilePath("/repo/app", "/etc/passwd")
+	assert.Error(t, err)
+}
+
+func runGit(t *testing.T, dir string, args ...string) string {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@example.com", "GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@example.com")
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("git %v: %v: %s", args, err, out)
+	}
+	return string(out)
+}
+
+// newTestRepo creates a bare git repository with a single commit on master which contains the `app` directory
+func newTestRepo(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "writeback-test")
+	assert.NoError(t, err)
+	remote := filepath.Join(dir, "remote.git")
+	work := filepath.Join(dir, "work")
+	runGit(t, dir, "init", "--bare", remote)
+	runGit(t, dir, "clone", remote, work)
+	assert.NoError(t, os.MkdirAll(filepath.Join(work, "app"), 0755))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(work, "app", "Chart.yaml"), []byte("name: app"), 0644))
+	runGit(t, work, "add", "--all")
+	runGit(t, work, "commit", "-m", "initial commit")
+	runGit(t, work, "push", "origin", "HEAD:refs/heads/master")
+	return remote, func() { _ = os.RemoveAll(dir) }
+}
+
+func TestCommit(t *testing.T) {
+	remote, cleanup := newTestRepo(t)
+	defer cleanup()
+
+	app := &v1alpha1.Application{Spec: v1alpha1.ApplicationSpec{
+		Source:    v1alpha1.ApplicationSource{RepoURL: remote, Path: "app", TargetRevision: "master"},
+		WriteBack: &v1alpha1.ApplicationWriteBack{},
+	}}
+	app.Name = "guestbook"
+	overrides := Overrides{Helm: &HelmOverrides{Parameters: []v1alpha1.HelmParameter{{Name: "image.tag", Value: "v2"}}}}
+
+	// the branch is not derived from the target revision
+	_, err := Commit(&v1alpha1.Repository{Repo: remote}, app, overrides, "update guestbook")
+	assert.EqualError(t, err, "application guestbook has no write back branch configured")
+
+	app.Spec.WriteBack.Branch = "master"
+	res, err := Commit(&v1alpha1.Repository{Repo: remote}, app, overrides, "update guestbook")
+	assert.NoError(t, err)
+	assert.Equal(t, "master", res.Branch)
+	assert.Equal(t, res.Revision, runGit(t, remote, "rev-parse", "master")[:40])
+	assert.Equal(t, "helm:\n  parameters:\n  - name: image.tag\n    value: v2\n", runGit(t, remote, "show", "master:app/.argocd-source.yaml"))
+
+	unchanged, err := Commit(&v1alpha1.Repository{Repo: remote}, app, overrides, "update guestbook")
+	assert.NoError(t, err)
+	assert.Equal(t, res.Revision, unchanged.Revision)
+}
+
+func TestCommit_PullRequest(t *testing.T) {
+	remote, cleanup := newTestRepo(t)
+	defer cleanup()
+
+	var requested map[string]string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/repos/argoproj/guestbook/pulls", r.URL.Path)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&requested))
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"html_url": "https://github.com/argoproj/guestbook/pull/1"}`))
+	}))
+	defer ts.Close()
+	githubAPIURL = ts.URL
+
+	app := &v1alpha1.Application{Spec: v1alpha1.ApplicationSpec{
+		Source:    v1alpha1.ApplicationSource{RepoURL: remote, Path: "app", TargetRevision: "master"},
+		WriteBack: &v1alpha1.ApplicationWriteBack{Branch: "master", PullRequest: true},
+	}}
+	app.Name = "guestbook"
+	overrides := Overrides{Kustomize: &KustomizeOverrides{Images: v1alpha1.KustomizeImages{"nginx:1.17"}}}
+
+	_, err := openPullRequest(&v1alpha1.Repository{Repo: remote, Password: "token"}, "head", "master", "title")
+	assert.NoError(t, err)
+	assert.Nil(t, requested)
+
+	res, err := Commit(&v1alpha1.Repository{Repo: remote}, app, overrides, "update guestbook")
+	assert.NoError(t, err)
+	assert.NotEqual(t, "master", res.Branch)
+	assert.Empty(t, res.PullRequestURL)
+	assert.Equal(t, res.Revision, runGit(t, remote, "rev-parse", res.Branch)[:40])
+
+	url, err := openPullRequest(&v1alpha1.Repository{Repo: "https://github.com/argoproj/guestbook.git", Username: "git", Password: "token"}, res.Branch, "master", "update guestbook")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://github.com/argoproj/guestbook/pull/1", url)
+	assert.Equal(t, map[string]string{"title": "update guestbook", "head": res.Branch, "base": "master"}, requested)
+}